@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: band/base/feeds/v1/stream.proto
+
+package feedsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Service_SubscribePrices_FullMethodName = "/band.base.feeds.v1.Service/SubscribePrices"
+)
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceClient interface {
+	// SubscribePrices streams the prices of the requested signal ids that changed in each committed block.
+	SubscribePrices(ctx context.Context, in *SubscribePricesRequest, opts ...grpc.CallOption) (Service_SubscribePricesClient, error)
+}
+
+type serviceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) SubscribePrices(ctx context.Context, in *SubscribePricesRequest, opts ...grpc.CallOption) (Service_SubscribePricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[0], Service_SubscribePrices_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceSubscribePricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_SubscribePricesClient interface {
+	Recv() (*SubscribePricesResponse, error)
+	grpc.ClientStream
+}
+
+type serviceSubscribePricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceSubscribePricesClient) Recv() (*SubscribePricesResponse, error) {
+	m := new(SubscribePricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility
+type ServiceServer interface {
+	// SubscribePrices streams the prices of the requested signal ids that changed in each committed block.
+	SubscribePrices(*SubscribePricesRequest, Service_SubscribePricesServer) error
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (UnimplementedServiceServer) SubscribePrices(*SubscribePricesRequest, Service_SubscribePricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePrices not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_SubscribePrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).SubscribePrices(m, &serviceSubscribePricesServer{stream})
+}
+
+type Service_SubscribePricesServer interface {
+	Send(*SubscribePricesResponse) error
+	grpc.ServerStream
+}
+
+type serviceSubscribePricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceSubscribePricesServer) Send(m *SubscribePricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "band.base.feeds.v1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePrices",
+			Handler:       _Service_SubscribePrices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "band/base/feeds/v1/stream.proto",
+}
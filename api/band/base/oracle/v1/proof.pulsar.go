@@ -3,6 +3,7 @@ package oraclev1
 
 import (
 	fmt "fmt"
+	v1beta1 "github.com/bandprotocol/chain/v3/api/band/feeds/v1beta1"
 	v1 "github.com/bandprotocol/chain/v3/api/band/oracle/v1"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -2808,28 +2809,74 @@ func (x *fastReflection_RequestCountProofResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_PricesProofRequest_1_list)(nil)
+
+type _PricesProofRequest_1_list struct {
+	list *[]string
+}
+
+func (x *_PricesProofRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PricesProofRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PricesProofRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PricesProofRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PricesProofRequest_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PricesProofRequest at list field SignalIds as it is not of Message kind"))
+}
+
+func (x *_PricesProofRequest_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PricesProofRequest_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PricesProofRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_SingleProofResult                 protoreflect.MessageDescriptor
-	fd_SingleProofResult_proof           protoreflect.FieldDescriptor
-	fd_SingleProofResult_evm_proof_bytes protoreflect.FieldDescriptor
+	md_PricesProofRequest            protoreflect.MessageDescriptor
+	fd_PricesProofRequest_signal_ids protoreflect.FieldDescriptor
+	fd_PricesProofRequest_height     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_SingleProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("SingleProofResult")
-	fd_SingleProofResult_proof = md_SingleProofResult.Fields().ByName("proof")
-	fd_SingleProofResult_evm_proof_bytes = md_SingleProofResult.Fields().ByName("evm_proof_bytes")
+	md_PricesProofRequest = File_band_base_oracle_v1_proof_proto.Messages().ByName("PricesProofRequest")
+	fd_PricesProofRequest_signal_ids = md_PricesProofRequest.Fields().ByName("signal_ids")
+	fd_PricesProofRequest_height = md_PricesProofRequest.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_SingleProofResult)(nil)
+var _ protoreflect.Message = (*fastReflection_PricesProofRequest)(nil)
 
-type fastReflection_SingleProofResult SingleProofResult
+type fastReflection_PricesProofRequest PricesProofRequest
 
-func (x *SingleProofResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SingleProofResult)(x)
+func (x *PricesProofRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PricesProofRequest)(x)
 }
 
-func (x *SingleProofResult) slowProtoReflect() protoreflect.Message {
+func (x *PricesProofRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2841,43 +2888,43 @@ func (x *SingleProofResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SingleProofResult_messageType fastReflection_SingleProofResult_messageType
-var _ protoreflect.MessageType = fastReflection_SingleProofResult_messageType{}
+var _fastReflection_PricesProofRequest_messageType fastReflection_PricesProofRequest_messageType
+var _ protoreflect.MessageType = fastReflection_PricesProofRequest_messageType{}
 
-type fastReflection_SingleProofResult_messageType struct{}
+type fastReflection_PricesProofRequest_messageType struct{}
 
-func (x fastReflection_SingleProofResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SingleProofResult)(nil)
+func (x fastReflection_PricesProofRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PricesProofRequest)(nil)
 }
-func (x fastReflection_SingleProofResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_SingleProofResult)
+func (x fastReflection_PricesProofRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_PricesProofRequest)
 }
-func (x fastReflection_SingleProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SingleProofResult
+func (x fastReflection_PricesProofRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricesProofRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SingleProofResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_SingleProofResult
+func (x *fastReflection_PricesProofRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricesProofRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SingleProofResult) Type() protoreflect.MessageType {
-	return _fastReflection_SingleProofResult_messageType
+func (x *fastReflection_PricesProofRequest) Type() protoreflect.MessageType {
+	return _fastReflection_PricesProofRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SingleProofResult) New() protoreflect.Message {
-	return new(fastReflection_SingleProofResult)
+func (x *fastReflection_PricesProofRequest) New() protoreflect.Message {
+	return new(fastReflection_PricesProofRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SingleProofResult) Interface() protoreflect.ProtoMessage {
-	return (*SingleProofResult)(x)
+func (x *fastReflection_PricesProofRequest) Interface() protoreflect.ProtoMessage {
+	return (*PricesProofRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2885,16 +2932,16 @@ func (x *fastReflection_SingleProofResult) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SingleProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Proof != nil {
-		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-		if !f(fd_SingleProofResult_proof, value) {
+func (x *fastReflection_PricesProofRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.SignalIds) != 0 {
+		value := protoreflect.ValueOfList(&_PricesProofRequest_1_list{list: &x.SignalIds})
+		if !f(fd_PricesProofRequest_signal_ids, value) {
 			return
 		}
 	}
-	if len(x.EvmProofBytes) != 0 {
-		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
-		if !f(fd_SingleProofResult_evm_proof_bytes, value) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_PricesProofRequest_height, value) {
 			return
 		}
 	}
@@ -2911,17 +2958,17 @@ func (x *fastReflection_SingleProofResult) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SingleProofResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PricesProofRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		return x.Proof != nil
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		return len(x.EvmProofBytes) != 0
+	case "band.base.oracle.v1.PricesProofRequest.signal_ids":
+		return len(x.SignalIds) != 0
+	case "band.base.oracle.v1.PricesProofRequest.height":
+		return x.Height != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2931,17 +2978,17 @@ func (x *fastReflection_SingleProofResult) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProofResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PricesProofRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		x.Proof = nil
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		x.EvmProofBytes = nil
+	case "band.base.oracle.v1.PricesProofRequest.signal_ids":
+		x.SignalIds = nil
+	case "band.base.oracle.v1.PricesProofRequest.height":
+		x.Height = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2951,19 +2998,22 @@ func (x *fastReflection_SingleProofResult) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SingleProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PricesProofRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		value := x.Proof
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		value := x.EvmProofBytes
-		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.PricesProofRequest.signal_ids":
+		if len(x.SignalIds) == 0 {
+			return protoreflect.ValueOfList(&_PricesProofRequest_1_list{})
+		}
+		listValue := &_PricesProofRequest_1_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(listValue)
+	case "band.base.oracle.v1.PricesProofRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2977,17 +3027,19 @@ func (x *fastReflection_SingleProofResult) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PricesProofRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		x.Proof = value.Message().Interface().(*SingleProof)
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		x.EvmProofBytes = value.Bytes()
+	case "band.base.oracle.v1.PricesProofRequest.signal_ids":
+		lv := value.List()
+		clv := lv.(*_PricesProofRequest_1_list)
+		x.SignalIds = *clv.list
+	case "band.base.oracle.v1.PricesProofRequest.height":
+		x.Height = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3001,48 +3053,49 @@ func (x *fastReflection_SingleProofResult) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PricesProofRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		if x.Proof == nil {
-			x.Proof = new(SingleProof)
+	case "band.base.oracle.v1.PricesProofRequest.signal_ids":
+		if x.SignalIds == nil {
+			x.SignalIds = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.SingleProofResult is not mutable"))
+		value := &_PricesProofRequest_1_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.PricesProofRequest.height":
+		panic(fmt.Errorf("field height of message band.base.oracle.v1.PricesProofRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SingleProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PricesProofRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		m := new(SingleProof)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.PricesProofRequest.signal_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PricesProofRequest_1_list{list: &list})
+	case "band.base.oracle.v1.PricesProofRequest.height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SingleProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PricesProofRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.SingleProofResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PricesProofRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3050,7 +3103,7 @@ func (x *fastReflection_SingleProofResult) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SingleProofResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PricesProofRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3061,7 +3114,7 @@ func (x *fastReflection_SingleProofResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProofResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PricesProofRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3073,7 +3126,7 @@ func (x *fastReflection_SingleProofResult) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SingleProofResult) IsValid() bool {
+func (x *fastReflection_PricesProofRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3083,9 +3136,9 @@ func (x *fastReflection_SingleProofResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PricesProofRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SingleProofResult)
+		x := input.Message.Interface().(*PricesProofRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3097,13 +3150,14 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Proof != nil {
-			l = options.Size(x.Proof)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.SignalIds) > 0 {
+			for _, s := range x.SignalIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.EvmProofBytes)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3115,7 +3169,7 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SingleProofResult)
+		x := input.Message.Interface().(*PricesProofRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3134,26 +3188,19 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.EvmProofBytes) > 0 {
-			i -= len(x.EvmProofBytes)
-			copy(dAtA[i:], x.EvmProofBytes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.Proof != nil {
-			encoded, err := options.Marshal(x.Proof)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.SignalIds) > 0 {
+			for iNdEx := len(x.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SignalIds[iNdEx])
+				copy(dAtA[i:], x.SignalIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalIds[iNdEx])))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3166,7 +3213,7 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SingleProofResult)
+		x := input.Message.Interface().(*PricesProofRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3198,17 +3245,17 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricesProofRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricesProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3218,33 +3265,29 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Proof == nil {
-					x.Proof = &SingleProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.SignalIds = append(x.SignalIds, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var byteLen int
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3254,26 +3297,11 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-				if x.EvmProofBytes == nil {
-					x.EvmProofBytes = []byte{}
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3310,27 +3338,27 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MultiProofResult                 protoreflect.MessageDescriptor
-	fd_MultiProofResult_proof           protoreflect.FieldDescriptor
-	fd_MultiProofResult_evm_proof_bytes protoreflect.FieldDescriptor
+	md_PricesProofResponse        protoreflect.MessageDescriptor
+	fd_PricesProofResponse_height protoreflect.FieldDescriptor
+	fd_PricesProofResponse_result protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_MultiProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiProofResult")
-	fd_MultiProofResult_proof = md_MultiProofResult.Fields().ByName("proof")
-	fd_MultiProofResult_evm_proof_bytes = md_MultiProofResult.Fields().ByName("evm_proof_bytes")
+	md_PricesProofResponse = File_band_base_oracle_v1_proof_proto.Messages().ByName("PricesProofResponse")
+	fd_PricesProofResponse_height = md_PricesProofResponse.Fields().ByName("height")
+	fd_PricesProofResponse_result = md_PricesProofResponse.Fields().ByName("result")
 }
 
-var _ protoreflect.Message = (*fastReflection_MultiProofResult)(nil)
+var _ protoreflect.Message = (*fastReflection_PricesProofResponse)(nil)
 
-type fastReflection_MultiProofResult MultiProofResult
+type fastReflection_PricesProofResponse PricesProofResponse
 
-func (x *MultiProofResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MultiProofResult)(x)
+func (x *PricesProofResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PricesProofResponse)(x)
 }
 
-func (x *MultiProofResult) slowProtoReflect() protoreflect.Message {
+func (x *PricesProofResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3342,43 +3370,43 @@ func (x *MultiProofResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MultiProofResult_messageType fastReflection_MultiProofResult_messageType
-var _ protoreflect.MessageType = fastReflection_MultiProofResult_messageType{}
+var _fastReflection_PricesProofResponse_messageType fastReflection_PricesProofResponse_messageType
+var _ protoreflect.MessageType = fastReflection_PricesProofResponse_messageType{}
 
-type fastReflection_MultiProofResult_messageType struct{}
+type fastReflection_PricesProofResponse_messageType struct{}
 
-func (x fastReflection_MultiProofResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MultiProofResult)(nil)
+func (x fastReflection_PricesProofResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PricesProofResponse)(nil)
 }
-func (x fastReflection_MultiProofResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_MultiProofResult)
+func (x fastReflection_PricesProofResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_PricesProofResponse)
 }
-func (x fastReflection_MultiProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiProofResult
+func (x fastReflection_PricesProofResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricesProofResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MultiProofResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiProofResult
+func (x *fastReflection_PricesProofResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricesProofResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MultiProofResult) Type() protoreflect.MessageType {
-	return _fastReflection_MultiProofResult_messageType
+func (x *fastReflection_PricesProofResponse) Type() protoreflect.MessageType {
+	return _fastReflection_PricesProofResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MultiProofResult) New() protoreflect.Message {
-	return new(fastReflection_MultiProofResult)
+func (x *fastReflection_PricesProofResponse) New() protoreflect.Message {
+	return new(fastReflection_PricesProofResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MultiProofResult) Interface() protoreflect.ProtoMessage {
-	return (*MultiProofResult)(x)
+func (x *fastReflection_PricesProofResponse) Interface() protoreflect.ProtoMessage {
+	return (*PricesProofResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3386,16 +3414,16 @@ func (x *fastReflection_MultiProofResult) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MultiProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Proof != nil {
-		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-		if !f(fd_MultiProofResult_proof, value) {
+func (x *fastReflection_PricesProofResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_PricesProofResponse_height, value) {
 			return
 		}
 	}
-	if len(x.EvmProofBytes) != 0 {
-		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
-		if !f(fd_MultiProofResult_evm_proof_bytes, value) {
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_PricesProofResponse_result, value) {
 			return
 		}
 	}
@@ -3412,17 +3440,17 @@ func (x *fastReflection_MultiProofResult) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MultiProofResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PricesProofResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		return x.Proof != nil
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		return len(x.EvmProofBytes) != 0
+	case "band.base.oracle.v1.PricesProofResponse.height":
+		return x.Height != int64(0)
+	case "band.base.oracle.v1.PricesProofResponse.result":
+		return x.Result != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3432,17 +3460,17 @@ func (x *fastReflection_MultiProofResult) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProofResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PricesProofResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		x.Proof = nil
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		x.EvmProofBytes = nil
+	case "band.base.oracle.v1.PricesProofResponse.height":
+		x.Height = int64(0)
+	case "band.base.oracle.v1.PricesProofResponse.result":
+		x.Result = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3452,19 +3480,19 @@ func (x *fastReflection_MultiProofResult) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MultiProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PricesProofResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		value := x.Proof
+	case "band.base.oracle.v1.PricesProofResponse.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "band.base.oracle.v1.PricesProofResponse.result":
+		value := x.Result
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		value := x.EvmProofBytes
-		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3478,17 +3506,17 @@ func (x *fastReflection_MultiProofResult) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PricesProofResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		x.Proof = value.Message().Interface().(*MultiProof)
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		x.EvmProofBytes = value.Bytes()
+	case "band.base.oracle.v1.PricesProofResponse.height":
+		x.Height = value.Int()
+	case "band.base.oracle.v1.PricesProofResponse.result":
+		x.Result = value.Message().Interface().(*PricesProofResult)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3502,48 +3530,48 @@ func (x *fastReflection_MultiProofResult) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PricesProofResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		if x.Proof == nil {
-			x.Proof = new(MultiProof)
+	case "band.base.oracle.v1.PricesProofResponse.result":
+		if x.Result == nil {
+			x.Result = new(PricesProofResult)
 		}
-		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.MultiProofResult is not mutable"))
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	case "band.base.oracle.v1.PricesProofResponse.height":
+		panic(fmt.Errorf("field height of message band.base.oracle.v1.PricesProofResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MultiProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PricesProofResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		m := new(MultiProof)
+	case "band.base.oracle.v1.PricesProofResponse.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.base.oracle.v1.PricesProofResponse.result":
+		m := new(PricesProofResult)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MultiProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PricesProofResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiProofResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PricesProofResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3551,7 +3579,7 @@ func (x *fastReflection_MultiProofResult) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MultiProofResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PricesProofResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3562,7 +3590,7 @@ func (x *fastReflection_MultiProofResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProofResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PricesProofResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3574,7 +3602,7 @@ func (x *fastReflection_MultiProofResult) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MultiProofResult) IsValid() bool {
+func (x *fastReflection_PricesProofResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3584,9 +3612,9 @@ func (x *fastReflection_MultiProofResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PricesProofResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MultiProofResult)
+		x := input.Message.Interface().(*PricesProofResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3598,12 +3626,11 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Proof != nil {
-			l = options.Size(x.Proof)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
-		l = len(x.EvmProofBytes)
-		if l > 0 {
+		if x.Result != nil {
+			l = options.Size(x.Result)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3616,7 +3643,7 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MultiProofResult)
+		x := input.Message.Interface().(*PricesProofResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3635,15 +3662,8 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.EvmProofBytes) > 0 {
-			i -= len(x.EvmProofBytes)
-			copy(dAtA[i:], x.EvmProofBytes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Proof != nil {
-			encoded, err := options.Marshal(x.Proof)
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3654,7 +3674,12 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3667,7 +3692,7 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MultiProofResult)
+		x := input.Message.Interface().(*PricesProofResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3699,17 +3724,17 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricesProofResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricesProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var msglen int
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3719,33 +3744,16 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Proof == nil {
-					x.Proof = &MultiProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3755,24 +3763,26 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-				if x.EvmProofBytes == nil {
-					x.EvmProofBytes = []byte{}
+				if x.Result == nil {
+					x.Result = &PricesProofResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -3811,27 +3821,27 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CountProofResult                 protoreflect.MessageDescriptor
-	fd_CountProofResult_proof           protoreflect.FieldDescriptor
-	fd_CountProofResult_evm_proof_bytes protoreflect.FieldDescriptor
+	md_SingleProofResult                 protoreflect.MessageDescriptor
+	fd_SingleProofResult_proof           protoreflect.FieldDescriptor
+	fd_SingleProofResult_evm_proof_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_CountProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("CountProofResult")
-	fd_CountProofResult_proof = md_CountProofResult.Fields().ByName("proof")
-	fd_CountProofResult_evm_proof_bytes = md_CountProofResult.Fields().ByName("evm_proof_bytes")
+	md_SingleProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("SingleProofResult")
+	fd_SingleProofResult_proof = md_SingleProofResult.Fields().ByName("proof")
+	fd_SingleProofResult_evm_proof_bytes = md_SingleProofResult.Fields().ByName("evm_proof_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_CountProofResult)(nil)
+var _ protoreflect.Message = (*fastReflection_SingleProofResult)(nil)
 
-type fastReflection_CountProofResult CountProofResult
+type fastReflection_SingleProofResult SingleProofResult
 
-func (x *CountProofResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CountProofResult)(x)
+func (x *SingleProofResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SingleProofResult)(x)
 }
 
-func (x *CountProofResult) slowProtoReflect() protoreflect.Message {
+func (x *SingleProofResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3843,43 +3853,43 @@ func (x *CountProofResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CountProofResult_messageType fastReflection_CountProofResult_messageType
-var _ protoreflect.MessageType = fastReflection_CountProofResult_messageType{}
+var _fastReflection_SingleProofResult_messageType fastReflection_SingleProofResult_messageType
+var _ protoreflect.MessageType = fastReflection_SingleProofResult_messageType{}
 
-type fastReflection_CountProofResult_messageType struct{}
+type fastReflection_SingleProofResult_messageType struct{}
 
-func (x fastReflection_CountProofResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CountProofResult)(nil)
+func (x fastReflection_SingleProofResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SingleProofResult)(nil)
 }
-func (x fastReflection_CountProofResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_CountProofResult)
+func (x fastReflection_SingleProofResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_SingleProofResult)
 }
-func (x fastReflection_CountProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CountProofResult
+func (x fastReflection_SingleProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SingleProofResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CountProofResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_CountProofResult
+func (x *fastReflection_SingleProofResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_SingleProofResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CountProofResult) Type() protoreflect.MessageType {
-	return _fastReflection_CountProofResult_messageType
+func (x *fastReflection_SingleProofResult) Type() protoreflect.MessageType {
+	return _fastReflection_SingleProofResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CountProofResult) New() protoreflect.Message {
-	return new(fastReflection_CountProofResult)
+func (x *fastReflection_SingleProofResult) New() protoreflect.Message {
+	return new(fastReflection_SingleProofResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CountProofResult) Interface() protoreflect.ProtoMessage {
-	return (*CountProofResult)(x)
+func (x *fastReflection_SingleProofResult) Interface() protoreflect.ProtoMessage {
+	return (*SingleProofResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3887,16 +3897,16 @@ func (x *fastReflection_CountProofResult) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CountProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_SingleProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Proof != nil {
 		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-		if !f(fd_CountProofResult_proof, value) {
+		if !f(fd_SingleProofResult_proof, value) {
 			return
 		}
 	}
 	if len(x.EvmProofBytes) != 0 {
 		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
-		if !f(fd_CountProofResult_evm_proof_bytes, value) {
+		if !f(fd_SingleProofResult_evm_proof_bytes, value) {
 			return
 		}
 	}
@@ -3913,17 +3923,17 @@ func (x *fastReflection_CountProofResult) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CountProofResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SingleProofResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
+	case "band.base.oracle.v1.SingleProofResult.proof":
 		return x.Proof != nil
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
 		return len(x.EvmProofBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3933,17 +3943,17 @@ func (x *fastReflection_CountProofResult) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProofResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SingleProofResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
+	case "band.base.oracle.v1.SingleProofResult.proof":
 		x.Proof = nil
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
 		x.EvmProofBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3953,19 +3963,19 @@ func (x *fastReflection_CountProofResult) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CountProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
+	case "band.base.oracle.v1.SingleProofResult.proof":
 		value := x.Proof
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
 		value := x.EvmProofBytes
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3979,17 +3989,17 @@ func (x *fastReflection_CountProofResult) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SingleProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
-		x.Proof = value.Message().Interface().(*CountProof)
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+	case "band.base.oracle.v1.SingleProofResult.proof":
+		x.Proof = value.Message().Interface().(*SingleProof)
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
 		x.EvmProofBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4003,48 +4013,48 @@ func (x *fastReflection_CountProofResult) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
+	case "band.base.oracle.v1.SingleProofResult.proof":
 		if x.Proof == nil {
-			x.Proof = new(CountProof)
+			x.Proof = new(SingleProof)
 		}
 		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
-		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.CountProofResult is not mutable"))
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
+		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.SingleProofResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CountProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
-		m := new(CountProof)
+	case "band.base.oracle.v1.SingleProofResult.proof":
+		m := new(SingleProof)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CountProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SingleProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.CountProofResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.SingleProofResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4052,7 +4062,7 @@ func (x *fastReflection_CountProofResult) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CountProofResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SingleProofResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4063,7 +4073,7 @@ func (x *fastReflection_CountProofResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProofResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SingleProofResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4075,7 +4085,7 @@ func (x *fastReflection_CountProofResult) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CountProofResult) IsValid() bool {
+func (x *fastReflection_SingleProofResult) IsValid() bool {
 	return x != nil
 }
 
@@ -4085,9 +4095,9 @@ func (x *fastReflection_CountProofResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CountProofResult)
+		x := input.Message.Interface().(*SingleProofResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4117,7 +4127,7 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CountProofResult)
+		x := input.Message.Interface().(*SingleProofResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4168,7 +4178,7 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CountProofResult)
+		x := input.Message.Interface().(*SingleProofResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4200,10 +4210,10 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4236,7 +4246,7 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if x.Proof == nil {
-					x.Proof = &CountProof{}
+					x.Proof = &SingleProof{}
 				}
 				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
@@ -4312,29 +4322,27 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SingleProof                   protoreflect.MessageDescriptor
-	fd_SingleProof_block_height      protoreflect.FieldDescriptor
-	fd_SingleProof_oracle_data_proof protoreflect.FieldDescriptor
-	fd_SingleProof_block_relay_proof protoreflect.FieldDescriptor
+	md_MultiProofResult                 protoreflect.MessageDescriptor
+	fd_MultiProofResult_proof           protoreflect.FieldDescriptor
+	fd_MultiProofResult_evm_proof_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_SingleProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("SingleProof")
-	fd_SingleProof_block_height = md_SingleProof.Fields().ByName("block_height")
-	fd_SingleProof_oracle_data_proof = md_SingleProof.Fields().ByName("oracle_data_proof")
-	fd_SingleProof_block_relay_proof = md_SingleProof.Fields().ByName("block_relay_proof")
+	md_MultiProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiProofResult")
+	fd_MultiProofResult_proof = md_MultiProofResult.Fields().ByName("proof")
+	fd_MultiProofResult_evm_proof_bytes = md_MultiProofResult.Fields().ByName("evm_proof_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_SingleProof)(nil)
+var _ protoreflect.Message = (*fastReflection_MultiProofResult)(nil)
 
-type fastReflection_SingleProof SingleProof
+type fastReflection_MultiProofResult MultiProofResult
 
-func (x *SingleProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SingleProof)(x)
+func (x *MultiProofResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MultiProofResult)(x)
 }
 
-func (x *SingleProof) slowProtoReflect() protoreflect.Message {
+func (x *MultiProofResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4346,43 +4354,43 @@ func (x *SingleProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SingleProof_messageType fastReflection_SingleProof_messageType
-var _ protoreflect.MessageType = fastReflection_SingleProof_messageType{}
+var _fastReflection_MultiProofResult_messageType fastReflection_MultiProofResult_messageType
+var _ protoreflect.MessageType = fastReflection_MultiProofResult_messageType{}
 
-type fastReflection_SingleProof_messageType struct{}
+type fastReflection_MultiProofResult_messageType struct{}
 
-func (x fastReflection_SingleProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SingleProof)(nil)
+func (x fastReflection_MultiProofResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MultiProofResult)(nil)
 }
-func (x fastReflection_SingleProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_SingleProof)
+func (x fastReflection_MultiProofResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_MultiProofResult)
 }
-func (x fastReflection_SingleProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SingleProof
+func (x fastReflection_MultiProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiProofResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SingleProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_SingleProof
+func (x *fastReflection_MultiProofResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiProofResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SingleProof) Type() protoreflect.MessageType {
-	return _fastReflection_SingleProof_messageType
+func (x *fastReflection_MultiProofResult) Type() protoreflect.MessageType {
+	return _fastReflection_MultiProofResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SingleProof) New() protoreflect.Message {
-	return new(fastReflection_SingleProof)
+func (x *fastReflection_MultiProofResult) New() protoreflect.Message {
+	return new(fastReflection_MultiProofResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SingleProof) Interface() protoreflect.ProtoMessage {
-	return (*SingleProof)(x)
+func (x *fastReflection_MultiProofResult) Interface() protoreflect.ProtoMessage {
+	return (*MultiProofResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4390,22 +4398,16 @@ func (x *fastReflection_SingleProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SingleProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BlockHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_SingleProof_block_height, value) {
-			return
-		}
-	}
-	if x.OracleDataProof != nil {
-		value := protoreflect.ValueOfMessage(x.OracleDataProof.ProtoReflect())
-		if !f(fd_SingleProof_oracle_data_proof, value) {
+func (x *fastReflection_MultiProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_MultiProofResult_proof, value) {
 			return
 		}
 	}
-	if x.BlockRelayProof != nil {
-		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-		if !f(fd_SingleProof_block_relay_proof, value) {
+	if len(x.EvmProofBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
+		if !f(fd_MultiProofResult_evm_proof_bytes, value) {
 			return
 		}
 	}
@@ -4422,19 +4424,17 @@ func (x *fastReflection_SingleProof) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SingleProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MultiProofResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProof.block_height":
-		return x.BlockHeight != uint64(0)
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		return x.OracleDataProof != nil
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		return x.BlockRelayProof != nil
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		return x.Proof != nil
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		return len(x.EvmProofBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4444,19 +4444,17 @@ func (x *fastReflection_SingleProof) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MultiProofResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProof.block_height":
-		x.BlockHeight = uint64(0)
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		x.OracleDataProof = nil
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		x.BlockRelayProof = nil
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		x.Proof = nil
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		x.EvmProofBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4466,22 +4464,19 @@ func (x *fastReflection_SingleProof) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SingleProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.SingleProof.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		value := x.OracleDataProof
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		value := x.BlockRelayProof
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		value := x.Proof
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		value := x.EvmProofBytes
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4495,19 +4490,17 @@ func (x *fastReflection_SingleProof) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MultiProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProof.block_height":
-		x.BlockHeight = value.Uint()
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		x.OracleDataProof = value.Message().Interface().(*OracleDataProof)
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		x.Proof = value.Message().Interface().(*MultiProof)
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		x.EvmProofBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4521,56 +4514,48 @@ func (x *fastReflection_SingleProof) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		if x.OracleDataProof == nil {
-			x.OracleDataProof = new(OracleDataProof)
-		}
-		return protoreflect.ValueOfMessage(x.OracleDataProof.ProtoReflect())
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		if x.BlockRelayProof == nil {
-			x.BlockRelayProof = new(BlockRelayProof)
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		if x.Proof == nil {
+			x.Proof = new(MultiProof)
 		}
-		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-	case "band.base.oracle.v1.SingleProof.block_height":
-		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.SingleProof is not mutable"))
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.MultiProofResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SingleProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProof.block_height":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		m := new(OracleDataProof)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		m := new(BlockRelayProof)
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		m := new(MultiProof)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SingleProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MultiProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.SingleProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiProofResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4578,7 +4563,7 @@ func (x *fastReflection_SingleProof) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SingleProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MultiProofResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4589,7 +4574,7 @@ func (x *fastReflection_SingleProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MultiProofResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4601,7 +4586,7 @@ func (x *fastReflection_SingleProof) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SingleProof) IsValid() bool {
+func (x *fastReflection_MultiProofResult) IsValid() bool {
 	return x != nil
 }
 
@@ -4611,9 +4596,9 @@ func (x *fastReflection_SingleProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SingleProof)
+		x := input.Message.Interface().(*MultiProofResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4625,15 +4610,12 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
-		}
-		if x.OracleDataProof != nil {
-			l = options.Size(x.OracleDataProof)
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockRelayProof != nil {
-			l = options.Size(x.BlockRelayProof)
+		l = len(x.EvmProofBytes)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4646,7 +4628,7 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SingleProof)
+		x := input.Message.Interface().(*MultiProofResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4665,22 +4647,15 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockRelayProof != nil {
-			encoded, err := options.Marshal(x.BlockRelayProof)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.EvmProofBytes) > 0 {
+			i -= len(x.EvmProofBytes)
+			copy(dAtA[i:], x.EvmProofBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
-		if x.OracleDataProof != nil {
-			encoded, err := options.Marshal(x.OracleDataProof)
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4691,12 +4666,7 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4709,7 +4679,7 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SingleProof)
+		x := input.Message.Interface().(*MultiProofResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4741,34 +4711,15 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-				}
-				x.BlockHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockHeight |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4795,18 +4746,18 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.OracleDataProof == nil {
-					x.OracleDataProof = &OracleDataProof{}
+				if x.Proof == nil {
+					x.Proof = &MultiProof{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleDataProof); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4816,26 +4767,24 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlockRelayProof == nil {
-					x.BlockRelayProof = &BlockRelayProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.EvmProofBytes == nil {
+					x.EvmProofBytes = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -4873,81 +4822,28 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_MultiProof_2_list)(nil)
-
-type _MultiProof_2_list struct {
-	list *[]*OracleDataProof
-}
-
-func (x *_MultiProof_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MultiProof_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MultiProof_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*OracleDataProof)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MultiProof_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*OracleDataProof)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MultiProof_2_list) AppendMutable() protoreflect.Value {
-	v := new(OracleDataProof)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MultiProof_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MultiProof_2_list) NewElement() protoreflect.Value {
-	v := new(OracleDataProof)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MultiProof_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MultiProof                         protoreflect.MessageDescriptor
-	fd_MultiProof_block_height            protoreflect.FieldDescriptor
-	fd_MultiProof_oracle_data_multi_proof protoreflect.FieldDescriptor
-	fd_MultiProof_block_relay_proof       protoreflect.FieldDescriptor
+	md_CountProofResult                 protoreflect.MessageDescriptor
+	fd_CountProofResult_proof           protoreflect.FieldDescriptor
+	fd_CountProofResult_evm_proof_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_MultiProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiProof")
-	fd_MultiProof_block_height = md_MultiProof.Fields().ByName("block_height")
-	fd_MultiProof_oracle_data_multi_proof = md_MultiProof.Fields().ByName("oracle_data_multi_proof")
-	fd_MultiProof_block_relay_proof = md_MultiProof.Fields().ByName("block_relay_proof")
+	md_CountProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("CountProofResult")
+	fd_CountProofResult_proof = md_CountProofResult.Fields().ByName("proof")
+	fd_CountProofResult_evm_proof_bytes = md_CountProofResult.Fields().ByName("evm_proof_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_MultiProof)(nil)
+var _ protoreflect.Message = (*fastReflection_CountProofResult)(nil)
 
-type fastReflection_MultiProof MultiProof
+type fastReflection_CountProofResult CountProofResult
 
-func (x *MultiProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MultiProof)(x)
+func (x *CountProofResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CountProofResult)(x)
 }
 
-func (x *MultiProof) slowProtoReflect() protoreflect.Message {
+func (x *CountProofResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4959,43 +4855,43 @@ func (x *MultiProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MultiProof_messageType fastReflection_MultiProof_messageType
-var _ protoreflect.MessageType = fastReflection_MultiProof_messageType{}
+var _fastReflection_CountProofResult_messageType fastReflection_CountProofResult_messageType
+var _ protoreflect.MessageType = fastReflection_CountProofResult_messageType{}
 
-type fastReflection_MultiProof_messageType struct{}
+type fastReflection_CountProofResult_messageType struct{}
 
-func (x fastReflection_MultiProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MultiProof)(nil)
+func (x fastReflection_CountProofResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CountProofResult)(nil)
 }
-func (x fastReflection_MultiProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_MultiProof)
+func (x fastReflection_CountProofResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_CountProofResult)
 }
-func (x fastReflection_MultiProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiProof
+func (x fastReflection_CountProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CountProofResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MultiProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiProof
+func (x *fastReflection_CountProofResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_CountProofResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MultiProof) Type() protoreflect.MessageType {
-	return _fastReflection_MultiProof_messageType
+func (x *fastReflection_CountProofResult) Type() protoreflect.MessageType {
+	return _fastReflection_CountProofResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MultiProof) New() protoreflect.Message {
-	return new(fastReflection_MultiProof)
+func (x *fastReflection_CountProofResult) New() protoreflect.Message {
+	return new(fastReflection_CountProofResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MultiProof) Interface() protoreflect.ProtoMessage {
-	return (*MultiProof)(x)
+func (x *fastReflection_CountProofResult) Interface() protoreflect.ProtoMessage {
+	return (*CountProofResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5003,22 +4899,16 @@ func (x *fastReflection_MultiProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MultiProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BlockHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_MultiProof_block_height, value) {
-			return
-		}
-	}
-	if len(x.OracleDataMultiProof) != 0 {
-		value := protoreflect.ValueOfList(&_MultiProof_2_list{list: &x.OracleDataMultiProof})
-		if !f(fd_MultiProof_oracle_data_multi_proof, value) {
+func (x *fastReflection_CountProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_CountProofResult_proof, value) {
 			return
 		}
 	}
-	if x.BlockRelayProof != nil {
-		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-		if !f(fd_MultiProof_block_relay_proof, value) {
+	if len(x.EvmProofBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
+		if !f(fd_CountProofResult_evm_proof_bytes, value) {
 			return
 		}
 	}
@@ -5035,19 +4925,17 @@ func (x *fastReflection_MultiProof) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MultiProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CountProofResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProof.block_height":
-		return x.BlockHeight != uint64(0)
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		return len(x.OracleDataMultiProof) != 0
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		return x.BlockRelayProof != nil
+	case "band.base.oracle.v1.CountProofResult.proof":
+		return x.Proof != nil
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		return len(x.EvmProofBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5057,19 +4945,17 @@ func (x *fastReflection_MultiProof) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CountProofResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProof.block_height":
-		x.BlockHeight = uint64(0)
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		x.OracleDataMultiProof = nil
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		x.BlockRelayProof = nil
+	case "band.base.oracle.v1.CountProofResult.proof":
+		x.Proof = nil
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		x.EvmProofBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5079,25 +4965,19 @@ func (x *fastReflection_MultiProof) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MultiProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CountProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.MultiProof.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		if len(x.OracleDataMultiProof) == 0 {
-			return protoreflect.ValueOfList(&_MultiProof_2_list{})
-		}
-		listValue := &_MultiProof_2_list{list: &x.OracleDataMultiProof}
-		return protoreflect.ValueOfList(listValue)
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		value := x.BlockRelayProof
+	case "band.base.oracle.v1.CountProofResult.proof":
+		value := x.Proof
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		value := x.EvmProofBytes
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5111,21 +4991,17 @@ func (x *fastReflection_MultiProof) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CountProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProof.block_height":
-		x.BlockHeight = value.Uint()
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		lv := value.List()
-		clv := lv.(*_MultiProof_2_list)
-		x.OracleDataMultiProof = *clv.list
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
+	case "band.base.oracle.v1.CountProofResult.proof":
+		x.Proof = value.Message().Interface().(*CountProof)
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		x.EvmProofBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5139,57 +5015,48 @@ func (x *fastReflection_MultiProof) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CountProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		if x.OracleDataMultiProof == nil {
-			x.OracleDataMultiProof = []*OracleDataProof{}
-		}
-		value := &_MultiProof_2_list{list: &x.OracleDataMultiProof}
-		return protoreflect.ValueOfList(value)
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		if x.BlockRelayProof == nil {
-			x.BlockRelayProof = new(BlockRelayProof)
+	case "band.base.oracle.v1.CountProofResult.proof":
+		if x.Proof == nil {
+			x.Proof = new(CountProof)
 		}
-		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-	case "band.base.oracle.v1.MultiProof.block_height":
-		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.MultiProof is not mutable"))
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.CountProofResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MultiProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CountProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProof.block_height":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		list := []*OracleDataProof{}
-		return protoreflect.ValueOfList(&_MultiProof_2_list{list: &list})
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		m := new(BlockRelayProof)
+	case "band.base.oracle.v1.CountProofResult.proof":
+		m := new(CountProof)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MultiProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CountProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.CountProofResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5197,7 +5064,7 @@ func (x *fastReflection_MultiProof) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MultiProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CountProofResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5208,7 +5075,7 @@ func (x *fastReflection_MultiProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CountProofResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5220,7 +5087,7 @@ func (x *fastReflection_MultiProof) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MultiProof) IsValid() bool {
+func (x *fastReflection_CountProofResult) IsValid() bool {
 	return x != nil
 }
 
@@ -5230,9 +5097,9 @@ func (x *fastReflection_MultiProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MultiProof)
+		x := input.Message.Interface().(*CountProofResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5244,17 +5111,12 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
-		}
-		if len(x.OracleDataMultiProof) > 0 {
-			for _, e := range x.OracleDataMultiProof {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockRelayProof != nil {
-			l = options.Size(x.BlockRelayProof)
+		l = len(x.EvmProofBytes)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5267,7 +5129,7 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MultiProof)
+		x := input.Message.Interface().(*CountProofResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5286,8 +5148,15 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockRelayProof != nil {
-			encoded, err := options.Marshal(x.BlockRelayProof)
+		if len(x.EvmProofBytes) > 0 {
+			i -= len(x.EvmProofBytes)
+			copy(dAtA[i:], x.EvmProofBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5298,28 +5167,7 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.OracleDataMultiProof) > 0 {
-			for iNdEx := len(x.OracleDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.OracleDataMultiProof[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5332,7 +5180,7 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MultiProof)
+		x := input.Message.Interface().(*CountProofResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5364,34 +5212,15 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-				}
-				x.BlockHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockHeight |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5418,16 +5247,18 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OracleDataMultiProof = append(x.OracleDataMultiProof, &OracleDataProof{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleDataMultiProof[len(x.OracleDataMultiProof)-1]); err != nil {
+				if x.Proof == nil {
+					x.Proof = &CountProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5437,26 +5268,24 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlockRelayProof == nil {
-					x.BlockRelayProof = &BlockRelayProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.EvmProofBytes == nil {
+					x.EvmProofBytes = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -5495,29 +5324,27 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CountProof                   protoreflect.MessageDescriptor
-	fd_CountProof_block_height      protoreflect.FieldDescriptor
-	fd_CountProof_count_proof       protoreflect.FieldDescriptor
-	fd_CountProof_block_relay_proof protoreflect.FieldDescriptor
+	md_PricesProofResult                 protoreflect.MessageDescriptor
+	fd_PricesProofResult_proof           protoreflect.FieldDescriptor
+	fd_PricesProofResult_evm_proof_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_CountProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("CountProof")
-	fd_CountProof_block_height = md_CountProof.Fields().ByName("block_height")
-	fd_CountProof_count_proof = md_CountProof.Fields().ByName("count_proof")
-	fd_CountProof_block_relay_proof = md_CountProof.Fields().ByName("block_relay_proof")
+	md_PricesProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("PricesProofResult")
+	fd_PricesProofResult_proof = md_PricesProofResult.Fields().ByName("proof")
+	fd_PricesProofResult_evm_proof_bytes = md_PricesProofResult.Fields().ByName("evm_proof_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_CountProof)(nil)
+var _ protoreflect.Message = (*fastReflection_PricesProofResult)(nil)
 
-type fastReflection_CountProof CountProof
+type fastReflection_PricesProofResult PricesProofResult
 
-func (x *CountProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CountProof)(x)
+func (x *PricesProofResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PricesProofResult)(x)
 }
 
-func (x *CountProof) slowProtoReflect() protoreflect.Message {
+func (x *PricesProofResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5529,43 +5356,43 @@ func (x *CountProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CountProof_messageType fastReflection_CountProof_messageType
-var _ protoreflect.MessageType = fastReflection_CountProof_messageType{}
+var _fastReflection_PricesProofResult_messageType fastReflection_PricesProofResult_messageType
+var _ protoreflect.MessageType = fastReflection_PricesProofResult_messageType{}
 
-type fastReflection_CountProof_messageType struct{}
+type fastReflection_PricesProofResult_messageType struct{}
 
-func (x fastReflection_CountProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CountProof)(nil)
+func (x fastReflection_PricesProofResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PricesProofResult)(nil)
 }
-func (x fastReflection_CountProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_CountProof)
+func (x fastReflection_PricesProofResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_PricesProofResult)
 }
-func (x fastReflection_CountProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CountProof
+func (x fastReflection_PricesProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricesProofResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CountProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_CountProof
+func (x *fastReflection_PricesProofResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricesProofResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CountProof) Type() protoreflect.MessageType {
-	return _fastReflection_CountProof_messageType
+func (x *fastReflection_PricesProofResult) Type() protoreflect.MessageType {
+	return _fastReflection_PricesProofResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CountProof) New() protoreflect.Message {
-	return new(fastReflection_CountProof)
+func (x *fastReflection_PricesProofResult) New() protoreflect.Message {
+	return new(fastReflection_PricesProofResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CountProof) Interface() protoreflect.ProtoMessage {
-	return (*CountProof)(x)
+func (x *fastReflection_PricesProofResult) Interface() protoreflect.ProtoMessage {
+	return (*PricesProofResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5573,22 +5400,16 @@ func (x *fastReflection_CountProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CountProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BlockHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_CountProof_block_height, value) {
-			return
-		}
-	}
-	if x.CountProof != nil {
-		value := protoreflect.ValueOfMessage(x.CountProof.ProtoReflect())
-		if !f(fd_CountProof_count_proof, value) {
+func (x *fastReflection_PricesProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_PricesProofResult_proof, value) {
 			return
 		}
 	}
-	if x.BlockRelayProof != nil {
-		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-		if !f(fd_CountProof_block_relay_proof, value) {
+	if len(x.EvmProofBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
+		if !f(fd_PricesProofResult_evm_proof_bytes, value) {
 			return
 		}
 	}
@@ -5605,19 +5426,17 @@ func (x *fastReflection_CountProof) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CountProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PricesProofResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProof.block_height":
-		return x.BlockHeight != uint64(0)
-	case "band.base.oracle.v1.CountProof.count_proof":
-		return x.CountProof != nil
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		return x.BlockRelayProof != nil
+	case "band.base.oracle.v1.PricesProofResult.proof":
+		return x.Proof != nil
+	case "band.base.oracle.v1.PricesProofResult.evm_proof_bytes":
+		return len(x.EvmProofBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5627,19 +5446,17 @@ func (x *fastReflection_CountProof) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PricesProofResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProof.block_height":
-		x.BlockHeight = uint64(0)
-	case "band.base.oracle.v1.CountProof.count_proof":
-		x.CountProof = nil
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		x.BlockRelayProof = nil
+	case "band.base.oracle.v1.PricesProofResult.proof":
+		x.Proof = nil
+	case "band.base.oracle.v1.PricesProofResult.evm_proof_bytes":
+		x.EvmProofBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5649,22 +5466,19 @@ func (x *fastReflection_CountProof) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CountProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PricesProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.CountProof.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.CountProof.count_proof":
-		value := x.CountProof
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		value := x.BlockRelayProof
+	case "band.base.oracle.v1.PricesProofResult.proof":
+		value := x.Proof
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.PricesProofResult.evm_proof_bytes":
+		value := x.EvmProofBytes
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5678,19 +5492,17 @@ func (x *fastReflection_CountProof) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PricesProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProof.block_height":
-		x.BlockHeight = value.Uint()
-	case "band.base.oracle.v1.CountProof.count_proof":
-		x.CountProof = value.Message().Interface().(*RequestsCountProof)
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
+	case "band.base.oracle.v1.PricesProofResult.proof":
+		x.Proof = value.Message().Interface().(*PricesProof)
+	case "band.base.oracle.v1.PricesProofResult.evm_proof_bytes":
+		x.EvmProofBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5704,56 +5516,48 @@ func (x *fastReflection_CountProof) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PricesProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProof.count_proof":
-		if x.CountProof == nil {
-			x.CountProof = new(RequestsCountProof)
+	case "band.base.oracle.v1.PricesProofResult.proof":
+		if x.Proof == nil {
+			x.Proof = new(PricesProof)
 		}
-		return protoreflect.ValueOfMessage(x.CountProof.ProtoReflect())
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		if x.BlockRelayProof == nil {
-			x.BlockRelayProof = new(BlockRelayProof)
-		}
-		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-	case "band.base.oracle.v1.CountProof.block_height":
-		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.CountProof is not mutable"))
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "band.base.oracle.v1.PricesProofResult.evm_proof_bytes":
+		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.PricesProofResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CountProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PricesProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProof.block_height":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.CountProof.count_proof":
-		m := new(RequestsCountProof)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		m := new(BlockRelayProof)
+	case "band.base.oracle.v1.PricesProofResult.proof":
+		m := new(PricesProof)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.PricesProofResult.evm_proof_bytes":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CountProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PricesProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.CountProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PricesProofResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5761,7 +5565,7 @@ func (x *fastReflection_CountProof) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CountProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PricesProofResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5772,7 +5576,7 @@ func (x *fastReflection_CountProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PricesProofResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5784,7 +5588,7 @@ func (x *fastReflection_CountProof) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CountProof) IsValid() bool {
+func (x *fastReflection_PricesProofResult) IsValid() bool {
 	return x != nil
 }
 
@@ -5794,9 +5598,9 @@ func (x *fastReflection_CountProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PricesProofResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CountProof)
+		x := input.Message.Interface().(*PricesProofResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5808,15 +5612,12 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
-		}
-		if x.CountProof != nil {
-			l = options.Size(x.CountProof)
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockRelayProof != nil {
-			l = options.Size(x.BlockRelayProof)
+		l = len(x.EvmProofBytes)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5829,7 +5630,7 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CountProof)
+		x := input.Message.Interface().(*PricesProofResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5848,22 +5649,15 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockRelayProof != nil {
-			encoded, err := options.Marshal(x.BlockRelayProof)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.EvmProofBytes) > 0 {
+			i -= len(x.EvmProofBytes)
+			copy(dAtA[i:], x.EvmProofBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
-		if x.CountProof != nil {
-			encoded, err := options.Marshal(x.CountProof)
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5874,12 +5668,7 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5892,7 +5681,7 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CountProof)
+		x := input.Message.Interface().(*PricesProofResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5924,34 +5713,15 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricesProofResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricesProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-				}
-				x.BlockHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockHeight |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5978,18 +5748,18 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CountProof == nil {
-					x.CountProof = &RequestsCountProof{}
+				if x.Proof == nil {
+					x.Proof = &PricesProof{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CountProof); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5999,26 +5769,24 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlockRelayProof == nil {
-					x.BlockRelayProof = &BlockRelayProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.EvmProofBytes == nil {
+					x.EvmProofBytes = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -6056,81 +5824,30 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_OracleDataProof_3_list)(nil)
-
-type _OracleDataProof_3_list struct {
-	list *[]*IAVLMerklePath
-}
-
-func (x *_OracleDataProof_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_OracleDataProof_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_OracleDataProof_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_OracleDataProof_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_OracleDataProof_3_list) AppendMutable() protoreflect.Value {
-	v := new(IAVLMerklePath)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_OracleDataProof_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_OracleDataProof_3_list) NewElement() protoreflect.Value {
-	v := new(IAVLMerklePath)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_OracleDataProof_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_OracleDataProof              protoreflect.MessageDescriptor
-	fd_OracleDataProof_result       protoreflect.FieldDescriptor
-	fd_OracleDataProof_version      protoreflect.FieldDescriptor
-	fd_OracleDataProof_merkle_paths protoreflect.FieldDescriptor
+	md_SingleProof                   protoreflect.MessageDescriptor
+	fd_SingleProof_block_height      protoreflect.FieldDescriptor
+	fd_SingleProof_oracle_data_proof protoreflect.FieldDescriptor
+	fd_SingleProof_block_relay_proof protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_OracleDataProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("OracleDataProof")
-	fd_OracleDataProof_result = md_OracleDataProof.Fields().ByName("result")
-	fd_OracleDataProof_version = md_OracleDataProof.Fields().ByName("version")
-	fd_OracleDataProof_merkle_paths = md_OracleDataProof.Fields().ByName("merkle_paths")
+	md_SingleProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("SingleProof")
+	fd_SingleProof_block_height = md_SingleProof.Fields().ByName("block_height")
+	fd_SingleProof_oracle_data_proof = md_SingleProof.Fields().ByName("oracle_data_proof")
+	fd_SingleProof_block_relay_proof = md_SingleProof.Fields().ByName("block_relay_proof")
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleDataProof)(nil)
+var _ protoreflect.Message = (*fastReflection_SingleProof)(nil)
 
-type fastReflection_OracleDataProof OracleDataProof
+type fastReflection_SingleProof SingleProof
 
-func (x *OracleDataProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleDataProof)(x)
+func (x *SingleProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SingleProof)(x)
 }
 
-func (x *OracleDataProof) slowProtoReflect() protoreflect.Message {
+func (x *SingleProof) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6142,43 +5859,43 @@ func (x *OracleDataProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleDataProof_messageType fastReflection_OracleDataProof_messageType
-var _ protoreflect.MessageType = fastReflection_OracleDataProof_messageType{}
+var _fastReflection_SingleProof_messageType fastReflection_SingleProof_messageType
+var _ protoreflect.MessageType = fastReflection_SingleProof_messageType{}
 
-type fastReflection_OracleDataProof_messageType struct{}
+type fastReflection_SingleProof_messageType struct{}
 
-func (x fastReflection_OracleDataProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleDataProof)(nil)
+func (x fastReflection_SingleProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SingleProof)(nil)
 }
-func (x fastReflection_OracleDataProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleDataProof)
+func (x fastReflection_SingleProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_SingleProof)
 }
-func (x fastReflection_OracleDataProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleDataProof
+func (x fastReflection_SingleProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SingleProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleDataProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleDataProof
+func (x *fastReflection_SingleProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_SingleProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleDataProof) Type() protoreflect.MessageType {
-	return _fastReflection_OracleDataProof_messageType
+func (x *fastReflection_SingleProof) Type() protoreflect.MessageType {
+	return _fastReflection_SingleProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleDataProof) New() protoreflect.Message {
-	return new(fastReflection_OracleDataProof)
+func (x *fastReflection_SingleProof) New() protoreflect.Message {
+	return new(fastReflection_SingleProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleDataProof) Interface() protoreflect.ProtoMessage {
-	return (*OracleDataProof)(x)
+func (x *fastReflection_SingleProof) Interface() protoreflect.ProtoMessage {
+	return (*SingleProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6186,22 +5903,22 @@ func (x *fastReflection_OracleDataProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleDataProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Result != nil {
-		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-		if !f(fd_OracleDataProof_result, value) {
+func (x *fastReflection_SingleProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_SingleProof_block_height, value) {
 			return
 		}
 	}
-	if x.Version != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Version)
-		if !f(fd_OracleDataProof_version, value) {
+	if x.OracleDataProof != nil {
+		value := protoreflect.ValueOfMessage(x.OracleDataProof.ProtoReflect())
+		if !f(fd_SingleProof_oracle_data_proof, value) {
 			return
 		}
 	}
-	if len(x.MerklePaths) != 0 {
-		value := protoreflect.ValueOfList(&_OracleDataProof_3_list{list: &x.MerklePaths})
-		if !f(fd_OracleDataProof_merkle_paths, value) {
+	if x.BlockRelayProof != nil {
+		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+		if !f(fd_SingleProof_block_relay_proof, value) {
 			return
 		}
 	}
@@ -6218,19 +5935,19 @@ func (x *fastReflection_OracleDataProof) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleDataProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SingleProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		return x.Result != nil
-	case "band.base.oracle.v1.OracleDataProof.version":
-		return x.Version != uint64(0)
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		return len(x.MerklePaths) != 0
+	case "band.base.oracle.v1.SingleProof.block_height":
+		return x.BlockHeight != uint64(0)
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		return x.OracleDataProof != nil
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		return x.BlockRelayProof != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6240,19 +5957,19 @@ func (x *fastReflection_OracleDataProof) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleDataProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SingleProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		x.Result = nil
-	case "band.base.oracle.v1.OracleDataProof.version":
-		x.Version = uint64(0)
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		x.MerklePaths = nil
+	case "band.base.oracle.v1.SingleProof.block_height":
+		x.BlockHeight = uint64(0)
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		x.OracleDataProof = nil
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		x.BlockRelayProof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6262,25 +5979,22 @@ func (x *fastReflection_OracleDataProof) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleDataProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		value := x.Result
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.OracleDataProof.version":
-		value := x.Version
+	case "band.base.oracle.v1.SingleProof.block_height":
+		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		if len(x.MerklePaths) == 0 {
-			return protoreflect.ValueOfList(&_OracleDataProof_3_list{})
-		}
-		listValue := &_OracleDataProof_3_list{list: &x.MerklePaths}
-		return protoreflect.ValueOfList(listValue)
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		value := x.OracleDataProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		value := x.BlockRelayProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6294,21 +6008,19 @@ func (x *fastReflection_OracleDataProof) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleDataProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SingleProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		x.Result = value.Message().Interface().(*v1.Result)
-	case "band.base.oracle.v1.OracleDataProof.version":
-		x.Version = value.Uint()
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		lv := value.List()
-		clv := lv.(*_OracleDataProof_3_list)
-		x.MerklePaths = *clv.list
+	case "band.base.oracle.v1.SingleProof.block_height":
+		x.BlockHeight = value.Uint()
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		x.OracleDataProof = value.Message().Interface().(*OracleDataProof)
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6322,57 +6034,56 @@ func (x *fastReflection_OracleDataProof) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleDataProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		if x.Result == nil {
-			x.Result = new(v1.Result)
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		if x.OracleDataProof == nil {
+			x.OracleDataProof = new(OracleDataProof)
 		}
-		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		if x.MerklePaths == nil {
-			x.MerklePaths = []*IAVLMerklePath{}
+		return protoreflect.ValueOfMessage(x.OracleDataProof.ProtoReflect())
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		if x.BlockRelayProof == nil {
+			x.BlockRelayProof = new(BlockRelayProof)
 		}
-		value := &_OracleDataProof_3_list{list: &x.MerklePaths}
-		return protoreflect.ValueOfList(value)
-	case "band.base.oracle.v1.OracleDataProof.version":
-		panic(fmt.Errorf("field version of message band.base.oracle.v1.OracleDataProof is not mutable"))
+		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+	case "band.base.oracle.v1.SingleProof.block_height":
+		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.SingleProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleDataProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		m := new(v1.Result)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.OracleDataProof.version":
+	case "band.base.oracle.v1.SingleProof.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		list := []*IAVLMerklePath{}
-		return protoreflect.ValueOfList(&_OracleDataProof_3_list{list: &list})
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		m := new(OracleDataProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		m := new(BlockRelayProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleDataProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SingleProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.OracleDataProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.SingleProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6380,7 +6091,7 @@ func (x *fastReflection_OracleDataProof) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleDataProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SingleProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6391,7 +6102,7 @@ func (x *fastReflection_OracleDataProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleDataProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SingleProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6403,7 +6114,7 @@ func (x *fastReflection_OracleDataProof) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleDataProof) IsValid() bool {
+func (x *fastReflection_SingleProof) IsValid() bool {
 	return x != nil
 }
 
@@ -6413,9 +6124,9 @@ func (x *fastReflection_OracleDataProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleDataProof)
+		x := input.Message.Interface().(*SingleProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6427,18 +6138,16 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Result != nil {
-			l = options.Size(x.Result)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
-		if x.Version != 0 {
-			n += 1 + runtime.Sov(uint64(x.Version))
+		if x.OracleDataProof != nil {
+			l = options.Size(x.OracleDataProof)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.MerklePaths) > 0 {
-			for _, e := range x.MerklePaths {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.BlockRelayProof != nil {
+			l = options.Size(x.BlockRelayProof)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6450,7 +6159,7 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleDataProof)
+		x := input.Message.Interface().(*SingleProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6469,29 +6178,22 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MerklePaths) > 0 {
-			for iNdEx := len(x.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MerklePaths[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
+		if x.BlockRelayProof != nil {
+			encoded, err := options.Marshal(x.BlockRelayProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-		}
-		if x.Version != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x1a
 		}
-		if x.Result != nil {
-			encoded, err := options.Marshal(x.Result)
+		if x.OracleDataProof != nil {
+			encoded, err := options.Marshal(x.OracleDataProof)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6502,7 +6204,12 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6515,7 +6222,7 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleDataProof)
+		x := input.Message.Interface().(*SingleProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6547,15 +6254,34 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleDataProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6582,35 +6308,16 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Result == nil {
-					x.Result = &v1.Result{}
+				if x.OracleDataProof == nil {
+					x.OracleDataProof = &OracleDataProof{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleDataProof); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-				}
-				x.Version = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Version |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6637,8 +6344,10 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MerklePaths = append(x.MerklePaths, &IAVLMerklePath{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MerklePaths[len(x.MerklePaths)-1]); err != nil {
+				if x.BlockRelayProof == nil {
+					x.BlockRelayProof = &BlockRelayProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6677,34 +6386,81 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_IAVLMerklePath                  protoreflect.MessageDescriptor
-	fd_IAVLMerklePath_is_data_on_right protoreflect.FieldDescriptor
-	fd_IAVLMerklePath_subtree_height   protoreflect.FieldDescriptor
-	fd_IAVLMerklePath_subtree_size     protoreflect.FieldDescriptor
-	fd_IAVLMerklePath_subtree_version  protoreflect.FieldDescriptor
-	fd_IAVLMerklePath_sibling_hash     protoreflect.FieldDescriptor
+var _ protoreflect.List = (*_MultiProof_2_list)(nil)
+
+type _MultiProof_2_list struct {
+	list *[]*OracleDataProof
+}
+
+func (x *_MultiProof_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MultiProof_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MultiProof_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleDataProof)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MultiProof_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleDataProof)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MultiProof_2_list) AppendMutable() protoreflect.Value {
+	v := new(OracleDataProof)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MultiProof_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MultiProof_2_list) NewElement() protoreflect.Value {
+	v := new(OracleDataProof)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MultiProof_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MultiProof                         protoreflect.MessageDescriptor
+	fd_MultiProof_block_height            protoreflect.FieldDescriptor
+	fd_MultiProof_oracle_data_multi_proof protoreflect.FieldDescriptor
+	fd_MultiProof_block_relay_proof       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_IAVLMerklePath = File_band_base_oracle_v1_proof_proto.Messages().ByName("IAVLMerklePath")
-	fd_IAVLMerklePath_is_data_on_right = md_IAVLMerklePath.Fields().ByName("is_data_on_right")
-	fd_IAVLMerklePath_subtree_height = md_IAVLMerklePath.Fields().ByName("subtree_height")
-	fd_IAVLMerklePath_subtree_size = md_IAVLMerklePath.Fields().ByName("subtree_size")
-	fd_IAVLMerklePath_subtree_version = md_IAVLMerklePath.Fields().ByName("subtree_version")
-	fd_IAVLMerklePath_sibling_hash = md_IAVLMerklePath.Fields().ByName("sibling_hash")
+	md_MultiProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiProof")
+	fd_MultiProof_block_height = md_MultiProof.Fields().ByName("block_height")
+	fd_MultiProof_oracle_data_multi_proof = md_MultiProof.Fields().ByName("oracle_data_multi_proof")
+	fd_MultiProof_block_relay_proof = md_MultiProof.Fields().ByName("block_relay_proof")
 }
 
-var _ protoreflect.Message = (*fastReflection_IAVLMerklePath)(nil)
+var _ protoreflect.Message = (*fastReflection_MultiProof)(nil)
 
-type fastReflection_IAVLMerklePath IAVLMerklePath
+type fastReflection_MultiProof MultiProof
 
-func (x *IAVLMerklePath) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_IAVLMerklePath)(x)
+func (x *MultiProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MultiProof)(x)
 }
 
-func (x *IAVLMerklePath) slowProtoReflect() protoreflect.Message {
+func (x *MultiProof) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6716,43 +6472,43 @@ func (x *IAVLMerklePath) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_IAVLMerklePath_messageType fastReflection_IAVLMerklePath_messageType
-var _ protoreflect.MessageType = fastReflection_IAVLMerklePath_messageType{}
+var _fastReflection_MultiProof_messageType fastReflection_MultiProof_messageType
+var _ protoreflect.MessageType = fastReflection_MultiProof_messageType{}
 
-type fastReflection_IAVLMerklePath_messageType struct{}
+type fastReflection_MultiProof_messageType struct{}
 
-func (x fastReflection_IAVLMerklePath_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_IAVLMerklePath)(nil)
+func (x fastReflection_MultiProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MultiProof)(nil)
 }
-func (x fastReflection_IAVLMerklePath_messageType) New() protoreflect.Message {
-	return new(fastReflection_IAVLMerklePath)
+func (x fastReflection_MultiProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_MultiProof)
 }
-func (x fastReflection_IAVLMerklePath_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_IAVLMerklePath
+func (x fastReflection_MultiProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_IAVLMerklePath) Descriptor() protoreflect.MessageDescriptor {
-	return md_IAVLMerklePath
+func (x *fastReflection_MultiProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_IAVLMerklePath) Type() protoreflect.MessageType {
-	return _fastReflection_IAVLMerklePath_messageType
+func (x *fastReflection_MultiProof) Type() protoreflect.MessageType {
+	return _fastReflection_MultiProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_IAVLMerklePath) New() protoreflect.Message {
-	return new(fastReflection_IAVLMerklePath)
+func (x *fastReflection_MultiProof) New() protoreflect.Message {
+	return new(fastReflection_MultiProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_IAVLMerklePath) Interface() protoreflect.ProtoMessage {
-	return (*IAVLMerklePath)(x)
+func (x *fastReflection_MultiProof) Interface() protoreflect.ProtoMessage {
+	return (*MultiProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6760,34 +6516,22 @@ func (x *fastReflection_IAVLMerklePath) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_IAVLMerklePath) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.IsDataOnRight != false {
-		value := protoreflect.ValueOfBool(x.IsDataOnRight)
-		if !f(fd_IAVLMerklePath_is_data_on_right, value) {
-			return
-		}
-	}
-	if x.SubtreeHeight != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.SubtreeHeight)
-		if !f(fd_IAVLMerklePath_subtree_height, value) {
-			return
-		}
-	}
-	if x.SubtreeSize != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SubtreeSize)
-		if !f(fd_IAVLMerklePath_subtree_size, value) {
+func (x *fastReflection_MultiProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_MultiProof_block_height, value) {
 			return
 		}
 	}
-	if x.SubtreeVersion != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SubtreeVersion)
-		if !f(fd_IAVLMerklePath_subtree_version, value) {
+	if len(x.OracleDataMultiProof) != 0 {
+		value := protoreflect.ValueOfList(&_MultiProof_2_list{list: &x.OracleDataMultiProof})
+		if !f(fd_MultiProof_oracle_data_multi_proof, value) {
 			return
 		}
 	}
-	if len(x.SiblingHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.SiblingHash)
-		if !f(fd_IAVLMerklePath_sibling_hash, value) {
+	if x.BlockRelayProof != nil {
+		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+		if !f(fd_MultiProof_block_relay_proof, value) {
 			return
 		}
 	}
@@ -6804,23 +6548,19 @@ func (x *fastReflection_IAVLMerklePath) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_IAVLMerklePath) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MultiProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		return x.IsDataOnRight != false
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		return x.SubtreeHeight != uint32(0)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		return x.SubtreeSize != uint64(0)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
-		return x.SubtreeVersion != uint64(0)
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
-		return len(x.SiblingHash) != 0
+	case "band.base.oracle.v1.MultiProof.block_height":
+		return x.BlockHeight != uint64(0)
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		return len(x.OracleDataMultiProof) != 0
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		return x.BlockRelayProof != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6830,23 +6570,19 @@ func (x *fastReflection_IAVLMerklePath) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IAVLMerklePath) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MultiProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		x.IsDataOnRight = false
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		x.SubtreeHeight = uint32(0)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		x.SubtreeSize = uint64(0)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
-		x.SubtreeVersion = uint64(0)
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
-		x.SiblingHash = nil
+	case "band.base.oracle.v1.MultiProof.block_height":
+		x.BlockHeight = uint64(0)
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		x.OracleDataMultiProof = nil
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		x.BlockRelayProof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6856,28 +6592,25 @@ func (x *fastReflection_IAVLMerklePath) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_IAVLMerklePath) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		value := x.IsDataOnRight
-		return protoreflect.ValueOfBool(value)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		value := x.SubtreeHeight
-		return protoreflect.ValueOfUint32(value)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		value := x.SubtreeSize
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
-		value := x.SubtreeVersion
+	case "band.base.oracle.v1.MultiProof.block_height":
+		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
-		value := x.SiblingHash
-		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		if len(x.OracleDataMultiProof) == 0 {
+			return protoreflect.ValueOfList(&_MultiProof_2_list{})
+		}
+		listValue := &_MultiProof_2_list{list: &x.OracleDataMultiProof}
+		return protoreflect.ValueOfList(listValue)
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		value := x.BlockRelayProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6891,23 +6624,21 @@ func (x *fastReflection_IAVLMerklePath) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IAVLMerklePath) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MultiProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		x.IsDataOnRight = value.Bool()
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		x.SubtreeHeight = uint32(value.Uint())
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		x.SubtreeSize = value.Uint()
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
-		x.SubtreeVersion = value.Uint()
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
-		x.SiblingHash = value.Bytes()
+	case "band.base.oracle.v1.MultiProof.block_height":
+		x.BlockHeight = value.Uint()
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		lv := value.List()
+		clv := lv.(*_MultiProof_2_list)
+		x.OracleDataMultiProof = *clv.list
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6921,56 +6652,57 @@ func (x *fastReflection_IAVLMerklePath) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IAVLMerklePath) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		panic(fmt.Errorf("field is_data_on_right of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		panic(fmt.Errorf("field subtree_height of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		panic(fmt.Errorf("field subtree_size of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
-		panic(fmt.Errorf("field subtree_version of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
-		panic(fmt.Errorf("field sibling_hash of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		if x.OracleDataMultiProof == nil {
+			x.OracleDataMultiProof = []*OracleDataProof{}
+		}
+		value := &_MultiProof_2_list{list: &x.OracleDataMultiProof}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		if x.BlockRelayProof == nil {
+			x.BlockRelayProof = new(BlockRelayProof)
+		}
+		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+	case "band.base.oracle.v1.MultiProof.block_height":
+		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.MultiProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_IAVLMerklePath) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		return protoreflect.ValueOfBool(false)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+	case "band.base.oracle.v1.MultiProof.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		list := []*OracleDataProof{}
+		return protoreflect.ValueOfList(&_MultiProof_2_list{list: &list})
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		m := new(BlockRelayProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_IAVLMerklePath) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MultiProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.IAVLMerklePath", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6978,7 +6710,7 @@ func (x *fastReflection_IAVLMerklePath) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_IAVLMerklePath) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MultiProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6989,7 +6721,7 @@ func (x *fastReflection_IAVLMerklePath) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IAVLMerklePath) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MultiProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7001,7 +6733,7 @@ func (x *fastReflection_IAVLMerklePath) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_IAVLMerklePath) IsValid() bool {
+func (x *fastReflection_MultiProof) IsValid() bool {
 	return x != nil
 }
 
@@ -7011,9 +6743,9 @@ func (x *fastReflection_IAVLMerklePath) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*IAVLMerklePath)
+		x := input.Message.Interface().(*MultiProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7025,20 +6757,17 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.IsDataOnRight {
-			n += 2
-		}
-		if x.SubtreeHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.SubtreeHeight))
-		}
-		if x.SubtreeSize != 0 {
-			n += 1 + runtime.Sov(uint64(x.SubtreeSize))
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
-		if x.SubtreeVersion != 0 {
-			n += 1 + runtime.Sov(uint64(x.SubtreeVersion))
+		if len(x.OracleDataMultiProof) > 0 {
+			for _, e := range x.OracleDataMultiProof {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.SiblingHash)
-		if l > 0 {
+		if x.BlockRelayProof != nil {
+			l = options.Size(x.BlockRelayProof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7051,7 +6780,7 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*IAVLMerklePath)
+		x := input.Message.Interface().(*MultiProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7070,35 +6799,38 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.SiblingHash) > 0 {
-			i -= len(x.SiblingHash)
-			copy(dAtA[i:], x.SiblingHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SiblingHash)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.SubtreeVersion != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeVersion))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.SubtreeSize != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeSize))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.SubtreeHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeHeight))
+		if x.BlockRelayProof != nil {
+			encoded, err := options.Marshal(x.BlockRelayProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x1a
 		}
-		if x.IsDataOnRight {
-			i--
-			if x.IsDataOnRight {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if len(x.OracleDataMultiProof) > 0 {
+			for iNdEx := len(x.OracleDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OracleDataMultiProof[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -7113,7 +6845,7 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*IAVLMerklePath)
+		x := input.Message.Interface().(*MultiProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7145,17 +6877,17 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IAVLMerklePath: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IAVLMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
-				var v int
+				x.BlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7165,17 +6897,16 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.BlockHeight |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IsDataOnRight = bool(v != 0)
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeHeight", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
 				}
-				x.SubtreeHeight = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7185,54 +6916,31 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SubtreeHeight |= uint32(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeSize", wireType)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.SubtreeSize = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SubtreeSize |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeVersion", wireType)
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SubtreeVersion = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SubtreeVersion |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				x.OracleDataMultiProof = append(x.OracleDataMultiProof, &OracleDataProof{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleDataMultiProof[len(x.OracleDataMultiProof)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 5:
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7242,24 +6950,26 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SiblingHash = append(x.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.SiblingHash == nil {
-					x.SiblingHash = []byte{}
+				if x.BlockRelayProof == nil {
+					x.BlockRelayProof = &BlockRelayProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -7297,83 +7007,30 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_BlockRelayProof_4_list)(nil)
+var (
+	md_CountProof                   protoreflect.MessageDescriptor
+	fd_CountProof_block_height      protoreflect.FieldDescriptor
+	fd_CountProof_count_proof       protoreflect.FieldDescriptor
+	fd_CountProof_block_relay_proof protoreflect.FieldDescriptor
+)
 
-type _BlockRelayProof_4_list struct {
-	list *[]*TMSignature
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_CountProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("CountProof")
+	fd_CountProof_block_height = md_CountProof.Fields().ByName("block_height")
+	fd_CountProof_count_proof = md_CountProof.Fields().ByName("count_proof")
+	fd_CountProof_block_relay_proof = md_CountProof.Fields().ByName("block_relay_proof")
 }
 
-func (x *_BlockRelayProof_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_CountProof)(nil)
 
-func (x *_BlockRelayProof_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
+type fastReflection_CountProof CountProof
 
-func (x *_BlockRelayProof_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*TMSignature)
-	(*x.list)[i] = concreteValue
+func (x *CountProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CountProof)(x)
 }
 
-func (x *_BlockRelayProof_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*TMSignature)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_BlockRelayProof_4_list) AppendMutable() protoreflect.Value {
-	v := new(TMSignature)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_BlockRelayProof_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_BlockRelayProof_4_list) NewElement() protoreflect.Value {
-	v := new(TMSignature)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_BlockRelayProof_4_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_BlockRelayProof                           protoreflect.MessageDescriptor
-	fd_BlockRelayProof_multi_store_proof         protoreflect.FieldDescriptor
-	fd_BlockRelayProof_block_header_merkle_parts protoreflect.FieldDescriptor
-	fd_BlockRelayProof_common_encoded_vote_part  protoreflect.FieldDescriptor
-	fd_BlockRelayProof_signatures                protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_base_oracle_v1_proof_proto_init()
-	md_BlockRelayProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("BlockRelayProof")
-	fd_BlockRelayProof_multi_store_proof = md_BlockRelayProof.Fields().ByName("multi_store_proof")
-	fd_BlockRelayProof_block_header_merkle_parts = md_BlockRelayProof.Fields().ByName("block_header_merkle_parts")
-	fd_BlockRelayProof_common_encoded_vote_part = md_BlockRelayProof.Fields().ByName("common_encoded_vote_part")
-	fd_BlockRelayProof_signatures = md_BlockRelayProof.Fields().ByName("signatures")
-}
-
-var _ protoreflect.Message = (*fastReflection_BlockRelayProof)(nil)
-
-type fastReflection_BlockRelayProof BlockRelayProof
-
-func (x *BlockRelayProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BlockRelayProof)(x)
-}
-
-func (x *BlockRelayProof) slowProtoReflect() protoreflect.Message {
+func (x *CountProof) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7385,43 +7042,43 @@ func (x *BlockRelayProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BlockRelayProof_messageType fastReflection_BlockRelayProof_messageType
-var _ protoreflect.MessageType = fastReflection_BlockRelayProof_messageType{}
+var _fastReflection_CountProof_messageType fastReflection_CountProof_messageType
+var _ protoreflect.MessageType = fastReflection_CountProof_messageType{}
 
-type fastReflection_BlockRelayProof_messageType struct{}
+type fastReflection_CountProof_messageType struct{}
 
-func (x fastReflection_BlockRelayProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BlockRelayProof)(nil)
+func (x fastReflection_CountProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CountProof)(nil)
 }
-func (x fastReflection_BlockRelayProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_BlockRelayProof)
+func (x fastReflection_CountProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_CountProof)
 }
-func (x fastReflection_BlockRelayProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BlockRelayProof
+func (x fastReflection_CountProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CountProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BlockRelayProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_BlockRelayProof
+func (x *fastReflection_CountProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_CountProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BlockRelayProof) Type() protoreflect.MessageType {
-	return _fastReflection_BlockRelayProof_messageType
+func (x *fastReflection_CountProof) Type() protoreflect.MessageType {
+	return _fastReflection_CountProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BlockRelayProof) New() protoreflect.Message {
-	return new(fastReflection_BlockRelayProof)
+func (x *fastReflection_CountProof) New() protoreflect.Message {
+	return new(fastReflection_CountProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BlockRelayProof) Interface() protoreflect.ProtoMessage {
-	return (*BlockRelayProof)(x)
+func (x *fastReflection_CountProof) Interface() protoreflect.ProtoMessage {
+	return (*CountProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7429,28 +7086,22 @@ func (x *fastReflection_BlockRelayProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BlockRelayProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MultiStoreProof != nil {
-		value := protoreflect.ValueOfMessage(x.MultiStoreProof.ProtoReflect())
-		if !f(fd_BlockRelayProof_multi_store_proof, value) {
-			return
-		}
-	}
-	if x.BlockHeaderMerkleParts != nil {
-		value := protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
-		if !f(fd_BlockRelayProof_block_header_merkle_parts, value) {
+func (x *fastReflection_CountProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_CountProof_block_height, value) {
 			return
 		}
 	}
-	if x.CommonEncodedVotePart != nil {
-		value := protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
-		if !f(fd_BlockRelayProof_common_encoded_vote_part, value) {
+	if x.CountProof != nil {
+		value := protoreflect.ValueOfMessage(x.CountProof.ProtoReflect())
+		if !f(fd_CountProof_count_proof, value) {
 			return
 		}
 	}
-	if len(x.Signatures) != 0 {
-		value := protoreflect.ValueOfList(&_BlockRelayProof_4_list{list: &x.Signatures})
-		if !f(fd_BlockRelayProof_signatures, value) {
+	if x.BlockRelayProof != nil {
+		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+		if !f(fd_CountProof_block_relay_proof, value) {
 			return
 		}
 	}
@@ -7467,21 +7118,19 @@ func (x *fastReflection_BlockRelayProof) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BlockRelayProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CountProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		return x.MultiStoreProof != nil
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		return x.BlockHeaderMerkleParts != nil
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		return x.CommonEncodedVotePart != nil
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		return len(x.Signatures) != 0
+	case "band.base.oracle.v1.CountProof.block_height":
+		return x.BlockHeight != uint64(0)
+	case "band.base.oracle.v1.CountProof.count_proof":
+		return x.CountProof != nil
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		return x.BlockRelayProof != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7491,21 +7140,19 @@ func (x *fastReflection_BlockRelayProof) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockRelayProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CountProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		x.MultiStoreProof = nil
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		x.BlockHeaderMerkleParts = nil
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		x.CommonEncodedVotePart = nil
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		x.Signatures = nil
+	case "band.base.oracle.v1.CountProof.block_height":
+		x.BlockHeight = uint64(0)
+	case "band.base.oracle.v1.CountProof.count_proof":
+		x.CountProof = nil
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		x.BlockRelayProof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7515,28 +7162,22 @@ func (x *fastReflection_BlockRelayProof) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BlockRelayProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CountProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		value := x.MultiStoreProof
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		value := x.BlockHeaderMerkleParts
+	case "band.base.oracle.v1.CountProof.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.CountProof.count_proof":
+		value := x.CountProof
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		value := x.CommonEncodedVotePart
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		value := x.BlockRelayProof
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		if len(x.Signatures) == 0 {
-			return protoreflect.ValueOfList(&_BlockRelayProof_4_list{})
-		}
-		listValue := &_BlockRelayProof_4_list{list: &x.Signatures}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7550,23 +7191,19 @@ func (x *fastReflection_BlockRelayProof) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockRelayProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CountProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		x.MultiStoreProof = value.Message().Interface().(*MultiStoreProof)
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		x.BlockHeaderMerkleParts = value.Message().Interface().(*BlockHeaderMerkleParts)
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		x.CommonEncodedVotePart = value.Message().Interface().(*CommonEncodedVotePart)
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		lv := value.List()
-		clv := lv.(*_BlockRelayProof_4_list)
-		x.Signatures = *clv.list
+	case "band.base.oracle.v1.CountProof.block_height":
+		x.BlockHeight = value.Uint()
+	case "band.base.oracle.v1.CountProof.count_proof":
+		x.CountProof = value.Message().Interface().(*RequestsCountProof)
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7580,69 +7217,3966 @@ func (x *fastReflection_BlockRelayProof) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockRelayProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CountProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		if x.MultiStoreProof == nil {
-			x.MultiStoreProof = new(MultiStoreProof)
+	case "band.base.oracle.v1.CountProof.count_proof":
+		if x.CountProof == nil {
+			x.CountProof = new(RequestsCountProof)
 		}
-		return protoreflect.ValueOfMessage(x.MultiStoreProof.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		if x.BlockHeaderMerkleParts == nil {
-			x.BlockHeaderMerkleParts = new(BlockHeaderMerkleParts)
+		return protoreflect.ValueOfMessage(x.CountProof.ProtoReflect())
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		if x.BlockRelayProof == nil {
+			x.BlockRelayProof = new(BlockRelayProof)
 		}
-		return protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		if x.CommonEncodedVotePart == nil {
-			x.CommonEncodedVotePart = new(CommonEncodedVotePart)
+		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+	case "band.base.oracle.v1.CountProof.block_height":
+		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.CountProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		return protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		if x.Signatures == nil {
-			x.Signatures = []*TMSignature{}
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CountProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.CountProof.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.CountProof.count_proof":
+		m := new(RequestsCountProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		m := new(BlockRelayProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		value := &_BlockRelayProof_4_list{list: &x.Signatures}
-		return protoreflect.ValueOfList(value)
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CountProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.CountProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CountProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CountProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CountProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CountProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.CountProof != nil {
+			l = options.Size(x.CountProof)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockRelayProof != nil {
+			l = options.Size(x.BlockRelayProof)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CountProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockRelayProof != nil {
+			encoded, err := options.Marshal(x.BlockRelayProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.CountProof != nil {
+			encoded, err := options.Marshal(x.CountProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CountProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CountProof == nil {
+					x.CountProof = &RequestsCountProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CountProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockRelayProof == nil {
+					x.BlockRelayProof = &BlockRelayProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PricesProof_3_list)(nil)
+
+type _PricesProof_3_list struct {
+	list *[]*PriceProof
+}
+
+func (x *_PricesProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PricesProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PricesProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceProof)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PricesProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceProof)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PricesProof_3_list) AppendMutable() protoreflect.Value {
+	v := new(PriceProof)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PricesProof_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PricesProof_3_list) NewElement() protoreflect.Value {
+	v := new(PriceProof)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PricesProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PricesProof                         protoreflect.MessageDescriptor
+	fd_PricesProof_block_height            protoreflect.FieldDescriptor
+	fd_PricesProof_feeds_multi_store_proof protoreflect.FieldDescriptor
+	fd_PricesProof_price_proofs            protoreflect.FieldDescriptor
+	fd_PricesProof_block_relay_proof       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_PricesProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("PricesProof")
+	fd_PricesProof_block_height = md_PricesProof.Fields().ByName("block_height")
+	fd_PricesProof_feeds_multi_store_proof = md_PricesProof.Fields().ByName("feeds_multi_store_proof")
+	fd_PricesProof_price_proofs = md_PricesProof.Fields().ByName("price_proofs")
+	fd_PricesProof_block_relay_proof = md_PricesProof.Fields().ByName("block_relay_proof")
+}
+
+var _ protoreflect.Message = (*fastReflection_PricesProof)(nil)
+
+type fastReflection_PricesProof PricesProof
+
+func (x *PricesProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PricesProof)(x)
+}
+
+func (x *PricesProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PricesProof_messageType fastReflection_PricesProof_messageType
+var _ protoreflect.MessageType = fastReflection_PricesProof_messageType{}
+
+type fastReflection_PricesProof_messageType struct{}
+
+func (x fastReflection_PricesProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PricesProof)(nil)
+}
+func (x fastReflection_PricesProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_PricesProof)
+}
+func (x fastReflection_PricesProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricesProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PricesProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricesProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PricesProof) Type() protoreflect.MessageType {
+	return _fastReflection_PricesProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PricesProof) New() protoreflect.Message {
+	return new(fastReflection_PricesProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PricesProof) Interface() protoreflect.ProtoMessage {
+	return (*PricesProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PricesProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_PricesProof_block_height, value) {
+			return
+		}
+	}
+	if x.FeedsMultiStoreProof != nil {
+		value := protoreflect.ValueOfMessage(x.FeedsMultiStoreProof.ProtoReflect())
+		if !f(fd_PricesProof_feeds_multi_store_proof, value) {
+			return
+		}
+	}
+	if len(x.PriceProofs) != 0 {
+		value := protoreflect.ValueOfList(&_PricesProof_3_list{list: &x.PriceProofs})
+		if !f(fd_PricesProof_price_proofs, value) {
+			return
+		}
+	}
+	if x.BlockRelayProof != nil {
+		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+		if !f(fd_PricesProof_block_relay_proof, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PricesProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PricesProof.block_height":
+		return x.BlockHeight != uint64(0)
+	case "band.base.oracle.v1.PricesProof.feeds_multi_store_proof":
+		return x.FeedsMultiStoreProof != nil
+	case "band.base.oracle.v1.PricesProof.price_proofs":
+		return len(x.PriceProofs) != 0
+	case "band.base.oracle.v1.PricesProof.block_relay_proof":
+		return x.BlockRelayProof != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricesProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PricesProof.block_height":
+		x.BlockHeight = uint64(0)
+	case "band.base.oracle.v1.PricesProof.feeds_multi_store_proof":
+		x.FeedsMultiStoreProof = nil
+	case "band.base.oracle.v1.PricesProof.price_proofs":
+		x.PriceProofs = nil
+	case "band.base.oracle.v1.PricesProof.block_relay_proof":
+		x.BlockRelayProof = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PricesProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.PricesProof.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.PricesProof.feeds_multi_store_proof":
+		value := x.FeedsMultiStoreProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.PricesProof.price_proofs":
+		if len(x.PriceProofs) == 0 {
+			return protoreflect.ValueOfList(&_PricesProof_3_list{})
+		}
+		listValue := &_PricesProof_3_list{list: &x.PriceProofs}
+		return protoreflect.ValueOfList(listValue)
+	case "band.base.oracle.v1.PricesProof.block_relay_proof":
+		value := x.BlockRelayProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricesProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PricesProof.block_height":
+		x.BlockHeight = value.Uint()
+	case "band.base.oracle.v1.PricesProof.feeds_multi_store_proof":
+		x.FeedsMultiStoreProof = value.Message().Interface().(*FeedsMultiStoreProof)
+	case "band.base.oracle.v1.PricesProof.price_proofs":
+		lv := value.List()
+		clv := lv.(*_PricesProof_3_list)
+		x.PriceProofs = *clv.list
+	case "band.base.oracle.v1.PricesProof.block_relay_proof":
+		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricesProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PricesProof.feeds_multi_store_proof":
+		if x.FeedsMultiStoreProof == nil {
+			x.FeedsMultiStoreProof = new(FeedsMultiStoreProof)
+		}
+		return protoreflect.ValueOfMessage(x.FeedsMultiStoreProof.ProtoReflect())
+	case "band.base.oracle.v1.PricesProof.price_proofs":
+		if x.PriceProofs == nil {
+			x.PriceProofs = []*PriceProof{}
+		}
+		value := &_PricesProof_3_list{list: &x.PriceProofs}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.PricesProof.block_relay_proof":
+		if x.BlockRelayProof == nil {
+			x.BlockRelayProof = new(BlockRelayProof)
+		}
+		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+	case "band.base.oracle.v1.PricesProof.block_height":
+		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.PricesProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PricesProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PricesProof.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.PricesProof.feeds_multi_store_proof":
+		m := new(FeedsMultiStoreProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.PricesProof.price_proofs":
+		list := []*PriceProof{}
+		return protoreflect.ValueOfList(&_PricesProof_3_list{list: &list})
+	case "band.base.oracle.v1.PricesProof.block_relay_proof":
+		m := new(BlockRelayProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PricesProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PricesProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PricesProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PricesProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PricesProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricesProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PricesProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PricesProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PricesProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.FeedsMultiStoreProof != nil {
+			l = options.Size(x.FeedsMultiStoreProof)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PriceProofs) > 0 {
+			for _, e := range x.PriceProofs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BlockRelayProof != nil {
+			l = options.Size(x.BlockRelayProof)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PricesProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockRelayProof != nil {
+			encoded, err := options.Marshal(x.BlockRelayProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.PriceProofs) > 0 {
+			for iNdEx := len(x.PriceProofs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceProofs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.FeedsMultiStoreProof != nil {
+			encoded, err := options.Marshal(x.FeedsMultiStoreProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PricesProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricesProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricesProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeedsMultiStoreProof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeedsMultiStoreProof == nil {
+					x.FeedsMultiStoreProof = &FeedsMultiStoreProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeedsMultiStoreProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceProofs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceProofs = append(x.PriceProofs, &PriceProof{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceProofs[len(x.PriceProofs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockRelayProof == nil {
+					x.BlockRelayProof = &BlockRelayProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PriceProof_3_list)(nil)
+
+type _PriceProof_3_list struct {
+	list *[]*IAVLMerklePath
+}
+
+func (x *_PriceProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PriceProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PriceProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PriceProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PriceProof_3_list) AppendMutable() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PriceProof_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PriceProof_3_list) NewElement() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PriceProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PriceProof              protoreflect.MessageDescriptor
+	fd_PriceProof_price        protoreflect.FieldDescriptor
+	fd_PriceProof_version      protoreflect.FieldDescriptor
+	fd_PriceProof_merkle_paths protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_PriceProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("PriceProof")
+	fd_PriceProof_price = md_PriceProof.Fields().ByName("price")
+	fd_PriceProof_version = md_PriceProof.Fields().ByName("version")
+	fd_PriceProof_merkle_paths = md_PriceProof.Fields().ByName("merkle_paths")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceProof)(nil)
+
+type fastReflection_PriceProof PriceProof
+
+func (x *PriceProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceProof)(x)
+}
+
+func (x *PriceProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceProof_messageType fastReflection_PriceProof_messageType
+var _ protoreflect.MessageType = fastReflection_PriceProof_messageType{}
+
+type fastReflection_PriceProof_messageType struct{}
+
+func (x fastReflection_PriceProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceProof)(nil)
+}
+func (x fastReflection_PriceProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceProof)
+}
+func (x fastReflection_PriceProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceProof) Type() protoreflect.MessageType {
+	return _fastReflection_PriceProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceProof) New() protoreflect.Message {
+	return new(fastReflection_PriceProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceProof) Interface() protoreflect.ProtoMessage {
+	return (*PriceProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Price != nil {
+		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+		if !f(fd_PriceProof_price, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_PriceProof_version, value) {
+			return
+		}
+	}
+	if len(x.MerklePaths) != 0 {
+		value := protoreflect.ValueOfList(&_PriceProof_3_list{list: &x.MerklePaths})
+		if !f(fd_PriceProof_merkle_paths, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PriceProof.price":
+		return x.Price != nil
+	case "band.base.oracle.v1.PriceProof.version":
+		return x.Version != uint64(0)
+	case "band.base.oracle.v1.PriceProof.merkle_paths":
+		return len(x.MerklePaths) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PriceProof.price":
+		x.Price = nil
+	case "band.base.oracle.v1.PriceProof.version":
+		x.Version = uint64(0)
+	case "band.base.oracle.v1.PriceProof.merkle_paths":
+		x.MerklePaths = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.PriceProof.price":
+		value := x.Price
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.PriceProof.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.PriceProof.merkle_paths":
+		if len(x.MerklePaths) == 0 {
+			return protoreflect.ValueOfList(&_PriceProof_3_list{})
+		}
+		listValue := &_PriceProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PriceProof.price":
+		x.Price = value.Message().Interface().(*v1beta1.Price)
+	case "band.base.oracle.v1.PriceProof.version":
+		x.Version = value.Uint()
+	case "band.base.oracle.v1.PriceProof.merkle_paths":
+		lv := value.List()
+		clv := lv.(*_PriceProof_3_list)
+		x.MerklePaths = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PriceProof.price":
+		if x.Price == nil {
+			x.Price = new(v1beta1.Price)
+		}
+		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+	case "band.base.oracle.v1.PriceProof.merkle_paths":
+		if x.MerklePaths == nil {
+			x.MerklePaths = []*IAVLMerklePath{}
+		}
+		value := &_PriceProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.PriceProof.version":
+		panic(fmt.Errorf("field version of message band.base.oracle.v1.PriceProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PriceProof.price":
+		m := new(v1beta1.Price)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.PriceProof.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.PriceProof.merkle_paths":
+		list := []*IAVLMerklePath{}
+		return protoreflect.ValueOfList(&_PriceProof_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PriceProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Price != nil {
+			l = options.Size(x.Price)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if len(x.MerklePaths) > 0 {
+			for _, e := range x.MerklePaths {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MerklePaths) > 0 {
+			for iNdEx := len(x.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MerklePaths[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Price != nil {
+			encoded, err := options.Marshal(x.Price)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Price == nil {
+					x.Price = &v1beta1.Price{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerklePaths = append(x.MerklePaths, &IAVLMerklePath{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MerklePaths[len(x.MerklePaths)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_OracleDataProof_3_list)(nil)
+
+type _OracleDataProof_3_list struct {
+	list *[]*IAVLMerklePath
+}
+
+func (x *_OracleDataProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OracleDataProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_OracleDataProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OracleDataProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OracleDataProof_3_list) AppendMutable() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleDataProof_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OracleDataProof_3_list) NewElement() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleDataProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_OracleDataProof              protoreflect.MessageDescriptor
+	fd_OracleDataProof_result       protoreflect.FieldDescriptor
+	fd_OracleDataProof_version      protoreflect.FieldDescriptor
+	fd_OracleDataProof_merkle_paths protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_OracleDataProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("OracleDataProof")
+	fd_OracleDataProof_result = md_OracleDataProof.Fields().ByName("result")
+	fd_OracleDataProof_version = md_OracleDataProof.Fields().ByName("version")
+	fd_OracleDataProof_merkle_paths = md_OracleDataProof.Fields().ByName("merkle_paths")
+}
+
+var _ protoreflect.Message = (*fastReflection_OracleDataProof)(nil)
+
+type fastReflection_OracleDataProof OracleDataProof
+
+func (x *OracleDataProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleDataProof)(x)
+}
+
+func (x *OracleDataProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OracleDataProof_messageType fastReflection_OracleDataProof_messageType
+var _ protoreflect.MessageType = fastReflection_OracleDataProof_messageType{}
+
+type fastReflection_OracleDataProof_messageType struct{}
+
+func (x fastReflection_OracleDataProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleDataProof)(nil)
+}
+func (x fastReflection_OracleDataProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleDataProof)
+}
+func (x fastReflection_OracleDataProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleDataProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OracleDataProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleDataProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OracleDataProof) Type() protoreflect.MessageType {
+	return _fastReflection_OracleDataProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OracleDataProof) New() protoreflect.Message {
+	return new(fastReflection_OracleDataProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OracleDataProof) Interface() protoreflect.ProtoMessage {
+	return (*OracleDataProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleDataProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_OracleDataProof_result, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_OracleDataProof_version, value) {
+			return
+		}
+	}
+	if len(x.MerklePaths) != 0 {
+		value := protoreflect.ValueOfList(&_OracleDataProof_3_list{list: &x.MerklePaths})
+		if !f(fd_OracleDataProof_merkle_paths, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OracleDataProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		return x.Result != nil
+	case "band.base.oracle.v1.OracleDataProof.version":
+		return x.Version != uint64(0)
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		return len(x.MerklePaths) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleDataProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		x.Result = nil
+	case "band.base.oracle.v1.OracleDataProof.version":
+		x.Version = uint64(0)
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		x.MerklePaths = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleDataProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		value := x.Result
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.OracleDataProof.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		if len(x.MerklePaths) == 0 {
+			return protoreflect.ValueOfList(&_OracleDataProof_3_list{})
+		}
+		listValue := &_OracleDataProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleDataProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		x.Result = value.Message().Interface().(*v1.Result)
+	case "band.base.oracle.v1.OracleDataProof.version":
+		x.Version = value.Uint()
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		lv := value.List()
+		clv := lv.(*_OracleDataProof_3_list)
+		x.MerklePaths = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleDataProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		if x.Result == nil {
+			x.Result = new(v1.Result)
+		}
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		if x.MerklePaths == nil {
+			x.MerklePaths = []*IAVLMerklePath{}
+		}
+		value := &_OracleDataProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.OracleDataProof.version":
+		panic(fmt.Errorf("field version of message band.base.oracle.v1.OracleDataProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OracleDataProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		m := new(v1.Result)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.OracleDataProof.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		list := []*IAVLMerklePath{}
+		return protoreflect.ValueOfList(&_OracleDataProof_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OracleDataProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.OracleDataProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OracleDataProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleDataProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OracleDataProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OracleDataProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Result != nil {
+			l = options.Size(x.Result)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if len(x.MerklePaths) > 0 {
+			for _, e := range x.MerklePaths {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OracleDataProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MerklePaths) > 0 {
+			for iNdEx := len(x.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MerklePaths[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OracleDataProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleDataProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Result == nil {
+					x.Result = &v1.Result{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerklePaths = append(x.MerklePaths, &IAVLMerklePath{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MerklePaths[len(x.MerklePaths)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IAVLMerklePath                  protoreflect.MessageDescriptor
+	fd_IAVLMerklePath_is_data_on_right protoreflect.FieldDescriptor
+	fd_IAVLMerklePath_subtree_height   protoreflect.FieldDescriptor
+	fd_IAVLMerklePath_subtree_size     protoreflect.FieldDescriptor
+	fd_IAVLMerklePath_subtree_version  protoreflect.FieldDescriptor
+	fd_IAVLMerklePath_sibling_hash     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_IAVLMerklePath = File_band_base_oracle_v1_proof_proto.Messages().ByName("IAVLMerklePath")
+	fd_IAVLMerklePath_is_data_on_right = md_IAVLMerklePath.Fields().ByName("is_data_on_right")
+	fd_IAVLMerklePath_subtree_height = md_IAVLMerklePath.Fields().ByName("subtree_height")
+	fd_IAVLMerklePath_subtree_size = md_IAVLMerklePath.Fields().ByName("subtree_size")
+	fd_IAVLMerklePath_subtree_version = md_IAVLMerklePath.Fields().ByName("subtree_version")
+	fd_IAVLMerklePath_sibling_hash = md_IAVLMerklePath.Fields().ByName("sibling_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_IAVLMerklePath)(nil)
+
+type fastReflection_IAVLMerklePath IAVLMerklePath
+
+func (x *IAVLMerklePath) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IAVLMerklePath)(x)
+}
+
+func (x *IAVLMerklePath) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IAVLMerklePath_messageType fastReflection_IAVLMerklePath_messageType
+var _ protoreflect.MessageType = fastReflection_IAVLMerklePath_messageType{}
+
+type fastReflection_IAVLMerklePath_messageType struct{}
+
+func (x fastReflection_IAVLMerklePath_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IAVLMerklePath)(nil)
+}
+func (x fastReflection_IAVLMerklePath_messageType) New() protoreflect.Message {
+	return new(fastReflection_IAVLMerklePath)
+}
+func (x fastReflection_IAVLMerklePath_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IAVLMerklePath
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IAVLMerklePath) Descriptor() protoreflect.MessageDescriptor {
+	return md_IAVLMerklePath
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IAVLMerklePath) Type() protoreflect.MessageType {
+	return _fastReflection_IAVLMerklePath_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IAVLMerklePath) New() protoreflect.Message {
+	return new(fastReflection_IAVLMerklePath)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IAVLMerklePath) Interface() protoreflect.ProtoMessage {
+	return (*IAVLMerklePath)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IAVLMerklePath) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IsDataOnRight != false {
+		value := protoreflect.ValueOfBool(x.IsDataOnRight)
+		if !f(fd_IAVLMerklePath_is_data_on_right, value) {
+			return
+		}
+	}
+	if x.SubtreeHeight != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SubtreeHeight)
+		if !f(fd_IAVLMerklePath_subtree_height, value) {
+			return
+		}
+	}
+	if x.SubtreeSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubtreeSize)
+		if !f(fd_IAVLMerklePath_subtree_size, value) {
+			return
+		}
+	}
+	if x.SubtreeVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubtreeVersion)
+		if !f(fd_IAVLMerklePath_subtree_version, value) {
+			return
+		}
+	}
+	if len(x.SiblingHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.SiblingHash)
+		if !f(fd_IAVLMerklePath_sibling_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IAVLMerklePath) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		return x.IsDataOnRight != false
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		return x.SubtreeHeight != uint32(0)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		return x.SubtreeSize != uint64(0)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		return x.SubtreeVersion != uint64(0)
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		return len(x.SiblingHash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IAVLMerklePath) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		x.IsDataOnRight = false
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		x.SubtreeHeight = uint32(0)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		x.SubtreeSize = uint64(0)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		x.SubtreeVersion = uint64(0)
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		x.SiblingHash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IAVLMerklePath) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		value := x.IsDataOnRight
+		return protoreflect.ValueOfBool(value)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		value := x.SubtreeHeight
+		return protoreflect.ValueOfUint32(value)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		value := x.SubtreeSize
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		value := x.SubtreeVersion
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		value := x.SiblingHash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IAVLMerklePath) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		x.IsDataOnRight = value.Bool()
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		x.SubtreeHeight = uint32(value.Uint())
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		x.SubtreeSize = value.Uint()
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		x.SubtreeVersion = value.Uint()
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		x.SiblingHash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IAVLMerklePath) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		panic(fmt.Errorf("field is_data_on_right of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		panic(fmt.Errorf("field subtree_height of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		panic(fmt.Errorf("field subtree_size of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		panic(fmt.Errorf("field subtree_version of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		panic(fmt.Errorf("field sibling_hash of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IAVLMerklePath) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		return protoreflect.ValueOfBool(false)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IAVLMerklePath) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.IAVLMerklePath", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IAVLMerklePath) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IAVLMerklePath) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IAVLMerklePath) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IAVLMerklePath)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.IsDataOnRight {
+			n += 2
+		}
+		if x.SubtreeHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubtreeHeight))
+		}
+		if x.SubtreeSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubtreeSize))
+		}
+		if x.SubtreeVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubtreeVersion))
+		}
+		l = len(x.SiblingHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IAVLMerklePath)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SiblingHash) > 0 {
+			i -= len(x.SiblingHash)
+			copy(dAtA[i:], x.SiblingHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SiblingHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SubtreeVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeVersion))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SubtreeSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeSize))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SubtreeHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.IsDataOnRight {
+			i--
+			if x.IsDataOnRight {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IAVLMerklePath)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IAVLMerklePath: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IAVLMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsDataOnRight = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeHeight", wireType)
+				}
+				x.SubtreeHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubtreeHeight |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeSize", wireType)
+				}
+				x.SubtreeSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubtreeSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeVersion", wireType)
+				}
+				x.SubtreeVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubtreeVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SiblingHash = append(x.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.SiblingHash == nil {
+					x.SiblingHash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BlockRelayProof_4_list)(nil)
+
+type _BlockRelayProof_4_list struct {
+	list *[]*TMSignature
+}
+
+func (x *_BlockRelayProof_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BlockRelayProof_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BlockRelayProof_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TMSignature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BlockRelayProof_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TMSignature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BlockRelayProof_4_list) AppendMutable() protoreflect.Value {
+	v := new(TMSignature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BlockRelayProof_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BlockRelayProof_4_list) NewElement() protoreflect.Value {
+	v := new(TMSignature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BlockRelayProof_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BlockRelayProof                           protoreflect.MessageDescriptor
+	fd_BlockRelayProof_multi_store_proof         protoreflect.FieldDescriptor
+	fd_BlockRelayProof_block_header_merkle_parts protoreflect.FieldDescriptor
+	fd_BlockRelayProof_common_encoded_vote_part  protoreflect.FieldDescriptor
+	fd_BlockRelayProof_signatures                protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_BlockRelayProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("BlockRelayProof")
+	fd_BlockRelayProof_multi_store_proof = md_BlockRelayProof.Fields().ByName("multi_store_proof")
+	fd_BlockRelayProof_block_header_merkle_parts = md_BlockRelayProof.Fields().ByName("block_header_merkle_parts")
+	fd_BlockRelayProof_common_encoded_vote_part = md_BlockRelayProof.Fields().ByName("common_encoded_vote_part")
+	fd_BlockRelayProof_signatures = md_BlockRelayProof.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_BlockRelayProof)(nil)
+
+type fastReflection_BlockRelayProof BlockRelayProof
+
+func (x *BlockRelayProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BlockRelayProof)(x)
+}
+
+func (x *BlockRelayProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BlockRelayProof_messageType fastReflection_BlockRelayProof_messageType
+var _ protoreflect.MessageType = fastReflection_BlockRelayProof_messageType{}
+
+type fastReflection_BlockRelayProof_messageType struct{}
+
+func (x fastReflection_BlockRelayProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BlockRelayProof)(nil)
+}
+func (x fastReflection_BlockRelayProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_BlockRelayProof)
+}
+func (x fastReflection_BlockRelayProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockRelayProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BlockRelayProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockRelayProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BlockRelayProof) Type() protoreflect.MessageType {
+	return _fastReflection_BlockRelayProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BlockRelayProof) New() protoreflect.Message {
+	return new(fastReflection_BlockRelayProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BlockRelayProof) Interface() protoreflect.ProtoMessage {
+	return (*BlockRelayProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BlockRelayProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MultiStoreProof != nil {
+		value := protoreflect.ValueOfMessage(x.MultiStoreProof.ProtoReflect())
+		if !f(fd_BlockRelayProof_multi_store_proof, value) {
+			return
+		}
+	}
+	if x.BlockHeaderMerkleParts != nil {
+		value := protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
+		if !f(fd_BlockRelayProof_block_header_merkle_parts, value) {
+			return
+		}
+	}
+	if x.CommonEncodedVotePart != nil {
+		value := protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
+		if !f(fd_BlockRelayProof_common_encoded_vote_part, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_BlockRelayProof_4_list{list: &x.Signatures})
+		if !f(fd_BlockRelayProof_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BlockRelayProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		return x.MultiStoreProof != nil
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		return x.BlockHeaderMerkleParts != nil
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		return x.CommonEncodedVotePart != nil
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		return len(x.Signatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockRelayProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		x.MultiStoreProof = nil
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		x.BlockHeaderMerkleParts = nil
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		x.CommonEncodedVotePart = nil
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		x.Signatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BlockRelayProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		value := x.MultiStoreProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		value := x.BlockHeaderMerkleParts
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		value := x.CommonEncodedVotePart
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_BlockRelayProof_4_list{})
+		}
+		listValue := &_BlockRelayProof_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockRelayProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		x.MultiStoreProof = value.Message().Interface().(*MultiStoreProof)
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		x.BlockHeaderMerkleParts = value.Message().Interface().(*BlockHeaderMerkleParts)
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		x.CommonEncodedVotePart = value.Message().Interface().(*CommonEncodedVotePart)
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		lv := value.List()
+		clv := lv.(*_BlockRelayProof_4_list)
+		x.Signatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockRelayProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		if x.MultiStoreProof == nil {
+			x.MultiStoreProof = new(MultiStoreProof)
+		}
+		return protoreflect.ValueOfMessage(x.MultiStoreProof.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		if x.BlockHeaderMerkleParts == nil {
+			x.BlockHeaderMerkleParts = new(BlockHeaderMerkleParts)
+		}
+		return protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		if x.CommonEncodedVotePart == nil {
+			x.CommonEncodedVotePart = new(CommonEncodedVotePart)
+		}
+		return protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*TMSignature{}
+		}
+		value := &_BlockRelayProof_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BlockRelayProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		m := new(MultiStoreProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		m := new(BlockHeaderMerkleParts)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		m := new(CommonEncodedVotePart)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		list := []*TMSignature{}
+		return protoreflect.ValueOfList(&_BlockRelayProof_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BlockRelayProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.BlockRelayProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BlockRelayProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockRelayProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BlockRelayProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BlockRelayProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MultiStoreProof != nil {
+			l = options.Size(x.MultiStoreProof)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeaderMerkleParts != nil {
+			l = options.Size(x.BlockHeaderMerkleParts)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CommonEncodedVotePart != nil {
+			l = options.Size(x.CommonEncodedVotePart)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BlockRelayProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.CommonEncodedVotePart != nil {
+			encoded, err := options.Marshal(x.CommonEncodedVotePart)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeaderMerkleParts != nil {
+			encoded, err := options.Marshal(x.BlockHeaderMerkleParts)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MultiStoreProof != nil {
+			encoded, err := options.Marshal(x.MultiStoreProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BlockRelayProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockRelayProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MultiStoreProof == nil {
+					x.MultiStoreProof = &MultiStoreProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MultiStoreProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockHeaderMerkleParts == nil {
+					x.BlockHeaderMerkleParts = &BlockHeaderMerkleParts{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockHeaderMerkleParts); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CommonEncodedVotePart == nil {
+					x.CommonEncodedVotePart = &CommonEncodedVotePart{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommonEncodedVotePart); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &TMSignature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MultiStoreProof                                            protoreflect.MessageDescriptor
+	fd_MultiStoreProof_oracle_iavl_state_hash                     protoreflect.FieldDescriptor
+	fd_MultiStoreProof_mint_store_merkle_hash                     protoreflect.FieldDescriptor
+	fd_MultiStoreProof_params_to_restake_stores_merkle_hash       protoreflect.FieldDescriptor
+	fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash protoreflect.FieldDescriptor
+	fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash          protoreflect.FieldDescriptor
+	fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_MultiStoreProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiStoreProof")
+	fd_MultiStoreProof_oracle_iavl_state_hash = md_MultiStoreProof.Fields().ByName("oracle_iavl_state_hash")
+	fd_MultiStoreProof_mint_store_merkle_hash = md_MultiStoreProof.Fields().ByName("mint_store_merkle_hash")
+	fd_MultiStoreProof_params_to_restake_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("params_to_restake_stores_merkle_hash")
+	fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("rollingseed_to_transfer_stores_merkle_hash")
+	fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("tss_to_upgrade_stores_merkle_hash")
+	fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("auth_to_icahost_stores_merkle_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_MultiStoreProof)(nil)
+
+type fastReflection_MultiStoreProof MultiStoreProof
+
+func (x *MultiStoreProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MultiStoreProof)(x)
+}
+
+func (x *MultiStoreProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MultiStoreProof_messageType fastReflection_MultiStoreProof_messageType
+var _ protoreflect.MessageType = fastReflection_MultiStoreProof_messageType{}
+
+type fastReflection_MultiStoreProof_messageType struct{}
+
+func (x fastReflection_MultiStoreProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MultiStoreProof)(nil)
+}
+func (x fastReflection_MultiStoreProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_MultiStoreProof)
+}
+func (x fastReflection_MultiStoreProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiStoreProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MultiStoreProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiStoreProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MultiStoreProof) Type() protoreflect.MessageType {
+	return _fastReflection_MultiStoreProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MultiStoreProof) New() protoreflect.Message {
+	return new(fastReflection_MultiStoreProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MultiStoreProof) Interface() protoreflect.ProtoMessage {
+	return (*MultiStoreProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MultiStoreProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.OracleIavlStateHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.OracleIavlStateHash)
+		if !f(fd_MultiStoreProof_oracle_iavl_state_hash, value) {
+			return
+		}
+	}
+	if len(x.MintStoreMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.MintStoreMerkleHash)
+		if !f(fd_MultiStoreProof_mint_store_merkle_hash, value) {
+			return
+		}
+	}
+	if len(x.ParamsToRestakeStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.ParamsToRestakeStoresMerkleHash)
+		if !f(fd_MultiStoreProof_params_to_restake_stores_merkle_hash, value) {
+			return
+		}
+	}
+	if len(x.RollingseedToTransferStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.RollingseedToTransferStoresMerkleHash)
+		if !f(fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash, value) {
+			return
+		}
+	}
+	if len(x.TssToUpgradeStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.TssToUpgradeStoresMerkleHash)
+		if !f(fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash, value) {
+			return
+		}
+	}
+	if len(x.AuthToIcahostStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.AuthToIcahostStoresMerkleHash)
+		if !f(fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MultiStoreProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		return len(x.OracleIavlStateHash) != 0
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		return len(x.MintStoreMerkleHash) != 0
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		return len(x.ParamsToRestakeStoresMerkleHash) != 0
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		return len(x.RollingseedToTransferStoresMerkleHash) != 0
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		return len(x.TssToUpgradeStoresMerkleHash) != 0
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		return len(x.AuthToIcahostStoresMerkleHash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MultiStoreProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		x.OracleIavlStateHash = nil
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		x.MintStoreMerkleHash = nil
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		x.ParamsToRestakeStoresMerkleHash = nil
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		x.RollingseedToTransferStoresMerkleHash = nil
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		x.TssToUpgradeStoresMerkleHash = nil
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		x.AuthToIcahostStoresMerkleHash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MultiStoreProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		value := x.OracleIavlStateHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		value := x.MintStoreMerkleHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		value := x.ParamsToRestakeStoresMerkleHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		value := x.RollingseedToTransferStoresMerkleHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		value := x.TssToUpgradeStoresMerkleHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		value := x.AuthToIcahostStoresMerkleHash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MultiStoreProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		x.OracleIavlStateHash = value.Bytes()
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		x.MintStoreMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		x.ParamsToRestakeStoresMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		x.RollingseedToTransferStoresMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		x.TssToUpgradeStoresMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		x.AuthToIcahostStoresMerkleHash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MultiStoreProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		panic(fmt.Errorf("field oracle_iavl_state_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		panic(fmt.Errorf("field mint_store_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		panic(fmt.Errorf("field params_to_restake_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		panic(fmt.Errorf("field rollingseed_to_transfer_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		panic(fmt.Errorf("field tss_to_upgrade_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		panic(fmt.Errorf("field auth_to_icahost_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BlockRelayProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiStoreProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		m := new(MultiStoreProof)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		m := new(BlockHeaderMerkleParts)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		m := new(CommonEncodedVotePart)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		list := []*TMSignature{}
-		return protoreflect.ValueOfList(&_BlockRelayProof_4_list{list: &list})
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BlockRelayProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MultiStoreProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.BlockRelayProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiStoreProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7650,7 +11184,7 @@ func (x *fastReflection_BlockRelayProof) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BlockRelayProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MultiStoreProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7661,7 +11195,7 @@ func (x *fastReflection_BlockRelayProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockRelayProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MultiStoreProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7673,7 +11207,7 @@ func (x *fastReflection_BlockRelayProof) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BlockRelayProof) IsValid() bool {
+func (x *fastReflection_MultiStoreProof) IsValid() bool {
 	return x != nil
 }
 
@@ -7683,9 +11217,9 @@ func (x *fastReflection_BlockRelayProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BlockRelayProof)
+		x := input.Message.Interface().(*MultiStoreProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7697,23 +11231,29 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.MultiStoreProof != nil {
-			l = options.Size(x.MultiStoreProof)
+		l = len(x.OracleIavlStateHash)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockHeaderMerkleParts != nil {
-			l = options.Size(x.BlockHeaderMerkleParts)
+		l = len(x.MintStoreMerkleHash)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.CommonEncodedVotePart != nil {
-			l = options.Size(x.CommonEncodedVotePart)
+		l = len(x.ParamsToRestakeStoresMerkleHash)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Signatures) > 0 {
-			for _, e := range x.Signatures {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.RollingseedToTransferStoresMerkleHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TssToUpgradeStoresMerkleHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AuthToIcahostStoresMerkleHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7725,7 +11265,7 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BlockRelayProof)
+		x := input.Message.Interface().(*MultiStoreProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7744,61 +11284,45 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signatures) > 0 {
-			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Signatures[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
+		if len(x.AuthToIcahostStoresMerkleHash) > 0 {
+			i -= len(x.AuthToIcahostStoresMerkleHash)
+			copy(dAtA[i:], x.AuthToIcahostStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuthToIcahostStoresMerkleHash)))
+			i--
+			dAtA[i] = 0x32
 		}
-		if x.CommonEncodedVotePart != nil {
-			encoded, err := options.Marshal(x.CommonEncodedVotePart)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.TssToUpgradeStoresMerkleHash) > 0 {
+			i -= len(x.TssToUpgradeStoresMerkleHash)
+			copy(dAtA[i:], x.TssToUpgradeStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TssToUpgradeStoresMerkleHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.RollingseedToTransferStoresMerkleHash) > 0 {
+			i -= len(x.RollingseedToTransferStoresMerkleHash)
+			copy(dAtA[i:], x.RollingseedToTransferStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RollingseedToTransferStoresMerkleHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ParamsToRestakeStoresMerkleHash) > 0 {
+			i -= len(x.ParamsToRestakeStoresMerkleHash)
+			copy(dAtA[i:], x.ParamsToRestakeStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParamsToRestakeStoresMerkleHash)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.BlockHeaderMerkleParts != nil {
-			encoded, err := options.Marshal(x.BlockHeaderMerkleParts)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.MintStoreMerkleHash) > 0 {
+			i -= len(x.MintStoreMerkleHash)
+			copy(dAtA[i:], x.MintStoreMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintStoreMerkleHash)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.MultiStoreProof != nil {
-			encoded, err := options.Marshal(x.MultiStoreProof)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.OracleIavlStateHash) > 0 {
+			i -= len(x.OracleIavlStateHash)
+			copy(dAtA[i:], x.OracleIavlStateHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OracleIavlStateHash)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7813,7 +11337,7 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BlockRelayProof)
+		x := input.Message.Interface().(*MultiStoreProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7841,21 +11365,89 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 				if b < 0x80 {
 					break
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockRelayProof: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiStoreProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleIavlStateHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OracleIavlStateHash = append(x.OracleIavlStateHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.OracleIavlStateHash == nil {
+					x.OracleIavlStateHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintStoreMerkleHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintStoreMerkleHash = append(x.MintStoreMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.MintStoreMerkleHash == nil {
+					x.MintStoreMerkleHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParamsToRestakeStoresMerkleHash", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7865,33 +11457,31 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MultiStoreProof == nil {
-					x.MultiStoreProof = &MultiStoreProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MultiStoreProof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.ParamsToRestakeStoresMerkleHash = append(x.ParamsToRestakeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.ParamsToRestakeStoresMerkleHash == nil {
+					x.ParamsToRestakeStoresMerkleHash = []byte{}
 				}
 				iNdEx = postIndex
-			case 2:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RollingseedToTransferStoresMerkleHash", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7901,33 +11491,31 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlockHeaderMerkleParts == nil {
-					x.BlockHeaderMerkleParts = &BlockHeaderMerkleParts{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockHeaderMerkleParts); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.RollingseedToTransferStoresMerkleHash = append(x.RollingseedToTransferStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.RollingseedToTransferStoresMerkleHash == nil {
+					x.RollingseedToTransferStoresMerkleHash = []byte{}
 				}
 				iNdEx = postIndex
-			case 3:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssToUpgradeStoresMerkleHash", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7937,33 +11525,31 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CommonEncodedVotePart == nil {
-					x.CommonEncodedVotePart = &CommonEncodedVotePart{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommonEncodedVotePart); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.TssToUpgradeStoresMerkleHash = append(x.TssToUpgradeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.TssToUpgradeStoresMerkleHash == nil {
+					x.TssToUpgradeStoresMerkleHash = []byte{}
 				}
 				iNdEx = postIndex
-			case 4:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthToIcahostStoresMerkleHash", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7973,24 +11559,24 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signatures = append(x.Signatures, &TMSignature{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.AuthToIcahostStoresMerkleHash = append(x.AuthToIcahostStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.AuthToIcahostStoresMerkleHash == nil {
+					x.AuthToIcahostStoresMerkleHash = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -8029,36 +11615,36 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MultiStoreProof                                            protoreflect.MessageDescriptor
-	fd_MultiStoreProof_oracle_iavl_state_hash                     protoreflect.FieldDescriptor
-	fd_MultiStoreProof_mint_store_merkle_hash                     protoreflect.FieldDescriptor
-	fd_MultiStoreProof_params_to_restake_stores_merkle_hash       protoreflect.FieldDescriptor
-	fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash protoreflect.FieldDescriptor
-	fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash          protoreflect.FieldDescriptor
-	fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash         protoreflect.FieldDescriptor
+	md_FeedsMultiStoreProof                                         protoreflect.MessageDescriptor
+	fd_FeedsMultiStoreProof_feeds_iavl_state_hash                   protoreflect.FieldDescriptor
+	fd_FeedsMultiStoreProof_evidence_store_merkle_hash              protoreflect.FieldDescriptor
+	fd_FeedsMultiStoreProof_feegrant_to_feeibc_stores_merkle_hash   protoreflect.FieldDescriptor
+	fd_FeedsMultiStoreProof_globalfee_to_icahost_stores_merkle_hash protoreflect.FieldDescriptor
+	fd_FeedsMultiStoreProof_auth_to_distribution_stores_merkle_hash protoreflect.FieldDescriptor
+	fd_FeedsMultiStoreProof_mint_to_upgrade_stores_merkle_hash      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_MultiStoreProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiStoreProof")
-	fd_MultiStoreProof_oracle_iavl_state_hash = md_MultiStoreProof.Fields().ByName("oracle_iavl_state_hash")
-	fd_MultiStoreProof_mint_store_merkle_hash = md_MultiStoreProof.Fields().ByName("mint_store_merkle_hash")
-	fd_MultiStoreProof_params_to_restake_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("params_to_restake_stores_merkle_hash")
-	fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("rollingseed_to_transfer_stores_merkle_hash")
-	fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("tss_to_upgrade_stores_merkle_hash")
-	fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("auth_to_icahost_stores_merkle_hash")
+	md_FeedsMultiStoreProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("FeedsMultiStoreProof")
+	fd_FeedsMultiStoreProof_feeds_iavl_state_hash = md_FeedsMultiStoreProof.Fields().ByName("feeds_iavl_state_hash")
+	fd_FeedsMultiStoreProof_evidence_store_merkle_hash = md_FeedsMultiStoreProof.Fields().ByName("evidence_store_merkle_hash")
+	fd_FeedsMultiStoreProof_feegrant_to_feeibc_stores_merkle_hash = md_FeedsMultiStoreProof.Fields().ByName("feegrant_to_feeibc_stores_merkle_hash")
+	fd_FeedsMultiStoreProof_globalfee_to_icahost_stores_merkle_hash = md_FeedsMultiStoreProof.Fields().ByName("globalfee_to_icahost_stores_merkle_hash")
+	fd_FeedsMultiStoreProof_auth_to_distribution_stores_merkle_hash = md_FeedsMultiStoreProof.Fields().ByName("auth_to_distribution_stores_merkle_hash")
+	fd_FeedsMultiStoreProof_mint_to_upgrade_stores_merkle_hash = md_FeedsMultiStoreProof.Fields().ByName("mint_to_upgrade_stores_merkle_hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_MultiStoreProof)(nil)
+var _ protoreflect.Message = (*fastReflection_FeedsMultiStoreProof)(nil)
 
-type fastReflection_MultiStoreProof MultiStoreProof
+type fastReflection_FeedsMultiStoreProof FeedsMultiStoreProof
 
-func (x *MultiStoreProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MultiStoreProof)(x)
+func (x *FeedsMultiStoreProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeedsMultiStoreProof)(x)
 }
 
-func (x *MultiStoreProof) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[15]
+func (x *FeedsMultiStoreProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8069,43 +11655,43 @@ func (x *MultiStoreProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MultiStoreProof_messageType fastReflection_MultiStoreProof_messageType
-var _ protoreflect.MessageType = fastReflection_MultiStoreProof_messageType{}
+var _fastReflection_FeedsMultiStoreProof_messageType fastReflection_FeedsMultiStoreProof_messageType
+var _ protoreflect.MessageType = fastReflection_FeedsMultiStoreProof_messageType{}
 
-type fastReflection_MultiStoreProof_messageType struct{}
+type fastReflection_FeedsMultiStoreProof_messageType struct{}
 
-func (x fastReflection_MultiStoreProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MultiStoreProof)(nil)
+func (x fastReflection_FeedsMultiStoreProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeedsMultiStoreProof)(nil)
 }
-func (x fastReflection_MultiStoreProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_MultiStoreProof)
+func (x fastReflection_FeedsMultiStoreProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeedsMultiStoreProof)
 }
-func (x fastReflection_MultiStoreProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiStoreProof
+func (x fastReflection_FeedsMultiStoreProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeedsMultiStoreProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MultiStoreProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiStoreProof
+func (x *fastReflection_FeedsMultiStoreProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeedsMultiStoreProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MultiStoreProof) Type() protoreflect.MessageType {
-	return _fastReflection_MultiStoreProof_messageType
+func (x *fastReflection_FeedsMultiStoreProof) Type() protoreflect.MessageType {
+	return _fastReflection_FeedsMultiStoreProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MultiStoreProof) New() protoreflect.Message {
-	return new(fastReflection_MultiStoreProof)
+func (x *fastReflection_FeedsMultiStoreProof) New() protoreflect.Message {
+	return new(fastReflection_FeedsMultiStoreProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MultiStoreProof) Interface() protoreflect.ProtoMessage {
-	return (*MultiStoreProof)(x)
+func (x *fastReflection_FeedsMultiStoreProof) Interface() protoreflect.ProtoMessage {
+	return (*FeedsMultiStoreProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8113,40 +11699,40 @@ func (x *fastReflection_MultiStoreProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MultiStoreProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.OracleIavlStateHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.OracleIavlStateHash)
-		if !f(fd_MultiStoreProof_oracle_iavl_state_hash, value) {
+func (x *fastReflection_FeedsMultiStoreProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.FeedsIavlStateHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.FeedsIavlStateHash)
+		if !f(fd_FeedsMultiStoreProof_feeds_iavl_state_hash, value) {
 			return
 		}
 	}
-	if len(x.MintStoreMerkleHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.MintStoreMerkleHash)
-		if !f(fd_MultiStoreProof_mint_store_merkle_hash, value) {
+	if len(x.EvidenceStoreMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.EvidenceStoreMerkleHash)
+		if !f(fd_FeedsMultiStoreProof_evidence_store_merkle_hash, value) {
 			return
 		}
 	}
-	if len(x.ParamsToRestakeStoresMerkleHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.ParamsToRestakeStoresMerkleHash)
-		if !f(fd_MultiStoreProof_params_to_restake_stores_merkle_hash, value) {
+	if len(x.FeegrantToFeeibcStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.FeegrantToFeeibcStoresMerkleHash)
+		if !f(fd_FeedsMultiStoreProof_feegrant_to_feeibc_stores_merkle_hash, value) {
 			return
 		}
 	}
-	if len(x.RollingseedToTransferStoresMerkleHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.RollingseedToTransferStoresMerkleHash)
-		if !f(fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash, value) {
+	if len(x.GlobalfeeToIcahostStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.GlobalfeeToIcahostStoresMerkleHash)
+		if !f(fd_FeedsMultiStoreProof_globalfee_to_icahost_stores_merkle_hash, value) {
 			return
 		}
 	}
-	if len(x.TssToUpgradeStoresMerkleHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.TssToUpgradeStoresMerkleHash)
-		if !f(fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash, value) {
+	if len(x.AuthToDistributionStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.AuthToDistributionStoresMerkleHash)
+		if !f(fd_FeedsMultiStoreProof_auth_to_distribution_stores_merkle_hash, value) {
 			return
 		}
 	}
-	if len(x.AuthToIcahostStoresMerkleHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.AuthToIcahostStoresMerkleHash)
-		if !f(fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash, value) {
+	if len(x.MintToUpgradeStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.MintToUpgradeStoresMerkleHash)
+		if !f(fd_FeedsMultiStoreProof_mint_to_upgrade_stores_merkle_hash, value) {
 			return
 		}
 	}
@@ -8163,25 +11749,25 @@ func (x *fastReflection_MultiStoreProof) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MultiStoreProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FeedsMultiStoreProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
-		return len(x.OracleIavlStateHash) != 0
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
-		return len(x.MintStoreMerkleHash) != 0
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
-		return len(x.ParamsToRestakeStoresMerkleHash) != 0
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
-		return len(x.RollingseedToTransferStoresMerkleHash) != 0
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
-		return len(x.TssToUpgradeStoresMerkleHash) != 0
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
-		return len(x.AuthToIcahostStoresMerkleHash) != 0
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feeds_iavl_state_hash":
+		return len(x.FeedsIavlStateHash) != 0
+	case "band.base.oracle.v1.FeedsMultiStoreProof.evidence_store_merkle_hash":
+		return len(x.EvidenceStoreMerkleHash) != 0
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feegrant_to_feeibc_stores_merkle_hash":
+		return len(x.FeegrantToFeeibcStoresMerkleHash) != 0
+	case "band.base.oracle.v1.FeedsMultiStoreProof.globalfee_to_icahost_stores_merkle_hash":
+		return len(x.GlobalfeeToIcahostStoresMerkleHash) != 0
+	case "band.base.oracle.v1.FeedsMultiStoreProof.auth_to_distribution_stores_merkle_hash":
+		return len(x.AuthToDistributionStoresMerkleHash) != 0
+	case "band.base.oracle.v1.FeedsMultiStoreProof.mint_to_upgrade_stores_merkle_hash":
+		return len(x.MintToUpgradeStoresMerkleHash) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.FeedsMultiStoreProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.FeedsMultiStoreProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8191,25 +11777,25 @@ func (x *fastReflection_MultiStoreProof) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiStoreProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeedsMultiStoreProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
-		x.OracleIavlStateHash = nil
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
-		x.MintStoreMerkleHash = nil
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
-		x.ParamsToRestakeStoresMerkleHash = nil
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
-		x.RollingseedToTransferStoresMerkleHash = nil
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
-		x.TssToUpgradeStoresMerkleHash = nil
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
-		x.AuthToIcahostStoresMerkleHash = nil
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feeds_iavl_state_hash":
+		x.FeedsIavlStateHash = nil
+	case "band.base.oracle.v1.FeedsMultiStoreProof.evidence_store_merkle_hash":
+		x.EvidenceStoreMerkleHash = nil
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feegrant_to_feeibc_stores_merkle_hash":
+		x.FeegrantToFeeibcStoresMerkleHash = nil
+	case "band.base.oracle.v1.FeedsMultiStoreProof.globalfee_to_icahost_stores_merkle_hash":
+		x.GlobalfeeToIcahostStoresMerkleHash = nil
+	case "band.base.oracle.v1.FeedsMultiStoreProof.auth_to_distribution_stores_merkle_hash":
+		x.AuthToDistributionStoresMerkleHash = nil
+	case "band.base.oracle.v1.FeedsMultiStoreProof.mint_to_upgrade_stores_merkle_hash":
+		x.MintToUpgradeStoresMerkleHash = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.FeedsMultiStoreProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.FeedsMultiStoreProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8219,31 +11805,31 @@ func (x *fastReflection_MultiStoreProof) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MultiStoreProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeedsMultiStoreProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
-		value := x.OracleIavlStateHash
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feeds_iavl_state_hash":
+		value := x.FeedsIavlStateHash
 		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
-		value := x.MintStoreMerkleHash
+	case "band.base.oracle.v1.FeedsMultiStoreProof.evidence_store_merkle_hash":
+		value := x.EvidenceStoreMerkleHash
 		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
-		value := x.ParamsToRestakeStoresMerkleHash
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feegrant_to_feeibc_stores_merkle_hash":
+		value := x.FeegrantToFeeibcStoresMerkleHash
 		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
-		value := x.RollingseedToTransferStoresMerkleHash
+	case "band.base.oracle.v1.FeedsMultiStoreProof.globalfee_to_icahost_stores_merkle_hash":
+		value := x.GlobalfeeToIcahostStoresMerkleHash
 		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
-		value := x.TssToUpgradeStoresMerkleHash
+	case "band.base.oracle.v1.FeedsMultiStoreProof.auth_to_distribution_stores_merkle_hash":
+		value := x.AuthToDistributionStoresMerkleHash
 		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
-		value := x.AuthToIcahostStoresMerkleHash
+	case "band.base.oracle.v1.FeedsMultiStoreProof.mint_to_upgrade_stores_merkle_hash":
+		value := x.MintToUpgradeStoresMerkleHash
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.FeedsMultiStoreProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.FeedsMultiStoreProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8257,25 +11843,25 @@ func (x *fastReflection_MultiStoreProof) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiStoreProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeedsMultiStoreProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
-		x.OracleIavlStateHash = value.Bytes()
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
-		x.MintStoreMerkleHash = value.Bytes()
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
-		x.ParamsToRestakeStoresMerkleHash = value.Bytes()
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
-		x.RollingseedToTransferStoresMerkleHash = value.Bytes()
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
-		x.TssToUpgradeStoresMerkleHash = value.Bytes()
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
-		x.AuthToIcahostStoresMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feeds_iavl_state_hash":
+		x.FeedsIavlStateHash = value.Bytes()
+	case "band.base.oracle.v1.FeedsMultiStoreProof.evidence_store_merkle_hash":
+		x.EvidenceStoreMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feegrant_to_feeibc_stores_merkle_hash":
+		x.FeegrantToFeeibcStoresMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.FeedsMultiStoreProof.globalfee_to_icahost_stores_merkle_hash":
+		x.GlobalfeeToIcahostStoresMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.FeedsMultiStoreProof.auth_to_distribution_stores_merkle_hash":
+		x.AuthToDistributionStoresMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.FeedsMultiStoreProof.mint_to_upgrade_stores_merkle_hash":
+		x.MintToUpgradeStoresMerkleHash = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.FeedsMultiStoreProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.FeedsMultiStoreProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8289,60 +11875,60 @@ func (x *fastReflection_MultiStoreProof) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiStoreProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeedsMultiStoreProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
-		panic(fmt.Errorf("field oracle_iavl_state_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
-		panic(fmt.Errorf("field mint_store_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
-		panic(fmt.Errorf("field params_to_restake_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
-		panic(fmt.Errorf("field rollingseed_to_transfer_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
-		panic(fmt.Errorf("field tss_to_upgrade_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
-		panic(fmt.Errorf("field auth_to_icahost_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feeds_iavl_state_hash":
+		panic(fmt.Errorf("field feeds_iavl_state_hash of message band.base.oracle.v1.FeedsMultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.FeedsMultiStoreProof.evidence_store_merkle_hash":
+		panic(fmt.Errorf("field evidence_store_merkle_hash of message band.base.oracle.v1.FeedsMultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feegrant_to_feeibc_stores_merkle_hash":
+		panic(fmt.Errorf("field feegrant_to_feeibc_stores_merkle_hash of message band.base.oracle.v1.FeedsMultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.FeedsMultiStoreProof.globalfee_to_icahost_stores_merkle_hash":
+		panic(fmt.Errorf("field globalfee_to_icahost_stores_merkle_hash of message band.base.oracle.v1.FeedsMultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.FeedsMultiStoreProof.auth_to_distribution_stores_merkle_hash":
+		panic(fmt.Errorf("field auth_to_distribution_stores_merkle_hash of message band.base.oracle.v1.FeedsMultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.FeedsMultiStoreProof.mint_to_upgrade_stores_merkle_hash":
+		panic(fmt.Errorf("field mint_to_upgrade_stores_merkle_hash of message band.base.oracle.v1.FeedsMultiStoreProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.FeedsMultiStoreProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.FeedsMultiStoreProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MultiStoreProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeedsMultiStoreProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feeds_iavl_state_hash":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+	case "band.base.oracle.v1.FeedsMultiStoreProof.evidence_store_merkle_hash":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+	case "band.base.oracle.v1.FeedsMultiStoreProof.feegrant_to_feeibc_stores_merkle_hash":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+	case "band.base.oracle.v1.FeedsMultiStoreProof.globalfee_to_icahost_stores_merkle_hash":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+	case "band.base.oracle.v1.FeedsMultiStoreProof.auth_to_distribution_stores_merkle_hash":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+	case "band.base.oracle.v1.FeedsMultiStoreProof.mint_to_upgrade_stores_merkle_hash":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.FeedsMultiStoreProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.FeedsMultiStoreProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MultiStoreProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeedsMultiStoreProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiStoreProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.FeedsMultiStoreProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8350,7 +11936,7 @@ func (x *fastReflection_MultiStoreProof) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MultiStoreProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeedsMultiStoreProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8361,7 +11947,7 @@ func (x *fastReflection_MultiStoreProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiStoreProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeedsMultiStoreProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8373,7 +11959,7 @@ func (x *fastReflection_MultiStoreProof) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MultiStoreProof) IsValid() bool {
+func (x *fastReflection_FeedsMultiStoreProof) IsValid() bool {
 	return x != nil
 }
 
@@ -8383,9 +11969,9 @@ func (x *fastReflection_MultiStoreProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeedsMultiStoreProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MultiStoreProof)
+		x := input.Message.Interface().(*FeedsMultiStoreProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8397,27 +11983,27 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.OracleIavlStateHash)
+		l = len(x.FeedsIavlStateHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MintStoreMerkleHash)
+		l = len(x.EvidenceStoreMerkleHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ParamsToRestakeStoresMerkleHash)
+		l = len(x.FeegrantToFeeibcStoresMerkleHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RollingseedToTransferStoresMerkleHash)
+		l = len(x.GlobalfeeToIcahostStoresMerkleHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.TssToUpgradeStoresMerkleHash)
+		l = len(x.AuthToDistributionStoresMerkleHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.AuthToIcahostStoresMerkleHash)
+		l = len(x.MintToUpgradeStoresMerkleHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -8431,7 +12017,7 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MultiStoreProof)
+		x := input.Message.Interface().(*FeedsMultiStoreProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8450,45 +12036,45 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AuthToIcahostStoresMerkleHash) > 0 {
-			i -= len(x.AuthToIcahostStoresMerkleHash)
-			copy(dAtA[i:], x.AuthToIcahostStoresMerkleHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuthToIcahostStoresMerkleHash)))
+		if len(x.MintToUpgradeStoresMerkleHash) > 0 {
+			i -= len(x.MintToUpgradeStoresMerkleHash)
+			copy(dAtA[i:], x.MintToUpgradeStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintToUpgradeStoresMerkleHash)))
 			i--
 			dAtA[i] = 0x32
 		}
-		if len(x.TssToUpgradeStoresMerkleHash) > 0 {
-			i -= len(x.TssToUpgradeStoresMerkleHash)
-			copy(dAtA[i:], x.TssToUpgradeStoresMerkleHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TssToUpgradeStoresMerkleHash)))
+		if len(x.AuthToDistributionStoresMerkleHash) > 0 {
+			i -= len(x.AuthToDistributionStoresMerkleHash)
+			copy(dAtA[i:], x.AuthToDistributionStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuthToDistributionStoresMerkleHash)))
 			i--
 			dAtA[i] = 0x2a
 		}
-		if len(x.RollingseedToTransferStoresMerkleHash) > 0 {
-			i -= len(x.RollingseedToTransferStoresMerkleHash)
-			copy(dAtA[i:], x.RollingseedToTransferStoresMerkleHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RollingseedToTransferStoresMerkleHash)))
+		if len(x.GlobalfeeToIcahostStoresMerkleHash) > 0 {
+			i -= len(x.GlobalfeeToIcahostStoresMerkleHash)
+			copy(dAtA[i:], x.GlobalfeeToIcahostStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GlobalfeeToIcahostStoresMerkleHash)))
 			i--
 			dAtA[i] = 0x22
 		}
-		if len(x.ParamsToRestakeStoresMerkleHash) > 0 {
-			i -= len(x.ParamsToRestakeStoresMerkleHash)
-			copy(dAtA[i:], x.ParamsToRestakeStoresMerkleHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParamsToRestakeStoresMerkleHash)))
+		if len(x.FeegrantToFeeibcStoresMerkleHash) > 0 {
+			i -= len(x.FeegrantToFeeibcStoresMerkleHash)
+			copy(dAtA[i:], x.FeegrantToFeeibcStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeegrantToFeeibcStoresMerkleHash)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.MintStoreMerkleHash) > 0 {
-			i -= len(x.MintStoreMerkleHash)
-			copy(dAtA[i:], x.MintStoreMerkleHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintStoreMerkleHash)))
+		if len(x.EvidenceStoreMerkleHash) > 0 {
+			i -= len(x.EvidenceStoreMerkleHash)
+			copy(dAtA[i:], x.EvidenceStoreMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceStoreMerkleHash)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.OracleIavlStateHash) > 0 {
-			i -= len(x.OracleIavlStateHash)
-			copy(dAtA[i:], x.OracleIavlStateHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OracleIavlStateHash)))
+		if len(x.FeedsIavlStateHash) > 0 {
+			i -= len(x.FeedsIavlStateHash)
+			copy(dAtA[i:], x.FeedsIavlStateHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeedsIavlStateHash)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8503,7 +12089,7 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MultiStoreProof)
+		x := input.Message.Interface().(*FeedsMultiStoreProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8535,15 +12121,15 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiStoreProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedsMultiStoreProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedsMultiStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleIavlStateHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeedsIavlStateHash", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -8570,14 +12156,14 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OracleIavlStateHash = append(x.OracleIavlStateHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.OracleIavlStateHash == nil {
-					x.OracleIavlStateHash = []byte{}
+				x.FeedsIavlStateHash = append(x.FeedsIavlStateHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.FeedsIavlStateHash == nil {
+					x.FeedsIavlStateHash = []byte{}
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintStoreMerkleHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceStoreMerkleHash", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -8604,14 +12190,14 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MintStoreMerkleHash = append(x.MintStoreMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.MintStoreMerkleHash == nil {
-					x.MintStoreMerkleHash = []byte{}
+				x.EvidenceStoreMerkleHash = append(x.EvidenceStoreMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.EvidenceStoreMerkleHash == nil {
+					x.EvidenceStoreMerkleHash = []byte{}
 				}
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParamsToRestakeStoresMerkleHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeegrantToFeeibcStoresMerkleHash", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -8638,14 +12224,14 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ParamsToRestakeStoresMerkleHash = append(x.ParamsToRestakeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.ParamsToRestakeStoresMerkleHash == nil {
-					x.ParamsToRestakeStoresMerkleHash = []byte{}
+				x.FeegrantToFeeibcStoresMerkleHash = append(x.FeegrantToFeeibcStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.FeegrantToFeeibcStoresMerkleHash == nil {
+					x.FeegrantToFeeibcStoresMerkleHash = []byte{}
 				}
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RollingseedToTransferStoresMerkleHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalfeeToIcahostStoresMerkleHash", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -8672,14 +12258,14 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RollingseedToTransferStoresMerkleHash = append(x.RollingseedToTransferStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.RollingseedToTransferStoresMerkleHash == nil {
-					x.RollingseedToTransferStoresMerkleHash = []byte{}
+				x.GlobalfeeToIcahostStoresMerkleHash = append(x.GlobalfeeToIcahostStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.GlobalfeeToIcahostStoresMerkleHash == nil {
+					x.GlobalfeeToIcahostStoresMerkleHash = []byte{}
 				}
 				iNdEx = postIndex
 			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssToUpgradeStoresMerkleHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthToDistributionStoresMerkleHash", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -8706,14 +12292,14 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TssToUpgradeStoresMerkleHash = append(x.TssToUpgradeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.TssToUpgradeStoresMerkleHash == nil {
-					x.TssToUpgradeStoresMerkleHash = []byte{}
+				x.AuthToDistributionStoresMerkleHash = append(x.AuthToDistributionStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.AuthToDistributionStoresMerkleHash == nil {
+					x.AuthToDistributionStoresMerkleHash = []byte{}
 				}
 				iNdEx = postIndex
 			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthToIcahostStoresMerkleHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintToUpgradeStoresMerkleHash", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -8740,9 +12326,9 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AuthToIcahostStoresMerkleHash = append(x.AuthToIcahostStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.AuthToIcahostStoresMerkleHash == nil {
-					x.AuthToIcahostStoresMerkleHash = []byte{}
+				x.MintToUpgradeStoresMerkleHash = append(x.MintToUpgradeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.MintToUpgradeStoresMerkleHash == nil {
+					x.MintToUpgradeStoresMerkleHash = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -8814,7 +12400,7 @@ func (x *BlockHeaderMerkleParts) ProtoReflect() protoreflect.Message {
 }
 
 func (x *BlockHeaderMerkleParts) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[16]
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9632,7 +13218,7 @@ func (x *CommonEncodedVotePart) ProtoReflect() protoreflect.Message {
 }
 
 func (x *CommonEncodedVotePart) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[17]
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10124,7 +13710,7 @@ func (x *TMSignature) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TMSignature) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[18]
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10775,7 +14361,7 @@ func (x *RequestsCountProof) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RequestsCountProof) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[19]
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11547,6 +15133,96 @@ func (x *RequestCountProofResponse) GetResult() *CountProofResult {
 	return nil
 }
 
+// PricesProofRequest is request type for the Service/PricesProof RPC method.
+type PricesProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_ids is the list of signal IDs
+	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *PricesProofRequest) Reset() {
+	*x = PricesProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PricesProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricesProofRequest) ProtoMessage() {}
+
+// Deprecated: Use PricesProofRequest.ProtoReflect.Descriptor instead.
+func (*PricesProofRequest) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PricesProofRequest) GetSignalIds() []string {
+	if x != nil {
+		return x.SignalIds
+	}
+	return nil
+}
+
+func (x *PricesProofRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// PricesProofResponse is response type for the Service/PricesProof RPC method.
+type PricesProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64              `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Result *PricesProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *PricesProofResponse) Reset() {
+	*x = PricesProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PricesProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricesProofResponse) ProtoMessage() {}
+
+// Deprecated: Use PricesProofResponse.ProtoReflect.Descriptor instead.
+func (*PricesProofResponse) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PricesProofResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PricesProofResponse) GetResult() *PricesProofResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 // SingleProofResponse is the data structure for response of single proof
 type SingleProofResult struct {
 	state         protoimpl.MessageState
@@ -11560,7 +15236,7 @@ type SingleProofResult struct {
 func (x *SingleProofResult) Reset() {
 	*x = SingleProofResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[6]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11574,7 +15250,7 @@ func (*SingleProofResult) ProtoMessage() {}
 
 // Deprecated: Use SingleProofResult.ProtoReflect.Descriptor instead.
 func (*SingleProofResult) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{6}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SingleProofResult) GetProof() *SingleProof {
@@ -11604,7 +15280,7 @@ type MultiProofResult struct {
 func (x *MultiProofResult) Reset() {
 	*x = MultiProofResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[7]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11618,7 +15294,7 @@ func (*MultiProofResult) ProtoMessage() {}
 
 // Deprecated: Use MultiProofResult.ProtoReflect.Descriptor instead.
 func (*MultiProofResult) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{7}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MultiProofResult) GetProof() *MultiProof {
@@ -11648,7 +15324,7 @@ type CountProofResult struct {
 func (x *CountProofResult) Reset() {
 	*x = CountProofResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[8]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11662,7 +15338,7 @@ func (*CountProofResult) ProtoMessage() {}
 
 // Deprecated: Use CountProofResult.ProtoReflect.Descriptor instead.
 func (*CountProofResult) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{8}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CountProofResult) GetProof() *CountProof {
@@ -11679,6 +15355,50 @@ func (x *CountProofResult) GetEvmProofBytes() []byte {
 	return nil
 }
 
+// PricesProofResult is the data structure for response of prices proof
+type PricesProofResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proof         *PricesProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	EvmProofBytes []byte       `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3" json:"evm_proof_bytes,omitempty"`
+}
+
+func (x *PricesProofResult) Reset() {
+	*x = PricesProofResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PricesProofResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricesProofResult) ProtoMessage() {}
+
+// Deprecated: Use PricesProofResult.ProtoReflect.Descriptor instead.
+func (*PricesProofResult) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PricesProofResult) GetProof() *PricesProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *PricesProofResult) GetEvmProofBytes() []byte {
+	if x != nil {
+		return x.EvmProofBytes
+	}
+	return nil
+}
+
 // SingleProof contains block height, oracle data proof and block relay proof
 type SingleProof struct {
 	state         protoimpl.MessageState
@@ -11693,7 +15413,7 @@ type SingleProof struct {
 func (x *SingleProof) Reset() {
 	*x = SingleProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[9]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11707,7 +15427,7 @@ func (*SingleProof) ProtoMessage() {}
 
 // Deprecated: Use SingleProof.ProtoReflect.Descriptor instead.
 func (*SingleProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{9}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SingleProof) GetBlockHeight() uint64 {
@@ -11745,7 +15465,7 @@ type MultiProof struct {
 func (x *MultiProof) Reset() {
 	*x = MultiProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[10]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11759,78 +15479,190 @@ func (*MultiProof) ProtoMessage() {}
 
 // Deprecated: Use MultiProof.ProtoReflect.Descriptor instead.
 func (*MultiProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{10}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MultiProof) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *MultiProof) GetOracleDataMultiProof() []*OracleDataProof {
+	if x != nil {
+		return x.OracleDataMultiProof
+	}
+	return nil
+}
+
+func (x *MultiProof) GetBlockRelayProof() *BlockRelayProof {
+	if x != nil {
+		return x.BlockRelayProof
+	}
+	return nil
+}
+
+// CountProof contains block height, count proof and block relay proof
+type CountProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight     uint64              `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	CountProof      *RequestsCountProof `protobuf:"bytes,2,opt,name=count_proof,json=countProof,proto3" json:"count_proof,omitempty"`
+	BlockRelayProof *BlockRelayProof    `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof,omitempty"`
+}
+
+func (x *CountProof) Reset() {
+	*x = CountProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountProof) ProtoMessage() {}
+
+// Deprecated: Use CountProof.ProtoReflect.Descriptor instead.
+func (*CountProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CountProof) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *CountProof) GetCountProof() *RequestsCountProof {
+	if x != nil {
+		return x.CountProof
+	}
+	return nil
+}
+
+func (x *CountProof) GetBlockRelayProof() *BlockRelayProof {
+	if x != nil {
+		return x.BlockRelayProof
+	}
+	return nil
+}
+
+// PricesProof contains block height, feeds multi store proof, list of price proof and block relay proof
+type PricesProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight          uint64                `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	FeedsMultiStoreProof *FeedsMultiStoreProof `protobuf:"bytes,2,opt,name=feeds_multi_store_proof,json=feedsMultiStoreProof,proto3" json:"feeds_multi_store_proof,omitempty"`
+	PriceProofs          []*PriceProof         `protobuf:"bytes,3,rep,name=price_proofs,json=priceProofs,proto3" json:"price_proofs,omitempty"`
+	BlockRelayProof      *BlockRelayProof      `protobuf:"bytes,4,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof,omitempty"`
+}
+
+func (x *PricesProof) Reset() {
+	*x = PricesProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PricesProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricesProof) ProtoMessage() {}
+
+// Deprecated: Use PricesProof.ProtoReflect.Descriptor instead.
+func (*PricesProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MultiProof) GetBlockHeight() uint64 {
+func (x *PricesProof) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-func (x *MultiProof) GetOracleDataMultiProof() []*OracleDataProof {
+func (x *PricesProof) GetFeedsMultiStoreProof() *FeedsMultiStoreProof {
 	if x != nil {
-		return x.OracleDataMultiProof
+		return x.FeedsMultiStoreProof
 	}
 	return nil
 }
 
-func (x *MultiProof) GetBlockRelayProof() *BlockRelayProof {
+func (x *PricesProof) GetPriceProofs() []*PriceProof {
+	if x != nil {
+		return x.PriceProofs
+	}
+	return nil
+}
+
+func (x *PricesProof) GetBlockRelayProof() *BlockRelayProof {
 	if x != nil {
 		return x.BlockRelayProof
 	}
 	return nil
 }
 
-// CountProof contains block height, count proof and block relay proof
-type CountProof struct {
+// PriceProof contains price, version and merkle paths
+type PriceProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockHeight     uint64              `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	CountProof      *RequestsCountProof `protobuf:"bytes,2,opt,name=count_proof,json=countProof,proto3" json:"count_proof,omitempty"`
-	BlockRelayProof *BlockRelayProof    `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof,omitempty"`
+	Price       *v1beta1.Price    `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Version     uint64            `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []*IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths,omitempty"`
 }
 
-func (x *CountProof) Reset() {
-	*x = CountProof{}
+func (x *PriceProof) Reset() {
+	*x = PriceProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[11]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountProof) String() string {
+func (x *PriceProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountProof) ProtoMessage() {}
+func (*PriceProof) ProtoMessage() {}
 
-// Deprecated: Use CountProof.ProtoReflect.Descriptor instead.
-func (*CountProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PriceProof.ProtoReflect.Descriptor instead.
+func (*PriceProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CountProof) GetBlockHeight() uint64 {
+func (x *PriceProof) GetPrice() *v1beta1.Price {
 	if x != nil {
-		return x.BlockHeight
+		return x.Price
 	}
-	return 0
+	return nil
 }
 
-func (x *CountProof) GetCountProof() *RequestsCountProof {
+func (x *PriceProof) GetVersion() uint64 {
 	if x != nil {
-		return x.CountProof
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *CountProof) GetBlockRelayProof() *BlockRelayProof {
+func (x *PriceProof) GetMerklePaths() []*IAVLMerklePath {
 	if x != nil {
-		return x.BlockRelayProof
+		return x.MerklePaths
 	}
 	return nil
 }
@@ -11849,7 +15681,7 @@ type OracleDataProof struct {
 func (x *OracleDataProof) Reset() {
 	*x = OracleDataProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[12]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11863,7 +15695,7 @@ func (*OracleDataProof) ProtoMessage() {}
 
 // Deprecated: Use OracleDataProof.ProtoReflect.Descriptor instead.
 func (*OracleDataProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{12}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *OracleDataProof) GetResult() *v1.Result {
@@ -11903,7 +15735,7 @@ type IAVLMerklePath struct {
 func (x *IAVLMerklePath) Reset() {
 	*x = IAVLMerklePath{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[13]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11917,7 +15749,7 @@ func (*IAVLMerklePath) ProtoMessage() {}
 
 // Deprecated: Use IAVLMerklePath.ProtoReflect.Descriptor instead.
 func (*IAVLMerklePath) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{13}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *IAVLMerklePath) GetIsDataOnRight() bool {
@@ -11970,7 +15802,7 @@ type BlockRelayProof struct {
 func (x *BlockRelayProof) Reset() {
 	*x = BlockRelayProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[14]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11984,7 +15816,7 @@ func (*BlockRelayProof) ProtoMessage() {}
 
 // Deprecated: Use BlockRelayProof.ProtoReflect.Descriptor instead.
 func (*BlockRelayProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{14}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *BlockRelayProof) GetMultiStoreProof() *MultiStoreProof {
@@ -12055,7 +15887,7 @@ type MultiStoreProof struct {
 func (x *MultiStoreProof) Reset() {
 	*x = MultiStoreProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[15]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12069,7 +15901,7 @@ func (*MultiStoreProof) ProtoMessage() {}
 
 // Deprecated: Use MultiStoreProof.ProtoReflect.Descriptor instead.
 func (*MultiStoreProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{15}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MultiStoreProof) GetOracleIavlStateHash() []byte {
@@ -12114,6 +15946,85 @@ func (x *MultiStoreProof) GetAuthToIcahostStoresMerkleHash() []byte {
 	return nil
 }
 
+// FeedsMultiStoreProof stores a compact of other Cosmos-SDK modules' storage hash in multistore to
+// compute (in combination with feeds store hash) Tendermint's application state hash at a given block.
+// It uses the same Merkle tree as MultiStoreProof, but validates the correctness of [9] instead of [H].
+// Only [8], [N5], [N15], [N20], and [N25] are needed in order to compute [AppHash].
+type FeedsMultiStoreProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeedsIavlStateHash                 []byte `protobuf:"bytes,1,opt,name=feeds_iavl_state_hash,json=feedsIavlStateHash,proto3" json:"feeds_iavl_state_hash,omitempty"`
+	EvidenceStoreMerkleHash            []byte `protobuf:"bytes,2,opt,name=evidence_store_merkle_hash,json=evidenceStoreMerkleHash,proto3" json:"evidence_store_merkle_hash,omitempty"`
+	FeegrantToFeeibcStoresMerkleHash   []byte `protobuf:"bytes,3,opt,name=feegrant_to_feeibc_stores_merkle_hash,json=feegrantToFeeibcStoresMerkleHash,proto3" json:"feegrant_to_feeibc_stores_merkle_hash,omitempty"`
+	GlobalfeeToIcahostStoresMerkleHash []byte `protobuf:"bytes,4,opt,name=globalfee_to_icahost_stores_merkle_hash,json=globalfeeToIcahostStoresMerkleHash,proto3" json:"globalfee_to_icahost_stores_merkle_hash,omitempty"`
+	AuthToDistributionStoresMerkleHash []byte `protobuf:"bytes,5,opt,name=auth_to_distribution_stores_merkle_hash,json=authToDistributionStoresMerkleHash,proto3" json:"auth_to_distribution_stores_merkle_hash,omitempty"`
+	MintToUpgradeStoresMerkleHash      []byte `protobuf:"bytes,6,opt,name=mint_to_upgrade_stores_merkle_hash,json=mintToUpgradeStoresMerkleHash,proto3" json:"mint_to_upgrade_stores_merkle_hash,omitempty"`
+}
+
+func (x *FeedsMultiStoreProof) Reset() {
+	*x = FeedsMultiStoreProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedsMultiStoreProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedsMultiStoreProof) ProtoMessage() {}
+
+// Deprecated: Use FeedsMultiStoreProof.ProtoReflect.Descriptor instead.
+func (*FeedsMultiStoreProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FeedsMultiStoreProof) GetFeedsIavlStateHash() []byte {
+	if x != nil {
+		return x.FeedsIavlStateHash
+	}
+	return nil
+}
+
+func (x *FeedsMultiStoreProof) GetEvidenceStoreMerkleHash() []byte {
+	if x != nil {
+		return x.EvidenceStoreMerkleHash
+	}
+	return nil
+}
+
+func (x *FeedsMultiStoreProof) GetFeegrantToFeeibcStoresMerkleHash() []byte {
+	if x != nil {
+		return x.FeegrantToFeeibcStoresMerkleHash
+	}
+	return nil
+}
+
+func (x *FeedsMultiStoreProof) GetGlobalfeeToIcahostStoresMerkleHash() []byte {
+	if x != nil {
+		return x.GlobalfeeToIcahostStoresMerkleHash
+	}
+	return nil
+}
+
+func (x *FeedsMultiStoreProof) GetAuthToDistributionStoresMerkleHash() []byte {
+	if x != nil {
+		return x.AuthToDistributionStoresMerkleHash
+	}
+	return nil
+}
+
+func (x *FeedsMultiStoreProof) GetMintToUpgradeStoresMerkleHash() []byte {
+	if x != nil {
+		return x.MintToUpgradeStoresMerkleHash
+	}
+	return nil
+}
+
 // BlockHeaderMerkleParts stores a group of hashes using for computing Tendermint's block
 // header hash from app hash, and height.
 //
@@ -12158,7 +16069,7 @@ type BlockHeaderMerkleParts struct {
 func (x *BlockHeaderMerkleParts) Reset() {
 	*x = BlockHeaderMerkleParts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[16]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12172,7 +16083,7 @@ func (*BlockHeaderMerkleParts) ProtoMessage() {}
 
 // Deprecated: Use BlockHeaderMerkleParts.ProtoReflect.Descriptor instead.
 func (*BlockHeaderMerkleParts) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{16}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *BlockHeaderMerkleParts) GetVersionAndChainIdHash() []byte {
@@ -12244,7 +16155,7 @@ type CommonEncodedVotePart struct {
 func (x *CommonEncodedVotePart) Reset() {
 	*x = CommonEncodedVotePart{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[17]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12258,7 +16169,7 @@ func (*CommonEncodedVotePart) ProtoMessage() {}
 
 // Deprecated: Use CommonEncodedVotePart.ProtoReflect.Descriptor instead.
 func (*CommonEncodedVotePart) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{17}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CommonEncodedVotePart) GetSignedDataPrefix() []byte {
@@ -12294,7 +16205,7 @@ type TMSignature struct {
 func (x *TMSignature) Reset() {
 	*x = TMSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[18]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12308,7 +16219,7 @@ func (*TMSignature) ProtoMessage() {}
 
 // Deprecated: Use TMSignature.ProtoReflect.Descriptor instead.
 func (*TMSignature) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{18}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *TMSignature) GetR() []byte {
@@ -12353,7 +16264,7 @@ type RequestsCountProof struct {
 func (x *RequestsCountProof) Reset() {
 	*x = RequestsCountProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[19]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12367,7 +16278,7 @@ func (*RequestsCountProof) ProtoMessage() {}
 
 // Deprecated: Use RequestsCountProof.ProtoReflect.Descriptor instead.
 func (*RequestsCountProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{19}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RequestsCountProof) GetCount() uint64 {
@@ -12402,7 +16313,9 @@ var file_band_base_oracle_v1_proof_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
 	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e, 0x64,
 	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x6f, 0x66,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
@@ -12433,311 +16346,424 @@ var file_band_base_oracle_v1_proof_proto_rawDesc = []byte{
 	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62,
 	0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
 	0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xaf, 0x01, 0x0a,
-	0x11, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
-	0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73,
-	0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
-	0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xad,
-	0x01, 0x0a, 0x10, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
-	0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73,
-	0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
-	0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xad,
-	0x01, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
-	0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73,
-	0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
-	0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xe0,
-	0x01, 0x0a, 0x0b, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21,
-	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x56, 0x0a, 0x11, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4b, 0x0a, 0x12,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x73, 0x0a, 0x13, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xaf,
+	0x01, 0x0a, 0x11, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f,
+	0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69,
+	0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x52, 0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0xad, 0x01, 0x0a, 0x10, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f,
+	0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69,
+	0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x52, 0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0xad, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f,
+	0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69,
+	0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x52, 0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0xaf, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa,
+	0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
+	0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x52, 0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x0b, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x56, 0x0a,
+	0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xea, 0x01, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x61, 0x0a, 0x17, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x56, 0x0a, 0x11, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x22, 0xd7, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x4e, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x56, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65,
+	0x6c, 0x61, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xba, 0x02, 0x0a,
+	0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x66, 0x0a, 0x17, 0x66, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x73, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x14, 0x66, 0x65, 0x65, 0x64, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x48, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x73, 0x12, 0x56, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62,
 	0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x56, 0x0a, 0x11, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x22, 0xea, 0x01, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x61, 0x0a, 0x17, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x14, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x56, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c,
-	0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xd7,
-	0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x4e, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x56, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f,
-	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xaf, 0x01, 0x0a, 0x0f, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x34, 0x0a, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0c,
-	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x0e, 0x49,
-	0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x27, 0x0a,
-	0x10, 0x69, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x4f,
-	0x6e, 0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65,
-	0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
-	0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x74, 0x72,
-	0x65, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0c, 0x73, 0x69, 0x62,
-	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xab, 0x01, 0x0a, 0x0a, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x0f, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x34, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0c, 0x6d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x0e, 0x49, 0x41,
+	0x56, 0x4c, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x10,
+	0x69, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x4f, 0x6e,
+	0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73,
+	0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0c, 0x73, 0x69, 0x62, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34,
+	0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74,
+	0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73,
+	0x68, 0x22, 0x8a, 0x03, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x6c, 0x0a,
+	0x19, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x69, 0x0a, 0x18, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x56, 0x6f,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x4d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x97,
+	0x06, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x80, 0x01, 0x0a, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x61,
+	0x76, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x13, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x49,
+	0x41, 0x56, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0xfa, 0xde, 0x1f, 0x30,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74,
+	0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62,
+	0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x52, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x49, 0x61, 0x76, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x69, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63,
+	0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x13, 0x6d, 0x69, 0x6e,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x83, 0x01, 0x0a, 0x24, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
 	0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
 	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66,
 	0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x61,
-	0x73, 0x68, 0x22, 0x8a, 0x03, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61,
-	0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x6c,
-	0x0a, 0x19, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x69, 0x0a, 0x18,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x76,
-	0x6f, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x56,
-	0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x4d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22,
-	0x97, 0x06, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x12, 0x80, 0x01, 0x0a, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x69,
-	0x61, 0x76, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x13, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x49, 0x41, 0x56, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0xfa, 0xde, 0x1f,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x54, 0x6f, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x8f, 0x01, 0x0a, 0x2a, 0x72, 0x6f, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f,
 	0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
 	0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69,
 	0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x52, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x49, 0x61, 0x76, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x69, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x73, 0x52, 0x25, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x65, 0x64, 0x54, 0x6f,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x7d, 0x0a, 0x21, 0x74, 0x73, 0x73, 0x5f,
+	0x74, 0x6f, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d,
+	0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1c, 0x74, 0x73, 0x73, 0x54, 0x6f,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x7f, 0x0a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x74, 0x6f, 0x5f, 0x69, 0x63, 0x61, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d,
+	0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1d, 0x61, 0x75, 0x74, 0x68, 0x54,
+	0x6f, 0x49, 0x63, 0x61, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x22, 0xa9, 0x06, 0x0a, 0x14, 0x46, 0x65, 0x65,
+	0x64, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x7d, 0x0a, 0x15, 0x66, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x69, 0x61, 0x76, 0x6c, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x4a, 0xe2, 0xde, 0x1f, 0x12, 0x46, 0x65, 0x65, 0x64, 0x73, 0x49, 0x41, 0x56, 0x4c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
 	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x13, 0x6d, 0x69,
-	0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x83, 0x01, 0x0a, 0x24, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
-	0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x6d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
-	0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65,
-	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x54, 0x6f,
-	0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x8f, 0x01, 0x0a, 0x2a, 0x72, 0x6f, 0x6c, 0x6c,
-	0x69, 0x6e, 0x67, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c,
-	0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde,
-	0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d,
-	0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c,
-	0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x52, 0x25, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x65, 0x64, 0x54,
-	0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x7d, 0x0a, 0x21, 0x74, 0x73, 0x73,
-	0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f,
-	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1c, 0x74, 0x73, 0x73, 0x54,
-	0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65,
-	0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x7f, 0x0a, 0x22, 0x61, 0x75, 0x74, 0x68,
-	0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x63, 0x61, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f,
-	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1d, 0x61, 0x75, 0x74, 0x68,
-	0x54, 0x6f, 0x49, 0x63, 0x61, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x22, 0xb6, 0x05, 0x0a, 0x16, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50,
-	0x61, 0x72, 0x74, 0x73, 0x12, 0x6e, 0x0a, 0x19, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x61, 0x6e, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74,
-	0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x15, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x28, 0x0a,
-	0x10, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6e,
-	0x6f, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x6a, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x6f, 0x74, 0x68,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66,
-	0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x13,
-	0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4f, 0x74,
-	0x68, 0x65, 0x72, 0x12, 0x87, 0x01, 0x0a, 0x26, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
-	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06,
+	0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x12, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x49, 0x61, 0x76, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x71, 0x0a, 0x1a, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f,
 	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x21, 0x6e, 0x65, 0x78, 0x74,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x61, 0x73, 0x68, 0x41, 0x6e, 0x64,
-	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x60, 0x0a,
-	0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66,
-	0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0f,
-	0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x71, 0x0a, 0x1a, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20,
+	0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x17, 0x65, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x85, 0x01, 0x0a, 0x25, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x69, 0x62, 0x63, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d,
 	0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x17, 0x65, 0x76, 0x69, 0x64, 0x65,
-	0x6e, 0x63, 0x65, 0x41, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x48, 0x61,
-	0x73, 0x68, 0x22, 0xdf, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x62, 0x0a, 0x12,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66,
-	0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x10,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x12, 0x62, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f,
-	0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde,
-	0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d,
-	0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c,
-	0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75,
-	0x66, 0x66, 0x69, 0x78, 0x22, 0x86, 0x02, 0x0a, 0x0b, 0x54, 0x4d, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x20, 0x66, 0x65, 0x65, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x54, 0x6f, 0x46, 0x65, 0x65, 0x69, 0x62, 0x63, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x89, 0x01, 0x0a, 0x27,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x63, 0x61,
+	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa,
+	0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
+	0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x52, 0x22, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x66, 0x65, 0x65, 0x54, 0x6f,
+	0x49, 0x63, 0x61, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x89, 0x01, 0x0a, 0x27, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
+	0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73,
+	0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
+	0x22, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x7f, 0x0a, 0x22, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x75,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42,
 	0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
 	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66,
 	0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x01, 0x72, 0x12, 0x42, 0x0a, 0x01, 0x73, 0x18, 0x02, 0x20,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1d, 0x6d, 0x69, 0x6e, 0x74, 0x54, 0x6f, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0xb6, 0x05, 0x0a, 0x16, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12,
+	0x6e, 0x0a, 0x19, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e,
+	0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x15, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x41, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x12, 0x6a, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x69, 0x64, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x05, 0x20,
 	0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d,
 	0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x01, 0x73, 0x12, 0x0c, 0x0a, 0x01,
-	0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x76, 0x12, 0x61, 0x0a, 0x11, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63,
-	0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x10, 0x65, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x92, 0x01,
-	0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74,
-	0x68, 0x73, 0x32, 0xbc, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7f,
-	0x0a, 0x05, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x61, 0x6e,
+	0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x87,
+	0x01, 0x0a, 0x26, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66,
+	0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x21, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x48, 0x61, 0x73, 0x68, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x60, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d,
+	0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x71, 0x0a, 0x1a, 0x65, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34,
+	0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74,
+	0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x52, 0x17, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x6e,
+	0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x22, 0xdf, 0x01,
+	0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x56,
+	0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x62, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d,
+	0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x62, 0x0a, 0x12, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74,
+	0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x10, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x22,
+	0x86, 0x02, 0x0a, 0x0b, 0x54, 0x4d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x42, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74,
+	0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62,
+	0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x52, 0x01, 0x72, 0x12, 0x42, 0x0a, 0x01, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34,
+	0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74,
+	0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x52, 0x01, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x01, 0x76, 0x12, 0x61, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
+	0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65,
+	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x10, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x4c, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x41, 0x56, 0x4c,
+	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x32, 0xc9, 0x04,
+	0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7f, 0x0a, 0x05, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x29, 0x12, 0x27, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2f, 0x7b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x87, 0x01, 0x0a, 0x0a, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x12, 0x20, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
+	0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0xa5, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2d, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x2f, 0x7b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12,
-	0x87, 0x01, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x26,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2b, 0x12, 0x29, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x8a, 0x01, 0x0a,
+	0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x27, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
 	0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0xa5, 0x01, 0x0a, 0x11, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
-	0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f,
-	0x66, 0x42, 0xd7, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x41, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x42, 0x42, 0x4f, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x61, 0x73, 0x65,
-	0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e,
-	0x64, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x16, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a,
-	0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0xd7, 0x01, 0x0a, 0x17, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x62,
+	0x61, 0x73, 0x65, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x42, 0x4f, 0xaa, 0x02, 0x13, 0x42,
+	0x61, 0x6e, 0x64, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c,
+	0x42, 0x61, 0x73, 0x65, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x42, 0x61, 0x6e,
+	0x64, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -12752,7 +16778,7 @@ func file_band_base_oracle_v1_proof_proto_rawDescGZIP() []byte {
 	return file_band_base_oracle_v1_proof_proto_rawDescData
 }
 
-var file_band_base_oracle_v1_proof_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_band_base_oracle_v1_proof_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_band_base_oracle_v1_proof_proto_goTypes = []interface{}{
 	(*ProofRequest)(nil),              // 0: band.base.oracle.v1.ProofRequest
 	(*ProofResponse)(nil),             // 1: band.base.oracle.v1.ProofResponse
@@ -12760,53 +16786,69 @@ var file_band_base_oracle_v1_proof_proto_goTypes = []interface{}{
 	(*MultiProofResponse)(nil),        // 3: band.base.oracle.v1.MultiProofResponse
 	(*RequestCountProofRequest)(nil),  // 4: band.base.oracle.v1.RequestCountProofRequest
 	(*RequestCountProofResponse)(nil), // 5: band.base.oracle.v1.RequestCountProofResponse
-	(*SingleProofResult)(nil),         // 6: band.base.oracle.v1.SingleProofResult
-	(*MultiProofResult)(nil),          // 7: band.base.oracle.v1.MultiProofResult
-	(*CountProofResult)(nil),          // 8: band.base.oracle.v1.CountProofResult
-	(*SingleProof)(nil),               // 9: band.base.oracle.v1.SingleProof
-	(*MultiProof)(nil),                // 10: band.base.oracle.v1.MultiProof
-	(*CountProof)(nil),                // 11: band.base.oracle.v1.CountProof
-	(*OracleDataProof)(nil),           // 12: band.base.oracle.v1.OracleDataProof
-	(*IAVLMerklePath)(nil),            // 13: band.base.oracle.v1.IAVLMerklePath
-	(*BlockRelayProof)(nil),           // 14: band.base.oracle.v1.BlockRelayProof
-	(*MultiStoreProof)(nil),           // 15: band.base.oracle.v1.MultiStoreProof
-	(*BlockHeaderMerkleParts)(nil),    // 16: band.base.oracle.v1.BlockHeaderMerkleParts
-	(*CommonEncodedVotePart)(nil),     // 17: band.base.oracle.v1.CommonEncodedVotePart
-	(*TMSignature)(nil),               // 18: band.base.oracle.v1.TMSignature
-	(*RequestsCountProof)(nil),        // 19: band.base.oracle.v1.RequestsCountProof
-	(*v1.Result)(nil),                 // 20: band.oracle.v1.Result
+	(*PricesProofRequest)(nil),        // 6: band.base.oracle.v1.PricesProofRequest
+	(*PricesProofResponse)(nil),       // 7: band.base.oracle.v1.PricesProofResponse
+	(*SingleProofResult)(nil),         // 8: band.base.oracle.v1.SingleProofResult
+	(*MultiProofResult)(nil),          // 9: band.base.oracle.v1.MultiProofResult
+	(*CountProofResult)(nil),          // 10: band.base.oracle.v1.CountProofResult
+	(*PricesProofResult)(nil),         // 11: band.base.oracle.v1.PricesProofResult
+	(*SingleProof)(nil),               // 12: band.base.oracle.v1.SingleProof
+	(*MultiProof)(nil),                // 13: band.base.oracle.v1.MultiProof
+	(*CountProof)(nil),                // 14: band.base.oracle.v1.CountProof
+	(*PricesProof)(nil),               // 15: band.base.oracle.v1.PricesProof
+	(*PriceProof)(nil),                // 16: band.base.oracle.v1.PriceProof
+	(*OracleDataProof)(nil),           // 17: band.base.oracle.v1.OracleDataProof
+	(*IAVLMerklePath)(nil),            // 18: band.base.oracle.v1.IAVLMerklePath
+	(*BlockRelayProof)(nil),           // 19: band.base.oracle.v1.BlockRelayProof
+	(*MultiStoreProof)(nil),           // 20: band.base.oracle.v1.MultiStoreProof
+	(*FeedsMultiStoreProof)(nil),      // 21: band.base.oracle.v1.FeedsMultiStoreProof
+	(*BlockHeaderMerkleParts)(nil),    // 22: band.base.oracle.v1.BlockHeaderMerkleParts
+	(*CommonEncodedVotePart)(nil),     // 23: band.base.oracle.v1.CommonEncodedVotePart
+	(*TMSignature)(nil),               // 24: band.base.oracle.v1.TMSignature
+	(*RequestsCountProof)(nil),        // 25: band.base.oracle.v1.RequestsCountProof
+	(*v1beta1.Price)(nil),             // 26: band.feeds.v1beta1.Price
+	(*v1.Result)(nil),                 // 27: band.oracle.v1.Result
 }
 var file_band_base_oracle_v1_proof_proto_depIdxs = []int32{
-	6,  // 0: band.base.oracle.v1.ProofResponse.result:type_name -> band.base.oracle.v1.SingleProofResult
-	7,  // 1: band.base.oracle.v1.MultiProofResponse.result:type_name -> band.base.oracle.v1.MultiProofResult
-	8,  // 2: band.base.oracle.v1.RequestCountProofResponse.result:type_name -> band.base.oracle.v1.CountProofResult
-	9,  // 3: band.base.oracle.v1.SingleProofResult.proof:type_name -> band.base.oracle.v1.SingleProof
-	10, // 4: band.base.oracle.v1.MultiProofResult.proof:type_name -> band.base.oracle.v1.MultiProof
-	11, // 5: band.base.oracle.v1.CountProofResult.proof:type_name -> band.base.oracle.v1.CountProof
-	12, // 6: band.base.oracle.v1.SingleProof.oracle_data_proof:type_name -> band.base.oracle.v1.OracleDataProof
-	14, // 7: band.base.oracle.v1.SingleProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
-	12, // 8: band.base.oracle.v1.MultiProof.oracle_data_multi_proof:type_name -> band.base.oracle.v1.OracleDataProof
-	14, // 9: band.base.oracle.v1.MultiProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
-	19, // 10: band.base.oracle.v1.CountProof.count_proof:type_name -> band.base.oracle.v1.RequestsCountProof
-	14, // 11: band.base.oracle.v1.CountProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
-	20, // 12: band.base.oracle.v1.OracleDataProof.result:type_name -> band.oracle.v1.Result
-	13, // 13: band.base.oracle.v1.OracleDataProof.merkle_paths:type_name -> band.base.oracle.v1.IAVLMerklePath
-	15, // 14: band.base.oracle.v1.BlockRelayProof.multi_store_proof:type_name -> band.base.oracle.v1.MultiStoreProof
-	16, // 15: band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts:type_name -> band.base.oracle.v1.BlockHeaderMerkleParts
-	17, // 16: band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part:type_name -> band.base.oracle.v1.CommonEncodedVotePart
-	18, // 17: band.base.oracle.v1.BlockRelayProof.signatures:type_name -> band.base.oracle.v1.TMSignature
-	13, // 18: band.base.oracle.v1.RequestsCountProof.merkle_paths:type_name -> band.base.oracle.v1.IAVLMerklePath
-	0,  // 19: band.base.oracle.v1.Service.Proof:input_type -> band.base.oracle.v1.ProofRequest
-	2,  // 20: band.base.oracle.v1.Service.MultiProof:input_type -> band.base.oracle.v1.MultiProofRequest
-	4,  // 21: band.base.oracle.v1.Service.RequestCountProof:input_type -> band.base.oracle.v1.RequestCountProofRequest
-	1,  // 22: band.base.oracle.v1.Service.Proof:output_type -> band.base.oracle.v1.ProofResponse
-	3,  // 23: band.base.oracle.v1.Service.MultiProof:output_type -> band.base.oracle.v1.MultiProofResponse
-	5,  // 24: band.base.oracle.v1.Service.RequestCountProof:output_type -> band.base.oracle.v1.RequestCountProofResponse
-	22, // [22:25] is the sub-list for method output_type
-	19, // [19:22] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	8,  // 0: band.base.oracle.v1.ProofResponse.result:type_name -> band.base.oracle.v1.SingleProofResult
+	9,  // 1: band.base.oracle.v1.MultiProofResponse.result:type_name -> band.base.oracle.v1.MultiProofResult
+	10, // 2: band.base.oracle.v1.RequestCountProofResponse.result:type_name -> band.base.oracle.v1.CountProofResult
+	11, // 3: band.base.oracle.v1.PricesProofResponse.result:type_name -> band.base.oracle.v1.PricesProofResult
+	12, // 4: band.base.oracle.v1.SingleProofResult.proof:type_name -> band.base.oracle.v1.SingleProof
+	13, // 5: band.base.oracle.v1.MultiProofResult.proof:type_name -> band.base.oracle.v1.MultiProof
+	14, // 6: band.base.oracle.v1.CountProofResult.proof:type_name -> band.base.oracle.v1.CountProof
+	15, // 7: band.base.oracle.v1.PricesProofResult.proof:type_name -> band.base.oracle.v1.PricesProof
+	17, // 8: band.base.oracle.v1.SingleProof.oracle_data_proof:type_name -> band.base.oracle.v1.OracleDataProof
+	19, // 9: band.base.oracle.v1.SingleProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
+	17, // 10: band.base.oracle.v1.MultiProof.oracle_data_multi_proof:type_name -> band.base.oracle.v1.OracleDataProof
+	19, // 11: band.base.oracle.v1.MultiProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
+	25, // 12: band.base.oracle.v1.CountProof.count_proof:type_name -> band.base.oracle.v1.RequestsCountProof
+	19, // 13: band.base.oracle.v1.CountProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
+	21, // 14: band.base.oracle.v1.PricesProof.feeds_multi_store_proof:type_name -> band.base.oracle.v1.FeedsMultiStoreProof
+	16, // 15: band.base.oracle.v1.PricesProof.price_proofs:type_name -> band.base.oracle.v1.PriceProof
+	19, // 16: band.base.oracle.v1.PricesProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
+	26, // 17: band.base.oracle.v1.PriceProof.price:type_name -> band.feeds.v1beta1.Price
+	18, // 18: band.base.oracle.v1.PriceProof.merkle_paths:type_name -> band.base.oracle.v1.IAVLMerklePath
+	27, // 19: band.base.oracle.v1.OracleDataProof.result:type_name -> band.oracle.v1.Result
+	18, // 20: band.base.oracle.v1.OracleDataProof.merkle_paths:type_name -> band.base.oracle.v1.IAVLMerklePath
+	20, // 21: band.base.oracle.v1.BlockRelayProof.multi_store_proof:type_name -> band.base.oracle.v1.MultiStoreProof
+	22, // 22: band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts:type_name -> band.base.oracle.v1.BlockHeaderMerkleParts
+	23, // 23: band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part:type_name -> band.base.oracle.v1.CommonEncodedVotePart
+	24, // 24: band.base.oracle.v1.BlockRelayProof.signatures:type_name -> band.base.oracle.v1.TMSignature
+	18, // 25: band.base.oracle.v1.RequestsCountProof.merkle_paths:type_name -> band.base.oracle.v1.IAVLMerklePath
+	0,  // 26: band.base.oracle.v1.Service.Proof:input_type -> band.base.oracle.v1.ProofRequest
+	2,  // 27: band.base.oracle.v1.Service.MultiProof:input_type -> band.base.oracle.v1.MultiProofRequest
+	4,  // 28: band.base.oracle.v1.Service.RequestCountProof:input_type -> band.base.oracle.v1.RequestCountProofRequest
+	6,  // 29: band.base.oracle.v1.Service.PricesProof:input_type -> band.base.oracle.v1.PricesProofRequest
+	1,  // 30: band.base.oracle.v1.Service.Proof:output_type -> band.base.oracle.v1.ProofResponse
+	3,  // 31: band.base.oracle.v1.Service.MultiProof:output_type -> band.base.oracle.v1.MultiProofResponse
+	5,  // 32: band.base.oracle.v1.Service.RequestCountProof:output_type -> band.base.oracle.v1.RequestCountProofResponse
+	7,  // 33: band.base.oracle.v1.Service.PricesProof:output_type -> band.base.oracle.v1.PricesProofResponse
+	30, // [30:34] is the sub-list for method output_type
+	26, // [26:30] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_band_base_oracle_v1_proof_proto_init() }
@@ -12888,7 +16930,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleProofResult); i {
+			switch v := v.(*PricesProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12900,7 +16942,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiProofResult); i {
+			switch v := v.(*PricesProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12912,7 +16954,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountProofResult); i {
+			switch v := v.(*SingleProofResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12924,7 +16966,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleProof); i {
+			switch v := v.(*MultiProofResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12936,7 +16978,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiProof); i {
+			switch v := v.(*CountProofResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12948,7 +16990,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountProof); i {
+			switch v := v.(*PricesProofResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12960,7 +17002,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleDataProof); i {
+			switch v := v.(*SingleProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12972,7 +17014,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IAVLMerklePath); i {
+			switch v := v.(*MultiProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12984,7 +17026,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockRelayProof); i {
+			switch v := v.(*CountProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12996,7 +17038,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiStoreProof); i {
+			switch v := v.(*PricesProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13008,7 +17050,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeaderMerkleParts); i {
+			switch v := v.(*PriceProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13020,7 +17062,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommonEncodedVotePart); i {
+			switch v := v.(*OracleDataProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13032,7 +17074,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TMSignature); i {
+			switch v := v.(*IAVLMerklePath); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13044,6 +17086,78 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockRelayProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiStoreProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedsMultiStoreProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeaderMerkleParts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommonEncodedVotePart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TMSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RequestsCountProof); i {
 			case 0:
 				return &v.state
@@ -13062,7 +17176,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_base_oracle_v1_proof_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const (
 	Service_Proof_FullMethodName             = "/band.base.oracle.v1.Service/Proof"
 	Service_MultiProof_FullMethodName        = "/band.base.oracle.v1.Service/MultiProof"
 	Service_RequestCountProof_FullMethodName = "/band.base.oracle.v1.Service/RequestCountProof"
+	Service_PricesProof_FullMethodName       = "/band.base.oracle.v1.Service/PricesProof"
 )
 
 // ServiceClient is the client API for Service service.
@@ -34,6 +35,8 @@ type ServiceClient interface {
 	MultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(ctx context.Context, in *RequestCountProofRequest, opts ...grpc.CallOption) (*RequestCountProofResponse, error)
+	// PricesProof queries the proof of the feeds prices for given list of signal IDs
+	PricesProof(ctx context.Context, in *PricesProofRequest, opts ...grpc.CallOption) (*PricesProofResponse, error)
 }
 
 type serviceClient struct {
@@ -71,6 +74,15 @@ func (c *serviceClient) RequestCountProof(ctx context.Context, in *RequestCountP
 	return out, nil
 }
 
+func (c *serviceClient) PricesProof(ctx context.Context, in *PricesProofRequest, opts ...grpc.CallOption) (*PricesProofResponse, error) {
+	out := new(PricesProofResponse)
+	err := c.cc.Invoke(ctx, Service_PricesProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -81,6 +93,8 @@ type ServiceServer interface {
 	MultiProof(context.Context, *MultiProofRequest) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(context.Context, *RequestCountProofRequest) (*RequestCountProofResponse, error)
+	// PricesProof queries the proof of the feeds prices for given list of signal IDs
+	PricesProof(context.Context, *PricesProofRequest) (*PricesProofResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -97,6 +111,9 @@ func (UnimplementedServiceServer) MultiProof(context.Context, *MultiProofRequest
 func (UnimplementedServiceServer) RequestCountProof(context.Context, *RequestCountProofRequest) (*RequestCountProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestCountProof not implemented")
 }
+func (UnimplementedServiceServer) PricesProof(context.Context, *PricesProofRequest) (*PricesProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PricesProof not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +181,24 @@ func _Service_RequestCountProof_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_PricesProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PricesProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PricesProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_PricesProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PricesProof(ctx, req.(*PricesProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +218,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestCountProof",
 			Handler:    _Service_RequestCountProof_Handler,
 		},
+		{
+			MethodName: "PricesProof",
+			Handler:    _Service_PricesProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/base/oracle/v1/proof.proto",
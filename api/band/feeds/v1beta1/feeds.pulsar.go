@@ -1041,29 +1041,29 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Feed           protoreflect.MessageDescriptor
-	fd_Feed_signal_id protoreflect.FieldDescriptor
-	fd_Feed_power     protoreflect.FieldDescriptor
-	fd_Feed_interval  protoreflect.FieldDescriptor
+	md_Delegation           protoreflect.MessageDescriptor
+	fd_Delegation_delegator protoreflect.FieldDescriptor
+	fd_Delegation_delegatee protoreflect.FieldDescriptor
+	fd_Delegation_power     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_Feed = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("Feed")
-	fd_Feed_signal_id = md_Feed.Fields().ByName("signal_id")
-	fd_Feed_power = md_Feed.Fields().ByName("power")
-	fd_Feed_interval = md_Feed.Fields().ByName("interval")
+	md_Delegation = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("Delegation")
+	fd_Delegation_delegator = md_Delegation.Fields().ByName("delegator")
+	fd_Delegation_delegatee = md_Delegation.Fields().ByName("delegatee")
+	fd_Delegation_power = md_Delegation.Fields().ByName("power")
 }
 
-var _ protoreflect.Message = (*fastReflection_Feed)(nil)
+var _ protoreflect.Message = (*fastReflection_Delegation)(nil)
 
-type fastReflection_Feed Feed
+type fastReflection_Delegation Delegation
 
-func (x *Feed) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Feed)(x)
+func (x *Delegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Delegation)(x)
 }
 
-func (x *Feed) slowProtoReflect() protoreflect.Message {
+func (x *Delegation) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1075,43 +1075,43 @@ func (x *Feed) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Feed_messageType fastReflection_Feed_messageType
-var _ protoreflect.MessageType = fastReflection_Feed_messageType{}
+var _fastReflection_Delegation_messageType fastReflection_Delegation_messageType
+var _ protoreflect.MessageType = fastReflection_Delegation_messageType{}
 
-type fastReflection_Feed_messageType struct{}
+type fastReflection_Delegation_messageType struct{}
 
-func (x fastReflection_Feed_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Feed)(nil)
+func (x fastReflection_Delegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Delegation)(nil)
 }
-func (x fastReflection_Feed_messageType) New() protoreflect.Message {
-	return new(fastReflection_Feed)
+func (x fastReflection_Delegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_Delegation)
 }
-func (x fastReflection_Feed_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Feed
+func (x fastReflection_Delegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Delegation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Feed) Descriptor() protoreflect.MessageDescriptor {
-	return md_Feed
+func (x *fastReflection_Delegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_Delegation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Feed) Type() protoreflect.MessageType {
-	return _fastReflection_Feed_messageType
+func (x *fastReflection_Delegation) Type() protoreflect.MessageType {
+	return _fastReflection_Delegation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Feed) New() protoreflect.Message {
-	return new(fastReflection_Feed)
+func (x *fastReflection_Delegation) New() protoreflect.Message {
+	return new(fastReflection_Delegation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Feed) Interface() protoreflect.ProtoMessage {
-	return (*Feed)(x)
+func (x *fastReflection_Delegation) Interface() protoreflect.ProtoMessage {
+	return (*Delegation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1119,22 +1119,22 @@ func (x *fastReflection_Feed) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Feed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SignalId != "" {
-		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_Feed_signal_id, value) {
+func (x *fastReflection_Delegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_Delegation_delegator, value) {
 			return
 		}
 	}
-	if x.Power != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Power)
-		if !f(fd_Feed_power, value) {
+	if x.Delegatee != "" {
+		value := protoreflect.ValueOfString(x.Delegatee)
+		if !f(fd_Delegation_delegatee, value) {
 			return
 		}
 	}
-	if x.Interval != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Interval)
-		if !f(fd_Feed_interval, value) {
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_Delegation_power, value) {
 			return
 		}
 	}
@@ -1151,19 +1151,19 @@ func (x *fastReflection_Feed) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Feed) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Delegation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
-		return x.SignalId != ""
-	case "band.feeds.v1beta1.Feed.power":
+	case "band.feeds.v1beta1.Delegation.delegator":
+		return x.Delegator != ""
+	case "band.feeds.v1beta1.Delegation.delegatee":
+		return x.Delegatee != ""
+	case "band.feeds.v1beta1.Delegation.power":
 		return x.Power != int64(0)
-	case "band.feeds.v1beta1.Feed.interval":
-		return x.Interval != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Delegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Delegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1173,19 +1173,19 @@ func (x *fastReflection_Feed) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Feed) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Delegation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
-		x.SignalId = ""
-	case "band.feeds.v1beta1.Feed.power":
+	case "band.feeds.v1beta1.Delegation.delegator":
+		x.Delegator = ""
+	case "band.feeds.v1beta1.Delegation.delegatee":
+		x.Delegatee = ""
+	case "band.feeds.v1beta1.Delegation.power":
 		x.Power = int64(0)
-	case "band.feeds.v1beta1.Feed.interval":
-		x.Interval = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Delegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Delegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1195,22 +1195,22 @@ func (x *fastReflection_Feed) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Feed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Delegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
-		value := x.SignalId
+	case "band.feeds.v1beta1.Delegation.delegator":
+		value := x.Delegator
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.Feed.power":
+	case "band.feeds.v1beta1.Delegation.delegatee":
+		value := x.Delegatee
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.Delegation.power":
 		value := x.Power
 		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.Feed.interval":
-		value := x.Interval
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Delegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Delegation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1224,19 +1224,19 @@ func (x *fastReflection_Feed) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Feed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Delegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
-		x.SignalId = value.Interface().(string)
-	case "band.feeds.v1beta1.Feed.power":
+	case "band.feeds.v1beta1.Delegation.delegator":
+		x.Delegator = value.Interface().(string)
+	case "band.feeds.v1beta1.Delegation.delegatee":
+		x.Delegatee = value.Interface().(string)
+	case "band.feeds.v1beta1.Delegation.power":
 		x.Power = value.Int()
-	case "band.feeds.v1beta1.Feed.interval":
-		x.Interval = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Delegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Delegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1250,48 +1250,48 @@ func (x *fastReflection_Feed) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Feed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Delegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.Feed is not mutable"))
-	case "band.feeds.v1beta1.Feed.power":
-		panic(fmt.Errorf("field power of message band.feeds.v1beta1.Feed is not mutable"))
-	case "band.feeds.v1beta1.Feed.interval":
-		panic(fmt.Errorf("field interval of message band.feeds.v1beta1.Feed is not mutable"))
+	case "band.feeds.v1beta1.Delegation.delegator":
+		panic(fmt.Errorf("field delegator of message band.feeds.v1beta1.Delegation is not mutable"))
+	case "band.feeds.v1beta1.Delegation.delegatee":
+		panic(fmt.Errorf("field delegatee of message band.feeds.v1beta1.Delegation is not mutable"))
+	case "band.feeds.v1beta1.Delegation.power":
+		panic(fmt.Errorf("field power of message band.feeds.v1beta1.Delegation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Delegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Delegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Feed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Delegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Feed.signal_id":
+	case "band.feeds.v1beta1.Delegation.delegator":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.Feed.power":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.Feed.interval":
+	case "band.feeds.v1beta1.Delegation.delegatee":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.Delegation.power":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Delegation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Delegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Feed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Delegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.Feed", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.Delegation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1299,7 +1299,7 @@ func (x *fastReflection_Feed) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Feed) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Delegation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1310,7 +1310,7 @@ func (x *fastReflection_Feed) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Feed) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Delegation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1322,7 +1322,7 @@ func (x *fastReflection_Feed) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Feed) IsValid() bool {
+func (x *fastReflection_Delegation) IsValid() bool {
 	return x != nil
 }
 
@@ -1332,9 +1332,9 @@ func (x *fastReflection_Feed) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Delegation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Feed)
+		x := input.Message.Interface().(*Delegation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1346,16 +1346,17 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.SignalId)
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegatee)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Power != 0 {
 			n += 1 + runtime.Sov(uint64(x.Power))
 		}
-		if x.Interval != 0 {
-			n += 1 + runtime.Sov(uint64(x.Interval))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1366,7 +1367,7 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Feed)
+		x := input.Message.Interface().(*Delegation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1385,20 +1386,22 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Interval != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.Power != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+		if len(x.Delegatee) > 0 {
+			i -= len(x.Delegatee)
+			copy(dAtA[i:], x.Delegatee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegatee)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.SignalId) > 0 {
-			i -= len(x.SignalId)
-			copy(dAtA[i:], x.SignalId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1413,7 +1416,7 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Feed)
+		x := input.Message.Interface().(*Delegation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1445,15 +1448,15 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Feed: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Delegation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Feed: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1481,13 +1484,13 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignalId = string(dAtA[iNdEx:postIndex])
+				x.Delegator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
 				}
-				x.Power = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1497,16 +1500,29 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Power |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegatee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				x.Interval = 0
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1516,7 +1532,7 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Interval |= int64(b&0x7F) << shift
+					x.Power |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1557,31 +1573,31 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_FeedWithDeviation                       protoreflect.MessageDescriptor
-	fd_FeedWithDeviation_signal_id             protoreflect.FieldDescriptor
-	fd_FeedWithDeviation_power                 protoreflect.FieldDescriptor
-	fd_FeedWithDeviation_interval              protoreflect.FieldDescriptor
-	fd_FeedWithDeviation_deviation_basis_point protoreflect.FieldDescriptor
+	md_CircuitBreaker                 protoreflect.MessageDescriptor
+	fd_CircuitBreaker_signal_id       protoreflect.FieldDescriptor
+	fd_CircuitBreaker_reference_price protoreflect.FieldDescriptor
+	fd_CircuitBreaker_halted_price    protoreflect.FieldDescriptor
+	fd_CircuitBreaker_halted_at       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_FeedWithDeviation = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("FeedWithDeviation")
-	fd_FeedWithDeviation_signal_id = md_FeedWithDeviation.Fields().ByName("signal_id")
-	fd_FeedWithDeviation_power = md_FeedWithDeviation.Fields().ByName("power")
-	fd_FeedWithDeviation_interval = md_FeedWithDeviation.Fields().ByName("interval")
-	fd_FeedWithDeviation_deviation_basis_point = md_FeedWithDeviation.Fields().ByName("deviation_basis_point")
+	md_CircuitBreaker = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CircuitBreaker")
+	fd_CircuitBreaker_signal_id = md_CircuitBreaker.Fields().ByName("signal_id")
+	fd_CircuitBreaker_reference_price = md_CircuitBreaker.Fields().ByName("reference_price")
+	fd_CircuitBreaker_halted_price = md_CircuitBreaker.Fields().ByName("halted_price")
+	fd_CircuitBreaker_halted_at = md_CircuitBreaker.Fields().ByName("halted_at")
 }
 
-var _ protoreflect.Message = (*fastReflection_FeedWithDeviation)(nil)
+var _ protoreflect.Message = (*fastReflection_CircuitBreaker)(nil)
 
-type fastReflection_FeedWithDeviation FeedWithDeviation
+type fastReflection_CircuitBreaker CircuitBreaker
 
-func (x *FeedWithDeviation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_FeedWithDeviation)(x)
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(x)
 }
 
-func (x *FeedWithDeviation) slowProtoReflect() protoreflect.Message {
+func (x *CircuitBreaker) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1593,43 +1609,43 @@ func (x *FeedWithDeviation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_FeedWithDeviation_messageType fastReflection_FeedWithDeviation_messageType
-var _ protoreflect.MessageType = fastReflection_FeedWithDeviation_messageType{}
+var _fastReflection_CircuitBreaker_messageType fastReflection_CircuitBreaker_messageType
+var _ protoreflect.MessageType = fastReflection_CircuitBreaker_messageType{}
 
-type fastReflection_FeedWithDeviation_messageType struct{}
+type fastReflection_CircuitBreaker_messageType struct{}
 
-func (x fastReflection_FeedWithDeviation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_FeedWithDeviation)(nil)
+func (x fastReflection_CircuitBreaker_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(nil)
 }
-func (x fastReflection_FeedWithDeviation_messageType) New() protoreflect.Message {
-	return new(fastReflection_FeedWithDeviation)
+func (x fastReflection_CircuitBreaker_messageType) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
 }
-func (x fastReflection_FeedWithDeviation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeedWithDeviation
+func (x fastReflection_CircuitBreaker_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_FeedWithDeviation) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeedWithDeviation
+func (x *fastReflection_CircuitBreaker) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_FeedWithDeviation) Type() protoreflect.MessageType {
-	return _fastReflection_FeedWithDeviation_messageType
+func (x *fastReflection_CircuitBreaker) Type() protoreflect.MessageType {
+	return _fastReflection_CircuitBreaker_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_FeedWithDeviation) New() protoreflect.Message {
-	return new(fastReflection_FeedWithDeviation)
+func (x *fastReflection_CircuitBreaker) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_FeedWithDeviation) Interface() protoreflect.ProtoMessage {
-	return (*FeedWithDeviation)(x)
+func (x *fastReflection_CircuitBreaker) Interface() protoreflect.ProtoMessage {
+	return (*CircuitBreaker)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1637,28 +1653,28 @@ func (x *fastReflection_FeedWithDeviation) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_FeedWithDeviation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_CircuitBreaker) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.SignalId != "" {
 		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_FeedWithDeviation_signal_id, value) {
+		if !f(fd_CircuitBreaker_signal_id, value) {
 			return
 		}
 	}
-	if x.Power != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Power)
-		if !f(fd_FeedWithDeviation_power, value) {
+	if x.ReferencePrice != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReferencePrice)
+		if !f(fd_CircuitBreaker_reference_price, value) {
 			return
 		}
 	}
-	if x.Interval != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Interval)
-		if !f(fd_FeedWithDeviation_interval, value) {
+	if x.HaltedPrice != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HaltedPrice)
+		if !f(fd_CircuitBreaker_halted_price, value) {
 			return
 		}
 	}
-	if x.DeviationBasisPoint != int64(0) {
-		value := protoreflect.ValueOfInt64(x.DeviationBasisPoint)
-		if !f(fd_FeedWithDeviation_deviation_basis_point, value) {
+	if x.HaltedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.HaltedAt)
+		if !f(fd_CircuitBreaker_halted_at, value) {
 			return
 		}
 	}
@@ -1675,21 +1691,21 @@ func (x *fastReflection_FeedWithDeviation) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_FeedWithDeviation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CircuitBreaker) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+	case "band.feeds.v1beta1.CircuitBreaker.signal_id":
 		return x.SignalId != ""
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
-		return x.Power != int64(0)
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		return x.Interval != int64(0)
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
-		return x.DeviationBasisPoint != int64(0)
+	case "band.feeds.v1beta1.CircuitBreaker.reference_price":
+		return x.ReferencePrice != uint64(0)
+	case "band.feeds.v1beta1.CircuitBreaker.halted_price":
+		return x.HaltedPrice != uint64(0)
+	case "band.feeds.v1beta1.CircuitBreaker.halted_at":
+		return x.HaltedAt != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1699,21 +1715,21 @@ func (x *fastReflection_FeedWithDeviation) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedWithDeviation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CircuitBreaker) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+	case "band.feeds.v1beta1.CircuitBreaker.signal_id":
 		x.SignalId = ""
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
-		x.Power = int64(0)
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		x.Interval = int64(0)
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
-		x.DeviationBasisPoint = int64(0)
+	case "band.feeds.v1beta1.CircuitBreaker.reference_price":
+		x.ReferencePrice = uint64(0)
+	case "band.feeds.v1beta1.CircuitBreaker.halted_price":
+		x.HaltedPrice = uint64(0)
+	case "band.feeds.v1beta1.CircuitBreaker.halted_at":
+		x.HaltedAt = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1723,25 +1739,25 @@ func (x *fastReflection_FeedWithDeviation) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_FeedWithDeviation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CircuitBreaker) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+	case "band.feeds.v1beta1.CircuitBreaker.signal_id":
 		value := x.SignalId
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
-		value := x.Power
-		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		value := x.Interval
-		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
-		value := x.DeviationBasisPoint
+	case "band.feeds.v1beta1.CircuitBreaker.reference_price":
+		value := x.ReferencePrice
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.CircuitBreaker.halted_price":
+		value := x.HaltedPrice
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.CircuitBreaker.halted_at":
+		value := x.HaltedAt
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreaker does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1755,21 +1771,21 @@ func (x *fastReflection_FeedWithDeviation) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedWithDeviation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CircuitBreaker) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+	case "band.feeds.v1beta1.CircuitBreaker.signal_id":
 		x.SignalId = value.Interface().(string)
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
-		x.Power = value.Int()
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		x.Interval = value.Int()
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
-		x.DeviationBasisPoint = value.Int()
+	case "band.feeds.v1beta1.CircuitBreaker.reference_price":
+		x.ReferencePrice = value.Uint()
+	case "band.feeds.v1beta1.CircuitBreaker.halted_price":
+		x.HaltedPrice = value.Uint()
+	case "band.feeds.v1beta1.CircuitBreaker.halted_at":
+		x.HaltedAt = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1783,52 +1799,52 @@ func (x *fastReflection_FeedWithDeviation) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedWithDeviation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CircuitBreaker) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
-		panic(fmt.Errorf("field power of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		panic(fmt.Errorf("field interval of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
-		panic(fmt.Errorf("field deviation_basis_point of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
+	case "band.feeds.v1beta1.CircuitBreaker.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.CircuitBreaker is not mutable"))
+	case "band.feeds.v1beta1.CircuitBreaker.reference_price":
+		panic(fmt.Errorf("field reference_price of message band.feeds.v1beta1.CircuitBreaker is not mutable"))
+	case "band.feeds.v1beta1.CircuitBreaker.halted_price":
+		panic(fmt.Errorf("field halted_price of message band.feeds.v1beta1.CircuitBreaker is not mutable"))
+	case "band.feeds.v1beta1.CircuitBreaker.halted_at":
+		panic(fmt.Errorf("field halted_at of message band.feeds.v1beta1.CircuitBreaker is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_FeedWithDeviation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CircuitBreaker) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+	case "band.feeds.v1beta1.CircuitBreaker.signal_id":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.FeedWithDeviation.power":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.FeedWithDeviation.interval":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+	case "band.feeds.v1beta1.CircuitBreaker.reference_price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.CircuitBreaker.halted_price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.CircuitBreaker.halted_at":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_FeedWithDeviation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CircuitBreaker) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.FeedWithDeviation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CircuitBreaker", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1836,7 +1852,7 @@ func (x *fastReflection_FeedWithDeviation) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_FeedWithDeviation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CircuitBreaker) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1847,7 +1863,7 @@ func (x *fastReflection_FeedWithDeviation) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeedWithDeviation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CircuitBreaker) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1859,7 +1875,7 @@ func (x *fastReflection_FeedWithDeviation) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_FeedWithDeviation) IsValid() bool {
+func (x *fastReflection_CircuitBreaker) IsValid() bool {
 	return x != nil
 }
 
@@ -1869,9 +1885,9 @@ func (x *fastReflection_FeedWithDeviation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CircuitBreaker) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*FeedWithDeviation)
+		x := input.Message.Interface().(*CircuitBreaker)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1887,14 +1903,14 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Power != 0 {
-			n += 1 + runtime.Sov(uint64(x.Power))
+		if x.ReferencePrice != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReferencePrice))
 		}
-		if x.Interval != 0 {
-			n += 1 + runtime.Sov(uint64(x.Interval))
+		if x.HaltedPrice != 0 {
+			n += 1 + runtime.Sov(uint64(x.HaltedPrice))
 		}
-		if x.DeviationBasisPoint != 0 {
-			n += 1 + runtime.Sov(uint64(x.DeviationBasisPoint))
+		if x.HaltedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.HaltedAt))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1906,7 +1922,7 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*FeedWithDeviation)
+		x := input.Message.Interface().(*CircuitBreaker)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1925,18 +1941,18 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.DeviationBasisPoint != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationBasisPoint))
+		if x.HaltedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HaltedAt))
 			i--
 			dAtA[i] = 0x20
 		}
-		if x.Interval != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+		if x.HaltedPrice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HaltedPrice))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.Power != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+		if x.ReferencePrice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReferencePrice))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -1958,7 +1974,7 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*FeedWithDeviation)
+		x := input.Message.Interface().(*CircuitBreaker)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1990,10 +2006,10 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedWithDeviation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedWithDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2030,9 +2046,9 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
 				}
-				x.Power = 0
+				x.ReferencePrice = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2042,16 +2058,16 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Power |= int64(b&0x7F) << shift
+					x.ReferencePrice |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HaltedPrice", wireType)
 				}
-				x.Interval = 0
+				x.HaltedPrice = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2061,16 +2077,16 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Interval |= int64(b&0x7F) << shift
+					x.HaltedPrice |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeviationBasisPoint", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HaltedAt", wireType)
 				}
-				x.DeviationBasisPoint = 0
+				x.HaltedAt = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2080,7 +2096,7 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DeviationBasisPoint |= int64(b&0x7F) << shift
+					x.HaltedAt |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -2120,81 +2136,30 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_CurrentFeeds_1_list)(nil)
-
-type _CurrentFeeds_1_list struct {
-	list *[]*Feed
-}
-
-func (x *_CurrentFeeds_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_CurrentFeeds_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_CurrentFeeds_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Feed)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_CurrentFeeds_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Feed)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_CurrentFeeds_1_list) AppendMutable() protoreflect.Value {
-	v := new(Feed)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CurrentFeeds_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_CurrentFeeds_1_list) NewElement() protoreflect.Value {
-	v := new(Feed)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CurrentFeeds_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_CurrentFeeds                       protoreflect.MessageDescriptor
-	fd_CurrentFeeds_feeds                 protoreflect.FieldDescriptor
-	fd_CurrentFeeds_last_update_timestamp protoreflect.FieldDescriptor
-	fd_CurrentFeeds_last_update_block     protoreflect.FieldDescriptor
+	md_Feed           protoreflect.MessageDescriptor
+	fd_Feed_signal_id protoreflect.FieldDescriptor
+	fd_Feed_power     protoreflect.FieldDescriptor
+	fd_Feed_interval  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_CurrentFeeds = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CurrentFeeds")
-	fd_CurrentFeeds_feeds = md_CurrentFeeds.Fields().ByName("feeds")
-	fd_CurrentFeeds_last_update_timestamp = md_CurrentFeeds.Fields().ByName("last_update_timestamp")
-	fd_CurrentFeeds_last_update_block = md_CurrentFeeds.Fields().ByName("last_update_block")
+	md_Feed = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("Feed")
+	fd_Feed_signal_id = md_Feed.Fields().ByName("signal_id")
+	fd_Feed_power = md_Feed.Fields().ByName("power")
+	fd_Feed_interval = md_Feed.Fields().ByName("interval")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrentFeeds)(nil)
+var _ protoreflect.Message = (*fastReflection_Feed)(nil)
 
-type fastReflection_CurrentFeeds CurrentFeeds
+type fastReflection_Feed Feed
 
-func (x *CurrentFeeds) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrentFeeds)(x)
+func (x *Feed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Feed)(x)
 }
 
-func (x *CurrentFeeds) slowProtoReflect() protoreflect.Message {
+func (x *Feed) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2206,43 +2171,43 @@ func (x *CurrentFeeds) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrentFeeds_messageType fastReflection_CurrentFeeds_messageType
-var _ protoreflect.MessageType = fastReflection_CurrentFeeds_messageType{}
+var _fastReflection_Feed_messageType fastReflection_Feed_messageType
+var _ protoreflect.MessageType = fastReflection_Feed_messageType{}
 
-type fastReflection_CurrentFeeds_messageType struct{}
+type fastReflection_Feed_messageType struct{}
 
-func (x fastReflection_CurrentFeeds_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrentFeeds)(nil)
+func (x fastReflection_Feed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Feed)(nil)
 }
-func (x fastReflection_CurrentFeeds_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrentFeeds)
+func (x fastReflection_Feed_messageType) New() protoreflect.Message {
+	return new(fastReflection_Feed)
 }
-func (x fastReflection_CurrentFeeds_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrentFeeds
+func (x fastReflection_Feed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Feed
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrentFeeds) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrentFeeds
+func (x *fastReflection_Feed) Descriptor() protoreflect.MessageDescriptor {
+	return md_Feed
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrentFeeds) Type() protoreflect.MessageType {
-	return _fastReflection_CurrentFeeds_messageType
+func (x *fastReflection_Feed) Type() protoreflect.MessageType {
+	return _fastReflection_Feed_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrentFeeds) New() protoreflect.Message {
-	return new(fastReflection_CurrentFeeds)
+func (x *fastReflection_Feed) New() protoreflect.Message {
+	return new(fastReflection_Feed)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrentFeeds) Interface() protoreflect.ProtoMessage {
-	return (*CurrentFeeds)(x)
+func (x *fastReflection_Feed) Interface() protoreflect.ProtoMessage {
+	return (*Feed)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2250,22 +2215,22 @@ func (x *fastReflection_CurrentFeeds) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrentFeeds) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Feeds) != 0 {
-		value := protoreflect.ValueOfList(&_CurrentFeeds_1_list{list: &x.Feeds})
-		if !f(fd_CurrentFeeds_feeds, value) {
+func (x *fastReflection_Feed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_Feed_signal_id, value) {
 			return
 		}
 	}
-	if x.LastUpdateTimestamp != int64(0) {
-		value := protoreflect.ValueOfInt64(x.LastUpdateTimestamp)
-		if !f(fd_CurrentFeeds_last_update_timestamp, value) {
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_Feed_power, value) {
 			return
 		}
 	}
-	if x.LastUpdateBlock != int64(0) {
-		value := protoreflect.ValueOfInt64(x.LastUpdateBlock)
-		if !f(fd_CurrentFeeds_last_update_block, value) {
+	if x.Interval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Interval)
+		if !f(fd_Feed_interval, value) {
 			return
 		}
 	}
@@ -2282,19 +2247,19 @@ func (x *fastReflection_CurrentFeeds) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrentFeeds) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Feed) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		return len(x.Feeds) != 0
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
-		return x.LastUpdateTimestamp != int64(0)
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
-		return x.LastUpdateBlock != int64(0)
+	case "band.feeds.v1beta1.Feed.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.Feed.power":
+		return x.Power != int64(0)
+	case "band.feeds.v1beta1.Feed.interval":
+		return x.Interval != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2304,19 +2269,19 @@ func (x *fastReflection_CurrentFeeds) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeeds) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Feed) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		x.Feeds = nil
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
-		x.LastUpdateTimestamp = int64(0)
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
-		x.LastUpdateBlock = int64(0)
+	case "band.feeds.v1beta1.Feed.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.Feed.power":
+		x.Power = int64(0)
+	case "band.feeds.v1beta1.Feed.interval":
+		x.Interval = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2326,25 +2291,22 @@ func (x *fastReflection_CurrentFeeds) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrentFeeds) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Feed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		if len(x.Feeds) == 0 {
-			return protoreflect.ValueOfList(&_CurrentFeeds_1_list{})
-		}
-		listValue := &_CurrentFeeds_1_list{list: &x.Feeds}
-		return protoreflect.ValueOfList(listValue)
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
-		value := x.LastUpdateTimestamp
+	case "band.feeds.v1beta1.Feed.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.Feed.power":
+		value := x.Power
 		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
-		value := x.LastUpdateBlock
+	case "band.feeds.v1beta1.Feed.interval":
+		value := x.Interval
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2358,21 +2320,19 @@ func (x *fastReflection_CurrentFeeds) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeeds) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Feed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		lv := value.List()
-		clv := lv.(*_CurrentFeeds_1_list)
-		x.Feeds = *clv.list
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
-		x.LastUpdateTimestamp = value.Int()
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
-		x.LastUpdateBlock = value.Int()
+	case "band.feeds.v1beta1.Feed.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.Feed.power":
+		x.Power = value.Int()
+	case "band.feeds.v1beta1.Feed.interval":
+		x.Interval = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2386,53 +2346,48 @@ func (x *fastReflection_CurrentFeeds) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeeds) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Feed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		if x.Feeds == nil {
-			x.Feeds = []*Feed{}
-		}
-		value := &_CurrentFeeds_1_list{list: &x.Feeds}
-		return protoreflect.ValueOfList(value)
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
-		panic(fmt.Errorf("field last_update_timestamp of message band.feeds.v1beta1.CurrentFeeds is not mutable"))
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
-		panic(fmt.Errorf("field last_update_block of message band.feeds.v1beta1.CurrentFeeds is not mutable"))
+	case "band.feeds.v1beta1.Feed.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.Feed is not mutable"))
+	case "band.feeds.v1beta1.Feed.power":
+		panic(fmt.Errorf("field power of message band.feeds.v1beta1.Feed is not mutable"))
+	case "band.feeds.v1beta1.Feed.interval":
+		panic(fmt.Errorf("field interval of message band.feeds.v1beta1.Feed is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrentFeeds) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Feed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeeds.feeds":
-		list := []*Feed{}
-		return protoreflect.ValueOfList(&_CurrentFeeds_1_list{list: &list})
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+	case "band.feeds.v1beta1.Feed.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.Feed.power":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+	case "band.feeds.v1beta1.Feed.interval":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.Feed does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrentFeeds) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Feed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CurrentFeeds", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.Feed", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2440,7 +2395,7 @@ func (x *fastReflection_CurrentFeeds) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrentFeeds) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Feed) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2451,7 +2406,7 @@ func (x *fastReflection_CurrentFeeds) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeeds) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Feed) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2463,7 +2418,7 @@ func (x *fastReflection_CurrentFeeds) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrentFeeds) IsValid() bool {
+func (x *fastReflection_Feed) IsValid() bool {
 	return x != nil
 }
 
@@ -2473,9 +2428,9 @@ func (x *fastReflection_CurrentFeeds) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrentFeeds)
+		x := input.Message.Interface().(*Feed)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2487,17 +2442,15 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Feeds) > 0 {
-			for _, e := range x.Feeds {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.LastUpdateTimestamp != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastUpdateTimestamp))
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
 		}
-		if x.LastUpdateBlock != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastUpdateBlock))
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2509,7 +2462,7 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrentFeeds)
+		x := input.Message.Interface().(*Feed)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2528,31 +2481,22 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.LastUpdateBlock != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateBlock))
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.LastUpdateTimestamp != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateTimestamp))
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Feeds) > 0 {
-			for iNdEx := len(x.Feeds) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Feeds[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2565,7 +2509,7 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrentFeeds)
+		x := input.Message.Interface().(*Feed)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2597,17 +2541,17 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeeds: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Feed: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeeds: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Feed: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2617,31 +2561,29 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Feeds = append(x.Feeds, &Feed{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Feeds[len(x.Feeds)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				x.LastUpdateTimestamp = 0
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2651,16 +2593,16 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LastUpdateTimestamp |= int64(b&0x7F) << shift
+					x.Power |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 				}
-				x.LastUpdateBlock = 0
+				x.Interval = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2670,7 +2612,7 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LastUpdateBlock |= int64(b&0x7F) << shift
+					x.Interval |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -2710,81 +2652,32 @@ func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_CurrentFeedWithDeviations_1_list)(nil)
-
-type _CurrentFeedWithDeviations_1_list struct {
-	list *[]*FeedWithDeviation
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*FeedWithDeviation)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*FeedWithDeviation)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) AppendMutable() protoreflect.Value {
-	v := new(FeedWithDeviation)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) NewElement() protoreflect.Value {
-	v := new(FeedWithDeviation)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CurrentFeedWithDeviations_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_CurrentFeedWithDeviations                       protoreflect.MessageDescriptor
-	fd_CurrentFeedWithDeviations_feeds                 protoreflect.FieldDescriptor
-	fd_CurrentFeedWithDeviations_last_update_timestamp protoreflect.FieldDescriptor
-	fd_CurrentFeedWithDeviations_last_update_block     protoreflect.FieldDescriptor
+	md_FeedWithDeviation                       protoreflect.MessageDescriptor
+	fd_FeedWithDeviation_signal_id             protoreflect.FieldDescriptor
+	fd_FeedWithDeviation_power                 protoreflect.FieldDescriptor
+	fd_FeedWithDeviation_interval              protoreflect.FieldDescriptor
+	fd_FeedWithDeviation_deviation_basis_point protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_CurrentFeedWithDeviations = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CurrentFeedWithDeviations")
-	fd_CurrentFeedWithDeviations_feeds = md_CurrentFeedWithDeviations.Fields().ByName("feeds")
-	fd_CurrentFeedWithDeviations_last_update_timestamp = md_CurrentFeedWithDeviations.Fields().ByName("last_update_timestamp")
-	fd_CurrentFeedWithDeviations_last_update_block = md_CurrentFeedWithDeviations.Fields().ByName("last_update_block")
+	md_FeedWithDeviation = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("FeedWithDeviation")
+	fd_FeedWithDeviation_signal_id = md_FeedWithDeviation.Fields().ByName("signal_id")
+	fd_FeedWithDeviation_power = md_FeedWithDeviation.Fields().ByName("power")
+	fd_FeedWithDeviation_interval = md_FeedWithDeviation.Fields().ByName("interval")
+	fd_FeedWithDeviation_deviation_basis_point = md_FeedWithDeviation.Fields().ByName("deviation_basis_point")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrentFeedWithDeviations)(nil)
+var _ protoreflect.Message = (*fastReflection_FeedWithDeviation)(nil)
 
-type fastReflection_CurrentFeedWithDeviations CurrentFeedWithDeviations
+type fastReflection_FeedWithDeviation FeedWithDeviation
 
-func (x *CurrentFeedWithDeviations) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrentFeedWithDeviations)(x)
+func (x *FeedWithDeviation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeedWithDeviation)(x)
 }
 
-func (x *CurrentFeedWithDeviations) slowProtoReflect() protoreflect.Message {
+func (x *FeedWithDeviation) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2796,43 +2689,43 @@ func (x *CurrentFeedWithDeviations) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrentFeedWithDeviations_messageType fastReflection_CurrentFeedWithDeviations_messageType
-var _ protoreflect.MessageType = fastReflection_CurrentFeedWithDeviations_messageType{}
+var _fastReflection_FeedWithDeviation_messageType fastReflection_FeedWithDeviation_messageType
+var _ protoreflect.MessageType = fastReflection_FeedWithDeviation_messageType{}
 
-type fastReflection_CurrentFeedWithDeviations_messageType struct{}
+type fastReflection_FeedWithDeviation_messageType struct{}
 
-func (x fastReflection_CurrentFeedWithDeviations_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrentFeedWithDeviations)(nil)
+func (x fastReflection_FeedWithDeviation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeedWithDeviation)(nil)
 }
-func (x fastReflection_CurrentFeedWithDeviations_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrentFeedWithDeviations)
+func (x fastReflection_FeedWithDeviation_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeedWithDeviation)
 }
-func (x fastReflection_CurrentFeedWithDeviations_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrentFeedWithDeviations
+func (x fastReflection_FeedWithDeviation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeedWithDeviation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrentFeedWithDeviations) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrentFeedWithDeviations
+func (x *fastReflection_FeedWithDeviation) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeedWithDeviation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrentFeedWithDeviations) Type() protoreflect.MessageType {
-	return _fastReflection_CurrentFeedWithDeviations_messageType
+func (x *fastReflection_FeedWithDeviation) Type() protoreflect.MessageType {
+	return _fastReflection_FeedWithDeviation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrentFeedWithDeviations) New() protoreflect.Message {
-	return new(fastReflection_CurrentFeedWithDeviations)
+func (x *fastReflection_FeedWithDeviation) New() protoreflect.Message {
+	return new(fastReflection_FeedWithDeviation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrentFeedWithDeviations) Interface() protoreflect.ProtoMessage {
-	return (*CurrentFeedWithDeviations)(x)
+func (x *fastReflection_FeedWithDeviation) Interface() protoreflect.ProtoMessage {
+	return (*FeedWithDeviation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2840,27 +2733,33 @@ func (x *fastReflection_CurrentFeedWithDeviations) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrentFeedWithDeviations) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Feeds) != 0 {
-		value := protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{list: &x.Feeds})
-		if !f(fd_CurrentFeedWithDeviations_feeds, value) {
+func (x *fastReflection_FeedWithDeviation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_FeedWithDeviation_signal_id, value) {
 			return
 		}
 	}
-	if x.LastUpdateTimestamp != int64(0) {
-		value := protoreflect.ValueOfInt64(x.LastUpdateTimestamp)
-		if !f(fd_CurrentFeedWithDeviations_last_update_timestamp, value) {
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_FeedWithDeviation_power, value) {
 			return
 		}
 	}
-	if x.LastUpdateBlock != int64(0) {
-		value := protoreflect.ValueOfInt64(x.LastUpdateBlock)
-		if !f(fd_CurrentFeedWithDeviations_last_update_block, value) {
+	if x.Interval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Interval)
+		if !f(fd_FeedWithDeviation_interval, value) {
 			return
 		}
 	}
-}
-
+	if x.DeviationBasisPoint != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DeviationBasisPoint)
+		if !f(fd_FeedWithDeviation_deviation_basis_point, value) {
+			return
+		}
+	}
+}
+
 // Has reports whether a field is populated.
 //
 // Some fields have the property of nullability where it is possible to
@@ -2872,19 +2771,21 @@ func (x *fastReflection_CurrentFeedWithDeviations) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrentFeedWithDeviations) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FeedWithDeviation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		return len(x.Feeds) != 0
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
-		return x.LastUpdateTimestamp != int64(0)
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
-		return x.LastUpdateBlock != int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
+		return x.Power != int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		return x.Interval != int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+		return x.DeviationBasisPoint != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2894,19 +2795,21 @@ func (x *fastReflection_CurrentFeedWithDeviations) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeedWithDeviations) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeedWithDeviation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		x.Feeds = nil
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
-		x.LastUpdateTimestamp = int64(0)
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
-		x.LastUpdateBlock = int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
+		x.Power = int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		x.Interval = int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+		x.DeviationBasisPoint = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2916,25 +2819,25 @@ func (x *fastReflection_CurrentFeedWithDeviations) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrentFeedWithDeviations) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeedWithDeviation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		if len(x.Feeds) == 0 {
-			return protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{})
-		}
-		listValue := &_CurrentFeedWithDeviations_1_list{list: &x.Feeds}
-		return protoreflect.ValueOfList(listValue)
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
-		value := x.LastUpdateTimestamp
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
+		value := x.Power
 		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
-		value := x.LastUpdateBlock
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		value := x.Interval
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+		value := x.DeviationBasisPoint
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2948,21 +2851,21 @@ func (x *fastReflection_CurrentFeedWithDeviations) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeedWithDeviations) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeedWithDeviation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		lv := value.List()
-		clv := lv.(*_CurrentFeedWithDeviations_1_list)
-		x.Feeds = *clv.list
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
-		x.LastUpdateTimestamp = value.Int()
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
-		x.LastUpdateBlock = value.Int()
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
+		x.Power = value.Int()
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		x.Interval = value.Int()
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+		x.DeviationBasisPoint = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2976,53 +2879,52 @@ func (x *fastReflection_CurrentFeedWithDeviations) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeedWithDeviations) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeedWithDeviation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		if x.Feeds == nil {
-			x.Feeds = []*FeedWithDeviation{}
-		}
-		value := &_CurrentFeedWithDeviations_1_list{list: &x.Feeds}
-		return protoreflect.ValueOfList(value)
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
-		panic(fmt.Errorf("field last_update_timestamp of message band.feeds.v1beta1.CurrentFeedWithDeviations is not mutable"))
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
-		panic(fmt.Errorf("field last_update_block of message band.feeds.v1beta1.CurrentFeedWithDeviations is not mutable"))
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
+		panic(fmt.Errorf("field power of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		panic(fmt.Errorf("field interval of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
+		panic(fmt.Errorf("field deviation_basis_point of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrentFeedWithDeviations) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeedWithDeviation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
-		list := []*FeedWithDeviation{}
-		return protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{list: &list})
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+	case "band.feeds.v1beta1.FeedWithDeviation.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.FeedWithDeviation.power":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+	case "band.feeds.v1beta1.FeedWithDeviation.interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedWithDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrentFeedWithDeviations) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeedWithDeviation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CurrentFeedWithDeviations", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.FeedWithDeviation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3030,7 +2932,7 @@ func (x *fastReflection_CurrentFeedWithDeviations) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrentFeedWithDeviations) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeedWithDeviation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3041,7 +2943,7 @@ func (x *fastReflection_CurrentFeedWithDeviations) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrentFeedWithDeviations) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeedWithDeviation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3053,7 +2955,7 @@ func (x *fastReflection_CurrentFeedWithDeviations) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrentFeedWithDeviations) IsValid() bool {
+func (x *fastReflection_FeedWithDeviation) IsValid() bool {
 	return x != nil
 }
 
@@ -3063,9 +2965,9 @@ func (x *fastReflection_CurrentFeedWithDeviations) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrentFeedWithDeviations)
+		x := input.Message.Interface().(*FeedWithDeviation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3077,17 +2979,18 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if len(x.Feeds) > 0 {
-			for _, e := range x.Feeds {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.LastUpdateTimestamp != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastUpdateTimestamp))
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
 		}
-		if x.LastUpdateBlock != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastUpdateBlock))
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.DeviationBasisPoint != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeviationBasisPoint))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3099,7 +3002,7 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrentFeedWithDeviations)
+		x := input.Message.Interface().(*FeedWithDeviation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3118,31 +3021,27 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.LastUpdateBlock != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateBlock))
+		if x.DeviationBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationBasisPoint))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.LastUpdateTimestamp != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateTimestamp))
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Feeds) > 0 {
-			for iNdEx := len(x.Feeds) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Feeds[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3155,7 +3054,7 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrentFeedWithDeviations)
+		x := input.Message.Interface().(*FeedWithDeviation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3187,17 +3086,17 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeedWithDeviations: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedWithDeviation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeedWithDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedWithDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3207,31 +3106,29 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Feeds = append(x.Feeds, &FeedWithDeviation{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Feeds[len(x.Feeds)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				x.LastUpdateTimestamp = 0
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3241,16 +3138,16 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LastUpdateTimestamp |= int64(b&0x7F) << shift
+					x.Power |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 				}
-				x.LastUpdateBlock = 0
+				x.Interval = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3260,25 +3157,4059 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LastUpdateBlock |= int64(b&0x7F) << shift
+					x.Interval |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeviationBasisPoint", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.DeviationBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeviationBasisPoint |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_CurrentFeeds_1_list)(nil)
+
+type _CurrentFeeds_1_list struct {
+	list *[]*Feed
+}
+
+func (x *_CurrentFeeds_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CurrentFeeds_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CurrentFeeds_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Feed)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CurrentFeeds_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Feed)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CurrentFeeds_1_list) AppendMutable() protoreflect.Value {
+	v := new(Feed)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrentFeeds_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CurrentFeeds_1_list) NewElement() protoreflect.Value {
+	v := new(Feed)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrentFeeds_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CurrentFeeds                       protoreflect.MessageDescriptor
+	fd_CurrentFeeds_feeds                 protoreflect.FieldDescriptor
+	fd_CurrentFeeds_last_update_timestamp protoreflect.FieldDescriptor
+	fd_CurrentFeeds_last_update_block     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_CurrentFeeds = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CurrentFeeds")
+	fd_CurrentFeeds_feeds = md_CurrentFeeds.Fields().ByName("feeds")
+	fd_CurrentFeeds_last_update_timestamp = md_CurrentFeeds.Fields().ByName("last_update_timestamp")
+	fd_CurrentFeeds_last_update_block = md_CurrentFeeds.Fields().ByName("last_update_block")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrentFeeds)(nil)
+
+type fastReflection_CurrentFeeds CurrentFeeds
+
+func (x *CurrentFeeds) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrentFeeds)(x)
+}
+
+func (x *CurrentFeeds) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrentFeeds_messageType fastReflection_CurrentFeeds_messageType
+var _ protoreflect.MessageType = fastReflection_CurrentFeeds_messageType{}
+
+type fastReflection_CurrentFeeds_messageType struct{}
+
+func (x fastReflection_CurrentFeeds_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrentFeeds)(nil)
+}
+func (x fastReflection_CurrentFeeds_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrentFeeds)
+}
+func (x fastReflection_CurrentFeeds_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrentFeeds
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrentFeeds) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrentFeeds
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrentFeeds) Type() protoreflect.MessageType {
+	return _fastReflection_CurrentFeeds_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrentFeeds) New() protoreflect.Message {
+	return new(fastReflection_CurrentFeeds)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrentFeeds) Interface() protoreflect.ProtoMessage {
+	return (*CurrentFeeds)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrentFeeds) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Feeds) != 0 {
+		value := protoreflect.ValueOfList(&_CurrentFeeds_1_list{list: &x.Feeds})
+		if !f(fd_CurrentFeeds_feeds, value) {
+			return
+		}
+	}
+	if x.LastUpdateTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUpdateTimestamp)
+		if !f(fd_CurrentFeeds_last_update_timestamp, value) {
+			return
+		}
+	}
+	if x.LastUpdateBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUpdateBlock)
+		if !f(fd_CurrentFeeds_last_update_block, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrentFeeds) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		return len(x.Feeds) != 0
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		return x.LastUpdateTimestamp != int64(0)
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+		return x.LastUpdateBlock != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrentFeeds) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		x.Feeds = nil
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		x.LastUpdateTimestamp = int64(0)
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+		x.LastUpdateBlock = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrentFeeds) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		if len(x.Feeds) == 0 {
+			return protoreflect.ValueOfList(&_CurrentFeeds_1_list{})
+		}
+		listValue := &_CurrentFeeds_1_list{list: &x.Feeds}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		value := x.LastUpdateTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+		value := x.LastUpdateBlock
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrentFeeds) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		lv := value.List()
+		clv := lv.(*_CurrentFeeds_1_list)
+		x.Feeds = *clv.list
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		x.LastUpdateTimestamp = value.Int()
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+		x.LastUpdateBlock = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrentFeeds) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		if x.Feeds == nil {
+			x.Feeds = []*Feed{}
+		}
+		value := &_CurrentFeeds_1_list{list: &x.Feeds}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		panic(fmt.Errorf("field last_update_timestamp of message band.feeds.v1beta1.CurrentFeeds is not mutable"))
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+		panic(fmt.Errorf("field last_update_block of message band.feeds.v1beta1.CurrentFeeds is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrentFeeds) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CurrentFeeds.feeds":
+		list := []*Feed{}
+		return protoreflect.ValueOfList(&_CurrentFeeds_1_list{list: &list})
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.CurrentFeeds.last_update_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeeds"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeeds does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrentFeeds) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CurrentFeeds", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrentFeeds) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrentFeeds) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrentFeeds) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrentFeeds) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrentFeeds)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Feeds) > 0 {
+			for _, e := range x.Feeds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LastUpdateTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdateTimestamp))
+		}
+		if x.LastUpdateBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdateBlock))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrentFeeds)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastUpdateBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateBlock))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.LastUpdateTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateTimestamp))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Feeds) > 0 {
+			for iNdEx := len(x.Feeds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Feeds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrentFeeds)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeeds: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeeds: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Feeds = append(x.Feeds, &Feed{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Feeds[len(x.Feeds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+				}
+				x.LastUpdateTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdateTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+				}
+				x.LastUpdateBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdateBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_CurrentFeedWithDeviations_1_list)(nil)
+
+type _CurrentFeedWithDeviations_1_list struct {
+	list *[]*FeedWithDeviation
+}
+
+func (x *_CurrentFeedWithDeviations_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CurrentFeedWithDeviations_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CurrentFeedWithDeviations_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeedWithDeviation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CurrentFeedWithDeviations_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeedWithDeviation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CurrentFeedWithDeviations_1_list) AppendMutable() protoreflect.Value {
+	v := new(FeedWithDeviation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrentFeedWithDeviations_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CurrentFeedWithDeviations_1_list) NewElement() protoreflect.Value {
+	v := new(FeedWithDeviation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrentFeedWithDeviations_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CurrentFeedWithDeviations                       protoreflect.MessageDescriptor
+	fd_CurrentFeedWithDeviations_feeds                 protoreflect.FieldDescriptor
+	fd_CurrentFeedWithDeviations_last_update_timestamp protoreflect.FieldDescriptor
+	fd_CurrentFeedWithDeviations_last_update_block     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_CurrentFeedWithDeviations = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CurrentFeedWithDeviations")
+	fd_CurrentFeedWithDeviations_feeds = md_CurrentFeedWithDeviations.Fields().ByName("feeds")
+	fd_CurrentFeedWithDeviations_last_update_timestamp = md_CurrentFeedWithDeviations.Fields().ByName("last_update_timestamp")
+	fd_CurrentFeedWithDeviations_last_update_block = md_CurrentFeedWithDeviations.Fields().ByName("last_update_block")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrentFeedWithDeviations)(nil)
+
+type fastReflection_CurrentFeedWithDeviations CurrentFeedWithDeviations
+
+func (x *CurrentFeedWithDeviations) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrentFeedWithDeviations)(x)
+}
+
+func (x *CurrentFeedWithDeviations) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrentFeedWithDeviations_messageType fastReflection_CurrentFeedWithDeviations_messageType
+var _ protoreflect.MessageType = fastReflection_CurrentFeedWithDeviations_messageType{}
+
+type fastReflection_CurrentFeedWithDeviations_messageType struct{}
+
+func (x fastReflection_CurrentFeedWithDeviations_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrentFeedWithDeviations)(nil)
+}
+func (x fastReflection_CurrentFeedWithDeviations_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrentFeedWithDeviations)
+}
+func (x fastReflection_CurrentFeedWithDeviations_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrentFeedWithDeviations
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrentFeedWithDeviations) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrentFeedWithDeviations
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrentFeedWithDeviations) Type() protoreflect.MessageType {
+	return _fastReflection_CurrentFeedWithDeviations_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrentFeedWithDeviations) New() protoreflect.Message {
+	return new(fastReflection_CurrentFeedWithDeviations)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrentFeedWithDeviations) Interface() protoreflect.ProtoMessage {
+	return (*CurrentFeedWithDeviations)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrentFeedWithDeviations) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Feeds) != 0 {
+		value := protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{list: &x.Feeds})
+		if !f(fd_CurrentFeedWithDeviations_feeds, value) {
+			return
+		}
+	}
+	if x.LastUpdateTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUpdateTimestamp)
+		if !f(fd_CurrentFeedWithDeviations_last_update_timestamp, value) {
+			return
+		}
+	}
+	if x.LastUpdateBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUpdateBlock)
+		if !f(fd_CurrentFeedWithDeviations_last_update_block, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrentFeedWithDeviations) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		return len(x.Feeds) != 0
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		return x.LastUpdateTimestamp != int64(0)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		return x.LastUpdateBlock != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrentFeedWithDeviations) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		x.Feeds = nil
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		x.LastUpdateTimestamp = int64(0)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		x.LastUpdateBlock = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrentFeedWithDeviations) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		if len(x.Feeds) == 0 {
+			return protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{})
+		}
+		listValue := &_CurrentFeedWithDeviations_1_list{list: &x.Feeds}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		value := x.LastUpdateTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		value := x.LastUpdateBlock
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrentFeedWithDeviations) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		lv := value.List()
+		clv := lv.(*_CurrentFeedWithDeviations_1_list)
+		x.Feeds = *clv.list
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		x.LastUpdateTimestamp = value.Int()
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		x.LastUpdateBlock = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrentFeedWithDeviations) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		if x.Feeds == nil {
+			x.Feeds = []*FeedWithDeviation{}
+		}
+		value := &_CurrentFeedWithDeviations_1_list{list: &x.Feeds}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		panic(fmt.Errorf("field last_update_timestamp of message band.feeds.v1beta1.CurrentFeedWithDeviations is not mutable"))
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		panic(fmt.Errorf("field last_update_block of message band.feeds.v1beta1.CurrentFeedWithDeviations is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrentFeedWithDeviations) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.feeds":
+		list := []*FeedWithDeviation{}
+		return protoreflect.ValueOfList(&_CurrentFeedWithDeviations_1_list{list: &list})
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.CurrentFeedWithDeviations.last_update_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CurrentFeedWithDeviations"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CurrentFeedWithDeviations does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrentFeedWithDeviations) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CurrentFeedWithDeviations", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrentFeedWithDeviations) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrentFeedWithDeviations) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrentFeedWithDeviations) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrentFeedWithDeviations)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Feeds) > 0 {
+			for _, e := range x.Feeds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LastUpdateTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdateTimestamp))
+		}
+		if x.LastUpdateBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdateBlock))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrentFeedWithDeviations)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastUpdateBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateBlock))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.LastUpdateTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdateTimestamp))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Feeds) > 0 {
+			for iNdEx := len(x.Feeds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Feeds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrentFeedWithDeviations)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeedWithDeviations: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrentFeedWithDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Feeds = append(x.Feeds, &FeedWithDeviation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Feeds[len(x.Feeds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+				}
+				x.LastUpdateTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdateTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+				}
+				x.LastUpdateBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdateBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Price                    protoreflect.MessageDescriptor
+	fd_Price_status             protoreflect.FieldDescriptor
+	fd_Price_signal_id          protoreflect.FieldDescriptor
+	fd_Price_price              protoreflect.FieldDescriptor
+	fd_Price_timestamp          protoreflect.FieldDescriptor
+	fd_Price_aggregation_method protoreflect.FieldDescriptor
+	fd_Price_confidence         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_Price = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("Price")
+	fd_Price_status = md_Price.Fields().ByName("status")
+	fd_Price_signal_id = md_Price.Fields().ByName("signal_id")
+	fd_Price_price = md_Price.Fields().ByName("price")
+	fd_Price_timestamp = md_Price.Fields().ByName("timestamp")
+	fd_Price_aggregation_method = md_Price.Fields().ByName("aggregation_method")
+	fd_Price_confidence = md_Price.Fields().ByName("confidence")
+}
+
+var _ protoreflect.Message = (*fastReflection_Price)(nil)
+
+type fastReflection_Price Price
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Price)(x)
+}
+
+func (x *Price) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Price_messageType fastReflection_Price_messageType
+var _ protoreflect.MessageType = fastReflection_Price_messageType{}
+
+type fastReflection_Price_messageType struct{}
+
+func (x fastReflection_Price_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Price)(nil)
+}
+func (x fastReflection_Price_messageType) New() protoreflect.Message {
+	return new(fastReflection_Price)
+}
+func (x fastReflection_Price_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Price
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Price) Descriptor() protoreflect.MessageDescriptor {
+	return md_Price
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Price) Type() protoreflect.MessageType {
+	return _fastReflection_Price_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Price) New() protoreflect.Message {
+	return new(fastReflection_Price)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Price) Interface() protoreflect.ProtoMessage {
+	return (*Price)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Price) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Price_status, value) {
+			return
+		}
+	}
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_Price_signal_id, value) {
+			return
+		}
+	}
+	if x.Price != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Price)
+		if !f(fd_Price_price, value) {
+			return
+		}
+	}
+	if x.Timestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Timestamp)
+		if !f(fd_Price_timestamp, value) {
+			return
+		}
+	}
+	if x.AggregationMethod != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AggregationMethod))
+		if !f(fd_Price_aggregation_method, value) {
+			return
+		}
+	}
+	if x.Confidence != nil {
+		value := protoreflect.ValueOfMessage(x.Confidence.ProtoReflect())
+		if !f(fd_Price_confidence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Price) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.Price.status":
+		return x.Status != 0
+	case "band.feeds.v1beta1.Price.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.Price.price":
+		return x.Price != uint64(0)
+	case "band.feeds.v1beta1.Price.timestamp":
+		return x.Timestamp != int64(0)
+	case "band.feeds.v1beta1.Price.aggregation_method":
+		return x.AggregationMethod != 0
+	case "band.feeds.v1beta1.Price.confidence":
+		return x.Confidence != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Price) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.Price.status":
+		x.Status = 0
+	case "band.feeds.v1beta1.Price.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.Price.price":
+		x.Price = uint64(0)
+	case "band.feeds.v1beta1.Price.timestamp":
+		x.Timestamp = int64(0)
+	case "band.feeds.v1beta1.Price.aggregation_method":
+		x.AggregationMethod = 0
+	case "band.feeds.v1beta1.Price.confidence":
+		x.Confidence = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Price) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.Price.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.feeds.v1beta1.Price.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.Price.price":
+		value := x.Price
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.Price.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.Price.aggregation_method":
+		value := x.AggregationMethod
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.feeds.v1beta1.Price.confidence":
+		value := x.Confidence
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Price) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.Price.status":
+		x.Status = (PriceStatus)(value.Enum())
+	case "band.feeds.v1beta1.Price.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.Price.price":
+		x.Price = value.Uint()
+	case "band.feeds.v1beta1.Price.timestamp":
+		x.Timestamp = value.Int()
+	case "band.feeds.v1beta1.Price.aggregation_method":
+		x.AggregationMethod = (AggregationMethod)(value.Enum())
+	case "band.feeds.v1beta1.Price.confidence":
+		x.Confidence = value.Message().Interface().(*PriceConfidence)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Price) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.Price.confidence":
+		if x.Confidence == nil {
+			x.Confidence = new(PriceConfidence)
+		}
+		return protoreflect.ValueOfMessage(x.Confidence.ProtoReflect())
+	case "band.feeds.v1beta1.Price.status":
+		panic(fmt.Errorf("field status of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.Price.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.Price.price":
+		panic(fmt.Errorf("field price of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.Price.timestamp":
+		panic(fmt.Errorf("field timestamp of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.Price.aggregation_method":
+		panic(fmt.Errorf("field aggregation_method of message band.feeds.v1beta1.Price is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Price) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.Price.status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.feeds.v1beta1.Price.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.Price.price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.Price.timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.Price.aggregation_method":
+		return protoreflect.ValueOfEnum(0)
+	case "band.feeds.v1beta1.Price.confidence":
+		m := new(PriceConfidence)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Price) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.Price", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Price) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Price) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Price) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Price)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Price != 0 {
+			n += 1 + runtime.Sov(uint64(x.Price))
+		}
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
+		if x.AggregationMethod != 0 {
+			n += 1 + runtime.Sov(uint64(x.AggregationMethod))
+		}
+		if x.Confidence != nil {
+			l = options.Size(x.Confidence)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Price)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Confidence != nil {
+			encoded, err := options.Marshal(x.Confidence)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.AggregationMethod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AggregationMethod))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Price != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Price))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Price)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Price: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= PriceStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				x.Price = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Price |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+				}
+				x.AggregationMethod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Confidence == nil {
+					x.Confidence = &PriceConfidence{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Confidence); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceConfidence                 protoreflect.MessageDescriptor
+	fd_PriceConfidence_reporter_count  protoreflect.FieldDescriptor
+	fd_PriceConfidence_power_share_bps protoreflect.FieldDescriptor
+	fd_PriceConfidence_dispersion      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_PriceConfidence = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("PriceConfidence")
+	fd_PriceConfidence_reporter_count = md_PriceConfidence.Fields().ByName("reporter_count")
+	fd_PriceConfidence_power_share_bps = md_PriceConfidence.Fields().ByName("power_share_bps")
+	fd_PriceConfidence_dispersion = md_PriceConfidence.Fields().ByName("dispersion")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceConfidence)(nil)
+
+type fastReflection_PriceConfidence PriceConfidence
+
+func (x *PriceConfidence) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceConfidence)(x)
+}
+
+func (x *PriceConfidence) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceConfidence_messageType fastReflection_PriceConfidence_messageType
+var _ protoreflect.MessageType = fastReflection_PriceConfidence_messageType{}
+
+type fastReflection_PriceConfidence_messageType struct{}
+
+func (x fastReflection_PriceConfidence_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceConfidence)(nil)
+}
+func (x fastReflection_PriceConfidence_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceConfidence)
+}
+func (x fastReflection_PriceConfidence_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceConfidence
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceConfidence) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceConfidence
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceConfidence) Type() protoreflect.MessageType {
+	return _fastReflection_PriceConfidence_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceConfidence) New() protoreflect.Message {
+	return new(fastReflection_PriceConfidence)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceConfidence) Interface() protoreflect.ProtoMessage {
+	return (*PriceConfidence)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceConfidence) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReporterCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReporterCount)
+		if !f(fd_PriceConfidence_reporter_count, value) {
+			return
+		}
+	}
+	if x.PowerShareBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PowerShareBps)
+		if !f(fd_PriceConfidence_power_share_bps, value) {
+			return
+		}
+	}
+	if x.Dispersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Dispersion)
+		if !f(fd_PriceConfidence_dispersion, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceConfidence) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.PriceConfidence.reporter_count":
+		return x.ReporterCount != uint64(0)
+	case "band.feeds.v1beta1.PriceConfidence.power_share_bps":
+		return x.PowerShareBps != uint64(0)
+	case "band.feeds.v1beta1.PriceConfidence.dispersion":
+		return x.Dispersion != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceConfidence"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceConfidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceConfidence) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.PriceConfidence.reporter_count":
+		x.ReporterCount = uint64(0)
+	case "band.feeds.v1beta1.PriceConfidence.power_share_bps":
+		x.PowerShareBps = uint64(0)
+	case "band.feeds.v1beta1.PriceConfidence.dispersion":
+		x.Dispersion = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceConfidence"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceConfidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceConfidence) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.PriceConfidence.reporter_count":
+		value := x.ReporterCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.PriceConfidence.power_share_bps":
+		value := x.PowerShareBps
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.PriceConfidence.dispersion":
+		value := x.Dispersion
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceConfidence"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceConfidence does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceConfidence) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.PriceConfidence.reporter_count":
+		x.ReporterCount = value.Uint()
+	case "band.feeds.v1beta1.PriceConfidence.power_share_bps":
+		x.PowerShareBps = value.Uint()
+	case "band.feeds.v1beta1.PriceConfidence.dispersion":
+		x.Dispersion = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceConfidence"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceConfidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceConfidence) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.PriceConfidence.reporter_count":
+		panic(fmt.Errorf("field reporter_count of message band.feeds.v1beta1.PriceConfidence is not mutable"))
+	case "band.feeds.v1beta1.PriceConfidence.power_share_bps":
+		panic(fmt.Errorf("field power_share_bps of message band.feeds.v1beta1.PriceConfidence is not mutable"))
+	case "band.feeds.v1beta1.PriceConfidence.dispersion":
+		panic(fmt.Errorf("field dispersion of message band.feeds.v1beta1.PriceConfidence is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceConfidence"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceConfidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceConfidence) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.PriceConfidence.reporter_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.PriceConfidence.power_share_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.PriceConfidence.dispersion":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.PriceConfidence"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.PriceConfidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceConfidence) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.PriceConfidence", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceConfidence) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceConfidence) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceConfidence) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceConfidence) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceConfidence)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ReporterCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReporterCount))
+		}
+		if x.PowerShareBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.PowerShareBps))
+		}
+		if x.Dispersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.Dispersion))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceConfidence)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Dispersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Dispersion))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PowerShareBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PowerShareBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ReporterCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReporterCount))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceConfidence)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceConfidence: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceConfidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReporterCount", wireType)
+				}
+				x.ReporterCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReporterCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PowerShareBps", wireType)
+				}
+				x.PowerShareBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PowerShareBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
+				}
+				x.Dispersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Dispersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SignalAggregationMethod               protoreflect.MessageDescriptor
+	fd_SignalAggregationMethod_signal_id     protoreflect.FieldDescriptor
+	fd_SignalAggregationMethod_method        protoreflect.FieldDescriptor
+	fd_SignalAggregationMethod_min_reporters protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_SignalAggregationMethod = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("SignalAggregationMethod")
+	fd_SignalAggregationMethod_signal_id = md_SignalAggregationMethod.Fields().ByName("signal_id")
+	fd_SignalAggregationMethod_method = md_SignalAggregationMethod.Fields().ByName("method")
+	fd_SignalAggregationMethod_min_reporters = md_SignalAggregationMethod.Fields().ByName("min_reporters")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignalAggregationMethod)(nil)
+
+type fastReflection_SignalAggregationMethod SignalAggregationMethod
+
+func (x *SignalAggregationMethod) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignalAggregationMethod)(x)
+}
+
+func (x *SignalAggregationMethod) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignalAggregationMethod_messageType fastReflection_SignalAggregationMethod_messageType
+var _ protoreflect.MessageType = fastReflection_SignalAggregationMethod_messageType{}
+
+type fastReflection_SignalAggregationMethod_messageType struct{}
+
+func (x fastReflection_SignalAggregationMethod_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignalAggregationMethod)(nil)
+}
+func (x fastReflection_SignalAggregationMethod_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignalAggregationMethod)
+}
+func (x fastReflection_SignalAggregationMethod_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalAggregationMethod
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignalAggregationMethod) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalAggregationMethod
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignalAggregationMethod) Type() protoreflect.MessageType {
+	return _fastReflection_SignalAggregationMethod_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignalAggregationMethod) New() protoreflect.Message {
+	return new(fastReflection_SignalAggregationMethod)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignalAggregationMethod) Interface() protoreflect.ProtoMessage {
+	return (*SignalAggregationMethod)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignalAggregationMethod) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_SignalAggregationMethod_signal_id, value) {
+			return
+		}
+	}
+	if x.Method != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Method))
+		if !f(fd_SignalAggregationMethod_method, value) {
+			return
+		}
+	}
+	if x.MinReporters != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinReporters)
+		if !f(fd_SignalAggregationMethod_min_reporters, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignalAggregationMethod) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalAggregationMethod.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.SignalAggregationMethod.method":
+		return x.Method != 0
+	case "band.feeds.v1beta1.SignalAggregationMethod.min_reporters":
+		return x.MinReporters != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalAggregationMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalAggregationMethod) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalAggregationMethod.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.SignalAggregationMethod.method":
+		x.Method = 0
+	case "band.feeds.v1beta1.SignalAggregationMethod.min_reporters":
+		x.MinReporters = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalAggregationMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignalAggregationMethod) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.SignalAggregationMethod.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalAggregationMethod.method":
+		value := x.Method
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.feeds.v1beta1.SignalAggregationMethod.min_reporters":
+		value := x.MinReporters
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalAggregationMethod does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalAggregationMethod) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalAggregationMethod.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalAggregationMethod.method":
+		x.Method = (AggregationMethod)(value.Enum())
+	case "band.feeds.v1beta1.SignalAggregationMethod.min_reporters":
+		x.MinReporters = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalAggregationMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalAggregationMethod) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalAggregationMethod.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.SignalAggregationMethod is not mutable"))
+	case "band.feeds.v1beta1.SignalAggregationMethod.method":
+		panic(fmt.Errorf("field method of message band.feeds.v1beta1.SignalAggregationMethod is not mutable"))
+	case "band.feeds.v1beta1.SignalAggregationMethod.min_reporters":
+		panic(fmt.Errorf("field min_reporters of message band.feeds.v1beta1.SignalAggregationMethod is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalAggregationMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignalAggregationMethod) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalAggregationMethod.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalAggregationMethod.method":
+		return protoreflect.ValueOfEnum(0)
+	case "band.feeds.v1beta1.SignalAggregationMethod.min_reporters":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalAggregationMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignalAggregationMethod) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.SignalAggregationMethod", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignalAggregationMethod) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalAggregationMethod) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignalAggregationMethod) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignalAggregationMethod) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignalAggregationMethod)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Method != 0 {
+			n += 1 + runtime.Sov(uint64(x.Method))
+		}
+		if x.MinReporters != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinReporters))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignalAggregationMethod)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MinReporters != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinReporters))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Method != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Method))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignalAggregationMethod)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalAggregationMethod: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalAggregationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				}
+				x.Method = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Method |= AggregationMethod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinReporters", wireType)
+				}
+				x.MinReporters = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinReporters |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SignalPrice           protoreflect.MessageDescriptor
+	fd_SignalPrice_status    protoreflect.FieldDescriptor
+	fd_SignalPrice_signal_id protoreflect.FieldDescriptor
+	fd_SignalPrice_price     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_SignalPrice = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("SignalPrice")
+	fd_SignalPrice_status = md_SignalPrice.Fields().ByName("status")
+	fd_SignalPrice_signal_id = md_SignalPrice.Fields().ByName("signal_id")
+	fd_SignalPrice_price = md_SignalPrice.Fields().ByName("price")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignalPrice)(nil)
+
+type fastReflection_SignalPrice SignalPrice
+
+func (x *SignalPrice) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignalPrice)(x)
+}
+
+func (x *SignalPrice) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignalPrice_messageType fastReflection_SignalPrice_messageType
+var _ protoreflect.MessageType = fastReflection_SignalPrice_messageType{}
+
+type fastReflection_SignalPrice_messageType struct{}
+
+func (x fastReflection_SignalPrice_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignalPrice)(nil)
+}
+func (x fastReflection_SignalPrice_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignalPrice)
+}
+func (x fastReflection_SignalPrice_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalPrice
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignalPrice) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalPrice
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignalPrice) Type() protoreflect.MessageType {
+	return _fastReflection_SignalPrice_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignalPrice) New() protoreflect.Message {
+	return new(fastReflection_SignalPrice)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignalPrice) Interface() protoreflect.ProtoMessage {
+	return (*SignalPrice)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignalPrice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_SignalPrice_status, value) {
+			return
+		}
+	}
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_SignalPrice_signal_id, value) {
+			return
+		}
+	}
+	if x.Price != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Price)
+		if !f(fd_SignalPrice_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignalPrice) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalPrice.status":
+		return x.Status != 0
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.SignalPrice.price":
+		return x.Price != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalPrice) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalPrice.status":
+		x.Status = 0
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.SignalPrice.price":
+		x.Price = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignalPrice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.SignalPrice.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalPrice.price":
+		value := x.Price
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalPrice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalPrice.status":
+		x.Status = (SignalPriceStatus)(value.Enum())
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalPrice.price":
+		x.Price = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalPrice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalPrice.status":
+		panic(fmt.Errorf("field status of message band.feeds.v1beta1.SignalPrice is not mutable"))
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.SignalPrice is not mutable"))
+	case "band.feeds.v1beta1.SignalPrice.price":
+		panic(fmt.Errorf("field price of message band.feeds.v1beta1.SignalPrice is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignalPrice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.SignalPrice.status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.feeds.v1beta1.SignalPrice.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalPrice.price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignalPrice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.SignalPrice", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignalPrice) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalPrice) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignalPrice) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignalPrice)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Price != 0 {
+			n += 1 + runtime.Sov(uint64(x.Price))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignalPrice)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Price != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Price))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignalPrice)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalPrice: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= SignalPriceStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				x.Price = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Price |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorPrice                     protoreflect.MessageDescriptor
+	fd_ValidatorPrice_signal_price_status protoreflect.FieldDescriptor
+	fd_ValidatorPrice_signal_id           protoreflect.FieldDescriptor
+	fd_ValidatorPrice_price               protoreflect.FieldDescriptor
+	fd_ValidatorPrice_timestamp           protoreflect.FieldDescriptor
+	fd_ValidatorPrice_block_height        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_ValidatorPrice = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ValidatorPrice")
+	fd_ValidatorPrice_signal_price_status = md_ValidatorPrice.Fields().ByName("signal_price_status")
+	fd_ValidatorPrice_signal_id = md_ValidatorPrice.Fields().ByName("signal_id")
+	fd_ValidatorPrice_price = md_ValidatorPrice.Fields().ByName("price")
+	fd_ValidatorPrice_timestamp = md_ValidatorPrice.Fields().ByName("timestamp")
+	fd_ValidatorPrice_block_height = md_ValidatorPrice.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorPrice)(nil)
+
+type fastReflection_ValidatorPrice ValidatorPrice
+
+func (x *ValidatorPrice) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPrice)(x)
+}
+
+func (x *ValidatorPrice) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorPrice_messageType fastReflection_ValidatorPrice_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPrice_messageType{}
+
+type fastReflection_ValidatorPrice_messageType struct{}
+
+func (x fastReflection_ValidatorPrice_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPrice)(nil)
+}
+func (x fastReflection_ValidatorPrice_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPrice)
+}
+func (x fastReflection_ValidatorPrice_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPrice
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorPrice) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPrice
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorPrice) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPrice_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorPrice) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPrice)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorPrice) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPrice)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorPrice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalPriceStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.SignalPriceStatus))
+		if !f(fd_ValidatorPrice_signal_price_status, value) {
+			return
+		}
+	}
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_ValidatorPrice_signal_id, value) {
+			return
+		}
+	}
+	if x.Price != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Price)
+		if !f(fd_ValidatorPrice_price, value) {
+			return
+		}
+	}
+	if x.Timestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Timestamp)
+		if !f(fd_ValidatorPrice_timestamp, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_ValidatorPrice_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorPrice) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		return x.SignalPriceStatus != 0
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		return x.Price != uint64(0)
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		return x.Timestamp != int64(0)
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		return x.BlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPrice) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		x.SignalPriceStatus = 0
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		x.Price = uint64(0)
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		x.Timestamp = int64(0)
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		x.BlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorPrice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		value := x.SignalPriceStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		value := x.Price
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPrice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		x.SignalPriceStatus = (SignalPriceStatus)(value.Enum())
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		x.Price = value.Uint()
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		x.Timestamp = value.Int()
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		x.BlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPrice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		panic(fmt.Errorf("field signal_price_status of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		panic(fmt.Errorf("field price of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		panic(fmt.Errorf("field timestamp of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		panic(fmt.Errorf("field block_height of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorPrice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.ValidatorPrice.price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.ValidatorPrice.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorPrice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ValidatorPrice", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorPrice) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPrice) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorPrice) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorPrice)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SignalPriceStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.SignalPriceStatus))
+		}
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Price != 0 {
+			n += 1 + runtime.Sov(uint64(x.Price))
+		}
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPrice)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Price != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Price))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.SignalPriceStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignalPriceStatus))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPrice)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPrice: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalPriceStatus", wireType)
+				}
+				x.SignalPriceStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SignalPriceStatus |= SignalPriceStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				x.Price = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Price |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -3300,33 +7231,80 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_ValidatorPriceList_2_list)(nil)
+
+type _ValidatorPriceList_2_list struct {
+	list *[]*ValidatorPrice
+}
+
+func (x *_ValidatorPriceList_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorPriceList_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorPriceList_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorPriceList_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorPriceList_2_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorPrice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorPriceList_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorPriceList_2_list) NewElement() protoreflect.Value {
+	v := new(ValidatorPrice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorPriceList_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Price           protoreflect.MessageDescriptor
-	fd_Price_status    protoreflect.FieldDescriptor
-	fd_Price_signal_id protoreflect.FieldDescriptor
-	fd_Price_price     protoreflect.FieldDescriptor
-	fd_Price_timestamp protoreflect.FieldDescriptor
+	md_ValidatorPriceList                  protoreflect.MessageDescriptor
+	fd_ValidatorPriceList_validator        protoreflect.FieldDescriptor
+	fd_ValidatorPriceList_validator_prices protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_Price = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("Price")
-	fd_Price_status = md_Price.Fields().ByName("status")
-	fd_Price_signal_id = md_Price.Fields().ByName("signal_id")
-	fd_Price_price = md_Price.Fields().ByName("price")
-	fd_Price_timestamp = md_Price.Fields().ByName("timestamp")
+	md_ValidatorPriceList = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ValidatorPriceList")
+	fd_ValidatorPriceList_validator = md_ValidatorPriceList.Fields().ByName("validator")
+	fd_ValidatorPriceList_validator_prices = md_ValidatorPriceList.Fields().ByName("validator_prices")
 }
 
-var _ protoreflect.Message = (*fastReflection_Price)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorPriceList)(nil)
 
-type fastReflection_Price Price
+type fastReflection_ValidatorPriceList ValidatorPriceList
 
-func (x *Price) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Price)(x)
+func (x *ValidatorPriceList) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPriceList)(x)
 }
 
-func (x *Price) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[6]
+func (x *ValidatorPriceList) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3337,43 +7315,43 @@ func (x *Price) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Price_messageType fastReflection_Price_messageType
-var _ protoreflect.MessageType = fastReflection_Price_messageType{}
+var _fastReflection_ValidatorPriceList_messageType fastReflection_ValidatorPriceList_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPriceList_messageType{}
 
-type fastReflection_Price_messageType struct{}
+type fastReflection_ValidatorPriceList_messageType struct{}
 
-func (x fastReflection_Price_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Price)(nil)
+func (x fastReflection_ValidatorPriceList_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPriceList)(nil)
 }
-func (x fastReflection_Price_messageType) New() protoreflect.Message {
-	return new(fastReflection_Price)
+func (x fastReflection_ValidatorPriceList_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPriceList)
 }
-func (x fastReflection_Price_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Price
+func (x fastReflection_ValidatorPriceList_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPriceList
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Price) Descriptor() protoreflect.MessageDescriptor {
-	return md_Price
+func (x *fastReflection_ValidatorPriceList) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPriceList
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Price) Type() protoreflect.MessageType {
-	return _fastReflection_Price_messageType
+func (x *fastReflection_ValidatorPriceList) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPriceList_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Price) New() protoreflect.Message {
-	return new(fastReflection_Price)
+func (x *fastReflection_ValidatorPriceList) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPriceList)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Price) Interface() protoreflect.ProtoMessage {
-	return (*Price)(x)
+func (x *fastReflection_ValidatorPriceList) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPriceList)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3381,28 +7359,16 @@ func (x *fastReflection_Price) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Price) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_Price_status, value) {
-			return
-		}
-	}
-	if x.SignalId != "" {
-		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_Price_signal_id, value) {
-			return
-		}
-	}
-	if x.Price != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Price)
-		if !f(fd_Price_price, value) {
+func (x *fastReflection_ValidatorPriceList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorPriceList_validator, value) {
 			return
 		}
 	}
-	if x.Timestamp != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Timestamp)
-		if !f(fd_Price_timestamp, value) {
+	if len(x.ValidatorPrices) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorPriceList_2_list{list: &x.ValidatorPrices})
+		if !f(fd_ValidatorPriceList_validator_prices, value) {
 			return
 		}
 	}
@@ -3419,21 +7385,17 @@ func (x *fastReflection_Price) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Price) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorPriceList) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		return x.Status != 0
-	case "band.feeds.v1beta1.Price.signal_id":
-		return x.SignalId != ""
-	case "band.feeds.v1beta1.Price.price":
-		return x.Price != uint64(0)
-	case "band.feeds.v1beta1.Price.timestamp":
-		return x.Timestamp != int64(0)
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+		return x.Validator != ""
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
+		return len(x.ValidatorPrices) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3443,21 +7405,17 @@ func (x *fastReflection_Price) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Price) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorPriceList) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		x.Status = 0
-	case "band.feeds.v1beta1.Price.signal_id":
-		x.SignalId = ""
-	case "band.feeds.v1beta1.Price.price":
-		x.Price = uint64(0)
-	case "band.feeds.v1beta1.Price.timestamp":
-		x.Timestamp = int64(0)
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+		x.Validator = ""
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
+		x.ValidatorPrices = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3467,25 +7425,22 @@ func (x *fastReflection_Price) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Price) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPriceList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.feeds.v1beta1.Price.signal_id":
-		value := x.SignalId
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+		value := x.Validator
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.Price.price":
-		value := x.Price
-		return protoreflect.ValueOfUint64(value)
-	case "band.feeds.v1beta1.Price.timestamp":
-		value := x.Timestamp
-		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
+		if len(x.ValidatorPrices) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorPriceList_2_list{})
+		}
+		listValue := &_ValidatorPriceList_2_list{list: &x.ValidatorPrices}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3499,21 +7454,19 @@ func (x *fastReflection_Price) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Price) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorPriceList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		x.Status = (PriceStatus)(value.Enum())
-	case "band.feeds.v1beta1.Price.signal_id":
-		x.SignalId = value.Interface().(string)
-	case "band.feeds.v1beta1.Price.price":
-		x.Price = value.Uint()
-	case "band.feeds.v1beta1.Price.timestamp":
-		x.Timestamp = value.Int()
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+		x.Validator = value.Interface().(string)
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
+		lv := value.List()
+		clv := lv.(*_ValidatorPriceList_2_list)
+		x.ValidatorPrices = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3527,52 +7480,49 @@ func (x *fastReflection_Price) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Price) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPriceList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		panic(fmt.Errorf("field status of message band.feeds.v1beta1.Price is not mutable"))
-	case "band.feeds.v1beta1.Price.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.Price is not mutable"))
-	case "band.feeds.v1beta1.Price.price":
-		panic(fmt.Errorf("field price of message band.feeds.v1beta1.Price is not mutable"))
-	case "band.feeds.v1beta1.Price.timestamp":
-		panic(fmt.Errorf("field timestamp of message band.feeds.v1beta1.Price is not mutable"))
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
+		if x.ValidatorPrices == nil {
+			x.ValidatorPrices = []*ValidatorPrice{}
+		}
+		value := &_ValidatorPriceList_2_list{list: &x.ValidatorPrices}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.ValidatorPriceList is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Price) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPriceList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.Price.status":
-		return protoreflect.ValueOfEnum(0)
-	case "band.feeds.v1beta1.Price.signal_id":
+	case "band.feeds.v1beta1.ValidatorPriceList.validator":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.Price.price":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.feeds.v1beta1.Price.timestamp":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
+		list := []*ValidatorPrice{}
+		return protoreflect.ValueOfList(&_ValidatorPriceList_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Price"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.Price does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Price) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorPriceList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.Price", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ValidatorPriceList", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3580,7 +7530,7 @@ func (x *fastReflection_Price) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Price) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorPriceList) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3591,7 +7541,7 @@ func (x *fastReflection_Price) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Price) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorPriceList) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3603,7 +7553,7 @@ func (x *fastReflection_Price) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Price) IsValid() bool {
+func (x *fastReflection_ValidatorPriceList) IsValid() bool {
 	return x != nil
 }
 
@@ -3613,9 +7563,9 @@ func (x *fastReflection_Price) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Price)
+		x := input.Message.Interface().(*ValidatorPriceList)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3627,18 +7577,15 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
-		}
-		l = len(x.SignalId)
+		l = len(x.Validator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Price != 0 {
-			n += 1 + runtime.Sov(uint64(x.Price))
-		}
-		if x.Timestamp != 0 {
-			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		if len(x.ValidatorPrices) > 0 {
+			for _, e := range x.ValidatorPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3650,7 +7597,7 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Price)
+		x := input.Message.Interface().(*ValidatorPriceList)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3669,27 +7616,28 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Timestamp != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.Price != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Price))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.SignalId) > 0 {
-			i -= len(x.SignalId)
-			copy(dAtA[i:], x.SignalId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.ValidatorPrices) > 0 {
+			for iNdEx := len(x.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3702,7 +7650,7 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Price)
+		x := input.Message.Interface().(*ValidatorPriceList)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3734,34 +7682,15 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Price: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceList: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceList: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-				}
-				x.Status = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Status |= PriceStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3789,13 +7718,13 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignalId = string(dAtA[iNdEx:postIndex])
+				x.Validator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
 				}
-				x.Price = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3805,30 +7734,26 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Price |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.Timestamp = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Timestamp |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorPrices = append(x.ValidatorPrices, &ValidatorPrice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorPrices[len(x.ValidatorPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3865,30 +7790,36 @@ func (x *fastReflection_Price) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SignalPrice           protoreflect.MessageDescriptor
-	fd_SignalPrice_status    protoreflect.FieldDescriptor
-	fd_SignalPrice_signal_id protoreflect.FieldDescriptor
-	fd_SignalPrice_price     protoreflect.FieldDescriptor
+	md_ValidatorAccuracy                    protoreflect.MessageDescriptor
+	fd_ValidatorAccuracy_validator          protoreflect.FieldDescriptor
+	fd_ValidatorAccuracy_window             protoreflect.FieldDescriptor
+	fd_ValidatorAccuracy_submission_count   protoreflect.FieldDescriptor
+	fd_ValidatorAccuracy_report_count       protoreflect.FieldDescriptor
+	fd_ValidatorAccuracy_outlier_count      protoreflect.FieldDescriptor
+	fd_ValidatorAccuracy_accuracy_score_bps protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_SignalPrice = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("SignalPrice")
-	fd_SignalPrice_status = md_SignalPrice.Fields().ByName("status")
-	fd_SignalPrice_signal_id = md_SignalPrice.Fields().ByName("signal_id")
-	fd_SignalPrice_price = md_SignalPrice.Fields().ByName("price")
+	md_ValidatorAccuracy = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ValidatorAccuracy")
+	fd_ValidatorAccuracy_validator = md_ValidatorAccuracy.Fields().ByName("validator")
+	fd_ValidatorAccuracy_window = md_ValidatorAccuracy.Fields().ByName("window")
+	fd_ValidatorAccuracy_submission_count = md_ValidatorAccuracy.Fields().ByName("submission_count")
+	fd_ValidatorAccuracy_report_count = md_ValidatorAccuracy.Fields().ByName("report_count")
+	fd_ValidatorAccuracy_outlier_count = md_ValidatorAccuracy.Fields().ByName("outlier_count")
+	fd_ValidatorAccuracy_accuracy_score_bps = md_ValidatorAccuracy.Fields().ByName("accuracy_score_bps")
 }
 
-var _ protoreflect.Message = (*fastReflection_SignalPrice)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorAccuracy)(nil)
 
-type fastReflection_SignalPrice SignalPrice
+type fastReflection_ValidatorAccuracy ValidatorAccuracy
 
-func (x *SignalPrice) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SignalPrice)(x)
+func (x *ValidatorAccuracy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorAccuracy)(x)
 }
 
-func (x *SignalPrice) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
+func (x *ValidatorAccuracy) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3899,43 +7830,43 @@ func (x *SignalPrice) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SignalPrice_messageType fastReflection_SignalPrice_messageType
-var _ protoreflect.MessageType = fastReflection_SignalPrice_messageType{}
+var _fastReflection_ValidatorAccuracy_messageType fastReflection_ValidatorAccuracy_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorAccuracy_messageType{}
 
-type fastReflection_SignalPrice_messageType struct{}
+type fastReflection_ValidatorAccuracy_messageType struct{}
 
-func (x fastReflection_SignalPrice_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SignalPrice)(nil)
+func (x fastReflection_ValidatorAccuracy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorAccuracy)(nil)
 }
-func (x fastReflection_SignalPrice_messageType) New() protoreflect.Message {
-	return new(fastReflection_SignalPrice)
+func (x fastReflection_ValidatorAccuracy_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorAccuracy)
 }
-func (x fastReflection_SignalPrice_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignalPrice
+func (x fastReflection_ValidatorAccuracy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorAccuracy
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SignalPrice) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignalPrice
+func (x *fastReflection_ValidatorAccuracy) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorAccuracy
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SignalPrice) Type() protoreflect.MessageType {
-	return _fastReflection_SignalPrice_messageType
+func (x *fastReflection_ValidatorAccuracy) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorAccuracy_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SignalPrice) New() protoreflect.Message {
-	return new(fastReflection_SignalPrice)
+func (x *fastReflection_ValidatorAccuracy) New() protoreflect.Message {
+	return new(fastReflection_ValidatorAccuracy)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SignalPrice) Interface() protoreflect.ProtoMessage {
-	return (*SignalPrice)(x)
+func (x *fastReflection_ValidatorAccuracy) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorAccuracy)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3943,22 +7874,40 @@ func (x *fastReflection_SignalPrice) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SignalPrice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_SignalPrice_status, value) {
+func (x *fastReflection_ValidatorAccuracy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorAccuracy_validator, value) {
 			return
 		}
 	}
-	if x.SignalId != "" {
-		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_SignalPrice_signal_id, value) {
+	if x.Window != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Window)
+		if !f(fd_ValidatorAccuracy_window, value) {
 			return
 		}
 	}
-	if x.Price != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Price)
-		if !f(fd_SignalPrice_price, value) {
+	if x.SubmissionCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubmissionCount)
+		if !f(fd_ValidatorAccuracy_submission_count, value) {
+			return
+		}
+	}
+	if x.ReportCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReportCount)
+		if !f(fd_ValidatorAccuracy_report_count, value) {
+			return
+		}
+	}
+	if x.OutlierCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutlierCount)
+		if !f(fd_ValidatorAccuracy_outlier_count, value) {
+			return
+		}
+	}
+	if x.AccuracyScoreBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AccuracyScoreBps)
+		if !f(fd_ValidatorAccuracy_accuracy_score_bps, value) {
 			return
 		}
 	}
@@ -3975,19 +7924,25 @@ func (x *fastReflection_SignalPrice) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SignalPrice) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorAccuracy) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		return x.Status != 0
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
-		return x.SignalId != ""
-	case "band.feeds.v1beta1.SignalPrice.price":
-		return x.Price != uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
+		return x.Validator != ""
+	case "band.feeds.v1beta1.ValidatorAccuracy.window":
+		return x.Window != uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.submission_count":
+		return x.SubmissionCount != uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.report_count":
+		return x.ReportCount != uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		return x.OutlierCount != uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.accuracy_score_bps":
+		return x.AccuracyScoreBps != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3997,19 +7952,25 @@ func (x *fastReflection_SignalPrice) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalPrice) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorAccuracy) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		x.Status = 0
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
-		x.SignalId = ""
-	case "band.feeds.v1beta1.SignalPrice.price":
-		x.Price = uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
+		x.Validator = ""
+	case "band.feeds.v1beta1.ValidatorAccuracy.window":
+		x.Window = uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.submission_count":
+		x.SubmissionCount = uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.report_count":
+		x.ReportCount = uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		x.OutlierCount = uint64(0)
+	case "band.feeds.v1beta1.ValidatorAccuracy.accuracy_score_bps":
+		x.AccuracyScoreBps = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4019,22 +7980,31 @@ func (x *fastReflection_SignalPrice) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SignalPrice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorAccuracy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
-		value := x.SignalId
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
+		value := x.Validator
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.SignalPrice.price":
-		value := x.Price
+	case "band.feeds.v1beta1.ValidatorAccuracy.window":
+		value := x.Window
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.ValidatorAccuracy.submission_count":
+		value := x.SubmissionCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.ValidatorAccuracy.report_count":
+		value := x.ReportCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		value := x.OutlierCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.ValidatorAccuracy.accuracy_score_bps":
+		value := x.AccuracyScoreBps
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4048,19 +8018,25 @@ func (x *fastReflection_SignalPrice) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalPrice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorAccuracy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		x.Status = (SignalPriceStatus)(value.Enum())
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
-		x.SignalId = value.Interface().(string)
-	case "band.feeds.v1beta1.SignalPrice.price":
-		x.Price = value.Uint()
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
+		x.Validator = value.Interface().(string)
+	case "band.feeds.v1beta1.ValidatorAccuracy.window":
+		x.Window = value.Uint()
+	case "band.feeds.v1beta1.ValidatorAccuracy.submission_count":
+		x.SubmissionCount = value.Uint()
+	case "band.feeds.v1beta1.ValidatorAccuracy.report_count":
+		x.ReportCount = value.Uint()
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		x.OutlierCount = value.Uint()
+	case "band.feeds.v1beta1.ValidatorAccuracy.accuracy_score_bps":
+		x.AccuracyScoreBps = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4074,48 +8050,60 @@ func (x *fastReflection_SignalPrice) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalPrice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorAccuracy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		panic(fmt.Errorf("field status of message band.feeds.v1beta1.SignalPrice is not mutable"))
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.SignalPrice is not mutable"))
-	case "band.feeds.v1beta1.SignalPrice.price":
-		panic(fmt.Errorf("field price of message band.feeds.v1beta1.SignalPrice is not mutable"))
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
+		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.ValidatorAccuracy is not mutable"))
+	case "band.feeds.v1beta1.ValidatorAccuracy.window":
+		panic(fmt.Errorf("field window of message band.feeds.v1beta1.ValidatorAccuracy is not mutable"))
+	case "band.feeds.v1beta1.ValidatorAccuracy.submission_count":
+		panic(fmt.Errorf("field submission_count of message band.feeds.v1beta1.ValidatorAccuracy is not mutable"))
+	case "band.feeds.v1beta1.ValidatorAccuracy.report_count":
+		panic(fmt.Errorf("field report_count of message band.feeds.v1beta1.ValidatorAccuracy is not mutable"))
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		panic(fmt.Errorf("field outlier_count of message band.feeds.v1beta1.ValidatorAccuracy is not mutable"))
+	case "band.feeds.v1beta1.ValidatorAccuracy.accuracy_score_bps":
+		panic(fmt.Errorf("field accuracy_score_bps of message band.feeds.v1beta1.ValidatorAccuracy is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SignalPrice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorAccuracy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.SignalPrice.status":
-		return protoreflect.ValueOfEnum(0)
-	case "band.feeds.v1beta1.SignalPrice.signal_id":
+	case "band.feeds.v1beta1.ValidatorAccuracy.validator":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.SignalPrice.price":
+	case "band.feeds.v1beta1.ValidatorAccuracy.window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.ValidatorAccuracy.submission_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.ValidatorAccuracy.report_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.ValidatorAccuracy.outlier_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.ValidatorAccuracy.accuracy_score_bps":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorAccuracy"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.SignalPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorAccuracy does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SignalPrice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorAccuracy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.SignalPrice", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ValidatorAccuracy", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4123,7 +8111,7 @@ func (x *fastReflection_SignalPrice) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SignalPrice) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorAccuracy) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4134,7 +8122,7 @@ func (x *fastReflection_SignalPrice) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalPrice) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorAccuracy) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4146,7 +8134,7 @@ func (x *fastReflection_SignalPrice) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SignalPrice) IsValid() bool {
+func (x *fastReflection_ValidatorAccuracy) IsValid() bool {
 	return x != nil
 }
 
@@ -4156,9 +8144,9 @@ func (x *fastReflection_SignalPrice) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorAccuracy) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SignalPrice)
+		x := input.Message.Interface().(*ValidatorAccuracy)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4170,15 +8158,24 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
-		}
-		l = len(x.SignalId)
+		l = len(x.Validator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Price != 0 {
-			n += 1 + runtime.Sov(uint64(x.Price))
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		if x.SubmissionCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubmissionCount))
+		}
+		if x.ReportCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportCount))
+		}
+		if x.OutlierCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutlierCount))
+		}
+		if x.AccuracyScoreBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccuracyScoreBps))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4190,7 +8187,7 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SignalPrice)
+		x := input.Message.Interface().(*ValidatorAccuracy)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4209,22 +8206,37 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Price != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Price))
+		if x.AccuracyScoreBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccuracyScoreBps))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.OutlierCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutlierCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ReportCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SubmissionCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubmissionCount))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.SignalId) > 0 {
-			i -= len(x.SignalId)
-			copy(dAtA[i:], x.SignalId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4237,7 +8249,7 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SignalPrice)
+		x := input.Message.Interface().(*ValidatorAccuracy)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4269,17 +8281,17 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalPrice: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccuracy: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccuracy: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 				}
-				x.Status = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4289,16 +8301,29 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Status |= SignalPriceStatus(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 				}
-				var stringLen uint64
+				x.Window = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4308,29 +8333,54 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Window |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmissionCount", wireType)
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.SubmissionCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubmissionCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportCount", wireType)
 				}
-				x.SignalId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
+				x.ReportCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReportCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutlierCount", wireType)
 				}
-				x.Price = 0
+				x.OutlierCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4340,7 +8390,26 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Price |= uint64(b&0x7F) << shift
+					x.OutlierCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccuracyScoreBps", wireType)
+				}
+				x.AccuracyScoreBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccuracyScoreBps |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -4381,34 +8450,28 @@ func (x *fastReflection_SignalPrice) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ValidatorPrice                     protoreflect.MessageDescriptor
-	fd_ValidatorPrice_signal_price_status protoreflect.FieldDescriptor
-	fd_ValidatorPrice_signal_id           protoreflect.FieldDescriptor
-	fd_ValidatorPrice_price               protoreflect.FieldDescriptor
-	fd_ValidatorPrice_timestamp           protoreflect.FieldDescriptor
-	fd_ValidatorPrice_block_height        protoreflect.FieldDescriptor
+	md_AccuracyRecord               protoreflect.MessageDescriptor
+	fd_AccuracyRecord_report_count  protoreflect.FieldDescriptor
+	fd_AccuracyRecord_outlier_count protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_ValidatorPrice = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ValidatorPrice")
-	fd_ValidatorPrice_signal_price_status = md_ValidatorPrice.Fields().ByName("signal_price_status")
-	fd_ValidatorPrice_signal_id = md_ValidatorPrice.Fields().ByName("signal_id")
-	fd_ValidatorPrice_price = md_ValidatorPrice.Fields().ByName("price")
-	fd_ValidatorPrice_timestamp = md_ValidatorPrice.Fields().ByName("timestamp")
-	fd_ValidatorPrice_block_height = md_ValidatorPrice.Fields().ByName("block_height")
+	md_AccuracyRecord = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("AccuracyRecord")
+	fd_AccuracyRecord_report_count = md_AccuracyRecord.Fields().ByName("report_count")
+	fd_AccuracyRecord_outlier_count = md_AccuracyRecord.Fields().ByName("outlier_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorPrice)(nil)
+var _ protoreflect.Message = (*fastReflection_AccuracyRecord)(nil)
 
-type fastReflection_ValidatorPrice ValidatorPrice
+type fastReflection_AccuracyRecord AccuracyRecord
 
-func (x *ValidatorPrice) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorPrice)(x)
+func (x *AccuracyRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccuracyRecord)(x)
 }
 
-func (x *ValidatorPrice) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[8]
+func (x *AccuracyRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4419,43 +8482,43 @@ func (x *ValidatorPrice) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorPrice_messageType fastReflection_ValidatorPrice_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorPrice_messageType{}
+var _fastReflection_AccuracyRecord_messageType fastReflection_AccuracyRecord_messageType
+var _ protoreflect.MessageType = fastReflection_AccuracyRecord_messageType{}
 
-type fastReflection_ValidatorPrice_messageType struct{}
+type fastReflection_AccuracyRecord_messageType struct{}
 
-func (x fastReflection_ValidatorPrice_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorPrice)(nil)
+func (x fastReflection_AccuracyRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccuracyRecord)(nil)
 }
-func (x fastReflection_ValidatorPrice_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorPrice)
+func (x fastReflection_AccuracyRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccuracyRecord)
 }
-func (x fastReflection_ValidatorPrice_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorPrice
+func (x fastReflection_AccuracyRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccuracyRecord
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorPrice) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorPrice
+func (x *fastReflection_AccuracyRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccuracyRecord
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorPrice) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorPrice_messageType
+func (x *fastReflection_AccuracyRecord) Type() protoreflect.MessageType {
+	return _fastReflection_AccuracyRecord_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorPrice) New() protoreflect.Message {
-	return new(fastReflection_ValidatorPrice)
+func (x *fastReflection_AccuracyRecord) New() protoreflect.Message {
+	return new(fastReflection_AccuracyRecord)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorPrice) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorPrice)(x)
+func (x *fastReflection_AccuracyRecord) Interface() protoreflect.ProtoMessage {
+	return (*AccuracyRecord)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4463,34 +8526,16 @@ func (x *fastReflection_ValidatorPrice) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorPrice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SignalPriceStatus != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.SignalPriceStatus))
-		if !f(fd_ValidatorPrice_signal_price_status, value) {
-			return
-		}
-	}
-	if x.SignalId != "" {
-		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_ValidatorPrice_signal_id, value) {
-			return
-		}
-	}
-	if x.Price != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Price)
-		if !f(fd_ValidatorPrice_price, value) {
-			return
-		}
-	}
-	if x.Timestamp != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Timestamp)
-		if !f(fd_ValidatorPrice_timestamp, value) {
+func (x *fastReflection_AccuracyRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReportCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReportCount)
+		if !f(fd_AccuracyRecord_report_count, value) {
 			return
 		}
 	}
-	if x.BlockHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockHeight)
-		if !f(fd_ValidatorPrice_block_height, value) {
+	if x.OutlierCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutlierCount)
+		if !f(fd_AccuracyRecord_outlier_count, value) {
 			return
 		}
 	}
@@ -4507,23 +8552,17 @@ func (x *fastReflection_ValidatorPrice) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorPrice) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AccuracyRecord) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
-		return x.SignalPriceStatus != 0
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
-		return x.SignalId != ""
-	case "band.feeds.v1beta1.ValidatorPrice.price":
-		return x.Price != uint64(0)
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
-		return x.Timestamp != int64(0)
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
-		return x.BlockHeight != int64(0)
+	case "band.feeds.v1beta1.AccuracyRecord.report_count":
+		return x.ReportCount != uint64(0)
+	case "band.feeds.v1beta1.AccuracyRecord.outlier_count":
+		return x.OutlierCount != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.AccuracyRecord"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.AccuracyRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4533,23 +8572,17 @@ func (x *fastReflection_ValidatorPrice) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPrice) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AccuracyRecord) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
-		x.SignalPriceStatus = 0
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
-		x.SignalId = ""
-	case "band.feeds.v1beta1.ValidatorPrice.price":
-		x.Price = uint64(0)
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
-		x.Timestamp = int64(0)
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
-		x.BlockHeight = int64(0)
+	case "band.feeds.v1beta1.AccuracyRecord.report_count":
+		x.ReportCount = uint64(0)
+	case "band.feeds.v1beta1.AccuracyRecord.outlier_count":
+		x.OutlierCount = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.AccuracyRecord"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.AccuracyRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4559,28 +8592,19 @@ func (x *fastReflection_ValidatorPrice) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorPrice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccuracyRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
-		value := x.SignalPriceStatus
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
-		value := x.SignalId
-		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.ValidatorPrice.price":
-		value := x.Price
+	case "band.feeds.v1beta1.AccuracyRecord.report_count":
+		value := x.ReportCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.AccuracyRecord.outlier_count":
+		value := x.OutlierCount
 		return protoreflect.ValueOfUint64(value)
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
-		value := x.Timestamp
-		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.AccuracyRecord"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.AccuracyRecord does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4594,23 +8618,17 @@ func (x *fastReflection_ValidatorPrice) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPrice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AccuracyRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
-		x.SignalPriceStatus = (SignalPriceStatus)(value.Enum())
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
-		x.SignalId = value.Interface().(string)
-	case "band.feeds.v1beta1.ValidatorPrice.price":
-		x.Price = value.Uint()
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
-		x.Timestamp = value.Int()
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
-		x.BlockHeight = value.Int()
+	case "band.feeds.v1beta1.AccuracyRecord.report_count":
+		x.ReportCount = value.Uint()
+	case "band.feeds.v1beta1.AccuracyRecord.outlier_count":
+		x.OutlierCount = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.AccuracyRecord"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.AccuracyRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4624,56 +8642,44 @@ func (x *fastReflection_ValidatorPrice) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPrice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccuracyRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
-		panic(fmt.Errorf("field signal_price_status of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
-	case "band.feeds.v1beta1.ValidatorPrice.price":
-		panic(fmt.Errorf("field price of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
-		panic(fmt.Errorf("field timestamp of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
-		panic(fmt.Errorf("field block_height of message band.feeds.v1beta1.ValidatorPrice is not mutable"))
+	case "band.feeds.v1beta1.AccuracyRecord.report_count":
+		panic(fmt.Errorf("field report_count of message band.feeds.v1beta1.AccuracyRecord is not mutable"))
+	case "band.feeds.v1beta1.AccuracyRecord.outlier_count":
+		panic(fmt.Errorf("field outlier_count of message band.feeds.v1beta1.AccuracyRecord is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.AccuracyRecord"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.AccuracyRecord does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorPrice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccuracyRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPrice.signal_price_status":
-		return protoreflect.ValueOfEnum(0)
-	case "band.feeds.v1beta1.ValidatorPrice.signal_id":
-		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.ValidatorPrice.price":
+	case "band.feeds.v1beta1.AccuracyRecord.report_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.AccuracyRecord.outlier_count":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.feeds.v1beta1.ValidatorPrice.timestamp":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.ValidatorPrice.block_height":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPrice"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.AccuracyRecord"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPrice does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.AccuracyRecord does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorPrice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AccuracyRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ValidatorPrice", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.AccuracyRecord", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4681,7 +8687,7 @@ func (x *fastReflection_ValidatorPrice) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorPrice) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AccuracyRecord) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4692,7 +8698,7 @@ func (x *fastReflection_ValidatorPrice) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPrice) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AccuracyRecord) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4704,7 +8710,7 @@ func (x *fastReflection_ValidatorPrice) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorPrice) IsValid() bool {
+func (x *fastReflection_AccuracyRecord) IsValid() bool {
 	return x != nil
 }
 
@@ -4714,9 +8720,9 @@ func (x *fastReflection_ValidatorPrice) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AccuracyRecord) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorPrice)
+		x := input.Message.Interface().(*AccuracyRecord)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4724,25 +8730,15 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 			}
 		}
 		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.SignalPriceStatus != 0 {
-			n += 1 + runtime.Sov(uint64(x.SignalPriceStatus))
-		}
-		l = len(x.SignalId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Price != 0 {
-			n += 1 + runtime.Sov(uint64(x.Price))
-		}
-		if x.Timestamp != 0 {
-			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ReportCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportCount))
 		}
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		if x.OutlierCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutlierCount))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4754,7 +8750,7 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorPrice)
+		x := input.Message.Interface().(*AccuracyRecord)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4773,30 +8769,13 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.Timestamp != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.Price != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Price))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.SignalId) > 0 {
-			i -= len(x.SignalId)
-			copy(dAtA[i:], x.SignalId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+		if x.OutlierCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutlierCount))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.SignalPriceStatus != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignalPriceStatus))
+		if x.ReportCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportCount))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -4811,7 +8790,7 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorPrice)
+		x := input.Message.Interface().(*AccuracyRecord)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4843,17 +8822,17 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPrice: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccuracyRecord: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccuracyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalPriceStatus", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportCount", wireType)
 				}
-				x.SignalPriceStatus = 0
+				x.ReportCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4863,86 +8842,16 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SignalPriceStatus |= SignalPriceStatus(b&0x7F) << shift
+					x.ReportCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SignalId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-				}
-				x.Price = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Price |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-				}
-				x.Timestamp = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Timestamp |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutlierCount", wireType)
 				}
-				x.BlockHeight = 0
+				x.OutlierCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4952,7 +8861,7 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockHeight |= int64(b&0x7F) << shift
+					x.OutlierCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -4992,80 +8901,41 @@ func (x *fastReflection_ValidatorPrice) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorPriceList_2_list)(nil)
-
-type _ValidatorPriceList_2_list struct {
-	list *[]*ValidatorPrice
-}
-
-func (x *_ValidatorPriceList_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_ValidatorPriceList_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_ValidatorPriceList_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_ValidatorPriceList_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_ValidatorPriceList_2_list) AppendMutable() protoreflect.Value {
-	v := new(ValidatorPrice)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ValidatorPriceList_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ValidatorPriceList_2_list) NewElement() protoreflect.Value {
-	v := new(ValidatorPrice)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ValidatorPriceList_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_ValidatorPriceList                  protoreflect.MessageDescriptor
-	fd_ValidatorPriceList_validator        protoreflect.FieldDescriptor
-	fd_ValidatorPriceList_validator_prices protoreflect.FieldDescriptor
+	md_SignalMetadata                            protoreflect.MessageDescriptor
+	fd_SignalMetadata_signal_id                  protoreflect.FieldDescriptor
+	fd_SignalMetadata_decimals                   protoreflect.FieldDescriptor
+	fd_SignalMetadata_base_asset                 protoreflect.FieldDescriptor
+	fd_SignalMetadata_quote_asset                protoreflect.FieldDescriptor
+	fd_SignalMetadata_category                   protoreflect.FieldDescriptor
+	fd_SignalMetadata_description                protoreflect.FieldDescriptor
+	fd_SignalMetadata_status                     protoreflect.FieldDescriptor
+	fd_SignalMetadata_max_price_move_basis_point protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_feeds_proto_init()
-	md_ValidatorPriceList = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("ValidatorPriceList")
-	fd_ValidatorPriceList_validator = md_ValidatorPriceList.Fields().ByName("validator")
-	fd_ValidatorPriceList_validator_prices = md_ValidatorPriceList.Fields().ByName("validator_prices")
+	md_SignalMetadata = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("SignalMetadata")
+	fd_SignalMetadata_signal_id = md_SignalMetadata.Fields().ByName("signal_id")
+	fd_SignalMetadata_decimals = md_SignalMetadata.Fields().ByName("decimals")
+	fd_SignalMetadata_base_asset = md_SignalMetadata.Fields().ByName("base_asset")
+	fd_SignalMetadata_quote_asset = md_SignalMetadata.Fields().ByName("quote_asset")
+	fd_SignalMetadata_category = md_SignalMetadata.Fields().ByName("category")
+	fd_SignalMetadata_description = md_SignalMetadata.Fields().ByName("description")
+	fd_SignalMetadata_status = md_SignalMetadata.Fields().ByName("status")
+	fd_SignalMetadata_max_price_move_basis_point = md_SignalMetadata.Fields().ByName("max_price_move_basis_point")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorPriceList)(nil)
+var _ protoreflect.Message = (*fastReflection_SignalMetadata)(nil)
 
-type fastReflection_ValidatorPriceList ValidatorPriceList
+type fastReflection_SignalMetadata SignalMetadata
 
-func (x *ValidatorPriceList) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorPriceList)(x)
+func (x *SignalMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignalMetadata)(x)
 }
 
-func (x *ValidatorPriceList) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[9]
+func (x *SignalMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5076,43 +8946,43 @@ func (x *ValidatorPriceList) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorPriceList_messageType fastReflection_ValidatorPriceList_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorPriceList_messageType{}
+var _fastReflection_SignalMetadata_messageType fastReflection_SignalMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_SignalMetadata_messageType{}
 
-type fastReflection_ValidatorPriceList_messageType struct{}
+type fastReflection_SignalMetadata_messageType struct{}
 
-func (x fastReflection_ValidatorPriceList_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorPriceList)(nil)
+func (x fastReflection_SignalMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignalMetadata)(nil)
 }
-func (x fastReflection_ValidatorPriceList_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorPriceList)
+func (x fastReflection_SignalMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignalMetadata)
 }
-func (x fastReflection_ValidatorPriceList_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorPriceList
+func (x fastReflection_SignalMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalMetadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorPriceList) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorPriceList
+func (x *fastReflection_SignalMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalMetadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorPriceList) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorPriceList_messageType
+func (x *fastReflection_SignalMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_SignalMetadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorPriceList) New() protoreflect.Message {
-	return new(fastReflection_ValidatorPriceList)
+func (x *fastReflection_SignalMetadata) New() protoreflect.Message {
+	return new(fastReflection_SignalMetadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorPriceList) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorPriceList)(x)
+func (x *fastReflection_SignalMetadata) Interface() protoreflect.ProtoMessage {
+	return (*SignalMetadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5120,16 +8990,52 @@ func (x *fastReflection_ValidatorPriceList) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorPriceList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_ValidatorPriceList_validator, value) {
+func (x *fastReflection_SignalMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_SignalMetadata_signal_id, value) {
 			return
 		}
 	}
-	if len(x.ValidatorPrices) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorPriceList_2_list{list: &x.ValidatorPrices})
-		if !f(fd_ValidatorPriceList_validator_prices, value) {
+	if x.Decimals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Decimals)
+		if !f(fd_SignalMetadata_decimals, value) {
+			return
+		}
+	}
+	if x.BaseAsset != "" {
+		value := protoreflect.ValueOfString(x.BaseAsset)
+		if !f(fd_SignalMetadata_base_asset, value) {
+			return
+		}
+	}
+	if x.QuoteAsset != "" {
+		value := protoreflect.ValueOfString(x.QuoteAsset)
+		if !f(fd_SignalMetadata_quote_asset, value) {
+			return
+		}
+	}
+	if x.Category != "" {
+		value := protoreflect.ValueOfString(x.Category)
+		if !f(fd_SignalMetadata_category, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_SignalMetadata_description, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_SignalMetadata_status, value) {
+			return
+		}
+	}
+	if x.MaxPriceMoveBasisPoint != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxPriceMoveBasisPoint)
+		if !f(fd_SignalMetadata_max_price_move_basis_point, value) {
 			return
 		}
 	}
@@ -5146,17 +9052,29 @@ func (x *fastReflection_ValidatorPriceList) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorPriceList) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SignalMetadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
-		return x.Validator != ""
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		return len(x.ValidatorPrices) != 0
+	case "band.feeds.v1beta1.SignalMetadata.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.SignalMetadata.decimals":
+		return x.Decimals != uint32(0)
+	case "band.feeds.v1beta1.SignalMetadata.base_asset":
+		return x.BaseAsset != ""
+	case "band.feeds.v1beta1.SignalMetadata.quote_asset":
+		return x.QuoteAsset != ""
+	case "band.feeds.v1beta1.SignalMetadata.category":
+		return x.Category != ""
+	case "band.feeds.v1beta1.SignalMetadata.description":
+		return x.Description != ""
+	case "band.feeds.v1beta1.SignalMetadata.status":
+		return x.Status != 0
+	case "band.feeds.v1beta1.SignalMetadata.max_price_move_basis_point":
+		return x.MaxPriceMoveBasisPoint != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5166,17 +9084,29 @@ func (x *fastReflection_ValidatorPriceList) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPriceList) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SignalMetadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
-		x.Validator = ""
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		x.ValidatorPrices = nil
+	case "band.feeds.v1beta1.SignalMetadata.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.SignalMetadata.decimals":
+		x.Decimals = uint32(0)
+	case "band.feeds.v1beta1.SignalMetadata.base_asset":
+		x.BaseAsset = ""
+	case "band.feeds.v1beta1.SignalMetadata.quote_asset":
+		x.QuoteAsset = ""
+	case "band.feeds.v1beta1.SignalMetadata.category":
+		x.Category = ""
+	case "band.feeds.v1beta1.SignalMetadata.description":
+		x.Description = ""
+	case "band.feeds.v1beta1.SignalMetadata.status":
+		x.Status = 0
+	case "band.feeds.v1beta1.SignalMetadata.max_price_move_basis_point":
+		x.MaxPriceMoveBasisPoint = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5186,22 +9116,37 @@ func (x *fastReflection_ValidatorPriceList) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorPriceList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
-		value := x.Validator
+	case "band.feeds.v1beta1.SignalMetadata.signal_id":
+		value := x.SignalId
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		if len(x.ValidatorPrices) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorPriceList_2_list{})
-		}
-		listValue := &_ValidatorPriceList_2_list{list: &x.ValidatorPrices}
-		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.SignalMetadata.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint32(value)
+	case "band.feeds.v1beta1.SignalMetadata.base_asset":
+		value := x.BaseAsset
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalMetadata.quote_asset":
+		value := x.QuoteAsset
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalMetadata.category":
+		value := x.Category
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalMetadata.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.SignalMetadata.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.feeds.v1beta1.SignalMetadata.max_price_move_basis_point":
+		value := x.MaxPriceMoveBasisPoint
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalMetadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5215,19 +9160,29 @@ func (x *fastReflection_ValidatorPriceList) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPriceList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SignalMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
-		x.Validator = value.Interface().(string)
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		lv := value.List()
-		clv := lv.(*_ValidatorPriceList_2_list)
-		x.ValidatorPrices = *clv.list
+	case "band.feeds.v1beta1.SignalMetadata.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalMetadata.decimals":
+		x.Decimals = uint32(value.Uint())
+	case "band.feeds.v1beta1.SignalMetadata.base_asset":
+		x.BaseAsset = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalMetadata.quote_asset":
+		x.QuoteAsset = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalMetadata.category":
+		x.Category = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalMetadata.description":
+		x.Description = value.Interface().(string)
+	case "band.feeds.v1beta1.SignalMetadata.status":
+		x.Status = (SignalMetadataStatus)(value.Enum())
+	case "band.feeds.v1beta1.SignalMetadata.max_price_move_basis_point":
+		x.MaxPriceMoveBasisPoint = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5241,49 +9196,68 @@ func (x *fastReflection_ValidatorPriceList) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPriceList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		if x.ValidatorPrices == nil {
-			x.ValidatorPrices = []*ValidatorPrice{}
-		}
-		value := &_ValidatorPriceList_2_list{list: &x.ValidatorPrices}
-		return protoreflect.ValueOfList(value)
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
-		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.ValidatorPriceList is not mutable"))
+	case "band.feeds.v1beta1.SignalMetadata.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.SignalMetadata is not mutable"))
+	case "band.feeds.v1beta1.SignalMetadata.decimals":
+		panic(fmt.Errorf("field decimals of message band.feeds.v1beta1.SignalMetadata is not mutable"))
+	case "band.feeds.v1beta1.SignalMetadata.base_asset":
+		panic(fmt.Errorf("field base_asset of message band.feeds.v1beta1.SignalMetadata is not mutable"))
+	case "band.feeds.v1beta1.SignalMetadata.quote_asset":
+		panic(fmt.Errorf("field quote_asset of message band.feeds.v1beta1.SignalMetadata is not mutable"))
+	case "band.feeds.v1beta1.SignalMetadata.category":
+		panic(fmt.Errorf("field category of message band.feeds.v1beta1.SignalMetadata is not mutable"))
+	case "band.feeds.v1beta1.SignalMetadata.description":
+		panic(fmt.Errorf("field description of message band.feeds.v1beta1.SignalMetadata is not mutable"))
+	case "band.feeds.v1beta1.SignalMetadata.status":
+		panic(fmt.Errorf("field status of message band.feeds.v1beta1.SignalMetadata is not mutable"))
+	case "band.feeds.v1beta1.SignalMetadata.max_price_move_basis_point":
+		panic(fmt.Errorf("field max_price_move_basis_point of message band.feeds.v1beta1.SignalMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorPriceList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.ValidatorPriceList.validator":
+	case "band.feeds.v1beta1.SignalMetadata.signal_id":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.ValidatorPriceList.validator_prices":
-		list := []*ValidatorPrice{}
-		return protoreflect.ValueOfList(&_ValidatorPriceList_2_list{list: &list})
+	case "band.feeds.v1beta1.SignalMetadata.decimals":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "band.feeds.v1beta1.SignalMetadata.base_asset":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalMetadata.quote_asset":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalMetadata.category":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalMetadata.description":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.SignalMetadata.status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.feeds.v1beta1.SignalMetadata.max_price_move_basis_point":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.ValidatorPriceList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.SignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.ValidatorPriceList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.SignalMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorPriceList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SignalMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.ValidatorPriceList", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.SignalMetadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5291,7 +9265,7 @@ func (x *fastReflection_ValidatorPriceList) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorPriceList) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SignalMetadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5302,7 +9276,7 @@ func (x *fastReflection_ValidatorPriceList) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorPriceList) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SignalMetadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5314,7 +9288,7 @@ func (x *fastReflection_ValidatorPriceList) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorPriceList) IsValid() bool {
+func (x *fastReflection_SignalMetadata) IsValid() bool {
 	return x != nil
 }
 
@@ -5324,9 +9298,9 @@ func (x *fastReflection_ValidatorPriceList) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SignalMetadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorPriceList)
+		x := input.Message.Interface().(*SignalMetadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5338,15 +9312,34 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Validator)
+		l = len(x.SignalId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.ValidatorPrices) > 0 {
-			for _, e := range x.ValidatorPrices {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Decimals != 0 {
+			n += 1 + runtime.Sov(uint64(x.Decimals))
+		}
+		l = len(x.BaseAsset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.QuoteAsset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Category)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.MaxPriceMoveBasisPoint != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPriceMoveBasisPoint))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5358,7 +9351,7 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorPriceList)
+		x := input.Message.Interface().(*SignalMetadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5377,26 +9370,53 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorPrices) > 0 {
-			for iNdEx := len(x.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ValidatorPrices[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+		if x.MaxPriceMoveBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceMoveBasisPoint))
+			i--
+			dAtA[i] = 0x40
 		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Category) > 0 {
+			i -= len(x.Category)
+			copy(dAtA[i:], x.Category)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Category)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.QuoteAsset) > 0 {
+			i -= len(x.QuoteAsset)
+			copy(dAtA[i:], x.QuoteAsset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuoteAsset)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BaseAsset) > 0 {
+			i -= len(x.BaseAsset)
+			copy(dAtA[i:], x.BaseAsset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseAsset)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5411,7 +9431,7 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorPriceList)
+		x := input.Message.Interface().(*SignalMetadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5443,15 +9463,15 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceList: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalMetadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceList: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5479,13 +9499,32 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5495,26 +9534,158 @@ func (x *fastReflection_ValidatorPriceList) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorPrices = append(x.ValidatorPrices, &ValidatorPrice{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorPrices[len(x.ValidatorPrices)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.BaseAsset = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QuoteAsset = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Category = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= SignalMetadataStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceMoveBasisPoint", wireType)
+				}
+				x.MaxPriceMoveBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceMoveBasisPoint |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5572,7 +9743,7 @@ func (x *ReferenceSourceConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ReferenceSourceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[10]
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6102,7 +10273,7 @@ func (x *FeedsSignatureOrder) ProtoReflect() protoreflect.Message {
 }
 
 func (x *FeedsSignatureOrder) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[11]
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6589,6 +10760,8 @@ const (
 	PriceStatus_PRICE_STATUS_AVAILABLE PriceStatus = 3
 	// PRICE_STATUS_NOT_IN_CURRENT_FEEDS is a not in current feed price status.
 	PriceStatus_PRICE_STATUS_NOT_IN_CURRENT_FEEDS PriceStatus = 4
+	// PRICE_STATUS_HALTED is a halted price status of a signal id whose circuit breaker is triggered.
+	PriceStatus_PRICE_STATUS_HALTED PriceStatus = 5
 )
 
 // Enum value maps for PriceStatus.
@@ -6599,6 +10772,7 @@ var (
 		2: "PRICE_STATUS_NOT_READY",
 		3: "PRICE_STATUS_AVAILABLE",
 		4: "PRICE_STATUS_NOT_IN_CURRENT_FEEDS",
+		5: "PRICE_STATUS_HALTED",
 	}
 	PriceStatus_value = map[string]int32{
 		"PRICE_STATUS_UNSPECIFIED":          0,
@@ -6606,6 +10780,7 @@ var (
 		"PRICE_STATUS_NOT_READY":            2,
 		"PRICE_STATUS_AVAILABLE":            3,
 		"PRICE_STATUS_NOT_IN_CURRENT_FEEDS": 4,
+		"PRICE_STATUS_HALTED":               5,
 	}
 )
 
@@ -6636,6 +10811,64 @@ func (PriceStatus) EnumDescriptor() ([]byte, []int) {
 	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{0}
 }
 
+// AggregationMethod is the method used to aggregate the validator prices of a signal id into a price.
+type AggregationMethod int32
+
+const (
+	// AGGREGATION_METHOD_UNSPECIFIED is an unspecified aggregation method.
+	AggregationMethod_AGGREGATION_METHOD_UNSPECIFIED AggregationMethod = 0
+	// AGGREGATION_METHOD_WEIGHTED_MEDIAN is the time-weighted and power-weighted median of the validator prices.
+	AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN AggregationMethod = 1
+	// AGGREGATION_METHOD_TRIMMED_MEAN is the power-weighted mean of the validator prices after trimming the lowest
+	// and the highest quarter of the power.
+	AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN AggregationMethod = 2
+	// AGGREGATION_METHOD_MEDIAN is the power-weighted median of the validator prices.
+	AggregationMethod_AGGREGATION_METHOD_MEDIAN AggregationMethod = 3
+)
+
+// Enum value maps for AggregationMethod.
+var (
+	AggregationMethod_name = map[int32]string{
+		0: "AGGREGATION_METHOD_UNSPECIFIED",
+		1: "AGGREGATION_METHOD_WEIGHTED_MEDIAN",
+		2: "AGGREGATION_METHOD_TRIMMED_MEAN",
+		3: "AGGREGATION_METHOD_MEDIAN",
+	}
+	AggregationMethod_value = map[string]int32{
+		"AGGREGATION_METHOD_UNSPECIFIED":     0,
+		"AGGREGATION_METHOD_WEIGHTED_MEDIAN": 1,
+		"AGGREGATION_METHOD_TRIMMED_MEAN":    2,
+		"AGGREGATION_METHOD_MEDIAN":          3,
+	}
+)
+
+func (x AggregationMethod) Enum() *AggregationMethod {
+	p := new(AggregationMethod)
+	*p = x
+	return p
+}
+
+func (x AggregationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_feeds_v1beta1_feeds_proto_enumTypes[1].Descriptor()
+}
+
+func (AggregationMethod) Type() protoreflect.EnumType {
+	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[1]
+}
+
+func (x AggregationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregationMethod.Descriptor instead.
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{1}
+}
+
 // SignalPriceStatus is a structure that defines the price status of a signal id.
 type SignalPriceStatus int32
 
@@ -6677,11 +10910,11 @@ func (x SignalPriceStatus) String() string {
 }
 
 func (SignalPriceStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_band_feeds_v1beta1_feeds_proto_enumTypes[1].Descriptor()
+	return file_band_feeds_v1beta1_feeds_proto_enumTypes[2].Descriptor()
 }
 
 func (SignalPriceStatus) Type() protoreflect.EnumType {
-	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[1]
+	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[2]
 }
 
 func (x SignalPriceStatus) Number() protoreflect.EnumNumber {
@@ -6690,7 +10923,60 @@ func (x SignalPriceStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SignalPriceStatus.Descriptor instead.
 func (SignalPriceStatus) EnumDescriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{1}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{2}
+}
+
+// SignalMetadataStatus is the status of a signal id in the signal registry.
+type SignalMetadataStatus int32
+
+const (
+	// SIGNAL_METADATA_STATUS_UNSPECIFIED is an unspecified signal metadata status.
+	SignalMetadataStatus_SIGNAL_METADATA_STATUS_UNSPECIFIED SignalMetadataStatus = 0
+	// SIGNAL_METADATA_STATUS_ACTIVE is the status of a signal id that can be voted.
+	SignalMetadataStatus_SIGNAL_METADATA_STATUS_ACTIVE SignalMetadataStatus = 1
+	// SIGNAL_METADATA_STATUS_DEPRECATED is the status of a signal id that is no longer supported.
+	SignalMetadataStatus_SIGNAL_METADATA_STATUS_DEPRECATED SignalMetadataStatus = 2
+)
+
+// Enum value maps for SignalMetadataStatus.
+var (
+	SignalMetadataStatus_name = map[int32]string{
+		0: "SIGNAL_METADATA_STATUS_UNSPECIFIED",
+		1: "SIGNAL_METADATA_STATUS_ACTIVE",
+		2: "SIGNAL_METADATA_STATUS_DEPRECATED",
+	}
+	SignalMetadataStatus_value = map[string]int32{
+		"SIGNAL_METADATA_STATUS_UNSPECIFIED": 0,
+		"SIGNAL_METADATA_STATUS_ACTIVE":      1,
+		"SIGNAL_METADATA_STATUS_DEPRECATED":  2,
+	}
+)
+
+func (x SignalMetadataStatus) Enum() *SignalMetadataStatus {
+	p := new(SignalMetadataStatus)
+	*p = x
+	return p
+}
+
+func (x SignalMetadataStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignalMetadataStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_feeds_v1beta1_feeds_proto_enumTypes[3].Descriptor()
+}
+
+func (SignalMetadataStatus) Type() protoreflect.EnumType {
+	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[3]
+}
+
+func (x SignalMetadataStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignalMetadataStatus.Descriptor instead.
+func (SignalMetadataStatus) EnumDescriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{3}
 }
 
 // Signal is the data structure that contains signal id and power of that signal.
@@ -6785,6 +11071,125 @@ func (x *Vote) GetSignals() []*Signal {
 	return nil
 }
 
+// Delegation is the data structure that contains the feeds voting power delegated by a delegator to a delegatee.
+type Delegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator is the address of the delegator of the voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegatee is the address that votes with the delegated voting power.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// power is the delegated voting power.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (x *Delegation) Reset() {
+	*x = Delegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Delegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delegation) ProtoMessage() {}
+
+// Deprecated: Use Delegation.ProtoReflect.Descriptor instead.
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Delegation) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *Delegation) GetDelegatee() string {
+	if x != nil {
+		return x.Delegatee
+	}
+	return ""
+}
+
+func (x *Delegation) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+// CircuitBreaker is a structure that holds the triggered circuit breaker of a signal id.
+type CircuitBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the signal id whose prices are halted.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// reference_price is the last available price of the signal id before the circuit breaker is triggered.
+	ReferencePrice uint64 `protobuf:"varint,2,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	// halted_price is the price that triggered the circuit breaker.
+	HaltedPrice uint64 `protobuf:"varint,3,opt,name=halted_price,json=haltedPrice,proto3" json:"halted_price,omitempty"`
+	// halted_at is the timestamp at which the circuit breaker is triggered.
+	HaltedAt int64 `protobuf:"varint,4,opt,name=halted_at,json=haltedAt,proto3" json:"halted_at,omitempty"`
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker) ProtoMessage() {}
+
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CircuitBreaker) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *CircuitBreaker) GetReferencePrice() uint64 {
+	if x != nil {
+		return x.ReferencePrice
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetHaltedPrice() uint64 {
+	if x != nil {
+		return x.HaltedPrice
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetHaltedAt() int64 {
+	if x != nil {
+		return x.HaltedAt
+	}
+	return 0
+}
+
 // Feed is a structure that holds a signal id, its total power, and its calculated interval.
 type Feed struct {
 	state         protoimpl.MessageState
@@ -6802,7 +11207,7 @@ type Feed struct {
 func (x *Feed) Reset() {
 	*x = Feed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[2]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6816,7 +11221,7 @@ func (*Feed) ProtoMessage() {}
 
 // Deprecated: Use Feed.ProtoReflect.Descriptor instead.
 func (*Feed) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{2}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Feed) GetSignalId() string {
@@ -6859,7 +11264,7 @@ type FeedWithDeviation struct {
 func (x *FeedWithDeviation) Reset() {
 	*x = FeedWithDeviation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[3]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6873,7 +11278,7 @@ func (*FeedWithDeviation) ProtoMessage() {}
 
 // Deprecated: Use FeedWithDeviation.ProtoReflect.Descriptor instead.
 func (*FeedWithDeviation) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{3}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FeedWithDeviation) GetSignalId() string {
@@ -6921,7 +11326,7 @@ type CurrentFeeds struct {
 func (x *CurrentFeeds) Reset() {
 	*x = CurrentFeeds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[4]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6935,7 +11340,7 @@ func (*CurrentFeeds) ProtoMessage() {}
 
 // Deprecated: Use CurrentFeeds.ProtoReflect.Descriptor instead.
 func (*CurrentFeeds) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{4}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CurrentFeeds) GetFeeds() []*Feed {
@@ -6977,7 +11382,7 @@ type CurrentFeedWithDeviations struct {
 func (x *CurrentFeedWithDeviations) Reset() {
 	*x = CurrentFeedWithDeviations{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[5]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6991,7 +11396,7 @@ func (*CurrentFeedWithDeviations) ProtoMessage() {}
 
 // Deprecated: Use CurrentFeedWithDeviations.ProtoReflect.Descriptor instead.
 func (*CurrentFeedWithDeviations) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{5}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CurrentFeedWithDeviations) GetFeeds() []*FeedWithDeviation {
@@ -7029,52 +11434,184 @@ type Price struct {
 	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
 	// timestamp is the timestamp at which the price was aggregated.
 	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// aggregation_method is the method that aggregated the validator prices into the price.
+	AggregationMethod AggregationMethod `protobuf:"varint,5,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=band.feeds.v1beta1.AggregationMethod" json:"aggregation_method,omitempty"`
+	// confidence is the metadata about the validator prices that the price was aggregated from. It is only set for
+	// available prices.
+	Confidence *PriceConfidence `protobuf:"bytes,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
 }
 
 func (x *Price) Reset() {
 	*x = Price{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[6]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Price) GetStatus() PriceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PriceStatus_PRICE_STATUS_UNSPECIFIED
+}
+
+func (x *Price) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *Price) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Price) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Price) GetAggregationMethod() AggregationMethod {
+	if x != nil {
+		return x.AggregationMethod
+	}
+	return AggregationMethod_AGGREGATION_METHOD_UNSPECIFIED
+}
+
+func (x *Price) GetConfidence() *PriceConfidence {
+	if x != nil {
+		return x.Confidence
+	}
+	return nil
+}
+
+// PriceConfidence is a structure that describes how many validators contributed to a price and how much their
+// prices agree.
+type PriceConfidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reporter_count is the number of validators that reported an available price.
+	ReporterCount uint64 `protobuf:"varint,1,opt,name=reporter_count,json=reporterCount,proto3" json:"reporter_count,omitempty"`
+	// power_share_bps is the power of the validators that reported an available price in basis points of the total
+	// bonded power.
+	PowerShareBps uint64 `protobuf:"varint,2,opt,name=power_share_bps,json=powerShareBps,proto3" json:"power_share_bps,omitempty"`
+	// dispersion is the power-weighted interquartile range of the available validator prices.
+	Dispersion uint64 `protobuf:"varint,3,opt,name=dispersion,proto3" json:"dispersion,omitempty"`
+}
+
+func (x *PriceConfidence) Reset() {
+	*x = PriceConfidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceConfidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceConfidence) ProtoMessage() {}
+
+// Deprecated: Use PriceConfidence.ProtoReflect.Descriptor instead.
+func (*PriceConfidence) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PriceConfidence) GetReporterCount() uint64 {
+	if x != nil {
+		return x.ReporterCount
+	}
+	return 0
+}
+
+func (x *PriceConfidence) GetPowerShareBps() uint64 {
+	if x != nil {
+		return x.PowerShareBps
+	}
+	return 0
+}
+
+func (x *PriceConfidence) GetDispersion() uint64 {
+	if x != nil {
+		return x.Dispersion
+	}
+	return 0
+}
+
+// SignalAggregationMethod is the aggregation method assigned to a signal id.
+type SignalAggregationMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the signal id that the aggregation method is assigned to.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// method is the aggregation method of the signal id.
+	Method AggregationMethod `protobuf:"varint,2,opt,name=method,proto3,enum=band.feeds.v1beta1.AggregationMethod" json:"method,omitempty"`
+	// min_reporters is the minimum number of validators that must report an available price for the price to be
+	// aggregated. Zero means no minimum.
+	MinReporters uint64 `protobuf:"varint,3,opt,name=min_reporters,json=minReporters,proto3" json:"min_reporters,omitempty"`
+}
+
+func (x *SignalAggregationMethod) Reset() {
+	*x = SignalAggregationMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Price) String() string {
+func (x *SignalAggregationMethod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Price) ProtoMessage() {}
-
-// Deprecated: Use Price.ProtoReflect.Descriptor instead.
-func (*Price) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{6}
-}
+func (*SignalAggregationMethod) ProtoMessage() {}
 
-func (x *Price) GetStatus() PriceStatus {
-	if x != nil {
-		return x.Status
-	}
-	return PriceStatus_PRICE_STATUS_UNSPECIFIED
+// Deprecated: Use SignalAggregationMethod.ProtoReflect.Descriptor instead.
+func (*SignalAggregationMethod) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Price) GetSignalId() string {
+func (x *SignalAggregationMethod) GetSignalId() string {
 	if x != nil {
 		return x.SignalId
 	}
 	return ""
 }
 
-func (x *Price) GetPrice() uint64 {
+func (x *SignalAggregationMethod) GetMethod() AggregationMethod {
 	if x != nil {
-		return x.Price
+		return x.Method
 	}
-	return 0
+	return AggregationMethod_AGGREGATION_METHOD_UNSPECIFIED
 }
 
-func (x *Price) GetTimestamp() int64 {
+func (x *SignalAggregationMethod) GetMinReporters() uint64 {
 	if x != nil {
-		return x.Timestamp
+		return x.MinReporters
 	}
 	return 0
 }
@@ -7096,7 +11633,7 @@ type SignalPrice struct {
 func (x *SignalPrice) Reset() {
 	*x = SignalPrice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7110,7 +11647,7 @@ func (*SignalPrice) ProtoMessage() {}
 
 // Deprecated: Use SignalPrice.ProtoReflect.Descriptor instead.
 func (*SignalPrice) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{7}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SignalPrice) GetStatus() SignalPriceStatus {
@@ -7155,7 +11692,7 @@ type ValidatorPrice struct {
 func (x *ValidatorPrice) Reset() {
 	*x = ValidatorPrice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[8]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7169,7 +11706,7 @@ func (*ValidatorPrice) ProtoMessage() {}
 
 // Deprecated: Use ValidatorPrice.ProtoReflect.Descriptor instead.
 func (*ValidatorPrice) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{8}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ValidatorPrice) GetSignalPriceStatus() SignalPriceStatus {
@@ -7223,7 +11760,7 @@ type ValidatorPriceList struct {
 func (x *ValidatorPriceList) Reset() {
 	*x = ValidatorPriceList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[9]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7237,7 +11774,7 @@ func (*ValidatorPriceList) ProtoMessage() {}
 
 // Deprecated: Use ValidatorPriceList.ProtoReflect.Descriptor instead.
 func (*ValidatorPriceList) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{9}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ValidatorPriceList) GetValidator() string {
@@ -7254,6 +11791,237 @@ func (x *ValidatorPriceList) GetValidatorPrices() []*ValidatorPrice {
 	return nil
 }
 
+// ValidatorAccuracy is a structure that tracks how close the prices of a validator are to the final prices
+// over its latest submissions.
+type ValidatorAccuracy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator is the validator address.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// window is the accuracy window that the accuracy is tracked with.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// submission_count is the number of submissions of the validator tracked since the accuracy was reset.
+	SubmissionCount uint64 `protobuf:"varint,3,opt,name=submission_count,json=submissionCount,proto3" json:"submission_count,omitempty"`
+	// report_count is the number of prices reported by the validator within the window.
+	ReportCount uint64 `protobuf:"varint,4,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// outlier_count is the number of outlier prices reported by the validator within the window.
+	OutlierCount uint64 `protobuf:"varint,5,opt,name=outlier_count,json=outlierCount,proto3" json:"outlier_count,omitempty"`
+	// accuracy_score_bps is the ratio (in basis point) of non-outlier prices to reported prices within the window.
+	AccuracyScoreBps uint64 `protobuf:"varint,6,opt,name=accuracy_score_bps,json=accuracyScoreBps,proto3" json:"accuracy_score_bps,omitempty"`
+}
+
+func (x *ValidatorAccuracy) Reset() {
+	*x = ValidatorAccuracy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorAccuracy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorAccuracy) ProtoMessage() {}
+
+// Deprecated: Use ValidatorAccuracy.ProtoReflect.Descriptor instead.
+func (*ValidatorAccuracy) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ValidatorAccuracy) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorAccuracy) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *ValidatorAccuracy) GetSubmissionCount() uint64 {
+	if x != nil {
+		return x.SubmissionCount
+	}
+	return 0
+}
+
+func (x *ValidatorAccuracy) GetReportCount() uint64 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *ValidatorAccuracy) GetOutlierCount() uint64 {
+	if x != nil {
+		return x.OutlierCount
+	}
+	return 0
+}
+
+func (x *ValidatorAccuracy) GetAccuracyScoreBps() uint64 {
+	if x != nil {
+		return x.AccuracyScoreBps
+	}
+	return 0
+}
+
+// AccuracyRecord is a structure that holds the number of reported and outlier prices of a single submission of a
+// validator.
+type AccuracyRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// report_count is the number of prices reported in the submission.
+	ReportCount uint64 `protobuf:"varint,1,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// outlier_count is the number of outlier prices reported in the submission.
+	OutlierCount uint64 `protobuf:"varint,2,opt,name=outlier_count,json=outlierCount,proto3" json:"outlier_count,omitempty"`
+}
+
+func (x *AccuracyRecord) Reset() {
+	*x = AccuracyRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccuracyRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccuracyRecord) ProtoMessage() {}
+
+// Deprecated: Use AccuracyRecord.ProtoReflect.Descriptor instead.
+func (*AccuracyRecord) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AccuracyRecord) GetReportCount() uint64 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *AccuracyRecord) GetOutlierCount() uint64 {
+	if x != nil {
+		return x.OutlierCount
+	}
+	return 0
+}
+
+// SignalMetadata is a structure that defines the metadata of a signal id in the signal registry.
+type SignalMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the signal id that the metadata describes.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// decimals is the number of decimals of the price of the signal id.
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// base_asset is the asset that is priced by the signal id.
+	BaseAsset string `protobuf:"bytes,3,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	// quote_asset is the asset that the price of the signal id is denominated in.
+	QuoteAsset string `protobuf:"bytes,4,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	// category is the category of the signal id, e.g. crypto, forex or commodity.
+	Category string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	// description is the description of the signal id.
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// status is the status of the signal id.
+	Status SignalMetadataStatus `protobuf:"varint,7,opt,name=status,proto3,enum=band.feeds.v1beta1.SignalMetadataStatus" json:"status,omitempty"`
+	// max_price_move_basis_point is the maximum move (in basis point) of the price of the signal id from its last
+	// available price before its circuit breaker is triggered. Zero falls back to the max_price_move_basis_point param.
+	MaxPriceMoveBasisPoint int64 `protobuf:"varint,8,opt,name=max_price_move_basis_point,json=maxPriceMoveBasisPoint,proto3" json:"max_price_move_basis_point,omitempty"`
+}
+
+func (x *SignalMetadata) Reset() {
+	*x = SignalMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalMetadata) ProtoMessage() {}
+
+// Deprecated: Use SignalMetadata.ProtoReflect.Descriptor instead.
+func (*SignalMetadata) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SignalMetadata) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *SignalMetadata) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *SignalMetadata) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *SignalMetadata) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *SignalMetadata) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SignalMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SignalMetadata) GetStatus() SignalMetadataStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SignalMetadataStatus_SIGNAL_METADATA_STATUS_UNSPECIFIED
+}
+
+func (x *SignalMetadata) GetMaxPriceMoveBasisPoint() int64 {
+	if x != nil {
+		return x.MaxPriceMoveBasisPoint
+	}
+	return 0
+}
+
 // ReferenceSourceConfig is a structure that defines the information of reference price source.
 type ReferenceSourceConfig struct {
 	state         protoimpl.MessageState
@@ -7269,7 +12037,7 @@ type ReferenceSourceConfig struct {
 func (x *ReferenceSourceConfig) Reset() {
 	*x = ReferenceSourceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[10]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7283,7 +12051,7 @@ func (*ReferenceSourceConfig) ProtoMessage() {}
 
 // Deprecated: Use ReferenceSourceConfig.ProtoReflect.Descriptor instead.
 func (*ReferenceSourceConfig) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{10}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReferenceSourceConfig) GetRegistryIpfsHash() string {
@@ -7315,7 +12083,7 @@ type FeedsSignatureOrder struct {
 func (x *FeedsSignatureOrder) Reset() {
 	*x = FeedsSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[11]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7329,7 +12097,7 @@ func (*FeedsSignatureOrder) ProtoMessage() {}
 
 // Deprecated: Use FeedsSignatureOrder.ProtoReflect.Descriptor instead.
 func (*FeedsSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{11}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *FeedsSignatureOrder) GetSignalIds() []string {
@@ -7369,57 +12137,108 @@ var file_band_feeds_v1beta1_feeds_proto_rawDesc = []byte{
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
 	0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0x69, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x46,
+	0x98, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36,
+	0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x0e, 0x43,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x29, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x69, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12,
+	0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44,
+	0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f,
+	0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0xaa, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73,
+	0x12, 0x34, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc4, 0x01, 0x0a,
+	0x19, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68,
+	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46,
 	0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49,
-	0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x32, 0x0a,
-	0x15, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73,
-	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65,
-	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
-	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65,
-	0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x32,
-	0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04,
-	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc4, 0x01, 0x0a, 0x19, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44,
-	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05,
-	0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa5, 0x01, 0x0a, 0x05,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x32, 0x0a,
+	0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0xc0, 0x02, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x54, 0x0a, 0x12, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x0f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x50, 0x53, 0x52, 0x0d, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xae, 0x01, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x29,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52,
-	0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x04, 0xe8,
+	0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x3a, 0x04, 0xe8,
 	0xa0, 0x1f, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72,
 	0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
@@ -7455,61 +12274,129 @@ var file_band_feeds_v1beta1_feeds_proto_rawDesc = []byte{
 	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63,
 	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8c,
-	0x01, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x42, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x49, 0x50, 0x46, 0x53, 0x48, 0x61, 0x73, 0x68, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x49, 0x70, 0x66, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x80, 0x01,
-	0x0a, 0x13, 0x46, 0x65, 0x65, 0x64, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x73, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
-	0x2a, 0xb4, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22,
-	0x0a, 0x1e, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x44,
-	0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x1a,
-	0x0a, 0x16, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
-	0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52,
-	0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49,
-	0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x45, 0x45, 0x44, 0x53, 0x10,
-	0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xab, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a,
-	0x1f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49,
-	0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50,
-	0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x49, 0x47, 0x4e, 0x41,
-	0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
-	0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d,
-	0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x1a,
-	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xd4, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x42, 0x0a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e,
-	0x64, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca,
-	0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64,
-	0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65,
-	0x65, 0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa9,
+	0x02, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x29, 0x0a,
+	0x10, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f,
+	0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x42, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde,
+	0x1f, 0x10, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x42,
+	0x50, 0x53, 0x52, 0x10, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x42, 0x70, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x5e, 0x0a, 0x0e, 0x41, 0x63,
+	0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xd9, 0x02, 0x0a, 0x0e, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x42, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x70, 0x66,
+	0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xde,
+	0x1f, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x49, 0x50, 0x46, 0x53, 0x48, 0x61,
+	0x73, 0x68, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x49, 0x70, 0x66, 0x73,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x46, 0x65, 0x65, 0x64, 0x73, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x73,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x2a, 0xcd, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x43,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53,
+	0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52,
+	0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e,
+	0x54, 0x5f, 0x46, 0x45, 0x45, 0x44, 0x53, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x41, 0x4c, 0x54, 0x45, 0x44,
+	0x10, 0x05, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xa9, 0x01, 0x0a, 0x11, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x22,
+	0x0a, 0x1e, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45,
+	0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45,
+	0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x47,
+	0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44,
+	0x5f, 0x54, 0x52, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x02, 0x12,
+	0x1d, 0x0a, 0x19, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x03, 0x1a, 0x04,
+	0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xab, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x49,
+	0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x23, 0x0a, 0x1f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50,
+	0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x41, 0x56,
+	0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x49, 0x47,
+	0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x2a, 0x8e, 0x01, 0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x22, 0x53,
+	0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x45,
+	0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c,
+	0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x44, 0x45, 0x50, 0x52, 0x45, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x04, 0x88,
+	0xa3, 0x1e, 0x00, 0x42, 0xd4, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a,
+	0x46, 0x65, 0x65, 0x64, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e,
+	0x46, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12,
+	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c,
+	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64,
+	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -7524,39 +12411,52 @@ func file_band_feeds_v1beta1_feeds_proto_rawDescGZIP() []byte {
 	return file_band_feeds_v1beta1_feeds_proto_rawDescData
 }
 
-var file_band_feeds_v1beta1_feeds_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_band_feeds_v1beta1_feeds_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_band_feeds_v1beta1_feeds_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_band_feeds_v1beta1_feeds_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_band_feeds_v1beta1_feeds_proto_goTypes = []interface{}{
 	(PriceStatus)(0),                  // 0: band.feeds.v1beta1.PriceStatus
-	(SignalPriceStatus)(0),            // 1: band.feeds.v1beta1.SignalPriceStatus
-	(*Signal)(nil),                    // 2: band.feeds.v1beta1.Signal
-	(*Vote)(nil),                      // 3: band.feeds.v1beta1.Vote
-	(*Feed)(nil),                      // 4: band.feeds.v1beta1.Feed
-	(*FeedWithDeviation)(nil),         // 5: band.feeds.v1beta1.FeedWithDeviation
-	(*CurrentFeeds)(nil),              // 6: band.feeds.v1beta1.CurrentFeeds
-	(*CurrentFeedWithDeviations)(nil), // 7: band.feeds.v1beta1.CurrentFeedWithDeviations
-	(*Price)(nil),                     // 8: band.feeds.v1beta1.Price
-	(*SignalPrice)(nil),               // 9: band.feeds.v1beta1.SignalPrice
-	(*ValidatorPrice)(nil),            // 10: band.feeds.v1beta1.ValidatorPrice
-	(*ValidatorPriceList)(nil),        // 11: band.feeds.v1beta1.ValidatorPriceList
-	(*ReferenceSourceConfig)(nil),     // 12: band.feeds.v1beta1.ReferenceSourceConfig
-	(*FeedsSignatureOrder)(nil),       // 13: band.feeds.v1beta1.FeedsSignatureOrder
-	(Encoder)(0),                      // 14: band.feeds.v1beta1.Encoder
+	(AggregationMethod)(0),            // 1: band.feeds.v1beta1.AggregationMethod
+	(SignalPriceStatus)(0),            // 2: band.feeds.v1beta1.SignalPriceStatus
+	(SignalMetadataStatus)(0),         // 3: band.feeds.v1beta1.SignalMetadataStatus
+	(*Signal)(nil),                    // 4: band.feeds.v1beta1.Signal
+	(*Vote)(nil),                      // 5: band.feeds.v1beta1.Vote
+	(*Delegation)(nil),                // 6: band.feeds.v1beta1.Delegation
+	(*CircuitBreaker)(nil),            // 7: band.feeds.v1beta1.CircuitBreaker
+	(*Feed)(nil),                      // 8: band.feeds.v1beta1.Feed
+	(*FeedWithDeviation)(nil),         // 9: band.feeds.v1beta1.FeedWithDeviation
+	(*CurrentFeeds)(nil),              // 10: band.feeds.v1beta1.CurrentFeeds
+	(*CurrentFeedWithDeviations)(nil), // 11: band.feeds.v1beta1.CurrentFeedWithDeviations
+	(*Price)(nil),                     // 12: band.feeds.v1beta1.Price
+	(*PriceConfidence)(nil),           // 13: band.feeds.v1beta1.PriceConfidence
+	(*SignalAggregationMethod)(nil),   // 14: band.feeds.v1beta1.SignalAggregationMethod
+	(*SignalPrice)(nil),               // 15: band.feeds.v1beta1.SignalPrice
+	(*ValidatorPrice)(nil),            // 16: band.feeds.v1beta1.ValidatorPrice
+	(*ValidatorPriceList)(nil),        // 17: band.feeds.v1beta1.ValidatorPriceList
+	(*ValidatorAccuracy)(nil),         // 18: band.feeds.v1beta1.ValidatorAccuracy
+	(*AccuracyRecord)(nil),            // 19: band.feeds.v1beta1.AccuracyRecord
+	(*SignalMetadata)(nil),            // 20: band.feeds.v1beta1.SignalMetadata
+	(*ReferenceSourceConfig)(nil),     // 21: band.feeds.v1beta1.ReferenceSourceConfig
+	(*FeedsSignatureOrder)(nil),       // 22: band.feeds.v1beta1.FeedsSignatureOrder
+	(Encoder)(0),                      // 23: band.feeds.v1beta1.Encoder
 }
 var file_band_feeds_v1beta1_feeds_proto_depIdxs = []int32{
-	2,  // 0: band.feeds.v1beta1.Vote.signals:type_name -> band.feeds.v1beta1.Signal
-	4,  // 1: band.feeds.v1beta1.CurrentFeeds.feeds:type_name -> band.feeds.v1beta1.Feed
-	5,  // 2: band.feeds.v1beta1.CurrentFeedWithDeviations.feeds:type_name -> band.feeds.v1beta1.FeedWithDeviation
+	4,  // 0: band.feeds.v1beta1.Vote.signals:type_name -> band.feeds.v1beta1.Signal
+	8,  // 1: band.feeds.v1beta1.CurrentFeeds.feeds:type_name -> band.feeds.v1beta1.Feed
+	9,  // 2: band.feeds.v1beta1.CurrentFeedWithDeviations.feeds:type_name -> band.feeds.v1beta1.FeedWithDeviation
 	0,  // 3: band.feeds.v1beta1.Price.status:type_name -> band.feeds.v1beta1.PriceStatus
-	1,  // 4: band.feeds.v1beta1.SignalPrice.status:type_name -> band.feeds.v1beta1.SignalPriceStatus
-	1,  // 5: band.feeds.v1beta1.ValidatorPrice.signal_price_status:type_name -> band.feeds.v1beta1.SignalPriceStatus
-	10, // 6: band.feeds.v1beta1.ValidatorPriceList.validator_prices:type_name -> band.feeds.v1beta1.ValidatorPrice
-	14, // 7: band.feeds.v1beta1.FeedsSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	1,  // 4: band.feeds.v1beta1.Price.aggregation_method:type_name -> band.feeds.v1beta1.AggregationMethod
+	13, // 5: band.feeds.v1beta1.Price.confidence:type_name -> band.feeds.v1beta1.PriceConfidence
+	1,  // 6: band.feeds.v1beta1.SignalAggregationMethod.method:type_name -> band.feeds.v1beta1.AggregationMethod
+	2,  // 7: band.feeds.v1beta1.SignalPrice.status:type_name -> band.feeds.v1beta1.SignalPriceStatus
+	2,  // 8: band.feeds.v1beta1.ValidatorPrice.signal_price_status:type_name -> band.feeds.v1beta1.SignalPriceStatus
+	16, // 9: band.feeds.v1beta1.ValidatorPriceList.validator_prices:type_name -> band.feeds.v1beta1.ValidatorPrice
+	3,  // 10: band.feeds.v1beta1.SignalMetadata.status:type_name -> band.feeds.v1beta1.SignalMetadataStatus
+	23, // 11: band.feeds.v1beta1.FeedsSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_band_feeds_v1beta1_feeds_proto_init() }
@@ -7591,7 +12491,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Feed); i {
+			switch v := v.(*Delegation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7603,7 +12503,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeedWithDeviation); i {
+			switch v := v.(*CircuitBreaker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7615,7 +12515,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrentFeeds); i {
+			switch v := v.(*Feed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7627,7 +12527,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrentFeedWithDeviations); i {
+			switch v := v.(*FeedWithDeviation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7639,7 +12539,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Price); i {
+			switch v := v.(*CurrentFeeds); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7651,7 +12551,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignalPrice); i {
+			switch v := v.(*CurrentFeedWithDeviations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7663,7 +12563,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorPrice); i {
+			switch v := v.(*Price); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7675,7 +12575,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorPriceList); i {
+			switch v := v.(*PriceConfidence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7687,7 +12587,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReferenceSourceConfig); i {
+			switch v := v.(*SignalAggregationMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7699,6 +12599,90 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPriceList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorAccuracy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccuracyRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReferenceSourceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FeedsSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -7716,8 +12700,8 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_feeds_v1beta1_feeds_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      4,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -64,11 +64,167 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*SignalMetadata
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalMetadata)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalMetadata)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(SignalMetadata)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(SignalMetadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*Delegation
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Delegation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Delegation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(Delegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(Delegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*CircuitBreaker
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CircuitBreaker)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CircuitBreaker)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(CircuitBreaker)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(CircuitBreaker)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                         protoreflect.MessageDescriptor
 	fd_GenesisState_params                  protoreflect.FieldDescriptor
 	fd_GenesisState_votes                   protoreflect.FieldDescriptor
 	fd_GenesisState_reference_source_config protoreflect.FieldDescriptor
+	fd_GenesisState_signal_metadata         protoreflect.FieldDescriptor
+	fd_GenesisState_delegations             protoreflect.FieldDescriptor
+	fd_GenesisState_circuit_breakers        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -77,6 +233,9 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_votes = md_GenesisState.Fields().ByName("votes")
 	fd_GenesisState_reference_source_config = md_GenesisState.Fields().ByName("reference_source_config")
+	fd_GenesisState_signal_metadata = md_GenesisState.Fields().ByName("signal_metadata")
+	fd_GenesisState_delegations = md_GenesisState.Fields().ByName("delegations")
+	fd_GenesisState_circuit_breakers = md_GenesisState.Fields().ByName("circuit_breakers")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -162,6 +321,24 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.SignalMetadata) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.SignalMetadata})
+		if !f(fd_GenesisState_signal_metadata, value) {
+			return
+		}
+	}
+	if len(x.Delegations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Delegations})
+		if !f(fd_GenesisState_delegations, value) {
+			return
+		}
+	}
+	if len(x.CircuitBreakers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.CircuitBreakers})
+		if !f(fd_GenesisState_circuit_breakers, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -183,6 +360,12 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Votes) != 0
 	case "band.feeds.v1beta1.GenesisState.reference_source_config":
 		return x.ReferenceSourceConfig != nil
+	case "band.feeds.v1beta1.GenesisState.signal_metadata":
+		return len(x.SignalMetadata) != 0
+	case "band.feeds.v1beta1.GenesisState.delegations":
+		return len(x.Delegations) != 0
+	case "band.feeds.v1beta1.GenesisState.circuit_breakers":
+		return len(x.CircuitBreakers) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.GenesisState"))
@@ -205,6 +388,12 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Votes = nil
 	case "band.feeds.v1beta1.GenesisState.reference_source_config":
 		x.ReferenceSourceConfig = nil
+	case "band.feeds.v1beta1.GenesisState.signal_metadata":
+		x.SignalMetadata = nil
+	case "band.feeds.v1beta1.GenesisState.delegations":
+		x.Delegations = nil
+	case "band.feeds.v1beta1.GenesisState.circuit_breakers":
+		x.CircuitBreakers = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.GenesisState"))
@@ -233,6 +422,24 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "band.feeds.v1beta1.GenesisState.reference_source_config":
 		value := x.ReferenceSourceConfig
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.feeds.v1beta1.GenesisState.signal_metadata":
+		if len(x.SignalMetadata) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.SignalMetadata}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.GenesisState.delegations":
+		if len(x.Delegations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.GenesisState.circuit_breakers":
+		if len(x.CircuitBreakers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.CircuitBreakers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.GenesisState"))
@@ -261,6 +468,18 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Votes = *clv.list
 	case "band.feeds.v1beta1.GenesisState.reference_source_config":
 		x.ReferenceSourceConfig = value.Message().Interface().(*ReferenceSourceConfig)
+	case "band.feeds.v1beta1.GenesisState.signal_metadata":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.SignalMetadata = *clv.list
+	case "band.feeds.v1beta1.GenesisState.delegations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Delegations = *clv.list
+	case "band.feeds.v1beta1.GenesisState.circuit_breakers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.CircuitBreakers = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.GenesisState"))
@@ -297,6 +516,24 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.ReferenceSourceConfig = new(ReferenceSourceConfig)
 		}
 		return protoreflect.ValueOfMessage(x.ReferenceSourceConfig.ProtoReflect())
+	case "band.feeds.v1beta1.GenesisState.signal_metadata":
+		if x.SignalMetadata == nil {
+			x.SignalMetadata = []*SignalMetadata{}
+		}
+		value := &_GenesisState_4_list{list: &x.SignalMetadata}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.GenesisState.delegations":
+		if x.Delegations == nil {
+			x.Delegations = []*Delegation{}
+		}
+		value := &_GenesisState_5_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.GenesisState.circuit_breakers":
+		if x.CircuitBreakers == nil {
+			x.CircuitBreakers = []*CircuitBreaker{}
+		}
+		value := &_GenesisState_6_list{list: &x.CircuitBreakers}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.GenesisState"))
@@ -319,6 +556,15 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "band.feeds.v1beta1.GenesisState.reference_source_config":
 		m := new(ReferenceSourceConfig)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.feeds.v1beta1.GenesisState.signal_metadata":
+		list := []*SignalMetadata{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "band.feeds.v1beta1.GenesisState.delegations":
+		list := []*Delegation{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "band.feeds.v1beta1.GenesisState.circuit_breakers":
+		list := []*CircuitBreaker{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.GenesisState"))
@@ -402,6 +648,24 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.ReferenceSourceConfig)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.SignalMetadata) > 0 {
+			for _, e := range x.SignalMetadata {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Delegations) > 0 {
+			for _, e := range x.Delegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CircuitBreakers) > 0 {
+			for _, e := range x.CircuitBreakers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -431,6 +695,54 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.CircuitBreakers) > 0 {
+			for iNdEx := len(x.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CircuitBreakers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Delegations) > 0 {
+			for iNdEx := len(x.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Delegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.SignalMetadata) > 0 {
+			for iNdEx := len(x.SignalMetadata) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SignalMetadata[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if x.ReferenceSourceConfig != nil {
 			encoded, err := options.Marshal(x.ReferenceSourceConfig)
 			if err != nil {
@@ -630,6 +942,108 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalMetadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalMetadata = append(x.SignalMetadata, &SignalMetadata{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignalMetadata[len(x.SignalMetadata)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegations = append(x.Delegations, &Delegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegations[len(x.Delegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CircuitBreakers = append(x.CircuitBreakers, &CircuitBreaker{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CircuitBreakers[len(x.CircuitBreakers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -690,6 +1104,12 @@ type GenesisState struct {
 	Votes []*Vote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
 	// reference_source_config is the information about reference price config.
 	ReferenceSourceConfig *ReferenceSourceConfig `protobuf:"bytes,3,opt,name=reference_source_config,json=referenceSourceConfig,proto3" json:"reference_source_config,omitempty"`
+	// signal_metadata is a list of metadata of the signal ids in the signal registry.
+	SignalMetadata []*SignalMetadata `protobuf:"bytes,4,rep,name=signal_metadata,json=signalMetadata,proto3" json:"signal_metadata,omitempty"`
+	// delegations is a list of delegations of feeds voting power.
+	Delegations []*Delegation `protobuf:"bytes,5,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// circuit_breakers is a list of triggered circuit breakers.
+	CircuitBreakers []*CircuitBreaker `protobuf:"bytes,6,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -733,6 +1153,27 @@ func (x *GenesisState) GetReferenceSourceConfig() *ReferenceSourceConfig {
 	return nil
 }
 
+func (x *GenesisState) GetSignalMetadata() []*SignalMetadata {
+	if x != nil {
+		return x.SignalMetadata
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDelegations() []*Delegation {
+	if x != nil {
+		return x.Delegations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCircuitBreakers() []*CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreakers
+	}
+	return nil
+}
+
 var File_band_feeds_v1beta1_genesis_proto protoreflect.FileDescriptor
 
 var file_band_feeds_v1beta1_genesis_proto_rawDesc = []byte{
@@ -744,7 +1185,7 @@ var file_band_feeds_v1beta1_genesis_proto_rawDesc = []byte{
 	0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
 	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x62,
 	0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x01,
+	0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd7, 0x03,
 	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38,
 	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
@@ -759,7 +1200,22 @@ var file_band_feeds_v1beta1_genesis_proto_rawDesc = []byte{
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
 	0x52, 0x15, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0xd6, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x51, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x46, 0x0a, 0x0b, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x53, 0x0a, 0x10, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x42, 0xd6, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e,
 	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
 	0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
@@ -794,16 +1250,22 @@ var file_band_feeds_v1beta1_genesis_proto_goTypes = []interface{}{
 	(*Params)(nil),                // 1: band.feeds.v1beta1.Params
 	(*Vote)(nil),                  // 2: band.feeds.v1beta1.Vote
 	(*ReferenceSourceConfig)(nil), // 3: band.feeds.v1beta1.ReferenceSourceConfig
+	(*SignalMetadata)(nil),        // 4: band.feeds.v1beta1.SignalMetadata
+	(*Delegation)(nil),            // 5: band.feeds.v1beta1.Delegation
+	(*CircuitBreaker)(nil),        // 6: band.feeds.v1beta1.CircuitBreaker
 }
 var file_band_feeds_v1beta1_genesis_proto_depIdxs = []int32{
 	1, // 0: band.feeds.v1beta1.GenesisState.params:type_name -> band.feeds.v1beta1.Params
 	2, // 1: band.feeds.v1beta1.GenesisState.votes:type_name -> band.feeds.v1beta1.Vote
 	3, // 2: band.feeds.v1beta1.GenesisState.reference_source_config:type_name -> band.feeds.v1beta1.ReferenceSourceConfig
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: band.feeds.v1beta1.GenesisState.signal_metadata:type_name -> band.feeds.v1beta1.SignalMetadata
+	5, // 4: band.feeds.v1beta1.GenesisState.delegations:type_name -> band.feeds.v1beta1.Delegation
+	6, // 5: band.feeds.v1beta1.GenesisState.circuit_breakers:type_name -> band.feeds.v1beta1.CircuitBreaker
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_band_feeds_v1beta1_genesis_proto_init() }
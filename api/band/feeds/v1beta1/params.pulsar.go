@@ -14,6 +14,57 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_16_list)(nil)
+
+type _Params_16_list struct {
+	list *[]*SignalAggregationMethod
+}
+
+func (x *_Params_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalAggregationMethod)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalAggregationMethod)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_16_list) AppendMutable() protoreflect.Value {
+	v := new(SignalAggregationMethod)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_16_list) NewElement() protoreflect.Value {
+	v := new(SignalAggregationMethod)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_16_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                                  protoreflect.MessageDescriptor
 	fd_Params_admin                            protoreflect.FieldDescriptor
@@ -29,6 +80,14 @@ var (
 	fd_Params_current_feeds_update_interval    protoreflect.FieldDescriptor
 	fd_Params_price_quorum                     protoreflect.FieldDescriptor
 	fd_Params_max_signal_ids_per_signing       protoreflect.FieldDescriptor
+	fd_Params_price_history_size               protoreflect.FieldDescriptor
+	fd_Params_price_history_interval           protoreflect.FieldDescriptor
+	fd_Params_signal_aggregation_methods       protoreflect.FieldDescriptor
+	fd_Params_outlier_deviation_basis_point    protoreflect.FieldDescriptor
+	fd_Params_accuracy_window                  protoreflect.FieldDescriptor
+	fd_Params_max_outlier_basis_point          protoreflect.FieldDescriptor
+	fd_Params_require_registered_signals       protoreflect.FieldDescriptor
+	fd_Params_max_price_move_basis_point       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -47,6 +106,14 @@ func init() {
 	fd_Params_current_feeds_update_interval = md_Params.Fields().ByName("current_feeds_update_interval")
 	fd_Params_price_quorum = md_Params.Fields().ByName("price_quorum")
 	fd_Params_max_signal_ids_per_signing = md_Params.Fields().ByName("max_signal_ids_per_signing")
+	fd_Params_price_history_size = md_Params.Fields().ByName("price_history_size")
+	fd_Params_price_history_interval = md_Params.Fields().ByName("price_history_interval")
+	fd_Params_signal_aggregation_methods = md_Params.Fields().ByName("signal_aggregation_methods")
+	fd_Params_outlier_deviation_basis_point = md_Params.Fields().ByName("outlier_deviation_basis_point")
+	fd_Params_accuracy_window = md_Params.Fields().ByName("accuracy_window")
+	fd_Params_max_outlier_basis_point = md_Params.Fields().ByName("max_outlier_basis_point")
+	fd_Params_require_registered_signals = md_Params.Fields().ByName("require_registered_signals")
+	fd_Params_max_price_move_basis_point = md_Params.Fields().ByName("max_price_move_basis_point")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -192,6 +259,54 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PriceHistorySize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceHistorySize)
+		if !f(fd_Params_price_history_size, value) {
+			return
+		}
+	}
+	if x.PriceHistoryInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PriceHistoryInterval)
+		if !f(fd_Params_price_history_interval, value) {
+			return
+		}
+	}
+	if len(x.SignalAggregationMethods) != 0 {
+		value := protoreflect.ValueOfList(&_Params_16_list{list: &x.SignalAggregationMethods})
+		if !f(fd_Params_signal_aggregation_methods, value) {
+			return
+		}
+	}
+	if x.OutlierDeviationBasisPoint != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OutlierDeviationBasisPoint)
+		if !f(fd_Params_outlier_deviation_basis_point, value) {
+			return
+		}
+	}
+	if x.AccuracyWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AccuracyWindow)
+		if !f(fd_Params_accuracy_window, value) {
+			return
+		}
+	}
+	if x.MaxOutlierBasisPoint != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxOutlierBasisPoint)
+		if !f(fd_Params_max_outlier_basis_point, value) {
+			return
+		}
+	}
+	if x.RequireRegisteredSignals != false {
+		value := protoreflect.ValueOfBool(x.RequireRegisteredSignals)
+		if !f(fd_Params_require_registered_signals, value) {
+			return
+		}
+	}
+	if x.MaxPriceMoveBasisPoint != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxPriceMoveBasisPoint)
+		if !f(fd_Params_max_price_move_basis_point, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -233,6 +348,22 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.PriceQuorum != ""
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		return x.MaxSignalIdsPerSigning != uint64(0)
+	case "band.feeds.v1beta1.Params.price_history_size":
+		return x.PriceHistorySize != uint64(0)
+	case "band.feeds.v1beta1.Params.price_history_interval":
+		return x.PriceHistoryInterval != int64(0)
+	case "band.feeds.v1beta1.Params.signal_aggregation_methods":
+		return len(x.SignalAggregationMethods) != 0
+	case "band.feeds.v1beta1.Params.outlier_deviation_basis_point":
+		return x.OutlierDeviationBasisPoint != int64(0)
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		return x.AccuracyWindow != uint64(0)
+	case "band.feeds.v1beta1.Params.max_outlier_basis_point":
+		return x.MaxOutlierBasisPoint != int64(0)
+	case "band.feeds.v1beta1.Params.require_registered_signals":
+		return x.RequireRegisteredSignals != false
+	case "band.feeds.v1beta1.Params.max_price_move_basis_point":
+		return x.MaxPriceMoveBasisPoint != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -275,6 +406,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.PriceQuorum = ""
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		x.MaxSignalIdsPerSigning = uint64(0)
+	case "band.feeds.v1beta1.Params.price_history_size":
+		x.PriceHistorySize = uint64(0)
+	case "band.feeds.v1beta1.Params.price_history_interval":
+		x.PriceHistoryInterval = int64(0)
+	case "band.feeds.v1beta1.Params.signal_aggregation_methods":
+		x.SignalAggregationMethods = nil
+	case "band.feeds.v1beta1.Params.outlier_deviation_basis_point":
+		x.OutlierDeviationBasisPoint = int64(0)
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		x.AccuracyWindow = uint64(0)
+	case "band.feeds.v1beta1.Params.max_outlier_basis_point":
+		x.MaxOutlierBasisPoint = int64(0)
+	case "band.feeds.v1beta1.Params.require_registered_signals":
+		x.RequireRegisteredSignals = false
+	case "band.feeds.v1beta1.Params.max_price_move_basis_point":
+		x.MaxPriceMoveBasisPoint = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -330,6 +477,33 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		value := x.MaxSignalIdsPerSigning
 		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.Params.price_history_size":
+		value := x.PriceHistorySize
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.Params.price_history_interval":
+		value := x.PriceHistoryInterval
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.Params.signal_aggregation_methods":
+		if len(x.SignalAggregationMethods) == 0 {
+			return protoreflect.ValueOfList(&_Params_16_list{})
+		}
+		listValue := &_Params_16_list{list: &x.SignalAggregationMethods}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.Params.outlier_deviation_basis_point":
+		value := x.OutlierDeviationBasisPoint
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		value := x.AccuracyWindow
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.Params.max_outlier_basis_point":
+		value := x.MaxOutlierBasisPoint
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.Params.require_registered_signals":
+		value := x.RequireRegisteredSignals
+		return protoreflect.ValueOfBool(value)
+	case "band.feeds.v1beta1.Params.max_price_move_basis_point":
+		value := x.MaxPriceMoveBasisPoint
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -376,6 +550,24 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.PriceQuorum = value.Interface().(string)
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		x.MaxSignalIdsPerSigning = value.Uint()
+	case "band.feeds.v1beta1.Params.price_history_size":
+		x.PriceHistorySize = value.Uint()
+	case "band.feeds.v1beta1.Params.price_history_interval":
+		x.PriceHistoryInterval = value.Int()
+	case "band.feeds.v1beta1.Params.signal_aggregation_methods":
+		lv := value.List()
+		clv := lv.(*_Params_16_list)
+		x.SignalAggregationMethods = *clv.list
+	case "band.feeds.v1beta1.Params.outlier_deviation_basis_point":
+		x.OutlierDeviationBasisPoint = value.Int()
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		x.AccuracyWindow = value.Uint()
+	case "band.feeds.v1beta1.Params.max_outlier_basis_point":
+		x.MaxOutlierBasisPoint = value.Int()
+	case "band.feeds.v1beta1.Params.require_registered_signals":
+		x.RequireRegisteredSignals = value.Bool()
+	case "band.feeds.v1beta1.Params.max_price_move_basis_point":
+		x.MaxPriceMoveBasisPoint = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -396,6 +588,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.feeds.v1beta1.Params.signal_aggregation_methods":
+		if x.SignalAggregationMethods == nil {
+			x.SignalAggregationMethods = []*SignalAggregationMethod{}
+		}
+		value := &_Params_16_list{list: &x.SignalAggregationMethods}
+		return protoreflect.ValueOfList(value)
 	case "band.feeds.v1beta1.Params.admin":
 		panic(fmt.Errorf("field admin of message band.feeds.v1beta1.Params is not mutable"))
 	case "band.feeds.v1beta1.Params.allowable_block_time_discrepancy":
@@ -422,6 +620,20 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field price_quorum of message band.feeds.v1beta1.Params is not mutable"))
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		panic(fmt.Errorf("field max_signal_ids_per_signing of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.price_history_size":
+		panic(fmt.Errorf("field price_history_size of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.price_history_interval":
+		panic(fmt.Errorf("field price_history_interval of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.outlier_deviation_basis_point":
+		panic(fmt.Errorf("field outlier_deviation_basis_point of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		panic(fmt.Errorf("field accuracy_window of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.max_outlier_basis_point":
+		panic(fmt.Errorf("field max_outlier_basis_point of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.require_registered_signals":
+		panic(fmt.Errorf("field require_registered_signals of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.max_price_move_basis_point":
+		panic(fmt.Errorf("field max_price_move_basis_point of message band.feeds.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -461,6 +673,23 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.Params.price_history_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.Params.price_history_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.Params.signal_aggregation_methods":
+		list := []*SignalAggregationMethod{}
+		return protoreflect.ValueOfList(&_Params_16_list{list: &list})
+	case "band.feeds.v1beta1.Params.outlier_deviation_basis_point":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.Params.accuracy_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.Params.max_outlier_basis_point":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.Params.require_registered_signals":
+		return protoreflect.ValueOfBool(false)
+	case "band.feeds.v1beta1.Params.max_price_move_basis_point":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -571,6 +800,33 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.MaxSignalIdsPerSigning != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxSignalIdsPerSigning))
 		}
+		if x.PriceHistorySize != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceHistorySize))
+		}
+		if x.PriceHistoryInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceHistoryInterval))
+		}
+		if len(x.SignalAggregationMethods) > 0 {
+			for _, e := range x.SignalAggregationMethods {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.OutlierDeviationBasisPoint != 0 {
+			n += 2 + runtime.Sov(uint64(x.OutlierDeviationBasisPoint))
+		}
+		if x.AccuracyWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.AccuracyWindow))
+		}
+		if x.MaxOutlierBasisPoint != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxOutlierBasisPoint))
+		}
+		if x.RequireRegisteredSignals {
+			n += 3
+		}
+		if x.MaxPriceMoveBasisPoint != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxPriceMoveBasisPoint))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -600,6 +856,74 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxPriceMoveBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceMoveBasisPoint))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if x.RequireRegisteredSignals {
+			i--
+			if x.RequireRegisteredSignals {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.MaxOutlierBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxOutlierBasisPoint))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.AccuracyWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccuracyWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.OutlierDeviationBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutlierDeviationBasisPoint))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.SignalAggregationMethods) > 0 {
+			for iNdEx := len(x.SignalAggregationMethods) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SignalAggregationMethods[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.PriceHistoryInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceHistoryInterval))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.PriceHistorySize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceHistorySize))
+			i--
+			dAtA[i] = 0x70
+		}
 		if x.MaxSignalIdsPerSigning != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSignalIdsPerSigning))
 			i--
@@ -991,6 +1315,174 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistorySize", wireType)
+				}
+				x.PriceHistorySize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceHistorySize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryInterval", wireType)
+				}
+				x.PriceHistoryInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceHistoryInterval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalAggregationMethods", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalAggregationMethods = append(x.SignalAggregationMethods, &SignalAggregationMethod{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignalAggregationMethods[len(x.SignalAggregationMethods)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutlierDeviationBasisPoint", wireType)
+				}
+				x.OutlierDeviationBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutlierDeviationBasisPoint |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccuracyWindow", wireType)
+				}
+				x.AccuracyWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccuracyWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxOutlierBasisPoint", wireType)
+				}
+				x.MaxOutlierBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxOutlierBasisPoint |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequireRegisteredSignals", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RequireRegisteredSignals = bool(v != 0)
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceMoveBasisPoint", wireType)
+				}
+				x.MaxPriceMoveBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceMoveBasisPoint |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1074,6 +1566,30 @@ type Params struct {
 	PriceQuorum string `protobuf:"bytes,12,opt,name=price_quorum,json=priceQuorum,proto3" json:"price_quorum,omitempty"`
 	// max_signal_ids_per_signing is the maximum number of signals allowed in a single tss signing request.
 	MaxSignalIdsPerSigning uint64 `protobuf:"varint,13,opt,name=max_signal_ids_per_signing,json=maxSignalIdsPerSigning,proto3" json:"max_signal_ids_per_signing,omitempty"`
+	// price_history_size is the maximum number of historical prices kept for each signal. Zero disables
+	// the price history.
+	PriceHistorySize uint64 `protobuf:"varint,14,opt,name=price_history_size,json=priceHistorySize,proto3" json:"price_history_size,omitempty"`
+	// price_history_interval is the minimum time (in seconds) between two historical prices of a signal.
+	PriceHistoryInterval int64 `protobuf:"varint,15,opt,name=price_history_interval,json=priceHistoryInterval,proto3" json:"price_history_interval,omitempty"`
+	// signal_aggregation_methods is the list of signal ids that are aggregated with a method other than the default
+	// weighted median.
+	SignalAggregationMethods []*SignalAggregationMethod `protobuf:"bytes,16,rep,name=signal_aggregation_methods,json=signalAggregationMethods,proto3" json:"signal_aggregation_methods,omitempty"`
+	// outlier_deviation_basis_point is the deviation (in basis point) from the final price above which a validator
+	// price is counted as an outlier.
+	OutlierDeviationBasisPoint int64 `protobuf:"varint,17,opt,name=outlier_deviation_basis_point,json=outlierDeviationBasisPoint,proto3" json:"outlier_deviation_basis_point,omitempty"`
+	// accuracy_window is the number of latest submissions of a validator over which its accuracy is tracked. Zero
+	// disables the accuracy tracking.
+	AccuracyWindow uint64 `protobuf:"varint,18,opt,name=accuracy_window,json=accuracyWindow,proto3" json:"accuracy_window,omitempty"`
+	// max_outlier_basis_point is the maximum ratio (in basis point) of outlier prices to reported prices of a validator
+	// over a full accuracy window. A validator above it is deactivated. Zero disables the penalty.
+	MaxOutlierBasisPoint int64 `protobuf:"varint,19,opt,name=max_outlier_basis_point,json=maxOutlierBasisPoint,proto3" json:"max_outlier_basis_point,omitempty"`
+	// require_registered_signals is the flag to reject votes for signal ids that are not active in the signal registry.
+	// Otherwise, such votes are accepted and flagged with an event.
+	RequireRegisteredSignals bool `protobuf:"varint,20,opt,name=require_registered_signals,json=requireRegisteredSignals,proto3" json:"require_registered_signals,omitempty"`
+	// max_price_move_basis_point is the maximum move (in basis point) of the price of a signal id from its last available
+	// price. A larger move triggers the circuit breaker of the signal id and halts its price. Zero disables the circuit
+	// breaker.
+	MaxPriceMoveBasisPoint int64 `protobuf:"varint,21,opt,name=max_price_move_basis_point,json=maxPriceMoveBasisPoint,proto3" json:"max_price_move_basis_point,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1187,6 +1703,62 @@ func (x *Params) GetMaxSignalIdsPerSigning() uint64 {
 	return 0
 }
 
+func (x *Params) GetPriceHistorySize() uint64 {
+	if x != nil {
+		return x.PriceHistorySize
+	}
+	return 0
+}
+
+func (x *Params) GetPriceHistoryInterval() int64 {
+	if x != nil {
+		return x.PriceHistoryInterval
+	}
+	return 0
+}
+
+func (x *Params) GetSignalAggregationMethods() []*SignalAggregationMethod {
+	if x != nil {
+		return x.SignalAggregationMethods
+	}
+	return nil
+}
+
+func (x *Params) GetOutlierDeviationBasisPoint() int64 {
+	if x != nil {
+		return x.OutlierDeviationBasisPoint
+	}
+	return 0
+}
+
+func (x *Params) GetAccuracyWindow() uint64 {
+	if x != nil {
+		return x.AccuracyWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMaxOutlierBasisPoint() int64 {
+	if x != nil {
+		return x.MaxOutlierBasisPoint
+	}
+	return 0
+}
+
+func (x *Params) GetRequireRegisteredSignals() bool {
+	if x != nil {
+		return x.RequireRegisteredSignals
+	}
+	return false
+}
+
+func (x *Params) GetMaxPriceMoveBasisPoint() int64 {
+	if x != nil {
+		return x.MaxPriceMoveBasisPoint
+	}
+	return 0
+}
+
 var File_band_feeds_v1beta1_params_proto protoreflect.FileDescriptor
 
 var file_band_feeds_v1beta1_params_proto_rawDesc = []byte{
@@ -1196,7 +1768,9 @@ var file_band_feeds_v1beta1_params_proto_rawDesc = []byte{
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x09, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69,
@@ -1238,21 +1812,53 @@ var file_band_feeds_v1beta1_params_proto_rawDesc = []byte{
 	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x16, 0x4d, 0x61, 0x78, 0x53,
 	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x73, 0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69,
 	0x6e, 0x67, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73,
-	0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
-	0x42, 0xd5, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x42, 0x61,
-	0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x70, 0x72, 0x69, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x6f,
+	0x0a, 0x1a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x18, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12,
+	0x41, 0x0a, 0x1d, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x63, 0x63,
+	0x75, 0x72, 0x61, 0x63, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x35, 0x0a, 0x17, 0x6d,
+	0x61, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73,
+	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6d, 0x61,
+	0x78, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73,
+	0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x6f,
+	0x76, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x6f,
+	0x76, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x42, 0xd5, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e,
+	0x46, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12,
+	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c,
+	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64,
+	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1269,14 +1875,16 @@ func file_band_feeds_v1beta1_params_proto_rawDescGZIP() []byte {
 
 var file_band_feeds_v1beta1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_band_feeds_v1beta1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: band.feeds.v1beta1.Params
+	(*Params)(nil),                  // 0: band.feeds.v1beta1.Params
+	(*SignalAggregationMethod)(nil), // 1: band.feeds.v1beta1.SignalAggregationMethod
 }
 var file_band_feeds_v1beta1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: band.feeds.v1beta1.Params.signal_aggregation_methods:type_name -> band.feeds.v1beta1.SignalAggregationMethod
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_band_feeds_v1beta1_params_proto_init() }
@@ -1284,6 +1892,7 @@ func file_band_feeds_v1beta1_params_proto_init() {
 	if File_band_feeds_v1beta1_params_proto != nil {
 		return
 	}
+	file_band_feeds_v1beta1_feeds_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_band_feeds_v1beta1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
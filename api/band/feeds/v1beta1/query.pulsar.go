@@ -5330,23 +5330,27 @@ func (x *fastReflection_QueryAllPricesResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryReferenceSourceConfigRequest protoreflect.MessageDescriptor
+	md_QueryPriceAtRequest           protoreflect.MessageDescriptor
+	fd_QueryPriceAtRequest_signal_id protoreflect.FieldDescriptor
+	fd_QueryPriceAtRequest_timestamp protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_query_proto_init()
-	md_QueryReferenceSourceConfigRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryReferenceSourceConfigRequest")
+	md_QueryPriceAtRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryPriceAtRequest")
+	fd_QueryPriceAtRequest_signal_id = md_QueryPriceAtRequest.Fields().ByName("signal_id")
+	fd_QueryPriceAtRequest_timestamp = md_QueryPriceAtRequest.Fields().ByName("timestamp")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryReferenceSourceConfigRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPriceAtRequest)(nil)
 
-type fastReflection_QueryReferenceSourceConfigRequest QueryReferenceSourceConfigRequest
+type fastReflection_QueryPriceAtRequest QueryPriceAtRequest
 
-func (x *QueryReferenceSourceConfigRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryReferenceSourceConfigRequest)(x)
+func (x *QueryPriceAtRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPriceAtRequest)(x)
 }
 
-func (x *QueryReferenceSourceConfigRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPriceAtRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5358,43 +5362,43 @@ func (x *QueryReferenceSourceConfigRequest) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryReferenceSourceConfigRequest_messageType fastReflection_QueryReferenceSourceConfigRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryReferenceSourceConfigRequest_messageType{}
+var _fastReflection_QueryPriceAtRequest_messageType fastReflection_QueryPriceAtRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPriceAtRequest_messageType{}
 
-type fastReflection_QueryReferenceSourceConfigRequest_messageType struct{}
+type fastReflection_QueryPriceAtRequest_messageType struct{}
 
-func (x fastReflection_QueryReferenceSourceConfigRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryReferenceSourceConfigRequest)(nil)
+func (x fastReflection_QueryPriceAtRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPriceAtRequest)(nil)
 }
-func (x fastReflection_QueryReferenceSourceConfigRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryReferenceSourceConfigRequest)
+func (x fastReflection_QueryPriceAtRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceAtRequest)
 }
-func (x fastReflection_QueryReferenceSourceConfigRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReferenceSourceConfigRequest
+func (x fastReflection_QueryPriceAtRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceAtRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReferenceSourceConfigRequest
+func (x *fastReflection_QueryPriceAtRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceAtRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryReferenceSourceConfigRequest_messageType
+func (x *fastReflection_QueryPriceAtRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPriceAtRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryReferenceSourceConfigRequest)
+func (x *fastReflection_QueryPriceAtRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceAtRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryReferenceSourceConfigRequest)(x)
+func (x *fastReflection_QueryPriceAtRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPriceAtRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5402,7 +5406,19 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryPriceAtRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_QueryPriceAtRequest_signal_id, value) {
+			return
+		}
+	}
+	if x.Timestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Timestamp)
+		if !f(fd_QueryPriceAtRequest_timestamp, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5416,13 +5432,17 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPriceAtRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryPriceAtRequest.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.QueryPriceAtRequest.timestamp":
+		return x.Timestamp != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5432,13 +5452,17 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPriceAtRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryPriceAtRequest.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.QueryPriceAtRequest.timestamp":
+		x.Timestamp = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5448,13 +5472,19 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceAtRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryPriceAtRequest.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.QueryPriceAtRequest.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5468,13 +5498,17 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPriceAtRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryPriceAtRequest.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.QueryPriceAtRequest.timestamp":
+		x.Timestamp = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5488,36 +5522,44 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceAtRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryPriceAtRequest.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.QueryPriceAtRequest is not mutable"))
+	case "band.feeds.v1beta1.QueryPriceAtRequest.timestamp":
+		panic(fmt.Errorf("field timestamp of message band.feeds.v1beta1.QueryPriceAtRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceAtRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryPriceAtRequest.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.QueryPriceAtRequest.timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPriceAtRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryReferenceSourceConfigRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryPriceAtRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5525,7 +5567,7 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPriceAtRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5536,7 +5578,7 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPriceAtRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5548,7 +5590,7 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) IsValid() bool {
+func (x *fastReflection_QueryPriceAtRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5558,9 +5600,9 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryReferenceSourceConfigRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPriceAtRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryReferenceSourceConfigRequest)
+		x := input.Message.Interface().(*QueryPriceAtRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5572,6 +5614,13 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) ProtoMethods() *proto
 		var n int
 		var l int
 		_ = l
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5582,7 +5631,7 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReferenceSourceConfigRequest)
+		x := input.Message.Interface().(*QueryPriceAtRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5601,6 +5650,18 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) ProtoMethods() *proto
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5612,7 +5673,7 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReferenceSourceConfigRequest)
+		x := input.Message.Interface().(*QueryPriceAtRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5644,12 +5705,63 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReferenceSourceConfigRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceAtRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReferenceSourceConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5686,25 +5798,25 @@ func (x *fastReflection_QueryReferenceSourceConfigRequest) ProtoMethods() *proto
 }
 
 var (
-	md_QueryReferenceSourceConfigResponse                         protoreflect.MessageDescriptor
-	fd_QueryReferenceSourceConfigResponse_reference_source_config protoreflect.FieldDescriptor
+	md_QueryPriceAtResponse       protoreflect.MessageDescriptor
+	fd_QueryPriceAtResponse_price protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_query_proto_init()
-	md_QueryReferenceSourceConfigResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryReferenceSourceConfigResponse")
-	fd_QueryReferenceSourceConfigResponse_reference_source_config = md_QueryReferenceSourceConfigResponse.Fields().ByName("reference_source_config")
+	md_QueryPriceAtResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryPriceAtResponse")
+	fd_QueryPriceAtResponse_price = md_QueryPriceAtResponse.Fields().ByName("price")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryReferenceSourceConfigResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPriceAtResponse)(nil)
 
-type fastReflection_QueryReferenceSourceConfigResponse QueryReferenceSourceConfigResponse
+type fastReflection_QueryPriceAtResponse QueryPriceAtResponse
 
-func (x *QueryReferenceSourceConfigResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryReferenceSourceConfigResponse)(x)
+func (x *QueryPriceAtResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPriceAtResponse)(x)
 }
 
-func (x *QueryReferenceSourceConfigResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPriceAtResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5716,43 +5828,43 @@ func (x *QueryReferenceSourceConfigResponse) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryReferenceSourceConfigResponse_messageType fastReflection_QueryReferenceSourceConfigResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryReferenceSourceConfigResponse_messageType{}
+var _fastReflection_QueryPriceAtResponse_messageType fastReflection_QueryPriceAtResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPriceAtResponse_messageType{}
 
-type fastReflection_QueryReferenceSourceConfigResponse_messageType struct{}
+type fastReflection_QueryPriceAtResponse_messageType struct{}
 
-func (x fastReflection_QueryReferenceSourceConfigResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryReferenceSourceConfigResponse)(nil)
+func (x fastReflection_QueryPriceAtResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPriceAtResponse)(nil)
 }
-func (x fastReflection_QueryReferenceSourceConfigResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryReferenceSourceConfigResponse)
+func (x fastReflection_QueryPriceAtResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceAtResponse)
 }
-func (x fastReflection_QueryReferenceSourceConfigResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReferenceSourceConfigResponse
+func (x fastReflection_QueryPriceAtResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceAtResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReferenceSourceConfigResponse
+func (x *fastReflection_QueryPriceAtResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceAtResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryReferenceSourceConfigResponse_messageType
+func (x *fastReflection_QueryPriceAtResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPriceAtResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryReferenceSourceConfigResponse)
+func (x *fastReflection_QueryPriceAtResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceAtResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryReferenceSourceConfigResponse)(x)
+func (x *fastReflection_QueryPriceAtResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPriceAtResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5760,10 +5872,10 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ReferenceSourceConfig != nil {
-		value := protoreflect.ValueOfMessage(x.ReferenceSourceConfig.ProtoReflect())
-		if !f(fd_QueryReferenceSourceConfigResponse_reference_source_config, value) {
+func (x *fastReflection_QueryPriceAtResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Price != nil {
+		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+		if !f(fd_QueryPriceAtResponse_price, value) {
 			return
 		}
 	}
@@ -5780,15 +5892,15 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPriceAtResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
-		return x.ReferenceSourceConfig != nil
+	case "band.feeds.v1beta1.QueryPriceAtResponse.price":
+		return x.Price != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5798,15 +5910,15 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPriceAtResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
-		x.ReferenceSourceConfig = nil
+	case "band.feeds.v1beta1.QueryPriceAtResponse.price":
+		x.Price = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5816,16 +5928,16 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceAtResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
-		value := x.ReferenceSourceConfig
+	case "band.feeds.v1beta1.QueryPriceAtResponse.price":
+		value := x.Price
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5839,15 +5951,15 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPriceAtResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
-		x.ReferenceSourceConfig = value.Message().Interface().(*ReferenceSourceConfig)
+	case "band.feeds.v1beta1.QueryPriceAtResponse.price":
+		x.Price = value.Message().Interface().(*Price)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5861,44 +5973,44 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceAtResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
-		if x.ReferenceSourceConfig == nil {
-			x.ReferenceSourceConfig = new(ReferenceSourceConfig)
+	case "band.feeds.v1beta1.QueryPriceAtResponse.price":
+		if x.Price == nil {
+			x.Price = new(Price)
 		}
-		return protoreflect.ValueOfMessage(x.ReferenceSourceConfig.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceAtResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
-		m := new(ReferenceSourceConfig)
+	case "band.feeds.v1beta1.QueryPriceAtResponse.price":
+		m := new(Price)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceAtResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceAtResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPriceAtResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryReferenceSourceConfigResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryPriceAtResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5906,7 +6018,7 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPriceAtResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5917,7 +6029,7 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPriceAtResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5929,7 +6041,7 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) IsValid() bool {
+func (x *fastReflection_QueryPriceAtResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5939,9 +6051,9 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryReferenceSourceConfigResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPriceAtResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryReferenceSourceConfigResponse)
+		x := input.Message.Interface().(*QueryPriceAtResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5953,8 +6065,8 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		if x.ReferenceSourceConfig != nil {
-			l = options.Size(x.ReferenceSourceConfig)
+		if x.Price != nil {
+			l = options.Size(x.Price)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5967,7 +6079,7 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReferenceSourceConfigResponse)
+		x := input.Message.Interface().(*QueryPriceAtResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5986,8 +6098,8 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ReferenceSourceConfig != nil {
-			encoded, err := options.Marshal(x.ReferenceSourceConfig)
+		if x.Price != nil {
+			encoded, err := options.Marshal(x.Price)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6011,7 +6123,7 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReferenceSourceConfigResponse)
+		x := input.Message.Interface().(*QueryPriceAtResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6043,15 +6155,15 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReferenceSourceConfigResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceAtResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6078,10 +6190,10 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) ProtoMethods() *prot
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ReferenceSourceConfig == nil {
-					x.ReferenceSourceConfig = &ReferenceSourceConfig{}
+				if x.Price == nil {
+					x.Price = &Price{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReferenceSourceConfig); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6120,74 +6232,28 @@ func (x *fastReflection_QueryReferenceSourceConfigResponse) ProtoMethods() *prot
 	}
 }
 
-var _ protoreflect.List = (*_QuerySignalTotalPowersRequest_1_list)(nil)
-
-type _QuerySignalTotalPowersRequest_1_list struct {
-	list *[]string
-}
+var (
+	md_QueryPriceHistoryRequest            protoreflect.MessageDescriptor
+	fd_QueryPriceHistoryRequest_signal_id  protoreflect.FieldDescriptor
+	fd_QueryPriceHistoryRequest_pagination protoreflect.FieldDescriptor
+)
 
-func (x *_QuerySignalTotalPowersRequest_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryPriceHistoryRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryPriceHistoryRequest")
+	fd_QueryPriceHistoryRequest_signal_id = md_QueryPriceHistoryRequest.Fields().ByName("signal_id")
+	fd_QueryPriceHistoryRequest_pagination = md_QueryPriceHistoryRequest.Fields().ByName("pagination")
 }
 
-func (x *_QuerySignalTotalPowersRequest_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+var _ protoreflect.Message = (*fastReflection_QueryPriceHistoryRequest)(nil)
 
-func (x *_QuerySignalTotalPowersRequest_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
+type fastReflection_QueryPriceHistoryRequest QueryPriceHistoryRequest
 
-func (x *_QuerySignalTotalPowersRequest_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *QueryPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPriceHistoryRequest)(x)
 }
 
-func (x *_QuerySignalTotalPowersRequest_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QuerySignalTotalPowersRequest at list field SignalIds as it is not of Message kind"))
-}
-
-func (x *_QuerySignalTotalPowersRequest_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QuerySignalTotalPowersRequest_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_QuerySignalTotalPowersRequest_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QuerySignalTotalPowersRequest            protoreflect.MessageDescriptor
-	fd_QuerySignalTotalPowersRequest_signal_ids protoreflect.FieldDescriptor
-	fd_QuerySignalTotalPowersRequest_pagination protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_feeds_v1beta1_query_proto_init()
-	md_QuerySignalTotalPowersRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QuerySignalTotalPowersRequest")
-	fd_QuerySignalTotalPowersRequest_signal_ids = md_QuerySignalTotalPowersRequest.Fields().ByName("signal_ids")
-	fd_QuerySignalTotalPowersRequest_pagination = md_QuerySignalTotalPowersRequest.Fields().ByName("pagination")
-}
-
-var _ protoreflect.Message = (*fastReflection_QuerySignalTotalPowersRequest)(nil)
-
-type fastReflection_QuerySignalTotalPowersRequest QuerySignalTotalPowersRequest
-
-func (x *QuerySignalTotalPowersRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QuerySignalTotalPowersRequest)(x)
-}
-
-func (x *QuerySignalTotalPowersRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPriceHistoryRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6199,43 +6265,43 @@ func (x *QuerySignalTotalPowersRequest) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QuerySignalTotalPowersRequest_messageType fastReflection_QuerySignalTotalPowersRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QuerySignalTotalPowersRequest_messageType{}
+var _fastReflection_QueryPriceHistoryRequest_messageType fastReflection_QueryPriceHistoryRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPriceHistoryRequest_messageType{}
 
-type fastReflection_QuerySignalTotalPowersRequest_messageType struct{}
+type fastReflection_QueryPriceHistoryRequest_messageType struct{}
 
-func (x fastReflection_QuerySignalTotalPowersRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QuerySignalTotalPowersRequest)(nil)
+func (x fastReflection_QueryPriceHistoryRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPriceHistoryRequest)(nil)
 }
-func (x fastReflection_QuerySignalTotalPowersRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QuerySignalTotalPowersRequest)
+func (x fastReflection_QueryPriceHistoryRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceHistoryRequest)
 }
-func (x fastReflection_QuerySignalTotalPowersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySignalTotalPowersRequest
+func (x fastReflection_QueryPriceHistoryRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceHistoryRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QuerySignalTotalPowersRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySignalTotalPowersRequest
+func (x *fastReflection_QueryPriceHistoryRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceHistoryRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QuerySignalTotalPowersRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QuerySignalTotalPowersRequest_messageType
+func (x *fastReflection_QueryPriceHistoryRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPriceHistoryRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QuerySignalTotalPowersRequest) New() protoreflect.Message {
-	return new(fastReflection_QuerySignalTotalPowersRequest)
+func (x *fastReflection_QueryPriceHistoryRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceHistoryRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QuerySignalTotalPowersRequest) Interface() protoreflect.ProtoMessage {
-	return (*QuerySignalTotalPowersRequest)(x)
+func (x *fastReflection_QueryPriceHistoryRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPriceHistoryRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6243,16 +6309,16 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QuerySignalTotalPowersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.SignalIds) != 0 {
-		value := protoreflect.ValueOfList(&_QuerySignalTotalPowersRequest_1_list{list: &x.SignalIds})
-		if !f(fd_QuerySignalTotalPowersRequest_signal_ids, value) {
+func (x *fastReflection_QueryPriceHistoryRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_QueryPriceHistoryRequest_signal_id, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QuerySignalTotalPowersRequest_pagination, value) {
+		if !f(fd_QueryPriceHistoryRequest_pagination, value) {
 			return
 		}
 	}
@@ -6269,17 +6335,17 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QuerySignalTotalPowersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPriceHistoryRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
-		return len(x.SignalIds) != 0
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6289,17 +6355,17 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySignalTotalPowersRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPriceHistoryRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
-		x.SignalIds = nil
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6309,22 +6375,19 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QuerySignalTotalPowersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceHistoryRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
-		if len(x.SignalIds) == 0 {
-			return protoreflect.ValueOfList(&_QuerySignalTotalPowersRequest_1_list{})
-		}
-		listValue := &_QuerySignalTotalPowersRequest_1_list{list: &x.SignalIds}
-		return protoreflect.ValueOfList(listValue)
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6338,19 +6401,17 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySignalTotalPowersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPriceHistoryRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
-		lv := value.List()
-		clv := lv.(*_QuerySignalTotalPowersRequest_1_list)
-		x.SignalIds = *clv.list
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6364,53 +6425,48 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySignalTotalPowersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceHistoryRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
-		if x.SignalIds == nil {
-			x.SignalIds = []string{}
-		}
-		value := &_QuerySignalTotalPowersRequest_1_list{list: &x.SignalIds}
-		return protoreflect.ValueOfList(value)
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.QueryPriceHistoryRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QuerySignalTotalPowersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceHistoryRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QuerySignalTotalPowersRequest_1_list{list: &list})
-	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.QueryPriceHistoryRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QuerySignalTotalPowersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPriceHistoryRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QuerySignalTotalPowersRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryPriceHistoryRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6418,7 +6474,7 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QuerySignalTotalPowersRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPriceHistoryRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6429,7 +6485,7 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySignalTotalPowersRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPriceHistoryRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6441,7 +6497,7 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QuerySignalTotalPowersRequest) IsValid() bool {
+func (x *fastReflection_QueryPriceHistoryRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6451,9 +6507,9 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QuerySignalTotalPowersRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPriceHistoryRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QuerySignalTotalPowersRequest)
+		x := input.Message.Interface().(*QueryPriceHistoryRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6465,11 +6521,9 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		if len(x.SignalIds) > 0 {
-			for _, s := range x.SignalIds {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -6485,7 +6539,7 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySignalTotalPowersRequest)
+		x := input.Message.Interface().(*QueryPriceHistoryRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6518,14 +6572,12 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) ProtoMethods() *protoifac
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.SignalIds) > 0 {
-			for iNdEx := len(x.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.SignalIds[iNdEx])
-				copy(dAtA[i:], x.SignalIds[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalIds[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6538,7 +6590,7 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySignalTotalPowersRequest)
+		x := input.Message.Interface().(*QueryPriceHistoryRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6570,15 +6622,15 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalTotalPowersRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceHistoryRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalTotalPowersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6606,7 +6658,7 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignalIds = append(x.SignalIds, string(dAtA[iNdEx:postIndex]))
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -6679,79 +6731,79 @@ func (x *fastReflection_QuerySignalTotalPowersRequest) ProtoMethods() *protoifac
 	}
 }
 
-var _ protoreflect.List = (*_QuerySignalTotalPowersResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryPriceHistoryResponse_1_list)(nil)
 
-type _QuerySignalTotalPowersResponse_1_list struct {
-	list *[]*Signal
+type _QueryPriceHistoryResponse_1_list struct {
+	list *[]*Price
 }
 
-func (x *_QuerySignalTotalPowersResponse_1_list) Len() int {
+func (x *_QueryPriceHistoryResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QuerySignalTotalPowersResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryPriceHistoryResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QuerySignalTotalPowersResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryPriceHistoryResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Signal)
+	concreteValue := valueUnwrapped.Interface().(*Price)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QuerySignalTotalPowersResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryPriceHistoryResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Signal)
+	concreteValue := valueUnwrapped.Interface().(*Price)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QuerySignalTotalPowersResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Signal)
+func (x *_QueryPriceHistoryResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Price)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QuerySignalTotalPowersResponse_1_list) Truncate(n int) {
+func (x *_QueryPriceHistoryResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QuerySignalTotalPowersResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Signal)
+func (x *_QueryPriceHistoryResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Price)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QuerySignalTotalPowersResponse_1_list) IsValid() bool {
+func (x *_QueryPriceHistoryResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QuerySignalTotalPowersResponse                     protoreflect.MessageDescriptor
-	fd_QuerySignalTotalPowersResponse_signal_total_powers protoreflect.FieldDescriptor
-	fd_QuerySignalTotalPowersResponse_pagination          protoreflect.FieldDescriptor
+	md_QueryPriceHistoryResponse            protoreflect.MessageDescriptor
+	fd_QueryPriceHistoryResponse_prices     protoreflect.FieldDescriptor
+	fd_QueryPriceHistoryResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_query_proto_init()
-	md_QuerySignalTotalPowersResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QuerySignalTotalPowersResponse")
-	fd_QuerySignalTotalPowersResponse_signal_total_powers = md_QuerySignalTotalPowersResponse.Fields().ByName("signal_total_powers")
-	fd_QuerySignalTotalPowersResponse_pagination = md_QuerySignalTotalPowersResponse.Fields().ByName("pagination")
+	md_QueryPriceHistoryResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryPriceHistoryResponse")
+	fd_QueryPriceHistoryResponse_prices = md_QueryPriceHistoryResponse.Fields().ByName("prices")
+	fd_QueryPriceHistoryResponse_pagination = md_QueryPriceHistoryResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QuerySignalTotalPowersResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPriceHistoryResponse)(nil)
 
-type fastReflection_QuerySignalTotalPowersResponse QuerySignalTotalPowersResponse
+type fastReflection_QueryPriceHistoryResponse QueryPriceHistoryResponse
 
-func (x *QuerySignalTotalPowersResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QuerySignalTotalPowersResponse)(x)
+func (x *QueryPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPriceHistoryResponse)(x)
 }
 
-func (x *QuerySignalTotalPowersResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPriceHistoryResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6763,43 +6815,43 @@ func (x *QuerySignalTotalPowersResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QuerySignalTotalPowersResponse_messageType fastReflection_QuerySignalTotalPowersResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QuerySignalTotalPowersResponse_messageType{}
+var _fastReflection_QueryPriceHistoryResponse_messageType fastReflection_QueryPriceHistoryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPriceHistoryResponse_messageType{}
 
-type fastReflection_QuerySignalTotalPowersResponse_messageType struct{}
+type fastReflection_QueryPriceHistoryResponse_messageType struct{}
 
-func (x fastReflection_QuerySignalTotalPowersResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QuerySignalTotalPowersResponse)(nil)
+func (x fastReflection_QueryPriceHistoryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPriceHistoryResponse)(nil)
 }
-func (x fastReflection_QuerySignalTotalPowersResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QuerySignalTotalPowersResponse)
+func (x fastReflection_QueryPriceHistoryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceHistoryResponse)
 }
-func (x fastReflection_QuerySignalTotalPowersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySignalTotalPowersResponse
+func (x fastReflection_QueryPriceHistoryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceHistoryResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QuerySignalTotalPowersResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySignalTotalPowersResponse
+func (x *fastReflection_QueryPriceHistoryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceHistoryResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QuerySignalTotalPowersResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QuerySignalTotalPowersResponse_messageType
+func (x *fastReflection_QueryPriceHistoryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPriceHistoryResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QuerySignalTotalPowersResponse) New() protoreflect.Message {
-	return new(fastReflection_QuerySignalTotalPowersResponse)
+func (x *fastReflection_QueryPriceHistoryResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceHistoryResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QuerySignalTotalPowersResponse) Interface() protoreflect.ProtoMessage {
-	return (*QuerySignalTotalPowersResponse)(x)
+func (x *fastReflection_QueryPriceHistoryResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPriceHistoryResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6807,16 +6859,16 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QuerySignalTotalPowersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.SignalTotalPowers) != 0 {
-		value := protoreflect.ValueOfList(&_QuerySignalTotalPowersResponse_1_list{list: &x.SignalTotalPowers})
-		if !f(fd_QuerySignalTotalPowersResponse_signal_total_powers, value) {
+func (x *fastReflection_QueryPriceHistoryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Prices) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPriceHistoryResponse_1_list{list: &x.Prices})
+		if !f(fd_QueryPriceHistoryResponse_prices, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QuerySignalTotalPowersResponse_pagination, value) {
+		if !f(fd_QueryPriceHistoryResponse_pagination, value) {
 			return
 		}
 	}
@@ -6833,17 +6885,17 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QuerySignalTotalPowersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPriceHistoryResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
-		return len(x.SignalTotalPowers) != 0
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.prices":
+		return len(x.Prices) != 0
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6853,17 +6905,17 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySignalTotalPowersResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPriceHistoryResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
-		x.SignalTotalPowers = nil
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.prices":
+		x.Prices = nil
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6873,22 +6925,22 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QuerySignalTotalPowersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceHistoryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
-		if len(x.SignalTotalPowers) == 0 {
-			return protoreflect.ValueOfList(&_QuerySignalTotalPowersResponse_1_list{})
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.prices":
+		if len(x.Prices) == 0 {
+			return protoreflect.ValueOfList(&_QueryPriceHistoryResponse_1_list{})
 		}
-		listValue := &_QuerySignalTotalPowersResponse_1_list{list: &x.SignalTotalPowers}
+		listValue := &_QueryPriceHistoryResponse_1_list{list: &x.Prices}
 		return protoreflect.ValueOfList(listValue)
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6902,19 +6954,19 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySignalTotalPowersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPriceHistoryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.prices":
 		lv := value.List()
-		clv := lv.(*_QuerySignalTotalPowersResponse_1_list)
-		x.SignalTotalPowers = *clv.list
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+		clv := lv.(*_QueryPriceHistoryResponse_1_list)
+		x.Prices = *clv.list
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6928,53 +6980,53 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySignalTotalPowersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceHistoryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
-		if x.SignalTotalPowers == nil {
-			x.SignalTotalPowers = []*Signal{}
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.prices":
+		if x.Prices == nil {
+			x.Prices = []*Price{}
 		}
-		value := &_QuerySignalTotalPowersResponse_1_list{list: &x.SignalTotalPowers}
+		value := &_QueryPriceHistoryResponse_1_list{list: &x.Prices}
 		return protoreflect.ValueOfList(value)
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QuerySignalTotalPowersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceHistoryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
-		list := []*Signal{}
-		return protoreflect.ValueOfList(&_QuerySignalTotalPowersResponse_1_list{list: &list})
-	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.prices":
+		list := []*Price{}
+		return protoreflect.ValueOfList(&_QueryPriceHistoryResponse_1_list{list: &list})
+	case "band.feeds.v1beta1.QueryPriceHistoryResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryPriceHistoryResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryPriceHistoryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QuerySignalTotalPowersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPriceHistoryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QuerySignalTotalPowersResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryPriceHistoryResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6982,7 +7034,7 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QuerySignalTotalPowersResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPriceHistoryResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6993,7 +7045,7 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySignalTotalPowersResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPriceHistoryResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7005,7 +7057,7 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QuerySignalTotalPowersResponse) IsValid() bool {
+func (x *fastReflection_QueryPriceHistoryResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7015,9 +7067,9 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QuerySignalTotalPowersResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPriceHistoryResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QuerySignalTotalPowersResponse)
+		x := input.Message.Interface().(*QueryPriceHistoryResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7029,8 +7081,8 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		if len(x.SignalTotalPowers) > 0 {
-			for _, e := range x.SignalTotalPowers {
+		if len(x.Prices) > 0 {
+			for _, e := range x.Prices {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -7049,7 +7101,7 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySignalTotalPowersResponse)
+		x := input.Message.Interface().(*QueryPriceHistoryResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7082,9 +7134,9 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) ProtoMethods() *protoifa
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.SignalTotalPowers) > 0 {
-			for iNdEx := len(x.SignalTotalPowers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.SignalTotalPowers[iNdEx])
+		if len(x.Prices) > 0 {
+			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Prices[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7109,7 +7161,7 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySignalTotalPowersResponse)
+		x := input.Message.Interface().(*QueryPriceHistoryResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7141,15 +7193,15 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalTotalPowersResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceHistoryResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalTotalPowersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalTotalPowers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7176,8 +7228,8 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) ProtoMethods() *protoifa
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignalTotalPowers = append(x.SignalTotalPowers, &Signal{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignalTotalPowers[len(x.SignalTotalPowers)-1]); err != nil {
+				x.Prices = append(x.Prices, &Price{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7253,25 +7305,27 @@ func (x *fastReflection_QuerySignalTotalPowersResponse) ProtoMethods() *protoifa
 }
 
 var (
-	md_QueryValidValidatorRequest           protoreflect.MessageDescriptor
-	fd_QueryValidValidatorRequest_validator protoreflect.FieldDescriptor
+	md_QueryTWAPRequest           protoreflect.MessageDescriptor
+	fd_QueryTWAPRequest_signal_id protoreflect.FieldDescriptor
+	fd_QueryTWAPRequest_window    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_query_proto_init()
-	md_QueryValidValidatorRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidValidatorRequest")
-	fd_QueryValidValidatorRequest_validator = md_QueryValidValidatorRequest.Fields().ByName("validator")
+	md_QueryTWAPRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryTWAPRequest")
+	fd_QueryTWAPRequest_signal_id = md_QueryTWAPRequest.Fields().ByName("signal_id")
+	fd_QueryTWAPRequest_window = md_QueryTWAPRequest.Fields().ByName("window")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidValidatorRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTWAPRequest)(nil)
 
-type fastReflection_QueryValidValidatorRequest QueryValidValidatorRequest
+type fastReflection_QueryTWAPRequest QueryTWAPRequest
 
-func (x *QueryValidValidatorRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidValidatorRequest)(x)
+func (x *QueryTWAPRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTWAPRequest)(x)
 }
 
-func (x *QueryValidValidatorRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryTWAPRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7283,43 +7337,43 @@ func (x *QueryValidValidatorRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidValidatorRequest_messageType fastReflection_QueryValidValidatorRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidValidatorRequest_messageType{}
+var _fastReflection_QueryTWAPRequest_messageType fastReflection_QueryTWAPRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTWAPRequest_messageType{}
 
-type fastReflection_QueryValidValidatorRequest_messageType struct{}
+type fastReflection_QueryTWAPRequest_messageType struct{}
 
-func (x fastReflection_QueryValidValidatorRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidValidatorRequest)(nil)
+func (x fastReflection_QueryTWAPRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTWAPRequest)(nil)
 }
-func (x fastReflection_QueryValidValidatorRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidValidatorRequest)
+func (x fastReflection_QueryTWAPRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTWAPRequest)
 }
-func (x fastReflection_QueryValidValidatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidValidatorRequest
+func (x fastReflection_QueryTWAPRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTWAPRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidValidatorRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidValidatorRequest
+func (x *fastReflection_QueryTWAPRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTWAPRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidValidatorRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidValidatorRequest_messageType
+func (x *fastReflection_QueryTWAPRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTWAPRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidValidatorRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryValidValidatorRequest)
+func (x *fastReflection_QueryTWAPRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTWAPRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidValidatorRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidValidatorRequest)(x)
+func (x *fastReflection_QueryTWAPRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTWAPRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7327,10 +7381,16 @@ func (x *fastReflection_QueryValidValidatorRequest) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidValidatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_QueryValidValidatorRequest_validator, value) {
+func (x *fastReflection_QueryTWAPRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_QueryTWAPRequest_signal_id, value) {
+			return
+		}
+	}
+	if x.Window != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Window)
+		if !f(fd_QueryTWAPRequest_window, value) {
 			return
 		}
 	}
@@ -7347,15 +7407,17 @@ func (x *fastReflection_QueryValidValidatorRequest) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidValidatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTWAPRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
-		return x.Validator != ""
+	case "band.feeds.v1beta1.QueryTWAPRequest.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.QueryTWAPRequest.window":
+		return x.Window != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7365,15 +7427,17 @@ func (x *fastReflection_QueryValidValidatorRequest) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidValidatorRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTWAPRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
-		x.Validator = ""
+	case "band.feeds.v1beta1.QueryTWAPRequest.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.QueryTWAPRequest.window":
+		x.Window = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7383,16 +7447,19 @@ func (x *fastReflection_QueryValidValidatorRequest) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidValidatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTWAPRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
-		value := x.Validator
+	case "band.feeds.v1beta1.QueryTWAPRequest.signal_id":
+		value := x.SignalId
 		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.QueryTWAPRequest.window":
+		value := x.Window
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7406,15 +7473,17 @@ func (x *fastReflection_QueryValidValidatorRequest) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidValidatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTWAPRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
-		x.Validator = value.Interface().(string)
+	case "band.feeds.v1beta1.QueryTWAPRequest.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.QueryTWAPRequest.window":
+		x.Window = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7428,40 +7497,44 @@ func (x *fastReflection_QueryValidValidatorRequest) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidValidatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTWAPRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
-		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.QueryValidValidatorRequest is not mutable"))
+	case "band.feeds.v1beta1.QueryTWAPRequest.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.QueryTWAPRequest is not mutable"))
+	case "band.feeds.v1beta1.QueryTWAPRequest.window":
+		panic(fmt.Errorf("field window of message band.feeds.v1beta1.QueryTWAPRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidValidatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTWAPRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
+	case "band.feeds.v1beta1.QueryTWAPRequest.signal_id":
 		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.QueryTWAPRequest.window":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidValidatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTWAPRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidValidatorRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryTWAPRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7469,7 +7542,7 @@ func (x *fastReflection_QueryValidValidatorRequest) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidValidatorRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTWAPRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7480,7 +7553,7 @@ func (x *fastReflection_QueryValidValidatorRequest) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidValidatorRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTWAPRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7492,7 +7565,7 @@ func (x *fastReflection_QueryValidValidatorRequest) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidValidatorRequest) IsValid() bool {
+func (x *fastReflection_QueryTWAPRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7502,9 +7575,9 @@ func (x *fastReflection_QueryValidValidatorRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidValidatorRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTWAPRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidValidatorRequest)
+		x := input.Message.Interface().(*QueryTWAPRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7516,10 +7589,13 @@ func (x *fastReflection_QueryValidValidatorRequest) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.Validator)
+		l = len(x.SignalId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7530,7 +7606,7 @@ func (x *fastReflection_QueryValidValidatorRequest) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidValidatorRequest)
+		x := input.Message.Interface().(*QueryTWAPRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7549,10 +7625,15 @@ func (x *fastReflection_QueryValidValidatorRequest) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7567,7 +7648,7 @@ func (x *fastReflection_QueryValidValidatorRequest) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidValidatorRequest)
+		x := input.Message.Interface().(*QueryTWAPRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7599,15 +7680,15 @@ func (x *fastReflection_QueryValidValidatorRequest) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidValidatorRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7635,8 +7716,27 @@ func (x *fastReflection_QueryValidValidatorRequest) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7673,25 +7773,25 @@ func (x *fastReflection_QueryValidValidatorRequest) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryValidValidatorResponse       protoreflect.MessageDescriptor
-	fd_QueryValidValidatorResponse_valid protoreflect.FieldDescriptor
+	md_QueryTWAPResponse       protoreflect.MessageDescriptor
+	fd_QueryTWAPResponse_price protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_query_proto_init()
-	md_QueryValidValidatorResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidValidatorResponse")
-	fd_QueryValidValidatorResponse_valid = md_QueryValidValidatorResponse.Fields().ByName("valid")
+	md_QueryTWAPResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryTWAPResponse")
+	fd_QueryTWAPResponse_price = md_QueryTWAPResponse.Fields().ByName("price")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidValidatorResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTWAPResponse)(nil)
 
-type fastReflection_QueryValidValidatorResponse QueryValidValidatorResponse
+type fastReflection_QueryTWAPResponse QueryTWAPResponse
 
-func (x *QueryValidValidatorResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidValidatorResponse)(x)
+func (x *QueryTWAPResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTWAPResponse)(x)
 }
 
-func (x *QueryValidValidatorResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryTWAPResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7703,43 +7803,43 @@ func (x *QueryValidValidatorResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidValidatorResponse_messageType fastReflection_QueryValidValidatorResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidValidatorResponse_messageType{}
+var _fastReflection_QueryTWAPResponse_messageType fastReflection_QueryTWAPResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTWAPResponse_messageType{}
 
-type fastReflection_QueryValidValidatorResponse_messageType struct{}
+type fastReflection_QueryTWAPResponse_messageType struct{}
 
-func (x fastReflection_QueryValidValidatorResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidValidatorResponse)(nil)
+func (x fastReflection_QueryTWAPResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTWAPResponse)(nil)
 }
-func (x fastReflection_QueryValidValidatorResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidValidatorResponse)
+func (x fastReflection_QueryTWAPResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTWAPResponse)
 }
-func (x fastReflection_QueryValidValidatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidValidatorResponse
+func (x fastReflection_QueryTWAPResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTWAPResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidValidatorResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidValidatorResponse
+func (x *fastReflection_QueryTWAPResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTWAPResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidValidatorResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidValidatorResponse_messageType
+func (x *fastReflection_QueryTWAPResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTWAPResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidValidatorResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryValidValidatorResponse)
+func (x *fastReflection_QueryTWAPResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTWAPResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidValidatorResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidValidatorResponse)(x)
+func (x *fastReflection_QueryTWAPResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTWAPResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7747,10 +7847,10 @@ func (x *fastReflection_QueryValidValidatorResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidValidatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Valid != false {
-		value := protoreflect.ValueOfBool(x.Valid)
-		if !f(fd_QueryValidValidatorResponse_valid, value) {
+func (x *fastReflection_QueryTWAPResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Price != nil {
+		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+		if !f(fd_QueryTWAPResponse_price, value) {
 			return
 		}
 	}
@@ -7767,15 +7867,15 @@ func (x *fastReflection_QueryValidValidatorResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidValidatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTWAPResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
-		return x.Valid != false
+	case "band.feeds.v1beta1.QueryTWAPResponse.price":
+		return x.Price != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7785,15 +7885,15 @@ func (x *fastReflection_QueryValidValidatorResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidValidatorResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTWAPResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
-		x.Valid = false
+	case "band.feeds.v1beta1.QueryTWAPResponse.price":
+		x.Price = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7803,16 +7903,16 @@ func (x *fastReflection_QueryValidValidatorResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidValidatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTWAPResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
-		value := x.Valid
-		return protoreflect.ValueOfBool(value)
+	case "band.feeds.v1beta1.QueryTWAPResponse.price":
+		value := x.Price
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7826,15 +7926,15 @@ func (x *fastReflection_QueryValidValidatorResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidValidatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTWAPResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
-		x.Valid = value.Bool()
+	case "band.feeds.v1beta1.QueryTWAPResponse.price":
+		x.Price = value.Message().Interface().(*Price)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7848,40 +7948,44 @@ func (x *fastReflection_QueryValidValidatorResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidValidatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTWAPResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
-		panic(fmt.Errorf("field valid of message band.feeds.v1beta1.QueryValidValidatorResponse is not mutable"))
+	case "band.feeds.v1beta1.QueryTWAPResponse.price":
+		if x.Price == nil {
+			x.Price = new(Price)
+		}
+		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidValidatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTWAPResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
-		return protoreflect.ValueOfBool(false)
+	case "band.feeds.v1beta1.QueryTWAPResponse.price":
+		m := new(Price)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryTWAPResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryTWAPResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidValidatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTWAPResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidValidatorResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryTWAPResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7889,7 +7993,7 @@ func (x *fastReflection_QueryValidValidatorResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidValidatorResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTWAPResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7900,7 +8004,7 @@ func (x *fastReflection_QueryValidValidatorResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidValidatorResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTWAPResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7912,7 +8016,7 @@ func (x *fastReflection_QueryValidValidatorResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidValidatorResponse) IsValid() bool {
+func (x *fastReflection_QueryTWAPResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7922,9 +8026,9 @@ func (x *fastReflection_QueryValidValidatorResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidValidatorResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTWAPResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidValidatorResponse)
+		x := input.Message.Interface().(*QueryTWAPResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7936,8 +8040,9 @@ func (x *fastReflection_QueryValidValidatorResponse) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		if x.Valid {
-			n += 2
+		if x.Price != nil {
+			l = options.Size(x.Price)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7949,7 +8054,7 @@ func (x *fastReflection_QueryValidValidatorResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidValidatorResponse)
+		x := input.Message.Interface().(*QueryTWAPResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7968,15 +8073,19 @@ func (x *fastReflection_QueryValidValidatorResponse) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Valid {
-			i--
-			if x.Valid {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if x.Price != nil {
+			encoded, err := options.Marshal(x.Price)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7989,7 +8098,7 @@ func (x *fastReflection_QueryValidValidatorResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidValidatorResponse)
+		x := input.Message.Interface().(*QueryTWAPResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8021,17 +8130,17 @@ func (x *fastReflection_QueryValidValidatorResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidValidatorResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 				}
-				var v int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8041,13 +8150,29 @@ func (x *fastReflection_QueryValidValidatorResponse) ProtoMethods() *protoiface.
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Valid = bool(v != 0)
-			default:
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Price == nil {
+					x.Price = &Price{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
 				if err != nil {
@@ -8082,74 +8207,24 @@ func (x *fastReflection_QueryValidValidatorResponse) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_QueryValidatorPricesRequest_2_list)(nil)
-
-type _QueryValidatorPricesRequest_2_list struct {
-	list *[]string
-}
-
-func (x *_QueryValidatorPricesRequest_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryValidatorPricesRequest_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_QueryValidatorPricesRequest_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryValidatorPricesRequest_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryValidatorPricesRequest_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryValidatorPricesRequest at list field SignalIds as it is not of Message kind"))
-}
-
-func (x *_QueryValidatorPricesRequest_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryValidatorPricesRequest_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_QueryValidatorPricesRequest_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryValidatorPricesRequest            protoreflect.MessageDescriptor
-	fd_QueryValidatorPricesRequest_validator  protoreflect.FieldDescriptor
-	fd_QueryValidatorPricesRequest_signal_ids protoreflect.FieldDescriptor
+	md_QueryReferenceSourceConfigRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_query_proto_init()
-	md_QueryValidatorPricesRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidatorPricesRequest")
-	fd_QueryValidatorPricesRequest_validator = md_QueryValidatorPricesRequest.Fields().ByName("validator")
-	fd_QueryValidatorPricesRequest_signal_ids = md_QueryValidatorPricesRequest.Fields().ByName("signal_ids")
+	md_QueryReferenceSourceConfigRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryReferenceSourceConfigRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorPricesRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryReferenceSourceConfigRequest)(nil)
 
-type fastReflection_QueryValidatorPricesRequest QueryValidatorPricesRequest
+type fastReflection_QueryReferenceSourceConfigRequest QueryReferenceSourceConfigRequest
 
-func (x *QueryValidatorPricesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorPricesRequest)(x)
+func (x *QueryReferenceSourceConfigRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryReferenceSourceConfigRequest)(x)
 }
 
-func (x *QueryValidatorPricesRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryReferenceSourceConfigRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8161,43 +8236,43 @@ func (x *QueryValidatorPricesRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorPricesRequest_messageType fastReflection_QueryValidatorPricesRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorPricesRequest_messageType{}
+var _fastReflection_QueryReferenceSourceConfigRequest_messageType fastReflection_QueryReferenceSourceConfigRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryReferenceSourceConfigRequest_messageType{}
 
-type fastReflection_QueryValidatorPricesRequest_messageType struct{}
+type fastReflection_QueryReferenceSourceConfigRequest_messageType struct{}
 
-func (x fastReflection_QueryValidatorPricesRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorPricesRequest)(nil)
+func (x fastReflection_QueryReferenceSourceConfigRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryReferenceSourceConfigRequest)(nil)
 }
-func (x fastReflection_QueryValidatorPricesRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorPricesRequest)
+func (x fastReflection_QueryReferenceSourceConfigRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryReferenceSourceConfigRequest)
 }
-func (x fastReflection_QueryValidatorPricesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorPricesRequest
+func (x fastReflection_QueryReferenceSourceConfigRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReferenceSourceConfigRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorPricesRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorPricesRequest
+func (x *fastReflection_QueryReferenceSourceConfigRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReferenceSourceConfigRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorPricesRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorPricesRequest_messageType
+func (x *fastReflection_QueryReferenceSourceConfigRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryReferenceSourceConfigRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorPricesRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorPricesRequest)
+func (x *fastReflection_QueryReferenceSourceConfigRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryReferenceSourceConfigRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorPricesRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorPricesRequest)(x)
+func (x *fastReflection_QueryReferenceSourceConfigRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryReferenceSourceConfigRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8205,19 +8280,7 @@ func (x *fastReflection_QueryValidatorPricesRequest) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorPricesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_QueryValidatorPricesRequest_validator, value) {
-			return
-		}
-	}
-	if len(x.SignalIds) != 0 {
-		value := protoreflect.ValueOfList(&_QueryValidatorPricesRequest_2_list{list: &x.SignalIds})
-		if !f(fd_QueryValidatorPricesRequest_signal_ids, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryReferenceSourceConfigRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -8231,17 +8294,13 @@ func (x *fastReflection_QueryValidatorPricesRequest) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorPricesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryReferenceSourceConfigRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
-		return x.Validator != ""
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
-		return len(x.SignalIds) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8251,17 +8310,13 @@ func (x *fastReflection_QueryValidatorPricesRequest) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPricesRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryReferenceSourceConfigRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
-		x.Validator = ""
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
-		x.SignalIds = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8271,22 +8326,13 @@ func (x *fastReflection_QueryValidatorPricesRequest) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorPricesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReferenceSourceConfigRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
-		value := x.Validator
-		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
-		if len(x.SignalIds) == 0 {
-			return protoreflect.ValueOfList(&_QueryValidatorPricesRequest_2_list{})
-		}
-		listValue := &_QueryValidatorPricesRequest_2_list{list: &x.SignalIds}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8300,19 +8346,13 @@ func (x *fastReflection_QueryValidatorPricesRequest) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPricesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryReferenceSourceConfigRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
-		x.Validator = value.Interface().(string)
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
-		lv := value.List()
-		clv := lv.(*_QueryValidatorPricesRequest_2_list)
-		x.SignalIds = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8326,49 +8366,36 @@ func (x *fastReflection_QueryValidatorPricesRequest) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPricesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReferenceSourceConfigRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
-		if x.SignalIds == nil {
-			x.SignalIds = []string{}
-		}
-		value := &_QueryValidatorPricesRequest_2_list{list: &x.SignalIds}
-		return protoreflect.ValueOfList(value)
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
-		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.QueryValidatorPricesRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorPricesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReferenceSourceConfigRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
-		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryValidatorPricesRequest_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorPricesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryReferenceSourceConfigRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidatorPricesRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryReferenceSourceConfigRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8376,7 +8403,7 @@ func (x *fastReflection_QueryValidatorPricesRequest) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorPricesRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryReferenceSourceConfigRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8387,7 +8414,7 @@ func (x *fastReflection_QueryValidatorPricesRequest) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPricesRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryReferenceSourceConfigRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8399,7 +8426,7 @@ func (x *fastReflection_QueryValidatorPricesRequest) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorPricesRequest) IsValid() bool {
+func (x *fastReflection_QueryReferenceSourceConfigRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8409,9 +8436,9 @@ func (x *fastReflection_QueryValidatorPricesRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorPricesRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryReferenceSourceConfigRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorPricesRequest)
+		x := input.Message.Interface().(*QueryReferenceSourceConfigRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8423,16 +8450,6 @@ func (x *fastReflection_QueryValidatorPricesRequest) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		l = len(x.Validator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.SignalIds) > 0 {
-			for _, s := range x.SignalIds {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8443,7 +8460,7 @@ func (x *fastReflection_QueryValidatorPricesRequest) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorPricesRequest)
+		x := input.Message.Interface().(*QueryReferenceSourceConfigRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8462,22 +8479,6 @@ func (x *fastReflection_QueryValidatorPricesRequest) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.SignalIds) > 0 {
-			for iNdEx := len(x.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.SignalIds[iNdEx])
-				copy(dAtA[i:], x.SignalIds[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalIds[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -8489,7 +8490,7 @@ func (x *fastReflection_QueryValidatorPricesRequest) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorPricesRequest)
+		x := input.Message.Interface().(*QueryReferenceSourceConfigRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8521,76 +8522,12 @@ func (x *fastReflection_QueryValidatorPricesRequest) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPricesRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReferenceSourceConfigRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReferenceSourceConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SignalIds = append(x.SignalIds, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8626,77 +8563,26 @@ func (x *fastReflection_QueryValidatorPricesRequest) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_QueryValidatorPricesResponse_1_list)(nil)
-
-type _QueryValidatorPricesResponse_1_list struct {
-	list *[]*ValidatorPrice
-}
-
-func (x *_QueryValidatorPricesResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryValidatorPricesResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryValidatorPricesResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryValidatorPricesResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryValidatorPricesResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(ValidatorPrice)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryValidatorPricesResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryValidatorPricesResponse_1_list) NewElement() protoreflect.Value {
-	v := new(ValidatorPrice)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryValidatorPricesResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryValidatorPricesResponse                  protoreflect.MessageDescriptor
-	fd_QueryValidatorPricesResponse_validator_prices protoreflect.FieldDescriptor
+	md_QueryReferenceSourceConfigResponse                         protoreflect.MessageDescriptor
+	fd_QueryReferenceSourceConfigResponse_reference_source_config protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_query_proto_init()
-	md_QueryValidatorPricesResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidatorPricesResponse")
-	fd_QueryValidatorPricesResponse_validator_prices = md_QueryValidatorPricesResponse.Fields().ByName("validator_prices")
+	md_QueryReferenceSourceConfigResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryReferenceSourceConfigResponse")
+	fd_QueryReferenceSourceConfigResponse_reference_source_config = md_QueryReferenceSourceConfigResponse.Fields().ByName("reference_source_config")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorPricesResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryReferenceSourceConfigResponse)(nil)
 
-type fastReflection_QueryValidatorPricesResponse QueryValidatorPricesResponse
+type fastReflection_QueryReferenceSourceConfigResponse QueryReferenceSourceConfigResponse
 
-func (x *QueryValidatorPricesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorPricesResponse)(x)
+func (x *QueryReferenceSourceConfigResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryReferenceSourceConfigResponse)(x)
 }
 
-func (x *QueryValidatorPricesResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryReferenceSourceConfigResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8708,43 +8594,43 @@ func (x *QueryValidatorPricesResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorPricesResponse_messageType fastReflection_QueryValidatorPricesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorPricesResponse_messageType{}
+var _fastReflection_QueryReferenceSourceConfigResponse_messageType fastReflection_QueryReferenceSourceConfigResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryReferenceSourceConfigResponse_messageType{}
 
-type fastReflection_QueryValidatorPricesResponse_messageType struct{}
+type fastReflection_QueryReferenceSourceConfigResponse_messageType struct{}
 
-func (x fastReflection_QueryValidatorPricesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorPricesResponse)(nil)
+func (x fastReflection_QueryReferenceSourceConfigResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryReferenceSourceConfigResponse)(nil)
 }
-func (x fastReflection_QueryValidatorPricesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorPricesResponse)
+func (x fastReflection_QueryReferenceSourceConfigResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryReferenceSourceConfigResponse)
 }
-func (x fastReflection_QueryValidatorPricesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorPricesResponse
+func (x fastReflection_QueryReferenceSourceConfigResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReferenceSourceConfigResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorPricesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorPricesResponse
+func (x *fastReflection_QueryReferenceSourceConfigResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReferenceSourceConfigResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorPricesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorPricesResponse_messageType
+func (x *fastReflection_QueryReferenceSourceConfigResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryReferenceSourceConfigResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorPricesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorPricesResponse)
+func (x *fastReflection_QueryReferenceSourceConfigResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryReferenceSourceConfigResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorPricesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorPricesResponse)(x)
+func (x *fastReflection_QueryReferenceSourceConfigResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryReferenceSourceConfigResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8752,10 +8638,10 @@ func (x *fastReflection_QueryValidatorPricesResponse) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorPricesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ValidatorPrices) != 0 {
-		value := protoreflect.ValueOfList(&_QueryValidatorPricesResponse_1_list{list: &x.ValidatorPrices})
-		if !f(fd_QueryValidatorPricesResponse_validator_prices, value) {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReferenceSourceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.ReferenceSourceConfig.ProtoReflect())
+		if !f(fd_QueryReferenceSourceConfigResponse_reference_source_config, value) {
 			return
 		}
 	}
@@ -8772,15 +8658,15 @@ func (x *fastReflection_QueryValidatorPricesResponse) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorPricesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
-		return len(x.ValidatorPrices) != 0
+	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
+		return x.ReferenceSourceConfig != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8790,15 +8676,15 @@ func (x *fastReflection_QueryValidatorPricesResponse) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPricesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
-		x.ValidatorPrices = nil
+	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
+		x.ReferenceSourceConfig = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8808,19 +8694,16 @@ func (x *fastReflection_QueryValidatorPricesResponse) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorPricesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
-		if len(x.ValidatorPrices) == 0 {
-			return protoreflect.ValueOfList(&_QueryValidatorPricesResponse_1_list{})
-		}
-		listValue := &_QueryValidatorPricesResponse_1_list{list: &x.ValidatorPrices}
-		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
+		value := x.ReferenceSourceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8834,17 +8717,15 @@ func (x *fastReflection_QueryValidatorPricesResponse) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPricesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
-		lv := value.List()
-		clv := lv.(*_QueryValidatorPricesResponse_1_list)
-		x.ValidatorPrices = *clv.list
+	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
+		x.ReferenceSourceConfig = value.Message().Interface().(*ReferenceSourceConfig)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8858,45 +8739,44 @@ func (x *fastReflection_QueryValidatorPricesResponse) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPricesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
-		if x.ValidatorPrices == nil {
-			x.ValidatorPrices = []*ValidatorPrice{}
+	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
+		if x.ReferenceSourceConfig == nil {
+			x.ReferenceSourceConfig = new(ReferenceSourceConfig)
 		}
-		value := &_QueryValidatorPricesResponse_1_list{list: &x.ValidatorPrices}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.ReferenceSourceConfig.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorPricesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
-		list := []*ValidatorPrice{}
-		return protoreflect.ValueOfList(&_QueryValidatorPricesResponse_1_list{list: &list})
+	case "band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config":
+		m := new(ReferenceSourceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryReferenceSourceConfigResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorPricesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidatorPricesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryReferenceSourceConfigResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8904,7 +8784,7 @@ func (x *fastReflection_QueryValidatorPricesResponse) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorPricesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8915,7 +8795,7 @@ func (x *fastReflection_QueryValidatorPricesResponse) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorPricesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8927,7 +8807,7 @@ func (x *fastReflection_QueryValidatorPricesResponse) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorPricesResponse) IsValid() bool {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8937,9 +8817,9 @@ func (x *fastReflection_QueryValidatorPricesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorPricesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryReferenceSourceConfigResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorPricesResponse)
+		x := input.Message.Interface().(*QueryReferenceSourceConfigResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8951,11 +8831,9 @@ func (x *fastReflection_QueryValidatorPricesResponse) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		if len(x.ValidatorPrices) > 0 {
-			for _, e := range x.ValidatorPrices {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.ReferenceSourceConfig != nil {
+			l = options.Size(x.ReferenceSourceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8967,7 +8845,7 @@ func (x *fastReflection_QueryValidatorPricesResponse) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorPricesResponse)
+		x := input.Message.Interface().(*QueryReferenceSourceConfigResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8986,21 +8864,19 @@ func (x *fastReflection_QueryValidatorPricesResponse) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorPrices) > 0 {
-			for iNdEx := len(x.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ValidatorPrices[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.ReferenceSourceConfig != nil {
+			encoded, err := options.Marshal(x.ReferenceSourceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9013,7 +8889,7 @@ func (x *fastReflection_QueryValidatorPricesResponse) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorPricesResponse)
+		x := input.Message.Interface().(*QueryReferenceSourceConfigResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9045,15 +8921,15 @@ func (x *fastReflection_QueryValidatorPricesResponse) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPricesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReferenceSourceConfigResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9080,8 +8956,10 @@ func (x *fastReflection_QueryValidatorPricesResponse) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorPrices = append(x.ValidatorPrices, &ValidatorPrice{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorPrices[len(x.ValidatorPrices)-1]); err != nil {
+				if x.ReferenceSourceConfig == nil {
+					x.ReferenceSourceConfig = &ReferenceSourceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReferenceSourceConfig); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -9121,25 +8999,25 @@ func (x *fastReflection_QueryValidatorPricesResponse) ProtoMethods() *protoiface
 }
 
 var (
-	md_QueryVoteRequest       protoreflect.MessageDescriptor
-	fd_QueryVoteRequest_voter protoreflect.FieldDescriptor
+	md_QuerySignalMetadataRequest           protoreflect.MessageDescriptor
+	fd_QuerySignalMetadataRequest_signal_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_query_proto_init()
-	md_QueryVoteRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryVoteRequest")
-	fd_QueryVoteRequest_voter = md_QueryVoteRequest.Fields().ByName("voter")
+	md_QuerySignalMetadataRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QuerySignalMetadataRequest")
+	fd_QuerySignalMetadataRequest_signal_id = md_QuerySignalMetadataRequest.Fields().ByName("signal_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVoteRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySignalMetadataRequest)(nil)
 
-type fastReflection_QueryVoteRequest QueryVoteRequest
+type fastReflection_QuerySignalMetadataRequest QuerySignalMetadataRequest
 
-func (x *QueryVoteRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVoteRequest)(x)
+func (x *QuerySignalMetadataRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySignalMetadataRequest)(x)
 }
 
-func (x *QueryVoteRequest) slowProtoReflect() protoreflect.Message {
+func (x *QuerySignalMetadataRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9151,43 +9029,43 @@ func (x *QueryVoteRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVoteRequest_messageType fastReflection_QueryVoteRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVoteRequest_messageType{}
+var _fastReflection_QuerySignalMetadataRequest_messageType fastReflection_QuerySignalMetadataRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySignalMetadataRequest_messageType{}
 
-type fastReflection_QueryVoteRequest_messageType struct{}
+type fastReflection_QuerySignalMetadataRequest_messageType struct{}
 
-func (x fastReflection_QueryVoteRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVoteRequest)(nil)
+func (x fastReflection_QuerySignalMetadataRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySignalMetadataRequest)(nil)
 }
-func (x fastReflection_QueryVoteRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteRequest)
+func (x fastReflection_QuerySignalMetadataRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySignalMetadataRequest)
 }
-func (x fastReflection_QueryVoteRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteRequest
+func (x fastReflection_QuerySignalMetadataRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignalMetadataRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVoteRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteRequest
+func (x *fastReflection_QuerySignalMetadataRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignalMetadataRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVoteRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVoteRequest_messageType
+func (x *fastReflection_QuerySignalMetadataRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySignalMetadataRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVoteRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteRequest)
+func (x *fastReflection_QuerySignalMetadataRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySignalMetadataRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVoteRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryVoteRequest)(x)
+func (x *fastReflection_QuerySignalMetadataRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySignalMetadataRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9195,10 +9073,10 @@ func (x *fastReflection_QueryVoteRequest) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVoteRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_QueryVoteRequest_voter, value) {
+func (x *fastReflection_QuerySignalMetadataRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_QuerySignalMetadataRequest_signal_id, value) {
 			return
 		}
 	}
@@ -9215,15 +9093,15 @@ func (x *fastReflection_QueryVoteRequest) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVoteRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySignalMetadataRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryVoteRequest.voter":
-		return x.Voter != ""
+	case "band.feeds.v1beta1.QuerySignalMetadataRequest.signal_id":
+		return x.SignalId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9233,15 +9111,15 @@ func (x *fastReflection_QueryVoteRequest) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySignalMetadataRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryVoteRequest.voter":
-		x.Voter = ""
+	case "band.feeds.v1beta1.QuerySignalMetadataRequest.signal_id":
+		x.SignalId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9251,16 +9129,16 @@ func (x *fastReflection_QueryVoteRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVoteRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignalMetadataRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.QueryVoteRequest.voter":
-		value := x.Voter
+	case "band.feeds.v1beta1.QuerySignalMetadataRequest.signal_id":
+		value := x.SignalId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9274,15 +9152,15 @@ func (x *fastReflection_QueryVoteRequest) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySignalMetadataRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryVoteRequest.voter":
-		x.Voter = value.Interface().(string)
+	case "band.feeds.v1beta1.QuerySignalMetadataRequest.signal_id":
+		x.SignalId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9296,40 +9174,40 @@ func (x *fastReflection_QueryVoteRequest) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignalMetadataRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryVoteRequest.voter":
-		panic(fmt.Errorf("field voter of message band.feeds.v1beta1.QueryVoteRequest is not mutable"))
+	case "band.feeds.v1beta1.QuerySignalMetadataRequest.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.QuerySignalMetadataRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVoteRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignalMetadataRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryVoteRequest.voter":
+	case "band.feeds.v1beta1.QuerySignalMetadataRequest.signal_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataRequest"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVoteRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySignalMetadataRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryVoteRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QuerySignalMetadataRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9337,7 +9215,7 @@ func (x *fastReflection_QueryVoteRequest) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVoteRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySignalMetadataRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9348,7 +9226,7 @@ func (x *fastReflection_QueryVoteRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySignalMetadataRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9360,7 +9238,7 @@ func (x *fastReflection_QueryVoteRequest) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVoteRequest) IsValid() bool {
+func (x *fastReflection_QuerySignalMetadataRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9370,9 +9248,9 @@ func (x *fastReflection_QueryVoteRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySignalMetadataRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVoteRequest)
+		x := input.Message.Interface().(*QuerySignalMetadataRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9384,7 +9262,7 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Voter)
+		l = len(x.SignalId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -9398,7 +9276,7 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteRequest)
+		x := input.Message.Interface().(*QuerySignalMetadataRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9417,10 +9295,10 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -9435,7 +9313,7 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteRequest)
+		x := input.Message.Interface().(*QuerySignalMetadataRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9467,15 +9345,15 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalMetadataRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9503,7 +9381,7 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9540,77 +9418,26 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_QueryVoteResponse_1_list)(nil)
-
-type _QueryVoteResponse_1_list struct {
-	list *[]*Signal
-}
-
-func (x *_QueryVoteResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryVoteResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryVoteResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Signal)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryVoteResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Signal)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryVoteResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Signal)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryVoteResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryVoteResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Signal)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryVoteResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryVoteResponse         protoreflect.MessageDescriptor
-	fd_QueryVoteResponse_signals protoreflect.FieldDescriptor
+	md_QuerySignalMetadataResponse                 protoreflect.MessageDescriptor
+	fd_QuerySignalMetadataResponse_signal_metadata protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_query_proto_init()
-	md_QueryVoteResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryVoteResponse")
-	fd_QueryVoteResponse_signals = md_QueryVoteResponse.Fields().ByName("signals")
+	md_QuerySignalMetadataResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QuerySignalMetadataResponse")
+	fd_QuerySignalMetadataResponse_signal_metadata = md_QuerySignalMetadataResponse.Fields().ByName("signal_metadata")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVoteResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySignalMetadataResponse)(nil)
 
-type fastReflection_QueryVoteResponse QueryVoteResponse
+type fastReflection_QuerySignalMetadataResponse QuerySignalMetadataResponse
 
-func (x *QueryVoteResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVoteResponse)(x)
+func (x *QuerySignalMetadataResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySignalMetadataResponse)(x)
 }
 
-func (x *QueryVoteResponse) slowProtoReflect() protoreflect.Message {
+func (x *QuerySignalMetadataResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9622,43 +9449,43 @@ func (x *QueryVoteResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVoteResponse_messageType fastReflection_QueryVoteResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVoteResponse_messageType{}
+var _fastReflection_QuerySignalMetadataResponse_messageType fastReflection_QuerySignalMetadataResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySignalMetadataResponse_messageType{}
 
-type fastReflection_QueryVoteResponse_messageType struct{}
+type fastReflection_QuerySignalMetadataResponse_messageType struct{}
 
-func (x fastReflection_QueryVoteResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVoteResponse)(nil)
+func (x fastReflection_QuerySignalMetadataResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySignalMetadataResponse)(nil)
 }
-func (x fastReflection_QueryVoteResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteResponse)
+func (x fastReflection_QuerySignalMetadataResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySignalMetadataResponse)
 }
-func (x fastReflection_QueryVoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteResponse
+func (x fastReflection_QuerySignalMetadataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignalMetadataResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVoteResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteResponse
+func (x *fastReflection_QuerySignalMetadataResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignalMetadataResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVoteResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVoteResponse_messageType
+func (x *fastReflection_QuerySignalMetadataResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySignalMetadataResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVoteResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteResponse)
+func (x *fastReflection_QuerySignalMetadataResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySignalMetadataResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVoteResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryVoteResponse)(x)
+func (x *fastReflection_QuerySignalMetadataResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySignalMetadataResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9666,10 +9493,10 @@ func (x *fastReflection_QueryVoteResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Signals) != 0 {
-		value := protoreflect.ValueOfList(&_QueryVoteResponse_1_list{list: &x.Signals})
-		if !f(fd_QueryVoteResponse_signals, value) {
+func (x *fastReflection_QuerySignalMetadataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalMetadata != nil {
+		value := protoreflect.ValueOfMessage(x.SignalMetadata.ProtoReflect())
+		if !f(fd_QuerySignalMetadataResponse_signal_metadata, value) {
 			return
 		}
 	}
@@ -9686,15 +9513,15 @@ func (x *fastReflection_QueryVoteResponse) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySignalMetadataResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryVoteResponse.signals":
-		return len(x.Signals) != 0
+	case "band.feeds.v1beta1.QuerySignalMetadataResponse.signal_metadata":
+		return x.SignalMetadata != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9704,15 +9531,15 @@ func (x *fastReflection_QueryVoteResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySignalMetadataResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryVoteResponse.signals":
-		x.Signals = nil
+	case "band.feeds.v1beta1.QuerySignalMetadataResponse.signal_metadata":
+		x.SignalMetadata = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9722,19 +9549,16 @@ func (x *fastReflection_QueryVoteResponse) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignalMetadataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.QueryVoteResponse.signals":
-		if len(x.Signals) == 0 {
-			return protoreflect.ValueOfList(&_QueryVoteResponse_1_list{})
-		}
-		listValue := &_QueryVoteResponse_1_list{list: &x.Signals}
-		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.QuerySignalMetadataResponse.signal_metadata":
+		value := x.SignalMetadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9748,17 +9572,15 @@ func (x *fastReflection_QueryVoteResponse) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySignalMetadataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryVoteResponse.signals":
-		lv := value.List()
-		clv := lv.(*_QueryVoteResponse_1_list)
-		x.Signals = *clv.list
+	case "band.feeds.v1beta1.QuerySignalMetadataResponse.signal_metadata":
+		x.SignalMetadata = value.Message().Interface().(*SignalMetadata)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9772,45 +9594,44 @@ func (x *fastReflection_QueryVoteResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignalMetadataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryVoteResponse.signals":
-		if x.Signals == nil {
-			x.Signals = []*Signal{}
+	case "band.feeds.v1beta1.QuerySignalMetadataResponse.signal_metadata":
+		if x.SignalMetadata == nil {
+			x.SignalMetadata = new(SignalMetadata)
 		}
-		value := &_QueryVoteResponse_1_list{list: &x.Signals}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.SignalMetadata.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignalMetadataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.QueryVoteResponse.signals":
-		list := []*Signal{}
-		return protoreflect.ValueOfList(&_QueryVoteResponse_1_list{list: &list})
+	case "band.feeds.v1beta1.QuerySignalMetadataResponse.signal_metadata":
+		m := new(SignalMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySignalMetadataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryVoteResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QuerySignalMetadataResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9818,7 +9639,7 @@ func (x *fastReflection_QueryVoteResponse) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVoteResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySignalMetadataResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9829,7 +9650,7 @@ func (x *fastReflection_QueryVoteResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySignalMetadataResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9841,7 +9662,7 @@ func (x *fastReflection_QueryVoteResponse) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVoteResponse) IsValid() bool {
+func (x *fastReflection_QuerySignalMetadataResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9851,9 +9672,9 @@ func (x *fastReflection_QueryVoteResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySignalMetadataResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVoteResponse)
+		x := input.Message.Interface().(*QuerySignalMetadataResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9865,11 +9686,9 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Signals) > 0 {
-			for _, e := range x.Signals {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.SignalMetadata != nil {
+			l = options.Size(x.SignalMetadata)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -9881,7 +9700,7 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteResponse)
+		x := input.Message.Interface().(*QuerySignalMetadataResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9900,21 +9719,19 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signals) > 0 {
-			for iNdEx := len(x.Signals) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Signals[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.SignalMetadata != nil {
+			encoded, err := options.Marshal(x.SignalMetadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9927,7 +9744,7 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteResponse)
+		x := input.Message.Interface().(*QuerySignalMetadataResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9959,15 +9776,15 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalMetadataResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalMetadata", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9994,8 +9811,10 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signals = append(x.Signals, &Signal{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signals[len(x.Signals)-1]); err != nil {
+				if x.SignalMetadata == nil {
+					x.SignalMetadata = &SignalMetadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignalMetadata); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -10034,380 +9853,10628 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/feeds/v1beta1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryAllSignalMetadataRequest            protoreflect.MessageDescriptor
+	fd_QueryAllSignalMetadataRequest_pagination protoreflect.FieldDescriptor
 )
 
-// QueryCurrentFeedsRequest is the request type for the Query/CurrentFeeds RPC method.
-type QueryCurrentFeedsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryAllSignalMetadataRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryAllSignalMetadataRequest")
+	fd_QueryAllSignalMetadataRequest_pagination = md_QueryAllSignalMetadataRequest.Fields().ByName("pagination")
 }
 
-func (x *QueryCurrentFeedsRequest) Reset() {
-	*x = QueryCurrentFeedsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[0]
+var _ protoreflect.Message = (*fastReflection_QueryAllSignalMetadataRequest)(nil)
+
+type fastReflection_QueryAllSignalMetadataRequest QueryAllSignalMetadataRequest
+
+func (x *QueryAllSignalMetadataRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllSignalMetadataRequest)(x)
+}
+
+func (x *QueryAllSignalMetadataRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *QueryCurrentFeedsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_QueryAllSignalMetadataRequest_messageType fastReflection_QueryAllSignalMetadataRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllSignalMetadataRequest_messageType{}
+
+type fastReflection_QueryAllSignalMetadataRequest_messageType struct{}
+
+func (x fastReflection_QueryAllSignalMetadataRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllSignalMetadataRequest)(nil)
+}
+func (x fastReflection_QueryAllSignalMetadataRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllSignalMetadataRequest)
+}
+func (x fastReflection_QueryAllSignalMetadataRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllSignalMetadataRequest
 }
 
-func (*QueryCurrentFeedsRequest) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllSignalMetadataRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllSignalMetadataRequest
+}
 
-// Deprecated: Use QueryCurrentFeedsRequest.ProtoReflect.Descriptor instead.
-func (*QueryCurrentFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllSignalMetadataRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllSignalMetadataRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllSignalMetadataRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllSignalMetadataRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllSignalMetadataRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllSignalMetadataRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllSignalMetadataRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllSignalMetadataRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllSignalMetadataRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllSignalMetadataRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllSignalMetadataRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllSignalMetadataRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllSignalMetadataRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllSignalMetadataRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllSignalMetadataRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryAllSignalMetadataRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllSignalMetadataRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllSignalMetadataRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllSignalMetadataRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllSignalMetadataRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllSignalMetadataRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllSignalMetadataRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllSignalMetadataRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllSignalMetadataRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllSignalMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAllSignalMetadataResponse_1_list)(nil)
+
+type _QueryAllSignalMetadataResponse_1_list struct {
+	list *[]*SignalMetadata
+}
+
+func (x *_QueryAllSignalMetadataResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllSignalMetadataResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAllSignalMetadataResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalMetadata)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllSignalMetadataResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalMetadata)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllSignalMetadataResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(SignalMetadata)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllSignalMetadataResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllSignalMetadataResponse_1_list) NewElement() protoreflect.Value {
+	v := new(SignalMetadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllSignalMetadataResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAllSignalMetadataResponse                 protoreflect.MessageDescriptor
+	fd_QueryAllSignalMetadataResponse_signal_metadata protoreflect.FieldDescriptor
+	fd_QueryAllSignalMetadataResponse_pagination      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryAllSignalMetadataResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryAllSignalMetadataResponse")
+	fd_QueryAllSignalMetadataResponse_signal_metadata = md_QueryAllSignalMetadataResponse.Fields().ByName("signal_metadata")
+	fd_QueryAllSignalMetadataResponse_pagination = md_QueryAllSignalMetadataResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllSignalMetadataResponse)(nil)
+
+type fastReflection_QueryAllSignalMetadataResponse QueryAllSignalMetadataResponse
+
+func (x *QueryAllSignalMetadataResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllSignalMetadataResponse)(x)
+}
+
+func (x *QueryAllSignalMetadataResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllSignalMetadataResponse_messageType fastReflection_QueryAllSignalMetadataResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllSignalMetadataResponse_messageType{}
+
+type fastReflection_QueryAllSignalMetadataResponse_messageType struct{}
+
+func (x fastReflection_QueryAllSignalMetadataResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllSignalMetadataResponse)(nil)
+}
+func (x fastReflection_QueryAllSignalMetadataResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllSignalMetadataResponse)
+}
+func (x fastReflection_QueryAllSignalMetadataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllSignalMetadataResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllSignalMetadataResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllSignalMetadataResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllSignalMetadataResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllSignalMetadataResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllSignalMetadataResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllSignalMetadataResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllSignalMetadataResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllSignalMetadataResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllSignalMetadataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.SignalMetadata) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllSignalMetadataResponse_1_list{list: &x.SignalMetadata})
+		if !f(fd_QueryAllSignalMetadataResponse_signal_metadata, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllSignalMetadataResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllSignalMetadataResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.signal_metadata":
+		return len(x.SignalMetadata) != 0
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllSignalMetadataResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.signal_metadata":
+		x.SignalMetadata = nil
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllSignalMetadataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.signal_metadata":
+		if len(x.SignalMetadata) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllSignalMetadataResponse_1_list{})
+		}
+		listValue := &_QueryAllSignalMetadataResponse_1_list{list: &x.SignalMetadata}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllSignalMetadataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.signal_metadata":
+		lv := value.List()
+		clv := lv.(*_QueryAllSignalMetadataResponse_1_list)
+		x.SignalMetadata = *clv.list
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllSignalMetadataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.signal_metadata":
+		if x.SignalMetadata == nil {
+			x.SignalMetadata = []*SignalMetadata{}
+		}
+		value := &_QueryAllSignalMetadataResponse_1_list{list: &x.SignalMetadata}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllSignalMetadataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.signal_metadata":
+		list := []*SignalMetadata{}
+		return protoreflect.ValueOfList(&_QueryAllSignalMetadataResponse_1_list{list: &list})
+	case "band.feeds.v1beta1.QueryAllSignalMetadataResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryAllSignalMetadataResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryAllSignalMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllSignalMetadataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryAllSignalMetadataResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllSignalMetadataResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllSignalMetadataResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllSignalMetadataResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllSignalMetadataResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllSignalMetadataResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.SignalMetadata) > 0 {
+			for _, e := range x.SignalMetadata {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllSignalMetadataResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SignalMetadata) > 0 {
+			for iNdEx := len(x.SignalMetadata) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SignalMetadata[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllSignalMetadataResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllSignalMetadataResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllSignalMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalMetadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalMetadata = append(x.SignalMetadata, &SignalMetadata{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignalMetadata[len(x.SignalMetadata)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryCircuitBreakersRequest            protoreflect.MessageDescriptor
+	fd_QueryCircuitBreakersRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryCircuitBreakersRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryCircuitBreakersRequest")
+	fd_QueryCircuitBreakersRequest_pagination = md_QueryCircuitBreakersRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCircuitBreakersRequest)(nil)
+
+type fastReflection_QueryCircuitBreakersRequest QueryCircuitBreakersRequest
+
+func (x *QueryCircuitBreakersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCircuitBreakersRequest)(x)
+}
+
+func (x *QueryCircuitBreakersRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCircuitBreakersRequest_messageType fastReflection_QueryCircuitBreakersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCircuitBreakersRequest_messageType{}
+
+type fastReflection_QueryCircuitBreakersRequest_messageType struct{}
+
+func (x fastReflection_QueryCircuitBreakersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCircuitBreakersRequest)(nil)
+}
+func (x fastReflection_QueryCircuitBreakersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCircuitBreakersRequest)
+}
+func (x fastReflection_QueryCircuitBreakersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCircuitBreakersRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCircuitBreakersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCircuitBreakersRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCircuitBreakersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCircuitBreakersRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCircuitBreakersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCircuitBreakersRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCircuitBreakersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCircuitBreakersRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCircuitBreakersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryCircuitBreakersRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCircuitBreakersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCircuitBreakersRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCircuitBreakersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCircuitBreakersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCircuitBreakersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCircuitBreakersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCircuitBreakersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryCircuitBreakersRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCircuitBreakersRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCircuitBreakersRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCircuitBreakersRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCircuitBreakersRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCircuitBreakersRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCircuitBreakersRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCircuitBreakersRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCircuitBreakersRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCircuitBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryCircuitBreakersResponse_1_list)(nil)
+
+type _QueryCircuitBreakersResponse_1_list struct {
+	list *[]*CircuitBreaker
+}
+
+func (x *_QueryCircuitBreakersResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryCircuitBreakersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryCircuitBreakersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CircuitBreaker)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryCircuitBreakersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CircuitBreaker)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryCircuitBreakersResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(CircuitBreaker)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCircuitBreakersResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryCircuitBreakersResponse_1_list) NewElement() protoreflect.Value {
+	v := new(CircuitBreaker)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCircuitBreakersResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryCircuitBreakersResponse                  protoreflect.MessageDescriptor
+	fd_QueryCircuitBreakersResponse_circuit_breakers protoreflect.FieldDescriptor
+	fd_QueryCircuitBreakersResponse_pagination       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryCircuitBreakersResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryCircuitBreakersResponse")
+	fd_QueryCircuitBreakersResponse_circuit_breakers = md_QueryCircuitBreakersResponse.Fields().ByName("circuit_breakers")
+	fd_QueryCircuitBreakersResponse_pagination = md_QueryCircuitBreakersResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCircuitBreakersResponse)(nil)
+
+type fastReflection_QueryCircuitBreakersResponse QueryCircuitBreakersResponse
+
+func (x *QueryCircuitBreakersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCircuitBreakersResponse)(x)
+}
+
+func (x *QueryCircuitBreakersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCircuitBreakersResponse_messageType fastReflection_QueryCircuitBreakersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCircuitBreakersResponse_messageType{}
+
+type fastReflection_QueryCircuitBreakersResponse_messageType struct{}
+
+func (x fastReflection_QueryCircuitBreakersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCircuitBreakersResponse)(nil)
+}
+func (x fastReflection_QueryCircuitBreakersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCircuitBreakersResponse)
+}
+func (x fastReflection_QueryCircuitBreakersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCircuitBreakersResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCircuitBreakersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCircuitBreakersResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCircuitBreakersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCircuitBreakersResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCircuitBreakersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCircuitBreakersResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCircuitBreakersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCircuitBreakersResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCircuitBreakersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.CircuitBreakers) != 0 {
+		value := protoreflect.ValueOfList(&_QueryCircuitBreakersResponse_1_list{list: &x.CircuitBreakers})
+		if !f(fd_QueryCircuitBreakersResponse_circuit_breakers, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryCircuitBreakersResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCircuitBreakersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.circuit_breakers":
+		return len(x.CircuitBreakers) != 0
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCircuitBreakersResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.circuit_breakers":
+		x.CircuitBreakers = nil
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCircuitBreakersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.circuit_breakers":
+		if len(x.CircuitBreakers) == 0 {
+			return protoreflect.ValueOfList(&_QueryCircuitBreakersResponse_1_list{})
+		}
+		listValue := &_QueryCircuitBreakersResponse_1_list{list: &x.CircuitBreakers}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCircuitBreakersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.circuit_breakers":
+		lv := value.List()
+		clv := lv.(*_QueryCircuitBreakersResponse_1_list)
+		x.CircuitBreakers = *clv.list
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCircuitBreakersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.circuit_breakers":
+		if x.CircuitBreakers == nil {
+			x.CircuitBreakers = []*CircuitBreaker{}
+		}
+		value := &_QueryCircuitBreakersResponse_1_list{list: &x.CircuitBreakers}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCircuitBreakersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.circuit_breakers":
+		list := []*CircuitBreaker{}
+		return protoreflect.ValueOfList(&_QueryCircuitBreakersResponse_1_list{list: &list})
+	case "band.feeds.v1beta1.QueryCircuitBreakersResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryCircuitBreakersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryCircuitBreakersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCircuitBreakersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryCircuitBreakersResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCircuitBreakersResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCircuitBreakersResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCircuitBreakersResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCircuitBreakersResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCircuitBreakersResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.CircuitBreakers) > 0 {
+			for _, e := range x.CircuitBreakers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCircuitBreakersResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.CircuitBreakers) > 0 {
+			for iNdEx := len(x.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CircuitBreakers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCircuitBreakersResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCircuitBreakersResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CircuitBreakers = append(x.CircuitBreakers, &CircuitBreaker{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CircuitBreakers[len(x.CircuitBreakers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySignalTotalPowersRequest_1_list)(nil)
+
+type _QuerySignalTotalPowersRequest_1_list struct {
+	list *[]string
+}
+
+func (x *_QuerySignalTotalPowersRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySignalTotalPowersRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QuerySignalTotalPowersRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySignalTotalPowersRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySignalTotalPowersRequest_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QuerySignalTotalPowersRequest at list field SignalIds as it is not of Message kind"))
+}
+
+func (x *_QuerySignalTotalPowersRequest_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySignalTotalPowersRequest_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QuerySignalTotalPowersRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySignalTotalPowersRequest            protoreflect.MessageDescriptor
+	fd_QuerySignalTotalPowersRequest_signal_ids protoreflect.FieldDescriptor
+	fd_QuerySignalTotalPowersRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QuerySignalTotalPowersRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QuerySignalTotalPowersRequest")
+	fd_QuerySignalTotalPowersRequest_signal_ids = md_QuerySignalTotalPowersRequest.Fields().ByName("signal_ids")
+	fd_QuerySignalTotalPowersRequest_pagination = md_QuerySignalTotalPowersRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySignalTotalPowersRequest)(nil)
+
+type fastReflection_QuerySignalTotalPowersRequest QuerySignalTotalPowersRequest
+
+func (x *QuerySignalTotalPowersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySignalTotalPowersRequest)(x)
+}
+
+func (x *QuerySignalTotalPowersRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySignalTotalPowersRequest_messageType fastReflection_QuerySignalTotalPowersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySignalTotalPowersRequest_messageType{}
+
+type fastReflection_QuerySignalTotalPowersRequest_messageType struct{}
+
+func (x fastReflection_QuerySignalTotalPowersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySignalTotalPowersRequest)(nil)
+}
+func (x fastReflection_QuerySignalTotalPowersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySignalTotalPowersRequest)
+}
+func (x fastReflection_QuerySignalTotalPowersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignalTotalPowersRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySignalTotalPowersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignalTotalPowersRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySignalTotalPowersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySignalTotalPowersRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySignalTotalPowersRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySignalTotalPowersRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySignalTotalPowersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySignalTotalPowersRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySignalTotalPowersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.SignalIds) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySignalTotalPowersRequest_1_list{list: &x.SignalIds})
+		if !f(fd_QuerySignalTotalPowersRequest_signal_ids, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QuerySignalTotalPowersRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySignalTotalPowersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
+		return len(x.SignalIds) != 0
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySignalTotalPowersRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
+		x.SignalIds = nil
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySignalTotalPowersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
+		if len(x.SignalIds) == 0 {
+			return protoreflect.ValueOfList(&_QuerySignalTotalPowersRequest_1_list{})
+		}
+		listValue := &_QuerySignalTotalPowersRequest_1_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySignalTotalPowersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
+		lv := value.List()
+		clv := lv.(*_QuerySignalTotalPowersRequest_1_list)
+		x.SignalIds = *clv.list
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySignalTotalPowersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
+		if x.SignalIds == nil {
+			x.SignalIds = []string{}
+		}
+		value := &_QuerySignalTotalPowersRequest_1_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySignalTotalPowersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.signal_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QuerySignalTotalPowersRequest_1_list{list: &list})
+	case "band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySignalTotalPowersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QuerySignalTotalPowersRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySignalTotalPowersRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySignalTotalPowersRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySignalTotalPowersRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySignalTotalPowersRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySignalTotalPowersRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.SignalIds) > 0 {
+			for _, s := range x.SignalIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySignalTotalPowersRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SignalIds) > 0 {
+			for iNdEx := len(x.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SignalIds[iNdEx])
+				copy(dAtA[i:], x.SignalIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalIds[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySignalTotalPowersRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalTotalPowersRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalTotalPowersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalIds = append(x.SignalIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySignalTotalPowersResponse_1_list)(nil)
+
+type _QuerySignalTotalPowersResponse_1_list struct {
+	list *[]*Signal
+}
+
+func (x *_QuerySignalTotalPowersResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySignalTotalPowersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySignalTotalPowersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySignalTotalPowersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySignalTotalPowersResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Signal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySignalTotalPowersResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySignalTotalPowersResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Signal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySignalTotalPowersResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySignalTotalPowersResponse                     protoreflect.MessageDescriptor
+	fd_QuerySignalTotalPowersResponse_signal_total_powers protoreflect.FieldDescriptor
+	fd_QuerySignalTotalPowersResponse_pagination          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QuerySignalTotalPowersResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QuerySignalTotalPowersResponse")
+	fd_QuerySignalTotalPowersResponse_signal_total_powers = md_QuerySignalTotalPowersResponse.Fields().ByName("signal_total_powers")
+	fd_QuerySignalTotalPowersResponse_pagination = md_QuerySignalTotalPowersResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySignalTotalPowersResponse)(nil)
+
+type fastReflection_QuerySignalTotalPowersResponse QuerySignalTotalPowersResponse
+
+func (x *QuerySignalTotalPowersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySignalTotalPowersResponse)(x)
+}
+
+func (x *QuerySignalTotalPowersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySignalTotalPowersResponse_messageType fastReflection_QuerySignalTotalPowersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySignalTotalPowersResponse_messageType{}
+
+type fastReflection_QuerySignalTotalPowersResponse_messageType struct{}
+
+func (x fastReflection_QuerySignalTotalPowersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySignalTotalPowersResponse)(nil)
+}
+func (x fastReflection_QuerySignalTotalPowersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySignalTotalPowersResponse)
+}
+func (x fastReflection_QuerySignalTotalPowersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignalTotalPowersResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySignalTotalPowersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignalTotalPowersResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySignalTotalPowersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySignalTotalPowersResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySignalTotalPowersResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySignalTotalPowersResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySignalTotalPowersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySignalTotalPowersResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySignalTotalPowersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.SignalTotalPowers) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySignalTotalPowersResponse_1_list{list: &x.SignalTotalPowers})
+		if !f(fd_QuerySignalTotalPowersResponse_signal_total_powers, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QuerySignalTotalPowersResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySignalTotalPowersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
+		return len(x.SignalTotalPowers) != 0
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySignalTotalPowersResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
+		x.SignalTotalPowers = nil
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySignalTotalPowersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
+		if len(x.SignalTotalPowers) == 0 {
+			return protoreflect.ValueOfList(&_QuerySignalTotalPowersResponse_1_list{})
+		}
+		listValue := &_QuerySignalTotalPowersResponse_1_list{list: &x.SignalTotalPowers}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySignalTotalPowersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
+		lv := value.List()
+		clv := lv.(*_QuerySignalTotalPowersResponse_1_list)
+		x.SignalTotalPowers = *clv.list
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySignalTotalPowersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
+		if x.SignalTotalPowers == nil {
+			x.SignalTotalPowers = []*Signal{}
+		}
+		value := &_QuerySignalTotalPowersResponse_1_list{list: &x.SignalTotalPowers}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySignalTotalPowersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers":
+		list := []*Signal{}
+		return protoreflect.ValueOfList(&_QuerySignalTotalPowersResponse_1_list{list: &list})
+	case "band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QuerySignalTotalPowersResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QuerySignalTotalPowersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySignalTotalPowersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QuerySignalTotalPowersResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySignalTotalPowersResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySignalTotalPowersResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySignalTotalPowersResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySignalTotalPowersResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySignalTotalPowersResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.SignalTotalPowers) > 0 {
+			for _, e := range x.SignalTotalPowers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySignalTotalPowersResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SignalTotalPowers) > 0 {
+			for iNdEx := len(x.SignalTotalPowers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SignalTotalPowers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySignalTotalPowersResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalTotalPowersResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignalTotalPowersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalTotalPowers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalTotalPowers = append(x.SignalTotalPowers, &Signal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignalTotalPowers[len(x.SignalTotalPowers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidValidatorRequest           protoreflect.MessageDescriptor
+	fd_QueryValidValidatorRequest_validator protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryValidValidatorRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidValidatorRequest")
+	fd_QueryValidValidatorRequest_validator = md_QueryValidValidatorRequest.Fields().ByName("validator")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidValidatorRequest)(nil)
+
+type fastReflection_QueryValidValidatorRequest QueryValidValidatorRequest
+
+func (x *QueryValidValidatorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidValidatorRequest)(x)
+}
+
+func (x *QueryValidValidatorRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidValidatorRequest_messageType fastReflection_QueryValidValidatorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidValidatorRequest_messageType{}
+
+type fastReflection_QueryValidValidatorRequest_messageType struct{}
+
+func (x fastReflection_QueryValidValidatorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidValidatorRequest)(nil)
+}
+func (x fastReflection_QueryValidValidatorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidValidatorRequest)
+}
+func (x fastReflection_QueryValidValidatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidValidatorRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidValidatorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidValidatorRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidValidatorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidValidatorRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidValidatorRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidValidatorRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidValidatorRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidValidatorRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidValidatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_QueryValidValidatorRequest_validator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidValidatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
+		return x.Validator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidValidatorRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
+		x.Validator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidValidatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidValidatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
+		x.Validator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidValidatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
+		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.QueryValidValidatorRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidValidatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorRequest.validator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidValidatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidValidatorRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidValidatorRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidValidatorRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidValidatorRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidValidatorRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidValidatorRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidValidatorRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidValidatorRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidValidatorRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidValidatorResponse       protoreflect.MessageDescriptor
+	fd_QueryValidValidatorResponse_valid protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryValidValidatorResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidValidatorResponse")
+	fd_QueryValidValidatorResponse_valid = md_QueryValidValidatorResponse.Fields().ByName("valid")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidValidatorResponse)(nil)
+
+type fastReflection_QueryValidValidatorResponse QueryValidValidatorResponse
+
+func (x *QueryValidValidatorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidValidatorResponse)(x)
+}
+
+func (x *QueryValidValidatorResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidValidatorResponse_messageType fastReflection_QueryValidValidatorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidValidatorResponse_messageType{}
+
+type fastReflection_QueryValidValidatorResponse_messageType struct{}
+
+func (x fastReflection_QueryValidValidatorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidValidatorResponse)(nil)
+}
+func (x fastReflection_QueryValidValidatorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidValidatorResponse)
+}
+func (x fastReflection_QueryValidValidatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidValidatorResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidValidatorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidValidatorResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidValidatorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidValidatorResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidValidatorResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidValidatorResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidValidatorResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidValidatorResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidValidatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Valid != false {
+		value := protoreflect.ValueOfBool(x.Valid)
+		if !f(fd_QueryValidValidatorResponse_valid, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidValidatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
+		return x.Valid != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidValidatorResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
+		x.Valid = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidValidatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
+		value := x.Valid
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidValidatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
+		x.Valid = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidValidatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
+		panic(fmt.Errorf("field valid of message band.feeds.v1beta1.QueryValidValidatorResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidValidatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidValidatorResponse.valid":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidValidatorResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidValidatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidValidatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidValidatorResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidValidatorResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidValidatorResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidValidatorResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidValidatorResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidValidatorResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Valid {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidValidatorResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Valid {
+			i--
+			if x.Valid {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidValidatorResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidValidatorResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Valid = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidatorAccuracyRequest           protoreflect.MessageDescriptor
+	fd_QueryValidatorAccuracyRequest_validator protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryValidatorAccuracyRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidatorAccuracyRequest")
+	fd_QueryValidatorAccuracyRequest_validator = md_QueryValidatorAccuracyRequest.Fields().ByName("validator")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorAccuracyRequest)(nil)
+
+type fastReflection_QueryValidatorAccuracyRequest QueryValidatorAccuracyRequest
+
+func (x *QueryValidatorAccuracyRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorAccuracyRequest)(x)
+}
+
+func (x *QueryValidatorAccuracyRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorAccuracyRequest_messageType fastReflection_QueryValidatorAccuracyRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorAccuracyRequest_messageType{}
+
+type fastReflection_QueryValidatorAccuracyRequest_messageType struct{}
+
+func (x fastReflection_QueryValidatorAccuracyRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorAccuracyRequest)(nil)
+}
+func (x fastReflection_QueryValidatorAccuracyRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorAccuracyRequest)
+}
+func (x fastReflection_QueryValidatorAccuracyRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorAccuracyRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorAccuracyRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorAccuracyRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorAccuracyRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorAccuracyRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorAccuracyRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorAccuracyRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorAccuracyRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorAccuracyRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorAccuracyRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_QueryValidatorAccuracyRequest_validator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorAccuracyRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyRequest.validator":
+		return x.Validator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuracyRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyRequest.validator":
+		x.Validator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorAccuracyRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyRequest.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuracyRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyRequest.validator":
+		x.Validator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuracyRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyRequest.validator":
+		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.QueryValidatorAccuracyRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorAccuracyRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyRequest.validator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorAccuracyRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidatorAccuracyRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorAccuracyRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuracyRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorAccuracyRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorAccuracyRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorAccuracyRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorAccuracyRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorAccuracyRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorAccuracyRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorAccuracyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidatorAccuracyResponse                    protoreflect.MessageDescriptor
+	fd_QueryValidatorAccuracyResponse_validator_accuracy protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryValidatorAccuracyResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidatorAccuracyResponse")
+	fd_QueryValidatorAccuracyResponse_validator_accuracy = md_QueryValidatorAccuracyResponse.Fields().ByName("validator_accuracy")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorAccuracyResponse)(nil)
+
+type fastReflection_QueryValidatorAccuracyResponse QueryValidatorAccuracyResponse
+
+func (x *QueryValidatorAccuracyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorAccuracyResponse)(x)
+}
+
+func (x *QueryValidatorAccuracyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorAccuracyResponse_messageType fastReflection_QueryValidatorAccuracyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorAccuracyResponse_messageType{}
+
+type fastReflection_QueryValidatorAccuracyResponse_messageType struct{}
+
+func (x fastReflection_QueryValidatorAccuracyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorAccuracyResponse)(nil)
+}
+func (x fastReflection_QueryValidatorAccuracyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorAccuracyResponse)
+}
+func (x fastReflection_QueryValidatorAccuracyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorAccuracyResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorAccuracyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorAccuracyResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorAccuracyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorAccuracyResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorAccuracyResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorAccuracyResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorAccuracyResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorAccuracyResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorAccuracyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAccuracy != nil {
+		value := protoreflect.ValueOfMessage(x.ValidatorAccuracy.ProtoReflect())
+		if !f(fd_QueryValidatorAccuracyResponse_validator_accuracy, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorAccuracyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyResponse.validator_accuracy":
+		return x.ValidatorAccuracy != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuracyResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyResponse.validator_accuracy":
+		x.ValidatorAccuracy = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorAccuracyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyResponse.validator_accuracy":
+		value := x.ValidatorAccuracy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuracyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyResponse.validator_accuracy":
+		x.ValidatorAccuracy = value.Message().Interface().(*ValidatorAccuracy)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuracyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyResponse.validator_accuracy":
+		if x.ValidatorAccuracy == nil {
+			x.ValidatorAccuracy = new(ValidatorAccuracy)
+		}
+		return protoreflect.ValueOfMessage(x.ValidatorAccuracy.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorAccuracyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuracyResponse.validator_accuracy":
+		m := new(ValidatorAccuracy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuracyResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuracyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorAccuracyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidatorAccuracyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorAccuracyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuracyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorAccuracyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorAccuracyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorAccuracyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ValidatorAccuracy != nil {
+			l = options.Size(x.ValidatorAccuracy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorAccuracyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ValidatorAccuracy != nil {
+			encoded, err := options.Marshal(x.ValidatorAccuracy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorAccuracyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorAccuracyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorAccuracyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAccuracy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ValidatorAccuracy == nil {
+					x.ValidatorAccuracy = &ValidatorAccuracy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorAccuracy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidatorAccuraciesRequest            protoreflect.MessageDescriptor
+	fd_QueryValidatorAccuraciesRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryValidatorAccuraciesRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidatorAccuraciesRequest")
+	fd_QueryValidatorAccuraciesRequest_pagination = md_QueryValidatorAccuraciesRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorAccuraciesRequest)(nil)
+
+type fastReflection_QueryValidatorAccuraciesRequest QueryValidatorAccuraciesRequest
+
+func (x *QueryValidatorAccuraciesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorAccuraciesRequest)(x)
+}
+
+func (x *QueryValidatorAccuraciesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorAccuraciesRequest_messageType fastReflection_QueryValidatorAccuraciesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorAccuraciesRequest_messageType{}
+
+type fastReflection_QueryValidatorAccuraciesRequest_messageType struct{}
+
+func (x fastReflection_QueryValidatorAccuraciesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorAccuraciesRequest)(nil)
+}
+func (x fastReflection_QueryValidatorAccuraciesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorAccuraciesRequest)
+}
+func (x fastReflection_QueryValidatorAccuraciesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorAccuraciesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorAccuraciesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorAccuraciesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorAccuraciesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorAccuraciesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryValidatorAccuraciesRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidatorAccuraciesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorAccuraciesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorAccuraciesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorAccuraciesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorAccuraciesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorAccuraciesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorAccuraciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryValidatorAccuraciesResponse_1_list)(nil)
+
+type _QueryValidatorAccuraciesResponse_1_list struct {
+	list *[]*ValidatorAccuracy
+}
+
+func (x *_QueryValidatorAccuraciesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryValidatorAccuraciesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryValidatorAccuraciesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorAccuracy)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryValidatorAccuraciesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorAccuracy)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryValidatorAccuraciesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorAccuracy)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorAccuraciesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryValidatorAccuraciesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ValidatorAccuracy)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorAccuraciesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryValidatorAccuraciesResponse                      protoreflect.MessageDescriptor
+	fd_QueryValidatorAccuraciesResponse_validator_accuracies protoreflect.FieldDescriptor
+	fd_QueryValidatorAccuraciesResponse_pagination           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryValidatorAccuraciesResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidatorAccuraciesResponse")
+	fd_QueryValidatorAccuraciesResponse_validator_accuracies = md_QueryValidatorAccuraciesResponse.Fields().ByName("validator_accuracies")
+	fd_QueryValidatorAccuraciesResponse_pagination = md_QueryValidatorAccuraciesResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorAccuraciesResponse)(nil)
+
+type fastReflection_QueryValidatorAccuraciesResponse QueryValidatorAccuraciesResponse
+
+func (x *QueryValidatorAccuraciesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorAccuraciesResponse)(x)
+}
+
+func (x *QueryValidatorAccuraciesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorAccuraciesResponse_messageType fastReflection_QueryValidatorAccuraciesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorAccuraciesResponse_messageType{}
+
+type fastReflection_QueryValidatorAccuraciesResponse_messageType struct{}
+
+func (x fastReflection_QueryValidatorAccuraciesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorAccuraciesResponse)(nil)
+}
+func (x fastReflection_QueryValidatorAccuraciesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorAccuraciesResponse)
+}
+func (x fastReflection_QueryValidatorAccuraciesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorAccuraciesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorAccuraciesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorAccuraciesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorAccuraciesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorAccuraciesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValidatorAccuracies) != 0 {
+		value := protoreflect.ValueOfList(&_QueryValidatorAccuraciesResponse_1_list{list: &x.ValidatorAccuracies})
+		if !f(fd_QueryValidatorAccuraciesResponse_validator_accuracies, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryValidatorAccuraciesResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.validator_accuracies":
+		return len(x.ValidatorAccuracies) != 0
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.validator_accuracies":
+		x.ValidatorAccuracies = nil
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.validator_accuracies":
+		if len(x.ValidatorAccuracies) == 0 {
+			return protoreflect.ValueOfList(&_QueryValidatorAccuraciesResponse_1_list{})
+		}
+		listValue := &_QueryValidatorAccuraciesResponse_1_list{list: &x.ValidatorAccuracies}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.validator_accuracies":
+		lv := value.List()
+		clv := lv.(*_QueryValidatorAccuraciesResponse_1_list)
+		x.ValidatorAccuracies = *clv.list
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.validator_accuracies":
+		if x.ValidatorAccuracies == nil {
+			x.ValidatorAccuracies = []*ValidatorAccuracy{}
+		}
+		value := &_QueryValidatorAccuraciesResponse_1_list{list: &x.ValidatorAccuracies}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.validator_accuracies":
+		list := []*ValidatorAccuracy{}
+		return protoreflect.ValueOfList(&_QueryValidatorAccuraciesResponse_1_list{list: &list})
+	case "band.feeds.v1beta1.QueryValidatorAccuraciesResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorAccuraciesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorAccuraciesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidatorAccuraciesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorAccuraciesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorAccuraciesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.ValidatorAccuracies) > 0 {
+			for _, e := range x.ValidatorAccuracies {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorAccuraciesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValidatorAccuracies) > 0 {
+			for iNdEx := len(x.ValidatorAccuracies) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorAccuracies[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorAccuraciesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorAccuraciesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorAccuraciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAccuracies", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAccuracies = append(x.ValidatorAccuracies, &ValidatorAccuracy{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorAccuracies[len(x.ValidatorAccuracies)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryValidatorPricesRequest_2_list)(nil)
+
+type _QueryValidatorPricesRequest_2_list struct {
+	list *[]string
+}
+
+func (x *_QueryValidatorPricesRequest_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryValidatorPricesRequest_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryValidatorPricesRequest_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryValidatorPricesRequest_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryValidatorPricesRequest_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryValidatorPricesRequest at list field SignalIds as it is not of Message kind"))
+}
+
+func (x *_QueryValidatorPricesRequest_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryValidatorPricesRequest_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryValidatorPricesRequest_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryValidatorPricesRequest            protoreflect.MessageDescriptor
+	fd_QueryValidatorPricesRequest_validator  protoreflect.FieldDescriptor
+	fd_QueryValidatorPricesRequest_signal_ids protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryValidatorPricesRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidatorPricesRequest")
+	fd_QueryValidatorPricesRequest_validator = md_QueryValidatorPricesRequest.Fields().ByName("validator")
+	fd_QueryValidatorPricesRequest_signal_ids = md_QueryValidatorPricesRequest.Fields().ByName("signal_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorPricesRequest)(nil)
+
+type fastReflection_QueryValidatorPricesRequest QueryValidatorPricesRequest
+
+func (x *QueryValidatorPricesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPricesRequest)(x)
+}
+
+func (x *QueryValidatorPricesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorPricesRequest_messageType fastReflection_QueryValidatorPricesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorPricesRequest_messageType{}
+
+type fastReflection_QueryValidatorPricesRequest_messageType struct{}
+
+func (x fastReflection_QueryValidatorPricesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPricesRequest)(nil)
+}
+func (x fastReflection_QueryValidatorPricesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPricesRequest)
+}
+func (x fastReflection_QueryValidatorPricesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPricesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorPricesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPricesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorPricesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorPricesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorPricesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPricesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorPricesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorPricesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorPricesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_QueryValidatorPricesRequest_validator, value) {
+			return
+		}
+	}
+	if len(x.SignalIds) != 0 {
+		value := protoreflect.ValueOfList(&_QueryValidatorPricesRequest_2_list{list: &x.SignalIds})
+		if !f(fd_QueryValidatorPricesRequest_signal_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorPricesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
+		return x.Validator != ""
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
+		return len(x.SignalIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPricesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
+		x.Validator = ""
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
+		x.SignalIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorPricesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
+		if len(x.SignalIds) == 0 {
+			return protoreflect.ValueOfList(&_QueryValidatorPricesRequest_2_list{})
+		}
+		listValue := &_QueryValidatorPricesRequest_2_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPricesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
+		x.Validator = value.Interface().(string)
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
+		lv := value.List()
+		clv := lv.(*_QueryValidatorPricesRequest_2_list)
+		x.SignalIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPricesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
+		if x.SignalIds == nil {
+			x.SignalIds = []string{}
+		}
+		value := &_QueryValidatorPricesRequest_2_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
+		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.QueryValidatorPricesRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorPricesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.validator":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.QueryValidatorPricesRequest.signal_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryValidatorPricesRequest_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorPricesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidatorPricesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorPricesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPricesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorPricesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorPricesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorPricesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.SignalIds) > 0 {
+			for _, s := range x.SignalIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPricesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SignalIds) > 0 {
+			for iNdEx := len(x.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SignalIds[iNdEx])
+				copy(dAtA[i:], x.SignalIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalIds[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPricesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPricesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalIds = append(x.SignalIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryValidatorPricesResponse_1_list)(nil)
+
+type _QueryValidatorPricesResponse_1_list struct {
+	list *[]*ValidatorPrice
+}
+
+func (x *_QueryValidatorPricesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryValidatorPricesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryValidatorPricesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryValidatorPricesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPrice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryValidatorPricesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorPrice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorPricesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryValidatorPricesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ValidatorPrice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorPricesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryValidatorPricesResponse                  protoreflect.MessageDescriptor
+	fd_QueryValidatorPricesResponse_validator_prices protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryValidatorPricesResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryValidatorPricesResponse")
+	fd_QueryValidatorPricesResponse_validator_prices = md_QueryValidatorPricesResponse.Fields().ByName("validator_prices")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorPricesResponse)(nil)
+
+type fastReflection_QueryValidatorPricesResponse QueryValidatorPricesResponse
+
+func (x *QueryValidatorPricesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPricesResponse)(x)
+}
+
+func (x *QueryValidatorPricesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorPricesResponse_messageType fastReflection_QueryValidatorPricesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorPricesResponse_messageType{}
+
+type fastReflection_QueryValidatorPricesResponse_messageType struct{}
+
+func (x fastReflection_QueryValidatorPricesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorPricesResponse)(nil)
+}
+func (x fastReflection_QueryValidatorPricesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPricesResponse)
+}
+func (x fastReflection_QueryValidatorPricesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPricesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorPricesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorPricesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorPricesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorPricesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorPricesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorPricesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorPricesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorPricesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorPricesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValidatorPrices) != 0 {
+		value := protoreflect.ValueOfList(&_QueryValidatorPricesResponse_1_list{list: &x.ValidatorPrices})
+		if !f(fd_QueryValidatorPricesResponse_validator_prices, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorPricesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
+		return len(x.ValidatorPrices) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPricesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
+		x.ValidatorPrices = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorPricesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
+		if len(x.ValidatorPrices) == 0 {
+			return protoreflect.ValueOfList(&_QueryValidatorPricesResponse_1_list{})
+		}
+		listValue := &_QueryValidatorPricesResponse_1_list{list: &x.ValidatorPrices}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPricesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
+		lv := value.List()
+		clv := lv.(*_QueryValidatorPricesResponse_1_list)
+		x.ValidatorPrices = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPricesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
+		if x.ValidatorPrices == nil {
+			x.ValidatorPrices = []*ValidatorPrice{}
+		}
+		value := &_QueryValidatorPricesResponse_1_list{list: &x.ValidatorPrices}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorPricesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices":
+		list := []*ValidatorPrice{}
+		return protoreflect.ValueOfList(&_QueryValidatorPricesResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryValidatorPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryValidatorPricesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorPricesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryValidatorPricesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorPricesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorPricesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorPricesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorPricesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorPricesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.ValidatorPrices) > 0 {
+			for _, e := range x.ValidatorPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPricesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorPrices) > 0 {
+			for iNdEx := len(x.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorPricesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPricesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorPrices = append(x.ValidatorPrices, &ValidatorPrice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorPrices[len(x.ValidatorPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryVoteRequest       protoreflect.MessageDescriptor
+	fd_QueryVoteRequest_voter protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryVoteRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryVoteRequest")
+	fd_QueryVoteRequest_voter = md_QueryVoteRequest.Fields().ByName("voter")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVoteRequest)(nil)
+
+type fastReflection_QueryVoteRequest QueryVoteRequest
+
+func (x *QueryVoteRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteRequest)(x)
+}
+
+func (x *QueryVoteRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVoteRequest_messageType fastReflection_QueryVoteRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteRequest_messageType{}
+
+type fastReflection_QueryVoteRequest_messageType struct{}
+
+func (x fastReflection_QueryVoteRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteRequest)(nil)
+}
+func (x fastReflection_QueryVoteRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteRequest)
+}
+func (x fastReflection_QueryVoteRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVoteRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVoteRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVoteRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVoteRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVoteRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_QueryVoteRequest_voter, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVoteRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryVoteRequest.voter":
+		return x.Voter != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryVoteRequest.voter":
+		x.Voter = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVoteRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryVoteRequest.voter":
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryVoteRequest.voter":
+		x.Voter = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryVoteRequest.voter":
+		panic(fmt.Errorf("field voter of message band.feeds.v1beta1.QueryVoteRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVoteRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryVoteRequest.voter":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVoteRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryVoteRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVoteRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVoteRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVoteRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Voter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVoteRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVoteRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Voter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryVoteResponse_1_list)(nil)
+
+type _QueryVoteResponse_1_list struct {
+	list *[]*Signal
+}
+
+func (x *_QueryVoteResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryVoteResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryVoteResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryVoteResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryVoteResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Signal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVoteResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryVoteResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Signal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVoteResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryVoteResponse         protoreflect.MessageDescriptor
+	fd_QueryVoteResponse_signals protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryVoteResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryVoteResponse")
+	fd_QueryVoteResponse_signals = md_QueryVoteResponse.Fields().ByName("signals")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVoteResponse)(nil)
+
+type fastReflection_QueryVoteResponse QueryVoteResponse
+
+func (x *QueryVoteResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteResponse)(x)
+}
+
+func (x *QueryVoteResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVoteResponse_messageType fastReflection_QueryVoteResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteResponse_messageType{}
+
+type fastReflection_QueryVoteResponse_messageType struct{}
+
+func (x fastReflection_QueryVoteResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteResponse)(nil)
+}
+func (x fastReflection_QueryVoteResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteResponse)
+}
+func (x fastReflection_QueryVoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVoteResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVoteResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVoteResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVoteResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Signals) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVoteResponse_1_list{list: &x.Signals})
+		if !f(fd_QueryVoteResponse_signals, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryVoteResponse.signals":
+		return len(x.Signals) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryVoteResponse.signals":
+		x.Signals = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryVoteResponse.signals":
+		if len(x.Signals) == 0 {
+			return protoreflect.ValueOfList(&_QueryVoteResponse_1_list{})
+		}
+		listValue := &_QueryVoteResponse_1_list{list: &x.Signals}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryVoteResponse.signals":
+		lv := value.List()
+		clv := lv.(*_QueryVoteResponse_1_list)
+		x.Signals = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryVoteResponse.signals":
+		if x.Signals == nil {
+			x.Signals = []*Signal{}
+		}
+		value := &_QueryVoteResponse_1_list{list: &x.Signals}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryVoteResponse.signals":
+		list := []*Signal{}
+		return protoreflect.ValueOfList(&_QueryVoteResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryVoteResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryVoteResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVoteResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVoteResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVoteResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Signals) > 0 {
+			for _, e := range x.Signals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVoteResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signals) > 0 {
+			for iNdEx := len(x.Signals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVoteResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signals = append(x.Signals, &Signal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signals[len(x.Signals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDelegationRequest           protoreflect.MessageDescriptor
+	fd_QueryDelegationRequest_delegator protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryDelegationRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryDelegationRequest")
+	fd_QueryDelegationRequest_delegator = md_QueryDelegationRequest.Fields().ByName("delegator")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegationRequest)(nil)
+
+type fastReflection_QueryDelegationRequest QueryDelegationRequest
+
+func (x *QueryDelegationRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegationRequest)(x)
+}
+
+func (x *QueryDelegationRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegationRequest_messageType fastReflection_QueryDelegationRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegationRequest_messageType{}
+
+type fastReflection_QueryDelegationRequest_messageType struct{}
+
+func (x fastReflection_QueryDelegationRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegationRequest)(nil)
+}
+func (x fastReflection_QueryDelegationRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationRequest)
+}
+func (x fastReflection_QueryDelegationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegationRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegationRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegationRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegationRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegationRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegationRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_QueryDelegationRequest_delegator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegationRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationRequest.delegator":
+		return x.Delegator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationRequest.delegator":
+		x.Delegator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationRequest.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationRequest.delegator":
+		x.Delegator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationRequest.delegator":
+		panic(fmt.Errorf("field delegator of message band.feeds.v1beta1.QueryDelegationRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationRequest.delegator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryDelegationRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegationRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegationRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegationRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegationRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDelegationResponse            protoreflect.MessageDescriptor
+	fd_QueryDelegationResponse_delegation protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryDelegationResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryDelegationResponse")
+	fd_QueryDelegationResponse_delegation = md_QueryDelegationResponse.Fields().ByName("delegation")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegationResponse)(nil)
+
+type fastReflection_QueryDelegationResponse QueryDelegationResponse
+
+func (x *QueryDelegationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegationResponse)(x)
+}
+
+func (x *QueryDelegationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegationResponse_messageType fastReflection_QueryDelegationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegationResponse_messageType{}
+
+type fastReflection_QueryDelegationResponse_messageType struct{}
+
+func (x fastReflection_QueryDelegationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegationResponse)(nil)
+}
+func (x fastReflection_QueryDelegationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationResponse)
+}
+func (x fastReflection_QueryDelegationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegationResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegationResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegation != nil {
+		value := protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+		if !f(fd_QueryDelegationResponse_delegation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationResponse.delegation":
+		return x.Delegation != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationResponse.delegation":
+		x.Delegation = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationResponse.delegation":
+		value := x.Delegation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationResponse.delegation":
+		x.Delegation = value.Message().Interface().(*Delegation)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationResponse.delegation":
+		if x.Delegation == nil {
+			x.Delegation = new(Delegation)
+		}
+		return protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationResponse.delegation":
+		m := new(Delegation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryDelegationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Delegation != nil {
+			l = options.Size(x.Delegation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Delegation != nil {
+			encoded, err := options.Marshal(x.Delegation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Delegation == nil {
+					x.Delegation = &Delegation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDelegationsRequest            protoreflect.MessageDescriptor
+	fd_QueryDelegationsRequest_delegatee  protoreflect.FieldDescriptor
+	fd_QueryDelegationsRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryDelegationsRequest = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryDelegationsRequest")
+	fd_QueryDelegationsRequest_delegatee = md_QueryDelegationsRequest.Fields().ByName("delegatee")
+	fd_QueryDelegationsRequest_pagination = md_QueryDelegationsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegationsRequest)(nil)
+
+type fastReflection_QueryDelegationsRequest QueryDelegationsRequest
+
+func (x *QueryDelegationsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegationsRequest)(x)
+}
+
+func (x *QueryDelegationsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegationsRequest_messageType fastReflection_QueryDelegationsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegationsRequest_messageType{}
+
+type fastReflection_QueryDelegationsRequest_messageType struct{}
+
+func (x fastReflection_QueryDelegationsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegationsRequest)(nil)
+}
+func (x fastReflection_QueryDelegationsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationsRequest)
+}
+func (x fastReflection_QueryDelegationsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegationsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegationsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegationsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegationsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegationsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegationsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegationsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegatee != "" {
+		value := protoreflect.ValueOfString(x.Delegatee)
+		if !f(fd_QueryDelegationsRequest_delegatee, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDelegationsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegationsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsRequest.delegatee":
+		return x.Delegatee != ""
+	case "band.feeds.v1beta1.QueryDelegationsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsRequest.delegatee":
+		x.Delegatee = ""
+	case "band.feeds.v1beta1.QueryDelegationsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegationsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsRequest.delegatee":
+		value := x.Delegatee
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.QueryDelegationsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsRequest.delegatee":
+		x.Delegatee = value.Interface().(string)
+	case "band.feeds.v1beta1.QueryDelegationsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.feeds.v1beta1.QueryDelegationsRequest.delegatee":
+		panic(fmt.Errorf("field delegatee of message band.feeds.v1beta1.QueryDelegationsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegationsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsRequest.delegatee":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.QueryDelegationsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsRequest"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegationsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryDelegationsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegationsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegationsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegationsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegationsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Delegatee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Delegatee) > 0 {
+			i -= len(x.Delegatee)
+			copy(dAtA[i:], x.Delegatee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegatee)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegatee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryDelegationsResponse_1_list)(nil)
+
+type _QueryDelegationsResponse_1_list struct {
+	list *[]*Delegation
+}
+
+func (x *_QueryDelegationsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDelegationsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryDelegationsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Delegation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDelegationsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Delegation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDelegationsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Delegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDelegationsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDelegationsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Delegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDelegationsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryDelegationsResponse             protoreflect.MessageDescriptor
+	fd_QueryDelegationsResponse_delegations protoreflect.FieldDescriptor
+	fd_QueryDelegationsResponse_total_power protoreflect.FieldDescriptor
+	fd_QueryDelegationsResponse_pagination  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_query_proto_init()
+	md_QueryDelegationsResponse = File_band_feeds_v1beta1_query_proto.Messages().ByName("QueryDelegationsResponse")
+	fd_QueryDelegationsResponse_delegations = md_QueryDelegationsResponse.Fields().ByName("delegations")
+	fd_QueryDelegationsResponse_total_power = md_QueryDelegationsResponse.Fields().ByName("total_power")
+	fd_QueryDelegationsResponse_pagination = md_QueryDelegationsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegationsResponse)(nil)
+
+type fastReflection_QueryDelegationsResponse QueryDelegationsResponse
+
+func (x *QueryDelegationsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegationsResponse)(x)
+}
+
+func (x *QueryDelegationsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_query_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegationsResponse_messageType fastReflection_QueryDelegationsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegationsResponse_messageType{}
+
+type fastReflection_QueryDelegationsResponse_messageType struct{}
+
+func (x fastReflection_QueryDelegationsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegationsResponse)(nil)
+}
+func (x fastReflection_QueryDelegationsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationsResponse)
+}
+func (x fastReflection_QueryDelegationsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegationsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegationsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegationsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegationsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegationsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegationsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegationsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegationsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegationsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Delegations) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDelegationsResponse_1_list{list: &x.Delegations})
+		if !f(fd_QueryDelegationsResponse_delegations, value) {
+			return
+		}
+	}
+	if x.TotalPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalPower)
+		if !f(fd_QueryDelegationsResponse_total_power, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDelegationsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegationsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsResponse.delegations":
+		return len(x.Delegations) != 0
+	case "band.feeds.v1beta1.QueryDelegationsResponse.total_power":
+		return x.TotalPower != int64(0)
+	case "band.feeds.v1beta1.QueryDelegationsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsResponse.delegations":
+		x.Delegations = nil
+	case "band.feeds.v1beta1.QueryDelegationsResponse.total_power":
+		x.TotalPower = int64(0)
+	case "band.feeds.v1beta1.QueryDelegationsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegationsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsResponse.delegations":
+		if len(x.Delegations) == 0 {
+			return protoreflect.ValueOfList(&_QueryDelegationsResponse_1_list{})
+		}
+		listValue := &_QueryDelegationsResponse_1_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(listValue)
+	case "band.feeds.v1beta1.QueryDelegationsResponse.total_power":
+		value := x.TotalPower
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.QueryDelegationsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsResponse.delegations":
+		lv := value.List()
+		clv := lv.(*_QueryDelegationsResponse_1_list)
+		x.Delegations = *clv.list
+	case "band.feeds.v1beta1.QueryDelegationsResponse.total_power":
+		x.TotalPower = value.Int()
+	case "band.feeds.v1beta1.QueryDelegationsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsResponse.delegations":
+		if x.Delegations == nil {
+			x.Delegations = []*Delegation{}
+		}
+		value := &_QueryDelegationsResponse_1_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.QueryDelegationsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.feeds.v1beta1.QueryDelegationsResponse.total_power":
+		panic(fmt.Errorf("field total_power of message band.feeds.v1beta1.QueryDelegationsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegationsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.QueryDelegationsResponse.delegations":
+		list := []*Delegation{}
+		return protoreflect.ValueOfList(&_QueryDelegationsResponse_1_list{list: &list})
+	case "band.feeds.v1beta1.QueryDelegationsResponse.total_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.QueryDelegationsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.QueryDelegationsResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.QueryDelegationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegationsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.QueryDelegationsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegationsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegationsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegationsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegationsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegationsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Delegations) > 0 {
+			for _, e := range x.Delegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TotalPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalPower))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.TotalPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalPower))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Delegations) > 0 {
+			for iNdEx := len(x.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Delegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegationsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegations = append(x.Delegations, &Delegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegations[len(x.Delegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+				}
+				x.TotalPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/feeds/v1beta1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryCurrentFeedsRequest is the request type for the Query/CurrentFeeds RPC method.
+type QueryCurrentFeedsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryCurrentFeedsRequest) Reset() {
+	*x = QueryCurrentFeedsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCurrentFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCurrentFeedsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCurrentFeedsRequest.ProtoReflect.Descriptor instead.
+func (*QueryCurrentFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryCurrentFeedsResponse is the response type for the Query/CurrentFeeds RPC method.
+type QueryCurrentFeedsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// current_feeds is a list of currently supported feed-with-deviations, and its last update time and block.
+	CurrentFeeds *CurrentFeedWithDeviations `protobuf:"bytes,1,opt,name=current_feeds,json=currentFeeds,proto3" json:"current_feeds,omitempty"`
+}
+
+func (x *QueryCurrentFeedsResponse) Reset() {
+	*x = QueryCurrentFeedsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCurrentFeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCurrentFeedsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCurrentFeedsResponse.ProtoReflect.Descriptor instead.
+func (*QueryCurrentFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryCurrentFeedsResponse) GetCurrentFeeds() *CurrentFeedWithDeviations {
+	if x != nil {
+		return x.CurrentFeeds
+	}
+	return nil
+}
+
+// QueryIsFeederRequest is the request type for the Query/IsFeeder RPC method.
+type QueryIsFeederRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator is a validator address.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// feeder is a candidate account.
+	Feeder string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty"`
+}
+
+func (x *QueryIsFeederRequest) Reset() {
+	*x = QueryIsFeederRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryIsFeederRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryIsFeederRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryIsFeederRequest.ProtoReflect.Descriptor instead.
+func (*QueryIsFeederRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryIsFeederRequest) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *QueryIsFeederRequest) GetFeeder() string {
+	if x != nil {
+		return x.Feeder
+	}
+	return ""
+}
+
+// QueryIsFeederResponse is the response type for the Query/IsFeeder RPC method.
+type QueryIsFeederResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// is_feeder is true if this account has been granted by validator.
+	IsFeeder bool `protobuf:"varint,1,opt,name=is_feeder,json=isFeeder,proto3" json:"is_feeder,omitempty"`
+}
+
+func (x *QueryIsFeederResponse) Reset() {
+	*x = QueryIsFeederResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryIsFeederResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryIsFeederResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryIsFeederResponse.ProtoReflect.Descriptor instead.
+func (*QueryIsFeederResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryIsFeederResponse) GetIsFeeder() bool {
+	if x != nil {
+		return x.IsFeeder
+	}
+	return false
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{4}
+}
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params is the parameters of the module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryPriceRequest is the request type for the Query/Price RPC method.
+type QueryPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the signal id to query the price for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+}
+
+func (x *QueryPriceRequest) Reset() {
+	*x = QueryPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPriceRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPriceRequest.ProtoReflect.Descriptor instead.
+func (*QueryPriceRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryPriceRequest) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+// QueryPriceResponse is the response type for the Query/Price RPC method.
+type QueryPriceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// price is the aggregated price of the signal id.
+	Price *Price `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *QueryPriceResponse) Reset() {
+	*x = QueryPriceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPriceResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPriceResponse.ProtoReflect.Descriptor instead.
+func (*QueryPriceResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryPriceResponse) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// QueryPricesRequest is the request type for the Query/Prices RPC method.
+type QueryPricesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_ids is a list of signal ids to query prices for.
+	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+}
+
+func (x *QueryPricesRequest) Reset() {
+	*x = QueryPricesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPricesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPricesRequest.ProtoReflect.Descriptor instead.
+func (*QueryPricesRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryPricesRequest) GetSignalIds() []string {
+	if x != nil {
+		return x.SignalIds
+	}
+	return nil
+}
+
+// QueryPricesResponse is the response type for the Query/Prices RPC method.
+type QueryPricesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// prices is a list of prices.
+	Prices []*Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+}
+
+func (x *QueryPricesResponse) Reset() {
+	*x = QueryPricesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPricesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPricesResponse.ProtoReflect.Descriptor instead.
+func (*QueryPricesResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryPricesResponse) GetPrices() []*Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// QueryAllPricesRequest is the request type for the Query/AllPrices RPC method.
+type QueryAllPricesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination is the pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllPricesRequest) Reset() {
+	*x = QueryAllPricesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllPricesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllPricesRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllPricesRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryAllPricesRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryAllPricesResponse is the response type for the Query/AllPrices RPC method.
+type QueryAllPricesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// prices is a list of prices.
+	Prices []*Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	// pagination is the pagination information in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllPricesResponse) Reset() {
+	*x = QueryAllPricesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllPricesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllPricesResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllPricesResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryAllPricesResponse) GetPrices() []*Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *QueryAllPricesResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryPriceAtRequest is the request type for the Query/PriceAt RPC method.
+type QueryPriceAtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the signal id to query the price for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// timestamp is the unix time (in seconds) to query the price at.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *QueryPriceAtRequest) Reset() {
+	*x = QueryPriceAtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPriceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPriceAtRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPriceAtRequest.ProtoReflect.Descriptor instead.
+func (*QueryPriceAtRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryPriceAtRequest) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
 }
 
-// QueryCurrentFeedsResponse is the response type for the Query/CurrentFeeds RPC method.
-type QueryCurrentFeedsResponse struct {
+func (x *QueryPriceAtRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// QueryPriceAtResponse is the response type for the Query/PriceAt RPC method.
+type QueryPriceAtResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// current_feeds is a list of currently supported feed-with-deviations, and its last update time and block.
-	CurrentFeeds *CurrentFeedWithDeviations `protobuf:"bytes,1,opt,name=current_feeds,json=currentFeeds,proto3" json:"current_feeds,omitempty"`
+	// price is the latest historical price of the signal id at or before the timestamp.
+	Price *Price `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (x *QueryCurrentFeedsResponse) Reset() {
-	*x = QueryCurrentFeedsResponse{}
+func (x *QueryPriceAtResponse) Reset() {
+	*x = QueryPriceAtResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[1]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCurrentFeedsResponse) String() string {
+func (x *QueryPriceAtResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCurrentFeedsResponse) ProtoMessage() {}
+func (*QueryPriceAtResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryCurrentFeedsResponse.ProtoReflect.Descriptor instead.
-func (*QueryCurrentFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryPriceAtResponse.ProtoReflect.Descriptor instead.
+func (*QueryPriceAtResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryCurrentFeedsResponse) GetCurrentFeeds() *CurrentFeedWithDeviations {
+func (x *QueryPriceAtResponse) GetPrice() *Price {
 	if x != nil {
-		return x.CurrentFeeds
+		return x.Price
 	}
 	return nil
 }
 
-// QueryIsFeederRequest is the request type for the Query/IsFeeder RPC method.
-type QueryIsFeederRequest struct {
+// QueryPriceHistoryRequest is the request type for the Query/PriceHistory RPC method.
+type QueryPriceHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// validator is a validator address.
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	// feeder is a candidate account.
-	Feeder string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty"`
+	// signal_id is the signal id to query the historical prices for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// pagination is the pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryIsFeederRequest) Reset() {
-	*x = QueryIsFeederRequest{}
+func (x *QueryPriceHistoryRequest) Reset() {
+	*x = QueryPriceHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[2]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryIsFeederRequest) String() string {
+func (x *QueryPriceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryIsFeederRequest) ProtoMessage() {}
+func (*QueryPriceHistoryRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryIsFeederRequest.ProtoReflect.Descriptor instead.
-func (*QueryIsFeederRequest) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryIsFeederRequest) GetValidator() string {
+func (x *QueryPriceHistoryRequest) GetSignalId() string {
 	if x != nil {
-		return x.Validator
+		return x.SignalId
 	}
 	return ""
 }
 
-func (x *QueryIsFeederRequest) GetFeeder() string {
+func (x *QueryPriceHistoryRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
-		return x.Feeder
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryIsFeederResponse is the response type for the Query/IsFeeder RPC method.
-type QueryIsFeederResponse struct {
+// QueryPriceHistoryResponse is the response type for the Query/PriceHistory RPC method.
+type QueryPriceHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// is_feeder is true if this account has been granted by validator.
-	IsFeeder bool `protobuf:"varint,1,opt,name=is_feeder,json=isFeeder,proto3" json:"is_feeder,omitempty"`
+	// prices is a list of historical prices ordered by timestamp.
+	Prices []*Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	// pagination is the pagination information in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryIsFeederResponse) Reset() {
-	*x = QueryIsFeederResponse{}
+func (x *QueryPriceHistoryResponse) Reset() {
+	*x = QueryPriceHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[3]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryIsFeederResponse) String() string {
+func (x *QueryPriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryIsFeederResponse) ProtoMessage() {}
+func (*QueryPriceHistoryResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryIsFeederResponse.ProtoReflect.Descriptor instead.
-func (*QueryIsFeederResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryIsFeederResponse) GetIsFeeder() bool {
+func (x *QueryPriceHistoryResponse) GetPrices() []*Price {
 	if x != nil {
-		return x.IsFeeder
+		return x.Prices
 	}
-	return false
+	return nil
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (x *QueryPriceHistoryResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryTWAPRequest is the request type for the Query/TWAP RPC method.
+type QueryTWAPRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the signal id to query the time-weighted average price for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// window is the duration (in seconds) up to the current block time to average the price over.
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryTWAPRequest) Reset() {
+	*x = QueryTWAPRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[4]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryTWAPRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (*QueryTWAPRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryTWAPRequest.ProtoReflect.Descriptor instead.
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{16}
 }
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+func (x *QueryTWAPRequest) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *QueryTWAPRequest) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+// QueryTWAPResponse is the response type for the Query/TWAP RPC method.
+type QueryTWAPResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// params is the parameters of the module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// price is the time-weighted average price of the signal id. Its timestamp is the timestamp of the
+	// latest price in the window.
+	Price *Price `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryTWAPResponse) Reset() {
+	*x = QueryTWAPResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[5]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryTWAPResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryTWAPResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryTWAPResponse.ProtoReflect.Descriptor instead.
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryTWAPResponse) GetPrice() *Price {
 	if x != nil {
-		return x.Params
+		return x.Price
 	}
 	return nil
 }
 
-// QueryPriceRequest is the request type for the Query/Price RPC method.
-type QueryPriceRequest struct {
+// QueryReferenceSourceConfigRequest is the request type for the Query/ReferenceSourceConfig RPC method.
+type QueryReferenceSourceConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// signal_id is the signal id to query the price for.
-	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
 }
 
-func (x *QueryPriceRequest) Reset() {
-	*x = QueryPriceRequest{}
+func (x *QueryReferenceSourceConfigRequest) Reset() {
+	*x = QueryReferenceSourceConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[6]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPriceRequest) String() string {
+func (x *QueryReferenceSourceConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPriceRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryPriceRequest.ProtoReflect.Descriptor instead.
-func (*QueryPriceRequest) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{6}
-}
+func (*QueryReferenceSourceConfigRequest) ProtoMessage() {}
 
-func (x *QueryPriceRequest) GetSignalId() string {
-	if x != nil {
-		return x.SignalId
-	}
-	return ""
+// Deprecated: Use QueryReferenceSourceConfigRequest.ProtoReflect.Descriptor instead.
+func (*QueryReferenceSourceConfigRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{18}
 }
 
-// QueryPriceResponse is the response type for the Query/Price RPC method.
-type QueryPriceResponse struct {
+// QueryReferenceSourceConfigResponse is the response type for the Query/ReferenceSourceConfig RPC method.
+type QueryReferenceSourceConfigResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// price is the aggregated price of the signal id.
-	Price *Price `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	// reference_source_config is the information about the reference price source.
+	ReferenceSourceConfig *ReferenceSourceConfig `protobuf:"bytes,1,opt,name=reference_source_config,json=referenceSourceConfig,proto3" json:"reference_source_config,omitempty"`
 }
 
-func (x *QueryPriceResponse) Reset() {
-	*x = QueryPriceResponse{}
+func (x *QueryReferenceSourceConfigResponse) Reset() {
+	*x = QueryReferenceSourceConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[7]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPriceResponse) String() string {
+func (x *QueryReferenceSourceConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPriceResponse) ProtoMessage() {}
+func (*QueryReferenceSourceConfigResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPriceResponse.ProtoReflect.Descriptor instead.
-func (*QueryPriceResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryReferenceSourceConfigResponse.ProtoReflect.Descriptor instead.
+func (*QueryReferenceSourceConfigResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryPriceResponse) GetPrice() *Price {
+func (x *QueryReferenceSourceConfigResponse) GetReferenceSourceConfig() *ReferenceSourceConfig {
 	if x != nil {
-		return x.Price
+		return x.ReferenceSourceConfig
 	}
 	return nil
 }
 
-// QueryPricesRequest is the request type for the Query/Prices RPC method.
-type QueryPricesRequest struct {
+// QuerySignalMetadataRequest is the request type for the Query/SignalMetadata RPC method.
+type QuerySignalMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// signal_ids is a list of signal ids to query prices for.
-	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+	// signal_id is the signal id to query the metadata for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
 }
 
-func (x *QueryPricesRequest) Reset() {
-	*x = QueryPricesRequest{}
+func (x *QuerySignalMetadataRequest) Reset() {
+	*x = QuerySignalMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[8]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPricesRequest) String() string {
+func (x *QuerySignalMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPricesRequest) ProtoMessage() {}
+func (*QuerySignalMetadataRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryPricesRequest.ProtoReflect.Descriptor instead.
-func (*QueryPricesRequest) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QuerySignalMetadataRequest.ProtoReflect.Descriptor instead.
+func (*QuerySignalMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryPricesRequest) GetSignalIds() []string {
+func (x *QuerySignalMetadataRequest) GetSignalId() string {
 	if x != nil {
-		return x.SignalIds
+		return x.SignalId
 	}
-	return nil
+	return ""
 }
 
-// QueryPricesResponse is the response type for the Query/Prices RPC method.
-type QueryPricesResponse struct {
+// QuerySignalMetadataResponse is the response type for the Query/SignalMetadata RPC method.
+type QuerySignalMetadataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// prices is a list of prices.
-	Prices []*Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	// signal_metadata is the metadata of the signal id.
+	SignalMetadata *SignalMetadata `protobuf:"bytes,1,opt,name=signal_metadata,json=signalMetadata,proto3" json:"signal_metadata,omitempty"`
 }
 
-func (x *QueryPricesResponse) Reset() {
-	*x = QueryPricesResponse{}
+func (x *QuerySignalMetadataResponse) Reset() {
+	*x = QuerySignalMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[9]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPricesResponse) String() string {
+func (x *QuerySignalMetadataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPricesResponse) ProtoMessage() {}
+func (*QuerySignalMetadataResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPricesResponse.ProtoReflect.Descriptor instead.
-func (*QueryPricesResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QuerySignalMetadataResponse.ProtoReflect.Descriptor instead.
+func (*QuerySignalMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryPricesResponse) GetPrices() []*Price {
+func (x *QuerySignalMetadataResponse) GetSignalMetadata() *SignalMetadata {
 	if x != nil {
-		return x.Prices
+		return x.SignalMetadata
 	}
 	return nil
 }
 
-// QueryAllPricesRequest is the request type for the Query/AllPrices RPC method.
-type QueryAllPricesRequest struct {
+// QueryAllSignalMetadataRequest is the request type for the Query/AllSignalMetadata RPC method.
+type QueryAllSignalMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -10416,139 +20483,158 @@ type QueryAllPricesRequest struct {
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllPricesRequest) Reset() {
-	*x = QueryAllPricesRequest{}
+func (x *QueryAllSignalMetadataRequest) Reset() {
+	*x = QueryAllSignalMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[10]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllPricesRequest) String() string {
+func (x *QueryAllSignalMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllPricesRequest) ProtoMessage() {}
+func (*QueryAllSignalMetadataRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAllPricesRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllPricesRequest) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryAllSignalMetadataRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllSignalMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryAllPricesRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryAllSignalMetadataRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryAllPricesResponse is the response type for the Query/AllPrices RPC method.
-type QueryAllPricesResponse struct {
+// QueryAllSignalMetadataResponse is the response type for the Query/AllSignalMetadata RPC method.
+type QueryAllSignalMetadataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// prices is a list of prices.
-	Prices []*Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	// signal_metadata is a list of metadata of the signal ids.
+	SignalMetadata []*SignalMetadata `protobuf:"bytes,1,rep,name=signal_metadata,json=signalMetadata,proto3" json:"signal_metadata,omitempty"`
 	// pagination is the pagination information in the response.
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllPricesResponse) Reset() {
-	*x = QueryAllPricesResponse{}
+func (x *QueryAllSignalMetadataResponse) Reset() {
+	*x = QueryAllSignalMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[11]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllPricesResponse) String() string {
+func (x *QueryAllSignalMetadataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllPricesResponse) ProtoMessage() {}
+func (*QueryAllSignalMetadataResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllPricesResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllPricesResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QueryAllSignalMetadataResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllSignalMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryAllPricesResponse) GetPrices() []*Price {
+func (x *QueryAllSignalMetadataResponse) GetSignalMetadata() []*SignalMetadata {
 	if x != nil {
-		return x.Prices
+		return x.SignalMetadata
 	}
 	return nil
 }
 
-func (x *QueryAllPricesResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryAllSignalMetadataResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryReferenceSourceConfigRequest is the request type for the Query/ReferenceSourceConfig RPC method.
-type QueryReferenceSourceConfigRequest struct {
+// QueryCircuitBreakersRequest is the request type for the Query/CircuitBreakers RPC method.
+type QueryCircuitBreakersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// pagination is the pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryReferenceSourceConfigRequest) Reset() {
-	*x = QueryReferenceSourceConfigRequest{}
+func (x *QueryCircuitBreakersRequest) Reset() {
+	*x = QueryCircuitBreakersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[12]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryReferenceSourceConfigRequest) String() string {
+func (x *QueryCircuitBreakersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryReferenceSourceConfigRequest) ProtoMessage() {}
+func (*QueryCircuitBreakersRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryReferenceSourceConfigRequest.ProtoReflect.Descriptor instead.
-func (*QueryReferenceSourceConfigRequest) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QueryCircuitBreakersRequest.ProtoReflect.Descriptor instead.
+func (*QueryCircuitBreakersRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QueryCircuitBreakersRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
 }
 
-// QueryReferenceSourceConfigResponse is the response type for the Query/ReferenceSourceConfig RPC method.
-type QueryReferenceSourceConfigResponse struct {
+// QueryCircuitBreakersResponse is the response type for the Query/CircuitBreakers RPC method.
+type QueryCircuitBreakersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// reference_source_config is the information about the reference price source.
-	ReferenceSourceConfig *ReferenceSourceConfig `protobuf:"bytes,1,opt,name=reference_source_config,json=referenceSourceConfig,proto3" json:"reference_source_config,omitempty"`
+	// circuit_breakers is a list of triggered circuit breakers.
+	CircuitBreakers []*CircuitBreaker `protobuf:"bytes,1,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
+	// pagination is the pagination information in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryReferenceSourceConfigResponse) Reset() {
-	*x = QueryReferenceSourceConfigResponse{}
+func (x *QueryCircuitBreakersResponse) Reset() {
+	*x = QueryCircuitBreakersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[13]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryReferenceSourceConfigResponse) String() string {
+func (x *QueryCircuitBreakersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryReferenceSourceConfigResponse) ProtoMessage() {}
+func (*QueryCircuitBreakersResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryReferenceSourceConfigResponse.ProtoReflect.Descriptor instead.
-func (*QueryReferenceSourceConfigResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryCircuitBreakersResponse.ProtoReflect.Descriptor instead.
+func (*QueryCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryReferenceSourceConfigResponse) GetReferenceSourceConfig() *ReferenceSourceConfig {
+func (x *QueryCircuitBreakersResponse) GetCircuitBreakers() []*CircuitBreaker {
 	if x != nil {
-		return x.ReferenceSourceConfig
+		return x.CircuitBreakers
+	}
+	return nil
+}
+
+func (x *QueryCircuitBreakersResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
@@ -10568,7 +20654,7 @@ type QuerySignalTotalPowersRequest struct {
 func (x *QuerySignalTotalPowersRequest) Reset() {
 	*x = QuerySignalTotalPowersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[14]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10582,7 +20668,7 @@ func (*QuerySignalTotalPowersRequest) ProtoMessage() {}
 
 // Deprecated: Use QuerySignalTotalPowersRequest.ProtoReflect.Descriptor instead.
 func (*QuerySignalTotalPowersRequest) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{14}
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *QuerySignalTotalPowersRequest) GetSignalIds() []string {
@@ -10614,7 +20700,7 @@ type QuerySignalTotalPowersResponse struct {
 func (x *QuerySignalTotalPowersResponse) Reset() {
 	*x = QuerySignalTotalPowersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[15]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10628,7 +20714,7 @@ func (*QuerySignalTotalPowersResponse) ProtoMessage() {}
 
 // Deprecated: Use QuerySignalTotalPowersResponse.ProtoReflect.Descriptor instead.
 func (*QuerySignalTotalPowersResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{15}
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *QuerySignalTotalPowersResponse) GetSignalTotalPowers() []*Signal {
@@ -10658,7 +20744,7 @@ type QueryValidValidatorRequest struct {
 func (x *QueryValidValidatorRequest) Reset() {
 	*x = QueryValidValidatorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[16]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10672,7 +20758,7 @@ func (*QueryValidValidatorRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryValidValidatorRequest.ProtoReflect.Descriptor instead.
 func (*QueryValidValidatorRequest) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{16}
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryValidValidatorRequest) GetValidator() string {
@@ -10695,7 +20781,7 @@ type QueryValidValidatorResponse struct {
 func (x *QueryValidValidatorResponse) Reset() {
 	*x = QueryValidValidatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[17]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10709,7 +20795,7 @@ func (*QueryValidValidatorResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryValidValidatorResponse.ProtoReflect.Descriptor instead.
 func (*QueryValidValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{17}
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *QueryValidValidatorResponse) GetValid() bool {
@@ -10719,6 +20805,163 @@ func (x *QueryValidValidatorResponse) GetValid() bool {
 	return false
 }
 
+// QueryValidatorAccuracyRequest is the request type for the Query/ValidatorAccuracy RPC method.
+type QueryValidatorAccuracyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator is the validator address to query the accuracy for.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (x *QueryValidatorAccuracyRequest) Reset() {
+	*x = QueryValidatorAccuracyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorAccuracyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorAccuracyRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorAccuracyRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorAccuracyRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *QueryValidatorAccuracyRequest) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+// QueryValidatorAccuracyResponse is the response type for the Query/ValidatorAccuracy RPC method.
+type QueryValidatorAccuracyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator_accuracy is the accuracy of the validator.
+	ValidatorAccuracy *ValidatorAccuracy `protobuf:"bytes,1,opt,name=validator_accuracy,json=validatorAccuracy,proto3" json:"validator_accuracy,omitempty"`
+}
+
+func (x *QueryValidatorAccuracyResponse) Reset() {
+	*x = QueryValidatorAccuracyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorAccuracyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorAccuracyResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorAccuracyResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorAccuracyResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QueryValidatorAccuracyResponse) GetValidatorAccuracy() *ValidatorAccuracy {
+	if x != nil {
+		return x.ValidatorAccuracy
+	}
+	return nil
+}
+
+// QueryValidatorAccuraciesRequest is the request type for the Query/ValidatorAccuracies RPC method.
+type QueryValidatorAccuraciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination is used to paginate the result.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryValidatorAccuraciesRequest) Reset() {
+	*x = QueryValidatorAccuraciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorAccuraciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorAccuraciesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorAccuraciesRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorAccuraciesRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueryValidatorAccuraciesRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorAccuraciesResponse is the response type for the Query/ValidatorAccuracies RPC method.
+type QueryValidatorAccuraciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator_accuracies is a list of accuracies of the tracked validators.
+	ValidatorAccuracies []*ValidatorAccuracy `protobuf:"bytes,1,rep,name=validator_accuracies,json=validatorAccuracies,proto3" json:"validator_accuracies,omitempty"`
+	// pagination is used to paginate the result.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryValidatorAccuraciesResponse) Reset() {
+	*x = QueryValidatorAccuraciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorAccuraciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorAccuraciesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorAccuraciesResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorAccuraciesResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QueryValidatorAccuraciesResponse) GetValidatorAccuracies() []*ValidatorAccuracy {
+	if x != nil {
+		return x.ValidatorAccuracies
+	}
+	return nil
+}
+
+func (x *QueryValidatorAccuraciesResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 // QueryValidatorPricesRequest is the request type for the Query/ValidatorPrices RPC method.
 type QueryValidatorPricesRequest struct {
 	state         protoimpl.MessageState
@@ -10734,7 +20977,7 @@ type QueryValidatorPricesRequest struct {
 func (x *QueryValidatorPricesRequest) Reset() {
 	*x = QueryValidatorPricesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[18]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10748,7 +20991,7 @@ func (*QueryValidatorPricesRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryValidatorPricesRequest.ProtoReflect.Descriptor instead.
 func (*QueryValidatorPricesRequest) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{18}
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *QueryValidatorPricesRequest) GetValidator() string {
@@ -10778,7 +21021,7 @@ type QueryValidatorPricesResponse struct {
 func (x *QueryValidatorPricesResponse) Reset() {
 	*x = QueryValidatorPricesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[19]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10792,7 +21035,7 @@ func (*QueryValidatorPricesResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryValidatorPricesResponse.ProtoReflect.Descriptor instead.
 func (*QueryValidatorPricesResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{19}
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *QueryValidatorPricesResponse) GetValidatorPrices() []*ValidatorPrice {
@@ -10815,7 +21058,7 @@ type QueryVoteRequest struct {
 func (x *QueryVoteRequest) Reset() {
 	*x = QueryVoteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[20]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10829,7 +21072,7 @@ func (*QueryVoteRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryVoteRequest.ProtoReflect.Descriptor instead.
 func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{20}
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *QueryVoteRequest) GetVoter() string {
@@ -10852,7 +21095,7 @@ type QueryVoteResponse struct {
 func (x *QueryVoteResponse) Reset() {
 	*x = QueryVoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[21]
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10866,7 +21109,7 @@ func (*QueryVoteResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryVoteResponse.ProtoReflect.Descriptor instead.
 func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{21}
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *QueryVoteResponse) GetSignals() []*Signal {
@@ -10876,6 +21119,181 @@ func (x *QueryVoteResponse) GetSignals() []*Signal {
 	return nil
 }
 
+// QueryDelegationRequest is the request type for the Query/Delegation RPC method.
+type QueryDelegationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator is the delegator address to query delegation for.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (x *QueryDelegationRequest) Reset() {
+	*x = QueryDelegationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDelegationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDelegationRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDelegationRequest.ProtoReflect.Descriptor instead.
+func (*QueryDelegationRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QueryDelegationRequest) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+// QueryDelegationResponse is the response type for the Query/Delegation RPC method.
+type QueryDelegationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegation is the delegation of the delegator.
+	Delegation *Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+}
+
+func (x *QueryDelegationResponse) Reset() {
+	*x = QueryDelegationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDelegationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDelegationResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDelegationResponse.ProtoReflect.Descriptor instead.
+func (*QueryDelegationResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *QueryDelegationResponse) GetDelegation() *Delegation {
+	if x != nil {
+		return x.Delegation
+	}
+	return nil
+}
+
+// QueryDelegationsRequest is the request type for the Query/Delegations RPC method.
+type QueryDelegationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegatee is the delegatee address to query delegations for.
+	Delegatee string `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// pagination is used to paginate the result.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryDelegationsRequest) Reset() {
+	*x = QueryDelegationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDelegationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDelegationsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDelegationsRequest.ProtoReflect.Descriptor instead.
+func (*QueryDelegationsRequest) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *QueryDelegationsRequest) GetDelegatee() string {
+	if x != nil {
+		return x.Delegatee
+	}
+	return ""
+}
+
+func (x *QueryDelegationsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryDelegationsResponse is the response type for the Query/Delegations RPC method.
+type QueryDelegationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegations is a list of delegations to the delegatee.
+	Delegations []*Delegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// total_power is the total voting power delegated to the delegatee.
+	TotalPower int64 `protobuf:"varint,2,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// pagination is used to paginate the result.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryDelegationsResponse) Reset() {
+	*x = QueryDelegationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_query_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDelegationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDelegationsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDelegationsResponse.ProtoReflect.Descriptor instead.
+func (*QueryDelegationsResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_query_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *QueryDelegationsResponse) GetDelegations() []*Delegation {
+	if x != nil {
+		return x.Delegations
+	}
+	return nil
+}
+
+func (x *QueryDelegationsResponse) GetTotalPower() int64 {
+	if x != nil {
+		return x.TotalPower
+	}
+	return 0
+}
+
+func (x *QueryDelegationsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 var File_band_feeds_v1beta1_query_proto protoreflect.FileDescriptor
 
 var file_band_feeds_v1beta1_query_proto_rawDesc = []byte{
@@ -10953,189 +21371,443 @@ var file_band_feeds_v1beta1_query_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x23,
-	0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x17, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x72, 0x65,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50,
+	0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x4d, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22,
+	0x7f, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x9d, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x47, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x57, 0x41, 0x50, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x4a, 0x0a, 0x11, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x57, 0x41, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x23, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
 	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x22, 0x86, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x49, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb5, 0x01, 0x0a,
-	0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4a, 0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x22, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x67, 0x0a, 0x17, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x15, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x39, 0x0a, 0x1a, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x67, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x6c, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xbc, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62,
 	0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x65, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x63, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb5,
+	0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x11, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x1d, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21,
+	0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x7c, 0x0a, 0x1e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63,
+	0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
+	0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63,
+	0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x22, 0x69, 0x0a, 0x1f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcb, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x14, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x7d, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x22, 0x73, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x10,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
-	0x22, 0x4f, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x73, 0x32, 0xea, 0x0c, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x91, 0x01, 0x0a, 0x0c,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x2c, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65,
-	0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1e, 0x12, 0x1c, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12,
-	0x93, 0x01, 0x0a, 0x08, 0x49, 0x73, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x62,
+	0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x73, 0x22, 0x73, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62,
 	0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x49, 0x73, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72,
-	0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x2f, 0x7b, 0x66, 0x65,
-	0x65, 0x64, 0x65, 0x72, 0x7d, 0x12, 0x78, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
-	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x81, 0x01, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
-	0x12, 0x21, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0x78, 0x0a, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x2e,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x4f, 0x0a, 0x11, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x22, 0x50, 0x0a, 0x16,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x5f,
+	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x99, 0x01, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x01, 0x0a, 0x18,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
 	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xbd, 0x19, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x91, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
 	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x85, 0x01,
-	0x0a, 0x09, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x08, 0x49, 0x73, 0x46,
+	0x65, 0x65, 0x64, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x49, 0x73, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x46, 0x65, 0x65, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x7d, 0x2f, 0x7b, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x7d, 0x12, 0x78,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x12, 0x15, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x05, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x78, 0x0a, 0x06,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
 	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x6c, 0x6c, 0x5f, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0xb6, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x35, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
+	0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
+	0x15, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x09, 0x41, 0x6c, 0x6c, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x96,
+	0x01, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x74, 0x12, 0x27, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x74, 0x2f, 0x7b, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
 	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0xa6,
-	0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f,
-	0x77, 0x65, 0x72, 0x73, 0x12, 0x31, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
+	0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x04, 0x54, 0x57, 0x41,
+	0x50, 0x12, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x57, 0x41, 0x50,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
 	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0xa6, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x2e, 0x62, 0x61, 0x6e,
+	0x72, 0x79, 0x54, 0x57, 0x41, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x77, 0x61, 0x70, 0x12, 0xb6,
+	0x01, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x36, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12,
+	0x26, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0xa6, 0x01, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x61, 0x6e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4,
 	0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x12, 0xaa, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12,
-	0x2c, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x7f, 0x0a,
-	0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x61,
+	0x74, 0x61, 0x31, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0xa2, 0x01, 0x0a, 0x11, 0x41, 0x6c, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x31, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x6c, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x9d, 0x01, 0x0a, 0x0f, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0xa6, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x31, 0x2e, 0x62, 0x61,
 	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0xa6,
+	0x01, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x2e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x7d, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0xb2, 0x01, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x31, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x7d, 0x2f, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0xad, 0x01, 0x0a,
+	0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61,
+	0x63, 0x69, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63,
+	0x75, 0x72, 0x61, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x69, 0x65, 0x73, 0x12, 0xaa, 0x01, 0x0a,
+	0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x7d, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x7f, 0x0a, 0x04, 0x56, 0x6f, 0x74,
+	0x65, 0x12, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x9b, 0x01, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x66, 0x65, 0x65,
 	0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x42, 0xd4,
-	0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61,
-	0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03,
-	0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x73,
-	0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x5c,
-	0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e,
-	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x2f, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x9f, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x6f, 0x74, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x7d, 0x2f, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0xd4, 0x01, 0x0a, 0x16, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa,
+	0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64,
+	0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64,
+	0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e,
+	0x64, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -11150,7 +21822,7 @@ func file_band_feeds_v1beta1_query_proto_rawDescGZIP() []byte {
 	return file_band_feeds_v1beta1_query_proto_rawDescData
 }
 
-var file_band_feeds_v1beta1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_band_feeds_v1beta1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_band_feeds_v1beta1_query_proto_goTypes = []interface{}{
 	(*QueryCurrentFeedsRequest)(nil),           // 0: band.feeds.v1beta1.QueryCurrentFeedsRequest
 	(*QueryCurrentFeedsResponse)(nil),          // 1: band.feeds.v1beta1.QueryCurrentFeedsResponse
@@ -11164,66 +21836,130 @@ var file_band_feeds_v1beta1_query_proto_goTypes = []interface{}{
 	(*QueryPricesResponse)(nil),                // 9: band.feeds.v1beta1.QueryPricesResponse
 	(*QueryAllPricesRequest)(nil),              // 10: band.feeds.v1beta1.QueryAllPricesRequest
 	(*QueryAllPricesResponse)(nil),             // 11: band.feeds.v1beta1.QueryAllPricesResponse
-	(*QueryReferenceSourceConfigRequest)(nil),  // 12: band.feeds.v1beta1.QueryReferenceSourceConfigRequest
-	(*QueryReferenceSourceConfigResponse)(nil), // 13: band.feeds.v1beta1.QueryReferenceSourceConfigResponse
-	(*QuerySignalTotalPowersRequest)(nil),      // 14: band.feeds.v1beta1.QuerySignalTotalPowersRequest
-	(*QuerySignalTotalPowersResponse)(nil),     // 15: band.feeds.v1beta1.QuerySignalTotalPowersResponse
-	(*QueryValidValidatorRequest)(nil),         // 16: band.feeds.v1beta1.QueryValidValidatorRequest
-	(*QueryValidValidatorResponse)(nil),        // 17: band.feeds.v1beta1.QueryValidValidatorResponse
-	(*QueryValidatorPricesRequest)(nil),        // 18: band.feeds.v1beta1.QueryValidatorPricesRequest
-	(*QueryValidatorPricesResponse)(nil),       // 19: band.feeds.v1beta1.QueryValidatorPricesResponse
-	(*QueryVoteRequest)(nil),                   // 20: band.feeds.v1beta1.QueryVoteRequest
-	(*QueryVoteResponse)(nil),                  // 21: band.feeds.v1beta1.QueryVoteResponse
-	(*CurrentFeedWithDeviations)(nil),          // 22: band.feeds.v1beta1.CurrentFeedWithDeviations
-	(*Params)(nil),                             // 23: band.feeds.v1beta1.Params
-	(*Price)(nil),                              // 24: band.feeds.v1beta1.Price
-	(*v1beta1.PageRequest)(nil),                // 25: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),               // 26: cosmos.base.query.v1beta1.PageResponse
-	(*ReferenceSourceConfig)(nil),              // 27: band.feeds.v1beta1.ReferenceSourceConfig
-	(*Signal)(nil),                             // 28: band.feeds.v1beta1.Signal
-	(*ValidatorPrice)(nil),                     // 29: band.feeds.v1beta1.ValidatorPrice
+	(*QueryPriceAtRequest)(nil),                // 12: band.feeds.v1beta1.QueryPriceAtRequest
+	(*QueryPriceAtResponse)(nil),               // 13: band.feeds.v1beta1.QueryPriceAtResponse
+	(*QueryPriceHistoryRequest)(nil),           // 14: band.feeds.v1beta1.QueryPriceHistoryRequest
+	(*QueryPriceHistoryResponse)(nil),          // 15: band.feeds.v1beta1.QueryPriceHistoryResponse
+	(*QueryTWAPRequest)(nil),                   // 16: band.feeds.v1beta1.QueryTWAPRequest
+	(*QueryTWAPResponse)(nil),                  // 17: band.feeds.v1beta1.QueryTWAPResponse
+	(*QueryReferenceSourceConfigRequest)(nil),  // 18: band.feeds.v1beta1.QueryReferenceSourceConfigRequest
+	(*QueryReferenceSourceConfigResponse)(nil), // 19: band.feeds.v1beta1.QueryReferenceSourceConfigResponse
+	(*QuerySignalMetadataRequest)(nil),         // 20: band.feeds.v1beta1.QuerySignalMetadataRequest
+	(*QuerySignalMetadataResponse)(nil),        // 21: band.feeds.v1beta1.QuerySignalMetadataResponse
+	(*QueryAllSignalMetadataRequest)(nil),      // 22: band.feeds.v1beta1.QueryAllSignalMetadataRequest
+	(*QueryAllSignalMetadataResponse)(nil),     // 23: band.feeds.v1beta1.QueryAllSignalMetadataResponse
+	(*QueryCircuitBreakersRequest)(nil),        // 24: band.feeds.v1beta1.QueryCircuitBreakersRequest
+	(*QueryCircuitBreakersResponse)(nil),       // 25: band.feeds.v1beta1.QueryCircuitBreakersResponse
+	(*QuerySignalTotalPowersRequest)(nil),      // 26: band.feeds.v1beta1.QuerySignalTotalPowersRequest
+	(*QuerySignalTotalPowersResponse)(nil),     // 27: band.feeds.v1beta1.QuerySignalTotalPowersResponse
+	(*QueryValidValidatorRequest)(nil),         // 28: band.feeds.v1beta1.QueryValidValidatorRequest
+	(*QueryValidValidatorResponse)(nil),        // 29: band.feeds.v1beta1.QueryValidValidatorResponse
+	(*QueryValidatorAccuracyRequest)(nil),      // 30: band.feeds.v1beta1.QueryValidatorAccuracyRequest
+	(*QueryValidatorAccuracyResponse)(nil),     // 31: band.feeds.v1beta1.QueryValidatorAccuracyResponse
+	(*QueryValidatorAccuraciesRequest)(nil),    // 32: band.feeds.v1beta1.QueryValidatorAccuraciesRequest
+	(*QueryValidatorAccuraciesResponse)(nil),   // 33: band.feeds.v1beta1.QueryValidatorAccuraciesResponse
+	(*QueryValidatorPricesRequest)(nil),        // 34: band.feeds.v1beta1.QueryValidatorPricesRequest
+	(*QueryValidatorPricesResponse)(nil),       // 35: band.feeds.v1beta1.QueryValidatorPricesResponse
+	(*QueryVoteRequest)(nil),                   // 36: band.feeds.v1beta1.QueryVoteRequest
+	(*QueryVoteResponse)(nil),                  // 37: band.feeds.v1beta1.QueryVoteResponse
+	(*QueryDelegationRequest)(nil),             // 38: band.feeds.v1beta1.QueryDelegationRequest
+	(*QueryDelegationResponse)(nil),            // 39: band.feeds.v1beta1.QueryDelegationResponse
+	(*QueryDelegationsRequest)(nil),            // 40: band.feeds.v1beta1.QueryDelegationsRequest
+	(*QueryDelegationsResponse)(nil),           // 41: band.feeds.v1beta1.QueryDelegationsResponse
+	(*CurrentFeedWithDeviations)(nil),          // 42: band.feeds.v1beta1.CurrentFeedWithDeviations
+	(*Params)(nil),                             // 43: band.feeds.v1beta1.Params
+	(*Price)(nil),                              // 44: band.feeds.v1beta1.Price
+	(*v1beta1.PageRequest)(nil),                // 45: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),               // 46: cosmos.base.query.v1beta1.PageResponse
+	(*ReferenceSourceConfig)(nil),              // 47: band.feeds.v1beta1.ReferenceSourceConfig
+	(*SignalMetadata)(nil),                     // 48: band.feeds.v1beta1.SignalMetadata
+	(*CircuitBreaker)(nil),                     // 49: band.feeds.v1beta1.CircuitBreaker
+	(*Signal)(nil),                             // 50: band.feeds.v1beta1.Signal
+	(*ValidatorAccuracy)(nil),                  // 51: band.feeds.v1beta1.ValidatorAccuracy
+	(*ValidatorPrice)(nil),                     // 52: band.feeds.v1beta1.ValidatorPrice
+	(*Delegation)(nil),                         // 53: band.feeds.v1beta1.Delegation
 }
 var file_band_feeds_v1beta1_query_proto_depIdxs = []int32{
-	22, // 0: band.feeds.v1beta1.QueryCurrentFeedsResponse.current_feeds:type_name -> band.feeds.v1beta1.CurrentFeedWithDeviations
-	23, // 1: band.feeds.v1beta1.QueryParamsResponse.params:type_name -> band.feeds.v1beta1.Params
-	24, // 2: band.feeds.v1beta1.QueryPriceResponse.price:type_name -> band.feeds.v1beta1.Price
-	24, // 3: band.feeds.v1beta1.QueryPricesResponse.prices:type_name -> band.feeds.v1beta1.Price
-	25, // 4: band.feeds.v1beta1.QueryAllPricesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	24, // 5: band.feeds.v1beta1.QueryAllPricesResponse.prices:type_name -> band.feeds.v1beta1.Price
-	26, // 6: band.feeds.v1beta1.QueryAllPricesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	27, // 7: band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config:type_name -> band.feeds.v1beta1.ReferenceSourceConfig
-	25, // 8: band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	28, // 9: band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers:type_name -> band.feeds.v1beta1.Signal
-	26, // 10: band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	29, // 11: band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices:type_name -> band.feeds.v1beta1.ValidatorPrice
-	28, // 12: band.feeds.v1beta1.QueryVoteResponse.signals:type_name -> band.feeds.v1beta1.Signal
-	0,  // 13: band.feeds.v1beta1.Query.CurrentFeeds:input_type -> band.feeds.v1beta1.QueryCurrentFeedsRequest
-	2,  // 14: band.feeds.v1beta1.Query.IsFeeder:input_type -> band.feeds.v1beta1.QueryIsFeederRequest
-	4,  // 15: band.feeds.v1beta1.Query.Params:input_type -> band.feeds.v1beta1.QueryParamsRequest
-	6,  // 16: band.feeds.v1beta1.Query.Price:input_type -> band.feeds.v1beta1.QueryPriceRequest
-	8,  // 17: band.feeds.v1beta1.Query.Prices:input_type -> band.feeds.v1beta1.QueryPricesRequest
-	10, // 18: band.feeds.v1beta1.Query.AllPrices:input_type -> band.feeds.v1beta1.QueryAllPricesRequest
-	12, // 19: band.feeds.v1beta1.Query.ReferenceSourceConfig:input_type -> band.feeds.v1beta1.QueryReferenceSourceConfigRequest
-	14, // 20: band.feeds.v1beta1.Query.SignalTotalPowers:input_type -> band.feeds.v1beta1.QuerySignalTotalPowersRequest
-	16, // 21: band.feeds.v1beta1.Query.ValidValidator:input_type -> band.feeds.v1beta1.QueryValidValidatorRequest
-	18, // 22: band.feeds.v1beta1.Query.ValidatorPrices:input_type -> band.feeds.v1beta1.QueryValidatorPricesRequest
-	20, // 23: band.feeds.v1beta1.Query.Vote:input_type -> band.feeds.v1beta1.QueryVoteRequest
-	1,  // 24: band.feeds.v1beta1.Query.CurrentFeeds:output_type -> band.feeds.v1beta1.QueryCurrentFeedsResponse
-	3,  // 25: band.feeds.v1beta1.Query.IsFeeder:output_type -> band.feeds.v1beta1.QueryIsFeederResponse
-	5,  // 26: band.feeds.v1beta1.Query.Params:output_type -> band.feeds.v1beta1.QueryParamsResponse
-	7,  // 27: band.feeds.v1beta1.Query.Price:output_type -> band.feeds.v1beta1.QueryPriceResponse
-	9,  // 28: band.feeds.v1beta1.Query.Prices:output_type -> band.feeds.v1beta1.QueryPricesResponse
-	11, // 29: band.feeds.v1beta1.Query.AllPrices:output_type -> band.feeds.v1beta1.QueryAllPricesResponse
-	13, // 30: band.feeds.v1beta1.Query.ReferenceSourceConfig:output_type -> band.feeds.v1beta1.QueryReferenceSourceConfigResponse
-	15, // 31: band.feeds.v1beta1.Query.SignalTotalPowers:output_type -> band.feeds.v1beta1.QuerySignalTotalPowersResponse
-	17, // 32: band.feeds.v1beta1.Query.ValidValidator:output_type -> band.feeds.v1beta1.QueryValidValidatorResponse
-	19, // 33: band.feeds.v1beta1.Query.ValidatorPrices:output_type -> band.feeds.v1beta1.QueryValidatorPricesResponse
-	21, // 34: band.feeds.v1beta1.Query.Vote:output_type -> band.feeds.v1beta1.QueryVoteResponse
-	24, // [24:35] is the sub-list for method output_type
-	13, // [13:24] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	42, // 0: band.feeds.v1beta1.QueryCurrentFeedsResponse.current_feeds:type_name -> band.feeds.v1beta1.CurrentFeedWithDeviations
+	43, // 1: band.feeds.v1beta1.QueryParamsResponse.params:type_name -> band.feeds.v1beta1.Params
+	44, // 2: band.feeds.v1beta1.QueryPriceResponse.price:type_name -> band.feeds.v1beta1.Price
+	44, // 3: band.feeds.v1beta1.QueryPricesResponse.prices:type_name -> band.feeds.v1beta1.Price
+	45, // 4: band.feeds.v1beta1.QueryAllPricesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	44, // 5: band.feeds.v1beta1.QueryAllPricesResponse.prices:type_name -> band.feeds.v1beta1.Price
+	46, // 6: band.feeds.v1beta1.QueryAllPricesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	44, // 7: band.feeds.v1beta1.QueryPriceAtResponse.price:type_name -> band.feeds.v1beta1.Price
+	45, // 8: band.feeds.v1beta1.QueryPriceHistoryRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	44, // 9: band.feeds.v1beta1.QueryPriceHistoryResponse.prices:type_name -> band.feeds.v1beta1.Price
+	46, // 10: band.feeds.v1beta1.QueryPriceHistoryResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	44, // 11: band.feeds.v1beta1.QueryTWAPResponse.price:type_name -> band.feeds.v1beta1.Price
+	47, // 12: band.feeds.v1beta1.QueryReferenceSourceConfigResponse.reference_source_config:type_name -> band.feeds.v1beta1.ReferenceSourceConfig
+	48, // 13: band.feeds.v1beta1.QuerySignalMetadataResponse.signal_metadata:type_name -> band.feeds.v1beta1.SignalMetadata
+	45, // 14: band.feeds.v1beta1.QueryAllSignalMetadataRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	48, // 15: band.feeds.v1beta1.QueryAllSignalMetadataResponse.signal_metadata:type_name -> band.feeds.v1beta1.SignalMetadata
+	46, // 16: band.feeds.v1beta1.QueryAllSignalMetadataResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	45, // 17: band.feeds.v1beta1.QueryCircuitBreakersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	49, // 18: band.feeds.v1beta1.QueryCircuitBreakersResponse.circuit_breakers:type_name -> band.feeds.v1beta1.CircuitBreaker
+	46, // 19: band.feeds.v1beta1.QueryCircuitBreakersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	45, // 20: band.feeds.v1beta1.QuerySignalTotalPowersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	50, // 21: band.feeds.v1beta1.QuerySignalTotalPowersResponse.signal_total_powers:type_name -> band.feeds.v1beta1.Signal
+	46, // 22: band.feeds.v1beta1.QuerySignalTotalPowersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	51, // 23: band.feeds.v1beta1.QueryValidatorAccuracyResponse.validator_accuracy:type_name -> band.feeds.v1beta1.ValidatorAccuracy
+	45, // 24: band.feeds.v1beta1.QueryValidatorAccuraciesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	51, // 25: band.feeds.v1beta1.QueryValidatorAccuraciesResponse.validator_accuracies:type_name -> band.feeds.v1beta1.ValidatorAccuracy
+	46, // 26: band.feeds.v1beta1.QueryValidatorAccuraciesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	52, // 27: band.feeds.v1beta1.QueryValidatorPricesResponse.validator_prices:type_name -> band.feeds.v1beta1.ValidatorPrice
+	50, // 28: band.feeds.v1beta1.QueryVoteResponse.signals:type_name -> band.feeds.v1beta1.Signal
+	53, // 29: band.feeds.v1beta1.QueryDelegationResponse.delegation:type_name -> band.feeds.v1beta1.Delegation
+	45, // 30: band.feeds.v1beta1.QueryDelegationsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	53, // 31: band.feeds.v1beta1.QueryDelegationsResponse.delegations:type_name -> band.feeds.v1beta1.Delegation
+	46, // 32: band.feeds.v1beta1.QueryDelegationsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 33: band.feeds.v1beta1.Query.CurrentFeeds:input_type -> band.feeds.v1beta1.QueryCurrentFeedsRequest
+	2,  // 34: band.feeds.v1beta1.Query.IsFeeder:input_type -> band.feeds.v1beta1.QueryIsFeederRequest
+	4,  // 35: band.feeds.v1beta1.Query.Params:input_type -> band.feeds.v1beta1.QueryParamsRequest
+	6,  // 36: band.feeds.v1beta1.Query.Price:input_type -> band.feeds.v1beta1.QueryPriceRequest
+	8,  // 37: band.feeds.v1beta1.Query.Prices:input_type -> band.feeds.v1beta1.QueryPricesRequest
+	10, // 38: band.feeds.v1beta1.Query.AllPrices:input_type -> band.feeds.v1beta1.QueryAllPricesRequest
+	12, // 39: band.feeds.v1beta1.Query.PriceAt:input_type -> band.feeds.v1beta1.QueryPriceAtRequest
+	14, // 40: band.feeds.v1beta1.Query.PriceHistory:input_type -> band.feeds.v1beta1.QueryPriceHistoryRequest
+	16, // 41: band.feeds.v1beta1.Query.TWAP:input_type -> band.feeds.v1beta1.QueryTWAPRequest
+	18, // 42: band.feeds.v1beta1.Query.ReferenceSourceConfig:input_type -> band.feeds.v1beta1.QueryReferenceSourceConfigRequest
+	20, // 43: band.feeds.v1beta1.Query.SignalMetadata:input_type -> band.feeds.v1beta1.QuerySignalMetadataRequest
+	22, // 44: band.feeds.v1beta1.Query.AllSignalMetadata:input_type -> band.feeds.v1beta1.QueryAllSignalMetadataRequest
+	24, // 45: band.feeds.v1beta1.Query.CircuitBreakers:input_type -> band.feeds.v1beta1.QueryCircuitBreakersRequest
+	26, // 46: band.feeds.v1beta1.Query.SignalTotalPowers:input_type -> band.feeds.v1beta1.QuerySignalTotalPowersRequest
+	28, // 47: band.feeds.v1beta1.Query.ValidValidator:input_type -> band.feeds.v1beta1.QueryValidValidatorRequest
+	30, // 48: band.feeds.v1beta1.Query.ValidatorAccuracy:input_type -> band.feeds.v1beta1.QueryValidatorAccuracyRequest
+	32, // 49: band.feeds.v1beta1.Query.ValidatorAccuracies:input_type -> band.feeds.v1beta1.QueryValidatorAccuraciesRequest
+	34, // 50: band.feeds.v1beta1.Query.ValidatorPrices:input_type -> band.feeds.v1beta1.QueryValidatorPricesRequest
+	36, // 51: band.feeds.v1beta1.Query.Vote:input_type -> band.feeds.v1beta1.QueryVoteRequest
+	38, // 52: band.feeds.v1beta1.Query.Delegation:input_type -> band.feeds.v1beta1.QueryDelegationRequest
+	40, // 53: band.feeds.v1beta1.Query.Delegations:input_type -> band.feeds.v1beta1.QueryDelegationsRequest
+	1,  // 54: band.feeds.v1beta1.Query.CurrentFeeds:output_type -> band.feeds.v1beta1.QueryCurrentFeedsResponse
+	3,  // 55: band.feeds.v1beta1.Query.IsFeeder:output_type -> band.feeds.v1beta1.QueryIsFeederResponse
+	5,  // 56: band.feeds.v1beta1.Query.Params:output_type -> band.feeds.v1beta1.QueryParamsResponse
+	7,  // 57: band.feeds.v1beta1.Query.Price:output_type -> band.feeds.v1beta1.QueryPriceResponse
+	9,  // 58: band.feeds.v1beta1.Query.Prices:output_type -> band.feeds.v1beta1.QueryPricesResponse
+	11, // 59: band.feeds.v1beta1.Query.AllPrices:output_type -> band.feeds.v1beta1.QueryAllPricesResponse
+	13, // 60: band.feeds.v1beta1.Query.PriceAt:output_type -> band.feeds.v1beta1.QueryPriceAtResponse
+	15, // 61: band.feeds.v1beta1.Query.PriceHistory:output_type -> band.feeds.v1beta1.QueryPriceHistoryResponse
+	17, // 62: band.feeds.v1beta1.Query.TWAP:output_type -> band.feeds.v1beta1.QueryTWAPResponse
+	19, // 63: band.feeds.v1beta1.Query.ReferenceSourceConfig:output_type -> band.feeds.v1beta1.QueryReferenceSourceConfigResponse
+	21, // 64: band.feeds.v1beta1.Query.SignalMetadata:output_type -> band.feeds.v1beta1.QuerySignalMetadataResponse
+	23, // 65: band.feeds.v1beta1.Query.AllSignalMetadata:output_type -> band.feeds.v1beta1.QueryAllSignalMetadataResponse
+	25, // 66: band.feeds.v1beta1.Query.CircuitBreakers:output_type -> band.feeds.v1beta1.QueryCircuitBreakersResponse
+	27, // 67: band.feeds.v1beta1.Query.SignalTotalPowers:output_type -> band.feeds.v1beta1.QuerySignalTotalPowersResponse
+	29, // 68: band.feeds.v1beta1.Query.ValidValidator:output_type -> band.feeds.v1beta1.QueryValidValidatorResponse
+	31, // 69: band.feeds.v1beta1.Query.ValidatorAccuracy:output_type -> band.feeds.v1beta1.QueryValidatorAccuracyResponse
+	33, // 70: band.feeds.v1beta1.Query.ValidatorAccuracies:output_type -> band.feeds.v1beta1.QueryValidatorAccuraciesResponse
+	35, // 71: band.feeds.v1beta1.Query.ValidatorPrices:output_type -> band.feeds.v1beta1.QueryValidatorPricesResponse
+	37, // 72: band.feeds.v1beta1.Query.Vote:output_type -> band.feeds.v1beta1.QueryVoteResponse
+	39, // 73: band.feeds.v1beta1.Query.Delegation:output_type -> band.feeds.v1beta1.QueryDelegationResponse
+	41, // 74: band.feeds.v1beta1.Query.Delegations:output_type -> band.feeds.v1beta1.QueryDelegationsResponse
+	54, // [54:75] is the sub-list for method output_type
+	33, // [33:54] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_band_feeds_v1beta1_query_proto_init() }
@@ -11379,7 +22115,7 @@ func file_band_feeds_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryReferenceSourceConfigRequest); i {
+			switch v := v.(*QueryPriceAtRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11391,7 +22127,7 @@ func file_band_feeds_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryReferenceSourceConfigResponse); i {
+			switch v := v.(*QueryPriceAtResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11403,7 +22139,7 @@ func file_band_feeds_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySignalTotalPowersRequest); i {
+			switch v := v.(*QueryPriceHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11415,7 +22151,7 @@ func file_band_feeds_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySignalTotalPowersResponse); i {
+			switch v := v.(*QueryPriceHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11427,7 +22163,7 @@ func file_band_feeds_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidValidatorRequest); i {
+			switch v := v.(*QueryTWAPRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11439,7 +22175,7 @@ func file_band_feeds_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidValidatorResponse); i {
+			switch v := v.(*QueryTWAPResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11451,7 +22187,7 @@ func file_band_feeds_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorPricesRequest); i {
+			switch v := v.(*QueryReferenceSourceConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11463,7 +22199,7 @@ func file_band_feeds_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorPricesResponse); i {
+			switch v := v.(*QueryReferenceSourceConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11475,7 +22211,7 @@ func file_band_feeds_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVoteRequest); i {
+			switch v := v.(*QuerySignalMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11487,6 +22223,198 @@ func file_band_feeds_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySignalMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllSignalMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllSignalMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCircuitBreakersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCircuitBreakersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySignalTotalPowersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySignalTotalPowersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidValidatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidValidatorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorAccuracyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorAccuracyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorAccuraciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorAccuraciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorPricesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorPricesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryVoteResponse); i {
 			case 0:
 				return &v.state
@@ -11498,6 +22426,54 @@ func file_band_feeds_v1beta1_query_proto_init() {
 				return nil
 			}
 		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_query_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -11505,7 +22481,7 @@ func file_band_feeds_v1beta1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_feeds_v1beta1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,11 +25,21 @@ const (
 	Query_Price_FullMethodName                 = "/band.feeds.v1beta1.Query/Price"
 	Query_Prices_FullMethodName                = "/band.feeds.v1beta1.Query/Prices"
 	Query_AllPrices_FullMethodName             = "/band.feeds.v1beta1.Query/AllPrices"
+	Query_PriceAt_FullMethodName               = "/band.feeds.v1beta1.Query/PriceAt"
+	Query_PriceHistory_FullMethodName          = "/band.feeds.v1beta1.Query/PriceHistory"
+	Query_TWAP_FullMethodName                  = "/band.feeds.v1beta1.Query/TWAP"
 	Query_ReferenceSourceConfig_FullMethodName = "/band.feeds.v1beta1.Query/ReferenceSourceConfig"
+	Query_SignalMetadata_FullMethodName        = "/band.feeds.v1beta1.Query/SignalMetadata"
+	Query_AllSignalMetadata_FullMethodName     = "/band.feeds.v1beta1.Query/AllSignalMetadata"
+	Query_CircuitBreakers_FullMethodName       = "/band.feeds.v1beta1.Query/CircuitBreakers"
 	Query_SignalTotalPowers_FullMethodName     = "/band.feeds.v1beta1.Query/SignalTotalPowers"
 	Query_ValidValidator_FullMethodName        = "/band.feeds.v1beta1.Query/ValidValidator"
+	Query_ValidatorAccuracy_FullMethodName     = "/band.feeds.v1beta1.Query/ValidatorAccuracy"
+	Query_ValidatorAccuracies_FullMethodName   = "/band.feeds.v1beta1.Query/ValidatorAccuracies"
 	Query_ValidatorPrices_FullMethodName       = "/band.feeds.v1beta1.Query/ValidatorPrices"
 	Query_Vote_FullMethodName                  = "/band.feeds.v1beta1.Query/Vote"
+	Query_Delegation_FullMethodName            = "/band.feeds.v1beta1.Query/Delegation"
+	Query_Delegations_FullMethodName           = "/band.feeds.v1beta1.Query/Delegations"
 )
 
 // QueryClient is the client API for Query service.
@@ -48,17 +58,37 @@ type QueryClient interface {
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
 	// AllPrices is an RPC method that returns all prices.
 	AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error)
+	// PriceAt is an RPC method that returns the historical price of a signal at a given time.
+	PriceAt(ctx context.Context, in *QueryPriceAtRequest, opts ...grpc.CallOption) (*QueryPriceAtResponse, error)
+	// PriceHistory is an RPC method that returns the historical prices of a signal.
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average price of a signal over a window.
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
 	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
 	ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error)
+	// SignalMetadata is an RPC method that returns the metadata of a signal id in the signal registry.
+	SignalMetadata(ctx context.Context, in *QuerySignalMetadataRequest, opts ...grpc.CallOption) (*QuerySignalMetadataResponse, error)
+	// AllSignalMetadata is an RPC method that returns the metadata of all signal ids in the signal registry.
+	AllSignalMetadata(ctx context.Context, in *QueryAllSignalMetadataRequest, opts ...grpc.CallOption) (*QueryAllSignalMetadataResponse, error)
+	// CircuitBreakers is an RPC method that returns the triggered circuit breakers of the signal ids.
+	CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error)
 	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
 	// id.
 	SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error)
 	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
 	ValidValidator(ctx context.Context, in *QueryValidValidatorRequest, opts ...grpc.CallOption) (*QueryValidValidatorResponse, error)
+	// ValidatorAccuracy is an RPC method that returns the accuracy score of a validator.
+	ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error)
+	// ValidatorAccuracies is an RPC method that returns the accuracy scores of all tracked validators.
+	ValidatorAccuracies(ctx context.Context, in *QueryValidatorAccuraciesRequest, opts ...grpc.CallOption) (*QueryValidatorAccuraciesResponse, error)
 	// ValidatorPrices is an RPC method that returns prices of a validator.
 	ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error)
 	// Vote is an RPC method that returns signals of a voter.
 	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
+	// Delegation is an RPC method that returns the delegation of feeds voting power of a delegator.
+	Delegation(ctx context.Context, in *QueryDelegationRequest, opts ...grpc.CallOption) (*QueryDelegationResponse, error)
+	// Delegations is an RPC method that returns the delegations of feeds voting power to a delegatee.
+	Delegations(ctx context.Context, in *QueryDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegationsResponse, error)
 }
 
 type queryClient struct {
@@ -123,6 +153,33 @@ func (c *queryClient) AllPrices(ctx context.Context, in *QueryAllPricesRequest,
 	return out, nil
 }
 
+func (c *queryClient) PriceAt(ctx context.Context, in *QueryPriceAtRequest, opts ...grpc.CallOption) (*QueryPriceAtResponse, error) {
+	out := new(QueryPriceAtResponse)
+	err := c.cc.Invoke(ctx, Query_PriceAt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_PriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_TWAP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error) {
 	out := new(QueryReferenceSourceConfigResponse)
 	err := c.cc.Invoke(ctx, Query_ReferenceSourceConfig_FullMethodName, in, out, opts...)
@@ -132,6 +189,33 @@ func (c *queryClient) ReferenceSourceConfig(ctx context.Context, in *QueryRefere
 	return out, nil
 }
 
+func (c *queryClient) SignalMetadata(ctx context.Context, in *QuerySignalMetadataRequest, opts ...grpc.CallOption) (*QuerySignalMetadataResponse, error) {
+	out := new(QuerySignalMetadataResponse)
+	err := c.cc.Invoke(ctx, Query_SignalMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllSignalMetadata(ctx context.Context, in *QueryAllSignalMetadataRequest, opts ...grpc.CallOption) (*QueryAllSignalMetadataResponse, error) {
+	out := new(QueryAllSignalMetadataResponse)
+	err := c.cc.Invoke(ctx, Query_AllSignalMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error) {
+	out := new(QueryCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, Query_CircuitBreakers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error) {
 	out := new(QuerySignalTotalPowersResponse)
 	err := c.cc.Invoke(ctx, Query_SignalTotalPowers_FullMethodName, in, out, opts...)
@@ -150,6 +234,24 @@ func (c *queryClient) ValidValidator(ctx context.Context, in *QueryValidValidato
 	return out, nil
 }
 
+func (c *queryClient) ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error) {
+	out := new(QueryValidatorAccuracyResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorAccuracy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorAccuracies(ctx context.Context, in *QueryValidatorAccuraciesRequest, opts ...grpc.CallOption) (*QueryValidatorAccuraciesResponse, error) {
+	out := new(QueryValidatorAccuraciesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorAccuracies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error) {
 	out := new(QueryValidatorPricesResponse)
 	err := c.cc.Invoke(ctx, Query_ValidatorPrices_FullMethodName, in, out, opts...)
@@ -168,6 +270,24 @@ func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) Delegation(ctx context.Context, in *QueryDelegationRequest, opts ...grpc.CallOption) (*QueryDelegationResponse, error) {
+	out := new(QueryDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_Delegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Delegations(ctx context.Context, in *QueryDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegationsResponse, error) {
+	out := new(QueryDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_Delegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -184,17 +304,37 @@ type QueryServer interface {
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
 	// AllPrices is an RPC method that returns all prices.
 	AllPrices(context.Context, *QueryAllPricesRequest) (*QueryAllPricesResponse, error)
+	// PriceAt is an RPC method that returns the historical price of a signal at a given time.
+	PriceAt(context.Context, *QueryPriceAtRequest) (*QueryPriceAtResponse, error)
+	// PriceHistory is an RPC method that returns the historical prices of a signal.
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average price of a signal over a window.
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
 	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
 	ReferenceSourceConfig(context.Context, *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error)
+	// SignalMetadata is an RPC method that returns the metadata of a signal id in the signal registry.
+	SignalMetadata(context.Context, *QuerySignalMetadataRequest) (*QuerySignalMetadataResponse, error)
+	// AllSignalMetadata is an RPC method that returns the metadata of all signal ids in the signal registry.
+	AllSignalMetadata(context.Context, *QueryAllSignalMetadataRequest) (*QueryAllSignalMetadataResponse, error)
+	// CircuitBreakers is an RPC method that returns the triggered circuit breakers of the signal ids.
+	CircuitBreakers(context.Context, *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error)
 	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
 	// id.
 	SignalTotalPowers(context.Context, *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error)
 	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
 	ValidValidator(context.Context, *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error)
+	// ValidatorAccuracy is an RPC method that returns the accuracy score of a validator.
+	ValidatorAccuracy(context.Context, *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error)
+	// ValidatorAccuracies is an RPC method that returns the accuracy scores of all tracked validators.
+	ValidatorAccuracies(context.Context, *QueryValidatorAccuraciesRequest) (*QueryValidatorAccuraciesResponse, error)
 	// ValidatorPrices is an RPC method that returns prices of a validator.
 	ValidatorPrices(context.Context, *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error)
 	// Vote is an RPC method that returns signals of a voter.
 	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
+	// Delegation is an RPC method that returns the delegation of feeds voting power of a delegator.
+	Delegation(context.Context, *QueryDelegationRequest) (*QueryDelegationResponse, error)
+	// Delegations is an RPC method that returns the delegations of feeds voting power to a delegatee.
+	Delegations(context.Context, *QueryDelegationsRequest) (*QueryDelegationsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -220,21 +360,51 @@ func (UnimplementedQueryServer) Prices(context.Context, *QueryPricesRequest) (*Q
 func (UnimplementedQueryServer) AllPrices(context.Context, *QueryAllPricesRequest) (*QueryAllPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllPrices not implemented")
 }
+func (UnimplementedQueryServer) PriceAt(context.Context, *QueryPriceAtRequest) (*QueryPriceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAt not implemented")
+}
+func (UnimplementedQueryServer) PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
+func (UnimplementedQueryServer) TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
 func (UnimplementedQueryServer) ReferenceSourceConfig(context.Context, *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReferenceSourceConfig not implemented")
 }
+func (UnimplementedQueryServer) SignalMetadata(context.Context, *QuerySignalMetadataRequest) (*QuerySignalMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalMetadata not implemented")
+}
+func (UnimplementedQueryServer) AllSignalMetadata(context.Context, *QueryAllSignalMetadataRequest) (*QueryAllSignalMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllSignalMetadata not implemented")
+}
+func (UnimplementedQueryServer) CircuitBreakers(context.Context, *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakers not implemented")
+}
 func (UnimplementedQueryServer) SignalTotalPowers(context.Context, *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignalTotalPowers not implemented")
 }
 func (UnimplementedQueryServer) ValidValidator(context.Context, *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidValidator not implemented")
 }
+func (UnimplementedQueryServer) ValidatorAccuracy(context.Context, *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAccuracy not implemented")
+}
+func (UnimplementedQueryServer) ValidatorAccuracies(context.Context, *QueryValidatorAccuraciesRequest) (*QueryValidatorAccuraciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAccuracies not implemented")
+}
 func (UnimplementedQueryServer) ValidatorPrices(context.Context, *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPrices not implemented")
 }
 func (UnimplementedQueryServer) Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (UnimplementedQueryServer) Delegation(context.Context, *QueryDelegationRequest) (*QueryDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegation not implemented")
+}
+func (UnimplementedQueryServer) Delegations(context.Context, *QueryDelegationsRequest) (*QueryDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegations not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -356,6 +526,60 @@ func _Query_AllPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceAt(ctx, req.(*QueryPriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ReferenceSourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryReferenceSourceConfigRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +598,60 @@ func _Query_ReferenceSourceConfig_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SignalMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SignalMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalMetadata(ctx, req.(*QuerySignalMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllSignalMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSignalMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllSignalMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllSignalMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllSignalMetadata(ctx, req.(*QueryAllSignalMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CircuitBreakers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakers(ctx, req.(*QueryCircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SignalTotalPowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySignalTotalPowersRequest)
 	if err := dec(in); err != nil {
@@ -410,6 +688,42 @@ func _Query_ValidValidator_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorAccuracy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAccuracyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorAccuracy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, req.(*QueryValidatorAccuracyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorAccuracies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAccuraciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAccuracies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorAccuracies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAccuracies(ctx, req.(*QueryValidatorAccuraciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorPricesRequest)
 	if err := dec(in); err != nil {
@@ -446,6 +760,42 @@ func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Delegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Delegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Delegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Delegation(ctx, req.(*QueryDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Delegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Delegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Delegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Delegations(ctx, req.(*QueryDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -477,10 +827,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllPrices",
 			Handler:    _Query_AllPrices_Handler,
 		},
+		{
+			MethodName: "PriceAt",
+			Handler:    _Query_PriceAt_Handler,
+		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
 		{
 			MethodName: "ReferenceSourceConfig",
 			Handler:    _Query_ReferenceSourceConfig_Handler,
 		},
+		{
+			MethodName: "SignalMetadata",
+			Handler:    _Query_SignalMetadata_Handler,
+		},
+		{
+			MethodName: "AllSignalMetadata",
+			Handler:    _Query_AllSignalMetadata_Handler,
+		},
+		{
+			MethodName: "CircuitBreakers",
+			Handler:    _Query_CircuitBreakers_Handler,
+		},
 		{
 			MethodName: "SignalTotalPowers",
 			Handler:    _Query_SignalTotalPowers_Handler,
@@ -489,6 +863,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidValidator",
 			Handler:    _Query_ValidValidator_Handler,
 		},
+		{
+			MethodName: "ValidatorAccuracy",
+			Handler:    _Query_ValidatorAccuracy_Handler,
+		},
+		{
+			MethodName: "ValidatorAccuracies",
+			Handler:    _Query_ValidatorAccuracies_Handler,
+		},
 		{
 			MethodName: "ValidatorPrices",
 			Handler:    _Query_ValidatorPrices_Handler,
@@ -497,6 +879,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Query_Vote_Handler,
 		},
+		{
+			MethodName: "Delegation",
+			Handler:    _Query_Delegation_Handler,
+		},
+		{
+			MethodName: "Delegations",
+			Handler:    _Query_Delegations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/feeds/v1beta1/query.proto",
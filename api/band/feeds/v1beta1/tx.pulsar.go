@@ -930,81 +930,30 @@ func (x *fastReflection_MsgVoteResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_MsgSubmitSignalPrices_3_list)(nil)
-
-type _MsgSubmitSignalPrices_3_list struct {
-	list *[]*SignalPrice
-}
-
-func (x *_MsgSubmitSignalPrices_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgSubmitSignalPrices_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgSubmitSignalPrices_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*SignalPrice)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgSubmitSignalPrices_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*SignalPrice)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgSubmitSignalPrices_3_list) AppendMutable() protoreflect.Value {
-	v := new(SignalPrice)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgSubmitSignalPrices_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgSubmitSignalPrices_3_list) NewElement() protoreflect.Value {
-	v := new(SignalPrice)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgSubmitSignalPrices_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgSubmitSignalPrices               protoreflect.MessageDescriptor
-	fd_MsgSubmitSignalPrices_validator     protoreflect.FieldDescriptor
-	fd_MsgSubmitSignalPrices_timestamp     protoreflect.FieldDescriptor
-	fd_MsgSubmitSignalPrices_signal_prices protoreflect.FieldDescriptor
+	md_MsgDelegateVotingPower           protoreflect.MessageDescriptor
+	fd_MsgDelegateVotingPower_delegator protoreflect.FieldDescriptor
+	fd_MsgDelegateVotingPower_delegatee protoreflect.FieldDescriptor
+	fd_MsgDelegateVotingPower_power     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_tx_proto_init()
-	md_MsgSubmitSignalPrices = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgSubmitSignalPrices")
-	fd_MsgSubmitSignalPrices_validator = md_MsgSubmitSignalPrices.Fields().ByName("validator")
-	fd_MsgSubmitSignalPrices_timestamp = md_MsgSubmitSignalPrices.Fields().ByName("timestamp")
-	fd_MsgSubmitSignalPrices_signal_prices = md_MsgSubmitSignalPrices.Fields().ByName("signal_prices")
+	md_MsgDelegateVotingPower = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgDelegateVotingPower")
+	fd_MsgDelegateVotingPower_delegator = md_MsgDelegateVotingPower.Fields().ByName("delegator")
+	fd_MsgDelegateVotingPower_delegatee = md_MsgDelegateVotingPower.Fields().ByName("delegatee")
+	fd_MsgDelegateVotingPower_power = md_MsgDelegateVotingPower.Fields().ByName("power")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitSignalPrices)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgDelegateVotingPower)(nil)
 
-type fastReflection_MsgSubmitSignalPrices MsgSubmitSignalPrices
+type fastReflection_MsgDelegateVotingPower MsgDelegateVotingPower
 
-func (x *MsgSubmitSignalPrices) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSignalPrices)(x)
+func (x *MsgDelegateVotingPower) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDelegateVotingPower)(x)
 }
 
-func (x *MsgSubmitSignalPrices) slowProtoReflect() protoreflect.Message {
+func (x *MsgDelegateVotingPower) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1016,43 +965,43 @@ func (x *MsgSubmitSignalPrices) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitSignalPrices_messageType fastReflection_MsgSubmitSignalPrices_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitSignalPrices_messageType{}
+var _fastReflection_MsgDelegateVotingPower_messageType fastReflection_MsgDelegateVotingPower_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDelegateVotingPower_messageType{}
 
-type fastReflection_MsgSubmitSignalPrices_messageType struct{}
+type fastReflection_MsgDelegateVotingPower_messageType struct{}
 
-func (x fastReflection_MsgSubmitSignalPrices_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSignalPrices)(nil)
+func (x fastReflection_MsgDelegateVotingPower_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDelegateVotingPower)(nil)
 }
-func (x fastReflection_MsgSubmitSignalPrices_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSignalPrices)
+func (x fastReflection_MsgDelegateVotingPower_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDelegateVotingPower)
 }
-func (x fastReflection_MsgSubmitSignalPrices_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSignalPrices
+func (x fastReflection_MsgDelegateVotingPower_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDelegateVotingPower
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitSignalPrices) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSignalPrices
+func (x *fastReflection_MsgDelegateVotingPower) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDelegateVotingPower
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitSignalPrices) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitSignalPrices_messageType
+func (x *fastReflection_MsgDelegateVotingPower) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDelegateVotingPower_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitSignalPrices) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSignalPrices)
+func (x *fastReflection_MsgDelegateVotingPower) New() protoreflect.Message {
+	return new(fastReflection_MsgDelegateVotingPower)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitSignalPrices) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitSignalPrices)(x)
+func (x *fastReflection_MsgDelegateVotingPower) Interface() protoreflect.ProtoMessage {
+	return (*MsgDelegateVotingPower)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1060,22 +1009,22 @@ func (x *fastReflection_MsgSubmitSignalPrices) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitSignalPrices) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_MsgSubmitSignalPrices_validator, value) {
+func (x *fastReflection_MsgDelegateVotingPower) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_MsgDelegateVotingPower_delegator, value) {
 			return
 		}
 	}
-	if x.Timestamp != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Timestamp)
-		if !f(fd_MsgSubmitSignalPrices_timestamp, value) {
+	if x.Delegatee != "" {
+		value := protoreflect.ValueOfString(x.Delegatee)
+		if !f(fd_MsgDelegateVotingPower_delegatee, value) {
 			return
 		}
 	}
-	if len(x.SignalPrices) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSubmitSignalPrices_3_list{list: &x.SignalPrices})
-		if !f(fd_MsgSubmitSignalPrices_signal_prices, value) {
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_MsgDelegateVotingPower_power, value) {
 			return
 		}
 	}
@@ -1092,19 +1041,19 @@ func (x *fastReflection_MsgSubmitSignalPrices) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitSignalPrices) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgDelegateVotingPower) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
-		return x.Validator != ""
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
-		return x.Timestamp != int64(0)
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
-		return len(x.SignalPrices) != 0
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegator":
+		return x.Delegator != ""
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegatee":
+		return x.Delegatee != ""
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.power":
+		return x.Power != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1114,19 +1063,19 @@ func (x *fastReflection_MsgSubmitSignalPrices) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSignalPrices) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgDelegateVotingPower) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
-		x.Validator = ""
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
-		x.Timestamp = int64(0)
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
-		x.SignalPrices = nil
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegator":
+		x.Delegator = ""
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegatee":
+		x.Delegatee = ""
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.power":
+		x.Power = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1136,25 +1085,22 @@ func (x *fastReflection_MsgSubmitSignalPrices) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitSignalPrices) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDelegateVotingPower) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
-		value := x.Validator
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegator":
+		value := x.Delegator
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
-		value := x.Timestamp
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegatee":
+		value := x.Delegatee
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.power":
+		value := x.Power
 		return protoreflect.ValueOfInt64(value)
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
-		if len(x.SignalPrices) == 0 {
-			return protoreflect.ValueOfList(&_MsgSubmitSignalPrices_3_list{})
-		}
-		listValue := &_MsgSubmitSignalPrices_3_list{list: &x.SignalPrices}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPower does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1168,21 +1114,19 @@ func (x *fastReflection_MsgSubmitSignalPrices) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSignalPrices) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgDelegateVotingPower) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
-		x.Validator = value.Interface().(string)
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
-		x.Timestamp = value.Int()
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
-		lv := value.List()
-		clv := lv.(*_MsgSubmitSignalPrices_3_list)
-		x.SignalPrices = *clv.list
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegator":
+		x.Delegator = value.Interface().(string)
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegatee":
+		x.Delegatee = value.Interface().(string)
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.power":
+		x.Power = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1196,53 +1140,48 @@ func (x *fastReflection_MsgSubmitSignalPrices) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSignalPrices) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDelegateVotingPower) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
-		if x.SignalPrices == nil {
-			x.SignalPrices = []*SignalPrice{}
-		}
-		value := &_MsgSubmitSignalPrices_3_list{list: &x.SignalPrices}
-		return protoreflect.ValueOfList(value)
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
-		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.MsgSubmitSignalPrices is not mutable"))
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
-		panic(fmt.Errorf("field timestamp of message band.feeds.v1beta1.MsgSubmitSignalPrices is not mutable"))
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegator":
+		panic(fmt.Errorf("field delegator of message band.feeds.v1beta1.MsgDelegateVotingPower is not mutable"))
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegatee":
+		panic(fmt.Errorf("field delegatee of message band.feeds.v1beta1.MsgDelegateVotingPower is not mutable"))
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.power":
+		panic(fmt.Errorf("field power of message band.feeds.v1beta1.MsgDelegateVotingPower is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPower does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitSignalPrices) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDelegateVotingPower) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegator":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.delegatee":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.MsgDelegateVotingPower.power":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
-		list := []*SignalPrice{}
-		return protoreflect.ValueOfList(&_MsgSubmitSignalPrices_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPower"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPower does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitSignalPrices) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgDelegateVotingPower) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgSubmitSignalPrices", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgDelegateVotingPower", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1250,7 +1189,7 @@ func (x *fastReflection_MsgSubmitSignalPrices) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitSignalPrices) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgDelegateVotingPower) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1261,7 +1200,7 @@ func (x *fastReflection_MsgSubmitSignalPrices) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSignalPrices) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgDelegateVotingPower) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1273,7 +1212,7 @@ func (x *fastReflection_MsgSubmitSignalPrices) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitSignalPrices) IsValid() bool {
+func (x *fastReflection_MsgDelegateVotingPower) IsValid() bool {
 	return x != nil
 }
 
@@ -1283,9 +1222,9 @@ func (x *fastReflection_MsgSubmitSignalPrices) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitSignalPrices) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgDelegateVotingPower) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitSignalPrices)
+		x := input.Message.Interface().(*MsgDelegateVotingPower)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1297,18 +1236,16 @@ func (x *fastReflection_MsgSubmitSignalPrices) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.Validator)
+		l = len(x.Delegator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Timestamp != 0 {
-			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		l = len(x.Delegatee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.SignalPrices) > 0 {
-			for _, e := range x.SignalPrices {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1320,7 +1257,7 @@ func (x *fastReflection_MsgSubmitSignalPrices) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSignalPrices)
+		x := input.Message.Interface().(*MsgDelegateVotingPower)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1339,31 +1276,22 @@ func (x *fastReflection_MsgSubmitSignalPrices) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.SignalPrices) > 0 {
-			for iNdEx := len(x.SignalPrices) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.SignalPrices[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+			i--
+			dAtA[i] = 0x18
 		}
-		if x.Timestamp != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+		if len(x.Delegatee) > 0 {
+			i -= len(x.Delegatee)
+			copy(dAtA[i:], x.Delegatee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegatee)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1378,7 +1306,7 @@ func (x *fastReflection_MsgSubmitSignalPrices) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSignalPrices)
+		x := input.Message.Interface().(*MsgDelegateVotingPower)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1410,15 +1338,15 @@ func (x *fastReflection_MsgSubmitSignalPrices) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignalPrices: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDelegateVotingPower: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignalPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDelegateVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1446,13 +1374,13 @@ func (x *fastReflection_MsgSubmitSignalPrices) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
+				x.Delegator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
 				}
-				x.Timestamp = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1462,16 +1390,29 @@ func (x *fastReflection_MsgSubmitSignalPrices) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Timestamp |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegatee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalPrices", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				var msglen int
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1481,26 +1422,11 @@ func (x *fastReflection_MsgSubmitSignalPrices) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Power |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SignalPrices = append(x.SignalPrices, &SignalPrice{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignalPrices[len(x.SignalPrices)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1537,23 +1463,23 @@ func (x *fastReflection_MsgSubmitSignalPrices) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgSubmitSignalPricesResponse protoreflect.MessageDescriptor
+	md_MsgDelegateVotingPowerResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_tx_proto_init()
-	md_MsgSubmitSignalPricesResponse = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgSubmitSignalPricesResponse")
+	md_MsgDelegateVotingPowerResponse = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgDelegateVotingPowerResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitSignalPricesResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgDelegateVotingPowerResponse)(nil)
 
-type fastReflection_MsgSubmitSignalPricesResponse MsgSubmitSignalPricesResponse
+type fastReflection_MsgDelegateVotingPowerResponse MsgDelegateVotingPowerResponse
 
-func (x *MsgSubmitSignalPricesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSignalPricesResponse)(x)
+func (x *MsgDelegateVotingPowerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDelegateVotingPowerResponse)(x)
 }
 
-func (x *MsgSubmitSignalPricesResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgDelegateVotingPowerResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1565,43 +1491,2715 @@ func (x *MsgSubmitSignalPricesResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitSignalPricesResponse_messageType fastReflection_MsgSubmitSignalPricesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitSignalPricesResponse_messageType{}
+var _fastReflection_MsgDelegateVotingPowerResponse_messageType fastReflection_MsgDelegateVotingPowerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDelegateVotingPowerResponse_messageType{}
 
-type fastReflection_MsgSubmitSignalPricesResponse_messageType struct{}
+type fastReflection_MsgDelegateVotingPowerResponse_messageType struct{}
 
-func (x fastReflection_MsgSubmitSignalPricesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSignalPricesResponse)(nil)
+func (x fastReflection_MsgDelegateVotingPowerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDelegateVotingPowerResponse)(nil)
 }
-func (x fastReflection_MsgSubmitSignalPricesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSignalPricesResponse)
+func (x fastReflection_MsgDelegateVotingPowerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDelegateVotingPowerResponse)
 }
-func (x fastReflection_MsgSubmitSignalPricesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSignalPricesResponse
+func (x fastReflection_MsgDelegateVotingPowerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDelegateVotingPowerResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSignalPricesResponse
+func (x *fastReflection_MsgDelegateVotingPowerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDelegateVotingPowerResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitSignalPricesResponse_messageType
+func (x *fastReflection_MsgDelegateVotingPowerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDelegateVotingPowerResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSignalPricesResponse)
+func (x *fastReflection_MsgDelegateVotingPowerResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDelegateVotingPowerResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitSignalPricesResponse)(x)
+func (x *fastReflection_MsgDelegateVotingPowerResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDelegateVotingPowerResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPowerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPowerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPowerResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPowerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPowerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgDelegateVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgDelegateVotingPowerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgDelegateVotingPowerResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDelegateVotingPowerResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDelegateVotingPowerResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDelegateVotingPowerResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDelegateVotingPowerResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDelegateVotingPowerResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDelegateVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSubmitSignalPrices_3_list)(nil)
+
+type _MsgSubmitSignalPrices_3_list struct {
+	list *[]*SignalPrice
+}
+
+func (x *_MsgSubmitSignalPrices_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitSignalPrices_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSubmitSignalPrices_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalPrice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitSignalPrices_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalPrice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitSignalPrices_3_list) AppendMutable() protoreflect.Value {
+	v := new(SignalPrice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitSignalPrices_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitSignalPrices_3_list) NewElement() protoreflect.Value {
+	v := new(SignalPrice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitSignalPrices_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSubmitSignalPrices               protoreflect.MessageDescriptor
+	fd_MsgSubmitSignalPrices_validator     protoreflect.FieldDescriptor
+	fd_MsgSubmitSignalPrices_timestamp     protoreflect.FieldDescriptor
+	fd_MsgSubmitSignalPrices_signal_prices protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_tx_proto_init()
+	md_MsgSubmitSignalPrices = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgSubmitSignalPrices")
+	fd_MsgSubmitSignalPrices_validator = md_MsgSubmitSignalPrices.Fields().ByName("validator")
+	fd_MsgSubmitSignalPrices_timestamp = md_MsgSubmitSignalPrices.Fields().ByName("timestamp")
+	fd_MsgSubmitSignalPrices_signal_prices = md_MsgSubmitSignalPrices.Fields().ByName("signal_prices")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitSignalPrices)(nil)
+
+type fastReflection_MsgSubmitSignalPrices MsgSubmitSignalPrices
+
+func (x *MsgSubmitSignalPrices) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSignalPrices)(x)
+}
+
+func (x *MsgSubmitSignalPrices) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitSignalPrices_messageType fastReflection_MsgSubmitSignalPrices_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitSignalPrices_messageType{}
+
+type fastReflection_MsgSubmitSignalPrices_messageType struct{}
+
+func (x fastReflection_MsgSubmitSignalPrices_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSignalPrices)(nil)
+}
+func (x fastReflection_MsgSubmitSignalPrices_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSignalPrices)
+}
+func (x fastReflection_MsgSubmitSignalPrices_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSignalPrices
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitSignalPrices) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSignalPrices
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitSignalPrices) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitSignalPrices_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitSignalPrices) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSignalPrices)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitSignalPrices) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitSignalPrices)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitSignalPrices) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_MsgSubmitSignalPrices_validator, value) {
+			return
+		}
+	}
+	if x.Timestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Timestamp)
+		if !f(fd_MsgSubmitSignalPrices_timestamp, value) {
+			return
+		}
+	}
+	if len(x.SignalPrices) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitSignalPrices_3_list{list: &x.SignalPrices})
+		if !f(fd_MsgSubmitSignalPrices_signal_prices, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitSignalPrices) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
+		return x.Validator != ""
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
+		return x.Timestamp != int64(0)
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
+		return len(x.SignalPrices) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignalPrices) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
+		x.Validator = ""
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
+		x.Timestamp = int64(0)
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
+		x.SignalPrices = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitSignalPrices) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
+		if len(x.SignalPrices) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitSignalPrices_3_list{})
+		}
+		listValue := &_MsgSubmitSignalPrices_3_list{list: &x.SignalPrices}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignalPrices) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
+		x.Validator = value.Interface().(string)
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
+		x.Timestamp = value.Int()
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitSignalPrices_3_list)
+		x.SignalPrices = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignalPrices) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
+		if x.SignalPrices == nil {
+			x.SignalPrices = []*SignalPrice{}
+		}
+		value := &_MsgSubmitSignalPrices_3_list{list: &x.SignalPrices}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
+		panic(fmt.Errorf("field validator of message band.feeds.v1beta1.MsgSubmitSignalPrices is not mutable"))
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
+		panic(fmt.Errorf("field timestamp of message band.feeds.v1beta1.MsgSubmitSignalPrices is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitSignalPrices) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.validator":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices":
+		list := []*SignalPrice{}
+		return protoreflect.ValueOfList(&_MsgSubmitSignalPrices_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPrices"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPrices does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitSignalPrices) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgSubmitSignalPrices", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitSignalPrices) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignalPrices) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitSignalPrices) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitSignalPrices) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitSignalPrices)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
+		if len(x.SignalPrices) > 0 {
+			for _, e := range x.SignalPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSignalPrices)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SignalPrices) > 0 {
+			for iNdEx := len(x.SignalPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SignalPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSignalPrices)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignalPrices: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignalPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalPrices = append(x.SignalPrices, &SignalPrice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignalPrices[len(x.SignalPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitSignalPricesResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_tx_proto_init()
+	md_MsgSubmitSignalPricesResponse = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgSubmitSignalPricesResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitSignalPricesResponse)(nil)
+
+type fastReflection_MsgSubmitSignalPricesResponse MsgSubmitSignalPricesResponse
+
+func (x *MsgSubmitSignalPricesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSignalPricesResponse)(x)
+}
+
+func (x *MsgSubmitSignalPricesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitSignalPricesResponse_messageType fastReflection_MsgSubmitSignalPricesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitSignalPricesResponse_messageType{}
+
+type fastReflection_MsgSubmitSignalPricesResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitSignalPricesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSignalPricesResponse)(nil)
+}
+func (x fastReflection_MsgSubmitSignalPricesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSignalPricesResponse)
+}
+func (x fastReflection_MsgSubmitSignalPricesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSignalPricesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSignalPricesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitSignalPricesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSignalPricesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitSignalPricesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgSubmitSignalPricesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitSignalPricesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitSignalPricesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSignalPricesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSignalPricesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignalPricesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignalPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateReferenceSourceConfig                         protoreflect.MessageDescriptor
+	fd_MsgUpdateReferenceSourceConfig_admin                   protoreflect.FieldDescriptor
+	fd_MsgUpdateReferenceSourceConfig_reference_source_config protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_tx_proto_init()
+	md_MsgUpdateReferenceSourceConfig = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgUpdateReferenceSourceConfig")
+	fd_MsgUpdateReferenceSourceConfig_admin = md_MsgUpdateReferenceSourceConfig.Fields().ByName("admin")
+	fd_MsgUpdateReferenceSourceConfig_reference_source_config = md_MsgUpdateReferenceSourceConfig.Fields().ByName("reference_source_config")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateReferenceSourceConfig)(nil)
+
+type fastReflection_MsgUpdateReferenceSourceConfig MsgUpdateReferenceSourceConfig
+
+func (x *MsgUpdateReferenceSourceConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateReferenceSourceConfig)(x)
+}
+
+func (x *MsgUpdateReferenceSourceConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateReferenceSourceConfig_messageType fastReflection_MsgUpdateReferenceSourceConfig_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateReferenceSourceConfig_messageType{}
+
+type fastReflection_MsgUpdateReferenceSourceConfig_messageType struct{}
+
+func (x fastReflection_MsgUpdateReferenceSourceConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateReferenceSourceConfig)(nil)
+}
+func (x fastReflection_MsgUpdateReferenceSourceConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateReferenceSourceConfig)
+}
+func (x fastReflection_MsgUpdateReferenceSourceConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateReferenceSourceConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateReferenceSourceConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateReferenceSourceConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateReferenceSourceConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateReferenceSourceConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_MsgUpdateReferenceSourceConfig_admin, value) {
+			return
+		}
+	}
+	if x.ReferenceSourceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.ReferenceSourceConfig.ProtoReflect())
+		if !f(fd_MsgUpdateReferenceSourceConfig_reference_source_config, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
+		return x.Admin != ""
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
+		return x.ReferenceSourceConfig != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
+		x.Admin = ""
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
+		x.ReferenceSourceConfig = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
+		value := x.Admin
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
+		value := x.ReferenceSourceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
+		x.Admin = value.Interface().(string)
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
+		x.ReferenceSourceConfig = value.Message().Interface().(*ReferenceSourceConfig)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
+		if x.ReferenceSourceConfig == nil {
+			x.ReferenceSourceConfig = new(ReferenceSourceConfig)
+		}
+		return protoreflect.ValueOfMessage(x.ReferenceSourceConfig.ProtoReflect())
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
+		panic(fmt.Errorf("field admin of message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
+		m := new(ReferenceSourceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgUpdateReferenceSourceConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateReferenceSourceConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Admin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReferenceSourceConfig != nil {
+			l = options.Size(x.ReferenceSourceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ReferenceSourceConfig != nil {
+			encoded, err := options.Marshal(x.ReferenceSourceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Admin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReferenceSourceConfig == nil {
+					x.ReferenceSourceConfig = &ReferenceSourceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReferenceSourceConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateReferenceSourceConfigResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_tx_proto_init()
+	md_MsgUpdateReferenceSourceConfigResponse = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgUpdateReferenceSourceConfigResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateReferenceSourceConfigResponse)(nil)
+
+type fastReflection_MsgUpdateReferenceSourceConfigResponse MsgUpdateReferenceSourceConfigResponse
+
+func (x *MsgUpdateReferenceSourceConfigResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateReferenceSourceConfigResponse)(x)
+}
+
+func (x *MsgUpdateReferenceSourceConfigResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType{}
+
+type fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateReferenceSourceConfigResponse)(nil)
+}
+func (x fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateReferenceSourceConfigResponse)
+}
+func (x fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateReferenceSourceConfigResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateReferenceSourceConfigResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateReferenceSourceConfigResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateReferenceSourceConfigResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfigResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfigResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfigResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParams           protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_tx_proto_init()
+	md_MsgUpdateParams = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgUpdateParams")
+	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
+	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+
+type fastReflection_MsgUpdateParams MsgUpdateParams
+
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(x)
+}
+
+func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+
+type fastReflection_MsgUpdateParams_messageType struct{}
+
+func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(nil)
+}
+func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateParams_authority, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_MsgUpdateParams_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateParams.authority":
+		return x.Authority != ""
+	case "band.feeds.v1beta1.MsgUpdateParams.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateParams.authority":
+		x.Authority = ""
+	case "band.feeds.v1beta1.MsgUpdateParams.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateParams.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.MsgUpdateParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateParams.authority":
+		x.Authority = value.Interface().(string)
+	case "band.feeds.v1beta1.MsgUpdateParams.params":
+		x.Params = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateParams.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "band.feeds.v1beta1.MsgUpdateParams.authority":
+		panic(fmt.Errorf("field authority of message band.feeds.v1beta1.MsgUpdateParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.MsgUpdateParams.authority":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.MsgUpdateParams.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgUpdateParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_tx_proto_init()
+	md_MsgUpdateParamsResponse = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+
+type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(x)
+}
+
+func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+
+type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParamsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1609,7 +4207,7 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -1623,13 +4221,13 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1639,13 +4237,13 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1655,13 +4253,13 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1675,13 +4273,13 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1695,36 +4293,36 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgSubmitSignalPricesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgSubmitSignalPricesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgSubmitSignalPricesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgUpdateParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1732,7 +4330,7 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1743,7 +4341,7 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1755,7 +4353,7 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1765,9 +4363,9 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitSignalPricesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitSignalPricesResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1789,7 +4387,7 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSignalPricesResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1819,7 +4417,7 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSignalPricesResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1851,10 +4449,10 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignalPricesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignalPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -1892,29 +4490,80 @@ func (x *fastReflection_MsgSubmitSignalPricesResponse) ProtoMethods() *protoifac
 	}
 }
 
+var _ protoreflect.List = (*_MsgUpdateSignalMetadata_2_list)(nil)
+
+type _MsgUpdateSignalMetadata_2_list struct {
+	list *[]*SignalMetadata
+}
+
+func (x *_MsgUpdateSignalMetadata_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateSignalMetadata_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdateSignalMetadata_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalMetadata)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateSignalMetadata_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalMetadata)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateSignalMetadata_2_list) AppendMutable() protoreflect.Value {
+	v := new(SignalMetadata)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateSignalMetadata_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateSignalMetadata_2_list) NewElement() protoreflect.Value {
+	v := new(SignalMetadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateSignalMetadata_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUpdateReferenceSourceConfig                         protoreflect.MessageDescriptor
-	fd_MsgUpdateReferenceSourceConfig_admin                   protoreflect.FieldDescriptor
-	fd_MsgUpdateReferenceSourceConfig_reference_source_config protoreflect.FieldDescriptor
+	md_MsgUpdateSignalMetadata                 protoreflect.MessageDescriptor
+	fd_MsgUpdateSignalMetadata_authority       protoreflect.FieldDescriptor
+	fd_MsgUpdateSignalMetadata_signal_metadata protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_tx_proto_init()
-	md_MsgUpdateReferenceSourceConfig = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgUpdateReferenceSourceConfig")
-	fd_MsgUpdateReferenceSourceConfig_admin = md_MsgUpdateReferenceSourceConfig.Fields().ByName("admin")
-	fd_MsgUpdateReferenceSourceConfig_reference_source_config = md_MsgUpdateReferenceSourceConfig.Fields().ByName("reference_source_config")
+	md_MsgUpdateSignalMetadata = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgUpdateSignalMetadata")
+	fd_MsgUpdateSignalMetadata_authority = md_MsgUpdateSignalMetadata.Fields().ByName("authority")
+	fd_MsgUpdateSignalMetadata_signal_metadata = md_MsgUpdateSignalMetadata.Fields().ByName("signal_metadata")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateReferenceSourceConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateSignalMetadata)(nil)
 
-type fastReflection_MsgUpdateReferenceSourceConfig MsgUpdateReferenceSourceConfig
+type fastReflection_MsgUpdateSignalMetadata MsgUpdateSignalMetadata
 
-func (x *MsgUpdateReferenceSourceConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateReferenceSourceConfig)(x)
+func (x *MsgUpdateSignalMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateSignalMetadata)(x)
 }
 
-func (x *MsgUpdateReferenceSourceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[4]
+func (x *MsgUpdateSignalMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1925,43 +4574,43 @@ func (x *MsgUpdateReferenceSourceConfig) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateReferenceSourceConfig_messageType fastReflection_MsgUpdateReferenceSourceConfig_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateReferenceSourceConfig_messageType{}
+var _fastReflection_MsgUpdateSignalMetadata_messageType fastReflection_MsgUpdateSignalMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateSignalMetadata_messageType{}
 
-type fastReflection_MsgUpdateReferenceSourceConfig_messageType struct{}
+type fastReflection_MsgUpdateSignalMetadata_messageType struct{}
 
-func (x fastReflection_MsgUpdateReferenceSourceConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateReferenceSourceConfig)(nil)
+func (x fastReflection_MsgUpdateSignalMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateSignalMetadata)(nil)
 }
-func (x fastReflection_MsgUpdateReferenceSourceConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateReferenceSourceConfig)
+func (x fastReflection_MsgUpdateSignalMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateSignalMetadata)
 }
-func (x fastReflection_MsgUpdateReferenceSourceConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateReferenceSourceConfig
+func (x fastReflection_MsgUpdateSignalMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateSignalMetadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateReferenceSourceConfig
+func (x *fastReflection_MsgUpdateSignalMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateSignalMetadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateReferenceSourceConfig_messageType
+func (x *fastReflection_MsgUpdateSignalMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateSignalMetadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateReferenceSourceConfig)
+func (x *fastReflection_MsgUpdateSignalMetadata) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateSignalMetadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateReferenceSourceConfig)(x)
+func (x *fastReflection_MsgUpdateSignalMetadata) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateSignalMetadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1969,16 +4618,16 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Admin != "" {
-		value := protoreflect.ValueOfString(x.Admin)
-		if !f(fd_MsgUpdateReferenceSourceConfig_admin, value) {
+func (x *fastReflection_MsgUpdateSignalMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateSignalMetadata_authority, value) {
 			return
 		}
 	}
-	if x.ReferenceSourceConfig != nil {
-		value := protoreflect.ValueOfMessage(x.ReferenceSourceConfig.ProtoReflect())
-		if !f(fd_MsgUpdateReferenceSourceConfig_reference_source_config, value) {
+	if len(x.SignalMetadata) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateSignalMetadata_2_list{list: &x.SignalMetadata})
+		if !f(fd_MsgUpdateSignalMetadata_signal_metadata, value) {
 			return
 		}
 	}
@@ -1995,17 +4644,17 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateSignalMetadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
-		return x.Admin != ""
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
-		return x.ReferenceSourceConfig != nil
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.authority":
+		return x.Authority != ""
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.signal_metadata":
+		return len(x.SignalMetadata) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2015,17 +4664,17 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateSignalMetadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
-		x.Admin = ""
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
-		x.ReferenceSourceConfig = nil
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.authority":
+		x.Authority = ""
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.signal_metadata":
+		x.SignalMetadata = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2035,19 +4684,22 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateSignalMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
-		value := x.Admin
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.authority":
+		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
-		value := x.ReferenceSourceConfig
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.signal_metadata":
+		if len(x.SignalMetadata) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateSignalMetadata_2_list{})
+		}
+		listValue := &_MsgUpdateSignalMetadata_2_list{list: &x.SignalMetadata}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2061,17 +4713,19 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateSignalMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
-		x.Admin = value.Interface().(string)
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
-		x.ReferenceSourceConfig = value.Message().Interface().(*ReferenceSourceConfig)
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.authority":
+		x.Authority = value.Interface().(string)
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.signal_metadata":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateSignalMetadata_2_list)
+		x.SignalMetadata = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2085,48 +4739,49 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateSignalMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
-		if x.ReferenceSourceConfig == nil {
-			x.ReferenceSourceConfig = new(ReferenceSourceConfig)
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.signal_metadata":
+		if x.SignalMetadata == nil {
+			x.SignalMetadata = []*SignalMetadata{}
 		}
-		return protoreflect.ValueOfMessage(x.ReferenceSourceConfig.ProtoReflect())
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
-		panic(fmt.Errorf("field admin of message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig is not mutable"))
+		value := &_MsgUpdateSignalMetadata_2_list{list: &x.SignalMetadata}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.authority":
+		panic(fmt.Errorf("field authority of message band.feeds.v1beta1.MsgUpdateSignalMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateSignalMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.admin":
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.authority":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config":
-		m := new(ReferenceSourceConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.feeds.v1beta1.MsgUpdateSignalMetadata.signal_metadata":
+		list := []*SignalMetadata{}
+		return protoreflect.ValueOfList(&_MsgUpdateSignalMetadata_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadata"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateSignalMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgUpdateReferenceSourceConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgUpdateSignalMetadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2134,7 +4789,7 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateSignalMetadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2145,7 +4800,7 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateSignalMetadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2157,7 +4812,7 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) IsValid() bool {
+func (x *fastReflection_MsgUpdateSignalMetadata) IsValid() bool {
 	return x != nil
 }
 
@@ -2167,9 +4822,9 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateReferenceSourceConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateSignalMetadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfig)
+		x := input.Message.Interface().(*MsgUpdateSignalMetadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2181,13 +4836,15 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		l = len(x.Admin)
+		l = len(x.Authority)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ReferenceSourceConfig != nil {
-			l = options.Size(x.ReferenceSourceConfig)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.SignalMetadata) > 0 {
+			for _, e := range x.SignalMetadata {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2199,7 +4856,7 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfig)
+		x := input.Message.Interface().(*MsgUpdateSignalMetadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2218,24 +4875,26 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ReferenceSourceConfig != nil {
-			encoded, err := options.Marshal(x.ReferenceSourceConfig)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.SignalMetadata) > 0 {
+			for iNdEx := len(x.SignalMetadata) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SignalMetadata[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
 		}
-		if len(x.Admin) > 0 {
-			i -= len(x.Admin)
-			copy(dAtA[i:], x.Admin)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2250,7 +4909,7 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfig)
+		x := input.Message.Interface().(*MsgUpdateSignalMetadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2282,15 +4941,15 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateSignalMetadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateSignalMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2318,11 +4977,11 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) ProtoMethods() *protoifa
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Admin = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalMetadata", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2349,10 +5008,8 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) ProtoMethods() *protoifa
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ReferenceSourceConfig == nil {
-					x.ReferenceSourceConfig = &ReferenceSourceConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReferenceSourceConfig); err != nil {
+				x.SignalMetadata = append(x.SignalMetadata, &SignalMetadata{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignalMetadata[len(x.SignalMetadata)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2392,24 +5049,24 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfig) ProtoMethods() *protoifa
 }
 
 var (
-	md_MsgUpdateReferenceSourceConfigResponse protoreflect.MessageDescriptor
+	md_MsgUpdateSignalMetadataResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_tx_proto_init()
-	md_MsgUpdateReferenceSourceConfigResponse = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgUpdateReferenceSourceConfigResponse")
+	md_MsgUpdateSignalMetadataResponse = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgUpdateSignalMetadataResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateReferenceSourceConfigResponse)(nil)
-
-type fastReflection_MsgUpdateReferenceSourceConfigResponse MsgUpdateReferenceSourceConfigResponse
+var _ protoreflect.Message = (*fastReflection_MsgUpdateSignalMetadataResponse)(nil)
 
-func (x *MsgUpdateReferenceSourceConfigResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateReferenceSourceConfigResponse)(x)
+type fastReflection_MsgUpdateSignalMetadataResponse MsgUpdateSignalMetadataResponse
+
+func (x *MsgUpdateSignalMetadataResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateSignalMetadataResponse)(x)
 }
 
-func (x *MsgUpdateReferenceSourceConfigResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[5]
+func (x *MsgUpdateSignalMetadataResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2420,43 +5077,43 @@ func (x *MsgUpdateReferenceSourceConfigResponse) slowProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType{}
+var _fastReflection_MsgUpdateSignalMetadataResponse_messageType fastReflection_MsgUpdateSignalMetadataResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateSignalMetadataResponse_messageType{}
 
-type fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType struct{}
+type fastReflection_MsgUpdateSignalMetadataResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateReferenceSourceConfigResponse)(nil)
+func (x fastReflection_MsgUpdateSignalMetadataResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateSignalMetadataResponse)(nil)
 }
-func (x fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateReferenceSourceConfigResponse)
+func (x fastReflection_MsgUpdateSignalMetadataResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateSignalMetadataResponse)
 }
-func (x fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateReferenceSourceConfigResponse
+func (x fastReflection_MsgUpdateSignalMetadataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateSignalMetadataResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateReferenceSourceConfigResponse
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateSignalMetadataResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateReferenceSourceConfigResponse_messageType
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateSignalMetadataResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateReferenceSourceConfigResponse)
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateSignalMetadataResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateReferenceSourceConfigResponse)(x)
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateSignalMetadataResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2464,7 +5121,7 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Interface() prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -2478,13 +5135,13 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Range(f func(pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2494,13 +5151,13 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Has(fd protorefl
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2510,13 +5167,13 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Clear(fd protore
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadataResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2530,13 +5187,13 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Get(descriptor p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2550,36 +5207,36 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Set(fd protorefl
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateSignalMetadataResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateSignalMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgUpdateSignalMetadataResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2587,7 +5244,7 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) WhichOneof(d pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2598,7 +5255,7 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) GetUnknown() pro
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2610,7 +5267,7 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) SetUnknown(field
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2620,9 +5277,9 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateSignalMetadataResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfigResponse)
+		x := input.Message.Interface().(*MsgUpdateSignalMetadataResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2644,7 +5301,7 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) ProtoMethods() *
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfigResponse)
+		x := input.Message.Interface().(*MsgUpdateSignalMetadataResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2674,7 +5331,7 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) ProtoMethods() *
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateReferenceSourceConfigResponse)
+		x := input.Message.Interface().(*MsgUpdateSignalMetadataResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2706,10 +5363,10 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) ProtoMethods() *
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateSignalMetadataResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateSignalMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -2747,29 +5404,75 @@ func (x *fastReflection_MsgUpdateReferenceSourceConfigResponse) ProtoMethods() *
 	}
 }
 
+var _ protoreflect.List = (*_MsgResetCircuitBreakers_2_list)(nil)
+
+type _MsgResetCircuitBreakers_2_list struct {
+	list *[]string
+}
+
+func (x *_MsgResetCircuitBreakers_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgResetCircuitBreakers_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgResetCircuitBreakers_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgResetCircuitBreakers_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgResetCircuitBreakers_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgResetCircuitBreakers at list field SignalIds as it is not of Message kind"))
+}
+
+func (x *_MsgResetCircuitBreakers_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgResetCircuitBreakers_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgResetCircuitBreakers_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUpdateParams           protoreflect.MessageDescriptor
-	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
-	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+	md_MsgResetCircuitBreakers            protoreflect.MessageDescriptor
+	fd_MsgResetCircuitBreakers_signer     protoreflect.FieldDescriptor
+	fd_MsgResetCircuitBreakers_signal_ids protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_tx_proto_init()
-	md_MsgUpdateParams = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgUpdateParams")
-	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
-	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+	md_MsgResetCircuitBreakers = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgResetCircuitBreakers")
+	fd_MsgResetCircuitBreakers_signer = md_MsgResetCircuitBreakers.Fields().ByName("signer")
+	fd_MsgResetCircuitBreakers_signal_ids = md_MsgResetCircuitBreakers.Fields().ByName("signal_ids")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgResetCircuitBreakers)(nil)
 
-type fastReflection_MsgUpdateParams MsgUpdateParams
+type fastReflection_MsgResetCircuitBreakers MsgResetCircuitBreakers
 
-func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(x)
+func (x *MsgResetCircuitBreakers) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgResetCircuitBreakers)(x)
 }
 
-func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[6]
+func (x *MsgResetCircuitBreakers) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2780,43 +5483,43 @@ func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+var _fastReflection_MsgResetCircuitBreakers_messageType fastReflection_MsgResetCircuitBreakers_messageType
+var _ protoreflect.MessageType = fastReflection_MsgResetCircuitBreakers_messageType{}
 
-type fastReflection_MsgUpdateParams_messageType struct{}
+type fastReflection_MsgResetCircuitBreakers_messageType struct{}
 
-func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(nil)
+func (x fastReflection_MsgResetCircuitBreakers_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgResetCircuitBreakers)(nil)
 }
-func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x fastReflection_MsgResetCircuitBreakers_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgResetCircuitBreakers)
 }
-func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x fastReflection_MsgResetCircuitBreakers_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResetCircuitBreakers
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x *fastReflection_MsgResetCircuitBreakers) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResetCircuitBreakers
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParams_messageType
+func (x *fastReflection_MsgResetCircuitBreakers) Type() protoreflect.MessageType {
+	return _fastReflection_MsgResetCircuitBreakers_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x *fastReflection_MsgResetCircuitBreakers) New() protoreflect.Message {
+	return new(fastReflection_MsgResetCircuitBreakers)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParams)(x)
+func (x *fastReflection_MsgResetCircuitBreakers) Interface() protoreflect.ProtoMessage {
+	return (*MsgResetCircuitBreakers)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2824,16 +5527,16 @@ func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Authority != "" {
-		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateParams_authority, value) {
+func (x *fastReflection_MsgResetCircuitBreakers) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgResetCircuitBreakers_signer, value) {
 			return
 		}
 	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_MsgUpdateParams_params, value) {
+	if len(x.SignalIds) != 0 {
+		value := protoreflect.ValueOfList(&_MsgResetCircuitBreakers_2_list{list: &x.SignalIds})
+		if !f(fd_MsgResetCircuitBreakers_signal_ids, value) {
 			return
 		}
 	}
@@ -2850,17 +5553,17 @@ func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgResetCircuitBreakers) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateParams.authority":
-		return x.Authority != ""
-	case "band.feeds.v1beta1.MsgUpdateParams.params":
-		return x.Params != nil
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signer":
+		return x.Signer != ""
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signal_ids":
+		return len(x.SignalIds) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakers"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakers does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2870,17 +5573,17 @@ func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgResetCircuitBreakers) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateParams.authority":
-		x.Authority = ""
-	case "band.feeds.v1beta1.MsgUpdateParams.params":
-		x.Params = nil
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signer":
+		x.Signer = ""
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signal_ids":
+		x.SignalIds = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakers"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakers does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2890,19 +5593,22 @@ func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResetCircuitBreakers) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateParams.authority":
-		value := x.Authority
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signer":
+		value := x.Signer
 		return protoreflect.ValueOfString(value)
-	case "band.feeds.v1beta1.MsgUpdateParams.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signal_ids":
+		if len(x.SignalIds) == 0 {
+			return protoreflect.ValueOfList(&_MsgResetCircuitBreakers_2_list{})
+		}
+		listValue := &_MsgResetCircuitBreakers_2_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakers"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakers does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2916,17 +5622,19 @@ func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgResetCircuitBreakers) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateParams.authority":
-		x.Authority = value.Interface().(string)
-	case "band.feeds.v1beta1.MsgUpdateParams.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signer":
+		x.Signer = value.Interface().(string)
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signal_ids":
+		lv := value.List()
+		clv := lv.(*_MsgResetCircuitBreakers_2_list)
+		x.SignalIds = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakers"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakers does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2940,48 +5648,49 @@ func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResetCircuitBreakers) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateParams.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signal_ids":
+		if x.SignalIds == nil {
+			x.SignalIds = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "band.feeds.v1beta1.MsgUpdateParams.authority":
-		panic(fmt.Errorf("field authority of message band.feeds.v1beta1.MsgUpdateParams is not mutable"))
+		value := &_MsgResetCircuitBreakers_2_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(value)
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signer":
+		panic(fmt.Errorf("field signer of message band.feeds.v1beta1.MsgResetCircuitBreakers is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakers"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakers does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResetCircuitBreakers) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.feeds.v1beta1.MsgUpdateParams.authority":
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signer":
 		return protoreflect.ValueOfString("")
-	case "band.feeds.v1beta1.MsgUpdateParams.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.feeds.v1beta1.MsgResetCircuitBreakers.signal_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgResetCircuitBreakers_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakers"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakers does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgResetCircuitBreakers) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgUpdateParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgResetCircuitBreakers", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2989,7 +5698,7 @@ func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgResetCircuitBreakers) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3000,7 +5709,7 @@ func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgResetCircuitBreakers) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3012,7 +5721,7 @@ func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+func (x *fastReflection_MsgResetCircuitBreakers) IsValid() bool {
 	return x != nil
 }
 
@@ -3022,9 +5731,9 @@ func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgResetCircuitBreakers) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgResetCircuitBreakers)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3036,13 +5745,15 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Authority)
+		l = len(x.Signer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.SignalIds) > 0 {
+			for _, s := range x.SignalIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3054,7 +5765,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgResetCircuitBreakers)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3073,24 +5784,19 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.SignalIds) > 0 {
+			for iNdEx := len(x.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SignalIds[iNdEx])
+				copy(dAtA[i:], x.SignalIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalIds[iNdEx])))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
 		}
-		if len(x.Authority) > 0 {
-			i -= len(x.Authority)
-			copy(dAtA[i:], x.Authority)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3105,7 +5811,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgResetCircuitBreakers)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3137,15 +5843,15 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResetCircuitBreakers: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResetCircuitBreakers: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3173,13 +5879,13 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Authority = string(dAtA[iNdEx:postIndex])
+				x.Signer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3189,27 +5895,23 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.SignalIds = append(x.SignalIds, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3247,24 +5949,24 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+	md_MsgResetCircuitBreakersResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_feeds_v1beta1_tx_proto_init()
-	md_MsgUpdateParamsResponse = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+	md_MsgResetCircuitBreakersResponse = File_band_feeds_v1beta1_tx_proto.Messages().ByName("MsgResetCircuitBreakersResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgResetCircuitBreakersResponse)(nil)
 
-type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+type fastReflection_MsgResetCircuitBreakersResponse MsgResetCircuitBreakersResponse
 
-func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(x)
+func (x *MsgResetCircuitBreakersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgResetCircuitBreakersResponse)(x)
 }
 
-func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[7]
+func (x *MsgResetCircuitBreakersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3275,43 +5977,43 @@ func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+var _fastReflection_MsgResetCircuitBreakersResponse_messageType fastReflection_MsgResetCircuitBreakersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgResetCircuitBreakersResponse_messageType{}
 
-type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+type fastReflection_MsgResetCircuitBreakersResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+func (x fastReflection_MsgResetCircuitBreakersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgResetCircuitBreakersResponse)(nil)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x fastReflection_MsgResetCircuitBreakersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgResetCircuitBreakersResponse)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x fastReflection_MsgResetCircuitBreakersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResetCircuitBreakersResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x *fastReflection_MsgResetCircuitBreakersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResetCircuitBreakersResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParamsResponse_messageType
+func (x *fastReflection_MsgResetCircuitBreakersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgResetCircuitBreakersResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x *fastReflection_MsgResetCircuitBreakersResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgResetCircuitBreakersResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParamsResponse)(x)
+func (x *fastReflection_MsgResetCircuitBreakersResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgResetCircuitBreakersResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3319,7 +6021,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3333,13 +6035,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakersResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3349,13 +6051,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakersResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3365,13 +6067,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakersResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakersResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3385,13 +6087,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakersResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3405,36 +6107,36 @@ func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakersResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.MsgResetCircuitBreakersResponse"))
 		}
-		panic(fmt.Errorf("message band.feeds.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.feeds.v1beta1.MsgResetCircuitBreakersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgUpdateParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.MsgResetCircuitBreakersResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3442,7 +6144,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3453,7 +6155,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3465,7 +6167,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3475,9 +6177,9 @@ func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgResetCircuitBreakersResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgResetCircuitBreakersResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3499,7 +6201,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgResetCircuitBreakersResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3529,7 +6231,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgResetCircuitBreakersResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3561,10 +6263,10 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResetCircuitBreakersResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResetCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -3688,6 +6390,88 @@ func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
 	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{1}
 }
 
+// MsgDelegateVotingPower is the transaction message to delegate feeds voting power to another address.
+type MsgDelegateVotingPower struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator is the address of the delegator that wants to delegate its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegatee is the address that votes with the delegated voting power.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// power is the voting power to delegate. Zero removes the delegation of the delegator.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (x *MsgDelegateVotingPower) Reset() {
+	*x = MsgDelegateVotingPower{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDelegateVotingPower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDelegateVotingPower) ProtoMessage() {}
+
+// Deprecated: Use MsgDelegateVotingPower.ProtoReflect.Descriptor instead.
+func (*MsgDelegateVotingPower) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgDelegateVotingPower) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *MsgDelegateVotingPower) GetDelegatee() string {
+	if x != nil {
+		return x.Delegatee
+	}
+	return ""
+}
+
+func (x *MsgDelegateVotingPower) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+// MsgDelegateVotingPowerResponse is the response type for the Msg/DelegateVotingPower RPC method.
+type MsgDelegateVotingPowerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgDelegateVotingPowerResponse) Reset() {
+	*x = MsgDelegateVotingPowerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDelegateVotingPowerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDelegateVotingPowerResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgDelegateVotingPowerResponse.ProtoReflect.Descriptor instead.
+func (*MsgDelegateVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{3}
+}
+
 // MsgSubmitSignalPrices is the transaction message to submit multiple signal prices.
 type MsgSubmitSignalPrices struct {
 	state         protoimpl.MessageState
@@ -3705,7 +6489,7 @@ type MsgSubmitSignalPrices struct {
 func (x *MsgSubmitSignalPrices) Reset() {
 	*x = MsgSubmitSignalPrices{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[2]
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3719,7 +6503,7 @@ func (*MsgSubmitSignalPrices) ProtoMessage() {}
 
 // Deprecated: Use MsgSubmitSignalPrices.ProtoReflect.Descriptor instead.
 func (*MsgSubmitSignalPrices) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{2}
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MsgSubmitSignalPrices) GetValidator() string {
@@ -3753,7 +6537,7 @@ type MsgSubmitSignalPricesResponse struct {
 func (x *MsgSubmitSignalPricesResponse) Reset() {
 	*x = MsgSubmitSignalPricesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[3]
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3767,7 +6551,7 @@ func (*MsgSubmitSignalPricesResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgSubmitSignalPricesResponse.ProtoReflect.Descriptor instead.
 func (*MsgSubmitSignalPricesResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{3}
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{5}
 }
 
 // MsgUpdateReferenceSourceConfig is the transaction message to update reference price source's configuration.
@@ -3785,7 +6569,7 @@ type MsgUpdateReferenceSourceConfig struct {
 func (x *MsgUpdateReferenceSourceConfig) Reset() {
 	*x = MsgUpdateReferenceSourceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[4]
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3799,7 +6583,7 @@ func (*MsgUpdateReferenceSourceConfig) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateReferenceSourceConfig.ProtoReflect.Descriptor instead.
 func (*MsgUpdateReferenceSourceConfig) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{4}
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MsgUpdateReferenceSourceConfig) GetAdmin() string {
@@ -3826,7 +6610,7 @@ type MsgUpdateReferenceSourceConfigResponse struct {
 func (x *MsgUpdateReferenceSourceConfigResponse) Reset() {
 	*x = MsgUpdateReferenceSourceConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[5]
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3840,7 +6624,7 @@ func (*MsgUpdateReferenceSourceConfigResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateReferenceSourceConfigResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateReferenceSourceConfigResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{5}
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{7}
 }
 
 // MsgUpdateParams is the transaction message to update parameters.
@@ -3858,7 +6642,7 @@ type MsgUpdateParams struct {
 func (x *MsgUpdateParams) Reset() {
 	*x = MsgUpdateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[6]
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3872,7 +6656,7 @@ func (*MsgUpdateParams) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{6}
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MsgUpdateParams) GetAuthority() string {
@@ -3899,7 +6683,7 @@ type MsgUpdateParamsResponse struct {
 func (x *MsgUpdateParamsResponse) Reset() {
 	*x = MsgUpdateParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[7]
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3913,7 +6697,153 @@ func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{7}
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+// MsgUpdateSignalMetadata is the transaction message to add or update signal ids in the signal registry.
+type MsgUpdateSignalMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// signal_metadata is a list of metadata of the signal ids to add or update.
+	SignalMetadata []*SignalMetadata `protobuf:"bytes,2,rep,name=signal_metadata,json=signalMetadata,proto3" json:"signal_metadata,omitempty"`
+}
+
+func (x *MsgUpdateSignalMetadata) Reset() {
+	*x = MsgUpdateSignalMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateSignalMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateSignalMetadata) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateSignalMetadata.ProtoReflect.Descriptor instead.
+func (*MsgUpdateSignalMetadata) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgUpdateSignalMetadata) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateSignalMetadata) GetSignalMetadata() []*SignalMetadata {
+	if x != nil {
+		return x.SignalMetadata
+	}
+	return nil
+}
+
+// MsgUpdateSignalMetadataResponse is the response type for the Msg/UpdateSignalMetadata RPC method.
+type MsgUpdateSignalMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateSignalMetadataResponse) Reset() {
+	*x = MsgUpdateSignalMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateSignalMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateSignalMetadataResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateSignalMetadataResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateSignalMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// MsgResetCircuitBreakers is the transaction message to reset the triggered circuit breakers of signal ids.
+type MsgResetCircuitBreakers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signer is the address of the admin or the governance account.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// signal_ids is a list of signal ids whose circuit breakers are reset.
+	SignalIds []string `protobuf:"bytes,2,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+}
+
+func (x *MsgResetCircuitBreakers) Reset() {
+	*x = MsgResetCircuitBreakers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgResetCircuitBreakers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgResetCircuitBreakers) ProtoMessage() {}
+
+// Deprecated: Use MsgResetCircuitBreakers.ProtoReflect.Descriptor instead.
+func (*MsgResetCircuitBreakers) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgResetCircuitBreakers) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgResetCircuitBreakers) GetSignalIds() []string {
+	if x != nil {
+		return x.SignalIds
+	}
+	return nil
+}
+
+// MsgResetCircuitBreakersResponse is the response type for the Msg/ResetCircuitBreakers RPC method.
+type MsgResetCircuitBreakersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgResetCircuitBreakersResponse) Reset() {
+	*x = MsgResetCircuitBreakersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgResetCircuitBreakersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgResetCircuitBreakersResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgResetCircuitBreakersResponse.ProtoReflect.Descriptor instead.
+func (*MsgResetCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_tx_proto_rawDescGZIP(), []int{13}
 }
 
 var File_band_feeds_v1beta1_tx_proto protoreflect.FileDescriptor
@@ -3942,96 +6872,163 @@ var file_band_feeds_v1beta1_tx_proto_rawDesc = []byte{
 	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x3a, 0x1c, 0x82, 0xe7, 0xb0, 0x2a, 0x05, 0x76, 0x6f,
 	0x74, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x0d, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x4d, 0x73,
 	0x67, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf2, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x4a, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0c, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x2e, 0x82,
-	0xe7, 0xb0, 0x2a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x1b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x1f, 0x0a,
-	0x1d, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xee,
-	0x01, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x12, 0x67, 0x0a, 0x17, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x15, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a,
-	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x24, 0x66, 0x65, 0x65, 0x64, 0x73,
-	0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x28, 0x0a, 0x26, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x16, 0x4d, 0x73, 0x67,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x3a, 0x2f, 0x82, 0xe7, 0xb0, 0x2a, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73,
+	0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf2, 0x01, 0x0a,
+	0x15, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x4a, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x3a, 0x2e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x67, 0x0a, 0x17, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x65,
 	0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x0f, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a,
-	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x33,
+	0x82, 0xe7, 0xb0, 0x2a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x24, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x22, 0x28, 0x0a, 0x26, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xad, 0x01,
+	0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x3a, 0x28, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a,
+	0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x0f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0e, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a,
+	0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x1d, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73,
+	0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a,
-	0x28, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x15, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbc, 0x03, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x48, 0x0a, 0x04,
-	0x56, 0x6f, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74,
-	0x65, 0x1a, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x62,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x49, 0x44, 0x73, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73,
+	0x3a, 0x2d, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x1d, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x22,
+	0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xa7, 0x06, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x48, 0x0a, 0x04, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x1a,
+	0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x1a, 0x32, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x12, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x31, 0x2e, 0x62,
 	0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
 	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x31, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
-	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x1b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3a,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
-	0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8d, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x32, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x1a, 0x3a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x60, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7,
-	0xb0, 0x2a, 0x01, 0x42, 0xd1, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x07,
-	0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2,
-	0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x42, 0x61, 0x6e,
-	0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2,
-	0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x78, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x33, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x14, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73,
+	0x1a, 0x33, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xd1, 0x01, 0x0a,
+	0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02,
+	0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73,
+	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c,
+	0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64,
+	0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4046,39 +7043,53 @@ func file_band_feeds_v1beta1_tx_proto_rawDescGZIP() []byte {
 	return file_band_feeds_v1beta1_tx_proto_rawDescData
 }
 
-var file_band_feeds_v1beta1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_band_feeds_v1beta1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_band_feeds_v1beta1_tx_proto_goTypes = []interface{}{
 	(*MsgVote)(nil),                                // 0: band.feeds.v1beta1.MsgVote
 	(*MsgVoteResponse)(nil),                        // 1: band.feeds.v1beta1.MsgVoteResponse
-	(*MsgSubmitSignalPrices)(nil),                  // 2: band.feeds.v1beta1.MsgSubmitSignalPrices
-	(*MsgSubmitSignalPricesResponse)(nil),          // 3: band.feeds.v1beta1.MsgSubmitSignalPricesResponse
-	(*MsgUpdateReferenceSourceConfig)(nil),         // 4: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig
-	(*MsgUpdateReferenceSourceConfigResponse)(nil), // 5: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse
-	(*MsgUpdateParams)(nil),                        // 6: band.feeds.v1beta1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),                // 7: band.feeds.v1beta1.MsgUpdateParamsResponse
-	(*Signal)(nil),                                 // 8: band.feeds.v1beta1.Signal
-	(*SignalPrice)(nil),                            // 9: band.feeds.v1beta1.SignalPrice
-	(*ReferenceSourceConfig)(nil),                  // 10: band.feeds.v1beta1.ReferenceSourceConfig
-	(*Params)(nil),                                 // 11: band.feeds.v1beta1.Params
+	(*MsgDelegateVotingPower)(nil),                 // 2: band.feeds.v1beta1.MsgDelegateVotingPower
+	(*MsgDelegateVotingPowerResponse)(nil),         // 3: band.feeds.v1beta1.MsgDelegateVotingPowerResponse
+	(*MsgSubmitSignalPrices)(nil),                  // 4: band.feeds.v1beta1.MsgSubmitSignalPrices
+	(*MsgSubmitSignalPricesResponse)(nil),          // 5: band.feeds.v1beta1.MsgSubmitSignalPricesResponse
+	(*MsgUpdateReferenceSourceConfig)(nil),         // 6: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig
+	(*MsgUpdateReferenceSourceConfigResponse)(nil), // 7: band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse
+	(*MsgUpdateParams)(nil),                        // 8: band.feeds.v1beta1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),                // 9: band.feeds.v1beta1.MsgUpdateParamsResponse
+	(*MsgUpdateSignalMetadata)(nil),                // 10: band.feeds.v1beta1.MsgUpdateSignalMetadata
+	(*MsgUpdateSignalMetadataResponse)(nil),        // 11: band.feeds.v1beta1.MsgUpdateSignalMetadataResponse
+	(*MsgResetCircuitBreakers)(nil),                // 12: band.feeds.v1beta1.MsgResetCircuitBreakers
+	(*MsgResetCircuitBreakersResponse)(nil),        // 13: band.feeds.v1beta1.MsgResetCircuitBreakersResponse
+	(*Signal)(nil),                                 // 14: band.feeds.v1beta1.Signal
+	(*SignalPrice)(nil),                            // 15: band.feeds.v1beta1.SignalPrice
+	(*ReferenceSourceConfig)(nil),                  // 16: band.feeds.v1beta1.ReferenceSourceConfig
+	(*Params)(nil),                                 // 17: band.feeds.v1beta1.Params
+	(*SignalMetadata)(nil),                         // 18: band.feeds.v1beta1.SignalMetadata
 }
 var file_band_feeds_v1beta1_tx_proto_depIdxs = []int32{
-	8,  // 0: band.feeds.v1beta1.MsgVote.signals:type_name -> band.feeds.v1beta1.Signal
-	9,  // 1: band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices:type_name -> band.feeds.v1beta1.SignalPrice
-	10, // 2: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config:type_name -> band.feeds.v1beta1.ReferenceSourceConfig
-	11, // 3: band.feeds.v1beta1.MsgUpdateParams.params:type_name -> band.feeds.v1beta1.Params
-	0,  // 4: band.feeds.v1beta1.Msg.Vote:input_type -> band.feeds.v1beta1.MsgVote
-	2,  // 5: band.feeds.v1beta1.Msg.SubmitSignalPrices:input_type -> band.feeds.v1beta1.MsgSubmitSignalPrices
-	4,  // 6: band.feeds.v1beta1.Msg.UpdateReferenceSourceConfig:input_type -> band.feeds.v1beta1.MsgUpdateReferenceSourceConfig
-	6,  // 7: band.feeds.v1beta1.Msg.UpdateParams:input_type -> band.feeds.v1beta1.MsgUpdateParams
-	1,  // 8: band.feeds.v1beta1.Msg.Vote:output_type -> band.feeds.v1beta1.MsgVoteResponse
-	3,  // 9: band.feeds.v1beta1.Msg.SubmitSignalPrices:output_type -> band.feeds.v1beta1.MsgSubmitSignalPricesResponse
-	5,  // 10: band.feeds.v1beta1.Msg.UpdateReferenceSourceConfig:output_type -> band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse
-	7,  // 11: band.feeds.v1beta1.Msg.UpdateParams:output_type -> band.feeds.v1beta1.MsgUpdateParamsResponse
-	8,  // [8:12] is the sub-list for method output_type
-	4,  // [4:8] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	14, // 0: band.feeds.v1beta1.MsgVote.signals:type_name -> band.feeds.v1beta1.Signal
+	15, // 1: band.feeds.v1beta1.MsgSubmitSignalPrices.signal_prices:type_name -> band.feeds.v1beta1.SignalPrice
+	16, // 2: band.feeds.v1beta1.MsgUpdateReferenceSourceConfig.reference_source_config:type_name -> band.feeds.v1beta1.ReferenceSourceConfig
+	17, // 3: band.feeds.v1beta1.MsgUpdateParams.params:type_name -> band.feeds.v1beta1.Params
+	18, // 4: band.feeds.v1beta1.MsgUpdateSignalMetadata.signal_metadata:type_name -> band.feeds.v1beta1.SignalMetadata
+	0,  // 5: band.feeds.v1beta1.Msg.Vote:input_type -> band.feeds.v1beta1.MsgVote
+	2,  // 6: band.feeds.v1beta1.Msg.DelegateVotingPower:input_type -> band.feeds.v1beta1.MsgDelegateVotingPower
+	4,  // 7: band.feeds.v1beta1.Msg.SubmitSignalPrices:input_type -> band.feeds.v1beta1.MsgSubmitSignalPrices
+	6,  // 8: band.feeds.v1beta1.Msg.UpdateReferenceSourceConfig:input_type -> band.feeds.v1beta1.MsgUpdateReferenceSourceConfig
+	8,  // 9: band.feeds.v1beta1.Msg.UpdateParams:input_type -> band.feeds.v1beta1.MsgUpdateParams
+	10, // 10: band.feeds.v1beta1.Msg.UpdateSignalMetadata:input_type -> band.feeds.v1beta1.MsgUpdateSignalMetadata
+	12, // 11: band.feeds.v1beta1.Msg.ResetCircuitBreakers:input_type -> band.feeds.v1beta1.MsgResetCircuitBreakers
+	1,  // 12: band.feeds.v1beta1.Msg.Vote:output_type -> band.feeds.v1beta1.MsgVoteResponse
+	3,  // 13: band.feeds.v1beta1.Msg.DelegateVotingPower:output_type -> band.feeds.v1beta1.MsgDelegateVotingPowerResponse
+	5,  // 14: band.feeds.v1beta1.Msg.SubmitSignalPrices:output_type -> band.feeds.v1beta1.MsgSubmitSignalPricesResponse
+	7,  // 15: band.feeds.v1beta1.Msg.UpdateReferenceSourceConfig:output_type -> band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse
+	9,  // 16: band.feeds.v1beta1.Msg.UpdateParams:output_type -> band.feeds.v1beta1.MsgUpdateParamsResponse
+	11, // 17: band.feeds.v1beta1.Msg.UpdateSignalMetadata:output_type -> band.feeds.v1beta1.MsgUpdateSignalMetadataResponse
+	13, // 18: band.feeds.v1beta1.Msg.ResetCircuitBreakers:output_type -> band.feeds.v1beta1.MsgResetCircuitBreakersResponse
+	12, // [12:19] is the sub-list for method output_type
+	5,  // [5:12] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_band_feeds_v1beta1_tx_proto_init() }
@@ -4114,7 +7125,7 @@ func file_band_feeds_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitSignalPrices); i {
+			switch v := v.(*MsgDelegateVotingPower); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4126,7 +7137,7 @@ func file_band_feeds_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitSignalPricesResponse); i {
+			switch v := v.(*MsgDelegateVotingPowerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4138,7 +7149,7 @@ func file_band_feeds_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateReferenceSourceConfig); i {
+			switch v := v.(*MsgSubmitSignalPrices); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4150,7 +7161,7 @@ func file_band_feeds_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateReferenceSourceConfigResponse); i {
+			switch v := v.(*MsgSubmitSignalPricesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4162,7 +7173,7 @@ func file_band_feeds_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParams); i {
+			switch v := v.(*MsgUpdateReferenceSourceConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4174,6 +7185,30 @@ func file_band_feeds_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateReferenceSourceConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUpdateParamsResponse); i {
 			case 0:
 				return &v.state
@@ -4185,6 +7220,54 @@ func file_band_feeds_v1beta1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_band_feeds_v1beta1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateSignalMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateSignalMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgResetCircuitBreakers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgResetCircuitBreakersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4192,7 +7275,7 @@ func file_band_feeds_v1beta1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_feeds_v1beta1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,9 +20,12 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Msg_Vote_FullMethodName                        = "/band.feeds.v1beta1.Msg/Vote"
+	Msg_DelegateVotingPower_FullMethodName         = "/band.feeds.v1beta1.Msg/DelegateVotingPower"
 	Msg_SubmitSignalPrices_FullMethodName          = "/band.feeds.v1beta1.Msg/SubmitSignalPrices"
 	Msg_UpdateReferenceSourceConfig_FullMethodName = "/band.feeds.v1beta1.Msg/UpdateReferenceSourceConfig"
 	Msg_UpdateParams_FullMethodName                = "/band.feeds.v1beta1.Msg/UpdateParams"
+	Msg_UpdateSignalMetadata_FullMethodName        = "/band.feeds.v1beta1.Msg/UpdateSignalMetadata"
+	Msg_ResetCircuitBreakers_FullMethodName        = "/band.feeds.v1beta1.Msg/ResetCircuitBreakers"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,12 +34,18 @@ const (
 type MsgClient interface {
 	// Vote is an RPC method to vote signal ids and their powers.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// DelegateVotingPower is an RPC method to delegate feeds voting power to another address.
+	DelegateVotingPower(ctx context.Context, in *MsgDelegateVotingPower, opts ...grpc.CallOption) (*MsgDelegateVotingPowerResponse, error)
 	// SubmitSignalPrices is an RPC method to submit signal prices.
 	SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalPrices, opts ...grpc.CallOption) (*MsgSubmitSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpdateReferenceSourceConfig, opts ...grpc.CallOption) (*MsgUpdateReferenceSourceConfigResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateSignalMetadata is an RPC method to add or update signal ids in the signal registry.
+	UpdateSignalMetadata(ctx context.Context, in *MsgUpdateSignalMetadata, opts ...grpc.CallOption) (*MsgUpdateSignalMetadataResponse, error)
+	// ResetCircuitBreakers is an RPC method to reset the triggered circuit breakers of signal ids.
+	ResetCircuitBreakers(ctx context.Context, in *MsgResetCircuitBreakers, opts ...grpc.CallOption) (*MsgResetCircuitBreakersResponse, error)
 }
 
 type msgClient struct {
@@ -56,6 +65,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) DelegateVotingPower(ctx context.Context, in *MsgDelegateVotingPower, opts ...grpc.CallOption) (*MsgDelegateVotingPowerResponse, error) {
+	out := new(MsgDelegateVotingPowerResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateVotingPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalPrices, opts ...grpc.CallOption) (*MsgSubmitSignalPricesResponse, error) {
 	out := new(MsgSubmitSignalPricesResponse)
 	err := c.cc.Invoke(ctx, Msg_SubmitSignalPrices_FullMethodName, in, out, opts...)
@@ -83,18 +101,42 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateSignalMetadata(ctx context.Context, in *MsgUpdateSignalMetadata, opts ...grpc.CallOption) (*MsgUpdateSignalMetadataResponse, error) {
+	out := new(MsgUpdateSignalMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateSignalMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetCircuitBreakers(ctx context.Context, in *MsgResetCircuitBreakers, opts ...grpc.CallOption) (*MsgResetCircuitBreakersResponse, error) {
+	out := new(MsgResetCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, Msg_ResetCircuitBreakers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// Vote is an RPC method to vote signal ids and their powers.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// DelegateVotingPower is an RPC method to delegate feeds voting power to another address.
+	DelegateVotingPower(context.Context, *MsgDelegateVotingPower) (*MsgDelegateVotingPowerResponse, error)
 	// SubmitSignalPrices is an RPC method to submit signal prices.
 	SubmitSignalPrices(context.Context, *MsgSubmitSignalPrices) (*MsgSubmitSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(context.Context, *MsgUpdateReferenceSourceConfig) (*MsgUpdateReferenceSourceConfigResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateSignalMetadata is an RPC method to add or update signal ids in the signal registry.
+	UpdateSignalMetadata(context.Context, *MsgUpdateSignalMetadata) (*MsgUpdateSignalMetadataResponse, error)
+	// ResetCircuitBreakers is an RPC method to reset the triggered circuit breakers of signal ids.
+	ResetCircuitBreakers(context.Context, *MsgResetCircuitBreakers) (*MsgResetCircuitBreakersResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -105,6 +147,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Vote(context.Context, *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (UnimplementedMsgServer) DelegateVotingPower(context.Context, *MsgDelegateVotingPower) (*MsgDelegateVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVotingPower not implemented")
+}
 func (UnimplementedMsgServer) SubmitSignalPrices(context.Context, *MsgSubmitSignalPrices) (*MsgSubmitSignalPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignalPrices not implemented")
 }
@@ -114,6 +159,12 @@ func (UnimplementedMsgServer) UpdateReferenceSourceConfig(context.Context, *MsgU
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateSignalMetadata(context.Context, *MsgUpdateSignalMetadata) (*MsgUpdateSignalMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSignalMetadata not implemented")
+}
+func (UnimplementedMsgServer) ResetCircuitBreakers(context.Context, *MsgResetCircuitBreakers) (*MsgResetCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreakers not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -145,6 +196,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateVotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVotingPower)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateVotingPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVotingPower(ctx, req.(*MsgDelegateVotingPower))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitSignalPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitSignalPrices)
 	if err := dec(in); err != nil {
@@ -199,6 +268,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateSignalMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSignalMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSignalMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateSignalMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSignalMetadata(ctx, req.(*MsgUpdateSignalMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetCircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCircuitBreakers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResetCircuitBreakers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCircuitBreakers(ctx, req.(*MsgResetCircuitBreakers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -210,6 +315,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "DelegateVotingPower",
+			Handler:    _Msg_DelegateVotingPower_Handler,
+		},
 		{
 			MethodName: "SubmitSignalPrices",
 			Handler:    _Msg_SubmitSignalPrices_Handler,
@@ -222,6 +331,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateSignalMetadata",
+			Handler:    _Msg_UpdateSignalMetadata_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreakers",
+			Handler:    _Msg_ResetCircuitBreakers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/feeds/v1beta1/tx.proto",
@@ -74,6 +74,8 @@ var (
 	fd_DataSource_filename    protoreflect.FieldDescriptor
 	fd_DataSource_treasury    protoreflect.FieldDescriptor
 	fd_DataSource_fee         protoreflect.FieldDescriptor
+	fd_DataSource_cacheable   protoreflect.FieldDescriptor
+	fd_DataSource_version     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -85,6 +87,8 @@ func init() {
 	fd_DataSource_filename = md_DataSource.Fields().ByName("filename")
 	fd_DataSource_treasury = md_DataSource.Fields().ByName("treasury")
 	fd_DataSource_fee = md_DataSource.Fields().ByName("fee")
+	fd_DataSource_cacheable = md_DataSource.Fields().ByName("cacheable")
+	fd_DataSource_version = md_DataSource.Fields().ByName("version")
 }
 
 var _ protoreflect.Message = (*fastReflection_DataSource)(nil)
@@ -188,6 +192,18 @@ func (x *fastReflection_DataSource) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
+	if x.Cacheable != false {
+		value := protoreflect.ValueOfBool(x.Cacheable)
+		if !f(fd_DataSource_cacheable, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_DataSource_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -215,6 +231,10 @@ func (x *fastReflection_DataSource) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Treasury != ""
 	case "band.oracle.v1.DataSource.fee":
 		return len(x.Fee) != 0
+	case "band.oracle.v1.DataSource.cacheable":
+		return x.Cacheable != false
+	case "band.oracle.v1.DataSource.version":
+		return x.Version != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -243,6 +263,10 @@ func (x *fastReflection_DataSource) Clear(fd protoreflect.FieldDescriptor) {
 		x.Treasury = ""
 	case "band.oracle.v1.DataSource.fee":
 		x.Fee = nil
+	case "band.oracle.v1.DataSource.cacheable":
+		x.Cacheable = false
+	case "band.oracle.v1.DataSource.version":
+		x.Version = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -280,6 +304,12 @@ func (x *fastReflection_DataSource) Get(descriptor protoreflect.FieldDescriptor)
 		}
 		listValue := &_DataSource_6_list{list: &x.Fee}
 		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.DataSource.cacheable":
+		value := x.Cacheable
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.DataSource.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -314,6 +344,10 @@ func (x *fastReflection_DataSource) Set(fd protoreflect.FieldDescriptor, value p
 		lv := value.List()
 		clv := lv.(*_DataSource_6_list)
 		x.Fee = *clv.list
+	case "band.oracle.v1.DataSource.cacheable":
+		x.Cacheable = value.Bool()
+	case "band.oracle.v1.DataSource.version":
+		x.Version = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -350,6 +384,10 @@ func (x *fastReflection_DataSource) Mutable(fd protoreflect.FieldDescriptor) pro
 		panic(fmt.Errorf("field filename of message band.oracle.v1.DataSource is not mutable"))
 	case "band.oracle.v1.DataSource.treasury":
 		panic(fmt.Errorf("field treasury of message band.oracle.v1.DataSource is not mutable"))
+	case "band.oracle.v1.DataSource.cacheable":
+		panic(fmt.Errorf("field cacheable of message band.oracle.v1.DataSource is not mutable"))
+	case "band.oracle.v1.DataSource.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.DataSource is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -376,6 +414,10 @@ func (x *fastReflection_DataSource) NewField(fd protoreflect.FieldDescriptor) pr
 	case "band.oracle.v1.DataSource.fee":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_DataSource_6_list{list: &list})
+	case "band.oracle.v1.DataSource.cacheable":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.DataSource.version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -471,6 +513,12 @@ func (x *fastReflection_DataSource) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Cacheable {
+			n += 2
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -500,6 +548,21 @@ func (x *fastReflection_DataSource) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Cacheable {
+			i--
+			if x.Cacheable {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
 		if len(x.Fee) > 0 {
 			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Fee[iNdEx])
@@ -794,6 +857,45 @@ func (x *fastReflection_DataSource) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cacheable", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Cacheable = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -830,35 +932,29 @@ func (x *fastReflection_DataSource) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_OracleScript                 protoreflect.MessageDescriptor
-	fd_OracleScript_owner           protoreflect.FieldDescriptor
-	fd_OracleScript_name            protoreflect.FieldDescriptor
-	fd_OracleScript_description     protoreflect.FieldDescriptor
-	fd_OracleScript_filename        protoreflect.FieldDescriptor
-	fd_OracleScript_schema          protoreflect.FieldDescriptor
-	fd_OracleScript_source_code_url protoreflect.FieldDescriptor
+	md_DataSourceVersion                protoreflect.MessageDescriptor
+	fd_DataSourceVersion_data_source_id protoreflect.FieldDescriptor
+	fd_DataSourceVersion_version        protoreflect.FieldDescriptor
+	fd_DataSourceVersion_filename       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_OracleScript = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleScript")
-	fd_OracleScript_owner = md_OracleScript.Fields().ByName("owner")
-	fd_OracleScript_name = md_OracleScript.Fields().ByName("name")
-	fd_OracleScript_description = md_OracleScript.Fields().ByName("description")
-	fd_OracleScript_filename = md_OracleScript.Fields().ByName("filename")
-	fd_OracleScript_schema = md_OracleScript.Fields().ByName("schema")
-	fd_OracleScript_source_code_url = md_OracleScript.Fields().ByName("source_code_url")
+	md_DataSourceVersion = File_band_oracle_v1_oracle_proto.Messages().ByName("DataSourceVersion")
+	fd_DataSourceVersion_data_source_id = md_DataSourceVersion.Fields().ByName("data_source_id")
+	fd_DataSourceVersion_version = md_DataSourceVersion.Fields().ByName("version")
+	fd_DataSourceVersion_filename = md_DataSourceVersion.Fields().ByName("filename")
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleScript)(nil)
+var _ protoreflect.Message = (*fastReflection_DataSourceVersion)(nil)
 
-type fastReflection_OracleScript OracleScript
+type fastReflection_DataSourceVersion DataSourceVersion
 
-func (x *OracleScript) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleScript)(x)
+func (x *DataSourceVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DataSourceVersion)(x)
 }
 
-func (x *OracleScript) slowProtoReflect() protoreflect.Message {
+func (x *DataSourceVersion) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -870,43 +966,43 @@ func (x *OracleScript) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleScript_messageType fastReflection_OracleScript_messageType
-var _ protoreflect.MessageType = fastReflection_OracleScript_messageType{}
+var _fastReflection_DataSourceVersion_messageType fastReflection_DataSourceVersion_messageType
+var _ protoreflect.MessageType = fastReflection_DataSourceVersion_messageType{}
 
-type fastReflection_OracleScript_messageType struct{}
+type fastReflection_DataSourceVersion_messageType struct{}
 
-func (x fastReflection_OracleScript_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleScript)(nil)
+func (x fastReflection_DataSourceVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DataSourceVersion)(nil)
 }
-func (x fastReflection_OracleScript_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleScript)
+func (x fastReflection_DataSourceVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_DataSourceVersion)
 }
-func (x fastReflection_OracleScript_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleScript
+func (x fastReflection_DataSourceVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSourceVersion
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleScript) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleScript
+func (x *fastReflection_DataSourceVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSourceVersion
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleScript) Type() protoreflect.MessageType {
-	return _fastReflection_OracleScript_messageType
+func (x *fastReflection_DataSourceVersion) Type() protoreflect.MessageType {
+	return _fastReflection_DataSourceVersion_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleScript) New() protoreflect.Message {
-	return new(fastReflection_OracleScript)
+func (x *fastReflection_DataSourceVersion) New() protoreflect.Message {
+	return new(fastReflection_DataSourceVersion)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleScript) Interface() protoreflect.ProtoMessage {
-	return (*OracleScript)(x)
+func (x *fastReflection_DataSourceVersion) Interface() protoreflect.ProtoMessage {
+	return (*DataSourceVersion)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -914,40 +1010,22 @@ func (x *fastReflection_OracleScript) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleScript) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Owner != "" {
-		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_OracleScript_owner, value) {
-			return
-		}
-	}
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_OracleScript_name, value) {
+func (x *fastReflection_DataSourceVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_DataSourceVersion_data_source_id, value) {
 			return
 		}
 	}
-	if x.Description != "" {
-		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_OracleScript_description, value) {
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_DataSourceVersion_version, value) {
 			return
 		}
 	}
 	if x.Filename != "" {
 		value := protoreflect.ValueOfString(x.Filename)
-		if !f(fd_OracleScript_filename, value) {
-			return
-		}
-	}
-	if x.Schema != "" {
-		value := protoreflect.ValueOfString(x.Schema)
-		if !f(fd_OracleScript_schema, value) {
-			return
-		}
-	}
-	if x.SourceCodeUrl != "" {
-		value := protoreflect.ValueOfString(x.SourceCodeUrl)
-		if !f(fd_OracleScript_source_code_url, value) {
+		if !f(fd_DataSourceVersion_filename, value) {
 			return
 		}
 	}
@@ -964,25 +1042,19 @@ func (x *fastReflection_OracleScript) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleScript) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DataSourceVersion) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleScript.owner":
-		return x.Owner != ""
-	case "band.oracle.v1.OracleScript.name":
-		return x.Name != ""
-	case "band.oracle.v1.OracleScript.description":
-		return x.Description != ""
-	case "band.oracle.v1.OracleScript.filename":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.DataSourceVersion.version":
+		return x.Version != uint64(0)
+	case "band.oracle.v1.DataSourceVersion.filename":
 		return x.Filename != ""
-	case "band.oracle.v1.OracleScript.schema":
-		return x.Schema != ""
-	case "band.oracle.v1.OracleScript.source_code_url":
-		return x.SourceCodeUrl != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -992,25 +1064,19 @@ func (x *fastReflection_OracleScript) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleScript) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DataSourceVersion) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleScript.owner":
-		x.Owner = ""
-	case "band.oracle.v1.OracleScript.name":
-		x.Name = ""
-	case "band.oracle.v1.OracleScript.description":
-		x.Description = ""
-	case "band.oracle.v1.OracleScript.filename":
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.DataSourceVersion.version":
+		x.Version = uint64(0)
+	case "band.oracle.v1.DataSourceVersion.filename":
 		x.Filename = ""
-	case "band.oracle.v1.OracleScript.schema":
-		x.Schema = ""
-	case "band.oracle.v1.OracleScript.source_code_url":
-		x.SourceCodeUrl = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1020,31 +1086,1341 @@ func (x *fastReflection_OracleScript) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleScript) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DataSourceVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.DataSourceVersion.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		value := x.Filename
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DataSourceVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.DataSourceVersion.version":
+		x.Version = value.Uint()
+	case "band.oracle.v1.DataSourceVersion.filename":
+		x.Filename = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DataSourceVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.filename":
+		panic(fmt.Errorf("field filename of message band.oracle.v1.DataSourceVersion is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DataSourceVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.DataSourceVersion.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.DataSourceVersion.filename":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DataSourceVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.DataSourceVersion", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DataSourceVersion) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DataSourceVersion) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DataSourceVersion) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DataSourceVersion) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DataSourceVersion)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.Filename)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DataSourceVersion)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Filename) > 0 {
+			i -= len(x.Filename)
+			copy(dAtA[i:], x.Filename)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Filename)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DataSourceVersion)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSourceVersion: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSourceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Filename = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OracleScript                 protoreflect.MessageDescriptor
+	fd_OracleScript_owner           protoreflect.FieldDescriptor
+	fd_OracleScript_name            protoreflect.FieldDescriptor
+	fd_OracleScript_description     protoreflect.FieldDescriptor
+	fd_OracleScript_filename        protoreflect.FieldDescriptor
+	fd_OracleScript_schema          protoreflect.FieldDescriptor
+	fd_OracleScript_source_code_url protoreflect.FieldDescriptor
+	fd_OracleScript_version         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_OracleScript = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleScript")
+	fd_OracleScript_owner = md_OracleScript.Fields().ByName("owner")
+	fd_OracleScript_name = md_OracleScript.Fields().ByName("name")
+	fd_OracleScript_description = md_OracleScript.Fields().ByName("description")
+	fd_OracleScript_filename = md_OracleScript.Fields().ByName("filename")
+	fd_OracleScript_schema = md_OracleScript.Fields().ByName("schema")
+	fd_OracleScript_source_code_url = md_OracleScript.Fields().ByName("source_code_url")
+	fd_OracleScript_version = md_OracleScript.Fields().ByName("version")
+}
+
+var _ protoreflect.Message = (*fastReflection_OracleScript)(nil)
+
+type fastReflection_OracleScript OracleScript
+
+func (x *OracleScript) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleScript)(x)
+}
+
+func (x *OracleScript) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OracleScript_messageType fastReflection_OracleScript_messageType
+var _ protoreflect.MessageType = fastReflection_OracleScript_messageType{}
+
+type fastReflection_OracleScript_messageType struct{}
+
+func (x fastReflection_OracleScript_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleScript)(nil)
+}
+func (x fastReflection_OracleScript_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleScript)
+}
+func (x fastReflection_OracleScript_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleScript
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OracleScript) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleScript
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OracleScript) Type() protoreflect.MessageType {
+	return _fastReflection_OracleScript_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OracleScript) New() protoreflect.Message {
+	return new(fastReflection_OracleScript)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OracleScript) Interface() protoreflect.ProtoMessage {
+	return (*OracleScript)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleScript) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_OracleScript_owner, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_OracleScript_name, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_OracleScript_description, value) {
+			return
+		}
+	}
+	if x.Filename != "" {
+		value := protoreflect.ValueOfString(x.Filename)
+		if !f(fd_OracleScript_filename, value) {
+			return
+		}
+	}
+	if x.Schema != "" {
+		value := protoreflect.ValueOfString(x.Schema)
+		if !f(fd_OracleScript_schema, value) {
+			return
+		}
+	}
+	if x.SourceCodeUrl != "" {
+		value := protoreflect.ValueOfString(x.SourceCodeUrl)
+		if !f(fd_OracleScript_source_code_url, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_OracleScript_version, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OracleScript) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleScript.owner":
+		return x.Owner != ""
+	case "band.oracle.v1.OracleScript.name":
+		return x.Name != ""
+	case "band.oracle.v1.OracleScript.description":
+		return x.Description != ""
+	case "band.oracle.v1.OracleScript.filename":
+		return x.Filename != ""
+	case "band.oracle.v1.OracleScript.schema":
+		return x.Schema != ""
+	case "band.oracle.v1.OracleScript.source_code_url":
+		return x.SourceCodeUrl != ""
+	case "band.oracle.v1.OracleScript.version":
+		return x.Version != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleScript) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleScript.owner":
+		x.Owner = ""
+	case "band.oracle.v1.OracleScript.name":
+		x.Name = ""
+	case "band.oracle.v1.OracleScript.description":
+		x.Description = ""
+	case "band.oracle.v1.OracleScript.filename":
+		x.Filename = ""
+	case "band.oracle.v1.OracleScript.schema":
+		x.Schema = ""
+	case "band.oracle.v1.OracleScript.source_code_url":
+		x.SourceCodeUrl = ""
+	case "band.oracle.v1.OracleScript.version":
+		x.Version = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleScript) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.OracleScript.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScript.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScript.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScript.filename":
+		value := x.Filename
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScript.schema":
+		value := x.Schema
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScript.source_code_url":
+		value := x.SourceCodeUrl
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScript.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleScript) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleScript.owner":
+		x.Owner = value.Interface().(string)
+	case "band.oracle.v1.OracleScript.name":
+		x.Name = value.Interface().(string)
+	case "band.oracle.v1.OracleScript.description":
+		x.Description = value.Interface().(string)
+	case "band.oracle.v1.OracleScript.filename":
+		x.Filename = value.Interface().(string)
+	case "band.oracle.v1.OracleScript.schema":
+		x.Schema = value.Interface().(string)
+	case "band.oracle.v1.OracleScript.source_code_url":
+		x.SourceCodeUrl = value.Interface().(string)
+	case "band.oracle.v1.OracleScript.version":
+		x.Version = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleScript) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleScript.owner":
+		panic(fmt.Errorf("field owner of message band.oracle.v1.OracleScript is not mutable"))
+	case "band.oracle.v1.OracleScript.name":
+		panic(fmt.Errorf("field name of message band.oracle.v1.OracleScript is not mutable"))
+	case "band.oracle.v1.OracleScript.description":
+		panic(fmt.Errorf("field description of message band.oracle.v1.OracleScript is not mutable"))
+	case "band.oracle.v1.OracleScript.filename":
+		panic(fmt.Errorf("field filename of message band.oracle.v1.OracleScript is not mutable"))
+	case "band.oracle.v1.OracleScript.schema":
+		panic(fmt.Errorf("field schema of message band.oracle.v1.OracleScript is not mutable"))
+	case "band.oracle.v1.OracleScript.source_code_url":
+		panic(fmt.Errorf("field source_code_url of message band.oracle.v1.OracleScript is not mutable"))
+	case "band.oracle.v1.OracleScript.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.OracleScript is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OracleScript) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleScript.owner":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScript.name":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScript.description":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScript.filename":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScript.schema":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScript.source_code_url":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScript.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OracleScript) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleScript", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OracleScript) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleScript) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OracleScript) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OracleScript)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Filename)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Schema)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SourceCodeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OracleScript)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.SourceCodeUrl) > 0 {
+			i -= len(x.SourceCodeUrl)
+			copy(dAtA[i:], x.SourceCodeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceCodeUrl)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Schema) > 0 {
+			i -= len(x.Schema)
+			copy(dAtA[i:], x.Schema)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schema)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Filename) > 0 {
+			i -= len(x.Filename)
+			copy(dAtA[i:], x.Filename)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Filename)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OracleScript)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScript: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Filename = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schema = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceCodeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceCodeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OracleScriptVersion                  protoreflect.MessageDescriptor
+	fd_OracleScriptVersion_oracle_script_id protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_version          protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_filename         protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_schema           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_OracleScriptVersion = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleScriptVersion")
+	fd_OracleScriptVersion_oracle_script_id = md_OracleScriptVersion.Fields().ByName("oracle_script_id")
+	fd_OracleScriptVersion_version = md_OracleScriptVersion.Fields().ByName("version")
+	fd_OracleScriptVersion_filename = md_OracleScriptVersion.Fields().ByName("filename")
+	fd_OracleScriptVersion_schema = md_OracleScriptVersion.Fields().ByName("schema")
+}
+
+var _ protoreflect.Message = (*fastReflection_OracleScriptVersion)(nil)
+
+type fastReflection_OracleScriptVersion OracleScriptVersion
+
+func (x *OracleScriptVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleScriptVersion)(x)
+}
+
+func (x *OracleScriptVersion) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OracleScriptVersion_messageType fastReflection_OracleScriptVersion_messageType
+var _ protoreflect.MessageType = fastReflection_OracleScriptVersion_messageType{}
+
+type fastReflection_OracleScriptVersion_messageType struct{}
+
+func (x fastReflection_OracleScriptVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleScriptVersion)(nil)
+}
+func (x fastReflection_OracleScriptVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleScriptVersion)
+}
+func (x fastReflection_OracleScriptVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleScriptVersion
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OracleScriptVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleScriptVersion
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OracleScriptVersion) Type() protoreflect.MessageType {
+	return _fastReflection_OracleScriptVersion_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OracleScriptVersion) New() protoreflect.Message {
+	return new(fastReflection_OracleScriptVersion)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OracleScriptVersion) Interface() protoreflect.ProtoMessage {
+	return (*OracleScriptVersion)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleScriptVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_OracleScriptVersion_oracle_script_id, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_OracleScriptVersion_version, value) {
+			return
+		}
+	}
+	if x.Filename != "" {
+		value := protoreflect.ValueOfString(x.Filename)
+		if !f(fd_OracleScriptVersion_filename, value) {
+			return
+		}
+	}
+	if x.Schema != "" {
+		value := protoreflect.ValueOfString(x.Schema)
+		if !f(fd_OracleScriptVersion_schema, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OracleScriptVersion) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		return x.Version != uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		return x.Filename != ""
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		return x.Schema != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleScriptVersion) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		x.Version = uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		x.Filename = ""
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		x.Schema = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleScriptVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleScript.owner":
-		value := x.Owner
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.OracleScript.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.OracleScript.description":
-		value := x.Description
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.OracleScript.filename":
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleScriptVersion.filename":
 		value := x.Filename
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.OracleScript.schema":
+	case "band.oracle.v1.OracleScriptVersion.schema":
 		value := x.Schema
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.OracleScript.source_code_url":
-		value := x.SourceCodeUrl
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1058,25 +2434,21 @@ func (x *fastReflection_OracleScript) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleScript) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OracleScriptVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleScript.owner":
-		x.Owner = value.Interface().(string)
-	case "band.oracle.v1.OracleScript.name":
-		x.Name = value.Interface().(string)
-	case "band.oracle.v1.OracleScript.description":
-		x.Description = value.Interface().(string)
-	case "band.oracle.v1.OracleScript.filename":
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.OracleScriptVersion.version":
+		x.Version = value.Uint()
+	case "band.oracle.v1.OracleScriptVersion.filename":
 		x.Filename = value.Interface().(string)
-	case "band.oracle.v1.OracleScript.schema":
+	case "band.oracle.v1.OracleScriptVersion.schema":
 		x.Schema = value.Interface().(string)
-	case "band.oracle.v1.OracleScript.source_code_url":
-		x.SourceCodeUrl = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1090,60 +2462,52 @@ func (x *fastReflection_OracleScript) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleScript) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleScriptVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleScript.owner":
-		panic(fmt.Errorf("field owner of message band.oracle.v1.OracleScript is not mutable"))
-	case "band.oracle.v1.OracleScript.name":
-		panic(fmt.Errorf("field name of message band.oracle.v1.OracleScript is not mutable"))
-	case "band.oracle.v1.OracleScript.description":
-		panic(fmt.Errorf("field description of message band.oracle.v1.OracleScript is not mutable"))
-	case "band.oracle.v1.OracleScript.filename":
-		panic(fmt.Errorf("field filename of message band.oracle.v1.OracleScript is not mutable"))
-	case "band.oracle.v1.OracleScript.schema":
-		panic(fmt.Errorf("field schema of message band.oracle.v1.OracleScript is not mutable"))
-	case "band.oracle.v1.OracleScript.source_code_url":
-		panic(fmt.Errorf("field source_code_url of message band.oracle.v1.OracleScript is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		panic(fmt.Errorf("field filename of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		panic(fmt.Errorf("field schema of message band.oracle.v1.OracleScriptVersion is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleScript) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleScriptVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleScript.owner":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.OracleScript.name":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.OracleScript.description":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.OracleScript.filename":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.OracleScript.schema":
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleScriptVersion.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleScriptVersion.filename":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.OracleScript.source_code_url":
+	case "band.oracle.v1.OracleScriptVersion.schema":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleScript) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OracleScriptVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleScript", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleScriptVersion", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1151,7 +2515,7 @@ func (x *fastReflection_OracleScript) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleScript) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OracleScriptVersion) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1162,7 +2526,7 @@ func (x *fastReflection_OracleScript) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleScript) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OracleScriptVersion) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1174,7 +2538,7 @@ func (x *fastReflection_OracleScript) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleScript) IsValid() bool {
+func (x *fastReflection_OracleScriptVersion) IsValid() bool {
 	return x != nil
 }
 
@@ -1184,9 +2548,9 @@ func (x *fastReflection_OracleScript) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OracleScriptVersion) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleScript)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1198,17 +2562,11 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Owner)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
 		}
-		l = len(x.Description)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
 		}
 		l = len(x.Filename)
 		if l > 0 {
@@ -1218,10 +2576,6 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.SourceCodeUrl)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1232,7 +2586,7 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleScript)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1251,47 +2605,29 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.SourceCodeUrl) > 0 {
-			i -= len(x.SourceCodeUrl)
-			copy(dAtA[i:], x.SourceCodeUrl)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceCodeUrl)))
-			i--
-			dAtA[i] = 0x32
-		}
 		if len(x.Schema) > 0 {
 			i -= len(x.Schema)
 			copy(dAtA[i:], x.Schema)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schema)))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x22
 		}
 		if len(x.Filename) > 0 {
 			i -= len(x.Filename)
 			copy(dAtA[i:], x.Filename)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Filename)))
 			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Description) > 0 {
-			i -= len(x.Description)
-			copy(dAtA[i:], x.Description)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
-			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Owner) > 0 {
-			i -= len(x.Owner)
-			copy(dAtA[i:], x.Owner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1304,7 +2640,7 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleScript)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1336,17 +2672,17 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScript: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScriptVersion: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScriptVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
 				}
-				var stringLen uint64
+				x.OracleScriptId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1356,29 +2692,16 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.OracleScriptId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Owner = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 				}
-				var stringLen uint64
+				x.Version = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1388,57 +2711,12 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Version |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Name = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Description = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 				}
@@ -1470,7 +2748,7 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 				}
 				x.Filename = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 4:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 				}
@@ -1502,38 +2780,6 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 				}
 				x.Schema = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceCodeUrl", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SourceCodeUrl = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1570,10 +2816,11 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RawRequest                protoreflect.MessageDescriptor
-	fd_RawRequest_external_id    protoreflect.FieldDescriptor
-	fd_RawRequest_data_source_id protoreflect.FieldDescriptor
-	fd_RawRequest_calldata       protoreflect.FieldDescriptor
+	md_RawRequest                     protoreflect.MessageDescriptor
+	fd_RawRequest_external_id         protoreflect.FieldDescriptor
+	fd_RawRequest_data_source_id      protoreflect.FieldDescriptor
+	fd_RawRequest_calldata            protoreflect.FieldDescriptor
+	fd_RawRequest_data_source_version protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1582,6 +2829,7 @@ func init() {
 	fd_RawRequest_external_id = md_RawRequest.Fields().ByName("external_id")
 	fd_RawRequest_data_source_id = md_RawRequest.Fields().ByName("data_source_id")
 	fd_RawRequest_calldata = md_RawRequest.Fields().ByName("calldata")
+	fd_RawRequest_data_source_version = md_RawRequest.Fields().ByName("data_source_version")
 }
 
 var _ protoreflect.Message = (*fastReflection_RawRequest)(nil)
@@ -1593,7 +2841,7 @@ func (x *RawRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RawRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1667,6 +2915,12 @@ func (x *fastReflection_RawRequest) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
+	if x.DataSourceVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceVersion)
+		if !f(fd_RawRequest_data_source_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1688,6 +2942,8 @@ func (x *fastReflection_RawRequest) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.DataSourceId != uint64(0)
 	case "band.oracle.v1.RawRequest.calldata":
 		return len(x.Calldata) != 0
+	case "band.oracle.v1.RawRequest.data_source_version":
+		return x.DataSourceVersion != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1710,6 +2966,8 @@ func (x *fastReflection_RawRequest) Clear(fd protoreflect.FieldDescriptor) {
 		x.DataSourceId = uint64(0)
 	case "band.oracle.v1.RawRequest.calldata":
 		x.Calldata = nil
+	case "band.oracle.v1.RawRequest.data_source_version":
+		x.DataSourceVersion = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1735,6 +2993,9 @@ func (x *fastReflection_RawRequest) Get(descriptor protoreflect.FieldDescriptor)
 	case "band.oracle.v1.RawRequest.calldata":
 		value := x.Calldata
 		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.RawRequest.data_source_version":
+		value := x.DataSourceVersion
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1761,6 +3022,8 @@ func (x *fastReflection_RawRequest) Set(fd protoreflect.FieldDescriptor, value p
 		x.DataSourceId = value.Uint()
 	case "band.oracle.v1.RawRequest.calldata":
 		x.Calldata = value.Bytes()
+	case "band.oracle.v1.RawRequest.data_source_version":
+		x.DataSourceVersion = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1787,6 +3050,8 @@ func (x *fastReflection_RawRequest) Mutable(fd protoreflect.FieldDescriptor) pro
 		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RawRequest is not mutable"))
 	case "band.oracle.v1.RawRequest.calldata":
 		panic(fmt.Errorf("field calldata of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.RawRequest.data_source_version":
+		panic(fmt.Errorf("field data_source_version of message band.oracle.v1.RawRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1806,6 +3071,8 @@ func (x *fastReflection_RawRequest) NewField(fd protoreflect.FieldDescriptor) pr
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "band.oracle.v1.RawRequest.calldata":
 		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.RawRequest.data_source_version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1885,6 +3152,9 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.DataSourceVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceVersion))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1914,6 +3184,11 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DataSourceVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceVersion))
+			i--
+			dAtA[i] = 0x20
+		}
 		if len(x.Calldata) > 0 {
 			i -= len(x.Calldata)
 			copy(dAtA[i:], x.Calldata)
@@ -2052,6 +3327,25 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 					x.Calldata = []byte{}
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersion", wireType)
+				}
+				x.DataSourceVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2111,7 +3405,7 @@ func (x *RawReport) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RawReport) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2754,20 +4048,23 @@ func (x *_Request_13_list) IsValid() bool {
 }
 
 var (
-	md_Request                      protoreflect.MessageDescriptor
-	fd_Request_oracle_script_id     protoreflect.FieldDescriptor
-	fd_Request_calldata             protoreflect.FieldDescriptor
-	fd_Request_requested_validators protoreflect.FieldDescriptor
-	fd_Request_min_count            protoreflect.FieldDescriptor
-	fd_Request_request_height       protoreflect.FieldDescriptor
-	fd_Request_request_time         protoreflect.FieldDescriptor
-	fd_Request_client_id            protoreflect.FieldDescriptor
-	fd_Request_raw_requests         protoreflect.FieldDescriptor
-	fd_Request_ibc_channel          protoreflect.FieldDescriptor
-	fd_Request_execute_gas          protoreflect.FieldDescriptor
-	fd_Request_tss_encoder          protoreflect.FieldDescriptor
-	fd_Request_requester            protoreflect.FieldDescriptor
-	fd_Request_fee_limit            protoreflect.FieldDescriptor
+	md_Request                       protoreflect.MessageDescriptor
+	fd_Request_oracle_script_id      protoreflect.FieldDescriptor
+	fd_Request_calldata              protoreflect.FieldDescriptor
+	fd_Request_requested_validators  protoreflect.FieldDescriptor
+	fd_Request_min_count             protoreflect.FieldDescriptor
+	fd_Request_request_height        protoreflect.FieldDescriptor
+	fd_Request_request_time          protoreflect.FieldDescriptor
+	fd_Request_client_id             protoreflect.FieldDescriptor
+	fd_Request_raw_requests          protoreflect.FieldDescriptor
+	fd_Request_ibc_channel           protoreflect.FieldDescriptor
+	fd_Request_execute_gas           protoreflect.FieldDescriptor
+	fd_Request_tss_encoder           protoreflect.FieldDescriptor
+	fd_Request_requester             protoreflect.FieldDescriptor
+	fd_Request_fee_limit             protoreflect.FieldDescriptor
+	fd_Request_oracle_script_version protoreflect.FieldDescriptor
+	fd_Request_callback_module       protoreflect.FieldDescriptor
+	fd_Request_callback_gas_limit    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2786,6 +4083,9 @@ func init() {
 	fd_Request_tss_encoder = md_Request.Fields().ByName("tss_encoder")
 	fd_Request_requester = md_Request.Fields().ByName("requester")
 	fd_Request_fee_limit = md_Request.Fields().ByName("fee_limit")
+	fd_Request_oracle_script_version = md_Request.Fields().ByName("oracle_script_version")
+	fd_Request_callback_module = md_Request.Fields().ByName("callback_module")
+	fd_Request_callback_gas_limit = md_Request.Fields().ByName("callback_gas_limit")
 }
 
 var _ protoreflect.Message = (*fastReflection_Request)(nil)
@@ -2797,7 +4097,7 @@ func (x *Request) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Request) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2931,6 +4231,24 @@ func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_Request_oracle_script_version, value) {
+			return
+		}
+	}
+	if x.CallbackModule != "" {
+		value := protoreflect.ValueOfString(x.CallbackModule)
+		if !f(fd_Request_callback_module, value) {
+			return
+		}
+	}
+	if x.CallbackGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CallbackGasLimit)
+		if !f(fd_Request_callback_gas_limit, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2972,6 +4290,12 @@ func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Requester != ""
 	case "band.oracle.v1.Request.fee_limit":
 		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.Request.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
+	case "band.oracle.v1.Request.callback_module":
+		return x.CallbackModule != ""
+	case "band.oracle.v1.Request.callback_gas_limit":
+		return x.CallbackGasLimit != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3014,6 +4338,12 @@ func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
 		x.Requester = ""
 	case "band.oracle.v1.Request.fee_limit":
 		x.FeeLimit = nil
+	case "band.oracle.v1.Request.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
+	case "band.oracle.v1.Request.callback_module":
+		x.CallbackModule = ""
+	case "band.oracle.v1.Request.callback_gas_limit":
+		x.CallbackGasLimit = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3078,6 +4408,15 @@ func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) pr
 		}
 		listValue := &_Request_13_list{list: &x.FeeLimit}
 		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.oracle_script_version":
+		value := x.OracleScriptVersion
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Request.callback_module":
+		value := x.CallbackModule
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Request.callback_gas_limit":
+		value := x.CallbackGasLimit
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3130,6 +4469,12 @@ func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value prot
 		lv := value.List()
 		clv := lv.(*_Request_13_list)
 		x.FeeLimit = *clv.list
+	case "band.oracle.v1.Request.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
+	case "band.oracle.v1.Request.callback_module":
+		x.CallbackModule = value.Interface().(string)
+	case "band.oracle.v1.Request.callback_gas_limit":
+		x.CallbackGasLimit = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3191,6 +4536,12 @@ func (x *fastReflection_Request) Mutable(fd protoreflect.FieldDescriptor) protor
 		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.Request is not mutable"))
 	case "band.oracle.v1.Request.requester":
 		panic(fmt.Errorf("field requester of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.callback_module":
+		panic(fmt.Errorf("field callback_module of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.callback_gas_limit":
+		panic(fmt.Errorf("field callback_gas_limit of message band.oracle.v1.Request is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3234,6 +4585,12 @@ func (x *fastReflection_Request) NewField(fd protoreflect.FieldDescriptor) proto
 	case "band.oracle.v1.Request.fee_limit":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_Request_13_list{list: &list})
+	case "band.oracle.v1.Request.oracle_script_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Request.callback_module":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Request.callback_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3355,6 +4712,16 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.OracleScriptVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptVersion))
+		}
+		l = len(x.CallbackModule)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CallbackGasLimit != 0 {
+			n += 2 + runtime.Sov(uint64(x.CallbackGasLimit))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3384,6 +4751,25 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CallbackGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CallbackGasLimit))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.CallbackModule) > 0 {
+			i -= len(x.CallbackModule)
+			copy(dAtA[i:], x.CallbackModule)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CallbackModule)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
+			i--
+			dAtA[i] = 0x70
+		}
 		if len(x.FeeLimit) > 0 {
 			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
@@ -3887,6 +5273,76 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+				}
+				x.OracleScriptVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CallbackModule", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CallbackModule = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+				}
+				x.CallbackGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CallbackGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3997,7 +5453,7 @@ func (x *Report) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Report) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4621,7 +6077,7 @@ func (x *OracleRequestPacketData) ProtoReflect() protoreflect.Message {
 }
 
 func (x *OracleRequestPacketData) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5466,7 +6922,7 @@ func (x *OracleRequestPacketAcknowledgement) ProtoReflect() protoreflect.Message
 }
 
 func (x *OracleRequestPacketAcknowledgement) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5882,7 +7338,7 @@ func (x *OracleResponsePacketData) ProtoReflect() protoreflect.Message {
 }
 
 func (x *OracleResponsePacketData) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6616,7 +8072,7 @@ func (x *Result) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Result) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7536,7 +8992,7 @@ func (x *SigningResult) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SigningResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8050,7 +9506,7 @@ func (x *ValidatorStatus) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorStatus) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8539,7 +9995,7 @@ func (x *ActiveValidator) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8985,6 +10441,57 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_12_list)(nil)
+
+type _Params_12_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_Params_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_12_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_12_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                           protoreflect.MessageDescriptor
 	fd_Params_max_raw_request_count     protoreflect.FieldDescriptor
@@ -8998,6 +10505,8 @@ var (
 	fd_Params_oracle_reward_percentage  protoreflect.FieldDescriptor
 	fd_Params_inactive_penalty_duration protoreflect.FieldDescriptor
 	fd_Params_ibc_request_enabled       protoreflect.FieldDescriptor
+	fd_Params_callback_gas_price        protoreflect.FieldDescriptor
+	fd_Params_max_callback_gas_limit    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -9014,6 +10523,8 @@ func init() {
 	fd_Params_oracle_reward_percentage = md_Params.Fields().ByName("oracle_reward_percentage")
 	fd_Params_inactive_penalty_duration = md_Params.Fields().ByName("inactive_penalty_duration")
 	fd_Params_ibc_request_enabled = md_Params.Fields().ByName("ibc_request_enabled")
+	fd_Params_callback_gas_price = md_Params.Fields().ByName("callback_gas_price")
+	fd_Params_max_callback_gas_limit = md_Params.Fields().ByName("max_callback_gas_limit")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -9025,7 +10536,7 @@ func (x *Params) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9147,6 +10658,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.CallbackGasPrice) != 0 {
+		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.CallbackGasPrice})
+		if !f(fd_Params_callback_gas_price, value) {
+			return
+		}
+	}
+	if x.MaxCallbackGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCallbackGasLimit)
+		if !f(fd_Params_max_callback_gas_limit, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -9184,6 +10707,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.InactivePenaltyDuration != uint64(0)
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		return x.IbcRequestEnabled != false
+	case "band.oracle.v1.Params.callback_gas_price":
+		return len(x.CallbackGasPrice) != 0
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		return x.MaxCallbackGasLimit != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9222,6 +10749,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.InactivePenaltyDuration = uint64(0)
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		x.IbcRequestEnabled = false
+	case "band.oracle.v1.Params.callback_gas_price":
+		x.CallbackGasPrice = nil
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		x.MaxCallbackGasLimit = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9271,6 +10802,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		value := x.IbcRequestEnabled
 		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.Params.callback_gas_price":
+		if len(x.CallbackGasPrice) == 0 {
+			return protoreflect.ValueOfList(&_Params_12_list{})
+		}
+		listValue := &_Params_12_list{list: &x.CallbackGasPrice}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		value := x.MaxCallbackGasLimit
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9313,6 +10853,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.InactivePenaltyDuration = value.Uint()
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		x.IbcRequestEnabled = value.Bool()
+	case "band.oracle.v1.Params.callback_gas_price":
+		lv := value.List()
+		clv := lv.(*_Params_12_list)
+		x.CallbackGasPrice = *clv.list
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		x.MaxCallbackGasLimit = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9333,6 +10879,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.oracle.v1.Params.callback_gas_price":
+		if x.CallbackGasPrice == nil {
+			x.CallbackGasPrice = []*v1beta1.DecCoin{}
+		}
+		value := &_Params_12_list{list: &x.CallbackGasPrice}
+		return protoreflect.ValueOfList(value)
 	case "band.oracle.v1.Params.max_raw_request_count":
 		panic(fmt.Errorf("field max_raw_request_count of message band.oracle.v1.Params is not mutable"))
 	case "band.oracle.v1.Params.max_ask_count":
@@ -9355,6 +10907,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field inactive_penalty_duration of message band.oracle.v1.Params is not mutable"))
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		panic(fmt.Errorf("field ibc_request_enabled of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		panic(fmt.Errorf("field max_callback_gas_limit of message band.oracle.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9390,6 +10944,11 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.Params.callback_gas_price":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9492,6 +11051,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.IbcRequestEnabled {
 			n += 2
 		}
+		if len(x.CallbackGasPrice) > 0 {
+			for _, e := range x.CallbackGasPrice {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxCallbackGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCallbackGasLimit))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9521,6 +11089,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxCallbackGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCallbackGasLimit))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.CallbackGasPrice) > 0 {
+			for iNdEx := len(x.CallbackGasPrice) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CallbackGasPrice[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
 		if x.IbcRequestEnabled {
 			i--
 			if x.IbcRequestEnabled {
@@ -9840,6 +11429,59 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.IbcRequestEnabled = bool(v != 0)
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CallbackGasPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CallbackGasPrice = append(x.CallbackGasPrice, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CallbackGasPrice[len(x.CallbackGasPrice)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGasLimit", wireType)
+				}
+				x.MaxCallbackGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCallbackGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9941,7 +11583,7 @@ func (x *PendingResolveList) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PendingResolveList) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10433,7 +12075,7 @@ func (x *IBCChannel) ProtoReflect() protoreflect.Message {
 }
 
 func (x *IBCChannel) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10923,7 +12565,7 @@ func (x *RequestVerification) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RequestVerification) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11551,7 +13193,7 @@ func (x *PriceResult) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PriceResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12157,7 +13799,7 @@ func (x *OracleResultSignatureOrder) ProtoReflect() protoreflect.Message {
 }
 
 func (x *OracleResultSignatureOrder) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12658,6 +14300,59 @@ func (ResolveStatus) EnumDescriptor() ([]byte, []int) {
 	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
 }
 
+// CacheableOption is the option to update whether a data source is cacheable.
+type CacheableOption int32
+
+const (
+	// CACHEABLE_OPTION_DO_NOT_MODIFY keeps whether the data source is cacheable.
+	CacheableOption_CACHEABLE_OPTION_DO_NOT_MODIFY CacheableOption = 0
+	// CACHEABLE_OPTION_ENABLE makes the data source cacheable.
+	CacheableOption_CACHEABLE_OPTION_ENABLE CacheableOption = 1
+	// CACHEABLE_OPTION_DISABLE makes the data source non-cacheable.
+	CacheableOption_CACHEABLE_OPTION_DISABLE CacheableOption = 2
+)
+
+// Enum value maps for CacheableOption.
+var (
+	CacheableOption_name = map[int32]string{
+		0: "CACHEABLE_OPTION_DO_NOT_MODIFY",
+		1: "CACHEABLE_OPTION_ENABLE",
+		2: "CACHEABLE_OPTION_DISABLE",
+	}
+	CacheableOption_value = map[string]int32{
+		"CACHEABLE_OPTION_DO_NOT_MODIFY": 0,
+		"CACHEABLE_OPTION_ENABLE":        1,
+		"CACHEABLE_OPTION_DISABLE":       2,
+	}
+)
+
+func (x CacheableOption) Enum() *CacheableOption {
+	p := new(CacheableOption)
+	*p = x
+	return p
+}
+
+func (x CacheableOption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CacheableOption) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_oracle_v1_oracle_proto_enumTypes[1].Descriptor()
+}
+
+func (CacheableOption) Type() protoreflect.EnumType {
+	return &file_band_oracle_v1_oracle_proto_enumTypes[1]
+}
+
+func (x CacheableOption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CacheableOption.Descriptor instead.
+func (CacheableOption) EnumDescriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+}
+
 // Encoder is an enumerator that defines the mode of encoding message in tss module.
 type Encoder int32
 
@@ -12699,11 +14394,11 @@ func (x Encoder) String() string {
 }
 
 func (Encoder) Descriptor() protoreflect.EnumDescriptor {
-	return file_band_oracle_v1_oracle_proto_enumTypes[1].Descriptor()
+	return file_band_oracle_v1_oracle_proto_enumTypes[2].Descriptor()
 }
 
 func (Encoder) Type() protoreflect.EnumType {
-	return &file_band_oracle_v1_oracle_proto_enumTypes[1]
+	return &file_band_oracle_v1_oracle_proto_enumTypes[2]
 }
 
 func (x Encoder) Number() protoreflect.EnumNumber {
@@ -12712,7 +14407,7 @@ func (x Encoder) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Encoder.Descriptor instead.
 func (Encoder) EnumDescriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
 }
 
 // DataSource is the data structure for storing data sources in the storage.
@@ -12735,6 +14430,12 @@ type DataSource struct {
 	// Fee is the data source fee per ask_count that data provider will receive
 	// from requester.
 	Fee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=fee,proto3" json:"fee,omitempty"`
+	// Cacheable indicates that validators may reuse a recent result of the data
+	// source for the same calldata instead of executing it for every raw request.
+	Cacheable bool `protobuf:"varint,7,opt,name=cacheable,proto3" json:"cacheable,omitempty"`
+	// Version is the latest version of the data source. It starts at 1 and is
+	// incremented whenever the executable of the data source is changed.
+	Version uint64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *DataSource) Reset() {
@@ -12799,6 +14500,77 @@ func (x *DataSource) GetFee() []*v1beta1.Coin {
 	return nil
 }
 
+func (x *DataSource) GetCacheable() bool {
+	if x != nil {
+		return x.Cacheable
+	}
+	return false
+}
+
+func (x *DataSource) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// DataSourceVersion is an immutable snapshot of the executable of a data
+// source at a given version.
+type DataSourceVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSourceID is the ID of the data source
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// Version is the version of the data source
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is string of file name used as reference for locating
+	// data source file stored in bandchain nodes
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+}
+
+func (x *DataSourceVersion) Reset() {
+	*x = DataSourceVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSourceVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSourceVersion) ProtoMessage() {}
+
+// Deprecated: Use DataSourceVersion.ProtoReflect.Descriptor instead.
+func (*DataSourceVersion) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DataSourceVersion) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *DataSourceVersion) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DataSourceVersion) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
 // OracleScript is the data structure for storing oracle scripts in the storage.
 type OracleScript struct {
 	state         protoimpl.MessageState
@@ -12822,12 +14594,16 @@ type OracleScript struct {
 	// It is recommendded to store source code on IPFS and get its URL to preserve
 	// decentralization.
 	SourceCodeUrl string `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// Version is the latest version of the oracle script. It starts at 1 and is
+	// incremented whenever the Wasm code or the schema of the oracle script is
+	// changed.
+	Version uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *OracleScript) Reset() {
 	*x = OracleScript{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[1]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12841,7 +14617,7 @@ func (*OracleScript) ProtoMessage() {}
 
 // Deprecated: Use OracleScript.ProtoReflect.Descriptor instead.
 func (*OracleScript) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *OracleScript) GetOwner() string {
@@ -12886,6 +14662,79 @@ func (x *OracleScript) GetSourceCodeUrl() string {
 	return ""
 }
 
+func (x *OracleScript) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// OracleScriptVersion is an immutable snapshot of the Wasm code and the schema
+// of an oracle script at a given version.
+type OracleScriptVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptID is the ID of the oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Version is the version of the oracle script
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is string of file name used as reference for locating
+	// compiled oracle script WASM file stored in bandchain nodes
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Schema is the schema of the oracle script input/output
+	Schema string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (x *OracleScriptVersion) Reset() {
+	*x = OracleScriptVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OracleScriptVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleScriptVersion) ProtoMessage() {}
+
+// Deprecated: Use OracleScriptVersion.ProtoReflect.Descriptor instead.
+func (*OracleScriptVersion) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OracleScriptVersion) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
+	}
+	return 0
+}
+
+func (x *OracleScriptVersion) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OracleScriptVersion) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *OracleScriptVersion) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
 // RawRequest is the data structure for storing raw requests in the storage.
 type RawRequest struct {
 	state         protoimpl.MessageState
@@ -12899,12 +14748,16 @@ type RawRequest struct {
 	// Calldata is the data used as argument params for executing data source
 	// script
 	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// DataSourceVersion is the version of the data source at the time of the
+	// request, which validators must execute. It is 0 for the requests made
+	// before data sources are versioned.
+	DataSourceVersion uint64 `protobuf:"varint,4,opt,name=data_source_version,json=dataSourceVersion,proto3" json:"data_source_version,omitempty"`
 }
 
 func (x *RawRequest) Reset() {
 	*x = RawRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12918,7 +14771,7 @@ func (*RawRequest) ProtoMessage() {}
 
 // Deprecated: Use RawRequest.ProtoReflect.Descriptor instead.
 func (*RawRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RawRequest) GetExternalId() uint64 {
@@ -12942,6 +14795,13 @@ func (x *RawRequest) GetCalldata() []byte {
 	return nil
 }
 
+func (x *RawRequest) GetDataSourceVersion() uint64 {
+	if x != nil {
+		return x.DataSourceVersion
+	}
+	return 0
+}
+
 // RawRequest is the data structure for storing raw reporter in the storage.
 type RawReport struct {
 	state         protoimpl.MessageState
@@ -12963,7 +14823,7 @@ type RawReport struct {
 func (x *RawReport) Reset() {
 	*x = RawReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12977,7 +14837,7 @@ func (*RawReport) ProtoMessage() {}
 
 // Deprecated: Use RawReport.ProtoReflect.Descriptor instead.
 func (*RawReport) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RawReport) GetExternalId() uint64 {
@@ -13039,12 +14899,22 @@ type Request struct {
 	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
 	// FeeLimit is the maximum tokens that will be paid for this request.
 	FeeLimit []*v1beta1.Coin `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// OracleScriptVersion is the version of the oracle script used by the
+	// request. It is 0 for the requests made before oracle scripts are
+	// versioned.
+	OracleScriptVersion uint64 `protobuf:"varint,14,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+	// CallbackModule is the name of the module to be called back when the
+	// request is resolved or expired, or empty if there is none.
+	CallbackModule string `protobuf:"bytes,15,opt,name=callback_module,json=callbackModule,proto3" json:"callback_module,omitempty"`
+	// CallbackGasLimit is the maximum amount of gas the callback of the request
+	// can consume.
+	CallbackGasLimit uint64 `protobuf:"varint,16,opt,name=callback_gas_limit,json=callbackGasLimit,proto3" json:"callback_gas_limit,omitempty"`
 }
 
 func (x *Request) Reset() {
 	*x = Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13058,7 +14928,7 @@ func (*Request) ProtoMessage() {}
 
 // Deprecated: Use Request.ProtoReflect.Descriptor instead.
 func (*Request) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Request) GetOracleScriptId() uint64 {
@@ -13152,6 +15022,27 @@ func (x *Request) GetFeeLimit() []*v1beta1.Coin {
 	return nil
 }
 
+func (x *Request) GetOracleScriptVersion() uint64 {
+	if x != nil {
+		return x.OracleScriptVersion
+	}
+	return 0
+}
+
+func (x *Request) GetCallbackModule() string {
+	if x != nil {
+		return x.CallbackModule
+	}
+	return ""
+}
+
+func (x *Request) GetCallbackGasLimit() uint64 {
+	if x != nil {
+		return x.CallbackGasLimit
+	}
+	return 0
+}
+
 // Report is the data structure for storing reports in the storage.
 type Report struct {
 	state         protoimpl.MessageState
@@ -13171,7 +15062,7 @@ type Report struct {
 func (x *Report) Reset() {
 	*x = Report{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13185,7 +15076,7 @@ func (*Report) ProtoMessage() {}
 
 // Deprecated: Use Report.ProtoReflect.Descriptor instead.
 func (*Report) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Report) GetValidator() string {
@@ -13247,7 +15138,7 @@ type OracleRequestPacketData struct {
 func (x *OracleRequestPacketData) Reset() {
 	*x = OracleRequestPacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13261,7 +15152,7 @@ func (*OracleRequestPacketData) ProtoMessage() {}
 
 // Deprecated: Use OracleRequestPacketData.ProtoReflect.Descriptor instead.
 func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OracleRequestPacketData) GetClientId() string {
@@ -13341,7 +15232,7 @@ type OracleRequestPacketAcknowledgement struct {
 func (x *OracleRequestPacketAcknowledgement) Reset() {
 	*x = OracleRequestPacketAcknowledgement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13355,7 +15246,7 @@ func (*OracleRequestPacketAcknowledgement) ProtoMessage() {}
 
 // Deprecated: Use OracleRequestPacketAcknowledgement.ProtoReflect.Descriptor instead.
 func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OracleRequestPacketAcknowledgement) GetRequestId() uint64 {
@@ -13398,7 +15289,7 @@ type OracleResponsePacketData struct {
 func (x *OracleResponsePacketData) Reset() {
 	*x = OracleResponsePacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13412,7 +15303,7 @@ func (*OracleResponsePacketData) ProtoMessage() {}
 
 // Deprecated: Use OracleResponsePacketData.ProtoReflect.Descriptor instead.
 func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *OracleResponsePacketData) GetClientId() string {
@@ -13508,7 +15399,7 @@ type Result struct {
 func (x *Result) Reset() {
 	*x = Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13522,7 +15413,7 @@ func (*Result) ProtoMessage() {}
 
 // Deprecated: Use Result.ProtoReflect.Descriptor instead.
 func (*Result) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Result) GetClientId() string {
@@ -13619,7 +15510,7 @@ type SigningResult struct {
 func (x *SigningResult) Reset() {
 	*x = SigningResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13633,7 +15524,7 @@ func (*SigningResult) ProtoMessage() {}
 
 // Deprecated: Use SigningResult.ProtoReflect.Descriptor instead.
 func (*SigningResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SigningResult) GetSigningId() uint64 {
@@ -13674,7 +15565,7 @@ type ValidatorStatus struct {
 func (x *ValidatorStatus) Reset() {
 	*x = ValidatorStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13688,7 +15579,7 @@ func (*ValidatorStatus) ProtoMessage() {}
 
 // Deprecated: Use ValidatorStatus.ProtoReflect.Descriptor instead.
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ValidatorStatus) GetIsActive() bool {
@@ -13720,7 +15611,7 @@ type ActiveValidator struct {
 func (x *ActiveValidator) Reset() {
 	*x = ActiveValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13734,7 +15625,7 @@ func (*ActiveValidator) ProtoMessage() {}
 
 // Deprecated: Use ActiveValidator.ProtoReflect.Descriptor instead.
 func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ActiveValidator) GetAddress() string {
@@ -13788,12 +15679,19 @@ type Params struct {
 	// IBCRequestEnabled is a flag indicating whether sending oracle request via
 	// IBC is allowed
 	IbcRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// CallbackGasPrice is the price per unit of gas of the callbacks of the
+	// requests made by other modules, which is paid from the fee limit of the
+	// requests.
+	CallbackGasPrice []*v1beta1.DecCoin `protobuf:"bytes,12,rep,name=callback_gas_price,json=callbackGasPrice,proto3" json:"callback_gas_price,omitempty"`
+	// MaxCallbackGasLimit is the maximum amount of gas the callback of a request
+	// can be given.
+	MaxCallbackGasLimit uint64 `protobuf:"varint,13,opt,name=max_callback_gas_limit,json=maxCallbackGasLimit,proto3" json:"max_callback_gas_limit,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13807,7 +15705,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Params) GetMaxRawRequestCount() uint64 {
@@ -13887,6 +15785,20 @@ func (x *Params) GetIbcRequestEnabled() bool {
 	return false
 }
 
+func (x *Params) GetCallbackGasPrice() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.CallbackGasPrice
+	}
+	return nil
+}
+
+func (x *Params) GetMaxCallbackGasLimit() uint64 {
+	if x != nil {
+		return x.MaxCallbackGasLimit
+	}
+	return 0
+}
+
 // PendingResolveList is a list of requests that are waiting to be resolved
 type PendingResolveList struct {
 	state         protoimpl.MessageState
@@ -13900,7 +15812,7 @@ type PendingResolveList struct {
 func (x *PendingResolveList) Reset() {
 	*x = PendingResolveList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13914,7 +15826,7 @@ func (*PendingResolveList) ProtoMessage() {}
 
 // Deprecated: Use PendingResolveList.ProtoReflect.Descriptor instead.
 func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PendingResolveList) GetRequestIds() []uint64 {
@@ -13942,7 +15854,7 @@ type IBCChannel struct {
 func (x *IBCChannel) Reset() {
 	*x = IBCChannel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13956,7 +15868,7 @@ func (*IBCChannel) ProtoMessage() {}
 
 // Deprecated: Use IBCChannel.ProtoReflect.Descriptor instead.
 func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *IBCChannel) GetPortId() string {
@@ -13995,7 +15907,7 @@ type RequestVerification struct {
 func (x *RequestVerification) Reset() {
 	*x = RequestVerification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14009,7 +15921,7 @@ func (*RequestVerification) ProtoMessage() {}
 
 // Deprecated: Use RequestVerification.ProtoReflect.Descriptor instead.
 func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RequestVerification) GetChainId() string {
@@ -14071,7 +15983,7 @@ type PriceResult struct {
 func (x *PriceResult) Reset() {
 	*x = PriceResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14085,7 +15997,7 @@ func (*PriceResult) ProtoMessage() {}
 
 // Deprecated: Use PriceResult.ProtoReflect.Descriptor instead.
 func (*PriceResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PriceResult) GetSymbol() string {
@@ -14138,7 +16050,7 @@ type OracleResultSignatureOrder struct {
 func (x *OracleResultSignatureOrder) Reset() {
 	*x = OracleResultSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14152,7 +16064,7 @@ func (*OracleResultSignatureOrder) ProtoMessage() {}
 
 // Deprecated: Use OracleResultSignatureOrder.ProtoReflect.Descriptor instead.
 func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *OracleResultSignatureOrder) GetRequestId() uint64 {
@@ -14180,7 +16092,7 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
 	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x02, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
@@ -14195,325 +16107,382 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xcf, 0x01, 0x0a,
-	0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a,
-	0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb5,
-	0x01, 0x0a, 0x0a, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a,
-	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44,
-	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc5, 0x05, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0b, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0b,
-	0x69, 0x62, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x0e, 0xe2,
-	0xde, 0x1f, 0x0a, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x69,
-	0x62, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73,
-	0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53,
-	0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0,
-	0x1f, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x69,
-	0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
-	0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72,
-	0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xe6, 0x03, 0x0a, 0x17, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x09,
-	0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
-	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41,
-	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xc7, 0x02, 0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f,
-	0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x03, 0x0a, 0x06, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69,
+	0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xe9,
+	0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
+	0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb9, 0x01, 0x0a, 0x13, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde,
+	0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xe5, 0x01, 0x0a, 0x0a, 0x52, 0x61, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde,
+	0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c,
+	0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x81,
+	0x01, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xd0, 0x06, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e,
+	0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69,
 	0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f,
-	0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52,
-	0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61,
-	0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4b, 0xe2,
-	0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x3a,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33,
-	0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x3a, 0x04, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
-	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x3a,
-	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xc4, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x73,
-	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d,
-	0x61, 0x78, 0x41, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
-	0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
-	0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x67, 0x61, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x61, 0x73, 0x6d,
-	0x47, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x12, 0x2c,
-	0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x61, 0x6d, 0x70,
-	0x6c, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x15, 0xe2, 0xde, 0x1f, 0x11, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x11, 0x69, 0x62, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0x3b, 0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x4a, 0x0a, 0x0a,
-	0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
-	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72,
-	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x26, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0b, 0xe2, 0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
-	0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3,
-	0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x70, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31,
-	0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x1f, 0x52, 0x45, 0x53,
+	0x43, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0b, 0x69, 0x62, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x49, 0x42, 0x43, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x69, 0x62, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47,
+	0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a,
+	0x0a, 0x11, 0x69, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61,
+	0x77, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0a, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xe6, 0x03, 0x0a, 0x17, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29,
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c,
+	0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c,
+	0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
+	0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65,
+	0x70, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74,
+	0x73, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54,
+	0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xc7, 0x02, 0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a,
+	0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f,
+	0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x03, 0x0a,
+	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde,
+	0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde,
+	0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x6a, 0x0a, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61,
+	0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x76, 0x33, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xfa, 0x05, 0x0a, 0x06,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61,
+	0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
+	0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c,
+	0x6c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x67,
+	0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x77,
+	0x61, 0x73, 0x6d, 0x47, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72,
+	0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x38, 0x0a, 0x18, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69, 0x6e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x15, 0xe2, 0xde, 0x1f, 0x11, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x11, 0x69, 0x62, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x7f, 0x0a, 0x12,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44,
+	0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x10, 0x63, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a,
+	0x16, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d,
+	0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x3b, 0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x73, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x4a, 0x0a, 0x0a, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xde, 0x1f,
+	0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x70, 0x78, 0x12,
+	0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x90, 0x01,
+	0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
+	0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3c, 0x0a, 0x1f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17, 0x8a, 0x9d, 0x20, 0x13, 0x52, 0x45, 0x53,
 	0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17,
-	0x8a, 0x9d, 0x20, 0x13, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x01, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x12,
-	0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20,
-	0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0x03, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x1a,
-	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x6a, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
-	0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x43,
-	0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
-	0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x42, 0x49,
-	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41,
-	0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
-	0x00, 0x42, 0xb9, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61,
-	0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61,
-	0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42,
-	0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a,
-	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e,
-	0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x1a, 0x1a, 0x8a, 0x9d,
+	0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f,
+	0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
+	0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x1a, 0x8a, 0x9d,
+	0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x76,
+	0x0a, 0x0f, 0x43, 0x61, 0x63, 0x68, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x41, 0x43, 0x48, 0x45, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4f,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
+	0x49, 0x46, 0x59, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x41, 0x43, 0x48, 0x45, 0x41, 0x42,
+	0x4c, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x43, 0x48, 0x45, 0x41, 0x42, 0x4c, 0x45, 0x5f,
+	0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02,
+	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x6a, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e,
+	0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x42,
+	0x49, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50,
+	0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x42, 0xb9, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62,
+	0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42,
+	0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e,
+	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61,
+	0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14528,51 +16497,56 @@ func file_band_oracle_v1_oracle_proto_rawDescGZIP() []byte {
 	return file_band_oracle_v1_oracle_proto_rawDescData
 }
 
-var file_band_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_band_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_band_oracle_v1_oracle_proto_goTypes = []interface{}{
 	(ResolveStatus)(0),                         // 0: band.oracle.v1.ResolveStatus
-	(Encoder)(0),                               // 1: band.oracle.v1.Encoder
-	(*DataSource)(nil),                         // 2: band.oracle.v1.DataSource
-	(*OracleScript)(nil),                       // 3: band.oracle.v1.OracleScript
-	(*RawRequest)(nil),                         // 4: band.oracle.v1.RawRequest
-	(*RawReport)(nil),                          // 5: band.oracle.v1.RawReport
-	(*Request)(nil),                            // 6: band.oracle.v1.Request
-	(*Report)(nil),                             // 7: band.oracle.v1.Report
-	(*OracleRequestPacketData)(nil),            // 8: band.oracle.v1.OracleRequestPacketData
-	(*OracleRequestPacketAcknowledgement)(nil), // 9: band.oracle.v1.OracleRequestPacketAcknowledgement
-	(*OracleResponsePacketData)(nil),           // 10: band.oracle.v1.OracleResponsePacketData
-	(*Result)(nil),                             // 11: band.oracle.v1.Result
-	(*SigningResult)(nil),                      // 12: band.oracle.v1.SigningResult
-	(*ValidatorStatus)(nil),                    // 13: band.oracle.v1.ValidatorStatus
-	(*ActiveValidator)(nil),                    // 14: band.oracle.v1.ActiveValidator
-	(*Params)(nil),                             // 15: band.oracle.v1.Params
-	(*PendingResolveList)(nil),                 // 16: band.oracle.v1.PendingResolveList
-	(*IBCChannel)(nil),                         // 17: band.oracle.v1.IBCChannel
-	(*RequestVerification)(nil),                // 18: band.oracle.v1.RequestVerification
-	(*PriceResult)(nil),                        // 19: band.oracle.v1.PriceResult
-	(*OracleResultSignatureOrder)(nil),         // 20: band.oracle.v1.OracleResultSignatureOrder
-	(*v1beta1.Coin)(nil),                       // 21: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil),              // 22: google.protobuf.Timestamp
+	(CacheableOption)(0),                       // 1: band.oracle.v1.CacheableOption
+	(Encoder)(0),                               // 2: band.oracle.v1.Encoder
+	(*DataSource)(nil),                         // 3: band.oracle.v1.DataSource
+	(*DataSourceVersion)(nil),                  // 4: band.oracle.v1.DataSourceVersion
+	(*OracleScript)(nil),                       // 5: band.oracle.v1.OracleScript
+	(*OracleScriptVersion)(nil),                // 6: band.oracle.v1.OracleScriptVersion
+	(*RawRequest)(nil),                         // 7: band.oracle.v1.RawRequest
+	(*RawReport)(nil),                          // 8: band.oracle.v1.RawReport
+	(*Request)(nil),                            // 9: band.oracle.v1.Request
+	(*Report)(nil),                             // 10: band.oracle.v1.Report
+	(*OracleRequestPacketData)(nil),            // 11: band.oracle.v1.OracleRequestPacketData
+	(*OracleRequestPacketAcknowledgement)(nil), // 12: band.oracle.v1.OracleRequestPacketAcknowledgement
+	(*OracleResponsePacketData)(nil),           // 13: band.oracle.v1.OracleResponsePacketData
+	(*Result)(nil),                             // 14: band.oracle.v1.Result
+	(*SigningResult)(nil),                      // 15: band.oracle.v1.SigningResult
+	(*ValidatorStatus)(nil),                    // 16: band.oracle.v1.ValidatorStatus
+	(*ActiveValidator)(nil),                    // 17: band.oracle.v1.ActiveValidator
+	(*Params)(nil),                             // 18: band.oracle.v1.Params
+	(*PendingResolveList)(nil),                 // 19: band.oracle.v1.PendingResolveList
+	(*IBCChannel)(nil),                         // 20: band.oracle.v1.IBCChannel
+	(*RequestVerification)(nil),                // 21: band.oracle.v1.RequestVerification
+	(*PriceResult)(nil),                        // 22: band.oracle.v1.PriceResult
+	(*OracleResultSignatureOrder)(nil),         // 23: band.oracle.v1.OracleResultSignatureOrder
+	(*v1beta1.Coin)(nil),                       // 24: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil),              // 25: google.protobuf.Timestamp
+	(*v1beta1.DecCoin)(nil),                    // 26: cosmos.base.v1beta1.DecCoin
 }
 var file_band_oracle_v1_oracle_proto_depIdxs = []int32{
-	21, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
-	4,  // 1: band.oracle.v1.Request.raw_requests:type_name -> band.oracle.v1.RawRequest
-	17, // 2: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
-	1,  // 3: band.oracle.v1.Request.tss_encoder:type_name -> band.oracle.v1.Encoder
-	21, // 4: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 5: band.oracle.v1.Report.raw_reports:type_name -> band.oracle.v1.RawReport
-	21, // 6: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	1,  // 7: band.oracle.v1.OracleRequestPacketData.tss_encoder:type_name -> band.oracle.v1.Encoder
+	24, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 1: band.oracle.v1.Request.raw_requests:type_name -> band.oracle.v1.RawRequest
+	20, // 2: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
+	2,  // 3: band.oracle.v1.Request.tss_encoder:type_name -> band.oracle.v1.Encoder
+	24, // 4: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 5: band.oracle.v1.Report.raw_reports:type_name -> band.oracle.v1.RawReport
+	24, // 6: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 7: band.oracle.v1.OracleRequestPacketData.tss_encoder:type_name -> band.oracle.v1.Encoder
 	0,  // 8: band.oracle.v1.OracleResponsePacketData.resolve_status:type_name -> band.oracle.v1.ResolveStatus
 	0,  // 9: band.oracle.v1.Result.resolve_status:type_name -> band.oracle.v1.ResolveStatus
-	22, // 10: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
-	1,  // 11: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	25, // 10: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
+	26, // 11: band.oracle.v1.Params.callback_gas_price:type_name -> cosmos.base.v1beta1.DecCoin
+	2,  // 12: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_oracle_proto_init() }
@@ -14594,7 +16568,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleScript); i {
+			switch v := v.(*DataSourceVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14606,7 +16580,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawRequest); i {
+			switch v := v.(*OracleScript); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14618,7 +16592,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawReport); i {
+			switch v := v.(*OracleScriptVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14630,7 +16604,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Request); i {
+			switch v := v.(*RawRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14642,7 +16616,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Report); i {
+			switch v := v.(*RawReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14654,7 +16628,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleRequestPacketData); i {
+			switch v := v.(*Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14666,7 +16640,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleRequestPacketAcknowledgement); i {
+			switch v := v.(*Report); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14678,7 +16652,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleResponsePacketData); i {
+			switch v := v.(*OracleRequestPacketData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14690,7 +16664,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Result); i {
+			switch v := v.(*OracleRequestPacketAcknowledgement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14702,7 +16676,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigningResult); i {
+			switch v := v.(*OracleResponsePacketData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14714,7 +16688,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorStatus); i {
+			switch v := v.(*Result); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14726,7 +16700,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveValidator); i {
+			switch v := v.(*SigningResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14738,7 +16712,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+			switch v := v.(*ValidatorStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14750,7 +16724,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingResolveList); i {
+			switch v := v.(*ActiveValidator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14762,7 +16736,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCChannel); i {
+			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14774,7 +16748,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestVerification); i {
+			switch v := v.(*PendingResolveList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14786,7 +16760,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceResult); i {
+			switch v := v.(*IBCChannel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14798,6 +16772,30 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OracleResultSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -14815,8 +16813,8 @@ func file_band_oracle_v1_oracle_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_oracle_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   19,
+			NumEnums:      3,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,6 +2,7 @@
 package oraclev1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -2552,6 +2553,1062 @@ func (x *fastReflection_QueryDataSourceResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var (
+	md_QueryDataSourceVersionsRequest                protoreflect.MessageDescriptor
+	fd_QueryDataSourceVersionsRequest_data_source_id protoreflect.FieldDescriptor
+	fd_QueryDataSourceVersionsRequest_pagination     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryDataSourceVersionsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryDataSourceVersionsRequest")
+	fd_QueryDataSourceVersionsRequest_data_source_id = md_QueryDataSourceVersionsRequest.Fields().ByName("data_source_id")
+	fd_QueryDataSourceVersionsRequest_pagination = md_QueryDataSourceVersionsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDataSourceVersionsRequest)(nil)
+
+type fastReflection_QueryDataSourceVersionsRequest QueryDataSourceVersionsRequest
+
+func (x *QueryDataSourceVersionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsRequest)(x)
+}
+
+func (x *QueryDataSourceVersionsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDataSourceVersionsRequest_messageType fastReflection_QueryDataSourceVersionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDataSourceVersionsRequest_messageType{}
+
+type fastReflection_QueryDataSourceVersionsRequest_messageType struct{}
+
+func (x fastReflection_QueryDataSourceVersionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsRequest)(nil)
+}
+func (x fastReflection_QueryDataSourceVersionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsRequest)
+}
+func (x fastReflection_QueryDataSourceVersionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDataSourceVersionsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDataSourceVersionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDataSourceVersionsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_QueryDataSourceVersionsRequest_data_source_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDataSourceVersionsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.QueryDataSourceVersionsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDataSourceVersionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDataSourceVersionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryDataSourceVersionsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDataSourceVersionsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDataSourceVersionsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDataSourceVersionsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDataSourceVersionsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDataSourceVersionsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDataSourceVersionsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryDataSourceVersionsResponse_1_list)(nil)
+
+type _QueryDataSourceVersionsResponse_1_list struct {
+	list *[]*DataSourceVersion
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DataSourceVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DataSourceVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DataSourceVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DataSourceVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryDataSourceVersionsResponse                      protoreflect.MessageDescriptor
+	fd_QueryDataSourceVersionsResponse_data_source_versions protoreflect.FieldDescriptor
+	fd_QueryDataSourceVersionsResponse_pagination           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryDataSourceVersionsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryDataSourceVersionsResponse")
+	fd_QueryDataSourceVersionsResponse_data_source_versions = md_QueryDataSourceVersionsResponse.Fields().ByName("data_source_versions")
+	fd_QueryDataSourceVersionsResponse_pagination = md_QueryDataSourceVersionsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDataSourceVersionsResponse)(nil)
+
+type fastReflection_QueryDataSourceVersionsResponse QueryDataSourceVersionsResponse
+
+func (x *QueryDataSourceVersionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsResponse)(x)
+}
+
+func (x *QueryDataSourceVersionsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDataSourceVersionsResponse_messageType fastReflection_QueryDataSourceVersionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDataSourceVersionsResponse_messageType{}
+
+type fastReflection_QueryDataSourceVersionsResponse_messageType struct{}
+
+func (x fastReflection_QueryDataSourceVersionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsResponse)(nil)
+}
+func (x fastReflection_QueryDataSourceVersionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsResponse)
+}
+func (x fastReflection_QueryDataSourceVersionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDataSourceVersionsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDataSourceVersionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDataSourceVersionsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.DataSourceVersions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDataSourceVersionsResponse_1_list{list: &x.DataSourceVersions})
+		if !f(fd_QueryDataSourceVersionsResponse_data_source_versions, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDataSourceVersionsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.data_source_versions":
+		return len(x.DataSourceVersions) != 0
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.data_source_versions":
+		x.DataSourceVersions = nil
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.data_source_versions":
+		if len(x.DataSourceVersions) == 0 {
+			return protoreflect.ValueOfList(&_QueryDataSourceVersionsResponse_1_list{})
+		}
+		listValue := &_QueryDataSourceVersionsResponse_1_list{list: &x.DataSourceVersions}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.data_source_versions":
+		lv := value.List()
+		clv := lv.(*_QueryDataSourceVersionsResponse_1_list)
+		x.DataSourceVersions = *clv.list
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.data_source_versions":
+		if x.DataSourceVersions == nil {
+			x.DataSourceVersions = []*DataSourceVersion{}
+		}
+		value := &_QueryDataSourceVersionsResponse_1_list{list: &x.DataSourceVersions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDataSourceVersionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.data_source_versions":
+		list := []*DataSourceVersion{}
+		return protoreflect.ValueOfList(&_QueryDataSourceVersionsResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDataSourceVersionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryDataSourceVersionsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDataSourceVersionsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDataSourceVersionsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDataSourceVersionsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDataSourceVersionsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.DataSourceVersions) > 0 {
+			for _, e := range x.DataSourceVersions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDataSourceVersionsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DataSourceVersions) > 0 {
+			for iNdEx := len(x.DataSourceVersions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DataSourceVersions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDataSourceVersionsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DataSourceVersions = append(x.DataSourceVersions, &DataSourceVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DataSourceVersions[len(x.DataSourceVersions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
 	md_QueryOracleScriptRequest                  protoreflect.MessageDescriptor
 	fd_QueryOracleScriptRequest_oracle_script_id protoreflect.FieldDescriptor
@@ -2559,20 +3616,861 @@ var (
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryOracleScriptRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryOracleScriptRequest")
-	fd_QueryOracleScriptRequest_oracle_script_id = md_QueryOracleScriptRequest.Fields().ByName("oracle_script_id")
+	md_QueryOracleScriptRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryOracleScriptRequest")
+	fd_QueryOracleScriptRequest_oracle_script_id = md_QueryOracleScriptRequest.Fields().ByName("oracle_script_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOracleScriptRequest)(nil)
+
+type fastReflection_QueryOracleScriptRequest QueryOracleScriptRequest
+
+func (x *QueryOracleScriptRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptRequest)(x)
+}
+
+func (x *QueryOracleScriptRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOracleScriptRequest_messageType fastReflection_QueryOracleScriptRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOracleScriptRequest_messageType{}
+
+type fastReflection_QueryOracleScriptRequest_messageType struct{}
+
+func (x fastReflection_QueryOracleScriptRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptRequest)(nil)
+}
+func (x fastReflection_QueryOracleScriptRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptRequest)
+}
+func (x fastReflection_QueryOracleScriptRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOracleScriptRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOracleScriptRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOracleScriptRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOracleScriptRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOracleScriptRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOracleScriptRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOracleScriptRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_QueryOracleScriptRequest_oracle_script_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOracleScriptRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOracleScriptRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.QueryOracleScriptRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOracleScriptRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOracleScriptRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryOracleScriptRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOracleScriptRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOracleScriptRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOracleScriptRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOracleScriptRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOracleScriptRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOracleScriptRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryOracleScriptResponse               protoreflect.MessageDescriptor
+	fd_QueryOracleScriptResponse_oracle_script protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryOracleScriptResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryOracleScriptResponse")
+	fd_QueryOracleScriptResponse_oracle_script = md_QueryOracleScriptResponse.Fields().ByName("oracle_script")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOracleScriptResponse)(nil)
+
+type fastReflection_QueryOracleScriptResponse QueryOracleScriptResponse
+
+func (x *QueryOracleScriptResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptResponse)(x)
+}
+
+func (x *QueryOracleScriptResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOracleScriptResponse_messageType fastReflection_QueryOracleScriptResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOracleScriptResponse_messageType{}
+
+type fastReflection_QueryOracleScriptResponse_messageType struct{}
+
+func (x fastReflection_QueryOracleScriptResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptResponse)(nil)
+}
+func (x fastReflection_QueryOracleScriptResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptResponse)
+}
+func (x fastReflection_QueryOracleScriptResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOracleScriptResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOracleScriptResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOracleScriptResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOracleScriptResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOracleScriptResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOracleScriptResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOracleScriptResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScript != nil {
+		value := protoreflect.ValueOfMessage(x.OracleScript.ProtoReflect())
+		if !f(fd_QueryOracleScriptResponse_oracle_script, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOracleScriptResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
+		return x.OracleScript != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
+		x.OracleScript = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOracleScriptResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
+		value := x.OracleScript
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
+		x.OracleScript = value.Message().Interface().(*OracleScript)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
+		if x.OracleScript == nil {
+			x.OracleScript = new(OracleScript)
+		}
+		return protoreflect.ValueOfMessage(x.OracleScript.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOracleScriptResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
+		m := new(OracleScript)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOracleScriptResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryOracleScriptResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOracleScriptResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOracleScriptResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOracleScriptResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOracleScriptResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OracleScript != nil {
+			l = options.Size(x.OracleScript)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOracleScriptResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OracleScript != nil {
+			encoded, err := options.Marshal(x.OracleScript)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOracleScriptResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScript", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OracleScript == nil {
+					x.OracleScript = &OracleScript{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleScript); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryOracleScriptVersionsRequest                  protoreflect.MessageDescriptor
+	fd_QueryOracleScriptVersionsRequest_oracle_script_id protoreflect.FieldDescriptor
+	fd_QueryOracleScriptVersionsRequest_pagination       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryOracleScriptVersionsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryOracleScriptVersionsRequest")
+	fd_QueryOracleScriptVersionsRequest_oracle_script_id = md_QueryOracleScriptVersionsRequest.Fields().ByName("oracle_script_id")
+	fd_QueryOracleScriptVersionsRequest_pagination = md_QueryOracleScriptVersionsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOracleScriptRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOracleScriptVersionsRequest)(nil)
 
-type fastReflection_QueryOracleScriptRequest QueryOracleScriptRequest
+type fastReflection_QueryOracleScriptVersionsRequest QueryOracleScriptVersionsRequest
 
-func (x *QueryOracleScriptRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOracleScriptRequest)(x)
+func (x *QueryOracleScriptVersionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsRequest)(x)
 }
 
-func (x *QueryOracleScriptRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[6]
+func (x *QueryOracleScriptVersionsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2583,43 +4481,43 @@ func (x *QueryOracleScriptRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOracleScriptRequest_messageType fastReflection_QueryOracleScriptRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOracleScriptRequest_messageType{}
+var _fastReflection_QueryOracleScriptVersionsRequest_messageType fastReflection_QueryOracleScriptVersionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOracleScriptVersionsRequest_messageType{}
 
-type fastReflection_QueryOracleScriptRequest_messageType struct{}
+type fastReflection_QueryOracleScriptVersionsRequest_messageType struct{}
 
-func (x fastReflection_QueryOracleScriptRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOracleScriptRequest)(nil)
+func (x fastReflection_QueryOracleScriptVersionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsRequest)(nil)
 }
-func (x fastReflection_QueryOracleScriptRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOracleScriptRequest)
+func (x fastReflection_QueryOracleScriptVersionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsRequest)
 }
-func (x fastReflection_QueryOracleScriptRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOracleScriptRequest
+func (x fastReflection_QueryOracleScriptVersionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOracleScriptRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOracleScriptRequest
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOracleScriptRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOracleScriptRequest_messageType
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOracleScriptVersionsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOracleScriptRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryOracleScriptRequest)
+func (x *fastReflection_QueryOracleScriptVersionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOracleScriptRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryOracleScriptRequest)(x)
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOracleScriptVersionsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2627,10 +4525,16 @@ func (x *fastReflection_QueryOracleScriptRequest) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOracleScriptRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.OracleScriptId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_QueryOracleScriptRequest_oracle_script_id, value) {
+		if !f(fd_QueryOracleScriptVersionsRequest_oracle_script_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOracleScriptVersionsRequest_pagination, value) {
 			return
 		}
 	}
@@ -2647,15 +4551,17 @@ func (x *fastReflection_QueryOracleScriptRequest) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOracleScriptRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
 		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2665,15 +4571,17 @@ func (x *fastReflection_QueryOracleScriptRequest) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOracleScriptRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
 		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2683,16 +4591,19 @@ func (x *fastReflection_QueryOracleScriptRequest) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOracleScriptRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
 		value := x.OracleScriptId
 		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2706,15 +4617,17 @@ func (x *fastReflection_QueryOracleScriptRequest) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOracleScriptRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
 		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2728,40 +4641,48 @@ func (x *fastReflection_QueryOracleScriptRequest) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOracleScriptRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.QueryOracleScriptRequest is not mutable"))
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.QueryOracleScriptVersionsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOracleScriptRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryOracleScriptRequest.oracle_script_id":
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOracleScriptRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryOracleScriptRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryOracleScriptVersionsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2769,7 +4690,7 @@ func (x *fastReflection_QueryOracleScriptRequest) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOracleScriptRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2780,7 +4701,7 @@ func (x *fastReflection_QueryOracleScriptRequest) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOracleScriptRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2792,7 +4713,7 @@ func (x *fastReflection_QueryOracleScriptRequest) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOracleScriptRequest) IsValid() bool {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2802,9 +4723,9 @@ func (x *fastReflection_QueryOracleScriptRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOracleScriptRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOracleScriptVersionsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOracleScriptRequest)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2819,6 +4740,10 @@ func (x *fastReflection_QueryOracleScriptRequest) ProtoMethods() *protoiface.Met
 		if x.OracleScriptId != 0 {
 			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2829,7 +4754,7 @@ func (x *fastReflection_QueryOracleScriptRequest) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOracleScriptRequest)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2848,6 +4773,20 @@ func (x *fastReflection_QueryOracleScriptRequest) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if x.OracleScriptId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
 			i--
@@ -2864,7 +4803,7 @@ func (x *fastReflection_QueryOracleScriptRequest) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOracleScriptRequest)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2896,10 +4835,10 @@ func (x *fastReflection_QueryOracleScriptRequest) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2921,6 +4860,42 @@ func (x *fastReflection_QueryOracleScriptRequest) ProtoMethods() *protoiface.Met
 						break
 					}
 				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2956,27 +4931,80 @@ func (x *fastReflection_QueryOracleScriptRequest) ProtoMethods() *protoiface.Met
 	}
 }
 
+var _ protoreflect.List = (*_QueryOracleScriptVersionsResponse_1_list)(nil)
+
+type _QueryOracleScriptVersionsResponse_1_list struct {
+	list *[]*OracleScriptVersion
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleScriptVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleScriptVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(OracleScriptVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(OracleScriptVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryOracleScriptResponse               protoreflect.MessageDescriptor
-	fd_QueryOracleScriptResponse_oracle_script protoreflect.FieldDescriptor
+	md_QueryOracleScriptVersionsResponse                        protoreflect.MessageDescriptor
+	fd_QueryOracleScriptVersionsResponse_oracle_script_versions protoreflect.FieldDescriptor
+	fd_QueryOracleScriptVersionsResponse_pagination             protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryOracleScriptResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryOracleScriptResponse")
-	fd_QueryOracleScriptResponse_oracle_script = md_QueryOracleScriptResponse.Fields().ByName("oracle_script")
+	md_QueryOracleScriptVersionsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryOracleScriptVersionsResponse")
+	fd_QueryOracleScriptVersionsResponse_oracle_script_versions = md_QueryOracleScriptVersionsResponse.Fields().ByName("oracle_script_versions")
+	fd_QueryOracleScriptVersionsResponse_pagination = md_QueryOracleScriptVersionsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOracleScriptResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOracleScriptVersionsResponse)(nil)
 
-type fastReflection_QueryOracleScriptResponse QueryOracleScriptResponse
+type fastReflection_QueryOracleScriptVersionsResponse QueryOracleScriptVersionsResponse
 
-func (x *QueryOracleScriptResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOracleScriptResponse)(x)
+func (x *QueryOracleScriptVersionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsResponse)(x)
 }
 
-func (x *QueryOracleScriptResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[7]
+func (x *QueryOracleScriptVersionsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2987,43 +5015,43 @@ func (x *QueryOracleScriptResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOracleScriptResponse_messageType fastReflection_QueryOracleScriptResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOracleScriptResponse_messageType{}
+var _fastReflection_QueryOracleScriptVersionsResponse_messageType fastReflection_QueryOracleScriptVersionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOracleScriptVersionsResponse_messageType{}
 
-type fastReflection_QueryOracleScriptResponse_messageType struct{}
+type fastReflection_QueryOracleScriptVersionsResponse_messageType struct{}
 
-func (x fastReflection_QueryOracleScriptResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOracleScriptResponse)(nil)
-}
-func (x fastReflection_QueryOracleScriptResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOracleScriptResponse)
+func (x fastReflection_QueryOracleScriptVersionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsResponse)(nil)
 }
-func (x fastReflection_QueryOracleScriptResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOracleScriptResponse
+func (x fastReflection_QueryOracleScriptVersionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsResponse)
+}
+func (x fastReflection_QueryOracleScriptVersionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOracleScriptResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOracleScriptResponse
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOracleScriptResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOracleScriptResponse_messageType
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOracleScriptVersionsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOracleScriptResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryOracleScriptResponse)
+func (x *fastReflection_QueryOracleScriptVersionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOracleScriptResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryOracleScriptResponse)(x)
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOracleScriptVersionsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3031,10 +5059,16 @@ func (x *fastReflection_QueryOracleScriptResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOracleScriptResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OracleScript != nil {
-		value := protoreflect.ValueOfMessage(x.OracleScript.ProtoReflect())
-		if !f(fd_QueryOracleScriptResponse_oracle_script, value) {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.OracleScriptVersions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryOracleScriptVersionsResponse_1_list{list: &x.OracleScriptVersions})
+		if !f(fd_QueryOracleScriptVersionsResponse_oracle_script_versions, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOracleScriptVersionsResponse_pagination, value) {
 			return
 		}
 	}
@@ -3051,15 +5085,17 @@ func (x *fastReflection_QueryOracleScriptResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOracleScriptResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
-		return x.OracleScript != nil
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.oracle_script_versions":
+		return len(x.OracleScriptVersions) != 0
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3069,15 +5105,17 @@ func (x *fastReflection_QueryOracleScriptResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOracleScriptResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
-		x.OracleScript = nil
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.oracle_script_versions":
+		x.OracleScriptVersions = nil
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3087,16 +5125,22 @@ func (x *fastReflection_QueryOracleScriptResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOracleScriptResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
-		value := x.OracleScript
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.oracle_script_versions":
+		if len(x.OracleScriptVersions) == 0 {
+			return protoreflect.ValueOfList(&_QueryOracleScriptVersionsResponse_1_list{})
+		}
+		listValue := &_QueryOracleScriptVersionsResponse_1_list{list: &x.OracleScriptVersions}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3110,15 +5154,19 @@ func (x *fastReflection_QueryOracleScriptResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOracleScriptResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
-		x.OracleScript = value.Message().Interface().(*OracleScript)
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.oracle_script_versions":
+		lv := value.List()
+		clv := lv.(*_QueryOracleScriptVersionsResponse_1_list)
+		x.OracleScriptVersions = *clv.list
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3132,44 +5180,53 @@ func (x *fastReflection_QueryOracleScriptResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOracleScriptResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
-		if x.OracleScript == nil {
-			x.OracleScript = new(OracleScript)
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.oracle_script_versions":
+		if x.OracleScriptVersions == nil {
+			x.OracleScriptVersions = []*OracleScriptVersion{}
 		}
-		return protoreflect.ValueOfMessage(x.OracleScript.ProtoReflect())
+		value := &_QueryOracleScriptVersionsResponse_1_list{list: &x.OracleScriptVersions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOracleScriptResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryOracleScriptResponse.oracle_script":
-		m := new(OracleScript)
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.oracle_script_versions":
+		list := []*OracleScriptVersion{}
+		return protoreflect.ValueOfList(&_QueryOracleScriptVersionsResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOracleScriptResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryOracleScriptResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryOracleScriptVersionsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3177,7 +5234,7 @@ func (x *fastReflection_QueryOracleScriptResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOracleScriptResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3188,7 +5245,7 @@ func (x *fastReflection_QueryOracleScriptResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOracleScriptResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3200,7 +5257,7 @@ func (x *fastReflection_QueryOracleScriptResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOracleScriptResponse) IsValid() bool {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3210,9 +5267,9 @@ func (x *fastReflection_QueryOracleScriptResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOracleScriptResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOracleScriptVersionsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOracleScriptResponse)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3224,8 +5281,14 @@ func (x *fastReflection_QueryOracleScriptResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.OracleScript != nil {
-			l = options.Size(x.OracleScript)
+		if len(x.OracleScriptVersions) > 0 {
+			for _, e := range x.OracleScriptVersions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3238,7 +5301,7 @@ func (x *fastReflection_QueryOracleScriptResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOracleScriptResponse)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3257,8 +5320,8 @@ func (x *fastReflection_QueryOracleScriptResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.OracleScript != nil {
-			encoded, err := options.Marshal(x.OracleScript)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3269,7 +5332,23 @@ func (x *fastReflection_QueryOracleScriptResponse) ProtoMethods() *protoiface.Me
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.OracleScriptVersions) > 0 {
+			for iNdEx := len(x.OracleScriptVersions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OracleScriptVersions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3282,7 +5361,7 @@ func (x *fastReflection_QueryOracleScriptResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOracleScriptResponse)
+		x := input.Message.Interface().(*QueryOracleScriptVersionsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3314,15 +5393,15 @@ func (x *fastReflection_QueryOracleScriptResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScript", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3349,10 +5428,44 @@ func (x *fastReflection_QueryOracleScriptResponse) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.OracleScript == nil {
-					x.OracleScript = &OracleScript{}
+				x.OracleScriptVersions = append(x.OracleScriptVersions, &OracleScriptVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleScriptVersions[len(x.OracleScriptVersions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleScript); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3411,7 +5524,7 @@ func (x *QueryRequestRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryRequestRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[8]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3847,11 +5960,12 @@ func (x *_QueryRequestResponse_2_list) IsValid() bool {
 }
 
 var (
-	md_QueryRequestResponse         protoreflect.MessageDescriptor
-	fd_QueryRequestResponse_request protoreflect.FieldDescriptor
-	fd_QueryRequestResponse_reports protoreflect.FieldDescriptor
-	fd_QueryRequestResponse_result  protoreflect.FieldDescriptor
-	fd_QueryRequestResponse_signing protoreflect.FieldDescriptor
+	md_QueryRequestResponse                       protoreflect.MessageDescriptor
+	fd_QueryRequestResponse_request               protoreflect.FieldDescriptor
+	fd_QueryRequestResponse_reports               protoreflect.FieldDescriptor
+	fd_QueryRequestResponse_result                protoreflect.FieldDescriptor
+	fd_QueryRequestResponse_signing               protoreflect.FieldDescriptor
+	fd_QueryRequestResponse_oracle_script_version protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3861,6 +5975,7 @@ func init() {
 	fd_QueryRequestResponse_reports = md_QueryRequestResponse.Fields().ByName("reports")
 	fd_QueryRequestResponse_result = md_QueryRequestResponse.Fields().ByName("result")
 	fd_QueryRequestResponse_signing = md_QueryRequestResponse.Fields().ByName("signing")
+	fd_QueryRequestResponse_oracle_script_version = md_QueryRequestResponse.Fields().ByName("oracle_script_version")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryRequestResponse)(nil)
@@ -3872,7 +5987,7 @@ func (x *QueryRequestResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryRequestResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[9]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3952,6 +6067,12 @@ func (x *fastReflection_QueryRequestResponse) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_QueryRequestResponse_oracle_script_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3975,6 +6096,8 @@ func (x *fastReflection_QueryRequestResponse) Has(fd protoreflect.FieldDescripto
 		return x.Result != nil
 	case "band.oracle.v1.QueryRequestResponse.signing":
 		return x.Signing != nil
+	case "band.oracle.v1.QueryRequestResponse.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
@@ -3999,6 +6122,8 @@ func (x *fastReflection_QueryRequestResponse) Clear(fd protoreflect.FieldDescrip
 		x.Result = nil
 	case "band.oracle.v1.QueryRequestResponse.signing":
 		x.Signing = nil
+	case "band.oracle.v1.QueryRequestResponse.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
@@ -4030,6 +6155,9 @@ func (x *fastReflection_QueryRequestResponse) Get(descriptor protoreflect.FieldD
 	case "band.oracle.v1.QueryRequestResponse.signing":
 		value := x.Signing
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.oracle_script_version":
+		value := x.OracleScriptVersion
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
@@ -4060,6 +6188,8 @@ func (x *fastReflection_QueryRequestResponse) Set(fd protoreflect.FieldDescripto
 		x.Result = value.Message().Interface().(*Result)
 	case "band.oracle.v1.QueryRequestResponse.signing":
 		x.Signing = value.Message().Interface().(*SigningResult)
+	case "band.oracle.v1.QueryRequestResponse.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
@@ -4101,6 +6231,8 @@ func (x *fastReflection_QueryRequestResponse) Mutable(fd protoreflect.FieldDescr
 			x.Signing = new(SigningResult)
 		}
 		return protoreflect.ValueOfMessage(x.Signing.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.QueryRequestResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
@@ -4126,6 +6258,8 @@ func (x *fastReflection_QueryRequestResponse) NewField(fd protoreflect.FieldDesc
 	case "band.oracle.v1.QueryRequestResponse.signing":
 		m := new(SigningResult)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.QueryRequestResponse.oracle_script_version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestResponse"))
@@ -4213,6 +6347,9 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.Signing)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.OracleScriptVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptVersion))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4242,6 +6379,11 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.Signing != nil {
 			encoded, err := options.Marshal(x.Signing)
 			if err != nil {
@@ -4491,6 +6633,25 @@ func (x *fastReflection_QueryRequestResponse) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+				}
+				x.OracleScriptVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4546,7 +6707,7 @@ func (x *QueryPendingRequestsRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryPendingRequestsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[10]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5012,7 +7173,7 @@ func (x *QueryPendingRequestsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryPendingRequestsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[11]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5500,7 +7661,7 @@ func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[12]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5858,7 +8019,7 @@ func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[13]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6293,7 +8454,7 @@ func (x *QueryValidatorRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryValidatorRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[14]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6713,7 +8874,7 @@ func (x *QueryValidatorResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryValidatorResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[15]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7150,7 +9311,7 @@ func (x *QueryIsReporterRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryIsReporterRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[16]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7632,7 +9793,7 @@ func (x *QueryIsReporterResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryIsReporterResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[17]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8042,7 +10203,7 @@ func (x *QueryReportersRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryReportersRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[18]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8508,7 +10669,7 @@ func (x *QueryReportersResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryReportersResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[19]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8940,7 +11101,7 @@ func (x *QueryActiveValidatorsRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryActiveValidatorsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[20]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9349,7 +11510,7 @@ func (x *QueryActiveValidatorsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryActiveValidatorsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[21]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9798,7 +11959,7 @@ func (x *QueryRequestSearchRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryRequestSearchRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[22]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10356,7 +12517,7 @@ func (x *QueryRequestSearchResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryRequestSearchResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[23]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10841,7 +13002,7 @@ func (x *QueryRequestPriceRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryRequestPriceRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[24]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11418,7 +13579,7 @@ func (x *QueryRequestPriceResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryRequestPriceResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[25]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11875,7 +14036,7 @@ func (x *QueryRequestVerificationRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryRequestVerificationRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[26]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12677,7 +14838,7 @@ func (x *QueryRequestVerificationResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryRequestVerificationResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_query_proto_msgTypes[27]
+	mi := &file_band_oracle_v1_query_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13533,42 +15694,136 @@ func (x *QueryDataSourceRequest) GetDataSourceId() uint64 {
 	if x != nil {
 		return x.DataSourceId
 	}
-	return 0
+	return 0
+}
+
+// QueryDataSourceResponse is response type for the Query/DataSource RPC method.
+type QueryDataSourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSource is summary information of a data source
+	DataSource *DataSource `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
+}
+
+func (x *QueryDataSourceResponse) Reset() {
+	*x = QueryDataSourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataSourceResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDataSourceResponse.ProtoReflect.Descriptor instead.
+func (*QueryDataSourceResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryDataSourceResponse) GetDataSource() *DataSource {
+	if x != nil {
+		return x.DataSource
+	}
+	return nil
+}
+
+// QueryDataSourceVersionsRequest is request type for the
+// Query/DataSourceVersions RPC method.
+type QueryDataSourceVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSourceID is ID of a data source script
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryDataSourceVersionsRequest) Reset() {
+	*x = QueryDataSourceVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataSourceVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataSourceVersionsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDataSourceVersionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryDataSourceVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryDataSourceVersionsRequest) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *QueryDataSourceVersionsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
 }
 
-// QueryDataSourceResponse is response type for the Query/DataSource RPC method.
-type QueryDataSourceResponse struct {
+// QueryDataSourceVersionsResponse is response type for the
+// Query/DataSourceVersions RPC method.
+type QueryDataSourceVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DataSource is summary information of a data source
-	DataSource *DataSource `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
+	// DataSourceVersions is the list of versions of the data source
+	DataSourceVersions []*DataSourceVersion `protobuf:"bytes,1,rep,name=data_source_versions,json=dataSourceVersions,proto3" json:"data_source_versions,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryDataSourceResponse) Reset() {
-	*x = QueryDataSourceResponse{}
+func (x *QueryDataSourceVersionsResponse) Reset() {
+	*x = QueryDataSourceVersionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[5]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDataSourceResponse) String() string {
+func (x *QueryDataSourceVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDataSourceResponse) ProtoMessage() {}
+func (*QueryDataSourceVersionsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryDataSourceResponse.ProtoReflect.Descriptor instead.
-func (*QueryDataSourceResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryDataSourceVersionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryDataSourceVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *QueryDataSourceResponse) GetDataSource() *DataSource {
+func (x *QueryDataSourceVersionsResponse) GetDataSourceVersions() []*DataSourceVersion {
 	if x != nil {
-		return x.DataSource
+		return x.DataSourceVersions
+	}
+	return nil
+}
+
+func (x *QueryDataSourceVersionsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
@@ -13587,7 +15842,7 @@ type QueryOracleScriptRequest struct {
 func (x *QueryOracleScriptRequest) Reset() {
 	*x = QueryOracleScriptRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[6]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13601,7 +15856,7 @@ func (*QueryOracleScriptRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryOracleScriptRequest.ProtoReflect.Descriptor instead.
 func (*QueryOracleScriptRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *QueryOracleScriptRequest) GetOracleScriptId() uint64 {
@@ -13625,7 +15880,7 @@ type QueryOracleScriptResponse struct {
 func (x *QueryOracleScriptResponse) Reset() {
 	*x = QueryOracleScriptResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[7]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13639,7 +15894,7 @@ func (*QueryOracleScriptResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryOracleScriptResponse.ProtoReflect.Descriptor instead.
 func (*QueryOracleScriptResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueryOracleScriptResponse) GetOracleScript() *OracleScript {
@@ -13649,6 +15904,100 @@ func (x *QueryOracleScriptResponse) GetOracleScript() *OracleScript {
 	return nil
 }
 
+// QueryOracleScriptVersionsRequest is request type for the
+// Query/OracleScriptVersions RPC method.
+type QueryOracleScriptVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryOracleScriptVersionsRequest) Reset() {
+	*x = QueryOracleScriptVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOracleScriptVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOracleScriptVersionsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryOracleScriptVersionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryOracleScriptVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryOracleScriptVersionsRequest) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
+	}
+	return 0
+}
+
+func (x *QueryOracleScriptVersionsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryOracleScriptVersionsResponse is response type for the
+// Query/OracleScriptVersions RPC method.
+type QueryOracleScriptVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptVersions is the list of versions of the oracle script
+	OracleScriptVersions []*OracleScriptVersion `protobuf:"bytes,1,rep,name=oracle_script_versions,json=oracleScriptVersions,proto3" json:"oracle_script_versions,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryOracleScriptVersionsResponse) Reset() {
+	*x = QueryOracleScriptVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOracleScriptVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOracleScriptVersionsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryOracleScriptVersionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryOracleScriptVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryOracleScriptVersionsResponse) GetOracleScriptVersions() []*OracleScriptVersion {
+	if x != nil {
+		return x.OracleScriptVersions
+	}
+	return nil
+}
+
+func (x *QueryOracleScriptVersionsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 // QueryRequestRequest is request type for the Query/Request RPC method.
 type QueryRequestRequest struct {
 	state         protoimpl.MessageState
@@ -13662,7 +16011,7 @@ type QueryRequestRequest struct {
 func (x *QueryRequestRequest) Reset() {
 	*x = QueryRequestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13676,7 +16025,7 @@ func (*QueryRequestRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryRequestRequest.ProtoReflect.Descriptor instead.
 func (*QueryRequestRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QueryRequestRequest) GetRequestId() uint64 {
@@ -13701,12 +16050,16 @@ type QueryRequestResponse struct {
 	Result *Result `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 	// Signing is the signing detail in the bandtss module.
 	Signing *SigningResult `protobuf:"bytes,4,opt,name=signing,proto3" json:"signing,omitempty"`
+	// OracleScriptVersion is the version of the oracle script used to resolve the
+	// request. It is 0 if the request is not resolved yet or was resolved before
+	// oracle scripts are versioned.
+	OracleScriptVersion uint64 `protobuf:"varint,5,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
 }
 
 func (x *QueryRequestResponse) Reset() {
 	*x = QueryRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13720,7 +16073,7 @@ func (*QueryRequestResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryRequestResponse.ProtoReflect.Descriptor instead.
 func (*QueryRequestResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryRequestResponse) GetRequest() *Request {
@@ -13751,6 +16104,13 @@ func (x *QueryRequestResponse) GetSigning() *SigningResult {
 	return nil
 }
 
+func (x *QueryRequestResponse) GetOracleScriptVersion() uint64 {
+	if x != nil {
+		return x.OracleScriptVersion
+	}
+	return 0
+}
+
 // QueryPendingRequestRequest is request type for the Query/PendingRequests RPC
 // method.
 type QueryPendingRequestsRequest struct {
@@ -13765,7 +16125,7 @@ type QueryPendingRequestsRequest struct {
 func (x *QueryPendingRequestsRequest) Reset() {
 	*x = QueryPendingRequestsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13779,7 +16139,7 @@ func (*QueryPendingRequestsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryPendingRequestsRequest.ProtoReflect.Descriptor instead.
 func (*QueryPendingRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryPendingRequestsRequest) GetValidatorAddress() string {
@@ -13803,7 +16163,7 @@ type QueryPendingRequestsResponse struct {
 func (x *QueryPendingRequestsResponse) Reset() {
 	*x = QueryPendingRequestsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13817,7 +16177,7 @@ func (*QueryPendingRequestsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryPendingRequestsResponse.ProtoReflect.Descriptor instead.
 func (*QueryPendingRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryPendingRequestsResponse) GetRequestIds() []uint64 {
@@ -13837,7 +16197,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[12]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13851,7 +16211,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 // QueryParamsResponse is response type for the Query/Params RPC method.
@@ -13867,7 +16227,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13881,7 +16241,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -13904,7 +16264,7 @@ type QueryValidatorRequest struct {
 func (x *QueryValidatorRequest) Reset() {
 	*x = QueryValidatorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[14]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13918,7 +16278,7 @@ func (*QueryValidatorRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryValidatorRequest.ProtoReflect.Descriptor instead.
 func (*QueryValidatorRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QueryValidatorRequest) GetValidatorAddress() string {
@@ -13941,7 +16301,7 @@ type QueryValidatorResponse struct {
 func (x *QueryValidatorResponse) Reset() {
 	*x = QueryValidatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[15]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13955,7 +16315,7 @@ func (*QueryValidatorResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryValidatorResponse.ProtoReflect.Descriptor instead.
 func (*QueryValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryValidatorResponse) GetStatus() *ValidatorStatus {
@@ -13980,7 +16340,7 @@ type QueryIsReporterRequest struct {
 func (x *QueryIsReporterRequest) Reset() {
 	*x = QueryIsReporterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[16]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13994,7 +16354,7 @@ func (*QueryIsReporterRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryIsReporterRequest.ProtoReflect.Descriptor instead.
 func (*QueryIsReporterRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueryIsReporterRequest) GetValidatorAddress() string {
@@ -14024,7 +16384,7 @@ type QueryIsReporterResponse struct {
 func (x *QueryIsReporterResponse) Reset() {
 	*x = QueryIsReporterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[17]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14038,7 +16398,7 @@ func (*QueryIsReporterResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryIsReporterResponse.ProtoReflect.Descriptor instead.
 func (*QueryIsReporterResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueryIsReporterResponse) GetIsReporter() bool {
@@ -14061,7 +16421,7 @@ type QueryReportersRequest struct {
 func (x *QueryReportersRequest) Reset() {
 	*x = QueryReportersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[18]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14075,7 +16435,7 @@ func (*QueryReportersRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryReportersRequest.ProtoReflect.Descriptor instead.
 func (*QueryReportersRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{18}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueryReportersRequest) GetValidatorAddress() string {
@@ -14098,7 +16458,7 @@ type QueryReportersResponse struct {
 func (x *QueryReportersResponse) Reset() {
 	*x = QueryReportersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[19]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14112,7 +16472,7 @@ func (*QueryReportersResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryReportersResponse.ProtoReflect.Descriptor instead.
 func (*QueryReportersResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{19}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueryReportersResponse) GetReporter() []string {
@@ -14133,7 +16493,7 @@ type QueryActiveValidatorsRequest struct {
 func (x *QueryActiveValidatorsRequest) Reset() {
 	*x = QueryActiveValidatorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[20]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14147,7 +16507,7 @@ func (*QueryActiveValidatorsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryActiveValidatorsRequest.ProtoReflect.Descriptor instead.
 func (*QueryActiveValidatorsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{20}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
 // QueryActiveValidatorsResponse is response type for the Query/ActiveValidators
@@ -14164,7 +16524,7 @@ type QueryActiveValidatorsResponse struct {
 func (x *QueryActiveValidatorsResponse) Reset() {
 	*x = QueryActiveValidatorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[21]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14178,7 +16538,7 @@ func (*QueryActiveValidatorsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryActiveValidatorsResponse.ProtoReflect.Descriptor instead.
 func (*QueryActiveValidatorsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{21}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QueryActiveValidatorsResponse) GetValidators() []*ActiveValidator {
@@ -14209,7 +16569,7 @@ type QueryRequestSearchRequest struct {
 func (x *QueryRequestSearchRequest) Reset() {
 	*x = QueryRequestSearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[22]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14223,7 +16583,7 @@ func (*QueryRequestSearchRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryRequestSearchRequest.ProtoReflect.Descriptor instead.
 func (*QueryRequestSearchRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{22}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *QueryRequestSearchRequest) GetOracleScriptId() uint64 {
@@ -14268,7 +16628,7 @@ type QueryRequestSearchResponse struct {
 func (x *QueryRequestSearchResponse) Reset() {
 	*x = QueryRequestSearchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[23]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14282,7 +16642,7 @@ func (*QueryRequestSearchResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryRequestSearchResponse.ProtoReflect.Descriptor instead.
 func (*QueryRequestSearchResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{23}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *QueryRequestSearchResponse) GetRequest() *QueryRequestResponse {
@@ -14310,7 +16670,7 @@ type QueryRequestPriceRequest struct {
 func (x *QueryRequestPriceRequest) Reset() {
 	*x = QueryRequestPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[24]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14324,7 +16684,7 @@ func (*QueryRequestPriceRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryRequestPriceRequest.ProtoReflect.Descriptor instead.
 func (*QueryRequestPriceRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{24}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryRequestPriceRequest) GetSymbols() []string {
@@ -14362,7 +16722,7 @@ type QueryRequestPriceResponse struct {
 func (x *QueryRequestPriceResponse) Reset() {
 	*x = QueryRequestPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[25]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14376,7 +16736,7 @@ func (*QueryRequestPriceResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryRequestPriceResponse.ProtoReflect.Descriptor instead.
 func (*QueryRequestPriceResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{25}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *QueryRequestPriceResponse) GetPriceResults() []*PriceResult {
@@ -14418,7 +16778,7 @@ type QueryRequestVerificationRequest struct {
 func (x *QueryRequestVerificationRequest) Reset() {
 	*x = QueryRequestVerificationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[26]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14432,7 +16792,7 @@ func (*QueryRequestVerificationRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryRequestVerificationRequest.ProtoReflect.Descriptor instead.
 func (*QueryRequestVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{26}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *QueryRequestVerificationRequest) GetChainId() string {
@@ -14517,7 +16877,7 @@ type QueryRequestVerificationResponse struct {
 func (x *QueryRequestVerificationResponse) Reset() {
 	*x = QueryRequestVerificationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[27]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14531,7 +16891,7 @@ func (*QueryRequestVerificationResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryRequestVerificationResponse.ProtoReflect.Descriptor instead.
 func (*QueryRequestVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{27}
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *QueryRequestVerificationResponse) GetChainId() string {
@@ -14586,64 +16946,114 @@ var file_band_oracle_v1_query_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
 	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e,
-	0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96,
-	0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x11, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x22, 0x27, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x3e, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x64, 0x22, 0x56, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x64,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x44, 0x0a, 0x18, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x22,
-	0x5e, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x52, 0x0c, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22,
-	0x34, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
-	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x69,
-	0x6e, 0x67, 0x22, 0x6d, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x13, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x27, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3e, 0x0a,
+	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x56, 0x0a,
+	0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x46,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x12, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44,
+	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0d, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x0c, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcd, 0x01, 0x0a, 0x21,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5f, 0x0a, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x13, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0x9e, 0x02, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x32,
+	0x0a, 0x15, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69,
 	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4,
@@ -14772,7 +17182,7 @@ var file_band_oracle_v1_query_proto_rawDesc = []byte{
 	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x64, 0x65,
 	0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x44, 0x65, 0x6c,
-	0x61, 0x79, 0x32, 0xb9, 0x0f, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6c, 0x0a, 0x06,
+	0x61, 0x79, 0x32, 0xa9, 0x12, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6c, 0x0a, 0x06,
 	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
 	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75,
 	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x61, 0x6e,
@@ -14795,17 +17205,40 @@ var file_band_oracle_v1_query_proto_rawDesc = []byte{
 	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3,
 	0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31,
 	0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x99,
-	0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
-	0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7e, 0x0a, 0x07, 0x52, 0x65,
+	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb0,
+	0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31,
+	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x99, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12,
+	0x2c, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xba, 0x01,
+	0x0a, 0x14, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7e, 0x0a, 0x07, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
 	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x61, 0x6e,
@@ -14923,92 +17356,110 @@ func file_band_oracle_v1_query_proto_rawDescGZIP() []byte {
 	return file_band_oracle_v1_query_proto_rawDescData
 }
 
-var file_band_oracle_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_band_oracle_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_band_oracle_v1_query_proto_goTypes = []interface{}{
-	(*QueryCountsRequest)(nil),               // 0: band.oracle.v1.QueryCountsRequest
-	(*QueryCountsResponse)(nil),              // 1: band.oracle.v1.QueryCountsResponse
-	(*QueryDataRequest)(nil),                 // 2: band.oracle.v1.QueryDataRequest
-	(*QueryDataResponse)(nil),                // 3: band.oracle.v1.QueryDataResponse
-	(*QueryDataSourceRequest)(nil),           // 4: band.oracle.v1.QueryDataSourceRequest
-	(*QueryDataSourceResponse)(nil),          // 5: band.oracle.v1.QueryDataSourceResponse
-	(*QueryOracleScriptRequest)(nil),         // 6: band.oracle.v1.QueryOracleScriptRequest
-	(*QueryOracleScriptResponse)(nil),        // 7: band.oracle.v1.QueryOracleScriptResponse
-	(*QueryRequestRequest)(nil),              // 8: band.oracle.v1.QueryRequestRequest
-	(*QueryRequestResponse)(nil),             // 9: band.oracle.v1.QueryRequestResponse
-	(*QueryPendingRequestsRequest)(nil),      // 10: band.oracle.v1.QueryPendingRequestsRequest
-	(*QueryPendingRequestsResponse)(nil),     // 11: band.oracle.v1.QueryPendingRequestsResponse
-	(*QueryParamsRequest)(nil),               // 12: band.oracle.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),              // 13: band.oracle.v1.QueryParamsResponse
-	(*QueryValidatorRequest)(nil),            // 14: band.oracle.v1.QueryValidatorRequest
-	(*QueryValidatorResponse)(nil),           // 15: band.oracle.v1.QueryValidatorResponse
-	(*QueryIsReporterRequest)(nil),           // 16: band.oracle.v1.QueryIsReporterRequest
-	(*QueryIsReporterResponse)(nil),          // 17: band.oracle.v1.QueryIsReporterResponse
-	(*QueryReportersRequest)(nil),            // 18: band.oracle.v1.QueryReportersRequest
-	(*QueryReportersResponse)(nil),           // 19: band.oracle.v1.QueryReportersResponse
-	(*QueryActiveValidatorsRequest)(nil),     // 20: band.oracle.v1.QueryActiveValidatorsRequest
-	(*QueryActiveValidatorsResponse)(nil),    // 21: band.oracle.v1.QueryActiveValidatorsResponse
-	(*QueryRequestSearchRequest)(nil),        // 22: band.oracle.v1.QueryRequestSearchRequest
-	(*QueryRequestSearchResponse)(nil),       // 23: band.oracle.v1.QueryRequestSearchResponse
-	(*QueryRequestPriceRequest)(nil),         // 24: band.oracle.v1.QueryRequestPriceRequest
-	(*QueryRequestPriceResponse)(nil),        // 25: band.oracle.v1.QueryRequestPriceResponse
-	(*QueryRequestVerificationRequest)(nil),  // 26: band.oracle.v1.QueryRequestVerificationRequest
-	(*QueryRequestVerificationResponse)(nil), // 27: band.oracle.v1.QueryRequestVerificationResponse
-	(*DataSource)(nil),                       // 28: band.oracle.v1.DataSource
-	(*OracleScript)(nil),                     // 29: band.oracle.v1.OracleScript
-	(*Request)(nil),                          // 30: band.oracle.v1.Request
-	(*Report)(nil),                           // 31: band.oracle.v1.Report
-	(*Result)(nil),                           // 32: band.oracle.v1.Result
-	(*SigningResult)(nil),                    // 33: band.oracle.v1.SigningResult
-	(*Params)(nil),                           // 34: band.oracle.v1.Params
-	(*ValidatorStatus)(nil),                  // 35: band.oracle.v1.ValidatorStatus
-	(*ActiveValidator)(nil),                  // 36: band.oracle.v1.ActiveValidator
-	(*PriceResult)(nil),                      // 37: band.oracle.v1.PriceResult
+	(*QueryCountsRequest)(nil),                // 0: band.oracle.v1.QueryCountsRequest
+	(*QueryCountsResponse)(nil),               // 1: band.oracle.v1.QueryCountsResponse
+	(*QueryDataRequest)(nil),                  // 2: band.oracle.v1.QueryDataRequest
+	(*QueryDataResponse)(nil),                 // 3: band.oracle.v1.QueryDataResponse
+	(*QueryDataSourceRequest)(nil),            // 4: band.oracle.v1.QueryDataSourceRequest
+	(*QueryDataSourceResponse)(nil),           // 5: band.oracle.v1.QueryDataSourceResponse
+	(*QueryDataSourceVersionsRequest)(nil),    // 6: band.oracle.v1.QueryDataSourceVersionsRequest
+	(*QueryDataSourceVersionsResponse)(nil),   // 7: band.oracle.v1.QueryDataSourceVersionsResponse
+	(*QueryOracleScriptRequest)(nil),          // 8: band.oracle.v1.QueryOracleScriptRequest
+	(*QueryOracleScriptResponse)(nil),         // 9: band.oracle.v1.QueryOracleScriptResponse
+	(*QueryOracleScriptVersionsRequest)(nil),  // 10: band.oracle.v1.QueryOracleScriptVersionsRequest
+	(*QueryOracleScriptVersionsResponse)(nil), // 11: band.oracle.v1.QueryOracleScriptVersionsResponse
+	(*QueryRequestRequest)(nil),               // 12: band.oracle.v1.QueryRequestRequest
+	(*QueryRequestResponse)(nil),              // 13: band.oracle.v1.QueryRequestResponse
+	(*QueryPendingRequestsRequest)(nil),       // 14: band.oracle.v1.QueryPendingRequestsRequest
+	(*QueryPendingRequestsResponse)(nil),      // 15: band.oracle.v1.QueryPendingRequestsResponse
+	(*QueryParamsRequest)(nil),                // 16: band.oracle.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),               // 17: band.oracle.v1.QueryParamsResponse
+	(*QueryValidatorRequest)(nil),             // 18: band.oracle.v1.QueryValidatorRequest
+	(*QueryValidatorResponse)(nil),            // 19: band.oracle.v1.QueryValidatorResponse
+	(*QueryIsReporterRequest)(nil),            // 20: band.oracle.v1.QueryIsReporterRequest
+	(*QueryIsReporterResponse)(nil),           // 21: band.oracle.v1.QueryIsReporterResponse
+	(*QueryReportersRequest)(nil),             // 22: band.oracle.v1.QueryReportersRequest
+	(*QueryReportersResponse)(nil),            // 23: band.oracle.v1.QueryReportersResponse
+	(*QueryActiveValidatorsRequest)(nil),      // 24: band.oracle.v1.QueryActiveValidatorsRequest
+	(*QueryActiveValidatorsResponse)(nil),     // 25: band.oracle.v1.QueryActiveValidatorsResponse
+	(*QueryRequestSearchRequest)(nil),         // 26: band.oracle.v1.QueryRequestSearchRequest
+	(*QueryRequestSearchResponse)(nil),        // 27: band.oracle.v1.QueryRequestSearchResponse
+	(*QueryRequestPriceRequest)(nil),          // 28: band.oracle.v1.QueryRequestPriceRequest
+	(*QueryRequestPriceResponse)(nil),         // 29: band.oracle.v1.QueryRequestPriceResponse
+	(*QueryRequestVerificationRequest)(nil),   // 30: band.oracle.v1.QueryRequestVerificationRequest
+	(*QueryRequestVerificationResponse)(nil),  // 31: band.oracle.v1.QueryRequestVerificationResponse
+	(*DataSource)(nil),                        // 32: band.oracle.v1.DataSource
+	(*v1beta1.PageRequest)(nil),               // 33: cosmos.base.query.v1beta1.PageRequest
+	(*DataSourceVersion)(nil),                 // 34: band.oracle.v1.DataSourceVersion
+	(*v1beta1.PageResponse)(nil),              // 35: cosmos.base.query.v1beta1.PageResponse
+	(*OracleScript)(nil),                      // 36: band.oracle.v1.OracleScript
+	(*OracleScriptVersion)(nil),               // 37: band.oracle.v1.OracleScriptVersion
+	(*Request)(nil),                           // 38: band.oracle.v1.Request
+	(*Report)(nil),                            // 39: band.oracle.v1.Report
+	(*Result)(nil),                            // 40: band.oracle.v1.Result
+	(*SigningResult)(nil),                     // 41: band.oracle.v1.SigningResult
+	(*Params)(nil),                            // 42: band.oracle.v1.Params
+	(*ValidatorStatus)(nil),                   // 43: band.oracle.v1.ValidatorStatus
+	(*ActiveValidator)(nil),                   // 44: band.oracle.v1.ActiveValidator
+	(*PriceResult)(nil),                       // 45: band.oracle.v1.PriceResult
 }
 var file_band_oracle_v1_query_proto_depIdxs = []int32{
-	28, // 0: band.oracle.v1.QueryDataSourceResponse.data_source:type_name -> band.oracle.v1.DataSource
-	29, // 1: band.oracle.v1.QueryOracleScriptResponse.oracle_script:type_name -> band.oracle.v1.OracleScript
-	30, // 2: band.oracle.v1.QueryRequestResponse.request:type_name -> band.oracle.v1.Request
-	31, // 3: band.oracle.v1.QueryRequestResponse.reports:type_name -> band.oracle.v1.Report
-	32, // 4: band.oracle.v1.QueryRequestResponse.result:type_name -> band.oracle.v1.Result
-	33, // 5: band.oracle.v1.QueryRequestResponse.signing:type_name -> band.oracle.v1.SigningResult
-	34, // 6: band.oracle.v1.QueryParamsResponse.params:type_name -> band.oracle.v1.Params
-	35, // 7: band.oracle.v1.QueryValidatorResponse.status:type_name -> band.oracle.v1.ValidatorStatus
-	36, // 8: band.oracle.v1.QueryActiveValidatorsResponse.validators:type_name -> band.oracle.v1.ActiveValidator
-	9,  // 9: band.oracle.v1.QueryRequestSearchResponse.request:type_name -> band.oracle.v1.QueryRequestResponse
-	37, // 10: band.oracle.v1.QueryRequestPriceResponse.price_results:type_name -> band.oracle.v1.PriceResult
-	0,  // 11: band.oracle.v1.Query.Counts:input_type -> band.oracle.v1.QueryCountsRequest
-	2,  // 12: band.oracle.v1.Query.Data:input_type -> band.oracle.v1.QueryDataRequest
-	4,  // 13: band.oracle.v1.Query.DataSource:input_type -> band.oracle.v1.QueryDataSourceRequest
-	6,  // 14: band.oracle.v1.Query.OracleScript:input_type -> band.oracle.v1.QueryOracleScriptRequest
-	8,  // 15: band.oracle.v1.Query.Request:input_type -> band.oracle.v1.QueryRequestRequest
-	10, // 16: band.oracle.v1.Query.PendingRequests:input_type -> band.oracle.v1.QueryPendingRequestsRequest
-	14, // 17: band.oracle.v1.Query.Validator:input_type -> band.oracle.v1.QueryValidatorRequest
-	16, // 18: band.oracle.v1.Query.IsReporter:input_type -> band.oracle.v1.QueryIsReporterRequest
-	18, // 19: band.oracle.v1.Query.Reporters:input_type -> band.oracle.v1.QueryReportersRequest
-	20, // 20: band.oracle.v1.Query.ActiveValidators:input_type -> band.oracle.v1.QueryActiveValidatorsRequest
-	12, // 21: band.oracle.v1.Query.Params:input_type -> band.oracle.v1.QueryParamsRequest
-	22, // 22: band.oracle.v1.Query.RequestSearch:input_type -> band.oracle.v1.QueryRequestSearchRequest
-	24, // 23: band.oracle.v1.Query.RequestPrice:input_type -> band.oracle.v1.QueryRequestPriceRequest
-	26, // 24: band.oracle.v1.Query.RequestVerification:input_type -> band.oracle.v1.QueryRequestVerificationRequest
-	1,  // 25: band.oracle.v1.Query.Counts:output_type -> band.oracle.v1.QueryCountsResponse
-	3,  // 26: band.oracle.v1.Query.Data:output_type -> band.oracle.v1.QueryDataResponse
-	5,  // 27: band.oracle.v1.Query.DataSource:output_type -> band.oracle.v1.QueryDataSourceResponse
-	7,  // 28: band.oracle.v1.Query.OracleScript:output_type -> band.oracle.v1.QueryOracleScriptResponse
-	9,  // 29: band.oracle.v1.Query.Request:output_type -> band.oracle.v1.QueryRequestResponse
-	11, // 30: band.oracle.v1.Query.PendingRequests:output_type -> band.oracle.v1.QueryPendingRequestsResponse
-	15, // 31: band.oracle.v1.Query.Validator:output_type -> band.oracle.v1.QueryValidatorResponse
-	17, // 32: band.oracle.v1.Query.IsReporter:output_type -> band.oracle.v1.QueryIsReporterResponse
-	19, // 33: band.oracle.v1.Query.Reporters:output_type -> band.oracle.v1.QueryReportersResponse
-	21, // 34: band.oracle.v1.Query.ActiveValidators:output_type -> band.oracle.v1.QueryActiveValidatorsResponse
-	13, // 35: band.oracle.v1.Query.Params:output_type -> band.oracle.v1.QueryParamsResponse
-	23, // 36: band.oracle.v1.Query.RequestSearch:output_type -> band.oracle.v1.QueryRequestSearchResponse
-	25, // 37: band.oracle.v1.Query.RequestPrice:output_type -> band.oracle.v1.QueryRequestPriceResponse
-	27, // 38: band.oracle.v1.Query.RequestVerification:output_type -> band.oracle.v1.QueryRequestVerificationResponse
-	25, // [25:39] is the sub-list for method output_type
-	11, // [11:25] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	32, // 0: band.oracle.v1.QueryDataSourceResponse.data_source:type_name -> band.oracle.v1.DataSource
+	33, // 1: band.oracle.v1.QueryDataSourceVersionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	34, // 2: band.oracle.v1.QueryDataSourceVersionsResponse.data_source_versions:type_name -> band.oracle.v1.DataSourceVersion
+	35, // 3: band.oracle.v1.QueryDataSourceVersionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	36, // 4: band.oracle.v1.QueryOracleScriptResponse.oracle_script:type_name -> band.oracle.v1.OracleScript
+	33, // 5: band.oracle.v1.QueryOracleScriptVersionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	37, // 6: band.oracle.v1.QueryOracleScriptVersionsResponse.oracle_script_versions:type_name -> band.oracle.v1.OracleScriptVersion
+	35, // 7: band.oracle.v1.QueryOracleScriptVersionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 8: band.oracle.v1.QueryRequestResponse.request:type_name -> band.oracle.v1.Request
+	39, // 9: band.oracle.v1.QueryRequestResponse.reports:type_name -> band.oracle.v1.Report
+	40, // 10: band.oracle.v1.QueryRequestResponse.result:type_name -> band.oracle.v1.Result
+	41, // 11: band.oracle.v1.QueryRequestResponse.signing:type_name -> band.oracle.v1.SigningResult
+	42, // 12: band.oracle.v1.QueryParamsResponse.params:type_name -> band.oracle.v1.Params
+	43, // 13: band.oracle.v1.QueryValidatorResponse.status:type_name -> band.oracle.v1.ValidatorStatus
+	44, // 14: band.oracle.v1.QueryActiveValidatorsResponse.validators:type_name -> band.oracle.v1.ActiveValidator
+	13, // 15: band.oracle.v1.QueryRequestSearchResponse.request:type_name -> band.oracle.v1.QueryRequestResponse
+	45, // 16: band.oracle.v1.QueryRequestPriceResponse.price_results:type_name -> band.oracle.v1.PriceResult
+	0,  // 17: band.oracle.v1.Query.Counts:input_type -> band.oracle.v1.QueryCountsRequest
+	2,  // 18: band.oracle.v1.Query.Data:input_type -> band.oracle.v1.QueryDataRequest
+	4,  // 19: band.oracle.v1.Query.DataSource:input_type -> band.oracle.v1.QueryDataSourceRequest
+	6,  // 20: band.oracle.v1.Query.DataSourceVersions:input_type -> band.oracle.v1.QueryDataSourceVersionsRequest
+	8,  // 21: band.oracle.v1.Query.OracleScript:input_type -> band.oracle.v1.QueryOracleScriptRequest
+	10, // 22: band.oracle.v1.Query.OracleScriptVersions:input_type -> band.oracle.v1.QueryOracleScriptVersionsRequest
+	12, // 23: band.oracle.v1.Query.Request:input_type -> band.oracle.v1.QueryRequestRequest
+	14, // 24: band.oracle.v1.Query.PendingRequests:input_type -> band.oracle.v1.QueryPendingRequestsRequest
+	18, // 25: band.oracle.v1.Query.Validator:input_type -> band.oracle.v1.QueryValidatorRequest
+	20, // 26: band.oracle.v1.Query.IsReporter:input_type -> band.oracle.v1.QueryIsReporterRequest
+	22, // 27: band.oracle.v1.Query.Reporters:input_type -> band.oracle.v1.QueryReportersRequest
+	24, // 28: band.oracle.v1.Query.ActiveValidators:input_type -> band.oracle.v1.QueryActiveValidatorsRequest
+	16, // 29: band.oracle.v1.Query.Params:input_type -> band.oracle.v1.QueryParamsRequest
+	26, // 30: band.oracle.v1.Query.RequestSearch:input_type -> band.oracle.v1.QueryRequestSearchRequest
+	28, // 31: band.oracle.v1.Query.RequestPrice:input_type -> band.oracle.v1.QueryRequestPriceRequest
+	30, // 32: band.oracle.v1.Query.RequestVerification:input_type -> band.oracle.v1.QueryRequestVerificationRequest
+	1,  // 33: band.oracle.v1.Query.Counts:output_type -> band.oracle.v1.QueryCountsResponse
+	3,  // 34: band.oracle.v1.Query.Data:output_type -> band.oracle.v1.QueryDataResponse
+	5,  // 35: band.oracle.v1.Query.DataSource:output_type -> band.oracle.v1.QueryDataSourceResponse
+	7,  // 36: band.oracle.v1.Query.DataSourceVersions:output_type -> band.oracle.v1.QueryDataSourceVersionsResponse
+	9,  // 37: band.oracle.v1.Query.OracleScript:output_type -> band.oracle.v1.QueryOracleScriptResponse
+	11, // 38: band.oracle.v1.Query.OracleScriptVersions:output_type -> band.oracle.v1.QueryOracleScriptVersionsResponse
+	13, // 39: band.oracle.v1.Query.Request:output_type -> band.oracle.v1.QueryRequestResponse
+	15, // 40: band.oracle.v1.Query.PendingRequests:output_type -> band.oracle.v1.QueryPendingRequestsResponse
+	19, // 41: band.oracle.v1.Query.Validator:output_type -> band.oracle.v1.QueryValidatorResponse
+	21, // 42: band.oracle.v1.Query.IsReporter:output_type -> band.oracle.v1.QueryIsReporterResponse
+	23, // 43: band.oracle.v1.Query.Reporters:output_type -> band.oracle.v1.QueryReportersResponse
+	25, // 44: band.oracle.v1.Query.ActiveValidators:output_type -> band.oracle.v1.QueryActiveValidatorsResponse
+	17, // 45: band.oracle.v1.Query.Params:output_type -> band.oracle.v1.QueryParamsResponse
+	27, // 46: band.oracle.v1.Query.RequestSearch:output_type -> band.oracle.v1.QueryRequestSearchResponse
+	29, // 47: band.oracle.v1.Query.RequestPrice:output_type -> band.oracle.v1.QueryRequestPriceResponse
+	31, // 48: band.oracle.v1.Query.RequestVerification:output_type -> band.oracle.v1.QueryRequestVerificationResponse
+	33, // [33:49] is the sub-list for method output_type
+	17, // [17:33] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_query_proto_init() }
@@ -15091,7 +17542,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOracleScriptRequest); i {
+			switch v := v.(*QueryDataSourceVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15103,7 +17554,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOracleScriptResponse); i {
+			switch v := v.(*QueryDataSourceVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15115,7 +17566,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestRequest); i {
+			switch v := v.(*QueryOracleScriptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15127,7 +17578,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestResponse); i {
+			switch v := v.(*QueryOracleScriptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15139,7 +17590,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPendingRequestsRequest); i {
+			switch v := v.(*QueryOracleScriptVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15151,7 +17602,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPendingRequestsResponse); i {
+			switch v := v.(*QueryOracleScriptVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15163,7 +17614,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15175,7 +17626,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsResponse); i {
+			switch v := v.(*QueryRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15187,7 +17638,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorRequest); i {
+			switch v := v.(*QueryPendingRequestsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15199,7 +17650,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorResponse); i {
+			switch v := v.(*QueryPendingRequestsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15211,7 +17662,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryIsReporterRequest); i {
+			switch v := v.(*QueryParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15223,7 +17674,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryIsReporterResponse); i {
+			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15235,7 +17686,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryReportersRequest); i {
+			switch v := v.(*QueryValidatorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15247,7 +17698,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryReportersResponse); i {
+			switch v := v.(*QueryValidatorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15259,7 +17710,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActiveValidatorsRequest); i {
+			switch v := v.(*QueryIsReporterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15271,7 +17722,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActiveValidatorsResponse); i {
+			switch v := v.(*QueryIsReporterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15283,7 +17734,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestSearchRequest); i {
+			switch v := v.(*QueryReportersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15295,7 +17746,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestSearchResponse); i {
+			switch v := v.(*QueryReportersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15307,7 +17758,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestPriceRequest); i {
+			switch v := v.(*QueryActiveValidatorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15319,7 +17770,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestPriceResponse); i {
+			switch v := v.(*QueryActiveValidatorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15331,7 +17782,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestVerificationRequest); i {
+			switch v := v.(*QueryRequestSearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15343,6 +17794,54 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestPriceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestVerificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryRequestVerificationResponse); i {
 			case 0:
 				return &v.state
@@ -15361,7 +17860,7 @@ func file_band_oracle_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,20 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Counts_FullMethodName              = "/band.oracle.v1.Query/Counts"
-	Query_Data_FullMethodName                = "/band.oracle.v1.Query/Data"
-	Query_DataSource_FullMethodName          = "/band.oracle.v1.Query/DataSource"
-	Query_OracleScript_FullMethodName        = "/band.oracle.v1.Query/OracleScript"
-	Query_Request_FullMethodName             = "/band.oracle.v1.Query/Request"
-	Query_PendingRequests_FullMethodName     = "/band.oracle.v1.Query/PendingRequests"
-	Query_Validator_FullMethodName           = "/band.oracle.v1.Query/Validator"
-	Query_IsReporter_FullMethodName          = "/band.oracle.v1.Query/IsReporter"
-	Query_Reporters_FullMethodName           = "/band.oracle.v1.Query/Reporters"
-	Query_ActiveValidators_FullMethodName    = "/band.oracle.v1.Query/ActiveValidators"
-	Query_Params_FullMethodName              = "/band.oracle.v1.Query/Params"
-	Query_RequestSearch_FullMethodName       = "/band.oracle.v1.Query/RequestSearch"
-	Query_RequestPrice_FullMethodName        = "/band.oracle.v1.Query/RequestPrice"
-	Query_RequestVerification_FullMethodName = "/band.oracle.v1.Query/RequestVerification"
+	Query_Counts_FullMethodName               = "/band.oracle.v1.Query/Counts"
+	Query_Data_FullMethodName                 = "/band.oracle.v1.Query/Data"
+	Query_DataSource_FullMethodName           = "/band.oracle.v1.Query/DataSource"
+	Query_DataSourceVersions_FullMethodName   = "/band.oracle.v1.Query/DataSourceVersions"
+	Query_OracleScript_FullMethodName         = "/band.oracle.v1.Query/OracleScript"
+	Query_OracleScriptVersions_FullMethodName = "/band.oracle.v1.Query/OracleScriptVersions"
+	Query_Request_FullMethodName              = "/band.oracle.v1.Query/Request"
+	Query_PendingRequests_FullMethodName      = "/band.oracle.v1.Query/PendingRequests"
+	Query_Validator_FullMethodName            = "/band.oracle.v1.Query/Validator"
+	Query_IsReporter_FullMethodName           = "/band.oracle.v1.Query/IsReporter"
+	Query_Reporters_FullMethodName            = "/band.oracle.v1.Query/Reporters"
+	Query_ActiveValidators_FullMethodName     = "/band.oracle.v1.Query/ActiveValidators"
+	Query_Params_FullMethodName               = "/band.oracle.v1.Query/Params"
+	Query_RequestSearch_FullMethodName        = "/band.oracle.v1.Query/RequestSearch"
+	Query_RequestPrice_FullMethodName         = "/band.oracle.v1.Query/RequestPrice"
+	Query_RequestVerification_FullMethodName  = "/band.oracle.v1.Query/RequestVerification"
 )
 
 // QueryClient is the client API for Query service.
@@ -47,8 +49,14 @@ type QueryClient interface {
 	Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error)
 	// DataSource queries data source summary info for given data source id.
 	DataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error)
+	// DataSourceVersions queries all versions of the data source for given data
+	// source id.
+	DataSourceVersions(ctx context.Context, in *QueryDataSourceVersionsRequest, opts ...grpc.CallOption) (*QueryDataSourceVersionsResponse, error)
 	// OracleScript queries oracle script summary info for given oracle script id.
 	OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error)
+	// OracleScriptVersions queries all versions of the oracle script for given
+	// oracle script id.
+	OracleScriptVersions(ctx context.Context, in *QueryOracleScriptVersionsRequest, opts ...grpc.CallOption) (*QueryOracleScriptVersionsResponse, error)
 	// Request queries request info for given request id.
 	Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error)
 	// PendingRequests queries list of pending request IDs assigned to given
@@ -109,6 +117,15 @@ func (c *queryClient) DataSource(ctx context.Context, in *QueryDataSourceRequest
 	return out, nil
 }
 
+func (c *queryClient) DataSourceVersions(ctx context.Context, in *QueryDataSourceVersionsRequest, opts ...grpc.CallOption) (*QueryDataSourceVersionsResponse, error) {
+	out := new(QueryDataSourceVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_DataSourceVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error) {
 	out := new(QueryOracleScriptResponse)
 	err := c.cc.Invoke(ctx, Query_OracleScript_FullMethodName, in, out, opts...)
@@ -118,6 +135,15 @@ func (c *queryClient) OracleScript(ctx context.Context, in *QueryOracleScriptReq
 	return out, nil
 }
 
+func (c *queryClient) OracleScriptVersions(ctx context.Context, in *QueryOracleScriptVersionsRequest, opts ...grpc.CallOption) (*QueryOracleScriptVersionsResponse, error) {
+	out := new(QueryOracleScriptVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_OracleScriptVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error) {
 	out := new(QueryRequestResponse)
 	err := c.cc.Invoke(ctx, Query_Request_FullMethodName, in, out, opts...)
@@ -220,8 +246,14 @@ type QueryServer interface {
 	Data(context.Context, *QueryDataRequest) (*QueryDataResponse, error)
 	// DataSource queries data source summary info for given data source id.
 	DataSource(context.Context, *QueryDataSourceRequest) (*QueryDataSourceResponse, error)
+	// DataSourceVersions queries all versions of the data source for given data
+	// source id.
+	DataSourceVersions(context.Context, *QueryDataSourceVersionsRequest) (*QueryDataSourceVersionsResponse, error)
 	// OracleScript queries oracle script summary info for given oracle script id.
 	OracleScript(context.Context, *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error)
+	// OracleScriptVersions queries all versions of the oracle script for given
+	// oracle script id.
+	OracleScriptVersions(context.Context, *QueryOracleScriptVersionsRequest) (*QueryOracleScriptVersionsResponse, error)
 	// Request queries request info for given request id.
 	Request(context.Context, *QueryRequestRequest) (*QueryRequestResponse, error)
 	// PendingRequests queries list of pending request IDs assigned to given
@@ -261,9 +293,15 @@ func (UnimplementedQueryServer) Data(context.Context, *QueryDataRequest) (*Query
 func (UnimplementedQueryServer) DataSource(context.Context, *QueryDataSourceRequest) (*QueryDataSourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DataSource not implemented")
 }
+func (UnimplementedQueryServer) DataSourceVersions(context.Context, *QueryDataSourceVersionsRequest) (*QueryDataSourceVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataSourceVersions not implemented")
+}
 func (UnimplementedQueryServer) OracleScript(context.Context, *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OracleScript not implemented")
 }
+func (UnimplementedQueryServer) OracleScriptVersions(context.Context, *QueryOracleScriptVersionsRequest) (*QueryOracleScriptVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleScriptVersions not implemented")
+}
 func (UnimplementedQueryServer) Request(context.Context, *QueryRequestRequest) (*QueryRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
 }
@@ -361,6 +399,24 @@ func _Query_DataSource_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DataSourceVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataSourceVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataSourceVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DataSourceVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataSourceVersions(ctx, req.(*QueryDataSourceVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_OracleScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryOracleScriptRequest)
 	if err := dec(in); err != nil {
@@ -379,6 +435,24 @@ func _Query_OracleScript_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OracleScriptVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleScriptVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleScriptVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OracleScriptVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleScriptVersions(ctx, req.(*QueryOracleScriptVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRequestRequest)
 	if err := dec(in); err != nil {
@@ -578,10 +652,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DataSource",
 			Handler:    _Query_DataSource_Handler,
 		},
+		{
+			MethodName: "DataSourceVersions",
+			Handler:    _Query_DataSourceVersions_Handler,
+		},
 		{
 			MethodName: "OracleScript",
 			Handler:    _Query_OracleScript_Handler,
 		},
+		{
+			MethodName: "OracleScriptVersions",
+			Handler:    _Query_OracleScriptVersions_Handler,
+		},
 		{
 			MethodName: "Request",
 			Handler:    _Query_Request_Handler,
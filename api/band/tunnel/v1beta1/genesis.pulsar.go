@@ -115,13 +115,65 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*IBCPacketRef
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IBCPacketRef)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IBCPacketRef)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(IBCPacketRef)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(IBCPacketRef)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState              protoreflect.MessageDescriptor
-	fd_GenesisState_params       protoreflect.FieldDescriptor
-	fd_GenesisState_tunnel_count protoreflect.FieldDescriptor
-	fd_GenesisState_tunnels      protoreflect.FieldDescriptor
-	fd_GenesisState_deposits     protoreflect.FieldDescriptor
-	fd_GenesisState_total_fees   protoreflect.FieldDescriptor
+	md_GenesisState                 protoreflect.MessageDescriptor
+	fd_GenesisState_params          protoreflect.FieldDescriptor
+	fd_GenesisState_tunnel_count    protoreflect.FieldDescriptor
+	fd_GenesisState_tunnels         protoreflect.FieldDescriptor
+	fd_GenesisState_deposits        protoreflect.FieldDescriptor
+	fd_GenesisState_total_fees      protoreflect.FieldDescriptor
+	fd_GenesisState_ibc_packet_refs protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -132,6 +184,7 @@ func init() {
 	fd_GenesisState_tunnels = md_GenesisState.Fields().ByName("tunnels")
 	fd_GenesisState_deposits = md_GenesisState.Fields().ByName("deposits")
 	fd_GenesisState_total_fees = md_GenesisState.Fields().ByName("total_fees")
+	fd_GenesisState_ibc_packet_refs = md_GenesisState.Fields().ByName("ibc_packet_refs")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -229,6 +282,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.IbcPacketRefs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.IbcPacketRefs})
+		if !f(fd_GenesisState_ibc_packet_refs, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -254,6 +313,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Deposits) != 0
 	case "band.tunnel.v1beta1.GenesisState.total_fees":
 		return x.TotalFees != nil
+	case "band.tunnel.v1beta1.GenesisState.ibc_packet_refs":
+		return len(x.IbcPacketRefs) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.GenesisState"))
@@ -280,6 +341,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Deposits = nil
 	case "band.tunnel.v1beta1.GenesisState.total_fees":
 		x.TotalFees = nil
+	case "band.tunnel.v1beta1.GenesisState.ibc_packet_refs":
+		x.IbcPacketRefs = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.GenesisState"))
@@ -317,6 +380,12 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "band.tunnel.v1beta1.GenesisState.total_fees":
 		value := x.TotalFees
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tunnel.v1beta1.GenesisState.ibc_packet_refs":
+		if len(x.IbcPacketRefs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.IbcPacketRefs}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.GenesisState"))
@@ -351,6 +420,10 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Deposits = *clv.list
 	case "band.tunnel.v1beta1.GenesisState.total_fees":
 		x.TotalFees = value.Message().Interface().(*TotalFees)
+	case "band.tunnel.v1beta1.GenesisState.ibc_packet_refs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.IbcPacketRefs = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.GenesisState"))
@@ -393,6 +466,12 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.TotalFees = new(TotalFees)
 		}
 		return protoreflect.ValueOfMessage(x.TotalFees.ProtoReflect())
+	case "band.tunnel.v1beta1.GenesisState.ibc_packet_refs":
+		if x.IbcPacketRefs == nil {
+			x.IbcPacketRefs = []*IBCPacketRef{}
+		}
+		value := &_GenesisState_6_list{list: &x.IbcPacketRefs}
+		return protoreflect.ValueOfList(value)
 	case "band.tunnel.v1beta1.GenesisState.tunnel_count":
 		panic(fmt.Errorf("field tunnel_count of message band.tunnel.v1beta1.GenesisState is not mutable"))
 	default:
@@ -422,6 +501,9 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "band.tunnel.v1beta1.GenesisState.total_fees":
 		m := new(TotalFees)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tunnel.v1beta1.GenesisState.ibc_packet_refs":
+		list := []*IBCPacketRef{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.GenesisState"))
@@ -514,6 +596,12 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.TotalFees)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.IbcPacketRefs) > 0 {
+			for _, e := range x.IbcPacketRefs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -543,6 +631,22 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.IbcPacketRefs) > 0 {
+			for iNdEx := len(x.IbcPacketRefs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IbcPacketRefs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if x.TotalFees != nil {
 			encoded, err := options.Marshal(x.TotalFees)
 			if err != nil {
@@ -816,6 +920,40 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcPacketRefs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IbcPacketRefs = append(x.IbcPacketRefs, &IBCPacketRef{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcPacketRefs[len(x.IbcPacketRefs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -851,105 +989,815 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/tunnel/v1beta1/genesis.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_IBCPacketRef              protoreflect.MessageDescriptor
+	fd_IBCPacketRef_port_id      protoreflect.FieldDescriptor
+	fd_IBCPacketRef_channel_id   protoreflect.FieldDescriptor
+	fd_IBCPacketRef_ibc_sequence protoreflect.FieldDescriptor
+	fd_IBCPacketRef_tunnel_id    protoreflect.FieldDescriptor
+	fd_IBCPacketRef_sequence     protoreflect.FieldDescriptor
 )
 
-// GenesisState represents the initial state of the blockchain.
-type GenesisState struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_band_tunnel_v1beta1_genesis_proto_init()
+	md_IBCPacketRef = File_band_tunnel_v1beta1_genesis_proto.Messages().ByName("IBCPacketRef")
+	fd_IBCPacketRef_port_id = md_IBCPacketRef.Fields().ByName("port_id")
+	fd_IBCPacketRef_channel_id = md_IBCPacketRef.Fields().ByName("channel_id")
+	fd_IBCPacketRef_ibc_sequence = md_IBCPacketRef.Fields().ByName("ibc_sequence")
+	fd_IBCPacketRef_tunnel_id = md_IBCPacketRef.Fields().ByName("tunnel_id")
+	fd_IBCPacketRef_sequence = md_IBCPacketRef.Fields().ByName("sequence")
+}
 
-	// params is all parameters of the module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	// tunnel_count is the number of tunnels.
-	TunnelCount uint64 `protobuf:"varint,2,opt,name=tunnel_count,json=tunnelCount,proto3" json:"tunnel_count,omitempty"`
-	// tunnels is the list of tunnels.
-	Tunnels []*Tunnel `protobuf:"bytes,3,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
-	// deposits is the list of deposits.
-	Deposits []*Deposit `protobuf:"bytes,4,rep,name=deposits,proto3" json:"deposits,omitempty"`
-	// total_fees is the type for the total fees collected by the tunnel
-	TotalFees *TotalFees `protobuf:"bytes,5,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+var _ protoreflect.Message = (*fastReflection_IBCPacketRef)(nil)
+
+type fastReflection_IBCPacketRef IBCPacketRef
+
+func (x *IBCPacketRef) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCPacketRef)(x)
 }
 
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_genesis_proto_msgTypes[0]
+func (x *IBCPacketRef) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *GenesisState) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_IBCPacketRef_messageType fastReflection_IBCPacketRef_messageType
+var _ protoreflect.MessageType = fastReflection_IBCPacketRef_messageType{}
+
+type fastReflection_IBCPacketRef_messageType struct{}
+
+func (x fastReflection_IBCPacketRef_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCPacketRef)(nil)
+}
+func (x fastReflection_IBCPacketRef_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCPacketRef)
+}
+func (x fastReflection_IBCPacketRef_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCPacketRef
 }
 
-func (*GenesisState) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IBCPacketRef) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCPacketRef
+}
 
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_genesis_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IBCPacketRef) Type() protoreflect.MessageType {
+	return _fastReflection_IBCPacketRef_messageType
 }
 
-func (x *GenesisState) GetParams() *Params {
-	if x != nil {
-		return x.Params
-	}
-	return nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IBCPacketRef) New() protoreflect.Message {
+	return new(fastReflection_IBCPacketRef)
 }
 
-func (x *GenesisState) GetTunnelCount() uint64 {
-	if x != nil {
-		return x.TunnelCount
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IBCPacketRef) Interface() protoreflect.ProtoMessage {
+	return (*IBCPacketRef)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IBCPacketRef) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_IBCPacketRef_port_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_IBCPacketRef_channel_id, value) {
+			return
+		}
+	}
+	if x.IbcSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IbcSequence)
+		if !f(fd_IBCPacketRef_ibc_sequence, value) {
+			return
+		}
+	}
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_IBCPacketRef_tunnel_id, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_IBCPacketRef_sequence, value) {
+			return
+		}
 	}
-	return 0
 }
 
-func (x *GenesisState) GetTunnels() []*Tunnel {
-	if x != nil {
-		return x.Tunnels
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IBCPacketRef) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketRef.port_id":
+		return x.PortId != ""
+	case "band.tunnel.v1beta1.IBCPacketRef.channel_id":
+		return x.ChannelId != ""
+	case "band.tunnel.v1beta1.IBCPacketRef.ibc_sequence":
+		return x.IbcSequence != uint64(0)
+	case "band.tunnel.v1beta1.IBCPacketRef.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.IBCPacketRef.sequence":
+		return x.Sequence != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketRef"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketRef does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-func (x *GenesisState) GetDeposits() []*Deposit {
-	if x != nil {
-		return x.Deposits
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCPacketRef) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketRef.port_id":
+		x.PortId = ""
+	case "band.tunnel.v1beta1.IBCPacketRef.channel_id":
+		x.ChannelId = ""
+	case "band.tunnel.v1beta1.IBCPacketRef.ibc_sequence":
+		x.IbcSequence = uint64(0)
+	case "band.tunnel.v1beta1.IBCPacketRef.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.IBCPacketRef.sequence":
+		x.Sequence = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketRef"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketRef does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-func (x *GenesisState) GetTotalFees() *TotalFees {
-	if x != nil {
-		return x.TotalFees
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IBCPacketRef) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketRef.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.IBCPacketRef.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.IBCPacketRef.ibc_sequence":
+		value := x.IbcSequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.IBCPacketRef.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.IBCPacketRef.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketRef"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketRef does not contain field %s", descriptor.FullName()))
 	}
-	return nil
 }
 
-var File_band_tunnel_v1beta1_genesis_proto protoreflect.FileDescriptor
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCPacketRef) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketRef.port_id":
+		x.PortId = value.Interface().(string)
+	case "band.tunnel.v1beta1.IBCPacketRef.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "band.tunnel.v1beta1.IBCPacketRef.ibc_sequence":
+		x.IbcSequence = value.Uint()
+	case "band.tunnel.v1beta1.IBCPacketRef.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.IBCPacketRef.sequence":
+		x.Sequence = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketRef"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketRef does not contain field %s", fd.FullName()))
+	}
+}
 
-var file_band_tunnel_v1beta1_genesis_proto_rawDesc = []byte{
-	0x0a, 0x21, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x13, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20,
-	0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65,
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCPacketRef) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketRef.port_id":
+		panic(fmt.Errorf("field port_id of message band.tunnel.v1beta1.IBCPacketRef is not mutable"))
+	case "band.tunnel.v1beta1.IBCPacketRef.channel_id":
+		panic(fmt.Errorf("field channel_id of message band.tunnel.v1beta1.IBCPacketRef is not mutable"))
+	case "band.tunnel.v1beta1.IBCPacketRef.ibc_sequence":
+		panic(fmt.Errorf("field ibc_sequence of message band.tunnel.v1beta1.IBCPacketRef is not mutable"))
+	case "band.tunnel.v1beta1.IBCPacketRef.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.IBCPacketRef is not mutable"))
+	case "band.tunnel.v1beta1.IBCPacketRef.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.IBCPacketRef is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketRef"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketRef does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IBCPacketRef) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCPacketRef.port_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.IBCPacketRef.channel_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.IBCPacketRef.ibc_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.IBCPacketRef.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.IBCPacketRef.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCPacketRef"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCPacketRef does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IBCPacketRef) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.IBCPacketRef", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IBCPacketRef) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCPacketRef) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IBCPacketRef) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IBCPacketRef) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IBCPacketRef)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IbcSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.IbcSequence))
+		}
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IBCPacketRef)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.IbcSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IbcSequence))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IBCPacketRef)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCPacketRef: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCPacketRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcSequence", wireType)
+				}
+				x.IbcSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IbcSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/tunnel/v1beta1/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenesisState represents the initial state of the blockchain.
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params is all parameters of the module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// tunnel_count is the number of tunnels.
+	TunnelCount uint64 `protobuf:"varint,2,opt,name=tunnel_count,json=tunnelCount,proto3" json:"tunnel_count,omitempty"`
+	// tunnels is the list of tunnels.
+	Tunnels []*Tunnel `protobuf:"bytes,3,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+	// deposits is the list of deposits.
+	Deposits []*Deposit `protobuf:"bytes,4,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	// total_fees is the type for the total fees collected by the tunnel
+	TotalFees *TotalFees `protobuf:"bytes,5,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	// ibc_packet_refs is the list of references from in-flight IBC packets to the tunnel packets they deliver.
+	IbcPacketRefs []*IBCPacketRef `protobuf:"bytes,6,rep,name=ibc_packet_refs,json=ibcPacketRefs,proto3" json:"ibc_packet_refs,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTunnelCount() uint64 {
+	if x != nil {
+		return x.TunnelCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetTunnels() []*Tunnel {
+	if x != nil {
+		return x.Tunnels
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDeposits() []*Deposit {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTotalFees() *TotalFees {
+	if x != nil {
+		return x.TotalFees
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIbcPacketRefs() []*IBCPacketRef {
+	if x != nil {
+		return x.IbcPacketRefs
+	}
+	return nil
+}
+
+// IBCPacketRef is the reference from an IBC packet to the tunnel packet that it delivers.
+type IBCPacketRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// port_id is the source port of the IBC packet.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the source channel of the IBC packet.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// ibc_sequence is the sequence of the IBC packet.
+	IbcSequence uint64 `protobuf:"varint,3,opt,name=ibc_sequence,json=ibcSequence,proto3" json:"ibc_sequence,omitempty"`
+	// tunnel_id is the tunnel ID of the tunnel packet.
+	TunnelId uint64 `protobuf:"varint,4,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the tunnel packet.
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *IBCPacketRef) Reset() {
+	*x = IBCPacketRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBCPacketRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBCPacketRef) ProtoMessage() {}
+
+// Deprecated: Use IBCPacketRef.ProtoReflect.Descriptor instead.
+func (*IBCPacketRef) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IBCPacketRef) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *IBCPacketRef) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *IBCPacketRef) GetIbcSequence() uint64 {
+	if x != nil {
+		return x.IbcSequence
+	}
+	return 0
+}
+
+func (x *IBCPacketRef) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *IBCPacketRef) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+var File_band_tunnel_v1beta1_genesis_proto protoreflect.FileDescriptor
+
+var file_band_tunnel_v1beta1_genesis_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x13, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20,
+	0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x20, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xae, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
+	0x74, 0x6f, 0x22, 0x90, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
 	0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
 	0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
@@ -968,21 +1816,41 @@ var file_band_tunnel_v1beta1_genesis_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
 	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65,
 	0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46,
-	0x65, 0x65, 0x73, 0x42, 0xdd, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
-	0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13,
-	0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64,
-	0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61,
-	0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x0f, 0x69, 0x62, 0x63, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x42,
+	0x15, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x0d, 0x49, 0x42, 0x43, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x0d, 0x69, 0x62, 0x63, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x0c, 0x49, 0x42, 0x43, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x23, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe2, 0xde, 0x1f, 0x06, 0x50, 0x6f, 0x72,
+	0x74, 0x49, 0x44, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0c, 0x69, 0x62, 0x63,
+	0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0f, 0xe2, 0xde, 0x1f, 0x0b, 0x49, 0x42, 0x43, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x0b, 0x69, 0x62, 0x63, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a,
+	0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x42, 0xdd, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e,
+	0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02,
+	0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e,
+	0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42,
+	0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -997,24 +1865,26 @@ func file_band_tunnel_v1beta1_genesis_proto_rawDescGZIP() []byte {
 	return file_band_tunnel_v1beta1_genesis_proto_rawDescData
 }
 
-var file_band_tunnel_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_band_tunnel_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_band_tunnel_v1beta1_genesis_proto_goTypes = []interface{}{
 	(*GenesisState)(nil), // 0: band.tunnel.v1beta1.GenesisState
-	(*Params)(nil),       // 1: band.tunnel.v1beta1.Params
-	(*Tunnel)(nil),       // 2: band.tunnel.v1beta1.Tunnel
-	(*Deposit)(nil),      // 3: band.tunnel.v1beta1.Deposit
-	(*TotalFees)(nil),    // 4: band.tunnel.v1beta1.TotalFees
+	(*IBCPacketRef)(nil), // 1: band.tunnel.v1beta1.IBCPacketRef
+	(*Params)(nil),       // 2: band.tunnel.v1beta1.Params
+	(*Tunnel)(nil),       // 3: band.tunnel.v1beta1.Tunnel
+	(*Deposit)(nil),      // 4: band.tunnel.v1beta1.Deposit
+	(*TotalFees)(nil),    // 5: band.tunnel.v1beta1.TotalFees
 }
 var file_band_tunnel_v1beta1_genesis_proto_depIdxs = []int32{
-	1, // 0: band.tunnel.v1beta1.GenesisState.params:type_name -> band.tunnel.v1beta1.Params
-	2, // 1: band.tunnel.v1beta1.GenesisState.tunnels:type_name -> band.tunnel.v1beta1.Tunnel
-	3, // 2: band.tunnel.v1beta1.GenesisState.deposits:type_name -> band.tunnel.v1beta1.Deposit
-	4, // 3: band.tunnel.v1beta1.GenesisState.total_fees:type_name -> band.tunnel.v1beta1.TotalFees
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2, // 0: band.tunnel.v1beta1.GenesisState.params:type_name -> band.tunnel.v1beta1.Params
+	3, // 1: band.tunnel.v1beta1.GenesisState.tunnels:type_name -> band.tunnel.v1beta1.Tunnel
+	4, // 2: band.tunnel.v1beta1.GenesisState.deposits:type_name -> band.tunnel.v1beta1.Deposit
+	5, // 3: band.tunnel.v1beta1.GenesisState.total_fees:type_name -> band.tunnel.v1beta1.TotalFees
+	1, // 4: band.tunnel.v1beta1.GenesisState.ibc_packet_refs:type_name -> band.tunnel.v1beta1.IBCPacketRef
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_band_tunnel_v1beta1_genesis_proto_init() }
@@ -1037,6 +1907,18 @@ func file_band_tunnel_v1beta1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_band_tunnel_v1beta1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCPacketRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1044,7 +1926,7 @@ func file_band_tunnel_v1beta1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_tunnel_v1beta1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -117,16 +117,20 @@ func (x *_Params_7_list) IsValid() bool {
 }
 
 var (
-	md_Params                             protoreflect.MessageDescriptor
-	fd_Params_min_deposit                 protoreflect.FieldDescriptor
-	fd_Params_min_interval                protoreflect.FieldDescriptor
-	fd_Params_max_interval                protoreflect.FieldDescriptor
-	fd_Params_min_deviation_bps           protoreflect.FieldDescriptor
-	fd_Params_max_deviation_bps           protoreflect.FieldDescriptor
-	fd_Params_max_signals                 protoreflect.FieldDescriptor
-	fd_Params_base_packet_fee             protoreflect.FieldDescriptor
-	fd_Params_router_ibc_channel          protoreflect.FieldDescriptor
-	fd_Params_router_integration_contract protoreflect.FieldDescriptor
+	md_Params                              protoreflect.MessageDescriptor
+	fd_Params_min_deposit                  protoreflect.FieldDescriptor
+	fd_Params_min_interval                 protoreflect.FieldDescriptor
+	fd_Params_max_interval                 protoreflect.FieldDescriptor
+	fd_Params_min_deviation_bps            protoreflect.FieldDescriptor
+	fd_Params_max_deviation_bps            protoreflect.FieldDescriptor
+	fd_Params_max_signals                  protoreflect.FieldDescriptor
+	fd_Params_base_packet_fee              protoreflect.FieldDescriptor
+	fd_Params_router_ibc_channel           protoreflect.FieldDescriptor
+	fd_Params_router_integration_contract  protoreflect.FieldDescriptor
+	fd_Params_timeout_refund_bps           protoreflect.FieldDescriptor
+	fd_Params_packet_retention_count       protoreflect.FieldDescriptor
+	fd_Params_packet_retention_duration    protoreflect.FieldDescriptor
+	fd_Params_max_pruned_packets_per_block protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -141,6 +145,10 @@ func init() {
 	fd_Params_base_packet_fee = md_Params.Fields().ByName("base_packet_fee")
 	fd_Params_router_ibc_channel = md_Params.Fields().ByName("router_ibc_channel")
 	fd_Params_router_integration_contract = md_Params.Fields().ByName("router_integration_contract")
+	fd_Params_timeout_refund_bps = md_Params.Fields().ByName("timeout_refund_bps")
+	fd_Params_packet_retention_count = md_Params.Fields().ByName("packet_retention_count")
+	fd_Params_packet_retention_duration = md_Params.Fields().ByName("packet_retention_duration")
+	fd_Params_max_pruned_packets_per_block = md_Params.Fields().ByName("max_pruned_packets_per_block")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -262,6 +270,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.TimeoutRefundBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutRefundBps)
+		if !f(fd_Params_timeout_refund_bps, value) {
+			return
+		}
+	}
+	if x.PacketRetentionCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketRetentionCount)
+		if !f(fd_Params_packet_retention_count, value) {
+			return
+		}
+	}
+	if x.PacketRetentionDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketRetentionDuration)
+		if !f(fd_Params_packet_retention_duration, value) {
+			return
+		}
+	}
+	if x.MaxPrunedPacketsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPrunedPacketsPerBlock)
+		if !f(fd_Params_max_pruned_packets_per_block, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -295,6 +327,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.RouterIbcChannel != ""
 	case "band.tunnel.v1beta1.Params.router_integration_contract":
 		return x.RouterIntegrationContract != ""
+	case "band.tunnel.v1beta1.Params.timeout_refund_bps":
+		return x.TimeoutRefundBps != uint64(0)
+	case "band.tunnel.v1beta1.Params.packet_retention_count":
+		return x.PacketRetentionCount != uint64(0)
+	case "band.tunnel.v1beta1.Params.packet_retention_duration":
+		return x.PacketRetentionDuration != uint64(0)
+	case "band.tunnel.v1beta1.Params.max_pruned_packets_per_block":
+		return x.MaxPrunedPacketsPerBlock != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -329,6 +369,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.RouterIbcChannel = ""
 	case "band.tunnel.v1beta1.Params.router_integration_contract":
 		x.RouterIntegrationContract = ""
+	case "band.tunnel.v1beta1.Params.timeout_refund_bps":
+		x.TimeoutRefundBps = uint64(0)
+	case "band.tunnel.v1beta1.Params.packet_retention_count":
+		x.PacketRetentionCount = uint64(0)
+	case "band.tunnel.v1beta1.Params.packet_retention_duration":
+		x.PacketRetentionDuration = uint64(0)
+	case "band.tunnel.v1beta1.Params.max_pruned_packets_per_block":
+		x.MaxPrunedPacketsPerBlock = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -378,6 +426,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.tunnel.v1beta1.Params.router_integration_contract":
 		value := x.RouterIntegrationContract
 		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.Params.timeout_refund_bps":
+		value := x.TimeoutRefundBps
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.Params.packet_retention_count":
+		value := x.PacketRetentionCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.Params.packet_retention_duration":
+		value := x.PacketRetentionDuration
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.Params.max_pruned_packets_per_block":
+		value := x.MaxPrunedPacketsPerBlock
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -420,6 +480,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.RouterIbcChannel = value.Interface().(string)
 	case "band.tunnel.v1beta1.Params.router_integration_contract":
 		x.RouterIntegrationContract = value.Interface().(string)
+	case "band.tunnel.v1beta1.Params.timeout_refund_bps":
+		x.TimeoutRefundBps = value.Uint()
+	case "band.tunnel.v1beta1.Params.packet_retention_count":
+		x.PacketRetentionCount = value.Uint()
+	case "band.tunnel.v1beta1.Params.packet_retention_duration":
+		x.PacketRetentionDuration = value.Uint()
+	case "band.tunnel.v1beta1.Params.max_pruned_packets_per_block":
+		x.MaxPrunedPacketsPerBlock = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -466,6 +534,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field router_ibc_channel of message band.tunnel.v1beta1.Params is not mutable"))
 	case "band.tunnel.v1beta1.Params.router_integration_contract":
 		panic(fmt.Errorf("field router_integration_contract of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.timeout_refund_bps":
+		panic(fmt.Errorf("field timeout_refund_bps of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.packet_retention_count":
+		panic(fmt.Errorf("field packet_retention_count of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.packet_retention_duration":
+		panic(fmt.Errorf("field packet_retention_duration of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.max_pruned_packets_per_block":
+		panic(fmt.Errorf("field max_pruned_packets_per_block of message band.tunnel.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -499,6 +575,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "band.tunnel.v1beta1.Params.router_integration_contract":
 		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.Params.timeout_refund_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.Params.packet_retention_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.Params.packet_retention_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.Params.max_pruned_packets_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -603,6 +687,18 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.TimeoutRefundBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutRefundBps))
+		}
+		if x.PacketRetentionCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.PacketRetentionCount))
+		}
+		if x.PacketRetentionDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.PacketRetentionDuration))
+		}
+		if x.MaxPrunedPacketsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPrunedPacketsPerBlock))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -632,6 +728,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxPrunedPacketsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPrunedPacketsPerBlock))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.PacketRetentionDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketRetentionDuration))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.PacketRetentionCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketRetentionCount))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.TimeoutRefundBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutRefundBps))
+			i--
+			dAtA[i] = 0x50
+		}
 		if len(x.RouterIntegrationContract) > 0 {
 			i -= len(x.RouterIntegrationContract)
 			copy(dAtA[i:], x.RouterIntegrationContract)
@@ -979,6 +1095,82 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.RouterIntegrationContract = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutRefundBps", wireType)
+				}
+				x.TimeoutRefundBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutRefundBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketRetentionCount", wireType)
+				}
+				x.PacketRetentionCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketRetentionCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketRetentionDuration", wireType)
+				}
+				x.PacketRetentionDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketRetentionDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPacketsPerBlock", wireType)
+				}
+				x.MaxPrunedPacketsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPrunedPacketsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1052,6 +1244,17 @@ type Params struct {
 	// router_integration_contract specifies the address of the Router integration contract on the Router chain
 	// that the tunnel module will interact with.
 	RouterIntegrationContract string `protobuf:"bytes,9,opt,name=router_integration_contract,json=routerIntegrationContract,proto3" json:"router_integration_contract,omitempty"`
+	// timeout_refund_bps is the portion of the base packet fee in basis points that is refunded
+	// to the fee payer when an IBC packet of the tunnel is timed out.
+	TimeoutRefundBps uint64 `protobuf:"varint,10,opt,name=timeout_refund_bps,json=timeoutRefundBps,proto3" json:"timeout_refund_bps,omitempty"`
+	// packet_retention_count is the number of the latest packets of each tunnel that are never pruned.
+	// Zero disables the count-based retention.
+	PacketRetentionCount uint64 `protobuf:"varint,11,opt,name=packet_retention_count,json=packetRetentionCount,proto3" json:"packet_retention_count,omitempty"`
+	// packet_retention_duration is the duration in seconds for which packets are never pruned.
+	// Zero disables the age-based retention.
+	PacketRetentionDuration uint64 `protobuf:"varint,12,opt,name=packet_retention_duration,json=packetRetentionDuration,proto3" json:"packet_retention_duration,omitempty"`
+	// max_pruned_packets_per_block is the maximum number of packets pruned in a block.
+	MaxPrunedPacketsPerBlock uint64 `protobuf:"varint,13,opt,name=max_pruned_packets_per_block,json=maxPrunedPacketsPerBlock,proto3" json:"max_pruned_packets_per_block,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1137,6 +1340,34 @@ func (x *Params) GetRouterIntegrationContract() string {
 	return ""
 }
 
+func (x *Params) GetTimeoutRefundBps() uint64 {
+	if x != nil {
+		return x.TimeoutRefundBps
+	}
+	return 0
+}
+
+func (x *Params) GetPacketRetentionCount() uint64 {
+	if x != nil {
+		return x.PacketRetentionCount
+	}
+	return 0
+}
+
+func (x *Params) GetPacketRetentionDuration() uint64 {
+	if x != nil {
+		return x.PacketRetentionDuration
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPrunedPacketsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxPrunedPacketsPerBlock
+	}
+	return 0
+}
+
 var File_band_tunnel_v1beta1_params_proto protoreflect.FileDescriptor
 
 var file_band_tunnel_v1beta1_params_proto_rawDesc = []byte{
@@ -1146,7 +1377,7 @@ var file_band_tunnel_v1beta1_params_proto_rawDesc = []byte{
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xde, 0x04,
+	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x06,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x6c, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f,
 	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
@@ -1184,22 +1415,37 @@ var file_band_tunnel_v1beta1_params_proto_rawDesc = []byte{
 	0x65, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
 	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x72,
 	0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xdc,
-	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13,
-	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x42, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x42, 0x50, 0x53, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x42, 0x70, 0x73, 0x12, 0x34, 0x0a, 0x16,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e,
+	0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xdc, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
+	0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13,
+	0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64,
+	0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61,
+	0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
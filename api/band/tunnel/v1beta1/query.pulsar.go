@@ -3,13 +3,16 @@ package tunnelv1beta1
 
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -19,6 +22,7 @@ var (
 	md_QueryTunnelsRequest               protoreflect.MessageDescriptor
 	fd_QueryTunnelsRequest_status_filter protoreflect.FieldDescriptor
 	fd_QueryTunnelsRequest_pagination    protoreflect.FieldDescriptor
+	fd_QueryTunnelsRequest_admin         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +30,7 @@ func init() {
 	md_QueryTunnelsRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryTunnelsRequest")
 	fd_QueryTunnelsRequest_status_filter = md_QueryTunnelsRequest.Fields().ByName("status_filter")
 	fd_QueryTunnelsRequest_pagination = md_QueryTunnelsRequest.Fields().ByName("pagination")
+	fd_QueryTunnelsRequest_admin = md_QueryTunnelsRequest.Fields().ByName("admin")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryTunnelsRequest)(nil)
@@ -105,6 +110,12 @@ func (x *fastReflection_QueryTunnelsRequest) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_QueryTunnelsRequest_admin, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -124,6 +135,8 @@ func (x *fastReflection_QueryTunnelsRequest) Has(fd protoreflect.FieldDescriptor
 		return x.StatusFilter != 0
 	case "band.tunnel.v1beta1.QueryTunnelsRequest.pagination":
 		return x.Pagination != nil
+	case "band.tunnel.v1beta1.QueryTunnelsRequest.admin":
+		return x.Admin != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTunnelsRequest"))
@@ -144,6 +157,8 @@ func (x *fastReflection_QueryTunnelsRequest) Clear(fd protoreflect.FieldDescript
 		x.StatusFilter = 0
 	case "band.tunnel.v1beta1.QueryTunnelsRequest.pagination":
 		x.Pagination = nil
+	case "band.tunnel.v1beta1.QueryTunnelsRequest.admin":
+		x.Admin = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTunnelsRequest"))
@@ -166,6 +181,9 @@ func (x *fastReflection_QueryTunnelsRequest) Get(descriptor protoreflect.FieldDe
 	case "band.tunnel.v1beta1.QueryTunnelsRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tunnel.v1beta1.QueryTunnelsRequest.admin":
+		value := x.Admin
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTunnelsRequest"))
@@ -190,6 +208,8 @@ func (x *fastReflection_QueryTunnelsRequest) Set(fd protoreflect.FieldDescriptor
 		x.StatusFilter = (TunnelStatusFilter)(value.Enum())
 	case "band.tunnel.v1beta1.QueryTunnelsRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "band.tunnel.v1beta1.QueryTunnelsRequest.admin":
+		x.Admin = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTunnelsRequest"))
@@ -217,6 +237,8 @@ func (x *fastReflection_QueryTunnelsRequest) Mutable(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	case "band.tunnel.v1beta1.QueryTunnelsRequest.status_filter":
 		panic(fmt.Errorf("field status_filter of message band.tunnel.v1beta1.QueryTunnelsRequest is not mutable"))
+	case "band.tunnel.v1beta1.QueryTunnelsRequest.admin":
+		panic(fmt.Errorf("field admin of message band.tunnel.v1beta1.QueryTunnelsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTunnelsRequest"))
@@ -235,6 +257,8 @@ func (x *fastReflection_QueryTunnelsRequest) NewField(fd protoreflect.FieldDescr
 	case "band.tunnel.v1beta1.QueryTunnelsRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tunnel.v1beta1.QueryTunnelsRequest.admin":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTunnelsRequest"))
@@ -311,6 +335,10 @@ func (x *fastReflection_QueryTunnelsRequest) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Admin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -340,6 +368,13 @@ func (x *fastReflection_QueryTunnelsRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
@@ -463,6 +498,38 @@ func (x *fastReflection_QueryTunnelsRequest) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Admin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4926,27 +4993,31 @@ func (x *fastReflection_QueryPacketsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryPacketRequest           protoreflect.MessageDescriptor
-	fd_QueryPacketRequest_tunnel_id protoreflect.FieldDescriptor
-	fd_QueryPacketRequest_sequence  protoreflect.FieldDescriptor
+	md_QueryPacketsByTimeRequest            protoreflect.MessageDescriptor
+	fd_QueryPacketsByTimeRequest_tunnel_id  protoreflect.FieldDescriptor
+	fd_QueryPacketsByTimeRequest_start_time protoreflect.FieldDescriptor
+	fd_QueryPacketsByTimeRequest_end_time   protoreflect.FieldDescriptor
+	fd_QueryPacketsByTimeRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_query_proto_init()
-	md_QueryPacketRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryPacketRequest")
-	fd_QueryPacketRequest_tunnel_id = md_QueryPacketRequest.Fields().ByName("tunnel_id")
-	fd_QueryPacketRequest_sequence = md_QueryPacketRequest.Fields().ByName("sequence")
+	md_QueryPacketsByTimeRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryPacketsByTimeRequest")
+	fd_QueryPacketsByTimeRequest_tunnel_id = md_QueryPacketsByTimeRequest.Fields().ByName("tunnel_id")
+	fd_QueryPacketsByTimeRequest_start_time = md_QueryPacketsByTimeRequest.Fields().ByName("start_time")
+	fd_QueryPacketsByTimeRequest_end_time = md_QueryPacketsByTimeRequest.Fields().ByName("end_time")
+	fd_QueryPacketsByTimeRequest_pagination = md_QueryPacketsByTimeRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPacketRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPacketsByTimeRequest)(nil)
 
-type fastReflection_QueryPacketRequest QueryPacketRequest
+type fastReflection_QueryPacketsByTimeRequest QueryPacketsByTimeRequest
 
-func (x *QueryPacketRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPacketRequest)(x)
+func (x *QueryPacketsByTimeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPacketsByTimeRequest)(x)
 }
 
-func (x *QueryPacketRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPacketsByTimeRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4958,43 +5029,43 @@ func (x *QueryPacketRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPacketRequest_messageType fastReflection_QueryPacketRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPacketRequest_messageType{}
+var _fastReflection_QueryPacketsByTimeRequest_messageType fastReflection_QueryPacketsByTimeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPacketsByTimeRequest_messageType{}
 
-type fastReflection_QueryPacketRequest_messageType struct{}
+type fastReflection_QueryPacketsByTimeRequest_messageType struct{}
 
-func (x fastReflection_QueryPacketRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPacketRequest)(nil)
+func (x fastReflection_QueryPacketsByTimeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPacketsByTimeRequest)(nil)
 }
-func (x fastReflection_QueryPacketRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPacketRequest)
+func (x fastReflection_QueryPacketsByTimeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketsByTimeRequest)
 }
-func (x fastReflection_QueryPacketRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPacketRequest
+func (x fastReflection_QueryPacketsByTimeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketsByTimeRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPacketRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPacketRequest
+func (x *fastReflection_QueryPacketsByTimeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketsByTimeRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPacketRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPacketRequest_messageType
+func (x *fastReflection_QueryPacketsByTimeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPacketsByTimeRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPacketRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryPacketRequest)
+func (x *fastReflection_QueryPacketsByTimeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketsByTimeRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPacketRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryPacketRequest)(x)
+func (x *fastReflection_QueryPacketsByTimeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPacketsByTimeRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5002,16 +5073,28 @@ func (x *fastReflection_QueryPacketRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPacketRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryPacketsByTimeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.TunnelId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.TunnelId)
-		if !f(fd_QueryPacketRequest_tunnel_id, value) {
+		if !f(fd_QueryPacketsByTimeRequest_tunnel_id, value) {
 			return
 		}
 	}
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_QueryPacketRequest_sequence, value) {
+	if x.StartTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartTime)
+		if !f(fd_QueryPacketsByTimeRequest_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EndTime)
+		if !f(fd_QueryPacketsByTimeRequest_end_time, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPacketsByTimeRequest_pagination, value) {
 			return
 		}
 	}
@@ -5028,17 +5111,21 @@ func (x *fastReflection_QueryPacketRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPacketRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPacketsByTimeRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.tunnel_id":
 		return x.TunnelId != uint64(0)
-	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
-		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.start_time":
+		return x.StartTime != int64(0)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.end_time":
+		return x.EndTime != int64(0)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5048,17 +5135,21 @@ func (x *fastReflection_QueryPacketRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPacketRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPacketsByTimeRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.tunnel_id":
 		x.TunnelId = uint64(0)
-	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
-		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.start_time":
+		x.StartTime = int64(0)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.end_time":
+		x.EndTime = int64(0)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5068,19 +5159,25 @@ func (x *fastReflection_QueryPacketRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPacketRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByTimeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.tunnel_id":
 		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
-		value := x.Sequence
-		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5094,17 +5191,21 @@ func (x *fastReflection_QueryPacketRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPacketRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPacketsByTimeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.tunnel_id":
 		x.TunnelId = value.Uint()
-	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
-		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.start_time":
+		x.StartTime = value.Int()
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.end_time":
+		x.EndTime = value.Int()
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5118,44 +5219,56 @@ func (x *fastReflection_QueryPacketRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPacketRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByTimeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
-		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.QueryPacketRequest is not mutable"))
-	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
-		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.QueryPacketRequest is not mutable"))
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.QueryPacketsByTimeRequest is not mutable"))
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.start_time":
+		panic(fmt.Errorf("field start_time of message band.tunnel.v1beta1.QueryPacketsByTimeRequest is not mutable"))
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.end_time":
+		panic(fmt.Errorf("field end_time of message band.tunnel.v1beta1.QueryPacketsByTimeRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPacketRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByTimeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.start_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.end_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.QueryPacketsByTimeRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPacketRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPacketsByTimeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryPacketRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryPacketsByTimeRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5163,7 +5276,7 @@ func (x *fastReflection_QueryPacketRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPacketRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPacketsByTimeRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5174,7 +5287,7 @@ func (x *fastReflection_QueryPacketRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPacketRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPacketsByTimeRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5186,7 +5299,7 @@ func (x *fastReflection_QueryPacketRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPacketRequest) IsValid() bool {
+func (x *fastReflection_QueryPacketsByTimeRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5196,9 +5309,9 @@ func (x *fastReflection_QueryPacketRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPacketRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPacketsByTimeRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPacketRequest)
+		x := input.Message.Interface().(*QueryPacketsByTimeRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5213,8 +5326,15 @@ func (x *fastReflection_QueryPacketRequest) ProtoMethods() *protoiface.Methods {
 		if x.TunnelId != 0 {
 			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
+		if x.StartTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartTime))
+		}
+		if x.EndTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndTime))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5226,7 +5346,7 @@ func (x *fastReflection_QueryPacketRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPacketRequest)
+		x := input.Message.Interface().(*QueryPacketsByTimeRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5245,8 +5365,27 @@ func (x *fastReflection_QueryPacketRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EndTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndTime))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.StartTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartTime))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -5266,7 +5405,7 @@ func (x *fastReflection_QueryPacketRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPacketRequest)
+		x := input.Message.Interface().(*QueryPacketsByTimeRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5298,10 +5437,10 @@ func (x *fastReflection_QueryPacketRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketsByTimeRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketsByTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5325,9 +5464,9 @@ func (x *fastReflection_QueryPacketRequest) ProtoMethods() *protoiface.Methods {
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 				}
-				x.Sequence = 0
+				x.StartTime = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5337,11 +5476,66 @@ func (x *fastReflection_QueryPacketRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
+					x.StartTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				x.EndTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5377,74 +5571,127 @@ func (x *fastReflection_QueryPacketRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_QueryPacketResponse        protoreflect.MessageDescriptor
-	fd_QueryPacketResponse_packet protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_QueryPacketsByTimeResponse_1_list)(nil)
 
-func init() {
-	file_band_tunnel_v1beta1_query_proto_init()
-	md_QueryPacketResponse = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryPacketResponse")
-	fd_QueryPacketResponse_packet = md_QueryPacketResponse.Fields().ByName("packet")
+type _QueryPacketsByTimeResponse_1_list struct {
+	list *[]*Packet
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPacketResponse)(nil)
-
-type fastReflection_QueryPacketResponse QueryPacketResponse
+func (x *_QueryPacketsByTimeResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-func (x *QueryPacketResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPacketResponse)(x)
+func (x *_QueryPacketsByTimeResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *QueryPacketResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_QueryPacketsByTimeResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Packet)
+	(*x.list)[i] = concreteValue
 }
 
-var _fastReflection_QueryPacketResponse_messageType fastReflection_QueryPacketResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPacketResponse_messageType{}
+func (x *_QueryPacketsByTimeResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Packet)
+	*x.list = append(*x.list, concreteValue)
+}
 
-type fastReflection_QueryPacketResponse_messageType struct{}
+func (x *_QueryPacketsByTimeResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Packet)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-func (x fastReflection_QueryPacketResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPacketResponse)(nil)
+func (x *_QueryPacketsByTimeResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
-func (x fastReflection_QueryPacketResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPacketResponse)
+
+func (x *_QueryPacketsByTimeResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Packet)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_QueryPacketResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPacketResponse
+
+func (x *_QueryPacketsByTimeResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPacketsByTimeResponse            protoreflect.MessageDescriptor
+	fd_QueryPacketsByTimeResponse_packets    protoreflect.FieldDescriptor
+	fd_QueryPacketsByTimeResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_query_proto_init()
+	md_QueryPacketsByTimeResponse = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryPacketsByTimeResponse")
+	fd_QueryPacketsByTimeResponse_packets = md_QueryPacketsByTimeResponse.Fields().ByName("packets")
+	fd_QueryPacketsByTimeResponse_pagination = md_QueryPacketsByTimeResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPacketsByTimeResponse)(nil)
+
+type fastReflection_QueryPacketsByTimeResponse QueryPacketsByTimeResponse
+
+func (x *QueryPacketsByTimeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPacketsByTimeResponse)(x)
+}
+
+func (x *QueryPacketsByTimeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPacketsByTimeResponse_messageType fastReflection_QueryPacketsByTimeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPacketsByTimeResponse_messageType{}
+
+type fastReflection_QueryPacketsByTimeResponse_messageType struct{}
+
+func (x fastReflection_QueryPacketsByTimeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPacketsByTimeResponse)(nil)
+}
+func (x fastReflection_QueryPacketsByTimeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketsByTimeResponse)
+}
+func (x fastReflection_QueryPacketsByTimeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketsByTimeResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPacketResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPacketResponse
+func (x *fastReflection_QueryPacketsByTimeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketsByTimeResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPacketResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPacketResponse_messageType
+func (x *fastReflection_QueryPacketsByTimeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPacketsByTimeResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPacketResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryPacketResponse)
+func (x *fastReflection_QueryPacketsByTimeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketsByTimeResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPacketResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryPacketResponse)(x)
+func (x *fastReflection_QueryPacketsByTimeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPacketsByTimeResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5452,10 +5699,16 @@ func (x *fastReflection_QueryPacketResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPacketResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Packet != nil {
-		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
-		if !f(fd_QueryPacketResponse_packet, value) {
+func (x *fastReflection_QueryPacketsByTimeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Packets) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPacketsByTimeResponse_1_list{list: &x.Packets})
+		if !f(fd_QueryPacketsByTimeResponse_packets, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPacketsByTimeResponse_pagination, value) {
 			return
 		}
 	}
@@ -5472,15 +5725,17 @@ func (x *fastReflection_QueryPacketResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPacketResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPacketsByTimeResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
-		return x.Packet != nil
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.packets":
+		return len(x.Packets) != 0
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5490,15 +5745,17 @@ func (x *fastReflection_QueryPacketResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPacketResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPacketsByTimeResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
-		x.Packet = nil
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.packets":
+		x.Packets = nil
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5508,16 +5765,22 @@ func (x *fastReflection_QueryPacketResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPacketResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByTimeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
-		value := x.Packet
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.packets":
+		if len(x.Packets) == 0 {
+			return protoreflect.ValueOfList(&_QueryPacketsByTimeResponse_1_list{})
+		}
+		listValue := &_QueryPacketsByTimeResponse_1_list{list: &x.Packets}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5531,15 +5794,19 @@ func (x *fastReflection_QueryPacketResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPacketResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPacketsByTimeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
-		x.Packet = value.Message().Interface().(*Packet)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.packets":
+		lv := value.List()
+		clv := lv.(*_QueryPacketsByTimeResponse_1_list)
+		x.Packets = *clv.list
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5553,44 +5820,53 @@ func (x *fastReflection_QueryPacketResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPacketResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByTimeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
-		if x.Packet == nil {
-			x.Packet = new(Packet)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.packets":
+		if x.Packets == nil {
+			x.Packets = []*Packet{}
 		}
-		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		value := &_QueryPacketsByTimeResponse_1_list{list: &x.Packets}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPacketResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByTimeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
-		m := new(Packet)
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.packets":
+		list := []*Packet{}
+		return protoreflect.ValueOfList(&_QueryPacketsByTimeResponse_1_list{list: &list})
+	case "band.tunnel.v1beta1.QueryPacketsByTimeResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByTimeResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByTimeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPacketResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPacketsByTimeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryPacketResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryPacketsByTimeResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5598,7 +5874,7 @@ func (x *fastReflection_QueryPacketResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPacketResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPacketsByTimeResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5609,7 +5885,7 @@ func (x *fastReflection_QueryPacketResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPacketResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPacketsByTimeResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5621,7 +5897,7 @@ func (x *fastReflection_QueryPacketResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPacketResponse) IsValid() bool {
+func (x *fastReflection_QueryPacketsByTimeResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5631,9 +5907,9 @@ func (x *fastReflection_QueryPacketResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPacketResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPacketsByTimeResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPacketResponse)
+		x := input.Message.Interface().(*QueryPacketsByTimeResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5645,8 +5921,14 @@ func (x *fastReflection_QueryPacketResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Packet != nil {
-			l = options.Size(x.Packet)
+		if len(x.Packets) > 0 {
+			for _, e := range x.Packets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5659,7 +5941,7 @@ func (x *fastReflection_QueryPacketResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPacketResponse)
+		x := input.Message.Interface().(*QueryPacketsByTimeResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5678,8 +5960,8 @@ func (x *fastReflection_QueryPacketResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Packet != nil {
-			encoded, err := options.Marshal(x.Packet)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5690,7 +5972,23 @@ func (x *fastReflection_QueryPacketResponse) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Packets) > 0 {
+			for iNdEx := len(x.Packets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Packets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5703,7 +6001,7 @@ func (x *fastReflection_QueryPacketResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPacketResponse)
+		x := input.Message.Interface().(*QueryPacketsByTimeResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5735,15 +6033,15 @@ func (x *fastReflection_QueryPacketResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketsByTimeResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketsByTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5770,10 +6068,44 @@ func (x *fastReflection_QueryPacketResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Packet == nil {
-					x.Packet = &Packet{}
+				x.Packets = append(x.Packets, &Packet{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packets[len(x.Packets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5813,23 +6145,29 @@ func (x *fastReflection_QueryPacketResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryTotalFeesRequest protoreflect.MessageDescriptor
+	md_QueryPacketsByStatusRequest              protoreflect.MessageDescriptor
+	fd_QueryPacketsByStatusRequest_status       protoreflect.FieldDescriptor
+	fd_QueryPacketsByStatusRequest_receipt_type protoreflect.FieldDescriptor
+	fd_QueryPacketsByStatusRequest_pagination   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_query_proto_init()
-	md_QueryTotalFeesRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryTotalFeesRequest")
+	md_QueryPacketsByStatusRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryPacketsByStatusRequest")
+	fd_QueryPacketsByStatusRequest_status = md_QueryPacketsByStatusRequest.Fields().ByName("status")
+	fd_QueryPacketsByStatusRequest_receipt_type = md_QueryPacketsByStatusRequest.Fields().ByName("receipt_type")
+	fd_QueryPacketsByStatusRequest_pagination = md_QueryPacketsByStatusRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTotalFeesRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPacketsByStatusRequest)(nil)
 
-type fastReflection_QueryTotalFeesRequest QueryTotalFeesRequest
+type fastReflection_QueryPacketsByStatusRequest QueryPacketsByStatusRequest
 
-func (x *QueryTotalFeesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTotalFeesRequest)(x)
+func (x *QueryPacketsByStatusRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPacketsByStatusRequest)(x)
 }
 
-func (x *QueryTotalFeesRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPacketsByStatusRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5841,43 +6179,43 @@ func (x *QueryTotalFeesRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTotalFeesRequest_messageType fastReflection_QueryTotalFeesRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTotalFeesRequest_messageType{}
+var _fastReflection_QueryPacketsByStatusRequest_messageType fastReflection_QueryPacketsByStatusRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPacketsByStatusRequest_messageType{}
 
-type fastReflection_QueryTotalFeesRequest_messageType struct{}
+type fastReflection_QueryPacketsByStatusRequest_messageType struct{}
 
-func (x fastReflection_QueryTotalFeesRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTotalFeesRequest)(nil)
+func (x fastReflection_QueryPacketsByStatusRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPacketsByStatusRequest)(nil)
 }
-func (x fastReflection_QueryTotalFeesRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalFeesRequest)
+func (x fastReflection_QueryPacketsByStatusRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketsByStatusRequest)
 }
-func (x fastReflection_QueryTotalFeesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalFeesRequest
+func (x fastReflection_QueryPacketsByStatusRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketsByStatusRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTotalFeesRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalFeesRequest
+func (x *fastReflection_QueryPacketsByStatusRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketsByStatusRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTotalFeesRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTotalFeesRequest_messageType
+func (x *fastReflection_QueryPacketsByStatusRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPacketsByStatusRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTotalFeesRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalFeesRequest)
+func (x *fastReflection_QueryPacketsByStatusRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketsByStatusRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTotalFeesRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTotalFeesRequest)(x)
+func (x *fastReflection_QueryPacketsByStatusRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPacketsByStatusRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5885,7 +6223,25 @@ func (x *fastReflection_QueryTotalFeesRequest) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTotalFeesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryPacketsByStatusRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_QueryPacketsByStatusRequest_status, value) {
+			return
+		}
+	}
+	if x.ReceiptType != "" {
+		value := protoreflect.ValueOfString(x.ReceiptType)
+		if !f(fd_QueryPacketsByStatusRequest_receipt_type, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPacketsByStatusRequest_pagination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5899,13 +6255,19 @@ func (x *fastReflection_QueryTotalFeesRequest) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTotalFeesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPacketsByStatusRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.status":
+		return x.Status != 0
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.receipt_type":
+		return x.ReceiptType != ""
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5915,13 +6277,19 @@ func (x *fastReflection_QueryTotalFeesRequest) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalFeesRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPacketsByStatusRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.status":
+		x.Status = 0
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.receipt_type":
+		x.ReceiptType = ""
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5931,13 +6299,22 @@ func (x *fastReflection_QueryTotalFeesRequest) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTotalFeesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByStatusRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.receipt_type":
+		value := x.ReceiptType
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5951,13 +6328,19 @@ func (x *fastReflection_QueryTotalFeesRequest) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalFeesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPacketsByStatusRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.status":
+		x.Status = (PacketStatus)(value.Enum())
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.receipt_type":
+		x.ReceiptType = value.Interface().(string)
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5971,36 +6354,52 @@ func (x *fastReflection_QueryTotalFeesRequest) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalFeesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByStatusRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.status":
+		panic(fmt.Errorf("field status of message band.tunnel.v1beta1.QueryPacketsByStatusRequest is not mutable"))
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.receipt_type":
+		panic(fmt.Errorf("field receipt_type of message band.tunnel.v1beta1.QueryPacketsByStatusRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTotalFeesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByStatusRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.receipt_type":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.QueryPacketsByStatusRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTotalFeesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPacketsByStatusRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryTotalFeesRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryPacketsByStatusRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6008,7 +6407,7 @@ func (x *fastReflection_QueryTotalFeesRequest) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTotalFeesRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPacketsByStatusRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6019,7 +6418,7 @@ func (x *fastReflection_QueryTotalFeesRequest) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalFeesRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPacketsByStatusRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6031,7 +6430,7 @@ func (x *fastReflection_QueryTotalFeesRequest) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTotalFeesRequest) IsValid() bool {
+func (x *fastReflection_QueryPacketsByStatusRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6041,9 +6440,9 @@ func (x *fastReflection_QueryTotalFeesRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTotalFeesRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPacketsByStatusRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTotalFeesRequest)
+		x := input.Message.Interface().(*QueryPacketsByStatusRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6055,6 +6454,17 @@ func (x *fastReflection_QueryTotalFeesRequest) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.ReceiptType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6065,7 +6475,7 @@ func (x *fastReflection_QueryTotalFeesRequest) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalFeesRequest)
+		x := input.Message.Interface().(*QueryPacketsByStatusRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6084,6 +6494,32 @@ func (x *fastReflection_QueryTotalFeesRequest) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ReceiptType) > 0 {
+			i -= len(x.ReceiptType)
+			copy(dAtA[i:], x.ReceiptType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReceiptType)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6095,7 +6531,7 @@ func (x *fastReflection_QueryTotalFeesRequest) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalFeesRequest)
+		x := input.Message.Interface().(*QueryPacketsByStatusRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6127,12 +6563,99 @@ func (x *fastReflection_QueryTotalFeesRequest) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalFeesRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketsByStatusRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= PacketStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceiptType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6168,26 +6691,79 @@ func (x *fastReflection_QueryTotalFeesRequest) ProtoMethods() *protoiface.Method
 	}
 }
 
+var _ protoreflect.List = (*_QueryPacketsByStatusResponse_1_list)(nil)
+
+type _QueryPacketsByStatusResponse_1_list struct {
+	list *[]*Packet
+}
+
+func (x *_QueryPacketsByStatusResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPacketsByStatusResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPacketsByStatusResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Packet)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPacketsByStatusResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Packet)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPacketsByStatusResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Packet)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPacketsByStatusResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPacketsByStatusResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Packet)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPacketsByStatusResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryTotalFeesResponse            protoreflect.MessageDescriptor
-	fd_QueryTotalFeesResponse_total_fees protoreflect.FieldDescriptor
+	md_QueryPacketsByStatusResponse            protoreflect.MessageDescriptor
+	fd_QueryPacketsByStatusResponse_packets    protoreflect.FieldDescriptor
+	fd_QueryPacketsByStatusResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_query_proto_init()
-	md_QueryTotalFeesResponse = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryTotalFeesResponse")
-	fd_QueryTotalFeesResponse_total_fees = md_QueryTotalFeesResponse.Fields().ByName("total_fees")
+	md_QueryPacketsByStatusResponse = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryPacketsByStatusResponse")
+	fd_QueryPacketsByStatusResponse_packets = md_QueryPacketsByStatusResponse.Fields().ByName("packets")
+	fd_QueryPacketsByStatusResponse_pagination = md_QueryPacketsByStatusResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTotalFeesResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPacketsByStatusResponse)(nil)
 
-type fastReflection_QueryTotalFeesResponse QueryTotalFeesResponse
+type fastReflection_QueryPacketsByStatusResponse QueryPacketsByStatusResponse
 
-func (x *QueryTotalFeesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTotalFeesResponse)(x)
+func (x *QueryPacketsByStatusResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPacketsByStatusResponse)(x)
 }
 
-func (x *QueryTotalFeesResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPacketsByStatusResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6199,43 +6775,43 @@ func (x *QueryTotalFeesResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTotalFeesResponse_messageType fastReflection_QueryTotalFeesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTotalFeesResponse_messageType{}
+var _fastReflection_QueryPacketsByStatusResponse_messageType fastReflection_QueryPacketsByStatusResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPacketsByStatusResponse_messageType{}
 
-type fastReflection_QueryTotalFeesResponse_messageType struct{}
+type fastReflection_QueryPacketsByStatusResponse_messageType struct{}
 
-func (x fastReflection_QueryTotalFeesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTotalFeesResponse)(nil)
+func (x fastReflection_QueryPacketsByStatusResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPacketsByStatusResponse)(nil)
 }
-func (x fastReflection_QueryTotalFeesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalFeesResponse)
+func (x fastReflection_QueryPacketsByStatusResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketsByStatusResponse)
 }
-func (x fastReflection_QueryTotalFeesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalFeesResponse
+func (x fastReflection_QueryPacketsByStatusResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketsByStatusResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTotalFeesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalFeesResponse
+func (x *fastReflection_QueryPacketsByStatusResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketsByStatusResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTotalFeesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTotalFeesResponse_messageType
+func (x *fastReflection_QueryPacketsByStatusResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPacketsByStatusResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTotalFeesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalFeesResponse)
+func (x *fastReflection_QueryPacketsByStatusResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketsByStatusResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTotalFeesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTotalFeesResponse)(x)
+func (x *fastReflection_QueryPacketsByStatusResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPacketsByStatusResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6243,10 +6819,16 @@ func (x *fastReflection_QueryTotalFeesResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTotalFeesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TotalFees != nil {
-		value := protoreflect.ValueOfMessage(x.TotalFees.ProtoReflect())
-		if !f(fd_QueryTotalFeesResponse_total_fees, value) {
+func (x *fastReflection_QueryPacketsByStatusResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Packets) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPacketsByStatusResponse_1_list{list: &x.Packets})
+		if !f(fd_QueryPacketsByStatusResponse_packets, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPacketsByStatusResponse_pagination, value) {
 			return
 		}
 	}
@@ -6263,15 +6845,17 @@ func (x *fastReflection_QueryTotalFeesResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTotalFeesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPacketsByStatusResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
-		return x.TotalFees != nil
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.packets":
+		return len(x.Packets) != 0
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6281,15 +6865,17 @@ func (x *fastReflection_QueryTotalFeesResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalFeesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPacketsByStatusResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
-		x.TotalFees = nil
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.packets":
+		x.Packets = nil
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6299,16 +6885,22 @@ func (x *fastReflection_QueryTotalFeesResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTotalFeesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByStatusResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
-		value := x.TotalFees
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.packets":
+		if len(x.Packets) == 0 {
+			return protoreflect.ValueOfList(&_QueryPacketsByStatusResponse_1_list{})
+		}
+		listValue := &_QueryPacketsByStatusResponse_1_list{list: &x.Packets}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6322,15 +6914,19 @@ func (x *fastReflection_QueryTotalFeesResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalFeesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPacketsByStatusResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
-		x.TotalFees = value.Message().Interface().(*TotalFees)
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.packets":
+		lv := value.List()
+		clv := lv.(*_QueryPacketsByStatusResponse_1_list)
+		x.Packets = *clv.list
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6344,44 +6940,53 @@ func (x *fastReflection_QueryTotalFeesResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalFeesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByStatusResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
-		if x.TotalFees == nil {
-			x.TotalFees = new(TotalFees)
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.packets":
+		if x.Packets == nil {
+			x.Packets = []*Packet{}
 		}
-		return protoreflect.ValueOfMessage(x.TotalFees.ProtoReflect())
+		value := &_QueryPacketsByStatusResponse_1_list{list: &x.Packets}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTotalFeesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketsByStatusResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
-		m := new(TotalFees)
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.packets":
+		list := []*Packet{}
+		return protoreflect.ValueOfList(&_QueryPacketsByStatusResponse_1_list{list: &list})
+	case "band.tunnel.v1beta1.QueryPacketsByStatusResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketsByStatusResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketsByStatusResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTotalFeesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPacketsByStatusResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryTotalFeesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryPacketsByStatusResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6389,7 +6994,7 @@ func (x *fastReflection_QueryTotalFeesResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTotalFeesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPacketsByStatusResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6400,7 +7005,7 @@ func (x *fastReflection_QueryTotalFeesResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalFeesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPacketsByStatusResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6412,7 +7017,7 @@ func (x *fastReflection_QueryTotalFeesResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTotalFeesResponse) IsValid() bool {
+func (x *fastReflection_QueryPacketsByStatusResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6422,9 +7027,9 @@ func (x *fastReflection_QueryTotalFeesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTotalFeesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPacketsByStatusResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTotalFeesResponse)
+		x := input.Message.Interface().(*QueryPacketsByStatusResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6436,8 +7041,14 @@ func (x *fastReflection_QueryTotalFeesResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.TotalFees != nil {
-			l = options.Size(x.TotalFees)
+		if len(x.Packets) > 0 {
+			for _, e := range x.Packets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6450,7 +7061,7 @@ func (x *fastReflection_QueryTotalFeesResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalFeesResponse)
+		x := input.Message.Interface().(*QueryPacketsByStatusResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6469,8 +7080,8 @@ func (x *fastReflection_QueryTotalFeesResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TotalFees != nil {
-			encoded, err := options.Marshal(x.TotalFees)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6481,7 +7092,23 @@ func (x *fastReflection_QueryTotalFeesResponse) ProtoMethods() *protoiface.Metho
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Packets) > 0 {
+			for iNdEx := len(x.Packets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Packets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6494,7 +7121,7 @@ func (x *fastReflection_QueryTotalFeesResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalFeesResponse)
+		x := input.Message.Interface().(*QueryPacketsByStatusResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6526,15 +7153,15 @@ func (x *fastReflection_QueryTotalFeesResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalFeesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketsByStatusResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6561,10 +7188,44 @@ func (x *fastReflection_QueryTotalFeesResponse) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TotalFees == nil {
-					x.TotalFees = &TotalFees{}
+				x.Packets = append(x.Packets, &Packet{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packets[len(x.Packets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalFees); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6604,23 +7265,27 @@ func (x *fastReflection_QueryTotalFeesResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryParamsRequest protoreflect.MessageDescriptor
+	md_QueryPacketRequest           protoreflect.MessageDescriptor
+	fd_QueryPacketRequest_tunnel_id protoreflect.FieldDescriptor
+	fd_QueryPacketRequest_sequence  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_query_proto_init()
-	md_QueryParamsRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryParamsRequest")
+	md_QueryPacketRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryPacketRequest")
+	fd_QueryPacketRequest_tunnel_id = md_QueryPacketRequest.Fields().ByName("tunnel_id")
+	fd_QueryPacketRequest_sequence = md_QueryPacketRequest.Fields().ByName("sequence")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPacketRequest)(nil)
 
-type fastReflection_QueryParamsRequest QueryParamsRequest
+type fastReflection_QueryPacketRequest QueryPacketRequest
 
-func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(x)
+func (x *QueryPacketRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPacketRequest)(x)
 }
 
-func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPacketRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6632,43 +7297,43 @@ func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+var _fastReflection_QueryPacketRequest_messageType fastReflection_QueryPacketRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPacketRequest_messageType{}
 
-type fastReflection_QueryParamsRequest_messageType struct{}
+type fastReflection_QueryPacketRequest_messageType struct{}
 
-func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(nil)
+func (x fastReflection_QueryPacketRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPacketRequest)(nil)
 }
-func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x fastReflection_QueryPacketRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketRequest)
 }
-func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x fastReflection_QueryPacketRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x *fastReflection_QueryPacketRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsRequest_messageType
+func (x *fastReflection_QueryPacketRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPacketRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x *fastReflection_QueryPacketRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsRequest)(x)
+func (x *fastReflection_QueryPacketRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPacketRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6676,7 +7341,19 @@ func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryPacketRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_QueryPacketRequest_tunnel_id, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_QueryPacketRequest_sequence, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -6690,13 +7367,17 @@ func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPacketRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
+		return x.Sequence != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6706,13 +7387,17 @@ func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPacketRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
+		x.Sequence = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6722,13 +7407,19 @@ func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6742,13 +7433,17 @@ func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPacketRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
+		x.Sequence = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6762,36 +7457,44 @@ func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.QueryPacketRequest is not mutable"))
+	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.QueryPacketRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryPacketRequest.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.QueryPacketRequest.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketRequest"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPacketRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryParamsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryPacketRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6799,7 +7502,7 @@ func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPacketRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6810,7 +7513,7 @@ func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPacketRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6822,7 +7525,7 @@ func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+func (x *fastReflection_QueryPacketRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6832,9 +7535,9 @@ func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPacketRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryPacketRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6846,6 +7549,12 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6856,7 +7565,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryPacketRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6875,6 +7584,16 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6886,7 +7605,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryPacketRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6918,12 +7637,50 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6960,25 +7717,25 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryParamsResponse        protoreflect.MessageDescriptor
-	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+	md_QueryPacketResponse        protoreflect.MessageDescriptor
+	fd_QueryPacketResponse_packet protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_query_proto_init()
-	md_QueryParamsResponse = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryParamsResponse")
-	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+	md_QueryPacketResponse = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryPacketResponse")
+	fd_QueryPacketResponse_packet = md_QueryPacketResponse.Fields().ByName("packet")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPacketResponse)(nil)
 
-type fastReflection_QueryParamsResponse QueryParamsResponse
+type fastReflection_QueryPacketResponse QueryPacketResponse
 
-func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(x)
+func (x *QueryPacketResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPacketResponse)(x)
 }
 
-func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPacketResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6990,43 +7747,43 @@ func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+var _fastReflection_QueryPacketResponse_messageType fastReflection_QueryPacketResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPacketResponse_messageType{}
 
-type fastReflection_QueryParamsResponse_messageType struct{}
+type fastReflection_QueryPacketResponse_messageType struct{}
 
-func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(nil)
+func (x fastReflection_QueryPacketResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPacketResponse)(nil)
 }
-func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x fastReflection_QueryPacketResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketResponse)
 }
-func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x fastReflection_QueryPacketResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x *fastReflection_QueryPacketResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPacketResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsResponse_messageType
+func (x *fastReflection_QueryPacketResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPacketResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x *fastReflection_QueryPacketResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPacketResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsResponse)(x)
+func (x *fastReflection_QueryPacketResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPacketResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7034,10 +7791,10 @@ func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_QueryParamsResponse_params, value) {
+func (x *fastReflection_QueryPacketResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_QueryPacketResponse_packet, value) {
 			return
 		}
 	}
@@ -7054,15 +7811,15 @@ func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPacketResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryParamsResponse.params":
-		return x.Params != nil
+	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
+		return x.Packet != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7072,15 +7829,15 @@ func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPacketResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryParamsResponse.params":
-		x.Params = nil
+	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
+		x.Packet = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7090,16 +7847,16 @@ func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.QueryParamsResponse.params":
-		value := x.Params
+	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
+		value := x.Packet
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7113,15 +7870,15 @@ func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPacketResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryParamsResponse.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
+		x.Packet = value.Message().Interface().(*Packet)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7135,44 +7892,44 @@ func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryParamsResponse.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
+		if x.Packet == nil {
+			x.Packet = new(Packet)
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPacketResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.QueryParamsResponse.params":
-		m := new(Params)
+	case "band.tunnel.v1beta1.QueryPacketResponse.packet":
+		m := new(Packet)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPacketResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryPacketResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7180,7 +7937,7 @@ func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPacketResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7191,7 +7948,7 @@ func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPacketResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7203,7 +7960,7 @@ func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+func (x *fastReflection_QueryPacketResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7213,9 +7970,9 @@ func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPacketResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryPacketResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7227,8 +7984,8 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7241,7 +7998,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryPacketResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7260,8 +8017,8 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7285,7 +8042,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryPacketResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7317,15 +8074,15 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7352,10 +8109,10 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				if x.Packet == nil {
+					x.Packet = &Packet{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7394,584 +8151,6874 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/tunnel/v1beta1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// TunnelStatusFilter defines a filter for tunnel status.
-type TunnelStatusFilter int32
-
-const (
-	// TUNNEL_STATUS_FILTER_UNSPECIFIED defines an unspecified status.
-	TunnelStatusFilter_TUNNEL_STATUS_FILTER_UNSPECIFIED TunnelStatusFilter = 0
-	// TUNNEL_STATUS_FILTER_ACTIVE defines an active tunnel.
-	TunnelStatusFilter_TUNNEL_STATUS_FILTER_ACTIVE TunnelStatusFilter = 1
-	// TUNNEL_STATUS_FILTER_INACTIVE defines an inactive tunnel.
-	TunnelStatusFilter_TUNNEL_STATUS_FILTER_INACTIVE TunnelStatusFilter = 2
-)
-
-// Enum value maps for TunnelStatusFilter.
 var (
-	TunnelStatusFilter_name = map[int32]string{
-		0: "TUNNEL_STATUS_FILTER_UNSPECIFIED",
-		1: "TUNNEL_STATUS_FILTER_ACTIVE",
-		2: "TUNNEL_STATUS_FILTER_INACTIVE",
-	}
-	TunnelStatusFilter_value = map[string]int32{
-		"TUNNEL_STATUS_FILTER_UNSPECIFIED": 0,
-		"TUNNEL_STATUS_FILTER_ACTIVE":      1,
-		"TUNNEL_STATUS_FILTER_INACTIVE":    2,
-	}
+	md_QueryFailedPacketsRequest            protoreflect.MessageDescriptor
+	fd_QueryFailedPacketsRequest_tunnel_id  protoreflect.FieldDescriptor
+	fd_QueryFailedPacketsRequest_pagination protoreflect.FieldDescriptor
 )
 
-func (x TunnelStatusFilter) Enum() *TunnelStatusFilter {
-	p := new(TunnelStatusFilter)
-	*p = x
-	return p
+func init() {
+	file_band_tunnel_v1beta1_query_proto_init()
+	md_QueryFailedPacketsRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryFailedPacketsRequest")
+	fd_QueryFailedPacketsRequest_tunnel_id = md_QueryFailedPacketsRequest.Fields().ByName("tunnel_id")
+	fd_QueryFailedPacketsRequest_pagination = md_QueryFailedPacketsRequest.Fields().ByName("pagination")
 }
 
-func (x TunnelStatusFilter) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
+var _ protoreflect.Message = (*fastReflection_QueryFailedPacketsRequest)(nil)
 
-func (TunnelStatusFilter) Descriptor() protoreflect.EnumDescriptor {
-	return file_band_tunnel_v1beta1_query_proto_enumTypes[0].Descriptor()
-}
+type fastReflection_QueryFailedPacketsRequest QueryFailedPacketsRequest
 
-func (TunnelStatusFilter) Type() protoreflect.EnumType {
-	return &file_band_tunnel_v1beta1_query_proto_enumTypes[0]
+func (x *QueryFailedPacketsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFailedPacketsRequest)(x)
 }
 
-func (x TunnelStatusFilter) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
+func (x *QueryFailedPacketsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryFailedPacketsRequest_messageType fastReflection_QueryFailedPacketsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFailedPacketsRequest_messageType{}
+
+type fastReflection_QueryFailedPacketsRequest_messageType struct{}
+
+func (x fastReflection_QueryFailedPacketsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFailedPacketsRequest)(nil)
+}
+func (x fastReflection_QueryFailedPacketsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFailedPacketsRequest)
+}
+func (x fastReflection_QueryFailedPacketsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFailedPacketsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryFailedPacketsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFailedPacketsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryFailedPacketsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFailedPacketsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryFailedPacketsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryFailedPacketsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryFailedPacketsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryFailedPacketsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryFailedPacketsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_QueryFailedPacketsRequest_tunnel_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryFailedPacketsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryFailedPacketsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFailedPacketsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryFailedPacketsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFailedPacketsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFailedPacketsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.QueryFailedPacketsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryFailedPacketsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.QueryFailedPacketsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryFailedPacketsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryFailedPacketsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryFailedPacketsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFailedPacketsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryFailedPacketsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryFailedPacketsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryFailedPacketsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFailedPacketsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFailedPacketsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFailedPacketsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFailedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryFailedPacketsResponse_1_list)(nil)
+
+type _QueryFailedPacketsResponse_1_list struct {
+	list *[]*FailedPacket
+}
+
+func (x *_QueryFailedPacketsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryFailedPacketsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryFailedPacketsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FailedPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryFailedPacketsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FailedPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryFailedPacketsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(FailedPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryFailedPacketsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryFailedPacketsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(FailedPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryFailedPacketsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryFailedPacketsResponse                protoreflect.MessageDescriptor
+	fd_QueryFailedPacketsResponse_failed_packets protoreflect.FieldDescriptor
+	fd_QueryFailedPacketsResponse_pagination     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_query_proto_init()
+	md_QueryFailedPacketsResponse = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryFailedPacketsResponse")
+	fd_QueryFailedPacketsResponse_failed_packets = md_QueryFailedPacketsResponse.Fields().ByName("failed_packets")
+	fd_QueryFailedPacketsResponse_pagination = md_QueryFailedPacketsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryFailedPacketsResponse)(nil)
+
+type fastReflection_QueryFailedPacketsResponse QueryFailedPacketsResponse
+
+func (x *QueryFailedPacketsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFailedPacketsResponse)(x)
+}
+
+func (x *QueryFailedPacketsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryFailedPacketsResponse_messageType fastReflection_QueryFailedPacketsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFailedPacketsResponse_messageType{}
+
+type fastReflection_QueryFailedPacketsResponse_messageType struct{}
+
+func (x fastReflection_QueryFailedPacketsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFailedPacketsResponse)(nil)
+}
+func (x fastReflection_QueryFailedPacketsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFailedPacketsResponse)
+}
+func (x fastReflection_QueryFailedPacketsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFailedPacketsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryFailedPacketsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFailedPacketsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryFailedPacketsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFailedPacketsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryFailedPacketsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryFailedPacketsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryFailedPacketsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryFailedPacketsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryFailedPacketsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.FailedPackets) != 0 {
+		value := protoreflect.ValueOfList(&_QueryFailedPacketsResponse_1_list{list: &x.FailedPackets})
+		if !f(fd_QueryFailedPacketsResponse_failed_packets, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryFailedPacketsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryFailedPacketsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.failed_packets":
+		return len(x.FailedPackets) != 0
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFailedPacketsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.failed_packets":
+		x.FailedPackets = nil
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryFailedPacketsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.failed_packets":
+		if len(x.FailedPackets) == 0 {
+			return protoreflect.ValueOfList(&_QueryFailedPacketsResponse_1_list{})
+		}
+		listValue := &_QueryFailedPacketsResponse_1_list{list: &x.FailedPackets}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFailedPacketsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.failed_packets":
+		lv := value.List()
+		clv := lv.(*_QueryFailedPacketsResponse_1_list)
+		x.FailedPackets = *clv.list
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFailedPacketsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.failed_packets":
+		if x.FailedPackets == nil {
+			x.FailedPackets = []*FailedPacket{}
+		}
+		value := &_QueryFailedPacketsResponse_1_list{list: &x.FailedPackets}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryFailedPacketsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.failed_packets":
+		list := []*FailedPacket{}
+		return protoreflect.ValueOfList(&_QueryFailedPacketsResponse_1_list{list: &list})
+	case "band.tunnel.v1beta1.QueryFailedPacketsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFailedPacketsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFailedPacketsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryFailedPacketsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryFailedPacketsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryFailedPacketsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFailedPacketsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryFailedPacketsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryFailedPacketsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryFailedPacketsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.FailedPackets) > 0 {
+			for _, e := range x.FailedPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFailedPacketsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.FailedPackets) > 0 {
+			for iNdEx := len(x.FailedPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FailedPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFailedPacketsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFailedPacketsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFailedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedPackets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailedPackets = append(x.FailedPackets, &FailedPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FailedPackets[len(x.FailedPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryFeePayerBalanceRequest           protoreflect.MessageDescriptor
+	fd_QueryFeePayerBalanceRequest_tunnel_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_query_proto_init()
+	md_QueryFeePayerBalanceRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryFeePayerBalanceRequest")
+	fd_QueryFeePayerBalanceRequest_tunnel_id = md_QueryFeePayerBalanceRequest.Fields().ByName("tunnel_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryFeePayerBalanceRequest)(nil)
+
+type fastReflection_QueryFeePayerBalanceRequest QueryFeePayerBalanceRequest
+
+func (x *QueryFeePayerBalanceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFeePayerBalanceRequest)(x)
+}
+
+func (x *QueryFeePayerBalanceRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryFeePayerBalanceRequest_messageType fastReflection_QueryFeePayerBalanceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFeePayerBalanceRequest_messageType{}
+
+type fastReflection_QueryFeePayerBalanceRequest_messageType struct{}
+
+func (x fastReflection_QueryFeePayerBalanceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFeePayerBalanceRequest)(nil)
+}
+func (x fastReflection_QueryFeePayerBalanceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFeePayerBalanceRequest)
+}
+func (x fastReflection_QueryFeePayerBalanceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeePayerBalanceRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryFeePayerBalanceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeePayerBalanceRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryFeePayerBalanceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFeePayerBalanceRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryFeePayerBalanceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryFeePayerBalanceRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryFeePayerBalanceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryFeePayerBalanceRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryFeePayerBalanceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_QueryFeePayerBalanceRequest_tunnel_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryFeePayerBalanceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceRequest.tunnel_id":
+		return x.TunnelId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeePayerBalanceRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceRequest.tunnel_id":
+		x.TunnelId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryFeePayerBalanceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceRequest.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeePayerBalanceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceRequest.tunnel_id":
+		x.TunnelId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeePayerBalanceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceRequest.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.QueryFeePayerBalanceRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryFeePayerBalanceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceRequest.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryFeePayerBalanceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryFeePayerBalanceRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryFeePayerBalanceRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeePayerBalanceRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryFeePayerBalanceRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryFeePayerBalanceRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryFeePayerBalanceRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFeePayerBalanceRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFeePayerBalanceRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeePayerBalanceRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeePayerBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryFeePayerBalanceResponse_2_list)(nil)
+
+type _QueryFeePayerBalanceResponse_2_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryFeePayerBalanceResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryFeePayerBalanceResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryFeePayerBalanceResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryFeePayerBalanceResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryFeePayerBalanceResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryFeePayerBalanceResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryFeePayerBalanceResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryFeePayerBalanceResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryFeePayerBalanceResponse                protoreflect.MessageDescriptor
+	fd_QueryFeePayerBalanceResponse_fee_payer      protoreflect.FieldDescriptor
+	fd_QueryFeePayerBalanceResponse_balance        protoreflect.FieldDescriptor
+	fd_QueryFeePayerBalanceResponse_funding_policy protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_query_proto_init()
+	md_QueryFeePayerBalanceResponse = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryFeePayerBalanceResponse")
+	fd_QueryFeePayerBalanceResponse_fee_payer = md_QueryFeePayerBalanceResponse.Fields().ByName("fee_payer")
+	fd_QueryFeePayerBalanceResponse_balance = md_QueryFeePayerBalanceResponse.Fields().ByName("balance")
+	fd_QueryFeePayerBalanceResponse_funding_policy = md_QueryFeePayerBalanceResponse.Fields().ByName("funding_policy")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryFeePayerBalanceResponse)(nil)
+
+type fastReflection_QueryFeePayerBalanceResponse QueryFeePayerBalanceResponse
+
+func (x *QueryFeePayerBalanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFeePayerBalanceResponse)(x)
+}
+
+func (x *QueryFeePayerBalanceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryFeePayerBalanceResponse_messageType fastReflection_QueryFeePayerBalanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFeePayerBalanceResponse_messageType{}
+
+type fastReflection_QueryFeePayerBalanceResponse_messageType struct{}
+
+func (x fastReflection_QueryFeePayerBalanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFeePayerBalanceResponse)(nil)
+}
+func (x fastReflection_QueryFeePayerBalanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFeePayerBalanceResponse)
+}
+func (x fastReflection_QueryFeePayerBalanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeePayerBalanceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryFeePayerBalanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeePayerBalanceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryFeePayerBalanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFeePayerBalanceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryFeePayerBalanceResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryFeePayerBalanceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryFeePayerBalanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryFeePayerBalanceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryFeePayerBalanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FeePayer != "" {
+		value := protoreflect.ValueOfString(x.FeePayer)
+		if !f(fd_QueryFeePayerBalanceResponse_fee_payer, value) {
+			return
+		}
+	}
+	if len(x.Balance) != 0 {
+		value := protoreflect.ValueOfList(&_QueryFeePayerBalanceResponse_2_list{list: &x.Balance})
+		if !f(fd_QueryFeePayerBalanceResponse_balance, value) {
+			return
+		}
+	}
+	if x.FundingPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.FundingPolicy.ProtoReflect())
+		if !f(fd_QueryFeePayerBalanceResponse_funding_policy, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryFeePayerBalanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.fee_payer":
+		return x.FeePayer != ""
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.balance":
+		return len(x.Balance) != 0
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.funding_policy":
+		return x.FundingPolicy != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeePayerBalanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.fee_payer":
+		x.FeePayer = ""
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.balance":
+		x.Balance = nil
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.funding_policy":
+		x.FundingPolicy = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryFeePayerBalanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.fee_payer":
+		value := x.FeePayer
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.balance":
+		if len(x.Balance) == 0 {
+			return protoreflect.ValueOfList(&_QueryFeePayerBalanceResponse_2_list{})
+		}
+		listValue := &_QueryFeePayerBalanceResponse_2_list{list: &x.Balance}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.funding_policy":
+		value := x.FundingPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeePayerBalanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.fee_payer":
+		x.FeePayer = value.Interface().(string)
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.balance":
+		lv := value.List()
+		clv := lv.(*_QueryFeePayerBalanceResponse_2_list)
+		x.Balance = *clv.list
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.funding_policy":
+		x.FundingPolicy = value.Message().Interface().(*FundingPolicy)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeePayerBalanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.balance":
+		if x.Balance == nil {
+			x.Balance = []*v1beta11.Coin{}
+		}
+		value := &_QueryFeePayerBalanceResponse_2_list{list: &x.Balance}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.funding_policy":
+		if x.FundingPolicy == nil {
+			x.FundingPolicy = new(FundingPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.FundingPolicy.ProtoReflect())
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.fee_payer":
+		panic(fmt.Errorf("field fee_payer of message band.tunnel.v1beta1.QueryFeePayerBalanceResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryFeePayerBalanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.fee_payer":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.balance":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryFeePayerBalanceResponse_2_list{list: &list})
+	case "band.tunnel.v1beta1.QueryFeePayerBalanceResponse.funding_policy":
+		m := new(FundingPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryFeePayerBalanceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryFeePayerBalanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryFeePayerBalanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryFeePayerBalanceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryFeePayerBalanceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeePayerBalanceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryFeePayerBalanceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryFeePayerBalanceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryFeePayerBalanceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.FeePayer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Balance) > 0 {
+			for _, e := range x.Balance {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FundingPolicy != nil {
+			l = options.Size(x.FundingPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFeePayerBalanceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FundingPolicy != nil {
+			encoded, err := options.Marshal(x.FundingPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Balance) > 0 {
+			for iNdEx := len(x.Balance) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Balance[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.FeePayer) > 0 {
+			i -= len(x.FeePayer)
+			copy(dAtA[i:], x.FeePayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeePayer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFeePayerBalanceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeePayerBalanceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeePayerBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeePayer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balance = append(x.Balance, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance[len(x.Balance)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FundingPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FundingPolicy == nil {
+					x.FundingPolicy = &FundingPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FundingPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryEstimateTunnelCostRequest_2_list)(nil)
+
+type _QueryEstimateTunnelCostRequest_2_list struct {
+	list *[]*SignalDeviation
+}
+
+func (x *_QueryEstimateTunnelCostRequest_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEstimateTunnelCostRequest_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostRequest_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalDeviation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEstimateTunnelCostRequest_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignalDeviation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEstimateTunnelCostRequest_2_list) AppendMutable() protoreflect.Value {
+	v := new(SignalDeviation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostRequest_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEstimateTunnelCostRequest_2_list) NewElement() protoreflect.Value {
+	v := new(SignalDeviation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostRequest_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryEstimateTunnelCostRequest_4_list)(nil)
+
+type _QueryEstimateTunnelCostRequest_4_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryEstimateTunnelCostRequest_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEstimateTunnelCostRequest_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostRequest_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEstimateTunnelCostRequest_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEstimateTunnelCostRequest_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostRequest_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEstimateTunnelCostRequest_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostRequest_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryEstimateTunnelCostRequest                   protoreflect.MessageDescriptor
+	fd_QueryEstimateTunnelCostRequest_route             protoreflect.FieldDescriptor
+	fd_QueryEstimateTunnelCostRequest_signal_deviations protoreflect.FieldDescriptor
+	fd_QueryEstimateTunnelCostRequest_interval          protoreflect.FieldDescriptor
+	fd_QueryEstimateTunnelCostRequest_relayer_fee       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_query_proto_init()
+	md_QueryEstimateTunnelCostRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryEstimateTunnelCostRequest")
+	fd_QueryEstimateTunnelCostRequest_route = md_QueryEstimateTunnelCostRequest.Fields().ByName("route")
+	fd_QueryEstimateTunnelCostRequest_signal_deviations = md_QueryEstimateTunnelCostRequest.Fields().ByName("signal_deviations")
+	fd_QueryEstimateTunnelCostRequest_interval = md_QueryEstimateTunnelCostRequest.Fields().ByName("interval")
+	fd_QueryEstimateTunnelCostRequest_relayer_fee = md_QueryEstimateTunnelCostRequest.Fields().ByName("relayer_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEstimateTunnelCostRequest)(nil)
+
+type fastReflection_QueryEstimateTunnelCostRequest QueryEstimateTunnelCostRequest
+
+func (x *QueryEstimateTunnelCostRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEstimateTunnelCostRequest)(x)
+}
+
+func (x *QueryEstimateTunnelCostRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEstimateTunnelCostRequest_messageType fastReflection_QueryEstimateTunnelCostRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEstimateTunnelCostRequest_messageType{}
+
+type fastReflection_QueryEstimateTunnelCostRequest_messageType struct{}
+
+func (x fastReflection_QueryEstimateTunnelCostRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEstimateTunnelCostRequest)(nil)
+}
+func (x fastReflection_QueryEstimateTunnelCostRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateTunnelCostRequest)
+}
+func (x fastReflection_QueryEstimateTunnelCostRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateTunnelCostRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateTunnelCostRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEstimateTunnelCostRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateTunnelCostRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEstimateTunnelCostRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Route != nil {
+		value := protoreflect.ValueOfMessage(x.Route.ProtoReflect())
+		if !f(fd_QueryEstimateTunnelCostRequest_route, value) {
+			return
+		}
+	}
+	if len(x.SignalDeviations) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEstimateTunnelCostRequest_2_list{list: &x.SignalDeviations})
+		if !f(fd_QueryEstimateTunnelCostRequest_signal_deviations, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_QueryEstimateTunnelCostRequest_interval, value) {
+			return
+		}
+	}
+	if len(x.RelayerFee) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEstimateTunnelCostRequest_4_list{list: &x.RelayerFee})
+		if !f(fd_QueryEstimateTunnelCostRequest_relayer_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.route":
+		return x.Route != nil
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.signal_deviations":
+		return len(x.SignalDeviations) != 0
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.interval":
+		return x.Interval != uint64(0)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.relayer_fee":
+		return len(x.RelayerFee) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.route":
+		x.Route = nil
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.signal_deviations":
+		x.SignalDeviations = nil
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.interval":
+		x.Interval = uint64(0)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.relayer_fee":
+		x.RelayerFee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.route":
+		value := x.Route
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.signal_deviations":
+		if len(x.SignalDeviations) == 0 {
+			return protoreflect.ValueOfList(&_QueryEstimateTunnelCostRequest_2_list{})
+		}
+		listValue := &_QueryEstimateTunnelCostRequest_2_list{list: &x.SignalDeviations}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.interval":
+		value := x.Interval
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.relayer_fee":
+		if len(x.RelayerFee) == 0 {
+			return protoreflect.ValueOfList(&_QueryEstimateTunnelCostRequest_4_list{})
+		}
+		listValue := &_QueryEstimateTunnelCostRequest_4_list{list: &x.RelayerFee}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.route":
+		x.Route = value.Message().Interface().(*anypb.Any)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.signal_deviations":
+		lv := value.List()
+		clv := lv.(*_QueryEstimateTunnelCostRequest_2_list)
+		x.SignalDeviations = *clv.list
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.interval":
+		x.Interval = value.Uint()
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.relayer_fee":
+		lv := value.List()
+		clv := lv.(*_QueryEstimateTunnelCostRequest_4_list)
+		x.RelayerFee = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.route":
+		if x.Route == nil {
+			x.Route = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Route.ProtoReflect())
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.signal_deviations":
+		if x.SignalDeviations == nil {
+			x.SignalDeviations = []*SignalDeviation{}
+		}
+		value := &_QueryEstimateTunnelCostRequest_2_list{list: &x.SignalDeviations}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.relayer_fee":
+		if x.RelayerFee == nil {
+			x.RelayerFee = []*v1beta11.Coin{}
+		}
+		value := &_QueryEstimateTunnelCostRequest_4_list{list: &x.RelayerFee}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.interval":
+		panic(fmt.Errorf("field interval of message band.tunnel.v1beta1.QueryEstimateTunnelCostRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.route":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.signal_deviations":
+		list := []*SignalDeviation{}
+		return protoreflect.ValueOfList(&_QueryEstimateTunnelCostRequest_2_list{list: &list})
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.relayer_fee":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryEstimateTunnelCostRequest_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryEstimateTunnelCostRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEstimateTunnelCostRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEstimateTunnelCostRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Route != nil {
+			l = options.Size(x.Route)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.SignalDeviations) > 0 {
+			for _, e := range x.SignalDeviations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if len(x.RelayerFee) > 0 {
+			for _, e := range x.RelayerFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateTunnelCostRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RelayerFee) > 0 {
+			for iNdEx := len(x.RelayerFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RelayerFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.SignalDeviations) > 0 {
+			for iNdEx := len(x.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SignalDeviations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Route != nil {
+			encoded, err := options.Marshal(x.Route)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateTunnelCostRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateTunnelCostRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateTunnelCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Route == nil {
+					x.Route = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Route); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalDeviations = append(x.SignalDeviations, &SignalDeviation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignalDeviations[len(x.SignalDeviations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RelayerFee = append(x.RelayerFee, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RelayerFee[len(x.RelayerFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryEstimateTunnelCostResponse_1_list)(nil)
+
+type _QueryEstimateTunnelCostResponse_1_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryEstimateTunnelCostResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEstimateTunnelCostResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEstimateTunnelCostResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryEstimateTunnelCostResponse_2_list)(nil)
+
+type _QueryEstimateTunnelCostResponse_2_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryEstimateTunnelCostResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEstimateTunnelCostResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEstimateTunnelCostResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryEstimateTunnelCostResponse_3_list)(nil)
+
+type _QueryEstimateTunnelCostResponse_3_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryEstimateTunnelCostResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEstimateTunnelCostResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEstimateTunnelCostResponse_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryEstimateTunnelCostResponse_4_list)(nil)
+
+type _QueryEstimateTunnelCostResponse_4_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryEstimateTunnelCostResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEstimateTunnelCostResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEstimateTunnelCostResponse_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryEstimateTunnelCostResponse_6_list)(nil)
+
+type _QueryEstimateTunnelCostResponse_6_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryEstimateTunnelCostResponse_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEstimateTunnelCostResponse_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEstimateTunnelCostResponse_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryEstimateTunnelCostResponse_8_list)(nil)
+
+type _QueryEstimateTunnelCostResponse_8_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryEstimateTunnelCostResponse_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEstimateTunnelCostResponse_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEstimateTunnelCostResponse_8_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEstimateTunnelCostResponse_8_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEstimateTunnelCostResponse_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryEstimateTunnelCostResponse                           protoreflect.MessageDescriptor
+	fd_QueryEstimateTunnelCostResponse_min_deposit               protoreflect.FieldDescriptor
+	fd_QueryEstimateTunnelCostResponse_base_packet_fee           protoreflect.FieldDescriptor
+	fd_QueryEstimateTunnelCostResponse_route_fee                 protoreflect.FieldDescriptor
+	fd_QueryEstimateTunnelCostResponse_packet_fee                protoreflect.FieldDescriptor
+	fd_QueryEstimateTunnelCostResponse_packets_per_day           protoreflect.FieldDescriptor
+	fd_QueryEstimateTunnelCostResponse_daily_cost                protoreflect.FieldDescriptor
+	fd_QueryEstimateTunnelCostResponse_deviation_packets_per_day protoreflect.FieldDescriptor
+	fd_QueryEstimateTunnelCostResponse_relayer_fee               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_query_proto_init()
+	md_QueryEstimateTunnelCostResponse = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryEstimateTunnelCostResponse")
+	fd_QueryEstimateTunnelCostResponse_min_deposit = md_QueryEstimateTunnelCostResponse.Fields().ByName("min_deposit")
+	fd_QueryEstimateTunnelCostResponse_base_packet_fee = md_QueryEstimateTunnelCostResponse.Fields().ByName("base_packet_fee")
+	fd_QueryEstimateTunnelCostResponse_route_fee = md_QueryEstimateTunnelCostResponse.Fields().ByName("route_fee")
+	fd_QueryEstimateTunnelCostResponse_packet_fee = md_QueryEstimateTunnelCostResponse.Fields().ByName("packet_fee")
+	fd_QueryEstimateTunnelCostResponse_packets_per_day = md_QueryEstimateTunnelCostResponse.Fields().ByName("packets_per_day")
+	fd_QueryEstimateTunnelCostResponse_daily_cost = md_QueryEstimateTunnelCostResponse.Fields().ByName("daily_cost")
+	fd_QueryEstimateTunnelCostResponse_deviation_packets_per_day = md_QueryEstimateTunnelCostResponse.Fields().ByName("deviation_packets_per_day")
+	fd_QueryEstimateTunnelCostResponse_relayer_fee = md_QueryEstimateTunnelCostResponse.Fields().ByName("relayer_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEstimateTunnelCostResponse)(nil)
+
+type fastReflection_QueryEstimateTunnelCostResponse QueryEstimateTunnelCostResponse
+
+func (x *QueryEstimateTunnelCostResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEstimateTunnelCostResponse)(x)
+}
+
+func (x *QueryEstimateTunnelCostResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEstimateTunnelCostResponse_messageType fastReflection_QueryEstimateTunnelCostResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEstimateTunnelCostResponse_messageType{}
+
+type fastReflection_QueryEstimateTunnelCostResponse_messageType struct{}
+
+func (x fastReflection_QueryEstimateTunnelCostResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEstimateTunnelCostResponse)(nil)
+}
+func (x fastReflection_QueryEstimateTunnelCostResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateTunnelCostResponse)
+}
+func (x fastReflection_QueryEstimateTunnelCostResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateTunnelCostResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEstimateTunnelCostResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEstimateTunnelCostResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEstimateTunnelCostResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEstimateTunnelCostResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MinDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_1_list{list: &x.MinDeposit})
+		if !f(fd_QueryEstimateTunnelCostResponse_min_deposit, value) {
+			return
+		}
+	}
+	if len(x.BasePacketFee) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_2_list{list: &x.BasePacketFee})
+		if !f(fd_QueryEstimateTunnelCostResponse_base_packet_fee, value) {
+			return
+		}
+	}
+	if len(x.RouteFee) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_3_list{list: &x.RouteFee})
+		if !f(fd_QueryEstimateTunnelCostResponse_route_fee, value) {
+			return
+		}
+	}
+	if len(x.PacketFee) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_4_list{list: &x.PacketFee})
+		if !f(fd_QueryEstimateTunnelCostResponse_packet_fee, value) {
+			return
+		}
+	}
+	if x.PacketsPerDay != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketsPerDay)
+		if !f(fd_QueryEstimateTunnelCostResponse_packets_per_day, value) {
+			return
+		}
+	}
+	if len(x.DailyCost) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_6_list{list: &x.DailyCost})
+		if !f(fd_QueryEstimateTunnelCostResponse_daily_cost, value) {
+			return
+		}
+	}
+	if x.DeviationPacketsPerDay != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DeviationPacketsPerDay)
+		if !f(fd_QueryEstimateTunnelCostResponse_deviation_packets_per_day, value) {
+			return
+		}
+	}
+	if len(x.RelayerFee) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_8_list{list: &x.RelayerFee})
+		if !f(fd_QueryEstimateTunnelCostResponse_relayer_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.min_deposit":
+		return len(x.MinDeposit) != 0
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.base_packet_fee":
+		return len(x.BasePacketFee) != 0
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.route_fee":
+		return len(x.RouteFee) != 0
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packet_fee":
+		return len(x.PacketFee) != 0
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packets_per_day":
+		return x.PacketsPerDay != uint64(0)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.daily_cost":
+		return len(x.DailyCost) != 0
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.deviation_packets_per_day":
+		return x.DeviationPacketsPerDay != uint64(0)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.relayer_fee":
+		return len(x.RelayerFee) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.min_deposit":
+		x.MinDeposit = nil
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.base_packet_fee":
+		x.BasePacketFee = nil
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.route_fee":
+		x.RouteFee = nil
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packet_fee":
+		x.PacketFee = nil
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packets_per_day":
+		x.PacketsPerDay = uint64(0)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.daily_cost":
+		x.DailyCost = nil
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.deviation_packets_per_day":
+		x.DeviationPacketsPerDay = uint64(0)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.relayer_fee":
+		x.RelayerFee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.min_deposit":
+		if len(x.MinDeposit) == 0 {
+			return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_1_list{})
+		}
+		listValue := &_QueryEstimateTunnelCostResponse_1_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.base_packet_fee":
+		if len(x.BasePacketFee) == 0 {
+			return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_2_list{})
+		}
+		listValue := &_QueryEstimateTunnelCostResponse_2_list{list: &x.BasePacketFee}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.route_fee":
+		if len(x.RouteFee) == 0 {
+			return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_3_list{})
+		}
+		listValue := &_QueryEstimateTunnelCostResponse_3_list{list: &x.RouteFee}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packet_fee":
+		if len(x.PacketFee) == 0 {
+			return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_4_list{})
+		}
+		listValue := &_QueryEstimateTunnelCostResponse_4_list{list: &x.PacketFee}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packets_per_day":
+		value := x.PacketsPerDay
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.daily_cost":
+		if len(x.DailyCost) == 0 {
+			return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_6_list{})
+		}
+		listValue := &_QueryEstimateTunnelCostResponse_6_list{list: &x.DailyCost}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.deviation_packets_per_day":
+		value := x.DeviationPacketsPerDay
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.relayer_fee":
+		if len(x.RelayerFee) == 0 {
+			return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_8_list{})
+		}
+		listValue := &_QueryEstimateTunnelCostResponse_8_list{list: &x.RelayerFee}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.min_deposit":
+		lv := value.List()
+		clv := lv.(*_QueryEstimateTunnelCostResponse_1_list)
+		x.MinDeposit = *clv.list
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.base_packet_fee":
+		lv := value.List()
+		clv := lv.(*_QueryEstimateTunnelCostResponse_2_list)
+		x.BasePacketFee = *clv.list
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.route_fee":
+		lv := value.List()
+		clv := lv.(*_QueryEstimateTunnelCostResponse_3_list)
+		x.RouteFee = *clv.list
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packet_fee":
+		lv := value.List()
+		clv := lv.(*_QueryEstimateTunnelCostResponse_4_list)
+		x.PacketFee = *clv.list
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packets_per_day":
+		x.PacketsPerDay = value.Uint()
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.daily_cost":
+		lv := value.List()
+		clv := lv.(*_QueryEstimateTunnelCostResponse_6_list)
+		x.DailyCost = *clv.list
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.deviation_packets_per_day":
+		x.DeviationPacketsPerDay = value.Uint()
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.relayer_fee":
+		lv := value.List()
+		clv := lv.(*_QueryEstimateTunnelCostResponse_8_list)
+		x.RelayerFee = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.min_deposit":
+		if x.MinDeposit == nil {
+			x.MinDeposit = []*v1beta11.Coin{}
+		}
+		value := &_QueryEstimateTunnelCostResponse_1_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.base_packet_fee":
+		if x.BasePacketFee == nil {
+			x.BasePacketFee = []*v1beta11.Coin{}
+		}
+		value := &_QueryEstimateTunnelCostResponse_2_list{list: &x.BasePacketFee}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.route_fee":
+		if x.RouteFee == nil {
+			x.RouteFee = []*v1beta11.Coin{}
+		}
+		value := &_QueryEstimateTunnelCostResponse_3_list{list: &x.RouteFee}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packet_fee":
+		if x.PacketFee == nil {
+			x.PacketFee = []*v1beta11.Coin{}
+		}
+		value := &_QueryEstimateTunnelCostResponse_4_list{list: &x.PacketFee}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.daily_cost":
+		if x.DailyCost == nil {
+			x.DailyCost = []*v1beta11.Coin{}
+		}
+		value := &_QueryEstimateTunnelCostResponse_6_list{list: &x.DailyCost}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.relayer_fee":
+		if x.RelayerFee == nil {
+			x.RelayerFee = []*v1beta11.Coin{}
+		}
+		value := &_QueryEstimateTunnelCostResponse_8_list{list: &x.RelayerFee}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packets_per_day":
+		panic(fmt.Errorf("field packets_per_day of message band.tunnel.v1beta1.QueryEstimateTunnelCostResponse is not mutable"))
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.deviation_packets_per_day":
+		panic(fmt.Errorf("field deviation_packets_per_day of message band.tunnel.v1beta1.QueryEstimateTunnelCostResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.min_deposit":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_1_list{list: &list})
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.base_packet_fee":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_2_list{list: &list})
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.route_fee":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_3_list{list: &list})
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packet_fee":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_4_list{list: &list})
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packets_per_day":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.daily_cost":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_6_list{list: &list})
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.deviation_packets_per_day":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.relayer_fee":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryEstimateTunnelCostResponse_8_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryEstimateTunnelCostResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryEstimateTunnelCostResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEstimateTunnelCostResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEstimateTunnelCostResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.MinDeposit) > 0 {
+			for _, e := range x.MinDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BasePacketFee) > 0 {
+			for _, e := range x.BasePacketFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RouteFee) > 0 {
+			for _, e := range x.RouteFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PacketFee) > 0 {
+			for _, e := range x.PacketFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PacketsPerDay != 0 {
+			n += 1 + runtime.Sov(uint64(x.PacketsPerDay))
+		}
+		if len(x.DailyCost) > 0 {
+			for _, e := range x.DailyCost {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DeviationPacketsPerDay != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeviationPacketsPerDay))
+		}
+		if len(x.RelayerFee) > 0 {
+			for _, e := range x.RelayerFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateTunnelCostResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RelayerFee) > 0 {
+			for iNdEx := len(x.RelayerFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RelayerFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.DeviationPacketsPerDay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationPacketsPerDay))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.DailyCost) > 0 {
+			for iNdEx := len(x.DailyCost) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DailyCost[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.PacketsPerDay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketsPerDay))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.PacketFee) > 0 {
+			for iNdEx := len(x.PacketFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PacketFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.RouteFee) > 0 {
+			for iNdEx := len(x.RouteFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RouteFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.BasePacketFee) > 0 {
+			for iNdEx := len(x.BasePacketFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BasePacketFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.MinDeposit) > 0 {
+			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEstimateTunnelCostResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateTunnelCostResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEstimateTunnelCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinDeposit = append(x.MinDeposit, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasePacketFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasePacketFee = append(x.BasePacketFee, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BasePacketFee[len(x.BasePacketFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RouteFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RouteFee = append(x.RouteFee, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RouteFee[len(x.RouteFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PacketFee = append(x.PacketFee, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PacketFee[len(x.PacketFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketsPerDay", wireType)
+				}
+				x.PacketsPerDay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketsPerDay |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DailyCost", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DailyCost = append(x.DailyCost, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DailyCost[len(x.DailyCost)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeviationPacketsPerDay", wireType)
+				}
+				x.DeviationPacketsPerDay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeviationPacketsPerDay |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RelayerFee = append(x.RelayerFee, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RelayerFee[len(x.RelayerFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTotalFeesRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_query_proto_init()
+	md_QueryTotalFeesRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryTotalFeesRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTotalFeesRequest)(nil)
+
+type fastReflection_QueryTotalFeesRequest QueryTotalFeesRequest
+
+func (x *QueryTotalFeesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTotalFeesRequest)(x)
+}
+
+func (x *QueryTotalFeesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTotalFeesRequest_messageType fastReflection_QueryTotalFeesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTotalFeesRequest_messageType{}
+
+type fastReflection_QueryTotalFeesRequest_messageType struct{}
+
+func (x fastReflection_QueryTotalFeesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTotalFeesRequest)(nil)
+}
+func (x fastReflection_QueryTotalFeesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalFeesRequest)
+}
+func (x fastReflection_QueryTotalFeesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalFeesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTotalFeesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalFeesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTotalFeesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTotalFeesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTotalFeesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalFeesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTotalFeesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTotalFeesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTotalFeesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTotalFeesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalFeesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTotalFeesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalFeesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalFeesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTotalFeesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTotalFeesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryTotalFeesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTotalFeesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalFeesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTotalFeesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTotalFeesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTotalFeesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalFeesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalFeesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalFeesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTotalFeesResponse            protoreflect.MessageDescriptor
+	fd_QueryTotalFeesResponse_total_fees protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_query_proto_init()
+	md_QueryTotalFeesResponse = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryTotalFeesResponse")
+	fd_QueryTotalFeesResponse_total_fees = md_QueryTotalFeesResponse.Fields().ByName("total_fees")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTotalFeesResponse)(nil)
+
+type fastReflection_QueryTotalFeesResponse QueryTotalFeesResponse
+
+func (x *QueryTotalFeesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTotalFeesResponse)(x)
+}
+
+func (x *QueryTotalFeesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTotalFeesResponse_messageType fastReflection_QueryTotalFeesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTotalFeesResponse_messageType{}
+
+type fastReflection_QueryTotalFeesResponse_messageType struct{}
+
+func (x fastReflection_QueryTotalFeesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTotalFeesResponse)(nil)
+}
+func (x fastReflection_QueryTotalFeesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalFeesResponse)
+}
+func (x fastReflection_QueryTotalFeesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalFeesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTotalFeesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalFeesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTotalFeesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTotalFeesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTotalFeesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalFeesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTotalFeesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTotalFeesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTotalFeesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TotalFees != nil {
+		value := protoreflect.ValueOfMessage(x.TotalFees.ProtoReflect())
+		if !f(fd_QueryTotalFeesResponse_total_fees, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTotalFeesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
+		return x.TotalFees != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalFeesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
+		x.TotalFees = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTotalFeesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
+		value := x.TotalFees
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalFeesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
+		x.TotalFees = value.Message().Interface().(*TotalFees)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalFeesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
+		if x.TotalFees == nil {
+			x.TotalFees = new(TotalFees)
+		}
+		return protoreflect.ValueOfMessage(x.TotalFees.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTotalFeesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees":
+		m := new(TotalFees)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryTotalFeesResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryTotalFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTotalFeesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryTotalFeesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTotalFeesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalFeesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTotalFeesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTotalFeesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTotalFeesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TotalFees != nil {
+			l = options.Size(x.TotalFees)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalFeesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TotalFees != nil {
+			encoded, err := options.Marshal(x.TotalFees)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalFeesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalFeesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalFees == nil {
+					x.TotalFees = &TotalFees{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalFees); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryParamsRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_query_proto_init()
+	md_QueryParamsRequest = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryParamsRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+
+type fastReflection_QueryParamsRequest QueryParamsRequest
+
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
+}
+
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+
+type fastReflection_QueryParamsRequest_messageType struct{}
+
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
+}
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
+}
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryParamsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryParamsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryParamsResponse        protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_query_proto_init()
+	md_QueryParamsResponse = File_band_tunnel_v1beta1_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+
+type fastReflection_QueryParamsResponse QueryParamsResponse
+
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
+}
+
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+
+type fastReflection_QueryParamsResponse_messageType struct{}
+
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
+}
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
+}
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsResponse_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryParamsResponse.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryParamsResponse.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.QueryParamsResponse.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.QueryParamsResponse.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.QueryParamsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryParamsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/tunnel/v1beta1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TunnelStatusFilter defines a filter for tunnel status.
+type TunnelStatusFilter int32
+
+const (
+	// TUNNEL_STATUS_FILTER_UNSPECIFIED defines an unspecified status.
+	TunnelStatusFilter_TUNNEL_STATUS_FILTER_UNSPECIFIED TunnelStatusFilter = 0
+	// TUNNEL_STATUS_FILTER_ACTIVE defines an active tunnel.
+	TunnelStatusFilter_TUNNEL_STATUS_FILTER_ACTIVE TunnelStatusFilter = 1
+	// TUNNEL_STATUS_FILTER_INACTIVE defines an inactive tunnel.
+	TunnelStatusFilter_TUNNEL_STATUS_FILTER_INACTIVE TunnelStatusFilter = 2
+)
+
+// Enum value maps for TunnelStatusFilter.
+var (
+	TunnelStatusFilter_name = map[int32]string{
+		0: "TUNNEL_STATUS_FILTER_UNSPECIFIED",
+		1: "TUNNEL_STATUS_FILTER_ACTIVE",
+		2: "TUNNEL_STATUS_FILTER_INACTIVE",
+	}
+	TunnelStatusFilter_value = map[string]int32{
+		"TUNNEL_STATUS_FILTER_UNSPECIFIED": 0,
+		"TUNNEL_STATUS_FILTER_ACTIVE":      1,
+		"TUNNEL_STATUS_FILTER_INACTIVE":    2,
+	}
+)
+
+func (x TunnelStatusFilter) Enum() *TunnelStatusFilter {
+	p := new(TunnelStatusFilter)
+	*p = x
+	return p
+}
+
+func (x TunnelStatusFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TunnelStatusFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_tunnel_v1beta1_query_proto_enumTypes[0].Descriptor()
+}
+
+func (TunnelStatusFilter) Type() protoreflect.EnumType {
+	return &file_band_tunnel_v1beta1_query_proto_enumTypes[0]
+}
+
+func (x TunnelStatusFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TunnelStatusFilter.Descriptor instead.
+func (TunnelStatusFilter) EnumDescriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryTunnelsRequest is the request type for the Query/Tunnels RPC method.
+type QueryTunnelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status_filter is a flag to filter tunnels by status.
+	StatusFilter TunnelStatusFilter `protobuf:"varint,1,opt,name=status_filter,json=statusFilter,proto3,enum=band.tunnel.v1beta1.TunnelStatusFilter" json:"status_filter,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// admin is an optional address to filter tunnels that the address is the creator or a co-administrator of.
+	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (x *QueryTunnelsRequest) Reset() {
+	*x = QueryTunnelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTunnelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTunnelsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTunnelsRequest.ProtoReflect.Descriptor instead.
+func (*QueryTunnelsRequest) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryTunnelsRequest) GetStatusFilter() TunnelStatusFilter {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return TunnelStatusFilter_TUNNEL_STATUS_FILTER_UNSPECIFIED
+}
+
+func (x *QueryTunnelsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryTunnelsRequest) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+// QueryTunnelsResponse is the response type for the Query/Tunnels RPC method.
+type QueryTunnelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Tunnels is a list of tunnels.
+	Tunnels []*Tunnel `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryTunnelsResponse) Reset() {
+	*x = QueryTunnelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTunnelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTunnelsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTunnelsResponse.ProtoReflect.Descriptor instead.
+func (*QueryTunnelsResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryTunnelsResponse) GetTunnels() []*Tunnel {
+	if x != nil {
+		return x.Tunnels
+	}
+	return nil
+}
+
+func (x *QueryTunnelsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryTunnelRequest is the request type for the Query/Tunnel RPC method.
+type QueryTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to query.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+}
+
+func (x *QueryTunnelRequest) Reset() {
+	*x = QueryTunnelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTunnelRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTunnelRequest.ProtoReflect.Descriptor instead.
+func (*QueryTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryTunnelRequest) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+// QueryTunnelResponse is the response type for the Query/Tunnel RPC method.
+type QueryTunnelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel is the tunnel with the given ID.
+	Tunnel *Tunnel `protobuf:"bytes,1,opt,name=tunnel,proto3" json:"tunnel,omitempty"`
+}
+
+func (x *QueryTunnelResponse) Reset() {
+	*x = QueryTunnelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTunnelResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTunnelResponse.ProtoReflect.Descriptor instead.
+func (*QueryTunnelResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryTunnelResponse) GetTunnel() *Tunnel {
+	if x != nil {
+		return x.Tunnel
+	}
+	return nil
+}
+
+// QueryDepositsRequest is the request type for the Query/Deposits RPC method.
+type QueryDepositsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to query deposits.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
 
-// Deprecated: Use TunnelStatusFilter.Descriptor instead.
-func (TunnelStatusFilter) EnumDescriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{0}
+func (x *QueryDepositsRequest) Reset() {
+	*x = QueryDepositsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDepositsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDepositsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDepositsRequest.ProtoReflect.Descriptor instead.
+func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryDepositsRequest) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *QueryDepositsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryDepositsResponse is the response type for the Query/Deposits RPC method.
+type QueryDepositsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// deposits is a list of deposits.
+	Deposits []*Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryDepositsResponse) Reset() {
+	*x = QueryDepositsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDepositsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDepositsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDepositsResponse.ProtoReflect.Descriptor instead.
+func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryDepositsResponse) GetDeposits() []*Deposit {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+func (x *QueryDepositsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryDepositRequest is the request type for the Query/Deposit RPC method.
+type QueryDepositRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to query.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// depositor is the address of the depositor to query.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+}
+
+func (x *QueryDepositRequest) Reset() {
+	*x = QueryDepositRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDepositRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDepositRequest.ProtoReflect.Descriptor instead.
+func (*QueryDepositRequest) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryDepositRequest) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *QueryDepositRequest) GetDepositor() string {
+	if x != nil {
+		return x.Depositor
+	}
+	return ""
+}
+
+// QueryDepositResponse is the response type for the Query/Deposit RPC method.
+type QueryDepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// deposit is the deposit with the given tunnel ID and depositor address.
+	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *QueryDepositResponse) Reset() {
+	*x = QueryDepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDepositResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDepositResponse.ProtoReflect.Descriptor instead.
+func (*QueryDepositResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryDepositResponse) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+// QueryPacketsRequest is the request type for the Query/Packets RPC method.
+type QueryPacketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to query packets.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryPacketsRequest) Reset() {
+	*x = QueryPacketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPacketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPacketsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPacketsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPacketsRequest) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryPacketsRequest) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *QueryPacketsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryPacketsResponse is the response type for the Query/Packets RPC method.
+type QueryPacketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// packets is a list of packets.
+	Packets []*Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryPacketsResponse) Reset() {
+	*x = QueryPacketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPacketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPacketsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPacketsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPacketsResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryPacketsResponse) GetPackets() []*Packet {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+func (x *QueryPacketsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
 }
 
-// QueryTunnelsRequest is the request type for the Query/Tunnels RPC method.
-type QueryTunnelsRequest struct {
+// QueryPacketsByTimeRequest is the request type for the Query/PacketsByTime RPC method.
+type QueryPacketsByTimeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// status_filter is a flag to filter tunnels by status.
-	StatusFilter TunnelStatusFilter `protobuf:"varint,1,opt,name=status_filter,json=statusFilter,proto3,enum=band.tunnel.v1beta1.TunnelStatusFilter" json:"status_filter,omitempty"`
+	// tunnel_id is the ID of the tunnel to query packets.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// start_time is the earliest creation time (unix seconds) of the packets, inclusive.
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the latest creation time (unix seconds) of the packets, inclusive. Zero means no upper bound.
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	// pagination defines an optional pagination for the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryTunnelsRequest) Reset() {
-	*x = QueryTunnelsRequest{}
+func (x *QueryPacketsByTimeRequest) Reset() {
+	*x = QueryPacketsByTimeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[0]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTunnelsRequest) String() string {
+func (x *QueryPacketsByTimeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTunnelsRequest) ProtoMessage() {}
+func (*QueryPacketsByTimeRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTunnelsRequest.ProtoReflect.Descriptor instead.
-func (*QueryTunnelsRequest) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryPacketsByTimeRequest.ProtoReflect.Descriptor instead.
+func (*QueryPacketsByTimeRequest) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryTunnelsRequest) GetStatusFilter() TunnelStatusFilter {
+func (x *QueryPacketsByTimeRequest) GetTunnelId() uint64 {
 	if x != nil {
-		return x.StatusFilter
+		return x.TunnelId
 	}
-	return TunnelStatusFilter_TUNNEL_STATUS_FILTER_UNSPECIFIED
+	return 0
 }
 
-func (x *QueryTunnelsRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryPacketsByTimeRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *QueryPacketsByTimeRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *QueryPacketsByTimeRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryTunnelsResponse is the response type for the Query/Tunnels RPC method.
-type QueryTunnelsResponse struct {
+// QueryPacketsByTimeResponse is the response type for the Query/PacketsByTime RPC method.
+type QueryPacketsByTimeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Tunnels is a list of tunnels.
-	Tunnels []*Tunnel `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+	// packets is a list of packets ordered by creation time.
+	Packets []*Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
 	// pagination defines an optional pagination for the response.
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryTunnelsResponse) Reset() {
-	*x = QueryTunnelsResponse{}
+func (x *QueryPacketsByTimeResponse) Reset() {
+	*x = QueryPacketsByTimeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[1]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTunnelsResponse) String() string {
+func (x *QueryPacketsByTimeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTunnelsResponse) ProtoMessage() {}
+func (*QueryPacketsByTimeResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTunnelsResponse.ProtoReflect.Descriptor instead.
-func (*QueryTunnelsResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryPacketsByTimeResponse.ProtoReflect.Descriptor instead.
+func (*QueryPacketsByTimeResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *QueryTunnelsResponse) GetTunnels() []*Tunnel {
+func (x *QueryPacketsByTimeResponse) GetPackets() []*Packet {
 	if x != nil {
-		return x.Tunnels
+		return x.Packets
 	}
 	return nil
 }
 
-func (x *QueryTunnelsResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryPacketsByTimeResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryTunnelRequest is the request type for the Query/Tunnel RPC method.
-type QueryTunnelRequest struct {
+// QueryPacketsByStatusRequest is the request type for the Query/PacketsByStatus RPC method.
+type QueryPacketsByStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the ID of the tunnel to query.
-	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// status is the status of the packets.
+	Status PacketStatus `protobuf:"varint,1,opt,name=status,proto3,enum=band.tunnel.v1beta1.PacketStatus" json:"status,omitempty"`
+	// receipt_type is the optional full name of the packet receipt type, e.g. band.tunnel.v1beta1.TSSPacketReceipt.
+	ReceiptType string `protobuf:"bytes,2,opt,name=receipt_type,json=receiptType,proto3" json:"receipt_type,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryTunnelRequest) Reset() {
-	*x = QueryTunnelRequest{}
+func (x *QueryPacketsByStatusRequest) Reset() {
+	*x = QueryPacketsByStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[2]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTunnelRequest) String() string {
+func (x *QueryPacketsByStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTunnelRequest) ProtoMessage() {}
+func (*QueryPacketsByStatusRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTunnelRequest.ProtoReflect.Descriptor instead.
-func (*QueryTunnelRequest) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryPacketsByStatusRequest.ProtoReflect.Descriptor instead.
+func (*QueryPacketsByStatusRequest) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueryTunnelRequest) GetTunnelId() uint64 {
+func (x *QueryPacketsByStatusRequest) GetStatus() PacketStatus {
 	if x != nil {
-		return x.TunnelId
+		return x.Status
 	}
-	return 0
+	return PacketStatus_PACKET_STATUS_UNSPECIFIED
 }
 
-// QueryTunnelResponse is the response type for the Query/Tunnel RPC method.
-type QueryTunnelResponse struct {
+func (x *QueryPacketsByStatusRequest) GetReceiptType() string {
+	if x != nil {
+		return x.ReceiptType
+	}
+	return ""
+}
+
+func (x *QueryPacketsByStatusRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryPacketsByStatusResponse is the response type for the Query/PacketsByStatus RPC method.
+type QueryPacketsByStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel is the tunnel with the given ID.
-	Tunnel *Tunnel `protobuf:"bytes,1,opt,name=tunnel,proto3" json:"tunnel,omitempty"`
+	// packets is a list of packets.
+	Packets []*Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryTunnelResponse) Reset() {
-	*x = QueryTunnelResponse{}
+func (x *QueryPacketsByStatusResponse) Reset() {
+	*x = QueryPacketsByStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[3]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTunnelResponse) String() string {
+func (x *QueryPacketsByStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTunnelResponse) ProtoMessage() {}
+func (*QueryPacketsByStatusResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTunnelResponse.ProtoReflect.Descriptor instead.
-func (*QueryTunnelResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryPacketsByStatusResponse.ProtoReflect.Descriptor instead.
+func (*QueryPacketsByStatusResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryTunnelResponse) GetTunnel() *Tunnel {
+func (x *QueryPacketsByStatusResponse) GetPackets() []*Packet {
 	if x != nil {
-		return x.Tunnel
+		return x.Packets
 	}
 	return nil
 }
 
-// QueryDepositsRequest is the request type for the Query/Deposits RPC method.
-type QueryDepositsRequest struct {
+func (x *QueryPacketsByStatusResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryPacketRequest is the request type for the Query/Packet RPC method.
+type QueryPacketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the ID of the tunnel to query deposits.
+	// tunnel_id is the ID of the tunnel to query packets.
 	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// sequence is the sequence of the packet to query.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (x *QueryDepositsRequest) Reset() {
-	*x = QueryDepositsRequest{}
+func (x *QueryPacketRequest) Reset() {
+	*x = QueryPacketRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[4]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDepositsRequest) String() string {
+func (x *QueryPacketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDepositsRequest) ProtoMessage() {}
+func (*QueryPacketRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryDepositsRequest.ProtoReflect.Descriptor instead.
-func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryPacketRequest.ProtoReflect.Descriptor instead.
+func (*QueryPacketRequest) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryDepositsRequest) GetTunnelId() uint64 {
+func (x *QueryPacketRequest) GetTunnelId() uint64 {
 	if x != nil {
 		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *QueryDepositsRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryPacketRequest) GetSequence() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.Sequence
 	}
-	return nil
+	return 0
 }
 
-// QueryDepositsResponse is the response type for the Query/Deposits RPC method.
-type QueryDepositsResponse struct {
+// QueryPacketResponse is the response type for the Query/Packet RPC method.
+type QueryPacketResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// deposits is a list of deposits.
-	Deposits []*Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
-	// pagination defines an optional pagination for the response.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// packet is the packet with the given tunnel ID and sequence.
+	Packet *Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
 }
 
-func (x *QueryDepositsResponse) Reset() {
-	*x = QueryDepositsResponse{}
+func (x *QueryPacketResponse) Reset() {
+	*x = QueryPacketResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[5]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDepositsResponse) String() string {
+func (x *QueryPacketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDepositsResponse) ProtoMessage() {}
+func (*QueryPacketResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryDepositsResponse.ProtoReflect.Descriptor instead.
-func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryPacketResponse.ProtoReflect.Descriptor instead.
+func (*QueryPacketResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryDepositsResponse) GetDeposits() []*Deposit {
+func (x *QueryPacketResponse) GetPacket() *Packet {
 	if x != nil {
-		return x.Deposits
+		return x.Packet
 	}
 	return nil
 }
 
-func (x *QueryDepositsResponse) GetPagination() *v1beta1.PageResponse {
+// QueryFailedPacketsRequest is the request type for the Query/FailedPackets RPC method.
+type QueryFailedPacketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to query failed packets.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryFailedPacketsRequest) Reset() {
+	*x = QueryFailedPacketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFailedPacketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFailedPacketsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryFailedPacketsRequest.ProtoReflect.Descriptor instead.
+func (*QueryFailedPacketsRequest) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueryFailedPacketsRequest) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *QueryFailedPacketsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryDepositRequest is the request type for the Query/Deposit RPC method.
-type QueryDepositRequest struct {
+// QueryFailedPacketsResponse is the response type for the Query/FailedPackets RPC method.
+type QueryFailedPacketsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the ID of the tunnel to query.
-	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// depositor is the address of the depositor to query.
-	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// failed_packets is a list of failed packets.
+	FailedPackets []*FailedPacket `protobuf:"bytes,1,rep,name=failed_packets,json=failedPackets,proto3" json:"failed_packets,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryDepositRequest) Reset() {
-	*x = QueryDepositRequest{}
+func (x *QueryFailedPacketsResponse) Reset() {
+	*x = QueryFailedPacketsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[6]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDepositRequest) String() string {
+func (x *QueryFailedPacketsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDepositRequest) ProtoMessage() {}
+func (*QueryFailedPacketsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryDepositRequest.ProtoReflect.Descriptor instead.
-func (*QueryDepositRequest) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryFailedPacketsResponse.ProtoReflect.Descriptor instead.
+func (*QueryFailedPacketsResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryDepositRequest) GetTunnelId() uint64 {
+func (x *QueryFailedPacketsResponse) GetFailedPackets() []*FailedPacket {
 	if x != nil {
-		return x.TunnelId
+		return x.FailedPackets
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryDepositRequest) GetDepositor() string {
+func (x *QueryFailedPacketsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.Depositor
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryDepositResponse is the response type for the Query/Deposit RPC method.
-type QueryDepositResponse struct {
+// QueryFeePayerBalanceRequest is the request type for the Query/FeePayerBalance RPC method.
+type QueryFeePayerBalanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// deposit is the deposit with the given tunnel ID and depositor address.
-	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// tunnel_id is the ID of the tunnel to query.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
 }
 
-func (x *QueryDepositResponse) Reset() {
-	*x = QueryDepositResponse{}
+func (x *QueryFeePayerBalanceRequest) Reset() {
+	*x = QueryFeePayerBalanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[7]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDepositResponse) String() string {
+func (x *QueryFeePayerBalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDepositResponse) ProtoMessage() {}
+func (*QueryFeePayerBalanceRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryDepositResponse.ProtoReflect.Descriptor instead.
-func (*QueryDepositResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryFeePayerBalanceRequest.ProtoReflect.Descriptor instead.
+func (*QueryFeePayerBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryDepositResponse) GetDeposit() *Deposit {
+func (x *QueryFeePayerBalanceRequest) GetTunnelId() uint64 {
 	if x != nil {
-		return x.Deposit
+		return x.TunnelId
 	}
-	return nil
+	return 0
 }
 
-// QueryPacketsRequest is the request type for the Query/Packets RPC method.
-type QueryPacketsRequest struct {
+// QueryFeePayerBalanceResponse is the response type for the Query/FeePayerBalance RPC method.
+type QueryFeePayerBalanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the ID of the tunnel to query packets.
-	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// fee_payer is the address of the fee payer of the tunnel.
+	FeePayer string `protobuf:"bytes,1,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// balance is the balance of the fee payer.
+	Balance []*v1beta11.Coin `protobuf:"bytes,2,rep,name=balance,proto3" json:"balance,omitempty"`
+	// funding_policy is the funding policy of the tunnel, if any.
+	FundingPolicy *FundingPolicy `protobuf:"bytes,3,opt,name=funding_policy,json=fundingPolicy,proto3" json:"funding_policy,omitempty"`
 }
 
-func (x *QueryPacketsRequest) Reset() {
-	*x = QueryPacketsRequest{}
+func (x *QueryFeePayerBalanceResponse) Reset() {
+	*x = QueryFeePayerBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[8]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPacketsRequest) String() string {
+func (x *QueryFeePayerBalanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPacketsRequest) ProtoMessage() {}
+func (*QueryFeePayerBalanceResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryFeePayerBalanceResponse.ProtoReflect.Descriptor instead.
+func (*QueryFeePayerBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{19}
+}
 
-// Deprecated: Use QueryPacketsRequest.ProtoReflect.Descriptor instead.
-func (*QueryPacketsRequest) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{8}
+func (x *QueryFeePayerBalanceResponse) GetFeePayer() string {
+	if x != nil {
+		return x.FeePayer
+	}
+	return ""
 }
 
-func (x *QueryPacketsRequest) GetTunnelId() uint64 {
+func (x *QueryFeePayerBalanceResponse) GetBalance() []*v1beta11.Coin {
 	if x != nil {
-		return x.TunnelId
+		return x.Balance
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryPacketsRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryFeePayerBalanceResponse) GetFundingPolicy() *FundingPolicy {
 	if x != nil {
-		return x.Pagination
+		return x.FundingPolicy
 	}
 	return nil
 }
 
-// QueryPacketsResponse is the response type for the Query/Packets RPC method.
-type QueryPacketsResponse struct {
+// QueryEstimateTunnelCostRequest is the request type for the Query/EstimateTunnelCost RPC method.
+type QueryEstimateTunnelCostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// packets is a list of packets.
-	Packets []*Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
-	// pagination defines an optional pagination for the response.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// route is the proposed route of the tunnel.
+	Route *anypb.Any `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// signal_deviations is the list of proposed signal deviations.
+	SignalDeviations []*SignalDeviation `protobuf:"bytes,2,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations,omitempty"`
+	// interval is the proposed interval for delivering the signal prices in seconds.
+	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// relayer_fee is the expected fee paid off-chain to the relayer for each packet of an IBC-based route.
+	// It is only used for the estimate and is not charged by the tunnel module.
+	RelayerFee []*v1beta11.Coin `protobuf:"bytes,4,rep,name=relayer_fee,json=relayerFee,proto3" json:"relayer_fee,omitempty"`
 }
 
-func (x *QueryPacketsResponse) Reset() {
-	*x = QueryPacketsResponse{}
+func (x *QueryEstimateTunnelCostRequest) Reset() {
+	*x = QueryEstimateTunnelCostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[9]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPacketsResponse) String() string {
+func (x *QueryEstimateTunnelCostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPacketsResponse) ProtoMessage() {}
+func (*QueryEstimateTunnelCostRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryPacketsResponse.ProtoReflect.Descriptor instead.
-func (*QueryPacketsResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryEstimateTunnelCostRequest.ProtoReflect.Descriptor instead.
+func (*QueryEstimateTunnelCostRequest) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryPacketsResponse) GetPackets() []*Packet {
+func (x *QueryEstimateTunnelCostRequest) GetRoute() *anypb.Any {
 	if x != nil {
-		return x.Packets
+		return x.Route
 	}
 	return nil
 }
 
-func (x *QueryPacketsResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryEstimateTunnelCostRequest) GetSignalDeviations() []*SignalDeviation {
 	if x != nil {
-		return x.Pagination
+		return x.SignalDeviations
 	}
 	return nil
 }
 
-// QueryPacketRequest is the request type for the Query/Packet RPC method.
-type QueryPacketRequest struct {
+func (x *QueryEstimateTunnelCostRequest) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *QueryEstimateTunnelCostRequest) GetRelayerFee() []*v1beta11.Coin {
+	if x != nil {
+		return x.RelayerFee
+	}
+	return nil
+}
+
+// QueryEstimateTunnelCostResponse is the response type for the Query/EstimateTunnelCost RPC method.
+type QueryEstimateTunnelCostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the ID of the tunnel to query packets.
-	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// sequence is the sequence of the packet to query.
-	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-}
-
-func (x *QueryPacketRequest) Reset() {
-	*x = QueryPacketRequest{}
+	// min_deposit is the minimum deposit required to activate the tunnel.
+	MinDeposit []*v1beta11.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// base_packet_fee is the base fee charged by the tunnel module for each packet.
+	BasePacketFee []*v1beta11.Coin `protobuf:"bytes,2,rep,name=base_packet_fee,json=basePacketFee,proto3" json:"base_packet_fee,omitempty"`
+	// route_fee is the fee charged by the route for each packet, e.g. the bandtss signing fee.
+	RouteFee []*v1beta11.Coin `protobuf:"bytes,3,rep,name=route_fee,json=routeFee,proto3" json:"route_fee,omitempty"`
+	// packet_fee is the total cost of each packet, including the relayer fee.
+	PacketFee []*v1beta11.Coin `protobuf:"bytes,4,rep,name=packet_fee,json=packetFee,proto3" json:"packet_fee,omitempty"`
+	// packets_per_day is the estimated number of packets produced per day, including the packets
+	// triggered by price deviations.
+	PacketsPerDay uint64 `protobuf:"varint,5,opt,name=packets_per_day,json=packetsPerDay,proto3" json:"packets_per_day,omitempty"`
+	// daily_cost is the estimated cost of running the tunnel per day.
+	DailyCost []*v1beta11.Coin `protobuf:"bytes,6,rep,name=daily_cost,json=dailyCost,proto3" json:"daily_cost,omitempty"`
+	// deviation_packets_per_day is the estimated number of packets per day triggered by price deviations,
+	// modeled from the price history of the signals.
+	DeviationPacketsPerDay uint64 `protobuf:"varint,7,opt,name=deviation_packets_per_day,json=deviationPacketsPerDay,proto3" json:"deviation_packets_per_day,omitempty"`
+	// relayer_fee is the expected fee paid off-chain to the relayer for each packet, which is zero for
+	// routes that are not relayed through IBC.
+	RelayerFee []*v1beta11.Coin `protobuf:"bytes,8,rep,name=relayer_fee,json=relayerFee,proto3" json:"relayer_fee,omitempty"`
+}
+
+func (x *QueryEstimateTunnelCostResponse) Reset() {
+	*x = QueryEstimateTunnelCostResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[10]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPacketRequest) String() string {
+func (x *QueryEstimateTunnelCostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPacketRequest) ProtoMessage() {}
+func (*QueryEstimateTunnelCostResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPacketRequest.ProtoReflect.Descriptor instead.
-func (*QueryPacketRequest) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryEstimateTunnelCostResponse.ProtoReflect.Descriptor instead.
+func (*QueryEstimateTunnelCostResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryPacketRequest) GetTunnelId() uint64 {
+func (x *QueryEstimateTunnelCostResponse) GetMinDeposit() []*v1beta11.Coin {
 	if x != nil {
-		return x.TunnelId
+		return x.MinDeposit
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryPacketRequest) GetSequence() uint64 {
+func (x *QueryEstimateTunnelCostResponse) GetBasePacketFee() []*v1beta11.Coin {
 	if x != nil {
-		return x.Sequence
+		return x.BasePacketFee
 	}
-	return 0
+	return nil
 }
 
-// QueryPacketResponse is the response type for the Query/Packet RPC method.
-type QueryPacketResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// packet is the packet with the given tunnel ID and sequence.
-	Packet *Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+func (x *QueryEstimateTunnelCostResponse) GetRouteFee() []*v1beta11.Coin {
+	if x != nil {
+		return x.RouteFee
+	}
+	return nil
 }
 
-func (x *QueryPacketResponse) Reset() {
-	*x = QueryPacketResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryEstimateTunnelCostResponse) GetPacketFee() []*v1beta11.Coin {
+	if x != nil {
+		return x.PacketFee
 	}
+	return nil
 }
 
-func (x *QueryPacketResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryEstimateTunnelCostResponse) GetPacketsPerDay() uint64 {
+	if x != nil {
+		return x.PacketsPerDay
+	}
+	return 0
 }
 
-func (*QueryPacketResponse) ProtoMessage() {}
+func (x *QueryEstimateTunnelCostResponse) GetDailyCost() []*v1beta11.Coin {
+	if x != nil {
+		return x.DailyCost
+	}
+	return nil
+}
 
-// Deprecated: Use QueryPacketResponse.ProtoReflect.Descriptor instead.
-func (*QueryPacketResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{11}
+func (x *QueryEstimateTunnelCostResponse) GetDeviationPacketsPerDay() uint64 {
+	if x != nil {
+		return x.DeviationPacketsPerDay
+	}
+	return 0
 }
 
-func (x *QueryPacketResponse) GetPacket() *Packet {
+func (x *QueryEstimateTunnelCostResponse) GetRelayerFee() []*v1beta11.Coin {
 	if x != nil {
-		return x.Packet
+		return x.RelayerFee
 	}
 	return nil
 }
@@ -7986,7 +15033,7 @@ type QueryTotalFeesRequest struct {
 func (x *QueryTotalFeesRequest) Reset() {
 	*x = QueryTotalFeesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[12]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8000,7 +15047,7 @@ func (*QueryTotalFeesRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTotalFeesRequest.ProtoReflect.Descriptor instead.
 func (*QueryTotalFeesRequest) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{12}
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{22}
 }
 
 // QueryTotalFeesResponse is the response type for the Query/TotalFees RPC method.
@@ -8016,7 +15063,7 @@ type QueryTotalFeesResponse struct {
 func (x *QueryTotalFeesResponse) Reset() {
 	*x = QueryTotalFeesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[13]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8030,7 +15077,7 @@ func (*QueryTotalFeesResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTotalFeesResponse.ProtoReflect.Descriptor instead.
 func (*QueryTotalFeesResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{13}
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueryTotalFeesResponse) GetTotalFees() *TotalFees {
@@ -8050,7 +15097,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[14]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8064,7 +15111,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{14}
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{24}
 }
 
 // QueryParamsResponse is the response type for the Query/Params RPC method.
@@ -8080,7 +15127,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[15]
+		mi := &file_band_tunnel_v1beta1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8094,7 +15141,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{15}
+	return file_band_tunnel_v1beta1_query_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -8110,180 +15157,395 @@ var file_band_tunnel_v1beta1_query_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x13, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x35, 0x0a, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
-	0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x31, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x7b, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
-	0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x08, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x50, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x22, 0x54, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x7a, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x62, 0x61,
+	0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20,
+	0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xc1, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x70, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a,
-	0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x31, 0x0a,
+	0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x22, 0x50, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x22, 0x7b, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x9a, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x13,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x4a, 0x0a, 0x13,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x5d, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46,
-	0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73,
-	0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x22, 0x54,
+	0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x22, 0x7a, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x96, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xba, 0x01, 0x0a, 0x19, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x46,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc3, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9e, 0x01, 0x0a, 0x1c,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2a, 0x84, 0x01, 0x0a, 0x12, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x24, 0x0a, 0x20, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x43,
-	0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x49,
-	0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x32,
-	0x8c, 0x09, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x07, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x73, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x12,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x4a, 0x0a, 0x13, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x1a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0e, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x1b,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x87, 0x02, 0x0a, 0x1c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x66, 0x65, 0x65,
+	0x5f, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x65, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x66, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0d, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0xbb, 0x02, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x0a, 0xca, 0xb4, 0x2d, 0x06,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x57, 0x0a,
+	0x11, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x6c, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x22, 0x97, 0x06, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x12, 0x73, 0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x12, 0x68, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x65,
+	0x65, 0x12, 0x6a, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x6a, 0x0a, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x6f, 0x73,
+	0x74, 0x12, 0x39, 0x0a, 0x19, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x6c, 0x0a, 0x0b,
+	0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0a,
+	0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x65, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65,
+	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65,
+	0x65, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2a, 0x84, 0x01, 0x0a, 0x12, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x55, 0x4e, 0x4e, 0x45,
+	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x55, 0x4e, 0x4e,
+	0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52,
+	0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
+	0x00, 0x32, 0xfa, 0x0f, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x07, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x88, 0x01, 0x0a,
+	0x06, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x25, 0x12, 0x23, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x08, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
 	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x19, 0x12, 0x17, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x06, 0x54,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
-	0x12, 0x23, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x08, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x12, 0xa0, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x28, 0x2e,
 	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2e, 0x12, 0x2c, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12,
-	0xa0, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x28, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x73, 0x2f, 0x7b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x7d, 0x12, 0x93, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x28,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x7d, 0x12, 0x93, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0xad, 0x01, 0x0a, 0x0d, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0xa1, 0x01, 0x0a, 0x0f, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x12, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36,
-	0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x9b,
+	0x01, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2f, 0x7b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x7d, 0x12, 0xac, 0x01, 0x0a,
+	0x0d, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x2e,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x2f, 0x7b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0xb5, 0x01, 0x0a, 0x0f,
+	0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x50, 0x61,
+	0x79, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65,
+	0x50, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0xb0, 0x01, 0x0a, 0x12, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x33, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x34, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a,
+	0x22, 0x24, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x88, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c,
 	0x46, 0x65, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
 	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
@@ -8330,70 +15592,117 @@ func file_band_tunnel_v1beta1_query_proto_rawDescGZIP() []byte {
 }
 
 var file_band_tunnel_v1beta1_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_band_tunnel_v1beta1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_band_tunnel_v1beta1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_band_tunnel_v1beta1_query_proto_goTypes = []interface{}{
-	(TunnelStatusFilter)(0),        // 0: band.tunnel.v1beta1.TunnelStatusFilter
-	(*QueryTunnelsRequest)(nil),    // 1: band.tunnel.v1beta1.QueryTunnelsRequest
-	(*QueryTunnelsResponse)(nil),   // 2: band.tunnel.v1beta1.QueryTunnelsResponse
-	(*QueryTunnelRequest)(nil),     // 3: band.tunnel.v1beta1.QueryTunnelRequest
-	(*QueryTunnelResponse)(nil),    // 4: band.tunnel.v1beta1.QueryTunnelResponse
-	(*QueryDepositsRequest)(nil),   // 5: band.tunnel.v1beta1.QueryDepositsRequest
-	(*QueryDepositsResponse)(nil),  // 6: band.tunnel.v1beta1.QueryDepositsResponse
-	(*QueryDepositRequest)(nil),    // 7: band.tunnel.v1beta1.QueryDepositRequest
-	(*QueryDepositResponse)(nil),   // 8: band.tunnel.v1beta1.QueryDepositResponse
-	(*QueryPacketsRequest)(nil),    // 9: band.tunnel.v1beta1.QueryPacketsRequest
-	(*QueryPacketsResponse)(nil),   // 10: band.tunnel.v1beta1.QueryPacketsResponse
-	(*QueryPacketRequest)(nil),     // 11: band.tunnel.v1beta1.QueryPacketRequest
-	(*QueryPacketResponse)(nil),    // 12: band.tunnel.v1beta1.QueryPacketResponse
-	(*QueryTotalFeesRequest)(nil),  // 13: band.tunnel.v1beta1.QueryTotalFeesRequest
-	(*QueryTotalFeesResponse)(nil), // 14: band.tunnel.v1beta1.QueryTotalFeesResponse
-	(*QueryParamsRequest)(nil),     // 15: band.tunnel.v1beta1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),    // 16: band.tunnel.v1beta1.QueryParamsResponse
-	(*v1beta1.PageRequest)(nil),    // 17: cosmos.base.query.v1beta1.PageRequest
-	(*Tunnel)(nil),                 // 18: band.tunnel.v1beta1.Tunnel
-	(*v1beta1.PageResponse)(nil),   // 19: cosmos.base.query.v1beta1.PageResponse
-	(*Deposit)(nil),                // 20: band.tunnel.v1beta1.Deposit
-	(*Packet)(nil),                 // 21: band.tunnel.v1beta1.Packet
-	(*TotalFees)(nil),              // 22: band.tunnel.v1beta1.TotalFees
-	(*Params)(nil),                 // 23: band.tunnel.v1beta1.Params
+	(TunnelStatusFilter)(0),                 // 0: band.tunnel.v1beta1.TunnelStatusFilter
+	(*QueryTunnelsRequest)(nil),             // 1: band.tunnel.v1beta1.QueryTunnelsRequest
+	(*QueryTunnelsResponse)(nil),            // 2: band.tunnel.v1beta1.QueryTunnelsResponse
+	(*QueryTunnelRequest)(nil),              // 3: band.tunnel.v1beta1.QueryTunnelRequest
+	(*QueryTunnelResponse)(nil),             // 4: band.tunnel.v1beta1.QueryTunnelResponse
+	(*QueryDepositsRequest)(nil),            // 5: band.tunnel.v1beta1.QueryDepositsRequest
+	(*QueryDepositsResponse)(nil),           // 6: band.tunnel.v1beta1.QueryDepositsResponse
+	(*QueryDepositRequest)(nil),             // 7: band.tunnel.v1beta1.QueryDepositRequest
+	(*QueryDepositResponse)(nil),            // 8: band.tunnel.v1beta1.QueryDepositResponse
+	(*QueryPacketsRequest)(nil),             // 9: band.tunnel.v1beta1.QueryPacketsRequest
+	(*QueryPacketsResponse)(nil),            // 10: band.tunnel.v1beta1.QueryPacketsResponse
+	(*QueryPacketsByTimeRequest)(nil),       // 11: band.tunnel.v1beta1.QueryPacketsByTimeRequest
+	(*QueryPacketsByTimeResponse)(nil),      // 12: band.tunnel.v1beta1.QueryPacketsByTimeResponse
+	(*QueryPacketsByStatusRequest)(nil),     // 13: band.tunnel.v1beta1.QueryPacketsByStatusRequest
+	(*QueryPacketsByStatusResponse)(nil),    // 14: band.tunnel.v1beta1.QueryPacketsByStatusResponse
+	(*QueryPacketRequest)(nil),              // 15: band.tunnel.v1beta1.QueryPacketRequest
+	(*QueryPacketResponse)(nil),             // 16: band.tunnel.v1beta1.QueryPacketResponse
+	(*QueryFailedPacketsRequest)(nil),       // 17: band.tunnel.v1beta1.QueryFailedPacketsRequest
+	(*QueryFailedPacketsResponse)(nil),      // 18: band.tunnel.v1beta1.QueryFailedPacketsResponse
+	(*QueryFeePayerBalanceRequest)(nil),     // 19: band.tunnel.v1beta1.QueryFeePayerBalanceRequest
+	(*QueryFeePayerBalanceResponse)(nil),    // 20: band.tunnel.v1beta1.QueryFeePayerBalanceResponse
+	(*QueryEstimateTunnelCostRequest)(nil),  // 21: band.tunnel.v1beta1.QueryEstimateTunnelCostRequest
+	(*QueryEstimateTunnelCostResponse)(nil), // 22: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse
+	(*QueryTotalFeesRequest)(nil),           // 23: band.tunnel.v1beta1.QueryTotalFeesRequest
+	(*QueryTotalFeesResponse)(nil),          // 24: band.tunnel.v1beta1.QueryTotalFeesResponse
+	(*QueryParamsRequest)(nil),              // 25: band.tunnel.v1beta1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),             // 26: band.tunnel.v1beta1.QueryParamsResponse
+	(*v1beta1.PageRequest)(nil),             // 27: cosmos.base.query.v1beta1.PageRequest
+	(*Tunnel)(nil),                          // 28: band.tunnel.v1beta1.Tunnel
+	(*v1beta1.PageResponse)(nil),            // 29: cosmos.base.query.v1beta1.PageResponse
+	(*Deposit)(nil),                         // 30: band.tunnel.v1beta1.Deposit
+	(*Packet)(nil),                          // 31: band.tunnel.v1beta1.Packet
+	(PacketStatus)(0),                       // 32: band.tunnel.v1beta1.PacketStatus
+	(*FailedPacket)(nil),                    // 33: band.tunnel.v1beta1.FailedPacket
+	(*v1beta11.Coin)(nil),                   // 34: cosmos.base.v1beta1.Coin
+	(*FundingPolicy)(nil),                   // 35: band.tunnel.v1beta1.FundingPolicy
+	(*anypb.Any)(nil),                       // 36: google.protobuf.Any
+	(*SignalDeviation)(nil),                 // 37: band.tunnel.v1beta1.SignalDeviation
+	(*TotalFees)(nil),                       // 38: band.tunnel.v1beta1.TotalFees
+	(*Params)(nil),                          // 39: band.tunnel.v1beta1.Params
 }
 var file_band_tunnel_v1beta1_query_proto_depIdxs = []int32{
 	0,  // 0: band.tunnel.v1beta1.QueryTunnelsRequest.status_filter:type_name -> band.tunnel.v1beta1.TunnelStatusFilter
-	17, // 1: band.tunnel.v1beta1.QueryTunnelsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	18, // 2: band.tunnel.v1beta1.QueryTunnelsResponse.tunnels:type_name -> band.tunnel.v1beta1.Tunnel
-	19, // 3: band.tunnel.v1beta1.QueryTunnelsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	18, // 4: band.tunnel.v1beta1.QueryTunnelResponse.tunnel:type_name -> band.tunnel.v1beta1.Tunnel
-	17, // 5: band.tunnel.v1beta1.QueryDepositsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	20, // 6: band.tunnel.v1beta1.QueryDepositsResponse.deposits:type_name -> band.tunnel.v1beta1.Deposit
-	19, // 7: band.tunnel.v1beta1.QueryDepositsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	20, // 8: band.tunnel.v1beta1.QueryDepositResponse.deposit:type_name -> band.tunnel.v1beta1.Deposit
-	17, // 9: band.tunnel.v1beta1.QueryPacketsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	21, // 10: band.tunnel.v1beta1.QueryPacketsResponse.packets:type_name -> band.tunnel.v1beta1.Packet
-	19, // 11: band.tunnel.v1beta1.QueryPacketsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	21, // 12: band.tunnel.v1beta1.QueryPacketResponse.packet:type_name -> band.tunnel.v1beta1.Packet
-	22, // 13: band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees:type_name -> band.tunnel.v1beta1.TotalFees
-	23, // 14: band.tunnel.v1beta1.QueryParamsResponse.params:type_name -> band.tunnel.v1beta1.Params
-	1,  // 15: band.tunnel.v1beta1.Query.Tunnels:input_type -> band.tunnel.v1beta1.QueryTunnelsRequest
-	3,  // 16: band.tunnel.v1beta1.Query.Tunnel:input_type -> band.tunnel.v1beta1.QueryTunnelRequest
-	5,  // 17: band.tunnel.v1beta1.Query.Deposits:input_type -> band.tunnel.v1beta1.QueryDepositsRequest
-	7,  // 18: band.tunnel.v1beta1.Query.Deposit:input_type -> band.tunnel.v1beta1.QueryDepositRequest
-	9,  // 19: band.tunnel.v1beta1.Query.Packets:input_type -> band.tunnel.v1beta1.QueryPacketsRequest
-	11, // 20: band.tunnel.v1beta1.Query.Packet:input_type -> band.tunnel.v1beta1.QueryPacketRequest
-	13, // 21: band.tunnel.v1beta1.Query.TotalFees:input_type -> band.tunnel.v1beta1.QueryTotalFeesRequest
-	15, // 22: band.tunnel.v1beta1.Query.Params:input_type -> band.tunnel.v1beta1.QueryParamsRequest
-	2,  // 23: band.tunnel.v1beta1.Query.Tunnels:output_type -> band.tunnel.v1beta1.QueryTunnelsResponse
-	4,  // 24: band.tunnel.v1beta1.Query.Tunnel:output_type -> band.tunnel.v1beta1.QueryTunnelResponse
-	6,  // 25: band.tunnel.v1beta1.Query.Deposits:output_type -> band.tunnel.v1beta1.QueryDepositsResponse
-	8,  // 26: band.tunnel.v1beta1.Query.Deposit:output_type -> band.tunnel.v1beta1.QueryDepositResponse
-	10, // 27: band.tunnel.v1beta1.Query.Packets:output_type -> band.tunnel.v1beta1.QueryPacketsResponse
-	12, // 28: band.tunnel.v1beta1.Query.Packet:output_type -> band.tunnel.v1beta1.QueryPacketResponse
-	14, // 29: band.tunnel.v1beta1.Query.TotalFees:output_type -> band.tunnel.v1beta1.QueryTotalFeesResponse
-	16, // 30: band.tunnel.v1beta1.Query.Params:output_type -> band.tunnel.v1beta1.QueryParamsResponse
-	23, // [23:31] is the sub-list for method output_type
-	15, // [15:23] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	27, // 1: band.tunnel.v1beta1.QueryTunnelsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	28, // 2: band.tunnel.v1beta1.QueryTunnelsResponse.tunnels:type_name -> band.tunnel.v1beta1.Tunnel
+	29, // 3: band.tunnel.v1beta1.QueryTunnelsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	28, // 4: band.tunnel.v1beta1.QueryTunnelResponse.tunnel:type_name -> band.tunnel.v1beta1.Tunnel
+	27, // 5: band.tunnel.v1beta1.QueryDepositsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	30, // 6: band.tunnel.v1beta1.QueryDepositsResponse.deposits:type_name -> band.tunnel.v1beta1.Deposit
+	29, // 7: band.tunnel.v1beta1.QueryDepositsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	30, // 8: band.tunnel.v1beta1.QueryDepositResponse.deposit:type_name -> band.tunnel.v1beta1.Deposit
+	27, // 9: band.tunnel.v1beta1.QueryPacketsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	31, // 10: band.tunnel.v1beta1.QueryPacketsResponse.packets:type_name -> band.tunnel.v1beta1.Packet
+	29, // 11: band.tunnel.v1beta1.QueryPacketsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	27, // 12: band.tunnel.v1beta1.QueryPacketsByTimeRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	31, // 13: band.tunnel.v1beta1.QueryPacketsByTimeResponse.packets:type_name -> band.tunnel.v1beta1.Packet
+	29, // 14: band.tunnel.v1beta1.QueryPacketsByTimeResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	32, // 15: band.tunnel.v1beta1.QueryPacketsByStatusRequest.status:type_name -> band.tunnel.v1beta1.PacketStatus
+	27, // 16: band.tunnel.v1beta1.QueryPacketsByStatusRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	31, // 17: band.tunnel.v1beta1.QueryPacketsByStatusResponse.packets:type_name -> band.tunnel.v1beta1.Packet
+	29, // 18: band.tunnel.v1beta1.QueryPacketsByStatusResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	31, // 19: band.tunnel.v1beta1.QueryPacketResponse.packet:type_name -> band.tunnel.v1beta1.Packet
+	27, // 20: band.tunnel.v1beta1.QueryFailedPacketsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	33, // 21: band.tunnel.v1beta1.QueryFailedPacketsResponse.failed_packets:type_name -> band.tunnel.v1beta1.FailedPacket
+	29, // 22: band.tunnel.v1beta1.QueryFailedPacketsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	34, // 23: band.tunnel.v1beta1.QueryFeePayerBalanceResponse.balance:type_name -> cosmos.base.v1beta1.Coin
+	35, // 24: band.tunnel.v1beta1.QueryFeePayerBalanceResponse.funding_policy:type_name -> band.tunnel.v1beta1.FundingPolicy
+	36, // 25: band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.route:type_name -> google.protobuf.Any
+	37, // 26: band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.signal_deviations:type_name -> band.tunnel.v1beta1.SignalDeviation
+	34, // 27: band.tunnel.v1beta1.QueryEstimateTunnelCostRequest.relayer_fee:type_name -> cosmos.base.v1beta1.Coin
+	34, // 28: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	34, // 29: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.base_packet_fee:type_name -> cosmos.base.v1beta1.Coin
+	34, // 30: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.route_fee:type_name -> cosmos.base.v1beta1.Coin
+	34, // 31: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.packet_fee:type_name -> cosmos.base.v1beta1.Coin
+	34, // 32: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.daily_cost:type_name -> cosmos.base.v1beta1.Coin
+	34, // 33: band.tunnel.v1beta1.QueryEstimateTunnelCostResponse.relayer_fee:type_name -> cosmos.base.v1beta1.Coin
+	38, // 34: band.tunnel.v1beta1.QueryTotalFeesResponse.total_fees:type_name -> band.tunnel.v1beta1.TotalFees
+	39, // 35: band.tunnel.v1beta1.QueryParamsResponse.params:type_name -> band.tunnel.v1beta1.Params
+	1,  // 36: band.tunnel.v1beta1.Query.Tunnels:input_type -> band.tunnel.v1beta1.QueryTunnelsRequest
+	3,  // 37: band.tunnel.v1beta1.Query.Tunnel:input_type -> band.tunnel.v1beta1.QueryTunnelRequest
+	5,  // 38: band.tunnel.v1beta1.Query.Deposits:input_type -> band.tunnel.v1beta1.QueryDepositsRequest
+	7,  // 39: band.tunnel.v1beta1.Query.Deposit:input_type -> band.tunnel.v1beta1.QueryDepositRequest
+	9,  // 40: band.tunnel.v1beta1.Query.Packets:input_type -> band.tunnel.v1beta1.QueryPacketsRequest
+	11, // 41: band.tunnel.v1beta1.Query.PacketsByTime:input_type -> band.tunnel.v1beta1.QueryPacketsByTimeRequest
+	13, // 42: band.tunnel.v1beta1.Query.PacketsByStatus:input_type -> band.tunnel.v1beta1.QueryPacketsByStatusRequest
+	15, // 43: band.tunnel.v1beta1.Query.Packet:input_type -> band.tunnel.v1beta1.QueryPacketRequest
+	17, // 44: band.tunnel.v1beta1.Query.FailedPackets:input_type -> band.tunnel.v1beta1.QueryFailedPacketsRequest
+	19, // 45: band.tunnel.v1beta1.Query.FeePayerBalance:input_type -> band.tunnel.v1beta1.QueryFeePayerBalanceRequest
+	21, // 46: band.tunnel.v1beta1.Query.EstimateTunnelCost:input_type -> band.tunnel.v1beta1.QueryEstimateTunnelCostRequest
+	23, // 47: band.tunnel.v1beta1.Query.TotalFees:input_type -> band.tunnel.v1beta1.QueryTotalFeesRequest
+	25, // 48: band.tunnel.v1beta1.Query.Params:input_type -> band.tunnel.v1beta1.QueryParamsRequest
+	2,  // 49: band.tunnel.v1beta1.Query.Tunnels:output_type -> band.tunnel.v1beta1.QueryTunnelsResponse
+	4,  // 50: band.tunnel.v1beta1.Query.Tunnel:output_type -> band.tunnel.v1beta1.QueryTunnelResponse
+	6,  // 51: band.tunnel.v1beta1.Query.Deposits:output_type -> band.tunnel.v1beta1.QueryDepositsResponse
+	8,  // 52: band.tunnel.v1beta1.Query.Deposit:output_type -> band.tunnel.v1beta1.QueryDepositResponse
+	10, // 53: band.tunnel.v1beta1.Query.Packets:output_type -> band.tunnel.v1beta1.QueryPacketsResponse
+	12, // 54: band.tunnel.v1beta1.Query.PacketsByTime:output_type -> band.tunnel.v1beta1.QueryPacketsByTimeResponse
+	14, // 55: band.tunnel.v1beta1.Query.PacketsByStatus:output_type -> band.tunnel.v1beta1.QueryPacketsByStatusResponse
+	16, // 56: band.tunnel.v1beta1.Query.Packet:output_type -> band.tunnel.v1beta1.QueryPacketResponse
+	18, // 57: band.tunnel.v1beta1.Query.FailedPackets:output_type -> band.tunnel.v1beta1.QueryFailedPacketsResponse
+	20, // 58: band.tunnel.v1beta1.Query.FeePayerBalance:output_type -> band.tunnel.v1beta1.QueryFeePayerBalanceResponse
+	22, // 59: band.tunnel.v1beta1.Query.EstimateTunnelCost:output_type -> band.tunnel.v1beta1.QueryEstimateTunnelCostResponse
+	24, // 60: band.tunnel.v1beta1.Query.TotalFees:output_type -> band.tunnel.v1beta1.QueryTotalFeesResponse
+	26, // 61: band.tunnel.v1beta1.Query.Params:output_type -> band.tunnel.v1beta1.QueryParamsResponse
+	49, // [49:62] is the sub-list for method output_type
+	36, // [36:49] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_band_tunnel_v1beta1_query_proto_init() }
@@ -8525,7 +15834,7 @@ func file_band_tunnel_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPacketRequest); i {
+			switch v := v.(*QueryPacketsByTimeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8537,7 +15846,7 @@ func file_band_tunnel_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPacketResponse); i {
+			switch v := v.(*QueryPacketsByTimeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8549,7 +15858,7 @@ func file_band_tunnel_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalFeesRequest); i {
+			switch v := v.(*QueryPacketsByStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8561,7 +15870,7 @@ func file_band_tunnel_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalFeesResponse); i {
+			switch v := v.(*QueryPacketsByStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8573,7 +15882,7 @@ func file_band_tunnel_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryPacketRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8585,6 +15894,126 @@ func file_band_tunnel_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPacketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFailedPacketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFailedPacketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFeePayerBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFeePayerBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEstimateTunnelCostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEstimateTunnelCostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTotalFeesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTotalFeesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
@@ -8603,7 +16032,7 @@ func file_band_tunnel_v1beta1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_tunnel_v1beta1_query_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   16,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,14 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Tunnels_FullMethodName   = "/band.tunnel.v1beta1.Query/Tunnels"
-	Query_Tunnel_FullMethodName    = "/band.tunnel.v1beta1.Query/Tunnel"
-	Query_Deposits_FullMethodName  = "/band.tunnel.v1beta1.Query/Deposits"
-	Query_Deposit_FullMethodName   = "/band.tunnel.v1beta1.Query/Deposit"
-	Query_Packets_FullMethodName   = "/band.tunnel.v1beta1.Query/Packets"
-	Query_Packet_FullMethodName    = "/band.tunnel.v1beta1.Query/Packet"
-	Query_TotalFees_FullMethodName = "/band.tunnel.v1beta1.Query/TotalFees"
-	Query_Params_FullMethodName    = "/band.tunnel.v1beta1.Query/Params"
+	Query_Tunnels_FullMethodName            = "/band.tunnel.v1beta1.Query/Tunnels"
+	Query_Tunnel_FullMethodName             = "/band.tunnel.v1beta1.Query/Tunnel"
+	Query_Deposits_FullMethodName           = "/band.tunnel.v1beta1.Query/Deposits"
+	Query_Deposit_FullMethodName            = "/band.tunnel.v1beta1.Query/Deposit"
+	Query_Packets_FullMethodName            = "/band.tunnel.v1beta1.Query/Packets"
+	Query_PacketsByTime_FullMethodName      = "/band.tunnel.v1beta1.Query/PacketsByTime"
+	Query_PacketsByStatus_FullMethodName    = "/band.tunnel.v1beta1.Query/PacketsByStatus"
+	Query_Packet_FullMethodName             = "/band.tunnel.v1beta1.Query/Packet"
+	Query_FailedPackets_FullMethodName      = "/band.tunnel.v1beta1.Query/FailedPackets"
+	Query_FeePayerBalance_FullMethodName    = "/band.tunnel.v1beta1.Query/FeePayerBalance"
+	Query_EstimateTunnelCost_FullMethodName = "/band.tunnel.v1beta1.Query/EstimateTunnelCost"
+	Query_TotalFees_FullMethodName          = "/band.tunnel.v1beta1.Query/TotalFees"
+	Query_Params_FullMethodName             = "/band.tunnel.v1beta1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -43,8 +48,20 @@ type QueryClient interface {
 	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
 	// Packets is a RPC method that returns all packets of a tunnel.
 	Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error)
+	// PacketsByTime is a RPC method that returns the packets of a tunnel created within a time range.
+	PacketsByTime(ctx context.Context, in *QueryPacketsByTimeRequest, opts ...grpc.CallOption) (*QueryPacketsByTimeResponse, error)
+	// PacketsByStatus is a RPC method that returns the packets of all tunnels with the given status
+	// and receipt type.
+	PacketsByStatus(ctx context.Context, in *QueryPacketsByStatusRequest, opts ...grpc.CallOption) (*QueryPacketsByStatusResponse, error)
 	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
 	Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error)
+	// FailedPackets is a RPC method that returns all failed packets of a tunnel.
+	FailedPackets(ctx context.Context, in *QueryFailedPacketsRequest, opts ...grpc.CallOption) (*QueryFailedPacketsResponse, error)
+	// FeePayerBalance is a RPC method that returns the fee payer balance and the funding policy of a tunnel.
+	FeePayerBalance(ctx context.Context, in *QueryFeePayerBalanceRequest, opts ...grpc.CallOption) (*QueryFeePayerBalanceResponse, error)
+	// EstimateTunnelCost is a RPC method that estimates the cost of creating and running a tunnel
+	// with the given route, signal deviations and interval.
+	EstimateTunnelCost(ctx context.Context, in *QueryEstimateTunnelCostRequest, opts ...grpc.CallOption) (*QueryEstimateTunnelCostResponse, error)
 	// TotalFees is a RPC method that returns the total fees collected by the tunnel
 	TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error)
 	// Params is a RPC method that returns all parameters of the module.
@@ -104,6 +121,24 @@ func (c *queryClient) Packets(ctx context.Context, in *QueryPacketsRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) PacketsByTime(ctx context.Context, in *QueryPacketsByTimeRequest, opts ...grpc.CallOption) (*QueryPacketsByTimeResponse, error) {
+	out := new(QueryPacketsByTimeResponse)
+	err := c.cc.Invoke(ctx, Query_PacketsByTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PacketsByStatus(ctx context.Context, in *QueryPacketsByStatusRequest, opts ...grpc.CallOption) (*QueryPacketsByStatusResponse, error) {
+	out := new(QueryPacketsByStatusResponse)
+	err := c.cc.Invoke(ctx, Query_PacketsByStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error) {
 	out := new(QueryPacketResponse)
 	err := c.cc.Invoke(ctx, Query_Packet_FullMethodName, in, out, opts...)
@@ -113,6 +148,33 @@ func (c *queryClient) Packet(ctx context.Context, in *QueryPacketRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) FailedPackets(ctx context.Context, in *QueryFailedPacketsRequest, opts ...grpc.CallOption) (*QueryFailedPacketsResponse, error) {
+	out := new(QueryFailedPacketsResponse)
+	err := c.cc.Invoke(ctx, Query_FailedPackets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePayerBalance(ctx context.Context, in *QueryFeePayerBalanceRequest, opts ...grpc.CallOption) (*QueryFeePayerBalanceResponse, error) {
+	out := new(QueryFeePayerBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_FeePayerBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateTunnelCost(ctx context.Context, in *QueryEstimateTunnelCostRequest, opts ...grpc.CallOption) (*QueryEstimateTunnelCostResponse, error) {
+	out := new(QueryEstimateTunnelCostResponse)
+	err := c.cc.Invoke(ctx, Query_EstimateTunnelCost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error) {
 	out := new(QueryTotalFeesResponse)
 	err := c.cc.Invoke(ctx, Query_TotalFees_FullMethodName, in, out, opts...)
@@ -145,8 +207,20 @@ type QueryServer interface {
 	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
 	// Packets is a RPC method that returns all packets of a tunnel.
 	Packets(context.Context, *QueryPacketsRequest) (*QueryPacketsResponse, error)
+	// PacketsByTime is a RPC method that returns the packets of a tunnel created within a time range.
+	PacketsByTime(context.Context, *QueryPacketsByTimeRequest) (*QueryPacketsByTimeResponse, error)
+	// PacketsByStatus is a RPC method that returns the packets of all tunnels with the given status
+	// and receipt type.
+	PacketsByStatus(context.Context, *QueryPacketsByStatusRequest) (*QueryPacketsByStatusResponse, error)
 	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
 	Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error)
+	// FailedPackets is a RPC method that returns all failed packets of a tunnel.
+	FailedPackets(context.Context, *QueryFailedPacketsRequest) (*QueryFailedPacketsResponse, error)
+	// FeePayerBalance is a RPC method that returns the fee payer balance and the funding policy of a tunnel.
+	FeePayerBalance(context.Context, *QueryFeePayerBalanceRequest) (*QueryFeePayerBalanceResponse, error)
+	// EstimateTunnelCost is a RPC method that estimates the cost of creating and running a tunnel
+	// with the given route, signal deviations and interval.
+	EstimateTunnelCost(context.Context, *QueryEstimateTunnelCostRequest) (*QueryEstimateTunnelCostResponse, error)
 	// TotalFees is a RPC method that returns the total fees collected by the tunnel
 	TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error)
 	// Params is a RPC method that returns all parameters of the module.
@@ -173,9 +247,24 @@ func (UnimplementedQueryServer) Deposit(context.Context, *QueryDepositRequest) (
 func (UnimplementedQueryServer) Packets(context.Context, *QueryPacketsRequest) (*QueryPacketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Packets not implemented")
 }
+func (UnimplementedQueryServer) PacketsByTime(context.Context, *QueryPacketsByTimeRequest) (*QueryPacketsByTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketsByTime not implemented")
+}
+func (UnimplementedQueryServer) PacketsByStatus(context.Context, *QueryPacketsByStatusRequest) (*QueryPacketsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketsByStatus not implemented")
+}
 func (UnimplementedQueryServer) Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Packet not implemented")
 }
+func (UnimplementedQueryServer) FailedPackets(context.Context, *QueryFailedPacketsRequest) (*QueryFailedPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedPackets not implemented")
+}
+func (UnimplementedQueryServer) FeePayerBalance(context.Context, *QueryFeePayerBalanceRequest) (*QueryFeePayerBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayerBalance not implemented")
+}
+func (UnimplementedQueryServer) EstimateTunnelCost(context.Context, *QueryEstimateTunnelCostRequest) (*QueryEstimateTunnelCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTunnelCost not implemented")
+}
 func (UnimplementedQueryServer) TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalFees not implemented")
 }
@@ -285,6 +374,42 @@ func _Query_Packets_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PacketsByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketsByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketsByTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PacketsByTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketsByTime(ctx, req.(*QueryPacketsByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PacketsByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketsByStatus(ctx, req.(*QueryPacketsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Packet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPacketRequest)
 	if err := dec(in); err != nil {
@@ -303,6 +428,60 @@ func _Query_Packet_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FailedPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FailedPackets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedPackets(ctx, req.(*QueryFailedPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayerBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayerBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayerBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePayerBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayerBalance(ctx, req.(*QueryFeePayerBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateTunnelCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateTunnelCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateTunnelCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EstimateTunnelCost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateTunnelCost(ctx, req.(*QueryEstimateTunnelCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTotalFeesRequest)
 	if err := dec(in); err != nil {
@@ -366,10 +545,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Packets",
 			Handler:    _Query_Packets_Handler,
 		},
+		{
+			MethodName: "PacketsByTime",
+			Handler:    _Query_PacketsByTime_Handler,
+		},
+		{
+			MethodName: "PacketsByStatus",
+			Handler:    _Query_PacketsByStatus_Handler,
+		},
 		{
 			MethodName: "Packet",
 			Handler:    _Query_Packet_Handler,
 		},
+		{
+			MethodName: "FailedPackets",
+			Handler:    _Query_FailedPackets_Handler,
+		},
+		{
+			MethodName: "FeePayerBalance",
+			Handler:    _Query_FeePayerBalance_Handler,
+		},
+		{
+			MethodName: "EstimateTunnelCost",
+			Handler:    _Query_EstimateTunnelCost_Handler,
+		},
 		{
 			MethodName: "TotalFees",
 			Handler:    _Query_TotalFees_Handler,
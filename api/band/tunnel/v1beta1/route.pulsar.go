@@ -3758,7 +3758,7 @@ func (x *IBCHookMemo_Payload) ProtoReflect() protoreflect.Message {
 }
 
 func (x *IBCHookMemo_Payload) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[11]
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4255,7 +4255,7 @@ func (x *IBCHookMemo_Payload_Msg) ProtoReflect() protoreflect.Message {
 }
 
 func (x *IBCHookMemo_Payload_Msg) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[12]
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4690,7 +4690,7 @@ func (x *IBCHookMemo_Payload_Msg_ReceivePacket) ProtoReflect() protoreflect.Mess
 }
 
 func (x *IBCHookMemo_Payload_Msg_ReceivePacket) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[13]
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6498,7 +6498,7 @@ func (x *RouterMemo_Payload) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RouterMemo_Payload) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[14]
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6995,7 +6995,7 @@ func (x *RouterMemo_Payload_Msg) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RouterMemo_Payload_Msg) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[15]
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7436,7 +7436,7 @@ func (x *RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoReflect() protoreflect
 }
 
 func (x *RouterMemo_Payload_Msg_ReceiveBandDataArgs) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[16]
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8006,168 +8006,1040 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMethods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/tunnel/v1beta1/route.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_IBCABIRoute            protoreflect.MessageDescriptor
+	fd_IBCABIRoute_channel_id protoreflect.FieldDescriptor
+	fd_IBCABIRoute_encoder    protoreflect.FieldDescriptor
 )
 
-// TSSRoute represents a route for TSS packets and implements the RouteI interface.
-type TSSRoute struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_IBCABIRoute = File_band_tunnel_v1beta1_route_proto.Messages().ByName("IBCABIRoute")
+	fd_IBCABIRoute_channel_id = md_IBCABIRoute.Fields().ByName("channel_id")
+	fd_IBCABIRoute_encoder = md_IBCABIRoute.Fields().ByName("encoder")
+}
 
-	// destination_chain_id is the destination chain ID
-	DestinationChainId string `protobuf:"bytes,1,opt,name=destination_chain_id,json=destinationChainId,proto3" json:"destination_chain_id,omitempty"`
-	// destination_contract_address is the destination contract address
-	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
-	// encoder is the mode of encoding packet data.
-	Encoder v1beta1.Encoder `protobuf:"varint,3,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+var _ protoreflect.Message = (*fastReflection_IBCABIRoute)(nil)
+
+type fastReflection_IBCABIRoute IBCABIRoute
+
+func (x *IBCABIRoute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCABIRoute)(x)
 }
 
-func (x *TSSRoute) Reset() {
-	*x = TSSRoute{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[0]
+func (x *IBCABIRoute) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *TSSRoute) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TSSRoute) ProtoMessage() {}
+var _fastReflection_IBCABIRoute_messageType fastReflection_IBCABIRoute_messageType
+var _ protoreflect.MessageType = fastReflection_IBCABIRoute_messageType{}
 
-// Deprecated: Use TSSRoute.ProtoReflect.Descriptor instead.
-func (*TSSRoute) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{0}
-}
+type fastReflection_IBCABIRoute_messageType struct{}
 
-func (x *TSSRoute) GetDestinationChainId() string {
-	if x != nil {
-		return x.DestinationChainId
-	}
-	return ""
+func (x fastReflection_IBCABIRoute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCABIRoute)(nil)
 }
-
-func (x *TSSRoute) GetDestinationContractAddress() string {
-	if x != nil {
-		return x.DestinationContractAddress
-	}
-	return ""
+func (x fastReflection_IBCABIRoute_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCABIRoute)
 }
-
-func (x *TSSRoute) GetEncoder() v1beta1.Encoder {
-	if x != nil {
-		return x.Encoder
-	}
-	return v1beta1.Encoder(0)
+func (x fastReflection_IBCABIRoute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCABIRoute
 }
 
-// TSSPacketReceipt represents a receipt for a TSS packet and implements the PacketReceiptI interface.
-type TSSPacketReceipt struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// signing_id is the signing ID
-	SigningId uint64 `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3" json:"signing_id,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IBCABIRoute) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCABIRoute
 }
 
-func (x *TSSPacketReceipt) Reset() {
-	*x = TSSPacketReceipt{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IBCABIRoute) Type() protoreflect.MessageType {
+	return _fastReflection_IBCABIRoute_messageType
 }
 
-func (x *TSSPacketReceipt) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IBCABIRoute) New() protoreflect.Message {
+	return new(fastReflection_IBCABIRoute)
 }
 
-func (*TSSPacketReceipt) ProtoMessage() {}
-
-// Deprecated: Use TSSPacketReceipt.ProtoReflect.Descriptor instead.
-func (*TSSPacketReceipt) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IBCABIRoute) Interface() protoreflect.ProtoMessage {
+	return (*IBCABIRoute)(x)
 }
 
-func (x *TSSPacketReceipt) GetSigningId() uint64 {
-	if x != nil {
-		return x.SigningId
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IBCABIRoute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_IBCABIRoute_channel_id, value) {
+			return
+		}
+	}
+	if x.Encoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
+		if !f(fd_IBCABIRoute_encoder, value) {
+			return
+		}
 	}
-	return 0
 }
 
-// IBCRoute represents a route for IBC packets and implements the RouteI interface.
-type IBCRoute struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// channel_id is the IBC channel ID
-	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IBCABIRoute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCABIRoute.channel_id":
+		return x.ChannelId != ""
+	case "band.tunnel.v1beta1.IBCABIRoute.encoder":
+		return x.Encoder != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIRoute does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *IBCRoute) Reset() {
-	*x = IBCRoute{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCABIRoute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCABIRoute.channel_id":
+		x.ChannelId = ""
+	case "band.tunnel.v1beta1.IBCABIRoute.encoder":
+		x.Encoder = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIRoute does not contain field %s", fd.FullName()))
 	}
 }
 
-func (x *IBCRoute) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IBCABIRoute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.IBCABIRoute.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.IBCABIRoute.encoder":
+		value := x.Encoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIRoute does not contain field %s", descriptor.FullName()))
+	}
 }
 
-func (*IBCRoute) ProtoMessage() {}
-
-// Deprecated: Use IBCRoute.ProtoReflect.Descriptor instead.
-func (*IBCRoute) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{2}
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCABIRoute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCABIRoute.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "band.tunnel.v1beta1.IBCABIRoute.encoder":
+		x.Encoder = (v1beta1.Encoder)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIRoute does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *IBCRoute) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCABIRoute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCABIRoute.channel_id":
+		panic(fmt.Errorf("field channel_id of message band.tunnel.v1beta1.IBCABIRoute is not mutable"))
+	case "band.tunnel.v1beta1.IBCABIRoute.encoder":
+		panic(fmt.Errorf("field encoder of message band.tunnel.v1beta1.IBCABIRoute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIRoute does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-// IBCPacketReceipt represents a receipt for a IBC packet and implements the PacketReceiptI interface.
-type IBCPacketReceipt struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// sequence is representing the sequence of the IBC packet.
-	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IBCABIRoute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCABIRoute.channel_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.IBCABIRoute.encoder":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIRoute does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *IBCPacketReceipt) Reset() {
-	*x = IBCPacketReceipt{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IBCABIRoute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.IBCABIRoute", d.FullName()))
 	}
+	panic("unreachable")
 }
 
-func (x *IBCPacketReceipt) String() string {
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IBCABIRoute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCABIRoute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IBCABIRoute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IBCABIRoute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IBCABIRoute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Encoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.Encoder))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IBCABIRoute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Encoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IBCABIRoute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCABIRoute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCABIRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				}
+				x.Encoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Encoder |= v1beta1.Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IBCABIPacketReceipt          protoreflect.MessageDescriptor
+	fd_IBCABIPacketReceipt_sequence protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_IBCABIPacketReceipt = File_band_tunnel_v1beta1_route_proto.Messages().ByName("IBCABIPacketReceipt")
+	fd_IBCABIPacketReceipt_sequence = md_IBCABIPacketReceipt.Fields().ByName("sequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_IBCABIPacketReceipt)(nil)
+
+type fastReflection_IBCABIPacketReceipt IBCABIPacketReceipt
+
+func (x *IBCABIPacketReceipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCABIPacketReceipt)(x)
+}
+
+func (x *IBCABIPacketReceipt) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IBCABIPacketReceipt_messageType fastReflection_IBCABIPacketReceipt_messageType
+var _ protoreflect.MessageType = fastReflection_IBCABIPacketReceipt_messageType{}
+
+type fastReflection_IBCABIPacketReceipt_messageType struct{}
+
+func (x fastReflection_IBCABIPacketReceipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCABIPacketReceipt)(nil)
+}
+func (x fastReflection_IBCABIPacketReceipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCABIPacketReceipt)
+}
+func (x fastReflection_IBCABIPacketReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCABIPacketReceipt
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IBCABIPacketReceipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCABIPacketReceipt
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IBCABIPacketReceipt) Type() protoreflect.MessageType {
+	return _fastReflection_IBCABIPacketReceipt_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IBCABIPacketReceipt) New() protoreflect.Message {
+	return new(fastReflection_IBCABIPacketReceipt)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IBCABIPacketReceipt) Interface() protoreflect.ProtoMessage {
+	return (*IBCABIPacketReceipt)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IBCABIPacketReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_IBCABIPacketReceipt_sequence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IBCABIPacketReceipt) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCABIPacketReceipt.sequence":
+		return x.Sequence != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCABIPacketReceipt) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCABIPacketReceipt.sequence":
+		x.Sequence = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IBCABIPacketReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.IBCABIPacketReceipt.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIPacketReceipt does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCABIPacketReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCABIPacketReceipt.sequence":
+		x.Sequence = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCABIPacketReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCABIPacketReceipt.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.IBCABIPacketReceipt is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IBCABIPacketReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.IBCABIPacketReceipt.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCABIPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCABIPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IBCABIPacketReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.IBCABIPacketReceipt", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IBCABIPacketReceipt) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCABIPacketReceipt) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IBCABIPacketReceipt) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IBCABIPacketReceipt) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IBCABIPacketReceipt)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IBCABIPacketReceipt)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IBCABIPacketReceipt)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCABIPacketReceipt: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCABIPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/tunnel/v1beta1/route.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TSSRoute represents a route for TSS packets and implements the RouteI interface.
+type TSSRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// destination_chain_id is the destination chain ID
+	DestinationChainId string `protobuf:"bytes,1,opt,name=destination_chain_id,json=destinationChainId,proto3" json:"destination_chain_id,omitempty"`
+	// destination_contract_address is the destination contract address
+	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
+	// encoder is the mode of encoding packet data.
+	Encoder v1beta1.Encoder `protobuf:"varint,3,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+}
+
+func (x *TSSRoute) Reset() {
+	*x = TSSRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSSRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSSRoute) ProtoMessage() {}
+
+// Deprecated: Use TSSRoute.ProtoReflect.Descriptor instead.
+func (*TSSRoute) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TSSRoute) GetDestinationChainId() string {
+	if x != nil {
+		return x.DestinationChainId
+	}
+	return ""
+}
+
+func (x *TSSRoute) GetDestinationContractAddress() string {
+	if x != nil {
+		return x.DestinationContractAddress
+	}
+	return ""
+}
+
+func (x *TSSRoute) GetEncoder() v1beta1.Encoder {
+	if x != nil {
+		return x.Encoder
+	}
+	return v1beta1.Encoder(0)
+}
+
+// TSSPacketReceipt represents a receipt for a TSS packet and implements the PacketReceiptI interface.
+type TSSPacketReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signing_id is the signing ID
+	SigningId uint64 `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3" json:"signing_id,omitempty"`
+}
+
+func (x *TSSPacketReceipt) Reset() {
+	*x = TSSPacketReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSSPacketReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSSPacketReceipt) ProtoMessage() {}
+
+// Deprecated: Use TSSPacketReceipt.ProtoReflect.Descriptor instead.
+func (*TSSPacketReceipt) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TSSPacketReceipt) GetSigningId() uint64 {
+	if x != nil {
+		return x.SigningId
+	}
+	return 0
+}
+
+// IBCRoute represents a route for IBC packets and implements the RouteI interface.
+type IBCRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel_id is the IBC channel ID
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *IBCRoute) Reset() {
+	*x = IBCRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBCRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBCRoute) ProtoMessage() {}
+
+// Deprecated: Use IBCRoute.ProtoReflect.Descriptor instead.
+func (*IBCRoute) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IBCRoute) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// IBCPacketReceipt represents a receipt for a IBC packet and implements the PacketReceiptI interface.
+type IBCPacketReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence is representing the sequence of the IBC packet.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *IBCPacketReceipt) Reset() {
+	*x = IBCPacketReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBCPacketReceipt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
@@ -8498,6 +9370,89 @@ func (x *RouterMemo) GetWasm() *RouterMemo_Payload {
 	return nil
 }
 
+// IBCABIRoute represents a route for IBC packets whose payload is ABI-encoded and implements the RouteI interface.
+type IBCABIRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel_id is the IBC channel ID
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// encoder is the mode of encoding packet data.
+	Encoder v1beta1.Encoder `protobuf:"varint,2,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+}
+
+func (x *IBCABIRoute) Reset() {
+	*x = IBCABIRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBCABIRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBCABIRoute) ProtoMessage() {}
+
+// Deprecated: Use IBCABIRoute.ProtoReflect.Descriptor instead.
+func (*IBCABIRoute) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IBCABIRoute) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *IBCABIRoute) GetEncoder() v1beta1.Encoder {
+	if x != nil {
+		return x.Encoder
+	}
+	return v1beta1.Encoder(0)
+}
+
+// IBCABIPacketReceipt represents a receipt for a IBC ABI packet and implements the PacketReceiptI interface.
+type IBCABIPacketReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence is representing the sequence of the IBC packet.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *IBCABIPacketReceipt) Reset() {
+	*x = IBCABIPacketReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBCABIPacketReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBCABIPacketReceipt) ProtoMessage() {}
+
+// Deprecated: Use IBCABIPacketReceipt.ProtoReflect.Descriptor instead.
+func (*IBCABIPacketReceipt) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *IBCABIPacketReceipt) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 // Payload defines target contract and detail of function call (msg).
 type IBCHookMemo_Payload struct {
 	state         protoimpl.MessageState
@@ -8513,7 +9468,7 @@ type IBCHookMemo_Payload struct {
 func (x *IBCHookMemo_Payload) Reset() {
 	*x = IBCHookMemo_Payload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[11]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8557,7 +9512,7 @@ type IBCHookMemo_Payload_Msg struct {
 func (x *IBCHookMemo_Payload_Msg) Reset() {
 	*x = IBCHookMemo_Payload_Msg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[12]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8594,7 +9549,7 @@ type IBCHookMemo_Payload_Msg_ReceivePacket struct {
 func (x *IBCHookMemo_Payload_Msg_ReceivePacket) Reset() {
 	*x = IBCHookMemo_Payload_Msg_ReceivePacket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[13]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8633,7 +9588,7 @@ type RouterMemo_Payload struct {
 func (x *RouterMemo_Payload) Reset() {
 	*x = RouterMemo_Payload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[14]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8677,7 +9632,7 @@ type RouterMemo_Payload_Msg struct {
 func (x *RouterMemo_Payload_Msg) Reset() {
 	*x = RouterMemo_Payload_Msg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[15]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8720,7 +9675,7 @@ type RouterMemo_Payload_Msg_ReceiveBandDataArgs struct {
 func (x *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Reset() {
 	*x = RouterMemo_Payload_Msg_ReceiveBandDataArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[16]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8909,22 +9864,34 @@ var file_band_tunnel_v1beta1_route_proto_rawDesc = []byte{
 	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a,
 	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0xdf, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x42, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61,
-	0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa,
-	0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61,
-	0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15,
-	0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x7e, 0x0a, 0x0b, 0x49, 0x42, 0x43, 0x41, 0x42,
+	0x49, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x0a, 0xca, 0xb4, 0x2d,
+	0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x22, 0x45, 0x0a, 0x13, 0x49, 0x42, 0x43, 0x41, 0x42,
+	0x49, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x42, 0xdf,
+	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62,
+	0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42,
+	0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8939,7 +9906,7 @@ func file_band_tunnel_v1beta1_route_proto_rawDescGZIP() []byte {
 	return file_band_tunnel_v1beta1_route_proto_rawDescData
 }
 
-var file_band_tunnel_v1beta1_route_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_band_tunnel_v1beta1_route_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_band_tunnel_v1beta1_route_proto_goTypes = []interface{}{
 	(*TSSRoute)(nil),                                   // 0: band.tunnel.v1beta1.TSSRoute
 	(*TSSPacketReceipt)(nil),                           // 1: band.tunnel.v1beta1.TSSPacketReceipt
@@ -8952,30 +9919,33 @@ var file_band_tunnel_v1beta1_route_proto_goTypes = []interface{}{
 	(*RouterRoute)(nil),                                // 8: band.tunnel.v1beta1.RouterRoute
 	(*RouterPacketReceipt)(nil),                        // 9: band.tunnel.v1beta1.RouterPacketReceipt
 	(*RouterMemo)(nil),                                 // 10: band.tunnel.v1beta1.RouterMemo
-	(*IBCHookMemo_Payload)(nil),                        // 11: band.tunnel.v1beta1.IBCHookMemo.Payload
-	(*IBCHookMemo_Payload_Msg)(nil),                    // 12: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg
-	(*IBCHookMemo_Payload_Msg_ReceivePacket)(nil),      // 13: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket
-	(*RouterMemo_Payload)(nil),                         // 14: band.tunnel.v1beta1.RouterMemo.Payload
-	(*RouterMemo_Payload_Msg)(nil),                     // 15: band.tunnel.v1beta1.RouterMemo.Payload.Msg
-	(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)(nil), // 16: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs
-	(v1beta1.Encoder)(0),                               // 17: band.feeds.v1beta1.Encoder
-	(*v1beta1.Price)(nil),                              // 18: band.feeds.v1beta1.Price
+	(*IBCABIRoute)(nil),                                // 11: band.tunnel.v1beta1.IBCABIRoute
+	(*IBCABIPacketReceipt)(nil),                        // 12: band.tunnel.v1beta1.IBCABIPacketReceipt
+	(*IBCHookMemo_Payload)(nil),                        // 13: band.tunnel.v1beta1.IBCHookMemo.Payload
+	(*IBCHookMemo_Payload_Msg)(nil),                    // 14: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg
+	(*IBCHookMemo_Payload_Msg_ReceivePacket)(nil),      // 15: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket
+	(*RouterMemo_Payload)(nil),                         // 16: band.tunnel.v1beta1.RouterMemo.Payload
+	(*RouterMemo_Payload_Msg)(nil),                     // 17: band.tunnel.v1beta1.RouterMemo.Payload.Msg
+	(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)(nil), // 18: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs
+	(v1beta1.Encoder)(0),                               // 19: band.feeds.v1beta1.Encoder
+	(*v1beta1.Price)(nil),                              // 20: band.feeds.v1beta1.Price
 }
 var file_band_tunnel_v1beta1_route_proto_depIdxs = []int32{
-	17, // 0: band.tunnel.v1beta1.TSSRoute.encoder:type_name -> band.feeds.v1beta1.Encoder
-	18, // 1: band.tunnel.v1beta1.TunnelPricesPacketData.prices:type_name -> band.feeds.v1beta1.Price
-	11, // 2: band.tunnel.v1beta1.IBCHookMemo.wasm:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload
-	14, // 3: band.tunnel.v1beta1.RouterMemo.wasm:type_name -> band.tunnel.v1beta1.RouterMemo.Payload
-	12, // 4: band.tunnel.v1beta1.IBCHookMemo.Payload.msg:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload.Msg
-	13, // 5: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.receive_packet:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket
-	4,  // 6: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket.packet:type_name -> band.tunnel.v1beta1.TunnelPricesPacketData
-	15, // 7: band.tunnel.v1beta1.RouterMemo.Payload.msg:type_name -> band.tunnel.v1beta1.RouterMemo.Payload.Msg
-	16, // 8: band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data:type_name -> band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	19, // 0: band.tunnel.v1beta1.TSSRoute.encoder:type_name -> band.feeds.v1beta1.Encoder
+	20, // 1: band.tunnel.v1beta1.TunnelPricesPacketData.prices:type_name -> band.feeds.v1beta1.Price
+	13, // 2: band.tunnel.v1beta1.IBCHookMemo.wasm:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload
+	16, // 3: band.tunnel.v1beta1.RouterMemo.wasm:type_name -> band.tunnel.v1beta1.RouterMemo.Payload
+	19, // 4: band.tunnel.v1beta1.IBCABIRoute.encoder:type_name -> band.feeds.v1beta1.Encoder
+	14, // 5: band.tunnel.v1beta1.IBCHookMemo.Payload.msg:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload.Msg
+	15, // 6: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.receive_packet:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket
+	4,  // 7: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket.packet:type_name -> band.tunnel.v1beta1.TunnelPricesPacketData
+	17, // 8: band.tunnel.v1beta1.RouterMemo.Payload.msg:type_name -> band.tunnel.v1beta1.RouterMemo.Payload.Msg
+	18, // 9: band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data:type_name -> band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_band_tunnel_v1beta1_route_proto_init() }
@@ -9117,7 +10087,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCHookMemo_Payload); i {
+			switch v := v.(*IBCABIRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9129,7 +10099,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCHookMemo_Payload_Msg); i {
+			switch v := v.(*IBCABIPacketReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9141,7 +10111,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCHookMemo_Payload_Msg_ReceivePacket); i {
+			switch v := v.(*IBCHookMemo_Payload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9153,7 +10123,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouterMemo_Payload); i {
+			switch v := v.(*IBCHookMemo_Payload_Msg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9165,7 +10135,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouterMemo_Payload_Msg); i {
+			switch v := v.(*IBCHookMemo_Payload_Msg_ReceivePacket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9177,6 +10147,30 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouterMemo_Payload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouterMemo_Payload_Msg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RouterMemo_Payload_Msg_ReceiveBandDataArgs); i {
 			case 0:
 				return &v.state
@@ -9195,7 +10189,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_tunnel_v1beta1_route_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
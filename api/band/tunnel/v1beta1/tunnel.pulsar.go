@@ -120,18 +120,127 @@ func (x *_Tunnel_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Tunnel_12_list)(nil)
+
+type _Tunnel_12_list struct {
+	list *[]*DerivedSignal
+}
+
+func (x *_Tunnel_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Tunnel_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Tunnel_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DerivedSignal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Tunnel_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DerivedSignal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Tunnel_12_list) AppendMutable() protoreflect.Value {
+	v := new(DerivedSignal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Tunnel_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Tunnel_12_list) NewElement() protoreflect.Value {
+	v := new(DerivedSignal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Tunnel_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Tunnel_13_list)(nil)
+
+type _Tunnel_13_list struct {
+	list *[]*TunnelAdmin
+}
+
+func (x *_Tunnel_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Tunnel_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Tunnel_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TunnelAdmin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Tunnel_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TunnelAdmin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Tunnel_13_list) AppendMutable() protoreflect.Value {
+	v := new(TunnelAdmin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Tunnel_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Tunnel_13_list) NewElement() protoreflect.Value {
+	v := new(TunnelAdmin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Tunnel_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Tunnel                   protoreflect.MessageDescriptor
-	fd_Tunnel_id                protoreflect.FieldDescriptor
-	fd_Tunnel_sequence          protoreflect.FieldDescriptor
-	fd_Tunnel_route             protoreflect.FieldDescriptor
-	fd_Tunnel_fee_payer         protoreflect.FieldDescriptor
-	fd_Tunnel_signal_deviations protoreflect.FieldDescriptor
-	fd_Tunnel_interval          protoreflect.FieldDescriptor
-	fd_Tunnel_total_deposit     protoreflect.FieldDescriptor
-	fd_Tunnel_is_active         protoreflect.FieldDescriptor
-	fd_Tunnel_created_at        protoreflect.FieldDescriptor
-	fd_Tunnel_creator           protoreflect.FieldDescriptor
+	md_Tunnel                          protoreflect.MessageDescriptor
+	fd_Tunnel_id                       protoreflect.FieldDescriptor
+	fd_Tunnel_sequence                 protoreflect.FieldDescriptor
+	fd_Tunnel_route                    protoreflect.FieldDescriptor
+	fd_Tunnel_fee_payer                protoreflect.FieldDescriptor
+	fd_Tunnel_signal_deviations        protoreflect.FieldDescriptor
+	fd_Tunnel_interval                 protoreflect.FieldDescriptor
+	fd_Tunnel_total_deposit            protoreflect.FieldDescriptor
+	fd_Tunnel_is_active                protoreflect.FieldDescriptor
+	fd_Tunnel_created_at               protoreflect.FieldDescriptor
+	fd_Tunnel_creator                  protoreflect.FieldDescriptor
+	fd_Tunnel_schedule                 protoreflect.FieldDescriptor
+	fd_Tunnel_derived_signals          protoreflect.FieldDescriptor
+	fd_Tunnel_admins                   protoreflect.FieldDescriptor
+	fd_Tunnel_funding_policy           protoreflect.FieldDescriptor
+	fd_Tunnel_include_price_confidence protoreflect.FieldDescriptor
+	fd_Tunnel_pending_creator          protoreflect.FieldDescriptor
+	fd_Tunnel_funding_source           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -147,6 +256,13 @@ func init() {
 	fd_Tunnel_is_active = md_Tunnel.Fields().ByName("is_active")
 	fd_Tunnel_created_at = md_Tunnel.Fields().ByName("created_at")
 	fd_Tunnel_creator = md_Tunnel.Fields().ByName("creator")
+	fd_Tunnel_schedule = md_Tunnel.Fields().ByName("schedule")
+	fd_Tunnel_derived_signals = md_Tunnel.Fields().ByName("derived_signals")
+	fd_Tunnel_admins = md_Tunnel.Fields().ByName("admins")
+	fd_Tunnel_funding_policy = md_Tunnel.Fields().ByName("funding_policy")
+	fd_Tunnel_include_price_confidence = md_Tunnel.Fields().ByName("include_price_confidence")
+	fd_Tunnel_pending_creator = md_Tunnel.Fields().ByName("pending_creator")
+	fd_Tunnel_funding_source = md_Tunnel.Fields().ByName("funding_source")
 }
 
 var _ protoreflect.Message = (*fastReflection_Tunnel)(nil)
@@ -274,6 +390,48 @@ func (x *fastReflection_Tunnel) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.Schedule != "" {
+		value := protoreflect.ValueOfString(x.Schedule)
+		if !f(fd_Tunnel_schedule, value) {
+			return
+		}
+	}
+	if len(x.DerivedSignals) != 0 {
+		value := protoreflect.ValueOfList(&_Tunnel_12_list{list: &x.DerivedSignals})
+		if !f(fd_Tunnel_derived_signals, value) {
+			return
+		}
+	}
+	if len(x.Admins) != 0 {
+		value := protoreflect.ValueOfList(&_Tunnel_13_list{list: &x.Admins})
+		if !f(fd_Tunnel_admins, value) {
+			return
+		}
+	}
+	if x.FundingPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.FundingPolicy.ProtoReflect())
+		if !f(fd_Tunnel_funding_policy, value) {
+			return
+		}
+	}
+	if x.IncludePriceConfidence != false {
+		value := protoreflect.ValueOfBool(x.IncludePriceConfidence)
+		if !f(fd_Tunnel_include_price_confidence, value) {
+			return
+		}
+	}
+	if x.PendingCreator != "" {
+		value := protoreflect.ValueOfString(x.PendingCreator)
+		if !f(fd_Tunnel_pending_creator, value) {
+			return
+		}
+	}
+	if x.FundingSource != "" {
+		value := protoreflect.ValueOfString(x.FundingSource)
+		if !f(fd_Tunnel_funding_source, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -309,6 +467,20 @@ func (x *fastReflection_Tunnel) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.CreatedAt != int64(0)
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		return x.Creator != ""
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		return x.Schedule != ""
+	case "band.tunnel.v1beta1.Tunnel.derived_signals":
+		return len(x.DerivedSignals) != 0
+	case "band.tunnel.v1beta1.Tunnel.admins":
+		return len(x.Admins) != 0
+	case "band.tunnel.v1beta1.Tunnel.funding_policy":
+		return x.FundingPolicy != nil
+	case "band.tunnel.v1beta1.Tunnel.include_price_confidence":
+		return x.IncludePriceConfidence != false
+	case "band.tunnel.v1beta1.Tunnel.pending_creator":
+		return x.PendingCreator != ""
+	case "band.tunnel.v1beta1.Tunnel.funding_source":
+		return x.FundingSource != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -345,6 +517,20 @@ func (x *fastReflection_Tunnel) Clear(fd protoreflect.FieldDescriptor) {
 		x.CreatedAt = int64(0)
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		x.Creator = ""
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		x.Schedule = ""
+	case "band.tunnel.v1beta1.Tunnel.derived_signals":
+		x.DerivedSignals = nil
+	case "band.tunnel.v1beta1.Tunnel.admins":
+		x.Admins = nil
+	case "band.tunnel.v1beta1.Tunnel.funding_policy":
+		x.FundingPolicy = nil
+	case "band.tunnel.v1beta1.Tunnel.include_price_confidence":
+		x.IncludePriceConfidence = false
+	case "band.tunnel.v1beta1.Tunnel.pending_creator":
+		x.PendingCreator = ""
+	case "band.tunnel.v1beta1.Tunnel.funding_source":
+		x.FundingSource = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -397,6 +583,33 @@ func (x *fastReflection_Tunnel) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		value := x.Schedule
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.Tunnel.derived_signals":
+		if len(x.DerivedSignals) == 0 {
+			return protoreflect.ValueOfList(&_Tunnel_12_list{})
+		}
+		listValue := &_Tunnel_12_list{list: &x.DerivedSignals}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.Tunnel.admins":
+		if len(x.Admins) == 0 {
+			return protoreflect.ValueOfList(&_Tunnel_13_list{})
+		}
+		listValue := &_Tunnel_13_list{list: &x.Admins}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.Tunnel.funding_policy":
+		value := x.FundingPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tunnel.v1beta1.Tunnel.include_price_confidence":
+		value := x.IncludePriceConfidence
+		return protoreflect.ValueOfBool(value)
+	case "band.tunnel.v1beta1.Tunnel.pending_creator":
+		value := x.PendingCreator
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.Tunnel.funding_source":
+		value := x.FundingSource
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -441,6 +654,24 @@ func (x *fastReflection_Tunnel) Set(fd protoreflect.FieldDescriptor, value proto
 		x.CreatedAt = value.Int()
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		x.Creator = value.Interface().(string)
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		x.Schedule = value.Interface().(string)
+	case "band.tunnel.v1beta1.Tunnel.derived_signals":
+		lv := value.List()
+		clv := lv.(*_Tunnel_12_list)
+		x.DerivedSignals = *clv.list
+	case "band.tunnel.v1beta1.Tunnel.admins":
+		lv := value.List()
+		clv := lv.(*_Tunnel_13_list)
+		x.Admins = *clv.list
+	case "band.tunnel.v1beta1.Tunnel.funding_policy":
+		x.FundingPolicy = value.Message().Interface().(*FundingPolicy)
+	case "band.tunnel.v1beta1.Tunnel.include_price_confidence":
+		x.IncludePriceConfidence = value.Bool()
+	case "band.tunnel.v1beta1.Tunnel.pending_creator":
+		x.PendingCreator = value.Interface().(string)
+	case "band.tunnel.v1beta1.Tunnel.funding_source":
+		x.FundingSource = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -478,6 +709,23 @@ func (x *fastReflection_Tunnel) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Tunnel_7_list{list: &x.TotalDeposit}
 		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.Tunnel.derived_signals":
+		if x.DerivedSignals == nil {
+			x.DerivedSignals = []*DerivedSignal{}
+		}
+		value := &_Tunnel_12_list{list: &x.DerivedSignals}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.Tunnel.admins":
+		if x.Admins == nil {
+			x.Admins = []*TunnelAdmin{}
+		}
+		value := &_Tunnel_13_list{list: &x.Admins}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.Tunnel.funding_policy":
+		if x.FundingPolicy == nil {
+			x.FundingPolicy = new(FundingPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.FundingPolicy.ProtoReflect())
 	case "band.tunnel.v1beta1.Tunnel.id":
 		panic(fmt.Errorf("field id of message band.tunnel.v1beta1.Tunnel is not mutable"))
 	case "band.tunnel.v1beta1.Tunnel.sequence":
@@ -492,6 +740,14 @@ func (x *fastReflection_Tunnel) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.Tunnel is not mutable"))
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		panic(fmt.Errorf("field creator of message band.tunnel.v1beta1.Tunnel is not mutable"))
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		panic(fmt.Errorf("field schedule of message band.tunnel.v1beta1.Tunnel is not mutable"))
+	case "band.tunnel.v1beta1.Tunnel.include_price_confidence":
+		panic(fmt.Errorf("field include_price_confidence of message band.tunnel.v1beta1.Tunnel is not mutable"))
+	case "band.tunnel.v1beta1.Tunnel.pending_creator":
+		panic(fmt.Errorf("field pending_creator of message band.tunnel.v1beta1.Tunnel is not mutable"))
+	case "band.tunnel.v1beta1.Tunnel.funding_source":
+		panic(fmt.Errorf("field funding_source of message band.tunnel.v1beta1.Tunnel is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -528,6 +784,23 @@ func (x *fastReflection_Tunnel) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfInt64(int64(0))
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.Tunnel.derived_signals":
+		list := []*DerivedSignal{}
+		return protoreflect.ValueOfList(&_Tunnel_12_list{list: &list})
+	case "band.tunnel.v1beta1.Tunnel.admins":
+		list := []*TunnelAdmin{}
+		return protoreflect.ValueOfList(&_Tunnel_13_list{list: &list})
+	case "band.tunnel.v1beta1.Tunnel.funding_policy":
+		m := new(FundingPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tunnel.v1beta1.Tunnel.include_price_confidence":
+		return protoreflect.ValueOfBool(false)
+	case "band.tunnel.v1beta1.Tunnel.pending_creator":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.Tunnel.funding_source":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -636,6 +909,37 @@ func (x *fastReflection_Tunnel) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Schedule)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DerivedSignals) > 0 {
+			for _, e := range x.DerivedSignals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Admins) > 0 {
+			for _, e := range x.Admins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FundingPolicy != nil {
+			l = options.Size(x.FundingPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IncludePriceConfidence {
+			n += 2
+		}
+		l = len(x.PendingCreator)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FundingSource)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -665,6 +969,87 @@ func (x *fastReflection_Tunnel) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.FundingSource) > 0 {
+			i -= len(x.FundingSource)
+			copy(dAtA[i:], x.FundingSource)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FundingSource)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.PendingCreator) > 0 {
+			i -= len(x.PendingCreator)
+			copy(dAtA[i:], x.PendingCreator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingCreator)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.IncludePriceConfidence {
+			i--
+			if x.IncludePriceConfidence {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.FundingPolicy != nil {
+			encoded, err := options.Marshal(x.FundingPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.Admins) > 0 {
+			for iNdEx := len(x.Admins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Admins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.DerivedSignals) > 0 {
+			for iNdEx := len(x.DerivedSignals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DerivedSignals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.Schedule) > 0 {
+			i -= len(x.Schedule)
+			copy(dAtA[i:], x.Schedule)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schedule)))
+			i--
+			dAtA[i] = 0x5a
+		}
 		if len(x.Creator) > 0 {
 			i -= len(x.Creator)
 			copy(dAtA[i:], x.Creator)
@@ -1068,117 +1453,2956 @@ func (x *fastReflection_Tunnel) ProtoMethods() *protoiface.Methods {
 				}
 				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Schedule = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DerivedSignals", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_LatestPrices_2_list)(nil)
-
-type _LatestPrices_2_list struct {
-	list *[]*v1beta11.Price
-}
-
-func (x *_LatestPrices_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_LatestPrices_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_LatestPrices_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DerivedSignals = append(x.DerivedSignals, &DerivedSignal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DerivedSignals[len(x.DerivedSignals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Admins = append(x.Admins, &TunnelAdmin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Admins[len(x.Admins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FundingPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FundingPolicy == nil {
+					x.FundingPolicy = &FundingPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FundingPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludePriceConfidence", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IncludePriceConfidence = bool(v != 0)
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingCreator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingCreator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FundingSource", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FundingSource = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TunnelAdmin_2_list)(nil)
+
+type _TunnelAdmin_2_list struct {
+	list *[]TunnelPermission
+}
+
+func (x *_TunnelAdmin_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TunnelAdmin_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_TunnelAdmin_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (TunnelPermission)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TunnelAdmin_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (TunnelPermission)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TunnelAdmin_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TunnelAdmin at list field Permissions as it is not of Message kind"))
+}
+
+func (x *_TunnelAdmin_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TunnelAdmin_2_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TunnelAdmin_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TunnelAdmin             protoreflect.MessageDescriptor
+	fd_TunnelAdmin_address     protoreflect.FieldDescriptor
+	fd_TunnelAdmin_permissions protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_TunnelAdmin = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TunnelAdmin")
+	fd_TunnelAdmin_address = md_TunnelAdmin.Fields().ByName("address")
+	fd_TunnelAdmin_permissions = md_TunnelAdmin.Fields().ByName("permissions")
+}
+
+var _ protoreflect.Message = (*fastReflection_TunnelAdmin)(nil)
+
+type fastReflection_TunnelAdmin TunnelAdmin
+
+func (x *TunnelAdmin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TunnelAdmin)(x)
+}
+
+func (x *TunnelAdmin) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TunnelAdmin_messageType fastReflection_TunnelAdmin_messageType
+var _ protoreflect.MessageType = fastReflection_TunnelAdmin_messageType{}
+
+type fastReflection_TunnelAdmin_messageType struct{}
+
+func (x fastReflection_TunnelAdmin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TunnelAdmin)(nil)
+}
+func (x fastReflection_TunnelAdmin_messageType) New() protoreflect.Message {
+	return new(fastReflection_TunnelAdmin)
+}
+func (x fastReflection_TunnelAdmin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelAdmin
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TunnelAdmin) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelAdmin
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TunnelAdmin) Type() protoreflect.MessageType {
+	return _fastReflection_TunnelAdmin_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TunnelAdmin) New() protoreflect.Message {
+	return new(fastReflection_TunnelAdmin)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TunnelAdmin) Interface() protoreflect.ProtoMessage {
+	return (*TunnelAdmin)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TunnelAdmin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_TunnelAdmin_address, value) {
+			return
+		}
+	}
+	if len(x.Permissions) != 0 {
+		value := protoreflect.ValueOfList(&_TunnelAdmin_2_list{list: &x.Permissions})
+		if !f(fd_TunnelAdmin_permissions, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TunnelAdmin) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelAdmin.address":
+		return x.Address != ""
+	case "band.tunnel.v1beta1.TunnelAdmin.permissions":
+		return len(x.Permissions) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelAdmin"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelAdmin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelAdmin) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelAdmin.address":
+		x.Address = ""
+	case "band.tunnel.v1beta1.TunnelAdmin.permissions":
+		x.Permissions = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelAdmin"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelAdmin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TunnelAdmin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.TunnelAdmin.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.TunnelAdmin.permissions":
+		if len(x.Permissions) == 0 {
+			return protoreflect.ValueOfList(&_TunnelAdmin_2_list{})
+		}
+		listValue := &_TunnelAdmin_2_list{list: &x.Permissions}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelAdmin"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelAdmin does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelAdmin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelAdmin.address":
+		x.Address = value.Interface().(string)
+	case "band.tunnel.v1beta1.TunnelAdmin.permissions":
+		lv := value.List()
+		clv := lv.(*_TunnelAdmin_2_list)
+		x.Permissions = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelAdmin"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelAdmin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelAdmin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelAdmin.permissions":
+		if x.Permissions == nil {
+			x.Permissions = []TunnelPermission{}
+		}
+		value := &_TunnelAdmin_2_list{list: &x.Permissions}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.TunnelAdmin.address":
+		panic(fmt.Errorf("field address of message band.tunnel.v1beta1.TunnelAdmin is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelAdmin"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelAdmin does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TunnelAdmin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelAdmin.address":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.TunnelAdmin.permissions":
+		list := []TunnelPermission{}
+		return protoreflect.ValueOfList(&_TunnelAdmin_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelAdmin"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelAdmin does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TunnelAdmin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TunnelAdmin", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TunnelAdmin) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelAdmin) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TunnelAdmin) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TunnelAdmin) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TunnelAdmin)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Permissions) > 0 {
+			l = 0
+			for _, e := range x.Permissions {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TunnelAdmin)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Permissions) > 0 {
+			var pksize2 int
+			for _, num := range x.Permissions {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.Permissions {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TunnelAdmin)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelAdmin: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v TunnelPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TunnelPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Permissions = append(x.Permissions, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.Permissions) == 0 {
+						x.Permissions = make([]TunnelPermission, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v TunnelPermission
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= TunnelPermission(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Permissions = append(x.Permissions, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FundingPolicy_2_list)(nil)
+
+type _FundingPolicy_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FundingPolicy_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FundingPolicy_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FundingPolicy_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FundingPolicy_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FundingPolicy_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FundingPolicy_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FundingPolicy_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FundingPolicy_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FundingPolicy_3_list)(nil)
+
+type _FundingPolicy_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FundingPolicy_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FundingPolicy_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FundingPolicy_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FundingPolicy_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FundingPolicy_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FundingPolicy_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FundingPolicy_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FundingPolicy_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FundingPolicy_4_list)(nil)
+
+type _FundingPolicy_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FundingPolicy_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FundingPolicy_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FundingPolicy_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FundingPolicy_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FundingPolicy_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FundingPolicy_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FundingPolicy_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FundingPolicy_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FundingPolicy               protoreflect.MessageDescriptor
+	fd_FundingPolicy_source        protoreflect.FieldDescriptor
+	fd_FundingPolicy_allowance     protoreflect.FieldDescriptor
+	fd_FundingPolicy_threshold     protoreflect.FieldDescriptor
+	fd_FundingPolicy_top_up_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_FundingPolicy = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("FundingPolicy")
+	fd_FundingPolicy_source = md_FundingPolicy.Fields().ByName("source")
+	fd_FundingPolicy_allowance = md_FundingPolicy.Fields().ByName("allowance")
+	fd_FundingPolicy_threshold = md_FundingPolicy.Fields().ByName("threshold")
+	fd_FundingPolicy_top_up_amount = md_FundingPolicy.Fields().ByName("top_up_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_FundingPolicy)(nil)
+
+type fastReflection_FundingPolicy FundingPolicy
+
+func (x *FundingPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FundingPolicy)(x)
+}
+
+func (x *FundingPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FundingPolicy_messageType fastReflection_FundingPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_FundingPolicy_messageType{}
+
+type fastReflection_FundingPolicy_messageType struct{}
+
+func (x fastReflection_FundingPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FundingPolicy)(nil)
+}
+func (x fastReflection_FundingPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_FundingPolicy)
+}
+func (x fastReflection_FundingPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FundingPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FundingPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_FundingPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FundingPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_FundingPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FundingPolicy) New() protoreflect.Message {
+	return new(fastReflection_FundingPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FundingPolicy) Interface() protoreflect.ProtoMessage {
+	return (*FundingPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FundingPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Source != "" {
+		value := protoreflect.ValueOfString(x.Source)
+		if !f(fd_FundingPolicy_source, value) {
+			return
+		}
+	}
+	if len(x.Allowance) != 0 {
+		value := protoreflect.ValueOfList(&_FundingPolicy_2_list{list: &x.Allowance})
+		if !f(fd_FundingPolicy_allowance, value) {
+			return
+		}
+	}
+	if len(x.Threshold) != 0 {
+		value := protoreflect.ValueOfList(&_FundingPolicy_3_list{list: &x.Threshold})
+		if !f(fd_FundingPolicy_threshold, value) {
+			return
+		}
+	}
+	if len(x.TopUpAmount) != 0 {
+		value := protoreflect.ValueOfList(&_FundingPolicy_4_list{list: &x.TopUpAmount})
+		if !f(fd_FundingPolicy_top_up_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FundingPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.FundingPolicy.source":
+		return x.Source != ""
+	case "band.tunnel.v1beta1.FundingPolicy.allowance":
+		return len(x.Allowance) != 0
+	case "band.tunnel.v1beta1.FundingPolicy.threshold":
+		return len(x.Threshold) != 0
+	case "band.tunnel.v1beta1.FundingPolicy.top_up_amount":
+		return len(x.TopUpAmount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FundingPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.FundingPolicy.source":
+		x.Source = ""
+	case "band.tunnel.v1beta1.FundingPolicy.allowance":
+		x.Allowance = nil
+	case "band.tunnel.v1beta1.FundingPolicy.threshold":
+		x.Threshold = nil
+	case "band.tunnel.v1beta1.FundingPolicy.top_up_amount":
+		x.TopUpAmount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FundingPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.FundingPolicy.source":
+		value := x.Source
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.FundingPolicy.allowance":
+		if len(x.Allowance) == 0 {
+			return protoreflect.ValueOfList(&_FundingPolicy_2_list{})
+		}
+		listValue := &_FundingPolicy_2_list{list: &x.Allowance}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.FundingPolicy.threshold":
+		if len(x.Threshold) == 0 {
+			return protoreflect.ValueOfList(&_FundingPolicy_3_list{})
+		}
+		listValue := &_FundingPolicy_3_list{list: &x.Threshold}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.FundingPolicy.top_up_amount":
+		if len(x.TopUpAmount) == 0 {
+			return protoreflect.ValueOfList(&_FundingPolicy_4_list{})
+		}
+		listValue := &_FundingPolicy_4_list{list: &x.TopUpAmount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FundingPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FundingPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.FundingPolicy.source":
+		x.Source = value.Interface().(string)
+	case "band.tunnel.v1beta1.FundingPolicy.allowance":
+		lv := value.List()
+		clv := lv.(*_FundingPolicy_2_list)
+		x.Allowance = *clv.list
+	case "band.tunnel.v1beta1.FundingPolicy.threshold":
+		lv := value.List()
+		clv := lv.(*_FundingPolicy_3_list)
+		x.Threshold = *clv.list
+	case "band.tunnel.v1beta1.FundingPolicy.top_up_amount":
+		lv := value.List()
+		clv := lv.(*_FundingPolicy_4_list)
+		x.TopUpAmount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FundingPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.FundingPolicy.allowance":
+		if x.Allowance == nil {
+			x.Allowance = []*v1beta1.Coin{}
+		}
+		value := &_FundingPolicy_2_list{list: &x.Allowance}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.FundingPolicy.threshold":
+		if x.Threshold == nil {
+			x.Threshold = []*v1beta1.Coin{}
+		}
+		value := &_FundingPolicy_3_list{list: &x.Threshold}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.FundingPolicy.top_up_amount":
+		if x.TopUpAmount == nil {
+			x.TopUpAmount = []*v1beta1.Coin{}
+		}
+		value := &_FundingPolicy_4_list{list: &x.TopUpAmount}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.FundingPolicy.source":
+		panic(fmt.Errorf("field source of message band.tunnel.v1beta1.FundingPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FundingPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.FundingPolicy.source":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.FundingPolicy.allowance":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FundingPolicy_2_list{list: &list})
+	case "band.tunnel.v1beta1.FundingPolicy.threshold":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FundingPolicy_3_list{list: &list})
+	case "band.tunnel.v1beta1.FundingPolicy.top_up_amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FundingPolicy_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FundingPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.FundingPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FundingPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FundingPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FundingPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FundingPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FundingPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Source)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Allowance) > 0 {
+			for _, e := range x.Allowance {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Threshold) > 0 {
+			for _, e := range x.Threshold {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopUpAmount) > 0 {
+			for _, e := range x.TopUpAmount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FundingPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TopUpAmount) > 0 {
+			for iNdEx := len(x.TopUpAmount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopUpAmount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Threshold) > 0 {
+			for iNdEx := len(x.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Threshold[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Allowance) > 0 {
+			for iNdEx := len(x.Allowance) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Allowance[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Source) > 0 {
+			i -= len(x.Source)
+			copy(dAtA[i:], x.Source)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Source)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FundingPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FundingPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FundingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Source = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allowance = append(x.Allowance, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allowance[len(x.Allowance)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = append(x.Threshold, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Threshold[len(x.Threshold)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopUpAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TopUpAmount = append(x.TopUpAmount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopUpAmount[len(x.TopUpAmount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_LatestPrices_2_list)(nil)
+
+type _LatestPrices_2_list struct {
+	list *[]*v1beta11.Price
+}
+
+func (x *_LatestPrices_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LatestPrices_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LatestPrices_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LatestPrices_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LatestPrices_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Price)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LatestPrices_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LatestPrices_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Price)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LatestPrices_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_LatestPrices               protoreflect.MessageDescriptor
+	fd_LatestPrices_tunnel_id     protoreflect.FieldDescriptor
+	fd_LatestPrices_prices        protoreflect.FieldDescriptor
+	fd_LatestPrices_last_interval protoreflect.FieldDescriptor
+	fd_LatestPrices_last_schedule protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_LatestPrices = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("LatestPrices")
+	fd_LatestPrices_tunnel_id = md_LatestPrices.Fields().ByName("tunnel_id")
+	fd_LatestPrices_prices = md_LatestPrices.Fields().ByName("prices")
+	fd_LatestPrices_last_interval = md_LatestPrices.Fields().ByName("last_interval")
+	fd_LatestPrices_last_schedule = md_LatestPrices.Fields().ByName("last_schedule")
+}
+
+var _ protoreflect.Message = (*fastReflection_LatestPrices)(nil)
+
+type fastReflection_LatestPrices LatestPrices
+
+func (x *LatestPrices) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LatestPrices)(x)
+}
+
+func (x *LatestPrices) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LatestPrices_messageType fastReflection_LatestPrices_messageType
+var _ protoreflect.MessageType = fastReflection_LatestPrices_messageType{}
+
+type fastReflection_LatestPrices_messageType struct{}
+
+func (x fastReflection_LatestPrices_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LatestPrices)(nil)
+}
+func (x fastReflection_LatestPrices_messageType) New() protoreflect.Message {
+	return new(fastReflection_LatestPrices)
+}
+func (x fastReflection_LatestPrices_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LatestPrices
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LatestPrices) Descriptor() protoreflect.MessageDescriptor {
+	return md_LatestPrices
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LatestPrices) Type() protoreflect.MessageType {
+	return _fastReflection_LatestPrices_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LatestPrices) New() protoreflect.Message {
+	return new(fastReflection_LatestPrices)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LatestPrices) Interface() protoreflect.ProtoMessage {
+	return (*LatestPrices)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LatestPrices) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_LatestPrices_tunnel_id, value) {
+			return
+		}
+	}
+	if len(x.Prices) != 0 {
+		value := protoreflect.ValueOfList(&_LatestPrices_2_list{list: &x.Prices})
+		if !f(fd_LatestPrices_prices, value) {
+			return
+		}
+	}
+	if x.LastInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastInterval)
+		if !f(fd_LatestPrices_last_interval, value) {
+			return
+		}
+	}
+	if x.LastSchedule != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastSchedule)
+		if !f(fd_LatestPrices_last_schedule, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LatestPrices) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.LatestPrices.prices":
+		return len(x.Prices) != 0
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		return x.LastInterval != int64(0)
+	case "band.tunnel.v1beta1.LatestPrices.last_schedule":
+		return x.LastSchedule != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LatestPrices) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.LatestPrices.prices":
+		x.Prices = nil
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		x.LastInterval = int64(0)
+	case "band.tunnel.v1beta1.LatestPrices.last_schedule":
+		x.LastSchedule = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LatestPrices) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.LatestPrices.prices":
+		if len(x.Prices) == 0 {
+			return protoreflect.ValueOfList(&_LatestPrices_2_list{})
+		}
+		listValue := &_LatestPrices_2_list{list: &x.Prices}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		value := x.LastInterval
+		return protoreflect.ValueOfInt64(value)
+	case "band.tunnel.v1beta1.LatestPrices.last_schedule":
+		value := x.LastSchedule
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LatestPrices) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.LatestPrices.prices":
+		lv := value.List()
+		clv := lv.(*_LatestPrices_2_list)
+		x.Prices = *clv.list
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		x.LastInterval = value.Int()
+	case "band.tunnel.v1beta1.LatestPrices.last_schedule":
+		x.LastSchedule = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LatestPrices) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.LatestPrices.prices":
+		if x.Prices == nil {
+			x.Prices = []*v1beta11.Price{}
+		}
+		value := &_LatestPrices_2_list{list: &x.Prices}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.LatestPrices is not mutable"))
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		panic(fmt.Errorf("field last_interval of message band.tunnel.v1beta1.LatestPrices is not mutable"))
+	case "band.tunnel.v1beta1.LatestPrices.last_schedule":
+		panic(fmt.Errorf("field last_schedule of message band.tunnel.v1beta1.LatestPrices is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LatestPrices) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.LatestPrices.prices":
+		list := []*v1beta11.Price{}
+		return protoreflect.ValueOfList(&_LatestPrices_2_list{list: &list})
+	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.LatestPrices.last_schedule":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LatestPrices) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.LatestPrices", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LatestPrices) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LatestPrices) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LatestPrices) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LatestPrices)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if len(x.Prices) > 0 {
+			for _, e := range x.Prices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LastInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastInterval))
+		}
+		if x.LastSchedule != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastSchedule))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LatestPrices)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastSchedule != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastSchedule))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.LastInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastInterval))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Prices) > 0 {
+			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Prices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LatestPrices)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LatestPrices: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LatestPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Prices = append(x.Prices, &v1beta11.Price{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastInterval", wireType)
+				}
+				x.LastInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastInterval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastSchedule", wireType)
+				}
+				x.LastSchedule = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastSchedule |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TotalFees_1_list)(nil)
+
+type _TotalFees_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_TotalFees_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TotalFees_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TotalFees_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TotalFees_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TotalFees_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TotalFees_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TotalFees_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TotalFees_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TotalFees                       protoreflect.MessageDescriptor
+	fd_TotalFees_total_base_packet_fee protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_TotalFees = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TotalFees")
+	fd_TotalFees_total_base_packet_fee = md_TotalFees.Fields().ByName("total_base_packet_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_TotalFees)(nil)
+
+type fastReflection_TotalFees TotalFees
+
+func (x *TotalFees) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TotalFees)(x)
+}
+
+func (x *TotalFees) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TotalFees_messageType fastReflection_TotalFees_messageType
+var _ protoreflect.MessageType = fastReflection_TotalFees_messageType{}
+
+type fastReflection_TotalFees_messageType struct{}
+
+func (x fastReflection_TotalFees_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TotalFees)(nil)
+}
+func (x fastReflection_TotalFees_messageType) New() protoreflect.Message {
+	return new(fastReflection_TotalFees)
+}
+func (x fastReflection_TotalFees_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TotalFees
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TotalFees) Descriptor() protoreflect.MessageDescriptor {
+	return md_TotalFees
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TotalFees) Type() protoreflect.MessageType {
+	return _fastReflection_TotalFees_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TotalFees) New() protoreflect.Message {
+	return new(fastReflection_TotalFees)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TotalFees) Interface() protoreflect.ProtoMessage {
+	return (*TotalFees)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TotalFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TotalBasePacketFee) != 0 {
+		value := protoreflect.ValueOfList(&_TotalFees_1_list{list: &x.TotalBasePacketFee})
+		if !f(fd_TotalFees_total_base_packet_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TotalFees) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
+		return len(x.TotalBasePacketFee) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TotalFees) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
+		x.TotalBasePacketFee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TotalFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
+		if len(x.TotalBasePacketFee) == 0 {
+			return protoreflect.ValueOfList(&_TotalFees_1_list{})
+		}
+		listValue := &_TotalFees_1_list{list: &x.TotalBasePacketFee}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TotalFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
+		lv := value.List()
+		clv := lv.(*_TotalFees_1_list)
+		x.TotalBasePacketFee = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TotalFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
+		if x.TotalBasePacketFee == nil {
+			x.TotalBasePacketFee = []*v1beta1.Coin{}
+		}
+		value := &_TotalFees_1_list{list: &x.TotalBasePacketFee}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TotalFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_TotalFees_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TotalFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TotalFees", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TotalFees) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TotalFees) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TotalFees) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TotalFees)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.TotalBasePacketFee) > 0 {
+			for _, e := range x.TotalBasePacketFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TotalFees)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalBasePacketFee) > 0 {
+			for iNdEx := len(x.TotalBasePacketFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalBasePacketFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TotalFees)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TotalFees: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TotalFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBasePacketFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalBasePacketFee = append(x.TotalBasePacketFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalBasePacketFee[len(x.TotalBasePacketFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Packet_3_list)(nil)
+
+type _Packet_3_list struct {
+	list *[]*v1beta11.Price
+}
+
+func (x *_Packet_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Packet_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Packet_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_LatestPrices_2_list) Append(value protoreflect.Value) {
+func (x *_Packet_3_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_LatestPrices_2_list) AppendMutable() protoreflect.Value {
+func (x *_Packet_3_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta11.Price)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_LatestPrices_2_list) Truncate(n int) {
+func (x *_Packet_3_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_LatestPrices_2_list) NewElement() protoreflect.Value {
+func (x *_Packet_3_list) NewElement() protoreflect.Value {
 	v := new(v1beta11.Price)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_LatestPrices_2_list) IsValid() bool {
+func (x *_Packet_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Packet_6_list)(nil)
+
+type _Packet_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Packet_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Packet_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Packet_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Packet_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Packet_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Packet_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Packet_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Packet_6_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_LatestPrices               protoreflect.MessageDescriptor
-	fd_LatestPrices_tunnel_id     protoreflect.FieldDescriptor
-	fd_LatestPrices_prices        protoreflect.FieldDescriptor
-	fd_LatestPrices_last_interval protoreflect.FieldDescriptor
+	md_Packet            protoreflect.MessageDescriptor
+	fd_Packet_tunnel_id  protoreflect.FieldDescriptor
+	fd_Packet_sequence   protoreflect.FieldDescriptor
+	fd_Packet_prices     protoreflect.FieldDescriptor
+	fd_Packet_receipt    protoreflect.FieldDescriptor
+	fd_Packet_created_at protoreflect.FieldDescriptor
+	fd_Packet_base_fee   protoreflect.FieldDescriptor
+	fd_Packet_status     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_LatestPrices = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("LatestPrices")
-	fd_LatestPrices_tunnel_id = md_LatestPrices.Fields().ByName("tunnel_id")
-	fd_LatestPrices_prices = md_LatestPrices.Fields().ByName("prices")
-	fd_LatestPrices_last_interval = md_LatestPrices.Fields().ByName("last_interval")
+	md_Packet = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Packet")
+	fd_Packet_tunnel_id = md_Packet.Fields().ByName("tunnel_id")
+	fd_Packet_sequence = md_Packet.Fields().ByName("sequence")
+	fd_Packet_prices = md_Packet.Fields().ByName("prices")
+	fd_Packet_receipt = md_Packet.Fields().ByName("receipt")
+	fd_Packet_created_at = md_Packet.Fields().ByName("created_at")
+	fd_Packet_base_fee = md_Packet.Fields().ByName("base_fee")
+	fd_Packet_status = md_Packet.Fields().ByName("status")
 }
 
-var _ protoreflect.Message = (*fastReflection_LatestPrices)(nil)
+var _ protoreflect.Message = (*fastReflection_Packet)(nil)
 
-type fastReflection_LatestPrices LatestPrices
+type fastReflection_Packet Packet
 
-func (x *LatestPrices) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_LatestPrices)(x)
+func (x *Packet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Packet)(x)
 }
 
-func (x *LatestPrices) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[1]
+func (x *Packet) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,43 +4413,43 @@ func (x *LatestPrices) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_LatestPrices_messageType fastReflection_LatestPrices_messageType
-var _ protoreflect.MessageType = fastReflection_LatestPrices_messageType{}
+var _fastReflection_Packet_messageType fastReflection_Packet_messageType
+var _ protoreflect.MessageType = fastReflection_Packet_messageType{}
 
-type fastReflection_LatestPrices_messageType struct{}
+type fastReflection_Packet_messageType struct{}
 
-func (x fastReflection_LatestPrices_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_LatestPrices)(nil)
+func (x fastReflection_Packet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Packet)(nil)
 }
-func (x fastReflection_LatestPrices_messageType) New() protoreflect.Message {
-	return new(fastReflection_LatestPrices)
+func (x fastReflection_Packet_messageType) New() protoreflect.Message {
+	return new(fastReflection_Packet)
 }
-func (x fastReflection_LatestPrices_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_LatestPrices
+func (x fastReflection_Packet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Packet
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_LatestPrices) Descriptor() protoreflect.MessageDescriptor {
-	return md_LatestPrices
+func (x *fastReflection_Packet) Descriptor() protoreflect.MessageDescriptor {
+	return md_Packet
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_LatestPrices) Type() protoreflect.MessageType {
-	return _fastReflection_LatestPrices_messageType
+func (x *fastReflection_Packet) Type() protoreflect.MessageType {
+	return _fastReflection_Packet_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_LatestPrices) New() protoreflect.Message {
-	return new(fastReflection_LatestPrices)
+func (x *fastReflection_Packet) New() protoreflect.Message {
+	return new(fastReflection_Packet)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_LatestPrices) Interface() protoreflect.ProtoMessage {
-	return (*LatestPrices)(x)
+func (x *fastReflection_Packet) Interface() protoreflect.ProtoMessage {
+	return (*Packet)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1233,22 +4457,46 @@ func (x *fastReflection_LatestPrices) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_LatestPrices) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_Packet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.TunnelId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.TunnelId)
-		if !f(fd_LatestPrices_tunnel_id, value) {
+		if !f(fd_Packet_tunnel_id, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_Packet_sequence, value) {
 			return
 		}
 	}
 	if len(x.Prices) != 0 {
-		value := protoreflect.ValueOfList(&_LatestPrices_2_list{list: &x.Prices})
-		if !f(fd_LatestPrices_prices, value) {
+		value := protoreflect.ValueOfList(&_Packet_3_list{list: &x.Prices})
+		if !f(fd_Packet_prices, value) {
 			return
 		}
 	}
-	if x.LastInterval != int64(0) {
-		value := protoreflect.ValueOfInt64(x.LastInterval)
-		if !f(fd_LatestPrices_last_interval, value) {
+	if x.Receipt != nil {
+		value := protoreflect.ValueOfMessage(x.Receipt.ProtoReflect())
+		if !f(fd_Packet_receipt, value) {
+			return
+		}
+	}
+	if x.CreatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAt)
+		if !f(fd_Packet_created_at, value) {
+			return
+		}
+	}
+	if len(x.BaseFee) != 0 {
+		value := protoreflect.ValueOfList(&_Packet_6_list{list: &x.BaseFee})
+		if !f(fd_Packet_base_fee, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Packet_status, value) {
 			return
 		}
 	}
@@ -1265,19 +4513,27 @@ func (x *fastReflection_LatestPrices) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_LatestPrices) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Packet) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
 		return x.TunnelId != uint64(0)
-	case "band.tunnel.v1beta1.LatestPrices.prices":
+	case "band.tunnel.v1beta1.Packet.sequence":
+		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.Packet.prices":
 		return len(x.Prices) != 0
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
-		return x.LastInterval != int64(0)
+	case "band.tunnel.v1beta1.Packet.receipt":
+		return x.Receipt != nil
+	case "band.tunnel.v1beta1.Packet.created_at":
+		return x.CreatedAt != int64(0)
+	case "band.tunnel.v1beta1.Packet.base_fee":
+		return len(x.BaseFee) != 0
+	case "band.tunnel.v1beta1.Packet.status":
+		return x.Status != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1287,19 +4543,27 @@ func (x *fastReflection_LatestPrices) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LatestPrices) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Packet) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
 		x.TunnelId = uint64(0)
-	case "band.tunnel.v1beta1.LatestPrices.prices":
+	case "band.tunnel.v1beta1.Packet.sequence":
+		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.Packet.prices":
 		x.Prices = nil
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
-		x.LastInterval = int64(0)
+	case "band.tunnel.v1beta1.Packet.receipt":
+		x.Receipt = nil
+	case "band.tunnel.v1beta1.Packet.created_at":
+		x.CreatedAt = int64(0)
+	case "band.tunnel.v1beta1.Packet.base_fee":
+		x.BaseFee = nil
+	case "band.tunnel.v1beta1.Packet.status":
+		x.Status = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1309,25 +4573,40 @@ func (x *fastReflection_LatestPrices) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_LatestPrices) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Packet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
 		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.LatestPrices.prices":
+	case "band.tunnel.v1beta1.Packet.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.Packet.prices":
 		if len(x.Prices) == 0 {
-			return protoreflect.ValueOfList(&_LatestPrices_2_list{})
+			return protoreflect.ValueOfList(&_Packet_3_list{})
 		}
-		listValue := &_LatestPrices_2_list{list: &x.Prices}
+		listValue := &_Packet_3_list{list: &x.Prices}
 		return protoreflect.ValueOfList(listValue)
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
-		value := x.LastInterval
+	case "band.tunnel.v1beta1.Packet.receipt":
+		value := x.Receipt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tunnel.v1beta1.Packet.created_at":
+		value := x.CreatedAt
 		return protoreflect.ValueOfInt64(value)
+	case "band.tunnel.v1beta1.Packet.base_fee":
+		if len(x.BaseFee) == 0 {
+			return protoreflect.ValueOfList(&_Packet_6_list{})
+		}
+		listValue := &_Packet_6_list{list: &x.BaseFee}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.Packet.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1341,21 +4620,31 @@ func (x *fastReflection_LatestPrices) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LatestPrices) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Packet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
 		x.TunnelId = value.Uint()
-	case "band.tunnel.v1beta1.LatestPrices.prices":
+	case "band.tunnel.v1beta1.Packet.sequence":
+		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.Packet.prices":
 		lv := value.List()
-		clv := lv.(*_LatestPrices_2_list)
+		clv := lv.(*_Packet_3_list)
 		x.Prices = *clv.list
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
-		x.LastInterval = value.Int()
+	case "band.tunnel.v1beta1.Packet.receipt":
+		x.Receipt = value.Message().Interface().(*anypb.Any)
+	case "band.tunnel.v1beta1.Packet.created_at":
+		x.CreatedAt = value.Int()
+	case "band.tunnel.v1beta1.Packet.base_fee":
+		lv := value.List()
+		clv := lv.(*_Packet_6_list)
+		x.BaseFee = *clv.list
+	case "band.tunnel.v1beta1.Packet.status":
+		x.Status = (PacketStatus)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1369,53 +4658,78 @@ func (x *fastReflection_LatestPrices) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LatestPrices) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Packet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.prices":
+	case "band.tunnel.v1beta1.Packet.prices":
 		if x.Prices == nil {
 			x.Prices = []*v1beta11.Price{}
 		}
-		value := &_LatestPrices_2_list{list: &x.Prices}
+		value := &_Packet_3_list{list: &x.Prices}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.Packet.receipt":
+		if x.Receipt == nil {
+			x.Receipt = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Receipt.ProtoReflect())
+	case "band.tunnel.v1beta1.Packet.base_fee":
+		if x.BaseFee == nil {
+			x.BaseFee = []*v1beta1.Coin{}
+		}
+		value := &_Packet_6_list{list: &x.BaseFee}
 		return protoreflect.ValueOfList(value)
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
-		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.LatestPrices is not mutable"))
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
-		panic(fmt.Errorf("field last_interval of message band.tunnel.v1beta1.LatestPrices is not mutable"))
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.Packet is not mutable"))
+	case "band.tunnel.v1beta1.Packet.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.Packet is not mutable"))
+	case "band.tunnel.v1beta1.Packet.created_at":
+		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.Packet is not mutable"))
+	case "band.tunnel.v1beta1.Packet.status":
+		panic(fmt.Errorf("field status of message band.tunnel.v1beta1.Packet is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_LatestPrices) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Packet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.LatestPrices.tunnel_id":
+	case "band.tunnel.v1beta1.Packet.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.LatestPrices.prices":
+	case "band.tunnel.v1beta1.Packet.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.Packet.prices":
 		list := []*v1beta11.Price{}
-		return protoreflect.ValueOfList(&_LatestPrices_2_list{list: &list})
-	case "band.tunnel.v1beta1.LatestPrices.last_interval":
+		return protoreflect.ValueOfList(&_Packet_3_list{list: &list})
+	case "band.tunnel.v1beta1.Packet.receipt":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tunnel.v1beta1.Packet.created_at":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.Packet.base_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Packet_6_list{list: &list})
+	case "band.tunnel.v1beta1.Packet.status":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LatestPrices"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.LatestPrices does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_LatestPrices) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Packet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.LatestPrices", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Packet", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1423,7 +4737,7 @@ func (x *fastReflection_LatestPrices) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_LatestPrices) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Packet) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1434,7 +4748,7 @@ func (x *fastReflection_LatestPrices) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LatestPrices) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Packet) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1446,7 +4760,7 @@ func (x *fastReflection_LatestPrices) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_LatestPrices) IsValid() bool {
+func (x *fastReflection_Packet) IsValid() bool {
 	return x != nil
 }
 
@@ -1456,9 +4770,9 @@ func (x *fastReflection_LatestPrices) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*LatestPrices)
+		x := input.Message.Interface().(*Packet)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1473,14 +4787,30 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 		if x.TunnelId != 0 {
 			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
 		if len(x.Prices) > 0 {
 			for _, e := range x.Prices {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.LastInterval != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastInterval))
+		if x.Receipt != nil {
+			l = options.Size(x.Receipt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		}
+		if len(x.BaseFee) > 0 {
+			for _, e := range x.BaseFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1492,7 +4822,7 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*LatestPrices)
+		x := input.Message.Interface().(*Packet)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1511,10 +4841,45 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.LastInterval != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastInterval))
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x38
+		}
+		if len(x.BaseFee) > 0 {
+			for iNdEx := len(x.BaseFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BaseFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Receipt != nil {
+			encoded, err := options.Marshal(x.Receipt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
 		}
 		if len(x.Prices) > 0 {
 			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
@@ -1525,72 +4890,185 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 						Buf:               input.Buf,
 					}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.TunnelId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*LatestPrices)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Packet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Packet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Prices = append(x.Prices, &v1beta11.Price{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if x.Receipt == nil {
+					x.Receipt = &anypb.Any{}
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LatestPrices: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LatestPrices: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Receipt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 				}
-				x.TunnelId = 0
+				x.CreatedAt = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1600,14 +5078,14 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TunnelId |= uint64(b&0x7F) << shift
+					x.CreatedAt |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 2:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1634,16 +5112,16 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Prices = append(x.Prices, &v1beta11.Price{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
+				x.BaseFee = append(x.BaseFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BaseFee[len(x.BaseFee)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 7:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastInterval", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 				}
-				x.LastInterval = 0
+				x.Status = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1653,7 +5131,7 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LastInterval |= int64(b&0x7F) << shift
+					x.Status |= PacketStatus(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1693,78 +5171,35 @@ func (x *fastReflection_LatestPrices) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_TotalFees_1_list)(nil)
-
-type _TotalFees_1_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_TotalFees_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TotalFees_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_TotalFees_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TotalFees_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TotalFees_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TotalFees_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TotalFees_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TotalFees_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_TotalFees                       protoreflect.MessageDescriptor
-	fd_TotalFees_total_base_packet_fee protoreflect.FieldDescriptor
+	md_FailedPacket                 protoreflect.MessageDescriptor
+	fd_FailedPacket_tunnel_id       protoreflect.FieldDescriptor
+	fd_FailedPacket_sequence        protoreflect.FieldDescriptor
+	fd_FailedPacket_reason          protoreflect.FieldDescriptor
+	fd_FailedPacket_attempts        protoreflect.FieldDescriptor
+	fd_FailedPacket_last_attempt_at protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_TotalFees = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TotalFees")
-	fd_TotalFees_total_base_packet_fee = md_TotalFees.Fields().ByName("total_base_packet_fee")
+	md_FailedPacket = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("FailedPacket")
+	fd_FailedPacket_tunnel_id = md_FailedPacket.Fields().ByName("tunnel_id")
+	fd_FailedPacket_sequence = md_FailedPacket.Fields().ByName("sequence")
+	fd_FailedPacket_reason = md_FailedPacket.Fields().ByName("reason")
+	fd_FailedPacket_attempts = md_FailedPacket.Fields().ByName("attempts")
+	fd_FailedPacket_last_attempt_at = md_FailedPacket.Fields().ByName("last_attempt_at")
 }
 
-var _ protoreflect.Message = (*fastReflection_TotalFees)(nil)
+var _ protoreflect.Message = (*fastReflection_FailedPacket)(nil)
 
-type fastReflection_TotalFees TotalFees
+type fastReflection_FailedPacket FailedPacket
 
-func (x *TotalFees) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TotalFees)(x)
+func (x *FailedPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FailedPacket)(x)
 }
 
-func (x *TotalFees) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[2]
+func (x *FailedPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1775,43 +5210,43 @@ func (x *TotalFees) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TotalFees_messageType fastReflection_TotalFees_messageType
-var _ protoreflect.MessageType = fastReflection_TotalFees_messageType{}
+var _fastReflection_FailedPacket_messageType fastReflection_FailedPacket_messageType
+var _ protoreflect.MessageType = fastReflection_FailedPacket_messageType{}
 
-type fastReflection_TotalFees_messageType struct{}
+type fastReflection_FailedPacket_messageType struct{}
 
-func (x fastReflection_TotalFees_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TotalFees)(nil)
+func (x fastReflection_FailedPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FailedPacket)(nil)
 }
-func (x fastReflection_TotalFees_messageType) New() protoreflect.Message {
-	return new(fastReflection_TotalFees)
+func (x fastReflection_FailedPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_FailedPacket)
 }
-func (x fastReflection_TotalFees_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TotalFees
+func (x fastReflection_FailedPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FailedPacket
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TotalFees) Descriptor() protoreflect.MessageDescriptor {
-	return md_TotalFees
+func (x *fastReflection_FailedPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_FailedPacket
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TotalFees) Type() protoreflect.MessageType {
-	return _fastReflection_TotalFees_messageType
+func (x *fastReflection_FailedPacket) Type() protoreflect.MessageType {
+	return _fastReflection_FailedPacket_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TotalFees) New() protoreflect.Message {
-	return new(fastReflection_TotalFees)
+func (x *fastReflection_FailedPacket) New() protoreflect.Message {
+	return new(fastReflection_FailedPacket)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TotalFees) Interface() protoreflect.ProtoMessage {
-	return (*TotalFees)(x)
+func (x *fastReflection_FailedPacket) Interface() protoreflect.ProtoMessage {
+	return (*FailedPacket)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1819,10 +5254,34 @@ func (x *fastReflection_TotalFees) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TotalFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.TotalBasePacketFee) != 0 {
-		value := protoreflect.ValueOfList(&_TotalFees_1_list{list: &x.TotalBasePacketFee})
-		if !f(fd_TotalFees_total_base_packet_fee, value) {
+func (x *fastReflection_FailedPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_FailedPacket_tunnel_id, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_FailedPacket_sequence, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_FailedPacket_reason, value) {
+			return
+		}
+	}
+	if x.Attempts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Attempts)
+		if !f(fd_FailedPacket_attempts, value) {
+			return
+		}
+	}
+	if x.LastAttemptAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastAttemptAt)
+		if !f(fd_FailedPacket_last_attempt_at, value) {
 			return
 		}
 	}
@@ -1839,15 +5298,23 @@ func (x *fastReflection_TotalFees) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TotalFees) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FailedPacket) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
-		return len(x.TotalBasePacketFee) != 0
+	case "band.tunnel.v1beta1.FailedPacket.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.FailedPacket.sequence":
+		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.FailedPacket.reason":
+		return x.Reason != ""
+	case "band.tunnel.v1beta1.FailedPacket.attempts":
+		return x.Attempts != uint64(0)
+	case "band.tunnel.v1beta1.FailedPacket.last_attempt_at":
+		return x.LastAttemptAt != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1857,15 +5324,23 @@ func (x *fastReflection_TotalFees) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TotalFees) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FailedPacket) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
-		x.TotalBasePacketFee = nil
+	case "band.tunnel.v1beta1.FailedPacket.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.FailedPacket.sequence":
+		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.FailedPacket.reason":
+		x.Reason = ""
+	case "band.tunnel.v1beta1.FailedPacket.attempts":
+		x.Attempts = uint64(0)
+	case "band.tunnel.v1beta1.FailedPacket.last_attempt_at":
+		x.LastAttemptAt = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1875,19 +5350,28 @@ func (x *fastReflection_TotalFees) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TotalFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FailedPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
-		if len(x.TotalBasePacketFee) == 0 {
-			return protoreflect.ValueOfList(&_TotalFees_1_list{})
-		}
-		listValue := &_TotalFees_1_list{list: &x.TotalBasePacketFee}
-		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.FailedPacket.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.FailedPacket.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.FailedPacket.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.FailedPacket.attempts":
+		value := x.Attempts
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.FailedPacket.last_attempt_at":
+		value := x.LastAttemptAt
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacket does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1901,17 +5385,23 @@ func (x *fastReflection_TotalFees) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TotalFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FailedPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
-		lv := value.List()
-		clv := lv.(*_TotalFees_1_list)
-		x.TotalBasePacketFee = *clv.list
+	case "band.tunnel.v1beta1.FailedPacket.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.FailedPacket.sequence":
+		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.FailedPacket.reason":
+		x.Reason = value.Interface().(string)
+	case "band.tunnel.v1beta1.FailedPacket.attempts":
+		x.Attempts = value.Uint()
+	case "band.tunnel.v1beta1.FailedPacket.last_attempt_at":
+		x.LastAttemptAt = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1925,45 +5415,56 @@ func (x *fastReflection_TotalFees) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TotalFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FailedPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
-		if x.TotalBasePacketFee == nil {
-			x.TotalBasePacketFee = []*v1beta1.Coin{}
-		}
-		value := &_TotalFees_1_list{list: &x.TotalBasePacketFee}
-		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.FailedPacket.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.FailedPacket is not mutable"))
+	case "band.tunnel.v1beta1.FailedPacket.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.FailedPacket is not mutable"))
+	case "band.tunnel.v1beta1.FailedPacket.reason":
+		panic(fmt.Errorf("field reason of message band.tunnel.v1beta1.FailedPacket is not mutable"))
+	case "band.tunnel.v1beta1.FailedPacket.attempts":
+		panic(fmt.Errorf("field attempts of message band.tunnel.v1beta1.FailedPacket is not mutable"))
+	case "band.tunnel.v1beta1.FailedPacket.last_attempt_at":
+		panic(fmt.Errorf("field last_attempt_at of message band.tunnel.v1beta1.FailedPacket is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacket does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TotalFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FailedPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TotalFees.total_base_packet_fee":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_TotalFees_1_list{list: &list})
+	case "band.tunnel.v1beta1.FailedPacket.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.FailedPacket.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.FailedPacket.reason":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.FailedPacket.attempts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.FailedPacket.last_attempt_at":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TotalFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TotalFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacket does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TotalFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FailedPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TotalFees", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.FailedPacket", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1971,7 +5472,7 @@ func (x *fastReflection_TotalFees) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TotalFees) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FailedPacket) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1982,7 +5483,7 @@ func (x *fastReflection_TotalFees) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TotalFees) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FailedPacket) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1994,7 +5495,7 @@ func (x *fastReflection_TotalFees) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TotalFees) IsValid() bool {
+func (x *fastReflection_FailedPacket) IsValid() bool {
 	return x != nil
 }
 
@@ -2004,9 +5505,9 @@ func (x *fastReflection_TotalFees) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FailedPacket) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TotalFees)
+		x := input.Message.Interface().(*FailedPacket)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2018,11 +5519,21 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.TotalBasePacketFee) > 0 {
-			for _, e := range x.TotalBasePacketFee {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Attempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempts))
+		}
+		if x.LastAttemptAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastAttemptAt))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2034,7 +5545,7 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TotalFees)
+		x := input.Message.Interface().(*FailedPacket)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2053,21 +5564,32 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TotalBasePacketFee) > 0 {
-			for iNdEx := len(x.TotalBasePacketFee) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TotalBasePacketFee[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.LastAttemptAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastAttemptAt))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Attempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempts))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2080,7 +5602,7 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TotalFees)
+		x := input.Message.Interface().(*FailedPacket)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2112,17 +5634,55 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TotalFees: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FailedPacket: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TotalFees: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FailedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBasePacketFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2132,26 +5692,62 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TotalBasePacketFee = append(x.TotalBasePacketFee, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalBasePacketFee[len(x.TotalBasePacketFee)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+				}
+				x.Attempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastAttemptAt", wireType)
+				}
+				x.LastAttemptAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastAttemptAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2187,86 +5783,82 @@ func (x *fastReflection_TotalFees) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Packet_3_list)(nil)
+var _ protoreflect.List = (*_Deposit_3_list)(nil)
 
-type _Packet_3_list struct {
-	list *[]*v1beta11.Price
+type _Deposit_3_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_Packet_3_list) Len() int {
+func (x *_Deposit_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Packet_3_list) Get(i int) protoreflect.Value {
+func (x *_Deposit_3_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Packet_3_list) Set(i int, value protoreflect.Value) {
+func (x *_Deposit_3_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Packet_3_list) Append(value protoreflect.Value) {
+func (x *_Deposit_3_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Packet_3_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta11.Price)
+func (x *_Deposit_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Packet_3_list) Truncate(n int) {
+func (x *_Deposit_3_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Packet_3_list) NewElement() protoreflect.Value {
-	v := new(v1beta11.Price)
+func (x *_Deposit_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Packet_3_list) IsValid() bool {
+func (x *_Deposit_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Packet            protoreflect.MessageDescriptor
-	fd_Packet_tunnel_id  protoreflect.FieldDescriptor
-	fd_Packet_sequence   protoreflect.FieldDescriptor
-	fd_Packet_prices     protoreflect.FieldDescriptor
-	fd_Packet_receipt    protoreflect.FieldDescriptor
-	fd_Packet_created_at protoreflect.FieldDescriptor
+	md_Deposit           protoreflect.MessageDescriptor
+	fd_Deposit_tunnel_id protoreflect.FieldDescriptor
+	fd_Deposit_depositor protoreflect.FieldDescriptor
+	fd_Deposit_amount    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_Packet = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Packet")
-	fd_Packet_tunnel_id = md_Packet.Fields().ByName("tunnel_id")
-	fd_Packet_sequence = md_Packet.Fields().ByName("sequence")
-	fd_Packet_prices = md_Packet.Fields().ByName("prices")
-	fd_Packet_receipt = md_Packet.Fields().ByName("receipt")
-	fd_Packet_created_at = md_Packet.Fields().ByName("created_at")
+	md_Deposit = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Deposit")
+	fd_Deposit_tunnel_id = md_Deposit.Fields().ByName("tunnel_id")
+	fd_Deposit_depositor = md_Deposit.Fields().ByName("depositor")
+	fd_Deposit_amount = md_Deposit.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_Packet)(nil)
+var _ protoreflect.Message = (*fastReflection_Deposit)(nil)
 
-type fastReflection_Packet Packet
+type fastReflection_Deposit Deposit
 
-func (x *Packet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Packet)(x)
+func (x *Deposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Deposit)(x)
 }
 
-func (x *Packet) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[3]
+func (x *Deposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2277,78 +5869,66 @@ func (x *Packet) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Packet_messageType fastReflection_Packet_messageType
-var _ protoreflect.MessageType = fastReflection_Packet_messageType{}
+var _fastReflection_Deposit_messageType fastReflection_Deposit_messageType
+var _ protoreflect.MessageType = fastReflection_Deposit_messageType{}
 
-type fastReflection_Packet_messageType struct{}
+type fastReflection_Deposit_messageType struct{}
 
-func (x fastReflection_Packet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Packet)(nil)
+func (x fastReflection_Deposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Deposit)(nil)
 }
-func (x fastReflection_Packet_messageType) New() protoreflect.Message {
-	return new(fastReflection_Packet)
+func (x fastReflection_Deposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_Deposit)
 }
-func (x fastReflection_Packet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Packet
+func (x fastReflection_Deposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Deposit
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Packet) Descriptor() protoreflect.MessageDescriptor {
-	return md_Packet
+func (x *fastReflection_Deposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_Deposit
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Packet) Type() protoreflect.MessageType {
-	return _fastReflection_Packet_messageType
+func (x *fastReflection_Deposit) Type() protoreflect.MessageType {
+	return _fastReflection_Deposit_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Packet) New() protoreflect.Message {
-	return new(fastReflection_Packet)
+func (x *fastReflection_Deposit) New() protoreflect.Message {
+	return new(fastReflection_Deposit)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Packet) Interface() protoreflect.ProtoMessage {
-	return (*Packet)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_Packet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TunnelId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TunnelId)
-		if !f(fd_Packet_tunnel_id, value) {
-			return
-		}
-	}
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_Packet_sequence, value) {
-			return
-		}
-	}
-	if len(x.Prices) != 0 {
-		value := protoreflect.ValueOfList(&_Packet_3_list{list: &x.Prices})
-		if !f(fd_Packet_prices, value) {
+func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
+	return (*Deposit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_Deposit_tunnel_id, value) {
 			return
 		}
 	}
-	if x.Receipt != nil {
-		value := protoreflect.ValueOfMessage(x.Receipt.ProtoReflect())
-		if !f(fd_Packet_receipt, value) {
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_Deposit_depositor, value) {
 			return
 		}
 	}
-	if x.CreatedAt != int64(0) {
-		value := protoreflect.ValueOfInt64(x.CreatedAt)
-		if !f(fd_Packet_created_at, value) {
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_Deposit_3_list{list: &x.Amount})
+		if !f(fd_Deposit_amount, value) {
 			return
 		}
 	}
@@ -2365,23 +5945,19 @@ func (x *fastReflection_Packet) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Packet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
 		return x.TunnelId != uint64(0)
-	case "band.tunnel.v1beta1.Packet.sequence":
-		return x.Sequence != uint64(0)
-	case "band.tunnel.v1beta1.Packet.prices":
-		return len(x.Prices) != 0
-	case "band.tunnel.v1beta1.Packet.receipt":
-		return x.Receipt != nil
-	case "band.tunnel.v1beta1.Packet.created_at":
-		return x.CreatedAt != int64(0)
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		return x.Depositor != ""
+	case "band.tunnel.v1beta1.Deposit.amount":
+		return len(x.Amount) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2391,23 +5967,19 @@ func (x *fastReflection_Packet) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Packet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
 		x.TunnelId = uint64(0)
-	case "band.tunnel.v1beta1.Packet.sequence":
-		x.Sequence = uint64(0)
-	case "band.tunnel.v1beta1.Packet.prices":
-		x.Prices = nil
-	case "band.tunnel.v1beta1.Packet.receipt":
-		x.Receipt = nil
-	case "band.tunnel.v1beta1.Packet.created_at":
-		x.CreatedAt = int64(0)
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		x.Depositor = ""
+	case "band.tunnel.v1beta1.Deposit.amount":
+		x.Amount = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2417,31 +5989,25 @@ func (x *fastReflection_Packet) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Packet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
 		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.Packet.sequence":
-		value := x.Sequence
-		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.Packet.prices":
-		if len(x.Prices) == 0 {
-			return protoreflect.ValueOfList(&_Packet_3_list{})
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.Deposit.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_Deposit_3_list{})
 		}
-		listValue := &_Packet_3_list{list: &x.Prices}
+		listValue := &_Deposit_3_list{list: &x.Amount}
 		return protoreflect.ValueOfList(listValue)
-	case "band.tunnel.v1beta1.Packet.receipt":
-		value := x.Receipt
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.tunnel.v1beta1.Packet.created_at":
-		value := x.CreatedAt
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2455,25 +6021,21 @@ func (x *fastReflection_Packet) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Packet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
 		x.TunnelId = value.Uint()
-	case "band.tunnel.v1beta1.Packet.sequence":
-		x.Sequence = value.Uint()
-	case "band.tunnel.v1beta1.Packet.prices":
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		x.Depositor = value.Interface().(string)
+	case "band.tunnel.v1beta1.Deposit.amount":
 		lv := value.List()
-		clv := lv.(*_Packet_3_list)
-		x.Prices = *clv.list
-	case "band.tunnel.v1beta1.Packet.receipt":
-		x.Receipt = value.Message().Interface().(*anypb.Any)
-	case "band.tunnel.v1beta1.Packet.created_at":
-		x.CreatedAt = value.Int()
+		clv := lv.(*_Deposit_3_list)
+		x.Amount = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2487,65 +6049,53 @@ func (x *fastReflection_Packet) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Packet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Deposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Packet.prices":
-		if x.Prices == nil {
-			x.Prices = []*v1beta11.Price{}
+	case "band.tunnel.v1beta1.Deposit.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
 		}
-		value := &_Packet_3_list{list: &x.Prices}
+		value := &_Deposit_3_list{list: &x.Amount}
 		return protoreflect.ValueOfList(value)
-	case "band.tunnel.v1beta1.Packet.receipt":
-		if x.Receipt == nil {
-			x.Receipt = new(anypb.Any)
-		}
-		return protoreflect.ValueOfMessage(x.Receipt.ProtoReflect())
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
-		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.Packet is not mutable"))
-	case "band.tunnel.v1beta1.Packet.sequence":
-		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.Packet is not mutable"))
-	case "band.tunnel.v1beta1.Packet.created_at":
-		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.Packet is not mutable"))
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.Deposit is not mutable"))
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		panic(fmt.Errorf("field depositor of message band.tunnel.v1beta1.Deposit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Packet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Deposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Packet.tunnel_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.Packet.sequence":
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.Packet.prices":
-		list := []*v1beta11.Price{}
-		return protoreflect.ValueOfList(&_Packet_3_list{list: &list})
-	case "band.tunnel.v1beta1.Packet.receipt":
-		m := new(anypb.Any)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.tunnel.v1beta1.Packet.created_at":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.Deposit.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Deposit_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Packet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Packet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Packet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Deposit", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2553,7 +6103,7 @@ func (x *fastReflection_Packet) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Packet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2564,7 +6114,7 @@ func (x *fastReflection_Packet) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Packet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2576,7 +6126,7 @@ func (x *fastReflection_Packet) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Packet) IsValid() bool {
+func (x *fastReflection_Deposit) IsValid() bool {
 	return x != nil
 }
 
@@ -2586,9 +6136,9 @@ func (x *fastReflection_Packet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Packet)
+		x := input.Message.Interface().(*Deposit)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2603,22 +6153,16 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 		if x.TunnelId != 0 {
 			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Prices) > 0 {
-			for _, e := range x.Prices {
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Receipt != nil {
-			l = options.Size(x.Receipt)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.CreatedAt != 0 {
-			n += 1 + runtime.Sov(uint64(x.CreatedAt))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2629,7 +6173,7 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Packet)
+		x := input.Message.Interface().(*Deposit)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2648,28 +6192,9 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.CreatedAt != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.Receipt != nil {
-			encoded, err := options.Marshal(x.Receipt)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Prices) > 0 {
-			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Prices[iNdEx])
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2683,10 +6208,12 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 				dAtA[i] = 0x1a
 			}
 		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
 		if x.TunnelId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
@@ -2704,7 +6231,7 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Packet)
+		x := input.Message.Interface().(*Deposit)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2736,10 +6263,10 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Packet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2761,30 +6288,11 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-				}
-				x.Sequence = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2794,29 +6302,27 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Prices = append(x.Prices, &v1beta11.Price{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2843,32 +6349,11 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Receipt == nil {
-					x.Receipt = &anypb.Any{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Receipt); err != nil {
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-				}
-				x.CreatedAt = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.CreatedAt |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2904,82 +6389,31 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Deposit_3_list)(nil)
-
-type _Deposit_3_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Deposit_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Deposit_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Deposit_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Deposit_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Deposit_3_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Deposit_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Deposit_3_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Deposit_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Deposit           protoreflect.MessageDescriptor
-	fd_Deposit_tunnel_id protoreflect.FieldDescriptor
-	fd_Deposit_depositor protoreflect.FieldDescriptor
-	fd_Deposit_amount    protoreflect.FieldDescriptor
+	md_SignalDeviation                    protoreflect.MessageDescriptor
+	fd_SignalDeviation_signal_id          protoreflect.FieldDescriptor
+	fd_SignalDeviation_soft_deviation_bps protoreflect.FieldDescriptor
+	fd_SignalDeviation_hard_deviation_bps protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_Deposit = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Deposit")
-	fd_Deposit_tunnel_id = md_Deposit.Fields().ByName("tunnel_id")
-	fd_Deposit_depositor = md_Deposit.Fields().ByName("depositor")
-	fd_Deposit_amount = md_Deposit.Fields().ByName("amount")
+	md_SignalDeviation = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("SignalDeviation")
+	fd_SignalDeviation_signal_id = md_SignalDeviation.Fields().ByName("signal_id")
+	fd_SignalDeviation_soft_deviation_bps = md_SignalDeviation.Fields().ByName("soft_deviation_bps")
+	fd_SignalDeviation_hard_deviation_bps = md_SignalDeviation.Fields().ByName("hard_deviation_bps")
 }
 
-var _ protoreflect.Message = (*fastReflection_Deposit)(nil)
+var _ protoreflect.Message = (*fastReflection_SignalDeviation)(nil)
 
-type fastReflection_Deposit Deposit
+type fastReflection_SignalDeviation SignalDeviation
 
-func (x *Deposit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Deposit)(x)
+func (x *SignalDeviation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignalDeviation)(x)
 }
 
-func (x *Deposit) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4]
+func (x *SignalDeviation) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2990,43 +6424,43 @@ func (x *Deposit) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Deposit_messageType fastReflection_Deposit_messageType
-var _ protoreflect.MessageType = fastReflection_Deposit_messageType{}
+var _fastReflection_SignalDeviation_messageType fastReflection_SignalDeviation_messageType
+var _ protoreflect.MessageType = fastReflection_SignalDeviation_messageType{}
 
-type fastReflection_Deposit_messageType struct{}
+type fastReflection_SignalDeviation_messageType struct{}
 
-func (x fastReflection_Deposit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Deposit)(nil)
+func (x fastReflection_SignalDeviation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignalDeviation)(nil)
 }
-func (x fastReflection_Deposit_messageType) New() protoreflect.Message {
-	return new(fastReflection_Deposit)
+func (x fastReflection_SignalDeviation_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignalDeviation)
 }
-func (x fastReflection_Deposit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Deposit
+func (x fastReflection_SignalDeviation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalDeviation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Deposit) Descriptor() protoreflect.MessageDescriptor {
-	return md_Deposit
+func (x *fastReflection_SignalDeviation) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalDeviation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Deposit) Type() protoreflect.MessageType {
-	return _fastReflection_Deposit_messageType
+func (x *fastReflection_SignalDeviation) Type() protoreflect.MessageType {
+	return _fastReflection_SignalDeviation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Deposit) New() protoreflect.Message {
-	return new(fastReflection_Deposit)
+func (x *fastReflection_SignalDeviation) New() protoreflect.Message {
+	return new(fastReflection_SignalDeviation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
-	return (*Deposit)(x)
+func (x *fastReflection_SignalDeviation) Interface() protoreflect.ProtoMessage {
+	return (*SignalDeviation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3034,22 +6468,22 @@ func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TunnelId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TunnelId)
-		if !f(fd_Deposit_tunnel_id, value) {
+func (x *fastReflection_SignalDeviation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_SignalDeviation_signal_id, value) {
 			return
 		}
 	}
-	if x.Depositor != "" {
-		value := protoreflect.ValueOfString(x.Depositor)
-		if !f(fd_Deposit_depositor, value) {
+	if x.SoftDeviationBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SoftDeviationBps)
+		if !f(fd_SignalDeviation_soft_deviation_bps, value) {
 			return
 		}
 	}
-	if len(x.Amount) != 0 {
-		value := protoreflect.ValueOfList(&_Deposit_3_list{list: &x.Amount})
-		if !f(fd_Deposit_amount, value) {
+	if x.HardDeviationBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HardDeviationBps)
+		if !f(fd_SignalDeviation_hard_deviation_bps, value) {
 			return
 		}
 	}
@@ -3066,19 +6500,19 @@ func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SignalDeviation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		return x.TunnelId != uint64(0)
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		return x.Depositor != ""
-	case "band.tunnel.v1beta1.Deposit.amount":
-		return len(x.Amount) != 0
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		return x.SignalId != ""
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		return x.SoftDeviationBps != uint64(0)
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		return x.HardDeviationBps != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3088,19 +6522,19 @@ func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SignalDeviation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		x.TunnelId = uint64(0)
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		x.Depositor = ""
-	case "band.tunnel.v1beta1.Deposit.amount":
-		x.Amount = nil
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		x.SignalId = ""
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		x.SoftDeviationBps = uint64(0)
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		x.HardDeviationBps = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3110,25 +6544,22 @@ func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalDeviation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		value := x.TunnelId
-		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		value := x.Depositor
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		value := x.SignalId
 		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.Deposit.amount":
-		if len(x.Amount) == 0 {
-			return protoreflect.ValueOfList(&_Deposit_3_list{})
-		}
-		listValue := &_Deposit_3_list{list: &x.Amount}
-		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		value := x.SoftDeviationBps
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		value := x.HardDeviationBps
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3142,21 +6573,19 @@ func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SignalDeviation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		x.TunnelId = value.Uint()
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		x.Depositor = value.Interface().(string)
-	case "band.tunnel.v1beta1.Deposit.amount":
-		lv := value.List()
-		clv := lv.(*_Deposit_3_list)
-		x.Amount = *clv.list
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		x.SoftDeviationBps = value.Uint()
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		x.HardDeviationBps = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3170,53 +6599,48 @@ func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalDeviation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.amount":
-		if x.Amount == nil {
-			x.Amount = []*v1beta1.Coin{}
-		}
-		value := &_Deposit_3_list{list: &x.Amount}
-		return protoreflect.ValueOfList(value)
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.Deposit is not mutable"))
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		panic(fmt.Errorf("field depositor of message band.tunnel.v1beta1.Deposit is not mutable"))
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		panic(fmt.Errorf("field soft_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		panic(fmt.Errorf("field hard_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Deposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SignalDeviation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.Deposit.depositor":
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
 		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.Deposit.amount":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Deposit_3_list{list: &list})
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SignalDeviation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Deposit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.SignalDeviation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3224,7 +6648,7 @@ func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SignalDeviation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3235,7 +6659,7 @@ func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SignalDeviation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3247,7 +6671,7 @@ func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Deposit) IsValid() bool {
+func (x *fastReflection_SignalDeviation) IsValid() bool {
 	return x != nil
 }
 
@@ -3257,9 +6681,9 @@ func (x *fastReflection_Deposit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*SignalDeviation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3271,18 +6695,15 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.TunnelId != 0 {
-			n += 1 + runtime.Sov(uint64(x.TunnelId))
-		}
-		l = len(x.Depositor)
+		l = len(x.SignalId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Amount) > 0 {
-			for _, e := range x.Amount {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.SoftDeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.SoftDeviationBps))
+		}
+		if x.HardDeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.HardDeviationBps))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3294,7 +6715,7 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*SignalDeviation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3313,33 +6734,22 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Amount[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
+		if x.HardDeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HardDeviationBps))
+			i--
+			dAtA[i] = 0x18
 		}
-		if len(x.Depositor) > 0 {
-			i -= len(x.Depositor)
-			copy(dAtA[i:], x.Depositor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+		if x.SoftDeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SoftDeviationBps))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.TunnelId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3352,7 +6762,7 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*SignalDeviation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3384,34 +6794,15 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
-				}
-				x.TunnelId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TunnelId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3439,13 +6830,13 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Depositor = string(dAtA[iNdEx:postIndex])
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SoftDeviationBps", wireType)
 				}
-				var msglen int
+				x.SoftDeviationBps = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3455,26 +6846,30 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.SoftDeviationBps |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HardDeviationBps", wireType)
 				}
-				x.Amount = append(x.Amount, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.HardDeviationBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HardDeviationBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3511,30 +6906,34 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SignalDeviation                    protoreflect.MessageDescriptor
-	fd_SignalDeviation_signal_id          protoreflect.FieldDescriptor
-	fd_SignalDeviation_soft_deviation_bps protoreflect.FieldDescriptor
-	fd_SignalDeviation_hard_deviation_bps protoreflect.FieldDescriptor
+	md_DerivedSignal                    protoreflect.MessageDescriptor
+	fd_DerivedSignal_signal_id          protoreflect.FieldDescriptor
+	fd_DerivedSignal_expression         protoreflect.FieldDescriptor
+	fd_DerivedSignal_soft_deviation_bps protoreflect.FieldDescriptor
+	fd_DerivedSignal_hard_deviation_bps protoreflect.FieldDescriptor
+	fd_DerivedSignal_twap_window        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_SignalDeviation = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("SignalDeviation")
-	fd_SignalDeviation_signal_id = md_SignalDeviation.Fields().ByName("signal_id")
-	fd_SignalDeviation_soft_deviation_bps = md_SignalDeviation.Fields().ByName("soft_deviation_bps")
-	fd_SignalDeviation_hard_deviation_bps = md_SignalDeviation.Fields().ByName("hard_deviation_bps")
+	md_DerivedSignal = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("DerivedSignal")
+	fd_DerivedSignal_signal_id = md_DerivedSignal.Fields().ByName("signal_id")
+	fd_DerivedSignal_expression = md_DerivedSignal.Fields().ByName("expression")
+	fd_DerivedSignal_soft_deviation_bps = md_DerivedSignal.Fields().ByName("soft_deviation_bps")
+	fd_DerivedSignal_hard_deviation_bps = md_DerivedSignal.Fields().ByName("hard_deviation_bps")
+	fd_DerivedSignal_twap_window = md_DerivedSignal.Fields().ByName("twap_window")
 }
 
-var _ protoreflect.Message = (*fastReflection_SignalDeviation)(nil)
+var _ protoreflect.Message = (*fastReflection_DerivedSignal)(nil)
 
-type fastReflection_SignalDeviation SignalDeviation
+type fastReflection_DerivedSignal DerivedSignal
 
-func (x *SignalDeviation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SignalDeviation)(x)
+func (x *DerivedSignal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DerivedSignal)(x)
 }
 
-func (x *SignalDeviation) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5]
+func (x *DerivedSignal) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3545,43 +6944,43 @@ func (x *SignalDeviation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SignalDeviation_messageType fastReflection_SignalDeviation_messageType
-var _ protoreflect.MessageType = fastReflection_SignalDeviation_messageType{}
+var _fastReflection_DerivedSignal_messageType fastReflection_DerivedSignal_messageType
+var _ protoreflect.MessageType = fastReflection_DerivedSignal_messageType{}
 
-type fastReflection_SignalDeviation_messageType struct{}
+type fastReflection_DerivedSignal_messageType struct{}
 
-func (x fastReflection_SignalDeviation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SignalDeviation)(nil)
+func (x fastReflection_DerivedSignal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DerivedSignal)(nil)
 }
-func (x fastReflection_SignalDeviation_messageType) New() protoreflect.Message {
-	return new(fastReflection_SignalDeviation)
+func (x fastReflection_DerivedSignal_messageType) New() protoreflect.Message {
+	return new(fastReflection_DerivedSignal)
 }
-func (x fastReflection_SignalDeviation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignalDeviation
+func (x fastReflection_DerivedSignal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DerivedSignal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SignalDeviation) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignalDeviation
+func (x *fastReflection_DerivedSignal) Descriptor() protoreflect.MessageDescriptor {
+	return md_DerivedSignal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SignalDeviation) Type() protoreflect.MessageType {
-	return _fastReflection_SignalDeviation_messageType
+func (x *fastReflection_DerivedSignal) Type() protoreflect.MessageType {
+	return _fastReflection_DerivedSignal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SignalDeviation) New() protoreflect.Message {
-	return new(fastReflection_SignalDeviation)
+func (x *fastReflection_DerivedSignal) New() protoreflect.Message {
+	return new(fastReflection_DerivedSignal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SignalDeviation) Interface() protoreflect.ProtoMessage {
-	return (*SignalDeviation)(x)
+func (x *fastReflection_DerivedSignal) Interface() protoreflect.ProtoMessage {
+	return (*DerivedSignal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3589,22 +6988,34 @@ func (x *fastReflection_SignalDeviation) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SignalDeviation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_DerivedSignal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.SignalId != "" {
 		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_SignalDeviation_signal_id, value) {
+		if !f(fd_DerivedSignal_signal_id, value) {
+			return
+		}
+	}
+	if x.Expression != "" {
+		value := protoreflect.ValueOfString(x.Expression)
+		if !f(fd_DerivedSignal_expression, value) {
 			return
 		}
 	}
 	if x.SoftDeviationBps != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.SoftDeviationBps)
-		if !f(fd_SignalDeviation_soft_deviation_bps, value) {
+		if !f(fd_DerivedSignal_soft_deviation_bps, value) {
 			return
 		}
 	}
 	if x.HardDeviationBps != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.HardDeviationBps)
-		if !f(fd_SignalDeviation_hard_deviation_bps, value) {
+		if !f(fd_DerivedSignal_hard_deviation_bps, value) {
+			return
+		}
+	}
+	if x.TwapWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TwapWindow)
+		if !f(fd_DerivedSignal_twap_window, value) {
 			return
 		}
 	}
@@ -3621,19 +7032,23 @@ func (x *fastReflection_SignalDeviation) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SignalDeviation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DerivedSignal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+	case "band.tunnel.v1beta1.DerivedSignal.signal_id":
 		return x.SignalId != ""
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+	case "band.tunnel.v1beta1.DerivedSignal.expression":
+		return x.Expression != ""
+	case "band.tunnel.v1beta1.DerivedSignal.soft_deviation_bps":
 		return x.SoftDeviationBps != uint64(0)
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+	case "band.tunnel.v1beta1.DerivedSignal.hard_deviation_bps":
 		return x.HardDeviationBps != uint64(0)
+	case "band.tunnel.v1beta1.DerivedSignal.twap_window":
+		return x.TwapWindow != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DerivedSignal"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DerivedSignal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3643,19 +7058,23 @@ func (x *fastReflection_SignalDeviation) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DerivedSignal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+	case "band.tunnel.v1beta1.DerivedSignal.signal_id":
 		x.SignalId = ""
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+	case "band.tunnel.v1beta1.DerivedSignal.expression":
+		x.Expression = ""
+	case "band.tunnel.v1beta1.DerivedSignal.soft_deviation_bps":
 		x.SoftDeviationBps = uint64(0)
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+	case "band.tunnel.v1beta1.DerivedSignal.hard_deviation_bps":
 		x.HardDeviationBps = uint64(0)
+	case "band.tunnel.v1beta1.DerivedSignal.twap_window":
+		x.TwapWindow = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DerivedSignal"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DerivedSignal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3665,22 +7084,28 @@ func (x *fastReflection_SignalDeviation) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SignalDeviation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DerivedSignal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+	case "band.tunnel.v1beta1.DerivedSignal.signal_id":
 		value := x.SignalId
 		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+	case "band.tunnel.v1beta1.DerivedSignal.expression":
+		value := x.Expression
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.DerivedSignal.soft_deviation_bps":
 		value := x.SoftDeviationBps
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+	case "band.tunnel.v1beta1.DerivedSignal.hard_deviation_bps":
 		value := x.HardDeviationBps
 		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.DerivedSignal.twap_window":
+		value := x.TwapWindow
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DerivedSignal"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DerivedSignal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3694,19 +7119,23 @@ func (x *fastReflection_SignalDeviation) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DerivedSignal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+	case "band.tunnel.v1beta1.DerivedSignal.signal_id":
 		x.SignalId = value.Interface().(string)
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+	case "band.tunnel.v1beta1.DerivedSignal.expression":
+		x.Expression = value.Interface().(string)
+	case "band.tunnel.v1beta1.DerivedSignal.soft_deviation_bps":
 		x.SoftDeviationBps = value.Uint()
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+	case "band.tunnel.v1beta1.DerivedSignal.hard_deviation_bps":
 		x.HardDeviationBps = value.Uint()
+	case "band.tunnel.v1beta1.DerivedSignal.twap_window":
+		x.TwapWindow = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DerivedSignal"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DerivedSignal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3716,52 +7145,60 @@ func (x *fastReflection_SignalDeviation) Set(fd protoreflect.FieldDescriptor, va
 // For a field belonging to a oneof, it implicitly clears any other field
 // that may be currently set within the same oneof.
 // For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
-		panic(fmt.Errorf("field soft_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
-		panic(fmt.Errorf("field hard_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DerivedSignal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.DerivedSignal.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.tunnel.v1beta1.DerivedSignal is not mutable"))
+	case "band.tunnel.v1beta1.DerivedSignal.expression":
+		panic(fmt.Errorf("field expression of message band.tunnel.v1beta1.DerivedSignal is not mutable"))
+	case "band.tunnel.v1beta1.DerivedSignal.soft_deviation_bps":
+		panic(fmt.Errorf("field soft_deviation_bps of message band.tunnel.v1beta1.DerivedSignal is not mutable"))
+	case "band.tunnel.v1beta1.DerivedSignal.hard_deviation_bps":
+		panic(fmt.Errorf("field hard_deviation_bps of message band.tunnel.v1beta1.DerivedSignal is not mutable"))
+	case "band.tunnel.v1beta1.DerivedSignal.twap_window":
+		panic(fmt.Errorf("field twap_window of message band.tunnel.v1beta1.DerivedSignal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DerivedSignal"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DerivedSignal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SignalDeviation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DerivedSignal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+	case "band.tunnel.v1beta1.DerivedSignal.signal_id":
 		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+	case "band.tunnel.v1beta1.DerivedSignal.expression":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.DerivedSignal.soft_deviation_bps":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+	case "band.tunnel.v1beta1.DerivedSignal.hard_deviation_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.DerivedSignal.twap_window":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DerivedSignal"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DerivedSignal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SignalDeviation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DerivedSignal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.SignalDeviation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.DerivedSignal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3769,7 +7206,7 @@ func (x *fastReflection_SignalDeviation) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SignalDeviation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DerivedSignal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3780,7 +7217,7 @@ func (x *fastReflection_SignalDeviation) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DerivedSignal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3792,7 +7229,7 @@ func (x *fastReflection_SignalDeviation) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SignalDeviation) IsValid() bool {
+func (x *fastReflection_DerivedSignal) IsValid() bool {
 	return x != nil
 }
 
@@ -3802,9 +7239,9 @@ func (x *fastReflection_SignalDeviation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DerivedSignal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SignalDeviation)
+		x := input.Message.Interface().(*DerivedSignal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3820,12 +7257,19 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Expression)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.SoftDeviationBps != 0 {
 			n += 1 + runtime.Sov(uint64(x.SoftDeviationBps))
 		}
 		if x.HardDeviationBps != 0 {
 			n += 1 + runtime.Sov(uint64(x.HardDeviationBps))
 		}
+		if x.TwapWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.TwapWindow))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3836,7 +7280,7 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SignalDeviation)
+		x := input.Message.Interface().(*DerivedSignal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3855,15 +7299,27 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.TwapWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TwapWindow))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.HardDeviationBps != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.HardDeviationBps))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x20
 		}
 		if x.SoftDeviationBps != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SoftDeviationBps))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x18
+		}
+		if len(x.Expression) > 0 {
+			i -= len(x.Expression)
+			copy(dAtA[i:], x.Expression)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Expression)))
+			i--
+			dAtA[i] = 0x12
 		}
 		if len(x.SignalId) > 0 {
 			i -= len(x.SignalId)
@@ -3883,7 +7339,7 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SignalDeviation)
+		x := input.Message.Interface().(*DerivedSignal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3915,10 +7371,10 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DerivedSignal: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DerivedSignal: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3954,6 +7410,38 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Expression = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SoftDeviationBps", wireType)
 				}
@@ -3972,7 +7460,7 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 3:
+			case 4:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HardDeviationBps", wireType)
 				}
@@ -3991,6 +7479,25 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+				}
+				x.TwapWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TwapWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4103,7 +7610,7 @@ func (x *TunnelSignatureOrder) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TunnelSignatureOrder) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6]
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4677,6 +8184,145 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TunnelPermission defines an action on the tunnel that the creator can grant to a co-administrator.
+type TunnelPermission int32
+
+const (
+	// TUNNEL_PERMISSION_UNSPECIFIED defines an unspecified permission.
+	TunnelPermission_TUNNEL_PERMISSION_UNSPECIFIED TunnelPermission = 0
+	// TUNNEL_PERMISSION_UPDATE_ROUTE allows updating the route of the tunnel.
+	TunnelPermission_TUNNEL_PERMISSION_UPDATE_ROUTE TunnelPermission = 1
+	// TUNNEL_PERMISSION_UPDATE_SIGNALS_AND_INTERVAL allows updating the signals and interval of the tunnel.
+	TunnelPermission_TUNNEL_PERMISSION_UPDATE_SIGNALS_AND_INTERVAL TunnelPermission = 2
+	// TUNNEL_PERMISSION_ACTIVATE allows activating the tunnel.
+	TunnelPermission_TUNNEL_PERMISSION_ACTIVATE TunnelPermission = 3
+	// TUNNEL_PERMISSION_DEACTIVATE allows deactivating the tunnel.
+	TunnelPermission_TUNNEL_PERMISSION_DEACTIVATE TunnelPermission = 4
+	// TUNNEL_PERMISSION_TRIGGER allows manually triggering the tunnel.
+	TunnelPermission_TUNNEL_PERMISSION_TRIGGER TunnelPermission = 5
+	// TUNNEL_PERMISSION_WITHDRAW_FEE_PAYER_FUNDS allows withdrawing the fee payer funds to the creator.
+	TunnelPermission_TUNNEL_PERMISSION_WITHDRAW_FEE_PAYER_FUNDS TunnelPermission = 6
+	// TUNNEL_PERMISSION_RETRY_PACKET allows resending failed packets of the tunnel.
+	TunnelPermission_TUNNEL_PERMISSION_RETRY_PACKET TunnelPermission = 7
+)
+
+// Enum value maps for TunnelPermission.
+var (
+	TunnelPermission_name = map[int32]string{
+		0: "TUNNEL_PERMISSION_UNSPECIFIED",
+		1: "TUNNEL_PERMISSION_UPDATE_ROUTE",
+		2: "TUNNEL_PERMISSION_UPDATE_SIGNALS_AND_INTERVAL",
+		3: "TUNNEL_PERMISSION_ACTIVATE",
+		4: "TUNNEL_PERMISSION_DEACTIVATE",
+		5: "TUNNEL_PERMISSION_TRIGGER",
+		6: "TUNNEL_PERMISSION_WITHDRAW_FEE_PAYER_FUNDS",
+		7: "TUNNEL_PERMISSION_RETRY_PACKET",
+	}
+	TunnelPermission_value = map[string]int32{
+		"TUNNEL_PERMISSION_UNSPECIFIED":                 0,
+		"TUNNEL_PERMISSION_UPDATE_ROUTE":                1,
+		"TUNNEL_PERMISSION_UPDATE_SIGNALS_AND_INTERVAL": 2,
+		"TUNNEL_PERMISSION_ACTIVATE":                    3,
+		"TUNNEL_PERMISSION_DEACTIVATE":                  4,
+		"TUNNEL_PERMISSION_TRIGGER":                     5,
+		"TUNNEL_PERMISSION_WITHDRAW_FEE_PAYER_FUNDS":    6,
+		"TUNNEL_PERMISSION_RETRY_PACKET":                7,
+	}
+)
+
+func (x TunnelPermission) Enum() *TunnelPermission {
+	p := new(TunnelPermission)
+	*p = x
+	return p
+}
+
+func (x TunnelPermission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TunnelPermission) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_tunnel_v1beta1_tunnel_proto_enumTypes[0].Descriptor()
+}
+
+func (TunnelPermission) Type() protoreflect.EnumType {
+	return &file_band_tunnel_v1beta1_tunnel_proto_enumTypes[0]
+}
+
+func (x TunnelPermission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TunnelPermission.Descriptor instead.
+func (TunnelPermission) EnumDescriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+// PacketStatus defines the delivery status of a packet.
+type PacketStatus int32
+
+const (
+	// PACKET_STATUS_UNSPECIFIED defines a packet whose delivery is not tracked by the route.
+	PacketStatus_PACKET_STATUS_UNSPECIFIED PacketStatus = 0
+	// PACKET_STATUS_PENDING defines a packet that is waiting for an acknowledgement or a timeout.
+	PacketStatus_PACKET_STATUS_PENDING PacketStatus = 1
+	// PACKET_STATUS_SUCCESS defines a packet that is acknowledged successfully by the counterparty chain.
+	PacketStatus_PACKET_STATUS_SUCCESS PacketStatus = 2
+	// PACKET_STATUS_ERROR defines a packet that is acknowledged with an error by the counterparty chain.
+	PacketStatus_PACKET_STATUS_ERROR PacketStatus = 3
+	// PACKET_STATUS_TIMEOUT defines a packet that is timed out before being received by the counterparty chain.
+	PacketStatus_PACKET_STATUS_TIMEOUT PacketStatus = 4
+	// PACKET_STATUS_FAILED defines a packet that the route failed to send, e.g. the signing request of
+	// a TSS packet cannot be created. The packet can be resent with MsgRetryPacket.
+	PacketStatus_PACKET_STATUS_FAILED PacketStatus = 5
+)
+
+// Enum value maps for PacketStatus.
+var (
+	PacketStatus_name = map[int32]string{
+		0: "PACKET_STATUS_UNSPECIFIED",
+		1: "PACKET_STATUS_PENDING",
+		2: "PACKET_STATUS_SUCCESS",
+		3: "PACKET_STATUS_ERROR",
+		4: "PACKET_STATUS_TIMEOUT",
+		5: "PACKET_STATUS_FAILED",
+	}
+	PacketStatus_value = map[string]int32{
+		"PACKET_STATUS_UNSPECIFIED": 0,
+		"PACKET_STATUS_PENDING":     1,
+		"PACKET_STATUS_SUCCESS":     2,
+		"PACKET_STATUS_ERROR":       3,
+		"PACKET_STATUS_TIMEOUT":     4,
+		"PACKET_STATUS_FAILED":      5,
+	}
+)
+
+func (x PacketStatus) Enum() *PacketStatus {
+	p := new(PacketStatus)
+	*p = x
+	return p
+}
+
+func (x PacketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PacketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_tunnel_v1beta1_tunnel_proto_enumTypes[1].Descriptor()
+}
+
+func (PacketStatus) Type() protoreflect.EnumType {
+	return &file_band_tunnel_v1beta1_tunnel_proto_enumTypes[1]
+}
+
+func (x PacketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PacketStatus.Descriptor instead.
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{1}
+}
+
 // Tunnel contains the information of the tunnel that is created by the user
 type Tunnel struct {
 	state         protoimpl.MessageState
@@ -4703,96 +8349,271 @@ type Tunnel struct {
 	CreatedAt int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// creator is the address of the creator
 	Creator string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schedule is the cron-like expression (minute hour day-of-month month day-of-week) evaluated
+	// against the block time in UTC for delivering all signal prices. It is disabled if empty.
+	Schedule string `protobuf:"bytes,11,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// derived_signals is the list of signals whose prices are derived from the feed prices
+	DerivedSignals []*DerivedSignal `protobuf:"bytes,12,rep,name=derived_signals,json=derivedSignals,proto3" json:"derived_signals,omitempty"`
+	// admins is the list of co-administrators of the tunnel and their permissions
+	Admins []*TunnelAdmin `protobuf:"bytes,13,rep,name=admins,proto3" json:"admins,omitempty"`
+	// funding_policy is the optional policy to automatically top up the fee payer from a funding account
+	FundingPolicy *FundingPolicy `protobuf:"bytes,14,opt,name=funding_policy,json=fundingPolicy,proto3" json:"funding_policy,omitempty"`
+	// include_price_confidence is the flag to include the confidence metadata of the feed prices in the packets
+	IncludePriceConfidence bool `protobuf:"varint,15,opt,name=include_price_confidence,json=includePriceConfidence,proto3" json:"include_price_confidence,omitempty"`
+	// pending_creator is the address proposed as the new creator; it becomes the creator once it accepts the ownership
+	PendingCreator string `protobuf:"bytes,16,opt,name=pending_creator,json=pendingCreator,proto3" json:"pending_creator,omitempty"`
+	// funding_source is the funding account approved by the creator to set the funding policy of the tunnel
+	FundingSource string `protobuf:"bytes,17,opt,name=funding_source,json=fundingSource,proto3" json:"funding_source,omitempty"`
+}
+
+func (x *Tunnel) Reset() {
+	*x = Tunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tunnel) ProtoMessage() {}
+
+// Deprecated: Use Tunnel.ProtoReflect.Descriptor instead.
+func (*Tunnel) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tunnel) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tunnel) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Tunnel) GetRoute() *anypb.Any {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *Tunnel) GetFeePayer() string {
+	if x != nil {
+		return x.FeePayer
+	}
+	return ""
+}
+
+func (x *Tunnel) GetSignalDeviations() []*SignalDeviation {
+	if x != nil {
+		return x.SignalDeviations
+	}
+	return nil
+}
+
+func (x *Tunnel) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Tunnel) GetTotalDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalDeposit
+	}
+	return nil
+}
+
+func (x *Tunnel) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Tunnel) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Tunnel) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Tunnel) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *Tunnel) GetDerivedSignals() []*DerivedSignal {
+	if x != nil {
+		return x.DerivedSignals
+	}
+	return nil
+}
+
+func (x *Tunnel) GetAdmins() []*TunnelAdmin {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *Tunnel) GetFundingPolicy() *FundingPolicy {
+	if x != nil {
+		return x.FundingPolicy
+	}
+	return nil
+}
+
+func (x *Tunnel) GetIncludePriceConfidence() bool {
+	if x != nil {
+		return x.IncludePriceConfidence
+	}
+	return false
+}
+
+func (x *Tunnel) GetPendingCreator() string {
+	if x != nil {
+		return x.PendingCreator
+	}
+	return ""
+}
+
+func (x *Tunnel) GetFundingSource() string {
+	if x != nil {
+		return x.FundingSource
+	}
+	return ""
 }
 
-func (x *Tunnel) Reset() {
-	*x = Tunnel{}
+// TunnelAdmin is a co-administrator of the tunnel with the permissions granted by the creator
+type TunnelAdmin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address of the co-administrator
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// permissions is the list of actions that the co-administrator can perform
+	Permissions []TunnelPermission `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=band.tunnel.v1beta1.TunnelPermission" json:"permissions,omitempty"`
+}
+
+func (x *TunnelAdmin) Reset() {
+	*x = TunnelAdmin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[0]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Tunnel) String() string {
+func (x *TunnelAdmin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tunnel) ProtoMessage() {}
+func (*TunnelAdmin) ProtoMessage() {}
 
-// Deprecated: Use Tunnel.ProtoReflect.Descriptor instead.
-func (*Tunnel) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use TunnelAdmin.ProtoReflect.Descriptor instead.
+func (*TunnelAdmin) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Tunnel) GetId() uint64 {
+func (x *TunnelAdmin) GetAddress() string {
 	if x != nil {
-		return x.Id
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *Tunnel) GetSequence() uint64 {
+func (x *TunnelAdmin) GetPermissions() []TunnelPermission {
 	if x != nil {
-		return x.Sequence
+		return x.Permissions
 	}
-	return 0
+	return nil
 }
 
-func (x *Tunnel) GetRoute() *anypb.Any {
-	if x != nil {
-		return x.Route
-	}
-	return nil
+// FundingPolicy is the allowance granted by a funding account to top up the fee payer of the tunnel
+// whenever the fee payer balance falls below the threshold
+type FundingPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// source is the address of the funding account
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// allowance is the remaining amount of coins that can be pulled from the funding account
+	Allowance []*v1beta1.Coin `protobuf:"bytes,2,rep,name=allowance,proto3" json:"allowance,omitempty"`
+	// threshold is the fee payer balance below which the fee payer is topped up
+	Threshold []*v1beta1.Coin `protobuf:"bytes,3,rep,name=threshold,proto3" json:"threshold,omitempty"`
+	// top_up_amount is the amount of coins sent to the fee payer on each top-up
+	TopUpAmount []*v1beta1.Coin `protobuf:"bytes,4,rep,name=top_up_amount,json=topUpAmount,proto3" json:"top_up_amount,omitempty"`
 }
 
-func (x *Tunnel) GetFeePayer() string {
-	if x != nil {
-		return x.FeePayer
+func (x *FundingPolicy) Reset() {
+	*x = FundingPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Tunnel) GetSignalDeviations() []*SignalDeviation {
-	if x != nil {
-		return x.SignalDeviations
-	}
-	return nil
+func (x *FundingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Tunnel) GetInterval() uint64 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
+func (*FundingPolicy) ProtoMessage() {}
+
+// Deprecated: Use FundingPolicy.ProtoReflect.Descriptor instead.
+func (*FundingPolicy) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Tunnel) GetTotalDeposit() []*v1beta1.Coin {
+func (x *FundingPolicy) GetSource() string {
 	if x != nil {
-		return x.TotalDeposit
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-func (x *Tunnel) GetIsActive() bool {
+func (x *FundingPolicy) GetAllowance() []*v1beta1.Coin {
 	if x != nil {
-		return x.IsActive
+		return x.Allowance
 	}
-	return false
+	return nil
 }
 
-func (x *Tunnel) GetCreatedAt() int64 {
+func (x *FundingPolicy) GetThreshold() []*v1beta1.Coin {
 	if x != nil {
-		return x.CreatedAt
+		return x.Threshold
 	}
-	return 0
+	return nil
 }
 
-func (x *Tunnel) GetCreator() string {
+func (x *FundingPolicy) GetTopUpAmount() []*v1beta1.Coin {
 	if x != nil {
-		return x.Creator
+		return x.TopUpAmount
 	}
-	return ""
+	return nil
 }
 
 // LatestPrices is the type for prices that tunnel produces
@@ -4807,12 +8628,14 @@ type LatestPrices struct {
 	Prices []*v1beta11.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
 	// last_interval is the last interval when the signal prices are produced by interval trigger
 	LastInterval int64 `protobuf:"varint,3,opt,name=last_interval,json=lastInterval,proto3" json:"last_interval,omitempty"`
+	// last_schedule is the last time when the signal prices are produced by schedule trigger
+	LastSchedule int64 `protobuf:"varint,4,opt,name=last_schedule,json=lastSchedule,proto3" json:"last_schedule,omitempty"`
 }
 
 func (x *LatestPrices) Reset() {
 	*x = LatestPrices{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[1]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4826,7 +8649,7 @@ func (*LatestPrices) ProtoMessage() {}
 
 // Deprecated: Use LatestPrices.ProtoReflect.Descriptor instead.
 func (*LatestPrices) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{1}
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *LatestPrices) GetTunnelId() uint64 {
@@ -4850,6 +8673,13 @@ func (x *LatestPrices) GetLastInterval() int64 {
 	return 0
 }
 
+func (x *LatestPrices) GetLastSchedule() int64 {
+	if x != nil {
+		return x.LastSchedule
+	}
+	return 0
+}
+
 // TotalFees is the type for the total fees collected by the tunnel
 type TotalFees struct {
 	state         protoimpl.MessageState
@@ -4863,7 +8693,7 @@ type TotalFees struct {
 func (x *TotalFees) Reset() {
 	*x = TotalFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[2]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4877,7 +8707,7 @@ func (*TotalFees) ProtoMessage() {}
 
 // Deprecated: Use TotalFees.ProtoReflect.Descriptor instead.
 func (*TotalFees) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{2}
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TotalFees) GetTotalBasePacketFee() []*v1beta1.Coin {
@@ -4903,12 +8733,16 @@ type Packet struct {
 	Receipt *anypb.Any `protobuf:"bytes,4,opt,name=receipt,proto3" json:"receipt,omitempty"`
 	// created_at is the timestamp when the packet is created
 	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// base_fee is the base packet fee charged for the packet.
+	BaseFee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// status is the delivery status of the packet on the destination route.
+	Status PacketStatus `protobuf:"varint,7,opt,name=status,proto3,enum=band.tunnel.v1beta1.PacketStatus" json:"status,omitempty"`
 }
 
 func (x *Packet) Reset() {
 	*x = Packet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[3]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4922,7 +8756,7 @@ func (*Packet) ProtoMessage() {}
 
 // Deprecated: Use Packet.ProtoReflect.Descriptor instead.
 func (*Packet) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{3}
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Packet) GetTunnelId() uint64 {
@@ -4960,6 +8794,93 @@ func (x *Packet) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *Packet) GetBaseFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.BaseFee
+	}
+	return nil
+}
+
+func (x *Packet) GetStatus() PacketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PacketStatus_PACKET_STATUS_UNSPECIFIED
+}
+
+// FailedPacket is the record of a packet that failed to be sent to the destination route.
+type FailedPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the tunnel ID
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the failed packet.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// reason is the error returned by the route on the latest attempt.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// attempts is the number of times the packet has been tried to be sent.
+	Attempts uint64 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// last_attempt_at is the timestamp of the latest attempt.
+	LastAttemptAt int64 `protobuf:"varint,5,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+}
+
+func (x *FailedPacket) Reset() {
+	*x = FailedPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailedPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedPacket) ProtoMessage() {}
+
+// Deprecated: Use FailedPacket.ProtoReflect.Descriptor instead.
+func (*FailedPacket) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FailedPacket) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *FailedPacket) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *FailedPacket) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FailedPacket) GetAttempts() uint64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *FailedPacket) GetLastAttemptAt() int64 {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return 0
+}
+
 // Deposit defines an amount deposited by an account address to the tunnel.
 type Deposit struct {
 	state         protoimpl.MessageState
@@ -4977,7 +8898,7 @@ type Deposit struct {
 func (x *Deposit) Reset() {
 	*x = Deposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4991,7 +8912,7 @@ func (*Deposit) ProtoMessage() {}
 
 // Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{4}
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Deposit) GetTunnelId() uint64 {
@@ -5032,7 +8953,7 @@ type SignalDeviation struct {
 func (x *SignalDeviation) Reset() {
 	*x = SignalDeviation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5046,7 +8967,7 @@ func (*SignalDeviation) ProtoMessage() {}
 
 // Deprecated: Use SignalDeviation.ProtoReflect.Descriptor instead.
 func (*SignalDeviation) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{5}
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SignalDeviation) GetSignalId() string {
@@ -5070,6 +8991,80 @@ func (x *SignalDeviation) GetHardDeviationBps() uint64 {
 	return 0
 }
 
+// DerivedSignal is the type for a signal whose price is calculated from the prices of feed signals
+type DerivedSignal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the signal ID of the derived signal
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// expression is the arithmetic expression over feed signal IDs, e.g. "CS:ETH-USD / CS:BTC-USD"
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// soft_deviation_bps is the soft deviation in basis points
+	SoftDeviationBps uint64 `protobuf:"varint,3,opt,name=soft_deviation_bps,json=softDeviationBps,proto3" json:"soft_deviation_bps,omitempty"`
+	// hard_deviation_bps is the hard deviation in basis points
+	HardDeviationBps uint64 `protobuf:"varint,4,opt,name=hard_deviation_bps,json=hardDeviationBps,proto3" json:"hard_deviation_bps,omitempty"`
+	// twap_window is the duration in seconds of the time-weighted average of the source feed prices;
+	// zero uses the latest feed prices
+	TwapWindow uint64 `protobuf:"varint,5,opt,name=twap_window,json=twapWindow,proto3" json:"twap_window,omitempty"`
+}
+
+func (x *DerivedSignal) Reset() {
+	*x = DerivedSignal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DerivedSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivedSignal) ProtoMessage() {}
+
+// Deprecated: Use DerivedSignal.ProtoReflect.Descriptor instead.
+func (*DerivedSignal) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DerivedSignal) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *DerivedSignal) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *DerivedSignal) GetSoftDeviationBps() uint64 {
+	if x != nil {
+		return x.SoftDeviationBps
+	}
+	return 0
+}
+
+func (x *DerivedSignal) GetHardDeviationBps() uint64 {
+	if x != nil {
+		return x.HardDeviationBps
+	}
+	return 0
+}
+
+func (x *DerivedSignal) GetTwapWindow() uint64 {
+	if x != nil {
+		return x.TwapWindow
+	}
+	return 0
+}
+
 // TunnelSignatureOrder defines a general signature order for sending signature to tss group.
 type TunnelSignatureOrder struct {
 	state         protoimpl.MessageState
@@ -5089,7 +9084,7 @@ type TunnelSignatureOrder struct {
 func (x *TunnelSignatureOrder) Reset() {
 	*x = TunnelSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5103,7 +9098,7 @@ func (*TunnelSignatureOrder) ProtoMessage() {}
 
 // Deprecated: Use TunnelSignatureOrder.ProtoReflect.Descriptor instead.
 func (*TunnelSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{6}
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TunnelSignatureOrder) GetSequence() uint64 {
@@ -5152,7 +9147,7 @@ var file_band_tunnel_v1beta1_tunnel_proto_rawDesc = []byte{
 	0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
 	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66,
 	0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x04, 0x0a, 0x06, 0x54, 0x75, 0x6e,
+	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x07, 0x0a, 0x06, 0x54, 0x75, 0x6e,
 	0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
 	0x06, 0xe2, 0xde, 0x1f, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
 	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
@@ -5185,17 +9180,82 @@ var file_band_tunnel_v1beta1_tunnel_proto_rawDesc = []byte{
 	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
 	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9d, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde,
-	0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a,
-	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x74,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x51,
+	0x0a, 0x0f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x73, 0x12, 0x3e, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x73, 0x12, 0x49, 0x0a, 0x0e, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x66,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x38, 0x0a, 0x18,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x0e, 0x66, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x66, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0x90, 0x01, 0x0a, 0x0b, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0x9d, 0x03, 0x0a, 0x0d, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x6e, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x6e, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x74, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x5f, 0x75,
+	0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0b, 0x74, 0x6f, 0x70, 0x55, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0xc2, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x37, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x23, 0x0a,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x74,
 	0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x7e, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
 	0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
@@ -5204,7 +9264,7 @@ var file_band_tunnel_v1beta1_tunnel_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
 	0x6e, 0x73, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xeb, 0x01, 0x0a,
+	0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8e, 0x03, 0x0a,
 	0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65,
 	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08,
 	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
@@ -5219,61 +9279,133 @@ var file_band_tunnel_v1beta1_tunnel_proto_rawDesc = []byte{
 	0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
 	0x74, 0x49, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x07, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x64, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xca, 0x01, 0x0a, 0x0f, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x12, 0x73, 0x6f, 0x66, 0x74,
-	0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65,
-	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10, 0x73, 0x6f, 0x66, 0x74,
-	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x42, 0x0a, 0x12,
-	0x68, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
-	0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x48, 0x61,
-	0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10,
-	0x68, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc7, 0x01, 0x0a, 0x14, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
-	0x42, 0xe0, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64,
-	0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca,
-	0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa8,
-	0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x66, 0x0a, 0x08, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46,
+	0x65, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb7, 0x01,
+	0x0a, 0x0c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x29,
+	0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52,
+	0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41,
+	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdc, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x36,
+	0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xca, 0x01, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x12, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10, 0x73, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x42, 0x0a, 0x12, 0x68, 0x61, 0x72,
+	0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x48, 0x61, 0x72, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10, 0x68, 0x61, 0x72,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0x99, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x42, 0x0a, 0x12, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde,
+	0x1f, 0x10, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x50, 0x53, 0x52, 0x10, 0x73, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x70, 0x73, 0x12, 0x42, 0x0a, 0x12, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x48, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10, 0x68, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x74, 0x77, 0x61, 0x70,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe2,
+	0xde, 0x1f, 0x0a, 0x54, 0x57, 0x41, 0x50, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0a, 0x74,
+	0x77, 0x61, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0xc7, 0x01, 0x0a, 0x14, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x07,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x2a, 0xc7, 0x02, 0x0a, 0x10, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x1d, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f,
+	0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x31, 0x0a, 0x2d, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x53, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x55, 0x4e, 0x4e,
+	0x45, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x55, 0x4e, 0x4e,
+	0x45, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x55,
+	0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x10, 0x05, 0x12, 0x2e, 0x0a, 0x2a, 0x54, 0x55, 0x4e,
+	0x4e, 0x45, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x50, 0x41, 0x59, 0x45,
+	0x52, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x55, 0x4e,
+	0x4e, 0x45, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x54, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x07, 0x1a, 0x04, 0x88,
+	0xa3, 0x1e, 0x00, 0x2a, 0xb7, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19,
+	0x0a, 0x15, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x43,
+	0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x12, 0x18, 0x0a,
+	0x14, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xe0, 0x01,
+	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62,
+	0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42,
+	0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5288,36 +9420,52 @@ func file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP() []byte {
 	return file_band_tunnel_v1beta1_tunnel_proto_rawDescData
 }
 
-var file_band_tunnel_v1beta1_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_band_tunnel_v1beta1_tunnel_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_band_tunnel_v1beta1_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_band_tunnel_v1beta1_tunnel_proto_goTypes = []interface{}{
-	(*Tunnel)(nil),               // 0: band.tunnel.v1beta1.Tunnel
-	(*LatestPrices)(nil),         // 1: band.tunnel.v1beta1.LatestPrices
-	(*TotalFees)(nil),            // 2: band.tunnel.v1beta1.TotalFees
-	(*Packet)(nil),               // 3: band.tunnel.v1beta1.Packet
-	(*Deposit)(nil),              // 4: band.tunnel.v1beta1.Deposit
-	(*SignalDeviation)(nil),      // 5: band.tunnel.v1beta1.SignalDeviation
-	(*TunnelSignatureOrder)(nil), // 6: band.tunnel.v1beta1.TunnelSignatureOrder
-	(*anypb.Any)(nil),            // 7: google.protobuf.Any
-	(*v1beta1.Coin)(nil),         // 8: cosmos.base.v1beta1.Coin
-	(*v1beta11.Price)(nil),       // 9: band.feeds.v1beta1.Price
-	(v1beta11.Encoder)(0),        // 10: band.feeds.v1beta1.Encoder
+	(TunnelPermission)(0),        // 0: band.tunnel.v1beta1.TunnelPermission
+	(PacketStatus)(0),            // 1: band.tunnel.v1beta1.PacketStatus
+	(*Tunnel)(nil),               // 2: band.tunnel.v1beta1.Tunnel
+	(*TunnelAdmin)(nil),          // 3: band.tunnel.v1beta1.TunnelAdmin
+	(*FundingPolicy)(nil),        // 4: band.tunnel.v1beta1.FundingPolicy
+	(*LatestPrices)(nil),         // 5: band.tunnel.v1beta1.LatestPrices
+	(*TotalFees)(nil),            // 6: band.tunnel.v1beta1.TotalFees
+	(*Packet)(nil),               // 7: band.tunnel.v1beta1.Packet
+	(*FailedPacket)(nil),         // 8: band.tunnel.v1beta1.FailedPacket
+	(*Deposit)(nil),              // 9: band.tunnel.v1beta1.Deposit
+	(*SignalDeviation)(nil),      // 10: band.tunnel.v1beta1.SignalDeviation
+	(*DerivedSignal)(nil),        // 11: band.tunnel.v1beta1.DerivedSignal
+	(*TunnelSignatureOrder)(nil), // 12: band.tunnel.v1beta1.TunnelSignatureOrder
+	(*anypb.Any)(nil),            // 13: google.protobuf.Any
+	(*v1beta1.Coin)(nil),         // 14: cosmos.base.v1beta1.Coin
+	(*v1beta11.Price)(nil),       // 15: band.feeds.v1beta1.Price
+	(v1beta11.Encoder)(0),        // 16: band.feeds.v1beta1.Encoder
 }
 var file_band_tunnel_v1beta1_tunnel_proto_depIdxs = []int32{
-	7,  // 0: band.tunnel.v1beta1.Tunnel.route:type_name -> google.protobuf.Any
-	5,  // 1: band.tunnel.v1beta1.Tunnel.signal_deviations:type_name -> band.tunnel.v1beta1.SignalDeviation
-	8,  // 2: band.tunnel.v1beta1.Tunnel.total_deposit:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 3: band.tunnel.v1beta1.LatestPrices.prices:type_name -> band.feeds.v1beta1.Price
-	8,  // 4: band.tunnel.v1beta1.TotalFees.total_base_packet_fee:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 5: band.tunnel.v1beta1.Packet.prices:type_name -> band.feeds.v1beta1.Price
-	7,  // 6: band.tunnel.v1beta1.Packet.receipt:type_name -> google.protobuf.Any
-	8,  // 7: band.tunnel.v1beta1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 8: band.tunnel.v1beta1.TunnelSignatureOrder.prices:type_name -> band.feeds.v1beta1.Price
-	10, // 9: band.tunnel.v1beta1.TunnelSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	13, // 0: band.tunnel.v1beta1.Tunnel.route:type_name -> google.protobuf.Any
+	10, // 1: band.tunnel.v1beta1.Tunnel.signal_deviations:type_name -> band.tunnel.v1beta1.SignalDeviation
+	14, // 2: band.tunnel.v1beta1.Tunnel.total_deposit:type_name -> cosmos.base.v1beta1.Coin
+	11, // 3: band.tunnel.v1beta1.Tunnel.derived_signals:type_name -> band.tunnel.v1beta1.DerivedSignal
+	3,  // 4: band.tunnel.v1beta1.Tunnel.admins:type_name -> band.tunnel.v1beta1.TunnelAdmin
+	4,  // 5: band.tunnel.v1beta1.Tunnel.funding_policy:type_name -> band.tunnel.v1beta1.FundingPolicy
+	0,  // 6: band.tunnel.v1beta1.TunnelAdmin.permissions:type_name -> band.tunnel.v1beta1.TunnelPermission
+	14, // 7: band.tunnel.v1beta1.FundingPolicy.allowance:type_name -> cosmos.base.v1beta1.Coin
+	14, // 8: band.tunnel.v1beta1.FundingPolicy.threshold:type_name -> cosmos.base.v1beta1.Coin
+	14, // 9: band.tunnel.v1beta1.FundingPolicy.top_up_amount:type_name -> cosmos.base.v1beta1.Coin
+	15, // 10: band.tunnel.v1beta1.LatestPrices.prices:type_name -> band.feeds.v1beta1.Price
+	14, // 11: band.tunnel.v1beta1.TotalFees.total_base_packet_fee:type_name -> cosmos.base.v1beta1.Coin
+	15, // 12: band.tunnel.v1beta1.Packet.prices:type_name -> band.feeds.v1beta1.Price
+	13, // 13: band.tunnel.v1beta1.Packet.receipt:type_name -> google.protobuf.Any
+	14, // 14: band.tunnel.v1beta1.Packet.base_fee:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 15: band.tunnel.v1beta1.Packet.status:type_name -> band.tunnel.v1beta1.PacketStatus
+	14, // 16: band.tunnel.v1beta1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
+	15, // 17: band.tunnel.v1beta1.TunnelSignatureOrder.prices:type_name -> band.feeds.v1beta1.Price
+	16, // 18: band.tunnel.v1beta1.TunnelSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_band_tunnel_v1beta1_tunnel_proto_init() }
@@ -5339,7 +9487,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatestPrices); i {
+			switch v := v.(*TunnelAdmin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5351,7 +9499,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TotalFees); i {
+			switch v := v.(*FundingPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5363,7 +9511,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Packet); i {
+			switch v := v.(*LatestPrices); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5375,7 +9523,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Deposit); i {
+			switch v := v.(*TotalFees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5387,7 +9535,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignalDeviation); i {
+			switch v := v.(*Packet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5399,6 +9547,54 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailedPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalDeviation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DerivedSignal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TunnelSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -5416,13 +9612,14 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_tunnel_v1beta1_tunnel_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_band_tunnel_v1beta1_tunnel_proto_goTypes,
 		DependencyIndexes: file_band_tunnel_v1beta1_tunnel_proto_depIdxs,
+		EnumInfos:         file_band_tunnel_v1beta1_tunnel_proto_enumTypes,
 		MessageInfos:      file_band_tunnel_v1beta1_tunnel_proto_msgTypes,
 	}.Build()
 	File_band_tunnel_v1beta1_tunnel_proto = out.File
@@ -120,13 +120,67 @@ func (x *_MsgCreateTunnel_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_MsgCreateTunnel_7_list)(nil)
+
+type _MsgCreateTunnel_7_list struct {
+	list *[]*DerivedSignal
+}
+
+func (x *_MsgCreateTunnel_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreateTunnel_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreateTunnel_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DerivedSignal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateTunnel_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DerivedSignal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateTunnel_7_list) AppendMutable() protoreflect.Value {
+	v := new(DerivedSignal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateTunnel_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateTunnel_7_list) NewElement() protoreflect.Value {
+	v := new(DerivedSignal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateTunnel_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgCreateTunnel                   protoreflect.MessageDescriptor
-	fd_MsgCreateTunnel_signal_deviations protoreflect.FieldDescriptor
-	fd_MsgCreateTunnel_interval          protoreflect.FieldDescriptor
-	fd_MsgCreateTunnel_route             protoreflect.FieldDescriptor
-	fd_MsgCreateTunnel_initial_deposit   protoreflect.FieldDescriptor
-	fd_MsgCreateTunnel_creator           protoreflect.FieldDescriptor
+	md_MsgCreateTunnel                          protoreflect.MessageDescriptor
+	fd_MsgCreateTunnel_signal_deviations        protoreflect.FieldDescriptor
+	fd_MsgCreateTunnel_interval                 protoreflect.FieldDescriptor
+	fd_MsgCreateTunnel_route                    protoreflect.FieldDescriptor
+	fd_MsgCreateTunnel_initial_deposit          protoreflect.FieldDescriptor
+	fd_MsgCreateTunnel_creator                  protoreflect.FieldDescriptor
+	fd_MsgCreateTunnel_schedule                 protoreflect.FieldDescriptor
+	fd_MsgCreateTunnel_derived_signals          protoreflect.FieldDescriptor
+	fd_MsgCreateTunnel_include_price_confidence protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -137,6 +191,9 @@ func init() {
 	fd_MsgCreateTunnel_route = md_MsgCreateTunnel.Fields().ByName("route")
 	fd_MsgCreateTunnel_initial_deposit = md_MsgCreateTunnel.Fields().ByName("initial_deposit")
 	fd_MsgCreateTunnel_creator = md_MsgCreateTunnel.Fields().ByName("creator")
+	fd_MsgCreateTunnel_schedule = md_MsgCreateTunnel.Fields().ByName("schedule")
+	fd_MsgCreateTunnel_derived_signals = md_MsgCreateTunnel.Fields().ByName("derived_signals")
+	fd_MsgCreateTunnel_include_price_confidence = md_MsgCreateTunnel.Fields().ByName("include_price_confidence")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateTunnel)(nil)
@@ -234,6 +291,24 @@ func (x *fastReflection_MsgCreateTunnel) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.Schedule != "" {
+		value := protoreflect.ValueOfString(x.Schedule)
+		if !f(fd_MsgCreateTunnel_schedule, value) {
+			return
+		}
+	}
+	if len(x.DerivedSignals) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateTunnel_7_list{list: &x.DerivedSignals})
+		if !f(fd_MsgCreateTunnel_derived_signals, value) {
+			return
+		}
+	}
+	if x.IncludePriceConfidence != false {
+		value := protoreflect.ValueOfBool(x.IncludePriceConfidence)
+		if !f(fd_MsgCreateTunnel_include_price_confidence, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -259,6 +334,12 @@ func (x *fastReflection_MsgCreateTunnel) Has(fd protoreflect.FieldDescriptor) bo
 		return len(x.InitialDeposit) != 0
 	case "band.tunnel.v1beta1.MsgCreateTunnel.creator":
 		return x.Creator != ""
+	case "band.tunnel.v1beta1.MsgCreateTunnel.schedule":
+		return x.Schedule != ""
+	case "band.tunnel.v1beta1.MsgCreateTunnel.derived_signals":
+		return len(x.DerivedSignals) != 0
+	case "band.tunnel.v1beta1.MsgCreateTunnel.include_price_confidence":
+		return x.IncludePriceConfidence != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgCreateTunnel"))
@@ -285,6 +366,12 @@ func (x *fastReflection_MsgCreateTunnel) Clear(fd protoreflect.FieldDescriptor)
 		x.InitialDeposit = nil
 	case "band.tunnel.v1beta1.MsgCreateTunnel.creator":
 		x.Creator = ""
+	case "band.tunnel.v1beta1.MsgCreateTunnel.schedule":
+		x.Schedule = ""
+	case "band.tunnel.v1beta1.MsgCreateTunnel.derived_signals":
+		x.DerivedSignals = nil
+	case "band.tunnel.v1beta1.MsgCreateTunnel.include_price_confidence":
+		x.IncludePriceConfidence = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgCreateTunnel"))
@@ -322,6 +409,18 @@ func (x *fastReflection_MsgCreateTunnel) Get(descriptor protoreflect.FieldDescri
 	case "band.tunnel.v1beta1.MsgCreateTunnel.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.MsgCreateTunnel.schedule":
+		value := x.Schedule
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.MsgCreateTunnel.derived_signals":
+		if len(x.DerivedSignals) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateTunnel_7_list{})
+		}
+		listValue := &_MsgCreateTunnel_7_list{list: &x.DerivedSignals}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.MsgCreateTunnel.include_price_confidence":
+		value := x.IncludePriceConfidence
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgCreateTunnel"))
@@ -356,6 +455,14 @@ func (x *fastReflection_MsgCreateTunnel) Set(fd protoreflect.FieldDescriptor, va
 		x.InitialDeposit = *clv.list
 	case "band.tunnel.v1beta1.MsgCreateTunnel.creator":
 		x.Creator = value.Interface().(string)
+	case "band.tunnel.v1beta1.MsgCreateTunnel.schedule":
+		x.Schedule = value.Interface().(string)
+	case "band.tunnel.v1beta1.MsgCreateTunnel.derived_signals":
+		lv := value.List()
+		clv := lv.(*_MsgCreateTunnel_7_list)
+		x.DerivedSignals = *clv.list
+	case "band.tunnel.v1beta1.MsgCreateTunnel.include_price_confidence":
+		x.IncludePriceConfidence = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgCreateTunnel"))
@@ -393,10 +500,20 @@ func (x *fastReflection_MsgCreateTunnel) Mutable(fd protoreflect.FieldDescriptor
 		}
 		value := &_MsgCreateTunnel_4_list{list: &x.InitialDeposit}
 		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.MsgCreateTunnel.derived_signals":
+		if x.DerivedSignals == nil {
+			x.DerivedSignals = []*DerivedSignal{}
+		}
+		value := &_MsgCreateTunnel_7_list{list: &x.DerivedSignals}
+		return protoreflect.ValueOfList(value)
 	case "band.tunnel.v1beta1.MsgCreateTunnel.interval":
 		panic(fmt.Errorf("field interval of message band.tunnel.v1beta1.MsgCreateTunnel is not mutable"))
 	case "band.tunnel.v1beta1.MsgCreateTunnel.creator":
 		panic(fmt.Errorf("field creator of message band.tunnel.v1beta1.MsgCreateTunnel is not mutable"))
+	case "band.tunnel.v1beta1.MsgCreateTunnel.schedule":
+		panic(fmt.Errorf("field schedule of message band.tunnel.v1beta1.MsgCreateTunnel is not mutable"))
+	case "band.tunnel.v1beta1.MsgCreateTunnel.include_price_confidence":
+		panic(fmt.Errorf("field include_price_confidence of message band.tunnel.v1beta1.MsgCreateTunnel is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgCreateTunnel"))
@@ -423,6 +540,13 @@ func (x *fastReflection_MsgCreateTunnel) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfList(&_MsgCreateTunnel_4_list{list: &list})
 	case "band.tunnel.v1beta1.MsgCreateTunnel.creator":
 		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.MsgCreateTunnel.schedule":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.MsgCreateTunnel.derived_signals":
+		list := []*DerivedSignal{}
+		return protoreflect.ValueOfList(&_MsgCreateTunnel_7_list{list: &list})
+	case "band.tunnel.v1beta1.MsgCreateTunnel.include_price_confidence":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgCreateTunnel"))
@@ -515,6 +639,19 @@ func (x *fastReflection_MsgCreateTunnel) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Schedule)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DerivedSignals) > 0 {
+			for _, e := range x.DerivedSignals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.IncludePriceConfidence {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -544,6 +681,39 @@ func (x *fastReflection_MsgCreateTunnel) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.IncludePriceConfidence {
+			i--
+			if x.IncludePriceConfidence {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.DerivedSignals) > 0 {
+			for iNdEx := len(x.DerivedSignals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DerivedSignals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Schedule) > 0 {
+			i -= len(x.Schedule)
+			copy(dAtA[i:], x.Schedule)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schedule)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if len(x.Creator) > 0 {
 			i -= len(x.Creator)
 			copy(dAtA[i:], x.Creator)
@@ -806,6 +976,92 @@ func (x *fastReflection_MsgCreateTunnel) ProtoMethods() *protoiface.Methods {
 				}
 				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schedule = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DerivedSignals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DerivedSignals = append(x.DerivedSignals, &DerivedSignal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DerivedSignals[len(x.DerivedSignals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludePriceConfidence", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IncludePriceConfidence = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2199,12 +2455,66 @@ func (x *_MsgUpdateSignalsAndInterval_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_MsgUpdateSignalsAndInterval_6_list)(nil)
+
+type _MsgUpdateSignalsAndInterval_6_list struct {
+	list *[]*DerivedSignal
+}
+
+func (x *_MsgUpdateSignalsAndInterval_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateSignalsAndInterval_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdateSignalsAndInterval_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DerivedSignal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateSignalsAndInterval_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DerivedSignal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateSignalsAndInterval_6_list) AppendMutable() protoreflect.Value {
+	v := new(DerivedSignal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateSignalsAndInterval_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateSignalsAndInterval_6_list) NewElement() protoreflect.Value {
+	v := new(DerivedSignal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateSignalsAndInterval_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUpdateSignalsAndInterval                   protoreflect.MessageDescriptor
-	fd_MsgUpdateSignalsAndInterval_tunnel_id         protoreflect.FieldDescriptor
-	fd_MsgUpdateSignalsAndInterval_signal_deviations protoreflect.FieldDescriptor
-	fd_MsgUpdateSignalsAndInterval_interval          protoreflect.FieldDescriptor
-	fd_MsgUpdateSignalsAndInterval_creator           protoreflect.FieldDescriptor
+	md_MsgUpdateSignalsAndInterval                          protoreflect.MessageDescriptor
+	fd_MsgUpdateSignalsAndInterval_tunnel_id                protoreflect.FieldDescriptor
+	fd_MsgUpdateSignalsAndInterval_signal_deviations        protoreflect.FieldDescriptor
+	fd_MsgUpdateSignalsAndInterval_interval                 protoreflect.FieldDescriptor
+	fd_MsgUpdateSignalsAndInterval_creator                  protoreflect.FieldDescriptor
+	fd_MsgUpdateSignalsAndInterval_schedule                 protoreflect.FieldDescriptor
+	fd_MsgUpdateSignalsAndInterval_derived_signals          protoreflect.FieldDescriptor
+	fd_MsgUpdateSignalsAndInterval_include_price_confidence protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2214,6 +2524,9 @@ func init() {
 	fd_MsgUpdateSignalsAndInterval_signal_deviations = md_MsgUpdateSignalsAndInterval.Fields().ByName("signal_deviations")
 	fd_MsgUpdateSignalsAndInterval_interval = md_MsgUpdateSignalsAndInterval.Fields().ByName("interval")
 	fd_MsgUpdateSignalsAndInterval_creator = md_MsgUpdateSignalsAndInterval.Fields().ByName("creator")
+	fd_MsgUpdateSignalsAndInterval_schedule = md_MsgUpdateSignalsAndInterval.Fields().ByName("schedule")
+	fd_MsgUpdateSignalsAndInterval_derived_signals = md_MsgUpdateSignalsAndInterval.Fields().ByName("derived_signals")
+	fd_MsgUpdateSignalsAndInterval_include_price_confidence = md_MsgUpdateSignalsAndInterval.Fields().ByName("include_price_confidence")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgUpdateSignalsAndInterval)(nil)
@@ -2305,6 +2618,24 @@ func (x *fastReflection_MsgUpdateSignalsAndInterval) Range(f func(protoreflect.F
 			return
 		}
 	}
+	if x.Schedule != "" {
+		value := protoreflect.ValueOfString(x.Schedule)
+		if !f(fd_MsgUpdateSignalsAndInterval_schedule, value) {
+			return
+		}
+	}
+	if len(x.DerivedSignals) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateSignalsAndInterval_6_list{list: &x.DerivedSignals})
+		if !f(fd_MsgUpdateSignalsAndInterval_derived_signals, value) {
+			return
+		}
+	}
+	if x.IncludePriceConfidence != false {
+		value := protoreflect.ValueOfBool(x.IncludePriceConfidence)
+		if !f(fd_MsgUpdateSignalsAndInterval_include_price_confidence, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2328,6 +2659,12 @@ func (x *fastReflection_MsgUpdateSignalsAndInterval) Has(fd protoreflect.FieldDe
 		return x.Interval != uint64(0)
 	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.creator":
 		return x.Creator != ""
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.schedule":
+		return x.Schedule != ""
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.derived_signals":
+		return len(x.DerivedSignals) != 0
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.include_price_confidence":
+		return x.IncludePriceConfidence != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateSignalsAndInterval"))
@@ -2352,6 +2689,12 @@ func (x *fastReflection_MsgUpdateSignalsAndInterval) Clear(fd protoreflect.Field
 		x.Interval = uint64(0)
 	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.creator":
 		x.Creator = ""
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.schedule":
+		x.Schedule = ""
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.derived_signals":
+		x.DerivedSignals = nil
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.include_price_confidence":
+		x.IncludePriceConfidence = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateSignalsAndInterval"))
@@ -2383,6 +2726,18 @@ func (x *fastReflection_MsgUpdateSignalsAndInterval) Get(descriptor protoreflect
 	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.schedule":
+		value := x.Schedule
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.derived_signals":
+		if len(x.DerivedSignals) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateSignalsAndInterval_6_list{})
+		}
+		listValue := &_MsgUpdateSignalsAndInterval_6_list{list: &x.DerivedSignals}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.include_price_confidence":
+		value := x.IncludePriceConfidence
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateSignalsAndInterval"))
@@ -2413,6 +2768,14 @@ func (x *fastReflection_MsgUpdateSignalsAndInterval) Set(fd protoreflect.FieldDe
 		x.Interval = value.Uint()
 	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.creator":
 		x.Creator = value.Interface().(string)
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.schedule":
+		x.Schedule = value.Interface().(string)
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.derived_signals":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateSignalsAndInterval_6_list)
+		x.DerivedSignals = *clv.list
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.include_price_confidence":
+		x.IncludePriceConfidence = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateSignalsAndInterval"))
@@ -2439,12 +2802,22 @@ func (x *fastReflection_MsgUpdateSignalsAndInterval) Mutable(fd protoreflect.Fie
 		}
 		value := &_MsgUpdateSignalsAndInterval_2_list{list: &x.SignalDeviations}
 		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.derived_signals":
+		if x.DerivedSignals == nil {
+			x.DerivedSignals = []*DerivedSignal{}
+		}
+		value := &_MsgUpdateSignalsAndInterval_6_list{list: &x.DerivedSignals}
+		return protoreflect.ValueOfList(value)
 	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.tunnel_id":
 		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgUpdateSignalsAndInterval is not mutable"))
 	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.interval":
 		panic(fmt.Errorf("field interval of message band.tunnel.v1beta1.MsgUpdateSignalsAndInterval is not mutable"))
 	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.creator":
 		panic(fmt.Errorf("field creator of message band.tunnel.v1beta1.MsgUpdateSignalsAndInterval is not mutable"))
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.schedule":
+		panic(fmt.Errorf("field schedule of message band.tunnel.v1beta1.MsgUpdateSignalsAndInterval is not mutable"))
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.include_price_confidence":
+		panic(fmt.Errorf("field include_price_confidence of message band.tunnel.v1beta1.MsgUpdateSignalsAndInterval is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateSignalsAndInterval"))
@@ -2467,6 +2840,13 @@ func (x *fastReflection_MsgUpdateSignalsAndInterval) NewField(fd protoreflect.Fi
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.creator":
 		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.schedule":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.derived_signals":
+		list := []*DerivedSignal{}
+		return protoreflect.ValueOfList(&_MsgUpdateSignalsAndInterval_6_list{list: &list})
+	case "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.include_price_confidence":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateSignalsAndInterval"))
@@ -2552,6 +2932,19 @@ func (x *fastReflection_MsgUpdateSignalsAndInterval) ProtoMethods() *protoiface.
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Schedule)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DerivedSignals) > 0 {
+			for _, e := range x.DerivedSignals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.IncludePriceConfidence {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2581,6 +2974,39 @@ func (x *fastReflection_MsgUpdateSignalsAndInterval) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.IncludePriceConfidence {
+			i--
+			if x.IncludePriceConfidence {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.DerivedSignals) > 0 {
+			for iNdEx := len(x.DerivedSignals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DerivedSignals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Schedule) > 0 {
+			i -= len(x.Schedule)
+			copy(dAtA[i:], x.Schedule)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schedule)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if len(x.Creator) > 0 {
 			i -= len(x.Creator)
 			copy(dAtA[i:], x.Creator)
@@ -2767,13 +3193,99 @@ func (x *fastReflection_MsgUpdateSignalsAndInterval) ProtoMethods() *protoiface.
 				}
 				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schedule = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DerivedSignals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DerivedSignals = append(x.DerivedSignals, &DerivedSignal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DerivedSignals[len(x.DerivedSignals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludePriceConfidence", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IncludePriceConfidence = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
@@ -6592,81 +7104,30 @@ func (x *fastReflection_MsgTriggerTunnelResponse) ProtoMethods() *protoiface.Met
 	}
 }
 
-var _ protoreflect.List = (*_MsgDepositToTunnel_2_list)(nil)
-
-type _MsgDepositToTunnel_2_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_MsgDepositToTunnel_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgDepositToTunnel_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgDepositToTunnel_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgDepositToTunnel_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgDepositToTunnel_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgDepositToTunnel_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgDepositToTunnel_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgDepositToTunnel_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgDepositToTunnel           protoreflect.MessageDescriptor
-	fd_MsgDepositToTunnel_tunnel_id protoreflect.FieldDescriptor
-	fd_MsgDepositToTunnel_amount    protoreflect.FieldDescriptor
-	fd_MsgDepositToTunnel_depositor protoreflect.FieldDescriptor
+	md_MsgRetryPacket           protoreflect.MessageDescriptor
+	fd_MsgRetryPacket_tunnel_id protoreflect.FieldDescriptor
+	fd_MsgRetryPacket_sequence  protoreflect.FieldDescriptor
+	fd_MsgRetryPacket_sender    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tx_proto_init()
-	md_MsgDepositToTunnel = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgDepositToTunnel")
-	fd_MsgDepositToTunnel_tunnel_id = md_MsgDepositToTunnel.Fields().ByName("tunnel_id")
-	fd_MsgDepositToTunnel_amount = md_MsgDepositToTunnel.Fields().ByName("amount")
-	fd_MsgDepositToTunnel_depositor = md_MsgDepositToTunnel.Fields().ByName("depositor")
+	md_MsgRetryPacket = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgRetryPacket")
+	fd_MsgRetryPacket_tunnel_id = md_MsgRetryPacket.Fields().ByName("tunnel_id")
+	fd_MsgRetryPacket_sequence = md_MsgRetryPacket.Fields().ByName("sequence")
+	fd_MsgRetryPacket_sender = md_MsgRetryPacket.Fields().ByName("sender")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDepositToTunnel)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRetryPacket)(nil)
 
-type fastReflection_MsgDepositToTunnel MsgDepositToTunnel
+type fastReflection_MsgRetryPacket MsgRetryPacket
 
-func (x *MsgDepositToTunnel) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDepositToTunnel)(x)
+func (x *MsgRetryPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRetryPacket)(x)
 }
 
-func (x *MsgDepositToTunnel) slowProtoReflect() protoreflect.Message {
+func (x *MsgRetryPacket) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6678,43 +7139,43 @@ func (x *MsgDepositToTunnel) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDepositToTunnel_messageType fastReflection_MsgDepositToTunnel_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDepositToTunnel_messageType{}
+var _fastReflection_MsgRetryPacket_messageType fastReflection_MsgRetryPacket_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRetryPacket_messageType{}
 
-type fastReflection_MsgDepositToTunnel_messageType struct{}
+type fastReflection_MsgRetryPacket_messageType struct{}
 
-func (x fastReflection_MsgDepositToTunnel_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDepositToTunnel)(nil)
+func (x fastReflection_MsgRetryPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRetryPacket)(nil)
 }
-func (x fastReflection_MsgDepositToTunnel_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDepositToTunnel)
+func (x fastReflection_MsgRetryPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRetryPacket)
 }
-func (x fastReflection_MsgDepositToTunnel_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDepositToTunnel
+func (x fastReflection_MsgRetryPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRetryPacket
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDepositToTunnel) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDepositToTunnel
+func (x *fastReflection_MsgRetryPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRetryPacket
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDepositToTunnel) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDepositToTunnel_messageType
+func (x *fastReflection_MsgRetryPacket) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRetryPacket_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDepositToTunnel) New() protoreflect.Message {
-	return new(fastReflection_MsgDepositToTunnel)
+func (x *fastReflection_MsgRetryPacket) New() protoreflect.Message {
+	return new(fastReflection_MsgRetryPacket)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDepositToTunnel) Interface() protoreflect.ProtoMessage {
-	return (*MsgDepositToTunnel)(x)
+func (x *fastReflection_MsgRetryPacket) Interface() protoreflect.ProtoMessage {
+	return (*MsgRetryPacket)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6722,22 +7183,22 @@ func (x *fastReflection_MsgDepositToTunnel) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDepositToTunnel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRetryPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.TunnelId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.TunnelId)
-		if !f(fd_MsgDepositToTunnel_tunnel_id, value) {
+		if !f(fd_MsgRetryPacket_tunnel_id, value) {
 			return
 		}
 	}
-	if len(x.Amount) != 0 {
-		value := protoreflect.ValueOfList(&_MsgDepositToTunnel_2_list{list: &x.Amount})
-		if !f(fd_MsgDepositToTunnel_amount, value) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_MsgRetryPacket_sequence, value) {
 			return
 		}
 	}
-	if x.Depositor != "" {
-		value := protoreflect.ValueOfString(x.Depositor)
-		if !f(fd_MsgDepositToTunnel_depositor, value) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgRetryPacket_sender, value) {
 			return
 		}
 	}
@@ -6754,19 +7215,19 @@ func (x *fastReflection_MsgDepositToTunnel) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDepositToTunnel) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRetryPacket) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
+	case "band.tunnel.v1beta1.MsgRetryPacket.tunnel_id":
 		return x.TunnelId != uint64(0)
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
-		return len(x.Amount) != 0
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
-		return x.Depositor != ""
+	case "band.tunnel.v1beta1.MsgRetryPacket.sequence":
+		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.MsgRetryPacket.sender":
+		return x.Sender != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6776,19 +7237,19 @@ func (x *fastReflection_MsgDepositToTunnel) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDepositToTunnel) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRetryPacket) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
+	case "band.tunnel.v1beta1.MsgRetryPacket.tunnel_id":
 		x.TunnelId = uint64(0)
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
-		x.Amount = nil
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
-		x.Depositor = ""
+	case "band.tunnel.v1beta1.MsgRetryPacket.sequence":
+		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.MsgRetryPacket.sender":
+		x.Sender = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6798,25 +7259,22 @@ func (x *fastReflection_MsgDepositToTunnel) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDepositToTunnel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRetryPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
+	case "band.tunnel.v1beta1.MsgRetryPacket.tunnel_id":
 		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
-		if len(x.Amount) == 0 {
-			return protoreflect.ValueOfList(&_MsgDepositToTunnel_2_list{})
-		}
-		listValue := &_MsgDepositToTunnel_2_list{list: &x.Amount}
-		return protoreflect.ValueOfList(listValue)
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
-		value := x.Depositor
+	case "band.tunnel.v1beta1.MsgRetryPacket.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.MsgRetryPacket.sender":
+		value := x.Sender
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacket does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6830,21 +7288,19 @@ func (x *fastReflection_MsgDepositToTunnel) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDepositToTunnel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRetryPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
+	case "band.tunnel.v1beta1.MsgRetryPacket.tunnel_id":
 		x.TunnelId = value.Uint()
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
-		lv := value.List()
-		clv := lv.(*_MsgDepositToTunnel_2_list)
-		x.Amount = *clv.list
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
-		x.Depositor = value.Interface().(string)
+	case "band.tunnel.v1beta1.MsgRetryPacket.sequence":
+		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.MsgRetryPacket.sender":
+		x.Sender = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6858,53 +7314,48 @@ func (x *fastReflection_MsgDepositToTunnel) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDepositToTunnel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRetryPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
-		if x.Amount == nil {
-			x.Amount = []*v1beta1.Coin{}
-		}
-		value := &_MsgDepositToTunnel_2_list{list: &x.Amount}
-		return protoreflect.ValueOfList(value)
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
-		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgDepositToTunnel is not mutable"))
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
-		panic(fmt.Errorf("field depositor of message band.tunnel.v1beta1.MsgDepositToTunnel is not mutable"))
+	case "band.tunnel.v1beta1.MsgRetryPacket.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgRetryPacket is not mutable"))
+	case "band.tunnel.v1beta1.MsgRetryPacket.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.MsgRetryPacket is not mutable"))
+	case "band.tunnel.v1beta1.MsgRetryPacket.sender":
+		panic(fmt.Errorf("field sender of message band.tunnel.v1beta1.MsgRetryPacket is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacket does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDepositToTunnel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRetryPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
+	case "band.tunnel.v1beta1.MsgRetryPacket.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_MsgDepositToTunnel_2_list{list: &list})
-	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
+	case "band.tunnel.v1beta1.MsgRetryPacket.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.MsgRetryPacket.sender":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacket does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDepositToTunnel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRetryPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgDepositToTunnel", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgRetryPacket", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6912,7 +7363,7 @@ func (x *fastReflection_MsgDepositToTunnel) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDepositToTunnel) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRetryPacket) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6923,7 +7374,7 @@ func (x *fastReflection_MsgDepositToTunnel) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDepositToTunnel) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRetryPacket) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6935,7 +7386,7 @@ func (x *fastReflection_MsgDepositToTunnel) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDepositToTunnel) IsValid() bool {
+func (x *fastReflection_MsgRetryPacket) IsValid() bool {
 	return x != nil
 }
 
@@ -6945,9 +7396,9 @@ func (x *fastReflection_MsgDepositToTunnel) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDepositToTunnel) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRetryPacket) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDepositToTunnel)
+		x := input.Message.Interface().(*MsgRetryPacket)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6962,13 +7413,10 @@ func (x *fastReflection_MsgDepositToTunnel) ProtoMethods() *protoiface.Methods {
 		if x.TunnelId != 0 {
 			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
-		if len(x.Amount) > 0 {
-			for _, e := range x.Amount {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
 		}
-		l = len(x.Depositor)
+		l = len(x.Sender)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6982,7 +7430,7 @@ func (x *fastReflection_MsgDepositToTunnel) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDepositToTunnel)
+		x := input.Message.Interface().(*MsgRetryPacket)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7001,28 +7449,17 @@ func (x *fastReflection_MsgDepositToTunnel) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Depositor) > 0 {
-			i -= len(x.Depositor)
-			copy(dAtA[i:], x.Depositor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Amount) > 0 {
-			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Amount[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
 		}
 		if x.TunnelId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
@@ -7040,7 +7477,7 @@ func (x *fastReflection_MsgDepositToTunnel) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDepositToTunnel)
+		x := input.Message.Interface().(*MsgRetryPacket)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7072,10 +7509,10 @@ func (x *fastReflection_MsgDepositToTunnel) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDepositToTunnel: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRetryPacket: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDepositToTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRetryPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7098,10 +7535,10 @@ func (x *fastReflection_MsgDepositToTunnel) ProtoMethods() *protoiface.Methods {
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 				}
-				var msglen int
+				x.Sequence = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7111,29 +7548,14 @@ func (x *fastReflection_MsgDepositToTunnel) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Sequence |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Amount = append(x.Amount, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7161,7 +7583,7 @@ func (x *fastReflection_MsgDepositToTunnel) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Depositor = string(dAtA[iNdEx:postIndex])
+				x.Sender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7199,23 +7621,27 @@ func (x *fastReflection_MsgDepositToTunnel) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgDepositToTunnelResponse protoreflect.MessageDescriptor
+	md_MsgRetryPacketResponse         protoreflect.MessageDescriptor
+	fd_MsgRetryPacketResponse_success protoreflect.FieldDescriptor
+	fd_MsgRetryPacketResponse_reason  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tx_proto_init()
-	md_MsgDepositToTunnelResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgDepositToTunnelResponse")
+	md_MsgRetryPacketResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgRetryPacketResponse")
+	fd_MsgRetryPacketResponse_success = md_MsgRetryPacketResponse.Fields().ByName("success")
+	fd_MsgRetryPacketResponse_reason = md_MsgRetryPacketResponse.Fields().ByName("reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDepositToTunnelResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRetryPacketResponse)(nil)
 
-type fastReflection_MsgDepositToTunnelResponse MsgDepositToTunnelResponse
+type fastReflection_MsgRetryPacketResponse MsgRetryPacketResponse
 
-func (x *MsgDepositToTunnelResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDepositToTunnelResponse)(x)
+func (x *MsgRetryPacketResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRetryPacketResponse)(x)
 }
 
-func (x *MsgDepositToTunnelResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRetryPacketResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7227,43 +7653,43 @@ func (x *MsgDepositToTunnelResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDepositToTunnelResponse_messageType fastReflection_MsgDepositToTunnelResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDepositToTunnelResponse_messageType{}
+var _fastReflection_MsgRetryPacketResponse_messageType fastReflection_MsgRetryPacketResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRetryPacketResponse_messageType{}
 
-type fastReflection_MsgDepositToTunnelResponse_messageType struct{}
+type fastReflection_MsgRetryPacketResponse_messageType struct{}
 
-func (x fastReflection_MsgDepositToTunnelResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDepositToTunnelResponse)(nil)
+func (x fastReflection_MsgRetryPacketResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRetryPacketResponse)(nil)
 }
-func (x fastReflection_MsgDepositToTunnelResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDepositToTunnelResponse)
+func (x fastReflection_MsgRetryPacketResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRetryPacketResponse)
 }
-func (x fastReflection_MsgDepositToTunnelResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDepositToTunnelResponse
+func (x fastReflection_MsgRetryPacketResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRetryPacketResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDepositToTunnelResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDepositToTunnelResponse
+func (x *fastReflection_MsgRetryPacketResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRetryPacketResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDepositToTunnelResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDepositToTunnelResponse_messageType
+func (x *fastReflection_MsgRetryPacketResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRetryPacketResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDepositToTunnelResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgDepositToTunnelResponse)
+func (x *fastReflection_MsgRetryPacketResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRetryPacketResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDepositToTunnelResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgDepositToTunnelResponse)(x)
+func (x *fastReflection_MsgRetryPacketResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRetryPacketResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7271,7 +7697,19 @@ func (x *fastReflection_MsgDepositToTunnelResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDepositToTunnelResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRetryPacketResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Success != false {
+		value := protoreflect.ValueOfBool(x.Success)
+		if !f(fd_MsgRetryPacketResponse_success, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgRetryPacketResponse_reason, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7285,13 +7723,17 @@ func (x *fastReflection_MsgDepositToTunnelResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDepositToTunnelResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRetryPacketResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.success":
+		return x.Success != false
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7301,13 +7743,17 @@ func (x *fastReflection_MsgDepositToTunnelResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDepositToTunnelResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRetryPacketResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.success":
+		x.Success = false
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7317,13 +7763,19 @@ func (x *fastReflection_MsgDepositToTunnelResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDepositToTunnelResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRetryPacketResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.success":
+		value := x.Success
+		return protoreflect.ValueOfBool(value)
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacketResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7337,13 +7789,17 @@ func (x *fastReflection_MsgDepositToTunnelResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDepositToTunnelResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRetryPacketResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.success":
+		x.Success = value.Bool()
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7357,36 +7813,44 @@ func (x *fastReflection_MsgDepositToTunnelResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDepositToTunnelResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRetryPacketResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.success":
+		panic(fmt.Errorf("field success of message band.tunnel.v1beta1.MsgRetryPacketResponse is not mutable"))
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.reason":
+		panic(fmt.Errorf("field reason of message band.tunnel.v1beta1.MsgRetryPacketResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDepositToTunnelResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRetryPacketResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.success":
+		return protoreflect.ValueOfBool(false)
+	case "band.tunnel.v1beta1.MsgRetryPacketResponse.reason":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRetryPacketResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRetryPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDepositToTunnelResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRetryPacketResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgDepositToTunnelResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgRetryPacketResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7394,7 +7858,7 @@ func (x *fastReflection_MsgDepositToTunnelResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDepositToTunnelResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRetryPacketResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7405,7 +7869,7 @@ func (x *fastReflection_MsgDepositToTunnelResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDepositToTunnelResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRetryPacketResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7417,7 +7881,7 @@ func (x *fastReflection_MsgDepositToTunnelResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDepositToTunnelResponse) IsValid() bool {
+func (x *fastReflection_MsgRetryPacketResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7427,9 +7891,9 @@ func (x *fastReflection_MsgDepositToTunnelResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDepositToTunnelResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRetryPacketResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDepositToTunnelResponse)
+		x := input.Message.Interface().(*MsgRetryPacketResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7441,6 +7905,13 @@ func (x *fastReflection_MsgDepositToTunnelResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
+		if x.Success {
+			n += 2
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7451,7 +7922,7 @@ func (x *fastReflection_MsgDepositToTunnelResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDepositToTunnelResponse)
+		x := input.Message.Interface().(*MsgRetryPacketResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7470,6 +7941,23 @@ func (x *fastReflection_MsgDepositToTunnelResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Success {
+			i--
+			if x.Success {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7481,7 +7969,7 @@ func (x *fastReflection_MsgDepositToTunnelResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDepositToTunnelResponse)
+		x := input.Message.Interface().(*MsgRetryPacketResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7513,12 +8001,64 @@ func (x *fastReflection_MsgDepositToTunnelResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDepositToTunnelResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRetryPacketResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDepositToTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRetryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Success = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7554,81 +8094,30 @@ func (x *fastReflection_MsgDepositToTunnelResponse) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_MsgWithdrawFromTunnel_2_list)(nil)
-
-type _MsgWithdrawFromTunnel_2_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_MsgWithdrawFromTunnel_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgWithdrawFromTunnel_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgWithdrawFromTunnel_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgWithdrawFromTunnel_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgWithdrawFromTunnel_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgWithdrawFromTunnel_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgWithdrawFromTunnel_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgWithdrawFromTunnel_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgWithdrawFromTunnel            protoreflect.MessageDescriptor
-	fd_MsgWithdrawFromTunnel_tunnel_id  protoreflect.FieldDescriptor
-	fd_MsgWithdrawFromTunnel_amount     protoreflect.FieldDescriptor
-	fd_MsgWithdrawFromTunnel_withdrawer protoreflect.FieldDescriptor
+	md_MsgTransferTunnelOwnership             protoreflect.MessageDescriptor
+	fd_MsgTransferTunnelOwnership_tunnel_id   protoreflect.FieldDescriptor
+	fd_MsgTransferTunnelOwnership_new_creator protoreflect.FieldDescriptor
+	fd_MsgTransferTunnelOwnership_creator     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tx_proto_init()
-	md_MsgWithdrawFromTunnel = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgWithdrawFromTunnel")
-	fd_MsgWithdrawFromTunnel_tunnel_id = md_MsgWithdrawFromTunnel.Fields().ByName("tunnel_id")
-	fd_MsgWithdrawFromTunnel_amount = md_MsgWithdrawFromTunnel.Fields().ByName("amount")
-	fd_MsgWithdrawFromTunnel_withdrawer = md_MsgWithdrawFromTunnel.Fields().ByName("withdrawer")
+	md_MsgTransferTunnelOwnership = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgTransferTunnelOwnership")
+	fd_MsgTransferTunnelOwnership_tunnel_id = md_MsgTransferTunnelOwnership.Fields().ByName("tunnel_id")
+	fd_MsgTransferTunnelOwnership_new_creator = md_MsgTransferTunnelOwnership.Fields().ByName("new_creator")
+	fd_MsgTransferTunnelOwnership_creator = md_MsgTransferTunnelOwnership.Fields().ByName("creator")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgWithdrawFromTunnel)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgTransferTunnelOwnership)(nil)
 
-type fastReflection_MsgWithdrawFromTunnel MsgWithdrawFromTunnel
+type fastReflection_MsgTransferTunnelOwnership MsgTransferTunnelOwnership
 
-func (x *MsgWithdrawFromTunnel) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgWithdrawFromTunnel)(x)
+func (x *MsgTransferTunnelOwnership) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferTunnelOwnership)(x)
 }
 
-func (x *MsgWithdrawFromTunnel) slowProtoReflect() protoreflect.Message {
+func (x *MsgTransferTunnelOwnership) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7640,43 +8129,43 @@ func (x *MsgWithdrawFromTunnel) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgWithdrawFromTunnel_messageType fastReflection_MsgWithdrawFromTunnel_messageType
-var _ protoreflect.MessageType = fastReflection_MsgWithdrawFromTunnel_messageType{}
+var _fastReflection_MsgTransferTunnelOwnership_messageType fastReflection_MsgTransferTunnelOwnership_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferTunnelOwnership_messageType{}
 
-type fastReflection_MsgWithdrawFromTunnel_messageType struct{}
+type fastReflection_MsgTransferTunnelOwnership_messageType struct{}
 
-func (x fastReflection_MsgWithdrawFromTunnel_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgWithdrawFromTunnel)(nil)
+func (x fastReflection_MsgTransferTunnelOwnership_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferTunnelOwnership)(nil)
 }
-func (x fastReflection_MsgWithdrawFromTunnel_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdrawFromTunnel)
+func (x fastReflection_MsgTransferTunnelOwnership_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTunnelOwnership)
 }
-func (x fastReflection_MsgWithdrawFromTunnel_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdrawFromTunnel
+func (x fastReflection_MsgTransferTunnelOwnership_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTunnelOwnership
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgWithdrawFromTunnel) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdrawFromTunnel
+func (x *fastReflection_MsgTransferTunnelOwnership) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTunnelOwnership
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgWithdrawFromTunnel) Type() protoreflect.MessageType {
-	return _fastReflection_MsgWithdrawFromTunnel_messageType
+func (x *fastReflection_MsgTransferTunnelOwnership) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferTunnelOwnership_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgWithdrawFromTunnel) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdrawFromTunnel)
+func (x *fastReflection_MsgTransferTunnelOwnership) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTunnelOwnership)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgWithdrawFromTunnel) Interface() protoreflect.ProtoMessage {
-	return (*MsgWithdrawFromTunnel)(x)
+func (x *fastReflection_MsgTransferTunnelOwnership) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferTunnelOwnership)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7684,22 +8173,22 @@ func (x *fastReflection_MsgWithdrawFromTunnel) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgWithdrawFromTunnel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgTransferTunnelOwnership) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.TunnelId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.TunnelId)
-		if !f(fd_MsgWithdrawFromTunnel_tunnel_id, value) {
+		if !f(fd_MsgTransferTunnelOwnership_tunnel_id, value) {
 			return
 		}
 	}
-	if len(x.Amount) != 0 {
-		value := protoreflect.ValueOfList(&_MsgWithdrawFromTunnel_2_list{list: &x.Amount})
-		if !f(fd_MsgWithdrawFromTunnel_amount, value) {
+	if x.NewCreator != "" {
+		value := protoreflect.ValueOfString(x.NewCreator)
+		if !f(fd_MsgTransferTunnelOwnership_new_creator, value) {
 			return
 		}
 	}
-	if x.Withdrawer != "" {
-		value := protoreflect.ValueOfString(x.Withdrawer)
-		if !f(fd_MsgWithdrawFromTunnel_withdrawer, value) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgTransferTunnelOwnership_creator, value) {
 			return
 		}
 	}
@@ -7716,19 +8205,19 @@ func (x *fastReflection_MsgWithdrawFromTunnel) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgWithdrawFromTunnel) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgTransferTunnelOwnership) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.tunnel_id":
 		return x.TunnelId != uint64(0)
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
-		return len(x.Amount) != 0
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
-		return x.Withdrawer != ""
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.new_creator":
+		return x.NewCreator != ""
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.creator":
+		return x.Creator != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7738,19 +8227,19 @@ func (x *fastReflection_MsgWithdrawFromTunnel) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawFromTunnel) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgTransferTunnelOwnership) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.tunnel_id":
 		x.TunnelId = uint64(0)
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
-		x.Amount = nil
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
-		x.Withdrawer = ""
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.new_creator":
+		x.NewCreator = ""
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.creator":
+		x.Creator = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7760,25 +8249,22 @@ func (x *fastReflection_MsgWithdrawFromTunnel) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgWithdrawFromTunnel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTunnelOwnership) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.tunnel_id":
 		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
-		if len(x.Amount) == 0 {
-			return protoreflect.ValueOfList(&_MsgWithdrawFromTunnel_2_list{})
-		}
-		listValue := &_MsgWithdrawFromTunnel_2_list{list: &x.Amount}
-		return protoreflect.ValueOfList(listValue)
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
-		value := x.Withdrawer
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.new_creator":
+		value := x.NewCreator
 		return protoreflect.ValueOfString(value)
-	default:
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnership does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7792,21 +8278,19 @@ func (x *fastReflection_MsgWithdrawFromTunnel) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawFromTunnel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgTransferTunnelOwnership) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.tunnel_id":
 		x.TunnelId = value.Uint()
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
-		lv := value.List()
-		clv := lv.(*_MsgWithdrawFromTunnel_2_list)
-		x.Amount = *clv.list
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
-		x.Withdrawer = value.Interface().(string)
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.new_creator":
+		x.NewCreator = value.Interface().(string)
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.creator":
+		x.Creator = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7820,53 +8304,48 @@ func (x *fastReflection_MsgWithdrawFromTunnel) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawFromTunnel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTunnelOwnership) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
-		if x.Amount == nil {
-			x.Amount = []*v1beta1.Coin{}
-		}
-		value := &_MsgWithdrawFromTunnel_2_list{list: &x.Amount}
-		return protoreflect.ValueOfList(value)
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
-		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgWithdrawFromTunnel is not mutable"))
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
-		panic(fmt.Errorf("field withdrawer of message band.tunnel.v1beta1.MsgWithdrawFromTunnel is not mutable"))
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgTransferTunnelOwnership is not mutable"))
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.new_creator":
+		panic(fmt.Errorf("field new_creator of message band.tunnel.v1beta1.MsgTransferTunnelOwnership is not mutable"))
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.creator":
+		panic(fmt.Errorf("field creator of message band.tunnel.v1beta1.MsgTransferTunnelOwnership is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgWithdrawFromTunnel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTunnelOwnership) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_MsgWithdrawFromTunnel_2_list{list: &list})
-	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.new_creator":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.MsgTransferTunnelOwnership.creator":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgWithdrawFromTunnel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgTransferTunnelOwnership) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgWithdrawFromTunnel", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgTransferTunnelOwnership", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7874,7 +8353,7 @@ func (x *fastReflection_MsgWithdrawFromTunnel) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgWithdrawFromTunnel) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgTransferTunnelOwnership) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7885,7 +8364,7 @@ func (x *fastReflection_MsgWithdrawFromTunnel) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawFromTunnel) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgTransferTunnelOwnership) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7897,7 +8376,7 @@ func (x *fastReflection_MsgWithdrawFromTunnel) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgWithdrawFromTunnel) IsValid() bool {
+func (x *fastReflection_MsgTransferTunnelOwnership) IsValid() bool {
 	return x != nil
 }
 
@@ -7907,9 +8386,9 @@ func (x *fastReflection_MsgWithdrawFromTunnel) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgWithdrawFromTunnel) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgTransferTunnelOwnership) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgWithdrawFromTunnel)
+		x := input.Message.Interface().(*MsgTransferTunnelOwnership)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7924,13 +8403,11 @@ func (x *fastReflection_MsgWithdrawFromTunnel) ProtoMethods() *protoiface.Method
 		if x.TunnelId != 0 {
 			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
-		if len(x.Amount) > 0 {
-			for _, e := range x.Amount {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.NewCreator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Withdrawer)
+		l = len(x.Creator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7944,7 +8421,7 @@ func (x *fastReflection_MsgWithdrawFromTunnel) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdrawFromTunnel)
+		x := input.Message.Interface().(*MsgTransferTunnelOwnership)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7963,28 +8440,19 @@ func (x *fastReflection_MsgWithdrawFromTunnel) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Withdrawer) > 0 {
-			i -= len(x.Withdrawer)
-			copy(dAtA[i:], x.Withdrawer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Withdrawer)))
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Amount) > 0 {
-			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Amount[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+		if len(x.NewCreator) > 0 {
+			i -= len(x.NewCreator)
+			copy(dAtA[i:], x.NewCreator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewCreator)))
+			i--
+			dAtA[i] = 0x12
 		}
 		if x.TunnelId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
@@ -8002,7 +8470,7 @@ func (x *fastReflection_MsgWithdrawFromTunnel) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdrawFromTunnel)
+		x := input.Message.Interface().(*MsgTransferTunnelOwnership)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8034,10 +8502,10 @@ func (x *fastReflection_MsgWithdrawFromTunnel) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFromTunnel: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTunnelOwnership: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFromTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTunnelOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -8061,9 +8529,9 @@ func (x *fastReflection_MsgWithdrawFromTunnel) ProtoMethods() *protoiface.Method
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewCreator", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8073,29 +8541,27 @@ func (x *fastReflection_MsgWithdrawFromTunnel) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = append(x.Amount, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.NewCreator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8123,7 +8589,7 @@ func (x *fastReflection_MsgWithdrawFromTunnel) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Withdrawer = string(dAtA[iNdEx:postIndex])
+				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8161,23 +8627,23 @@ func (x *fastReflection_MsgWithdrawFromTunnel) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgWithdrawFromTunnelResponse protoreflect.MessageDescriptor
+	md_MsgTransferTunnelOwnershipResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tx_proto_init()
-	md_MsgWithdrawFromTunnelResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgWithdrawFromTunnelResponse")
+	md_MsgTransferTunnelOwnershipResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgTransferTunnelOwnershipResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgWithdrawFromTunnelResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgTransferTunnelOwnershipResponse)(nil)
 
-type fastReflection_MsgWithdrawFromTunnelResponse MsgWithdrawFromTunnelResponse
+type fastReflection_MsgTransferTunnelOwnershipResponse MsgTransferTunnelOwnershipResponse
 
-func (x *MsgWithdrawFromTunnelResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgWithdrawFromTunnelResponse)(x)
+func (x *MsgTransferTunnelOwnershipResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferTunnelOwnershipResponse)(x)
 }
 
-func (x *MsgWithdrawFromTunnelResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgTransferTunnelOwnershipResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8189,43 +8655,43 @@ func (x *MsgWithdrawFromTunnelResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgWithdrawFromTunnelResponse_messageType fastReflection_MsgWithdrawFromTunnelResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgWithdrawFromTunnelResponse_messageType{}
+var _fastReflection_MsgTransferTunnelOwnershipResponse_messageType fastReflection_MsgTransferTunnelOwnershipResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferTunnelOwnershipResponse_messageType{}
 
-type fastReflection_MsgWithdrawFromTunnelResponse_messageType struct{}
+type fastReflection_MsgTransferTunnelOwnershipResponse_messageType struct{}
 
-func (x fastReflection_MsgWithdrawFromTunnelResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgWithdrawFromTunnelResponse)(nil)
+func (x fastReflection_MsgTransferTunnelOwnershipResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferTunnelOwnershipResponse)(nil)
 }
-func (x fastReflection_MsgWithdrawFromTunnelResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdrawFromTunnelResponse)
+func (x fastReflection_MsgTransferTunnelOwnershipResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTunnelOwnershipResponse)
 }
-func (x fastReflection_MsgWithdrawFromTunnelResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdrawFromTunnelResponse
+func (x fastReflection_MsgTransferTunnelOwnershipResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTunnelOwnershipResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdrawFromTunnelResponse
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTunnelOwnershipResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgWithdrawFromTunnelResponse_messageType
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferTunnelOwnershipResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdrawFromTunnelResponse)
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTunnelOwnershipResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgWithdrawFromTunnelResponse)(x)
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferTunnelOwnershipResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8233,7 +8699,7 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -8247,13 +8713,13 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8263,13 +8729,13 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8279,13 +8745,13 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8299,13 +8765,13 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8319,36 +8785,36 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8356,7 +8822,7 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8367,7 +8833,7 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8379,7 +8845,7 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) IsValid() bool {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8389,9 +8855,9 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgWithdrawFromTunnelResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgTransferTunnelOwnershipResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgWithdrawFromTunnelResponse)
+		x := input.Message.Interface().(*MsgTransferTunnelOwnershipResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8413,7 +8879,7 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdrawFromTunnelResponse)
+		x := input.Message.Interface().(*MsgTransferTunnelOwnershipResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8443,7 +8909,7 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdrawFromTunnelResponse)
+		x := input.Message.Interface().(*MsgTransferTunnelOwnershipResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8475,10 +8941,10 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFromTunnelResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTunnelOwnershipResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFromTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTunnelOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -8517,27 +8983,27 @@ func (x *fastReflection_MsgWithdrawFromTunnelResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_MsgUpdateParams           protoreflect.MessageDescriptor
-	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
-	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+	md_MsgAcceptTunnelOwnership             protoreflect.MessageDescriptor
+	fd_MsgAcceptTunnelOwnership_tunnel_id   protoreflect.FieldDescriptor
+	fd_MsgAcceptTunnelOwnership_new_creator protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tx_proto_init()
-	md_MsgUpdateParams = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgUpdateParams")
-	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
-	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+	md_MsgAcceptTunnelOwnership = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgAcceptTunnelOwnership")
+	fd_MsgAcceptTunnelOwnership_tunnel_id = md_MsgAcceptTunnelOwnership.Fields().ByName("tunnel_id")
+	fd_MsgAcceptTunnelOwnership_new_creator = md_MsgAcceptTunnelOwnership.Fields().ByName("new_creator")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAcceptTunnelOwnership)(nil)
 
-type fastReflection_MsgUpdateParams MsgUpdateParams
+type fastReflection_MsgAcceptTunnelOwnership MsgAcceptTunnelOwnership
 
-func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(x)
+func (x *MsgAcceptTunnelOwnership) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptTunnelOwnership)(x)
 }
 
-func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+func (x *MsgAcceptTunnelOwnership) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8549,43 +9015,43 @@ func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+var _fastReflection_MsgAcceptTunnelOwnership_messageType fastReflection_MsgAcceptTunnelOwnership_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptTunnelOwnership_messageType{}
 
-type fastReflection_MsgUpdateParams_messageType struct{}
+type fastReflection_MsgAcceptTunnelOwnership_messageType struct{}
 
-func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(nil)
+func (x fastReflection_MsgAcceptTunnelOwnership_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptTunnelOwnership)(nil)
 }
-func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x fastReflection_MsgAcceptTunnelOwnership_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptTunnelOwnership)
 }
-func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x fastReflection_MsgAcceptTunnelOwnership_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptTunnelOwnership
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x *fastReflection_MsgAcceptTunnelOwnership) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptTunnelOwnership
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParams_messageType
+func (x *fastReflection_MsgAcceptTunnelOwnership) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptTunnelOwnership_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x *fastReflection_MsgAcceptTunnelOwnership) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptTunnelOwnership)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParams)(x)
+func (x *fastReflection_MsgAcceptTunnelOwnership) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptTunnelOwnership)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8593,16 +9059,16 @@ func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Authority != "" {
-		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateParams_authority, value) {
+func (x *fastReflection_MsgAcceptTunnelOwnership) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_MsgAcceptTunnelOwnership_tunnel_id, value) {
 			return
 		}
 	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_MsgUpdateParams_params, value) {
+	if x.NewCreator != "" {
+		value := protoreflect.ValueOfString(x.NewCreator)
+		if !f(fd_MsgAcceptTunnelOwnership_new_creator, value) {
 			return
 		}
 	}
@@ -8619,17 +9085,17 @@ func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAcceptTunnelOwnership) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
-		return x.Authority != ""
-	case "band.tunnel.v1beta1.MsgUpdateParams.params":
-		return x.Params != nil
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.new_creator":
+		return x.NewCreator != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8639,17 +9105,17 @@ func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAcceptTunnelOwnership) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
-		x.Authority = ""
-	case "band.tunnel.v1beta1.MsgUpdateParams.params":
-		x.Params = nil
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.new_creator":
+		x.NewCreator = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8659,19 +9125,19 @@ func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptTunnelOwnership) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
-		value := x.Authority
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.new_creator":
+		value := x.NewCreator
 		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.MsgUpdateParams.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnership does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8685,17 +9151,17 @@ func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAcceptTunnelOwnership) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
-		x.Authority = value.Interface().(string)
-	case "band.tunnel.v1beta1.MsgUpdateParams.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.new_creator":
+		x.NewCreator = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8709,48 +9175,44 @@ func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptTunnelOwnership) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgUpdateParams.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
-		panic(fmt.Errorf("field authority of message band.tunnel.v1beta1.MsgUpdateParams is not mutable"))
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgAcceptTunnelOwnership is not mutable"))
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.new_creator":
+		panic(fmt.Errorf("field new_creator of message band.tunnel.v1beta1.MsgAcceptTunnelOwnership is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptTunnelOwnership) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.MsgAcceptTunnelOwnership.new_creator":
 		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.MsgUpdateParams.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnership"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAcceptTunnelOwnership) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgUpdateParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgAcceptTunnelOwnership", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8758,7 +9220,7 @@ func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAcceptTunnelOwnership) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8769,7 +9231,7 @@ func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAcceptTunnelOwnership) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8781,7 +9243,7 @@ func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+func (x *fastReflection_MsgAcceptTunnelOwnership) IsValid() bool {
 	return x != nil
 }
 
@@ -8791,9 +9253,9 @@ func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAcceptTunnelOwnership) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgAcceptTunnelOwnership)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8805,14 +9267,13 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Authority)
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		l = len(x.NewCreator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8823,7 +9284,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgAcceptTunnelOwnership)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8842,26 +9303,17 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.NewCreator) > 0 {
+			i -= len(x.NewCreator)
+			copy(dAtA[i:], x.NewCreator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewCreator)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Authority) > 0 {
-			i -= len(x.Authority)
-			copy(dAtA[i:], x.Authority)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8874,7 +9326,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgAcceptTunnelOwnership)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8906,17 +9358,17 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptTunnelOwnership: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptTunnelOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
 				}
-				var stringLen uint64
+				x.TunnelId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8926,29 +9378,16 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.TunnelId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Authority = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewCreator", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8958,27 +9397,23 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.NewCreator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9016,23 +9451,23 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+	md_MsgAcceptTunnelOwnershipResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tx_proto_init()
-	md_MsgUpdateParamsResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+	md_MsgAcceptTunnelOwnershipResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgAcceptTunnelOwnershipResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAcceptTunnelOwnershipResponse)(nil)
 
-type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+type fastReflection_MsgAcceptTunnelOwnershipResponse MsgAcceptTunnelOwnershipResponse
 
-func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(x)
+func (x *MsgAcceptTunnelOwnershipResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptTunnelOwnershipResponse)(x)
 }
 
-func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgAcceptTunnelOwnershipResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9044,43 +9479,43 @@ func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+var _fastReflection_MsgAcceptTunnelOwnershipResponse_messageType fastReflection_MsgAcceptTunnelOwnershipResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptTunnelOwnershipResponse_messageType{}
 
-type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+type fastReflection_MsgAcceptTunnelOwnershipResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+func (x fastReflection_MsgAcceptTunnelOwnershipResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptTunnelOwnershipResponse)(nil)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x fastReflection_MsgAcceptTunnelOwnershipResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptTunnelOwnershipResponse)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x fastReflection_MsgAcceptTunnelOwnershipResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptTunnelOwnershipResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptTunnelOwnershipResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParamsResponse_messageType
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptTunnelOwnershipResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptTunnelOwnershipResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParamsResponse)(x)
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptTunnelOwnershipResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9088,7 +9523,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -9102,13 +9537,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9118,13 +9553,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9134,13 +9569,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9154,13 +9589,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9174,36 +9609,36 @@ func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgUpdateParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9211,7 +9646,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9222,7 +9657,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9234,7 +9669,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9244,9 +9679,9 @@ func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAcceptTunnelOwnershipResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgAcceptTunnelOwnershipResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9268,7 +9703,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgAcceptTunnelOwnershipResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9298,7 +9733,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgAcceptTunnelOwnershipResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9330,10 +9765,10 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptTunnelOwnershipResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptTunnelOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -9371,600 +9806,7941 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/tunnel/v1beta1/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// MsgCreateTunnel is the transaction message to create a new tunnel.
-type MsgCreateTunnel struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_MsgUpdateTunnelAdmins_2_list)(nil)
 
-	// signal_deviations is the list of signal deviations.
-	SignalDeviations []*SignalDeviation `protobuf:"bytes,1,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations,omitempty"`
-	// interval is the interval for delivering the signal prices in seconds.
-	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
-	// route is the route for delivering the signal prices
-	Route *anypb.Any `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
-	// initial_deposit is the deposit value that must be paid at tunnel creation.
-	InitialDeposit []*v1beta1.Coin `protobuf:"bytes,4,rep,name=initial_deposit,json=initialDeposit,proto3" json:"initial_deposit,omitempty"`
-	// creator is the address of the creator.
-	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+type _MsgUpdateTunnelAdmins_2_list struct {
+	list *[]*TunnelAdmin
 }
 
-func (x *MsgCreateTunnel) Reset() {
-	*x = MsgCreateTunnel{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_MsgUpdateTunnelAdmins_2_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *MsgCreateTunnel) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_MsgUpdateTunnelAdmins_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (*MsgCreateTunnel) ProtoMessage() {}
+func (x *_MsgUpdateTunnelAdmins_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TunnelAdmin)
+	(*x.list)[i] = concreteValue
+}
 
-// Deprecated: Use MsgCreateTunnel.ProtoReflect.Descriptor instead.
-func (*MsgCreateTunnel) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{0}
+func (x *_MsgUpdateTunnelAdmins_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TunnelAdmin)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *MsgCreateTunnel) GetSignalDeviations() []*SignalDeviation {
-	if x != nil {
-		return x.SignalDeviations
-	}
-	return nil
+func (x *_MsgUpdateTunnelAdmins_2_list) AppendMutable() protoreflect.Value {
+	v := new(TunnelAdmin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *MsgCreateTunnel) GetInterval() uint64 {
-	if x != nil {
-		return x.Interval
+func (x *_MsgUpdateTunnelAdmins_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return 0
+	*x.list = (*x.list)[:n]
 }
 
-func (x *MsgCreateTunnel) GetRoute() *anypb.Any {
-	if x != nil {
-		return x.Route
-	}
-	return nil
+func (x *_MsgUpdateTunnelAdmins_2_list) NewElement() protoreflect.Value {
+	v := new(TunnelAdmin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *MsgCreateTunnel) GetInitialDeposit() []*v1beta1.Coin {
-	if x != nil {
-		return x.InitialDeposit
-	}
-	return nil
+func (x *_MsgUpdateTunnelAdmins_2_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *MsgCreateTunnel) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
+var (
+	md_MsgUpdateTunnelAdmins           protoreflect.MessageDescriptor
+	fd_MsgUpdateTunnelAdmins_tunnel_id protoreflect.FieldDescriptor
+	fd_MsgUpdateTunnelAdmins_admins    protoreflect.FieldDescriptor
+	fd_MsgUpdateTunnelAdmins_creator   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgUpdateTunnelAdmins = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgUpdateTunnelAdmins")
+	fd_MsgUpdateTunnelAdmins_tunnel_id = md_MsgUpdateTunnelAdmins.Fields().ByName("tunnel_id")
+	fd_MsgUpdateTunnelAdmins_admins = md_MsgUpdateTunnelAdmins.Fields().ByName("admins")
+	fd_MsgUpdateTunnelAdmins_creator = md_MsgUpdateTunnelAdmins.Fields().ByName("creator")
 }
 
-// MsgCreateTunnelResponse is the response type for the Msg/CreateTunnel RPC method.
-type MsgCreateTunnelResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.Message = (*fastReflection_MsgUpdateTunnelAdmins)(nil)
 
-	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+type fastReflection_MsgUpdateTunnelAdmins MsgUpdateTunnelAdmins
+
+func (x *MsgUpdateTunnelAdmins) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateTunnelAdmins)(x)
 }
 
-func (x *MsgCreateTunnelResponse) Reset() {
-	*x = MsgCreateTunnelResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[1]
+func (x *MsgUpdateTunnelAdmins) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MsgCreateTunnelResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MsgUpdateTunnelAdmins_messageType fastReflection_MsgUpdateTunnelAdmins_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateTunnelAdmins_messageType{}
 
-func (*MsgCreateTunnelResponse) ProtoMessage() {}
+type fastReflection_MsgUpdateTunnelAdmins_messageType struct{}
 
-// Deprecated: Use MsgCreateTunnelResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateTunnelResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{1}
+func (x fastReflection_MsgUpdateTunnelAdmins_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateTunnelAdmins)(nil)
+}
+func (x fastReflection_MsgUpdateTunnelAdmins_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateTunnelAdmins)
+}
+func (x fastReflection_MsgUpdateTunnelAdmins_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateTunnelAdmins
 }
 
-func (x *MsgCreateTunnelResponse) GetTunnelId() uint64 {
-	if x != nil {
-		return x.TunnelId
-	}
-	return 0
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateTunnelAdmins) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateTunnelAdmins
 }
 
-// MsgUpdateRoute is the transaction message to update a route information of the tunnel.
-type MsgUpdateRoute struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateTunnelAdmins) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateTunnelAdmins_messageType
+}
 
-	// tunnel_id is the ID of the tunnel to edit.
-	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// route is the route for delivering the signal prices
-	Route *anypb.Any `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
-	// creator is the address of the creator.
-	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateTunnelAdmins) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateTunnelAdmins)
 }
 
-func (x *MsgUpdateRoute) Reset() {
-	*x = MsgUpdateRoute{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[2]
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateTunnelAdmins) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateTunnelAdmins)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateTunnelAdmins) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_MsgUpdateTunnelAdmins_tunnel_id, value) {
+			return
+		}
+	}
+	if len(x.Admins) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateTunnelAdmins_2_list{list: &x.Admins})
+		if !f(fd_MsgUpdateTunnelAdmins_admins, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgUpdateTunnelAdmins_creator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateTunnelAdmins) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.admins":
+		return len(x.Admins) != 0
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.creator":
+		return x.Creator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdmins"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdmins does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateTunnelAdmins) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.admins":
+		x.Admins = nil
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.creator":
+		x.Creator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdmins"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdmins does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateTunnelAdmins) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.admins":
+		if len(x.Admins) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateTunnelAdmins_2_list{})
+		}
+		listValue := &_MsgUpdateTunnelAdmins_2_list{list: &x.Admins}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdmins"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdmins does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateTunnelAdmins) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.admins":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateTunnelAdmins_2_list)
+		x.Admins = *clv.list
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.creator":
+		x.Creator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdmins"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdmins does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateTunnelAdmins) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.admins":
+		if x.Admins == nil {
+			x.Admins = []*TunnelAdmin{}
+		}
+		value := &_MsgUpdateTunnelAdmins_2_list{list: &x.Admins}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgUpdateTunnelAdmins is not mutable"))
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.creator":
+		panic(fmt.Errorf("field creator of message band.tunnel.v1beta1.MsgUpdateTunnelAdmins is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdmins"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdmins does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateTunnelAdmins) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.admins":
+		list := []*TunnelAdmin{}
+		return protoreflect.ValueOfList(&_MsgUpdateTunnelAdmins_2_list{list: &list})
+	case "band.tunnel.v1beta1.MsgUpdateTunnelAdmins.creator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdmins"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdmins does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateTunnelAdmins) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgUpdateTunnelAdmins", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateTunnelAdmins) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateTunnelAdmins) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateTunnelAdmins) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateTunnelAdmins) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateTunnelAdmins)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if len(x.Admins) > 0 {
+			for _, e := range x.Admins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateTunnelAdmins)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Admins) > 0 {
+			for iNdEx := len(x.Admins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Admins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateTunnelAdmins)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateTunnelAdmins: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateTunnelAdmins: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Admins = append(x.Admins, &TunnelAdmin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Admins[len(x.Admins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateTunnelAdminsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgUpdateTunnelAdminsResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgUpdateTunnelAdminsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateTunnelAdminsResponse)(nil)
+
+type fastReflection_MsgUpdateTunnelAdminsResponse MsgUpdateTunnelAdminsResponse
+
+func (x *MsgUpdateTunnelAdminsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateTunnelAdminsResponse)(x)
+}
+
+func (x *MsgUpdateTunnelAdminsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateTunnelAdminsResponse_messageType fastReflection_MsgUpdateTunnelAdminsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateTunnelAdminsResponse_messageType{}
+
+type fastReflection_MsgUpdateTunnelAdminsResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateTunnelAdminsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateTunnelAdminsResponse)(nil)
+}
+func (x fastReflection_MsgUpdateTunnelAdminsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateTunnelAdminsResponse)
+}
+func (x fastReflection_MsgUpdateTunnelAdminsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateTunnelAdminsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateTunnelAdminsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateTunnelAdminsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateTunnelAdminsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateTunnelAdminsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateTunnelAdminsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateTunnelAdminsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateTunnelAdminsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateTunnelAdminsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateTunnelAdminsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateTunnelAdminsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgApproveFundingSource           protoreflect.MessageDescriptor
+	fd_MsgApproveFundingSource_tunnel_id protoreflect.FieldDescriptor
+	fd_MsgApproveFundingSource_source    protoreflect.FieldDescriptor
+	fd_MsgApproveFundingSource_creator   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgApproveFundingSource = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgApproveFundingSource")
+	fd_MsgApproveFundingSource_tunnel_id = md_MsgApproveFundingSource.Fields().ByName("tunnel_id")
+	fd_MsgApproveFundingSource_source = md_MsgApproveFundingSource.Fields().ByName("source")
+	fd_MsgApproveFundingSource_creator = md_MsgApproveFundingSource.Fields().ByName("creator")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgApproveFundingSource)(nil)
+
+type fastReflection_MsgApproveFundingSource MsgApproveFundingSource
+
+func (x *MsgApproveFundingSource) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgApproveFundingSource)(x)
+}
+
+func (x *MsgApproveFundingSource) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgApproveFundingSource_messageType fastReflection_MsgApproveFundingSource_messageType
+var _ protoreflect.MessageType = fastReflection_MsgApproveFundingSource_messageType{}
+
+type fastReflection_MsgApproveFundingSource_messageType struct{}
+
+func (x fastReflection_MsgApproveFundingSource_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgApproveFundingSource)(nil)
+}
+func (x fastReflection_MsgApproveFundingSource_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgApproveFundingSource)
+}
+func (x fastReflection_MsgApproveFundingSource_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgApproveFundingSource
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgApproveFundingSource) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgApproveFundingSource
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgApproveFundingSource) Type() protoreflect.MessageType {
+	return _fastReflection_MsgApproveFundingSource_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgApproveFundingSource) New() protoreflect.Message {
+	return new(fastReflection_MsgApproveFundingSource)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgApproveFundingSource) Interface() protoreflect.ProtoMessage {
+	return (*MsgApproveFundingSource)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgApproveFundingSource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_MsgApproveFundingSource_tunnel_id, value) {
+			return
+		}
+	}
+	if x.Source != "" {
+		value := protoreflect.ValueOfString(x.Source)
+		if !f(fd_MsgApproveFundingSource_source, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgApproveFundingSource_creator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgApproveFundingSource) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.source":
+		return x.Source != ""
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.creator":
+		return x.Creator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSource"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgApproveFundingSource) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.source":
+		x.Source = ""
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.creator":
+		x.Creator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSource"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgApproveFundingSource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.source":
+		value := x.Source
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSource"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSource does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgApproveFundingSource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.source":
+		x.Source = value.Interface().(string)
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.creator":
+		x.Creator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSource"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgApproveFundingSource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgApproveFundingSource is not mutable"))
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.source":
+		panic(fmt.Errorf("field source of message band.tunnel.v1beta1.MsgApproveFundingSource is not mutable"))
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.creator":
+		panic(fmt.Errorf("field creator of message band.tunnel.v1beta1.MsgApproveFundingSource is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSource"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgApproveFundingSource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.source":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.MsgApproveFundingSource.creator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSource"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgApproveFundingSource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgApproveFundingSource", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgApproveFundingSource) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgApproveFundingSource) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgApproveFundingSource) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgApproveFundingSource) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgApproveFundingSource)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		l = len(x.Source)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgApproveFundingSource)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Source) > 0 {
+			i -= len(x.Source)
+			copy(dAtA[i:], x.Source)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Source)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgApproveFundingSource)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgApproveFundingSource: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgApproveFundingSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Source = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgApproveFundingSourceResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgApproveFundingSourceResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgApproveFundingSourceResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgApproveFundingSourceResponse)(nil)
+
+type fastReflection_MsgApproveFundingSourceResponse MsgApproveFundingSourceResponse
+
+func (x *MsgApproveFundingSourceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgApproveFundingSourceResponse)(x)
+}
+
+func (x *MsgApproveFundingSourceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgApproveFundingSourceResponse_messageType fastReflection_MsgApproveFundingSourceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgApproveFundingSourceResponse_messageType{}
+
+type fastReflection_MsgApproveFundingSourceResponse_messageType struct{}
+
+func (x fastReflection_MsgApproveFundingSourceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgApproveFundingSourceResponse)(nil)
+}
+func (x fastReflection_MsgApproveFundingSourceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgApproveFundingSourceResponse)
+}
+func (x fastReflection_MsgApproveFundingSourceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgApproveFundingSourceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgApproveFundingSourceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgApproveFundingSourceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgApproveFundingSourceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgApproveFundingSourceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgApproveFundingSourceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgApproveFundingSourceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgApproveFundingSourceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgApproveFundingSourceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgApproveFundingSourceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgApproveFundingSourceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSourceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgApproveFundingSourceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSourceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgApproveFundingSourceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSourceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgApproveFundingSourceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSourceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgApproveFundingSourceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSourceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgApproveFundingSourceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgApproveFundingSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgApproveFundingSourceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgApproveFundingSourceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgApproveFundingSourceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgApproveFundingSourceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgApproveFundingSourceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgApproveFundingSourceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgApproveFundingSourceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgApproveFundingSourceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgApproveFundingSourceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgApproveFundingSourceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgApproveFundingSourceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgApproveFundingSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSetFundingPolicy_2_list)(nil)
+
+type _MsgSetFundingPolicy_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgSetFundingPolicy_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSetFundingPolicy_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSetFundingPolicy_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSetFundingPolicy_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSetFundingPolicy_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetFundingPolicy_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSetFundingPolicy_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetFundingPolicy_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSetFundingPolicy_3_list)(nil)
+
+type _MsgSetFundingPolicy_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgSetFundingPolicy_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSetFundingPolicy_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSetFundingPolicy_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSetFundingPolicy_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSetFundingPolicy_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetFundingPolicy_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSetFundingPolicy_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetFundingPolicy_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSetFundingPolicy_4_list)(nil)
+
+type _MsgSetFundingPolicy_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgSetFundingPolicy_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSetFundingPolicy_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSetFundingPolicy_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSetFundingPolicy_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSetFundingPolicy_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetFundingPolicy_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSetFundingPolicy_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetFundingPolicy_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSetFundingPolicy               protoreflect.MessageDescriptor
+	fd_MsgSetFundingPolicy_tunnel_id     protoreflect.FieldDescriptor
+	fd_MsgSetFundingPolicy_allowance     protoreflect.FieldDescriptor
+	fd_MsgSetFundingPolicy_threshold     protoreflect.FieldDescriptor
+	fd_MsgSetFundingPolicy_top_up_amount protoreflect.FieldDescriptor
+	fd_MsgSetFundingPolicy_source        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgSetFundingPolicy = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgSetFundingPolicy")
+	fd_MsgSetFundingPolicy_tunnel_id = md_MsgSetFundingPolicy.Fields().ByName("tunnel_id")
+	fd_MsgSetFundingPolicy_allowance = md_MsgSetFundingPolicy.Fields().ByName("allowance")
+	fd_MsgSetFundingPolicy_threshold = md_MsgSetFundingPolicy.Fields().ByName("threshold")
+	fd_MsgSetFundingPolicy_top_up_amount = md_MsgSetFundingPolicy.Fields().ByName("top_up_amount")
+	fd_MsgSetFundingPolicy_source = md_MsgSetFundingPolicy.Fields().ByName("source")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetFundingPolicy)(nil)
+
+type fastReflection_MsgSetFundingPolicy MsgSetFundingPolicy
+
+func (x *MsgSetFundingPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetFundingPolicy)(x)
+}
+
+func (x *MsgSetFundingPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetFundingPolicy_messageType fastReflection_MsgSetFundingPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetFundingPolicy_messageType{}
+
+type fastReflection_MsgSetFundingPolicy_messageType struct{}
+
+func (x fastReflection_MsgSetFundingPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetFundingPolicy)(nil)
+}
+func (x fastReflection_MsgSetFundingPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetFundingPolicy)
+}
+func (x fastReflection_MsgSetFundingPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetFundingPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetFundingPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetFundingPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetFundingPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetFundingPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetFundingPolicy) New() protoreflect.Message {
+	return new(fastReflection_MsgSetFundingPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetFundingPolicy) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetFundingPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetFundingPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_MsgSetFundingPolicy_tunnel_id, value) {
+			return
+		}
+	}
+	if len(x.Allowance) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSetFundingPolicy_2_list{list: &x.Allowance})
+		if !f(fd_MsgSetFundingPolicy_allowance, value) {
+			return
+		}
+	}
+	if len(x.Threshold) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSetFundingPolicy_3_list{list: &x.Threshold})
+		if !f(fd_MsgSetFundingPolicy_threshold, value) {
+			return
+		}
+	}
+	if len(x.TopUpAmount) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSetFundingPolicy_4_list{list: &x.TopUpAmount})
+		if !f(fd_MsgSetFundingPolicy_top_up_amount, value) {
+			return
+		}
+	}
+	if x.Source != "" {
+		value := protoreflect.ValueOfString(x.Source)
+		if !f(fd_MsgSetFundingPolicy_source, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetFundingPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.allowance":
+		return len(x.Allowance) != 0
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.threshold":
+		return len(x.Threshold) != 0
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.top_up_amount":
+		return len(x.TopUpAmount) != 0
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.source":
+		return x.Source != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFundingPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.allowance":
+		x.Allowance = nil
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.threshold":
+		x.Threshold = nil
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.top_up_amount":
+		x.TopUpAmount = nil
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.source":
+		x.Source = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetFundingPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.allowance":
+		if len(x.Allowance) == 0 {
+			return protoreflect.ValueOfList(&_MsgSetFundingPolicy_2_list{})
+		}
+		listValue := &_MsgSetFundingPolicy_2_list{list: &x.Allowance}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.threshold":
+		if len(x.Threshold) == 0 {
+			return protoreflect.ValueOfList(&_MsgSetFundingPolicy_3_list{})
+		}
+		listValue := &_MsgSetFundingPolicy_3_list{list: &x.Threshold}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.top_up_amount":
+		if len(x.TopUpAmount) == 0 {
+			return protoreflect.ValueOfList(&_MsgSetFundingPolicy_4_list{})
+		}
+		listValue := &_MsgSetFundingPolicy_4_list{list: &x.TopUpAmount}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.source":
+		value := x.Source
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFundingPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.allowance":
+		lv := value.List()
+		clv := lv.(*_MsgSetFundingPolicy_2_list)
+		x.Allowance = *clv.list
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.threshold":
+		lv := value.List()
+		clv := lv.(*_MsgSetFundingPolicy_3_list)
+		x.Threshold = *clv.list
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.top_up_amount":
+		lv := value.List()
+		clv := lv.(*_MsgSetFundingPolicy_4_list)
+		x.TopUpAmount = *clv.list
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.source":
+		x.Source = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFundingPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.allowance":
+		if x.Allowance == nil {
+			x.Allowance = []*v1beta1.Coin{}
+		}
+		value := &_MsgSetFundingPolicy_2_list{list: &x.Allowance}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.threshold":
+		if x.Threshold == nil {
+			x.Threshold = []*v1beta1.Coin{}
+		}
+		value := &_MsgSetFundingPolicy_3_list{list: &x.Threshold}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.top_up_amount":
+		if x.TopUpAmount == nil {
+			x.TopUpAmount = []*v1beta1.Coin{}
+		}
+		value := &_MsgSetFundingPolicy_4_list{list: &x.TopUpAmount}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgSetFundingPolicy is not mutable"))
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.source":
+		panic(fmt.Errorf("field source of message band.tunnel.v1beta1.MsgSetFundingPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetFundingPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.allowance":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgSetFundingPolicy_2_list{list: &list})
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.threshold":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgSetFundingPolicy_3_list{list: &list})
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.top_up_amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgSetFundingPolicy_4_list{list: &list})
+	case "band.tunnel.v1beta1.MsgSetFundingPolicy.source":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetFundingPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgSetFundingPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetFundingPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFundingPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetFundingPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetFundingPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetFundingPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if len(x.Allowance) > 0 {
+			for _, e := range x.Allowance {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Threshold) > 0 {
+			for _, e := range x.Threshold {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopUpAmount) > 0 {
+			for _, e := range x.TopUpAmount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Source)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetFundingPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Source) > 0 {
+			i -= len(x.Source)
+			copy(dAtA[i:], x.Source)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Source)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.TopUpAmount) > 0 {
+			for iNdEx := len(x.TopUpAmount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopUpAmount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Threshold) > 0 {
+			for iNdEx := len(x.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Threshold[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Allowance) > 0 {
+			for iNdEx := len(x.Allowance) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Allowance[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetFundingPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetFundingPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetFundingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allowance = append(x.Allowance, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allowance[len(x.Allowance)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = append(x.Threshold, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Threshold[len(x.Threshold)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopUpAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TopUpAmount = append(x.TopUpAmount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopUpAmount[len(x.TopUpAmount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Source = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetFundingPolicyResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgSetFundingPolicyResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgSetFundingPolicyResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetFundingPolicyResponse)(nil)
+
+type fastReflection_MsgSetFundingPolicyResponse MsgSetFundingPolicyResponse
+
+func (x *MsgSetFundingPolicyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetFundingPolicyResponse)(x)
+}
+
+func (x *MsgSetFundingPolicyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetFundingPolicyResponse_messageType fastReflection_MsgSetFundingPolicyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetFundingPolicyResponse_messageType{}
+
+type fastReflection_MsgSetFundingPolicyResponse_messageType struct{}
+
+func (x fastReflection_MsgSetFundingPolicyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetFundingPolicyResponse)(nil)
+}
+func (x fastReflection_MsgSetFundingPolicyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetFundingPolicyResponse)
+}
+func (x fastReflection_MsgSetFundingPolicyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetFundingPolicyResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetFundingPolicyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetFundingPolicyResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetFundingPolicyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetFundingPolicyResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetFundingPolicyResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetFundingPolicyResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetFundingPolicyResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetFundingPolicyResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetFundingPolicyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetFundingPolicyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFundingPolicyResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetFundingPolicyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFundingPolicyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFundingPolicyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetFundingPolicyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgSetFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgSetFundingPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetFundingPolicyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgSetFundingPolicyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetFundingPolicyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFundingPolicyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetFundingPolicyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetFundingPolicyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetFundingPolicyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetFundingPolicyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetFundingPolicyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetFundingPolicyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetFundingPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRemoveFundingPolicy           protoreflect.MessageDescriptor
+	fd_MsgRemoveFundingPolicy_tunnel_id protoreflect.FieldDescriptor
+	fd_MsgRemoveFundingPolicy_sender    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgRemoveFundingPolicy = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgRemoveFundingPolicy")
+	fd_MsgRemoveFundingPolicy_tunnel_id = md_MsgRemoveFundingPolicy.Fields().ByName("tunnel_id")
+	fd_MsgRemoveFundingPolicy_sender = md_MsgRemoveFundingPolicy.Fields().ByName("sender")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRemoveFundingPolicy)(nil)
+
+type fastReflection_MsgRemoveFundingPolicy MsgRemoveFundingPolicy
+
+func (x *MsgRemoveFundingPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveFundingPolicy)(x)
+}
+
+func (x *MsgRemoveFundingPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRemoveFundingPolicy_messageType fastReflection_MsgRemoveFundingPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveFundingPolicy_messageType{}
+
+type fastReflection_MsgRemoveFundingPolicy_messageType struct{}
+
+func (x fastReflection_MsgRemoveFundingPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveFundingPolicy)(nil)
+}
+func (x fastReflection_MsgRemoveFundingPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveFundingPolicy)
+}
+func (x fastReflection_MsgRemoveFundingPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveFundingPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRemoveFundingPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveFundingPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRemoveFundingPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveFundingPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRemoveFundingPolicy) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveFundingPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRemoveFundingPolicy) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveFundingPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRemoveFundingPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_MsgRemoveFundingPolicy_tunnel_id, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgRemoveFundingPolicy_sender, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRemoveFundingPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.sender":
+		return x.Sender != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveFundingPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.sender":
+		x.Sender = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRemoveFundingPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveFundingPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.sender":
+		x.Sender = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveFundingPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgRemoveFundingPolicy is not mutable"))
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.sender":
+		panic(fmt.Errorf("field sender of message band.tunnel.v1beta1.MsgRemoveFundingPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRemoveFundingPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.MsgRemoveFundingPolicy.sender":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicy"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRemoveFundingPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgRemoveFundingPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRemoveFundingPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveFundingPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRemoveFundingPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRemoveFundingPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRemoveFundingPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveFundingPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveFundingPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveFundingPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveFundingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRemoveFundingPolicyResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgRemoveFundingPolicyResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgRemoveFundingPolicyResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRemoveFundingPolicyResponse)(nil)
+
+type fastReflection_MsgRemoveFundingPolicyResponse MsgRemoveFundingPolicyResponse
+
+func (x *MsgRemoveFundingPolicyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveFundingPolicyResponse)(x)
+}
+
+func (x *MsgRemoveFundingPolicyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRemoveFundingPolicyResponse_messageType fastReflection_MsgRemoveFundingPolicyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveFundingPolicyResponse_messageType{}
+
+type fastReflection_MsgRemoveFundingPolicyResponse_messageType struct{}
+
+func (x fastReflection_MsgRemoveFundingPolicyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveFundingPolicyResponse)(nil)
+}
+func (x fastReflection_MsgRemoveFundingPolicyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveFundingPolicyResponse)
+}
+func (x fastReflection_MsgRemoveFundingPolicyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveFundingPolicyResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveFundingPolicyResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveFundingPolicyResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveFundingPolicyResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveFundingPolicyResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRemoveFundingPolicyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRemoveFundingPolicyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveFundingPolicyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveFundingPolicyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveFundingPolicyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveFundingPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgDepositToTunnel_2_list)(nil)
+
+type _MsgDepositToTunnel_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgDepositToTunnel_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgDepositToTunnel_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgDepositToTunnel_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgDepositToTunnel_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgDepositToTunnel_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgDepositToTunnel_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgDepositToTunnel_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgDepositToTunnel_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgDepositToTunnel           protoreflect.MessageDescriptor
+	fd_MsgDepositToTunnel_tunnel_id protoreflect.FieldDescriptor
+	fd_MsgDepositToTunnel_amount    protoreflect.FieldDescriptor
+	fd_MsgDepositToTunnel_depositor protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgDepositToTunnel = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgDepositToTunnel")
+	fd_MsgDepositToTunnel_tunnel_id = md_MsgDepositToTunnel.Fields().ByName("tunnel_id")
+	fd_MsgDepositToTunnel_amount = md_MsgDepositToTunnel.Fields().ByName("amount")
+	fd_MsgDepositToTunnel_depositor = md_MsgDepositToTunnel.Fields().ByName("depositor")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDepositToTunnel)(nil)
+
+type fastReflection_MsgDepositToTunnel MsgDepositToTunnel
+
+func (x *MsgDepositToTunnel) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDepositToTunnel)(x)
+}
+
+func (x *MsgDepositToTunnel) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDepositToTunnel_messageType fastReflection_MsgDepositToTunnel_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDepositToTunnel_messageType{}
+
+type fastReflection_MsgDepositToTunnel_messageType struct{}
+
+func (x fastReflection_MsgDepositToTunnel_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDepositToTunnel)(nil)
+}
+func (x fastReflection_MsgDepositToTunnel_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDepositToTunnel)
+}
+func (x fastReflection_MsgDepositToTunnel_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDepositToTunnel
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDepositToTunnel) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDepositToTunnel
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDepositToTunnel) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDepositToTunnel_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDepositToTunnel) New() protoreflect.Message {
+	return new(fastReflection_MsgDepositToTunnel)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDepositToTunnel) Interface() protoreflect.ProtoMessage {
+	return (*MsgDepositToTunnel)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDepositToTunnel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_MsgDepositToTunnel_tunnel_id, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_MsgDepositToTunnel_2_list{list: &x.Amount})
+		if !f(fd_MsgDepositToTunnel_amount, value) {
+			return
+		}
+	}
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_MsgDepositToTunnel_depositor, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDepositToTunnel) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
+		return len(x.Amount) != 0
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
+		return x.Depositor != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDepositToTunnel) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
+		x.Amount = nil
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
+		x.Depositor = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDepositToTunnel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_MsgDepositToTunnel_2_list{})
+		}
+		listValue := &_MsgDepositToTunnel_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDepositToTunnel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
+		lv := value.List()
+		clv := lv.(*_MsgDepositToTunnel_2_list)
+		x.Amount = *clv.list
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
+		x.Depositor = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDepositToTunnel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_MsgDepositToTunnel_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgDepositToTunnel is not mutable"))
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
+		panic(fmt.Errorf("field depositor of message band.tunnel.v1beta1.MsgDepositToTunnel is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDepositToTunnel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgDepositToTunnel_2_list{list: &list})
+	case "band.tunnel.v1beta1.MsgDepositToTunnel.depositor":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnel does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDepositToTunnel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgDepositToTunnel", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDepositToTunnel) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDepositToTunnel) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDepositToTunnel) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDepositToTunnel) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDepositToTunnel)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDepositToTunnel)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDepositToTunnel)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDepositToTunnel: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDepositToTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDepositToTunnelResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgDepositToTunnelResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgDepositToTunnelResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDepositToTunnelResponse)(nil)
+
+type fastReflection_MsgDepositToTunnelResponse MsgDepositToTunnelResponse
+
+func (x *MsgDepositToTunnelResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDepositToTunnelResponse)(x)
+}
+
+func (x *MsgDepositToTunnelResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDepositToTunnelResponse_messageType fastReflection_MsgDepositToTunnelResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDepositToTunnelResponse_messageType{}
+
+type fastReflection_MsgDepositToTunnelResponse_messageType struct{}
+
+func (x fastReflection_MsgDepositToTunnelResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDepositToTunnelResponse)(nil)
+}
+func (x fastReflection_MsgDepositToTunnelResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDepositToTunnelResponse)
+}
+func (x fastReflection_MsgDepositToTunnelResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDepositToTunnelResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDepositToTunnelResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDepositToTunnelResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDepositToTunnelResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDepositToTunnelResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDepositToTunnelResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDepositToTunnelResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDepositToTunnelResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDepositToTunnelResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDepositToTunnelResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDepositToTunnelResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDepositToTunnelResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDepositToTunnelResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDepositToTunnelResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDepositToTunnelResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDepositToTunnelResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgDepositToTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgDepositToTunnelResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDepositToTunnelResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgDepositToTunnelResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDepositToTunnelResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDepositToTunnelResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDepositToTunnelResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDepositToTunnelResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDepositToTunnelResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDepositToTunnelResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDepositToTunnelResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDepositToTunnelResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDepositToTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgWithdrawFromTunnel_2_list)(nil)
+
+type _MsgWithdrawFromTunnel_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgWithdrawFromTunnel_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgWithdrawFromTunnel_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgWithdrawFromTunnel_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgWithdrawFromTunnel_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgWithdrawFromTunnel_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgWithdrawFromTunnel_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgWithdrawFromTunnel_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgWithdrawFromTunnel_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgWithdrawFromTunnel            protoreflect.MessageDescriptor
+	fd_MsgWithdrawFromTunnel_tunnel_id  protoreflect.FieldDescriptor
+	fd_MsgWithdrawFromTunnel_amount     protoreflect.FieldDescriptor
+	fd_MsgWithdrawFromTunnel_withdrawer protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgWithdrawFromTunnel = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgWithdrawFromTunnel")
+	fd_MsgWithdrawFromTunnel_tunnel_id = md_MsgWithdrawFromTunnel.Fields().ByName("tunnel_id")
+	fd_MsgWithdrawFromTunnel_amount = md_MsgWithdrawFromTunnel.Fields().ByName("amount")
+	fd_MsgWithdrawFromTunnel_withdrawer = md_MsgWithdrawFromTunnel.Fields().ByName("withdrawer")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgWithdrawFromTunnel)(nil)
+
+type fastReflection_MsgWithdrawFromTunnel MsgWithdrawFromTunnel
+
+func (x *MsgWithdrawFromTunnel) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawFromTunnel)(x)
+}
+
+func (x *MsgWithdrawFromTunnel) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgWithdrawFromTunnel_messageType fastReflection_MsgWithdrawFromTunnel_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWithdrawFromTunnel_messageType{}
+
+type fastReflection_MsgWithdrawFromTunnel_messageType struct{}
+
+func (x fastReflection_MsgWithdrawFromTunnel_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawFromTunnel)(nil)
+}
+func (x fastReflection_MsgWithdrawFromTunnel_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawFromTunnel)
+}
+func (x fastReflection_MsgWithdrawFromTunnel_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawFromTunnel
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgWithdrawFromTunnel) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawFromTunnel
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgWithdrawFromTunnel) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWithdrawFromTunnel_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgWithdrawFromTunnel) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawFromTunnel)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgWithdrawFromTunnel) Interface() protoreflect.ProtoMessage {
+	return (*MsgWithdrawFromTunnel)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgWithdrawFromTunnel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_MsgWithdrawFromTunnel_tunnel_id, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_MsgWithdrawFromTunnel_2_list{list: &x.Amount})
+		if !f(fd_MsgWithdrawFromTunnel_amount, value) {
+			return
+		}
+	}
+	if x.Withdrawer != "" {
+		value := protoreflect.ValueOfString(x.Withdrawer)
+		if !f(fd_MsgWithdrawFromTunnel_withdrawer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgWithdrawFromTunnel) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
+		return len(x.Amount) != 0
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
+		return x.Withdrawer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawFromTunnel) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
+		x.Amount = nil
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
+		x.Withdrawer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgWithdrawFromTunnel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_MsgWithdrawFromTunnel_2_list{})
+		}
+		listValue := &_MsgWithdrawFromTunnel_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
+		value := x.Withdrawer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawFromTunnel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
+		lv := value.List()
+		clv := lv.(*_MsgWithdrawFromTunnel_2_list)
+		x.Amount = *clv.list
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
+		x.Withdrawer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawFromTunnel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_MsgWithdrawFromTunnel_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.MsgWithdrawFromTunnel is not mutable"))
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
+		panic(fmt.Errorf("field withdrawer of message band.tunnel.v1beta1.MsgWithdrawFromTunnel is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgWithdrawFromTunnel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgWithdrawFromTunnel_2_list{list: &list})
+	case "band.tunnel.v1beta1.MsgWithdrawFromTunnel.withdrawer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnel"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnel does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgWithdrawFromTunnel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgWithdrawFromTunnel", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgWithdrawFromTunnel) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawFromTunnel) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgWithdrawFromTunnel) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgWithdrawFromTunnel) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgWithdrawFromTunnel)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Withdrawer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawFromTunnel)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Withdrawer) > 0 {
+			i -= len(x.Withdrawer)
+			copy(dAtA[i:], x.Withdrawer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Withdrawer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawFromTunnel)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFromTunnel: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFromTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Withdrawer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgWithdrawFromTunnelResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgWithdrawFromTunnelResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgWithdrawFromTunnelResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgWithdrawFromTunnelResponse)(nil)
+
+type fastReflection_MsgWithdrawFromTunnelResponse MsgWithdrawFromTunnelResponse
+
+func (x *MsgWithdrawFromTunnelResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawFromTunnelResponse)(x)
+}
+
+func (x *MsgWithdrawFromTunnelResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgWithdrawFromTunnelResponse_messageType fastReflection_MsgWithdrawFromTunnelResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWithdrawFromTunnelResponse_messageType{}
+
+type fastReflection_MsgWithdrawFromTunnelResponse_messageType struct{}
+
+func (x fastReflection_MsgWithdrawFromTunnelResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawFromTunnelResponse)(nil)
+}
+func (x fastReflection_MsgWithdrawFromTunnelResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawFromTunnelResponse)
+}
+func (x fastReflection_MsgWithdrawFromTunnelResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawFromTunnelResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawFromTunnelResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWithdrawFromTunnelResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawFromTunnelResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgWithdrawFromTunnelResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgWithdrawFromTunnelResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgWithdrawFromTunnelResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawFromTunnelResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawFromTunnelResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFromTunnelResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFromTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParams           protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgUpdateParams = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgUpdateParams")
+	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
+	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+
+type fastReflection_MsgUpdateParams MsgUpdateParams
+
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(x)
+}
+
+func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+
+type fastReflection_MsgUpdateParams_messageType struct{}
+
+func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(nil)
+}
+func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateParams_authority, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_MsgUpdateParams_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
+		return x.Authority != ""
+	case "band.tunnel.v1beta1.MsgUpdateParams.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
+		x.Authority = ""
+	case "band.tunnel.v1beta1.MsgUpdateParams.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.MsgUpdateParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
+		x.Authority = value.Interface().(string)
+	case "band.tunnel.v1beta1.MsgUpdateParams.params":
+		x.Params = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateParams.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
+		panic(fmt.Errorf("field authority of message band.tunnel.v1beta1.MsgUpdateParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.MsgUpdateParams.authority":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.MsgUpdateParams.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgUpdateParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tx_proto_init()
+	md_MsgUpdateParamsResponse = File_band_tunnel_v1beta1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+
+type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(x)
+}
+
+func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+
+type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParamsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParamsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.MsgUpdateParamsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/tunnel/v1beta1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgCreateTunnel is the transaction message to create a new tunnel.
+type MsgCreateTunnel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_deviations is the list of signal deviations.
+	SignalDeviations []*SignalDeviation `protobuf:"bytes,1,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations,omitempty"`
+	// interval is the interval for delivering the signal prices in seconds.
+	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// route is the route for delivering the signal prices
+	Route *anypb.Any `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	// initial_deposit is the deposit value that must be paid at tunnel creation.
+	InitialDeposit []*v1beta1.Coin `protobuf:"bytes,4,rep,name=initial_deposit,json=initialDeposit,proto3" json:"initial_deposit,omitempty"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schedule is the cron-like expression for delivering the signal prices. It is disabled if empty.
+	Schedule string `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// derived_signals is the list of signals whose prices are derived from the feed prices.
+	DerivedSignals []*DerivedSignal `protobuf:"bytes,7,rep,name=derived_signals,json=derivedSignals,proto3" json:"derived_signals,omitempty"`
+	// include_price_confidence is the flag to include the confidence metadata of the feed prices in the packets.
+	IncludePriceConfidence bool `protobuf:"varint,8,opt,name=include_price_confidence,json=includePriceConfidence,proto3" json:"include_price_confidence,omitempty"`
+}
+
+func (x *MsgCreateTunnel) Reset() {
+	*x = MsgCreateTunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateTunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateTunnel) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateTunnel.ProtoReflect.Descriptor instead.
+func (*MsgCreateTunnel) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgCreateTunnel) GetSignalDeviations() []*SignalDeviation {
+	if x != nil {
+		return x.SignalDeviations
+	}
+	return nil
+}
+
+func (x *MsgCreateTunnel) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *MsgCreateTunnel) GetRoute() *anypb.Any {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *MsgCreateTunnel) GetInitialDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.InitialDeposit
+	}
+	return nil
+}
+
+func (x *MsgCreateTunnel) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCreateTunnel) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *MsgCreateTunnel) GetDerivedSignals() []*DerivedSignal {
+	if x != nil {
+		return x.DerivedSignals
+	}
+	return nil
+}
+
+func (x *MsgCreateTunnel) GetIncludePriceConfidence() bool {
+	if x != nil {
+		return x.IncludePriceConfidence
+	}
+	return false
+}
+
+// MsgCreateTunnelResponse is the response type for the Msg/CreateTunnel RPC method.
+type MsgCreateTunnelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+}
+
+func (x *MsgCreateTunnelResponse) Reset() {
+	*x = MsgCreateTunnelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateTunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateTunnelResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateTunnelResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateTunnelResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgCreateTunnelResponse) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+// MsgUpdateRoute is the transaction message to update a route information of the tunnel.
+type MsgUpdateRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to edit.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// route is the route for delivering the signal prices
+	Route *anypb.Any `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (x *MsgUpdateRoute) Reset() {
+	*x = MsgUpdateRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateRoute) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateRoute.ProtoReflect.Descriptor instead.
+func (*MsgUpdateRoute) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgUpdateRoute) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *MsgUpdateRoute) GetRoute() *anypb.Any {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *MsgUpdateRoute) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+// MsgUpdateRouteResponse is the response type for the Msg/UpdateRoute RPC method.
+type MsgUpdateRouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateRouteResponse) Reset() {
+	*x = MsgUpdateRouteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateRouteResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateRouteResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateRouteResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+// MsgUpdateSignalsAndInterval is the transaction message to update signals and interval of the tunnel.
+type MsgUpdateSignalsAndInterval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to edit.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// signal_deviations is the list of signal deviations.
+	SignalDeviations []*SignalDeviation `protobuf:"bytes,2,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations,omitempty"`
+	// interval is the interval for delivering the signal prices.
+	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schedule is the cron-like expression for delivering the signal prices. It is disabled if empty.
+	Schedule string `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// derived_signals is the list of signals whose prices are derived from the feed prices.
+	DerivedSignals []*DerivedSignal `protobuf:"bytes,6,rep,name=derived_signals,json=derivedSignals,proto3" json:"derived_signals,omitempty"`
+	// include_price_confidence is the flag to include the confidence metadata of the feed prices in the packets.
+	IncludePriceConfidence bool `protobuf:"varint,7,opt,name=include_price_confidence,json=includePriceConfidence,proto3" json:"include_price_confidence,omitempty"`
+}
+
+func (x *MsgUpdateSignalsAndInterval) Reset() {
+	*x = MsgUpdateSignalsAndInterval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateSignalsAndInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateSignalsAndInterval) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateSignalsAndInterval.ProtoReflect.Descriptor instead.
+func (*MsgUpdateSignalsAndInterval) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgUpdateSignalsAndInterval) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *MsgUpdateSignalsAndInterval) GetSignalDeviations() []*SignalDeviation {
+	if x != nil {
+		return x.SignalDeviations
+	}
+	return nil
+}
+
+func (x *MsgUpdateSignalsAndInterval) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *MsgUpdateSignalsAndInterval) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgUpdateSignalsAndInterval) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *MsgUpdateSignalsAndInterval) GetDerivedSignals() []*DerivedSignal {
+	if x != nil {
+		return x.DerivedSignals
+	}
+	return nil
+}
+
+func (x *MsgUpdateSignalsAndInterval) GetIncludePriceConfidence() bool {
+	if x != nil {
+		return x.IncludePriceConfidence
+	}
+	return false
+}
+
+// MsgUpdateSignalsAndIntervalResponse is the response type for the Msg/UpdateSignalsAndInterval RPC method.
+type MsgUpdateSignalsAndIntervalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateSignalsAndIntervalResponse) Reset() {
+	*x = MsgUpdateSignalsAndIntervalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateSignalsAndIntervalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateSignalsAndIntervalResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateSignalsAndIntervalResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateSignalsAndIntervalResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+// MsgWithdrawFeePayerFunds is the transaction message to withdraw fee payer funds to creator.
+type MsgWithdrawFeePayerFunds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to withdraw fee payer coins.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// amount is the coins to withdraw.
+	Amount []*v1beta1.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (x *MsgWithdrawFeePayerFunds) Reset() {
+	*x = MsgWithdrawFeePayerFunds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgWithdrawFeePayerFunds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgWithdrawFeePayerFunds) ProtoMessage() {}
+
+// Deprecated: Use MsgWithdrawFeePayerFunds.ProtoReflect.Descriptor instead.
+func (*MsgWithdrawFeePayerFunds) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgWithdrawFeePayerFunds) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *MsgWithdrawFeePayerFunds) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *MsgWithdrawFeePayerFunds) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+// MsgWithdrawFeePayerFundsResponse is the response type for the Msg/WithdrawFeePayerFunds RPC method.
+type MsgWithdrawFeePayerFundsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgWithdrawFeePayerFundsResponse) Reset() {
+	*x = MsgWithdrawFeePayerFundsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgWithdrawFeePayerFundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgWithdrawFeePayerFundsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgWithdrawFeePayerFundsResponse.ProtoReflect.Descriptor instead.
+func (*MsgWithdrawFeePayerFundsResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgActivateTunnel is the transaction message to activate a tunnel.
+type MsgActivateTunnel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to activate.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (x *MsgActivateTunnel) Reset() {
+	*x = MsgActivateTunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgActivateTunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgActivateTunnel) ProtoMessage() {}
+
+// Deprecated: Use MsgActivateTunnel.ProtoReflect.Descriptor instead.
+func (*MsgActivateTunnel) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgActivateTunnel) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *MsgActivateTunnel) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+// MsgActivateTunnelResponse is the response type for the Msg/Activate RPC method.
+type MsgActivateTunnelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgActivateTunnelResponse) Reset() {
+	*x = MsgActivateTunnelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgActivateTunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgActivateTunnelResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgActivateTunnelResponse.ProtoReflect.Descriptor instead.
+func (*MsgActivateTunnelResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+// MsgDeactivateTunnel is the transaction message to deactivate a tunnel.
+type MsgDeactivateTunnel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to deactivate.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (x *MsgDeactivateTunnel) Reset() {
+	*x = MsgDeactivateTunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDeactivateTunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDeactivateTunnel) ProtoMessage() {}
+
+// Deprecated: Use MsgDeactivateTunnel.ProtoReflect.Descriptor instead.
+func (*MsgDeactivateTunnel) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgDeactivateTunnel) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *MsgDeactivateTunnel) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+// MsgDeactivateTunnelResponse is the response type for the Msg/Deactivate RPC method.
+type MsgDeactivateTunnelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgDeactivateTunnelResponse) Reset() {
+	*x = MsgDeactivateTunnelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDeactivateTunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDeactivateTunnelResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgDeactivateTunnelResponse.ProtoReflect.Descriptor instead.
+func (*MsgDeactivateTunnelResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// MsgTriggerTunnel is the transaction message to manually trigger a tunnel.
+type MsgTriggerTunnel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to manually trigger.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (x *MsgTriggerTunnel) Reset() {
+	*x = MsgTriggerTunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTriggerTunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTriggerTunnel) ProtoMessage() {}
+
+// Deprecated: Use MsgTriggerTunnel.ProtoReflect.Descriptor instead.
+func (*MsgTriggerTunnel) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgTriggerTunnel) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *MsgTriggerTunnel) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+// MsgTriggerTunnelResponse is the response type for the Msg/TriggerTunnel RPC method.
+type MsgTriggerTunnelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgTriggerTunnelResponse) Reset() {
+	*x = MsgTriggerTunnelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateRoute) String() string {
+func (x *MsgTriggerTunnelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateRoute) ProtoMessage() {}
+func (*MsgTriggerTunnelResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateRoute.ProtoReflect.Descriptor instead.
-func (*MsgUpdateRoute) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgTriggerTunnelResponse.ProtoReflect.Descriptor instead.
+func (*MsgTriggerTunnelResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MsgUpdateRoute) GetTunnelId() uint64 {
+// MsgRetryPacket is the transaction message to resend a failed packet of the tunnel.
+type MsgRetryPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel of the failed packet.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the failed packet.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// sender is the address of the tunnel creator or the tunnel fee payer.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *MsgRetryPacket) Reset() {
+	*x = MsgRetryPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRetryPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRetryPacket) ProtoMessage() {}
+
+// Deprecated: Use MsgRetryPacket.ProtoReflect.Descriptor instead.
+func (*MsgRetryPacket) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgRetryPacket) GetTunnelId() uint64 {
 	if x != nil {
 		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *MsgUpdateRoute) GetRoute() *anypb.Any {
+func (x *MsgRetryPacket) GetSequence() uint64 {
 	if x != nil {
-		return x.Route
+		return x.Sequence
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgUpdateRoute) GetCreator() string {
+func (x *MsgRetryPacket) GetSender() string {
 	if x != nil {
-		return x.Creator
+		return x.Sender
 	}
 	return ""
 }
 
-// MsgUpdateRouteResponse is the response type for the Msg/UpdateRoute RPC method.
-type MsgUpdateRouteResponse struct {
+// MsgRetryPacketResponse is the response type for the Msg/RetryPacket RPC method.
+type MsgRetryPacketResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// success is true if the packet is sent by the retry.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// reason is the route error if the packet fails to be sent again.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *MsgUpdateRouteResponse) Reset() {
-	*x = MsgUpdateRouteResponse{}
+func (x *MsgRetryPacketResponse) Reset() {
+	*x = MsgRetryPacketResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[3]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateRouteResponse) String() string {
+func (x *MsgRetryPacketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateRouteResponse) ProtoMessage() {}
+func (*MsgRetryPacketResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateRouteResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateRouteResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgRetryPacketResponse.ProtoReflect.Descriptor instead.
+func (*MsgRetryPacketResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{15}
 }
 
-// MsgUpdateSignalsAndInterval is the transaction message to update signals and interval of the tunnel.
-type MsgUpdateSignalsAndInterval struct {
+func (x *MsgRetryPacketResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MsgRetryPacketResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// MsgTransferTunnelOwnership is the transaction message to propose a new creator of the tunnel.
+// The ownership is transferred only after the new creator accepts it with MsgAcceptTunnelOwnership.
+type MsgTransferTunnelOwnership struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the ID of the tunnel to edit.
+	// tunnel_id is the ID of the tunnel to transfer.
 	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// signal_deviations is the list of signal deviations.
-	SignalDeviations []*SignalDeviation `protobuf:"bytes,2,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations,omitempty"`
-	// interval is the interval for delivering the signal prices.
-	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
-	// creator is the address of the creator.
-	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// new_creator is the address of the proposed new creator of the tunnel.
+	NewCreator string `protobuf:"bytes,2,opt,name=new_creator,json=newCreator,proto3" json:"new_creator,omitempty"`
+	// creator is the address of the current creator.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (x *MsgUpdateSignalsAndInterval) Reset() {
-	*x = MsgUpdateSignalsAndInterval{}
+func (x *MsgTransferTunnelOwnership) Reset() {
+	*x = MsgTransferTunnelOwnership{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[4]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateSignalsAndInterval) String() string {
+func (x *MsgTransferTunnelOwnership) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateSignalsAndInterval) ProtoMessage() {}
+func (*MsgTransferTunnelOwnership) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateSignalsAndInterval.ProtoReflect.Descriptor instead.
-func (*MsgUpdateSignalsAndInterval) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgTransferTunnelOwnership.ProtoReflect.Descriptor instead.
+func (*MsgTransferTunnelOwnership) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgUpdateSignalsAndInterval) GetTunnelId() uint64 {
+func (x *MsgTransferTunnelOwnership) GetTunnelId() uint64 {
 	if x != nil {
 		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *MsgUpdateSignalsAndInterval) GetSignalDeviations() []*SignalDeviation {
+func (x *MsgTransferTunnelOwnership) GetNewCreator() string {
 	if x != nil {
-		return x.SignalDeviations
+		return x.NewCreator
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgUpdateSignalsAndInterval) GetInterval() uint64 {
+func (x *MsgTransferTunnelOwnership) GetCreator() string {
 	if x != nil {
-		return x.Interval
+		return x.Creator
+	}
+	return ""
+}
+
+// MsgTransferTunnelOwnershipResponse is the response type for the Msg/TransferTunnelOwnership RPC method.
+type MsgTransferTunnelOwnershipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgTransferTunnelOwnershipResponse) Reset() {
+	*x = MsgTransferTunnelOwnershipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTransferTunnelOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTransferTunnelOwnershipResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgTransferTunnelOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*MsgTransferTunnelOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{17}
+}
+
+// MsgAcceptTunnelOwnership is the transaction message for the proposed creator to accept the ownership of the tunnel.
+type MsgAcceptTunnelOwnership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel to accept.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// new_creator is the address of the proposed new creator of the tunnel.
+	NewCreator string `protobuf:"bytes,2,opt,name=new_creator,json=newCreator,proto3" json:"new_creator,omitempty"`
+}
+
+func (x *MsgAcceptTunnelOwnership) Reset() {
+	*x = MsgAcceptTunnelOwnership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAcceptTunnelOwnership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAcceptTunnelOwnership) ProtoMessage() {}
+
+// Deprecated: Use MsgAcceptTunnelOwnership.ProtoReflect.Descriptor instead.
+func (*MsgAcceptTunnelOwnership) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgAcceptTunnelOwnership) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *MsgUpdateSignalsAndInterval) GetCreator() string {
+func (x *MsgAcceptTunnelOwnership) GetNewCreator() string {
 	if x != nil {
-		return x.Creator
+		return x.NewCreator
 	}
 	return ""
 }
 
-// MsgUpdateSignalsAndIntervalResponse is the response type for the Msg/UpdateSignalsAndInterval RPC method.
-type MsgUpdateSignalsAndIntervalResponse struct {
+// MsgAcceptTunnelOwnershipResponse is the response type for the Msg/AcceptTunnelOwnership RPC method.
+type MsgAcceptTunnelOwnershipResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateSignalsAndIntervalResponse) Reset() {
-	*x = MsgUpdateSignalsAndIntervalResponse{}
+func (x *MsgAcceptTunnelOwnershipResponse) Reset() {
+	*x = MsgAcceptTunnelOwnershipResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[5]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateSignalsAndIntervalResponse) String() string {
+func (x *MsgAcceptTunnelOwnershipResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateSignalsAndIntervalResponse) ProtoMessage() {}
+func (*MsgAcceptTunnelOwnershipResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateSignalsAndIntervalResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateSignalsAndIntervalResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgAcceptTunnelOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*MsgAcceptTunnelOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{19}
 }
 
-// MsgWithdrawFeePayerFunds is the transaction message to withdraw fee payer funds to creator.
-type MsgWithdrawFeePayerFunds struct {
+// MsgUpdateTunnelAdmins is the transaction message to update the co-administrators of the tunnel.
+type MsgUpdateTunnelAdmins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the ID of the tunnel to withdraw fee payer coins.
+	// tunnel_id is the ID of the tunnel to edit.
 	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// amount is the coins to withdraw.
-	Amount []*v1beta1.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
+	// admins is the new list of co-administrators of the tunnel; it replaces the existing list.
+	Admins []*TunnelAdmin `protobuf:"bytes,2,rep,name=admins,proto3" json:"admins,omitempty"`
 	// creator is the address of the creator.
 	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (x *MsgWithdrawFeePayerFunds) Reset() {
-	*x = MsgWithdrawFeePayerFunds{}
+func (x *MsgUpdateTunnelAdmins) Reset() {
+	*x = MsgUpdateTunnelAdmins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[6]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgWithdrawFeePayerFunds) String() string {
+func (x *MsgUpdateTunnelAdmins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgWithdrawFeePayerFunds) ProtoMessage() {}
+func (*MsgUpdateTunnelAdmins) ProtoMessage() {}
 
-// Deprecated: Use MsgWithdrawFeePayerFunds.ProtoReflect.Descriptor instead.
-func (*MsgWithdrawFeePayerFunds) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgUpdateTunnelAdmins.ProtoReflect.Descriptor instead.
+func (*MsgUpdateTunnelAdmins) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MsgWithdrawFeePayerFunds) GetTunnelId() uint64 {
+func (x *MsgUpdateTunnelAdmins) GetTunnelId() uint64 {
 	if x != nil {
 		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *MsgWithdrawFeePayerFunds) GetAmount() []*v1beta1.Coin {
+func (x *MsgUpdateTunnelAdmins) GetAdmins() []*TunnelAdmin {
 	if x != nil {
-		return x.Amount
+		return x.Admins
 	}
 	return nil
 }
 
-func (x *MsgWithdrawFeePayerFunds) GetCreator() string {
+func (x *MsgUpdateTunnelAdmins) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-// MsgWithdrawFeePayerFundsResponse is the response type for the Msg/WithdrawFeePayerFunds RPC method.
-type MsgWithdrawFeePayerFundsResponse struct {
+// MsgUpdateTunnelAdminsResponse is the response type for the Msg/UpdateTunnelAdmins RPC method.
+type MsgUpdateTunnelAdminsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgWithdrawFeePayerFundsResponse) Reset() {
-	*x = MsgWithdrawFeePayerFundsResponse{}
+func (x *MsgUpdateTunnelAdminsResponse) Reset() {
+	*x = MsgUpdateTunnelAdminsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[7]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgWithdrawFeePayerFundsResponse) String() string {
+func (x *MsgUpdateTunnelAdminsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgWithdrawFeePayerFundsResponse) ProtoMessage() {}
+func (*MsgUpdateTunnelAdminsResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgWithdrawFeePayerFundsResponse.ProtoReflect.Descriptor instead.
-func (*MsgWithdrawFeePayerFundsResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgUpdateTunnelAdminsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateTunnelAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{21}
 }
 
-// MsgActivateTunnel is the transaction message to activate a tunnel.
-type MsgActivateTunnel struct {
+// MsgApproveFundingSource is the transaction message for the creator to approve the funding account
+// that can set the funding policy of the tunnel.
+type MsgApproveFundingSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the ID of the tunnel to activate.
+	// tunnel_id is the ID of the tunnel.
 	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// source is the address of the approved funding account; an empty source revokes the approval.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 	// creator is the address of the creator.
-	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (x *MsgActivateTunnel) Reset() {
-	*x = MsgActivateTunnel{}
+func (x *MsgApproveFundingSource) Reset() {
+	*x = MsgApproveFundingSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[8]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgActivateTunnel) String() string {
+func (x *MsgApproveFundingSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgActivateTunnel) ProtoMessage() {}
+func (*MsgApproveFundingSource) ProtoMessage() {}
 
-// Deprecated: Use MsgActivateTunnel.ProtoReflect.Descriptor instead.
-func (*MsgActivateTunnel) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgApproveFundingSource.ProtoReflect.Descriptor instead.
+func (*MsgApproveFundingSource) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MsgActivateTunnel) GetTunnelId() uint64 {
+func (x *MsgApproveFundingSource) GetTunnelId() uint64 {
 	if x != nil {
 		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *MsgActivateTunnel) GetCreator() string {
+func (x *MsgApproveFundingSource) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MsgApproveFundingSource) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-// MsgActivateTunnelResponse is the response type for the Msg/Activate RPC method.
-type MsgActivateTunnelResponse struct {
+// MsgApproveFundingSourceResponse is the response type for the Msg/ApproveFundingSource RPC method.
+type MsgApproveFundingSourceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgActivateTunnelResponse) Reset() {
-	*x = MsgActivateTunnelResponse{}
+func (x *MsgApproveFundingSourceResponse) Reset() {
+	*x = MsgApproveFundingSourceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[9]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgActivateTunnelResponse) String() string {
+func (x *MsgApproveFundingSourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgActivateTunnelResponse) ProtoMessage() {}
+func (*MsgApproveFundingSourceResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgActivateTunnelResponse.ProtoReflect.Descriptor instead.
-func (*MsgActivateTunnelResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgApproveFundingSourceResponse.ProtoReflect.Descriptor instead.
+func (*MsgApproveFundingSourceResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{23}
 }
 
-// MsgDeactivateTunnel is the transaction message to deactivate a tunnel.
-type MsgDeactivateTunnel struct {
+// MsgSetFundingPolicy is the transaction message to grant an allowance from a funding account
+// to top up the fee payer of the tunnel. The funding account must be approved by the tunnel creator.
+type MsgSetFundingPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the ID of the tunnel to deactivate.
+	// tunnel_id is the ID of the tunnel to fund.
 	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// creator is the address of the creator.
-	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-}
-
-func (x *MsgDeactivateTunnel) Reset() {
-	*x = MsgDeactivateTunnel{}
+	// allowance is the maximum amount of coins that can be pulled from the funding account.
+	Allowance []*v1beta1.Coin `protobuf:"bytes,2,rep,name=allowance,proto3" json:"allowance,omitempty"`
+	// threshold is the fee payer balance below which the fee payer is topped up.
+	Threshold []*v1beta1.Coin `protobuf:"bytes,3,rep,name=threshold,proto3" json:"threshold,omitempty"`
+	// top_up_amount is the amount of coins sent to the fee payer on each top-up.
+	TopUpAmount []*v1beta1.Coin `protobuf:"bytes,4,rep,name=top_up_amount,json=topUpAmount,proto3" json:"top_up_amount,omitempty"`
+	// source is the address of the funding account.
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *MsgSetFundingPolicy) Reset() {
+	*x = MsgSetFundingPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[10]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgDeactivateTunnel) String() string {
+func (x *MsgSetFundingPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgDeactivateTunnel) ProtoMessage() {}
+func (*MsgSetFundingPolicy) ProtoMessage() {}
 
-// Deprecated: Use MsgDeactivateTunnel.ProtoReflect.Descriptor instead.
-func (*MsgDeactivateTunnel) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgSetFundingPolicy.ProtoReflect.Descriptor instead.
+func (*MsgSetFundingPolicy) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MsgDeactivateTunnel) GetTunnelId() uint64 {
+func (x *MsgSetFundingPolicy) GetTunnelId() uint64 {
 	if x != nil {
 		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *MsgDeactivateTunnel) GetCreator() string {
+func (x *MsgSetFundingPolicy) GetAllowance() []*v1beta1.Coin {
 	if x != nil {
-		return x.Creator
+		return x.Allowance
+	}
+	return nil
+}
+
+func (x *MsgSetFundingPolicy) GetThreshold() []*v1beta1.Coin {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+func (x *MsgSetFundingPolicy) GetTopUpAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.TopUpAmount
+	}
+	return nil
+}
+
+func (x *MsgSetFundingPolicy) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-// MsgDeactivateTunnelResponse is the response type for the Msg/Deactivate RPC method.
-type MsgDeactivateTunnelResponse struct {
+// MsgSetFundingPolicyResponse is the response type for the Msg/SetFundingPolicy RPC method.
+type MsgSetFundingPolicyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
-
-func (x *MsgDeactivateTunnelResponse) Reset() {
-	*x = MsgDeactivateTunnelResponse{}
+
+func (x *MsgSetFundingPolicyResponse) Reset() {
+	*x = MsgSetFundingPolicyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[11]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgDeactivateTunnelResponse) String() string {
+func (x *MsgSetFundingPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgDeactivateTunnelResponse) ProtoMessage() {}
+func (*MsgSetFundingPolicyResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgDeactivateTunnelResponse.ProtoReflect.Descriptor instead.
-func (*MsgDeactivateTunnelResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgSetFundingPolicyResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetFundingPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{25}
 }
 
-// MsgTriggerTunnel is the transaction message to manually trigger a tunnel.
-type MsgTriggerTunnel struct {
+// MsgRemoveFundingPolicy is the transaction message to remove the funding policy of the tunnel.
+type MsgRemoveFundingPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tunnel_id is the ID of the tunnel to manually trigger.
+	// tunnel_id is the ID of the tunnel.
 	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// creator is the address of the creator.
-	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// sender is the address of the funding account or the tunnel creator.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (x *MsgTriggerTunnel) Reset() {
-	*x = MsgTriggerTunnel{}
+func (x *MsgRemoveFundingPolicy) Reset() {
+	*x = MsgRemoveFundingPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[12]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgTriggerTunnel) String() string {
+func (x *MsgRemoveFundingPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgTriggerTunnel) ProtoMessage() {}
+func (*MsgRemoveFundingPolicy) ProtoMessage() {}
 
-// Deprecated: Use MsgTriggerTunnel.ProtoReflect.Descriptor instead.
-func (*MsgTriggerTunnel) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgRemoveFundingPolicy.ProtoReflect.Descriptor instead.
+func (*MsgRemoveFundingPolicy) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MsgTriggerTunnel) GetTunnelId() uint64 {
+func (x *MsgRemoveFundingPolicy) GetTunnelId() uint64 {
 	if x != nil {
 		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *MsgTriggerTunnel) GetCreator() string {
+func (x *MsgRemoveFundingPolicy) GetSender() string {
 	if x != nil {
-		return x.Creator
+		return x.Sender
 	}
 	return ""
 }
 
-// MsgTriggerTunnelResponse is the response type for the Msg/TriggerTunnel RPC method.
-type MsgTriggerTunnelResponse struct {
+// MsgRemoveFundingPolicyResponse is the response type for the Msg/RemoveFundingPolicy RPC method.
+type MsgRemoveFundingPolicyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgTriggerTunnelResponse) Reset() {
-	*x = MsgTriggerTunnelResponse{}
+func (x *MsgRemoveFundingPolicyResponse) Reset() {
+	*x = MsgRemoveFundingPolicyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[13]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgTriggerTunnelResponse) String() string {
+func (x *MsgRemoveFundingPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgTriggerTunnelResponse) ProtoMessage() {}
+func (*MsgRemoveFundingPolicyResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgTriggerTunnelResponse.ProtoReflect.Descriptor instead.
-func (*MsgTriggerTunnelResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgRemoveFundingPolicyResponse.ProtoReflect.Descriptor instead.
+func (*MsgRemoveFundingPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{27}
 }
 
 // MsgDepositToTunnel defines a message to deposit to an existing tunnel.
@@ -9984,7 +17760,7 @@ type MsgDepositToTunnel struct {
 func (x *MsgDepositToTunnel) Reset() {
 	*x = MsgDepositToTunnel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[14]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9998,7 +17774,7 @@ func (*MsgDepositToTunnel) ProtoMessage() {}
 
 // Deprecated: Use MsgDepositToTunnel.ProtoReflect.Descriptor instead.
 func (*MsgDepositToTunnel) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{14}
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MsgDepositToTunnel) GetTunnelId() uint64 {
@@ -10032,7 +17808,7 @@ type MsgDepositToTunnelResponse struct {
 func (x *MsgDepositToTunnelResponse) Reset() {
 	*x = MsgDepositToTunnelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[15]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10046,7 +17822,7 @@ func (*MsgDepositToTunnelResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgDepositToTunnelResponse.ProtoReflect.Descriptor instead.
 func (*MsgDepositToTunnelResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{15}
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{29}
 }
 
 // MsgWithdrawFromTunnel is the transaction message to withdraw a deposit from an existing tunnel.
@@ -10066,7 +17842,7 @@ type MsgWithdrawFromTunnel struct {
 func (x *MsgWithdrawFromTunnel) Reset() {
 	*x = MsgWithdrawFromTunnel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[16]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10080,7 +17856,7 @@ func (*MsgWithdrawFromTunnel) ProtoMessage() {}
 
 // Deprecated: Use MsgWithdrawFromTunnel.ProtoReflect.Descriptor instead.
 func (*MsgWithdrawFromTunnel) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{16}
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *MsgWithdrawFromTunnel) GetTunnelId() uint64 {
@@ -10114,7 +17890,7 @@ type MsgWithdrawFromTunnelResponse struct {
 func (x *MsgWithdrawFromTunnelResponse) Reset() {
 	*x = MsgWithdrawFromTunnelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[17]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10128,7 +17904,7 @@ func (*MsgWithdrawFromTunnelResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgWithdrawFromTunnelResponse.ProtoReflect.Descriptor instead.
 func (*MsgWithdrawFromTunnelResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{17}
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{31}
 }
 
 // MsgUpdateParams is the transaction message to update parameters.
@@ -10146,7 +17922,7 @@ type MsgUpdateParams struct {
 func (x *MsgUpdateParams) Reset() {
 	*x = MsgUpdateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[18]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10160,7 +17936,7 @@ func (*MsgUpdateParams) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{18}
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *MsgUpdateParams) GetAuthority() string {
@@ -10187,7 +17963,7 @@ type MsgUpdateParamsResponse struct {
 func (x *MsgUpdateParamsResponse) Reset() {
 	*x = MsgUpdateParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[19]
+		mi := &file_band_tunnel_v1beta1_tx_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10201,7 +17977,7 @@ func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{19}
+	return file_band_tunnel_v1beta1_tx_proto_rawDescGZIP(), []int{33}
 }
 
 var File_band_tunnel_v1beta1_tx_proto protoreflect.FileDescriptor
@@ -10224,7 +18000,7 @@ var file_band_tunnel_v1beta1_tx_proto_rawDesc = []byte{
 	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x62,
 	0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x96, 0x03, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e,
+	0xbf, 0x04, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e,
 	0x6e, 0x65, 0x6c, 0x12, 0x57, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x65,
 	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
@@ -10246,241 +18022,440 @@ var file_band_tunnel_v1beta1_tx_proto_rawDesc = []byte{
 	0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63,
 	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
 	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a,
-	0x27, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x16, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x44, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xcf,
-	0x01, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x42, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x52, 0x05, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x26, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x1b, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x41,
-	0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x64,
+	0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76,
+	0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e,
+	0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x38,
+	0x0a, 0x18, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x27, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x22, 0x44, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75,
 	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2,
 	0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f,
-	0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x76,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x33,
-	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x22, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x0a, 0xca, 0xb4, 0x2d, 0x06,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x32, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x3a, 0x26, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x15, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xcf, 0x03, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x76, 0x61, 0x6c, 0x22, 0x25, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x02, 0x0a, 0x18, 0x4d,
-	0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79,
-	0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8,
-	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
-	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x3a, 0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7,
-	0xb0, 0x2a, 0x1f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x46, 0x75, 0x6e,
-	0x64, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x09,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x29, 0x82, 0xe7, 0xb0,
-	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x09, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c,
-	0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x76, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x57,
+	0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x22, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x25, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x02, 0x0a,
+	0x18, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50,
+	0x61, 0x79, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde,
+	0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x3a, 0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x46,
+	0x75, 0x6e, 0x64, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29,
+	0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52,
+	0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x29, 0x82,
+	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x18,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a,
+	0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x2b, 0x82, 0xe7,
+	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67,
+	0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a,
+	0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x28, 0x82, 0xe7,
+	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x25,
+	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x15,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x4a, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0xea, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x6e,
+	0x65, 0x77, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x2b, 0x82, 0xe7, 0xb0, 0x2a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x44, 0x65,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x09, 0x74,
+	0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x32, 0x82, 0xe7, 0xb0, 0x2a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0x24,
+	0x0a, 0x22, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b,
+	0x6e, 0x65, 0x77, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x6e, 0x65, 0x77,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x34, 0x82, 0xe7, 0xb0, 0x2a, 0x0b, 0x6e, 0x65,
+	0x77, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0x22, 0x0a,
+	0x20, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xe5, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x74,
 	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c,
 	0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x28, 0x82, 0xe7, 0xb0, 0x2a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x8f, 0x02, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54,
-	0x6f, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8,
-	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
-	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x09,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x3a, 0x2c, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f,
-	0x4d, 0x73, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x6f, 0x54, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x54, 0x6f, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x98, 0x02, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x46, 0x72, 0x6f, 0x6d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2,
-	0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x2d, 0x82, 0xe7, 0xb0, 0x2a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdb, 0x01, 0x0a, 0x17, 0x4d,
+	0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x2f, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2f, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf4, 0x03, 0x0a, 0x13,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x6e,
+	0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x6e,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x74,
+	0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x5f, 0x75, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
 	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
 	0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x38, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x77,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x3a, 0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x0a,
-	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x1f, 0x0a, 0x1d, 0x4d,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x55, 0x70, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x2a, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d,
+	0x73, 0x67, 0x53, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xa4, 0x01, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x29, 0x0a, 0x09,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x2d, 0x82, 0xe7, 0xb0, 0x2a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x02, 0x0a, 0x12, 0x4d,
+	0x73, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x6f, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x68, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x3a, 0x2c,
+	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x19, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x54, 0x6f, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x1c, 0x0a, 0x1a,
+	0x4d, 0x73, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x6f, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98, 0x02, 0x0a, 0x15, 0x4d,
 	0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x01, 0x0a,
-	0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x3a, 0x29, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19,
-	0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe1, 0x08, 0x0a, 0x03, 0x4d, 0x73,
-	0x67, 0x12, 0x62, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x12, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x65, 0x72, 0x3a, 0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f,
+	0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x72, 0x6f, 0x6d, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x29, 0x82,
+	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x16, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xa2, 0x0f, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x62, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x24, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x1a, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x1a, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x76, 0x61, 0x6c, 0x12, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5f, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x23,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x1a, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
 	0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0x38, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x41, 0x6e, 0x64, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x7d, 0x0a, 0x15, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61,
-	0x79, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x86, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x73, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x30, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x73, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a,
+	0x38, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x15, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x46, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64,
+	0x73, 0x1a, 0x35, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x1a, 0x2e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6e, 0x0a, 0x10, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x1a, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x65, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0b, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
 	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79,
-	0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x1a, 0x35, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x73, 0x67, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x2b, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x17, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x1a, 0x37, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
 	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65,
-	0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
-	0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x2e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7d, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x1a, 0x35, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
 	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x10, 0x44, 0x65, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x28, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75,
+	0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x74, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x73, 0x1a, 0x32, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2c, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x34, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6e, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a,
+	0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x77, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x33, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0f, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x6f, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x27, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x6f,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75,
 	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x6f, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x12, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2a, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46,
+	0x72, 0x6f, 0x6d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x32, 0x2e, 0x62, 0x61, 0x6e, 0x64,
 	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x6b, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x6f, 0x54, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x12, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x54, 0x6f, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x2f, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x6f, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x12,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x12, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x32,
+	0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x72, 0x6f, 0x6d, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x1a, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xd8, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x46, 0x72, 0x6f, 0x6d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x62, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xd8, 0x01,
-	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42,
-	0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
-	0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x74, 0x61, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
+	0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13,
+	0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64,
+	0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61,
+	0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10495,7 +18470,7 @@ func file_band_tunnel_v1beta1_tx_proto_rawDescGZIP() []byte {
 	return file_band_tunnel_v1beta1_tx_proto_rawDescData
 }
 
-var file_band_tunnel_v1beta1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_band_tunnel_v1beta1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_band_tunnel_v1beta1_tx_proto_goTypes = []interface{}{
 	(*MsgCreateTunnel)(nil),                     // 0: band.tunnel.v1beta1.MsgCreateTunnel
 	(*MsgCreateTunnelResponse)(nil),             // 1: band.tunnel.v1beta1.MsgCreateTunnelResponse
@@ -10511,52 +18486,88 @@ var file_band_tunnel_v1beta1_tx_proto_goTypes = []interface{}{
 	(*MsgDeactivateTunnelResponse)(nil),         // 11: band.tunnel.v1beta1.MsgDeactivateTunnelResponse
 	(*MsgTriggerTunnel)(nil),                    // 12: band.tunnel.v1beta1.MsgTriggerTunnel
 	(*MsgTriggerTunnelResponse)(nil),            // 13: band.tunnel.v1beta1.MsgTriggerTunnelResponse
-	(*MsgDepositToTunnel)(nil),                  // 14: band.tunnel.v1beta1.MsgDepositToTunnel
-	(*MsgDepositToTunnelResponse)(nil),          // 15: band.tunnel.v1beta1.MsgDepositToTunnelResponse
-	(*MsgWithdrawFromTunnel)(nil),               // 16: band.tunnel.v1beta1.MsgWithdrawFromTunnel
-	(*MsgWithdrawFromTunnelResponse)(nil),       // 17: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse
-	(*MsgUpdateParams)(nil),                     // 18: band.tunnel.v1beta1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),             // 19: band.tunnel.v1beta1.MsgUpdateParamsResponse
-	(*SignalDeviation)(nil),                     // 20: band.tunnel.v1beta1.SignalDeviation
-	(*anypb.Any)(nil),                           // 21: google.protobuf.Any
-	(*v1beta1.Coin)(nil),                        // 22: cosmos.base.v1beta1.Coin
-	(*Params)(nil),                              // 23: band.tunnel.v1beta1.Params
+	(*MsgRetryPacket)(nil),                      // 14: band.tunnel.v1beta1.MsgRetryPacket
+	(*MsgRetryPacketResponse)(nil),              // 15: band.tunnel.v1beta1.MsgRetryPacketResponse
+	(*MsgTransferTunnelOwnership)(nil),          // 16: band.tunnel.v1beta1.MsgTransferTunnelOwnership
+	(*MsgTransferTunnelOwnershipResponse)(nil),  // 17: band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse
+	(*MsgAcceptTunnelOwnership)(nil),            // 18: band.tunnel.v1beta1.MsgAcceptTunnelOwnership
+	(*MsgAcceptTunnelOwnershipResponse)(nil),    // 19: band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse
+	(*MsgUpdateTunnelAdmins)(nil),               // 20: band.tunnel.v1beta1.MsgUpdateTunnelAdmins
+	(*MsgUpdateTunnelAdminsResponse)(nil),       // 21: band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse
+	(*MsgApproveFundingSource)(nil),             // 22: band.tunnel.v1beta1.MsgApproveFundingSource
+	(*MsgApproveFundingSourceResponse)(nil),     // 23: band.tunnel.v1beta1.MsgApproveFundingSourceResponse
+	(*MsgSetFundingPolicy)(nil),                 // 24: band.tunnel.v1beta1.MsgSetFundingPolicy
+	(*MsgSetFundingPolicyResponse)(nil),         // 25: band.tunnel.v1beta1.MsgSetFundingPolicyResponse
+	(*MsgRemoveFundingPolicy)(nil),              // 26: band.tunnel.v1beta1.MsgRemoveFundingPolicy
+	(*MsgRemoveFundingPolicyResponse)(nil),      // 27: band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse
+	(*MsgDepositToTunnel)(nil),                  // 28: band.tunnel.v1beta1.MsgDepositToTunnel
+	(*MsgDepositToTunnelResponse)(nil),          // 29: band.tunnel.v1beta1.MsgDepositToTunnelResponse
+	(*MsgWithdrawFromTunnel)(nil),               // 30: band.tunnel.v1beta1.MsgWithdrawFromTunnel
+	(*MsgWithdrawFromTunnelResponse)(nil),       // 31: band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse
+	(*MsgUpdateParams)(nil),                     // 32: band.tunnel.v1beta1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),             // 33: band.tunnel.v1beta1.MsgUpdateParamsResponse
+	(*SignalDeviation)(nil),                     // 34: band.tunnel.v1beta1.SignalDeviation
+	(*anypb.Any)(nil),                           // 35: google.protobuf.Any
+	(*v1beta1.Coin)(nil),                        // 36: cosmos.base.v1beta1.Coin
+	(*DerivedSignal)(nil),                       // 37: band.tunnel.v1beta1.DerivedSignal
+	(*TunnelAdmin)(nil),                         // 38: band.tunnel.v1beta1.TunnelAdmin
+	(*Params)(nil),                              // 39: band.tunnel.v1beta1.Params
 }
 var file_band_tunnel_v1beta1_tx_proto_depIdxs = []int32{
-	20, // 0: band.tunnel.v1beta1.MsgCreateTunnel.signal_deviations:type_name -> band.tunnel.v1beta1.SignalDeviation
-	21, // 1: band.tunnel.v1beta1.MsgCreateTunnel.route:type_name -> google.protobuf.Any
-	22, // 2: band.tunnel.v1beta1.MsgCreateTunnel.initial_deposit:type_name -> cosmos.base.v1beta1.Coin
-	21, // 3: band.tunnel.v1beta1.MsgUpdateRoute.route:type_name -> google.protobuf.Any
-	20, // 4: band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.signal_deviations:type_name -> band.tunnel.v1beta1.SignalDeviation
-	22, // 5: band.tunnel.v1beta1.MsgWithdrawFeePayerFunds.amount:type_name -> cosmos.base.v1beta1.Coin
-	22, // 6: band.tunnel.v1beta1.MsgDepositToTunnel.amount:type_name -> cosmos.base.v1beta1.Coin
-	22, // 7: band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount:type_name -> cosmos.base.v1beta1.Coin
-	23, // 8: band.tunnel.v1beta1.MsgUpdateParams.params:type_name -> band.tunnel.v1beta1.Params
-	0,  // 9: band.tunnel.v1beta1.Msg.CreateTunnel:input_type -> band.tunnel.v1beta1.MsgCreateTunnel
-	2,  // 10: band.tunnel.v1beta1.Msg.UpdateRoute:input_type -> band.tunnel.v1beta1.MsgUpdateRoute
-	4,  // 11: band.tunnel.v1beta1.Msg.UpdateSignalsAndInterval:input_type -> band.tunnel.v1beta1.MsgUpdateSignalsAndInterval
-	6,  // 12: band.tunnel.v1beta1.Msg.WithdrawFeePayerFunds:input_type -> band.tunnel.v1beta1.MsgWithdrawFeePayerFunds
-	8,  // 13: band.tunnel.v1beta1.Msg.ActivateTunnel:input_type -> band.tunnel.v1beta1.MsgActivateTunnel
-	10, // 14: band.tunnel.v1beta1.Msg.DeactivateTunnel:input_type -> band.tunnel.v1beta1.MsgDeactivateTunnel
-	12, // 15: band.tunnel.v1beta1.Msg.TriggerTunnel:input_type -> band.tunnel.v1beta1.MsgTriggerTunnel
-	14, // 16: band.tunnel.v1beta1.Msg.DepositToTunnel:input_type -> band.tunnel.v1beta1.MsgDepositToTunnel
-	16, // 17: band.tunnel.v1beta1.Msg.WithdrawFromTunnel:input_type -> band.tunnel.v1beta1.MsgWithdrawFromTunnel
-	18, // 18: band.tunnel.v1beta1.Msg.UpdateParams:input_type -> band.tunnel.v1beta1.MsgUpdateParams
-	1,  // 19: band.tunnel.v1beta1.Msg.CreateTunnel:output_type -> band.tunnel.v1beta1.MsgCreateTunnelResponse
-	3,  // 20: band.tunnel.v1beta1.Msg.UpdateRoute:output_type -> band.tunnel.v1beta1.MsgUpdateRouteResponse
-	5,  // 21: band.tunnel.v1beta1.Msg.UpdateSignalsAndInterval:output_type -> band.tunnel.v1beta1.MsgUpdateSignalsAndIntervalResponse
-	7,  // 22: band.tunnel.v1beta1.Msg.WithdrawFeePayerFunds:output_type -> band.tunnel.v1beta1.MsgWithdrawFeePayerFundsResponse
-	9,  // 23: band.tunnel.v1beta1.Msg.ActivateTunnel:output_type -> band.tunnel.v1beta1.MsgActivateTunnelResponse
-	11, // 24: band.tunnel.v1beta1.Msg.DeactivateTunnel:output_type -> band.tunnel.v1beta1.MsgDeactivateTunnelResponse
-	13, // 25: band.tunnel.v1beta1.Msg.TriggerTunnel:output_type -> band.tunnel.v1beta1.MsgTriggerTunnelResponse
-	15, // 26: band.tunnel.v1beta1.Msg.DepositToTunnel:output_type -> band.tunnel.v1beta1.MsgDepositToTunnelResponse
-	17, // 27: band.tunnel.v1beta1.Msg.WithdrawFromTunnel:output_type -> band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse
-	19, // 28: band.tunnel.v1beta1.Msg.UpdateParams:output_type -> band.tunnel.v1beta1.MsgUpdateParamsResponse
-	19, // [19:29] is the sub-list for method output_type
-	9,  // [9:19] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	34, // 0: band.tunnel.v1beta1.MsgCreateTunnel.signal_deviations:type_name -> band.tunnel.v1beta1.SignalDeviation
+	35, // 1: band.tunnel.v1beta1.MsgCreateTunnel.route:type_name -> google.protobuf.Any
+	36, // 2: band.tunnel.v1beta1.MsgCreateTunnel.initial_deposit:type_name -> cosmos.base.v1beta1.Coin
+	37, // 3: band.tunnel.v1beta1.MsgCreateTunnel.derived_signals:type_name -> band.tunnel.v1beta1.DerivedSignal
+	35, // 4: band.tunnel.v1beta1.MsgUpdateRoute.route:type_name -> google.protobuf.Any
+	34, // 5: band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.signal_deviations:type_name -> band.tunnel.v1beta1.SignalDeviation
+	37, // 6: band.tunnel.v1beta1.MsgUpdateSignalsAndInterval.derived_signals:type_name -> band.tunnel.v1beta1.DerivedSignal
+	36, // 7: band.tunnel.v1beta1.MsgWithdrawFeePayerFunds.amount:type_name -> cosmos.base.v1beta1.Coin
+	38, // 8: band.tunnel.v1beta1.MsgUpdateTunnelAdmins.admins:type_name -> band.tunnel.v1beta1.TunnelAdmin
+	36, // 9: band.tunnel.v1beta1.MsgSetFundingPolicy.allowance:type_name -> cosmos.base.v1beta1.Coin
+	36, // 10: band.tunnel.v1beta1.MsgSetFundingPolicy.threshold:type_name -> cosmos.base.v1beta1.Coin
+	36, // 11: band.tunnel.v1beta1.MsgSetFundingPolicy.top_up_amount:type_name -> cosmos.base.v1beta1.Coin
+	36, // 12: band.tunnel.v1beta1.MsgDepositToTunnel.amount:type_name -> cosmos.base.v1beta1.Coin
+	36, // 13: band.tunnel.v1beta1.MsgWithdrawFromTunnel.amount:type_name -> cosmos.base.v1beta1.Coin
+	39, // 14: band.tunnel.v1beta1.MsgUpdateParams.params:type_name -> band.tunnel.v1beta1.Params
+	0,  // 15: band.tunnel.v1beta1.Msg.CreateTunnel:input_type -> band.tunnel.v1beta1.MsgCreateTunnel
+	2,  // 16: band.tunnel.v1beta1.Msg.UpdateRoute:input_type -> band.tunnel.v1beta1.MsgUpdateRoute
+	4,  // 17: band.tunnel.v1beta1.Msg.UpdateSignalsAndInterval:input_type -> band.tunnel.v1beta1.MsgUpdateSignalsAndInterval
+	6,  // 18: band.tunnel.v1beta1.Msg.WithdrawFeePayerFunds:input_type -> band.tunnel.v1beta1.MsgWithdrawFeePayerFunds
+	8,  // 19: band.tunnel.v1beta1.Msg.ActivateTunnel:input_type -> band.tunnel.v1beta1.MsgActivateTunnel
+	10, // 20: band.tunnel.v1beta1.Msg.DeactivateTunnel:input_type -> band.tunnel.v1beta1.MsgDeactivateTunnel
+	12, // 21: band.tunnel.v1beta1.Msg.TriggerTunnel:input_type -> band.tunnel.v1beta1.MsgTriggerTunnel
+	14, // 22: band.tunnel.v1beta1.Msg.RetryPacket:input_type -> band.tunnel.v1beta1.MsgRetryPacket
+	16, // 23: band.tunnel.v1beta1.Msg.TransferTunnelOwnership:input_type -> band.tunnel.v1beta1.MsgTransferTunnelOwnership
+	18, // 24: band.tunnel.v1beta1.Msg.AcceptTunnelOwnership:input_type -> band.tunnel.v1beta1.MsgAcceptTunnelOwnership
+	20, // 25: band.tunnel.v1beta1.Msg.UpdateTunnelAdmins:input_type -> band.tunnel.v1beta1.MsgUpdateTunnelAdmins
+	22, // 26: band.tunnel.v1beta1.Msg.ApproveFundingSource:input_type -> band.tunnel.v1beta1.MsgApproveFundingSource
+	24, // 27: band.tunnel.v1beta1.Msg.SetFundingPolicy:input_type -> band.tunnel.v1beta1.MsgSetFundingPolicy
+	26, // 28: band.tunnel.v1beta1.Msg.RemoveFundingPolicy:input_type -> band.tunnel.v1beta1.MsgRemoveFundingPolicy
+	28, // 29: band.tunnel.v1beta1.Msg.DepositToTunnel:input_type -> band.tunnel.v1beta1.MsgDepositToTunnel
+	30, // 30: band.tunnel.v1beta1.Msg.WithdrawFromTunnel:input_type -> band.tunnel.v1beta1.MsgWithdrawFromTunnel
+	32, // 31: band.tunnel.v1beta1.Msg.UpdateParams:input_type -> band.tunnel.v1beta1.MsgUpdateParams
+	1,  // 32: band.tunnel.v1beta1.Msg.CreateTunnel:output_type -> band.tunnel.v1beta1.MsgCreateTunnelResponse
+	3,  // 33: band.tunnel.v1beta1.Msg.UpdateRoute:output_type -> band.tunnel.v1beta1.MsgUpdateRouteResponse
+	5,  // 34: band.tunnel.v1beta1.Msg.UpdateSignalsAndInterval:output_type -> band.tunnel.v1beta1.MsgUpdateSignalsAndIntervalResponse
+	7,  // 35: band.tunnel.v1beta1.Msg.WithdrawFeePayerFunds:output_type -> band.tunnel.v1beta1.MsgWithdrawFeePayerFundsResponse
+	9,  // 36: band.tunnel.v1beta1.Msg.ActivateTunnel:output_type -> band.tunnel.v1beta1.MsgActivateTunnelResponse
+	11, // 37: band.tunnel.v1beta1.Msg.DeactivateTunnel:output_type -> band.tunnel.v1beta1.MsgDeactivateTunnelResponse
+	13, // 38: band.tunnel.v1beta1.Msg.TriggerTunnel:output_type -> band.tunnel.v1beta1.MsgTriggerTunnelResponse
+	15, // 39: band.tunnel.v1beta1.Msg.RetryPacket:output_type -> band.tunnel.v1beta1.MsgRetryPacketResponse
+	17, // 40: band.tunnel.v1beta1.Msg.TransferTunnelOwnership:output_type -> band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse
+	19, // 41: band.tunnel.v1beta1.Msg.AcceptTunnelOwnership:output_type -> band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse
+	21, // 42: band.tunnel.v1beta1.Msg.UpdateTunnelAdmins:output_type -> band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse
+	23, // 43: band.tunnel.v1beta1.Msg.ApproveFundingSource:output_type -> band.tunnel.v1beta1.MsgApproveFundingSourceResponse
+	25, // 44: band.tunnel.v1beta1.Msg.SetFundingPolicy:output_type -> band.tunnel.v1beta1.MsgSetFundingPolicyResponse
+	27, // 45: band.tunnel.v1beta1.Msg.RemoveFundingPolicy:output_type -> band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse
+	29, // 46: band.tunnel.v1beta1.Msg.DepositToTunnel:output_type -> band.tunnel.v1beta1.MsgDepositToTunnelResponse
+	31, // 47: band.tunnel.v1beta1.Msg.WithdrawFromTunnel:output_type -> band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse
+	33, // 48: band.tunnel.v1beta1.Msg.UpdateParams:output_type -> band.tunnel.v1beta1.MsgUpdateParamsResponse
+	32, // [32:49] is the sub-list for method output_type
+	15, // [15:32] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_band_tunnel_v1beta1_tx_proto_init() }
@@ -10736,7 +18747,7 @@ func file_band_tunnel_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDepositToTunnel); i {
+			switch v := v.(*MsgRetryPacket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10748,7 +18759,7 @@ func file_band_tunnel_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDepositToTunnelResponse); i {
+			switch v := v.(*MsgRetryPacketResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10760,7 +18771,7 @@ func file_band_tunnel_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgWithdrawFromTunnel); i {
+			switch v := v.(*MsgTransferTunnelOwnership); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10772,7 +18783,7 @@ func file_band_tunnel_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgWithdrawFromTunnelResponse); i {
+			switch v := v.(*MsgTransferTunnelOwnershipResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10784,7 +18795,7 @@ func file_band_tunnel_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParams); i {
+			switch v := v.(*MsgAcceptTunnelOwnership); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10796,6 +18807,174 @@ func file_band_tunnel_v1beta1_tx_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAcceptTunnelOwnershipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateTunnelAdmins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateTunnelAdminsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgApproveFundingSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgApproveFundingSourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetFundingPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetFundingPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRemoveFundingPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRemoveFundingPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDepositToTunnel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDepositToTunnelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgWithdrawFromTunnel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgWithdrawFromTunnelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tx_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUpdateParamsResponse); i {
 			case 0:
 				return &v.state
@@ -10814,7 +18993,7 @@ func file_band_tunnel_v1beta1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_tunnel_v1beta1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,13 @@ const (
 	Msg_ActivateTunnel_FullMethodName           = "/band.tunnel.v1beta1.Msg/ActivateTunnel"
 	Msg_DeactivateTunnel_FullMethodName         = "/band.tunnel.v1beta1.Msg/DeactivateTunnel"
 	Msg_TriggerTunnel_FullMethodName            = "/band.tunnel.v1beta1.Msg/TriggerTunnel"
+	Msg_RetryPacket_FullMethodName              = "/band.tunnel.v1beta1.Msg/RetryPacket"
+	Msg_TransferTunnelOwnership_FullMethodName  = "/band.tunnel.v1beta1.Msg/TransferTunnelOwnership"
+	Msg_AcceptTunnelOwnership_FullMethodName    = "/band.tunnel.v1beta1.Msg/AcceptTunnelOwnership"
+	Msg_UpdateTunnelAdmins_FullMethodName       = "/band.tunnel.v1beta1.Msg/UpdateTunnelAdmins"
+	Msg_ApproveFundingSource_FullMethodName     = "/band.tunnel.v1beta1.Msg/ApproveFundingSource"
+	Msg_SetFundingPolicy_FullMethodName         = "/band.tunnel.v1beta1.Msg/SetFundingPolicy"
+	Msg_RemoveFundingPolicy_FullMethodName      = "/band.tunnel.v1beta1.Msg/RemoveFundingPolicy"
 	Msg_DepositToTunnel_FullMethodName          = "/band.tunnel.v1beta1.Msg/DepositToTunnel"
 	Msg_WithdrawFromTunnel_FullMethodName       = "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel"
 	Msg_UpdateParams_FullMethodName             = "/band.tunnel.v1beta1.Msg/UpdateParams"
@@ -49,6 +56,20 @@ type MsgClient interface {
 	DeactivateTunnel(ctx context.Context, in *MsgDeactivateTunnel, opts ...grpc.CallOption) (*MsgDeactivateTunnelResponse, error)
 	// TriggerTunnel is a RPC method to manually trigger a tunnel.
 	TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opts ...grpc.CallOption) (*MsgTriggerTunnelResponse, error)
+	// RetryPacket is a RPC method to resend a failed packet of the tunnel.
+	RetryPacket(ctx context.Context, in *MsgRetryPacket, opts ...grpc.CallOption) (*MsgRetryPacketResponse, error)
+	// TransferTunnelOwnership is a RPC method to propose a new creator of the tunnel.
+	TransferTunnelOwnership(ctx context.Context, in *MsgTransferTunnelOwnership, opts ...grpc.CallOption) (*MsgTransferTunnelOwnershipResponse, error)
+	// AcceptTunnelOwnership is a RPC method for the proposed creator to accept the ownership of the tunnel.
+	AcceptTunnelOwnership(ctx context.Context, in *MsgAcceptTunnelOwnership, opts ...grpc.CallOption) (*MsgAcceptTunnelOwnershipResponse, error)
+	// UpdateTunnelAdmins is a RPC method to update the co-administrators of the tunnel.
+	UpdateTunnelAdmins(ctx context.Context, in *MsgUpdateTunnelAdmins, opts ...grpc.CallOption) (*MsgUpdateTunnelAdminsResponse, error)
+	// ApproveFundingSource is a RPC method for the creator to approve the funding account of the tunnel.
+	ApproveFundingSource(ctx context.Context, in *MsgApproveFundingSource, opts ...grpc.CallOption) (*MsgApproveFundingSourceResponse, error)
+	// SetFundingPolicy is a RPC method to grant an allowance from a funding account to top up the fee payer.
+	SetFundingPolicy(ctx context.Context, in *MsgSetFundingPolicy, opts ...grpc.CallOption) (*MsgSetFundingPolicyResponse, error)
+	// RemoveFundingPolicy is a RPC method to remove the funding policy of a tunnel.
+	RemoveFundingPolicy(ctx context.Context, in *MsgRemoveFundingPolicy, opts ...grpc.CallOption) (*MsgRemoveFundingPolicyResponse, error)
 	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
 	DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error)
 	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
@@ -128,6 +149,69 @@ func (c *msgClient) TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opt
 	return out, nil
 }
 
+func (c *msgClient) RetryPacket(ctx context.Context, in *MsgRetryPacket, opts ...grpc.CallOption) (*MsgRetryPacketResponse, error) {
+	out := new(MsgRetryPacketResponse)
+	err := c.cc.Invoke(ctx, Msg_RetryPacket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferTunnelOwnership(ctx context.Context, in *MsgTransferTunnelOwnership, opts ...grpc.CallOption) (*MsgTransferTunnelOwnershipResponse, error) {
+	out := new(MsgTransferTunnelOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferTunnelOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptTunnelOwnership(ctx context.Context, in *MsgAcceptTunnelOwnership, opts ...grpc.CallOption) (*MsgAcceptTunnelOwnershipResponse, error) {
+	out := new(MsgAcceptTunnelOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptTunnelOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTunnelAdmins(ctx context.Context, in *MsgUpdateTunnelAdmins, opts ...grpc.CallOption) (*MsgUpdateTunnelAdminsResponse, error) {
+	out := new(MsgUpdateTunnelAdminsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTunnelAdmins_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveFundingSource(ctx context.Context, in *MsgApproveFundingSource, opts ...grpc.CallOption) (*MsgApproveFundingSourceResponse, error) {
+	out := new(MsgApproveFundingSourceResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveFundingSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFundingPolicy(ctx context.Context, in *MsgSetFundingPolicy, opts ...grpc.CallOption) (*MsgSetFundingPolicyResponse, error) {
+	out := new(MsgSetFundingPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFundingPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFundingPolicy(ctx context.Context, in *MsgRemoveFundingPolicy, opts ...grpc.CallOption) (*MsgRemoveFundingPolicyResponse, error) {
+	out := new(MsgRemoveFundingPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFundingPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error) {
 	out := new(MsgDepositToTunnelResponse)
 	err := c.cc.Invoke(ctx, Msg_DepositToTunnel_FullMethodName, in, out, opts...)
@@ -173,6 +257,20 @@ type MsgServer interface {
 	DeactivateTunnel(context.Context, *MsgDeactivateTunnel) (*MsgDeactivateTunnelResponse, error)
 	// TriggerTunnel is a RPC method to manually trigger a tunnel.
 	TriggerTunnel(context.Context, *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error)
+	// RetryPacket is a RPC method to resend a failed packet of the tunnel.
+	RetryPacket(context.Context, *MsgRetryPacket) (*MsgRetryPacketResponse, error)
+	// TransferTunnelOwnership is a RPC method to propose a new creator of the tunnel.
+	TransferTunnelOwnership(context.Context, *MsgTransferTunnelOwnership) (*MsgTransferTunnelOwnershipResponse, error)
+	// AcceptTunnelOwnership is a RPC method for the proposed creator to accept the ownership of the tunnel.
+	AcceptTunnelOwnership(context.Context, *MsgAcceptTunnelOwnership) (*MsgAcceptTunnelOwnershipResponse, error)
+	// UpdateTunnelAdmins is a RPC method to update the co-administrators of the tunnel.
+	UpdateTunnelAdmins(context.Context, *MsgUpdateTunnelAdmins) (*MsgUpdateTunnelAdminsResponse, error)
+	// ApproveFundingSource is a RPC method for the creator to approve the funding account of the tunnel.
+	ApproveFundingSource(context.Context, *MsgApproveFundingSource) (*MsgApproveFundingSourceResponse, error)
+	// SetFundingPolicy is a RPC method to grant an allowance from a funding account to top up the fee payer.
+	SetFundingPolicy(context.Context, *MsgSetFundingPolicy) (*MsgSetFundingPolicyResponse, error)
+	// RemoveFundingPolicy is a RPC method to remove the funding policy of a tunnel.
+	RemoveFundingPolicy(context.Context, *MsgRemoveFundingPolicy) (*MsgRemoveFundingPolicyResponse, error)
 	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
 	DepositToTunnel(context.Context, *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error)
 	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
@@ -207,6 +305,27 @@ func (UnimplementedMsgServer) DeactivateTunnel(context.Context, *MsgDeactivateTu
 func (UnimplementedMsgServer) TriggerTunnel(context.Context, *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerTunnel not implemented")
 }
+func (UnimplementedMsgServer) RetryPacket(context.Context, *MsgRetryPacket) (*MsgRetryPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPacket not implemented")
+}
+func (UnimplementedMsgServer) TransferTunnelOwnership(context.Context, *MsgTransferTunnelOwnership) (*MsgTransferTunnelOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTunnelOwnership not implemented")
+}
+func (UnimplementedMsgServer) AcceptTunnelOwnership(context.Context, *MsgAcceptTunnelOwnership) (*MsgAcceptTunnelOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTunnelOwnership not implemented")
+}
+func (UnimplementedMsgServer) UpdateTunnelAdmins(context.Context, *MsgUpdateTunnelAdmins) (*MsgUpdateTunnelAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTunnelAdmins not implemented")
+}
+func (UnimplementedMsgServer) ApproveFundingSource(context.Context, *MsgApproveFundingSource) (*MsgApproveFundingSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFundingSource not implemented")
+}
+func (UnimplementedMsgServer) SetFundingPolicy(context.Context, *MsgSetFundingPolicy) (*MsgSetFundingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFundingPolicy not implemented")
+}
+func (UnimplementedMsgServer) RemoveFundingPolicy(context.Context, *MsgRemoveFundingPolicy) (*MsgRemoveFundingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFundingPolicy not implemented")
+}
 func (UnimplementedMsgServer) DepositToTunnel(context.Context, *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositToTunnel not implemented")
 }
@@ -355,6 +474,132 @@ func _Msg_TriggerTunnel_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RetryPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetryPacket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryPacket(ctx, req.(*MsgRetryPacket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferTunnelOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTunnelOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTunnelOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferTunnelOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTunnelOwnership(ctx, req.(*MsgTransferTunnelOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptTunnelOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptTunnelOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptTunnelOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptTunnelOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptTunnelOwnership(ctx, req.(*MsgAcceptTunnelOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTunnelAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTunnelAdmins)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTunnelAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTunnelAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTunnelAdmins(ctx, req.(*MsgUpdateTunnelAdmins))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveFundingSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveFundingSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveFundingSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveFundingSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveFundingSource(ctx, req.(*MsgApproveFundingSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFundingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFundingPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFundingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFundingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFundingPolicy(ctx, req.(*MsgSetFundingPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFundingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFundingPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFundingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFundingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFundingPolicy(ctx, req.(*MsgRemoveFundingPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_DepositToTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDepositToTunnel)
 	if err := dec(in); err != nil {
@@ -444,6 +689,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerTunnel",
 			Handler:    _Msg_TriggerTunnel_Handler,
 		},
+		{
+			MethodName: "RetryPacket",
+			Handler:    _Msg_RetryPacket_Handler,
+		},
+		{
+			MethodName: "TransferTunnelOwnership",
+			Handler:    _Msg_TransferTunnelOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptTunnelOwnership",
+			Handler:    _Msg_AcceptTunnelOwnership_Handler,
+		},
+		{
+			MethodName: "UpdateTunnelAdmins",
+			Handler:    _Msg_UpdateTunnelAdmins_Handler,
+		},
+		{
+			MethodName: "ApproveFundingSource",
+			Handler:    _Msg_ApproveFundingSource_Handler,
+		},
+		{
+			MethodName: "SetFundingPolicy",
+			Handler:    _Msg_SetFundingPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveFundingPolicy",
+			Handler:    _Msg_RemoveFundingPolicy_Handler,
+		},
 		{
 			MethodName: "DepositToTunnel",
 			Handler:    _Msg_DepositToTunnel_Handler,
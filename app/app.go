@@ -13,6 +13,7 @@ import (
 	cmtos "github.com/cometbft/cometbft/libs/os"
 
 	dbm "github.com/cosmos/cosmos-db"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 
@@ -55,6 +56,8 @@ import (
 	"github.com/bandprotocol/chain/v3/app/keepers"
 	"github.com/bandprotocol/chain/v3/app/upgrades"
 	v3 "github.com/bandprotocol/chain/v3/app/upgrades/v3"
+	v3_1 "github.com/bandprotocol/chain/v3/app/upgrades/v3_1"
+	feedsstream "github.com/bandprotocol/chain/v3/client/grpc/feeds/stream"
 	nodeservice "github.com/bandprotocol/chain/v3/client/grpc/node"
 	proofservice "github.com/bandprotocol/chain/v3/client/grpc/oracle/proof"
 	oraclekeeper "github.com/bandprotocol/chain/v3/x/oracle/keeper"
@@ -64,7 +67,7 @@ var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
 
-	Upgrades = []upgrades.Upgrade{v3.Upgrade}
+	Upgrades = []upgrades.Upgrade{v3.Upgrade, v3_1.Upgrade}
 )
 
 var (
@@ -92,6 +95,9 @@ type BandApp struct {
 	// simulation manager
 	sm           *module.SimulationManager
 	configurator module.Configurator
+
+	// priceStreamer streams the price updates of the feeds module to the gRPC subscribers
+	priceStreamer *feedsstream.PriceStreamer
 }
 
 func init() {
@@ -308,6 +314,21 @@ func NewBandApp(
 		}
 	}
 
+	// load state streaming if enabled, and collect the price updates of the feeds module from the
+	// finalized blocks for the stream service alongside the configured streaming plugins
+	app.priceStreamer = feedsstream.NewPriceStreamer(feedsstream.DefaultHistorySize)
+	streamingManager, err := newStreamingManager(
+		app.CommitMultiStore(),
+		appOpts,
+		app.GetKVStoreKey(),
+		app.priceStreamer,
+	)
+	if err != nil {
+		logger.Error("failed to load state streaming", "err", err)
+		os.Exit(1)
+	}
+	app.SetStreamingManager(streamingManager)
+
 	app.setupUpgradeHandlers()
 	app.setupUpgradeStoreLoaders()
 
@@ -449,6 +470,13 @@ func (app *BandApp) RegisterNodeService(clientCtx client.Context, cfg config.Con
 	cosmosnodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
 }
 
+// RegisterGRPCServer registers the gRPC services of the app and the feeds stream service, which is
+// registered directly because the gRPC query router does not support streaming.
+func (app *BandApp) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+	feedsstream.RegisterStreamService(server, app.priceStreamer)
+}
+
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *BandApp) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
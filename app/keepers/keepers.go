@@ -1,7 +1,6 @@
 package keepers
 
 import (
-	"os"
 	"path/filepath"
 
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
@@ -157,16 +156,7 @@ func NewAppKeeper(
 	// Set keys KVStoreKey, TransientStoreKey, MemoryStoreKey
 	appKeepers.GenerateKeys()
 
-	/*
-		configure state listening capabilities using AppOptions
-		we are doing nothing with the returned streamingServices and waitGroup in this case
-	*/
-	// load state streaming if enabled
-
-	if err := bApp.RegisterStreamingServices(appOpts, appKeepers.keys); err != nil {
-		logger.Error("failed to load state streaming", "err", err)
-		os.Exit(1)
-	}
+	// state streaming is loaded by the app together with its own ABCI listeners
 
 	appKeepers.ParamsKeeper = initParamsKeeper(
 		appCodec,
@@ -453,6 +443,9 @@ func NewAppKeeper(
 		authtypes.FeeCollectorName,
 	)
 
+	// register the modules called back on oracle request results
+	oracleCbRouter := oracletypes.NewCallbackRouter()
+
 	appKeepers.OracleKeeper = oraclekeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[oracletypes.StoreKey],
@@ -469,6 +462,7 @@ func NewAppKeeper(
 		appKeepers.BandtssKeeper,
 		appKeepers.ScopedOracleKeeper,
 		owasmVM,
+		oracleCbRouter,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -508,11 +502,15 @@ func NewAppKeeper(
 	tssCbRouter.
 		AddRoute(bandtsstypes.RouterKey, bandtsskeeper.NewTSSCallback(appKeepers.BandtssKeeper))
 
+	oracleCbRouter.
+		AddRoute(tunneltypes.ModuleName, tunnelkeeper.NewOracleCallback(appKeepers.TunnelKeeper))
+
 	// It is vital to seal the request signature router here as to not allow
 	// further handlers to be registered after the keeper is created since this
 	// could create invalid or non-deterministic behavior.
 	tssContentRouter.Seal()
 	tssCbRouter.Seal()
+	oracleCbRouter.Seal()
 
 	// Middleware Stacks
 	appKeepers.ICAModule = ica.NewAppModule(nil, &appKeepers.ICAHostKeeper)
@@ -521,6 +519,7 @@ func NewAppKeeper(
 	// Create Transfer Stack
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(appKeepers.TransferKeeper)
+	transferStack = tunnel.NewIBCTransferMiddleware(transferStack, appKeepers.TunnelKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, appKeepers.IBCFeeKeeper)
 
 	// Create ICAHost Stack
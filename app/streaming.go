@@ -0,0 +1,95 @@
+package band
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"cosmossdk.io/store/streaming"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// newStreamingManager loads the streaming plugins configured in the app options in the same way as
+// BaseApp.RegisterStreamingServices, and returns a streaming manager with their listeners followed by
+// the given listeners of the app. BaseApp.RegisterStreamingServices cannot be used directly as it
+// replaces the listeners of the streaming manager, and the manager of the BaseApp cannot be read back.
+func newStreamingManager(
+	cms storetypes.CommitMultiStore,
+	appOpts servertypes.AppOptions,
+	keys map[string]*storetypes.KVStoreKey,
+	appListeners ...storetypes.ABCIListener,
+) (storetypes.StreamingManager, error) {
+	var listeners []storetypes.ABCIListener
+
+	streamingCfg := cast.ToStringMap(appOpts.Get(baseapp.StreamingTomlKey))
+	for service := range streamingCfg {
+		pluginKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, service, baseapp.StreamingABCIPluginTomlKey)
+		pluginName := strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey)))
+		if len(pluginName) == 0 {
+			continue
+		}
+
+		logLevel := cast.ToString(appOpts.Get(flags.FlagLogLevel))
+		plugin, err := streaming.NewStreamingPlugin(pluginName, logLevel)
+		if err != nil {
+			return storetypes.StreamingManager{}, fmt.Errorf("failed to load streaming plugin: %w", err)
+		}
+
+		listener, ok := plugin.(storetypes.ABCIListener)
+		if !ok {
+			return storetypes.StreamingManager{}, fmt.Errorf("unexpected plugin type %T", plugin)
+		}
+		listeners = append(listeners, listener)
+	}
+
+	stopNodeOnErrKey := fmt.Sprintf(
+		"%s.%s.%s",
+		baseapp.StreamingTomlKey,
+		baseapp.StreamingABCITomlKey,
+		baseapp.StreamingABCIStopNodeOnErrTomlKey,
+	)
+	if len(listeners) > 0 {
+		keysKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingABCITomlKey, baseapp.StreamingABCIKeysTomlKey)
+		cms.AddListeners(exposedStoreKeys(cast.ToStringSlice(appOpts.Get(keysKey)), keys))
+	}
+
+	return storetypes.StreamingManager{
+		ABCIListeners: append(listeners, appListeners...),
+		StopNodeOnErr: cast.ToBool(appOpts.Get(stopNodeOnErrKey)),
+	}, nil
+}
+
+// exposedStoreKeys returns the store keys with the given names sorted by name, or all store keys if the
+// names contain "*".
+func exposedStoreKeys(names []string, keys map[string]*storetypes.KVStoreKey) []storetypes.StoreKey {
+	exposeAll := false
+	for _, name := range names {
+		if name == "*" {
+			exposeAll = true
+			break
+		}
+	}
+
+	if exposeAll {
+		names = make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	storeKeys := make([]storetypes.StoreKey, 0, len(names))
+	for _, name := range names {
+		if key, ok := keys[name]; ok {
+			storeKeys = append(storeKeys, key)
+		}
+	}
+
+	return storeKeys
+}
@@ -0,0 +1,47 @@
+package band
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	feedsstream "github.com/bandprotocol/chain/v3/client/grpc/feeds/stream"
+)
+
+func TestNewStreamingManager(t *testing.T) {
+	priceStreamer := feedsstream.NewPriceStreamer(1)
+
+	// without a streaming plugin, only the listeners of the app are registered
+	manager, err := newStreamingManager(nil, simtestutil.AppOptionsMap{
+		"streaming.abci.stop-node-on-err": true,
+	}, nil, priceStreamer)
+	require.NoError(t, err)
+	require.Equal(t, []storetypes.ABCIListener{priceStreamer}, manager.ABCIListeners)
+	require.True(t, manager.StopNodeOnErr)
+
+	// a configured streaming plugin must be loaded
+	_, err = newStreamingManager(nil, simtestutil.AppOptionsMap{
+		"streaming": map[string]interface{}{"abci": map[string]interface{}{"plugin": "unknown"}},
+		"streaming.abci.plugin": "unknown",
+	}, nil, priceStreamer)
+	require.ErrorContains(t, err, "failed to load streaming plugin")
+}
+
+func TestExposedStoreKeys(t *testing.T) {
+	keys := storetypes.NewKVStoreKeys("bank", "feeds", "oracle")
+
+	require.Equal(
+		t,
+		[]storetypes.StoreKey{keys["feeds"], keys["oracle"]},
+		exposedStoreKeys([]string{"oracle", "feeds", "missing"}, keys),
+	)
+	require.Equal(
+		t,
+		[]storetypes.StoreKey{keys["bank"], keys["feeds"], keys["oracle"]},
+		exposedStoreKeys([]string{"*"}, keys),
+	)
+}
@@ -0,0 +1,25 @@
+package v3_1
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/bandprotocol/chain/v3/app/keepers"
+)
+
+// CreateUpgradeHandler creates the handler of the v3_1 upgrade, which runs the in-place
+// store migrations of the modules whose consensus versions have been bumped.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	_ *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
@@ -0,0 +1,91 @@
+package v3_1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	band "github.com/bandprotocol/chain/v3/app"
+	v3_1 "github.com/bandprotocol/chain/v3/app/upgrades/v3_1"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+type UpgradeTestSuite struct {
+	suite.Suite
+
+	app *band.BandApp
+	ctx sdk.Context
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) SetupTest() {
+	dir := testutil.GetTempDir(s.T())
+	s.app = bandtesting.SetupWithCustomHome(false, dir)
+	s.ctx = s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+
+	_, err := s.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: s.app.LastBlockHeight() + 1})
+	s.Require().NoError(err)
+	_, err = s.app.Commit()
+	s.Require().NoError(err)
+}
+
+func (s *UpgradeTestSuite) TestUpgrade() {
+	preUpgradeChecks(s)
+
+	upgradeHeight := int64(2)
+	s.ConfirmUpgradeSucceeded(v3_1.UpgradeName, upgradeHeight)
+
+	postUpgradeChecks(s)
+}
+
+func preUpgradeChecks(s *UpgradeTestSuite) {
+	// Roll x/oracle back to the consensus version 2, where data sources and oracle scripts are not versioned.
+	vm, err := s.app.AppKeepers.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().NoError(err)
+	vm[oracletypes.ModuleName] = 2
+	s.Require().NoError(s.app.AppKeepers.UpgradeKeeper.SetModuleVersionMap(s.ctx, vm))
+
+	dataSource := s.app.OracleKeeper.MustGetDataSource(s.ctx, 1)
+	dataSource.Version = 0
+	s.app.OracleKeeper.SetDataSource(s.ctx, 1, dataSource)
+	oracleScript := s.app.OracleKeeper.MustGetOracleScript(s.ctx, 1)
+	oracleScript.Version = 0
+	s.app.OracleKeeper.SetOracleScript(s.ctx, 1, oracleScript)
+}
+
+func postUpgradeChecks(s *UpgradeTestSuite) {
+	vm, err := s.app.AppKeepers.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(4), vm[oracletypes.ModuleName])
+
+	s.Require().Equal(uint64(1), s.app.OracleKeeper.MustGetDataSource(s.ctx, 1).Version)
+	_, err = s.app.OracleKeeper.GetDataSourceVersion(s.ctx, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), s.app.OracleKeeper.MustGetOracleScript(s.ctx, 1).Version)
+	_, err = s.app.OracleKeeper.GetOracleScriptVersion(s.ctx, 1, 1)
+	s.Require().NoError(err)
+}
+
+func (s *UpgradeTestSuite) ConfirmUpgradeSucceeded(upgradeName string, upgradeHeight int64) {
+	plan := upgradetypes.Plan{Name: upgradeName, Height: upgradeHeight}
+	err := s.app.AppKeepers.UpgradeKeeper.ScheduleUpgrade(s.ctx, plan)
+	s.Require().NoError(err)
+	_, err = s.app.AppKeepers.UpgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(upgradeHeight)
+	_, err = s.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: s.ctx.BlockHeight()})
+	s.Require().NoError(err)
+}
@@ -134,7 +134,7 @@ func createNewTunnel(
 ) error {
 	creator := bandtesting.Alice.Address
 	tunnel, err := ba.TunnelKeeper.AddTunnel(
-		ba.Ctx, route, signalDeviations, interval, creator,
+		ba.Ctx, route, signalDeviations, nil, interval, "", false, creator,
 	)
 	if err != nil {
 		return err
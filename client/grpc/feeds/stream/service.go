@@ -0,0 +1,84 @@
+package stream
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+)
+
+// RegisterStreamService registers the feeds stream gRPC service on the provided gRPC server.
+func RegisterStreamService(server gogogrpc.Server, streamer *PriceStreamer) {
+	RegisterServiceServer(server, NewStreamServer(streamer))
+}
+
+// to check streamServer implements ServiceServer
+var _ ServiceServer = streamServer{}
+
+// streamServer implements ServiceServer
+type streamServer struct {
+	streamer *PriceStreamer
+}
+
+// NewStreamServer returns new streamServer from provided PriceStreamer
+func NewStreamServer(streamer *PriceStreamer) ServiceServer {
+	return streamServer{
+		streamer: streamer,
+	}
+}
+
+// SubscribePrices streams the prices of the requested signal ids that changed in each committed block,
+// starting with the kept blocks at or after the requested start height.
+func (s streamServer) SubscribePrices(req *SubscribePricesRequest, srv Service_SubscribePricesServer) error {
+	id, backlog, updates, err := s.streamer.Subscribe(req.StartHeight)
+	if err != nil {
+		return status.Error(codes.OutOfRange, err.Error())
+	}
+	defer s.streamer.Unsubscribe(id)
+
+	signalIDs := make(map[string]bool, len(req.SignalIds))
+	for _, signalID := range req.SignalIds {
+		signalIDs[signalID] = true
+	}
+
+	for _, block := range backlog {
+		if err := sendPrices(srv, block, signalIDs); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return srv.Context().Err()
+		case block, ok := <-updates:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber fell behind the price updates")
+			}
+
+			if err := sendPrices(srv, block, signalIDs); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendPrices sends the prices of the block that belong to the given signal ids, or all prices if no
+// signal id is given. Nothing is sent if none of the prices belongs to them.
+func sendPrices(srv Service_SubscribePricesServer, block BlockPrices, signalIDs map[string]bool) error {
+	prices := block.Prices
+	if len(signalIDs) > 0 {
+		prices = nil
+		for _, price := range block.Prices {
+			if signalIDs[price.SignalID] {
+				prices = append(prices, price)
+			}
+		}
+	}
+
+	if len(prices) == 0 {
+		return nil
+	}
+
+	return srv.Send(&SubscribePricesResponse{Height: block.Height, Prices: prices})
+}
@@ -0,0 +1,702 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: band/base/feeds/v1/stream.proto
+
+package stream
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/bandprotocol/chain/v3/x/feeds/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribePricesRequest is request type for the Service/SubscribePrices RPC method.
+type SubscribePricesRequest struct {
+	// signal_ids is the list of signal ids to stream the prices of. Empty means all signal ids.
+	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+	// start_height is the block height to resume the stream from. Zero means the next committed block.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *SubscribePricesRequest) Reset()         { *m = SubscribePricesRequest{} }
+func (m *SubscribePricesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribePricesRequest) ProtoMessage()    {}
+func (*SubscribePricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_015e2a5e3b1a54d0, []int{0}
+}
+func (m *SubscribePricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribePricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribePricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribePricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribePricesRequest.Merge(m, src)
+}
+func (m *SubscribePricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribePricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribePricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribePricesRequest proto.InternalMessageInfo
+
+func (m *SubscribePricesRequest) GetSignalIds() []string {
+	if m != nil {
+		return m.SignalIds
+	}
+	return nil
+}
+
+func (m *SubscribePricesRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// SubscribePricesResponse is response type for the Service/SubscribePrices RPC method.
+type SubscribePricesResponse struct {
+	// height is the block height at which the prices were updated.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// prices is the list of updated prices.
+	Prices []types.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *SubscribePricesResponse) Reset()         { *m = SubscribePricesResponse{} }
+func (m *SubscribePricesResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribePricesResponse) ProtoMessage()    {}
+func (*SubscribePricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_015e2a5e3b1a54d0, []int{1}
+}
+func (m *SubscribePricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribePricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribePricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribePricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribePricesResponse.Merge(m, src)
+}
+func (m *SubscribePricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribePricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribePricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribePricesResponse proto.InternalMessageInfo
+
+func (m *SubscribePricesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SubscribePricesResponse) GetPrices() []types.Price {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribePricesRequest)(nil), "band.base.feeds.v1.SubscribePricesRequest")
+	proto.RegisterType((*SubscribePricesResponse)(nil), "band.base.feeds.v1.SubscribePricesResponse")
+}
+
+func init() { proto.RegisterFile("band/base/feeds/v1/stream.proto", fileDescriptor_015e2a5e3b1a54d0) }
+
+var fileDescriptor_015e2a5e3b1a54d0 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x6e, 0xea, 0x30,
+	0x14, 0x86, 0x13, 0xb8, 0xe2, 0x0a, 0x73, 0xa5, 0x2b, 0x59, 0x57, 0x5c, 0x8a, 0xd4, 0x40, 0x99,
+	0x50, 0x2b, 0xd9, 0x05, 0x86, 0xaa, 0x2b, 0x53, 0xbb, 0x55, 0x61, 0x63, 0x41, 0xb6, 0x73, 0x9a,
+	0xb8, 0x0a, 0x71, 0x6a, 0x9b, 0xf4, 0x35, 0xfa, 0x58, 0x8c, 0x8c, 0x9d, 0xaa, 0x0a, 0x5e, 0xa4,
+	0x8a, 0x13, 0x96, 0xd2, 0xa1, 0x5b, 0xce, 0x7f, 0xbe, 0xf3, 0xc7, 0xff, 0x39, 0x68, 0xc0, 0x59,
+	0x16, 0x51, 0xce, 0x0c, 0xd0, 0x47, 0x80, 0xc8, 0xd0, 0x62, 0x42, 0x8d, 0xd5, 0xc0, 0xd6, 0x24,
+	0xd7, 0xca, 0x2a, 0x8c, 0x4b, 0x80, 0x94, 0x00, 0x71, 0x00, 0x29, 0x26, 0xfd, 0x7f, 0xb1, 0x8a,
+	0x95, 0x6b, 0xd3, 0xf2, 0xab, 0x22, 0xfb, 0x81, 0xb3, 0x3a, 0xba, 0x70, 0xb0, 0x6c, 0x52, 0x55,
+	0x55, 0x7f, 0xb4, 0x44, 0xdd, 0xc5, 0x86, 0x1b, 0xa1, 0x25, 0x87, 0x07, 0x2d, 0x05, 0x98, 0x10,
+	0x9e, 0x37, 0x60, 0x2c, 0x3e, 0x47, 0xc8, 0xc8, 0x38, 0x63, 0xe9, 0x4a, 0x46, 0xa6, 0xe7, 0x0f,
+	0x9b, 0xe3, 0x76, 0xd8, 0xae, 0x94, 0xfb, 0xc8, 0xe0, 0x0b, 0xf4, 0xc7, 0x58, 0xa6, 0xed, 0x2a,
+	0x01, 0x19, 0x27, 0xb6, 0xd7, 0x18, 0xfa, 0xe3, 0x66, 0xd8, 0x71, 0xda, 0x9d, 0x93, 0x46, 0x4f,
+	0xe8, 0xff, 0x89, 0xb7, 0xc9, 0x55, 0x66, 0x00, 0x77, 0x51, 0xab, 0x9e, 0xf3, 0xdd, 0x5c, 0x5d,
+	0xe1, 0x1b, 0xd4, 0xca, 0x1d, 0xd9, 0x6b, 0x0c, 0x9b, 0xe3, 0xce, 0xf4, 0x8c, 0xb8, 0xa4, 0xc7,
+	0x90, 0xee, 0xfd, 0xc4, 0x79, 0xcd, 0x7f, 0x6d, 0xdf, 0x07, 0x5e, 0x58, 0xe3, 0xd3, 0x17, 0xf4,
+	0x7b, 0x01, 0xba, 0x90, 0x02, 0x70, 0x8a, 0xfe, 0x7e, 0xf9, 0x2d, 0xbe, 0x24, 0xa7, 0x0b, 0x23,
+	0xdf, 0xe7, 0xee, 0x5f, 0xfd, 0x88, 0xad, 0x72, 0x5c, 0xfb, 0xf3, 0xc5, 0x76, 0x1f, 0xf8, 0xbb,
+	0x7d, 0xe0, 0x7f, 0xec, 0x03, 0xff, 0xf5, 0x10, 0x78, 0xbb, 0x43, 0xe0, 0xbd, 0x1d, 0x02, 0x6f,
+	0x79, 0x1b, 0x4b, 0x9b, 0x6c, 0x38, 0x11, 0x6a, 0x4d, 0x4b, 0x4b, 0xb7, 0x70, 0xa1, 0x52, 0x2a,
+	0x12, 0x26, 0x33, 0x5a, 0xcc, 0xa8, 0x48, 0x25, 0x64, 0x96, 0xc6, 0x3a, 0x17, 0xf5, 0x89, 0xaa,
+	0x2b, 0xf3, 0x96, 0x63, 0x67, 0x9f, 0x03, 0x00, 0xc2, 0xc4, 0x56, 0xeb, 0x09, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// SubscribePrices streams the prices of the requested signal ids that changed in each committed block.
+	SubscribePrices(ctx context.Context, in *SubscribePricesRequest, opts ...grpc.CallOption) (Service_SubscribePricesClient, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) SubscribePrices(ctx context.Context, in *SubscribePricesRequest, opts ...grpc.CallOption) (Service_SubscribePricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Service_serviceDesc.Streams[0], "/band.base.feeds.v1.Service/SubscribePrices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceSubscribePricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_SubscribePricesClient interface {
+	Recv() (*SubscribePricesResponse, error)
+	grpc.ClientStream
+}
+
+type serviceSubscribePricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceSubscribePricesClient) Recv() (*SubscribePricesResponse, error) {
+	m := new(SubscribePricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// SubscribePrices streams the prices of the requested signal ids that changed in each committed block.
+	SubscribePrices(*SubscribePricesRequest, Service_SubscribePricesServer) error
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) SubscribePrices(req *SubscribePricesRequest, srv Service_SubscribePricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePrices not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_SubscribePrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).SubscribePrices(m, &serviceSubscribePricesServer{stream})
+}
+
+type Service_SubscribePricesServer interface {
+	Send(*SubscribePricesResponse) error
+	grpc.ServerStream
+}
+
+type serviceSubscribePricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceSubscribePricesServer) Send(m *SubscribePricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.base.feeds.v1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePrices",
+			Handler:       _Service_SubscribePrices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "band/base/feeds/v1/stream.proto",
+}
+
+func (m *SubscribePricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribePricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribePricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintStream(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribePricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribePricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribePricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribePricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for _, s := range m.SignalIds {
+			l = len(s)
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovStream(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *SubscribePricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStream(uint64(m.Height))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStream(x uint64) (n int) {
+	return sovStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribePricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribePricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribePricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribePricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribePricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribePricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types.Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStream = fmt.Errorf("proto: unexpected end of group")
+)
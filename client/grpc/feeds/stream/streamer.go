@@ -0,0 +1,226 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+const (
+	// DefaultHistorySize is the default number of latest blocks with price changes that are kept for
+	// resuming subscriptions.
+	DefaultHistorySize = 1000
+
+	// subscriberBufferSize is the number of blocks that can be queued for a subscriber before it is dropped.
+	subscriberBufferSize = 100
+)
+
+// BlockPrices is the list of prices that changed in a committed block.
+type BlockPrices struct {
+	Height int64
+	Prices []feedstypes.Price
+}
+
+var _ storetypes.ABCIListener = (*PriceStreamer)(nil)
+
+// PriceStreamer is an ABCI listener that collects the prices written in each finalized block from the
+// update price events of the feeds module, and publishes the prices that changed to its subscribers
+// once the block is committed. It keeps the latest blocks with price changes so that subscribers can
+// resume from a past height.
+type PriceStreamer struct {
+	mtx sync.Mutex
+
+	historySize int
+	history     []BlockPrices
+	// resumableHeight is the lowest height from which all price changes are kept in the history.
+	resumableHeight int64
+
+	latest  map[string]feedstypes.Price
+	pending BlockPrices
+
+	nextSubscriberID uint64
+	subscribers      map[uint64]chan BlockPrices
+}
+
+// NewPriceStreamer creates a new PriceStreamer that keeps the given number of latest blocks with price changes.
+func NewPriceStreamer(historySize int) *PriceStreamer {
+	return &PriceStreamer{
+		historySize: historySize,
+		latest:      make(map[string]feedstypes.Price),
+		subscribers: make(map[uint64]chan BlockPrices),
+	}
+}
+
+// ListenFinalizeBlock collects the prices written in the finalized block.
+func (s *PriceStreamer) ListenFinalizeBlock(
+	_ context.Context,
+	req abci.RequestFinalizeBlock,
+	res abci.ResponseFinalizeBlock,
+) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.pending = BlockPrices{Height: req.Height, Prices: ParseUpdatedPrices(res.Events)}
+
+	return nil
+}
+
+// ListenCommit publishes the prices of the committed block that changed since their latest update.
+func (s *PriceStreamer) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	block := BlockPrices{Height: s.pending.Height}
+	for _, price := range s.pending.Prices {
+		if latest, ok := s.latest[price.SignalID]; ok && latest.Equal(price) {
+			continue
+		}
+
+		s.latest[price.SignalID] = price
+		block.Prices = append(block.Prices, price)
+	}
+	s.pending = BlockPrices{}
+
+	if s.resumableHeight == 0 {
+		s.resumableHeight = block.Height
+	}
+
+	if len(block.Prices) == 0 {
+		return nil
+	}
+
+	s.history = append(s.history, block)
+	if len(s.history) > s.historySize {
+		s.resumableHeight = s.history[0].Height + 1
+		s.history = s.history[1:]
+	}
+
+	for id, ch := range s.subscribers {
+		select {
+		case ch <- block:
+		default:
+			// drop the subscriber that does not keep up rather than blocking the commit
+			close(ch)
+			delete(s.subscribers, id)
+		}
+	}
+
+	return nil
+}
+
+// Subscribe registers a new subscriber and returns its id, the kept blocks with price changes at or after
+// the given start height, and the channel of the blocks committed afterwards. A zero start height only
+// subscribes to the blocks committed afterwards. The channel is closed if the subscriber falls behind.
+func (s *PriceStreamer) Subscribe(startHeight int64) (uint64, []BlockPrices, <-chan BlockPrices, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var backlog []BlockPrices
+	if startHeight > 0 {
+		if s.resumableHeight == 0 || startHeight < s.resumableHeight {
+			return 0, nil, nil, fmt.Errorf(
+				"start height %d is before the lowest resumable height %d",
+				startHeight,
+				s.resumableHeight,
+			)
+		}
+
+		for _, block := range s.history {
+			if block.Height >= startHeight {
+				backlog = append(backlog, block)
+			}
+		}
+	}
+
+	s.nextSubscriberID++
+	ch := make(chan BlockPrices, subscriberBufferSize)
+	s.subscribers[s.nextSubscriberID] = ch
+
+	return s.nextSubscriberID, backlog, ch, nil
+}
+
+// Unsubscribe removes the subscriber with the given id.
+func (s *PriceStreamer) Unsubscribe(id uint64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if ch, ok := s.subscribers[id]; ok {
+		close(ch)
+		delete(s.subscribers, id)
+	}
+}
+
+// ParseUpdatedPrices returns the prices of the update price events of the feeds module.
+func ParseUpdatedPrices(events []abci.Event) []feedstypes.Price {
+	var prices []feedstypes.Price
+	for _, event := range events {
+		if event.Type != feedstypes.EventTypeUpdatePrice {
+			continue
+		}
+
+		price, err := parseUpdatePriceEvent(event)
+		if err != nil {
+			continue
+		}
+
+		prices = append(prices, price)
+	}
+
+	return prices
+}
+
+// parseUpdatePriceEvent returns the price of an update price event.
+func parseUpdatePriceEvent(event abci.Event) (price feedstypes.Price, err error) {
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case feedstypes.AttributeKeySignalID:
+			price.SignalID = attr.Value
+		case feedstypes.AttributeKeyPriceStatus:
+			price.Status = feedstypes.PriceStatus(feedstypes.PriceStatus_value[attr.Value])
+		case feedstypes.AttributeKeyPrice:
+			if price.Price, err = strconv.ParseUint(attr.Value, 10, 64); err != nil {
+				return feedstypes.Price{}, err
+			}
+		case feedstypes.AttributeKeyTimestamp:
+			if price.Timestamp, err = strconv.ParseInt(attr.Value, 10, 64); err != nil {
+				return feedstypes.Price{}, err
+			}
+		case feedstypes.AttributeKeyAggregationMethod:
+			price.AggregationMethod = feedstypes.AggregationMethod(feedstypes.AggregationMethod_value[attr.Value])
+		case feedstypes.AttributeKeyReporterCount:
+			if confidence(&price).ReporterCount, err = strconv.ParseUint(attr.Value, 10, 64); err != nil {
+				return feedstypes.Price{}, err
+			}
+		case feedstypes.AttributeKeyPowerShareBPS:
+			if confidence(&price).PowerShareBPS, err = strconv.ParseUint(attr.Value, 10, 64); err != nil {
+				return feedstypes.Price{}, err
+			}
+		case feedstypes.AttributeKeyDispersion:
+			if confidence(&price).Dispersion, err = strconv.ParseUint(attr.Value, 10, 64); err != nil {
+				return feedstypes.Price{}, err
+			}
+		}
+	}
+
+	if price.SignalID == "" {
+		return feedstypes.Price{}, fmt.Errorf("missing signal id")
+	}
+
+	return price, nil
+}
+
+// confidence returns the confidence of the given price, creating it if the price has none yet.
+func confidence(price *feedstypes.Price) *feedstypes.PriceConfidence {
+	if price.Confidence == nil {
+		price.Confidence = &feedstypes.PriceConfidence{}
+	}
+
+	return price.Confidence
+}
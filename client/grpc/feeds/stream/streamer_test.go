@@ -0,0 +1,141 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func updatePriceEvent(signalID string, status feedstypes.PriceStatus, price uint64, timestamp int64) abci.Event {
+	return abci.Event{
+		Type: feedstypes.EventTypeUpdatePrice,
+		Attributes: []abci.EventAttribute{
+			{Key: feedstypes.AttributeKeySignalID, Value: signalID},
+			{Key: feedstypes.AttributeKeyPriceStatus, Value: status.String()},
+			{Key: feedstypes.AttributeKeyPrice, Value: fmt.Sprintf("%d", price)},
+			{Key: feedstypes.AttributeKeyTimestamp, Value: fmt.Sprintf("%d", timestamp)},
+		},
+	}
+}
+
+func commitBlock(t *testing.T, s *PriceStreamer, height int64, events ...abci.Event) {
+	err := s.ListenFinalizeBlock(
+		context.Background(),
+		abci.RequestFinalizeBlock{Height: height},
+		abci.ResponseFinalizeBlock{Events: events},
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+func TestParseUpdatedPrices(t *testing.T) {
+	prices := ParseUpdatedPrices([]abci.Event{
+		updatePriceEvent("CS:BAND-USD", feedstypes.PRICE_STATUS_AVAILABLE, 1000, 100),
+		{Type: feedstypes.EventTypeUpdateCurrentFeeds},
+		{
+			Type: feedstypes.EventTypeUpdatePrice,
+			Attributes: []abci.EventAttribute{
+				{Key: feedstypes.AttributeKeySignalID, Value: "CS:ATOM-USD"},
+				{Key: feedstypes.AttributeKeyPrice, Value: "invalid"},
+			},
+		},
+		{
+			Type: feedstypes.EventTypeUpdatePrice,
+			Attributes: []abci.EventAttribute{
+				{Key: feedstypes.AttributeKeySignalID, Value: "CS:ETH-USD"},
+				{Key: feedstypes.AttributeKeyPriceStatus, Value: feedstypes.PRICE_STATUS_AVAILABLE.String()},
+				{Key: feedstypes.AttributeKeyPrice, Value: "2000"},
+				{Key: feedstypes.AttributeKeyTimestamp, Value: "100"},
+				{
+					Key:   feedstypes.AttributeKeyAggregationMethod,
+					Value: feedstypes.AGGREGATION_METHOD_WEIGHTED_MEDIAN.String(),
+				},
+				{Key: feedstypes.AttributeKeyReporterCount, Value: "3"},
+				{Key: feedstypes.AttributeKeyPowerShareBPS, Value: "9000"},
+				{Key: feedstypes.AttributeKeyDispersion, Value: "5"},
+			},
+		},
+	})
+
+	ethPrice := feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 2000, 100)
+	ethPrice.AggregationMethod = feedstypes.AGGREGATION_METHOD_WEIGHTED_MEDIAN
+	ethPrice.Confidence = &feedstypes.PriceConfidence{ReporterCount: 3, PowerShareBPS: 9000, Dispersion: 5}
+
+	require.Equal(t, []feedstypes.Price{
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 100),
+		ethPrice,
+	}, prices)
+}
+
+func TestPriceStreamer(t *testing.T) {
+	s := NewPriceStreamer(2)
+
+	// no block has been committed yet
+	_, _, _, err := s.Subscribe(1)
+	require.Error(t, err)
+
+	commitBlock(t, s, 10,
+		updatePriceEvent("CS:BAND-USD", feedstypes.PRICE_STATUS_AVAILABLE, 1000, 100),
+		updatePriceEvent("CS:ATOM-USD", feedstypes.PRICE_STATUS_AVAILABLE, 2000, 100),
+	)
+
+	id, backlog, updates, err := s.Subscribe(0)
+	require.NoError(t, err)
+	require.Empty(t, backlog)
+
+	// only the changed price is published
+	commitBlock(t, s, 11,
+		updatePriceEvent("CS:BAND-USD", feedstypes.PRICE_STATUS_AVAILABLE, 1000, 100),
+		updatePriceEvent("CS:ATOM-USD", feedstypes.PRICE_STATUS_AVAILABLE, 2100, 101),
+	)
+	require.Equal(t, BlockPrices{
+		Height: 11,
+		Prices: []feedstypes.Price{
+			feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ATOM-USD", 2100, 101),
+		},
+	}, <-updates)
+
+	// blocks without changes are not kept
+	commitBlock(t, s, 12,
+		updatePriceEvent("CS:BAND-USD", feedstypes.PRICE_STATUS_AVAILABLE, 1000, 100),
+	)
+	commitBlock(t, s, 13,
+		updatePriceEvent("CS:BAND-USD", feedstypes.PRICE_STATUS_AVAILABLE, 1100, 102),
+	)
+	require.Equal(t, int64(13), (<-updates).Height)
+	s.Unsubscribe(id)
+
+	// the block at height 10 is removed from the history
+	_, _, _, err = s.Subscribe(10)
+	require.Error(t, err)
+
+	_, backlog, _, err = s.Subscribe(12)
+	require.NoError(t, err)
+	require.Len(t, backlog, 1)
+	require.Equal(t, int64(13), backlog[0].Height)
+}
+
+func TestPriceStreamerDropSlowSubscriber(t *testing.T) {
+	s := NewPriceStreamer(DefaultHistorySize)
+
+	_, _, updates, err := s.Subscribe(0)
+	require.NoError(t, err)
+
+	for height := int64(1); height <= subscriberBufferSize+1; height++ {
+		commitBlock(t, s, height,
+			updatePriceEvent("CS:BAND-USD", feedstypes.PRICE_STATUS_AVAILABLE, uint64(height), height),
+		)
+	}
+
+	for range subscriberBufferSize {
+		<-updates
+	}
+	_, ok := <-updates
+	require.False(t, ok)
+}
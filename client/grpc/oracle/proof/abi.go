@@ -293,3 +293,141 @@ var verifyCountFormat = []byte(`
   }
 ]
 `)
+
+var verifyPricesFormat = []byte(`
+[
+  {
+    "internalType": "uint256",
+    "name": "blockHeight",
+    "type": "uint256"
+  },
+  {
+    "components": [
+      {
+        "internalType": "bytes32",
+        "name": "feedsIAVLStateHash",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "evidenceStoreMerkleHash",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "feegrantToFeeibcStoresMerkleHash",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "globalfeeToIcahostStoresMerkleHash",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "authToDistributionStoresMerkleHash",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "mintToUpgradeStoresMerkleHash",
+        "type": "bytes32"
+      }
+    ],
+    "internalType": "struct FeedsMultiStore.Data",
+    "name": "feedsMultiStore",
+    "type": "tuple"
+  },
+  {
+    "components": [
+      {
+        "components": [
+          {
+            "internalType": "string",
+            "name": "signalID",
+            "type": "string"
+          },
+          {
+            "internalType": "uint8",
+            "name": "status",
+            "type": "uint8"
+          },
+          {
+            "internalType": "uint64",
+            "name": "price",
+            "type": "uint64"
+          },
+          {
+            "internalType": "int64",
+            "name": "timestamp",
+            "type": "int64"
+          },
+          {
+            "internalType": "uint8",
+            "name": "aggregationMethod",
+            "type": "uint8"
+          },
+          {
+            "internalType": "uint64",
+            "name": "reporterCount",
+            "type": "uint64"
+          },
+          {
+            "internalType": "uint64",
+            "name": "powerShareBPS",
+            "type": "uint64"
+          },
+          {
+            "internalType": "uint64",
+            "name": "dispersion",
+            "type": "uint64"
+          }
+        ],
+        "internalType": "struct IFeedsVerifier.Price",
+        "name": "price",
+        "type": "tuple"
+      },
+      {
+        "internalType": "uint256",
+        "name": "version",
+        "type": "uint256"
+      },
+        {
+          "components": [
+            {
+              "internalType": "bool",
+              "name": "isDataOnRight",
+              "type": "bool"
+            },
+            {
+              "internalType": "uint8",
+              "name": "subtreeHeight",
+              "type": "uint8"
+            },
+            {
+              "internalType": "uint256",
+              "name": "subtreeSize",
+              "type": "uint256"
+            },
+            {
+              "internalType": "uint256",
+              "name": "subtreeVersion",
+              "type": "uint256"
+            },
+            {
+              "internalType": "bytes32",
+              "name": "siblingHash",
+              "type": "bytes32"
+            }
+          ],
+          "internalType": "struct IAVLMerklePath.Data[]",
+          "name": "merklePaths",
+          "type": "tuple[]"
+        }
+    ],
+    "internalType": "struct IFeedsVerifier.PriceProof[]",
+    "name": "priceProofs",
+    "type": "tuple[]"
+  }
+]
+`)
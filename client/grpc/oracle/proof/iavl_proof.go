@@ -6,6 +6,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
 	ics23 "github.com/cosmos/ics23/go"
 )
 
@@ -68,3 +70,52 @@ func GetMerklePaths(iavlEp *ics23.ExistenceProof) []IAVLMerklePath {
 	}
 	return paths
 }
+
+func getIAVLLeafHash(key []byte, value []byte, version uint64) []byte {
+	// ref: https://github.com/cosmos/iavl/blob/master/proof_ics23.go#L96
+	preimage := convertVarIntToBytes(0)
+	preimage = append(preimage, convertVarIntToBytes(1)...)
+	preimage = append(preimage, convertVarIntToBytes(int64(version))...)
+	preimage = append(preimage, encodeUvarint(uint64(len(key)))...)
+	preimage = append(preimage, key...)
+	preimage = append(preimage, 32) // size of value hash must be 32
+	preimage = append(preimage, tmhash.Sum(value)...)
+
+	return tmhash.Sum(preimage)
+}
+
+func getIAVLParentHash(path IAVLMerklePath, subtreeHash []byte) []byte {
+	var lengthByte byte = 0x20
+
+	// prefix of inner node
+	preimage := convertVarIntToBytes(int64(path.SubtreeHeight))
+	preimage = append(preimage, convertVarIntToBytes(int64(path.SubtreeSize))...)
+	preimage = append(preimage, convertVarIntToBytes(int64(path.SubtreeVersion))...)
+
+	if path.IsDataOnRight {
+		preimage = append(preimage, lengthByte)
+		preimage = append(preimage, path.SiblingHash...)
+
+		preimage = append(preimage, lengthByte)
+		preimage = append(preimage, subtreeHash...)
+	} else {
+		preimage = append(preimage, lengthByte)
+		preimage = append(preimage, subtreeHash...)
+
+		preimage = append(preimage, lengthByte)
+		preimage = append(preimage, path.SiblingHash...)
+	}
+
+	return tmhash.Sum(preimage)
+}
+
+// CalculateIAVLRootHash computes the root hash of the iAVL tree that contains the given key and value
+// at the given version from the given Merkle paths.
+func CalculateIAVLRootHash(key []byte, value []byte, version uint64, merklePaths []IAVLMerklePath) []byte {
+	currentHash := getIAVLLeafHash(key, value, version)
+	for _, path := range merklePaths {
+		currentHash = getIAVLParentHash(path, currentHash)
+	}
+
+	return currentHash
+}
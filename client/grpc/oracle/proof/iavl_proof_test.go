@@ -45,31 +45,6 @@ import (
 }
 */
 
-func getIAVLParentHash(path IAVLMerklePath, subtreeHash []byte) []byte {
-	var lengthByte byte = 0x20
-
-	// prefix of inner node
-	preimage := convertVarIntToBytes(int64(path.SubtreeHeight))
-	preimage = append(preimage, convertVarIntToBytes(int64(path.SubtreeSize))...)
-	preimage = append(preimage, convertVarIntToBytes(int64(path.SubtreeVersion))...)
-
-	if path.IsDataOnRight {
-		preimage = append(preimage, lengthByte)
-		preimage = append(preimage, path.SiblingHash...)
-
-		preimage = append(preimage, lengthByte)
-		preimage = append(preimage, subtreeHash...)
-	} else {
-		preimage = append(preimage, lengthByte)
-		preimage = append(preimage, subtreeHash...)
-
-		preimage = append(preimage, lengthByte)
-		preimage = append(preimage, path.SiblingHash...)
-	}
-
-	return tmhash.Sum(preimage)
-}
-
 func TestGetMerklePaths(t *testing.T) {
 	key := base64ToBytes("/wAAAAAAAAAB")
 	data := base64ToBytes(
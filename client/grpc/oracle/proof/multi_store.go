@@ -3,9 +3,13 @@ package proof
 import (
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 
 	ics23 "github.com/cosmos/ics23/go"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
 // MultiStoreProofEthereum is an Ethereum version of MultiStoreProof for solidity ABI-encoding.
@@ -40,3 +44,83 @@ func GetMultiStoreProof(multiStoreEp *ics23.ExistenceProof) MultiStoreProof {
 		AuthToIcahostStoresMerkleHash:         tmbytes.HexBytes(multiStoreEp.Path[4].Prefix[1:]),
 	}
 }
+
+// CalculateAppHash computes Tendermint's application state hash from the multi store proof.
+func (m *MultiStoreProof) CalculateAppHash() []byte {
+	return innerHash(
+		m.AuthToIcahostStoresMerkleHash,
+		innerHash(
+			innerHash(
+				innerHash(
+					innerHash(
+						m.MintStoreMerkleHash,
+						leafHash(encodeStoreMerkleLeaf(oracletypes.StoreKey, m.OracleIAVLStateHash)),
+					),
+					m.ParamsToRestakeStoresMerkleHash,
+				),
+				m.RollingseedToTransferStoresMerkleHash,
+			),
+			m.TssToUpgradeStoresMerkleHash,
+		),
+	)
+}
+
+// FeedsMultiStoreProofEthereum is an Ethereum version of FeedsMultiStoreProof for solidity ABI-encoding.
+type FeedsMultiStoreProofEthereum struct {
+	FeedsIAVLStateHash                 common.Hash
+	EvidenceStoreMerkleHash            common.Hash
+	FeegrantToFeeibcStoresMerkleHash   common.Hash
+	GlobalfeeToIcahostStoresMerkleHash common.Hash
+	AuthToDistributionStoresMerkleHash common.Hash
+	MintToUpgradeStoresMerkleHash      common.Hash
+}
+
+func (m *FeedsMultiStoreProof) encodeToEthFormat() FeedsMultiStoreProofEthereum {
+	return FeedsMultiStoreProofEthereum{
+		FeedsIAVLStateHash:                 common.BytesToHash(m.FeedsIAVLStateHash),
+		EvidenceStoreMerkleHash:            common.BytesToHash(m.EvidenceStoreMerkleHash),
+		FeegrantToFeeibcStoresMerkleHash:   common.BytesToHash(m.FeegrantToFeeibcStoresMerkleHash),
+		GlobalfeeToIcahostStoresMerkleHash: common.BytesToHash(m.GlobalfeeToIcahostStoresMerkleHash),
+		AuthToDistributionStoresMerkleHash: common.BytesToHash(m.AuthToDistributionStoresMerkleHash),
+		MintToUpgradeStoresMerkleHash:      common.BytesToHash(m.MintToUpgradeStoresMerkleHash),
+	}
+}
+
+// GetFeedsMultiStoreProof compacts Multi store proof of the feeds store from Tendermint to FeedsMultiStoreProof version.
+func GetFeedsMultiStoreProof(multiStoreEp *ics23.ExistenceProof) FeedsMultiStoreProof {
+	return FeedsMultiStoreProof{
+		FeedsIAVLStateHash:                 tmbytes.HexBytes(multiStoreEp.Value),
+		EvidenceStoreMerkleHash:            tmbytes.HexBytes(multiStoreEp.Path[0].Prefix[1:]),
+		FeegrantToFeeibcStoresMerkleHash:   tmbytes.HexBytes(multiStoreEp.Path[1].Suffix),
+		GlobalfeeToIcahostStoresMerkleHash: tmbytes.HexBytes(multiStoreEp.Path[2].Suffix),
+		AuthToDistributionStoresMerkleHash: tmbytes.HexBytes(multiStoreEp.Path[3].Prefix[1:]),
+		MintToUpgradeStoresMerkleHash:      tmbytes.HexBytes(multiStoreEp.Path[4].Suffix),
+	}
+}
+
+// CalculateAppHash computes Tendermint's application state hash from the feeds multi store proof.
+func (m *FeedsMultiStoreProof) CalculateAppHash() []byte {
+	return innerHash(
+		innerHash(
+			m.AuthToDistributionStoresMerkleHash,
+			innerHash(
+				innerHash(
+					innerHash(
+						m.EvidenceStoreMerkleHash,
+						leafHash(encodeStoreMerkleLeaf(feedstypes.StoreKey, m.FeedsIAVLStateHash)),
+					),
+					m.FeegrantToFeeibcStoresMerkleHash,
+				),
+				m.GlobalfeeToIcahostStoresMerkleHash,
+			),
+		),
+		m.MintToUpgradeStoresMerkleHash,
+	)
+}
+
+// encodeStoreMerkleLeaf encodes the name and the state hash of a store into a leaf of the multistore Merkle tree.
+func encodeStoreMerkleLeaf(storeKey string, stateHash []byte) []byte {
+	leaf := append([]byte{uint8(len(storeKey))}, []byte(storeKey)...)
+	leaf = append(leaf, 32) // size of state hash must be 32
+	return append(leaf, tmhash.Sum(stateHash)...)
+}
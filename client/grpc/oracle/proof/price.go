@@ -0,0 +1,99 @@
+package proof
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// PriceEthereum is an Ethereum version of Price for solidity ABI-encoding.
+type PriceEthereum struct {
+	SignalID          string
+	Status            uint8
+	Price             uint64
+	Timestamp         int64
+	AggregationMethod uint8
+	ReporterCount     uint64
+	PowerShareBPS     uint64
+	Dispersion        uint64
+}
+
+// PriceProofEthereum is an Ethereum version of PriceProof for solidity ABI-encoding.
+type PriceProofEthereum struct {
+	Price       PriceEthereum
+	Version     *big.Int
+	MerklePaths []IAVLMerklePathEthereum
+}
+
+func transformPrice(p feedstypes.Price) PriceEthereum {
+	var confidence feedstypes.PriceConfidence
+	if p.Confidence != nil {
+		confidence = *p.Confidence
+	}
+
+	return PriceEthereum{
+		SignalID:          p.SignalID,
+		Status:            uint8(p.Status),
+		Price:             p.Price,
+		Timestamp:         p.Timestamp,
+		AggregationMethod: uint8(p.AggregationMethod),
+		ReporterCount:     confidence.ReporterCount,
+		PowerShareBPS:     confidence.PowerShareBPS,
+		Dispersion:        confidence.Dispersion,
+	}
+}
+
+func (p *PriceProof) encodeToEthFormat() PriceProofEthereum {
+	parsePaths := make([]IAVLMerklePathEthereum, len(p.MerklePaths))
+	for i, path := range p.MerklePaths {
+		parsePaths[i] = path.encodeToEthFormat()
+	}
+
+	return PriceProofEthereum{
+		Price:       transformPrice(p.Price),
+		Version:     big.NewInt(int64(p.Version)),
+		MerklePaths: parsePaths,
+	}
+}
+
+// CalculateFeedsIAVLStateHash computes the root hash of the feeds store from the price proof.
+func (p *PriceProof) CalculateFeedsIAVLStateHash() ([]byte, error) {
+	value, err := p.Price.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return CalculateIAVLRootHash(feedstypes.PriceStoreKey(p.Price.SignalID), value, p.Version, p.MerklePaths), nil
+}
+
+// VerifyPricesProof verifies that the prices of the given prices proof are in the application state
+// of the given app hash. The app hash must come from a block header that is trusted by the caller,
+// e.g. one that is verified by a light client or by the block relay proof of the prices proof.
+func VerifyPricesProof(proof PricesProof, appHash []byte) error {
+	if len(proof.PriceProofs) == 0 {
+		return fmt.Errorf("no price proof found")
+	}
+
+	for _, priceProof := range proof.PriceProofs {
+		feedsStateHash, err := priceProof.CalculateFeedsIAVLStateHash()
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(feedsStateHash, proof.FeedsMultiStoreProof.FeedsIAVLStateHash) {
+			return fmt.Errorf("invalid price proof of signal id %s", priceProof.Price.SignalID)
+		}
+	}
+
+	if !bytes.Equal(proof.FeedsMultiStoreProof.CalculateAppHash(), appHash) {
+		return fmt.Errorf("invalid feeds multi store proof")
+	}
+
+	if !bytes.Equal(proof.BlockRelayProof.MultiStoreProof.CalculateAppHash(), appHash) {
+		return fmt.Errorf("invalid block relay multi store proof")
+	}
+
+	return nil
+}
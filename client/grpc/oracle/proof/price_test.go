@@ -0,0 +1,119 @@
+package proof
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// storeNames are the names of all KV stores of the application.
+var storeNames = []string{
+	"acc", "authz", "bandtss", "bank", "capability", "consensus", "crisis", "distribution", "evidence",
+	"feeds", "feegrant", "feeibc", "globalfee", "gov", "ibc", "icahost", "mint", "oracle", "params",
+	"restake", "rollingseed", "slashing", "staking", "transfer", "tss", "tunnel", "upgrade",
+}
+
+// setupPricesProof commits the given prices to a multistore with all stores of the application, and
+// returns the prices proof of the prices with the app hash of the committed state.
+func setupPricesProof(t *testing.T, prices []feedstypes.Price) (PricesProof, []byte) {
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, name := range storeNames {
+		cms.MountStoreWithDB(storetypes.NewKVStoreKey(name), storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+
+	// fill the stores so that the Merkle paths are not trivial
+	for _, name := range storeNames {
+		cms.GetCommitKVStore(cms.StoreKeysByName()[name]).Set([]byte("key"), []byte(name))
+	}
+
+	feedsStore := cms.GetCommitKVStore(cms.StoreKeysByName()[feedstypes.StoreKey])
+	for _, price := range prices {
+		bz, err := price.Marshal()
+		require.NoError(t, err)
+		feedsStore.Set(feedstypes.PriceStoreKey(price.SignalID), bz)
+	}
+	oracleStore := cms.GetCommitKVStore(cms.StoreKeysByName()[oracletypes.StoreKey])
+	oracleStore.Set(oracletypes.ParamsKeyPrefix, []byte("params"))
+
+	commitID := cms.Commit()
+
+	query := func(storeKey string, key []byte) (*ics23.ExistenceProof, *ics23.ExistenceProof) {
+		res, err := cms.Query(&storetypes.RequestQuery{
+			Path:   "/" + storeKey + "/key",
+			Data:   key,
+			Height: commitID.Version,
+			Prove:  true,
+		})
+		require.NoError(t, err)
+
+		iavlEp, multiStoreEp, err := parseProofOps(res.ProofOps, true)
+		require.NoError(t, err)
+
+		return iavlEp, multiStoreEp
+	}
+
+	_, oracleMultiStoreEp := query(oracletypes.StoreKey, oracletypes.ParamsKeyPrefix)
+	proof := PricesProof{
+		BlockHeight:     uint64(commitID.Version + 1),
+		BlockRelayProof: BlockRelayProof{MultiStoreProof: GetMultiStoreProof(oracleMultiStoreEp)},
+	}
+	for _, price := range prices {
+		iavlEp, feedsMultiStoreEp := query(feedstypes.StoreKey, feedstypes.PriceStoreKey(price.SignalID))
+
+		proof.FeedsMultiStoreProof = GetFeedsMultiStoreProof(feedsMultiStoreEp)
+		proof.PriceProofs = append(proof.PriceProofs, PriceProof{
+			Price:       price,
+			Version:     decodeIAVLLeafPrefix(iavlEp.Leaf.Prefix),
+			MerklePaths: GetMerklePaths(iavlEp),
+		})
+	}
+
+	return proof, commitID.Hash
+}
+
+func TestVerifyPricesProof(t *testing.T) {
+	prices := []feedstypes.Price{
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000000000, 1729000000),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BTC-USD", 65000000000000, 1729000000),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "CS:ETH-USD", 0, 1729000000),
+	}
+	prices[0].Confidence = feedstypes.NewPriceConfidence(3, 9000, 1000)
+
+	proof, appHash := setupPricesProof(t, prices)
+	require.Equal(t, appHash, proof.FeedsMultiStoreProof.CalculateAppHash())
+	require.Equal(t, appHash, proof.BlockRelayProof.MultiStoreProof.CalculateAppHash())
+	require.NoError(t, VerifyPricesProof(proof, appHash))
+
+	// the proof must be encodable for EVM verifiers
+	_, err := proof.encodeToEthData()
+	require.NoError(t, err)
+
+	// a tampered price cannot be verified
+	tamperedProof, _ := setupPricesProof(t, prices)
+	tamperedProof.PriceProofs[1].Price.Price++
+	require.ErrorContains(t, VerifyPricesProof(tamperedProof, appHash), "invalid price proof of signal id CS:BTC-USD")
+
+	// a tampered confidence cannot be verified
+	tamperedProof, _ = setupPricesProof(t, prices)
+	tamperedProof.PriceProofs[0].Price.Confidence = feedstypes.NewPriceConfidence(4, 9000, 1000)
+	require.ErrorContains(t, VerifyPricesProof(tamperedProof, appHash), "invalid price proof of signal id CS:BAND-USD")
+
+	// the proof cannot be verified against a different app hash
+	require.ErrorContains(t, VerifyPricesProof(proof, make([]byte, 32)), "invalid feeds multi store proof")
+
+	// a proof without prices cannot be verified
+	require.ErrorContains(t, VerifyPricesProof(PricesProof{}, appHash), "no price proof found")
+}
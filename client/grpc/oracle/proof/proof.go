@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
+	tmcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 
 	ics23 "github.com/cosmos/ics23/go"
@@ -18,9 +19,10 @@ import (
 )
 
 var (
-	relayArguments       abi.Arguments
-	verifyArguments      abi.Arguments
-	verifyCountArguments abi.Arguments
+	relayArguments        abi.Arguments
+	verifyArguments       abi.Arguments
+	verifyCountArguments  abi.Arguments
+	verifyPricesArguments abi.Arguments
 )
 
 const (
@@ -40,6 +42,10 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	err = json.Unmarshal(verifyPricesFormat, &verifyPricesArguments)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func (blockRelay *BlockRelayProof) encodeToEthData() ([]byte, error) {
@@ -81,6 +87,18 @@ func (o *RequestsCountProof) encodeToEthData(blockHeight uint64) ([]byte, error)
 	)
 }
 
+func (p *PricesProof) encodeToEthData() ([]byte, error) {
+	parsePriceProofs := make([]PriceProofEthereum, len(p.PriceProofs))
+	for i, priceProof := range p.PriceProofs {
+		parsePriceProofs[i] = priceProof.encodeToEthFormat()
+	}
+	return verifyPricesArguments.Pack(
+		big.NewInt(int64(p.BlockHeight)),
+		p.FeedsMultiStoreProof.encodeToEthFormat(),
+		parsePriceProofs,
+	)
+}
+
 type CommonEncodedVotePartEthereum struct {
 	SignedDataPrefix []byte
 	SignedDataSuffix []byte
@@ -95,13 +113,14 @@ func (commonVote *CommonEncodedVotePart) encodeToEthFormat() CommonEncodedVotePa
 
 func getProofsByKey(
 	ctx client.Context,
+	storeKey string,
 	key []byte,
 	queryOptions rpcclient.ABCIQueryOptions,
 	getMultiStoreEp bool,
 ) ([]byte, *ics23.ExistenceProof, *ics23.ExistenceProof, error) {
 	resp, err := ctx.Client.ABCIQueryWithOptions(
 		context.Background(),
-		"/store/oracle/key",
+		fmt.Sprintf("/store/%s/key", storeKey),
 		key,
 		queryOptions,
 	)
@@ -109,14 +128,27 @@ func getProofsByKey(
 		return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, err
 	}
 
-	proof := resp.Response.GetProofOps()
-	if proof == nil {
-		return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf("Proof not found")
+	iavlEp, multiStoreEp, err := parseProofOps(resp.Response.GetProofOps(), getMultiStoreEp)
+	if err != nil {
+		return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, err
+	}
+
+	return resp.Response.GetValue(), iavlEp, multiStoreEp, nil
+}
+
+// parseProofOps extracts the iAVL existence proof and, if requested, the multistore existence proof
+// from the given proof ops of an ABCI query.
+func parseProofOps(
+	proofOps *tmcrypto.ProofOps,
+	getMultiStoreEp bool,
+) (*ics23.ExistenceProof, *ics23.ExistenceProof, error) {
+	if proofOps == nil {
+		return &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf("Proof not found")
 	}
 
-	ops := proof.GetOps()
+	ops := proofOps.GetOps()
 	if ops == nil {
-		return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf("Proof ops not found")
+		return &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf("Proof ops not found")
 	}
 
 	// Extract iavl proof and multistore existence proof
@@ -133,7 +165,7 @@ func getProofsByKey(
 			iavlOps := storetypes.NewIavlCommitmentOp(op.Key, proof)
 			iavlEp = iavlOps.Proof.GetExist()
 			if iavlEp == nil {
-				return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf(
+				return &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf(
 					"IAVL existence proof not found",
 				)
 			}
@@ -147,15 +179,15 @@ func getProofsByKey(
 				multiStoreOps := storetypes.NewSimpleMerkleCommitmentOp(op.Key, proof)
 				multiStoreEp = multiStoreOps.Proof.GetExist()
 				if multiStoreEp == nil {
-					return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf(
+					return &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf(
 						"MultiStore existence proof not found",
 					)
 				}
 			}
 		default:
-			return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf("unknown proof ops found")
+			return &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf("unknown proof ops found")
 		}
 	}
 
-	return resp.Response.GetValue(), iavlEp, multiStoreEp, nil
+	return iavlEp, multiStoreEp, nil
 }
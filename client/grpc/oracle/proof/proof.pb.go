@@ -6,7 +6,8 @@ package proof
 import (
 	context "context"
 	fmt "fmt"
-	types "github.com/bandprotocol/chain/v3/x/oracle/types"
+	types "github.com/bandprotocol/chain/v3/x/feeds/types"
+	types1 "github.com/bandprotocol/chain/v3/x/oracle/types"
 	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -330,6 +331,114 @@ func (m *RequestCountProofResponse) GetResult() CountProofResult {
 	return CountProofResult{}
 }
 
+// PricesProofRequest is request type for the Service/PricesProof RPC method.
+type PricesProofRequest struct {
+	// signal_ids is the list of signal IDs
+	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PricesProofRequest) Reset()         { *m = PricesProofRequest{} }
+func (m *PricesProofRequest) String() string { return proto.CompactTextString(m) }
+func (*PricesProofRequest) ProtoMessage()    {}
+func (*PricesProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{6}
+}
+func (m *PricesProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricesProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricesProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricesProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricesProofRequest.Merge(m, src)
+}
+func (m *PricesProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricesProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricesProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricesProofRequest proto.InternalMessageInfo
+
+func (m *PricesProofRequest) GetSignalIds() []string {
+	if m != nil {
+		return m.SignalIds
+	}
+	return nil
+}
+
+func (m *PricesProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PricesProofResponse is response type for the Service/PricesProof RPC method.
+type PricesProofResponse struct {
+	Height int64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Result PricesProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result"`
+}
+
+func (m *PricesProofResponse) Reset()         { *m = PricesProofResponse{} }
+func (m *PricesProofResponse) String() string { return proto.CompactTextString(m) }
+func (*PricesProofResponse) ProtoMessage()    {}
+func (*PricesProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{7}
+}
+func (m *PricesProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricesProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricesProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricesProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricesProofResponse.Merge(m, src)
+}
+func (m *PricesProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricesProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricesProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricesProofResponse proto.InternalMessageInfo
+
+func (m *PricesProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PricesProofResponse) GetResult() PricesProofResult {
+	if m != nil {
+		return m.Result
+	}
+	return PricesProofResult{}
+}
+
 // SingleProofResponse is the data structure for response of single proof
 type SingleProofResult struct {
 	Proof         SingleProof                                      `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
@@ -340,7 +449,7 @@ func (m *SingleProofResult) Reset()         { *m = SingleProofResult{} }
 func (m *SingleProofResult) String() string { return proto.CompactTextString(m) }
 func (*SingleProofResult) ProtoMessage()    {}
 func (*SingleProofResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{6}
+	return fileDescriptor_9deb752797273b09, []int{8}
 }
 func (m *SingleProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +502,7 @@ func (m *MultiProofResult) Reset()         { *m = MultiProofResult{} }
 func (m *MultiProofResult) String() string { return proto.CompactTextString(m) }
 func (*MultiProofResult) ProtoMessage()    {}
 func (*MultiProofResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{7}
+	return fileDescriptor_9deb752797273b09, []int{9}
 }
 func (m *MultiProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +555,7 @@ func (m *CountProofResult) Reset()         { *m = CountProofResult{} }
 func (m *CountProofResult) String() string { return proto.CompactTextString(m) }
 func (*CountProofResult) ProtoMessage()    {}
 func (*CountProofResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{8}
+	return fileDescriptor_9deb752797273b09, []int{10}
 }
 func (m *CountProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,6 +598,59 @@ func (m *CountProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_
 	return nil
 }
 
+// PricesProofResult is the data structure for response of prices proof
+type PricesProofResult struct {
+	Proof         PricesProof                                      `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
+}
+
+func (m *PricesProofResult) Reset()         { *m = PricesProofResult{} }
+func (m *PricesProofResult) String() string { return proto.CompactTextString(m) }
+func (*PricesProofResult) ProtoMessage()    {}
+func (*PricesProofResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{11}
+}
+func (m *PricesProofResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricesProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricesProofResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricesProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricesProofResult.Merge(m, src)
+}
+func (m *PricesProofResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricesProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricesProofResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricesProofResult proto.InternalMessageInfo
+
+func (m *PricesProofResult) GetProof() PricesProof {
+	if m != nil {
+		return m.Proof
+	}
+	return PricesProof{}
+}
+
+func (m *PricesProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EvmProofBytes
+	}
+	return nil
+}
+
 // SingleProof contains block height, oracle data proof and block relay proof
 type SingleProof struct {
 	BlockHeight     uint64          `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
@@ -500,7 +662,7 @@ func (m *SingleProof) Reset()         { *m = SingleProof{} }
 func (m *SingleProof) String() string { return proto.CompactTextString(m) }
 func (*SingleProof) ProtoMessage()    {}
 func (*SingleProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{9}
+	return fileDescriptor_9deb752797273b09, []int{12}
 }
 func (m *SingleProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +723,7 @@ func (m *MultiProof) Reset()         { *m = MultiProof{} }
 func (m *MultiProof) String() string { return proto.CompactTextString(m) }
 func (*MultiProof) ProtoMessage()    {}
 func (*MultiProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{10}
+	return fileDescriptor_9deb752797273b09, []int{13}
 }
 func (m *MultiProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,7 +784,7 @@ func (m *CountProof) Reset()         { *m = CountProof{} }
 func (m *CountProof) String() string { return proto.CompactTextString(m) }
 func (*CountProof) ProtoMessage()    {}
 func (*CountProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{11}
+	return fileDescriptor_9deb752797273b09, []int{14}
 }
 func (m *CountProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,9 +834,139 @@ func (m *CountProof) GetBlockRelayProof() BlockRelayProof {
 	return BlockRelayProof{}
 }
 
+// PricesProof contains block height, feeds multi store proof, list of price proof and block relay proof
+type PricesProof struct {
+	BlockHeight          uint64               `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	FeedsMultiStoreProof FeedsMultiStoreProof `protobuf:"bytes,2,opt,name=feeds_multi_store_proof,json=feedsMultiStoreProof,proto3" json:"feeds_multi_store_proof"`
+	PriceProofs          []PriceProof         `protobuf:"bytes,3,rep,name=price_proofs,json=priceProofs,proto3" json:"price_proofs"`
+	BlockRelayProof      BlockRelayProof      `protobuf:"bytes,4,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
+}
+
+func (m *PricesProof) Reset()         { *m = PricesProof{} }
+func (m *PricesProof) String() string { return proto.CompactTextString(m) }
+func (*PricesProof) ProtoMessage()    {}
+func (*PricesProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{15}
+}
+func (m *PricesProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricesProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricesProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricesProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricesProof.Merge(m, src)
+}
+func (m *PricesProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricesProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricesProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricesProof proto.InternalMessageInfo
+
+func (m *PricesProof) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PricesProof) GetFeedsMultiStoreProof() FeedsMultiStoreProof {
+	if m != nil {
+		return m.FeedsMultiStoreProof
+	}
+	return FeedsMultiStoreProof{}
+}
+
+func (m *PricesProof) GetPriceProofs() []PriceProof {
+	if m != nil {
+		return m.PriceProofs
+	}
+	return nil
+}
+
+func (m *PricesProof) GetBlockRelayProof() BlockRelayProof {
+	if m != nil {
+		return m.BlockRelayProof
+	}
+	return BlockRelayProof{}
+}
+
+// PriceProof contains price, version and merkle paths
+type PriceProof struct {
+	Price       types.Price      `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+}
+
+func (m *PriceProof) Reset()         { *m = PriceProof{} }
+func (m *PriceProof) String() string { return proto.CompactTextString(m) }
+func (*PriceProof) ProtoMessage()    {}
+func (*PriceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{16}
+}
+func (m *PriceProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceProof.Merge(m, src)
+}
+func (m *PriceProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceProof proto.InternalMessageInfo
+
+func (m *PriceProof) GetPrice() types.Price {
+	if m != nil {
+		return m.Price
+	}
+	return types.Price{}
+}
+
+func (m *PriceProof) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PriceProof) GetMerklePaths() []IAVLMerklePath {
+	if m != nil {
+		return m.MerklePaths
+	}
+	return nil
+}
+
 // OracleDataProof contains result, version and merkle paths
 type OracleDataProof struct {
-	Result      types.Result     `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+	Result      types1.Result    `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
 	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
 }
@@ -683,7 +975,7 @@ func (m *OracleDataProof) Reset()         { *m = OracleDataProof{} }
 func (m *OracleDataProof) String() string { return proto.CompactTextString(m) }
 func (*OracleDataProof) ProtoMessage()    {}
 func (*OracleDataProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{12}
+	return fileDescriptor_9deb752797273b09, []int{17}
 }
 func (m *OracleDataProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,11 +1004,11 @@ func (m *OracleDataProof) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OracleDataProof proto.InternalMessageInfo
 
-func (m *OracleDataProof) GetResult() types.Result {
+func (m *OracleDataProof) GetResult() types1.Result {
 	if m != nil {
 		return m.Result
 	}
-	return types.Result{}
+	return types1.Result{}
 }
 
 func (m *OracleDataProof) GetVersion() uint64 {
@@ -746,7 +1038,7 @@ func (m *IAVLMerklePath) Reset()         { *m = IAVLMerklePath{} }
 func (m *IAVLMerklePath) String() string { return proto.CompactTextString(m) }
 func (*IAVLMerklePath) ProtoMessage()    {}
 func (*IAVLMerklePath) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{13}
+	return fileDescriptor_9deb752797273b09, []int{18}
 }
 func (m *IAVLMerklePath) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +1114,7 @@ func (m *BlockRelayProof) Reset()         { *m = BlockRelayProof{} }
 func (m *BlockRelayProof) String() string { return proto.CompactTextString(m) }
 func (*BlockRelayProof) ProtoMessage()    {}
 func (*BlockRelayProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{14}
+	return fileDescriptor_9deb752797273b09, []int{19}
 }
 func (m *BlockRelayProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1208,7 @@ func (m *MultiStoreProof) Reset()         { *m = MultiStoreProof{} }
 func (m *MultiStoreProof) String() string { return proto.CompactTextString(m) }
 func (*MultiStoreProof) ProtoMessage()    {}
 func (*MultiStoreProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{15}
+	return fileDescriptor_9deb752797273b09, []int{20}
 }
 func (m *MultiStoreProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -987,6 +1279,94 @@ func (m *MultiStoreProof) GetAuthToIcahostStoresMerkleHash() github_com_cometbft
 	return nil
 }
 
+// FeedsMultiStoreProof stores a compact of other Cosmos-SDK modules' storage hash in multistore to
+// compute (in combination with feeds store hash) Tendermint's application state hash at a given block.
+// It uses the same Merkle tree as MultiStoreProof, but validates the correctness of [9] instead of [H].
+// Only [8], [N5], [N15], [N20], and [N25] are needed in order to compute [AppHash].
+type FeedsMultiStoreProof struct {
+	FeedsIAVLStateHash                 github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=feeds_iavl_state_hash,json=feedsIavlStateHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"feeds_iavl_state_hash,omitempty"`
+	EvidenceStoreMerkleHash            github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evidence_store_merkle_hash,json=evidenceStoreMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evidence_store_merkle_hash,omitempty"`
+	FeegrantToFeeibcStoresMerkleHash   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=feegrant_to_feeibc_stores_merkle_hash,json=feegrantToFeeibcStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"feegrant_to_feeibc_stores_merkle_hash,omitempty"`
+	GlobalfeeToIcahostStoresMerkleHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,4,opt,name=globalfee_to_icahost_stores_merkle_hash,json=globalfeeToIcahostStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"globalfee_to_icahost_stores_merkle_hash,omitempty"`
+	AuthToDistributionStoresMerkleHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=auth_to_distribution_stores_merkle_hash,json=authToDistributionStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"auth_to_distribution_stores_merkle_hash,omitempty"`
+	MintToUpgradeStoresMerkleHash      github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=mint_to_upgrade_stores_merkle_hash,json=mintToUpgradeStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"mint_to_upgrade_stores_merkle_hash,omitempty"`
+}
+
+func (m *FeedsMultiStoreProof) Reset()         { *m = FeedsMultiStoreProof{} }
+func (m *FeedsMultiStoreProof) String() string { return proto.CompactTextString(m) }
+func (*FeedsMultiStoreProof) ProtoMessage()    {}
+func (*FeedsMultiStoreProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{21}
+}
+func (m *FeedsMultiStoreProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedsMultiStoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedsMultiStoreProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedsMultiStoreProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedsMultiStoreProof.Merge(m, src)
+}
+func (m *FeedsMultiStoreProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedsMultiStoreProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedsMultiStoreProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedsMultiStoreProof proto.InternalMessageInfo
+
+func (m *FeedsMultiStoreProof) GetFeedsIAVLStateHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.FeedsIAVLStateHash
+	}
+	return nil
+}
+
+func (m *FeedsMultiStoreProof) GetEvidenceStoreMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EvidenceStoreMerkleHash
+	}
+	return nil
+}
+
+func (m *FeedsMultiStoreProof) GetFeegrantToFeeibcStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.FeegrantToFeeibcStoresMerkleHash
+	}
+	return nil
+}
+
+func (m *FeedsMultiStoreProof) GetGlobalfeeToIcahostStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.GlobalfeeToIcahostStoresMerkleHash
+	}
+	return nil
+}
+
+func (m *FeedsMultiStoreProof) GetAuthToDistributionStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.AuthToDistributionStoresMerkleHash
+	}
+	return nil
+}
+
+func (m *FeedsMultiStoreProof) GetMintToUpgradeStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.MintToUpgradeStoresMerkleHash
+	}
+	return nil
+}
+
 // BlockHeaderMerkleParts stores a group of hashes using for computing Tendermint's block
 // header hash from app hash, and height.
 //
@@ -1028,7 +1408,7 @@ func (m *BlockHeaderMerkleParts) Reset()         { *m = BlockHeaderMerkleParts{}
 func (m *BlockHeaderMerkleParts) String() string { return proto.CompactTextString(m) }
 func (*BlockHeaderMerkleParts) ProtoMessage()    {}
 func (*BlockHeaderMerkleParts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{16}
+	return fileDescriptor_9deb752797273b09, []int{22}
 }
 func (m *BlockHeaderMerkleParts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1123,7 +1503,7 @@ func (m *CommonEncodedVotePart) Reset()         { *m = CommonEncodedVotePart{} }
 func (m *CommonEncodedVotePart) String() string { return proto.CompactTextString(m) }
 func (*CommonEncodedVotePart) ProtoMessage()    {}
 func (*CommonEncodedVotePart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{17}
+	return fileDescriptor_9deb752797273b09, []int{23}
 }
 func (m *CommonEncodedVotePart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1182,7 +1562,7 @@ func (m *TMSignature) Reset()         { *m = TMSignature{} }
 func (m *TMSignature) String() string { return proto.CompactTextString(m) }
 func (*TMSignature) ProtoMessage()    {}
 func (*TMSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{18}
+	return fileDescriptor_9deb752797273b09, []int{24}
 }
 func (m *TMSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,7 +1630,7 @@ func (m *RequestsCountProof) Reset()         { *m = RequestsCountProof{} }
 func (m *RequestsCountProof) String() string { return proto.CompactTextString(m) }
 func (*RequestsCountProof) ProtoMessage()    {}
 func (*RequestsCountProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{19}
+	return fileDescriptor_9deb752797273b09, []int{25}
 }
 func (m *RequestsCountProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1307,16 +1687,22 @@ func init() {
 	proto.RegisterType((*MultiProofResponse)(nil), "band.base.oracle.v1.MultiProofResponse")
 	proto.RegisterType((*RequestCountProofRequest)(nil), "band.base.oracle.v1.RequestCountProofRequest")
 	proto.RegisterType((*RequestCountProofResponse)(nil), "band.base.oracle.v1.RequestCountProofResponse")
+	proto.RegisterType((*PricesProofRequest)(nil), "band.base.oracle.v1.PricesProofRequest")
+	proto.RegisterType((*PricesProofResponse)(nil), "band.base.oracle.v1.PricesProofResponse")
 	proto.RegisterType((*SingleProofResult)(nil), "band.base.oracle.v1.SingleProofResult")
 	proto.RegisterType((*MultiProofResult)(nil), "band.base.oracle.v1.MultiProofResult")
 	proto.RegisterType((*CountProofResult)(nil), "band.base.oracle.v1.CountProofResult")
+	proto.RegisterType((*PricesProofResult)(nil), "band.base.oracle.v1.PricesProofResult")
 	proto.RegisterType((*SingleProof)(nil), "band.base.oracle.v1.SingleProof")
 	proto.RegisterType((*MultiProof)(nil), "band.base.oracle.v1.MultiProof")
 	proto.RegisterType((*CountProof)(nil), "band.base.oracle.v1.CountProof")
+	proto.RegisterType((*PricesProof)(nil), "band.base.oracle.v1.PricesProof")
+	proto.RegisterType((*PriceProof)(nil), "band.base.oracle.v1.PriceProof")
 	proto.RegisterType((*OracleDataProof)(nil), "band.base.oracle.v1.OracleDataProof")
 	proto.RegisterType((*IAVLMerklePath)(nil), "band.base.oracle.v1.IAVLMerklePath")
 	proto.RegisterType((*BlockRelayProof)(nil), "band.base.oracle.v1.BlockRelayProof")
 	proto.RegisterType((*MultiStoreProof)(nil), "band.base.oracle.v1.MultiStoreProof")
+	proto.RegisterType((*FeedsMultiStoreProof)(nil), "band.base.oracle.v1.FeedsMultiStoreProof")
 	proto.RegisterType((*BlockHeaderMerkleParts)(nil), "band.base.oracle.v1.BlockHeaderMerkleParts")
 	proto.RegisterType((*CommonEncodedVotePart)(nil), "band.base.oracle.v1.CommonEncodedVotePart")
 	proto.RegisterType((*TMSignature)(nil), "band.base.oracle.v1.TMSignature")
@@ -1326,105 +1712,124 @@ func init() {
 func init() { proto.RegisterFile("band/base/oracle/v1/proof.proto", fileDescriptor_9deb752797273b09) }
 
 var fileDescriptor_9deb752797273b09 = []byte{
-	// 1562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5d, 0x6f, 0x13, 0xc7,
-	0x1a, 0xce, 0xc6, 0x4e, 0xe0, 0xbc, 0x4e, 0x48, 0x32, 0x81, 0x60, 0x72, 0x20, 0x4e, 0xf6, 0x00,
-	0x09, 0xa0, 0x63, 0x1f, 0x3e, 0x6e, 0x8e, 0xce, 0xb9, 0x49, 0x80, 0x2a, 0x51, 0xf9, 0x92, 0x9d,
-	0xa6, 0x52, 0x55, 0x69, 0x3b, 0xde, 0x9d, 0xd8, 0x53, 0xd6, 0x33, 0x66, 0x67, 0x6c, 0x05, 0x2a,
-	0x44, 0xd5, 0xaa, 0xad, 0xd4, 0x9b, 0x56, 0xed, 0x45, 0x7f, 0x41, 0x2f, 0x2b, 0xee, 0x7a, 0xd5,
-	0x1f, 0xc0, 0x25, 0x52, 0x2f, 0xda, 0x2b, 0x8a, 0x42, 0xaf, 0xfa, 0x13, 0x7a, 0x55, 0xcd, 0xc7,
-	0xda, 0x6b, 0x7b, 0x21, 0x29, 0x06, 0xee, 0x76, 0x67, 0xdf, 0x79, 0x9e, 0xe7, 0xfd, 0x98, 0x77,
-	0x67, 0x06, 0x0a, 0x55, 0xcc, 0x82, 0x52, 0x15, 0x0b, 0x52, 0xe2, 0x11, 0xf6, 0x43, 0x52, 0x6a,
-	0x9f, 0x2f, 0x35, 0x23, 0xce, 0xb7, 0x8b, 0xcd, 0x88, 0x4b, 0x8e, 0x66, 0x95, 0x41, 0x51, 0x19,
-	0x14, 0x8d, 0x41, 0xb1, 0x7d, 0x7e, 0xfe, 0x78, 0x8d, 0xf3, 0x5a, 0x48, 0x4a, 0xb8, 0x49, 0x4b,
-	0x98, 0x31, 0x2e, 0xb1, 0xa4, 0x9c, 0x09, 0x33, 0x65, 0xfe, 0x70, 0x8d, 0xd7, 0xb8, 0x7e, 0x2c,
-	0xa9, 0x27, 0x3b, 0xfa, 0x4f, 0xcd, 0xd4, 0x25, 0xb1, 0x68, 0xfa, 0xa3, 0x7b, 0x15, 0x26, 0x6e,
-	0x29, 0xd2, 0x32, 0xb9, 0xd3, 0x22, 0x42, 0xa2, 0x13, 0x00, 0x91, 0x79, 0xf4, 0x68, 0x90, 0x77,
-	0x16, 0x9d, 0x95, 0x6c, 0xf9, 0x1f, 0x76, 0x64, 0x23, 0x40, 0x73, 0x30, 0x5e, 0x27, 0xb4, 0x56,
-	0x97, 0xf9, 0xd1, 0x45, 0x67, 0x25, 0x53, 0xb6, 0x6f, 0x6e, 0x03, 0x26, 0x2d, 0x8c, 0x68, 0x72,
-	0x26, 0x48, 0xc2, 0xd0, 0x49, 0x1a, 0xa2, 0x2b, 0x30, 0x1e, 0x11, 0xd1, 0x0a, 0x0d, 0x40, 0xee,
-	0xc2, 0xe9, 0x62, 0x8a, 0x9b, 0xc5, 0x0a, 0x65, 0xb5, 0x90, 0xc4, 0x88, 0xad, 0x50, 0xae, 0x65,
-	0x1f, 0x3d, 0x29, 0x8c, 0x94, 0xed, 0x5c, 0xf7, 0x12, 0xcc, 0x5c, 0x6f, 0x85, 0x92, 0xf6, 0x48,
-	0x2f, 0x40, 0xae, 0x2b, 0x5d, 0xe4, 0x9d, 0xc5, 0xcc, 0x4a, 0xb6, 0x0c, 0x1d, 0xed, 0xc2, 0xbd,
-	0x03, 0x28, 0x39, 0x6b, 0x0f, 0xa5, 0x97, 0xfb, 0x94, 0x9e, 0x4a, 0x55, 0xda, 0x03, 0x38, 0x28,
-	0x74, 0x1e, 0xf2, 0x56, 0xde, 0x65, 0xde, 0x62, 0x32, 0xa9, 0xd7, 0xdd, 0x81, 0x63, 0x29, 0xdf,
-	0x5e, 0x89, 0xaa, 0x1e, 0xc0, 0x41, 0x55, 0x0f, 0x1d, 0x98, 0x19, 0x08, 0x31, 0xfa, 0x3f, 0x8c,
-	0xe9, 0xfa, 0xd3, 0x8c, 0xb9, 0x0b, 0x8b, 0x7b, 0x65, 0xc6, 0x82, 0x9a, 0x49, 0xe8, 0x7d, 0x98,
-	0x22, 0xed, 0x86, 0xa7, 0x5f, 0xbc, 0xea, 0x5d, 0x49, 0x84, 0x56, 0x38, 0xb1, 0x76, 0xe9, 0xcf,
-	0x27, 0x85, 0xff, 0xd4, 0xa8, 0xac, 0xb7, 0xaa, 0x45, 0x9f, 0x37, 0x4a, 0x3e, 0x6f, 0x10, 0x59,
-	0xdd, 0x96, 0xdd, 0x87, 0x90, 0x56, 0x45, 0x49, 0x4f, 0x29, 0xae, 0x93, 0x9d, 0x35, 0xf5, 0x50,
-	0x9e, 0x24, 0xed, 0x86, 0x61, 0x51, 0xaf, 0xee, 0x0f, 0x0e, 0x4c, 0xf7, 0x87, 0x1a, 0xfd, 0xaf,
-	0x57, 0x70, 0x61, 0x8f, 0x04, 0xbd, 0x69, 0xbd, 0xfd, 0x49, 0xd8, 0x9f, 0xde, 0xee, 0xac, 0x37,
-	0xa9, 0xf7, 0xa9, 0x03, 0xb9, 0x44, 0x6a, 0xd1, 0x12, 0x4c, 0x54, 0x43, 0xee, 0xdf, 0xf6, 0x12,
-	0x45, 0x98, 0x2d, 0xe7, 0xf4, 0xd8, 0xba, 0xa9, 0xc4, 0x2d, 0x98, 0x31, 0xaa, 0xbd, 0x00, 0x4b,
-	0x6c, 0x84, 0xd9, 0xa2, 0x3c, 0x99, 0xea, 0xd9, 0x4d, 0xfd, 0x74, 0x05, 0x4b, 0x9c, 0x74, 0x6f,
-	0x8a, 0xf7, 0x0e, 0x2b, 0x5c, 0x43, 0x1d, 0x91, 0x10, 0xdf, 0xb5, 0xb8, 0x99, 0x17, 0xe0, 0xae,
-	0x29, 0xeb, 0xb2, 0x32, 0xee, 0xc1, 0xad, 0xf6, 0x0e, 0xbb, 0x7f, 0x38, 0x00, 0xdd, 0x62, 0xd8,
-	0x8f, 0x87, 0x18, 0x8e, 0x26, 0x3d, 0x6c, 0xa8, 0xc9, 0x1d, 0x3f, 0x33, 0x7f, 0xd3, 0xcf, 0xc3,
-	0x5d, 0x3f, 0x13, 0x2a, 0x5e, 0x97, 0xb3, 0xbf, 0x38, 0x00, 0xdd, 0x4a, 0xda, 0x8f, 0xb3, 0x37,
-	0x20, 0xe7, 0xab, 0x09, 0x3d, 0x89, 0x5c, 0x4e, 0xd5, 0x60, 0xbb, 0x96, 0x18, 0x28, 0x55, 0xf0,
-	0xbb, 0x94, 0xaf, 0xcb, 0xb3, 0x87, 0x0e, 0x4c, 0xf5, 0x45, 0x18, 0x5d, 0xea, 0x34, 0x45, 0xb3,
-	0xb2, 0xe6, 0x0c, 0x41, 0x52, 0xf1, 0x60, 0x17, 0x44, 0x79, 0x38, 0xd0, 0x26, 0x91, 0xa0, 0x9c,
-	0x69, 0x6f, 0xb3, 0xe5, 0xf8, 0x15, 0x5d, 0x83, 0x89, 0x06, 0x89, 0x6e, 0x87, 0xc4, 0x6b, 0x62,
-	0x59, 0x17, 0xf9, 0x8c, 0xce, 0xf6, 0xbf, 0x52, 0x65, 0x6f, 0xac, 0x6e, 0x5d, 0xbb, 0xae, 0x8d,
-	0x6f, 0x61, 0x59, 0xb7, 0x14, 0xb9, 0x46, 0x67, 0x44, 0xb8, 0x9f, 0x8d, 0xc2, 0xa1, 0x5e, 0x2b,
-	0xb4, 0x0c, 0xd3, 0x54, 0x98, 0xaa, 0xe2, 0xcc, 0x8b, 0x3a, 0x39, 0x39, 0x58, 0x9e, 0xa4, 0x42,
-	0xf9, 0x75, 0x93, 0x95, 0x75, 0x56, 0x4e, 0xc1, 0x21, 0xd1, 0xaa, 0xca, 0x88, 0x10, 0x2f, 0xf1,
-	0xdf, 0x9d, 0x2c, 0x4f, 0xda, 0x51, 0x9b, 0xbc, 0x25, 0x98, 0x88, 0xcd, 0x04, 0xbd, 0x47, 0x74,
-	0x9c, 0xb3, 0xe5, 0x9c, 0x1d, 0xab, 0xd0, 0x7b, 0x04, 0x2d, 0xc3, 0x54, 0x6c, 0x12, 0x7b, 0x9d,
-	0xd5, 0x56, 0x31, 0xc1, 0x96, 0x75, 0xfe, 0x5d, 0x98, 0x10, 0xb4, 0x1a, 0x52, 0x56, 0xf3, 0xea,
-	0x58, 0xd4, 0xf3, 0x63, 0x43, 0x74, 0x99, 0x9c, 0x45, 0x5a, 0xc7, 0xa2, 0xee, 0x7e, 0x99, 0x81,
-	0xa9, 0xbe, 0x24, 0xab, 0x2a, 0x31, 0xcb, 0x4a, 0x48, 0x1e, 0x11, 0x2f, 0xd9, 0x1e, 0x4f, 0x3e,
-	0xbf, 0x9d, 0x57, 0x94, 0x71, 0x4f, 0x95, 0x34, 0x7a, 0x87, 0x51, 0x08, 0xc7, 0xe2, 0x82, 0xc7,
-	0x01, 0x89, 0xbc, 0x4e, 0x3a, 0x23, 0x29, 0x6c, 0x6d, 0x9f, 0x7b, 0x7e, 0x15, 0xae, 0xeb, 0x49,
-	0x71, 0xbe, 0x22, 0x29, 0x2c, 0xcd, 0x5c, 0x35, 0xf5, 0x2b, 0xa2, 0x90, 0xf7, 0x79, 0xa3, 0xc1,
-	0x99, 0x47, 0x98, 0xcf, 0x03, 0x12, 0x78, 0x6d, 0x2e, 0x0d, 0x9b, 0x2d, 0xf9, 0xb3, 0xcf, 0xe9,
-	0xf5, 0x6a, 0xd2, 0x55, 0x33, 0x67, 0x8b, 0x4b, 0x0d, 0x67, 0xb9, 0x8e, 0xf8, 0x69, 0x1f, 0xd1,
-	0x5b, 0x00, 0x82, 0xd6, 0x18, 0x96, 0xad, 0x88, 0x88, 0x7c, 0x56, 0x17, 0x66, 0xfa, 0x9f, 0x7a,
-	0xf3, 0x7a, 0x25, 0x36, 0x8c, 0x97, 0x67, 0x77, 0xa6, 0xfb, 0xdd, 0x38, 0x4c, 0xf5, 0xc5, 0x12,
-	0x7d, 0xec, 0xc0, 0x9c, 0x6d, 0x78, 0x14, 0xb7, 0x43, 0x4f, 0x48, 0x2c, 0x89, 0x29, 0x02, 0x47,
-	0x17, 0xc1, 0xdb, 0xbb, 0x4f, 0x0a, 0xb3, 0x66, 0xf1, 0xa9, 0x82, 0xae, 0xa8, 0xef, 0x2a, 0xb5,
-	0x2f, 0x55, 0x1b, 0xb3, 0x86, 0x6a, 0x03, 0xb7, 0xc3, 0x0e, 0x10, 0xa2, 0x30, 0xd7, 0xa0, 0x4c,
-	0xda, 0x72, 0xb0, 0x59, 0xd3, 0x0a, 0x86, 0xf9, 0xd9, 0xcd, 0x2a, 0x4c, 0xed, 0xa8, 0xc9, 0x9a,
-	0xa6, 0xfa, 0xd4, 0x81, 0x93, 0x4d, 0x1c, 0xe1, 0x86, 0xf0, 0x24, 0xf7, 0x22, 0x22, 0x24, 0xbe,
-	0x4d, 0x0c, 0xb1, 0xe8, 0x61, 0xce, 0x0c, 0xc1, 0x5c, 0x30, 0x0c, 0x9b, 0xbc, 0x6c, 0xf0, 0xb5,
-	0x08, 0x91, 0x50, 0xf1, 0x95, 0x03, 0x67, 0x23, 0x1e, 0xaa, 0x45, 0x22, 0x08, 0x09, 0x94, 0x14,
-	0x19, 0x61, 0x26, 0xb6, 0x49, 0x94, 0xa6, 0x25, 0x3b, 0x84, 0x96, 0x53, 0x09, 0x9e, 0x4d, 0xbe,
-	0x69, 0x59, 0x06, 0x14, 0xdd, 0x87, 0x25, 0x29, 0x74, 0x4c, 0x5a, 0xcd, 0x5a, 0x84, 0x83, 0xd4,
-	0x98, 0x0c, 0xd3, 0x14, 0x8e, 0x4b, 0x21, 0x36, 0xf9, 0x3b, 0x06, 0x7c, 0x80, 0xfe, 0x01, 0xb8,
-	0xb8, 0x25, 0xeb, 0x8a, 0x9f, 0xfa, 0xb8, 0xce, 0x85, 0x4c, 0xe3, 0x1f, 0x1f, 0x82, 0xff, 0x84,
-	0xc2, 0xdf, 0xe4, 0x1b, 0x06, 0xbd, 0x5f, 0x80, 0xfb, 0xe3, 0x18, 0xcc, 0xa5, 0x77, 0x01, 0xc4,
-	0xe0, 0x98, 0xed, 0x9d, 0x1e, 0x66, 0x81, 0xe7, 0xd7, 0x31, 0x65, 0x1e, 0x0d, 0x92, 0x4b, 0xe4,
-	0xe5, 0x24, 0x1d, 0xb1, 0xb0, 0xab, 0x2c, 0xb8, 0xac, 0x40, 0x37, 0x02, 0x1d, 0x8b, 0xde, 0xd3,
-	0x56, 0xb6, 0x73, 0x08, 0x28, 0x40, 0x4e, 0xd2, 0x06, 0xf1, 0x04, 0xf1, 0x39, 0x0b, 0x6c, 0xb7,
-	0x07, 0x35, 0x54, 0xd1, 0x23, 0x68, 0x05, 0xa6, 0xb5, 0x01, 0xc3, 0x8c, 0xc7, 0x56, 0x59, 0xfd,
-	0xe3, 0x38, 0xa4, 0xc6, 0x6f, 0x60, 0xc6, 0xad, 0xe5, 0x87, 0x70, 0x34, 0xc4, 0x42, 0x7a, 0xa6,
-	0x5b, 0xd2, 0x40, 0x3b, 0xc6, 0x65, 0x9d, 0x44, 0x43, 0xe5, 0x78, 0x56, 0x81, 0xea, 0x28, 0x6e,
-	0x04, 0xab, 0x2c, 0xb8, 0xa9, 0x00, 0xd1, 0x17, 0x0e, 0x9c, 0x66, 0x64, 0x47, 0x7a, 0x6d, 0x1c,
-	0xd2, 0x00, 0x4b, 0x1e, 0xe9, 0xc8, 0x99, 0x58, 0xaa, 0x53, 0x0f, 0x13, 0x2d, 0x31, 0x7c, 0x7e,
-	0x97, 0x14, 0xc7, 0x56, 0x4c, 0xa1, 0x02, 0xa9, 0xc2, 0x1a, 0xe3, 0xeb, 0xc0, 0x7e, 0x00, 0x33,
-	0xda, 0x6b, 0xb3, 0x13, 0xb0, 0x9c, 0x07, 0x86, 0xe0, 0x9c, 0x52, 0x70, 0x66, 0x97, 0x61, 0x18,
-	0xee, 0xc0, 0x3c, 0x69, 0xd3, 0x80, 0x30, 0x9f, 0x68, 0xff, 0x9a, 0x11, 0x6f, 0x72, 0x41, 0x8c,
-	0xc7, 0xf9, 0x83, 0x43, 0x50, 0x1d, 0x8d, 0x71, 0x57, 0x59, 0x70, 0xcb, 0xa2, 0xea, 0xc2, 0xfd,
-	0xcd, 0x81, 0x23, 0xa9, 0x7f, 0x14, 0x54, 0x05, 0xa4, 0x5a, 0x3f, 0x09, 0xe2, 0xad, 0x3a, 0xd9,
-	0xa6, 0x3b, 0x43, 0x15, 0xec, 0xb4, 0xc1, 0x33, 0x5b, 0x30, 0x85, 0xd6, 0xcf, 0x21, 0x5a, 0xdb,
-	0x8a, 0x63, 0xf4, 0xd5, 0x70, 0x54, 0x34, 0x9a, 0xfb, 0xf9, 0x28, 0xe4, 0x12, 0xbf, 0x35, 0xb4,
-	0x06, 0x4e, 0x34, 0x94, 0x1b, 0x4e, 0xa4, 0x30, 0x86, 0x3b, 0x49, 0x39, 0x02, 0x4d, 0x80, 0xd3,
-	0xd6, 0xab, 0x70, 0xb2, 0xec, 0xb4, 0x11, 0x86, 0x99, 0x78, 0x1b, 0xa0, 0x16, 0x9b, 0x90, 0xb8,
-	0xd1, 0x1c, 0xaa, 0x71, 0x4f, 0x5b, 0xb8, 0xcd, 0x18, 0xcd, 0xfd, 0xc6, 0x01, 0x34, 0xb8, 0x0b,
-	0x47, 0x87, 0x61, 0x4c, 0xef, 0xc0, 0xed, 0xfe, 0xde, 0xbc, 0xbc, 0xa9, 0x7d, 0xee, 0x85, 0x9f,
-	0x32, 0x70, 0xa0, 0x42, 0xa2, 0x36, 0xf5, 0x09, 0x7a, 0x00, 0x63, 0xf6, 0xe4, 0x91, 0x0a, 0x96,
-	0xbc, 0x07, 0x99, 0x77, 0x5f, 0x64, 0x62, 0xae, 0x43, 0xdc, 0xd2, 0x27, 0x3f, 0xff, 0xfe, 0xed,
-	0xe8, 0x19, 0xb4, 0x5c, 0x52, 0xb6, 0xba, 0xf1, 0x76, 0xaf, 0xb2, 0xcc, 0xb5, 0x59, 0xe9, 0xa3,
-	0xee, 0xed, 0xcf, 0x7d, 0xd5, 0x6b, 0x92, 0xa7, 0xbd, 0xd3, 0x7b, 0x5e, 0xde, 0x18, 0x2d, 0xcb,
-	0x7b, 0x5f, 0xf2, 0x18, 0x41, 0x2b, 0x5a, 0x90, 0x8b, 0x16, 0x53, 0x05, 0x25, 0x8e, 0x8a, 0xe8,
-	0x7b, 0x07, 0x66, 0x06, 0xee, 0x79, 0xd0, 0xbf, 0x5f, 0x74, 0xb2, 0x1a, 0xb8, 0x2b, 0x9a, 0x2f,
-	0xee, 0xd7, 0xdc, 0xca, 0x3b, 0xaf, 0xe5, 0x9d, 0x43, 0x67, 0x52, 0xe5, 0xd9, 0x40, 0x09, 0x2f,
-	0x71, 0xe2, 0x5b, 0xab, 0x3c, 0xda, 0x5d, 0x70, 0x1e, 0xef, 0x2e, 0x38, 0x4f, 0x77, 0x17, 0x9c,
-	0xaf, 0x9f, 0x2d, 0x8c, 0x3c, 0x7e, 0xb6, 0x30, 0xf2, 0xeb, 0xb3, 0x85, 0x91, 0xf7, 0xfe, 0x9b,
-	0xa8, 0x58, 0x05, 0xa7, 0x6f, 0x0e, 0x7d, 0x1e, 0x96, 0x2c, 0xee, 0xc5, 0x92, 0x1f, 0x52, 0xc2,
-	0x64, 0xa9, 0x16, 0x35, 0xfd, 0x9e, 0x9c, 0x54, 0xc7, 0xb5, 0xed, 0xc5, 0xbf, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x00, 0xff, 0x2e, 0xa3, 0xee, 0x14, 0x00, 0x00,
+	// 1869 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x8a, 0x94, 0x1c, 0x3f, 0x4a, 0x96, 0x34, 0xb2, 0x65, 0x5a, 0x4d, 0x44, 0x79, 0x1b,
+	0x5b, 0x72, 0x82, 0x92, 0x95, 0xe3, 0x1e, 0x8a, 0xf6, 0x62, 0xd9, 0x31, 0xa4, 0x26, 0x8e, 0x8d,
+	0xa5, 0xaa, 0x02, 0x45, 0x81, 0xed, 0xec, 0xee, 0x90, 0x9c, 0x7a, 0xb9, 0x43, 0xef, 0x0c, 0x09,
+	0x27, 0x85, 0x91, 0xa2, 0x45, 0x1a, 0x34, 0x97, 0x16, 0xed, 0xa1, 0x9f, 0xa0, 0x87, 0xa2, 0x28,
+	0x7a, 0xeb, 0xa1, 0x9f, 0x20, 0xbd, 0x05, 0xe8, 0xa1, 0x3d, 0xa9, 0x81, 0xdc, 0x53, 0x3f, 0x42,
+	0x4f, 0xc5, 0xfc, 0x59, 0x72, 0x97, 0x5c, 0x89, 0x4a, 0x56, 0xd1, 0x8d, 0x3b, 0xfb, 0xe6, 0xf7,
+	0xfb, 0xbd, 0x99, 0xf7, 0x66, 0x1f, 0xdf, 0x40, 0xcd, 0xc3, 0x51, 0xd0, 0xf0, 0x30, 0x27, 0x0d,
+	0x16, 0x63, 0x3f, 0x24, 0x8d, 0xc1, 0x76, 0xa3, 0x17, 0x33, 0xd6, 0xaa, 0xf7, 0x62, 0x26, 0x18,
+	0x5a, 0x91, 0x06, 0x75, 0x69, 0x50, 0xd7, 0x06, 0xf5, 0xc1, 0xf6, 0xda, 0xab, 0x6d, 0xc6, 0xda,
+	0x21, 0x69, 0xe0, 0x1e, 0x6d, 0xe0, 0x28, 0x62, 0x02, 0x0b, 0xca, 0x22, 0xae, 0xa7, 0xac, 0x5d,
+	0x69, 0xb3, 0x36, 0x53, 0x3f, 0x1b, 0xf2, 0x97, 0x19, 0xfd, 0x9a, 0x62, 0x1a, 0x91, 0x18, 0x34,
+	0xfd, 0x72, 0x5d, 0xbd, 0x6c, 0x11, 0x12, 0xf0, 0xc6, 0x60, 0xdb, 0x23, 0x02, 0x6f, 0xeb, 0x27,
+	0xfd, 0xde, 0x7e, 0x1b, 0xe6, 0x9f, 0x48, 0x51, 0x0e, 0x79, 0xd6, 0x27, 0x5c, 0xa0, 0xd7, 0x00,
+	0x62, 0xfd, 0xd3, 0xa5, 0x41, 0xd5, 0xda, 0xb0, 0xb6, 0xca, 0xce, 0x25, 0x33, 0xb2, 0x17, 0xa0,
+	0x55, 0x98, 0xeb, 0x10, 0xda, 0xee, 0x88, 0xea, 0xcc, 0x86, 0xb5, 0x55, 0x72, 0xcc, 0x93, 0xdd,
+	0x85, 0x05, 0x03, 0xc3, 0x7b, 0x2c, 0xe2, 0x24, 0x65, 0x68, 0xa5, 0x0d, 0xd1, 0x03, 0x98, 0x8b,
+	0x09, 0xef, 0x87, 0x1a, 0xa0, 0x72, 0xe7, 0x56, 0x3d, 0x67, 0x19, 0xea, 0x4d, 0x1a, 0xb5, 0x43,
+	0x92, 0x20, 0xf6, 0x43, 0xb1, 0x53, 0xfe, 0xf4, 0xb0, 0x76, 0xc1, 0x31, 0x73, 0xed, 0xbb, 0xb0,
+	0xfc, 0xa8, 0x1f, 0x0a, 0x9a, 0x91, 0x5e, 0x83, 0xca, 0x48, 0x3a, 0xaf, 0x5a, 0x1b, 0xa5, 0xad,
+	0xb2, 0x03, 0x43, 0xed, 0xdc, 0x7e, 0x06, 0x28, 0x3d, 0x6b, 0x8a, 0xd2, 0xfb, 0x63, 0x4a, 0x6f,
+	0xe6, 0x2a, 0xcd, 0x00, 0x4e, 0x0a, 0x5d, 0x83, 0xaa, 0x91, 0x77, 0x9f, 0xf5, 0x23, 0x91, 0xd6,
+	0x6b, 0x3f, 0x87, 0xeb, 0x39, 0xef, 0xce, 0x44, 0x55, 0x06, 0x70, 0x52, 0xd5, 0x3b, 0x80, 0x9e,
+	0xc4, 0xd4, 0x27, 0x7c, 0x7c, 0xeb, 0x39, 0x6d, 0x47, 0x38, 0x1c, 0x2e, 0xdf, 0x25, 0xe7, 0x92,
+	0x1e, 0xd9, 0x0b, 0xf8, 0xb1, 0x5b, 0xcf, 0x61, 0x25, 0x03, 0x76, 0x26, 0x01, 0x90, 0x45, 0x9c,
+	0xf4, 0xe0, 0x2f, 0x16, 0x2c, 0x4f, 0x04, 0x09, 0xfa, 0x2e, 0xcc, 0xaa, 0x0c, 0x53, 0x94, 0x95,
+	0x3b, 0x1b, 0xd3, 0x62, 0xcb, 0x80, 0xea, 0x49, 0xe8, 0x47, 0xb0, 0x48, 0x06, 0x5d, 0x57, 0x3d,
+	0xb8, 0xde, 0xfb, 0x82, 0x70, 0x25, 0x71, 0x7e, 0xe7, 0xee, 0xff, 0x0e, 0x6b, 0xdf, 0x6c, 0x53,
+	0xd1, 0xe9, 0x7b, 0x75, 0x9f, 0x75, 0x1b, 0x3e, 0xeb, 0x12, 0xe1, 0xb5, 0xc4, 0xe8, 0x47, 0x48,
+	0x3d, 0xde, 0x50, 0x53, 0xea, 0xbb, 0xe4, 0xf9, 0x8e, 0xfc, 0xe1, 0x2c, 0x90, 0x41, 0x57, 0xb3,
+	0xc8, 0x47, 0xfb, 0xcf, 0x16, 0x2c, 0x8d, 0x07, 0x0b, 0xfa, 0x4e, 0x56, 0x70, 0x6d, 0x4a, 0x88,
+	0x9d, 0xb7, 0xde, 0xf1, 0x30, 0x3a, 0x9d, 0xde, 0xd1, 0xac, 0xf3, 0xd4, 0x2b, 0x23, 0x62, 0x22,
+	0x6a, 0x4e, 0x17, 0x11, 0xa9, 0x69, 0xe7, 0xa9, 0xf8, 0x73, 0x0b, 0x2a, 0xa9, 0x60, 0x44, 0x37,
+	0x60, 0xde, 0x0b, 0x99, 0xff, 0xd4, 0x4d, 0xe5, 0x4d, 0xd9, 0xa9, 0xa8, 0xb1, 0x5d, 0x9d, 0x3c,
+	0x07, 0xb0, 0xac, 0x65, 0xbb, 0x01, 0x16, 0x58, 0x0b, 0x33, 0x79, 0xf4, 0x7a, 0xae, 0x6b, 0x8f,
+	0xd5, 0xaf, 0x07, 0x58, 0xe0, 0xb4, 0x7b, 0x8b, 0x2c, 0x3b, 0x2c, 0x71, 0x35, 0x75, 0x4c, 0x42,
+	0xfc, 0xbe, 0xc1, 0x2d, 0x9d, 0x80, 0xbb, 0x23, 0xad, 0x1d, 0x69, 0x9c, 0xc1, 0xf5, 0xb2, 0xc3,
+	0xf6, 0x7f, 0x2d, 0x80, 0x51, 0xf8, 0x9e, 0xc6, 0x43, 0x0c, 0xd7, 0xd2, 0x1e, 0x76, 0xe5, 0xe4,
+	0xa1, 0x9f, 0xa5, 0x2f, 0xe8, 0xe7, 0x95, 0x91, 0x9f, 0x29, 0x15, 0x5f, 0x95, 0xb3, 0xff, 0xb4,
+	0x00, 0x46, 0xb1, 0x7f, 0x1a, 0x67, 0xdf, 0x83, 0x8a, 0x2f, 0x27, 0x64, 0x36, 0x72, 0x33, 0x57,
+	0x83, 0x39, 0xa4, 0xf9, 0x44, 0x72, 0x81, 0x3f, 0xa2, 0xfc, 0xaa, 0x3c, 0xfb, 0xdb, 0x0c, 0x54,
+	0x52, 0x49, 0x72, 0x1a, 0xd7, 0x5a, 0x70, 0x4d, 0x95, 0x19, 0x66, 0x07, 0xb9, 0x60, 0x31, 0xc9,
+	0xb8, 0x79, 0x3b, 0x57, 0xd0, 0x43, 0x39, 0x47, 0xed, 0x55, 0x53, 0xce, 0xc8, 0x6c, 0x66, 0x2b,
+	0xe7, 0x1d, 0xda, 0x85, 0xf9, 0x9e, 0x54, 0xa6, 0xb1, 0x79, 0xb5, 0xb4, 0x51, 0x3a, 0xf6, 0x60,
+	0x52, 0x2e, 0xa4, 0x21, 0x2b, 0xbd, 0xe1, 0x08, 0xcf, 0x5f, 0xbc, 0x72, 0xf1, 0xc5, 0xfb, 0x93,
+	0x05, 0x30, 0x62, 0x46, 0xdf, 0x92, 0x27, 0x12, 0xf5, 0x89, 0x39, 0x91, 0xae, 0x6b, 0x68, 0x5d,
+	0x92, 0x99, 0x02, 0x4d, 0x0b, 0x1d, 0x1d, 0x45, 0xd4, 0x27, 0xa8, 0x0a, 0x17, 0x07, 0x24, 0xe6,
+	0x94, 0x45, 0x6a, 0xfd, 0xca, 0x4e, 0xf2, 0x88, 0xde, 0x85, 0xf9, 0x2e, 0x89, 0x9f, 0x86, 0xc4,
+	0xed, 0x61, 0xd1, 0x49, 0x56, 0xe0, 0xeb, 0xb9, 0x92, 0xf7, 0xee, 0x1d, 0xbc, 0xfb, 0x48, 0x19,
+	0x3f, 0xc1, 0xa2, 0x93, 0xac, 0x42, 0x77, 0x38, 0xa2, 0x8e, 0xd1, 0xc5, 0xb1, 0x64, 0x42, 0x77,
+	0x87, 0x9f, 0x6c, 0xad, 0x79, 0x55, 0x63, 0xa7, 0x83, 0x73, 0xf2, 0x13, 0x7d, 0x6e, 0x8a, 0x3f,
+	0x9a, 0x81, 0xcb, 0x59, 0x2b, 0xb4, 0x09, 0x4b, 0x94, 0xeb, 0x03, 0x84, 0x45, 0x6e, 0x3c, 0x8c,
+	0xd1, 0x57, 0x9c, 0x05, 0xca, 0xa5, 0x5f, 0x8f, 0x23, 0x47, 0x45, 0xe9, 0x4d, 0xb8, 0xcc, 0xfb,
+	0x9e, 0x88, 0x09, 0x71, 0x53, 0xb5, 0xcd, 0x82, 0xb3, 0x60, 0x46, 0x4d, 0x30, 0xdf, 0x80, 0xf9,
+	0xc4, 0x8c, 0xd3, 0x0f, 0x88, 0x4a, 0xa9, 0xb2, 0x53, 0x31, 0x63, 0x4d, 0xfa, 0x01, 0x41, 0x9b,
+	0xb0, 0x98, 0x98, 0x24, 0x5e, 0x97, 0x95, 0x55, 0x42, 0x70, 0x60, 0x9c, 0xff, 0x01, 0xcc, 0x73,
+	0xea, 0x85, 0x34, 0x6a, 0xbb, 0x1d, 0xcc, 0x3b, 0xd5, 0xd9, 0x02, 0x1f, 0x94, 0x8a, 0x41, 0xda,
+	0xc5, 0xbc, 0x63, 0x7f, 0x52, 0x82, 0xc5, 0xb1, 0x90, 0x94, 0x31, 0x3d, 0x99, 0x7f, 0xd6, 0x09,
+	0x31, 0x9d, 0x9f, 0x7a, 0x8b, 0xdd, 0xb1, 0xac, 0x0b, 0xe1, 0x7a, 0x72, 0x00, 0xe0, 0x80, 0xc4,
+	0xee, 0x70, 0x3b, 0x63, 0xc1, 0x4d, 0x7e, 0xbf, 0x79, 0x7c, 0xce, 0xec, 0xaa, 0x49, 0xc9, 0x7e,
+	0xc5, 0x82, 0x1b, 0x9a, 0x55, 0x2f, 0xf7, 0x2d, 0xa2, 0x50, 0xf5, 0x59, 0xb7, 0xcb, 0x22, 0x97,
+	0x44, 0x3e, 0x0b, 0x48, 0xe0, 0x0e, 0x98, 0xd0, 0x6c, 0xe6, 0x74, 0x7b, 0xe3, 0x98, 0x42, 0x44,
+	0x4e, 0x7a, 0x5b, 0xcf, 0x39, 0x60, 0x42, 0xc1, 0x19, 0xae, 0xab, 0x7e, 0xde, 0x4b, 0xf4, 0xd0,
+	0xd4, 0xc0, 0xa2, 0x1f, 0x13, 0x5e, 0x2d, 0x6f, 0x94, 0x8e, 0x2d, 0x1a, 0xf6, 0x1f, 0x35, 0x13,
+	0xc3, 0xe4, 0x24, 0x1e, 0xcd, 0xb4, 0x7f, 0x3f, 0x07, 0x8b, 0xe3, 0x47, 0xd5, 0xcf, 0x2c, 0x58,
+	0x35, 0xdf, 0x36, 0x8a, 0x07, 0xa1, 0xcb, 0x05, 0x16, 0x44, 0x07, 0x81, 0xa5, 0x82, 0xe0, 0x9d,
+	0xa3, 0xc3, 0xda, 0x8a, 0x4e, 0x3e, 0x19, 0xd0, 0x4d, 0xf9, 0x5e, 0x6e, 0xed, 0x97, 0x8a, 0x8d,
+	0x15, 0x4d, 0xb5, 0x87, 0x07, 0xe1, 0x10, 0x08, 0x51, 0x58, 0xed, 0xd2, 0x48, 0x98, 0x70, 0x30,
+	0xbb, 0xa6, 0x14, 0x14, 0xa9, 0x6b, 0x56, 0x24, 0xa6, 0x72, 0x54, 0xef, 0x9a, 0xa2, 0xfa, 0x85,
+	0x05, 0xaf, 0xf7, 0x70, 0x8c, 0xbb, 0xdc, 0x15, 0xcc, 0x8d, 0x09, 0x17, 0xf8, 0x29, 0xd1, 0xc4,
+	0x3c, 0xc3, 0x5c, 0x2a, 0xc0, 0x5c, 0xd3, 0x0c, 0xfb, 0xcc, 0xd1, 0xf8, 0x4a, 0x04, 0x4f, 0xa9,
+	0xf8, 0xb5, 0x05, 0x6f, 0xc4, 0x2c, 0x94, 0x49, 0xc2, 0x09, 0x09, 0xa4, 0x14, 0x11, 0xe3, 0x88,
+	0xb7, 0x48, 0x9c, 0xa7, 0xa5, 0x5c, 0x40, 0xcb, 0xcd, 0x14, 0xcf, 0x3e, 0xdb, 0x37, 0x2c, 0x13,
+	0x8a, 0x5e, 0xc0, 0x0d, 0xc1, 0xd5, 0x9a, 0xf4, 0x7b, 0xed, 0x18, 0x07, 0xb9, 0x6b, 0x52, 0xe4,
+	0x50, 0x78, 0x55, 0x70, 0xbe, 0xcf, 0xbe, 0xaf, 0xc1, 0x27, 0xe8, 0x3f, 0x04, 0x1b, 0xf7, 0x45,
+	0x47, 0xf2, 0x53, 0x1f, 0x77, 0x18, 0x17, 0x79, 0xfc, 0x73, 0x05, 0xf8, 0x5f, 0x93, 0xf8, 0xfb,
+	0x6c, 0x4f, 0xa3, 0x8f, 0x0b, 0xb0, 0xff, 0x38, 0x07, 0x57, 0xf2, 0xbe, 0xf2, 0xe8, 0x05, 0x5c,
+	0xd5, 0x15, 0x43, 0x7e, 0x72, 0x7c, 0xef, 0xe8, 0xb0, 0x86, 0xd4, 0xc4, 0xe2, 0xb9, 0x81, 0x14,
+	0x51, 0x36, 0x35, 0x9e, 0xc1, 0x1a, 0x19, 0xd0, 0x80, 0x44, 0x3e, 0x39, 0xe3, 0xf4, 0xb8, 0x96,
+	0xe0, 0x8e, 0xa7, 0xc8, 0x47, 0x16, 0xdc, 0x6c, 0x11, 0xd2, 0x8e, 0x71, 0x24, 0xe4, 0x86, 0xb4,
+	0x08, 0xa1, 0x9e, 0x7f, 0xd6, 0x39, 0xb2, 0x91, 0x50, 0xec, 0xb3, 0x87, 0x8a, 0x60, 0x22, 0x26,
+	0x7e, 0x65, 0xc1, 0x66, 0x3b, 0x64, 0x1e, 0x0e, 0x5b, 0x84, 0x4c, 0x89, 0x8c, 0x22, 0x19, 0x62,
+	0x0f, 0x49, 0x8e, 0x0d, 0x0f, 0xa5, 0x25, 0x09, 0xd0, 0x80, 0x72, 0x11, 0x53, 0xaf, 0x2f, 0x5b,
+	0x60, 0x67, 0x9d, 0x25, 0xb6, 0x8e, 0xd2, 0x07, 0x29, 0x8a, 0xbc, 0x5c, 0x51, 0xa7, 0xe5, 0xc9,
+	0xb9, 0x5a, 0x28, 0x57, 0x24, 0xfe, 0xb1, 0xc9, 0x6a, 0xff, 0x75, 0x16, 0x56, 0xf3, 0xbf, 0x98,
+	0x28, 0x82, 0xeb, 0xa6, 0xce, 0x70, 0x71, 0x14, 0xb8, 0x7e, 0x07, 0xd3, 0xc8, 0xa5, 0x41, 0x3a,
+	0x63, 0xbe, 0x9c, 0xa4, 0xab, 0x06, 0xf6, 0x5e, 0x14, 0xdc, 0x97, 0xa0, 0x7b, 0x81, 0x5a, 0x8b,
+	0x6c, 0xf7, 0xa7, 0x3c, 0x6c, 0xe7, 0xd4, 0xa0, 0x22, 0x68, 0x97, 0xb8, 0x9c, 0xf8, 0x2c, 0x0a,
+	0x4c, 0x65, 0x04, 0x72, 0xa8, 0xa9, 0x46, 0xd0, 0x16, 0x2c, 0x29, 0x83, 0x08, 0x47, 0x2c, 0xb1,
+	0x2a, 0xab, 0x22, 0xeb, 0xb2, 0x1c, 0x7f, 0x0f, 0x47, 0xcc, 0x58, 0xfe, 0x04, 0xae, 0x85, 0x98,
+	0x0b, 0x57, 0x57, 0x16, 0x34, 0x50, 0x8e, 0x31, 0xd1, 0x21, 0x71, 0xa1, 0x9d, 0x5e, 0x91, 0xa0,
+	0x6a, 0x15, 0xf7, 0x82, 0x7b, 0x51, 0xf0, 0x58, 0x02, 0xa2, 0x8f, 0x2d, 0xb8, 0x15, 0x91, 0xe7,
+	0xc2, 0x1d, 0xe0, 0x90, 0x06, 0x58, 0xb0, 0x58, 0xad, 0x9c, 0x5e, 0x4b, 0x16, 0x71, 0x12, 0xf1,
+	0x3e, 0x2f, 0xbe, 0xbf, 0x37, 0x24, 0xc7, 0x41, 0x42, 0x21, 0x17, 0x52, 0x2e, 0x6b, 0x82, 0xaf,
+	0x16, 0xf6, 0xc7, 0xb0, 0xac, 0xbc, 0xd6, 0x55, 0xb3, 0xe1, 0xbc, 0x58, 0x80, 0x73, 0x51, 0xc2,
+	0xe9, 0x8a, 0x9c, 0x4f, 0x9c, 0x6c, 0xd2, 0xbf, 0x5e, 0xcc, 0x7a, 0x8c, 0x13, 0xed, 0x71, 0xf5,
+	0x95, 0xb3, 0x38, 0xd9, 0xee, 0x45, 0xc1, 0x13, 0x83, 0xaa, 0x02, 0xf7, 0xdf, 0x16, 0x5c, 0xcd,
+	0xad, 0xbe, 0x90, 0x07, 0x48, 0x96, 0x49, 0x24, 0x48, 0x3a, 0x18, 0xa4, 0x45, 0x9f, 0x17, 0x0a,
+	0xd8, 0x25, 0x8d, 0xa7, 0xff, 0xae, 0x48, 0xb4, 0x71, 0x0e, 0xde, 0x6f, 0x49, 0x8e, 0x99, 0xb3,
+	0xe1, 0x68, 0x2a, 0x34, 0xfb, 0x97, 0x33, 0x50, 0x49, 0x95, 0x80, 0x68, 0x07, 0xac, 0xb8, 0x90,
+	0x1b, 0x56, 0x2c, 0x31, 0x8a, 0x35, 0x98, 0x2c, 0x8e, 0xe6, 0xc1, 0x1a, 0xa8, 0x2c, 0x5c, 0x70,
+	0xac, 0x01, 0xc2, 0xb0, 0x9c, 0x94, 0xcc, 0x32, 0xd9, 0xb8, 0xc0, 0xdd, 0x5e, 0xa1, 0x23, 0x7c,
+	0xc9, 0xc0, 0xed, 0x27, 0x68, 0xf6, 0x6f, 0x2d, 0x40, 0x93, 0xcd, 0x09, 0x74, 0x05, 0x66, 0x55,
+	0x63, 0xc2, 0xf4, 0x06, 0xf4, 0xc3, 0x79, 0xfd, 0x27, 0xbc, 0xf3, 0xf7, 0x32, 0x5c, 0x6c, 0x92,
+	0x78, 0x20, 0xff, 0x39, 0x7f, 0x08, 0xb3, 0xa6, 0x6b, 0x71, 0x4c, 0x53, 0x60, 0xd4, 0x02, 0x5f,
+	0xb3, 0x4f, 0x32, 0xd1, 0x8d, 0x6d, 0xbb, 0xf1, 0xf3, 0x7f, 0xfc, 0xe7, 0x77, 0x33, 0xb7, 0xd1,
+	0x66, 0x43, 0xda, 0xaa, 0x83, 0x77, 0x74, 0xeb, 0xa2, 0x6f, 0x78, 0x1a, 0x3f, 0x1d, 0x5d, 0x44,
+	0xbc, 0x40, 0x1f, 0x67, 0x9b, 0x60, 0xb7, 0xa6, 0xde, 0x23, 0x68, 0x2d, 0x9b, 0x53, 0xed, 0x8c,
+	0xa0, 0x2d, 0x25, 0xc8, 0x46, 0x1b, 0xb9, 0x82, 0x52, 0x1d, 0x34, 0xf4, 0x07, 0x0b, 0x96, 0x27,
+	0xae, 0x1c, 0xd0, 0x37, 0x4e, 0x6a, 0x38, 0x4d, 0x5c, 0x5b, 0xac, 0xd5, 0x4f, 0x6b, 0x6e, 0xe4,
+	0x6d, 0x2b, 0x79, 0x6f, 0xa2, 0xdb, 0xb9, 0xf2, 0xcc, 0x42, 0x71, 0x37, 0xd5, 0x08, 0x43, 0x9f,
+	0x58, 0xd9, 0x7e, 0xd3, 0xe6, 0xf4, 0x3b, 0x02, 0xad, 0x6d, 0x6b, 0xba, 0xe1, 0xc9, 0x8b, 0xa6,
+	0x2f, 0xca, 0x54, 0xcb, 0x85, 0x6b, 0x31, 0x3b, 0xcd, 0x4f, 0x8f, 0xd6, 0xad, 0xcf, 0x8e, 0xd6,
+	0xad, 0xcf, 0x8f, 0xd6, 0xad, 0xdf, 0xbc, 0x5c, 0xbf, 0xf0, 0xd9, 0xcb, 0xf5, 0x0b, 0xff, 0x7a,
+	0xb9, 0x7e, 0xe1, 0x87, 0xdf, 0x4e, 0xa5, 0x8f, 0x44, 0x51, 0x37, 0x6a, 0x3e, 0x0b, 0x1b, 0x06,
+	0xee, 0xad, 0x86, 0x1f, 0x52, 0x12, 0x89, 0x46, 0x3b, 0xee, 0xf9, 0x99, 0x00, 0xf1, 0xe6, 0x94,
+	0xed, 0x5b, 0xff, 0x1f, 0x00, 0x86, 0xb8, 0x89, 0x7f, 0x26, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1445,6 +1850,8 @@ type ServiceClient interface {
 	MultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(ctx context.Context, in *RequestCountProofRequest, opts ...grpc.CallOption) (*RequestCountProofResponse, error)
+	// PricesProof queries the proof of the feeds prices for given list of signal IDs
+	PricesProof(ctx context.Context, in *PricesProofRequest, opts ...grpc.CallOption) (*PricesProofResponse, error)
 }
 
 type serviceClient struct {
@@ -1482,6 +1889,15 @@ func (c *serviceClient) RequestCountProof(ctx context.Context, in *RequestCountP
 	return out, nil
 }
 
+func (c *serviceClient) PricesProof(ctx context.Context, in *PricesProofRequest, opts ...grpc.CallOption) (*PricesProofResponse, error) {
+	out := new(PricesProofResponse)
+	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/PricesProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Proof queries the proof for given request ID
@@ -1490,6 +1906,8 @@ type ServiceServer interface {
 	MultiProof(context.Context, *MultiProofRequest) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(context.Context, *RequestCountProofRequest) (*RequestCountProofResponse, error)
+	// PricesProof queries the proof of the feeds prices for given list of signal IDs
+	PricesProof(context.Context, *PricesProofRequest) (*PricesProofResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1505,6 +1923,9 @@ func (*UnimplementedServiceServer) MultiProof(ctx context.Context, req *MultiPro
 func (*UnimplementedServiceServer) RequestCountProof(ctx context.Context, req *RequestCountProofRequest) (*RequestCountProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestCountProof not implemented")
 }
+func (*UnimplementedServiceServer) PricesProof(ctx context.Context, req *PricesProofRequest) (*PricesProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PricesProof not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1564,6 +1985,24 @@ func _Service_RequestCountProof_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_PricesProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PricesProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PricesProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.oracle.v1.Service/PricesProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PricesProof(ctx, req.(*PricesProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "band.base.oracle.v1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -1580,6 +2019,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RequestCountProof",
 			Handler:    _Service_RequestCountProof_Handler,
 		},
+		{
+			MethodName: "PricesProof",
+			Handler:    _Service_PricesProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/base/oracle/v1/proof.proto",
@@ -1796,6 +2239,81 @@ func (m *RequestCountProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *PricesProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricesProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricesProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PricesProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricesProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricesProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SingleProofResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1916,6 +2434,46 @@ func (m *CountProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PricesProofResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricesProofResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricesProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SingleProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2064,6 +2622,120 @@ func (m *CountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PricesProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricesProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricesProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PriceProofs) > 0 {
+		for iNdEx := len(m.PriceProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.FeedsMultiStoreProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *OracleDataProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2303,7 +2975,7 @@ func (m *MultiStoreProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeaderMerkleParts) Marshal() (dAtA []byte, err error) {
+func (m *FeedsMultiStoreProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2313,46 +2985,111 @@ func (m *BlockHeaderMerkleParts) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeaderMerkleParts) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeedsMultiStoreProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeaderMerkleParts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeedsMultiStoreProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvidenceAndProposerHash) > 0 {
-		i -= len(m.EvidenceAndProposerHash)
-		copy(dAtA[i:], m.EvidenceAndProposerHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvidenceAndProposerHash)))
+	if len(m.MintToUpgradeStoresMerkleHash) > 0 {
+		i -= len(m.MintToUpgradeStoresMerkleHash)
+		copy(dAtA[i:], m.MintToUpgradeStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.MintToUpgradeStoresMerkleHash)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x32
 	}
-	if len(m.LastResultsHash) > 0 {
-		i -= len(m.LastResultsHash)
-		copy(dAtA[i:], m.LastResultsHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.LastResultsHash)))
+	if len(m.AuthToDistributionStoresMerkleHash) > 0 {
+		i -= len(m.AuthToDistributionStoresMerkleHash)
+		copy(dAtA[i:], m.AuthToDistributionStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.AuthToDistributionStoresMerkleHash)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x2a
 	}
-	if len(m.NextValidatorHashAndConsensusHash) > 0 {
-		i -= len(m.NextValidatorHashAndConsensusHash)
-		copy(dAtA[i:], m.NextValidatorHashAndConsensusHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.NextValidatorHashAndConsensusHash)))
+	if len(m.GlobalfeeToIcahostStoresMerkleHash) > 0 {
+		i -= len(m.GlobalfeeToIcahostStoresMerkleHash)
+		copy(dAtA[i:], m.GlobalfeeToIcahostStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.GlobalfeeToIcahostStoresMerkleHash)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x22
 	}
-	if len(m.LastBlockIdAndOther) > 0 {
-		i -= len(m.LastBlockIdAndOther)
-		copy(dAtA[i:], m.LastBlockIdAndOther)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.LastBlockIdAndOther)))
+	if len(m.FeegrantToFeeibcStoresMerkleHash) > 0 {
+		i -= len(m.FeegrantToFeeibcStoresMerkleHash)
+		copy(dAtA[i:], m.FeegrantToFeeibcStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.FeegrantToFeeibcStoresMerkleHash)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
-	if m.TimeNanoSecond != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.TimeNanoSecond))
+	if len(m.EvidenceStoreMerkleHash) > 0 {
+		i -= len(m.EvidenceStoreMerkleHash)
+		copy(dAtA[i:], m.EvidenceStoreMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvidenceStoreMerkleHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedsIAVLStateHash) > 0 {
+		i -= len(m.FeedsIAVLStateHash)
+		copy(dAtA[i:], m.FeedsIAVLStateHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.FeedsIAVLStateHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHeaderMerkleParts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeaderMerkleParts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeaderMerkleParts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceAndProposerHash) > 0 {
+		i -= len(m.EvidenceAndProposerHash)
+		copy(dAtA[i:], m.EvidenceAndProposerHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvidenceAndProposerHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.LastResultsHash) > 0 {
+		i -= len(m.LastResultsHash)
+		copy(dAtA[i:], m.LastResultsHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.LastResultsHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NextValidatorHashAndConsensusHash) > 0 {
+		i -= len(m.NextValidatorHashAndConsensusHash)
+		copy(dAtA[i:], m.NextValidatorHashAndConsensusHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.NextValidatorHashAndConsensusHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastBlockIdAndOther) > 0 {
+		i -= len(m.LastBlockIdAndOther)
+		copy(dAtA[i:], m.LastBlockIdAndOther)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.LastBlockIdAndOther)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeNanoSecond != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.TimeNanoSecond))
 		i--
 		dAtA[i] = 0x20
 	}
@@ -2602,6 +3339,38 @@ func (m *RequestCountProofResponse) Size() (n int) {
 	return n
 }
 
+func (m *PricesProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for _, s := range m.SignalIds {
+			l = len(s)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PricesProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
 func (m *SingleProofResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2647,6 +3416,21 @@ func (m *CountProofResult) Size() (n int) {
 	return n
 }
 
+func (m *PricesProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
 func (m *SingleProof) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2699,6 +3483,48 @@ func (m *CountProof) Size() (n int) {
 	return n
 }
 
+func (m *PricesProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.FeedsMultiStoreProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if len(m.PriceProofs) > 0 {
+		for _, e := range m.PriceProofs {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *PriceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *OracleDataProof) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2798,6 +3624,39 @@ func (m *MultiStoreProof) Size() (n int) {
 	return n
 }
 
+func (m *FeedsMultiStoreProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedsIAVLStateHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.EvidenceStoreMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.FeegrantToFeeibcStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.GlobalfeeToIcahostStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.AuthToDistributionStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.MintToUpgradeStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
 func (m *BlockHeaderMerkleParts) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3474,7 +4333,7 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
+func (m *PricesProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3497,17 +4356,17 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: PricesProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PricesProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3517,30 +4376,29 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3550,26 +4408,11 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProof
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProof
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmProofBytes == nil {
-				m.EvmProofBytes = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -3591,7 +4434,7 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
+func (m *PricesProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3614,17 +4457,17 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: PricesProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PricesProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3634,30 +4477,16 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProof
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProof
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3667,24 +4496,23 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmProofBytes == nil {
-				m.EvmProofBytes = []byte{}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3708,7 +4536,7 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountProofResult) Unmarshal(dAtA []byte) error {
+func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3731,10 +4559,10 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3825,7 +4653,7 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SingleProof) Unmarshal(dAtA []byte) error {
+func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3848,17 +4676,17 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3868,14 +4696,500 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PricesProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PricesProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PricesProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SingleProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3902,7 +5216,8 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OracleDataMultiProof = append(m.OracleDataMultiProof, OracleDataProof{})
+			if err := m.OracleDataMultiProof[len(m.OracleDataMultiProof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3960,7 +5275,7 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProof) Unmarshal(dAtA []byte) error {
+func (m *CountProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3983,10 +5298,10 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4010,7 +5325,7 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4037,8 +5352,7 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleDataMultiProof = append(m.OracleDataMultiProof, OracleDataProof{})
-			if err := m.OracleDataMultiProof[len(m.OracleDataMultiProof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CountProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4096,7 +5410,7 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountProof) Unmarshal(dAtA []byte) error {
+func (m *PricesProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4119,10 +5433,10 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: PricesProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PricesProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4146,7 +5460,157 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedsMultiStoreProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeedsMultiStoreProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceProofs = append(m.PriceProofs, PriceProof{})
+			if err := m.PriceProofs[len(m.PriceProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4173,13 +5637,32 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CountProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4206,7 +5689,8 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4965,6 +6449,260 @@ func (m *MultiStoreProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeedsMultiStoreProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedsMultiStoreProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedsMultiStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedsIAVLStateHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedsIAVLStateHash = append(m.FeedsIAVLStateHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeedsIAVLStateHash == nil {
+				m.FeedsIAVLStateHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceStoreMerkleHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceStoreMerkleHash = append(m.EvidenceStoreMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvidenceStoreMerkleHash == nil {
+				m.EvidenceStoreMerkleHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeegrantToFeeibcStoresMerkleHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeegrantToFeeibcStoresMerkleHash = append(m.FeegrantToFeeibcStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeegrantToFeeibcStoresMerkleHash == nil {
+				m.FeegrantToFeeibcStoresMerkleHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalfeeToIcahostStoresMerkleHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalfeeToIcahostStoresMerkleHash = append(m.GlobalfeeToIcahostStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GlobalfeeToIcahostStoresMerkleHash == nil {
+				m.GlobalfeeToIcahostStoresMerkleHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToDistributionStoresMerkleHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthToDistributionStoresMerkleHash = append(m.AuthToDistributionStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuthToDistributionStoresMerkleHash == nil {
+				m.AuthToDistributionStoresMerkleHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintToUpgradeStoresMerkleHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintToUpgradeStoresMerkleHash = append(m.MintToUpgradeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintToUpgradeStoresMerkleHash == nil {
+				m.MintToUpgradeStoresMerkleHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BlockHeaderMerkleParts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
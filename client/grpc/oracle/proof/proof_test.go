@@ -6,8 +6,6 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	"github.com/cometbft/cometbft/crypto/tmhash"
-
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
@@ -19,16 +17,6 @@ func hexToBytes(hexstr string) []byte {
 	return b
 }
 
-func leafHash(item []byte) []byte {
-	// leaf prefix is 0
-	return tmhash.Sum(append([]byte{0}, item...))
-}
-
-func innerHash(left, right []byte) []byte {
-	// branch prefix is 1
-	return tmhash.Sum(append([]byte{1}, append(left, right...)...))
-}
-
 func TestEncodeRelay(t *testing.T) {
 	block := BlockRelayProof{
 		MultiStoreProof: MultiStoreProof{
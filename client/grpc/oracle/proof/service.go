@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
@@ -80,6 +81,7 @@ func (s proofServer) Proof(ctx context.Context, req *ProofRequest) (*ProofRespon
 	// Get the proofs for the requested id and height
 	value, iavlEp, multiStoreEp, err := getProofsByKey(
 		cliCtx,
+		types.StoreKey,
 		types.ResultStoreKey(requestID),
 		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 		true,
@@ -200,6 +202,7 @@ func (s proofServer) MultiProof(ctx context.Context, req *MultiProofRequest) (*M
 
 		value, iavlEp, multiStoreEp, err := getProofsByKey(
 			cliCtx,
+			types.StoreKey,
 			types.ResultStoreKey(requestID),
 			rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 			idx == 0,
@@ -294,6 +297,7 @@ func (s proofServer) RequestCountProof(
 	// Get the proofs for the count from the IAVL tree
 	value, iavlEp, multiStoreEp, err := getProofsByKey(
 		cliCtx,
+		types.StoreKey,
 		types.RequestCountStoreKey,
 		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 		true,
@@ -367,3 +371,135 @@ func (s proofServer) RequestCountProof(
 		},
 	}, nil
 }
+
+// PricesProof returns a proof of the feeds prices from provided signal IDs and block height
+func (s proofServer) PricesProof(ctx context.Context, req *PricesProofRequest) (*PricesProofResponse, error) {
+	cliCtx := s.clientCtx
+	// Set the height in the client context to the requested height
+	cliCtx.Height = req.Height
+
+	// Get the signal IDs from the request object
+	signalIDs := req.SignalIds
+	// If there are no signal IDs, return an error
+	if len(signalIDs) == 0 {
+		return nil, fmt.Errorf("please provide signal ids")
+	}
+
+	// If the height is 0, set the height to the latest block height - 1
+	if cliCtx.Height == 0 {
+		status, err := cliCtx.Client.Status(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		cliCtx.Height = status.SyncInfo.LatestBlockHeight - 1
+	}
+
+	height := &cliCtx.Height
+
+	// Get the commit at the specified height from the client
+	commit, err := cliCtx.Client.Commit(context.Background(), height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the commit is canonical, else return an error
+	if !commit.CanonicalCommit {
+		return nil, fmt.Errorf("commit is not canonical")
+	}
+
+	queryOptions := rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true}
+
+	// Get the oracle multi store proof from the oracle params, which always exist, to relay the block
+	_, _, oracleMultiStoreEp, err := getProofsByKey(cliCtx, types.StoreKey, types.ParamsKeyPrefix, queryOptions, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the signatures and common vote prefix from the commit header
+	signatures, commonVote, err := GetSignaturesAndPrefix(&commit.SignedHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a BlockRelayProof object with the relevant information
+	blockRelay := BlockRelayProof{
+		MultiStoreProof:        GetMultiStoreProof(oracleMultiStoreEp),
+		BlockHeaderMerkleParts: GetBlockHeaderMerkleParts(commit.Header),
+		CommonEncodedVotePart:  commonVote,
+		Signatures:             signatures,
+	}
+
+	// Create a PricesProof object and loop through each signal ID to get the relevant proofs
+	pricesProof := PricesProof{
+		BlockHeight:     uint64(commit.Height),
+		PriceProofs:     make([]PriceProof, len(signalIDs)),
+		BlockRelayProof: blockRelay,
+	}
+	for idx, signalID := range signalIDs {
+		value, iavlEp, feedsMultiStoreEp, err := getProofsByKey(
+			cliCtx,
+			feedstypes.StoreKey,
+			feedstypes.PriceStoreKey(signalID),
+			queryOptions,
+			idx == 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Unmarshal the value into a Price object
+		var price feedstypes.Price
+		if err := price.Unmarshal(value); err != nil {
+			return nil, err
+		}
+
+		pricesProof.PriceProofs[idx] = PriceProof{
+			Price:       price,
+			Version:     decodeIAVLLeafPrefix(iavlEp.Leaf.Prefix),
+			MerklePaths: GetMerklePaths(iavlEp),
+		}
+
+		// If this is the first iteration, set the feeds multi store proof in the prices proof object
+		if idx == 0 {
+			pricesProof.FeedsMultiStoreProof = GetFeedsMultiStoreProof(feedsMultiStoreEp)
+		}
+	}
+
+	// Calculate byte for proofbytes
+	var relayAndVerifyPricesArguments abi.Arguments
+	format := `[{"type":"bytes"},{"type":"bytes"}]`
+	err = json.Unmarshal([]byte(format), &relayAndVerifyPricesArguments)
+	if err != nil {
+		panic(err)
+	}
+
+	// Encode the block relay proof and the prices proof into Ethereum-compatible format
+	blockRelayBytes, err := blockRelay.encodeToEthData()
+	if err != nil {
+		return nil, err
+	}
+	pricesBytes, err := pricesProof.encodeToEthData()
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the encoded block relay bytes and prices bytes into a evm proof bytes
+	evmProofBytes, err := relayAndVerifyPricesArguments.Pack(blockRelayBytes, pricesBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the height is negative, return an error
+	if cliCtx.Height < 0 {
+		return nil, fmt.Errorf("negative height in response")
+	}
+
+	// Return a PricesProofResponse object with the relevant information
+	return &PricesProofResponse{
+		Height: cliCtx.Height,
+		Result: PricesProofResult{
+			Proof:         pricesProof,
+			EvmProofBytes: evmProofBytes,
+		},
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -174,3 +175,13 @@ func convertVarIntToBytes(orig int64) []byte {
 	n := binary.PutVarint(buf[:], orig)
 	return buf[:n]
 }
+
+func leafHash(item []byte) []byte {
+	// leaf prefix is 0
+	return tmhash.Sum(append([]byte{0}, item...))
+}
+
+func innerHash(left, right []byte) []byte {
+	// branch prefix is 1
+	return tmhash.Sum(append([]byte{1}, append(left, right...)...))
+}
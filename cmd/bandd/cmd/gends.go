@@ -61,7 +61,7 @@ func AddGenesisDataSourceCmd(defaultNodeHome string) *cobra.Command {
 
 			oracleGenState := types.GetGenesisStateFromAppState(cdc, appState)
 			oracleGenState.DataSources = append(oracleGenState.DataSources, types.NewDataSource(
-				owner, args[0], args[1], filename, fee, treasury,
+				owner, args[0], args[1], filename, fee, treasury, false,
 			))
 			oracleGenStateBz, err := cdc.MarshalJSON(oracleGenState)
 			if err != nil {
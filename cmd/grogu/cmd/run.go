@@ -37,6 +37,10 @@ const (
 	flagBothanTimeout        = "bothan-timeout"
 	flagDistrStartPct        = "distribution-start-pct"
 	flagDistrOffsetPct       = "distribution-offset-pct"
+	flagPriceCheckSignalIDs  = "price-check-signal-ids"
+	flagMaxPriceJumpBPS      = "max-price-jump-bps"
+	flagMaxPriceStaleness    = "max-price-staleness"
+	flagMaxMedianDevBPS      = "max-median-deviation-bps"
 	flagLogLevel             = "log-level"
 	flagUpdaterQueryInterval = "updater-query-interval"
 	flagMetricsListenAddr    = "metrics-listen-addr"
@@ -60,6 +64,10 @@ func RunCmd(ctx *context.Context) *cobra.Command {
 	cmd.Flags().Uint64(flagMaxTry, 5, "The maximum number of attempts to submit a transaction.")
 	cmd.Flags().Uint64(flagDistrStartPct, 50, "The starting percentage for the distribution offset range.")
 	cmd.Flags().Uint64(flagDistrOffsetPct, 30, "The offset percentage range from the starting distribution.")
+	cmd.Flags().String(flagPriceCheckSignalIDs, "", "The comma-separated signal IDs whose prices are checked for jumps and staleness.")
+	cmd.Flags().Int64(flagMaxPriceJumpBPS, 0, "The maximum deviation (in basis point) of a checked price from the last submitted price, 0 to disable.")
+	cmd.Flags().String(flagMaxPriceStaleness, "0s", "The maximum duration for which Bothan can return the same checked price, 0s to disable.")
+	cmd.Flags().Int64(flagMaxMedianDevBPS, 0, "The maximum deviation (in basis point) of a price from the on-chain price, 0 to disable.")
 	cmd.Flags().String(flagBothan, "", "The Bothan URL to connect to.")
 	cmd.Flags().String(flagBothanTimeout, "3s", "The timeout duration for Bothan requests.")
 	cmd.Flags().String(flagLogLevel, "info", "The application's log level.")
@@ -75,6 +83,10 @@ func RunCmd(ctx *context.Context) *cobra.Command {
 	_ = viper.BindPFlag(flagMaxTry, cmd.Flags().Lookup(flagMaxTry))
 	_ = viper.BindPFlag(flagDistrStartPct, cmd.Flags().Lookup(flagDistrStartPct))
 	_ = viper.BindPFlag(flagDistrOffsetPct, cmd.Flags().Lookup(flagDistrOffsetPct))
+	_ = viper.BindPFlag(flagPriceCheckSignalIDs, cmd.Flags().Lookup(flagPriceCheckSignalIDs))
+	_ = viper.BindPFlag(flagMaxPriceJumpBPS, cmd.Flags().Lookup(flagMaxPriceJumpBPS))
+	_ = viper.BindPFlag(flagMaxPriceStaleness, cmd.Flags().Lookup(flagMaxPriceStaleness))
+	_ = viper.BindPFlag(flagMaxMedianDevBPS, cmd.Flags().Lookup(flagMaxMedianDevBPS))
 	_ = viper.BindPFlag(flagBothan, cmd.Flags().Lookup(flagBothan))
 	_ = viper.BindPFlag(flagBothanTimeout, cmd.Flags().Lookup(flagBothanTimeout))
 	_ = viper.BindPFlag(flagLogLevel, cmd.Flags().Lookup(flagLogLevel))
@@ -150,6 +162,12 @@ func createRunE(ctx *context.Context) func(cmd *cobra.Command, args []string) er
 			&pendingSignalIDs,
 			ctx.Config.DistributionStartPercentage,
 			ctx.Config.DistributionOffsetPercentage,
+			signaller.PriceValidationConfig{
+				CheckedSignalIDs:             parseSignalIDSet(ctx.Config.PriceCheckSignalIDs),
+				MaxJumpBasisPoint:            ctx.Config.MaxPriceJumpBasisPoint,
+				MaxStaleness:                 ctx.Config.MaxPriceStaleness,
+				MaxMedianDeviationBasisPoint: ctx.Config.MaxMedianDeviationBasisPoint,
+			},
 		)
 
 		// Setup Submitter
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/rpc/client/http"
@@ -35,3 +36,15 @@ func createClients(nodeURIs []string) ([]rpcclient.RemoteClient, func(), error)
 
 	return clients, stopClients, nil
 }
+
+// parseSignalIDSet parses a comma-separated list of signal IDs into a set.
+func parseSignalIDSet(signalIDs string) map[string]bool {
+	set := make(map[string]bool)
+	for _, signalID := range strings.Split(signalIDs, ",") {
+		if signalID = strings.TrimSpace(signalID); signalID != "" {
+			set[signalID] = true
+		}
+	}
+
+	return set
+}
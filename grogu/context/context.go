@@ -38,6 +38,21 @@ type Config struct {
 	// DistributionOffsetPercentage defines the range of the percentage for price distribution.
 	DistributionOffsetPercentage uint64 `mapstructure:"distribution-offset-pct"`
 
+	// PriceCheckSignalIDs is the comma-separated list of signal IDs subject to the price jump and staleness checks.
+	PriceCheckSignalIDs string `mapstructure:"price-check-signal-ids"`
+
+	// MaxPriceJumpBasisPoint is the maximum deviation (in basis point) of a price of a checked signal from its
+	// last price submitted by the validator. Zero disables the check.
+	MaxPriceJumpBasisPoint int64 `mapstructure:"max-price-jump-bps"`
+
+	// MaxPriceStaleness is the maximum duration for which Bothan can return the same price of a checked signal.
+	// Zero disables the check.
+	MaxPriceStaleness time.Duration `mapstructure:"max-price-staleness"`
+
+	// MaxMedianDeviationBasisPoint is the maximum deviation (in basis point) of a price from the current on-chain
+	// price. Zero disables the check.
+	MaxMedianDeviationBasisPoint int64 `mapstructure:"max-median-deviation-bps"`
+
 	// Bothan is the URL for connecting to Bothan.
 	Bothan string `mapstructure:"bothan"`
 
@@ -18,6 +18,7 @@ type FeedQuerier interface {
 	QueryValidatorPrices(valAddress sdk.ValAddress) (*feeds.QueryValidatorPricesResponse, error)
 	QueryParams() (*feeds.QueryParamsResponse, error)
 	QueryCurrentFeeds() (*feeds.QueryCurrentFeedsResponse, error)
+	QueryPrices(signalIDs []string) (*feeds.QueryPricesResponse, error)
 }
 
 type CometQuerier interface {
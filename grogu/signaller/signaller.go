@@ -32,9 +32,12 @@ type Signaller struct {
 
 	distributionStartPercentage  uint64
 	distributionOffsetPercentage uint64
+	validationConfig             PriceValidationConfig
 
 	signalIDToFeed           map[string]types.FeedWithDeviation
 	signalIDToValidatorPrice map[string]types.ValidatorPrice
+	signalIDToPrice          map[string]types.Price
+	signalIDToObservation    map[string]priceObservation
 	params                   *types.Params
 	blockTime                int64
 }
@@ -50,6 +53,7 @@ func New(
 	pendingSignalIDs *sync.Map,
 	distributionStartPercentage uint64,
 	distributionOffsetPercentage uint64,
+	validationConfig PriceValidationConfig,
 ) *Signaller {
 	return &Signaller{
 		feedQuerier:                  feedQuerier,
@@ -62,8 +66,11 @@ func New(
 		pendingSignalIDs:             pendingSignalIDs,
 		distributionStartPercentage:  distributionStartPercentage,
 		distributionOffsetPercentage: distributionOffsetPercentage,
+		validationConfig:             validationConfig,
 		signalIDToFeed:               make(map[string]types.FeedWithDeviation),
 		signalIDToValidatorPrice:     make(map[string]types.ValidatorPrice),
+		signalIDToPrice:              make(map[string]types.Price),
+		signalIDToObservation:        make(map[string]priceObservation),
 		params:                       nil,
 	}
 }
@@ -91,6 +98,11 @@ func (s *Signaller) Start() {
 			continue
 		}
 
+		if !s.updatePriceMap() {
+			s.logger.Error("[Signaller] failed to update on-chain prices")
+			continue
+		}
+
 		s.execute()
 	}
 }
@@ -162,6 +174,26 @@ func (s *Signaller) updateValidatorPriceMap() bool {
 	return true
 }
 
+// updatePriceMap updates the on-chain prices of the current feeds if the prices from Bothan are
+// compared with them. It runs after the feed map is updated as it queries the prices of its signals.
+func (s *Signaller) updatePriceMap() bool {
+	if s.validationConfig.MaxJumpBasisPoint == 0 && s.validationConfig.MaxMedianDeviationBasisPoint == 0 {
+		return true
+	}
+
+	resp, err := s.feedQuerier.QueryPrices(s.getAllSignalIDs())
+	if err != nil {
+		s.logger.Error("[Signaller] failed to query prices: %v", err)
+		return false
+	}
+
+	s.signalIDToPrice = sliceToMap(resp.Prices, func(price types.Price) string {
+		return price.SignalID
+	})
+
+	return true
+}
+
 func (s *Signaller) updateBlockTime() bool {
 	resp, err := s.cometQuerier.GetLatestBlock()
 	if err != nil {
@@ -270,6 +302,11 @@ func (s *Signaller) filterAndPrepareSignalPrices(
 	conversionErrorCnt := 0
 	signalNotFoundCnt := 0
 	nonUrgentUnavailablePriceCnt := 0
+	withheldPriceCnts := map[string]int{
+		WithheldReasonPriceJump:       0,
+		WithheldReasonStalePrice:      0,
+		WithheldReasonMedianDeviation: 0,
+	}
 
 	for _, signalID := range signalIDs {
 		price, ok := pricesMap[signalID]
@@ -286,6 +323,15 @@ func (s *Signaller) filterAndPrepareSignalPrices(
 			continue
 		}
 
+		// withhold a suspicious price by treating it as unavailable. It is skipped until the validator
+		// is about to miss its report, at which point the signal is reported as unavailable instead of
+		// with the withheld price so that the validator is not deactivated for a missed report
+		if reason := s.validatePrice(signalPrice, currentTime); reason != "" {
+			withheldPriceCnts[reason]++
+			s.logger.Warn("[Signaller] withholding price of signal ID %s (%s): %d", signalID, reason, signalPrice.Price)
+			signalPrice = types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, signalID, 0)
+		}
+
 		if !s.isPriceValid(signalPrice, currentTime) {
 			continue
 		}
@@ -302,6 +348,7 @@ func (s *Signaller) filterAndPrepareSignalPrices(
 	telemetry.SetConversionErrorSignals(conversionErrorCnt)
 	telemetry.SetSignalNotFound(signalNotFoundCnt)
 	telemetry.SetNonUrgentUnavailablePriceSignals(nonUrgentUnavailablePriceCnt)
+	telemetry.SetWithheldPriceSignals(withheldPriceCnts)
 
 	return signalPrices
 }
@@ -130,6 +130,7 @@ func (s *SignallerTestSuite) SetupTest() {
 		&pendingSignalIDs,
 		50,
 		30,
+		PriceValidationConfig{},
 	)
 	s.SubmitCh = submitCh
 	s.assignedTime = calculateAssignedTime(
@@ -324,3 +325,96 @@ func (s *SignallerTestSuite) TestShouldUpdatePrice() {
 	newPrice.Price = 10025 // Within deviationBasisPoint
 	s.Require().False(s.Signaller.shouldUpdatePrice(feed, valPrice, newPrice, assignedTime.Add(-time.Second)))
 }
+
+func (s *SignallerTestSuite) TestValidatePrice() {
+	// Update internal variables
+	s.TestUpdateInternalVariables()
+
+	now := time.Unix(60, 0)
+	newPrice := feeds.NewSignalPrice(feeds.SIGNAL_PRICE_STATUS_AVAILABLE, "signal1", 15000)
+	s.Signaller.signalIDToPrice["signal1"] = feeds.NewPrice(feeds.PRICE_STATUS_AVAILABLE, "signal1", 10000, 0)
+
+	// Test case: all checks are disabled
+	s.Require().Empty(s.Signaller.validatePrice(newPrice, now))
+
+	// Test case: price jump and staleness are not checked for a signal that is not opted in
+	s.Signaller.validationConfig.MaxJumpBasisPoint = 4000
+	s.Signaller.validationConfig.MaxStaleness = time.Minute
+	s.Require().Empty(s.Signaller.validatePrice(newPrice, now))
+	s.Require().Empty(s.Signaller.validatePrice(newPrice, now.Add(2*time.Minute)))
+
+	// Test case: price jumps from the last submitted price
+	s.Signaller.validationConfig.CheckedSignalIDs = map[string]bool{"signal1": true}
+	newPrice.Price = 14000
+	s.Require().Empty(s.Signaller.validatePrice(newPrice, now))
+
+	newPrice.Price = 14001
+	s.Require().Equal(WithheldReasonPriceJump, s.Signaller.validatePrice(newPrice, now))
+
+	// Test case: price jump is not measured from the on-chain price
+	s.Signaller.signalIDToPrice["signal1"] = feeds.NewPrice(feeds.PRICE_STATUS_AVAILABLE, "signal1", 14001, 0)
+	s.Require().Equal(WithheldReasonPriceJump, s.Signaller.validatePrice(newPrice, now))
+
+	s.Signaller.signalIDToValidatorPrice["signal1"] = feeds.ValidatorPrice{
+		SignalPriceStatus: feeds.SIGNAL_PRICE_STATUS_AVAILABLE,
+		SignalID:          "signal1",
+		Price:             14001,
+	}
+	s.Require().Empty(s.Signaller.validatePrice(newPrice, now))
+
+	s.Signaller.signalIDToPrice["signal1"] = feeds.NewPrice(feeds.PRICE_STATUS_AVAILABLE, "signal1", 10000, 0)
+	s.Signaller.signalIDToValidatorPrice["signal1"] = feeds.ValidatorPrice{
+		SignalPriceStatus: feeds.SIGNAL_PRICE_STATUS_AVAILABLE,
+		SignalID:          "signal1",
+		Price:             10000,
+	}
+
+	// Test case: price deviates from the on-chain price
+	s.Signaller.validationConfig.MaxMedianDeviationBasisPoint = 1000
+	newPrice.Price = 11000
+	s.Require().Empty(s.Signaller.validatePrice(newPrice, now))
+
+	newPrice.Price = 12000
+	s.Require().Equal(WithheldReasonMedianDeviation, s.Signaller.validatePrice(newPrice, now))
+
+	// Test case: price does not change for too long
+	newPrice.Price = 10500
+	s.Require().Empty(s.Signaller.validatePrice(newPrice, now))
+	s.Require().Empty(s.Signaller.validatePrice(newPrice, now.Add(time.Minute)))
+	s.Require().Equal(WithheldReasonStalePrice, s.Signaller.validatePrice(newPrice, now.Add(time.Minute+time.Second)))
+
+	// Test case: a changed price is not stale
+	newPrice.Price = 10501
+	s.Require().Empty(s.Signaller.validatePrice(newPrice, now.Add(time.Minute+time.Second)))
+
+	// Test case: unavailable price is not checked
+	newPrice = feeds.NewSignalPrice(feeds.SIGNAL_PRICE_STATUS_UNAVAILABLE, "signal1", 0)
+	s.Require().Empty(s.Signaller.validatePrice(newPrice, now))
+}
+
+func (s *SignallerTestSuite) TestFilterAndPrepareSignalPricesWithheldPrice() {
+	s.TestUpdateInternalVariables()
+	s.Signaller.validationConfig.CheckedSignalIDs = map[string]bool{"signal1": true}
+	s.Signaller.validationConfig.MaxJumpBasisPoint = 1000
+	s.Signaller.signalIDToPrice["signal1"] = feeds.NewPrice(feeds.PRICE_STATUS_AVAILABLE, "signal1", 10000, 0)
+
+	prices := []*bothan.Price{
+		{
+			SignalId: "signal1",
+			Price:    20000,
+			Status:   bothan.Status_STATUS_AVAILABLE,
+		},
+	}
+	signalIDs := []string{"signal1"}
+
+	// the withheld price is not submitted before the urgent deadline
+	submitPrices := s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, time.Unix(49, 0))
+	s.Require().Empty(submitPrices)
+
+	// the withheld price is reported as unavailable after the urgent deadline
+	submitPrices = s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, time.Unix(51, 0))
+	s.Require().Equal(
+		[]feeds.SignalPrice{feeds.NewSignalPrice(feeds.SIGNAL_PRICE_STATUS_UNAVAILABLE, "signal1", 0)},
+		submitPrices,
+	)
+}
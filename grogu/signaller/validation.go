@@ -0,0 +1,82 @@
+package signaller
+
+import (
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// Reasons for withholding a price from Bothan.
+const (
+	WithheldReasonPriceJump       = "price_jump"
+	WithheldReasonStalePrice      = "stale_price"
+	WithheldReasonMedianDeviation = "median_deviation"
+)
+
+// PriceValidationConfig defines the sanity checks on the prices from Bothan before they are submitted.
+// A zero value disables the corresponding check.
+type PriceValidationConfig struct {
+	// CheckedSignalIDs is the set of signal IDs whose prices are subject to the price jump and
+	// staleness checks. The prices of other signals are not checked for jumps and staleness.
+	CheckedSignalIDs map[string]bool
+
+	// MaxJumpBasisPoint is the maximum deviation (in basis point) of a price from the last price
+	// of a checked signal submitted by the validator.
+	MaxJumpBasisPoint int64
+
+	// MaxStaleness is the maximum duration for which Bothan can return the same price of a checked
+	// signal. Bothan does not return the time of its prices, so the duration is measured from the
+	// time the price is first seen by the signaller.
+	MaxStaleness time.Duration
+
+	// MaxMedianDeviationBasisPoint is the maximum deviation (in basis point) of a price from the
+	// current on-chain price of the signal.
+	MaxMedianDeviationBasisPoint int64
+}
+
+// priceObservation is the price of a signal from Bothan and the time it is first seen.
+type priceObservation struct {
+	price     uint64
+	firstSeen time.Time
+}
+
+// validatePrice checks an available price from Bothan against the current on-chain price and, for
+// the checked signals, against the last price submitted by the validator and the time the price has
+// not changed. It returns the reason for withholding the price, or an empty string if the price
+// passes all checks.
+func (s *Signaller) validatePrice(signalPrice types.SignalPrice, now time.Time) string {
+	if signalPrice.Status != types.SIGNAL_PRICE_STATUS_AVAILABLE {
+		delete(s.signalIDToObservation, signalPrice.SignalID)
+		return ""
+	}
+
+	observation, ok := s.signalIDToObservation[signalPrice.SignalID]
+	if !ok || observation.price != signalPrice.Price {
+		observation = priceObservation{price: signalPrice.Price, firstSeen: now}
+		s.signalIDToObservation[signalPrice.SignalID] = observation
+	}
+
+	if s.validationConfig.CheckedSignalIDs[signalPrice.SignalID] {
+		valPrice, hasValPrice := s.signalIDToValidatorPrice[signalPrice.SignalID]
+		hasValPrice = hasValPrice && valPrice.SignalPriceStatus == types.SIGNAL_PRICE_STATUS_AVAILABLE
+
+		if s.validationConfig.MaxJumpBasisPoint > 0 && hasValPrice &&
+			isDeviated(s.validationConfig.MaxJumpBasisPoint+1, valPrice.Price, signalPrice.Price) {
+			return WithheldReasonPriceJump
+		}
+
+		if s.validationConfig.MaxStaleness > 0 && now.Sub(observation.firstSeen) > s.validationConfig.MaxStaleness {
+			return WithheldReasonStalePrice
+		}
+	}
+
+	price, hasPrice := s.signalIDToPrice[signalPrice.SignalID]
+	hasPrice = hasPrice && price.Status == types.PRICE_STATUS_AVAILABLE
+
+	if s.validationConfig.MaxMedianDeviationBasisPoint > 0 && hasPrice &&
+		isDeviated(s.validationConfig.MaxMedianDeviationBasisPoint+1, price.Price, signalPrice.Price) {
+		return WithheldReasonMedianDeviation
+	}
+
+	return ""
+}
@@ -40,6 +40,7 @@ type GroguCollector struct {
 	ConversionErrorSignalsGauge        prometheus.Gauge    // a gauge for the number of signal that failed to convert the result from Bothan server in the round.
 	SignalNotFoundGauge                prometheus.Gauge    // a gauge for the number of signal ID that not being found from the list.
 	NonUrgentUnavailableSignalIDsGauge prometheus.Gauge    // a gauge for the number of non-urgent signal in the round.
+	WithheldPriceSignalIDsGauge        prometheus.GaugeVec // a gauge for the number of signal whose price is withheld by the sanity checks in the round.
 	FilteredSignalingIDsGauge          prometheus.Gauge    // a gauge for the number of signal that should be submitted to BandChain in the round.
 	SignalPriceStatusGauge             prometheus.GaugeVec // a gauge for the number of signal per its status (every signals).
 
@@ -176,6 +177,18 @@ func SetNonUrgentUnavailablePriceSignals(count int) {
 	collector.NonUrgentUnavailableSignalIDsGauge.Set(float64(count))
 }
 
+// SetWithheldPriceSignals sets the number of signal whose price is withheld by the sanity checks
+// in the round per reason.
+func SetWithheldPriceSignals(counts map[string]int) {
+	if collector == nil {
+		return
+	}
+
+	for reason, count := range counts {
+		collector.WithheldPriceSignalIDsGauge.WithLabelValues(reason).Set(float64(count))
+	}
+}
+
 // SetFilteredSignalIDs sets the number of signal that should be submitted to BandChain in the round.
 func SetFilteredSignalIDs(count int) {
 	if collector == nil {
@@ -337,6 +350,10 @@ func NewGroguCollector() *GroguCollector {
 		Name: "grogu_non_urgent_unavailable_signal_ids",
 		Help: "number of signal IDs that the signal price whose status is unavailable and isn't urgent in the signaling round",
 	})
+	withheldPriceSignalIDsGauge := *registerer.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grogu_withheld_price_signal_ids",
+		Help: "number of signal IDs whose price is withheld by the sanity checks in the signaling round",
+	}, []string{"reason"})
 	filteredSignalingIDsGauge := registerer.NewGauge(prometheus.GaugeOpts{
 		Name: "grogu_filtered_signal_ids",
 		Help: "number of signal IDs that is allowed to submit to the BandChain in the signaling round",
@@ -405,6 +422,7 @@ func NewGroguCollector() *GroguCollector {
 		ConversionErrorSignalsGauge:        conversionErrorSignalsGauge,
 		SignalNotFoundGauge:                signalNotFoundGauge,
 		NonUrgentUnavailableSignalIDsGauge: nonUrgentUnavailableSignalIDsGauge,
+		WithheldPriceSignalIDsGauge:        withheldPriceSignalIDsGauge,
 		FilteredSignalingIDsGauge:          filteredSignalingIDsGauge,
 		SignalPriceStatusGauge:             signalPriceStatusGauge,
 		SubmittingTxCount:                  submittingTxCount,
@@ -434,6 +452,7 @@ func (c GroguCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.ConversionErrorSignalsGauge.Desc()
 	ch <- c.SignalNotFoundGauge.Desc()
 	ch <- c.NonUrgentUnavailableSignalIDsGauge.Desc()
+	c.WithheldPriceSignalIDsGauge.Describe(ch)
 	ch <- c.FilteredSignalingIDsGauge.Desc()
 	c.SignalPriceStatusGauge.Describe(ch)
 
@@ -464,6 +483,7 @@ func (c GroguCollector) Collect(ch chan<- prometheus.Metric) {
 	ch <- c.ConversionErrorSignalsGauge
 	ch <- c.SignalNotFoundGauge
 	ch <- c.NonUrgentUnavailableSignalIDsGauge
+	c.WithheldPriceSignalIDsGauge.Collect(ch)
 	ch <- c.FilteredSignalingIDsGauge
 	c.SignalPriceStatusGauge.Collect(ch)
 
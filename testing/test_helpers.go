@@ -274,6 +274,7 @@ func GenerateDataSources(homePath string) []oracletypes.DataSource {
 		hash := fc.AddFile([]byte("code" + idxStr))
 		DataSources = append(DataSources, oracletypes.NewDataSource(
 			Owner.Address, "name"+idxStr, "desc"+idxStr, hash, Coins1band, Treasury.Address,
+			false,
 		))
 	}
 	return DataSources[1:]
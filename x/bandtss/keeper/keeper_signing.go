@@ -219,6 +219,21 @@ func (k Keeper) MustGetSigning(ctx sdk.Context, id types.SigningID) types.Signin
 	return req
 }
 
+// GetSigningStatus retrieves the status of the tss signing of the current group of the given bandtss signing.
+func (k Keeper) GetSigningStatus(ctx sdk.Context, id types.SigningID) (tsstypes.SigningStatus, error) {
+	signing, err := k.GetSigning(ctx, id)
+	if err != nil {
+		return tsstypes.SIGNING_STATUS_UNSPECIFIED, err
+	}
+
+	tssSigning, err := k.tssKeeper.GetSigning(ctx, signing.CurrentGroupSigningID)
+	if err != nil {
+		return tsstypes.SIGNING_STATUS_UNSPECIFIED, err
+	}
+
+	return tssSigning.Status, nil
+}
+
 // SetSigningIDMapping sets a mapping between tss.signingID and bandtss signing id.
 func (k Keeper) SetSigningIDMapping(ctx sdk.Context, signingID tss.SigningID, signingInfoID types.SigningID) {
 	ctx.KVStore(k.storeKey).Set(
@@ -43,6 +43,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Get prices for a list of signal IDs",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signal_ids"}},
 				},
+				{
+					RpcMethod: "PriceAt",
+					Use:       "price-at [signal-id] [timestamp]",
+					Short:     "Get the historical price for a given signal ID at a unix timestamp",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "signal_id"},
+						{ProtoField: "timestamp"},
+					},
+				},
+				{
+					RpcMethod:      "PriceHistory",
+					Use:            "price-history [signal-id]",
+					Short:          "Get the historical prices for a given signal ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signal_id"}},
+				},
+				{
+					RpcMethod: "TWAP",
+					Use:       "twap [signal-id] [window]",
+					Short:     "Get the time-weighted average price for a given signal ID over a window in seconds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "signal_id"},
+						{ProtoField: "window"},
+					},
+				},
 				{
 					RpcMethod: "AllPrices",
 					Use:       "all-prices",
@@ -53,6 +77,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "reference-source-config",
 					Short:     "Get the configuration of the reference price source",
 				},
+				{
+					RpcMethod:      "SignalMetadata",
+					Use:            "signal-metadata [signal-id]",
+					Short:          "Get the metadata of a signal id in the signal registry",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signal_id"}},
+				},
+				{
+					RpcMethod: "AllSignalMetadata",
+					Use:       "all-signal-metadata",
+					Short:     "Get the metadata of all signal ids in the signal registry",
+				},
+				{
+					RpcMethod: "CircuitBreakers",
+					Use:       "circuit-breakers",
+					Short:     "Get the triggered circuit breakers of the signal ids",
+				},
 				{
 					RpcMethod: "SignalTotalPowers",
 					Use:       "signal-total-powers",
@@ -64,6 +104,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Check if the validator is valid to send prices",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
 				},
+				{
+					RpcMethod:      "ValidatorAccuracy",
+					Use:            "validator-accuracy [validator-address]",
+					Short:          "Get the accuracy score of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+				},
+				{
+					RpcMethod: "ValidatorAccuracies",
+					Use:       "validator-accuracies",
+					Short:     "Get the accuracy scores of all tracked validators",
+				},
 				{
 					RpcMethod:      "ValidatorPrices",
 					Use:            "validator-prices [validator-address]",
@@ -76,6 +127,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Get signals voted by a voter",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "voter"}},
 				},
+				{
+					RpcMethod:      "Delegation",
+					Use:            "delegation [delegator]",
+					Short:          "Get the voting power delegation of a delegator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator"}},
+				},
+				{
+					RpcMethod:      "Delegations",
+					Use:            "delegations [delegatee]",
+					Short:          "Get the voting power delegations to a delegatee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegatee"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
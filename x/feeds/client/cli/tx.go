@@ -45,6 +45,8 @@ func GetTxCmd() *cobra.Command {
 		GetTxCmdRemoveFeeders(),
 		GetTxCmdUpdateReferenceSourceConfig(),
 		GetTxCmdVote(),
+		GetTxCmdDelegateVotingPower(),
+		GetTxCmdResetCircuitBreakers(),
 	)
 
 	return txCmd
@@ -186,6 +188,75 @@ $ %s tx feeds vote CS:BAND-USD,1000000 --from mykey
 	return cmd
 }
 
+// GetTxCmdDelegateVotingPower creates a CLI command for delegating voting power
+func GetTxCmdDelegateVotingPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-voting-power [delegatee] [power]",
+		Short: "Delegate voting power to another address",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Delegate voting power to another address that votes signals with it. Delegating zero power removes the delegation.
+Example:
+$ %s tx feeds delegate-voting-power band1m5lq9u533qaya4q3nfyl6ulzqkpkhge9q8tpzs 1000000 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegator := clientCtx.GetFromAddress()
+			power, err := strconv.ParseInt(args[1], 0, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateVotingPower(delegator.String(), args[0], power)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetTxCmdResetCircuitBreakers creates a CLI command for resetting the circuit breakers of signal ids
+func GetTxCmdResetCircuitBreakers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-circuit-breakers [signal_id1] [signal_id2] ...",
+		Short: "Reset the triggered circuit breakers of signal ids",
+		Args:  cobra.MinimumNArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Reset the triggered circuit breakers of signal ids so that their prices are published again.
+Example:
+$ %s tx feeds reset-circuit-breakers CS:BAND-USD CS:ETH-USD --from admin
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgResetCircuitBreakers(signer.String(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdRequestSignature implements the request signature handler.
 func GetCmdRequestSignature() *cobra.Command {
 	cmd := &cobra.Command{
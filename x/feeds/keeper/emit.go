@@ -70,13 +70,84 @@ func emitEventUpdateParams(ctx sdk.Context, params types.Params) {
 }
 
 func emitEventUpdatePrice(ctx sdk.Context, price types.Price) {
+	event := sdk.NewEvent(
+		types.EventTypeUpdatePrice,
+		sdk.NewAttribute(types.AttributeKeySignalID, price.SignalID),
+		sdk.NewAttribute(types.AttributeKeyPriceStatus, price.Status.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, fmt.Sprintf("%d", price.Price)),
+		sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", price.Timestamp)),
+		sdk.NewAttribute(types.AttributeKeyAggregationMethod, price.AggregationMethod.String()),
+	)
+	if price.Confidence != nil {
+		event = event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyReporterCount, fmt.Sprintf("%d", price.Confidence.ReporterCount)),
+			sdk.NewAttribute(types.AttributeKeyPowerShareBPS, fmt.Sprintf("%d", price.Confidence.PowerShareBPS)),
+			sdk.NewAttribute(types.AttributeKeyDispersion, fmt.Sprintf("%d", price.Confidence.Dispersion)),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(event)
+}
+
+func emitEventDeactivateOutlierValidator(ctx sdk.Context, accuracy types.ValidatorAccuracy) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeactivateOutlierValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, accuracy.Validator),
+			sdk.NewAttribute(types.AttributeKeyReportCount, fmt.Sprintf("%d", accuracy.ReportCount)),
+			sdk.NewAttribute(types.AttributeKeyOutlierCount, fmt.Sprintf("%d", accuracy.OutlierCount)),
+		),
+	)
+}
+
+func emitEventUpdateSignalMetadata(ctx sdk.Context, metadata types.SignalMetadata) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateSignalMetadata,
+			sdk.NewAttribute(types.AttributeKeySignalID, metadata.SignalID),
+			sdk.NewAttribute(types.AttributeKeySignalStatus, metadata.Status.String()),
+		),
+	)
+}
+
+func emitEventVoteUnregisteredSignal(ctx sdk.Context, voter sdk.AccAddress, signalID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteUnregisteredSignal,
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeySignalID, signalID),
+		),
+	)
+}
+
+func emitEventDelegateVotingPower(ctx sdk.Context, delegator string, delegatee string, power int64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateVotingPower,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator),
+			sdk.NewAttribute(types.AttributeKeyDelegatee, delegatee),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+		),
+	)
+}
+
+func emitEventTriggerCircuitBreaker(ctx sdk.Context, circuitBreaker types.CircuitBreaker) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTriggerCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeySignalID, circuitBreaker.SignalID),
+			sdk.NewAttribute(types.AttributeKeyReferencePrice, fmt.Sprintf("%d", circuitBreaker.ReferencePrice)),
+			sdk.NewAttribute(types.AttributeKeyPrice, fmt.Sprintf("%d", circuitBreaker.HaltedPrice)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", circuitBreaker.HaltedAt)),
+		),
+	)
+}
+
+func emitEventResetCircuitBreaker(ctx sdk.Context, signalID string) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeUpdatePrice,
-			sdk.NewAttribute(types.AttributeKeySignalID, price.SignalID),
-			sdk.NewAttribute(types.AttributeKeyPriceStatus, price.Status.String()),
-			sdk.NewAttribute(types.AttributeKeyPrice, fmt.Sprintf("%d", price.Price)),
-			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", price.Timestamp)),
+			types.EventTypeResetCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeySignalID, signalID),
 		),
 	)
 }
@@ -15,8 +15,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	}
 
 	k.SetVotes(ctx, genState.Votes)
+	k.SetDelegations(ctx, genState.Delegations)
 
-	signalTotalPowers := CalculateSignalTotalPowersFromVotes(genState.Votes)
+	signalTotalPowers := CalculateSignalTotalPowersFromVotes(genState.Votes, genState.Delegations)
 	k.SetSignalTotalPowers(ctx, signalTotalPowers)
 
 	feeds := k.CalculateNewCurrentFeeds(ctx)
@@ -25,6 +26,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err := k.SetReferenceSourceConfig(ctx, genState.ReferenceSourceConfig); err != nil {
 		panic(err)
 	}
+
+	k.SetAllSignalMetadata(ctx, genState.SignalMetadata)
+	k.SetCircuitBreakers(ctx, genState.CircuitBreakers)
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -33,16 +37,28 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetParams(ctx),
 		k.GetVotes(ctx),
 		k.GetReferenceSourceConfig(ctx),
+		k.GetAllSignalMetadata(ctx),
+		k.GetDelegations(ctx),
+		k.GetCircuitBreakers(ctx),
 	)
 }
 
-// CalculateSignalTotalPowersFromVotes calculates the new signal-total-powers from the given votes using on init genesis process.
-func CalculateSignalTotalPowersFromVotes(votes []types.Vote) []types.Signal {
+// CalculateSignalTotalPowersFromVotes calculates the new signal-total-powers from the given votes and the voting
+// power delegated to the voters using on init genesis process.
+func CalculateSignalTotalPowersFromVotes(votes []types.Vote, delegations []types.Delegation) []types.Signal {
+	delegatedPowers := make(map[string]int64)
+	for _, d := range delegations {
+		delegatedPowers[d.Delegatee] += d.Power
+	}
+
 	signalIDToPower := make(map[string]int64)
 	for _, v := range votes {
 		for _, signal := range v.Signals {
 			signalIDToPower[signal.ID] += signal.Power
 		}
+		for _, signal := range types.DistributePower(v.Signals, delegatedPowers[v.Voter]) {
+			signalIDToPower[signal.ID] += signal.Power
+		}
 	}
 
 	keys := make([]string, 0, len(signalIDToPower))
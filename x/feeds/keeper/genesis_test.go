@@ -45,11 +45,29 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	}
 	suite.feedsKeeper.SetVotes(ctx, votes)
 
+	signalMetadata := []types.SignalMetadata{
+		types.NewSignalMetadata("CS:BAND-USD", 9, "BAND", "USD", "crypto", "", types.SIGNAL_METADATA_STATUS_ACTIVE, 0),
+	}
+	suite.feedsKeeper.SetAllSignalMetadata(ctx, signalMetadata)
+
+	delegations := []types.Delegation{
+		types.NewDelegation(ValidVoter2.String(), ValidVoter.String(), 1000),
+	}
+	suite.feedsKeeper.SetDelegations(ctx, delegations)
+
+	circuitBreakers := []types.CircuitBreaker{
+		types.NewCircuitBreaker("CS:BAND-USD", 1000, 2000, ctx.BlockTime().Unix()),
+	}
+	suite.feedsKeeper.SetCircuitBreakers(ctx, circuitBreakers)
+
 	exportGenesis := suite.feedsKeeper.ExportGenesis(ctx)
 
 	suite.Require().Equal(types.DefaultParams(), exportGenesis.Params)
 	suite.Require().Equal(types.DefaultReferenceSourceConfig(), exportGenesis.ReferenceSourceConfig)
 	suite.Require().Equal(votes, exportGenesis.Votes)
+	suite.Require().Equal(signalMetadata, exportGenesis.SignalMetadata)
+	suite.Require().Equal(delegations, exportGenesis.Delegations)
+	suite.Require().Equal(circuitBreakers, exportGenesis.CircuitBreakers)
 }
 
 func (suite *KeeperTestSuite) TestInitGenesis() {
@@ -88,6 +106,9 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	g := types.DefaultGenesisState()
 	g.Votes = votes
 	g.Params = params
+	g.Delegations = []types.Delegation{
+		types.NewDelegation(ValidFeeder.String(), ValidVoter.String(), 3000*1e6),
+	}
 
 	suite.feedsKeeper.InitGenesis(suite.ctx, *g)
 
@@ -97,32 +118,34 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 		suite.Require().
 			Equal(v.Signals, suite.feedsKeeper.GetVote(ctx, sdk.MustAccAddressFromBech32(v.Voter)))
 	}
+	suite.Require().Equal(g.Delegations, suite.feedsKeeper.GetDelegations(ctx))
+	suite.Require().Equal(int64(3000*1e6), suite.feedsKeeper.GetDelegatedPower(ctx, ValidVoter))
 
 	stpBand, err := suite.feedsKeeper.GetSignalTotalPower(ctx, "CS:BAND-USD")
 	suite.Require().NoError(err)
 	suite.Require().Equal(types.Signal{
 		ID:    "CS:BAND-USD",
-		Power: 30000 * 1e6,
+		Power: 31000 * 1e6,
 	}, stpBand)
 
 	stpBtc, err := suite.feedsKeeper.GetSignalTotalPower(ctx, "CS:BTC-USD")
 	suite.Require().NoError(err)
 	suite.Require().Equal(types.Signal{
 		ID:    "CS:BTC-USD",
-		Power: 60000 * 1e6,
+		Power: 62000 * 1e6,
 	}, stpBtc)
 
 	suite.Require().Equal(types.CurrentFeeds{
 		Feeds: []types.Feed{
 			{
 				SignalID: "CS:BTC-USD",
-				Power:    60000000000,
+				Power:    62000000000,
 				Interval: 60,
 			},
 			{
 				SignalID: "CS:BAND-USD",
-				Power:    30000000000,
-				Interval: 120,
+				Power:    31000000000,
+				Interval: 116,
 			},
 		},
 		LastUpdateTimestamp: ctx.BlockTime().Unix(),
@@ -101,6 +101,62 @@ func (q queryServer) Price(
 	}, nil
 }
 
+// PriceAt queries the historical price of a signal at a given time.
+func (q queryServer) PriceAt(
+	goCtx context.Context, req *types.QueryPriceAtRequest,
+) (*types.QueryPriceAtResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	price, err := q.keeper.GetPriceAt(ctx, req.SignalId, req.Timestamp)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPriceAtResponse{
+		Price: price,
+	}, nil
+}
+
+// PriceHistory queries the historical prices of a signal.
+func (q queryServer) PriceHistory(
+	goCtx context.Context, req *types.QueryPriceHistoryRequest,
+) (*types.QueryPriceHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	priceStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.PriceHistoryStoreKey(req.SignalId))
+
+	var prices []types.Price
+	pageRes, err := query.Paginate(priceStore, req.Pagination, func(key []byte, value []byte) error {
+		var price types.Price
+		if err := q.keeper.cdc.Unmarshal(value, &price); err != nil {
+			return err
+		}
+
+		prices = append(prices, price)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPriceHistoryResponse{Prices: prices, Pagination: pageRes}, nil
+}
+
+// TWAP queries the time-weighted average price of a signal.
+func (q queryServer) TWAP(
+	goCtx context.Context, req *types.QueryTWAPRequest,
+) (*types.QueryTWAPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Window <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "window must be positive")
+	}
+
+	return &types.QueryTWAPResponse{
+		Price: q.keeper.GetTWAPPrice(ctx, req.SignalId, req.Window),
+	}, nil
+}
+
 // Prices queries prices by signal ids.
 func (q queryServer) Prices(
 	goCtx context.Context, req *types.QueryPricesRequest,
@@ -158,6 +214,90 @@ func (q queryServer) ReferenceSourceConfig(
 	}, nil
 }
 
+// SignalMetadata queries the metadata of a signal id in the signal registry.
+func (q queryServer) SignalMetadata(
+	goCtx context.Context, req *types.QuerySignalMetadataRequest,
+) (*types.QuerySignalMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	metadata, err := q.keeper.GetSignalMetadata(ctx, req.SignalId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QuerySignalMetadataResponse{SignalMetadata: metadata}, nil
+}
+
+// AllSignalMetadata queries the metadata of all signal ids in the signal registry.
+func (q queryServer) AllSignalMetadata(
+	goCtx context.Context, req *types.QueryAllSignalMetadataRequest,
+) (*types.QueryAllSignalMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(q.keeper.storeKey)
+	metadataStore := prefix.NewStore(store, types.SignalMetadataStoreKeyPrefix)
+
+	filteredMetadata, pageRes, err := query.GenericFilteredPaginate(
+		q.keeper.cdc,
+		metadataStore,
+		req.Pagination,
+		func(key []byte, metadata *types.SignalMetadata) (*types.SignalMetadata, error) {
+			return metadata, nil
+		},
+		func() *types.SignalMetadata {
+			return &types.SignalMetadata{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	signalMetadata := make([]types.SignalMetadata, 0, len(filteredMetadata))
+	for _, metadata := range filteredMetadata {
+		signalMetadata = append(signalMetadata, *metadata)
+	}
+
+	return &types.QueryAllSignalMetadataResponse{
+		SignalMetadata: signalMetadata,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// CircuitBreakers queries the triggered circuit breakers of the signal ids.
+func (q queryServer) CircuitBreakers(
+	goCtx context.Context, req *types.QueryCircuitBreakersRequest,
+) (*types.QueryCircuitBreakersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(q.keeper.storeKey)
+	circuitBreakerStore := prefix.NewStore(store, types.CircuitBreakerStoreKeyPrefix)
+
+	filteredCircuitBreakers, pageRes, err := query.GenericFilteredPaginate(
+		q.keeper.cdc,
+		circuitBreakerStore,
+		req.Pagination,
+		func(key []byte, circuitBreaker *types.CircuitBreaker) (*types.CircuitBreaker, error) {
+			return circuitBreaker, nil
+		},
+		func() *types.CircuitBreaker {
+			return &types.CircuitBreaker{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	circuitBreakers := make([]types.CircuitBreaker, 0, len(filteredCircuitBreakers))
+	for _, circuitBreaker := range filteredCircuitBreakers {
+		circuitBreakers = append(circuitBreakers, *circuitBreaker)
+	}
+
+	return &types.QueryCircuitBreakersResponse{
+		CircuitBreakers: circuitBreakers,
+		Pagination:      pageRes,
+	}, nil
+}
+
 // SignalTotalPowers queries all current signal-total-powers.
 func (q queryServer) SignalTotalPowers(
 	goCtx context.Context, req *types.QuerySignalTotalPowersRequest,
@@ -228,6 +368,61 @@ func (q queryServer) ValidValidator(
 	return &types.QueryValidValidatorResponse{Valid: isValid}, nil
 }
 
+// ValidatorAccuracy queries the accuracy of a validator. A validator without any tracked submission
+// has a full accuracy score.
+func (q queryServer) ValidatorAccuracy(
+	goCtx context.Context, req *types.QueryValidatorAccuracyRequest,
+) (*types.QueryValidatorAccuracyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accuracy, err := q.keeper.GetValidatorAccuracy(ctx, val)
+	if err != nil {
+		accuracy = types.NewValidatorAccuracy(val, q.keeper.GetParams(ctx).AccuracyWindow)
+	}
+
+	return &types.QueryValidatorAccuracyResponse{ValidatorAccuracy: accuracy}, nil
+}
+
+// ValidatorAccuracies queries the accuracies of all tracked validators.
+func (q queryServer) ValidatorAccuracies(
+	goCtx context.Context, req *types.QueryValidatorAccuraciesRequest,
+) (*types.QueryValidatorAccuraciesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(q.keeper.storeKey)
+	accuracyStore := prefix.NewStore(store, types.ValidatorAccuracyStoreKeyPrefix)
+
+	filteredAccuracies, pageRes, err := query.GenericFilteredPaginate(
+		q.keeper.cdc,
+		accuracyStore,
+		req.Pagination,
+		func(key []byte, accuracy *types.ValidatorAccuracy) (*types.ValidatorAccuracy, error) {
+			return accuracy, nil
+		},
+		func() *types.ValidatorAccuracy {
+			return &types.ValidatorAccuracy{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	accuracies := make([]types.ValidatorAccuracy, 0, len(filteredAccuracies))
+	for _, accuracy := range filteredAccuracies {
+		accuracies = append(accuracies, *accuracy)
+	}
+
+	return &types.QueryValidatorAccuraciesResponse{
+		ValidatorAccuracies: accuracies,
+		Pagination:          pageRes,
+	}, nil
+}
+
 // ValidatorPrices queries all price-validator submitted by a validator.
 func (q queryServer) ValidatorPrices(
 	goCtx context.Context, req *types.QueryValidatorPricesRequest,
@@ -287,3 +482,57 @@ func (q queryServer) Vote(
 
 	return &types.QueryVoteResponse{Signals: signals}, nil
 }
+
+// Delegation queries the delegation of feeds voting power of a delegator.
+func (q queryServer) Delegation(
+	goCtx context.Context, req *types.QueryDelegationRequest,
+) (*types.QueryDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := q.keeper.GetDelegation(ctx, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationResponse{Delegation: delegation}, nil
+}
+
+// Delegations queries the delegations of feeds voting power to a delegatee.
+func (q queryServer) Delegations(
+	goCtx context.Context, req *types.QueryDelegationsRequest,
+) (*types.QueryDelegationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatee, err := sdk.AccAddressFromBech32(req.Delegatee)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(q.keeper.storeKey)
+	delegationStore := prefix.NewStore(store, types.DelegationsByDelegateeIndexKey(delegatee))
+
+	var delegations []types.Delegation
+	pageRes, err := query.Paginate(delegationStore, req.Pagination, func(_ []byte, value []byte) error {
+		delegation, err := q.keeper.GetDelegation(ctx, sdk.AccAddress(value))
+		if err != nil {
+			return err
+		}
+
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegationsResponse{
+		Delegations: delegations,
+		TotalPower:  q.keeper.GetDelegatedPower(ctx, delegatee),
+		Pagination:  pageRes,
+	}, nil
+}
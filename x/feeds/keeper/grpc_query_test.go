@@ -39,6 +39,44 @@ func (suite *KeeperTestSuite) TestQueryVote() {
 	}, res)
 }
 
+func (suite *KeeperTestSuite) TestQueryDelegations() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	// setup
+	_, err := suite.msgServer.DelegateVotingPower(
+		ctx,
+		types.NewMsgDelegateVotingPower(ValidVoter2.String(), ValidVoter.String(), 1e9),
+	)
+	suite.Require().NoError(err)
+	delegation := types.NewDelegation(ValidVoter2.String(), ValidVoter.String(), 1e9)
+
+	// query and check
+	res, err := queryClient.Delegation(context.Background(), &types.QueryDelegationRequest{
+		Delegator: ValidVoter2.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(delegation, res.Delegation)
+
+	_, err = queryClient.Delegation(context.Background(), &types.QueryDelegationRequest{
+		Delegator: ValidVoter.String(),
+	})
+	suite.Require().ErrorContains(err, "delegation not found")
+
+	res2, err := queryClient.Delegations(context.Background(), &types.QueryDelegationsRequest{
+		Delegatee: ValidVoter.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Delegation{delegation}, res2.Delegations)
+	suite.Require().Equal(int64(1e9), res2.TotalPower)
+
+	res2, err = queryClient.Delegations(context.Background(), &types.QueryDelegationsRequest{
+		Delegatee: ValidVoter2.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res2.Delegations)
+	suite.Require().Equal(int64(0), res2.TotalPower)
+}
+
 func (suite *KeeperTestSuite) TestQueryPrice() {
 	ctx, queryClient := suite.ctx, suite.queryClient
 
@@ -74,6 +112,46 @@ func (suite *KeeperTestSuite) TestQueryPrice() {
 	}, res)
 }
 
+func (suite *KeeperTestSuite) TestQueryPriceHistory() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	// setup
+	params := suite.feedsKeeper.GetParams(ctx)
+	prices := []types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 1000),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, 1060),
+	}
+	for _, price := range prices {
+		suite.feedsKeeper.AddPriceHistory(ctx, params, price)
+	}
+
+	// query and check
+	res, err := queryClient.PriceHistory(context.Background(), &types.QueryPriceHistoryRequest{
+		SignalId: "CS:BAND-USD",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(prices, res.Prices)
+
+	resAt, err := queryClient.PriceAt(context.Background(), &types.QueryPriceAtRequest{
+		SignalId:  "CS:BAND-USD",
+		Timestamp: 1059,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(prices[0], resAt.Price)
+
+	_, err = queryClient.PriceAt(context.Background(), &types.QueryPriceAtRequest{
+		SignalId:  "CS:ATOM-USD",
+		Timestamp: 1059,
+	})
+	suite.Require().Error(err)
+
+	_, err = queryClient.TWAP(context.Background(), &types.QueryTWAPRequest{
+		SignalId: "CS:BAND-USD",
+		Window:   0,
+	})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestQueryPrices() {
 	ctx, queryClient := suite.ctx, suite.queryClient
 
@@ -223,6 +301,67 @@ func (suite *KeeperTestSuite) TestQueryAllPrices() {
 	suite.Require().Nil(resNext.Pagination.NextKey)
 }
 
+func (suite *KeeperTestSuite) TestQuerySignalMetadata() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	// setup
+	signalMetadata := []types.SignalMetadata{
+		types.NewSignalMetadata("CS:ATOM-USD", 6, "ATOM", "USD", "crypto", "", types.SIGNAL_METADATA_STATUS_DEPRECATED, 0),
+		types.NewSignalMetadata("CS:BAND-USD", 9, "BAND", "USD", "crypto", "", types.SIGNAL_METADATA_STATUS_ACTIVE, 0),
+	}
+	suite.feedsKeeper.SetAllSignalMetadata(ctx, signalMetadata)
+
+	// query and check
+	res, err := queryClient.SignalMetadata(context.Background(), &types.QuerySignalMetadataRequest{
+		SignalId: "CS:BAND-USD",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(signalMetadata[1], res.SignalMetadata)
+
+	_, err = queryClient.SignalMetadata(context.Background(), &types.QuerySignalMetadataRequest{
+		SignalId: "CS:BTC-USD",
+	})
+	suite.Require().Error(err)
+
+	resAll, err := queryClient.AllSignalMetadata(context.Background(), &types.QueryAllSignalMetadataRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(signalMetadata, resAll.SignalMetadata)
+}
+
+func (suite *KeeperTestSuite) TestQueryValidatorAccuracy() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	// setup
+	params := suite.feedsKeeper.GetParams(ctx)
+	suite.feedsKeeper.UpdateValidatorAccuracy(ctx, params, ValidValidator, 4, 1)
+
+	// query and check
+	res, err := queryClient.ValidatorAccuracy(context.Background(), &types.QueryValidatorAccuracyRequest{
+		Validator: ValidValidator.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ValidatorAccuracy{
+		Validator:        ValidValidator.String(),
+		Window:           params.AccuracyWindow,
+		SubmissionCount:  1,
+		ReportCount:      4,
+		OutlierCount:     1,
+		AccuracyScoreBPS: 7500,
+	}, res.ValidatorAccuracy)
+
+	// a validator without any tracked submission has a full score
+	res, err = queryClient.ValidatorAccuracy(context.Background(), &types.QueryValidatorAccuracyRequest{
+		Validator: ValidValidator2.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewValidatorAccuracy(ValidValidator2, params.AccuracyWindow), res.ValidatorAccuracy)
+
+	resAll, err := queryClient.ValidatorAccuracies(context.Background(), &types.QueryValidatorAccuraciesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resAll.ValidatorAccuracies, 1)
+	suite.Require().Equal(ValidValidator.String(), resAll.ValidatorAccuracies[0].Validator)
+}
+
 func (suite *KeeperTestSuite) TestQueryValidatorPrices() {
 	ctx, queryClient := suite.ctx, suite.queryClient
 
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetValidatorAccuracy returns the accuracy of a validator.
+func (k Keeper) GetValidatorAccuracy(ctx sdk.Context, val sdk.ValAddress) (types.ValidatorAccuracy, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ValidatorAccuracyStoreKey(val))
+	if bz == nil {
+		return types.ValidatorAccuracy{}, types.ErrValidatorAccuracyNotFound.Wrapf(
+			"failed to get accuracy for validator: %s",
+			val.String(),
+		)
+	}
+
+	var accuracy types.ValidatorAccuracy
+	k.cdc.MustUnmarshal(bz, &accuracy)
+
+	return accuracy, nil
+}
+
+// GetValidatorAccuracies returns the accuracies of all tracked validators.
+func (k Keeper) GetValidatorAccuracies(ctx sdk.Context) (accuracies []types.ValidatorAccuracy) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorAccuracyStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var accuracy types.ValidatorAccuracy
+		k.cdc.MustUnmarshal(iterator.Value(), &accuracy)
+		accuracies = append(accuracies, accuracy)
+	}
+
+	return
+}
+
+// SetValidatorAccuracy sets the accuracy of a validator.
+func (k Keeper) SetValidatorAccuracy(ctx sdk.Context, val sdk.ValAddress, accuracy types.ValidatorAccuracy) {
+	ctx.KVStore(k.storeKey).Set(types.ValidatorAccuracyStoreKey(val), k.cdc.MustMarshal(&accuracy))
+}
+
+// DeleteValidatorAccuracy deletes the accuracy of a validator along with its accuracy records.
+func (k Keeper) DeleteValidatorAccuracy(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorAccuracyStoreKey(val))
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.AccuracyRecordsStoreKey(val))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getAccuracyRecord returns the accuracy record of a validator at an index of its window.
+func (k Keeper) getAccuracyRecord(ctx sdk.Context, val sdk.ValAddress, index uint64) types.AccuracyRecord {
+	var record types.AccuracyRecord
+	bz := ctx.KVStore(k.storeKey).Get(types.AccuracyRecordStoreKey(val, index))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &record)
+	}
+
+	return record
+}
+
+// setAccuracyRecord sets the accuracy record of a validator at an index of its window.
+func (k Keeper) setAccuracyRecord(ctx sdk.Context, val sdk.ValAddress, index uint64, record types.AccuracyRecord) {
+	ctx.KVStore(k.storeKey).Set(types.AccuracyRecordStoreKey(val, index), k.cdc.MustMarshal(&record))
+}
+
+// UpdateValidatorAccuracy records a submission of a validator with the given number of reported and
+// outlier prices in its rolling accuracy window, replacing the oldest submission once the window is
+// filled. A validator whose outlier ratio over a filled window exceeds max_outlier_basis_point is
+// deactivated and its accuracy is reset.
+func (k Keeper) UpdateValidatorAccuracy(
+	ctx sdk.Context,
+	params types.Params,
+	val sdk.ValAddress,
+	reportCount uint64,
+	outlierCount uint64,
+) {
+	if params.AccuracyWindow == 0 {
+		return
+	}
+
+	// restart the tracking if the window has changed since the last submission
+	accuracy, err := k.GetValidatorAccuracy(ctx, val)
+	if err != nil || accuracy.Window != params.AccuracyWindow {
+		k.DeleteValidatorAccuracy(ctx, val)
+		accuracy = types.NewValidatorAccuracy(val, params.AccuracyWindow)
+	}
+
+	index := accuracy.SubmissionCount % accuracy.Window
+	if accuracy.IsWindowFilled() {
+		oldest := k.getAccuracyRecord(ctx, val, index)
+		accuracy.ReportCount -= oldest.ReportCount
+		accuracy.OutlierCount -= oldest.OutlierCount
+	}
+	k.setAccuracyRecord(ctx, val, index, types.NewAccuracyRecord(reportCount, outlierCount))
+
+	accuracy.SubmissionCount++
+	accuracy.ReportCount += reportCount
+	accuracy.OutlierCount += outlierCount
+	accuracy.AccuracyScoreBPS = types.CalculateAccuracyScore(accuracy.ReportCount, accuracy.OutlierCount)
+
+	if params.MaxOutlierBasisPoint > 0 && accuracy.IsWindowFilled() &&
+		accuracy.OutlierBasisPoint() > uint64(params.MaxOutlierBasisPoint) {
+		k.oracleKeeper.MissReport(ctx, val, ctx.BlockTime())
+		k.DeleteValidatorAccuracy(ctx, val)
+		emitEventDeactivateOutlierValidator(ctx, accuracy)
+		return
+	}
+
+	k.SetValidatorAccuracy(ctx, val, accuracy)
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateValidatorAccuracy() {
+	ctx := suite.ctx
+
+	params := suite.feedsKeeper.GetParams(ctx)
+	params.AccuracyWindow = 3
+	params.MaxOutlierBasisPoint = 5000
+
+	for _, record := range []types.AccuracyRecord{
+		types.NewAccuracyRecord(2, 0),
+		types.NewAccuracyRecord(2, 1),
+		types.NewAccuracyRecord(2, 0),
+	} {
+		suite.feedsKeeper.UpdateValidatorAccuracy(ctx, params, ValidValidator, record.ReportCount, record.OutlierCount)
+	}
+
+	accuracy, err := suite.feedsKeeper.GetValidatorAccuracy(ctx, ValidValidator)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ValidatorAccuracy{
+		Validator:        ValidValidator.String(),
+		Window:           3,
+		SubmissionCount:  3,
+		ReportCount:      6,
+		OutlierCount:     1,
+		AccuracyScoreBPS: 8333,
+	}, accuracy)
+
+	// the oldest submission is replaced and the outlier ratio reaches but does not exceed the maximum
+	suite.feedsKeeper.UpdateValidatorAccuracy(ctx, params, ValidValidator, 2, 2)
+
+	accuracy, err = suite.feedsKeeper.GetValidatorAccuracy(ctx, ValidValidator)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(6), accuracy.ReportCount)
+	suite.Require().Equal(uint64(3), accuracy.OutlierCount)
+	suite.Require().Equal(uint64(5000), accuracy.AccuracyScoreBPS)
+
+	// the outlier ratio exceeds the maximum so the validator is deactivated and its accuracy is reset
+	suite.oracleKeeper.EXPECT().MissReport(gomock.Any(), ValidValidator, ctx.BlockTime()).Times(1)
+	suite.feedsKeeper.UpdateValidatorAccuracy(ctx, params, ValidValidator, 2, 2)
+
+	_, err = suite.feedsKeeper.GetValidatorAccuracy(ctx, ValidValidator)
+	suite.Require().ErrorIs(err, types.ErrValidatorAccuracyNotFound)
+	suite.Require().Empty(suite.feedsKeeper.GetValidatorAccuracies(ctx))
+}
+
+func (suite *KeeperTestSuite) TestUpdateValidatorAccuracyWindowChanged() {
+	ctx := suite.ctx
+
+	params := suite.feedsKeeper.GetParams(ctx)
+	params.AccuracyWindow = 2
+
+	suite.feedsKeeper.UpdateValidatorAccuracy(ctx, params, ValidValidator, 2, 1)
+	suite.feedsKeeper.UpdateValidatorAccuracy(ctx, params, ValidValidator, 2, 1)
+
+	// the tracking restarts with the new window
+	params.AccuracyWindow = 4
+	suite.feedsKeeper.UpdateValidatorAccuracy(ctx, params, ValidValidator, 1, 0)
+
+	accuracy, err := suite.feedsKeeper.GetValidatorAccuracy(ctx, ValidValidator)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ValidatorAccuracy{
+		Validator:        ValidValidator.String(),
+		Window:           4,
+		SubmissionCount:  1,
+		ReportCount:      1,
+		OutlierCount:     0,
+		AccuracyScoreBPS: 10000,
+	}, accuracy)
+
+	// the tracking is disabled
+	params.AccuracyWindow = 0
+	suite.feedsKeeper.UpdateValidatorAccuracy(ctx, params, ValidValidator2, 1, 1)
+
+	_, err = suite.feedsKeeper.GetValidatorAccuracy(ctx, ValidValidator2)
+	suite.Require().ErrorIs(err, types.ErrValidatorAccuracyNotFound)
+}
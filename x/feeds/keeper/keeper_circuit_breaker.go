@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetCircuitBreaker returns the triggered circuit breaker of a signal id.
+func (k Keeper) GetCircuitBreaker(ctx sdk.Context, signalID string) (types.CircuitBreaker, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CircuitBreakerStoreKey(signalID))
+	if bz == nil {
+		return types.CircuitBreaker{}, types.ErrCircuitBreakerNotFound.Wrapf(
+			"failed to get circuit breaker of signal id: %s",
+			signalID,
+		)
+	}
+
+	var circuitBreaker types.CircuitBreaker
+	k.cdc.MustUnmarshal(bz, &circuitBreaker)
+
+	return circuitBreaker, nil
+}
+
+// GetCircuitBreakers returns all triggered circuit breakers.
+func (k Keeper) GetCircuitBreakers(ctx sdk.Context) (circuitBreakers []types.CircuitBreaker) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.CircuitBreakerStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var circuitBreaker types.CircuitBreaker
+		k.cdc.MustUnmarshal(iterator.Value(), &circuitBreaker)
+		circuitBreakers = append(circuitBreakers, circuitBreaker)
+	}
+
+	return
+}
+
+// SetCircuitBreaker sets a triggered circuit breaker of a signal id.
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, circuitBreaker types.CircuitBreaker) {
+	ctx.KVStore(k.storeKey).Set(
+		types.CircuitBreakerStoreKey(circuitBreaker.SignalID),
+		k.cdc.MustMarshal(&circuitBreaker),
+	)
+}
+
+// SetCircuitBreakers sets multiple triggered circuit breakers.
+func (k Keeper) SetCircuitBreakers(ctx sdk.Context, circuitBreakers []types.CircuitBreaker) {
+	for _, circuitBreaker := range circuitBreakers {
+		k.SetCircuitBreaker(ctx, circuitBreaker)
+	}
+}
+
+// ResetCircuitBreaker removes the triggered circuit breaker of a signal id so that its next calculated
+// price is published without being compared with the price before the halt.
+func (k Keeper) ResetCircuitBreaker(ctx sdk.Context, signalID string) error {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.CircuitBreakerStoreKey(signalID)) {
+		return types.ErrCircuitBreakerNotFound.Wrapf(
+			"failed to get circuit breaker of signal id: %s",
+			signalID,
+		)
+	}
+
+	store.Delete(types.CircuitBreakerStoreKey(signalID))
+
+	return nil
+}
+
+// ApplyCircuitBreaker returns the given calculated price, or a halted price if the circuit breaker of
+// its signal id is triggered. The circuit breaker is triggered when an available price moves from the
+// last available price of the signal id by more than the max price move basis point of the signal id
+// in the signal registry, or max_price_move_basis_point if the signal id does not set one. A halted
+// price carries the last available price before the halt until the circuit breaker is reset.
+func (k Keeper) ApplyCircuitBreaker(ctx sdk.Context, params types.Params, price types.Price) types.Price {
+	if circuitBreaker, err := k.GetCircuitBreaker(ctx, price.SignalID); err == nil {
+		return types.NewPrice(types.PRICE_STATUS_HALTED, price.SignalID, circuitBreaker.ReferencePrice, price.Timestamp)
+	}
+
+	if price.Status != types.PRICE_STATUS_AVAILABLE {
+		return price
+	}
+
+	maxPriceMoveBasisPoint := params.MaxPriceMoveBasisPoint
+	if metadata, err := k.GetSignalMetadata(ctx, price.SignalID); err == nil {
+		maxPriceMoveBasisPoint = metadata.GetMaxPriceMoveBasisPointOrDefault(maxPriceMoveBasisPoint)
+	}
+
+	lastPrice, found := k.getLastAvailablePrice(ctx, price)
+	if !found || !types.IsPriceMoveExceeded(price.Price, lastPrice, maxPriceMoveBasisPoint) {
+		return price
+	}
+
+	circuitBreaker := types.NewCircuitBreaker(price.SignalID, lastPrice, price.Price, price.Timestamp)
+	k.SetCircuitBreaker(ctx, circuitBreaker)
+	emitEventTriggerCircuitBreaker(ctx, circuitBreaker)
+
+	return types.NewPrice(types.PRICE_STATUS_HALTED, price.SignalID, lastPrice, price.Timestamp)
+}
+
+// getLastAvailablePrice returns the last available price of the signal id of the given price, which is
+// the stored price if it is available or otherwise the latest historical price. The stored price is
+// halted only right after the circuit breaker is reset, in which case there is no price to compare with.
+func (k Keeper) getLastAvailablePrice(ctx sdk.Context, price types.Price) (uint64, bool) {
+	latestPrice := k.GetPrice(ctx, price.SignalID)
+	switch latestPrice.Status {
+	case types.PRICE_STATUS_AVAILABLE:
+		return latestPrice.Price, true
+	case types.PRICE_STATUS_HALTED:
+		return 0, false
+	}
+
+	historicalPrice, err := k.GetPriceAt(ctx, price.SignalID, price.Timestamp)
+	if err != nil {
+		return 0, false
+	}
+
+	return historicalPrice.Price, true
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestApplyCircuitBreaker() {
+	ctx := suite.ctx
+	params := suite.feedsKeeper.GetParams(ctx)
+	now := ctx.BlockTime().Unix()
+
+	// the circuit breaker is disabled by default
+	suite.feedsKeeper.SetPrice(ctx, types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now))
+	price := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1800, now)
+	suite.Require().Equal(price, suite.feedsKeeper.ApplyCircuitBreaker(ctx, params, price))
+
+	params.MaxPriceMoveBasisPoint = 2000
+
+	// a move within the limit is published
+	price = types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1200, now)
+	suite.Require().Equal(price, suite.feedsKeeper.ApplyCircuitBreaker(ctx, params, price))
+
+	// a larger move halts the price at the latest available price
+	price = types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1800, now)
+	halted := suite.feedsKeeper.ApplyCircuitBreaker(ctx, params, price)
+	suite.Require().Equal(types.NewPrice(types.PRICE_STATUS_HALTED, "CS:BAND-USD", 1000, now), halted)
+
+	circuitBreaker, err := suite.feedsKeeper.GetCircuitBreaker(ctx, "CS:BAND-USD")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewCircuitBreaker("CS:BAND-USD", 1000, 1800, now), circuitBreaker)
+
+	// the price stays halted even if it moves back
+	suite.feedsKeeper.SetPrice(ctx, halted)
+	price = types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now)
+	suite.Require().Equal(halted, suite.feedsKeeper.ApplyCircuitBreaker(ctx, params, price))
+	suite.Require().Equal(types.PRICE_STATUS_HALTED, suite.feedsKeeper.GetTWAPPrice(ctx, "CS:BAND-USD", 60).Status)
+
+	// the next price after the reset is published
+	suite.Require().NoError(suite.feedsKeeper.ResetCircuitBreaker(ctx, "CS:BAND-USD"))
+	price = types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1800, now)
+	suite.Require().Equal(price, suite.feedsKeeper.ApplyCircuitBreaker(ctx, params, price))
+	suite.Require().Empty(suite.feedsKeeper.GetCircuitBreakers(ctx))
+
+	err = suite.feedsKeeper.ResetCircuitBreaker(ctx, "CS:BAND-USD")
+	suite.Require().ErrorIs(err, types.ErrCircuitBreakerNotFound)
+}
+
+func (suite *KeeperTestSuite) TestApplyCircuitBreakerSignalMaxPriceMove() {
+	ctx := suite.ctx
+	params := suite.feedsKeeper.GetParams(ctx)
+	params.MaxPriceMoveBasisPoint = 2000
+	now := ctx.BlockTime().Unix()
+
+	suite.feedsKeeper.SetSignalMetadata(ctx, types.NewSignalMetadata(
+		"CS:BAND-USD", 9, "BAND", "USD", "crypto", "", types.SIGNAL_METADATA_STATUS_ACTIVE, 5000,
+	))
+	suite.feedsKeeper.SetPrice(ctx, types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now))
+	suite.feedsKeeper.SetPrice(ctx, types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 1000, now))
+
+	// the threshold of the signal id overrides the param
+	price := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1400, now)
+	suite.Require().Equal(price, suite.feedsKeeper.ApplyCircuitBreaker(ctx, params, price))
+
+	// a signal id without its own threshold falls back to the param
+	price = types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 1400, now)
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_HALTED, "CS:ETH-USD", 1000, now),
+		suite.feedsKeeper.ApplyCircuitBreaker(ctx, params, price),
+	)
+}
+
+func (suite *KeeperTestSuite) TestApplyCircuitBreakerAfterUnavailablePrice() {
+	ctx := suite.ctx
+	params := suite.feedsKeeper.GetParams(ctx)
+	params.MaxPriceMoveBasisPoint = 2000
+	now := ctx.BlockTime().Unix()
+
+	// the stored price is no longer available but the last available price is in the history
+	suite.feedsKeeper.AddPriceHistory(
+		ctx,
+		params,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-60),
+	)
+	suite.feedsKeeper.SetPrice(ctx, types.NewPrice(types.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, "CS:BAND-USD", 0, now))
+
+	price := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1800, now)
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_HALTED, "CS:BAND-USD", 1000, now),
+		suite.feedsKeeper.ApplyCircuitBreaker(ctx, params, price),
+	)
+}
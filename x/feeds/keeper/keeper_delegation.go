@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetDelegation returns the delegation of feeds voting power of a delegator.
+func (k Keeper) GetDelegation(ctx sdk.Context, delegator sdk.AccAddress) (types.Delegation, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DelegationStoreKey(delegator))
+	if bz == nil {
+		return types.Delegation{}, types.ErrDelegationNotFound.Wrapf(
+			"failed to get delegation of delegator: %s",
+			delegator.String(),
+		)
+	}
+
+	var d types.Delegation
+	k.cdc.MustUnmarshal(bz, &d)
+
+	return d, nil
+}
+
+// GetDelegations returns all delegations of feeds voting power.
+func (k Keeper) GetDelegations(ctx sdk.Context) (delegations []types.Delegation) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DelegationStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var d types.Delegation
+		k.cdc.MustUnmarshal(iterator.Value(), &d)
+		delegations = append(delegations, d)
+	}
+
+	return
+}
+
+// SetDelegation sets the delegation of a delegator and indexes it by its delegatee. It does not
+// update the delegated power of the delegatee.
+func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
+	delegator := sdk.MustAccAddressFromBech32(delegation.Delegator)
+	delegatee := sdk.MustAccAddressFromBech32(delegation.Delegatee)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DelegationStoreKey(delegator), k.cdc.MustMarshal(&delegation))
+	store.Set(types.DelegationByDelegateeIndexKey(delegatee, delegator), delegator)
+}
+
+// DeleteDelegation deletes the delegation of a delegator and its index. It does not update the
+// delegated power of the delegatee.
+func (k Keeper) DeleteDelegation(ctx sdk.Context, delegation types.Delegation) {
+	delegator := sdk.MustAccAddressFromBech32(delegation.Delegator)
+	delegatee := sdk.MustAccAddressFromBech32(delegation.Delegatee)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DelegationStoreKey(delegator))
+	store.Delete(types.DelegationByDelegateeIndexKey(delegatee, delegator))
+}
+
+// SetDelegations sets multiple delegations and the delegated powers of their delegatees.
+func (k Keeper) SetDelegations(ctx sdk.Context, delegations []types.Delegation) {
+	for _, d := range delegations {
+		delegatee := sdk.MustAccAddressFromBech32(d.Delegatee)
+
+		k.SetDelegation(ctx, d)
+		k.setDelegatedPower(ctx, delegatee, k.GetDelegatedPower(ctx, delegatee)+d.Power)
+	}
+}
+
+// GetDelegatedPower returns the total feeds voting power delegated to a delegatee.
+func (k Keeper) GetDelegatedPower(ctx sdk.Context, delegatee sdk.AccAddress) int64 {
+	return int64(sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.DelegatedPowerStoreKey(delegatee))))
+}
+
+// setDelegatedPower sets the total feeds voting power delegated to a delegatee.
+func (k Keeper) setDelegatedPower(ctx sdk.Context, delegatee sdk.AccAddress, power int64) {
+	if power == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.DelegatedPowerStoreKey(delegatee))
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.DelegatedPowerStoreKey(delegatee), sdk.Uint64ToBigEndian(uint64(power)))
+}
+
+// LockDelegatorPower locks the delegator's power equal to the sum of its own signal powers and the
+// given delegated power. It returns an error if the delegator does not have enough power to lock.
+func (k Keeper) LockDelegatorPower(ctx sdk.Context, delegator sdk.AccAddress, power int64) error {
+	sumPower := types.SumPower(k.GetVote(ctx, delegator)) + power
+	return k.restakeKeeper.SetLockedPower(ctx, delegator, types.ModuleName, math.NewInt(sumPower))
+}
+
+// UpdateDelegationAndReturnPowerDiff replaces the previous delegation of a delegator with a delegation
+// of the given power to the given delegatee, or deletes it if the power is zero. It returns the feed
+// power differences from the signals of the previous and new delegatees.
+func (k Keeper) UpdateDelegationAndReturnPowerDiff(
+	ctx sdk.Context,
+	delegator sdk.AccAddress,
+	delegatee sdk.AccAddress,
+	power int64,
+) map[string]int64 {
+	signalIDToPowerDiff := make(map[string]int64)
+
+	if prevDelegation, err := k.GetDelegation(ctx, delegator); err == nil {
+		k.DeleteDelegation(ctx, prevDelegation)
+		prevDelegatee := sdk.MustAccAddressFromBech32(prevDelegation.Delegatee)
+		k.updateDelegatedPower(ctx, prevDelegatee, -prevDelegation.Power, signalIDToPowerDiff)
+	}
+
+	if power > 0 {
+		k.SetDelegation(ctx, types.NewDelegation(delegator.String(), delegatee.String(), power))
+		k.updateDelegatedPower(ctx, delegatee, power, signalIDToPowerDiff)
+	}
+
+	return signalIDToPowerDiff
+}
+
+// updateDelegatedPower adds the given power difference to the delegated power of a delegatee and
+// records the resulting differences of the delegated power distributed to the delegatee's signals.
+func (k Keeper) updateDelegatedPower(
+	ctx sdk.Context,
+	delegatee sdk.AccAddress,
+	powerDiff int64,
+	signalIDToPowerDiff map[string]int64,
+) {
+	signals := k.GetVote(ctx, delegatee)
+	prevPower := k.GetDelegatedPower(ctx, delegatee)
+	newPower := prevPower + powerDiff
+
+	for _, signal := range types.DistributePower(signals, prevPower) {
+		signalIDToPowerDiff[signal.ID] -= signal.Power
+	}
+
+	k.setDelegatedPower(ctx, delegatee, newPower)
+
+	for _, signal := range types.DistributePower(signals, newPower) {
+		signalIDToPowerDiff[signal.ID] += signal.Power
+	}
+}
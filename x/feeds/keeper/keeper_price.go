@@ -176,6 +176,10 @@ func (k Keeper) CalculatePrices(ctx sdk.Context) error {
 		return err
 	}
 	powerQuorum := totalBondedToken.Mul(priceQuorum).TruncateInt()
+
+	// accuracy records of the validators that submitted prices in this block
+	accuracyRecords := make(map[string]types.AccuracyRecord)
+
 	// calculate prices for each feed
 	for _, feed := range currentFeeds.Feeds {
 		var validatorPriceInfos []types.ValidatorPriceInfo
@@ -212,27 +216,74 @@ func (k Keeper) CalculatePrices(ctx sdk.Context) error {
 		}
 
 		// calculate the final price for the feed
-		price, err := k.CalculatePrice(ctx, feed, validatorPriceInfos, powerQuorum)
+		aggregationMethod := params.GetSignalAggregationMethod(feed.SignalID)
+		price, err := k.CalculatePrice(ctx, feed, validatorPriceInfos, powerQuorum, aggregationMethod)
 		if err != nil {
 			return err
 		}
 
-		// set the calculated price in the store
+		// halt the price if it moves too far from the latest available price
+		price = k.ApplyCircuitBreaker(ctx, params, price)
+
+		// record how many validators and how much power contributed to the price and how much their prices agree
+		if price.Status == types.PRICE_STATUS_AVAILABLE {
+			price.Confidence = types.CalculatePriceConfidence(validatorPriceInfos, tbt)
+			tallyAccuracyRecords(accuracyRecords, allValidatorPrices, price, ctx.BlockHeight(), params)
+		}
+
+		// set the calculated price in the store and record it in the price history
 		k.SetPrice(ctx, price)
+		k.AddPriceHistory(ctx, params, price)
 
 		// emit event for updated price
 		emitEventUpdatePrice(ctx, price)
 	}
 
+	// update the accuracy of the validators in order of power so that the result is deterministic
+	for _, valInfo := range validatorsByPower {
+		record, ok := accuracyRecords[valInfo.Address.String()]
+		if !ok {
+			continue
+		}
+
+		k.UpdateValidatorAccuracy(ctx, params, valInfo.Address, record.ReportCount, record.OutlierCount)
+	}
+
 	return nil
 }
 
+// tallyAccuracyRecords counts the available prices submitted in the given block height for the signal of
+// the final price, and the ones among them that deviate from it by more than outlier_deviation_basis_point.
+func tallyAccuracyRecords(
+	accuracyRecords map[string]types.AccuracyRecord,
+	allValidatorPrices map[string]map[string]types.ValidatorPrice,
+	price types.Price,
+	blockHeight int64,
+	params types.Params,
+) {
+	for val, valPrices := range allValidatorPrices {
+		valPrice, ok := valPrices[price.SignalID]
+		if !ok || valPrice.BlockHeight != blockHeight ||
+			valPrice.SignalPriceStatus != types.SIGNAL_PRICE_STATUS_AVAILABLE {
+			continue
+		}
+
+		record := accuracyRecords[val]
+		record.ReportCount++
+		if types.IsOutlierPrice(valPrice.Price, price.Price, params.OutlierDeviationBasisPoint) {
+			record.OutlierCount++
+		}
+		accuracyRecords[val] = record
+	}
+}
+
 // CalculatePrice calculates the final price from validator prices and punishes validators who did not report.
 func (k Keeper) CalculatePrice(
 	ctx sdk.Context,
 	feed types.Feed,
 	validatorPriceInfos []types.ValidatorPriceInfo,
 	powerQuorum sdkmath.Int,
+	aggregationMethod types.SignalAggregationMethod,
 ) (types.Price, error) {
 	totalPower, availablePower, _, unsupportedPower := types.CalculatePricesPowers(validatorPriceInfos)
 
@@ -258,18 +309,38 @@ func (k Keeper) CalculatePrice(
 		), nil
 	}
 
-	price, err := types.MedianValidatorPriceInfos(validatorPriceInfos)
+	// If fewer validators than the minimum reporters of the signal report an available price,
+	// it also returns a price not ready price status.
+	if types.CountReporters(validatorPriceInfos) < aggregationMethod.MinReporters {
+		return types.NewPrice(
+			types.PRICE_STATUS_NOT_READY,
+			feed.SignalID,
+			0,
+			ctx.BlockTime().Unix(),
+		), nil
+	}
+
+	aggregator, err := types.GetAggregator(aggregationMethod.Method)
+	if err != nil {
+		// should not happen as the aggregation methods are validated in params
+		return types.Price{}, err
+	}
+
+	price, err := aggregator(validatorPriceInfos)
 	if err != nil {
 		// should not happen
 		return types.Price{}, err
 	}
 
-	return types.NewPrice(
+	aggregatedPrice := types.NewPrice(
 		types.PRICE_STATUS_AVAILABLE,
 		feed.SignalID,
 		price,
 		ctx.BlockTime().Unix(),
-	), nil
+	)
+	aggregatedPrice.AggregationMethod = aggregationMethod.Method
+
+	return aggregatedPrice, nil
 }
 
 // CheckMissReport checks if a validator has missed a report based on the given parameters.
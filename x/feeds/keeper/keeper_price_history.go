@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetPriceHistoryCount returns the number of historical prices of a signal.
+func (k Keeper) GetPriceHistoryCount(ctx sdk.Context, signalID string) uint64 {
+	return sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.PriceHistoryCountStoreKey(signalID)))
+}
+
+// setPriceHistoryCount sets the number of historical prices of a signal.
+func (k Keeper) setPriceHistoryCount(ctx sdk.Context, signalID string, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.PriceHistoryCountStoreKey(signalID), sdk.Uint64ToBigEndian(count))
+}
+
+// GetPriceHistory returns all historical prices of a signal ordered by timestamp.
+func (k Keeper) GetPriceHistory(ctx sdk.Context, signalID string) (prices []types.Price) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PriceHistoryStoreKey(signalID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.Price
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+
+	return
+}
+
+// GetPriceAt returns the latest historical price of a signal at or before the given timestamp.
+func (k Keeper) GetPriceAt(ctx sdk.Context, signalID string, timestamp int64) (types.Price, error) {
+	iterator := ctx.KVStore(k.storeKey).ReverseIterator(
+		types.PriceHistoryStoreKey(signalID),
+		types.PriceHistoryTimestampStoreKey(signalID, timestamp+1),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.Price{}, types.ErrPriceHistoryNotFound.Wrapf(
+			"failed to get price of signal id %s at %d",
+			signalID,
+			timestamp,
+		)
+	}
+
+	var price types.Price
+	k.cdc.MustUnmarshal(iterator.Value(), &price)
+
+	return price, nil
+}
+
+// AddPriceHistory records an available price in the history of its signal if the price history
+// interval has passed since the latest historical price, and removes the oldest historical prices
+// of the signal beyond the price history size. The cumulative price of the signal is recorded along
+// with the price so that TWAPs can be computed without scanning the history.
+func (k Keeper) AddPriceHistory(ctx sdk.Context, params types.Params, price types.Price) {
+	if params.PriceHistorySize == 0 || price.Status != types.PRICE_STATUS_AVAILABLE {
+		return
+	}
+
+	cumulativePrice := math.ZeroInt()
+	latest, err := k.GetPriceAt(ctx, price.SignalID, price.Timestamp)
+	if err == nil {
+		if latest.Timestamp == price.Timestamp || latest.Timestamp+params.PriceHistoryInterval > price.Timestamp {
+			return
+		}
+		cumulativePrice = k.getCumulativePriceAt(ctx, latest, price.Timestamp)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PriceHistoryTimestampStoreKey(price.SignalID, price.Timestamp), k.cdc.MustMarshal(&price))
+	k.setCumulativePrice(ctx, price.SignalID, price.Timestamp, cumulativePrice)
+	count := k.GetPriceHistoryCount(ctx, price.SignalID) + 1
+
+	// remove the oldest prices to keep the history bounded
+	if count > params.PriceHistorySize {
+		iterator := storetypes.KVStorePrefixIterator(store, types.PriceHistoryStoreKey(price.SignalID))
+		defer iterator.Close()
+
+		for ; iterator.Valid() && count > params.PriceHistorySize; iterator.Next() {
+			key := iterator.Key()
+			timestamp := int64(sdk.BigEndianToUint64(key[len(key)-8:]))
+			store.Delete(key)
+			store.Delete(types.CumulativePriceStoreKey(price.SignalID, timestamp))
+			count--
+		}
+	}
+
+	k.setPriceHistoryCount(ctx, price.SignalID, count)
+}
+
+// setCumulativePrice sets the cumulative price of a signal at the timestamp of a historical price.
+func (k Keeper) setCumulativePrice(ctx sdk.Context, signalID string, timestamp int64, cumulativePrice math.Int) {
+	bz, err := cumulativePrice.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.CumulativePriceStoreKey(signalID, timestamp), bz)
+}
+
+// getCumulativePrice returns the cumulative price of a signal at the timestamp of a historical price.
+func (k Keeper) getCumulativePrice(ctx sdk.Context, signalID string, timestamp int64) math.Int {
+	cumulativePrice := math.ZeroInt()
+	bz := ctx.KVStore(k.storeKey).Get(types.CumulativePriceStoreKey(signalID, timestamp))
+	if bz == nil {
+		return cumulativePrice
+	}
+	if err := cumulativePrice.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return cumulativePrice
+}
+
+// getCumulativePriceAt returns the cumulative price of a signal at the given timestamp, which is at
+// or after the given historical price and before the next one.
+func (k Keeper) getCumulativePriceAt(ctx sdk.Context, price types.Price, timestamp int64) math.Int {
+	cumulativePrice := k.getCumulativePrice(ctx, price.SignalID, price.Timestamp)
+	return cumulativePrice.Add(math.NewIntFromUint64(price.Price).MulRaw(timestamp - price.Timestamp))
+}
+
+// getFirstPriceHistoryAfter returns the earliest historical price of a signal after the given
+// timestamp and at or before the end timestamp.
+func (k Keeper) getFirstPriceHistoryAfter(
+	ctx sdk.Context,
+	signalID string,
+	timestamp int64,
+	endTime int64,
+) (types.Price, bool) {
+	iterator := ctx.KVStore(k.storeKey).Iterator(
+		types.PriceHistoryTimestampStoreKey(signalID, timestamp+1),
+		types.PriceHistoryTimestampStoreKey(signalID, endTime+1),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.Price{}, false
+	}
+
+	var price types.Price
+	k.cdc.MustUnmarshal(iterator.Value(), &price)
+	return price, true
+}
+
+// GetTWAPPrice returns the time-weighted average price of a signal over the given window up to the
+// current block time, computed from the cumulative prices at both ends of the window. The window starts
+// at the first historical price if the history is shorter than the window. The price is not ready if
+// the signal has no historical price in the window, and halted while the circuit breaker of the signal
+// is triggered.
+func (k Keeper) GetTWAPPrice(ctx sdk.Context, signalID string, window int64) types.Price {
+	endTime := ctx.BlockTime().Unix()
+	if _, err := k.GetCircuitBreaker(ctx, signalID); err == nil {
+		return types.NewPrice(types.PRICE_STATUS_HALTED, signalID, 0, endTime)
+	}
+	if window < 0 {
+		return types.NewPrice(types.PRICE_STATUS_NOT_READY, signalID, 0, endTime)
+	}
+	startTime := max(endTime-window, 0)
+
+	// the price at the start of the window is the latest price before it
+	startPrice, err := k.GetPriceAt(ctx, signalID, startTime)
+	if err != nil {
+		var found bool
+		startPrice, found = k.getFirstPriceHistoryAfter(ctx, signalID, startTime, endTime)
+		if !found {
+			return types.NewPrice(types.PRICE_STATUS_NOT_READY, signalID, 0, endTime)
+		}
+		startTime = startPrice.Timestamp
+	}
+
+	endPrice, err := k.GetPriceAt(ctx, signalID, endTime)
+	if err != nil {
+		return types.NewPrice(types.PRICE_STATUS_NOT_READY, signalID, 0, endTime)
+	}
+
+	if endTime == startTime {
+		return types.NewPrice(types.PRICE_STATUS_AVAILABLE, signalID, endPrice.Price, endPrice.Timestamp)
+	}
+
+	twap := k.getCumulativePriceAt(ctx, endPrice, endTime).
+		Sub(k.getCumulativePriceAt(ctx, startPrice, startTime)).
+		QuoRaw(endTime - startTime)
+
+	return types.NewPrice(types.PRICE_STATUS_AVAILABLE, signalID, twap.Uint64(), endPrice.Timestamp)
+}
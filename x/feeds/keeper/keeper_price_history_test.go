@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestAddPriceHistory() {
+	ctx := suite.ctx
+
+	params := suite.feedsKeeper.GetParams(ctx)
+	params.PriceHistorySize = 3
+	params.PriceHistoryInterval = 60
+
+	for _, ts := range []int64{1000, 1030, 1060, 1120, 1180} {
+		suite.feedsKeeper.AddPriceHistory(
+			ctx,
+			params,
+			types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", uint64(ts), ts),
+		)
+	}
+
+	// unavailable prices are not recorded
+	suite.feedsKeeper.AddPriceHistory(
+		ctx,
+		params,
+		types.NewPrice(types.PRICE_STATUS_NOT_READY, "CS:BAND-USD", 0, 1300),
+	)
+
+	// the price at 1030 is within the interval and the price at 1000 is removed by the size limit
+	suite.Require().Equal([]types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1060, 1060),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1120, 1120),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1180, 1180),
+	}, suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD"))
+	suite.Require().Equal(uint64(3), suite.feedsKeeper.GetPriceHistoryCount(ctx, "CS:BAND-USD"))
+
+	// the price history is disabled
+	params.PriceHistorySize = 0
+	suite.feedsKeeper.AddPriceHistory(
+		ctx,
+		params,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:ATOM-USD", 1, 1000),
+	)
+	suite.Require().Empty(suite.feedsKeeper.GetPriceHistory(ctx, "CS:ATOM-USD"))
+}
+
+func (suite *KeeperTestSuite) TestGetPriceAt() {
+	ctx := suite.ctx
+
+	params := suite.feedsKeeper.GetParams(ctx)
+	for _, ts := range []int64{1000, 1060} {
+		suite.feedsKeeper.AddPriceHistory(
+			ctx,
+			params,
+			types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", uint64(ts), ts),
+		)
+	}
+
+	price, err := suite.feedsKeeper.GetPriceAt(ctx, "CS:BAND-USD", 1059)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1000), price.Timestamp)
+
+	price, err = suite.feedsKeeper.GetPriceAt(ctx, "CS:BAND-USD", 1060)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1060), price.Timestamp)
+
+	_, err = suite.feedsKeeper.GetPriceAt(ctx, "CS:BAND-USD", 999)
+	suite.Require().ErrorIs(err, types.ErrPriceHistoryNotFound)
+}
+
+func (suite *KeeperTestSuite) TestGetTWAPPrice() {
+	ctx := suite.ctx.WithBlockTime(time.Unix(1200, 0))
+
+	params := suite.feedsKeeper.GetParams(ctx)
+	params.PriceHistoryInterval = 50
+	for _, ts := range []int64{1000, 1100, 1150} {
+		suite.feedsKeeper.AddPriceHistory(
+			ctx,
+			params,
+			types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", uint64(ts), ts),
+		)
+	}
+
+	// (1000*50 + 1100*50 + 1150*50) / 150
+	price := suite.feedsKeeper.GetTWAPPrice(ctx, "CS:BAND-USD", 150)
+	suite.Require().Equal(types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1083, 1150), price)
+
+	// the window starts at the first historical price: (1000*100 + 1100*50 + 1150*50) / 200
+	price = suite.feedsKeeper.GetTWAPPrice(ctx, "CS:BAND-USD", 1000)
+	suite.Require().Equal(types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1062, 1150), price)
+
+	price = suite.feedsKeeper.GetTWAPPrice(ctx, "CS:ATOM-USD", 150)
+	suite.Require().Equal(types.PRICE_STATUS_NOT_READY, price.Status)
+
+	// the cumulative prices stay valid after the oldest prices are removed: (1150*50 + 1250*50) / 100
+	params.PriceHistorySize = 2
+	ctx = ctx.WithBlockTime(time.Unix(1300, 0))
+	suite.feedsKeeper.AddPriceHistory(
+		ctx,
+		params,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1250, 1250),
+	)
+	suite.Require().Len(suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD"), 2)
+
+	price = suite.feedsKeeper.GetTWAPPrice(ctx, "CS:BAND-USD", 100)
+	suite.Require().Equal(types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1200, 1250), price)
+}
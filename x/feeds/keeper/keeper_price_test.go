@@ -110,10 +110,11 @@ func (suite *KeeperTestSuite) TestCalculatePrices() {
 	ctx := suite.ctx
 
 	tests := []struct {
-		name           string
-		setup          func()
-		expectError    bool
-		expectedPrices []types.Price
+		name               string
+		setup              func()
+		expectError        bool
+		expectedPrices     []types.Price
+		expectedAccuracies []types.ValidatorAccuracy
 	}{
 		{
 			name: "normal case with valid prices",
@@ -169,10 +170,31 @@ func (suite *KeeperTestSuite) TestCalculatePrices() {
 			expectError: false,
 			expectedPrices: []types.Price{
 				{
-					Status:    types.PRICE_STATUS_AVAILABLE,
-					SignalID:  "CS:BAND-USD",
-					Price:     1000,
-					Timestamp: ctx.BlockTime().Unix(),
+					Status:            types.PRICE_STATUS_AVAILABLE,
+					SignalID:          "CS:BAND-USD",
+					Price:             1000,
+					Timestamp:         ctx.BlockTime().Unix(),
+					AggregationMethod: types.AGGREGATION_METHOD_WEIGHTED_MEDIAN,
+					Confidence:        types.NewPriceConfidence(2, 7272, 1000),
+				},
+			},
+			// the price of the second validator deviates by 100% from the final price
+			expectedAccuracies: []types.ValidatorAccuracy{
+				{
+					Validator:        ValidValidator.String(),
+					Window:           types.DefaultAccuracyWindow,
+					SubmissionCount:  1,
+					ReportCount:      1,
+					OutlierCount:     0,
+					AccuracyScoreBPS: 10000,
+				},
+				{
+					Validator:        ValidValidator2.String(),
+					Window:           types.DefaultAccuracyWindow,
+					SubmissionCount:  1,
+					ReportCount:      1,
+					OutlierCount:     1,
+					AccuracyScoreBPS: 0,
 				},
 			},
 		},
@@ -219,6 +241,13 @@ func (suite *KeeperTestSuite) TestCalculatePrices() {
 					price := suite.feedsKeeper.GetPrice(ctx, expectedPrice.SignalID)
 					suite.Require().Equal(expectedPrice, price)
 				}
+				for _, expectedAccuracy := range tt.expectedAccuracies {
+					val, err := sdk.ValAddressFromBech32(expectedAccuracy.Validator)
+					suite.Require().NoError(err)
+					accuracy, err := suite.feedsKeeper.GetValidatorAccuracy(ctx, val)
+					suite.Require().NoError(err)
+					suite.Require().Equal(expectedAccuracy, accuracy)
+				}
 			}
 		})
 	}
@@ -237,6 +266,7 @@ func (suite *KeeperTestSuite) TestCalculatePrice() {
 		name                string
 		validatorPriceInfos []types.ValidatorPriceInfo
 		powerQuorum         sdkmath.Int
+		aggregationMethod   types.SignalAggregationMethod
 		expectedPrice       types.Price
 		expectError         bool
 	}{
@@ -326,10 +356,128 @@ func (suite *KeeperTestSuite) TestCalculatePrice() {
 			},
 			powerQuorum: sdkmath.NewInt(7000),
 			expectedPrice: types.Price{
-				Status:    types.PRICE_STATUS_AVAILABLE,
-				SignalID:  "CS:BAND-USD",
-				Price:     1000,
-				Timestamp: ctx.BlockTime().Unix(),
+				Status:            types.PRICE_STATUS_AVAILABLE,
+				SignalID:          "CS:BAND-USD",
+				Price:             1000,
+				Timestamp:         ctx.BlockTime().Unix(),
+				AggregationMethod: types.AGGREGATION_METHOD_WEIGHTED_MEDIAN,
+			},
+			expectError: false,
+		},
+		{
+			name: "trimmed mean",
+			validatorPriceInfos: []types.ValidatorPriceInfo{
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(3000),
+					Price:             1000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(3000),
+					Price:             2000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(3000),
+					Price:             3000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(1000),
+					Price:             10000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+			},
+			powerQuorum:       sdkmath.NewInt(7000),
+			aggregationMethod: types.NewSignalAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_TRIMMED_MEAN, 0),
+			expectedPrice: types.Price{
+				Status:            types.PRICE_STATUS_AVAILABLE,
+				SignalID:          "CS:BAND-USD",
+				Price:             2200,
+				Timestamp:         ctx.BlockTime().Unix(),
+				AggregationMethod: types.AGGREGATION_METHOD_TRIMMED_MEAN,
+			},
+			expectError: false,
+		},
+		{
+			name: "power-weighted median",
+			validatorPriceInfos: []types.ValidatorPriceInfo{
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(3000),
+					Price:             1000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(3000),
+					Price:             2000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(3000),
+					Price:             3000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(1000),
+					Price:             10000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+			},
+			powerQuorum:       sdkmath.NewInt(7000),
+			aggregationMethod: types.NewSignalAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_MEDIAN, 4),
+			expectedPrice: types.Price{
+				Status:            types.PRICE_STATUS_AVAILABLE,
+				SignalID:          "CS:BAND-USD",
+				Price:             2000,
+				Timestamp:         ctx.BlockTime().Unix(),
+				AggregationMethod: types.AGGREGATION_METHOD_MEDIAN,
+			},
+			expectError: false,
+		},
+		{
+			name: "fewer reporters than minimum",
+			validatorPriceInfos: []types.ValidatorPriceInfo{
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(3000),
+					Price:             1000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(3000),
+					Price:             2000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(3000),
+					Price:             3000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+				{
+					SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+					Power:             sdkmath.NewInt(1000),
+					Price:             10000,
+					Timestamp:         ctx.BlockTime().Unix(),
+				},
+			},
+			powerQuorum:       sdkmath.NewInt(7000),
+			aggregationMethod: types.NewSignalAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_MEDIAN, 5),
+			expectedPrice: types.Price{
+				Status:            types.PRICE_STATUS_NOT_READY,
+				SignalID:          "CS:BAND-USD",
+				Price:             0,
+				Timestamp:         ctx.BlockTime().Unix(),
+				AggregationMethod: types.AGGREGATION_METHOD_UNSPECIFIED,
 			},
 			expectError: false,
 		},
@@ -349,7 +497,18 @@ func (suite *KeeperTestSuite) TestCalculatePrice() {
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			price, err := suite.feedsKeeper.CalculatePrice(ctx, feed, tt.validatorPriceInfos, tt.powerQuorum)
+			aggregationMethod := tt.aggregationMethod
+			if aggregationMethod.Method == types.AGGREGATION_METHOD_UNSPECIFIED {
+				aggregationMethod = types.NewSignalAggregationMethod(feed.SignalID, types.DefaultAggregationMethod, 0)
+			}
+
+			price, err := suite.feedsKeeper.CalculatePrice(
+				ctx,
+				feed,
+				tt.validatorPriceInfos,
+				tt.powerQuorum,
+				aggregationMethod,
+			)
 			if tt.expectError {
 				suite.Require().Error(err)
 			} else {
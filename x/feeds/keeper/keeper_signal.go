@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/math"
@@ -149,7 +151,8 @@ func (k Keeper) SignalTotalPowersByPowerStoreIterator(ctx sdk.Context) dbm.Itera
 	)
 }
 
-// LockVoterPower locks the voter's power equal to the sum of the signal powers.
+// LockVoterPower locks the voter's power equal to the sum of the signal powers and the voting power
+// that the voter delegates to another address.
 // It returns an error if the voter does not have enough power to lock.
 func (k Keeper) LockVoterPower(
 	ctx sdk.Context,
@@ -157,6 +160,10 @@ func (k Keeper) LockVoterPower(
 	signals []types.Signal,
 ) error {
 	sumPower := types.SumPower(signals)
+	if delegation, err := k.GetDelegation(ctx, voter); err == nil {
+		sumPower += delegation.Power
+	}
+
 	if err := k.restakeKeeper.SetLockedPower(ctx, voter, types.ModuleName, math.NewInt(sumPower)); err != nil {
 		return err
 	}
@@ -165,13 +172,15 @@ func (k Keeper) LockVoterPower(
 }
 
 // UpdateVoteAndReturnPowerDiff delete previous signals and add new signals.
-// It also calculates feed power differences from voter's previous signals and new signals.
+// It also calculates feed power differences from voter's previous signals and new signals, including
+// the voting power delegated to the voter that is distributed to its signals.
 func (k Keeper) UpdateVoteAndReturnPowerDiff(
 	ctx sdk.Context,
 	voter sdk.AccAddress,
 	signals []types.Signal,
 ) map[string]int64 {
 	signalIDToPowerDiff := make(map[string]int64)
+	delegatedPower := k.GetDelegatedPower(ctx, voter)
 
 	prevSignals := k.GetVote(ctx, voter)
 	k.DeleteVote(ctx, voter)
@@ -179,12 +188,61 @@ func (k Keeper) UpdateVoteAndReturnPowerDiff(
 	for _, prevSignal := range prevSignals {
 		signalIDToPowerDiff[prevSignal.ID] -= prevSignal.Power
 	}
+	for _, prevSignal := range types.DistributePower(prevSignals, delegatedPower) {
+		signalIDToPowerDiff[prevSignal.ID] -= prevSignal.Power
+	}
 
 	k.SetVote(ctx, types.NewVote(voter.String(), signals))
 
 	for _, signal := range signals {
 		signalIDToPowerDiff[signal.ID] += signal.Power
 	}
+	for _, signal := range types.DistributePower(signals, delegatedPower) {
+		signalIDToPowerDiff[signal.ID] += signal.Power
+	}
 
 	return signalIDToPowerDiff
 }
+
+// UpdateSignalTotalPowers adds the given feed power differences to the signal-total-powers.
+// It returns an error if the total power of a signal becomes negative.
+func (k Keeper) UpdateSignalTotalPowers(ctx sdk.Context, signalIDToPowerDiff map[string]int64) error {
+	// sort keys to guarantee order of signalIDToPowerDiff iteration
+	keys := make([]string, 0, len(signalIDToPowerDiff))
+	for k := range signalIDToPowerDiff {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// iterate over each signal ID, updating the total power and ensuring no negative power values
+	for _, signalID := range keys {
+		// get the power difference for the current signal ID
+		powerDiff := signalIDToPowerDiff[signalID]
+		if powerDiff == 0 {
+			continue
+		}
+
+		// retrieve the total power of the current signal ID from the store
+		signalTotalPower, err := k.GetSignalTotalPower(ctx, signalID)
+		if err != nil {
+			// initialize a new signal with zero power if the signal ID does not exist
+			signalTotalPower = types.NewSignal(
+				signalID,
+				0,
+			)
+		}
+
+		// update the total power of the signal by adding the power difference
+		signalTotalPower.Power += powerDiff
+
+		// ensure the total power of the signal is not negative
+		if signalTotalPower.Power < 0 {
+			return types.ErrPowerNegative
+		}
+
+		// save the updated signal total power back to the store
+		k.SetSignalTotalPower(ctx, signalTotalPower)
+	}
+
+	return nil
+}
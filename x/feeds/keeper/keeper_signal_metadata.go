@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetSignalMetadata returns the metadata of a signal id in the signal registry.
+func (k Keeper) GetSignalMetadata(ctx sdk.Context, signalID string) (types.SignalMetadata, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SignalMetadataStoreKey(signalID))
+	if bz == nil {
+		return types.SignalMetadata{}, types.ErrSignalMetadataNotFound.Wrapf(
+			"failed to get metadata of signal id: %s",
+			signalID,
+		)
+	}
+
+	var metadata types.SignalMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+
+	return metadata, nil
+}
+
+// GetAllSignalMetadata returns the metadata of all signal ids in the signal registry.
+func (k Keeper) GetAllSignalMetadata(ctx sdk.Context) (signalMetadata []types.SignalMetadata) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SignalMetadataStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.SignalMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+		signalMetadata = append(signalMetadata, metadata)
+	}
+
+	return
+}
+
+// SetSignalMetadata sets the metadata of a signal id in the signal registry.
+func (k Keeper) SetSignalMetadata(ctx sdk.Context, metadata types.SignalMetadata) {
+	ctx.KVStore(k.storeKey).Set(types.SignalMetadataStoreKey(metadata.SignalID), k.cdc.MustMarshal(&metadata))
+}
+
+// SetAllSignalMetadata sets the metadata of multiple signal ids in the signal registry.
+func (k Keeper) SetAllSignalMetadata(ctx sdk.Context, signalMetadata []types.SignalMetadata) {
+	for _, metadata := range signalMetadata {
+		k.SetSignalMetadata(ctx, metadata)
+	}
+}
+
+// IsSignalRegistered returns true if the signal id is active in the signal registry.
+func (k Keeper) IsSignalRegistered(ctx sdk.Context, signalID string) bool {
+	metadata, err := k.GetSignalMetadata(ctx, signalID)
+	return err == nil && metadata.IsActive()
+}
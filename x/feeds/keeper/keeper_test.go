@@ -112,6 +112,15 @@ func (suite *KeeperTestSuite) SetupTest() {
 			return nil
 		}).
 		AnyTimes()
+	restakeKeeper.EXPECT().
+		SetLockedPower(gomock.Any(), ValidVoter2, types.ModuleName, gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _ sdk.AccAddress, _ string, amount math.Int) error {
+			if amount.GT(math.NewInt(1e10)) {
+				return restaketypes.ErrPowerNotEnough
+			}
+			return nil
+		}).
+		AnyTimes()
 	restakeKeeper.EXPECT().
 		SetLockedPower(gomock.Any(), InvalidVoter, types.ModuleName, gomock.Any()).
 		Return(restaketypes.ErrPowerNotEnough).
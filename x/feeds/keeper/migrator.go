@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/bandprotocol/chain/v3/x/feeds/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 migrates the x/feeds module state from the consensus version 1 to
+// version 2. Specifically, it records the cumulative prices of the existing price
+// history.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
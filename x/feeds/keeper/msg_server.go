@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -36,7 +35,8 @@ func (k msgServer) Vote(
 		return nil, err
 	}
 
-	maxCurrentFeeds := k.Keeper.GetParams(ctx).MaxCurrentFeeds
+	params := k.Keeper.GetParams(ctx)
+	maxCurrentFeeds := params.MaxCurrentFeeds
 
 	// check if the number of submitted signals exceeds the maximum allowed feeds
 	if uint64(len(msg.Signals)) > maxCurrentFeeds {
@@ -46,6 +46,19 @@ func (k msgServer) Vote(
 		)
 	}
 
+	// reject or flag the signals that are not active in the signal registry
+	for _, signal := range msg.Signals {
+		if k.Keeper.IsSignalRegistered(ctx, signal.ID) {
+			continue
+		}
+
+		if params.RequireRegisteredSignals {
+			return nil, types.ErrSignalNotRegistered.Wrapf("signal id %s is not active in the signal registry", signal.ID)
+		}
+
+		emitEventVoteUnregisteredSignal(ctx, voter, signal.ID)
+	}
+
 	// lock the voter's power equal to the sum of the signal powers
 	err = k.Keeper.LockVoterPower(ctx, voter, msg.Signals)
 	if err != nil {
@@ -55,42 +68,46 @@ func (k msgServer) Vote(
 	// RegisterNewSignals deletes previous signals and registers new signals then returns feed power differences
 	signalIDToPowerDiff := k.Keeper.UpdateVoteAndReturnPowerDiff(ctx, voter, msg.Signals)
 
-	// sort keys to guarantee order of signalIDToPowerDiff iteration
-	keys := make([]string, 0, len(signalIDToPowerDiff))
-	for k := range signalIDToPowerDiff {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-
-	// iterate over each signal ID, updating the total power and ensuring no negative power values
-	for _, signalID := range keys {
-		// get the power difference for the current signal ID
-		powerDiff := signalIDToPowerDiff[signalID]
-
-		// retrieve the total power of the current signal ID from the store
-		signalTotalPower, err := k.Keeper.GetSignalTotalPower(ctx, signalID)
-		if err != nil {
-			// initialize a new signal with zero power if the signal ID does not exist
-			signalTotalPower = types.NewSignal(
-				signalID,
-				0,
-			)
-		}
+	// update the total power of the signals and ensure no negative power values
+	if err := k.Keeper.UpdateSignalTotalPowers(ctx, signalIDToPowerDiff); err != nil {
+		return nil, err
+	}
 
-		// update the total power of the signal by adding the power difference
-		signalTotalPower.Power += powerDiff
+	// return an empty response indicating success
+	return &types.MsgVoteResponse{}, nil
+}
 
-		// ensure the total power of the signal is not negative
-		if signalTotalPower.Power < 0 {
-			return nil, types.ErrPowerNegative
-		}
+// DelegateVotingPower delegates feeds voting power to another address.
+func (k msgServer) DelegateVotingPower(
+	goCtx context.Context,
+	msg *types.MsgDelegateVotingPower,
+) (*types.MsgDelegateVotingPowerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-		// save the updated signal total power back to the store
-		k.Keeper.SetSignalTotalPower(ctx, signalTotalPower)
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
 	}
 
-	// return an empty response indicating success
-	return &types.MsgVoteResponse{}, nil
+	delegatee, err := sdk.AccAddressFromBech32(msg.Delegatee)
+	if err != nil {
+		return nil, err
+	}
+
+	// lock the delegator's power equal to the sum of its signal powers and the delegated power
+	if err := k.Keeper.LockDelegatorPower(ctx, delegator, msg.Power); err != nil {
+		return nil, err
+	}
+
+	// replace the previous delegation of the delegator and update the total power of the affected signals
+	signalIDToPowerDiff := k.Keeper.UpdateDelegationAndReturnPowerDiff(ctx, delegator, delegatee, msg.Power)
+	if err := k.Keeper.UpdateSignalTotalPowers(ctx, signalIDToPowerDiff); err != nil {
+		return nil, err
+	}
+
+	emitEventDelegateVotingPower(ctx, msg.Delegator, msg.Delegatee, msg.Power)
+
+	return &types.MsgDelegateVotingPowerResponse{}, nil
 }
 
 // SubmitSignalPrices submits new validator prices.
@@ -230,3 +247,55 @@ func (k msgServer) UpdateParams(
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateSignalMetadata adds or updates signal ids in the signal registry.
+func (k msgServer) UpdateSignalMetadata(
+	goCtx context.Context,
+	msg *types.MsgUpdateSignalMetadata,
+) (*types.MsgUpdateSignalMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if the request is from the authority
+	if k.Keeper.GetAuthority() != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s",
+			k.Keeper.GetAuthority(),
+			msg.Authority,
+		)
+	}
+
+	for _, metadata := range msg.SignalMetadata {
+		k.Keeper.SetSignalMetadata(ctx, metadata)
+		emitEventUpdateSignalMetadata(ctx, metadata)
+	}
+
+	return &types.MsgUpdateSignalMetadataResponse{}, nil
+}
+
+// ResetCircuitBreakers resets the triggered circuit breakers of signal ids.
+func (k msgServer) ResetCircuitBreakers(
+	goCtx context.Context,
+	msg *types.MsgResetCircuitBreakers,
+) (*types.MsgResetCircuitBreakersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if the request is from the admin or the authority
+	admin := k.Keeper.GetParams(ctx).Admin
+	if admin != msg.Signer && k.Keeper.GetAuthority() != msg.Signer {
+		return nil, types.ErrInvalidSigner.Wrapf(
+			"invalid signer; expected %s or %s, got %s",
+			admin,
+			k.Keeper.GetAuthority(),
+			msg.Signer,
+		)
+	}
+
+	for _, signalID := range msg.SignalIDs {
+		if err := k.Keeper.ResetCircuitBreaker(ctx, signalID); err != nil {
+			return nil, err
+		}
+		emitEventResetCircuitBreaker(ctx, signalID)
+	}
+
+	return &types.MsgResetCircuitBreakersResponse{}, nil
+}
@@ -157,6 +157,122 @@ func (suite *KeeperTestSuite) TestMsgVote() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgDelegateVotingPower() {
+	ctx := suite.ctx
+
+	requireSignalTotalPower := func(signalID string, power int64) {
+		signalTotalPower, err := suite.feedsKeeper.GetSignalTotalPower(ctx, signalID)
+		if power == 0 {
+			suite.Require().ErrorIs(err, types.ErrSignalTotalPowerNotFound)
+			return
+		}
+		suite.Require().NoError(err)
+		suite.Require().Equal(power, signalTotalPower.Power)
+	}
+
+	_, err := suite.msgServer.Vote(ctx, types.NewMsgVote(ValidVoter.String(), []types.Signal{
+		types.NewSignal("CS:BAND-USD", 100),
+		types.NewSignal("CS:BTC-USD", 300),
+	}))
+	suite.Require().NoError(err)
+
+	// the delegated power is distributed to the signals of the delegatee in proportion to their powers
+	_, err = suite.msgServer.DelegateVotingPower(
+		ctx,
+		types.NewMsgDelegateVotingPower(ValidVoter2.String(), ValidVoter.String(), 1000),
+	)
+	suite.Require().NoError(err)
+	requireSignalTotalPower("CS:BAND-USD", 350)
+	requireSignalTotalPower("CS:BTC-USD", 1050)
+
+	// the delegated power follows the new vote of the delegatee
+	_, err = suite.msgServer.Vote(ctx, types.NewMsgVote(ValidVoter.String(), []types.Signal{
+		types.NewSignal("CS:BAND-USD", 400),
+	}))
+	suite.Require().NoError(err)
+	requireSignalTotalPower("CS:BAND-USD", 1400)
+	requireSignalTotalPower("CS:BTC-USD", 0)
+
+	// the delegator can vote with its remaining power
+	_, err = suite.msgServer.Vote(ctx, types.NewMsgVote(ValidVoter2.String(), []types.Signal{
+		types.NewSignal("CS:ETH-USD", 1e10-1000),
+	}))
+	suite.Require().NoError(err)
+	requireSignalTotalPower("CS:ETH-USD", 1e10-1000)
+
+	_, err = suite.msgServer.Vote(ctx, types.NewMsgVote(ValidVoter2.String(), []types.Signal{
+		types.NewSignal("CS:ETH-USD", 1e10-999),
+	}))
+	suite.Require().ErrorContains(err, "power not enough")
+
+	_, err = suite.msgServer.DelegateVotingPower(
+		ctx,
+		types.NewMsgDelegateVotingPower(ValidVoter2.String(), ValidVoter.String(), 1001),
+	)
+	suite.Require().ErrorContains(err, "power not enough")
+
+	// removing the delegation removes the delegated power from the signals of the delegatee
+	_, err = suite.msgServer.DelegateVotingPower(
+		ctx,
+		types.NewMsgDelegateVotingPower(ValidVoter2.String(), ValidVoter.String(), 0),
+	)
+	suite.Require().NoError(err)
+	requireSignalTotalPower("CS:BAND-USD", 400)
+	suite.Require().Equal(int64(0), suite.feedsKeeper.GetDelegatedPower(ctx, ValidVoter))
+
+	_, err = suite.feedsKeeper.GetDelegation(ctx, ValidVoter2)
+	suite.Require().ErrorIs(err, types.ErrDelegationNotFound)
+}
+
+func (suite *KeeperTestSuite) TestMsgVoteSignalRegistry() {
+	ctx := suite.ctx
+
+	suite.feedsKeeper.SetSignalMetadata(
+		ctx,
+		types.NewSignalMetadata("CS:BAND-USD", 9, "BAND", "USD", "crypto", "", types.SIGNAL_METADATA_STATUS_ACTIVE, 0),
+	)
+	suite.feedsKeeper.SetSignalMetadata(
+		ctx,
+		types.NewSignalMetadata("CS:LUNA-USD", 9, "LUNA", "USD", "crypto", "", types.SIGNAL_METADATA_STATUS_DEPRECATED, 0),
+	)
+
+	// votes for unregistered signals are flagged by default
+	_, err := suite.msgServer.Vote(ctx, types.NewMsgVote(ValidVoter.String(), []types.Signal{
+		types.NewSignal("CS:BAND-USD", 1e9),
+		types.NewSignal("CS:LUNA-USD", 1e9),
+		types.NewSignal("CS:ATOM-USD", 1e9),
+	}))
+	suite.Require().NoError(err)
+
+	var flagged []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeVoteUnregisteredSignal {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeySignalID {
+				flagged = append(flagged, attr.Value)
+			}
+		}
+	}
+	suite.Require().Equal([]string{"CS:LUNA-USD", "CS:ATOM-USD"}, flagged)
+
+	// votes for unregistered signals are rejected if required
+	params := suite.feedsKeeper.GetParams(ctx)
+	params.RequireRegisteredSignals = true
+	suite.Require().NoError(suite.feedsKeeper.SetParams(ctx, params))
+
+	_, err = suite.msgServer.Vote(ctx, types.NewMsgVote(ValidVoter.String(), []types.Signal{
+		types.NewSignal("CS:LUNA-USD", 1e9),
+	}))
+	suite.Require().ErrorIs(err, types.ErrSignalNotRegistered)
+
+	_, err = suite.msgServer.Vote(ctx, types.NewMsgVote(ValidVoter.String(), []types.Signal{
+		types.NewSignal("CS:BAND-USD", 1e9),
+	}))
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) TestMsgSubmitSignalPrices() {
 	suite.feedsKeeper.SetCurrentFeeds(suite.ctx, []types.Feed{{
 		SignalID: "CS:BAND-USD",
@@ -349,3 +465,98 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateSignalMetadata() {
+	metadata := types.NewSignalMetadata("CS:BAND-USD", 9, "BAND", "USD", "crypto", "", types.SIGNAL_METADATA_STATUS_ACTIVE, 0)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateSignalMetadata
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     types.NewMsgUpdateSignalMetadata("invalid", []types.SignalMetadata{metadata}),
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:   "all good",
+			input:  types.NewMsgUpdateSignalMetadata(suite.feedsKeeper.GetAuthority(), []types.SignalMetadata{metadata}),
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateSignalMetadata(suite.ctx, tc.input)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+
+				stored, err := suite.feedsKeeper.GetSignalMetadata(suite.ctx, metadata.SignalID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(metadata, stored)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgResetCircuitBreakers() {
+	params := suite.feedsKeeper.GetParams(suite.ctx)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgResetCircuitBreakers
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid signer",
+			input:     types.NewMsgResetCircuitBreakers("invalid", []string{"CS:BAND-USD"}),
+			expErr:    true,
+			expErrMsg: "invalid signer",
+		},
+		{
+			name:      "circuit breaker not found",
+			input:     types.NewMsgResetCircuitBreakers(params.Admin, []string{"CS:ETH-USD"}),
+			expErr:    true,
+			expErrMsg: "circuit breaker not found",
+		},
+		{
+			name:   "reset by admin",
+			input:  types.NewMsgResetCircuitBreakers(params.Admin, []string{"CS:BAND-USD"}),
+			expErr: false,
+		},
+		{
+			name:   "reset by authority",
+			input:  types.NewMsgResetCircuitBreakers(suite.feedsKeeper.GetAuthority(), []string{"CS:BAND-USD"}),
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.feedsKeeper.SetCircuitBreaker(
+				suite.ctx,
+				types.NewCircuitBreaker("CS:BAND-USD", 1000, 2000, suite.ctx.BlockTime().Unix()),
+			)
+
+			_, err := suite.msgServer.ResetCircuitBreakers(suite.ctx, tc.input)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+
+				_, err := suite.feedsKeeper.GetCircuitBreaker(suite.ctx, "CS:BAND-USD")
+				suite.Require().ErrorIs(err, types.ErrCircuitBreakerNotFound)
+			}
+		})
+	}
+}
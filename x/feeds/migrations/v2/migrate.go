@@ -0,0 +1,52 @@
+package v2
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+const (
+	ModuleName = "feeds"
+)
+
+// Migrate migrates the x/feeds module state from the consensus version 1 to
+// version 2. Specifically, it records the cumulative price of every existing
+// historical price, accumulated from the oldest historical price of its signal.
+func Migrate(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	// the historical prices are ordered by signal and then by timestamp
+	var prices []types.Price
+	iterator := storetypes.KVStorePrefixIterator(store, types.PriceHistoryStoreKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.Price
+		cdc.MustUnmarshal(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+	iterator.Close()
+
+	cumulativePrice := math.ZeroInt()
+	for i, price := range prices {
+		if i == 0 || prices[i-1].SignalID != price.SignalID {
+			cumulativePrice = math.ZeroInt()
+		} else {
+			prev := prices[i-1]
+			cumulativePrice = cumulativePrice.Add(math.NewIntFromUint64(prev.Price).MulRaw(price.Timestamp - prev.Timestamp))
+		}
+
+		bz, err := cumulativePrice.Marshal()
+		if err != nil {
+			return err
+		}
+		store.Set(types.CumulativePriceStoreKey(price.SignalID, price.Timestamp), bz)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/bandprotocol/chain/v3/x/feeds"
+	v2 "github.com/bandprotocol/chain/v3/x/feeds/migrations/v2"
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feeds.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v2.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	prices := []types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 1000),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1100, 1100),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1150, 1150),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 3000, 1000),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 3100, 1060),
+	}
+	for _, price := range prices {
+		store.Set(types.PriceHistoryTimestampStoreKey(price.SignalID, price.Timestamp), cdc.MustMarshal(&price))
+	}
+
+	require.NoError(t, v2.Migrate(ctx, store, cdc))
+
+	expCumulativePrices := map[string]map[int64]int64{
+		"CS:BAND-USD": {1000: 0, 1100: 100000, 1150: 155000},
+		"CS:ETH-USD":  {1000: 0, 1060: 180000},
+	}
+	for signalID, cumulativePrices := range expCumulativePrices {
+		for timestamp, expCumulativePrice := range cumulativePrices {
+			var cumulativePrice math.Int
+			require.NoError(t, cumulativePrice.Unmarshal(store.Get(types.CumulativePriceStoreKey(signalID, timestamp))))
+			require.Equal(t, math.NewInt(expCumulativePrice), cumulativePrice)
+		}
+	}
+}
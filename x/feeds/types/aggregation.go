@@ -0,0 +1,193 @@
+package types
+
+import (
+	"cmp"
+	"slices"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// DefaultAggregationMethod is the aggregation method of the signal ids without an assigned method.
+const DefaultAggregationMethod = AGGREGATION_METHOD_WEIGHTED_MEDIAN
+
+// Aggregator aggregates the available prices of the given validator price infos into a single price.
+type Aggregator func(validatorPriceInfos []ValidatorPriceInfo) (uint64, error)
+
+// aggregators is the registry of the deterministic aggregators of the aggregation methods.
+var aggregators = map[AggregationMethod]Aggregator{
+	AGGREGATION_METHOD_WEIGHTED_MEDIAN: MedianValidatorPriceInfos,
+	AGGREGATION_METHOD_TRIMMED_MEAN:    TrimmedMeanValidatorPriceInfos,
+	AGGREGATION_METHOD_MEDIAN:          PowerMedianValidatorPriceInfos,
+}
+
+// GetAggregator returns the aggregator of the given aggregation method.
+func GetAggregator(method AggregationMethod) (Aggregator, error) {
+	aggregator, ok := aggregators[method]
+	if !ok {
+		return nil, ErrInvalidAggregationMethod.Wrapf("aggregation method %s is not registered", method)
+	}
+
+	return aggregator, nil
+}
+
+// NewSignalAggregationMethod creates a new SignalAggregationMethod instance.
+func NewSignalAggregationMethod(signalID string, method AggregationMethod, minReporters uint64) SignalAggregationMethod {
+	return SignalAggregationMethod{
+		SignalID:     signalID,
+		Method:       method,
+		MinReporters: minReporters,
+	}
+}
+
+// Validate validates the signal aggregation method.
+func (s SignalAggregationMethod) Validate() error {
+	if s.SignalID == "" {
+		return ErrInvalidSignal.Wrap("signal id cannot be empty")
+	}
+
+	signalIDLength := len(s.SignalID)
+	if uint64(signalIDLength) > MaxSignalIDCharacters {
+		return ErrSignalIDTooLarge.Wrapf(
+			"maximum number of characters is %d but received %d characters",
+			MaxSignalIDCharacters, signalIDLength,
+		)
+	}
+
+	if _, err := GetAggregator(s.Method); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CountReporters returns the number of validator price infos with an available price.
+func CountReporters(validatorPriceInfos []ValidatorPriceInfo) uint64 {
+	count := uint64(0)
+	for _, priceInfo := range validatorPriceInfos {
+		if priceInfo.SignalPriceStatus == SIGNAL_PRICE_STATUS_AVAILABLE {
+			count++
+		}
+	}
+
+	return count
+}
+
+// PowerMedianValidatorPriceInfos calculates the power-weighted median of the available prices
+// without favoring recent entries.
+func PowerMedianValidatorPriceInfos(validatorPriceInfos []ValidatorPriceInfo) (uint64, error) {
+	var weightedPrices []WeightedPrice
+	for _, priceInfo := range validatorPriceInfos {
+		if priceInfo.SignalPriceStatus == SIGNAL_PRICE_STATUS_AVAILABLE {
+			weightedPrices = append(weightedPrices, NewWeightedPrice(priceInfo.Power, priceInfo.Price))
+		}
+	}
+
+	return MedianWeightedPrice(weightedPrices)
+}
+
+// TrimmedMeanValidatorPriceInfos calculates the power-weighted mean of the available prices after
+// trimming the lowest and the highest quarter of the total power. An entry that crosses a trimming
+// boundary only contributes the part of its power inside the boundaries.
+func TrimmedMeanValidatorPriceInfos(validatorPriceInfos []ValidatorPriceInfo) (uint64, error) {
+	var validPrices []ValidatorPriceInfo
+	totalPower := sdkmath.NewInt(0)
+	for _, priceInfo := range validatorPriceInfos {
+		if priceInfo.SignalPriceStatus == SIGNAL_PRICE_STATUS_AVAILABLE {
+			validPrices = append(validPrices, priceInfo)
+			totalPower = totalPower.Add(priceInfo.Power)
+		}
+	}
+
+	// sort by price (ascending), breaking ties by power (ascending)
+	slices.SortStableFunc(validPrices, func(a, b ValidatorPriceInfo) int {
+		if cmpResult := cmp.Compare(a.Price, b.Price); cmpResult != 0 {
+			return cmpResult
+		}
+		return a.Power.BigInt().Cmp(b.Power.BigInt())
+	})
+
+	// powers are scaled by 4 so that the boundaries of the middle half are integers
+	lowerBound := totalPower
+	upperBound := totalPower.MulRaw(3)
+
+	totalWeight := sdkmath.NewInt(0)
+	weightedSum := sdkmath.NewInt(0)
+	currentPower := sdkmath.NewInt(0)
+	for _, priceInfo := range validPrices {
+		start := currentPower
+		end := currentPower.Add(priceInfo.Power.MulRaw(4))
+		currentPower = end
+
+		weight := sdkmath.MinInt(end, upperBound).Sub(sdkmath.MaxInt(start, lowerBound))
+		if !weight.IsPositive() {
+			continue
+		}
+
+		totalWeight = totalWeight.Add(weight)
+		weightedSum = weightedSum.Add(weight.Mul(sdkmath.NewIntFromUint64(priceInfo.Price)))
+	}
+
+	if totalWeight.IsZero() {
+		return 0, ErrInvalidWeightedPrices
+	}
+
+	return weightedSum.Quo(totalWeight).Uint64(), nil
+}
+
+// NewPriceConfidence creates a new PriceConfidence instance.
+func NewPriceConfidence(reporterCount uint64, powerShareBPS uint64, dispersion uint64) *PriceConfidence {
+	return &PriceConfidence{
+		ReporterCount: reporterCount,
+		PowerShareBPS: powerShareBPS,
+		Dispersion:    dispersion,
+	}
+}
+
+// CalculatePriceConfidence calculates the number of validators that reported an available price,
+// their power in basis points of the given total bonded power, and the power-weighted interquartile
+// range of their prices.
+func CalculatePriceConfidence(
+	validatorPriceInfos []ValidatorPriceInfo,
+	totalBondedPower sdkmath.Int,
+) *PriceConfidence {
+	var weightedPrices []WeightedPrice
+	availablePower := sdkmath.NewInt(0)
+	for _, priceInfo := range validatorPriceInfos {
+		if priceInfo.SignalPriceStatus == SIGNAL_PRICE_STATUS_AVAILABLE {
+			weightedPrices = append(weightedPrices, NewWeightedPrice(priceInfo.Power, priceInfo.Price))
+			availablePower = availablePower.Add(priceInfo.Power)
+		}
+	}
+
+	powerShareBPS := uint64(0)
+	if totalBondedPower.IsPositive() {
+		powerShareBPS = sdkmath.MinInt(availablePower.MulRaw(10000).Quo(totalBondedPower), sdkmath.NewInt(10000)).
+			Uint64()
+	}
+
+	// sort by price (ascending) to find the power-weighted quartiles
+	slices.SortStableFunc(weightedPrices, func(a, b WeightedPrice) int {
+		return cmp.Compare(a.Price, b.Price)
+	})
+
+	dispersion := uint64(0)
+	if availablePower.IsPositive() {
+		dispersion = weightedQuantile(weightedPrices, availablePower, 3) - weightedQuantile(weightedPrices, availablePower, 1)
+	}
+
+	return NewPriceConfidence(uint64(len(weightedPrices)), powerShareBPS, dispersion)
+}
+
+// weightedQuantile returns the first price of the given weighted prices, sorted by price, at which the
+// cumulative weight reaches the given number of quarters of the total weight.
+func weightedQuantile(weightedPrices []WeightedPrice, totalWeight sdkmath.Int, quarter int64) uint64 {
+	cumulativeWeight := sdkmath.NewInt(0)
+	for _, wp := range weightedPrices {
+		cumulativeWeight = cumulativeWeight.Add(wp.Weight)
+		if cumulativeWeight.MulRaw(4).GTE(totalWeight.MulRaw(quarter)) {
+			return wp.Price
+		}
+	}
+
+	return weightedPrices[len(weightedPrices)-1].Price
+}
@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestTrimmedMeanValidatorPriceInfos(t *testing.T) {
+	testCases := []struct {
+		name                string
+		validatorPriceInfos []types.ValidatorPriceInfo
+		expRes              uint64
+		expErr              error
+	}{
+		{
+			name: "outlier is trimmed",
+			validatorPriceInfos: []types.ValidatorPriceInfo{
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 100, Power: sdkmath.NewInt(100)},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 102, Power: sdkmath.NewInt(100)},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 104, Power: sdkmath.NewInt(100)},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 1000, Power: sdkmath.NewInt(100)},
+			},
+			expRes: 103,
+		},
+		{
+			name: "entries crossing the boundaries are partially weighted",
+			validatorPriceInfos: []types.ValidatorPriceInfo{
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 1000, Power: sdkmath.NewInt(3000)},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 2000, Power: sdkmath.NewInt(3000)},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 3000, Power: sdkmath.NewInt(3000)},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 10000, Power: sdkmath.NewInt(1000)},
+			},
+			expRes: 2200,
+		},
+		{
+			name: "unavailable prices are ignored",
+			validatorPriceInfos: []types.ValidatorPriceInfo{
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 100, Power: sdkmath.NewInt(100)},
+				{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_UNAVAILABLE, Price: 0, Power: sdkmath.NewInt(1000)},
+			},
+			expRes: 100,
+		},
+		{
+			name:                "no available price",
+			validatorPriceInfos: []types.ValidatorPriceInfo{},
+			expErr:              types.ErrInvalidWeightedPrices,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := types.TrimmedMeanValidatorPriceInfos(tc.validatorPriceInfos)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expRes, price)
+		})
+	}
+}
+
+func TestPowerMedianValidatorPriceInfos(t *testing.T) {
+	// the latest price does not get a higher weight
+	price, err := types.PowerMedianValidatorPriceInfos([]types.ValidatorPriceInfo{
+		{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 100, Power: sdkmath.NewInt(100), Timestamp: 100},
+		{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 200, Power: sdkmath.NewInt(300), Timestamp: 100},
+		{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 300, Power: sdkmath.NewInt(100), Timestamp: 200},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), price)
+}
+
+func TestGetAggregator(t *testing.T) {
+	_, err := types.GetAggregator(types.AGGREGATION_METHOD_WEIGHTED_MEDIAN)
+	require.NoError(t, err)
+
+	_, err = types.GetAggregator(types.AGGREGATION_METHOD_UNSPECIFIED)
+	require.ErrorIs(t, err, types.ErrInvalidAggregationMethod)
+}
+
+func TestGetSignalAggregationMethod(t *testing.T) {
+	params := types.DefaultParams()
+	params.SignalAggregationMethods = []types.SignalAggregationMethod{
+		types.NewSignalAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_TRIMMED_MEAN, 3),
+	}
+
+	require.Equal(
+		t,
+		types.NewSignalAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_TRIMMED_MEAN, 3),
+		params.GetSignalAggregationMethod("CS:BAND-USD"),
+	)
+	require.Equal(
+		t,
+		types.NewSignalAggregationMethod("CS:ETH-USD", types.AGGREGATION_METHOD_WEIGHTED_MEDIAN, 0),
+		params.GetSignalAggregationMethod("CS:ETH-USD"),
+	)
+}
+
+func TestCalculatePriceConfidence(t *testing.T) {
+	validatorPriceInfos := []types.ValidatorPriceInfo{
+		{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 100, Power: sdkmath.NewInt(100)},
+		{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 104, Power: sdkmath.NewInt(100)},
+		{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 102, Power: sdkmath.NewInt(100)},
+		{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 1000, Power: sdkmath.NewInt(100)},
+		{SignalPriceStatus: types.SIGNAL_PRICE_STATUS_UNAVAILABLE, Price: 0, Power: sdkmath.NewInt(100)},
+	}
+
+	require.Equal(
+		t,
+		types.NewPriceConfidence(4, 4000, 4),
+		types.CalculatePriceConfidence(validatorPriceInfos, sdkmath.NewInt(1000)),
+	)
+
+	// there is no power share without bonded power and no dispersion without available prices
+	require.Equal(
+		t,
+		types.NewPriceConfidence(0, 0, 0),
+		types.CalculatePriceConfidence(validatorPriceInfos[4:], sdkmath.NewInt(0)),
+	)
+}
@@ -0,0 +1,38 @@
+package types
+
+// NewCircuitBreaker creates a new CircuitBreaker instance.
+func NewCircuitBreaker(signalID string, referencePrice uint64, haltedPrice uint64, haltedAt int64) CircuitBreaker {
+	return CircuitBreaker{
+		SignalID:       signalID,
+		ReferencePrice: referencePrice,
+		HaltedPrice:    haltedPrice,
+		HaltedAt:       haltedAt,
+	}
+}
+
+// Validate validates the circuit breaker.
+func (c CircuitBreaker) Validate() error {
+	if c.SignalID == "" {
+		return ErrInvalidSignal.Wrap("signal id cannot be empty")
+	}
+
+	signalIDLength := len(c.SignalID)
+	if uint64(signalIDLength) > MaxSignalIDCharacters {
+		return ErrSignalIDTooLarge.Wrapf(
+			"maximum number of characters is %d but received %d characters",
+			MaxSignalIDCharacters, signalIDLength,
+		)
+	}
+
+	return nil
+}
+
+// IsPriceMoveExceeded returns true if the price moves from the reference price by more than the given
+// maximum move in basis points. Zero maximum move disables the check.
+func IsPriceMoveExceeded(price uint64, referencePrice uint64, maxPriceMoveBasisPoint int64) bool {
+	if maxPriceMoveBasisPoint == 0 {
+		return false
+	}
+
+	return IsOutlierPrice(price, referencePrice, maxPriceMoveBasisPoint)
+}
@@ -13,9 +13,12 @@ import (
 // RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "feeds/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateVotingPower{}, "feeds/MsgDelegateVotingPower")
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitSignalPrices{}, "feeds/MsgSubmitSignalPrices")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateReferenceSourceConfig{}, "feeds/MsgUpdateReferenceSourceConfig")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "feeds/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateSignalMetadata{}, "feeds/MsgUpdateSignalMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgResetCircuitBreakers{}, "feeds/MsgResetCircuitBreakers")
 
 	cdc.RegisterConcrete(&FeedsSignatureOrder{}, "feeds/FeedsSignatureOrder", nil)
 	cdc.RegisterConcrete(Params{}, "feeds/Params", nil)
@@ -26,9 +29,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgVote{},
+		&MsgDelegateVotingPower{},
 		&MsgSubmitSignalPrices{},
 		&MsgUpdateReferenceSourceConfig{},
 		&MsgUpdateParams{},
+		&MsgUpdateSignalMetadata{},
+		&MsgResetCircuitBreakers{},
 	)
 
 	registry.RegisterImplementations(
@@ -6,4 +6,13 @@ const (
 
 	// ExpectedBlockTime specifies the expected block time (in seconds).
 	ExpectedBlockTime int64 = 1
+
+	// BasisPointMax defines the number of basis points in a whole.
+	BasisPointMax uint64 = 10000
+
+	// MaxSignalDecimals defines the maximum number of decimals of a signal in the signal registry.
+	MaxSignalDecimals uint32 = 18
+
+	// MaxSignalDescriptionCharacters defines the maximum number of characters allowed in a signal description.
+	MaxSignalDescriptionCharacters uint64 = 256
 )
@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDelegation creates a new Delegation instance.
+func NewDelegation(delegator string, delegatee string, power int64) Delegation {
+	return Delegation{
+		Delegator: delegator,
+		Delegatee: delegatee,
+		Power:     power,
+	}
+}
+
+// Validate validates the delegation.
+func (d Delegation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.Delegator); err != nil {
+		return errorsmod.Wrap(err, "invalid delegator address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(d.Delegatee); err != nil {
+		return errorsmod.Wrap(err, "invalid delegatee address")
+	}
+
+	if d.Delegator == d.Delegatee {
+		return ErrInvalidDelegation.Wrap("delegator cannot delegate to itself")
+	}
+
+	if d.Power <= 0 {
+		return ErrInvalidDelegation.Wrap("delegated power must be positive")
+	}
+
+	return nil
+}
@@ -9,6 +9,12 @@ const (
 	EventTypeUpdateCurrentFeeds          = "update_current_feeds"
 	EventTypeUpdateReferenceSourceConfig = "update_reference_source_config"
 	EventTypeUpdateParams                = "update_params"
+	EventTypeDeactivateOutlierValidator  = "deactivate_outlier_validator"
+	EventTypeUpdateSignalMetadata        = "update_signal_metadata"
+	EventTypeVoteUnregisteredSignal      = "vote_unregistered_signal"
+	EventTypeDelegateVotingPower         = "delegate_voting_power"
+	EventTypeTriggerCircuitBreaker       = "trigger_circuit_breaker"
+	EventTypeResetCircuitBreaker         = "reset_circuit_breaker"
 
 	AttributeKeySignalPriceStatus   = "signal_price_status"
 	AttributeKeyPriceStatus         = "price_status"
@@ -25,4 +31,15 @@ const (
 	AttributeKeyRegistryIPFSHash    = "registry_ipfs_hash"
 	AttributeKeyRegistryVersion     = "registry_version"
 	AttributeKeyParams              = "params"
+	AttributeKeyReportCount         = "report_count"
+	AttributeKeyOutlierCount        = "outlier_count"
+	AttributeKeySignalStatus        = "signal_status"
+	AttributeKeyVoter               = "voter"
+	AttributeKeyDelegator           = "delegator"
+	AttributeKeyDelegatee           = "delegatee"
+	AttributeKeyReferencePrice      = "reference_price"
+	AttributeKeyAggregationMethod   = "aggregation_method"
+	AttributeKeyReporterCount       = "reporter_count"
+	AttributeKeyPowerShareBPS       = "power_share_bps"
+	AttributeKeyDispersion          = "dispersion"
 )
@@ -38,6 +38,8 @@ const (
 	PRICE_STATUS_AVAILABLE PriceStatus = 3
 	// PRICE_STATUS_NOT_IN_CURRENT_FEEDS is a not in current feed price status.
 	PRICE_STATUS_NOT_IN_CURRENT_FEEDS PriceStatus = 4
+	// PRICE_STATUS_HALTED is a halted price status of a signal id whose circuit breaker is triggered.
+	PRICE_STATUS_HALTED PriceStatus = 5
 )
 
 var PriceStatus_name = map[int32]string{
@@ -46,6 +48,7 @@ var PriceStatus_name = map[int32]string{
 	2: "PRICE_STATUS_NOT_READY",
 	3: "PRICE_STATUS_AVAILABLE",
 	4: "PRICE_STATUS_NOT_IN_CURRENT_FEEDS",
+	5: "PRICE_STATUS_HALTED",
 }
 
 var PriceStatus_value = map[string]int32{
@@ -54,6 +57,7 @@ var PriceStatus_value = map[string]int32{
 	"PRICE_STATUS_NOT_READY":            2,
 	"PRICE_STATUS_AVAILABLE":            3,
 	"PRICE_STATUS_NOT_IN_CURRENT_FEEDS": 4,
+	"PRICE_STATUS_HALTED":               5,
 }
 
 func (x PriceStatus) String() string {
@@ -64,6 +68,43 @@ func (PriceStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fc3afe81d3b13674, []int{0}
 }
 
+// AggregationMethod is the method used to aggregate the validator prices of a signal id into a price.
+type AggregationMethod int32
+
+const (
+	// AGGREGATION_METHOD_UNSPECIFIED is an unspecified aggregation method.
+	AGGREGATION_METHOD_UNSPECIFIED AggregationMethod = 0
+	// AGGREGATION_METHOD_WEIGHTED_MEDIAN is the time-weighted and power-weighted median of the validator prices.
+	AGGREGATION_METHOD_WEIGHTED_MEDIAN AggregationMethod = 1
+	// AGGREGATION_METHOD_TRIMMED_MEAN is the power-weighted mean of the validator prices after trimming the lowest
+	// and the highest quarter of the power.
+	AGGREGATION_METHOD_TRIMMED_MEAN AggregationMethod = 2
+	// AGGREGATION_METHOD_MEDIAN is the power-weighted median of the validator prices.
+	AGGREGATION_METHOD_MEDIAN AggregationMethod = 3
+)
+
+var AggregationMethod_name = map[int32]string{
+	0: "AGGREGATION_METHOD_UNSPECIFIED",
+	1: "AGGREGATION_METHOD_WEIGHTED_MEDIAN",
+	2: "AGGREGATION_METHOD_TRIMMED_MEAN",
+	3: "AGGREGATION_METHOD_MEDIAN",
+}
+
+var AggregationMethod_value = map[string]int32{
+	"AGGREGATION_METHOD_UNSPECIFIED":     0,
+	"AGGREGATION_METHOD_WEIGHTED_MEDIAN": 1,
+	"AGGREGATION_METHOD_TRIMMED_MEAN":    2,
+	"AGGREGATION_METHOD_MEDIAN":          3,
+}
+
+func (x AggregationMethod) String() string {
+	return proto.EnumName(AggregationMethod_name, int32(x))
+}
+
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{1}
+}
+
 // SignalPriceStatus is a structure that defines the price status of a signal id.
 type SignalPriceStatus int32
 
@@ -97,7 +138,39 @@ func (x SignalPriceStatus) String() string {
 }
 
 func (SignalPriceStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{1}
+	return fileDescriptor_fc3afe81d3b13674, []int{2}
+}
+
+// SignalMetadataStatus is the status of a signal id in the signal registry.
+type SignalMetadataStatus int32
+
+const (
+	// SIGNAL_METADATA_STATUS_UNSPECIFIED is an unspecified signal metadata status.
+	SIGNAL_METADATA_STATUS_UNSPECIFIED SignalMetadataStatus = 0
+	// SIGNAL_METADATA_STATUS_ACTIVE is the status of a signal id that can be voted.
+	SIGNAL_METADATA_STATUS_ACTIVE SignalMetadataStatus = 1
+	// SIGNAL_METADATA_STATUS_DEPRECATED is the status of a signal id that is no longer supported.
+	SIGNAL_METADATA_STATUS_DEPRECATED SignalMetadataStatus = 2
+)
+
+var SignalMetadataStatus_name = map[int32]string{
+	0: "SIGNAL_METADATA_STATUS_UNSPECIFIED",
+	1: "SIGNAL_METADATA_STATUS_ACTIVE",
+	2: "SIGNAL_METADATA_STATUS_DEPRECATED",
+}
+
+var SignalMetadataStatus_value = map[string]int32{
+	"SIGNAL_METADATA_STATUS_UNSPECIFIED": 0,
+	"SIGNAL_METADATA_STATUS_ACTIVE":      1,
+	"SIGNAL_METADATA_STATUS_DEPRECATED":  2,
+}
+
+func (x SignalMetadataStatus) String() string {
+	return proto.EnumName(SignalMetadataStatus_name, int32(x))
+}
+
+func (SignalMetadataStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{3}
 }
 
 // Signal is the data structure that contains signal id and power of that signal.
@@ -210,6 +283,143 @@ func (m *Vote) GetSignals() []Signal {
 	return nil
 }
 
+// Delegation is the data structure that contains the feeds voting power delegated by a delegator to a delegatee.
+type Delegation struct {
+	// delegator is the address of the delegator of the voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegatee is the address that votes with the delegated voting power.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// power is the delegated voting power.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *Delegation) Reset()         { *m = Delegation{} }
+func (m *Delegation) String() string { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()    {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{2}
+}
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(m, src)
+}
+func (m *Delegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
+
+func (m *Delegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *Delegation) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+func (m *Delegation) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// CircuitBreaker is a structure that holds the triggered circuit breaker of a signal id.
+type CircuitBreaker struct {
+	// signal_id is the signal id whose prices are halted.
+	SignalID string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// reference_price is the last available price of the signal id before the circuit breaker is triggered.
+	ReferencePrice uint64 `protobuf:"varint,2,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	// halted_price is the price that triggered the circuit breaker.
+	HaltedPrice uint64 `protobuf:"varint,3,opt,name=halted_price,json=haltedPrice,proto3" json:"halted_price,omitempty"`
+	// halted_at is the timestamp at which the circuit breaker is triggered.
+	HaltedAt int64 `protobuf:"varint,4,opt,name=halted_at,json=haltedAt,proto3" json:"halted_at,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{3}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetSignalID() string {
+	if m != nil {
+		return m.SignalID
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetReferencePrice() uint64 {
+	if m != nil {
+		return m.ReferencePrice
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetHaltedPrice() uint64 {
+	if m != nil {
+		return m.HaltedPrice
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetHaltedAt() int64 {
+	if m != nil {
+		return m.HaltedAt
+	}
+	return 0
+}
+
 // Feed is a structure that holds a signal id, its total power, and its calculated interval.
 type Feed struct {
 	// signal_id is the unique string that identifies the unit of feed.
@@ -224,7 +434,7 @@ func (m *Feed) Reset()         { *m = Feed{} }
 func (m *Feed) String() string { return proto.CompactTextString(m) }
 func (*Feed) ProtoMessage()    {}
 func (*Feed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{2}
+	return fileDescriptor_fc3afe81d3b13674, []int{4}
 }
 func (m *Feed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,7 +500,7 @@ func (m *FeedWithDeviation) Reset()         { *m = FeedWithDeviation{} }
 func (m *FeedWithDeviation) String() string { return proto.CompactTextString(m) }
 func (*FeedWithDeviation) ProtoMessage()    {}
 func (*FeedWithDeviation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{3}
+	return fileDescriptor_fc3afe81d3b13674, []int{5}
 }
 func (m *FeedWithDeviation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +571,7 @@ func (m *CurrentFeeds) Reset()         { *m = CurrentFeeds{} }
 func (m *CurrentFeeds) String() string { return proto.CompactTextString(m) }
 func (*CurrentFeeds) ProtoMessage()    {}
 func (*CurrentFeeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{4}
+	return fileDescriptor_fc3afe81d3b13674, []int{6}
 }
 func (m *CurrentFeeds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +636,7 @@ func (m *CurrentFeedWithDeviations) Reset()         { *m = CurrentFeedWithDeviat
 func (m *CurrentFeedWithDeviations) String() string { return proto.CompactTextString(m) }
 func (*CurrentFeedWithDeviations) ProtoMessage()    {}
 func (*CurrentFeedWithDeviations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{5}
+	return fileDescriptor_fc3afe81d3b13674, []int{7}
 }
 func (m *CurrentFeedWithDeviations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,13 +696,18 @@ type Price struct {
 	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
 	// timestamp is the timestamp at which the price was aggregated.
 	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// aggregation_method is the method that aggregated the validator prices into the price.
+	AggregationMethod AggregationMethod `protobuf:"varint,5,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=band.feeds.v1beta1.AggregationMethod" json:"aggregation_method,omitempty"`
+	// confidence is the metadata about the validator prices that the price was aggregated from. It is only set for
+	// available prices.
+	Confidence *PriceConfidence `protobuf:"bytes,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
 }
 
 func (m *Price) Reset()         { *m = Price{} }
 func (m *Price) String() string { return proto.CompactTextString(m) }
 func (*Price) ProtoMessage()    {}
 func (*Price) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{6}
+	return fileDescriptor_fc3afe81d3b13674, []int{8}
 }
 func (m *Price) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,6 +764,151 @@ func (m *Price) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *Price) GetAggregationMethod() AggregationMethod {
+	if m != nil {
+		return m.AggregationMethod
+	}
+	return AGGREGATION_METHOD_UNSPECIFIED
+}
+
+func (m *Price) GetConfidence() *PriceConfidence {
+	if m != nil {
+		return m.Confidence
+	}
+	return nil
+}
+
+// PriceConfidence is a structure that describes how many validators contributed to a price and how much their
+// prices agree.
+type PriceConfidence struct {
+	// reporter_count is the number of validators that reported an available price.
+	ReporterCount uint64 `protobuf:"varint,1,opt,name=reporter_count,json=reporterCount,proto3" json:"reporter_count,omitempty"`
+	// power_share_bps is the power of the validators that reported an available price in basis points of the total
+	// bonded power.
+	PowerShareBPS uint64 `protobuf:"varint,2,opt,name=power_share_bps,json=powerShareBps,proto3" json:"power_share_bps,omitempty"`
+	// dispersion is the power-weighted interquartile range of the available validator prices.
+	Dispersion uint64 `protobuf:"varint,3,opt,name=dispersion,proto3" json:"dispersion,omitempty"`
+}
+
+func (m *PriceConfidence) Reset()         { *m = PriceConfidence{} }
+func (m *PriceConfidence) String() string { return proto.CompactTextString(m) }
+func (*PriceConfidence) ProtoMessage()    {}
+func (*PriceConfidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{9}
+}
+func (m *PriceConfidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceConfidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceConfidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceConfidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceConfidence.Merge(m, src)
+}
+func (m *PriceConfidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceConfidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceConfidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceConfidence proto.InternalMessageInfo
+
+func (m *PriceConfidence) GetReporterCount() uint64 {
+	if m != nil {
+		return m.ReporterCount
+	}
+	return 0
+}
+
+func (m *PriceConfidence) GetPowerShareBPS() uint64 {
+	if m != nil {
+		return m.PowerShareBPS
+	}
+	return 0
+}
+
+func (m *PriceConfidence) GetDispersion() uint64 {
+	if m != nil {
+		return m.Dispersion
+	}
+	return 0
+}
+
+// SignalAggregationMethod is the aggregation method assigned to a signal id.
+type SignalAggregationMethod struct {
+	// signal_id is the signal id that the aggregation method is assigned to.
+	SignalID string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// method is the aggregation method of the signal id.
+	Method AggregationMethod `protobuf:"varint,2,opt,name=method,proto3,enum=band.feeds.v1beta1.AggregationMethod" json:"method,omitempty"`
+	// min_reporters is the minimum number of validators that must report an available price for the price to be
+	// aggregated. Zero means no minimum.
+	MinReporters uint64 `protobuf:"varint,3,opt,name=min_reporters,json=minReporters,proto3" json:"min_reporters,omitempty"`
+}
+
+func (m *SignalAggregationMethod) Reset()         { *m = SignalAggregationMethod{} }
+func (m *SignalAggregationMethod) String() string { return proto.CompactTextString(m) }
+func (*SignalAggregationMethod) ProtoMessage()    {}
+func (*SignalAggregationMethod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{10}
+}
+func (m *SignalAggregationMethod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalAggregationMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalAggregationMethod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalAggregationMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalAggregationMethod.Merge(m, src)
+}
+func (m *SignalAggregationMethod) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalAggregationMethod) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalAggregationMethod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalAggregationMethod proto.InternalMessageInfo
+
+func (m *SignalAggregationMethod) GetSignalID() string {
+	if m != nil {
+		return m.SignalID
+	}
+	return ""
+}
+
+func (m *SignalAggregationMethod) GetMethod() AggregationMethod {
+	if m != nil {
+		return m.Method
+	}
+	return AGGREGATION_METHOD_UNSPECIFIED
+}
+
+func (m *SignalAggregationMethod) GetMinReporters() uint64 {
+	if m != nil {
+		return m.MinReporters
+	}
+	return 0
+}
+
 // SignalPrice is a structure that defines the signaled price of a signal id.
 type SignalPrice struct {
 	// status is the status of the signal price.
@@ -563,7 +923,7 @@ func (m *SignalPrice) Reset()         { *m = SignalPrice{} }
 func (m *SignalPrice) String() string { return proto.CompactTextString(m) }
 func (*SignalPrice) ProtoMessage()    {}
 func (*SignalPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{7}
+	return fileDescriptor_fc3afe81d3b13674, []int{11}
 }
 func (m *SignalPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,7 +991,7 @@ func (m *ValidatorPrice) Reset()         { *m = ValidatorPrice{} }
 func (m *ValidatorPrice) String() string { return proto.CompactTextString(m) }
 func (*ValidatorPrice) ProtoMessage()    {}
 func (*ValidatorPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{8}
+	return fileDescriptor_fc3afe81d3b13674, []int{12}
 }
 func (m *ValidatorPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,7 +1068,7 @@ func (m *ValidatorPriceList) Reset()         { *m = ValidatorPriceList{} }
 func (m *ValidatorPriceList) String() string { return proto.CompactTextString(m) }
 func (*ValidatorPriceList) ProtoMessage()    {}
 func (*ValidatorPriceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{9}
+	return fileDescriptor_fc3afe81d3b13674, []int{13}
 }
 func (m *ValidatorPriceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,26 +1111,35 @@ func (m *ValidatorPriceList) GetValidatorPrices() []ValidatorPrice {
 	return nil
 }
 
-// ReferenceSourceConfig is a structure that defines the information of reference price source.
-type ReferenceSourceConfig struct {
-	// registry_ipfs_hash is the hash of the reference registry.
-	RegistryIPFSHash string `protobuf:"bytes,1,opt,name=registry_ipfs_hash,json=registryIpfsHash,proto3" json:"registry_ipfs_hash,omitempty"`
-	// registry_version is the version of the reference registry.
-	RegistryVersion string `protobuf:"bytes,2,opt,name=registry_version,json=registryVersion,proto3" json:"registry_version,omitempty"`
-}
-
-func (m *ReferenceSourceConfig) Reset()         { *m = ReferenceSourceConfig{} }
-func (m *ReferenceSourceConfig) String() string { return proto.CompactTextString(m) }
-func (*ReferenceSourceConfig) ProtoMessage()    {}
-func (*ReferenceSourceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{10}
-}
-func (m *ReferenceSourceConfig) XXX_Unmarshal(b []byte) error {
+// ValidatorAccuracy is a structure that tracks how close the prices of a validator are to the final prices
+// over its latest submissions.
+type ValidatorAccuracy struct {
+	// validator is the validator address.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// window is the accuracy window that the accuracy is tracked with.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// submission_count is the number of submissions of the validator tracked since the accuracy was reset.
+	SubmissionCount uint64 `protobuf:"varint,3,opt,name=submission_count,json=submissionCount,proto3" json:"submission_count,omitempty"`
+	// report_count is the number of prices reported by the validator within the window.
+	ReportCount uint64 `protobuf:"varint,4,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// outlier_count is the number of outlier prices reported by the validator within the window.
+	OutlierCount uint64 `protobuf:"varint,5,opt,name=outlier_count,json=outlierCount,proto3" json:"outlier_count,omitempty"`
+	// accuracy_score_bps is the ratio (in basis point) of non-outlier prices to reported prices within the window.
+	AccuracyScoreBPS uint64 `protobuf:"varint,6,opt,name=accuracy_score_bps,json=accuracyScoreBps,proto3" json:"accuracy_score_bps,omitempty"`
+}
+
+func (m *ValidatorAccuracy) Reset()         { *m = ValidatorAccuracy{} }
+func (m *ValidatorAccuracy) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAccuracy) ProtoMessage()    {}
+func (*ValidatorAccuracy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{14}
+}
+func (m *ValidatorAccuracy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReferenceSourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorAccuracy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReferenceSourceConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorAccuracy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -780,47 +1149,296 @@ func (m *ReferenceSourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ReferenceSourceConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReferenceSourceConfig.Merge(m, src)
+func (m *ValidatorAccuracy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAccuracy.Merge(m, src)
 }
-func (m *ReferenceSourceConfig) XXX_Size() int {
+func (m *ValidatorAccuracy) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReferenceSourceConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReferenceSourceConfig.DiscardUnknown(m)
+func (m *ValidatorAccuracy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAccuracy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReferenceSourceConfig proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorAccuracy proto.InternalMessageInfo
 
-func (m *ReferenceSourceConfig) GetRegistryIPFSHash() string {
+func (m *ValidatorAccuracy) GetValidator() string {
 	if m != nil {
-		return m.RegistryIPFSHash
+		return m.Validator
 	}
 	return ""
 }
 
-func (m *ReferenceSourceConfig) GetRegistryVersion() string {
+func (m *ValidatorAccuracy) GetWindow() uint64 {
 	if m != nil {
-		return m.RegistryVersion
+		return m.Window
 	}
-	return ""
+	return 0
 }
 
-// FeedsSignatureOrder defines a general signature order for feed data.
-type FeedsSignatureOrder struct {
-	// signal_ids is the list of signal ids that require signatures.
-	SignalIDs []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
-	// encoder is the mode of encoding feeds signature order.
-	Encoder Encoder `protobuf:"varint,2,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+func (m *ValidatorAccuracy) GetSubmissionCount() uint64 {
+	if m != nil {
+		return m.SubmissionCount
+	}
+	return 0
 }
 
-func (m *FeedsSignatureOrder) Reset()         { *m = FeedsSignatureOrder{} }
-func (m *FeedsSignatureOrder) String() string { return proto.CompactTextString(m) }
-func (*FeedsSignatureOrder) ProtoMessage()    {}
-func (*FeedsSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{11}
+func (m *ValidatorAccuracy) GetReportCount() uint64 {
+	if m != nil {
+		return m.ReportCount
+	}
+	return 0
 }
-func (m *FeedsSignatureOrder) XXX_Unmarshal(b []byte) error {
+
+func (m *ValidatorAccuracy) GetOutlierCount() uint64 {
+	if m != nil {
+		return m.OutlierCount
+	}
+	return 0
+}
+
+func (m *ValidatorAccuracy) GetAccuracyScoreBPS() uint64 {
+	if m != nil {
+		return m.AccuracyScoreBPS
+	}
+	return 0
+}
+
+// AccuracyRecord is a structure that holds the number of reported and outlier prices of a single submission of a
+// validator.
+type AccuracyRecord struct {
+	// report_count is the number of prices reported in the submission.
+	ReportCount uint64 `protobuf:"varint,1,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// outlier_count is the number of outlier prices reported in the submission.
+	OutlierCount uint64 `protobuf:"varint,2,opt,name=outlier_count,json=outlierCount,proto3" json:"outlier_count,omitempty"`
+}
+
+func (m *AccuracyRecord) Reset()         { *m = AccuracyRecord{} }
+func (m *AccuracyRecord) String() string { return proto.CompactTextString(m) }
+func (*AccuracyRecord) ProtoMessage()    {}
+func (*AccuracyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{15}
+}
+func (m *AccuracyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccuracyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccuracyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccuracyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccuracyRecord.Merge(m, src)
+}
+func (m *AccuracyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccuracyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccuracyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccuracyRecord proto.InternalMessageInfo
+
+func (m *AccuracyRecord) GetReportCount() uint64 {
+	if m != nil {
+		return m.ReportCount
+	}
+	return 0
+}
+
+func (m *AccuracyRecord) GetOutlierCount() uint64 {
+	if m != nil {
+		return m.OutlierCount
+	}
+	return 0
+}
+
+// SignalMetadata is a structure that defines the metadata of a signal id in the signal registry.
+type SignalMetadata struct {
+	// signal_id is the signal id that the metadata describes.
+	SignalID string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// decimals is the number of decimals of the price of the signal id.
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// base_asset is the asset that is priced by the signal id.
+	BaseAsset string `protobuf:"bytes,3,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	// quote_asset is the asset that the price of the signal id is denominated in.
+	QuoteAsset string `protobuf:"bytes,4,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	// category is the category of the signal id, e.g. crypto, forex or commodity.
+	Category string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	// description is the description of the signal id.
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// status is the status of the signal id.
+	Status SignalMetadataStatus `protobuf:"varint,7,opt,name=status,proto3,enum=band.feeds.v1beta1.SignalMetadataStatus" json:"status,omitempty"`
+	// max_price_move_basis_point is the maximum move (in basis point) of the price of the signal id from its last
+	// available price before its circuit breaker is triggered. Zero falls back to the max_price_move_basis_point param.
+	MaxPriceMoveBasisPoint int64 `protobuf:"varint,8,opt,name=max_price_move_basis_point,json=maxPriceMoveBasisPoint,proto3" json:"max_price_move_basis_point,omitempty"`
+}
+
+func (m *SignalMetadata) Reset()         { *m = SignalMetadata{} }
+func (m *SignalMetadata) String() string { return proto.CompactTextString(m) }
+func (*SignalMetadata) ProtoMessage()    {}
+func (*SignalMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{16}
+}
+func (m *SignalMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalMetadata.Merge(m, src)
+}
+func (m *SignalMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalMetadata proto.InternalMessageInfo
+
+func (m *SignalMetadata) GetSignalID() string {
+	if m != nil {
+		return m.SignalID
+	}
+	return ""
+}
+
+func (m *SignalMetadata) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *SignalMetadata) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
+
+func (m *SignalMetadata) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
+func (m *SignalMetadata) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *SignalMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SignalMetadata) GetStatus() SignalMetadataStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SIGNAL_METADATA_STATUS_UNSPECIFIED
+}
+
+func (m *SignalMetadata) GetMaxPriceMoveBasisPoint() int64 {
+	if m != nil {
+		return m.MaxPriceMoveBasisPoint
+	}
+	return 0
+}
+
+// ReferenceSourceConfig is a structure that defines the information of reference price source.
+type ReferenceSourceConfig struct {
+	// registry_ipfs_hash is the hash of the reference registry.
+	RegistryIPFSHash string `protobuf:"bytes,1,opt,name=registry_ipfs_hash,json=registryIpfsHash,proto3" json:"registry_ipfs_hash,omitempty"`
+	// registry_version is the version of the reference registry.
+	RegistryVersion string `protobuf:"bytes,2,opt,name=registry_version,json=registryVersion,proto3" json:"registry_version,omitempty"`
+}
+
+func (m *ReferenceSourceConfig) Reset()         { *m = ReferenceSourceConfig{} }
+func (m *ReferenceSourceConfig) String() string { return proto.CompactTextString(m) }
+func (*ReferenceSourceConfig) ProtoMessage()    {}
+func (*ReferenceSourceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{17}
+}
+func (m *ReferenceSourceConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferenceSourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferenceSourceConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferenceSourceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferenceSourceConfig.Merge(m, src)
+}
+func (m *ReferenceSourceConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferenceSourceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferenceSourceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferenceSourceConfig proto.InternalMessageInfo
+
+func (m *ReferenceSourceConfig) GetRegistryIPFSHash() string {
+	if m != nil {
+		return m.RegistryIPFSHash
+	}
+	return ""
+}
+
+func (m *ReferenceSourceConfig) GetRegistryVersion() string {
+	if m != nil {
+		return m.RegistryVersion
+	}
+	return ""
+}
+
+// FeedsSignatureOrder defines a general signature order for feed data.
+type FeedsSignatureOrder struct {
+	// signal_ids is the list of signal ids that require signatures.
+	SignalIDs []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+	// encoder is the mode of encoding feeds signature order.
+	Encoder Encoder `protobuf:"varint,2,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+}
+
+func (m *FeedsSignatureOrder) Reset()         { *m = FeedsSignatureOrder{} }
+func (m *FeedsSignatureOrder) String() string { return proto.CompactTextString(m) }
+func (*FeedsSignatureOrder) ProtoMessage()    {}
+func (*FeedsSignatureOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{18}
+}
+func (m *FeedsSignatureOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 func (m *FeedsSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
@@ -849,17 +1467,26 @@ var xxx_messageInfo_FeedsSignatureOrder proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("band.feeds.v1beta1.PriceStatus", PriceStatus_name, PriceStatus_value)
+	proto.RegisterEnum("band.feeds.v1beta1.AggregationMethod", AggregationMethod_name, AggregationMethod_value)
 	proto.RegisterEnum("band.feeds.v1beta1.SignalPriceStatus", SignalPriceStatus_name, SignalPriceStatus_value)
+	proto.RegisterEnum("band.feeds.v1beta1.SignalMetadataStatus", SignalMetadataStatus_name, SignalMetadataStatus_value)
 	proto.RegisterType((*Signal)(nil), "band.feeds.v1beta1.Signal")
 	proto.RegisterType((*Vote)(nil), "band.feeds.v1beta1.Vote")
+	proto.RegisterType((*Delegation)(nil), "band.feeds.v1beta1.Delegation")
+	proto.RegisterType((*CircuitBreaker)(nil), "band.feeds.v1beta1.CircuitBreaker")
 	proto.RegisterType((*Feed)(nil), "band.feeds.v1beta1.Feed")
 	proto.RegisterType((*FeedWithDeviation)(nil), "band.feeds.v1beta1.FeedWithDeviation")
 	proto.RegisterType((*CurrentFeeds)(nil), "band.feeds.v1beta1.CurrentFeeds")
 	proto.RegisterType((*CurrentFeedWithDeviations)(nil), "band.feeds.v1beta1.CurrentFeedWithDeviations")
 	proto.RegisterType((*Price)(nil), "band.feeds.v1beta1.Price")
+	proto.RegisterType((*PriceConfidence)(nil), "band.feeds.v1beta1.PriceConfidence")
+	proto.RegisterType((*SignalAggregationMethod)(nil), "band.feeds.v1beta1.SignalAggregationMethod")
 	proto.RegisterType((*SignalPrice)(nil), "band.feeds.v1beta1.SignalPrice")
 	proto.RegisterType((*ValidatorPrice)(nil), "band.feeds.v1beta1.ValidatorPrice")
 	proto.RegisterType((*ValidatorPriceList)(nil), "band.feeds.v1beta1.ValidatorPriceList")
+	proto.RegisterType((*ValidatorAccuracy)(nil), "band.feeds.v1beta1.ValidatorAccuracy")
+	proto.RegisterType((*AccuracyRecord)(nil), "band.feeds.v1beta1.AccuracyRecord")
+	proto.RegisterType((*SignalMetadata)(nil), "band.feeds.v1beta1.SignalMetadata")
 	proto.RegisterType((*ReferenceSourceConfig)(nil), "band.feeds.v1beta1.ReferenceSourceConfig")
 	proto.RegisterType((*FeedsSignatureOrder)(nil), "band.feeds.v1beta1.FeedsSignatureOrder")
 }
@@ -867,69 +1494,110 @@ func init() {
 func init() { proto.RegisterFile("band/feeds/v1beta1/feeds.proto", fileDescriptor_fc3afe81d3b13674) }
 
 var fileDescriptor_fc3afe81d3b13674 = []byte{
-	// 989 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0x1a, 0x47,
-	0x14, 0x66, 0x31, 0x76, 0xcc, 0xb3, 0x6b, 0xe3, 0xb1, 0x13, 0x6d, 0x68, 0x02, 0xb6, 0x2b, 0x4b,
-	0x8e, 0xd5, 0x80, 0xe2, 0xb4, 0xaa, 0x64, 0xb5, 0xaa, 0xc0, 0xac, 0xeb, 0x55, 0x2d, 0x8c, 0x76,
-	0xc1, 0x51, 0x7b, 0x59, 0x2d, 0xec, 0x00, 0xa3, 0xe2, 0x5d, 0x34, 0x33, 0xd0, 0xe4, 0xd6, 0x63,
-	0x0e, 0x3d, 0x54, 0xea, 0x1f, 0x88, 0x54, 0xf5, 0xd2, 0x5e, 0x7a, 0xf0, 0x4f, 0xe8, 0x21, 0xc7,
-	0x28, 0xa7, 0x9e, 0x50, 0x85, 0x2f, 0xbd, 0xf5, 0x2f, 0x54, 0x3b, 0x33, 0x0b, 0xc6, 0x86, 0x56,
-	0xaa, 0x64, 0xe5, 0xc6, 0x7c, 0xdf, 0xf7, 0xf6, 0x7d, 0xef, 0xcd, 0xdb, 0xc7, 0x42, 0xa6, 0xee,
-	0xfa, 0x5e, 0xbe, 0x89, 0xb1, 0xc7, 0xf2, 0xfd, 0x27, 0x75, 0xcc, 0xdd, 0x27, 0xf2, 0x94, 0xeb,
-	0xd2, 0x80, 0x07, 0x08, 0x85, 0x7c, 0x4e, 0x22, 0x8a, 0x4f, 0xdf, 0x6f, 0x04, 0xec, 0x3c, 0x60,
-	0x8e, 0x50, 0xe4, 0xe5, 0x41, 0xca, 0xd3, 0x1b, 0xad, 0xa0, 0x15, 0x48, 0x3c, 0xfc, 0xa5, 0xd0,
-	0xcd, 0x29, 0x49, 0xb0, 0xdf, 0x08, 0x3c, 0x4c, 0xa5, 0x62, 0xfb, 0x53, 0x58, 0xb0, 0x49, 0xcb,
-	0x77, 0x3b, 0xe8, 0x1e, 0xc4, 0x89, 0xa7, 0x6b, 0x9b, 0xda, 0x6e, 0xb2, 0xb8, 0x30, 0x1c, 0x64,
-	0xe3, 0x66, 0xc9, 0x8a, 0x13, 0x0f, 0x6d, 0xc0, 0x7c, 0x37, 0xf8, 0x16, 0x53, 0x3d, 0xbe, 0xa9,
-	0xed, 0xce, 0x59, 0xf2, 0x70, 0x90, 0xf8, 0xeb, 0x55, 0x56, 0xdb, 0x7e, 0x0e, 0x89, 0xb3, 0x80,
-	0x63, 0x94, 0x83, 0xf9, 0x7e, 0xc0, 0x31, 0x55, 0xe1, 0xfa, 0xdb, 0x8b, 0xc7, 0x1b, 0xca, 0x5e,
-	0xc1, 0xf3, 0x28, 0x66, 0xcc, 0xe6, 0x94, 0xf8, 0x2d, 0x4b, 0xca, 0xd0, 0x01, 0xdc, 0x61, 0x22,
-	0x2b, 0xd3, 0xe3, 0x9b, 0x73, 0xbb, 0x4b, 0xfb, 0xe9, 0xdc, 0xcd, 0x72, 0x73, 0xd2, 0x58, 0x31,
-	0xf1, 0x7a, 0x90, 0x8d, 0x59, 0x51, 0x80, 0xca, 0x4c, 0x20, 0x71, 0x84, 0xb1, 0x87, 0x1e, 0x41,
-	0x52, 0x12, 0xce, 0xc8, 0xfc, 0xf2, 0x70, 0x90, 0x5d, 0x94, 0xb1, 0x66, 0xc9, 0x5a, 0x94, 0xb4,
-	0x39, 0xa3, 0x10, 0x94, 0x86, 0x45, 0xe2, 0x73, 0x4c, 0xfb, 0x6e, 0x47, 0x9f, 0x13, 0xc4, 0xe8,
-	0xac, 0x52, 0xfd, 0xa2, 0xc1, 0x5a, 0x98, 0xeb, 0x19, 0xe1, 0xed, 0x12, 0xee, 0x13, 0x97, 0x93,
-	0xc0, 0xbf, 0xd5, 0xc4, 0x68, 0x1f, 0xee, 0x7a, 0x51, 0x26, 0xa7, 0xee, 0x32, 0xc2, 0x9c, 0x6e,
-	0x40, 0x7c, 0xae, 0x27, 0x84, 0x70, 0x7d, 0x44, 0x16, 0x43, 0xae, 0x12, 0x52, 0x63, 0xb3, 0xcb,
-	0x87, 0x3d, 0x4a, 0xb1, 0xcf, 0x43, 0xcf, 0x0c, 0x7d, 0x04, 0xf3, 0xa2, 0xab, 0xba, 0x26, 0x1a,
-	0xad, 0x4f, 0x6b, 0x74, 0xa8, 0x54, 0x6d, 0x96, 0xe2, 0xd0, 0x40, 0xc7, 0x65, 0xdc, 0xe9, 0x75,
-	0x3d, 0x97, 0x63, 0x87, 0x93, 0x73, 0xcc, 0xb8, 0x7b, 0xde, 0x55, 0x25, 0xac, 0x87, 0x64, 0x4d,
-	0x70, 0xd5, 0x88, 0x42, 0x7b, 0xb0, 0x76, 0x35, 0xa6, 0xde, 0x09, 0x1a, 0xdf, 0xa8, 0xca, 0x56,
-	0xc7, 0xfa, 0x62, 0x08, 0x2b, 0xb3, 0xbf, 0x6b, 0x70, 0xff, 0x8a, 0xd9, 0x89, 0x06, 0x33, 0x54,
-	0x98, 0x74, 0xbe, 0x33, 0xcb, 0xf9, 0x44, 0xd8, 0xbb, 0x28, 0xe3, 0x67, 0x0d, 0xe6, 0x2b, 0x94,
-	0x34, 0x30, 0xfa, 0x04, 0x16, 0x18, 0x77, 0x79, 0x8f, 0x89, 0x89, 0x58, 0xd9, 0xcf, 0x4e, 0xf3,
-	0x2c, 0xa4, 0xb6, 0x90, 0x59, 0x4a, 0x3e, 0x39, 0x4d, 0xf1, 0xff, 0x9c, 0xa6, 0xf0, 0x09, 0xc2,
-	0x53, 0xc2, 0x92, 0x07, 0xf4, 0x00, 0x92, 0xe3, 0xea, 0xe4, 0x94, 0x8c, 0x01, 0xe5, 0xf3, 0x47,
-	0x0d, 0x96, 0xe4, 0x03, 0xa5, 0xdb, 0xcf, 0xae, 0xb9, 0xdd, 0x99, 0xfd, 0x12, 0xde, 0x86, 0x67,
-	0xe5, 0xea, 0x6f, 0x0d, 0x56, 0xce, 0xdc, 0x0e, 0xf1, 0x5c, 0x1e, 0x50, 0x69, 0xac, 0x06, 0xeb,
-	0xea, 0xc9, 0x42, 0xe8, 0xfc, 0x1f, 0x97, 0x6b, 0xec, 0x3a, 0x74, 0xcb, 0x4d, 0x46, 0x5b, 0xb0,
-	0x2c, 0x86, 0xc5, 0x69, 0x63, 0xd2, 0x6a, 0x73, 0x7d, 0x5e, 0x08, 0x96, 0x04, 0x76, 0x2c, 0x20,
-	0x55, 0xf1, 0x6f, 0x1a, 0xa0, 0xc9, 0x8a, 0x4f, 0x08, 0xe3, 0xe8, 0x73, 0x48, 0xf6, 0x23, 0x54,
-	0x6d, 0x94, 0xad, 0xb7, 0x17, 0x8f, 0x1f, 0xaa, 0x45, 0x3a, 0x8a, 0x98, 0xdc, 0xa8, 0xe3, 0x18,
-	0x64, 0x43, 0x6a, 0x74, 0x90, 0x9d, 0x8b, 0xd6, 0xeb, 0xf6, 0xb4, 0x9e, 0x4d, 0x5a, 0x50, 0x2f,
-	0xce, 0x6a, 0x7f, 0x02, 0x8d, 0xd6, 0xed, 0xf7, 0x1a, 0xdc, 0xb5, 0x70, 0x13, 0x53, 0xec, 0x37,
-	0xb0, 0x1d, 0xf4, 0x68, 0x03, 0x1f, 0x06, 0x7e, 0x93, 0xb4, 0x50, 0x11, 0x10, 0xc5, 0x2d, 0xc2,
-	0x38, 0x7d, 0xe1, 0x90, 0x6e, 0x93, 0x39, 0x6d, 0x97, 0xb5, 0x95, 0xfd, 0x8d, 0xe1, 0x20, 0x9b,
-	0xb2, 0x14, 0x6b, 0x56, 0x8e, 0xec, 0x63, 0x97, 0xb5, 0xad, 0x54, 0xa4, 0x37, 0xbb, 0x4d, 0x16,
-	0x22, 0xe8, 0x11, 0x8c, 0x30, 0xa7, 0x8f, 0x29, 0x23, 0x81, 0x2f, 0xef, 0xc7, 0x5a, 0x8d, 0xf0,
-	0x33, 0x09, 0x2b, 0x3b, 0xdf, 0x69, 0xb0, 0x2e, 0xd6, 0x9b, 0xb8, 0x3a, 0xde, 0xa3, 0xf8, 0x94,
-	0x7a, 0x98, 0xa2, 0x0f, 0x01, 0x46, 0x37, 0x2c, 0xf7, 0x46, 0xb2, 0xf8, 0xde, 0x70, 0x90, 0x4d,
-	0x46, 0x57, 0xcc, 0xac, 0x64, 0x74, 0xc7, 0x0c, 0x7d, 0x0c, 0x77, 0xd4, 0x9f, 0xa1, 0xc8, 0xb6,
-	0xb2, 0xff, 0xfe, 0xb4, 0x36, 0x19, 0x52, 0x62, 0x45, 0xda, 0x83, 0xc4, 0xcb, 0x57, 0xd9, 0xd8,
-	0xde, 0x85, 0x06, 0x4b, 0x57, 0x87, 0xeb, 0x01, 0xe8, 0x15, 0xcb, 0x3c, 0x34, 0x1c, 0xbb, 0x5a,
-	0xa8, 0xd6, 0x6c, 0xa7, 0x56, 0xb6, 0x2b, 0xc6, 0xa1, 0x79, 0x64, 0x1a, 0xa5, 0x54, 0x0c, 0x6d,
-	0x43, 0xe6, 0x1a, 0xfb, 0x65, 0xf9, 0xf4, 0x59, 0xd9, 0xb1, 0xcd, 0x2f, 0xca, 0x85, 0x13, 0xc7,
-	0x2c, 0xa5, 0x34, 0x94, 0x86, 0x7b, 0x13, 0x9a, 0xf2, 0x69, 0xd5, 0xb1, 0x8c, 0x42, 0xe9, 0xab,
-	0x54, 0xfc, 0x06, 0x57, 0x38, 0x2b, 0x98, 0x27, 0x85, 0xe2, 0x89, 0x91, 0x9a, 0x43, 0x3b, 0xb0,
-	0x75, 0x23, 0xce, 0x2c, 0x3b, 0x87, 0x35, 0xcb, 0x32, 0xca, 0x55, 0xe7, 0xc8, 0x30, 0x4a, 0x76,
-	0x2a, 0x91, 0x4e, 0xbc, 0xfc, 0x29, 0x13, 0xdb, 0xfb, 0x55, 0x83, 0xb5, 0x1b, 0x2f, 0x0b, 0xfa,
-	0x00, 0xb2, 0xca, 0xc9, 0xbf, 0xd4, 0x30, 0x5b, 0x54, 0xab, 0x54, 0x4e, 0xad, 0xaa, 0x11, 0x16,
-	0x31, 0x53, 0x34, 0x76, 0x1c, 0x47, 0x5b, 0xf0, 0x70, 0x9a, 0xe8, 0x4a, 0x51, 0xd2, 0x6d, 0xf1,
-	0xf8, 0xf5, 0x30, 0xa3, 0xbd, 0x19, 0x66, 0xb4, 0x3f, 0x87, 0x19, 0xed, 0x87, 0xcb, 0x4c, 0xec,
-	0xcd, 0x65, 0x26, 0xf6, 0xc7, 0x65, 0x26, 0xf6, 0x75, 0xae, 0x45, 0x78, 0xbb, 0x57, 0xcf, 0x35,
-	0x82, 0xf3, 0x7c, 0x78, 0x69, 0xe2, 0x6b, 0xa6, 0x11, 0x74, 0xf2, 0x8d, 0xb6, 0x4b, 0xfc, 0x7c,
-	0xff, 0x69, 0xfe, 0xb9, 0xfa, 0xee, 0xe1, 0x2f, 0xba, 0x98, 0xd5, 0x17, 0x84, 0xe0, 0xe9, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x51, 0x26, 0xa8, 0x77, 0x09, 0x00, 0x00,
+	// 1640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x23, 0x49,
+	0x15, 0x4e, 0x77, 0x9c, 0x4c, 0xfc, 0xf2, 0xcb, 0xae, 0x64, 0x67, 0x3d, 0xde, 0x1d, 0x3b, 0xc9,
+	0x68, 0x20, 0x3b, 0x62, 0x13, 0xed, 0x2c, 0x3f, 0xc4, 0x88, 0x15, 0xb4, 0xed, 0x9e, 0xa4, 0x45,
+	0xec, 0x58, 0x65, 0x27, 0x23, 0x38, 0xd0, 0x6a, 0x77, 0x57, 0xec, 0xd2, 0xda, 0xdd, 0x4d, 0x57,
+	0xd9, 0x33, 0x73, 0xe3, 0xb8, 0x07, 0x84, 0x90, 0xb8, 0xc0, 0x6d, 0x25, 0x4e, 0x0b, 0x12, 0xe2,
+	0xb0, 0x7f, 0x00, 0x07, 0x0e, 0x7b, 0x41, 0x5a, 0xed, 0x09, 0x2e, 0x11, 0xf2, 0x5c, 0xb8, 0xf1,
+	0x2f, 0xa0, 0xae, 0xaa, 0xf6, 0xef, 0xb0, 0x1b, 0xd0, 0x68, 0x6f, 0xa9, 0xef, 0x7d, 0xd5, 0xf5,
+	0xbd, 0xaf, 0x5e, 0xbd, 0xaa, 0x18, 0x0a, 0x2d, 0xc7, 0xf7, 0x8e, 0xaf, 0x08, 0xf1, 0xd8, 0xf1,
+	0xe0, 0xbd, 0x16, 0xe1, 0xce, 0x7b, 0x72, 0x74, 0x14, 0x46, 0x01, 0x0f, 0x10, 0x8a, 0xe3, 0x47,
+	0x12, 0x51, 0xf1, 0xfc, 0x3d, 0x37, 0x60, 0xbd, 0x80, 0xd9, 0x82, 0x71, 0x2c, 0x07, 0x92, 0x9e,
+	0xdf, 0x6d, 0x07, 0xed, 0x40, 0xe2, 0xf1, 0x5f, 0x0a, 0xdd, 0x5b, 0xb0, 0x08, 0xf1, 0xdd, 0xc0,
+	0x23, 0x91, 0x64, 0x1c, 0xfc, 0x00, 0x56, 0x1b, 0xb4, 0xed, 0x3b, 0x5d, 0x74, 0x17, 0x74, 0xea,
+	0xe5, 0xb4, 0x3d, 0xed, 0x30, 0x5d, 0x5a, 0x1d, 0x5e, 0x17, 0x75, 0xab, 0x82, 0x75, 0xea, 0xa1,
+	0x5d, 0x58, 0x09, 0x83, 0xe7, 0x24, 0xca, 0xe9, 0x7b, 0xda, 0xe1, 0x32, 0x96, 0x83, 0x27, 0xa9,
+	0x7f, 0x7d, 0x5c, 0xd4, 0x0e, 0x5e, 0x40, 0xea, 0x32, 0xe0, 0x04, 0x1d, 0xc1, 0xca, 0x20, 0xe0,
+	0x24, 0x52, 0xd3, 0x73, 0x5f, 0x7c, 0xfa, 0xee, 0xae, 0x92, 0x67, 0x78, 0x5e, 0x44, 0x18, 0x6b,
+	0xf0, 0x88, 0xfa, 0x6d, 0x2c, 0x69, 0xe8, 0x09, 0xdc, 0x61, 0x62, 0x55, 0x96, 0xd3, 0xf7, 0x96,
+	0x0f, 0xd7, 0x1f, 0xe7, 0x8f, 0xe6, 0xd3, 0x3d, 0x92, 0xc2, 0x4a, 0xa9, 0xcf, 0xae, 0x8b, 0x4b,
+	0x38, 0x99, 0xa0, 0x56, 0xfe, 0xad, 0x06, 0x50, 0x21, 0x5d, 0xd2, 0x76, 0x38, 0x0d, 0x7c, 0xf4,
+	0x5d, 0x48, 0x7b, 0x72, 0x14, 0x7c, 0xb9, 0x88, 0x31, 0x75, 0x62, 0x1e, 0x21, 0x39, 0xfd, 0x2b,
+	0xce, 0x23, 0x64, 0x6c, 0xca, 0xf2, 0xbc, 0x29, 0x7f, 0xd0, 0x60, 0xab, 0x4c, 0x23, 0xb7, 0x4f,
+	0x79, 0x29, 0x22, 0xce, 0x87, 0x24, 0x42, 0xef, 0x40, 0x5a, 0xca, 0xb7, 0x47, 0x16, 0x6f, 0x0c,
+	0xaf, 0x8b, 0x6b, 0x32, 0x43, 0xab, 0x82, 0xd7, 0x64, 0xd8, 0xf2, 0xd0, 0x37, 0x61, 0x3b, 0x22,
+	0x57, 0x24, 0x22, 0xbe, 0x4b, 0xec, 0x30, 0xa2, 0xae, 0xd4, 0x95, 0xc2, 0x5b, 0x23, 0xb8, 0x1e,
+	0xa3, 0x68, 0x1f, 0x36, 0x3a, 0x4e, 0x97, 0x13, 0x4f, 0xb1, 0x96, 0x05, 0x6b, 0x5d, 0x62, 0x92,
+	0xf2, 0x16, 0xa4, 0x15, 0xc5, 0xe1, 0xb9, 0x94, 0x50, 0xba, 0x26, 0x01, 0x83, 0x2b, 0xb1, 0x14,
+	0x52, 0x4f, 0x09, 0xf1, 0x6e, 0xa3, 0x70, 0x61, 0x41, 0xa0, 0x3c, 0xac, 0x51, 0x9f, 0x93, 0x68,
+	0xe0, 0x74, 0x95, 0x29, 0xa3, 0xf1, 0xd8, 0x97, 0x6c, 0xbc, 0xd6, 0x33, 0xca, 0x3b, 0x15, 0x32,
+	0xa0, 0x72, 0xe7, 0x5e, 0xe7, 0xc2, 0xe8, 0x31, 0xbc, 0xe1, 0x25, 0x2b, 0xd9, 0x2d, 0x87, 0x51,
+	0x66, 0x87, 0x01, 0xf5, 0x13, 0x33, 0x76, 0x46, 0xc1, 0x52, 0x1c, 0xab, 0xc7, 0xa1, 0xb1, 0xd8,
+	0x8d, 0x72, 0x3f, 0x8a, 0x88, 0xcf, 0x63, 0xcd, 0x0c, 0x7d, 0x1b, 0x56, 0x44, 0x75, 0xe6, 0x34,
+	0x51, 0xb0, 0xb9, 0x45, 0x05, 0x1b, 0x33, 0x55, 0xb9, 0x4a, 0x72, 0x2c, 0xa0, 0xeb, 0x30, 0x6e,
+	0xf7, 0x43, 0xcf, 0xe1, 0xc4, 0xe6, 0xb4, 0x47, 0x18, 0x77, 0x7a, 0xa1, 0x4a, 0x61, 0x27, 0x0e,
+	0x5e, 0x88, 0x58, 0x33, 0x09, 0xa1, 0x47, 0x90, 0x9d, 0x9c, 0xd3, 0xea, 0x06, 0xee, 0x87, 0x2a,
+	0xb3, 0xed, 0x31, 0xbf, 0x14, 0xc3, 0x4a, 0xec, 0x5f, 0x35, 0xb8, 0x37, 0x21, 0x76, 0xca, 0x60,
+	0x86, 0x8c, 0x69, 0xe5, 0x0f, 0x6f, 0x52, 0x3e, 0x35, 0xed, 0xeb, 0x48, 0xe3, 0x2f, 0x3a, 0xac,
+	0xc8, 0xc2, 0xfd, 0x1e, 0xac, 0x32, 0xee, 0xf0, 0x3e, 0x13, 0x15, 0xb1, 0xf5, 0xb8, 0xb8, 0x48,
+	0xb3, 0xa0, 0x36, 0x04, 0x0d, 0x2b, 0xfa, 0x74, 0x35, 0xe9, 0x5f, 0x5a, 0x4d, 0x13, 0x07, 0x47,
+	0x0e, 0xd0, 0xdb, 0x90, 0x1e, 0x67, 0x27, 0xab, 0x64, 0x0c, 0xa0, 0x26, 0x20, 0xa7, 0xdd, 0x8e,
+	0x54, 0xd7, 0xb1, 0x7b, 0x84, 0x77, 0x02, 0x2f, 0xb7, 0x22, 0x34, 0x2e, 0xf4, 0xd5, 0x18, 0xb3,
+	0xab, 0x82, 0x8c, 0xb3, 0xce, 0x2c, 0x84, 0xca, 0x00, 0x6e, 0xe0, 0x5f, 0x51, 0x2f, 0x3e, 0xdc,
+	0xb9, 0xd5, 0x3d, 0xed, 0x70, 0xfd, 0xf1, 0x83, 0x1b, 0x33, 0x2e, 0x8f, 0xa8, 0x78, 0x62, 0x9a,
+	0xb2, 0xf0, 0x77, 0x1a, 0x6c, 0xcf, 0xb0, 0xd0, 0x43, 0xd8, 0x8a, 0x48, 0x18, 0x44, 0x9c, 0x44,
+	0xb6, 0x1b, 0xf4, 0x7d, 0x2e, 0x4c, 0x4d, 0xe1, 0xcd, 0x04, 0x2d, 0xc7, 0x20, 0xfa, 0x3e, 0x6c,
+	0x8b, 0x03, 0x65, 0xb3, 0x8e, 0x13, 0x11, 0xbb, 0x15, 0x32, 0xd9, 0x78, 0x4a, 0xd9, 0xe1, 0x75,
+	0x71, 0xb3, 0x1e, 0x87, 0x1a, 0x71, 0xa4, 0x54, 0x6f, 0xe0, 0xcd, 0x70, 0x3c, 0x0c, 0x19, 0x2a,
+	0x00, 0x78, 0x94, 0x85, 0x24, 0x62, 0x34, 0xf0, 0x95, 0x9f, 0x13, 0x88, 0xd2, 0xf6, 0x27, 0x0d,
+	0xde, 0x94, 0xfb, 0x30, 0xe7, 0xca, 0x6d, 0xba, 0xc0, 0x07, 0xb0, 0xaa, 0x7c, 0xd7, 0x6f, 0xe3,
+	0xbb, 0x9a, 0x84, 0x1e, 0xc0, 0x66, 0x8f, 0xfa, 0x76, 0x92, 0x3b, 0x53, 0x72, 0x37, 0x7a, 0xd4,
+	0xc7, 0x09, 0xa6, 0x04, 0xff, 0x46, 0x83, 0x75, 0x29, 0x40, 0x56, 0xe5, 0x07, 0x33, 0x55, 0xf9,
+	0xf0, 0xe6, 0x4b, 0xeb, 0x75, 0xd4, 0xa6, 0x52, 0xf5, 0x6f, 0x0d, 0xb6, 0x2e, 0x9d, 0x2e, 0xf5,
+	0xe2, 0x0b, 0x4c, 0x0a, 0xbb, 0x80, 0x1d, 0xf5, 0x65, 0x41, 0xb4, 0xff, 0x17, 0x95, 0x59, 0x36,
+	0x0b, 0xbd, 0xee, 0xc3, 0xb4, 0x0f, 0x1b, 0xa2, 0x29, 0xd8, 0x1d, 0x42, 0xdb, 0x1d, 0x2e, 0x8e,
+	0xd1, 0x32, 0x5e, 0x17, 0xd8, 0xa9, 0x80, 0x54, 0xc6, 0x7f, 0xd6, 0x00, 0x4d, 0x67, 0x7c, 0x46,
+	0x19, 0x47, 0x3f, 0x84, 0xf4, 0x20, 0x41, 0x55, 0xcd, 0xec, 0x7f, 0xf1, 0xe9, 0xbb, 0xf7, 0xd5,
+	0xdd, 0x3d, 0x9a, 0x31, 0x73, 0x89, 0x8f, 0xe6, 0xa0, 0x06, 0x64, 0x46, 0x03, 0xe9, 0x5c, 0xf2,
+	0x1c, 0x39, 0x58, 0xe4, 0xd9, 0xb4, 0x04, 0xd5, 0x20, 0xb7, 0x07, 0x53, 0x68, 0x52, 0x3a, 0x9f,
+	0xe8, 0x90, 0x1d, 0x0b, 0x70, 0xdd, 0x7e, 0xe4, 0xb8, 0x2f, 0xff, 0x7f, 0xc5, 0x77, 0x61, 0xf5,
+	0x39, 0xf5, 0xbd, 0xe0, 0xb9, 0x7a, 0x13, 0xa8, 0x11, 0x7a, 0x07, 0x32, 0xac, 0xdf, 0xea, 0x51,
+	0x16, 0x1f, 0x37, 0x75, 0xc8, 0xe5, 0x4e, 0x6c, 0x8f, 0x71, 0x79, 0xcc, 0xf7, 0x61, 0x43, 0xd6,
+	0xbe, 0xa2, 0xa5, 0xe4, 0xb3, 0x41, 0x62, 0x92, 0xf2, 0x00, 0x36, 0x83, 0x3e, 0xef, 0xd2, 0x51,
+	0xbf, 0x58, 0x91, 0x47, 0x44, 0x81, 0x92, 0x54, 0x02, 0xe4, 0xa8, 0xbc, 0x6c, 0xe6, 0x06, 0xaa,
+	0x63, 0xac, 0x8a, 0x8e, 0xb1, 0x3b, 0xbc, 0x2e, 0x66, 0x92, 0xac, 0x1b, 0x6e, 0x20, 0x9b, 0x46,
+	0xc6, 0x99, 0x42, 0xc2, 0xc4, 0xab, 0x9f, 0xc1, 0x56, 0xc2, 0xc5, 0xc4, 0x0d, 0x22, 0x6f, 0x4e,
+	0xa3, 0xf6, 0x15, 0x34, 0xea, 0xf3, 0x1a, 0xd5, 0xf7, 0xff, 0xa1, 0xc3, 0x96, 0x2c, 0xd9, 0x2a,
+	0xe1, 0x8e, 0xe7, 0x70, 0xe7, 0x36, 0xed, 0x26, 0x0f, 0x6b, 0x1e, 0x71, 0x69, 0x4f, 0xbe, 0x55,
+	0xb5, 0xc3, 0x4d, 0x3c, 0x1a, 0xa3, 0xfb, 0x00, 0x2d, 0x87, 0x11, 0xdb, 0x61, 0x8c, 0x48, 0xc3,
+	0xd3, 0x38, 0x1d, 0x23, 0x46, 0x0c, 0xa0, 0x22, 0xac, 0xff, 0xbc, 0x1f, 0xf0, 0x24, 0x9e, 0x12,
+	0x71, 0x10, 0x90, 0x24, 0xe4, 0x61, 0xcd, 0x75, 0x38, 0x69, 0x07, 0xd1, 0x4b, 0xe1, 0x71, 0x1a,
+	0x8f, 0xc6, 0x68, 0x0f, 0xd6, 0x3d, 0xc2, 0xdc, 0x88, 0x86, 0x71, 0x13, 0x13, 0xc6, 0xa6, 0xf1,
+	0x24, 0x84, 0x7e, 0x34, 0x6a, 0x47, 0x77, 0xc4, 0x41, 0x3f, 0xbc, 0xf9, 0xa0, 0x27, 0x89, 0xcf,
+	0x74, 0xa4, 0x27, 0x90, 0xef, 0x39, 0x2f, 0x54, 0xd3, 0xe8, 0x05, 0x03, 0x32, 0xf5, 0x46, 0x5a,
+	0x13, 0xe7, 0xf1, 0x6e, 0xcf, 0x79, 0x21, 0x4a, 0xbb, 0x1a, 0x0c, 0xc8, 0xdc, 0x33, 0xe9, 0x97,
+	0x1a, 0xbc, 0x81, 0x93, 0x77, 0x69, 0x23, 0xe8, 0x47, 0xea, 0xe6, 0x69, 0xc7, 0xf5, 0x11, 0x91,
+	0x36, 0x65, 0x3c, 0x7a, 0x69, 0xd3, 0xf0, 0x8a, 0xd9, 0x1d, 0x87, 0x75, 0x94, 0xd7, 0xa2, 0x3e,
+	0xb0, 0x8a, 0x5a, 0xf5, 0xa7, 0x8d, 0x53, 0x87, 0x75, 0x70, 0x26, 0xe1, 0x5b, 0xe1, 0x15, 0x8b,
+	0x91, 0xb8, 0xac, 0x47, 0xdf, 0x18, 0xa8, 0xdb, 0x45, 0xf4, 0x21, 0xbc, 0x9d, 0xe0, 0x97, 0x53,
+	0x57, 0xcc, 0x2f, 0x34, 0xd8, 0x11, 0xcf, 0x35, 0x91, 0x36, 0xef, 0x47, 0xe4, 0x3c, 0xf2, 0x48,
+	0x84, 0xbe, 0x05, 0x30, 0xda, 0x6f, 0xf9, 0x0e, 0x4a, 0x97, 0x36, 0x87, 0xd7, 0xc5, 0x74, 0xb2,
+	0xe1, 0x0c, 0xa7, 0x93, 0x1d, 0x67, 0xe8, 0x3b, 0x70, 0x47, 0xfd, 0x93, 0xa4, 0xae, 0x98, 0xb7,
+	0x16, 0x39, 0x6b, 0x4a, 0x0a, 0x4e, 0xb8, 0x4f, 0x52, 0x1f, 0x7d, 0x5c, 0x5c, 0x7a, 0xf4, 0x37,
+	0x0d, 0xd6, 0x27, 0x9b, 0xe8, 0xdb, 0x90, 0xab, 0x63, 0xab, 0x6c, 0xda, 0x8d, 0xa6, 0xd1, 0xbc,
+	0x68, 0xd8, 0x17, 0xb5, 0x46, 0xdd, 0x2c, 0x5b, 0x4f, 0x2d, 0xb3, 0x92, 0x59, 0x42, 0x07, 0x50,
+	0x98, 0x89, 0xfe, 0xb8, 0x76, 0xfe, 0xac, 0x66, 0x37, 0xac, 0x93, 0x9a, 0x71, 0x66, 0x5b, 0x95,
+	0x8c, 0x86, 0xf2, 0x70, 0x77, 0x8a, 0x53, 0x3b, 0x6f, 0xda, 0xd8, 0x34, 0x2a, 0x3f, 0xc9, 0xe8,
+	0x73, 0x31, 0xe3, 0xd2, 0xb0, 0xce, 0x8c, 0xd2, 0x99, 0x99, 0x59, 0x46, 0x0f, 0x61, 0x7f, 0x6e,
+	0x9e, 0x55, 0xb3, 0xcb, 0x17, 0x18, 0x9b, 0xb5, 0xa6, 0xfd, 0xd4, 0x34, 0x2b, 0x8d, 0x4c, 0x0a,
+	0xbd, 0x09, 0x3b, 0x53, 0xb4, 0x53, 0xe3, 0xac, 0x69, 0x56, 0x32, 0x2b, 0xf9, 0xd4, 0x47, 0xbf,
+	0x2f, 0x2c, 0x3d, 0xfa, 0x44, 0x83, 0xec, 0xfc, 0x7d, 0x7d, 0x00, 0x05, 0xe3, 0xe4, 0x04, 0x9b,
+	0x27, 0x46, 0xd3, 0x3a, 0xaf, 0xd9, 0x55, 0xb3, 0x79, 0x7a, 0x5e, 0x99, 0xc9, 0xed, 0x1b, 0x70,
+	0xb0, 0x80, 0xf3, 0xcc, 0xb4, 0x4e, 0x4e, 0x9b, 0x66, 0xc5, 0xae, 0x9a, 0x15, 0xcb, 0xa8, 0x65,
+	0x34, 0xf4, 0x00, 0x8a, 0x0b, 0x78, 0x4d, 0x6c, 0x55, 0xab, 0x82, 0x66, 0xd4, 0x32, 0x3a, 0xba,
+	0x0f, 0xf7, 0x16, 0x90, 0xd4, 0x37, 0x96, 0x95, 0xd6, 0x3f, 0x6a, 0x90, 0x9d, 0xbb, 0xd9, 0xe2,
+	0xef, 0x2b, 0x3b, 0xff, 0xcb, 0x46, 0xdc, 0x4c, 0xba, 0xa8, 0xd7, 0xcf, 0x71, 0xec, 0x88, 0x76,
+	0x33, 0x69, 0x6c, 0xbb, 0x8e, 0xf6, 0xe1, 0xfe, 0x22, 0xd2, 0xc4, 0xce, 0x28, 0xb5, 0xbf, 0xd2,
+	0x60, 0x77, 0xd1, 0xf1, 0x8c, 0x8d, 0x53, 0x5f, 0xa8, 0x9a, 0x4d, 0xa3, 0x62, 0x34, 0x8d, 0xc5,
+	0x9a, 0xc7, 0x2b, 0xcd, 0xf2, 0x8c, 0x72, 0xd3, 0xba, 0x34, 0x33, 0x5a, 0x5c, 0x03, 0x37, 0x50,
+	0x2a, 0x66, 0x1d, 0x9b, 0x65, 0x23, 0x4e, 0x4c, 0x97, 0x82, 0x4a, 0xa7, 0x9f, 0x0d, 0x0b, 0xda,
+	0xe7, 0xc3, 0x82, 0xf6, 0xcf, 0x61, 0x41, 0xfb, 0xf5, 0xab, 0xc2, 0xd2, 0xe7, 0xaf, 0x0a, 0x4b,
+	0x7f, 0x7f, 0x55, 0x58, 0xfa, 0xe9, 0x51, 0x9b, 0xf2, 0x4e, 0xbf, 0x75, 0xe4, 0x06, 0xbd, 0xe3,
+	0xf8, 0x28, 0x88, 0xdf, 0x0e, 0xdc, 0xa0, 0x7b, 0xec, 0x76, 0x1c, 0xea, 0x1f, 0x0f, 0xde, 0x3f,
+	0x7e, 0xa1, 0x7e, 0x65, 0xe0, 0x2f, 0x43, 0xc2, 0x5a, 0xab, 0x82, 0xf0, 0xfe, 0x7f, 0x06, 0x00,
+	0xa1, 0xb6, 0xab, 0x45, 0xe5, 0x10, 0x00, 0x00,
 }
 
 func (this *Signal) Equal(that interface{}) bool {
@@ -991,6 +1659,69 @@ func (this *Vote) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Delegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Delegation)
+	if !ok {
+		that2, ok := that.(Delegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Delegator != that1.Delegator {
+		return false
+	}
+	if this.Delegatee != that1.Delegatee {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	return true
+}
+func (this *CircuitBreaker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CircuitBreaker)
+	if !ok {
+		that2, ok := that.(CircuitBreaker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignalID != that1.SignalID {
+		return false
+	}
+	if this.ReferencePrice != that1.ReferencePrice {
+		return false
+	}
+	if this.HaltedPrice != that1.HaltedPrice {
+		return false
+	}
+	if this.HaltedAt != that1.HaltedAt {
+		return false
+	}
+	return true
+}
 func (this *Feed) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1155,16 +1886,22 @@ func (this *Price) Equal(that interface{}) bool {
 	if this.Timestamp != that1.Timestamp {
 		return false
 	}
+	if this.AggregationMethod != that1.AggregationMethod {
+		return false
+	}
+	if !this.Confidence.Equal(that1.Confidence) {
+		return false
+	}
 	return true
 }
-func (this *SignalPrice) Equal(that interface{}) bool {
+func (this *PriceConfidence) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SignalPrice)
+	that1, ok := that.(*PriceConfidence)
 	if !ok {
-		that2, ok := that.(SignalPrice)
+		that2, ok := that.(PriceConfidence)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1176,25 +1913,25 @@ func (this *SignalPrice) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Status != that1.Status {
+	if this.ReporterCount != that1.ReporterCount {
 		return false
 	}
-	if this.SignalID != that1.SignalID {
+	if this.PowerShareBPS != that1.PowerShareBPS {
 		return false
 	}
-	if this.Price != that1.Price {
+	if this.Dispersion != that1.Dispersion {
 		return false
 	}
 	return true
 }
-func (this *ValidatorPrice) Equal(that interface{}) bool {
+func (this *SignalAggregationMethod) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidatorPrice)
+	that1, ok := that.(*SignalAggregationMethod)
 	if !ok {
-		that2, ok := that.(ValidatorPrice)
+		that2, ok := that.(SignalAggregationMethod)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1206,31 +1943,25 @@ func (this *ValidatorPrice) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SignalPriceStatus != that1.SignalPriceStatus {
-		return false
-	}
 	if this.SignalID != that1.SignalID {
 		return false
 	}
-	if this.Price != that1.Price {
-		return false
-	}
-	if this.Timestamp != that1.Timestamp {
+	if this.Method != that1.Method {
 		return false
 	}
-	if this.BlockHeight != that1.BlockHeight {
+	if this.MinReporters != that1.MinReporters {
 		return false
 	}
 	return true
 }
-func (this *ValidatorPriceList) Equal(that interface{}) bool {
+func (this *SignalPrice) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidatorPriceList)
+	that1, ok := that.(*SignalPrice)
 	if !ok {
-		that2, ok := that.(ValidatorPriceList)
+		that2, ok := that.(SignalPrice)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1242,17 +1973,194 @@ func (this *ValidatorPriceList) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Validator != that1.Validator {
+	if this.Status != that1.Status {
 		return false
 	}
-	if len(this.ValidatorPrices) != len(that1.ValidatorPrices) {
+	if this.SignalID != that1.SignalID {
 		return false
 	}
-	for i := range this.ValidatorPrices {
-		if !this.ValidatorPrices[i].Equal(&that1.ValidatorPrices[i]) {
-			return false
+	if this.Price != that1.Price {
+		return false
+	}
+	return true
+}
+func (this *ValidatorPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorPrice)
+	if !ok {
+		that2, ok := that.(ValidatorPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignalPriceStatus != that1.SignalPriceStatus {
+		return false
+	}
+	if this.SignalID != that1.SignalID {
+		return false
+	}
+	if this.Price != that1.Price {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	return true
+}
+func (this *ValidatorPriceList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorPriceList)
+	if !ok {
+		that2, ok := that.(ValidatorPriceList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if len(this.ValidatorPrices) != len(that1.ValidatorPrices) {
+		return false
+	}
+	for i := range this.ValidatorPrices {
+		if !this.ValidatorPrices[i].Equal(&that1.ValidatorPrices[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorAccuracy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorAccuracy)
+	if !ok {
+		that2, ok := that.(ValidatorAccuracy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if this.SubmissionCount != that1.SubmissionCount {
+		return false
+	}
+	if this.ReportCount != that1.ReportCount {
+		return false
+	}
+	if this.OutlierCount != that1.OutlierCount {
+		return false
+	}
+	if this.AccuracyScoreBPS != that1.AccuracyScoreBPS {
+		return false
+	}
+	return true
+}
+func (this *AccuracyRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccuracyRecord)
+	if !ok {
+		that2, ok := that.(AccuracyRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ReportCount != that1.ReportCount {
+		return false
+	}
+	if this.OutlierCount != that1.OutlierCount {
+		return false
+	}
+	return true
+}
+func (this *SignalMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SignalMetadata)
+	if !ok {
+		that2, ok := that.(SignalMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignalID != that1.SignalID {
+		return false
+	}
+	if this.Decimals != that1.Decimals {
+		return false
+	}
+	if this.BaseAsset != that1.BaseAsset {
+		return false
+	}
+	if this.QuoteAsset != that1.QuoteAsset {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.MaxPriceMoveBasisPoint != that1.MaxPriceMoveBasisPoint {
+		return false
+	}
 	return true
 }
 func (this *ReferenceSourceConfig) Equal(that interface{}) bool {
@@ -1361,6 +2269,93 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaltedAt != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.HaltedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HaltedPrice != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.HaltedPrice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReferencePrice != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.ReferencePrice))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Feed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1560,6 +2555,23 @@ func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Confidence != nil {
+		{
+			size, err := m.Confidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeeds(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AggregationMethod != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.AggregationMethod))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintFeeds(dAtA, i, uint64(m.Timestamp))
 		i--
@@ -1585,7 +2597,7 @@ func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SignalPrice) Marshal() (dAtA []byte, err error) {
+func (m *PriceConfidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1595,37 +2607,35 @@ func (m *SignalPrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignalPrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceConfidence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignalPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceConfidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Price != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
+	if m.Dispersion != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Dispersion))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.SignalID) > 0 {
-		i -= len(m.SignalID)
-		copy(dAtA[i:], m.SignalID)
-		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+	if m.PowerShareBPS != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.PowerShareBPS))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Status != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Status))
+	if m.ReporterCount != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.ReporterCount))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorPrice) Marshal() (dAtA []byte, err error) {
+func (m *SignalAggregationMethod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1635,25 +2645,105 @@ func (m *ValidatorPrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorPrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalAggregationMethod) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalAggregationMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.BlockHeight))
+	if m.MinReporters != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.MinReporters))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x18
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Timestamp))
+	if m.Method != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Method))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Price != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Price != 0 {
 		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
@@ -1719,6 +2809,167 @@ func (m *ValidatorPriceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorAccuracy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAccuracy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAccuracy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccuracyScoreBPS != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.AccuracyScoreBPS))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OutlierCount != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.OutlierCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReportCount != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.ReportCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SubmissionCount != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.SubmissionCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccuracyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccuracyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccuracyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutlierCount != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.OutlierCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReportCount != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.ReportCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPriceMoveBasisPoint != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.MaxPriceMoveBasisPoint))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ReferenceSourceConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1839,6 +3090,48 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovFeeds(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.ReferencePrice != 0 {
+		n += 1 + sovFeeds(uint64(m.ReferencePrice))
+	}
+	if m.HaltedPrice != 0 {
+		n += 1 + sovFeeds(uint64(m.HaltedPrice))
+	}
+	if m.HaltedAt != 0 {
+		n += 1 + sovFeeds(uint64(m.HaltedAt))
+	}
+	return n
+}
+
 func (m *Feed) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1941,6 +3234,50 @@ func (m *Price) Size() (n int) {
 	if m.Timestamp != 0 {
 		n += 1 + sovFeeds(uint64(m.Timestamp))
 	}
+	if m.AggregationMethod != 0 {
+		n += 1 + sovFeeds(uint64(m.AggregationMethod))
+	}
+	if m.Confidence != nil {
+		l = m.Confidence.Size()
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceConfidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReporterCount != 0 {
+		n += 1 + sovFeeds(uint64(m.ReporterCount))
+	}
+	if m.PowerShareBPS != 0 {
+		n += 1 + sovFeeds(uint64(m.PowerShareBPS))
+	}
+	if m.Dispersion != 0 {
+		n += 1 + sovFeeds(uint64(m.Dispersion))
+	}
+	return n
+}
+
+func (m *SignalAggregationMethod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Method != 0 {
+		n += 1 + sovFeeds(uint64(m.Method))
+	}
+	if m.MinReporters != 0 {
+		n += 1 + sovFeeds(uint64(m.MinReporters))
+	}
 	return n
 }
 
@@ -2007,17 +3344,98 @@ func (m *ValidatorPriceList) Size() (n int) {
 	return n
 }
 
-func (m *ReferenceSourceConfig) Size() (n int) {
+func (m *ValidatorAccuracy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RegistryIPFSHash)
+	l = len(m.Validator)
 	if l > 0 {
 		n += 1 + l + sovFeeds(uint64(l))
 	}
-	l = len(m.RegistryVersion)
+	if m.Window != 0 {
+		n += 1 + sovFeeds(uint64(m.Window))
+	}
+	if m.SubmissionCount != 0 {
+		n += 1 + sovFeeds(uint64(m.SubmissionCount))
+	}
+	if m.ReportCount != 0 {
+		n += 1 + sovFeeds(uint64(m.ReportCount))
+	}
+	if m.OutlierCount != 0 {
+		n += 1 + sovFeeds(uint64(m.OutlierCount))
+	}
+	if m.AccuracyScoreBPS != 0 {
+		n += 1 + sovFeeds(uint64(m.AccuracyScoreBPS))
+	}
+	return n
+}
+
+func (m *AccuracyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReportCount != 0 {
+		n += 1 + sovFeeds(uint64(m.ReportCount))
+	}
+	if m.OutlierCount != 0 {
+		n += 1 + sovFeeds(uint64(m.OutlierCount))
+	}
+	return n
+}
+
+func (m *SignalMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovFeeds(uint64(m.Decimals))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovFeeds(uint64(m.Status))
+	}
+	if m.MaxPriceMoveBasisPoint != 0 {
+		n += 1 + sovFeeds(uint64(m.MaxPriceMoveBasisPoint))
+	}
+	return n
+}
+
+func (m *ReferenceSourceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RegistryIPFSHash)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	l = len(m.RegistryVersion)
 	if l > 0 {
 		n += 1 + l + sovFeeds(uint64(l))
 	}
@@ -2265,7 +3683,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Feed) Unmarshal(dAtA []byte) error {
+func (m *Delegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2288,15 +3706,15 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Feed: wiretype end group for non-group")
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Feed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2324,13 +3742,13 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalID = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
 			}
-			m.Power = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2340,16 +3758,29 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.Interval = 0
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2359,7 +3790,7 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= int64(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2385,7 +3816,7 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2408,10 +3839,10 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeedWithDeviation: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeedWithDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2448,9 +3879,9 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
 			}
-			m.Power = 0
+			m.ReferencePrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2460,16 +3891,16 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				m.ReferencePrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedPrice", wireType)
 			}
-			m.Interval = 0
+			m.HaltedPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2479,16 +3910,16 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= int64(b&0x7F) << shift
+				m.HaltedPrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviationBasisPoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedAt", wireType)
 			}
-			m.DeviationBasisPoint = 0
+			m.HaltedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2498,7 +3929,7 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeviationBasisPoint |= int64(b&0x7F) << shift
+				m.HaltedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2524,7 +3955,7 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
+func (m *Feed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2547,17 +3978,17 @@ func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentFeeds: wiretype end group for non-group")
+			return fmt.Errorf("proto: Feed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentFeeds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Feed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2567,31 +3998,29 @@ func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeeds
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeeds
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeds = append(m.Feeds, Feed{})
-			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.LastUpdateTimestamp = 0
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2601,16 +4030,16 @@ func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.LastUpdateBlock = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2620,7 +4049,7 @@ func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdateBlock |= int64(b&0x7F) << shift
+				m.Interval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2646,7 +4075,7 @@ func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
+func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2669,17 +4098,17 @@ func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentFeedWithDeviations: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedWithDeviation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentFeedWithDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedWithDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2689,31 +4118,29 @@ func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeeds
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeeds
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeds = append(m.Feeds, FeedWithDeviation{})
-			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.LastUpdateTimestamp = 0
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2723,16 +4150,16 @@ func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.LastUpdateBlock = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2742,7 +4169,26 @@ func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdateBlock |= int64(b&0x7F) << shift
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationBasisPoint", wireType)
+			}
+			m.DeviationBasisPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationBasisPoint |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2768,7 +4214,7 @@ func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Price) Unmarshal(dAtA []byte) error {
+func (m *CurrentFeeds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2791,36 +4237,17 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Price: wiretype end group for non-group")
+			return fmt.Errorf("proto: CurrentFeeds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CurrentFeeds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFeeds
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= PriceStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2830,29 +4257,31 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFeeds
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeeds
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalID = string(dAtA[iNdEx:postIndex])
+			m.Feeds = append(m.Feeds, Feed{})
+			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
 			}
-			m.Price = 0
+			m.LastUpdateTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2862,16 +4291,16 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
 			}
-			m.Timestamp = 0
+			m.LastUpdateBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2881,7 +4310,7 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.LastUpdateBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2907,7 +4336,7 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalPrice) Unmarshal(dAtA []byte) error {
+func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2930,17 +4359,17 @@ func (m *SignalPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: CurrentFeedWithDeviations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CurrentFeedWithDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2950,16 +4379,31 @@ func (m *SignalPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SignalPriceStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeds = append(m.Feeds, FeedWithDeviation{})
+			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
 			}
-			var stringLen uint64
+			m.LastUpdateTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -2969,29 +4413,16 @@ func (m *SignalPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFeeds
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFeeds
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignalID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
 			}
-			m.Price = 0
+			m.LastUpdateBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -3001,7 +4432,7 @@ func (m *SignalPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.LastUpdateBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3027,7 +4458,7 @@ func (m *SignalPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
+func (m *Price) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3050,17 +4481,17 @@ func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: Price: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalPriceStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.SignalPriceStatus = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -3070,7 +4501,7 @@ func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignalPriceStatus |= SignalPriceStatus(b&0x7F) << shift
+				m.Status |= PriceStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3147,9 +4578,9 @@ func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
 			}
-			m.BlockHeight = 0
+			m.AggregationMethod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -3159,11 +4590,47 @@ func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Confidence == nil {
+				m.Confidence = &PriceConfidence{}
+			}
+			if err := m.Confidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeeds(dAtA[iNdEx:])
@@ -3185,7 +4652,7 @@ func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPriceList) Unmarshal(dAtA []byte) error {
+func (m *PriceConfidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3208,15 +4675,122 @@ func (m *ValidatorPriceList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPriceList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceConfidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPriceList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceConfidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterCount", wireType)
+			}
+			m.ReporterCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReporterCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShareBPS", wireType)
+			}
+			m.PowerShareBPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerShareBPS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
+			}
+			m.Dispersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dispersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalAggregationMethod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalAggregationMethod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalAggregationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3244,13 +4818,13 @@ func (m *ValidatorPriceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.SignalID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			m.Method = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeeds
@@ -3260,26 +4834,956 @@ func (m *ValidatorPriceList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Method |= AggregationMethod(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFeeds
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReporters", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.MinReporters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinReporters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFeeds
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorPrices = append(m.ValidatorPrices, ValidatorPrice{})
-			if err := m.ValidatorPrices[len(m.ValidatorPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SignalPriceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalPriceStatus", wireType)
+			}
+			m.SignalPriceStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalPriceStatus |= SignalPriceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPriceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPriceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPriceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPrices = append(m.ValidatorPrices, ValidatorPrice{})
+			if err := m.ValidatorPrices[len(m.ValidatorPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccuracy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccuracy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccuracy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionCount", wireType)
+			}
+			m.SubmissionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportCount", wireType)
+			}
+			m.ReportCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierCount", wireType)
+			}
+			m.OutlierCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutlierCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccuracyScoreBPS", wireType)
+			}
+			m.AccuracyScoreBPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccuracyScoreBPS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccuracyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccuracyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccuracyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportCount", wireType)
+			}
+			m.ReportCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierCount", wireType)
+			}
+			m.OutlierCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutlierCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SignalMetadataStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceMoveBasisPoint", wireType)
+			}
+			m.MaxPriceMoveBasisPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceMoveBasisPoint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeeds(dAtA[iNdEx:])
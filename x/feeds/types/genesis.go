@@ -5,17 +5,30 @@ func NewGenesisState(
 	params Params,
 	votes []Vote,
 	rs ReferenceSourceConfig,
+	signalMetadata []SignalMetadata,
+	delegations []Delegation,
+	circuitBreakers []CircuitBreaker,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                params,
 		Votes:                 votes,
 		ReferenceSourceConfig: rs,
+		SignalMetadata:        signalMetadata,
+		Delegations:           delegations,
+		CircuitBreakers:       circuitBreakers,
 	}
 }
 
 // DefaultGenesisState returns the default genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Vote{}, DefaultReferenceSourceConfig())
+	return NewGenesisState(
+		DefaultParams(),
+		[]Vote{},
+		DefaultReferenceSourceConfig(),
+		[]SignalMetadata{},
+		[]Delegation{},
+		[]CircuitBreaker{},
+	)
 }
 
 // Validate performs basic genesis state validation
@@ -34,5 +47,38 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	signalIDs := make(map[string]bool, len(gs.SignalMetadata))
+	for _, m := range gs.SignalMetadata {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+		if signalIDs[m.SignalID] {
+			return ErrDuplicateSignalID.Wrapf("duplicate metadata of signal id %s", m.SignalID)
+		}
+		signalIDs[m.SignalID] = true
+	}
+
+	delegators := make(map[string]bool, len(gs.Delegations))
+	for _, d := range gs.Delegations {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		if delegators[d.Delegator] {
+			return ErrInvalidDelegation.Wrapf("duplicate delegation of delegator %s", d.Delegator)
+		}
+		delegators[d.Delegator] = true
+	}
+
+	haltedSignalIDs := make(map[string]bool, len(gs.CircuitBreakers))
+	for _, c := range gs.CircuitBreakers {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if haltedSignalIDs[c.SignalID] {
+			return ErrDuplicateSignalID.Wrapf("duplicate circuit breaker of signal id %s", c.SignalID)
+		}
+		haltedSignalIDs[c.SignalID] = true
+	}
+
 	return nil
 }
@@ -31,6 +31,12 @@ type GenesisState struct {
 	Votes []Vote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
 	// reference_source_config is the information about reference price config.
 	ReferenceSourceConfig ReferenceSourceConfig `protobuf:"bytes,3,opt,name=reference_source_config,json=referenceSourceConfig,proto3" json:"reference_source_config"`
+	// signal_metadata is a list of metadata of the signal ids in the signal registry.
+	SignalMetadata []SignalMetadata `protobuf:"bytes,4,rep,name=signal_metadata,json=signalMetadata,proto3" json:"signal_metadata"`
+	// delegations is a list of delegations of feeds voting power.
+	Delegations []Delegation `protobuf:"bytes,5,rep,name=delegations,proto3" json:"delegations"`
+	// circuit_breakers is a list of triggered circuit breakers.
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,6,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +93,27 @@ func (m *GenesisState) GetReferenceSourceConfig() ReferenceSourceConfig {
 	return ReferenceSourceConfig{}
 }
 
+func (m *GenesisState) GetSignalMetadata() []SignalMetadata {
+	if m != nil {
+		return m.SignalMetadata
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "band.feeds.v1beta1.GenesisState")
 }
@@ -94,25 +121,31 @@ func init() {
 func init() { proto.RegisterFile("band/feeds/v1beta1/genesis.proto", fileDescriptor_3665d63bc534e43f) }
 
 var fileDescriptor_3665d63bc534e43f = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x63, 0x0a, 0x1d, 0x52, 0xa6, 0x08, 0x44, 0x94, 0xc1, 0x8d, 0x98, 0xca, 0x62, 0xab,
-	0x2d, 0x03, 0x33, 0x0c, 0x30, 0xa2, 0x56, 0x62, 0x60, 0xa9, 0x1c, 0xf7, 0xe2, 0x46, 0xa2, 0x71,
-	0x64, 0xbb, 0x11, 0xbc, 0x05, 0x8f, 0xd5, 0xb1, 0x23, 0x13, 0x42, 0xc9, 0xc0, 0x6b, 0xa0, 0x38,
-	0x66, 0xaa, 0xb7, 0x93, 0xfe, 0xef, 0xff, 0xee, 0x74, 0x61, 0x9a, 0xb1, 0x72, 0x4d, 0x73, 0x80,
-	0xb5, 0xa6, 0xf5, 0x34, 0x03, 0xc3, 0xa6, 0x54, 0x40, 0x09, 0xba, 0xd0, 0xa4, 0x52, 0xd2, 0xc8,
-	0x28, 0xea, 0x08, 0x62, 0x09, 0xe2, 0x88, 0xe4, 0x42, 0x48, 0x21, 0x6d, 0x4c, 0xbb, 0xa9, 0x27,
-	0x93, 0xb1, 0xc7, 0x55, 0x31, 0xc5, 0xb6, 0x4e, 0x95, 0x60, 0x0f, 0xd0, 0x8b, 0x6d, 0x7e, 0xfd,
-	0x8b, 0xc2, 0xf3, 0xc7, 0x7e, 0xf9, 0xd2, 0x30, 0x03, 0xd1, 0x5d, 0x38, 0xec, 0x05, 0x31, 0x4a,
-	0xd1, 0x64, 0x34, 0x4b, 0xc8, 0xf1, 0x31, 0xe4, 0xd9, 0x12, 0xf7, 0xa7, 0xfb, 0xef, 0x71, 0xb0,
-	0x70, 0x7c, 0x74, 0x1b, 0x9e, 0xd5, 0xd2, 0x80, 0x8e, 0x4f, 0xd2, 0xc1, 0x64, 0x34, 0x8b, 0x7d,
-	0xc5, 0x17, 0x69, 0xc0, 0xd5, 0x7a, 0x38, 0x12, 0xe1, 0x95, 0x82, 0x1c, 0x14, 0x94, 0x1c, 0x56,
-	0x5a, 0xee, 0x14, 0x87, 0x15, 0x97, 0x65, 0x5e, 0x88, 0x78, 0x60, 0x0f, 0xb8, 0xf1, 0x79, 0x16,
-	0xff, 0x95, 0xa5, 0x6d, 0x3c, 0xd8, 0x82, 0x13, 0x5f, 0x2a, 0x6f, 0xf8, 0xb4, 0x6f, 0x30, 0x3a,
-	0x34, 0x18, 0xfd, 0x34, 0x18, 0x7d, 0xb6, 0x38, 0x38, 0xb4, 0x38, 0xf8, 0x6a, 0x71, 0xf0, 0x4a,
-	0x44, 0x61, 0x36, 0xbb, 0x8c, 0x70, 0xb9, 0xa5, 0xdd, 0x2e, 0xfb, 0x19, 0x2e, 0xdf, 0x28, 0xdf,
-	0xb0, 0xa2, 0xa4, 0xf5, 0x9c, 0xbe, 0xbb, 0x0f, 0x9a, 0x8f, 0x0a, 0x74, 0x36, 0xb4, 0xc0, 0xfc,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x44, 0xfc, 0x4b, 0xbb, 0xc9, 0x01, 0x00, 0x00,
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x3f, 0x8f, 0xda, 0x30,
+	0x18, 0xc6, 0x93, 0xf2, 0x67, 0x30, 0x55, 0xa9, 0xac, 0x56, 0x8d, 0x32, 0x18, 0xc4, 0x44, 0x97,
+	0x58, 0x40, 0x87, 0xce, 0x50, 0xb5, 0x5d, 0x2a, 0xb5, 0x44, 0xea, 0xd0, 0x25, 0x72, 0x9c, 0x17,
+	0x63, 0x15, 0x62, 0x64, 0x1b, 0xd4, 0x7e, 0x8b, 0xfb, 0x58, 0x8c, 0x6c, 0x77, 0xd3, 0xe9, 0x04,
+	0x5f, 0xe4, 0x84, 0xe3, 0x3b, 0x1d, 0xba, 0xdc, 0x66, 0xf9, 0xfd, 0x3d, 0xbf, 0xf7, 0x19, 0x5e,
+	0xd4, 0xcf, 0x59, 0x59, 0xd0, 0x05, 0x40, 0x61, 0xe8, 0x6e, 0x94, 0x83, 0x65, 0x23, 0x2a, 0xa0,
+	0x04, 0x23, 0x4d, 0xb2, 0xd1, 0xca, 0x2a, 0x8c, 0xcf, 0x44, 0xe2, 0x88, 0xc4, 0x13, 0xf1, 0x3b,
+	0xa1, 0x84, 0x72, 0x63, 0x7a, 0x7e, 0x55, 0x64, 0xdc, 0xab, 0x71, 0x6d, 0x98, 0x66, 0x6b, 0xaf,
+	0x8a, 0x49, 0x0d, 0x50, 0x89, 0xdd, 0x7c, 0x70, 0xdd, 0x40, 0xaf, 0xbf, 0x55, 0xcb, 0x53, 0xcb,
+	0x2c, 0xe0, 0xcf, 0xa8, 0x5d, 0x09, 0xa2, 0xb0, 0x1f, 0x0e, 0x3b, 0xe3, 0x38, 0x79, 0x5e, 0x26,
+	0xf9, 0xe9, 0x88, 0x69, 0x73, 0x7f, 0xdb, 0x0b, 0xe6, 0x9e, 0xc7, 0x9f, 0x50, 0x6b, 0xa7, 0x2c,
+	0x98, 0xe8, 0x55, 0xbf, 0x31, 0xec, 0x8c, 0xa3, 0xba, 0xe0, 0x6f, 0x65, 0xc1, 0xc7, 0x2a, 0x18,
+	0x0b, 0xf4, 0x41, 0xc3, 0x02, 0x34, 0x94, 0x1c, 0x32, 0xa3, 0xb6, 0x9a, 0x43, 0xc6, 0x55, 0xb9,
+	0x90, 0x22, 0x6a, 0xb8, 0x02, 0x1f, 0xeb, 0x3c, 0xf3, 0x87, 0x48, 0xea, 0x12, 0x33, 0x17, 0xf0,
+	0xe2, 0xf7, 0xba, 0x6e, 0x88, 0x7f, 0xa1, 0xae, 0x91, 0xa2, 0x64, 0xab, 0x6c, 0x0d, 0x96, 0x15,
+	0xcc, 0xb2, 0xa8, 0xe9, 0x8a, 0x0e, 0xea, 0x16, 0xa4, 0x0e, 0xfd, 0xe1, 0x49, 0x6f, 0x7e, 0x63,
+	0x2e, 0x7e, 0xf1, 0x57, 0xd4, 0x29, 0x60, 0x05, 0x82, 0x59, 0xa9, 0x4a, 0x13, 0xb5, 0x9c, 0x8e,
+	0xd4, 0xe9, 0xbe, 0x3c, 0x62, 0x5e, 0xf5, 0x34, 0x88, 0x53, 0xf4, 0x96, 0x4b, 0xcd, 0xb7, 0xd2,
+	0x66, 0xb9, 0x06, 0xf6, 0x17, 0xb4, 0x89, 0xda, 0x2f, 0x77, 0x9b, 0x55, 0xec, 0xb4, 0x42, 0xbd,
+	0xb0, 0xcb, 0x2f, 0x7e, 0xcd, 0xf4, 0xfb, 0xfe, 0x48, 0xc2, 0xc3, 0x91, 0x84, 0x77, 0x47, 0x12,
+	0x5e, 0x9d, 0x48, 0x70, 0x38, 0x91, 0xe0, 0xe6, 0x44, 0x82, 0x3f, 0x89, 0x90, 0x76, 0xb9, 0xcd,
+	0x13, 0xae, 0xd6, 0xf4, 0xac, 0x77, 0x97, 0xc0, 0xd5, 0x8a, 0xf2, 0x25, 0x93, 0x25, 0xdd, 0x4d,
+	0xe8, 0x3f, 0x7f, 0x31, 0xf6, 0xff, 0x06, 0x4c, 0xde, 0x76, 0xc0, 0xe4, 0x7e, 0x00, 0xe5, 0x52,
+	0x2c, 0xbd, 0xb9, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +168,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SignalMetadata) > 0 {
+		for iNdEx := len(m.SignalMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.ReferenceSourceConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -199,6 +274,24 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.ReferenceSourceConfig.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SignalMetadata) > 0 {
+		for _, e := range m.SignalMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -337,6 +430,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalMetadata = append(m.SignalMetadata, SignalMetadata{})
+			if err := m.SignalMetadata[len(m.SignalMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
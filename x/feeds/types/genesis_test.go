@@ -44,6 +44,83 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"duplicate signal metadata",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				SignalMetadata: []SignalMetadata{
+					NewSignalMetadata("CS:BAND-USD", 9, "BAND", "USD", "crypto", "", SIGNAL_METADATA_STATUS_ACTIVE, 0),
+					NewSignalMetadata("CS:BAND-USD", 9, "BAND", "USD", "crypto", "", SIGNAL_METADATA_STATUS_DEPRECATED, 0),
+				},
+			},
+			true,
+		},
+		{
+			"valid delegations",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				Delegations: []Delegation{
+					NewDelegation(ValidVoter, ValidDelegatee, 1000),
+					NewDelegation(ValidAdmin, ValidDelegatee, 2000),
+				},
+			},
+			false,
+		},
+		{
+			"self delegation",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				Delegations: []Delegation{
+					NewDelegation(ValidVoter, ValidVoter, 1000),
+				},
+			},
+			true,
+		},
+		{
+			"duplicate delegation",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				Delegations: []Delegation{
+					NewDelegation(ValidVoter, ValidDelegatee, 1000),
+					NewDelegation(ValidVoter, ValidAdmin, 2000),
+				},
+			},
+			true,
+		},
+		{
+			"valid circuit breakers",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				CircuitBreakers: []CircuitBreaker{
+					NewCircuitBreaker("CS:BAND-USD", 1000, 2000, 1),
+					NewCircuitBreaker("CS:ETH-USD", 1000, 2000, 1),
+				},
+			},
+			false,
+		},
+		{
+			"duplicate circuit breaker",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				CircuitBreakers: []CircuitBreaker{
+					NewCircuitBreaker("CS:BAND-USD", 1000, 2000, 1),
+					NewCircuitBreaker("CS:BAND-USD", 1000, 3000, 2),
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -32,9 +32,19 @@ var (
 	PriceStoreKeyPrefix              = []byte{0x11}
 	VoteStoreKeyPrefix               = []byte{0x12}
 	SignalTotalPowerStoreKeyPrefix   = []byte{0x13}
+	PriceHistoryStoreKeyPrefix       = []byte{0x14}
+	PriceHistoryCountStoreKeyPrefix  = []byte{0x15}
+	ValidatorAccuracyStoreKeyPrefix  = []byte{0x16}
+	AccuracyRecordStoreKeyPrefix     = []byte{0x17}
+	SignalMetadataStoreKeyPrefix     = []byte{0x18}
+	DelegationStoreKeyPrefix         = []byte{0x19}
+	DelegatedPowerStoreKeyPrefix     = []byte{0x1A}
+	CircuitBreakerStoreKeyPrefix     = []byte{0x1B}
+	CumulativePriceStoreKeyPrefix    = []byte{0x1C}
 
 	// index prefixes
 	SignalTotalPowerByPowerIndexKeyPrefix = []byte{0x80}
+	DelegationByDelegateeIndexKeyPrefix   = []byte{0x81}
 
 	// params store keys
 	ParamsKey = []byte{0x90}
@@ -60,6 +70,75 @@ func PriceStoreKey(signalID string) []byte {
 	return append(PriceStoreKeyPrefix, []byte(signalID)...)
 }
 
+// PriceHistoryStoreKey creates a key for iterating the historical prices of a signal
+func PriceHistoryStoreKey(signalID string) []byte {
+	return append(PriceHistoryStoreKeyPrefix, address.MustLengthPrefix([]byte(signalID))...)
+}
+
+// PriceHistoryTimestampStoreKey creates a key for storing a historical price of a signal at a timestamp
+func PriceHistoryTimestampStoreKey(signalID string, timestamp int64) []byte {
+	return append(PriceHistoryStoreKey(signalID), sdk.Uint64ToBigEndian(uint64(timestamp))...)
+}
+
+// CumulativePriceStoreKey creates a key for storing the cumulative price of a signal at the timestamp
+// of a historical price
+func CumulativePriceStoreKey(signalID string, timestamp int64) []byte {
+	return append(
+		append(CumulativePriceStoreKeyPrefix, address.MustLengthPrefix([]byte(signalID))...),
+		sdk.Uint64ToBigEndian(uint64(timestamp))...,
+	)
+}
+
+// PriceHistoryCountStoreKey creates a key for storing the number of historical prices of a signal
+func PriceHistoryCountStoreKey(signalID string) []byte {
+	return append(PriceHistoryCountStoreKeyPrefix, []byte(signalID)...)
+}
+
+// ValidatorAccuracyStoreKey creates a key for storing the accuracy of a validator
+func ValidatorAccuracyStoreKey(validator sdk.ValAddress) []byte {
+	return append(ValidatorAccuracyStoreKeyPrefix, address.MustLengthPrefix(validator.Bytes())...)
+}
+
+// AccuracyRecordsStoreKey creates a key for iterating the accuracy records of a validator
+func AccuracyRecordsStoreKey(validator sdk.ValAddress) []byte {
+	return append(AccuracyRecordStoreKeyPrefix, address.MustLengthPrefix(validator.Bytes())...)
+}
+
+// AccuracyRecordStoreKey creates a key for storing an accuracy record of a validator at an index of its window
+func AccuracyRecordStoreKey(validator sdk.ValAddress, index uint64) []byte {
+	return append(AccuracyRecordsStoreKey(validator), sdk.Uint64ToBigEndian(index)...)
+}
+
+// SignalMetadataStoreKey creates a key for storing the metadata of a signal in the signal registry
+func SignalMetadataStoreKey(signalID string) []byte {
+	return append(SignalMetadataStoreKeyPrefix, []byte(signalID)...)
+}
+
+// DelegationStoreKey creates a key for storing the delegation of a delegator
+func DelegationStoreKey(delegator sdk.AccAddress) []byte {
+	return append(DelegationStoreKeyPrefix, address.MustLengthPrefix(delegator.Bytes())...)
+}
+
+// DelegatedPowerStoreKey creates a key for storing the total voting power delegated to a delegatee
+func DelegatedPowerStoreKey(delegatee sdk.AccAddress) []byte {
+	return append(DelegatedPowerStoreKeyPrefix, address.MustLengthPrefix(delegatee.Bytes())...)
+}
+
+// DelegationsByDelegateeIndexKey creates a key for iterating the delegations to a delegatee
+func DelegationsByDelegateeIndexKey(delegatee sdk.AccAddress) []byte {
+	return append(DelegationByDelegateeIndexKeyPrefix, address.MustLengthPrefix(delegatee.Bytes())...)
+}
+
+// DelegationByDelegateeIndexKey creates a key for indexing the delegation of a delegator by its delegatee
+func DelegationByDelegateeIndexKey(delegatee sdk.AccAddress, delegator sdk.AccAddress) []byte {
+	return append(DelegationsByDelegateeIndexKey(delegatee), address.MustLengthPrefix(delegator.Bytes())...)
+}
+
+// CircuitBreakerStoreKey creates a key for storing the triggered circuit breaker of a signal
+func CircuitBreakerStoreKey(signalID string) []byte {
+	return append(CircuitBreakerStoreKeyPrefix, []byte(signalID)...)
+}
+
 // SignalTotalPowerByPowerIndexKey creates a key for storing signal-total-powers by power index
 func SignalTotalPowerByPowerIndexKey(signalID string, power int64) []byte {
 	powerBytes := make([]byte, 8)
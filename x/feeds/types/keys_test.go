@@ -52,6 +52,18 @@ func TestSignalTotalPowerStoreKey(t *testing.T) {
 	require.Equal(t, expectEmpty, SignalTotalPowerStoreKey(""))
 }
 
+func TestPriceHistoryTimestampStoreKey(t *testing.T) {
+	// Prefix: 0x14
+	expect, _ := hex.DecodeString("140442414e440000000000000064")
+	require.Equal(t, expect, PriceHistoryTimestampStoreKey("BAND", 100))
+}
+
+func TestPriceHistoryCountStoreKey(t *testing.T) {
+	// Prefix: 0x15
+	expect, _ := hex.DecodeString("1542414e44")
+	require.Equal(t, expect, PriceHistoryCountStoreKey("BAND"))
+}
+
 func TestSignalTotalPowerByPowerIndexKey(t *testing.T) {
 	// Prefix: 0x80
 	// Test with signal ID "BAND" and power 100
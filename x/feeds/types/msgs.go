@@ -11,12 +11,18 @@ var (
 	_ sdk.Msg = (*MsgSubmitSignalPrices)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgUpdateReferenceSourceConfig)(nil)
+	_ sdk.Msg = (*MsgUpdateSignalMetadata)(nil)
 	_ sdk.Msg = (*MsgVote)(nil)
+	_ sdk.Msg = (*MsgDelegateVotingPower)(nil)
+	_ sdk.Msg = (*MsgResetCircuitBreakers)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgSubmitSignalPrices)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateReferenceSourceConfig)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateSignalMetadata)(nil)
 	_ sdk.HasValidateBasic = (*MsgVote)(nil)
+	_ sdk.HasValidateBasic = (*MsgDelegateVotingPower)(nil)
+	_ sdk.HasValidateBasic = (*MsgResetCircuitBreakers)(nil)
 )
 
 // ====================================
@@ -134,6 +140,48 @@ func (m *MsgUpdateReferenceSourceConfig) ValidateBasic() error {
 	return nil
 }
 
+// ====================================
+// MsgUpdateSignalMetadata
+// ====================================
+
+// NewMsgUpdateSignalMetadata creates a new MsgUpdateSignalMetadata instance.
+func NewMsgUpdateSignalMetadata(
+	authority string,
+	signalMetadata []SignalMetadata,
+) *MsgUpdateSignalMetadata {
+	return &MsgUpdateSignalMetadata{
+		Authority:      authority,
+		SignalMetadata: signalMetadata,
+	}
+}
+
+// ValidateBasic does a check on the provided data.
+func (m *MsgUpdateSignalMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.SignalMetadata) == 0 {
+		return ErrInvalidSignalMetadata.Wrap("signal metadata cannot be empty")
+	}
+
+	signalIDSet := make(map[string]struct{})
+	for _, metadata := range m.SignalMetadata {
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := signalIDSet[metadata.SignalID]; exists {
+			return ErrDuplicateSignalID.Wrapf(
+				"duplicate signal ID found: %s", metadata.SignalID,
+			)
+		}
+		signalIDSet[metadata.SignalID] = struct{}{}
+	}
+
+	return nil
+}
+
 // ====================================
 // MsgVote
 // ====================================
@@ -176,3 +224,81 @@ func (m *MsgVote) ValidateBasic() error {
 
 	return nil
 }
+
+// ====================================
+// MsgDelegateVotingPower
+// ====================================
+
+// NewMsgDelegateVotingPower creates a new MsgDelegateVotingPower instance.
+func NewMsgDelegateVotingPower(
+	delegator string,
+	delegatee string,
+	power int64,
+) *MsgDelegateVotingPower {
+	return &MsgDelegateVotingPower{
+		Delegator: delegator,
+		Delegatee: delegatee,
+		Power:     power,
+	}
+}
+
+// ValidateBasic does a check on the provided data.
+func (m *MsgDelegateVotingPower) ValidateBasic() error {
+	// Check if the delegator address is valid
+	if _, err := sdk.AccAddressFromBech32(m.Delegator); err != nil {
+		return errorsmod.Wrap(err, "invalid delegator address")
+	}
+
+	// Check if the delegatee address is valid
+	if _, err := sdk.AccAddressFromBech32(m.Delegatee); err != nil {
+		return errorsmod.Wrap(err, "invalid delegatee address")
+	}
+
+	if m.Delegator == m.Delegatee {
+		return ErrInvalidDelegation.Wrap("delegator cannot delegate to itself")
+	}
+
+	if m.Power < 0 {
+		return ErrInvalidDelegation.Wrap("delegated power cannot be negative")
+	}
+
+	return nil
+}
+
+// ====================================
+// MsgResetCircuitBreakers
+// ====================================
+
+// NewMsgResetCircuitBreakers creates a new MsgResetCircuitBreakers instance.
+func NewMsgResetCircuitBreakers(
+	signer string,
+	signalIDs []string,
+) *MsgResetCircuitBreakers {
+	return &MsgResetCircuitBreakers{
+		Signer:    signer,
+		SignalIDs: signalIDs,
+	}
+}
+
+// ValidateBasic does a check on the provided data.
+func (m *MsgResetCircuitBreakers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	if len(m.SignalIDs) == 0 {
+		return ErrInvalidSignalIDs.Wrap("signal ids cannot be empty")
+	}
+
+	signalIDSet := make(map[string]struct{})
+	for _, signalID := range m.SignalIDs {
+		if _, exists := signalIDSet[signalID]; exists {
+			return ErrDuplicateSignalID.Wrapf(
+				"duplicate signal ID found: %s", signalID,
+			)
+		}
+		signalIDSet[signalID] = struct{}{}
+	}
+
+	return nil
+}
@@ -11,8 +11,9 @@ import (
 var (
 	ValidAuthority = sdk.AccAddress("636f736d6f7331787963726763336838396e72737671776539337a63").String()
 
-	ValidAdmin = sdk.AccAddress("1000000001").String()
-	ValidVoter = sdk.AccAddress("1000000002").String()
+	ValidAdmin     = sdk.AccAddress("1000000001").String()
+	ValidVoter     = sdk.AccAddress("1000000002").String()
+	ValidDelegatee = sdk.AccAddress("1000000003").String()
 
 	ValidValidator = sdk.ValAddress("2000000001").String()
 
@@ -33,6 +34,10 @@ var (
 		},
 	}
 
+	ValidSignalMetadata = []SignalMetadata{
+		NewSignalMetadata("CS:BAND-USD", 9, "BAND", "USD", "crypto", "", SIGNAL_METADATA_STATUS_ACTIVE, 0),
+	}
+
 	InvalidValidator = "invalidValidator"
 	InvalidAuthority = "invalidAuthority"
 	InvalidAdmin     = "invalidAdmin"
@@ -84,6 +89,38 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 	require.Error(t, err)
 }
 
+// ====================================
+// MsgUpdateSignalMetadata
+// ====================================
+
+func TestNewMsgUpdateSignalMetadata(t *testing.T) {
+	msg := NewMsgUpdateSignalMetadata(ValidAuthority, ValidSignalMetadata)
+	require.Equal(t, ValidAuthority, msg.Authority)
+	require.Equal(t, ValidSignalMetadata, msg.SignalMetadata)
+}
+
+func TestMsgUpdateSignalMetadata_ValidateBasic(t *testing.T) {
+	// Valid metadata
+	msg := NewMsgUpdateSignalMetadata(ValidAuthority, ValidSignalMetadata)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid authority
+	msg = NewMsgUpdateSignalMetadata(InvalidAuthority, ValidSignalMetadata)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Empty metadata
+	msg = NewMsgUpdateSignalMetadata(ValidAuthority, []SignalMetadata{})
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidSignalMetadata)
+
+	// Duplicate signal id
+	msg = NewMsgUpdateSignalMetadata(ValidAuthority, append(ValidSignalMetadata, ValidSignalMetadata...))
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrDuplicateSignalID)
+}
+
 // ====================================
 // MsgUpdateReferenceSourceConfig
 // ====================================
@@ -127,3 +164,74 @@ func TestMsgVote_ValidateBasic(t *testing.T) {
 	err = msg.ValidateBasic()
 	require.Error(t, err)
 }
+
+// ====================================
+// MsgDelegateVotingPower
+// ====================================
+
+func TestNewMsgDelegateVotingPower(t *testing.T) {
+	msg := NewMsgDelegateVotingPower(ValidVoter, ValidDelegatee, 1000)
+	require.Equal(t, ValidVoter, msg.Delegator)
+	require.Equal(t, ValidDelegatee, msg.Delegatee)
+	require.Equal(t, int64(1000), msg.Power)
+}
+
+func TestMsgDelegateVotingPower_ValidateBasic(t *testing.T) {
+	// Valid delegation
+	msg := NewMsgDelegateVotingPower(ValidVoter, ValidDelegatee, 1000)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Zero power to remove the delegation
+	msg = NewMsgDelegateVotingPower(ValidVoter, ValidDelegatee, 0)
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid delegator
+	msg = NewMsgDelegateVotingPower(InvalidVoter, ValidDelegatee, 1000)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid delegatee
+	msg = NewMsgDelegateVotingPower(ValidVoter, InvalidVoter, 1000)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Self delegation
+	msg = NewMsgDelegateVotingPower(ValidVoter, ValidVoter, 1000)
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidDelegation)
+
+	// Negative power
+	msg = NewMsgDelegateVotingPower(ValidVoter, ValidDelegatee, -1)
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidDelegation)
+}
+
+func TestNewMsgResetCircuitBreakers(t *testing.T) {
+	msg := NewMsgResetCircuitBreakers(ValidAdmin, []string{"CS:BAND-USD"})
+	require.Equal(t, ValidAdmin, msg.Signer)
+	require.Equal(t, []string{"CS:BAND-USD"}, msg.SignalIDs)
+}
+
+func TestMsgResetCircuitBreakers_ValidateBasic(t *testing.T) {
+	// Valid signal ids
+	msg := NewMsgResetCircuitBreakers(ValidAdmin, []string{"CS:BAND-USD", "CS:ETH-USD"})
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid signer
+	msg = NewMsgResetCircuitBreakers(InvalidVoter, []string{"CS:BAND-USD"})
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Empty signal ids
+	msg = NewMsgResetCircuitBreakers(ValidAdmin, []string{})
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidSignalIDs)
+
+	// Duplicate signal ids
+	msg = NewMsgResetCircuitBreakers(ValidAdmin, []string{"CS:BAND-USD", "CS:BAND-USD"})
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrDuplicateSignalID)
+}
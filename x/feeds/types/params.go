@@ -21,6 +21,16 @@ var (
 	DefaultCurrentFeedsUpdateInterval = int64(86400)
 	DefaultPriceQuorum                = math.LegacyNewDecWithPrec(30, 2)
 	DefaultMaxSignalIDsPerSigning     = uint64(25)
+	// keeps one day of prices with one price per minute
+	DefaultPriceHistorySize     = uint64(1440)
+	DefaultPriceHistoryInterval = int64(60)
+	// tracks the accuracy of the latest 100 submissions without penalizing outliers
+	DefaultOutlierDeviationBasisPoint = int64(500)
+	DefaultAccuracyWindow             = uint64(100)
+	DefaultMaxOutlierBasisPoint       = int64(0)
+	DefaultRequireRegisteredSignals   = false
+	// the circuit breaker is disabled by default
+	DefaultMaxPriceMoveBasisPoint = int64(0)
 )
 
 // NewParams creates a new Params instance
@@ -38,6 +48,14 @@ func NewParams(
 	currentFeedsUpdateInterval int64,
 	priceQuorum string,
 	maxSignalIDsPerSigning uint64,
+	priceHistorySize uint64,
+	priceHistoryInterval int64,
+	signalAggregationMethods []SignalAggregationMethod,
+	outlierDeviationBasisPoint int64,
+	accuracyWindow uint64,
+	maxOutlierBasisPoint int64,
+	requireRegisteredSignals bool,
+	maxPriceMoveBasisPoint int64,
 ) Params {
 	return Params{
 		Admin:                         admin,
@@ -53,6 +71,14 @@ func NewParams(
 		CurrentFeedsUpdateInterval:    currentFeedsUpdateInterval,
 		PriceQuorum:                   priceQuorum,
 		MaxSignalIDsPerSigning:        maxSignalIDsPerSigning,
+		PriceHistorySize:              priceHistorySize,
+		PriceHistoryInterval:          priceHistoryInterval,
+		SignalAggregationMethods:      signalAggregationMethods,
+		OutlierDeviationBasisPoint:    outlierDeviationBasisPoint,
+		AccuracyWindow:                accuracyWindow,
+		MaxOutlierBasisPoint:          maxOutlierBasisPoint,
+		RequireRegisteredSignals:      requireRegisteredSignals,
+		MaxPriceMoveBasisPoint:        maxPriceMoveBasisPoint,
 	}
 }
 
@@ -72,6 +98,14 @@ func DefaultParams() Params {
 		DefaultCurrentFeedsUpdateInterval,
 		DefaultPriceQuorum.String(),
 		DefaultMaxSignalIDsPerSigning,
+		DefaultPriceHistorySize,
+		DefaultPriceHistoryInterval,
+		nil,
+		DefaultOutlierDeviationBasisPoint,
+		DefaultAccuracyWindow,
+		DefaultMaxOutlierBasisPoint,
+		DefaultRequireRegisteredSignals,
+		DefaultMaxPriceMoveBasisPoint,
 	)
 }
 
@@ -125,6 +159,45 @@ func (p Params) Validate() error {
 	if err := validateUint64("max signal IDs per signing", false, p.MaxSignalIDsPerSigning); err != nil {
 		return err
 	}
+	if err := validateUint64("price history size", false, p.PriceHistorySize); err != nil {
+		return err
+	}
+	if p.PriceHistoryInterval < 0 {
+		return fmt.Errorf("price history interval cannot be negative: %d", p.PriceHistoryInterval)
+	}
+
+	signalIDs := make(map[string]bool, len(p.SignalAggregationMethods))
+	for _, s := range p.SignalAggregationMethods {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if signalIDs[s.SignalID] {
+			return ErrDuplicateSignalID.Wrapf("duplicate aggregation method of signal id %s", s.SignalID)
+		}
+		signalIDs[s.SignalID] = true
+	}
+
+	if err := validateInt64("outlier deviation basis point", true, p.OutlierDeviationBasisPoint); err != nil {
+		return err
+	}
+	if p.MaxOutlierBasisPoint < 0 || uint64(p.MaxOutlierBasisPoint) > BasisPointMax {
+		return fmt.Errorf("max outlier basis point must be between 0 and %d: %d", BasisPointMax, p.MaxOutlierBasisPoint)
+	}
+	if p.MaxPriceMoveBasisPoint < 0 {
+		return fmt.Errorf("max price move basis point cannot be negative: %d", p.MaxPriceMoveBasisPoint)
+	}
 
 	return nil
 }
+
+// GetSignalAggregationMethod returns the aggregation method assigned to the given signal id, or
+// the default aggregation method without a minimum number of reporters if none is assigned.
+func (p Params) GetSignalAggregationMethod(signalID string) SignalAggregationMethod {
+	for _, s := range p.SignalAggregationMethods {
+		if s.SignalID == signalID {
+			return s
+		}
+	}
+
+	return NewSignalAggregationMethod(signalID, DefaultAggregationMethod, 0)
+}
@@ -55,6 +55,30 @@ type Params struct {
 	PriceQuorum string `protobuf:"bytes,12,opt,name=price_quorum,json=priceQuorum,proto3" json:"price_quorum,omitempty"`
 	// max_signal_ids_per_signing is the maximum number of signals allowed in a single tss signing request.
 	MaxSignalIDsPerSigning uint64 `protobuf:"varint,13,opt,name=max_signal_ids_per_signing,json=maxSignalIdsPerSigning,proto3" json:"max_signal_ids_per_signing,omitempty"`
+	// price_history_size is the maximum number of historical prices kept for each signal. Zero disables
+	// the price history.
+	PriceHistorySize uint64 `protobuf:"varint,14,opt,name=price_history_size,json=priceHistorySize,proto3" json:"price_history_size,omitempty"`
+	// price_history_interval is the minimum time (in seconds) between two historical prices of a signal.
+	PriceHistoryInterval int64 `protobuf:"varint,15,opt,name=price_history_interval,json=priceHistoryInterval,proto3" json:"price_history_interval,omitempty"`
+	// signal_aggregation_methods is the list of signal ids that are aggregated with a method other than the default
+	// weighted median.
+	SignalAggregationMethods []SignalAggregationMethod `protobuf:"bytes,16,rep,name=signal_aggregation_methods,json=signalAggregationMethods,proto3" json:"signal_aggregation_methods"`
+	// outlier_deviation_basis_point is the deviation (in basis point) from the final price above which a validator
+	// price is counted as an outlier.
+	OutlierDeviationBasisPoint int64 `protobuf:"varint,17,opt,name=outlier_deviation_basis_point,json=outlierDeviationBasisPoint,proto3" json:"outlier_deviation_basis_point,omitempty"`
+	// accuracy_window is the number of latest submissions of a validator over which its accuracy is tracked. Zero
+	// disables the accuracy tracking.
+	AccuracyWindow uint64 `protobuf:"varint,18,opt,name=accuracy_window,json=accuracyWindow,proto3" json:"accuracy_window,omitempty"`
+	// max_outlier_basis_point is the maximum ratio (in basis point) of outlier prices to reported prices of a validator
+	// over a full accuracy window. A validator above it is deactivated. Zero disables the penalty.
+	MaxOutlierBasisPoint int64 `protobuf:"varint,19,opt,name=max_outlier_basis_point,json=maxOutlierBasisPoint,proto3" json:"max_outlier_basis_point,omitempty"`
+	// require_registered_signals is the flag to reject votes for signal ids that are not active in the signal registry.
+	// Otherwise, such votes are accepted and flagged with an event.
+	RequireRegisteredSignals bool `protobuf:"varint,20,opt,name=require_registered_signals,json=requireRegisteredSignals,proto3" json:"require_registered_signals,omitempty"`
+	// max_price_move_basis_point is the maximum move (in basis point) of the price of a signal id from its last available
+	// price. A larger move triggers the circuit breaker of the signal id and halts its price. Zero disables the circuit
+	// breaker.
+	MaxPriceMoveBasisPoint int64 `protobuf:"varint,21,opt,name=max_price_move_basis_point,json=maxPriceMoveBasisPoint,proto3" json:"max_price_move_basis_point,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -181,6 +205,62 @@ func (m *Params) GetMaxSignalIDsPerSigning() uint64 {
 	return 0
 }
 
+func (m *Params) GetPriceHistorySize() uint64 {
+	if m != nil {
+		return m.PriceHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetPriceHistoryInterval() int64 {
+	if m != nil {
+		return m.PriceHistoryInterval
+	}
+	return 0
+}
+
+func (m *Params) GetSignalAggregationMethods() []SignalAggregationMethod {
+	if m != nil {
+		return m.SignalAggregationMethods
+	}
+	return nil
+}
+
+func (m *Params) GetOutlierDeviationBasisPoint() int64 {
+	if m != nil {
+		return m.OutlierDeviationBasisPoint
+	}
+	return 0
+}
+
+func (m *Params) GetAccuracyWindow() uint64 {
+	if m != nil {
+		return m.AccuracyWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOutlierBasisPoint() int64 {
+	if m != nil {
+		return m.MaxOutlierBasisPoint
+	}
+	return 0
+}
+
+func (m *Params) GetRequireRegisteredSignals() bool {
+	if m != nil {
+		return m.RequireRegisteredSignals
+	}
+	return false
+}
+
+func (m *Params) GetMaxPriceMoveBasisPoint() int64 {
+	if m != nil {
+		return m.MaxPriceMoveBasisPoint
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "band.feeds.v1beta1.Params")
 }
@@ -188,41 +268,55 @@ func init() {
 func init() { proto.RegisterFile("band/feeds/v1beta1/params.proto", fileDescriptor_2d6fe56a3e836005) }
 
 var fileDescriptor_2d6fe56a3e836005 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xc6, 0x97, 0x77, 0x5b, 0x5f, 0xe6, 0x76, 0x42, 0x44, 0xd5, 0x94, 0x55, 0x5a, 0x5a, 0xe0,
-	0x52, 0x21, 0x91, 0x30, 0xed, 0x04, 0xb7, 0x95, 0x0a, 0xd8, 0x01, 0xa9, 0xb4, 0x83, 0x03, 0x17,
-	0xcb, 0xb1, 0x4d, 0x6a, 0x91, 0xd8, 0xc1, 0x76, 0xdb, 0xec, 0x5b, 0xf0, 0x11, 0xb8, 0xf1, 0x05,
-	0xf8, 0x10, 0x1c, 0x27, 0x4e, 0x9c, 0x10, 0x6a, 0x2f, 0x7c, 0x0c, 0xe4, 0x7f, 0xd2, 0xb5, 0x48,
-	0x70, 0x8b, 0x9f, 0xe7, 0xf7, 0x58, 0xfe, 0x3f, 0xb1, 0x51, 0x37, 0x21, 0x92, 0xc5, 0xef, 0x38,
-	0x67, 0x26, 0x9e, 0x9f, 0x26, 0xdc, 0x92, 0xd3, 0xb8, 0x20, 0x9a, 0xe4, 0x26, 0x2a, 0xb4, 0xb2,
-	0xca, 0xf7, 0x1d, 0x10, 0x01, 0x10, 0xd5, 0x40, 0xa7, 0x9d, 0xaa, 0x54, 0x81, 0x1d, 0xbb, 0xaf,
-	0x8a, 0xec, 0x1c, 0x53, 0x65, 0x72, 0x65, 0x70, 0x65, 0x54, 0x8b, 0xca, 0xba, 0xf7, 0x79, 0x1f,
-	0x35, 0x46, 0xb0, 0xab, 0x1f, 0xa1, 0x7d, 0xc2, 0x72, 0x21, 0x03, 0xaf, 0xe7, 0xf5, 0x0f, 0x06,
-	0xc1, 0xb7, 0x2f, 0x0f, 0xdb, 0x35, 0x7b, 0xce, 0x98, 0xe6, 0xc6, 0x4c, 0xac, 0x16, 0x32, 0x1d,
-	0x57, 0x98, 0xff, 0x1c, 0xf5, 0x48, 0x96, 0xa9, 0x05, 0x49, 0x32, 0x8e, 0x93, 0x4c, 0xd1, 0xf7,
-	0xd8, 0x8a, 0x9c, 0x63, 0x26, 0x0c, 0xd5, 0xbc, 0x20, 0x92, 0x5e, 0x05, 0xff, 0xf5, 0xbc, 0xfe,
-	0xee, 0xf8, 0xe4, 0x86, 0x1b, 0x38, 0xec, 0x52, 0xe4, 0x7c, 0xb8, 0x81, 0xfc, 0xbb, 0xa8, 0x95,
-	0x6a, 0x42, 0x39, 0x2e, 0xb8, 0x16, 0x8a, 0x05, 0xbb, 0x10, 0x6a, 0x82, 0x36, 0x02, 0xc9, 0x21,
-	0xb9, 0x90, 0x58, 0x48, 0xcb, 0xf5, 0x9c, 0x64, 0xc1, 0x5e, 0x85, 0xe4, 0x42, 0x5e, 0xd4, 0x12,
-	0x20, 0xa4, 0xdc, 0x20, 0xfb, 0x35, 0x42, 0xca, 0x1b, 0xe4, 0x11, 0x6a, 0x17, 0x6a, 0xc1, 0x35,
-	0x36, 0x96, 0x17, 0xd8, 0x4e, 0x35, 0x37, 0x53, 0x95, 0xb1, 0xa0, 0x01, 0xa8, 0x0f, 0xde, 0xc4,
-	0xf2, 0xe2, 0x72, 0xed, 0xf8, 0x0f, 0xd0, 0x1d, 0xb7, 0x29, 0x9d, 0x69, 0xcd, 0xa5, 0xc5, 0x50,
-	0x76, 0xf0, 0x7f, 0xcf, 0xeb, 0xef, 0x8d, 0x6f, 0xe7, 0xa4, 0x7c, 0x5a, 0xe9, 0xcf, 0x9c, 0xec,
-	0xdf, 0x47, 0x87, 0x54, 0xa9, 0x8c, 0xa9, 0x85, 0x84, 0x22, 0x82, 0x5b, 0xb0, 0x6d, 0x6b, 0x2d,
-	0xba, 0xb1, 0xfd, 0xc7, 0xe8, 0xd8, 0x0d, 0xc2, 0xf8, 0x5c, 0x10, 0x2b, 0x94, 0xc4, 0x09, 0x31,
-	0xc2, 0xe0, 0x42, 0x09, 0x69, 0x83, 0x03, 0x08, 0x1c, 0xe5, 0x42, 0x0e, 0xd7, 0xfe, 0xc0, 0xd9,
-	0x23, 0xe7, 0x42, 0x94, 0x94, 0xff, 0x88, 0xa2, 0x3a, 0x4a, 0xca, 0xbf, 0x45, 0xcf, 0xd1, 0xc9,
-	0x1f, 0x23, 0xe0, 0x59, 0xc1, 0x88, 0xe5, 0x9b, 0xb2, 0x9a, 0x10, 0xef, 0xd0, 0xad, 0x79, 0x5e,
-	0x03, 0xb2, 0x5d, 0x6f, 0xa1, 0x05, 0xe5, 0xf8, 0xc3, 0x4c, 0xe9, 0x59, 0x1e, 0xb4, 0xdc, 0x25,
-	0x19, 0x37, 0x41, 0x7b, 0x05, 0x92, 0xff, 0x06, 0x75, 0xdc, 0x01, 0x8d, 0x48, 0x25, 0xc9, 0xb0,
-	0x60, 0xc6, 0xfd, 0x50, 0x58, 0x0a, 0x99, 0x06, 0x87, 0xae, 0xb5, 0x41, 0x67, 0xf9, 0xa3, 0x7b,
-	0xf4, 0x92, 0x94, 0x13, 0x80, 0x2e, 0x86, 0x66, 0xc4, 0xf5, 0xa4, 0x22, 0xe0, 0xf4, 0xb5, 0xce,
-	0xb6, 0xf4, 0x27, 0x7b, 0xbf, 0x3e, 0x75, 0xbd, 0xc1, 0x8b, 0xaf, 0xcb, 0xd0, 0xbb, 0x5e, 0x86,
-	0xde, 0xcf, 0x65, 0xe8, 0x7d, 0x5c, 0x85, 0x3b, 0xd7, 0xab, 0x70, 0xe7, 0xfb, 0x2a, 0xdc, 0x79,
-	0x1b, 0xa5, 0xc2, 0x4e, 0x67, 0x49, 0x44, 0x55, 0x1e, 0xbb, 0x37, 0x01, 0x37, 0x9b, 0xaa, 0x2c,
-	0xa6, 0x53, 0x22, 0x64, 0x3c, 0x3f, 0x8b, 0xcb, 0xfa, 0x1d, 0xd9, 0xab, 0x82, 0x9b, 0xa4, 0x01,
-	0xc0, 0xd9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xef, 0xfe, 0xd0, 0x62, 0x03, 0x00, 0x00,
+	// 756 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x8f, 0x1b, 0x35,
+	0x18, 0xc6, 0x77, 0xd8, 0xed, 0xd2, 0xf5, 0x6e, 0xbb, 0xad, 0x09, 0x8b, 0x1b, 0xa9, 0x49, 0x80,
+	0x03, 0x11, 0x7f, 0x66, 0x28, 0x85, 0x03, 0x15, 0x97, 0x0d, 0x2b, 0x68, 0x0f, 0x2b, 0x42, 0x52,
+	0x40, 0xe2, 0x62, 0x39, 0xe3, 0x97, 0x89, 0xc5, 0x8c, 0x3d, 0xb5, 0x3d, 0xc9, 0xa4, 0x9f, 0x82,
+	0x2b, 0x37, 0x3e, 0x04, 0x1f, 0xa2, 0xc7, 0x8a, 0x13, 0xa7, 0x0a, 0x65, 0x2f, 0x7c, 0x0c, 0x64,
+	0x7b, 0xf2, 0x67, 0x45, 0x7a, 0xcb, 0x3c, 0xcf, 0xef, 0xf5, 0xeb, 0xf7, 0x7d, 0x26, 0x83, 0xba,
+	0x13, 0x26, 0x79, 0xf2, 0x0b, 0x00, 0x37, 0xc9, 0xec, 0xc1, 0x04, 0x2c, 0x7b, 0x90, 0x94, 0x4c,
+	0xb3, 0xc2, 0xc4, 0xa5, 0x56, 0x56, 0x61, 0xec, 0x80, 0xd8, 0x03, 0x71, 0x03, 0xb4, 0x5b, 0x99,
+	0xca, 0x94, 0xb7, 0x13, 0xf7, 0x2b, 0x90, 0xed, 0x7b, 0xa9, 0x32, 0x85, 0x32, 0x34, 0x18, 0xe1,
+	0xa1, 0xb1, 0x3a, 0x3b, 0xba, 0x84, 0x23, 0xbd, 0xff, 0xde, 0xef, 0x47, 0xe8, 0x70, 0xe8, 0xbb,
+	0xe2, 0x18, 0xdd, 0x60, 0xbc, 0x10, 0x92, 0x44, 0xbd, 0xa8, 0x7f, 0x34, 0x20, 0x7f, 0xfd, 0xf9,
+	0x49, 0xab, 0x39, 0xeb, 0x9c, 0x73, 0x0d, 0xc6, 0x8c, 0xad, 0x16, 0x32, 0x1b, 0x05, 0x0c, 0x7f,
+	0x8b, 0x7a, 0x2c, 0xcf, 0xd5, 0x9c, 0x4d, 0x72, 0xa0, 0x93, 0x5c, 0xa5, 0xbf, 0x52, 0x2b, 0x0a,
+	0xa0, 0x5c, 0x98, 0x54, 0x43, 0xc9, 0x64, 0xba, 0x20, 0x6f, 0xf4, 0xa2, 0xfe, 0xfe, 0xe8, 0xfe,
+	0x9a, 0x1b, 0x38, 0xec, 0xa9, 0x28, 0xe0, 0x62, 0x03, 0xe1, 0x77, 0xd1, 0x49, 0xa6, 0x59, 0x0a,
+	0xb4, 0x04, 0x2d, 0x14, 0x27, 0xfb, 0xbe, 0xe8, 0xd8, 0x6b, 0x43, 0x2f, 0x39, 0xa4, 0x10, 0x92,
+	0x0a, 0x69, 0x41, 0xcf, 0x58, 0x4e, 0x0e, 0x02, 0x52, 0x08, 0xf9, 0xa4, 0x91, 0x3c, 0xc2, 0xea,
+	0x0d, 0x72, 0xa3, 0x41, 0x58, 0xbd, 0x46, 0x3e, 0x45, 0xad, 0x52, 0xcd, 0x41, 0x53, 0x63, 0xa1,
+	0xa4, 0x76, 0xaa, 0xc1, 0x4c, 0x55, 0xce, 0xc9, 0xa1, 0x47, 0xb1, 0xf7, 0xc6, 0x16, 0xca, 0xa7,
+	0x2b, 0x07, 0x7f, 0x88, 0xee, 0xba, 0x43, 0xd3, 0x4a, 0x6b, 0x90, 0x96, 0xfa, 0xcd, 0x91, 0x37,
+	0x7b, 0x51, 0xff, 0x60, 0x74, 0x5a, 0xb0, 0xfa, 0xeb, 0xa0, 0x7f, 0xe3, 0x64, 0xfc, 0x3e, 0xba,
+	0x95, 0x2a, 0x95, 0x73, 0x35, 0x97, 0x7e, 0x11, 0xe4, 0xa6, 0x3f, 0xf6, 0x64, 0x25, 0xba, 0xb1,
+	0xf1, 0x97, 0xe8, 0x9e, 0x1b, 0x84, 0xc3, 0x4c, 0x30, 0x2b, 0x94, 0xa4, 0x13, 0x66, 0x84, 0xa1,
+	0xa5, 0x12, 0xd2, 0x92, 0x23, 0x5f, 0x70, 0x56, 0x08, 0x79, 0xb1, 0xf2, 0x07, 0xce, 0x1e, 0x3a,
+	0xd7, 0x97, 0xb2, 0xfa, 0x35, 0xa5, 0xa8, 0x29, 0x65, 0xf5, 0xae, 0xd2, 0x73, 0x74, 0xff, 0xda,
+	0x08, 0xb4, 0x2a, 0x39, 0xb3, 0xb0, 0x59, 0xd6, 0xb1, 0x2f, 0x6f, 0xa7, 0x5b, 0xf3, 0xfc, 0xe0,
+	0x91, 0xed, 0xf5, 0x96, 0x5a, 0xa4, 0x40, 0x9f, 0x55, 0x4a, 0x57, 0x05, 0x39, 0x71, 0x2f, 0xc9,
+	0xe8, 0xd8, 0x6b, 0xdf, 0x7b, 0x09, 0xff, 0x88, 0xda, 0xee, 0x82, 0x46, 0x64, 0x92, 0xe5, 0x54,
+	0x70, 0xe3, 0x02, 0xf5, 0x8f, 0x42, 0x66, 0xe4, 0x96, 0xdb, 0xda, 0xa0, 0xbd, 0x7c, 0xd5, 0x3d,
+	0xbb, 0x64, 0xf5, 0xd8, 0x43, 0x4f, 0x2e, 0xcc, 0x10, 0xf4, 0x38, 0x10, 0xfe, 0xf6, 0x8d, 0xce,
+	0xb7, 0x74, 0xfc, 0x31, 0xc2, 0xa1, 0xf5, 0x54, 0x18, 0xab, 0xf4, 0x82, 0x1a, 0xf1, 0x1c, 0xc8,
+	0x6d, 0x9f, 0xc2, 0x1d, 0xef, 0x3c, 0x0e, 0xc6, 0x58, 0x3c, 0x07, 0xfc, 0x39, 0x3a, 0xbb, 0x4e,
+	0xaf, 0x87, 0x3c, 0xf5, 0x43, 0xb6, 0xb6, 0x2b, 0xd6, 0xe3, 0x29, 0xd4, 0x6e, 0xee, 0xcd, 0xb2,
+	0x4c, 0x43, 0x16, 0x36, 0x5c, 0x80, 0x9d, 0x2a, 0x6e, 0xc8, 0x9d, 0xde, 0x7e, 0xff, 0xf8, 0xb3,
+	0x8f, 0xe2, 0xff, 0xff, 0x23, 0xe3, 0x70, 0xe1, 0xf3, 0x4d, 0xd1, 0xa5, 0xaf, 0x19, 0x1c, 0xbc,
+	0x78, 0xd5, 0xdd, 0x1b, 0x11, 0xb3, 0xdb, 0x36, 0x2e, 0x12, 0x55, 0xd9, 0x5c, 0x80, 0x7e, 0x4d,
+	0xa2, 0x77, 0x43, 0x24, 0x0d, 0xb4, 0x2b, 0xd5, 0x0f, 0xd0, 0x29, 0x4b, 0xd3, 0x4a, 0xb3, 0x74,
+	0x41, 0xe7, 0x42, 0x72, 0x35, 0x27, 0xd8, 0x2f, 0xe5, 0xf6, 0x4a, 0xfe, 0xc9, 0xab, 0xf8, 0x0b,
+	0xf4, 0x8e, 0x0b, 0x66, 0xd5, 0x6f, 0xbb, 0xcb, 0x5b, 0x61, 0x27, 0x05, 0xab, 0xbf, 0x0b, 0xee,
+	0xd6, 0xf9, 0x5f, 0xa1, 0xb6, 0x86, 0x67, 0x95, 0xd0, 0x40, 0x35, 0x64, 0xc2, 0x58, 0xd0, 0xc0,
+	0x9b, 0x78, 0x0d, 0x69, 0xf5, 0xa2, 0xfe, 0xcd, 0x11, 0x69, 0x88, 0xd1, 0x1a, 0x08, 0x0b, 0x31,
+	0xf8, 0x51, 0x78, 0x1b, 0x42, 0x16, 0x85, 0x9a, 0xc1, 0xb5, 0xbe, 0x6f, 0xaf, 0xdf, 0xd7, 0xa1,
+	0x03, 0x2e, 0xd5, 0x0c, 0x36, 0x9d, 0x1f, 0x1d, 0xfc, 0xfb, 0x47, 0x37, 0x1a, 0x3c, 0x7e, 0xb1,
+	0xec, 0x44, 0x2f, 0x97, 0x9d, 0xe8, 0x9f, 0x65, 0x27, 0xfa, 0xed, 0xaa, 0xb3, 0xf7, 0xf2, 0xaa,
+	0xb3, 0xf7, 0xf7, 0x55, 0x67, 0xef, 0xe7, 0x38, 0x13, 0x76, 0x5a, 0x4d, 0xe2, 0x54, 0x15, 0x89,
+	0xcb, 0xc4, 0x7f, 0xcb, 0x52, 0x95, 0x27, 0xe9, 0x94, 0x09, 0x99, 0xcc, 0x1e, 0x26, 0x75, 0xf3,
+	0xcd, 0xb3, 0x8b, 0x12, 0xcc, 0xe4, 0xd0, 0x03, 0x0f, 0xff, 0x1b, 0x00, 0xf1, 0x56, 0x66, 0x00,
+	0x74, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -283,6 +377,35 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaxSignalIDsPerSigning != that1.MaxSignalIDsPerSigning {
 		return false
 	}
+	if this.PriceHistorySize != that1.PriceHistorySize {
+		return false
+	}
+	if this.PriceHistoryInterval != that1.PriceHistoryInterval {
+		return false
+	}
+	if len(this.SignalAggregationMethods) != len(that1.SignalAggregationMethods) {
+		return false
+	}
+	for i := range this.SignalAggregationMethods {
+		if !this.SignalAggregationMethods[i].Equal(&that1.SignalAggregationMethods[i]) {
+			return false
+		}
+	}
+	if this.OutlierDeviationBasisPoint != that1.OutlierDeviationBasisPoint {
+		return false
+	}
+	if this.AccuracyWindow != that1.AccuracyWindow {
+		return false
+	}
+	if this.MaxOutlierBasisPoint != that1.MaxOutlierBasisPoint {
+		return false
+	}
+	if this.RequireRegisteredSignals != that1.RequireRegisteredSignals {
+		return false
+	}
+	if this.MaxPriceMoveBasisPoint != that1.MaxPriceMoveBasisPoint {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -305,6 +428,72 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPriceMoveBasisPoint != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceMoveBasisPoint))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.RequireRegisteredSignals {
+		i--
+		if m.RequireRegisteredSignals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxOutlierBasisPoint != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOutlierBasisPoint))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.AccuracyWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccuracyWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.OutlierDeviationBasisPoint != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OutlierDeviationBasisPoint))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.SignalAggregationMethods) > 0 {
+		for iNdEx := len(m.SignalAggregationMethods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalAggregationMethods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.PriceHistoryInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceHistoryInterval))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.PriceHistorySize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceHistorySize))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.MaxSignalIDsPerSigning != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxSignalIDsPerSigning))
 		i--
@@ -435,6 +624,33 @@ func (m *Params) Size() (n int) {
 	if m.MaxSignalIDsPerSigning != 0 {
 		n += 1 + sovParams(uint64(m.MaxSignalIDsPerSigning))
 	}
+	if m.PriceHistorySize != 0 {
+		n += 1 + sovParams(uint64(m.PriceHistorySize))
+	}
+	if m.PriceHistoryInterval != 0 {
+		n += 1 + sovParams(uint64(m.PriceHistoryInterval))
+	}
+	if len(m.SignalAggregationMethods) > 0 {
+		for _, e := range m.SignalAggregationMethods {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.OutlierDeviationBasisPoint != 0 {
+		n += 2 + sovParams(uint64(m.OutlierDeviationBasisPoint))
+	}
+	if m.AccuracyWindow != 0 {
+		n += 2 + sovParams(uint64(m.AccuracyWindow))
+	}
+	if m.MaxOutlierBasisPoint != 0 {
+		n += 2 + sovParams(uint64(m.MaxOutlierBasisPoint))
+	}
+	if m.RequireRegisteredSignals {
+		n += 3
+	}
+	if m.MaxPriceMoveBasisPoint != 0 {
+		n += 2 + sovParams(uint64(m.MaxPriceMoveBasisPoint))
+	}
 	return n
 }
 
@@ -746,6 +962,174 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistorySize", wireType)
+			}
+			m.PriceHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryInterval", wireType)
+			}
+			m.PriceHistoryInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalAggregationMethods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalAggregationMethods = append(m.SignalAggregationMethods, SignalAggregationMethod{})
+			if err := m.SignalAggregationMethods[len(m.SignalAggregationMethods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierDeviationBasisPoint", wireType)
+			}
+			m.OutlierDeviationBasisPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutlierDeviationBasisPoint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccuracyWindow", wireType)
+			}
+			m.AccuracyWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccuracyWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutlierBasisPoint", wireType)
+			}
+			m.MaxOutlierBasisPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutlierBasisPoint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireRegisteredSignals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireRegisteredSignals = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceMoveBasisPoint", wireType)
+			}
+			m.MaxPriceMoveBasisPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceMoveBasisPoint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
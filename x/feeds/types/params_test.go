@@ -75,6 +75,44 @@ func TestParams_Validate(t *testing.T) {
 			params.CurrentFeedsUpdateInterval = 0 // Invalid value
 			return params
 		}(), fmt.Errorf("current feeds update interval must be positive: 0")},
+		{"valid SignalAggregationMethods", func() types.Params {
+			params := types.DefaultParams()
+			params.SignalAggregationMethods = []types.SignalAggregationMethod{
+				types.NewSignalAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_TRIMMED_MEAN, 0),
+				types.NewSignalAggregationMethod("CS:ETH-USD", types.AGGREGATION_METHOD_MEDIAN, 10),
+			}
+			return params
+		}(), nil},
+		{"unregistered aggregation method", func() types.Params {
+			params := types.DefaultParams()
+			params.SignalAggregationMethods = []types.SignalAggregationMethod{
+				types.NewSignalAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_UNSPECIFIED, 0),
+			}
+			return params
+		}(), fmt.Errorf("aggregation method AGGREGATION_METHOD_UNSPECIFIED is not registered: invalid aggregation method")},
+		{"duplicate aggregation method", func() types.Params {
+			params := types.DefaultParams()
+			params.SignalAggregationMethods = []types.SignalAggregationMethod{
+				types.NewSignalAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_TRIMMED_MEAN, 0),
+				types.NewSignalAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_MEDIAN, 0),
+			}
+			return params
+		}(), fmt.Errorf("duplicate aggregation method of signal id CS:BAND-USD: duplicate signal id")},
+		{"invalid OutlierDeviationBasisPoint", func() types.Params {
+			params := types.DefaultParams()
+			params.OutlierDeviationBasisPoint = 0 // Invalid value
+			return params
+		}(), fmt.Errorf("outlier deviation basis point must be positive: 0")},
+		{"invalid MaxOutlierBasisPoint", func() types.Params {
+			params := types.DefaultParams()
+			params.MaxOutlierBasisPoint = 10001 // Invalid value
+			return params
+		}(), fmt.Errorf("max outlier basis point must be between 0 and 10000: 10001")},
+		{"invalid MaxPriceMoveBasisPoint", func() types.Params {
+			params := types.DefaultParams()
+			params.MaxPriceMoveBasisPoint = -1 // Invalid value
+			return params
+		}(), fmt.Errorf("max price move basis point cannot be negative: -1")},
 	}
 
 	for _, tt := range tests {
@@ -1,5 +1,9 @@
 package types
 
+import (
+	"cosmossdk.io/math"
+)
+
 // NewPrice creates a new price instance
 func NewPrice(
 	status PriceStatus,
@@ -27,3 +31,35 @@ func NewSignalPrice(
 		Price:    price,
 	}
 }
+
+// CalculateTWAP calculates the time-weighted average of the given prices, sorted by timestamp, over the
+// window from startTime to endTime. Each price is weighted by the time until the next price, or until
+// endTime for the last price. A price before startTime is weighted from startTime. If the window has no
+// duration, the last price is returned.
+func CalculateTWAP(prices []Price, startTime int64, endTime int64) (uint64, error) {
+	if len(prices) == 0 {
+		return 0, ErrPriceHistoryNotFound
+	}
+
+	weightedSum := math.ZeroInt()
+	totalDuration := int64(0)
+	for i, p := range prices {
+		from := max(p.Timestamp, startTime)
+		to := endTime
+		if i+1 < len(prices) {
+			to = prices[i+1].Timestamp
+		}
+		if to <= from {
+			continue
+		}
+
+		weightedSum = weightedSum.Add(math.NewIntFromUint64(p.Price).MulRaw(to - from))
+		totalDuration += to - from
+	}
+
+	if totalDuration == 0 {
+		return prices[len(prices)-1].Price, nil
+	}
+
+	return weightedSum.QuoRaw(totalDuration).Uint64(), nil
+}
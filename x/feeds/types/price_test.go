@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestCalculateTWAP(t *testing.T) {
+	prices := []types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 100),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, 160),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 4000, 190),
+	}
+
+	testCases := []struct {
+		name      string
+		prices    []types.Price
+		startTime int64
+		endTime   int64
+		expTWAP   uint64
+		expErr    error
+	}{
+		{
+			name:      "window covers all prices",
+			prices:    prices,
+			startTime: 100,
+			endTime:   200,
+			// (1000*60 + 2000*30 + 4000*10) / 100
+			expTWAP: 1600,
+		},
+		{
+			name:      "first price before the window",
+			prices:    prices,
+			startTime: 130,
+			endTime:   200,
+			// (1000*30 + 2000*30 + 4000*10) / 70
+			expTWAP: 1857,
+		},
+		{
+			name:      "window starts before the first price",
+			prices:    prices[1:],
+			startTime: 100,
+			endTime:   200,
+			// (2000*30 + 4000*10) / 40
+			expTWAP: 2500,
+		},
+		{
+			name:      "window without duration",
+			prices:    prices,
+			startTime: 190,
+			endTime:   190,
+			expTWAP:   4000,
+		},
+		{
+			name:      "no prices",
+			prices:    nil,
+			startTime: 100,
+			endTime:   200,
+			expErr:    types.ErrPriceHistoryNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			twap, err := types.CalculateTWAP(tc.prices, tc.startTime, tc.endTime)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expTWAP, twap)
+		})
+	}
+}
@@ -583,22 +583,26 @@ func (m *QueryAllPricesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryReferenceSourceConfigRequest is the request type for the Query/ReferenceSourceConfig RPC method.
-type QueryReferenceSourceConfigRequest struct {
+// QueryPriceAtRequest is the request type for the Query/PriceAt RPC method.
+type QueryPriceAtRequest struct {
+	// signal_id is the signal id to query the price for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// timestamp is the unix time (in seconds) to query the price at.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (m *QueryReferenceSourceConfigRequest) Reset()         { *m = QueryReferenceSourceConfigRequest{} }
-func (m *QueryReferenceSourceConfigRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryReferenceSourceConfigRequest) ProtoMessage()    {}
-func (*QueryReferenceSourceConfigRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPriceAtRequest) Reset()         { *m = QueryPriceAtRequest{} }
+func (m *QueryPriceAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtRequest) ProtoMessage()    {}
+func (*QueryPriceAtRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{12}
 }
-func (m *QueryReferenceSourceConfigRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceAtRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryReferenceSourceConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryReferenceSourceConfigRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceAtRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -608,36 +612,50 @@ func (m *QueryReferenceSourceConfigRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryReferenceSourceConfigRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryReferenceSourceConfigRequest.Merge(m, src)
+func (m *QueryPriceAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtRequest.Merge(m, src)
 }
-func (m *QueryReferenceSourceConfigRequest) XXX_Size() int {
+func (m *QueryPriceAtRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryReferenceSourceConfigRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryReferenceSourceConfigRequest.DiscardUnknown(m)
+func (m *QueryPriceAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryReferenceSourceConfigRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceAtRequest proto.InternalMessageInfo
 
-// QueryReferenceSourceConfigResponse is the response type for the Query/ReferenceSourceConfig RPC method.
-type QueryReferenceSourceConfigResponse struct {
-	// reference_source_config is the information about the reference price source.
-	ReferenceSourceConfig ReferenceSourceConfig `protobuf:"bytes,1,opt,name=reference_source_config,json=referenceSourceConfig,proto3" json:"reference_source_config"`
+func (m *QueryPriceAtRequest) GetSignalId() string {
+	if m != nil {
+		return m.SignalId
+	}
+	return ""
 }
 
-func (m *QueryReferenceSourceConfigResponse) Reset()         { *m = QueryReferenceSourceConfigResponse{} }
-func (m *QueryReferenceSourceConfigResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryReferenceSourceConfigResponse) ProtoMessage()    {}
-func (*QueryReferenceSourceConfigResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPriceAtRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// QueryPriceAtResponse is the response type for the Query/PriceAt RPC method.
+type QueryPriceAtResponse struct {
+	// price is the latest historical price of the signal id at or before the timestamp.
+	Price Price `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+}
+
+func (m *QueryPriceAtResponse) Reset()         { *m = QueryPriceAtResponse{} }
+func (m *QueryPriceAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtResponse) ProtoMessage()    {}
+func (*QueryPriceAtResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{13}
 }
-func (m *QueryReferenceSourceConfigResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceAtResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryReferenceSourceConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryReferenceSourceConfigResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceAtResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -647,45 +665,45 @@ func (m *QueryReferenceSourceConfigResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryReferenceSourceConfigResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryReferenceSourceConfigResponse.Merge(m, src)
+func (m *QueryPriceAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtResponse.Merge(m, src)
 }
-func (m *QueryReferenceSourceConfigResponse) XXX_Size() int {
+func (m *QueryPriceAtResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryReferenceSourceConfigResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryReferenceSourceConfigResponse.DiscardUnknown(m)
+func (m *QueryPriceAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryReferenceSourceConfigResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceAtResponse proto.InternalMessageInfo
 
-func (m *QueryReferenceSourceConfigResponse) GetReferenceSourceConfig() ReferenceSourceConfig {
+func (m *QueryPriceAtResponse) GetPrice() Price {
 	if m != nil {
-		return m.ReferenceSourceConfig
+		return m.Price
 	}
-	return ReferenceSourceConfig{}
+	return Price{}
 }
 
-// QuerySignalTotalPowersRequest is the request type for the Query/SignalTotalPowers RPC method.
-type QuerySignalTotalPowersRequest struct {
-	// signal_ids is a list of signal ids to query.
-	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+// QueryPriceHistoryRequest is the request type for the Query/PriceHistory RPC method.
+type QueryPriceHistoryRequest struct {
+	// signal_id is the signal id to query the historical prices for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
 	// pagination is the pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySignalTotalPowersRequest) Reset()         { *m = QuerySignalTotalPowersRequest{} }
-func (m *QuerySignalTotalPowersRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySignalTotalPowersRequest) ProtoMessage()    {}
-func (*QuerySignalTotalPowersRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPriceHistoryRequest) Reset()         { *m = QueryPriceHistoryRequest{} }
+func (m *QueryPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryPriceHistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{14}
 }
-func (m *QuerySignalTotalPowersRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySignalTotalPowersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySignalTotalPowersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -695,52 +713,52 @@ func (m *QuerySignalTotalPowersRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QuerySignalTotalPowersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySignalTotalPowersRequest.Merge(m, src)
+func (m *QueryPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryRequest.Merge(m, src)
 }
-func (m *QuerySignalTotalPowersRequest) XXX_Size() int {
+func (m *QueryPriceHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySignalTotalPowersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySignalTotalPowersRequest.DiscardUnknown(m)
+func (m *QueryPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySignalTotalPowersRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceHistoryRequest proto.InternalMessageInfo
 
-func (m *QuerySignalTotalPowersRequest) GetSignalIds() []string {
+func (m *QueryPriceHistoryRequest) GetSignalId() string {
 	if m != nil {
-		return m.SignalIds
+		return m.SignalId
 	}
-	return nil
+	return ""
 }
 
-func (m *QuerySignalTotalPowersRequest) GetPagination() *query.PageRequest {
+func (m *QueryPriceHistoryRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QuerySignalTotalPowersResponse is the response type for the Query/SignalTotalPowers RPC method.
-type QuerySignalTotalPowersResponse struct {
-	// signal_total_powers is a list of signal-total-powers.
-	SignalTotalPowers []*Signal `protobuf:"bytes,1,rep,name=signal_total_powers,json=signalTotalPowers,proto3" json:"signal_total_powers,omitempty"`
+// QueryPriceHistoryResponse is the response type for the Query/PriceHistory RPC method.
+type QueryPriceHistoryResponse struct {
+	// prices is a list of historical prices ordered by timestamp.
+	Prices []Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
 	// pagination is the pagination information in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySignalTotalPowersResponse) Reset()         { *m = QuerySignalTotalPowersResponse{} }
-func (m *QuerySignalTotalPowersResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySignalTotalPowersResponse) ProtoMessage()    {}
-func (*QuerySignalTotalPowersResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPriceHistoryResponse) Reset()         { *m = QueryPriceHistoryResponse{} }
+func (m *QueryPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryPriceHistoryResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{15}
 }
-func (m *QuerySignalTotalPowersResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySignalTotalPowersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySignalTotalPowersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -750,50 +768,52 @@ func (m *QuerySignalTotalPowersResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QuerySignalTotalPowersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySignalTotalPowersResponse.Merge(m, src)
+func (m *QueryPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryResponse.Merge(m, src)
 }
-func (m *QuerySignalTotalPowersResponse) XXX_Size() int {
+func (m *QueryPriceHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySignalTotalPowersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySignalTotalPowersResponse.DiscardUnknown(m)
+func (m *QueryPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySignalTotalPowersResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceHistoryResponse proto.InternalMessageInfo
 
-func (m *QuerySignalTotalPowersResponse) GetSignalTotalPowers() []*Signal {
+func (m *QueryPriceHistoryResponse) GetPrices() []Price {
 	if m != nil {
-		return m.SignalTotalPowers
+		return m.Prices
 	}
 	return nil
 }
 
-func (m *QuerySignalTotalPowersResponse) GetPagination() *query.PageResponse {
+func (m *QueryPriceHistoryResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryValidValidatorRequest is the request type for the Query/ValidValidator RPC method.
-type QueryValidValidatorRequest struct {
-	// validator is the validator address to query the flag.
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+// QueryTWAPRequest is the request type for the Query/TWAP RPC method.
+type QueryTWAPRequest struct {
+	// signal_id is the signal id to query the time-weighted average price for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// window is the duration (in seconds) up to the current block time to average the price over.
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (m *QueryValidValidatorRequest) Reset()         { *m = QueryValidValidatorRequest{} }
-func (m *QueryValidValidatorRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidValidatorRequest) ProtoMessage()    {}
-func (*QueryValidValidatorRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTWAPRequest) Reset()         { *m = QueryTWAPRequest{} }
+func (m *QueryTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPRequest) ProtoMessage()    {}
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{16}
 }
-func (m *QueryValidValidatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTWAPRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidValidatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTWAPRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -803,43 +823,51 @@ func (m *QueryValidValidatorRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryValidValidatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidValidatorRequest.Merge(m, src)
+func (m *QueryTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPRequest.Merge(m, src)
 }
-func (m *QueryValidValidatorRequest) XXX_Size() int {
+func (m *QueryTWAPRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidValidatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidValidatorRequest.DiscardUnknown(m)
+func (m *QueryTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidValidatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTWAPRequest proto.InternalMessageInfo
 
-func (m *QueryValidValidatorRequest) GetValidator() string {
+func (m *QueryTWAPRequest) GetSignalId() string {
 	if m != nil {
-		return m.Validator
+		return m.SignalId
 	}
 	return ""
 }
 
-// QueryValidValidatorResponse is the response type for the Query/ValidValidator RPC method.
-type QueryValidValidatorResponse struct {
-	// valid is a valid flag.
-	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+func (m *QueryTWAPRequest) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
 }
 
-func (m *QueryValidValidatorResponse) Reset()         { *m = QueryValidValidatorResponse{} }
-func (m *QueryValidValidatorResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidValidatorResponse) ProtoMessage()    {}
-func (*QueryValidValidatorResponse) Descriptor() ([]byte, []int) {
+// QueryTWAPResponse is the response type for the Query/TWAP RPC method.
+type QueryTWAPResponse struct {
+	// price is the time-weighted average price of the signal id. Its timestamp is the timestamp of the
+	// latest price in the window.
+	Price Price `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+}
+
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{17}
 }
-func (m *QueryValidValidatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidValidatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -849,45 +877,41 @@ func (m *QueryValidValidatorResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryValidValidatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidValidatorResponse.Merge(m, src)
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
 }
-func (m *QueryValidValidatorResponse) XXX_Size() int {
+func (m *QueryTWAPResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidValidatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidValidatorResponse.DiscardUnknown(m)
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidValidatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
 
-func (m *QueryValidValidatorResponse) GetValid() bool {
+func (m *QueryTWAPResponse) GetPrice() Price {
 	if m != nil {
-		return m.Valid
+		return m.Price
 	}
-	return false
+	return Price{}
 }
 
-// QueryValidatorPricesRequest is the request type for the Query/ValidatorPrices RPC method.
-type QueryValidatorPricesRequest struct {
-	// validator is the validator address to query prices for.
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	// signal_ids is the list of signal ids to query the price for.
-	SignalIds []string `protobuf:"bytes,2,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+// QueryReferenceSourceConfigRequest is the request type for the Query/ReferenceSourceConfig RPC method.
+type QueryReferenceSourceConfigRequest struct {
 }
 
-func (m *QueryValidatorPricesRequest) Reset()         { *m = QueryValidatorPricesRequest{} }
-func (m *QueryValidatorPricesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidatorPricesRequest) ProtoMessage()    {}
-func (*QueryValidatorPricesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryReferenceSourceConfigRequest) Reset()         { *m = QueryReferenceSourceConfigRequest{} }
+func (m *QueryReferenceSourceConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReferenceSourceConfigRequest) ProtoMessage()    {}
+func (*QueryReferenceSourceConfigRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{18}
 }
-func (m *QueryValidatorPricesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryReferenceSourceConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidatorPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReferenceSourceConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidatorPricesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReferenceSourceConfigRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -897,50 +921,36 @@ func (m *QueryValidatorPricesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryValidatorPricesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidatorPricesRequest.Merge(m, src)
+func (m *QueryReferenceSourceConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferenceSourceConfigRequest.Merge(m, src)
 }
-func (m *QueryValidatorPricesRequest) XXX_Size() int {
+func (m *QueryReferenceSourceConfigRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidatorPricesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidatorPricesRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryValidatorPricesRequest proto.InternalMessageInfo
-
-func (m *QueryValidatorPricesRequest) GetValidator() string {
-	if m != nil {
-		return m.Validator
-	}
-	return ""
+func (m *QueryReferenceSourceConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferenceSourceConfigRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorPricesRequest) GetSignalIds() []string {
-	if m != nil {
-		return m.SignalIds
-	}
-	return nil
-}
+var xxx_messageInfo_QueryReferenceSourceConfigRequest proto.InternalMessageInfo
 
-// QueryValidatorPricesResponse is the response type for the Query/ValidatorPrices RPC method.
-type QueryValidatorPricesResponse struct {
-	// validator_prices is a list of prices submitted by the validator.
-	ValidatorPrices []ValidatorPrice `protobuf:"bytes,1,rep,name=validator_prices,json=validatorPrices,proto3" json:"validator_prices"`
+// QueryReferenceSourceConfigResponse is the response type for the Query/ReferenceSourceConfig RPC method.
+type QueryReferenceSourceConfigResponse struct {
+	// reference_source_config is the information about the reference price source.
+	ReferenceSourceConfig ReferenceSourceConfig `protobuf:"bytes,1,opt,name=reference_source_config,json=referenceSourceConfig,proto3" json:"reference_source_config"`
 }
 
-func (m *QueryValidatorPricesResponse) Reset()         { *m = QueryValidatorPricesResponse{} }
-func (m *QueryValidatorPricesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidatorPricesResponse) ProtoMessage()    {}
-func (*QueryValidatorPricesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryReferenceSourceConfigResponse) Reset()         { *m = QueryReferenceSourceConfigResponse{} }
+func (m *QueryReferenceSourceConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReferenceSourceConfigResponse) ProtoMessage()    {}
+func (*QueryReferenceSourceConfigResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{19}
 }
-func (m *QueryValidatorPricesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryReferenceSourceConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidatorPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReferenceSourceConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidatorPricesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReferenceSourceConfigResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -950,43 +960,43 @@ func (m *QueryValidatorPricesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryValidatorPricesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidatorPricesResponse.Merge(m, src)
+func (m *QueryReferenceSourceConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferenceSourceConfigResponse.Merge(m, src)
 }
-func (m *QueryValidatorPricesResponse) XXX_Size() int {
+func (m *QueryReferenceSourceConfigResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidatorPricesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidatorPricesResponse.DiscardUnknown(m)
+func (m *QueryReferenceSourceConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferenceSourceConfigResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidatorPricesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryReferenceSourceConfigResponse proto.InternalMessageInfo
 
-func (m *QueryValidatorPricesResponse) GetValidatorPrices() []ValidatorPrice {
+func (m *QueryReferenceSourceConfigResponse) GetReferenceSourceConfig() ReferenceSourceConfig {
 	if m != nil {
-		return m.ValidatorPrices
+		return m.ReferenceSourceConfig
 	}
-	return nil
+	return ReferenceSourceConfig{}
 }
 
-// QueryVoteRequest is the request type for the Query/Vote RPC method.
-type QueryVoteRequest struct {
-	// voter is the voter address to query signal for.
-	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+// QuerySignalMetadataRequest is the request type for the Query/SignalMetadata RPC method.
+type QuerySignalMetadataRequest struct {
+	// signal_id is the signal id to query the metadata for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
 }
 
-func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
-func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVoteRequest) ProtoMessage()    {}
-func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySignalMetadataRequest) Reset()         { *m = QuerySignalMetadataRequest{} }
+func (m *QuerySignalMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalMetadataRequest) ProtoMessage()    {}
+func (*QuerySignalMetadataRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{20}
 }
-func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignalMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignalMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignalMetadataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -996,43 +1006,43 @@ func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueryVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVoteRequest.Merge(m, src)
+func (m *QuerySignalMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalMetadataRequest.Merge(m, src)
 }
-func (m *QueryVoteRequest) XXX_Size() int {
+func (m *QuerySignalMetadataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVoteRequest.DiscardUnknown(m)
+func (m *QuerySignalMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalMetadataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignalMetadataRequest proto.InternalMessageInfo
 
-func (m *QueryVoteRequest) GetVoter() string {
+func (m *QuerySignalMetadataRequest) GetSignalId() string {
 	if m != nil {
-		return m.Voter
+		return m.SignalId
 	}
 	return ""
 }
 
-// QueryVoteResponse is the response type for the Query/Vote RPC method.
-type QueryVoteResponse struct {
-	// signals is a list of signals submitted by the voter.
-	Signals []Signal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals"`
+// QuerySignalMetadataResponse is the response type for the Query/SignalMetadata RPC method.
+type QuerySignalMetadataResponse struct {
+	// signal_metadata is the metadata of the signal id.
+	SignalMetadata SignalMetadata `protobuf:"bytes,1,opt,name=signal_metadata,json=signalMetadata,proto3" json:"signal_metadata"`
 }
 
-func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
-func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVoteResponse) ProtoMessage()    {}
-func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySignalMetadataResponse) Reset()         { *m = QuerySignalMetadataResponse{} }
+func (m *QuerySignalMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalMetadataResponse) ProtoMessage()    {}
+func (*QuerySignalMetadataResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39690037efa6f66f, []int{21}
 }
-func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignalMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignalMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignalMetadataResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1042,1664 +1052,6144 @@ func (m *QueryVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVoteResponse.Merge(m, src)
+func (m *QuerySignalMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalMetadataResponse.Merge(m, src)
 }
-func (m *QueryVoteResponse) XXX_Size() int {
+func (m *QuerySignalMetadataResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVoteResponse.DiscardUnknown(m)
+func (m *QuerySignalMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalMetadataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignalMetadataResponse proto.InternalMessageInfo
 
-func (m *QueryVoteResponse) GetSignals() []Signal {
+func (m *QuerySignalMetadataResponse) GetSignalMetadata() SignalMetadata {
 	if m != nil {
-		return m.Signals
+		return m.SignalMetadata
 	}
-	return nil
+	return SignalMetadata{}
 }
 
-func init() {
-	proto.RegisterType((*QueryCurrentFeedsRequest)(nil), "band.feeds.v1beta1.QueryCurrentFeedsRequest")
-	proto.RegisterType((*QueryCurrentFeedsResponse)(nil), "band.feeds.v1beta1.QueryCurrentFeedsResponse")
-	proto.RegisterType((*QueryIsFeederRequest)(nil), "band.feeds.v1beta1.QueryIsFeederRequest")
-	proto.RegisterType((*QueryIsFeederResponse)(nil), "band.feeds.v1beta1.QueryIsFeederResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "band.feeds.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "band.feeds.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryPriceRequest)(nil), "band.feeds.v1beta1.QueryPriceRequest")
-	proto.RegisterType((*QueryPriceResponse)(nil), "band.feeds.v1beta1.QueryPriceResponse")
-	proto.RegisterType((*QueryPricesRequest)(nil), "band.feeds.v1beta1.QueryPricesRequest")
-	proto.RegisterType((*QueryPricesResponse)(nil), "band.feeds.v1beta1.QueryPricesResponse")
-	proto.RegisterType((*QueryAllPricesRequest)(nil), "band.feeds.v1beta1.QueryAllPricesRequest")
-	proto.RegisterType((*QueryAllPricesResponse)(nil), "band.feeds.v1beta1.QueryAllPricesResponse")
-	proto.RegisterType((*QueryReferenceSourceConfigRequest)(nil), "band.feeds.v1beta1.QueryReferenceSourceConfigRequest")
-	proto.RegisterType((*QueryReferenceSourceConfigResponse)(nil), "band.feeds.v1beta1.QueryReferenceSourceConfigResponse")
-	proto.RegisterType((*QuerySignalTotalPowersRequest)(nil), "band.feeds.v1beta1.QuerySignalTotalPowersRequest")
-	proto.RegisterType((*QuerySignalTotalPowersResponse)(nil), "band.feeds.v1beta1.QuerySignalTotalPowersResponse")
-	proto.RegisterType((*QueryValidValidatorRequest)(nil), "band.feeds.v1beta1.QueryValidValidatorRequest")
-	proto.RegisterType((*QueryValidValidatorResponse)(nil), "band.feeds.v1beta1.QueryValidValidatorResponse")
-	proto.RegisterType((*QueryValidatorPricesRequest)(nil), "band.feeds.v1beta1.QueryValidatorPricesRequest")
-	proto.RegisterType((*QueryValidatorPricesResponse)(nil), "band.feeds.v1beta1.QueryValidatorPricesResponse")
-	proto.RegisterType((*QueryVoteRequest)(nil), "band.feeds.v1beta1.QueryVoteRequest")
-	proto.RegisterType((*QueryVoteResponse)(nil), "band.feeds.v1beta1.QueryVoteResponse")
+// QueryAllSignalMetadataRequest is the request type for the Query/AllSignalMetadata RPC method.
+type QueryAllSignalMetadataRequest struct {
+	// pagination is the pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("band/feeds/v1beta1/query.proto", fileDescriptor_39690037efa6f66f) }
-
-var fileDescriptor_39690037efa6f66f = []byte{
-	// 1157 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xdf, 0x6f, 0xdb, 0x54,
-	0x14, 0xc7, 0xeb, 0xb0, 0x84, 0xe6, 0x50, 0xd8, 0x7a, 0x97, 0xb2, 0xd6, 0x6d, 0x33, 0x7a, 0xd7,
-	0x75, 0x6d, 0x69, 0xec, 0xb6, 0xd9, 0x00, 0xf1, 0x82, 0xd6, 0xa1, 0xc1, 0x40, 0x62, 0x23, 0x45,
-	0x80, 0x90, 0x50, 0xe4, 0x38, 0xb7, 0xae, 0xa5, 0xd4, 0x37, 0xf3, 0x75, 0xc2, 0xa6, 0xaa, 0x42,
-	0x20, 0x81, 0xc4, 0x03, 0x12, 0x68, 0x6f, 0xfc, 0x01, 0x3c, 0xf0, 0x3c, 0xf8, 0x1b, 0xf6, 0x38,
-	0x8d, 0x17, 0x9e, 0x10, 0x6a, 0x79, 0xe2, 0xaf, 0x40, 0xbe, 0xf7, 0x38, 0x8d, 0x5d, 0x7b, 0xf1,
-	0x7e, 0x3c, 0x35, 0xf6, 0xf9, 0x9e, 0x73, 0x3e, 0xe7, 0xdc, 0x1f, 0xc7, 0x85, 0x6a, 0xcb, 0xf2,
-	0xda, 0xe6, 0x0e, 0x63, 0x6d, 0x61, 0xf6, 0x37, 0x5a, 0x2c, 0xb0, 0x36, 0xcc, 0xdb, 0x3d, 0xe6,
-	0xdf, 0x35, 0xba, 0x3e, 0x0f, 0x38, 0x21, 0xa1, 0xdd, 0x90, 0x76, 0x03, 0xed, 0x7a, 0xc5, 0xe1,
-	0x0e, 0x97, 0x66, 0x33, 0xfc, 0xa5, 0x94, 0xfa, 0x9c, 0xc3, 0xb9, 0xd3, 0x61, 0xa6, 0xd5, 0x75,
-	0x4d, 0xcb, 0xf3, 0x78, 0x60, 0x05, 0x2e, 0xf7, 0x04, 0x5a, 0x67, 0x6c, 0x2e, 0xf6, 0xb8, 0x68,
-	0x2a, 0x37, 0xf5, 0x80, 0xa6, 0x55, 0xf5, 0x64, 0xb6, 0x2c, 0xc1, 0x54, 0xee, 0x01, 0x49, 0xd7,
-	0x72, 0x5c, 0x4f, 0xc6, 0x41, 0x6d, 0x1a, 0xae, 0x82, 0x53, 0xf6, 0xf3, 0x29, 0xf6, 0xae, 0xe5,
-	0x5b, 0x7b, 0x28, 0xa0, 0x3a, 0x4c, 0x7f, 0x1c, 0xa6, 0xb8, 0xd6, 0xf3, 0x7d, 0xe6, 0x05, 0xd7,
-	0x43, 0x65, 0x83, 0xdd, 0xee, 0x31, 0x11, 0xd0, 0x1e, 0xcc, 0xa4, 0xd8, 0x44, 0x97, 0x7b, 0x82,
-	0x91, 0xcf, 0xe1, 0x65, 0x5b, 0xbd, 0x6f, 0xca, 0xf0, 0xd3, 0xda, 0x6b, 0xda, 0xf2, 0x4b, 0x9b,
-	0x35, 0xe3, 0x64, 0x83, 0x8c, 0xa1, 0x00, 0x9f, 0xb9, 0xc1, 0xee, 0xbb, 0xac, 0xef, 0xaa, 0x66,
-	0x6c, 0x9d, 0x7a, 0xf0, 0xf7, 0xf9, 0xb1, 0xc6, 0x84, 0x3d, 0x94, 0x81, 0xfe, 0xa0, 0x41, 0x45,
-	0xe6, 0xbd, 0x21, 0xc2, 0x17, 0xcc, 0x47, 0x1e, 0xf2, 0x0e, 0x94, 0xfb, 0x56, 0xc7, 0x6d, 0x5b,
-	0x01, 0xf7, 0x65, 0xba, 0xf2, 0xd6, 0xc2, 0xa3, 0xfb, 0xb5, 0x79, 0xec, 0xde, 0xa7, 0x91, 0xed,
-	0x6a, 0xbb, 0xed, 0x33, 0x21, 0xb6, 0x03, 0xdf, 0xf5, 0x9c, 0xc6, 0xb1, 0x0f, 0x59, 0x87, 0xd2,
-	0x8e, 0x8c, 0x38, 0x5d, 0x90, 0xde, 0xd3, 0x8f, 0xee, 0xd7, 0x2a, 0xe8, 0x1d, 0x77, 0x42, 0x1d,
-	0xbd, 0x0c, 0x53, 0x09, 0x14, 0x2c, 0x7f, 0x16, 0xca, 0xae, 0x68, 0x62, 0xb4, 0x90, 0x65, 0xbc,
-	0x31, 0xee, 0xa2, 0x88, 0x56, 0x80, 0x48, 0xaf, 0x5b, 0xb2, 0xd3, 0x51, 0x3b, 0x6f, 0xc2, 0xd9,
-	0xd8, 0x5b, 0x8c, 0xf4, 0x16, 0x94, 0xd4, 0x8a, 0x60, 0x07, 0xf5, 0xb4, 0x0e, 0x2a, 0x1f, 0x6c,
-	0x17, 0xea, 0xe9, 0x3a, 0x4c, 0xaa, 0x80, 0xbe, 0x6b, 0xb3, 0xa8, 0x49, 0xb3, 0x50, 0x16, 0xae,
-	0xe3, 0x59, 0x9d, 0xa6, 0xdb, 0x56, 0x4d, 0x6a, 0x8c, 0xab, 0x17, 0x37, 0xda, 0xf4, 0xc3, 0x08,
-	0x4c, 0x79, 0x20, 0xc1, 0x15, 0x28, 0x76, 0xc3, 0x17, 0x08, 0x30, 0x93, 0x0a, 0x10, 0x0a, 0x30,
-	0xbf, 0x52, 0xd3, 0xfa, 0x70, 0xb0, 0xa8, 0x4a, 0x32, 0x0f, 0x30, 0xc8, 0x1f, 0x96, 0xf4, 0xc2,
-	0x72, 0xb9, 0x51, 0x8e, 0x00, 0x04, 0xfd, 0x28, 0x6a, 0x02, 0x3a, 0x21, 0xc2, 0x9b, 0x50, 0x92,
-	0x41, 0x95, 0x47, 0x0e, 0x06, 0x94, 0xd3, 0x26, 0x2e, 0xd0, 0xd5, 0x4e, 0x27, 0xce, 0x71, 0x1d,
-	0xe0, 0xf8, 0xb4, 0x60, 0x65, 0x4b, 0x06, 0x2e, 0x76, 0x78, 0xb4, 0x0c, 0x75, 0xac, 0x8f, 0x3b,
-	0xec, 0x44, 0x3d, 0x6c, 0x0c, 0x79, 0xd2, 0x5f, 0x34, 0x78, 0x35, 0x99, 0xe1, 0x19, 0xa1, 0xc9,
-	0x7b, 0x31, 0xb6, 0x82, 0x64, 0xbb, 0x34, 0x92, 0x4d, 0x65, 0x8d, 0xc1, 0x5d, 0x80, 0x05, 0xc9,
-	0xd6, 0x60, 0x3b, 0xcc, 0x67, 0x9e, 0xcd, 0xb6, 0x79, 0xcf, 0xb7, 0xd9, 0x35, 0xee, 0xed, 0xb8,
-	0x4e, 0xb4, 0xef, 0x7e, 0xd4, 0x80, 0x3e, 0x4e, 0x85, 0xd5, 0x38, 0x70, 0xce, 0x8f, 0x04, 0x4d,
-	0x21, 0x15, 0x4d, 0x5b, 0x4a, 0xb0, 0x7b, 0x2b, 0x69, 0xe5, 0xa5, 0xc6, 0xc4, 0x72, 0xa7, 0xfc,
-	0x34, 0x23, 0xfd, 0x5e, 0x83, 0x79, 0xc9, 0xb3, 0x2d, 0x77, 0xc5, 0x27, 0x3c, 0xb0, 0x3a, 0xb7,
-	0xf8, 0x57, 0xcc, 0xcf, 0xb9, 0x87, 0x12, 0x4b, 0x5b, 0x78, 0xea, 0xa5, 0xfd, 0x5d, 0x83, 0x6a,
-	0x16, 0x08, 0x36, 0xe5, 0x03, 0x38, 0x8b, 0x24, 0x41, 0x68, 0x6d, 0x76, 0xa5, 0x19, 0xd7, 0x3b,
-	0xf5, 0xa4, 0xaa, 0x58, 0x8d, 0x49, 0x91, 0x8c, 0xf9, 0xfc, 0x56, 0xfd, 0x4b, 0xd0, 0x25, 0xb6,
-	0xbc, 0xf0, 0x06, 0xb7, 0xde, 0xf3, 0xba, 0x25, 0x69, 0x1d, 0x66, 0x53, 0xc3, 0x63, 0x4b, 0x2a,
-	0x50, 0x94, 0x5a, 0xbc, 0xf5, 0xd4, 0x03, 0x3d, 0x18, 0x76, 0x0a, 0xf5, 0xf1, 0xd3, 0xf8, 0xcc,
-	0x57, 0x77, 0x7c, 0x4b, 0x14, 0x92, 0xd7, 0x8a, 0x80, 0xb9, 0xf4, 0xf4, 0x08, 0xbd, 0x0d, 0x67,
-	0x06, 0xb1, 0x9a, 0xb1, 0x43, 0x4b, 0xd3, 0x16, 0x31, 0x1e, 0x06, 0xb7, 0xf3, 0xe9, 0x7e, 0x3c,
-	0x38, 0xdd, 0x82, 0x33, 0x2a, 0x29, 0x0f, 0x06, 0xd7, 0xaf, 0x01, 0xc5, 0x3e, 0x0f, 0x58, 0x54,
-	0x64, 0xf6, 0x84, 0x51, 0x32, 0x7a, 0x13, 0xef, 0x70, 0x15, 0x03, 0x69, 0xdf, 0x86, 0x17, 0x55,
-	0x69, 0x39, 0x76, 0x1a, 0xc2, 0x45, 0x0e, 0x9b, 0xff, 0x4d, 0x40, 0x51, 0x46, 0x24, 0x3f, 0x6b,
-	0x30, 0x31, 0x3c, 0xba, 0xc9, 0x5a, 0x5a, 0x94, 0xac, 0xe9, 0xaf, 0xd7, 0x72, 0xaa, 0x15, 0x33,
-	0x5d, 0xfc, 0xf6, 0xcf, 0x7f, 0xef, 0x15, 0xaa, 0x64, 0x2e, 0xf1, 0xb5, 0x11, 0xfb, 0x48, 0x20,
-	0xf7, 0x34, 0x18, 0x8f, 0x66, 0x29, 0x59, 0xce, 0xcc, 0x90, 0x98, 0xfc, 0xfa, 0x4a, 0x0e, 0x25,
-	0x72, 0x6c, 0x4a, 0x8e, 0x35, 0xb2, 0x9a, 0xf2, 0x55, 0xc4, 0x7c, 0x73, 0x7f, 0xb0, 0x88, 0x07,
-	0xe6, 0xbe, 0x7a, 0x77, 0x40, 0xee, 0x40, 0x49, 0x0d, 0x58, 0xb2, 0x94, 0x99, 0x28, 0x36, 0xcb,
-	0xf5, 0x4b, 0x23, 0x75, 0x88, 0x33, 0x2f, 0x71, 0xce, 0x91, 0xa9, 0xd4, 0x8f, 0x30, 0xf2, 0x8d,
-	0x06, 0x45, 0xb9, 0x9b, 0xc8, 0xc5, 0xec, 0x88, 0x43, 0xe3, 0x5d, 0x5f, 0x1a, 0x25, 0xc3, 0xbc,
-	0x2b, 0x32, 0xef, 0x05, 0xb2, 0x90, 0xcc, 0x2b, 0xb7, 0xae, 0xb9, 0x3f, 0x38, 0x4c, 0xaa, 0x7a,
-	0x35, 0x97, 0x46, 0x04, 0xcf, 0x53, 0x7d, 0xec, 0xd8, 0x65, 0x57, 0xaf, 0xf2, 0x7d, 0xa7, 0x41,
-	0x79, 0x30, 0x56, 0x49, 0xf6, 0x22, 0x27, 0x87, 0xbb, 0xbe, 0x9a, 0x47, 0x8a, 0x0c, 0x0b, 0x92,
-	0x61, 0x96, 0xcc, 0x24, 0x18, 0xac, 0x4e, 0x07, 0x6f, 0x02, 0xf2, 0x87, 0x06, 0x53, 0xa9, 0x83,
-	0x8c, 0x5c, 0xc9, 0x4c, 0xf4, 0xb8, 0x91, 0xab, 0xbf, 0xf1, 0xa4, 0x6e, 0xc8, 0x6a, 0x48, 0xd6,
-	0x65, 0xb2, 0x94, 0x60, 0xcd, 0x18, 0xcc, 0xe4, 0x57, 0x0d, 0x26, 0x4f, 0x0c, 0x2f, 0xb2, 0x91,
-	0x99, 0x3d, 0x6b, 0xe2, 0xea, 0x9b, 0x4f, 0xe2, 0x82, 0xb0, 0xab, 0x12, 0x76, 0x91, 0xd0, 0x04,
-	0x6c, 0xca, 0xc0, 0x0c, 0x41, 0x5f, 0x89, 0xcf, 0x13, 0x62, 0x64, 0xa6, 0x4c, 0x9d, 0x6b, 0xba,
-	0x99, 0x5b, 0x8f, 0x7c, 0x75, 0xc9, 0x57, 0x23, 0xaf, 0x27, 0xf8, 0x06, 0x37, 0x80, 0x88, 0xdd,
-	0x06, 0xf2, 0x27, 0xf9, 0x4d, 0x83, 0xd3, 0x89, 0x21, 0x42, 0x46, 0x64, 0x3e, 0x31, 0xed, 0xf4,
-	0xf5, 0xfc, 0x0e, 0xc8, 0x7a, 0x59, 0xb2, 0x1a, 0x64, 0x2d, 0x1f, 0x2b, 0xee, 0xdb, 0xaf, 0xe1,
-	0x54, 0x38, 0x37, 0xc8, 0x62, 0x76, 0xbe, 0xe3, 0xd1, 0xa4, 0x5f, 0x1c, 0xa1, 0x1a, 0xb1, 0xac,
-	0x72, 0x5e, 0x85, 0x18, 0xe1, 0xdf, 0x03, 0xf9, 0xb8, 0xf5, 0xfe, 0x83, 0xc3, 0xaa, 0xf6, 0xf0,
-	0xb0, 0xaa, 0xfd, 0x73, 0x58, 0xd5, 0x7e, 0x3a, 0xaa, 0x8e, 0x3d, 0x3c, 0xaa, 0x8e, 0xfd, 0x75,
-	0x54, 0x1d, 0xfb, 0xc2, 0x70, 0xdc, 0x60, 0xb7, 0xd7, 0x32, 0x6c, 0xbe, 0x67, 0x86, 0x69, 0xe5,
-	0x7f, 0x9b, 0x36, 0xef, 0x98, 0xf6, 0xae, 0xe5, 0x7a, 0x66, 0xbf, 0x6e, 0xde, 0xc1, 0xf8, 0xc1,
-	0xdd, 0x2e, 0x13, 0xad, 0x92, 0x14, 0xd4, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x75, 0x45, 0x1c,
-	0x50, 0x80, 0x0f, 0x00, 0x00,
+func (m *QueryAllSignalMetadataRequest) Reset()         { *m = QueryAllSignalMetadataRequest{} }
+func (m *QueryAllSignalMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSignalMetadataRequest) ProtoMessage()    {}
+func (*QueryAllSignalMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{22}
+}
+func (m *QueryAllSignalMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSignalMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSignalMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSignalMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSignalMetadataRequest.Merge(m, src)
+}
+func (m *QueryAllSignalMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSignalMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSignalMetadataRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAllSignalMetadataRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// CurrentFeeds is an RPC method that returns a list of current supported feeds.
-	CurrentFeeds(ctx context.Context, in *QueryCurrentFeedsRequest, opts ...grpc.CallOption) (*QueryCurrentFeedsResponse, error)
-	// IsFeeder is an RPC method that returns whether an account is a feeder for a specified validator.
-	IsFeeder(ctx context.Context, in *QueryIsFeederRequest, opts ...grpc.CallOption) (*QueryIsFeederResponse, error)
-	// Params is an RPC method that returns all parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Price is an RPC method that returns price by signal id.
-	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
-	// Prices is an RPC method that prices by signal ids.
-	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
-	// AllPrices is an RPC method that returns all prices.
-	AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error)
-	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
-	ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error)
-	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
-	// id.
-	SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error)
-	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
-	ValidValidator(ctx context.Context, in *QueryValidValidatorRequest, opts ...grpc.CallOption) (*QueryValidValidatorResponse, error)
-	// ValidatorPrices is an RPC method that returns prices of a validator.
-	ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error)
-	// Vote is an RPC method that returns signals of a voter.
-	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
+func (m *QueryAllSignalMetadataRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryAllSignalMetadataResponse is the response type for the Query/AllSignalMetadata RPC method.
+type QueryAllSignalMetadataResponse struct {
+	// signal_metadata is a list of metadata of the signal ids.
+	SignalMetadata []SignalMetadata `protobuf:"bytes,1,rep,name=signal_metadata,json=signalMetadata,proto3" json:"signal_metadata"`
+	// pagination is the pagination information in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAllSignalMetadataResponse) Reset()         { *m = QueryAllSignalMetadataResponse{} }
+func (m *QueryAllSignalMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSignalMetadataResponse) ProtoMessage()    {}
+func (*QueryAllSignalMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{23}
 }
-
-func (c *queryClient) CurrentFeeds(ctx context.Context, in *QueryCurrentFeedsRequest, opts ...grpc.CallOption) (*QueryCurrentFeedsResponse, error) {
-	out := new(QueryCurrentFeedsResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/CurrentFeeds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllSignalMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSignalMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSignalMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllSignalMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSignalMetadataResponse.Merge(m, src)
+}
+func (m *QueryAllSignalMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSignalMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSignalMetadataResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) IsFeeder(ctx context.Context, in *QueryIsFeederRequest, opts ...grpc.CallOption) (*QueryIsFeederResponse, error) {
-	out := new(QueryIsFeederResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/IsFeeder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllSignalMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryAllSignalMetadataResponse) GetSignalMetadata() []SignalMetadata {
+	if m != nil {
+		return m.SignalMetadata
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllSignalMetadataResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error) {
-	out := new(QueryPriceResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Price", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryCircuitBreakersRequest is the request type for the Query/CircuitBreakers RPC method.
+type QueryCircuitBreakersRequest struct {
+	// pagination is the pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
-	out := new(QueryPricesResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Prices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCircuitBreakersRequest) Reset()         { *m = QueryCircuitBreakersRequest{} }
+func (m *QueryCircuitBreakersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakersRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{24}
+}
+func (m *QueryCircuitBreakersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCircuitBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakersRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakersRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error) {
-	out := new(QueryAllPricesResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/AllPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCircuitBreakersRequest proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error) {
-	out := new(QueryReferenceSourceConfigResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ReferenceSourceConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryCircuitBreakersResponse is the response type for the Query/CircuitBreakers RPC method.
+type QueryCircuitBreakersResponse struct {
+	// circuit_breakers is a list of triggered circuit breakers.
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,1,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+	// pagination is the pagination information in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error) {
-	out := new(QuerySignalTotalPowersResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/SignalTotalPowers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCircuitBreakersResponse) Reset()         { *m = QueryCircuitBreakersResponse{} }
+func (m *QueryCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakersResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{25}
+}
+func (m *QueryCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakersResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakersResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ValidValidator(ctx context.Context, in *QueryValidValidatorRequest, opts ...grpc.CallOption) (*QueryValidValidatorResponse, error) {
-	out := new(QueryValidValidatorResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCircuitBreakersResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakersResponse) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error) {
-	out := new(QueryValidatorPricesResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidatorPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCircuitBreakersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
-	out := new(QueryVoteResponse)
-	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Vote", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QuerySignalTotalPowersRequest is the request type for the Query/SignalTotalPowers RPC method.
+type QuerySignalTotalPowersRequest struct {
+	// signal_ids is a list of signal ids to query.
+	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+	// pagination is the pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// CurrentFeeds is an RPC method that returns a list of current supported feeds.
-	CurrentFeeds(context.Context, *QueryCurrentFeedsRequest) (*QueryCurrentFeedsResponse, error)
-	// IsFeeder is an RPC method that returns whether an account is a feeder for a specified validator.
-	IsFeeder(context.Context, *QueryIsFeederRequest) (*QueryIsFeederResponse, error)
-	// Params is an RPC method that returns all parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Price is an RPC method that returns price by signal id.
-	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
-	// Prices is an RPC method that prices by signal ids.
-	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
-	// AllPrices is an RPC method that returns all prices.
-	AllPrices(context.Context, *QueryAllPricesRequest) (*QueryAllPricesResponse, error)
-	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
-	ReferenceSourceConfig(context.Context, *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error)
-	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
-	// id.
-	SignalTotalPowers(context.Context, *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error)
-	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
-	ValidValidator(context.Context, *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error)
-	// ValidatorPrices is an RPC method that returns prices of a validator.
-	ValidatorPrices(context.Context, *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error)
-	// Vote is an RPC method that returns signals of a voter.
-	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
+func (m *QuerySignalTotalPowersRequest) Reset()         { *m = QuerySignalTotalPowersRequest{} }
+func (m *QuerySignalTotalPowersRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalTotalPowersRequest) ProtoMessage()    {}
+func (*QuerySignalTotalPowersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{26}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySignalTotalPowersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedQueryServer) CurrentFeeds(ctx context.Context, req *QueryCurrentFeedsRequest) (*QueryCurrentFeedsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentFeeds not implemented")
+func (m *QuerySignalTotalPowersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalTotalPowersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) IsFeeder(ctx context.Context, req *QueryIsFeederRequest) (*QueryIsFeederResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsFeeder not implemented")
+func (m *QuerySignalTotalPowersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalTotalPowersRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySignalTotalPowersRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPriceRequest) (*QueryPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
+func (m *QuerySignalTotalPowersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalTotalPowersRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+
+var xxx_messageInfo_QuerySignalTotalPowersRequest proto.InternalMessageInfo
+
+func (m *QuerySignalTotalPowersRequest) GetSignalIds() []string {
+	if m != nil {
+		return m.SignalIds
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) AllPrices(ctx context.Context, req *QueryAllPricesRequest) (*QueryAllPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllPrices not implemented")
+
+func (m *QuerySignalTotalPowersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ReferenceSourceConfig(ctx context.Context, req *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReferenceSourceConfig not implemented")
+
+// QuerySignalTotalPowersResponse is the response type for the Query/SignalTotalPowers RPC method.
+type QuerySignalTotalPowersResponse struct {
+	// signal_total_powers is a list of signal-total-powers.
+	SignalTotalPowers []*Signal `protobuf:"bytes,1,rep,name=signal_total_powers,json=signalTotalPowers,proto3" json:"signal_total_powers,omitempty"`
+	// pagination is the pagination information in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) SignalTotalPowers(ctx context.Context, req *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignalTotalPowers not implemented")
+
+func (m *QuerySignalTotalPowersResponse) Reset()         { *m = QuerySignalTotalPowersResponse{} }
+func (m *QuerySignalTotalPowersResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalTotalPowersResponse) ProtoMessage()    {}
+func (*QuerySignalTotalPowersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{27}
 }
-func (*UnimplementedQueryServer) ValidValidator(ctx context.Context, req *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidValidator not implemented")
+func (m *QuerySignalTotalPowersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ValidatorPrices(ctx context.Context, req *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPrices not implemented")
+func (m *QuerySignalTotalPowersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalTotalPowersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+func (m *QuerySignalTotalPowersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalTotalPowersResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QuerySignalTotalPowersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalTotalPowersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalTotalPowersResponse.DiscardUnknown(m)
 }
 
-func _Query_CurrentFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCurrentFeedsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CurrentFeeds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/CurrentFeeds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CurrentFeeds(ctx, req.(*QueryCurrentFeedsRequest))
+var xxx_messageInfo_QuerySignalTotalPowersResponse proto.InternalMessageInfo
+
+func (m *QuerySignalTotalPowersResponse) GetSignalTotalPowers() []*Signal {
+	if m != nil {
+		return m.SignalTotalPowers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_IsFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsFeederRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsFeeder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/IsFeeder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsFeeder(ctx, req.(*QueryIsFeederRequest))
+func (m *QuerySignalTotalPowersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryValidValidatorRequest is the request type for the Query/ValidValidator RPC method.
+type QueryValidValidatorRequest struct {
+	// validator is the validator address to query the flag.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Price(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/Price",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Price(ctx, req.(*QueryPriceRequest))
+func (m *QueryValidValidatorRequest) Reset()         { *m = QueryValidValidatorRequest{} }
+func (m *QueryValidValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidValidatorRequest) ProtoMessage()    {}
+func (*QueryValidValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{28}
+}
+func (m *QueryValidValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidValidatorRequest.Merge(m, src)
+}
+func (m *QueryValidValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidValidatorRequest.DiscardUnknown(m)
 }
 
-func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Prices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/Prices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Prices(ctx, req.(*QueryPricesRequest))
+var xxx_messageInfo_QueryValidValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryValidValidatorRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllPrices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/AllPrices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllPrices(ctx, req.(*QueryAllPricesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryValidValidatorResponse is the response type for the Query/ValidValidator RPC method.
+type QueryValidValidatorResponse struct {
+	// valid is a valid flag.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 }
 
-func _Query_ReferenceSourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReferenceSourceConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ReferenceSourceConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/ReferenceSourceConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ReferenceSourceConfig(ctx, req.(*QueryReferenceSourceConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryValidValidatorResponse) Reset()         { *m = QueryValidValidatorResponse{} }
+func (m *QueryValidValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidValidatorResponse) ProtoMessage()    {}
+func (*QueryValidValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{29}
 }
-
-func _Query_SignalTotalPowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySignalTotalPowersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignalTotalPowers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/SignalTotalPowers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignalTotalPowers(ctx, req.(*QuerySignalTotalPowersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryValidValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_ValidValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/ValidValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidValidator(ctx, req.(*QueryValidValidatorRequest))
+func (m *QueryValidValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_ValidatorPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorPrices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/ValidatorPrices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorPrices(ctx, req.(*QueryValidatorPricesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryValidValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidValidatorResponse.Merge(m, src)
+}
+func (m *QueryValidValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidValidatorResponse.DiscardUnknown(m)
 }
 
-func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Vote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.feeds.v1beta1.Query/Vote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Vote(ctx, req.(*QueryVoteRequest))
+var xxx_messageInfo_QueryValidValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryValidValidatorResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "band.feeds.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CurrentFeeds",
-			Handler:    _Query_CurrentFeeds_Handler,
-		},
-		{
-			MethodName: "IsFeeder",
-			Handler:    _Query_IsFeeder_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Price",
-			Handler:    _Query_Price_Handler,
-		},
-		{
-			MethodName: "Prices",
-			Handler:    _Query_Prices_Handler,
-		},
-		{
-			MethodName: "AllPrices",
-			Handler:    _Query_AllPrices_Handler,
-		},
-		{
-			MethodName: "ReferenceSourceConfig",
-			Handler:    _Query_ReferenceSourceConfig_Handler,
-		},
-		{
-			MethodName: "SignalTotalPowers",
-			Handler:    _Query_SignalTotalPowers_Handler,
-		},
-		{
-			MethodName: "ValidValidator",
-			Handler:    _Query_ValidValidator_Handler,
-		},
-		{
-			MethodName: "ValidatorPrices",
-			Handler:    _Query_ValidatorPrices_Handler,
-		},
-		{
-			MethodName: "Vote",
-			Handler:    _Query_Vote_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "band/feeds/v1beta1/query.proto",
+// QueryValidatorAccuracyRequest is the request type for the Query/ValidatorAccuracy RPC method.
+type QueryValidatorAccuracyRequest struct {
+	// validator is the validator address to query the accuracy for.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (m *QueryCurrentFeedsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorAccuracyRequest) Reset()         { *m = QueryValidatorAccuracyRequest{} }
+func (m *QueryValidatorAccuracyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAccuracyRequest) ProtoMessage()    {}
+func (*QueryValidatorAccuracyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{30}
+}
+func (m *QueryValidatorAccuracyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAccuracyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAccuracyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryCurrentFeedsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorAccuracyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAccuracyRequest.Merge(m, src)
 }
-
-func (m *QueryCurrentFeedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryValidatorAccuracyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAccuracyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAccuracyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCurrentFeedsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorAccuracyRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorAccuracyRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCurrentFeedsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorAccuracyResponse is the response type for the Query/ValidatorAccuracy RPC method.
+type QueryValidatorAccuracyResponse struct {
+	// validator_accuracy is the accuracy of the validator.
+	ValidatorAccuracy ValidatorAccuracy `protobuf:"bytes,1,opt,name=validator_accuracy,json=validatorAccuracy,proto3" json:"validator_accuracy"`
 }
 
-func (m *QueryCurrentFeedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CurrentFeeds.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryValidatorAccuracyResponse) Reset()         { *m = QueryValidatorAccuracyResponse{} }
+func (m *QueryValidatorAccuracyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAccuracyResponse) ProtoMessage()    {}
+func (*QueryValidatorAccuracyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{31}
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAccuracyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAccuracyResponse.Merge(m, src)
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAccuracyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAccuracyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIsFeederRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorAccuracyResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorAccuracyResponse) GetValidatorAccuracy() ValidatorAccuracy {
+	if m != nil {
+		return m.ValidatorAccuracy
 	}
-	return dAtA[:n], nil
+	return ValidatorAccuracy{}
 }
 
-func (m *QueryIsFeederRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorAccuraciesRequest is the request type for the Query/ValidatorAccuracies RPC method.
+type QueryValidatorAccuraciesRequest struct {
+	// pagination is used to paginate the result.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIsFeederRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Feeder) > 0 {
-		i -= len(m.Feeder)
-		copy(dAtA[i:], m.Feeder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Feeder)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValidatorAccuraciesRequest) Reset()         { *m = QueryValidatorAccuraciesRequest{} }
+func (m *QueryValidatorAccuraciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAccuraciesRequest) ProtoMessage()    {}
+func (*QueryValidatorAccuraciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{32}
+}
+func (m *QueryValidatorAccuraciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAccuraciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAccuraciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorAccuraciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAccuraciesRequest.Merge(m, src)
+}
+func (m *QueryValidatorAccuraciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAccuraciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAccuraciesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIsFeederResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorAccuraciesRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorAccuraciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIsFeederResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorAccuraciesResponse is the response type for the Query/ValidatorAccuracies RPC method.
+type QueryValidatorAccuraciesResponse struct {
+	// validator_accuracies is a list of accuracies of the tracked validators.
+	ValidatorAccuracies []ValidatorAccuracy `protobuf:"bytes,1,rep,name=validator_accuracies,json=validatorAccuracies,proto3" json:"validator_accuracies"`
+	// pagination is used to paginate the result.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIsFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsFeeder {
-		i--
-		if m.IsFeeder {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryValidatorAccuraciesResponse) Reset()         { *m = QueryValidatorAccuraciesResponse{} }
+func (m *QueryValidatorAccuraciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAccuraciesResponse) ProtoMessage()    {}
+func (*QueryValidatorAccuraciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{33}
+}
+func (m *QueryValidatorAccuraciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAccuraciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAccuraciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidatorAccuraciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAccuraciesResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorAccuraciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAccuraciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAccuraciesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryValidatorAccuraciesResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorAccuraciesResponse) GetValidatorAccuracies() []ValidatorAccuracy {
+	if m != nil {
+		return m.ValidatorAccuracies
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorAccuraciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorPricesRequest is the request type for the Query/ValidatorPrices RPC method.
+type QueryValidatorPricesRequest struct {
+	// validator is the validator address to query prices for.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// signal_ids is the list of signal ids to query the price for.
+	SignalIds []string `protobuf:"bytes,2,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryValidatorPricesRequest) Reset()         { *m = QueryValidatorPricesRequest{} }
+func (m *QueryValidatorPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPricesRequest) ProtoMessage()    {}
+func (*QueryValidatorPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{34}
+}
+func (m *QueryValidatorPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidatorPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPricesRequest.Merge(m, src)
 }
-
-func (m *QueryPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPricesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SignalId) > 0 {
-		i -= len(m.SignalId)
-		copy(dAtA[i:], m.SignalId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryValidatorPricesRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorPricesRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPricesRequest) GetSignalIds() []string {
+	if m != nil {
+		return m.SignalIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorPricesResponse is the response type for the Query/ValidatorPrices RPC method.
+type QueryValidatorPricesResponse struct {
+	// validator_prices is a list of prices submitted by the validator.
+	ValidatorPrices []ValidatorPrice `protobuf:"bytes,1,rep,name=validator_prices,json=validatorPrices,proto3" json:"validator_prices"`
 }
 
-func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryValidatorPricesResponse) Reset()         { *m = QueryValidatorPricesResponse{} }
+func (m *QueryValidatorPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPricesResponse) ProtoMessage()    {}
+func (*QueryValidatorPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{35}
+}
+func (m *QueryValidatorPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPricesResponse.Merge(m, src)
+}
+func (m *QueryValidatorPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPricesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorPricesResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorPricesResponse) GetValidatorPrices() []ValidatorPrice {
+	if m != nil {
+		return m.ValidatorPrices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVoteRequest is the request type for the Query/Vote RPC method.
+type QueryVoteRequest struct {
+	// voter is the voter address to query signal for.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
 }
 
-func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SignalIds) > 0 {
-		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SignalIds[iNdEx])
-			copy(dAtA[i:], m.SignalIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
+func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteRequest) ProtoMessage()    {}
+func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{36}
+}
+func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteRequest.Merge(m, src)
+}
+func (m *QueryVoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVoteRequest proto.InternalMessageInfo
+
+func (m *QueryVoteRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVoteResponse is the response type for the Query/Vote RPC method.
+type QueryVoteResponse struct {
+	// signals is a list of signals submitted by the voter.
+	Signals []Signal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals"`
 }
 
-func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
+func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteResponse) ProtoMessage()    {}
+func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{37}
+}
+func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteResponse.Merge(m, src)
+}
+func (m *QueryVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVoteResponse proto.InternalMessageInfo
+
+func (m *QueryVoteResponse) GetSignals() []Signal {
+	if m != nil {
+		return m.Signals
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegationRequest is the request type for the Query/Delegation RPC method.
+type QueryDelegationRequest struct {
+	// delegator is the delegator address to query delegation for.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 }
 
-func (m *QueryAllPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDelegationRequest) Reset()         { *m = QueryDelegationRequest{} }
+func (m *QueryDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationRequest) ProtoMessage()    {}
+func (*QueryDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{38}
+}
+func (m *QueryDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationRequest.Merge(m, src)
+}
+func (m *QueryDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegationResponse is the response type for the Query/Delegation RPC method.
+type QueryDelegationResponse struct {
+	// delegation is the delegation of the delegator.
+	Delegation Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
 }
 
-func (m *QueryAllPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Prices) > 0 {
-		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDelegationResponse) Reset()         { *m = QueryDelegationResponse{} }
+func (m *QueryDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationResponse) ProtoMessage()    {}
+func (*QueryDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{39}
+}
+func (m *QueryDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryReferenceSourceConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationResponse.Merge(m, src)
 }
-
-func (m *QueryReferenceSourceConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelegationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryReferenceSourceConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryReferenceSourceConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationResponse) GetDelegation() Delegation {
+	if m != nil {
+		return m.Delegation
 	}
-	return dAtA[:n], nil
+	return Delegation{}
 }
 
-func (m *QueryReferenceSourceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegationsRequest is the request type for the Query/Delegations RPC method.
+type QueryDelegationsRequest struct {
+	// delegatee is the delegatee address to query delegations for.
+	Delegatee string `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// pagination is used to paginate the result.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryReferenceSourceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ReferenceSourceConfig.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDelegationsRequest) Reset()         { *m = QueryDelegationsRequest{} }
+func (m *QueryDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsRequest) ProtoMessage()    {}
+func (*QueryDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{40}
+}
+func (m *QueryDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsRequest.Merge(m, src)
+}
+func (m *QueryDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySignalTotalPowersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationsRequest) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySignalTotalPowersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QuerySignalTotalPowersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SignalIds) > 0 {
-		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SignalIds[iNdEx])
-			copy(dAtA[i:], m.SignalIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+// QueryDelegationsResponse is the response type for the Query/Delegations RPC method.
+type QueryDelegationsResponse struct {
+	// delegations is a list of delegations to the delegatee.
+	Delegations []Delegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// total_power is the total voting power delegated to the delegatee.
+	TotalPower int64 `protobuf:"varint,2,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// pagination is used to paginate the result.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsResponse) Reset()         { *m = QueryDelegationsResponse{} }
+func (m *QueryDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsResponse) ProtoMessage()    {}
+func (*QueryDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{41}
+}
+func (m *QueryDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsResponse.Merge(m, src)
+}
+func (m *QueryDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySignalTotalPowersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationsResponse) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySignalTotalPowersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelegationsResponse) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
 }
 
-func (m *QuerySignalTotalPowersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SignalTotalPowers) > 0 {
-		for iNdEx := len(m.SignalTotalPowers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignalTotalPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryCurrentFeedsRequest)(nil), "band.feeds.v1beta1.QueryCurrentFeedsRequest")
+	proto.RegisterType((*QueryCurrentFeedsResponse)(nil), "band.feeds.v1beta1.QueryCurrentFeedsResponse")
+	proto.RegisterType((*QueryIsFeederRequest)(nil), "band.feeds.v1beta1.QueryIsFeederRequest")
+	proto.RegisterType((*QueryIsFeederResponse)(nil), "band.feeds.v1beta1.QueryIsFeederResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "band.feeds.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "band.feeds.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPriceRequest)(nil), "band.feeds.v1beta1.QueryPriceRequest")
+	proto.RegisterType((*QueryPriceResponse)(nil), "band.feeds.v1beta1.QueryPriceResponse")
+	proto.RegisterType((*QueryPricesRequest)(nil), "band.feeds.v1beta1.QueryPricesRequest")
+	proto.RegisterType((*QueryPricesResponse)(nil), "band.feeds.v1beta1.QueryPricesResponse")
+	proto.RegisterType((*QueryAllPricesRequest)(nil), "band.feeds.v1beta1.QueryAllPricesRequest")
+	proto.RegisterType((*QueryAllPricesResponse)(nil), "band.feeds.v1beta1.QueryAllPricesResponse")
+	proto.RegisterType((*QueryPriceAtRequest)(nil), "band.feeds.v1beta1.QueryPriceAtRequest")
+	proto.RegisterType((*QueryPriceAtResponse)(nil), "band.feeds.v1beta1.QueryPriceAtResponse")
+	proto.RegisterType((*QueryPriceHistoryRequest)(nil), "band.feeds.v1beta1.QueryPriceHistoryRequest")
+	proto.RegisterType((*QueryPriceHistoryResponse)(nil), "band.feeds.v1beta1.QueryPriceHistoryResponse")
+	proto.RegisterType((*QueryTWAPRequest)(nil), "band.feeds.v1beta1.QueryTWAPRequest")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "band.feeds.v1beta1.QueryTWAPResponse")
+	proto.RegisterType((*QueryReferenceSourceConfigRequest)(nil), "band.feeds.v1beta1.QueryReferenceSourceConfigRequest")
+	proto.RegisterType((*QueryReferenceSourceConfigResponse)(nil), "band.feeds.v1beta1.QueryReferenceSourceConfigResponse")
+	proto.RegisterType((*QuerySignalMetadataRequest)(nil), "band.feeds.v1beta1.QuerySignalMetadataRequest")
+	proto.RegisterType((*QuerySignalMetadataResponse)(nil), "band.feeds.v1beta1.QuerySignalMetadataResponse")
+	proto.RegisterType((*QueryAllSignalMetadataRequest)(nil), "band.feeds.v1beta1.QueryAllSignalMetadataRequest")
+	proto.RegisterType((*QueryAllSignalMetadataResponse)(nil), "band.feeds.v1beta1.QueryAllSignalMetadataResponse")
+	proto.RegisterType((*QueryCircuitBreakersRequest)(nil), "band.feeds.v1beta1.QueryCircuitBreakersRequest")
+	proto.RegisterType((*QueryCircuitBreakersResponse)(nil), "band.feeds.v1beta1.QueryCircuitBreakersResponse")
+	proto.RegisterType((*QuerySignalTotalPowersRequest)(nil), "band.feeds.v1beta1.QuerySignalTotalPowersRequest")
+	proto.RegisterType((*QuerySignalTotalPowersResponse)(nil), "band.feeds.v1beta1.QuerySignalTotalPowersResponse")
+	proto.RegisterType((*QueryValidValidatorRequest)(nil), "band.feeds.v1beta1.QueryValidValidatorRequest")
+	proto.RegisterType((*QueryValidValidatorResponse)(nil), "band.feeds.v1beta1.QueryValidValidatorResponse")
+	proto.RegisterType((*QueryValidatorAccuracyRequest)(nil), "band.feeds.v1beta1.QueryValidatorAccuracyRequest")
+	proto.RegisterType((*QueryValidatorAccuracyResponse)(nil), "band.feeds.v1beta1.QueryValidatorAccuracyResponse")
+	proto.RegisterType((*QueryValidatorAccuraciesRequest)(nil), "band.feeds.v1beta1.QueryValidatorAccuraciesRequest")
+	proto.RegisterType((*QueryValidatorAccuraciesResponse)(nil), "band.feeds.v1beta1.QueryValidatorAccuraciesResponse")
+	proto.RegisterType((*QueryValidatorPricesRequest)(nil), "band.feeds.v1beta1.QueryValidatorPricesRequest")
+	proto.RegisterType((*QueryValidatorPricesResponse)(nil), "band.feeds.v1beta1.QueryValidatorPricesResponse")
+	proto.RegisterType((*QueryVoteRequest)(nil), "band.feeds.v1beta1.QueryVoteRequest")
+	proto.RegisterType((*QueryVoteResponse)(nil), "band.feeds.v1beta1.QueryVoteResponse")
+	proto.RegisterType((*QueryDelegationRequest)(nil), "band.feeds.v1beta1.QueryDelegationRequest")
+	proto.RegisterType((*QueryDelegationResponse)(nil), "band.feeds.v1beta1.QueryDelegationResponse")
+	proto.RegisterType((*QueryDelegationsRequest)(nil), "band.feeds.v1beta1.QueryDelegationsRequest")
+	proto.RegisterType((*QueryDelegationsResponse)(nil), "band.feeds.v1beta1.QueryDelegationsResponse")
 }
 
-func (m *QueryValidValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("band/feeds/v1beta1/query.proto", fileDescriptor_39690037efa6f66f) }
+
+var fileDescriptor_39690037efa6f66f = []byte{
+	// 1824 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xdd, 0x4f, 0xdc, 0xd8,
+	0x15, 0xc0, 0x31, 0x04, 0xc2, 0x1c, 0xd2, 0x90, 0xb9, 0x40, 0x02, 0x06, 0x86, 0xe0, 0x04, 0xc2,
+	0xe7, 0x98, 0x8f, 0x24, 0x4d, 0xfb, 0x52, 0x41, 0x10, 0xf9, 0xa8, 0xd2, 0x90, 0x21, 0x4a, 0xaa,
+	0x48, 0xed, 0xd4, 0x78, 0x2e, 0x83, 0xd5, 0x61, 0x3c, 0xb1, 0x3d, 0x43, 0x10, 0x45, 0x51, 0x5b,
+	0xb5, 0x52, 0x1f, 0x2a, 0xb5, 0x8a, 0x54, 0xa9, 0xad, 0xb2, 0x2b, 0xed, 0xc3, 0x3e, 0xac, 0xb4,
+	0x2f, 0xab, 0xec, 0x3e, 0xed, 0xbe, 0x47, 0xda, 0x7d, 0x88, 0xb2, 0x2f, 0xfb, 0xb4, 0x5a, 0x25,
+	0xfb, 0x87, 0xac, 0x7c, 0x7d, 0xec, 0xb1, 0x3d, 0xd7, 0x63, 0x13, 0x66, 0xa5, 0x7d, 0x0a, 0xe3,
+	0x7b, 0x3e, 0x7e, 0xe7, 0xdc, 0xcf, 0x73, 0x02, 0x99, 0x2d, 0xa5, 0x5c, 0x90, 0xb7, 0x29, 0x2d,
+	0x98, 0x72, 0x6d, 0x71, 0x8b, 0x5a, 0xca, 0xa2, 0xfc, 0xb8, 0x4a, 0x8d, 0xfd, 0x6c, 0xc5, 0xd0,
+	0x2d, 0x9d, 0x10, 0x7b, 0x3c, 0xcb, 0xc6, 0xb3, 0x38, 0x2e, 0xf6, 0x17, 0xf5, 0xa2, 0xce, 0x86,
+	0x65, 0xfb, 0x2f, 0x47, 0x52, 0x1c, 0x29, 0xea, 0x7a, 0xb1, 0x44, 0x65, 0xa5, 0xa2, 0xc9, 0x4a,
+	0xb9, 0xac, 0x5b, 0x8a, 0xa5, 0xe9, 0x65, 0x13, 0x47, 0x87, 0x54, 0xdd, 0xdc, 0xd5, 0xcd, 0xbc,
+	0xa3, 0xe6, 0xfc, 0xc0, 0xa1, 0x19, 0xe7, 0x97, 0xbc, 0xa5, 0x98, 0xd4, 0xf1, 0xed, 0x91, 0x54,
+	0x94, 0xa2, 0x56, 0x66, 0x76, 0x50, 0x96, 0x87, 0xeb, 0xc0, 0x39, 0xe3, 0x63, 0x9c, 0xf1, 0x8a,
+	0x62, 0x28, 0xbb, 0x28, 0x20, 0x89, 0x30, 0x78, 0xcf, 0x76, 0x71, 0xbd, 0x6a, 0x18, 0xb4, 0x6c,
+	0xad, 0xdb, 0x92, 0x39, 0xfa, 0xb8, 0x4a, 0x4d, 0x4b, 0xaa, 0xc2, 0x10, 0x67, 0xcc, 0xac, 0xe8,
+	0x65, 0x93, 0x92, 0xdf, 0xc2, 0xcf, 0x54, 0xe7, 0x7b, 0x9e, 0x99, 0x1f, 0x14, 0xce, 0x0b, 0x53,
+	0x3d, 0x4b, 0xf3, 0xd9, 0xc6, 0x04, 0x65, 0x7d, 0x06, 0x1e, 0x6a, 0xd6, 0xce, 0x1a, 0xad, 0x69,
+	0x4e, 0x32, 0x56, 0x4f, 0xbc, 0xfc, 0x76, 0xac, 0x2d, 0x77, 0x4a, 0xf5, 0x79, 0x90, 0xfe, 0x21,
+	0x40, 0x3f, 0xf3, 0x7b, 0xcb, 0xb4, 0x3f, 0x50, 0x03, 0x79, 0xc8, 0xaf, 0x20, 0x55, 0x53, 0x4a,
+	0x5a, 0x41, 0xb1, 0x74, 0x83, 0xb9, 0x4b, 0xad, 0x8e, 0xbf, 0x7e, 0x31, 0x3f, 0x8a, 0xd9, 0x7b,
+	0xe0, 0x8e, 0xad, 0x14, 0x0a, 0x06, 0x35, 0xcd, 0x4d, 0xcb, 0xd0, 0xca, 0xc5, 0x5c, 0x5d, 0x87,
+	0x2c, 0x40, 0xd7, 0x36, 0xb3, 0x38, 0xd8, 0xce, 0xb4, 0x07, 0x5f, 0xbf, 0x98, 0xef, 0x47, 0xed,
+	0xa0, 0x12, 0xca, 0x49, 0x97, 0x61, 0x20, 0x84, 0x82, 0xe1, 0x0f, 0x43, 0x4a, 0x33, 0xf3, 0x68,
+	0xcd, 0x66, 0xe9, 0xce, 0x75, 0x6b, 0x28, 0x24, 0xf5, 0x03, 0x61, 0x5a, 0x1b, 0x2c, 0xd3, 0x6e,
+	0x3a, 0xef, 0x42, 0x5f, 0xe0, 0x2b, 0x5a, 0xba, 0x06, 0x5d, 0xce, 0x8c, 0x60, 0x06, 0x45, 0x5e,
+	0x06, 0x1d, 0x1d, 0x4c, 0x17, 0xca, 0x4b, 0x0b, 0x90, 0x76, 0x0c, 0x1a, 0x9a, 0x4a, 0xdd, 0x24,
+	0x0d, 0x43, 0xca, 0xd4, 0x8a, 0x65, 0xa5, 0x94, 0xd7, 0x0a, 0x4e, 0x92, 0x72, 0xdd, 0xce, 0x87,
+	0x5b, 0x05, 0xe9, 0xd7, 0x40, 0xfc, 0x1a, 0x48, 0x70, 0x05, 0x3a, 0x2b, 0xf6, 0x07, 0x04, 0x18,
+	0xe2, 0x02, 0xd8, 0x02, 0xe8, 0xdf, 0x91, 0x96, 0x96, 0xfd, 0xc6, 0xdc, 0x28, 0xc9, 0x28, 0x80,
+	0xe7, 0xdf, 0x0e, 0xa9, 0x63, 0x2a, 0x95, 0x4b, 0xb9, 0x00, 0xa6, 0xf4, 0x1b, 0xe8, 0x0b, 0x28,
+	0x21, 0xc2, 0xcf, 0xa1, 0x8b, 0x19, 0x75, 0x34, 0x12, 0x30, 0xa0, 0xb8, 0x94, 0xc7, 0x09, 0x5a,
+	0x29, 0x95, 0x82, 0x1c, 0xeb, 0x00, 0xf5, 0xdd, 0x82, 0x91, 0x4d, 0x66, 0x71, 0xb2, 0xed, 0xad,
+	0x95, 0x75, 0xb6, 0x75, 0x3d, 0xc3, 0x45, 0x37, 0x87, 0x39, 0x9f, 0xa6, 0xf4, 0x3f, 0x01, 0xce,
+	0x86, 0x3d, 0x1c, 0x13, 0x9a, 0xdc, 0x08, 0xb0, 0xb5, 0x33, 0xb6, 0x4b, 0xb1, 0x6c, 0x8e, 0xd7,
+	0x00, 0xdc, 0x86, 0x3f, 0x9b, 0x2b, 0x56, 0x92, 0x35, 0x40, 0x46, 0x20, 0x65, 0x69, 0xbb, 0xd4,
+	0xb4, 0x94, 0xdd, 0x0a, 0xf3, 0xdd, 0x91, 0xab, 0x7f, 0x90, 0xee, 0x40, 0x7f, 0xd0, 0xe2, 0xf1,
+	0xd6, 0xc8, 0x53, 0x3c, 0x5e, 0xd8, 0xd0, 0x4d, 0xcd, 0xb4, 0x74, 0x63, 0x3f, 0x11, 0xe5, 0x3a,
+	0x27, 0x45, 0xef, 0x32, 0x7d, 0xcf, 0x05, 0x18, 0xe2, 0x10, 0xfc, 0x64, 0x66, 0xf0, 0x06, 0x9c,
+	0x61, 0x78, 0xf7, 0x1f, 0xae, 0x6c, 0x24, 0x4a, 0xcc, 0x59, 0xe8, 0xda, 0xd3, 0xca, 0x05, 0x7d,
+	0x0f, 0xe7, 0x0e, 0x7f, 0x49, 0xb7, 0x21, 0xed, 0x33, 0x74, 0xbc, 0x59, 0xbb, 0x00, 0xe3, 0xcc,
+	0x56, 0x8e, 0x6e, 0x53, 0x83, 0x96, 0x55, 0xba, 0xa9, 0x57, 0x0d, 0x95, 0x5e, 0xd7, 0xcb, 0xdb,
+	0x5a, 0xd1, 0x3d, 0xce, 0xfe, 0x29, 0x80, 0xd4, 0x4c, 0x0a, 0x11, 0x8a, 0x70, 0xce, 0x70, 0x05,
+	0xf2, 0x26, 0x93, 0xc8, 0xab, 0x4c, 0x04, 0xa1, 0xa6, 0x79, 0x50, 0x5c, 0x9b, 0x08, 0x39, 0x60,
+	0xf0, 0x06, 0xa5, 0x5f, 0x80, 0xc8, 0x70, 0x36, 0x59, 0xa6, 0xee, 0x50, 0x4b, 0x29, 0x28, 0x96,
+	0x92, 0xe8, 0x58, 0xac, 0xc0, 0x30, 0x57, 0x15, 0x43, 0xb8, 0x07, 0xbd, 0xa8, 0xbb, 0x8b, 0x43,
+	0x88, 0x2e, 0xf1, 0xd0, 0x83, 0x46, 0x90, 0xf9, 0xb4, 0x19, 0xf8, 0x2a, 0x15, 0x61, 0xd4, 0x3d,
+	0x54, 0xf8, 0xbc, 0xad, 0x3a, 0xbe, 0x3e, 0x17, 0x20, 0x13, 0xe5, 0xa9, 0x59, 0x78, 0x1d, 0xc7,
+	0x09, 0xaf, 0x75, 0xdb, 0x83, 0xe2, 0xcc, 0x5c, 0xd7, 0x0c, 0xb5, 0xaa, 0x59, 0xab, 0x06, 0x55,
+	0xfe, 0x48, 0x0d, 0xf3, 0x47, 0xc8, 0xd2, 0x08, 0xdf, 0x0f, 0xe6, 0x68, 0x13, 0xce, 0xa8, 0xce,
+	0x50, 0x7e, 0x0b, 0xc7, 0x9a, 0x25, 0x29, 0x68, 0x06, 0x93, 0xd4, 0xab, 0x06, 0x8d, 0xb7, 0x2e,
+	0x4b, 0x7f, 0x17, 0x70, 0x39, 0x39, 0x93, 0x73, 0x5f, 0xb7, 0x94, 0xd2, 0x86, 0xbe, 0x47, 0x8d,
+	0x84, 0xb7, 0x72, 0xcb, 0x4e, 0xdb, 0x4f, 0xdd, 0xd5, 0xc6, 0x01, 0xc1, 0x4c, 0xde, 0x86, 0x3e,
+	0x24, 0xb1, 0xec, 0xd1, 0x7c, 0x85, 0x0d, 0x63, 0x32, 0xc5, 0xe8, 0x15, 0x97, 0x4b, 0x9b, 0x61,
+	0x9b, 0xad, 0x4b, 0xe0, 0xef, 0xf0, 0xec, 0x60, 0x4f, 0x48, 0xef, 0x1d, 0xd9, 0xaa, 0x77, 0xa7,
+	0xb4, 0x8c, 0xab, 0x38, 0x6c, 0x1e, 0x53, 0xd2, 0x0f, 0x9d, 0x4c, 0x16, 0xdf, 0x91, 0xce, 0x0f,
+	0xe9, 0x0f, 0x38, 0xa7, 0x75, 0xf3, 0xaa, 0x5a, 0x35, 0x14, 0x75, 0xbf, 0x65, 0x58, 0x7f, 0xc2,
+	0xc9, 0xe2, 0x78, 0x40, 0xb2, 0x47, 0x40, 0x3c, 0xf1, 0xbc, 0x82, 0xa3, 0xb8, 0xcf, 0x26, 0x78,
+	0x73, 0xd5, 0x60, 0x0a, 0xd7, 0x7e, 0xba, 0x16, 0x1e, 0x90, 0x34, 0x18, 0xe3, 0x7a, 0xd7, 0x5a,
+	0xff, 0x86, 0xfb, 0x52, 0x80, 0xf3, 0xd1, 0xbe, 0x30, 0xd6, 0xdf, 0x43, 0x7f, 0x43, 0xac, 0x9a,
+	0xf7, 0x32, 0x38, 0x52, 0xb4, 0x7d, 0xb5, 0x46, 0x3f, 0xad, 0x5b, 0xac, 0x87, 0xfe, 0xd5, 0x64,
+	0x3b, 0x09, 0x3e, 0x7c, 0x8f, 0x5d, 0x25, 0x05, 0xcf, 0x8a, 0xf6, 0xf0, 0x0b, 0xde, 0xc4, 0xa3,
+	0xb2, 0xc1, 0x7d, 0xfd, 0xa8, 0xac, 0xe7, 0x31, 0xf0, 0xba, 0x92, 0x9a, 0xe6, 0xd0, 0xff, 0x0e,
+	0xe9, 0xad, 0x05, 0x8d, 0x4b, 0xab, 0xf8, 0x4c, 0x7a, 0xa0, 0x5b, 0x5e, 0xa5, 0x93, 0x85, 0xce,
+	0x9a, 0x6e, 0x51, 0x37, 0xc8, 0xe8, 0x62, 0xce, 0x11, 0x93, 0xee, 0x42, 0xda, 0x67, 0x03, 0x69,
+	0x7f, 0x09, 0x27, 0x9d, 0xd0, 0x12, 0x1c, 0x41, 0x08, 0xe7, 0x2a, 0x48, 0x1b, 0x58, 0x19, 0xac,
+	0xd1, 0x12, 0x2d, 0xb2, 0xb9, 0x71, 0xd1, 0xae, 0x42, 0xaa, 0xe0, 0x7c, 0xd4, 0xe3, 0xf1, 0xea,
+	0xa2, 0x52, 0x1e, 0xce, 0x35, 0x58, 0x44, 0xd0, 0x35, 0x80, 0x82, 0xf7, 0x15, 0xf7, 0x42, 0x86,
+	0xc7, 0x5a, 0xd7, 0x45, 0x5e, 0x9f, 0x9e, 0xf4, 0x5f, 0xa1, 0xc1, 0x83, 0xd9, 0x08, 0x4d, 0x69,
+	0x62, 0x68, 0x4a, 0x5b, 0x76, 0x79, 0x7c, 0x25, 0xc0, 0x60, 0x23, 0x1b, 0x86, 0xbf, 0x0e, 0x3d,
+	0xf5, 0x30, 0xdc, 0xb9, 0x4a, 0x16, 0xbf, 0x5f, 0x91, 0x8c, 0x41, 0x8f, 0xef, 0xde, 0xc1, 0x37,
+	0x34, 0x58, 0xde, 0xa5, 0x12, 0xda, 0xa6, 0x1d, 0xef, 0xbc, 0x4d, 0x97, 0xbe, 0x18, 0x82, 0x4e,
+	0x16, 0x0e, 0xf9, 0xb7, 0x00, 0xa7, 0xfc, 0x3d, 0x14, 0x32, 0xc7, 0xe3, 0x8e, 0x6a, 0xc3, 0x88,
+	0xf3, 0x09, 0xa5, 0x1d, 0x06, 0xe9, 0xe2, 0x5f, 0xbe, 0xfe, 0xfe, 0x59, 0x7b, 0x86, 0x8c, 0x84,
+	0xda, 0x3e, 0x81, 0x6e, 0x0d, 0x79, 0x26, 0x40, 0xb7, 0xdb, 0xd4, 0x20, 0x53, 0x91, 0x1e, 0x42,
+	0x2d, 0x18, 0x71, 0x3a, 0x81, 0x24, 0x72, 0x2c, 0x31, 0x8e, 0x39, 0x32, 0xc3, 0x69, 0x4f, 0x51,
+	0x43, 0x3e, 0xf0, 0xb6, 0xf8, 0xa1, 0x7c, 0xe0, 0x7c, 0x3b, 0x24, 0x4f, 0xa0, 0xcb, 0xe9, 0x74,
+	0x90, 0xc9, 0x48, 0x47, 0x81, 0xa6, 0x8a, 0x78, 0x29, 0x56, 0x0e, 0x71, 0x46, 0x19, 0xce, 0x39,
+	0x32, 0xc0, 0xed, 0x86, 0x91, 0x3f, 0x0b, 0xd0, 0xc9, 0xce, 0x1a, 0x32, 0x11, 0x6d, 0xd1, 0xd7,
+	0x67, 0x11, 0x27, 0xe3, 0xc4, 0xd0, 0xef, 0x34, 0xf3, 0x7b, 0x81, 0x8c, 0x87, 0xfd, 0xda, 0x52,
+	0xa6, 0x7c, 0xe0, 0x1d, 0xb5, 0x4e, 0xf4, 0xec, 0x2b, 0x89, 0x31, 0x9e, 0x24, 0xfa, 0xc0, 0xa1,
+	0x1c, 0x1d, 0xbd, 0xe3, 0xef, 0x6f, 0x02, 0xa4, 0xbc, 0xfe, 0x06, 0x89, 0x9e, 0xe4, 0x70, 0x97,
+	0x45, 0x9c, 0x49, 0x22, 0x8a, 0x0c, 0xe3, 0x8c, 0x61, 0x98, 0x0c, 0x85, 0x18, 0x94, 0x52, 0x09,
+	0xef, 0x09, 0xf2, 0x1f, 0x01, 0x4e, 0x62, 0xe7, 0x81, 0xc4, 0xc4, 0xe6, 0x75, 0x3b, 0xc4, 0xa9,
+	0x78, 0x41, 0x24, 0xb8, 0xc6, 0x08, 0x96, 0xc8, 0x42, 0xec, 0x5c, 0xc8, 0x8a, 0x25, 0x1f, 0x78,
+	0x5d, 0x91, 0x43, 0xf2, 0x9e, 0x00, 0xa7, 0xfc, 0x1d, 0x84, 0x26, 0x5b, 0x98, 0xd3, 0xea, 0x10,
+	0xe7, 0x13, 0x4a, 0x23, 0xe7, 0x22, 0xe3, 0x9c, 0x25, 0xd3, 0xf1, 0x9c, 0x3b, 0xc8, 0xf3, 0x57,
+	0x01, 0x4e, 0xd8, 0xa5, 0x3f, 0xb9, 0x18, 0xe9, 0xca, 0xd7, 0x62, 0x10, 0x27, 0x62, 0xa4, 0x10,
+	0x24, 0xcb, 0x40, 0xa6, 0xc8, 0x64, 0x3c, 0x88, 0xb5, 0xa7, 0x54, 0xc8, 0x67, 0x02, 0x0c, 0x70,
+	0x4b, 0x77, 0x72, 0x25, 0xd2, 0x61, 0xb3, 0x26, 0x83, 0x78, 0xf5, 0xa8, 0x6a, 0x31, 0xe0, 0x11,
+	0xad, 0x08, 0xf2, 0xa1, 0x00, 0xa7, 0x83, 0x95, 0x2d, 0xc9, 0x46, 0xba, 0xe6, 0x56, 0xec, 0xa2,
+	0x9c, 0x58, 0x1e, 0x19, 0x97, 0x19, 0xe3, 0x3c, 0x99, 0x0d, 0x31, 0xe2, 0xf3, 0x22, 0x90, 0x5d,
+	0xb7, 0x32, 0x27, 0x1f, 0x08, 0x90, 0x6e, 0x28, 0xe5, 0xc9, 0x62, 0xb3, 0x6d, 0xc8, 0xc7, 0x5d,
+	0x3a, 0x8a, 0x0a, 0x12, 0x4f, 0x32, 0xe2, 0xf3, 0x24, 0xc3, 0x25, 0xf6, 0xda, 0x07, 0xe4, 0xb9,
+	0x00, 0xbd, 0xa1, 0x4a, 0x9a, 0x44, 0xa7, 0x87, 0x5f, 0xdb, 0x8b, 0x0b, 0xc9, 0x15, 0x10, 0xef,
+	0x12, 0xc3, 0x1b, 0x27, 0x63, 0xe1, 0x9b, 0x2f, 0x54, 0xb9, 0xdb, 0xb3, 0x9d, 0x6e, 0xa8, 0x50,
+	0x9b, 0x24, 0x31, 0xaa, 0xac, 0x16, 0x97, 0x8e, 0xa2, 0x82, 0x94, 0x33, 0x8c, 0xf2, 0x22, 0x91,
+	0xf8, 0x49, 0xf4, 0x57, 0xc5, 0x6c, 0x59, 0x06, 0x8b, 0xc6, 0x26, 0xcb, 0x92, 0x5b, 0xbc, 0x36,
+	0x59, 0x96, 0xfc, 0x6a, 0x34, 0x72, 0x59, 0x7a, 0xf7, 0xb5, 0x19, 0xb8, 0xbb, 0xd9, 0x9f, 0xe4,
+	0x13, 0x01, 0xd2, 0x0d, 0xd5, 0x50, 0x93, 0x8c, 0x46, 0x15, 0xb5, 0xe2, 0xd2, 0x51, 0x54, 0x90,
+	0xf8, 0x2a, 0x23, 0x5e, 0x20, 0xd9, 0x64, 0xc4, 0x6e, 0x1d, 0x4b, 0x3e, 0x16, 0xa0, 0x8f, 0x53,
+	0x11, 0x92, 0xe5, 0xc4, 0x0c, 0xf5, 0x5a, 0x55, 0xbc, 0x7c, 0x34, 0x25, 0x44, 0x9f, 0x65, 0xe8,
+	0x13, 0xe4, 0x42, 0x14, 0xba, 0xaf, 0x12, 0x25, 0x1f, 0x09, 0xd0, 0x1b, 0xaa, 0xba, 0x88, 0x1c,
+	0xef, 0x36, 0x78, 0x63, 0x2f, 0x24, 0x57, 0x40, 0xc6, 0xcb, 0x8c, 0x31, 0x4b, 0xe6, 0x92, 0xa5,
+	0x17, 0xaf, 0xf2, 0xa7, 0x70, 0xc2, 0x2e, 0xb4, 0x9a, 0xdc, 0x47, 0xbe, 0x5a, 0x4e, 0x9c, 0x88,
+	0x91, 0x8a, 0xd9, 0x3b, 0xac, 0xc0, 0xb3, 0x31, 0xec, 0x7f, 0x0f, 0xd9, 0x4f, 0xf2, 0x7f, 0x01,
+	0xa0, 0x5e, 0x0b, 0x90, 0xe8, 0x97, 0x4a, 0x43, 0xf9, 0x26, 0xce, 0x26, 0x92, 0x8d, 0x4b, 0x0f,
+	0x32, 0x79, 0xd5, 0xdd, 0xa1, 0x5c, 0x2f, 0x44, 0xc8, 0xfb, 0x02, 0xf4, 0xac, 0xf9, 0xea, 0x92,
+	0x24, 0x2e, 0xbd, 0x39, 0x9c, 0x4b, 0x26, 0x8c, 0x80, 0x57, 0x18, 0xa0, 0x4c, 0xe6, 0x9b, 0x03,
+	0x52, 0xea, 0x07, 0x34, 0x57, 0x6f, 0xbe, 0x7c, 0x93, 0x11, 0x5e, 0xbd, 0xc9, 0x08, 0xdf, 0xbd,
+	0xc9, 0x08, 0xff, 0x7a, 0x9b, 0x69, 0x7b, 0xf5, 0x36, 0xd3, 0xf6, 0xcd, 0xdb, 0x4c, 0xdb, 0xa3,
+	0x6c, 0x51, 0xb3, 0x76, 0xaa, 0x5b, 0x59, 0x55, 0xdf, 0x95, 0x6d, 0x10, 0xf6, 0x3f, 0xc9, 0xaa,
+	0x5e, 0x92, 0xd5, 0x1d, 0x45, 0x2b, 0xcb, 0xb5, 0x65, 0xf9, 0x09, 0xba, 0xb2, 0xf6, 0x2b, 0xd4,
+	0xdc, 0xea, 0x62, 0x02, 0xcb, 0x3f, 0x0c, 0x00, 0xc0, 0xf8, 0xfc, 0x7e, 0x5c, 0x1f, 0x00, 0x00,
 }
 
-func (m *QueryValidValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// CurrentFeeds is an RPC method that returns a list of current supported feeds.
+	CurrentFeeds(ctx context.Context, in *QueryCurrentFeedsRequest, opts ...grpc.CallOption) (*QueryCurrentFeedsResponse, error)
+	// IsFeeder is an RPC method that returns whether an account is a feeder for a specified validator.
+	IsFeeder(ctx context.Context, in *QueryIsFeederRequest, opts ...grpc.CallOption) (*QueryIsFeederResponse, error)
+	// Params is an RPC method that returns all parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Price is an RPC method that returns price by signal id.
+	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
+	// Prices is an RPC method that prices by signal ids.
+	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// AllPrices is an RPC method that returns all prices.
+	AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error)
+	// PriceAt is an RPC method that returns the historical price of a signal at a given time.
+	PriceAt(ctx context.Context, in *QueryPriceAtRequest, opts ...grpc.CallOption) (*QueryPriceAtResponse, error)
+	// PriceHistory is an RPC method that returns the historical prices of a signal.
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average price of a signal over a window.
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
+	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
+	ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error)
+	// SignalMetadata is an RPC method that returns the metadata of a signal id in the signal registry.
+	SignalMetadata(ctx context.Context, in *QuerySignalMetadataRequest, opts ...grpc.CallOption) (*QuerySignalMetadataResponse, error)
+	// AllSignalMetadata is an RPC method that returns the metadata of all signal ids in the signal registry.
+	AllSignalMetadata(ctx context.Context, in *QueryAllSignalMetadataRequest, opts ...grpc.CallOption) (*QueryAllSignalMetadataResponse, error)
+	// CircuitBreakers is an RPC method that returns the triggered circuit breakers of the signal ids.
+	CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error)
+	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
+	// id.
+	SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error)
+	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
+	ValidValidator(ctx context.Context, in *QueryValidValidatorRequest, opts ...grpc.CallOption) (*QueryValidValidatorResponse, error)
+	// ValidatorAccuracy is an RPC method that returns the accuracy score of a validator.
+	ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error)
+	// ValidatorAccuracies is an RPC method that returns the accuracy scores of all tracked validators.
+	ValidatorAccuracies(ctx context.Context, in *QueryValidatorAccuraciesRequest, opts ...grpc.CallOption) (*QueryValidatorAccuraciesResponse, error)
+	// ValidatorPrices is an RPC method that returns prices of a validator.
+	ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error)
+	// Vote is an RPC method that returns signals of a voter.
+	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
+	// Delegation is an RPC method that returns the delegation of feeds voting power of a delegator.
+	Delegation(ctx context.Context, in *QueryDelegationRequest, opts ...grpc.CallOption) (*QueryDelegationResponse, error)
+	// Delegations is an RPC method that returns the delegations of feeds voting power to a delegatee.
+	Delegations(ctx context.Context, in *QueryDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegationsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) CurrentFeeds(ctx context.Context, in *QueryCurrentFeedsRequest, opts ...grpc.CallOption) (*QueryCurrentFeedsResponse, error) {
+	out := new(QueryCurrentFeedsResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/CurrentFeeds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryValidValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IsFeeder(ctx context.Context, in *QueryIsFeederRequest, opts ...grpc.CallOption) (*QueryIsFeederResponse, error) {
+	out := new(QueryIsFeederResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/IsFeeder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryValidValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryValidValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Valid {
-		i--
-		if m.Valid {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error) {
+	out := new(QueryPriceResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Price", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryValidatorPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
+	out := new(QueryPricesResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Prices", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryValidatorPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryValidatorPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SignalIds) > 0 {
-		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SignalIds[iNdEx])
-			copy(dAtA[i:], m.SignalIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error) {
+	out := new(QueryAllPricesResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/AllPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryValidatorPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PriceAt(ctx context.Context, in *QueryPriceAtRequest, opts ...grpc.CallOption) (*QueryPriceAtResponse, error) {
+	out := new(QueryPriceAtResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/PriceAt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryValidatorPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryValidatorPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorPrices) > 0 {
-		for iNdEx := len(m.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/PriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/TWAP", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ReferenceSourceConfig(ctx context.Context, in *QueryReferenceSourceConfigRequest, opts ...grpc.CallOption) (*QueryReferenceSourceConfigResponse, error) {
+	out := new(QueryReferenceSourceConfigResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ReferenceSourceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SignalMetadata(ctx context.Context, in *QuerySignalMetadataRequest, opts ...grpc.CallOption) (*QuerySignalMetadataResponse, error) {
+	out := new(QuerySignalMetadataResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/SignalMetadata", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signals) > 0 {
-		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) AllSignalMetadata(ctx context.Context, in *QueryAllSignalMetadataRequest, opts ...grpc.CallOption) (*QueryAllSignalMetadataResponse, error) {
+	out := new(QueryAllSignalMetadataResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/AllSignalMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error) {
+	out := new(QueryCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/CircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryCurrentFeedsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) SignalTotalPowers(ctx context.Context, in *QuerySignalTotalPowersRequest, opts ...grpc.CallOption) (*QuerySignalTotalPowersResponse, error) {
+	out := new(QuerySignalTotalPowersResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/SignalTotalPowers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryCurrentFeedsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ValidValidator(ctx context.Context, in *QueryValidValidatorRequest, opts ...grpc.CallOption) (*QueryValidValidatorResponse, error) {
+	out := new(QueryValidValidatorResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CurrentFeeds.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryIsFeederRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error) {
+	out := new(QueryValidatorAccuracyResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidatorAccuracy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryIsFeederResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsFeeder {
-		n += 2
+func (c *queryClient) ValidatorAccuracies(ctx context.Context, in *QueryValidatorAccuraciesRequest, opts ...grpc.CallOption) (*QueryValidatorAccuraciesResponse, error) {
+	out := new(QueryValidatorAccuraciesResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidatorAccuracies", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ValidatorPrices(ctx context.Context, in *QueryValidatorPricesRequest, opts ...grpc.CallOption) (*QueryValidatorPricesResponse, error) {
+	out := new(QueryValidatorPricesResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/ValidatorPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
+	out := new(QueryVoteResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SignalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Delegation(ctx context.Context, in *QueryDelegationRequest, opts ...grpc.CallOption) (*QueryDelegationResponse, error) {
+	out := new(QueryDelegationResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Delegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Delegations(ctx context.Context, in *QueryDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegationsResponse, error) {
+	out := new(QueryDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Delegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SignalIds) > 0 {
-		for _, s := range m.SignalIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// CurrentFeeds is an RPC method that returns a list of current supported feeds.
+	CurrentFeeds(context.Context, *QueryCurrentFeedsRequest) (*QueryCurrentFeedsResponse, error)
+	// IsFeeder is an RPC method that returns whether an account is a feeder for a specified validator.
+	IsFeeder(context.Context, *QueryIsFeederRequest) (*QueryIsFeederResponse, error)
+	// Params is an RPC method that returns all parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Price is an RPC method that returns price by signal id.
+	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
+	// Prices is an RPC method that prices by signal ids.
+	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// AllPrices is an RPC method that returns all prices.
+	AllPrices(context.Context, *QueryAllPricesRequest) (*QueryAllPricesResponse, error)
+	// PriceAt is an RPC method that returns the historical price of a signal at a given time.
+	PriceAt(context.Context, *QueryPriceAtRequest) (*QueryPriceAtResponse, error)
+	// PriceHistory is an RPC method that returns the historical prices of a signal.
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average price of a signal over a window.
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
+	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
+	ReferenceSourceConfig(context.Context, *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error)
+	// SignalMetadata is an RPC method that returns the metadata of a signal id in the signal registry.
+	SignalMetadata(context.Context, *QuerySignalMetadataRequest) (*QuerySignalMetadataResponse, error)
+	// AllSignalMetadata is an RPC method that returns the metadata of all signal ids in the signal registry.
+	AllSignalMetadata(context.Context, *QueryAllSignalMetadataRequest) (*QueryAllSignalMetadataResponse, error)
+	// CircuitBreakers is an RPC method that returns the triggered circuit breakers of the signal ids.
+	CircuitBreakers(context.Context, *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error)
+	// SignalTotalPowers is an RPC method that returns all signal-total-powers or specified signal-total-power by signal
+	// id.
+	SignalTotalPowers(context.Context, *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error)
+	// ValidValidator is an RPC method that returns a flag to show if the validator is required to send prices.
+	ValidValidator(context.Context, *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error)
+	// ValidatorAccuracy is an RPC method that returns the accuracy score of a validator.
+	ValidatorAccuracy(context.Context, *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error)
+	// ValidatorAccuracies is an RPC method that returns the accuracy scores of all tracked validators.
+	ValidatorAccuracies(context.Context, *QueryValidatorAccuraciesRequest) (*QueryValidatorAccuraciesResponse, error)
+	// ValidatorPrices is an RPC method that returns prices of a validator.
+	ValidatorPrices(context.Context, *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error)
+	// Vote is an RPC method that returns signals of a voter.
+	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
+	// Delegation is an RPC method that returns the delegation of feeds voting power of a delegator.
+	Delegation(context.Context, *QueryDelegationRequest) (*QueryDelegationResponse, error)
+	// Delegations is an RPC method that returns the delegations of feeds voting power to a delegatee.
+	Delegations(context.Context, *QueryDelegationsRequest) (*QueryDelegationsResponse, error)
 }
 
-func (m *QueryPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAllPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) CurrentFeeds(ctx context.Context, req *QueryCurrentFeedsRequest) (*QueryCurrentFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentFeeds not implemented")
+}
+func (*UnimplementedQueryServer) IsFeeder(ctx context.Context, req *QueryIsFeederRequest) (*QueryIsFeederResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFeeder not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPriceRequest) (*QueryPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
+}
+func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+}
+func (*UnimplementedQueryServer) AllPrices(ctx context.Context, req *QueryAllPricesRequest) (*QueryAllPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPrices not implemented")
+}
+func (*UnimplementedQueryServer) PriceAt(ctx context.Context, req *QueryPriceAtRequest) (*QueryPriceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAt not implemented")
+}
+func (*UnimplementedQueryServer) PriceHistory(ctx context.Context, req *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
+func (*UnimplementedQueryServer) ReferenceSourceConfig(ctx context.Context, req *QueryReferenceSourceConfigRequest) (*QueryReferenceSourceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceSourceConfig not implemented")
+}
+func (*UnimplementedQueryServer) SignalMetadata(ctx context.Context, req *QuerySignalMetadataRequest) (*QuerySignalMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalMetadata not implemented")
+}
+func (*UnimplementedQueryServer) AllSignalMetadata(ctx context.Context, req *QueryAllSignalMetadataRequest) (*QueryAllSignalMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllSignalMetadata not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreakers(ctx context.Context, req *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakers not implemented")
+}
+func (*UnimplementedQueryServer) SignalTotalPowers(ctx context.Context, req *QuerySignalTotalPowersRequest) (*QuerySignalTotalPowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalTotalPowers not implemented")
+}
+func (*UnimplementedQueryServer) ValidValidator(ctx context.Context, req *QueryValidValidatorRequest) (*QueryValidValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidValidator not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorAccuracy(ctx context.Context, req *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAccuracy not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorAccuracies(ctx context.Context, req *QueryValidatorAccuraciesRequest) (*QueryValidatorAccuraciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAccuracies not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPrices(ctx context.Context, req *QueryValidatorPricesRequest) (*QueryValidatorPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPrices not implemented")
+}
+func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (*UnimplementedQueryServer) Delegation(ctx context.Context, req *QueryDelegationRequest) (*QueryDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegation not implemented")
+}
+func (*UnimplementedQueryServer) Delegations(ctx context.Context, req *QueryDelegationsRequest) (*QueryDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegations not implemented")
 }
 
-func (m *QueryAllPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_CurrentFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentFeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentFeeds(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/CurrentFeeds",
 	}
-	return n
-}
-
-func (m *QueryReferenceSourceConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentFeeds(ctx, req.(*QueryCurrentFeedsRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReferenceSourceConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_IsFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsFeederRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ReferenceSourceConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QuerySignalTotalPowersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).IsFeeder(ctx, in)
 	}
-	var l int
-	_ = l
-	if len(m.SignalIds) > 0 {
-		for _, s := range m.SignalIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/IsFeeder",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsFeeder(ctx, req.(*QueryIsFeederRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySignalTotalPowersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.SignalTotalPowers) > 0 {
-		for _, e := range m.SignalTotalPowers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Price(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryValidValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/Price",
 	}
-	var l int
-	_ = l
-	if m.Valid {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Price(ctx, req.(*QueryPriceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Prices(ctx, in)
 	}
-	if len(m.SignalIds) > 0 {
-		for _, s := range m.SignalIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/Prices",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Prices(ctx, req.(*QueryPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorPricesResponse) Size() (n int) {
-	if m == nil {
+func _Query_AllPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/AllPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllPrices(ctx, req.(*QueryAllPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/PriceAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceAt(ctx, req.(*QueryPriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/PriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReferenceSourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReferenceSourceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReferenceSourceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/ReferenceSourceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReferenceSourceConfig(ctx, req.(*QueryReferenceSourceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignalMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/SignalMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalMetadata(ctx, req.(*QuerySignalMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllSignalMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSignalMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllSignalMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/AllSignalMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllSignalMetadata(ctx, req.(*QueryAllSignalMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/CircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakers(ctx, req.(*QueryCircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignalTotalPowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalTotalPowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalTotalPowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/SignalTotalPowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalTotalPowers(ctx, req.(*QuerySignalTotalPowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/ValidValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidValidator(ctx, req.(*QueryValidValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorAccuracy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAccuracyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/ValidatorAccuracy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, req.(*QueryValidatorAccuracyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorAccuracies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAccuraciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAccuracies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/ValidatorAccuracies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAccuracies(ctx, req.(*QueryValidatorAccuraciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/ValidatorPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPrices(ctx, req.(*QueryValidatorPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/Vote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vote(ctx, req.(*QueryVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Delegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Delegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/Delegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Delegation(ctx, req.(*QueryDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Delegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Delegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/Delegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Delegations(ctx, req.(*QueryDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.feeds.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CurrentFeeds",
+			Handler:    _Query_CurrentFeeds_Handler,
+		},
+		{
+			MethodName: "IsFeeder",
+			Handler:    _Query_IsFeeder_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Price",
+			Handler:    _Query_Price_Handler,
+		},
+		{
+			MethodName: "Prices",
+			Handler:    _Query_Prices_Handler,
+		},
+		{
+			MethodName: "AllPrices",
+			Handler:    _Query_AllPrices_Handler,
+		},
+		{
+			MethodName: "PriceAt",
+			Handler:    _Query_PriceAt_Handler,
+		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
+		{
+			MethodName: "ReferenceSourceConfig",
+			Handler:    _Query_ReferenceSourceConfig_Handler,
+		},
+		{
+			MethodName: "SignalMetadata",
+			Handler:    _Query_SignalMetadata_Handler,
+		},
+		{
+			MethodName: "AllSignalMetadata",
+			Handler:    _Query_AllSignalMetadata_Handler,
+		},
+		{
+			MethodName: "CircuitBreakers",
+			Handler:    _Query_CircuitBreakers_Handler,
+		},
+		{
+			MethodName: "SignalTotalPowers",
+			Handler:    _Query_SignalTotalPowers_Handler,
+		},
+		{
+			MethodName: "ValidValidator",
+			Handler:    _Query_ValidValidator_Handler,
+		},
+		{
+			MethodName: "ValidatorAccuracy",
+			Handler:    _Query_ValidatorAccuracy_Handler,
+		},
+		{
+			MethodName: "ValidatorAccuracies",
+			Handler:    _Query_ValidatorAccuracies_Handler,
+		},
+		{
+			MethodName: "ValidatorPrices",
+			Handler:    _Query_ValidatorPrices_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Query_Vote_Handler,
+		},
+		{
+			MethodName: "Delegation",
+			Handler:    _Query_Delegation_Handler,
+		},
+		{
+			MethodName: "Delegations",
+			Handler:    _Query_Delegations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/feeds/v1beta1/query.proto",
+}
+
+func (m *QueryCurrentFeedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentFeedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentFeedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentFeedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentFeedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentFeedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrentFeeds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsFeederRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsFeederRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsFeederRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsFeederResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsFeederResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsFeeder {
+		i--
+		if m.IsFeeder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferenceSourceConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferenceSourceConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferenceSourceConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferenceSourceConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferenceSourceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferenceSourceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReferenceSourceConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SignalMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSignalMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSignalMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSignalMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSignalMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSignalMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSignalMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalMetadata) > 0 {
+		for iNdEx := len(m.SignalMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalTotalPowersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalTotalPowersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalTotalPowersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalTotalPowersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalTotalPowersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalTotalPowersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalTotalPowers) > 0 {
+		for iNdEx := len(m.SignalTotalPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalTotalPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAccuracyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAccuracyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAccuracyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAccuracyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAccuracyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAccuracyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidatorAccuracy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAccuraciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAccuraciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAccuraciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAccuraciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAccuraciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAccuraciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAccuracies) > 0 {
+		for iNdEx := len(m.ValidatorAccuracies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorAccuracies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorPrices) > 0 {
+		for iNdEx := len(m.ValidatorPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCurrentFeedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentFeedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentFeeds.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIsFeederRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsFeederResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsFeeder {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for _, s := range m.SignalIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryPriceAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReferenceSourceConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReferenceSourceConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReferenceSourceConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySignalMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SignalMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSignalMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSignalMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalMetadata) > 0 {
+		for _, e := range m.SignalMetadata {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalTotalPowersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for _, s := range m.SignalIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalTotalPowersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalTotalPowers) > 0 {
+		for _, e := range m.SignalTotalPowers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryValidatorAccuracyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccuracyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorAccuracy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorAccuraciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccuraciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorAccuracies) > 0 {
+		for _, e := range m.ValidatorAccuracies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SignalIds) > 0 {
+		for _, s := range m.SignalIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorPrices) > 0 {
+		for _, e := range m.ValidatorPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if len(m.ValidatorPrices) > 0 {
-		for _, e := range m.ValidatorPrices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCurrentFeedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentFeedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentFeedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentFeedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentFeedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentFeedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentFeeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsFeederRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsFeederRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsFeederRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsFeederResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsFeederResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFeeder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFeeder = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceAtRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceAtRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceAtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceAtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTWAPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReferenceSourceConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferenceSourceConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferenceSourceConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferenceSourceConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferenceSourceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignalMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignalMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignalMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSignalMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSignalMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSignalMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Signals) > 0 {
-		for _, e := range m.Signals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryCurrentFeedsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllSignalMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2722,12 +7212,82 @@ func (m *QueryCurrentFeedsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentFeedsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllSignalMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentFeedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllSignalMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalMetadata = append(m.SignalMetadata, SignalMetadata{})
+			if err := m.SignalMetadata[len(m.SignalMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2749,7 +7309,7 @@ func (m *QueryCurrentFeedsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentFeedsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2772,15 +7332,15 @@ func (m *QueryCurrentFeedsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentFeedsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentFeedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFeeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2807,7 +7367,10 @@ func (m *QueryCurrentFeedsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentFeeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2832,7 +7395,7 @@ func (m *QueryCurrentFeedsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsFeederRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2855,17 +7418,17 @@ func (m *QueryIsFeederRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsFeederRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsFeederRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2875,29 +7438,31 @@ func (m *QueryIsFeederRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2907,23 +7472,27 @@ func (m *QueryIsFeederRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2946,7 +7515,7 @@ func (m *QueryIsFeederRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsFeederResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2969,17 +7538,17 @@ func (m *QueryIsFeederResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsFeederResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignalTotalPowersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignalTotalPowersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFeeder", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2989,62 +7558,60 @@ func (m *QueryIsFeederResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFeeder = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3066,7 +7633,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignalTotalPowersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3089,15 +7656,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignalTotalPowersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignalTotalPowersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalTotalPowers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3124,7 +7691,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignalTotalPowers = append(m.SignalTotalPowers, &Signal{})
+			if err := m.SignalTotalPowers[len(m.SignalTotalPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3149,7 +7753,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3172,15 +7776,15 @@ func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3208,7 +7812,7 @@ func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalId = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3231,7 +7835,7 @@ func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3254,17 +7858,17 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3274,25 +7878,12 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Valid = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3314,7 +7905,7 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorAccuracyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3337,15 +7928,15 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorAccuracyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorAccuracyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3373,7 +7964,7 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3396,7 +7987,7 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorAccuracyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3419,15 +8010,15 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorAccuracyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorAccuracyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAccuracy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3454,8 +8045,7 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorAccuracy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3480,7 +8070,7 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorAccuraciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3503,10 +8093,10 @@ func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorAccuraciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorAccuraciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3566,7 +8156,7 @@ func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorAccuraciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3589,15 +8179,15 @@ func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorAccuraciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorAccuraciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAccuracies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3624,8 +8214,8 @@ func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAccuracies = append(m.ValidatorAccuracies, ValidatorAccuracy{})
+			if err := m.ValidatorAccuracies[len(m.ValidatorAccuracies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3686,140 +8276,7 @@ func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReferenceSourceConfigRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReferenceSourceConfigRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReferenceSourceConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReferenceSourceConfigResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ReferenceSourceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3842,15 +8299,15 @@ func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignalTotalPowersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignalTotalPowersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3878,13 +8335,13 @@ func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3894,27 +8351,23 @@ func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3937,7 +8390,7 @@ func (m *QuerySignalTotalPowersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignalTotalPowersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3960,49 +8413,15 @@ func (m *QuerySignalTotalPowersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignalTotalPowersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignalTotalPowersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalTotalPowers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignalTotalPowers = append(m.SignalTotalPowers, &Signal{})
-			if err := m.SignalTotalPowers[len(m.SignalTotalPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4029,10 +8448,8 @@ func (m *QuerySignalTotalPowersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorPrices = append(m.ValidatorPrices, ValidatorPrice{})
+			if err := m.ValidatorPrices[len(m.ValidatorPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4057,7 +8474,7 @@ func (m *QuerySignalTotalPowersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4080,15 +8497,15 @@ func (m *QueryValidValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4116,7 +8533,7 @@ func (m *QueryValidValidatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4139,7 +8556,7 @@ func (m *QueryValidValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4162,17 +8579,17 @@ func (m *QueryValidValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4182,12 +8599,26 @@ func (m *QueryValidValidatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Valid = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signals = append(m.Signals, Signal{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4209,7 +8640,7 @@ func (m *QueryValidValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4232,47 +8663,15 @@ func (m *QueryValidatorPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4300,7 +8699,7 @@ func (m *QueryValidatorPricesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4323,7 +8722,7 @@ func (m *QueryValidatorPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4346,15 +8745,15 @@ func (m *QueryValidatorPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4381,8 +8780,7 @@ func (m *QueryValidatorPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorPrices = append(m.ValidatorPrices, ValidatorPrice{})
-			if err := m.ValidatorPrices[len(m.ValidatorPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4407,7 +8805,7 @@ func (m *QueryValidatorPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4430,15 +8828,15 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4466,7 +8864,43 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Delegatee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4489,7 +8923,7 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4512,15 +8946,15 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4547,8 +8981,63 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signals = append(m.Signals, Signal{})
-			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
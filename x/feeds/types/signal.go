@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
 // NewSignal creates a new signal
 func NewSignal(id string, power int64) Signal {
 	return Signal{
@@ -42,3 +46,26 @@ func SumPower(signals []Signal) (sum int64) {
 	}
 	return
 }
+
+// DistributePower distributes the given power to the given signals in proportion to their powers. The
+// remainder of the proportional division is distributed one by one to the signals in order.
+func DistributePower(signals []Signal, power int64) []Signal {
+	sumPower := SumPower(signals)
+	if sumPower <= 0 || power <= 0 {
+		return nil
+	}
+
+	distributedSignals := make([]Signal, len(signals))
+	remainder := power
+	for i, signal := range signals {
+		distributedPower := sdkmath.NewInt(power).MulRaw(signal.Power).QuoRaw(sumPower).Int64()
+		distributedSignals[i] = NewSignal(signal.ID, distributedPower)
+		remainder -= distributedPower
+	}
+
+	for i := int64(0); i < remainder; i++ {
+		distributedSignals[i].Power++
+	}
+
+	return distributedSignals
+}
@@ -0,0 +1,83 @@
+package types
+
+// NewSignalMetadata creates a new SignalMetadata instance.
+func NewSignalMetadata(
+	signalID string,
+	decimals uint32,
+	baseAsset string,
+	quoteAsset string,
+	category string,
+	description string,
+	status SignalMetadataStatus,
+	maxPriceMoveBasisPoint int64,
+) SignalMetadata {
+	return SignalMetadata{
+		SignalID:    signalID,
+		Decimals:    decimals,
+		BaseAsset:   baseAsset,
+		QuoteAsset:  quoteAsset,
+		Category:    category,
+		Description: description,
+		Status:      status,
+
+		MaxPriceMoveBasisPoint: maxPriceMoveBasisPoint,
+	}
+}
+
+// IsActive returns true if the signal id can be voted.
+func (m SignalMetadata) IsActive() bool {
+	return m.Status == SIGNAL_METADATA_STATUS_ACTIVE
+}
+
+// Validate validates the signal metadata.
+func (m SignalMetadata) Validate() error {
+	if m.SignalID == "" {
+		return ErrInvalidSignal.Wrap("signal id cannot be empty")
+	}
+
+	signalIDLength := len(m.SignalID)
+	if uint64(signalIDLength) > MaxSignalIDCharacters {
+		return ErrSignalIDTooLarge.Wrapf(
+			"maximum number of characters is %d but received %d characters",
+			MaxSignalIDCharacters, signalIDLength,
+		)
+	}
+
+	if m.Decimals > MaxSignalDecimals {
+		return ErrInvalidSignalMetadata.Wrapf(
+			"maximum number of decimals is %d but received %d",
+			MaxSignalDecimals, m.Decimals,
+		)
+	}
+
+	descriptionLength := len(m.Description)
+	if uint64(descriptionLength) > MaxSignalDescriptionCharacters {
+		return ErrInvalidSignalMetadata.Wrapf(
+			"maximum number of description characters is %d but received %d characters",
+			MaxSignalDescriptionCharacters, descriptionLength,
+		)
+	}
+
+	if _, ok := SignalMetadataStatus_name[int32(m.Status)]; !ok || m.Status == SIGNAL_METADATA_STATUS_UNSPECIFIED {
+		return ErrInvalidSignalMetadata.Wrapf("invalid status %s of signal id %s", m.Status, m.SignalID)
+	}
+
+	if m.MaxPriceMoveBasisPoint < 0 {
+		return ErrInvalidSignalMetadata.Wrapf(
+			"max price move basis point cannot be negative: %d",
+			m.MaxPriceMoveBasisPoint,
+		)
+	}
+
+	return nil
+}
+
+// GetMaxPriceMoveBasisPointOrDefault returns the max price move basis point of the signal id, or the given
+// default if the signal id does not override it.
+func (m SignalMetadata) GetMaxPriceMoveBasisPointOrDefault(defaultValue int64) int64 {
+	if m.MaxPriceMoveBasisPoint == 0 {
+		return defaultValue
+	}
+
+	return m.MaxPriceMoveBasisPoint
+}
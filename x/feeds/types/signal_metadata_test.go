@@ -0,0 +1,63 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignalMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata SignalMetadata
+		expErr   error
+	}{
+		{
+			"valid metadata",
+			NewSignalMetadata("CS:BAND-USD", 9, "BAND", "USD", "crypto", "Band Protocol", SIGNAL_METADATA_STATUS_ACTIVE, 0),
+			nil,
+		},
+		{
+			"empty signal id",
+			NewSignalMetadata("", 9, "BAND", "USD", "crypto", "", SIGNAL_METADATA_STATUS_ACTIVE, 0),
+			ErrInvalidSignal,
+		},
+		{
+			"signal id too large",
+			NewSignalMetadata(strings.Repeat("A", 33), 9, "BAND", "USD", "crypto", "", SIGNAL_METADATA_STATUS_ACTIVE, 0),
+			ErrSignalIDTooLarge,
+		},
+		{
+			"too many decimals",
+			NewSignalMetadata("CS:BAND-USD", 19, "BAND", "USD", "crypto", "", SIGNAL_METADATA_STATUS_ACTIVE, 0),
+			ErrInvalidSignalMetadata,
+		},
+		{
+			"description too large",
+			NewSignalMetadata("CS:BAND-USD", 9, "BAND", "USD", "crypto", strings.Repeat("A", 257), SIGNAL_METADATA_STATUS_ACTIVE, 0),
+			ErrInvalidSignalMetadata,
+		},
+		{
+			"unspecified status",
+			NewSignalMetadata("CS:BAND-USD", 9, "BAND", "USD", "crypto", "", SIGNAL_METADATA_STATUS_UNSPECIFIED, 0),
+			ErrInvalidSignalMetadata,
+		},
+		{
+			"negative max price move basis point",
+			NewSignalMetadata("CS:BAND-USD", 9, "BAND", "USD", "crypto", "", SIGNAL_METADATA_STATUS_ACTIVE, -1),
+			ErrInvalidSignalMetadata,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
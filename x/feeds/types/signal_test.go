@@ -22,3 +22,30 @@ func TestSumPower(t *testing.T) {
 		},
 	}))
 }
+
+func TestDistributePower(t *testing.T) {
+	signals := []Signal{
+		NewSignal("CS:BAND-USD", 100),
+		NewSignal("CS:ATOM-USD", 100),
+		NewSignal("CS:OSMO-USD", 200),
+	}
+
+	// the power is distributed in proportion to the signal powers
+	require.Equal(t, []Signal{
+		NewSignal("CS:BAND-USD", 250),
+		NewSignal("CS:ATOM-USD", 250),
+		NewSignal("CS:OSMO-USD", 500),
+	}, DistributePower(signals, 1000))
+
+	// the remainder is distributed one by one to the signals in order
+	require.Equal(t, []Signal{
+		NewSignal("CS:BAND-USD", 3),
+		NewSignal("CS:ATOM-USD", 2),
+		NewSignal("CS:OSMO-USD", 5),
+	}, DistributePower(signals, 10))
+	require.Equal(t, int64(11), SumPower(DistributePower(signals, 11)))
+
+	// nothing is distributed without signals or power
+	require.Nil(t, DistributePower(nil, 1000))
+	require.Nil(t, DistributePower(signals, 0))
+}
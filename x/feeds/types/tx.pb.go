@@ -123,6 +123,107 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
+// MsgDelegateVotingPower is the transaction message to delegate feeds voting power to another address.
+type MsgDelegateVotingPower struct {
+	// delegator is the address of the delegator that wants to delegate its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegatee is the address that votes with the delegated voting power.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// power is the voting power to delegate. Zero removes the delegation of the delegator.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *MsgDelegateVotingPower) Reset()         { *m = MsgDelegateVotingPower{} }
+func (m *MsgDelegateVotingPower) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVotingPower) ProtoMessage()    {}
+func (*MsgDelegateVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{2}
+}
+func (m *MsgDelegateVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVotingPower.Merge(m, src)
+}
+func (m *MsgDelegateVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVotingPower proto.InternalMessageInfo
+
+func (m *MsgDelegateVotingPower) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgDelegateVotingPower) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+func (m *MsgDelegateVotingPower) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// MsgDelegateVotingPowerResponse is the response type for the Msg/DelegateVotingPower RPC method.
+type MsgDelegateVotingPowerResponse struct {
+}
+
+func (m *MsgDelegateVotingPowerResponse) Reset()         { *m = MsgDelegateVotingPowerResponse{} }
+func (m *MsgDelegateVotingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVotingPowerResponse) ProtoMessage()    {}
+func (*MsgDelegateVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{3}
+}
+func (m *MsgDelegateVotingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVotingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVotingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVotingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVotingPowerResponse.Merge(m, src)
+}
+func (m *MsgDelegateVotingPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVotingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVotingPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVotingPowerResponse proto.InternalMessageInfo
+
 // MsgSubmitSignalPrices is the transaction message to submit multiple signal prices.
 type MsgSubmitSignalPrices struct {
 	// validator is the address of the validator that is performing the operation.
@@ -137,7 +238,7 @@ func (m *MsgSubmitSignalPrices) Reset()         { *m = MsgSubmitSignalPrices{} }
 func (m *MsgSubmitSignalPrices) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitSignalPrices) ProtoMessage()    {}
 func (*MsgSubmitSignalPrices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{2}
+	return fileDescriptor_e1bc41512ee10d84, []int{4}
 }
 func (m *MsgSubmitSignalPrices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +296,7 @@ func (m *MsgSubmitSignalPricesResponse) Reset()         { *m = MsgSubmitSignalPr
 func (m *MsgSubmitSignalPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitSignalPricesResponse) ProtoMessage()    {}
 func (*MsgSubmitSignalPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{3}
+	return fileDescriptor_e1bc41512ee10d84, []int{5}
 }
 func (m *MsgSubmitSignalPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -236,7 +337,7 @@ func (m *MsgUpdateReferenceSourceConfig) Reset()         { *m = MsgUpdateReferen
 func (m *MsgUpdateReferenceSourceConfig) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateReferenceSourceConfig) ProtoMessage()    {}
 func (*MsgUpdateReferenceSourceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{4}
+	return fileDescriptor_e1bc41512ee10d84, []int{6}
 }
 func (m *MsgUpdateReferenceSourceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +390,7 @@ func (m *MsgUpdateReferenceSourceConfigResponse) Reset() {
 func (m *MsgUpdateReferenceSourceConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateReferenceSourceConfigResponse) ProtoMessage()    {}
 func (*MsgUpdateReferenceSourceConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{5}
+	return fileDescriptor_e1bc41512ee10d84, []int{7}
 }
 func (m *MsgUpdateReferenceSourceConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,7 +431,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{6}
+	return fileDescriptor_e1bc41512ee10d84, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +482,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{7}
+	return fileDescriptor_e1bc41512ee10d84, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,64 +511,269 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateSignalMetadata is the transaction message to add or update signal ids in the signal registry.
+type MsgUpdateSignalMetadata struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// signal_metadata is a list of metadata of the signal ids to add or update.
+	SignalMetadata []SignalMetadata `protobuf:"bytes,2,rep,name=signal_metadata,json=signalMetadata,proto3" json:"signal_metadata"`
+}
+
+func (m *MsgUpdateSignalMetadata) Reset()         { *m = MsgUpdateSignalMetadata{} }
+func (m *MsgUpdateSignalMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSignalMetadata) ProtoMessage()    {}
+func (*MsgUpdateSignalMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{10}
+}
+func (m *MsgUpdateSignalMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSignalMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSignalMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSignalMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSignalMetadata.Merge(m, src)
+}
+func (m *MsgUpdateSignalMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSignalMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSignalMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSignalMetadata proto.InternalMessageInfo
+
+func (m *MsgUpdateSignalMetadata) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateSignalMetadata) GetSignalMetadata() []SignalMetadata {
+	if m != nil {
+		return m.SignalMetadata
+	}
+	return nil
+}
+
+// MsgUpdateSignalMetadataResponse is the response type for the Msg/UpdateSignalMetadata RPC method.
+type MsgUpdateSignalMetadataResponse struct {
+}
+
+func (m *MsgUpdateSignalMetadataResponse) Reset()         { *m = MsgUpdateSignalMetadataResponse{} }
+func (m *MsgUpdateSignalMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSignalMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateSignalMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{11}
+}
+func (m *MsgUpdateSignalMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSignalMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSignalMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSignalMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSignalMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateSignalMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSignalMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSignalMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSignalMetadataResponse proto.InternalMessageInfo
+
+// MsgResetCircuitBreakers is the transaction message to reset the triggered circuit breakers of signal ids.
+type MsgResetCircuitBreakers struct {
+	// signer is the address of the admin or the governance account.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// signal_ids is a list of signal ids whose circuit breakers are reset.
+	SignalIDs []string `protobuf:"bytes,2,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+}
+
+func (m *MsgResetCircuitBreakers) Reset()         { *m = MsgResetCircuitBreakers{} }
+func (m *MsgResetCircuitBreakers) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreakers) ProtoMessage()    {}
+func (*MsgResetCircuitBreakers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{12}
+}
+func (m *MsgResetCircuitBreakers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreakers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreakers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetCircuitBreakers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreakers.Merge(m, src)
+}
+func (m *MsgResetCircuitBreakers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreakers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreakers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreakers proto.InternalMessageInfo
+
+func (m *MsgResetCircuitBreakers) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgResetCircuitBreakers) GetSignalIDs() []string {
+	if m != nil {
+		return m.SignalIDs
+	}
+	return nil
+}
+
+// MsgResetCircuitBreakersResponse is the response type for the Msg/ResetCircuitBreakers RPC method.
+type MsgResetCircuitBreakersResponse struct {
+}
+
+func (m *MsgResetCircuitBreakersResponse) Reset()         { *m = MsgResetCircuitBreakersResponse{} }
+func (m *MsgResetCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreakersResponse) ProtoMessage()    {}
+func (*MsgResetCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{13}
+}
+func (m *MsgResetCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreakersResponse.Merge(m, src)
+}
+func (m *MsgResetCircuitBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreakersResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgVote)(nil), "band.feeds.v1beta1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "band.feeds.v1beta1.MsgVoteResponse")
+	proto.RegisterType((*MsgDelegateVotingPower)(nil), "band.feeds.v1beta1.MsgDelegateVotingPower")
+	proto.RegisterType((*MsgDelegateVotingPowerResponse)(nil), "band.feeds.v1beta1.MsgDelegateVotingPowerResponse")
 	proto.RegisterType((*MsgSubmitSignalPrices)(nil), "band.feeds.v1beta1.MsgSubmitSignalPrices")
 	proto.RegisterType((*MsgSubmitSignalPricesResponse)(nil), "band.feeds.v1beta1.MsgSubmitSignalPricesResponse")
 	proto.RegisterType((*MsgUpdateReferenceSourceConfig)(nil), "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig")
 	proto.RegisterType((*MsgUpdateReferenceSourceConfigResponse)(nil), "band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "band.feeds.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.feeds.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateSignalMetadata)(nil), "band.feeds.v1beta1.MsgUpdateSignalMetadata")
+	proto.RegisterType((*MsgUpdateSignalMetadataResponse)(nil), "band.feeds.v1beta1.MsgUpdateSignalMetadataResponse")
+	proto.RegisterType((*MsgResetCircuitBreakers)(nil), "band.feeds.v1beta1.MsgResetCircuitBreakers")
+	proto.RegisterType((*MsgResetCircuitBreakersResponse)(nil), "band.feeds.v1beta1.MsgResetCircuitBreakersResponse")
 }
 
 func init() { proto.RegisterFile("band/feeds/v1beta1/tx.proto", fileDescriptor_e1bc41512ee10d84) }
 
 var fileDescriptor_e1bc41512ee10d84 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x4b, 0x1b, 0x41,
-	0x14, 0xce, 0x1a, 0x7f, 0x90, 0x51, 0x29, 0x0e, 0x8a, 0x71, 0xa3, 0x1b, 0x1b, 0x4b, 0x89, 0x16,
-	0x77, 0x49, 0x84, 0x52, 0x72, 0x29, 0xb5, 0x17, 0x29, 0x04, 0x24, 0xa1, 0x1e, 0x7a, 0x49, 0x27,
-	0xbb, 0xe3, 0x3a, 0xe0, 0xee, 0x2c, 0x33, 0x93, 0xa0, 0xb7, 0xd2, 0x63, 0xa1, 0x50, 0xe8, 0xdf,
-	0x51, 0xf0, 0xe0, 0xb1, 0x7f, 0x80, 0x47, 0xf1, 0xd4, 0x53, 0x29, 0x7a, 0xf0, 0xd6, 0x43, 0xff,
-	0x82, 0xb2, 0x33, 0xb3, 0x9b, 0xaa, 0x9b, 0x68, 0x2f, 0xc9, 0xee, 0x7c, 0xdf, 0xfb, 0xde, 0xfb,
-	0xde, 0xbc, 0x97, 0x80, 0x52, 0x17, 0x85, 0x9e, 0xb3, 0x8f, 0xb1, 0xc7, 0x9d, 0x7e, 0xad, 0x8b,
-	0x05, 0xaa, 0x39, 0xe2, 0xc8, 0x8e, 0x18, 0x15, 0x14, 0xc2, 0x18, 0xb4, 0x25, 0x68, 0x6b, 0xd0,
-	0x9c, 0xf7, 0xa9, 0x4f, 0x25, 0xec, 0xc4, 0x4f, 0x8a, 0x69, 0x5a, 0x19, 0x32, 0x2a, 0x4e, 0xe1,
-	0xe5, 0x0c, 0x3c, 0x42, 0x0c, 0x05, 0x09, 0x61, 0xc9, 0xa5, 0x3c, 0xa0, 0xbc, 0xa3, 0x94, 0xd5,
-	0x8b, 0x86, 0x16, 0xd5, 0x9b, 0x13, 0x70, 0xdf, 0xe9, 0xd7, 0xe2, 0x2f, 0x0d, 0xcc, 0xa1, 0x80,
-	0x84, 0xd4, 0x91, 0x9f, 0xea, 0xa8, 0xf2, 0xd5, 0x00, 0x53, 0x4d, 0xee, 0xef, 0x51, 0x81, 0xa1,
-	0x0d, 0x26, 0xfa, 0x54, 0x60, 0x56, 0x34, 0x56, 0x8d, 0x6a, 0x61, 0xbb, 0x78, 0x71, 0xba, 0x39,
-	0xaf, 0x85, 0x5f, 0x79, 0x1e, 0xc3, 0x9c, 0xb7, 0x05, 0x23, 0xa1, 0xdf, 0x52, 0x34, 0xd8, 0x00,
-	0x53, 0x9c, 0xf8, 0x21, 0x3a, 0xe4, 0xc5, 0xb1, 0xd5, 0x7c, 0x75, 0xba, 0x6e, 0xda, 0x77, 0xfd,
-	0xdb, 0x6d, 0x49, 0xd9, 0x1e, 0x3f, 0xfb, 0x59, 0xce, 0xb5, 0x92, 0x80, 0xc6, 0xf2, 0xc7, 0xeb,
-	0x93, 0x0d, 0xa5, 0xf3, 0xe9, 0xfa, 0x64, 0x63, 0x56, 0x39, 0xd5, 0x95, 0x54, 0xe6, 0xc0, 0x23,
-	0xfd, 0xd8, 0xc2, 0x3c, 0xa2, 0x21, 0xc7, 0x95, 0x3f, 0x06, 0x58, 0x68, 0x72, 0xbf, 0xdd, 0xeb,
-	0x06, 0x44, 0x28, 0xcd, 0x5d, 0x46, 0x5c, 0xcc, 0xe1, 0x4b, 0x50, 0xe8, 0xa3, 0x43, 0xe2, 0x21,
-	0x41, 0x93, 0xd2, 0x1f, 0x5f, 0x9c, 0x6e, 0xae, 0xe8, 0xd2, 0xf7, 0x12, 0xec, 0xa6, 0x87, 0x41,
-	0x0c, 0x5c, 0x06, 0x05, 0x41, 0x02, 0xcc, 0x05, 0x0a, 0xa2, 0xe2, 0xd8, 0xaa, 0x51, 0xcd, 0xb7,
-	0x06, 0x07, 0xf0, 0x0d, 0x98, 0x55, 0x45, 0x77, 0x22, 0x99, 0xaf, 0x98, 0x97, 0x5e, 0xcb, 0xc3,
-	0xbd, 0xca, 0xba, 0xb4, 0xe1, 0x19, 0xfe, 0x4f, 0xa9, 0x0d, 0x3b, 0x76, 0x3d, 0xc8, 0x1c, 0x3b,
-	0x2f, 0xa5, 0xce, 0xef, 0x5a, 0xab, 0x94, 0xc1, 0x4a, 0x26, 0x90, 0x76, 0xe5, 0xb7, 0x01, 0xac,
-	0x26, 0xf7, 0xdf, 0x46, 0x1e, 0x8a, 0x7b, 0xb5, 0x8f, 0x19, 0x0e, 0x5d, 0xdc, 0xa6, 0x3d, 0xe6,
-	0xe2, 0xd7, 0x34, 0xdc, 0x27, 0x7e, 0x7c, 0xab, 0xc8, 0x0b, 0x48, 0x78, 0xff, 0xad, 0x4a, 0x1a,
-	0xf4, 0xc1, 0x22, 0x4b, 0x84, 0x3a, 0x5c, 0x2a, 0x75, 0x5c, 0x29, 0x25, 0x7b, 0x33, 0x5d, 0x5f,
-	0xcf, 0x72, 0x9e, 0x99, 0x5b, 0xf7, 0x60, 0x81, 0x65, 0x81, 0x8d, 0x2d, 0x39, 0x02, 0x32, 0x69,
-	0xdc, 0x88, 0x27, 0x69, 0x23, 0x46, 0xb8, 0xa9, 0x54, 0xc1, 0xd3, 0xd1, 0x8c, 0xb4, 0x35, 0xdf,
-	0x0c, 0x39, 0x44, 0x8a, 0xba, 0x2b, 0x57, 0x07, 0x3e, 0x07, 0x05, 0xd4, 0x13, 0x07, 0x94, 0x11,
-	0x71, 0x7c, 0x6f, 0x3f, 0x06, 0x54, 0xf8, 0x02, 0x4c, 0xaa, 0xe5, 0xd3, 0x2d, 0xc8, 0x1c, 0x74,
-	0x95, 0x43, 0x7b, 0xd6, 0xfc, 0x46, 0x55, 0xde, 0x78, 0xaa, 0x14, 0x1b, 0x5d, 0xb8, 0x65, 0x54,
-	0xc5, 0x55, 0x96, 0xc0, 0xe2, 0xad, 0xa3, 0xc4, 0x4a, 0xfd, 0x7b, 0x1e, 0xe4, 0x9b, 0xdc, 0x87,
-	0x3b, 0x60, 0x5c, 0x2e, 0x6a, 0x29, 0x2b, 0xbd, 0x5e, 0x18, 0x73, 0x6d, 0x04, 0x98, 0x28, 0x42,
-	0x06, 0x60, 0xc6, 0x26, 0xad, 0x0f, 0x09, 0xbd, 0x4b, 0x35, 0x6b, 0x0f, 0xa6, 0xa6, 0x39, 0x3f,
-	0x1b, 0xa0, 0x34, 0x6a, 0x50, 0xeb, 0x43, 0x24, 0x47, 0xc4, 0x98, 0x8d, 0xff, 0x8f, 0x49, 0xeb,
-	0x79, 0x0f, 0x66, 0x6e, 0x0c, 0xc7, 0xda, 0x48, 0x2d, 0x45, 0x32, 0x9f, 0x3d, 0x80, 0x94, 0x64,
-	0x30, 0x27, 0x3e, 0x5c, 0x9f, 0x6c, 0x18, 0xdb, 0x3b, 0x67, 0x97, 0x96, 0x71, 0x7e, 0x69, 0x19,
-	0xbf, 0x2e, 0x2d, 0xe3, 0xcb, 0x95, 0x95, 0x3b, 0xbf, 0xb2, 0x72, 0x3f, 0xae, 0xac, 0xdc, 0x3b,
-	0xdb, 0x27, 0xe2, 0xa0, 0xd7, 0xb5, 0x5d, 0x1a, 0x38, 0xb1, 0xae, 0xfc, 0x4d, 0x76, 0xe9, 0xa1,
-	0xe3, 0x1e, 0x20, 0x12, 0x3a, 0xfd, 0x2d, 0xe7, 0x48, 0xff, 0x07, 0x88, 0xe3, 0x08, 0xf3, 0xee,
-	0xa4, 0x24, 0x6c, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xb7, 0x17, 0xf2, 0x85, 0x06, 0x00,
-	0x00,
+	// 914 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0x66, 0xe3, 0x40, 0xe4, 0x49, 0x28, 0xca, 0x14, 0x8a, 0xb3, 0x80, 0x4d, 0x36, 0x55, 0xe5,
+	0xd0, 0xb2, 0x1b, 0xb0, 0x54, 0x55, 0x7b, 0xa9, 0xea, 0xe4, 0x90, 0x54, 0xb2, 0x44, 0x8d, 0xca,
+	0xa1, 0x17, 0x3a, 0xde, 0x1d, 0x96, 0x51, 0xd9, 0x9d, 0xd5, 0xcc, 0xd8, 0x25, 0xb7, 0xaa, 0xc7,
+	0x4a, 0x95, 0x2a, 0xf5, 0x47, 0xb4, 0x97, 0x4a, 0x1c, 0xf2, 0x23, 0x72, 0x6b, 0x94, 0x43, 0xd5,
+	0x13, 0xaa, 0xe0, 0xc0, 0xad, 0x87, 0xfe, 0x82, 0x6a, 0x67, 0x66, 0x07, 0x0c, 0x63, 0x63, 0x72,
+	0x01, 0xef, 0x7c, 0xdf, 0xfb, 0xde, 0xfb, 0xde, 0xbc, 0x7d, 0x36, 0x58, 0xea, 0xa1, 0x2c, 0x0e,
+	0xf6, 0x30, 0x8e, 0x79, 0x30, 0xd8, 0xe8, 0x61, 0x81, 0x36, 0x02, 0x71, 0xe8, 0xe7, 0x8c, 0x0a,
+	0x0a, 0x61, 0x01, 0xfa, 0x12, 0xf4, 0x35, 0xe8, 0xce, 0x27, 0x34, 0xa1, 0x12, 0x0e, 0x8a, 0x4f,
+	0x8a, 0xe9, 0xd6, 0x2d, 0x32, 0x2a, 0x4e, 0xe1, 0x0d, 0x0b, 0x9e, 0x23, 0x86, 0xd2, 0x92, 0xf0,
+	0x20, 0xa2, 0x3c, 0xa5, 0x7c, 0x57, 0x29, 0xab, 0x07, 0x0d, 0x2d, 0xaa, 0xa7, 0x20, 0xe5, 0x49,
+	0x30, 0xd8, 0x28, 0xfe, 0x69, 0xe0, 0x3e, 0x4a, 0x49, 0x46, 0x03, 0xf9, 0x57, 0x1d, 0x79, 0xbf,
+	0x3a, 0xe0, 0x4e, 0x87, 0x27, 0x3b, 0x54, 0x60, 0xe8, 0x83, 0xe9, 0x01, 0x15, 0x98, 0xd5, 0x9c,
+	0x55, 0xa7, 0x59, 0x6d, 0xd7, 0xde, 0xbe, 0x5a, 0x9f, 0xd7, 0xc2, 0x5f, 0xc4, 0x31, 0xc3, 0x9c,
+	0x6f, 0x0b, 0x46, 0xb2, 0xa4, 0xab, 0x68, 0x30, 0x04, 0x77, 0x38, 0x49, 0x32, 0x74, 0xc0, 0x6b,
+	0xb7, 0x56, 0x2b, 0xcd, 0xbb, 0x9b, 0xae, 0x7f, 0xd5, 0xbf, 0xbf, 0x2d, 0x29, 0xed, 0xdb, 0xaf,
+	0x8f, 0x1b, 0x53, 0xdd, 0x32, 0x20, 0x5c, 0xfe, 0xf1, 0xec, 0x68, 0x4d, 0xe9, 0xfc, 0x74, 0x76,
+	0xb4, 0x36, 0xab, 0x9c, 0xea, 0x4a, 0xbc, 0xfb, 0x60, 0x4e, 0x7f, 0xec, 0x62, 0x9e, 0xd3, 0x8c,
+	0x63, 0xef, 0x4f, 0x07, 0x7c, 0xd0, 0xe1, 0xc9, 0x33, 0x7c, 0x80, 0x13, 0x24, 0xf0, 0x0e, 0x15,
+	0x24, 0x4b, 0xb6, 0xe8, 0xf7, 0x98, 0xc1, 0x4f, 0x41, 0x35, 0x56, 0xc7, 0xf4, 0xfa, 0xda, 0xcf,
+	0xa9, 0x17, 0xe2, 0x30, 0xae, 0xdd, 0x9a, 0x30, 0x0e, 0x63, 0x38, 0x0f, 0xa6, 0xf3, 0x22, 0x71,
+	0xad, 0xb2, 0xea, 0x34, 0x2b, 0x5d, 0xf5, 0x10, 0x06, 0x85, 0xa3, 0x73, 0xf5, 0xc2, 0xd5, 0xb2,
+	0x71, 0x65, 0x29, 0xdb, 0x5b, 0x05, 0x75, 0x3b, 0x62, 0x3c, 0xff, 0xe7, 0x80, 0x85, 0x0e, 0x4f,
+	0xb6, 0xfb, 0xbd, 0x94, 0x08, 0xd5, 0xc7, 0x2d, 0x46, 0x22, 0xcc, 0xe1, 0xe7, 0xa0, 0x3a, 0x40,
+	0x07, 0x24, 0xbe, 0x60, 0xf9, 0xe1, 0xdb, 0x57, 0xeb, 0x2b, 0xba, 0xf4, 0x9d, 0x12, 0xbb, 0xe4,
+	0xc1, 0xc4, 0xc0, 0x65, 0x50, 0x15, 0x24, 0xc5, 0x5c, 0xa0, 0x34, 0x97, 0xde, 0x2b, 0xdd, 0xf3,
+	0x03, 0xf8, 0x25, 0x98, 0x55, 0x17, 0xb5, 0x9b, 0xcb, 0x7c, 0xb5, 0x8a, 0xbc, 0xdf, 0xc6, 0xe8,
+	0xfb, 0x95, 0x75, 0xe9, 0x4b, 0xbe, 0xc7, 0xcf, 0x8f, 0x78, 0xe8, 0xcb, 0xbe, 0x98, 0xcc, 0x45,
+	0x5f, 0x96, 0x4c, 0x5f, 0xae, 0x5a, 0xf3, 0x1a, 0x60, 0xc5, 0x0a, 0x98, 0xae, 0xfc, 0xeb, 0xc8,
+	0xc6, 0x7d, 0x9d, 0xc7, 0xa8, 0x98, 0x8f, 0x3d, 0xcc, 0x70, 0x16, 0xe1, 0x6d, 0xda, 0x67, 0x11,
+	0x7e, 0x4a, 0xb3, 0x3d, 0x92, 0x14, 0x93, 0x8c, 0xe2, 0x94, 0x64, 0xd7, 0x4f, 0xb2, 0xa4, 0xc1,
+	0x04, 0x2c, 0xb2, 0x52, 0x68, 0x97, 0x4b, 0xa5, 0xdd, 0x48, 0x4a, 0xc9, 0xde, 0xdc, 0xdd, 0x7c,
+	0x6c, 0x73, 0x6e, 0xcd, 0xad, 0x7b, 0xb0, 0xc0, 0x6c, 0x60, 0xd8, 0x92, 0x63, 0x2f, 0x93, 0x16,
+	0x8d, 0xf8, 0xd0, 0x34, 0x62, 0x8c, 0x1b, 0xaf, 0x09, 0x3e, 0x1a, 0xcf, 0x30, 0xad, 0xf9, 0xc3,
+	0x01, 0x73, 0x86, 0xba, 0x25, 0xd7, 0x45, 0x31, 0xe5, 0xa8, 0x2f, 0xf6, 0x29, 0x23, 0xe2, 0xe5,
+	0xf5, 0x6f, 0x87, 0xa1, 0xc2, 0xcf, 0xc0, 0x8c, 0x5a, 0x38, 0xba, 0x05, 0xd6, 0x97, 0x5b, 0xe5,
+	0xd0, 0x9e, 0x35, 0x3f, 0x6c, 0xca, 0x1b, 0x37, 0x4a, 0x85, 0xd1, 0x85, 0x4b, 0x46, 0x55, 0x9c,
+	0xf7, 0x00, 0x2c, 0x5e, 0x3a, 0x32, 0x56, 0xfe, 0x72, 0x2e, 0x60, 0x6a, 0x0e, 0x3a, 0x58, 0xa0,
+	0x18, 0x09, 0xf4, 0xce, 0x96, 0xbe, 0x02, 0x73, 0x7a, 0xac, 0x53, 0x2d, 0xa5, 0x17, 0x97, 0x37,
+	0x7a, 0xb0, 0xcb, 0xa4, 0xda, 0xe3, 0x7b, 0x7c, 0xe8, 0x34, 0x7c, 0x72, 0xd5, 0xeb, 0xca, 0x25,
+	0xaf, 0xc3, 0x3a, 0xde, 0x43, 0xd0, 0x18, 0x01, 0x19, 0xef, 0xbf, 0x2b, 0xef, 0x5d, 0xcc, 0xb1,
+	0x78, 0x4a, 0x58, 0xd4, 0x27, 0xa2, 0xcd, 0x30, 0xfa, 0x0e, 0x33, 0x0e, 0x9f, 0x80, 0x99, 0xa2,
+	0x84, 0x09, 0xb6, 0xb4, 0xe6, 0xc1, 0x4f, 0x00, 0xd0, 0xae, 0x49, 0xac, 0x36, 0x75, 0xb5, 0x3d,
+	0x7b, 0x72, 0xdc, 0xa8, 0xaa, 0xec, 0x2f, 0x9e, 0xf1, 0x6e, 0x55, 0x11, 0x5e, 0xc4, 0x3c, 0x5c,
+	0x2f, 0x0c, 0xe9, 0xd0, 0x61, 0x37, 0xb6, 0x72, 0xb4, 0x1b, 0x1b, 0x54, 0xba, 0xd9, 0xfc, 0x6d,
+	0x06, 0x54, 0x3a, 0x3c, 0x81, 0xcf, 0xc1, 0x6d, 0xf9, 0x35, 0xb3, 0x64, 0x6b, 0xb6, 0x5e, 0xf7,
+	0xee, 0xa3, 0x31, 0x60, 0xa9, 0x08, 0xfb, 0xe0, 0x7d, 0xdb, 0xf7, 0xc0, 0xda, 0x88, 0x58, 0x0b,
+	0xd7, 0xdd, 0x9c, 0x9c, 0x6b, 0xd2, 0x32, 0x00, 0x2d, 0xab, 0xf8, 0xf1, 0x08, 0xa5, 0xab, 0x54,
+	0x77, 0x63, 0x62, 0xaa, 0xc9, 0xf9, 0xb3, 0x03, 0x96, 0xc6, 0x6d, 0xba, 0x51, 0x3e, 0xc6, 0xc4,
+	0xb8, 0xe1, 0xcd, 0x63, 0x4c, 0x3d, 0xdf, 0x82, 0x7b, 0x43, 0xdb, 0xe5, 0xd1, 0x58, 0x2d, 0x45,
+	0x72, 0x3f, 0x9e, 0x80, 0x64, 0x32, 0x1c, 0x82, 0x79, 0xeb, 0x4b, 0x3f, 0x5e, 0x64, 0x98, 0xec,
+	0xb6, 0x6e, 0x40, 0xbe, 0x98, 0xd9, 0xfa, 0xca, 0x8d, 0xca, 0x6c, 0x23, 0xbb, 0xad, 0x1b, 0x90,
+	0xcb, 0xcc, 0xee, 0xf4, 0x0f, 0x67, 0x47, 0x6b, 0x4e, 0xfb, 0xf9, 0xeb, 0x93, 0xba, 0xf3, 0xe6,
+	0xa4, 0xee, 0xfc, 0x73, 0x52, 0x77, 0x7e, 0x39, 0xad, 0x4f, 0xbd, 0x39, 0xad, 0x4f, 0xfd, 0x7d,
+	0x5a, 0x9f, 0xfa, 0xc6, 0x4f, 0x88, 0xd8, 0xef, 0xf7, 0xfc, 0x88, 0xa6, 0x41, 0xa1, 0x2f, 0x7f,
+	0xbc, 0x45, 0xf4, 0x20, 0x88, 0xf6, 0x11, 0xc9, 0x82, 0x41, 0x2b, 0x38, 0xd4, 0x3f, 0x16, 0xc5,
+	0xcb, 0x1c, 0xf3, 0xde, 0x8c, 0x24, 0xb4, 0xfe, 0x1f, 0x00, 0x13, 0x73, 0xae, 0xf9, 0xae, 0x0a,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -484,12 +790,18 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// Vote is an RPC method to vote signal ids and their powers.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// DelegateVotingPower is an RPC method to delegate feeds voting power to another address.
+	DelegateVotingPower(ctx context.Context, in *MsgDelegateVotingPower, opts ...grpc.CallOption) (*MsgDelegateVotingPowerResponse, error)
 	// SubmitSignalPrices is an RPC method to submit signal prices.
 	SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalPrices, opts ...grpc.CallOption) (*MsgSubmitSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpdateReferenceSourceConfig, opts ...grpc.CallOption) (*MsgUpdateReferenceSourceConfigResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateSignalMetadata is an RPC method to add or update signal ids in the signal registry.
+	UpdateSignalMetadata(ctx context.Context, in *MsgUpdateSignalMetadata, opts ...grpc.CallOption) (*MsgUpdateSignalMetadataResponse, error)
+	// ResetCircuitBreakers is an RPC method to reset the triggered circuit breakers of signal ids.
+	ResetCircuitBreakers(ctx context.Context, in *MsgResetCircuitBreakers, opts ...grpc.CallOption) (*MsgResetCircuitBreakersResponse, error)
 }
 
 type msgClient struct {
@@ -509,6 +821,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) DelegateVotingPower(ctx context.Context, in *MsgDelegateVotingPower, opts ...grpc.CallOption) (*MsgDelegateVotingPowerResponse, error) {
+	out := new(MsgDelegateVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/DelegateVotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalPrices, opts ...grpc.CallOption) (*MsgSubmitSignalPricesResponse, error) {
 	out := new(MsgSubmitSignalPricesResponse)
 	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/SubmitSignalPrices", in, out, opts...)
@@ -536,16 +857,40 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateSignalMetadata(ctx context.Context, in *MsgUpdateSignalMetadata, opts ...grpc.CallOption) (*MsgUpdateSignalMetadataResponse, error) {
+	out := new(MsgUpdateSignalMetadataResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/UpdateSignalMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetCircuitBreakers(ctx context.Context, in *MsgResetCircuitBreakers, opts ...grpc.CallOption) (*MsgResetCircuitBreakersResponse, error) {
+	out := new(MsgResetCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/ResetCircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Vote is an RPC method to vote signal ids and their powers.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// DelegateVotingPower is an RPC method to delegate feeds voting power to another address.
+	DelegateVotingPower(context.Context, *MsgDelegateVotingPower) (*MsgDelegateVotingPowerResponse, error)
 	// SubmitSignalPrices is an RPC method to submit signal prices.
 	SubmitSignalPrices(context.Context, *MsgSubmitSignalPrices) (*MsgSubmitSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(context.Context, *MsgUpdateReferenceSourceConfig) (*MsgUpdateReferenceSourceConfigResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateSignalMetadata is an RPC method to add or update signal ids in the signal registry.
+	UpdateSignalMetadata(context.Context, *MsgUpdateSignalMetadata) (*MsgUpdateSignalMetadataResponse, error)
+	// ResetCircuitBreakers is an RPC method to reset the triggered circuit breakers of signal ids.
+	ResetCircuitBreakers(context.Context, *MsgResetCircuitBreakers) (*MsgResetCircuitBreakersResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -555,6 +900,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) DelegateVotingPower(ctx context.Context, req *MsgDelegateVotingPower) (*MsgDelegateVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVotingPower not implemented")
+}
 func (*UnimplementedMsgServer) SubmitSignalPrices(ctx context.Context, req *MsgSubmitSignalPrices) (*MsgSubmitSignalPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignalPrices not implemented")
 }
@@ -564,6 +912,12 @@ func (*UnimplementedMsgServer) UpdateReferenceSourceConfig(ctx context.Context,
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateSignalMetadata(ctx context.Context, req *MsgUpdateSignalMetadata) (*MsgUpdateSignalMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSignalMetadata not implemented")
+}
+func (*UnimplementedMsgServer) ResetCircuitBreakers(ctx context.Context, req *MsgResetCircuitBreakers) (*MsgResetCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreakers not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -587,6 +941,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateVotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVotingPower)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Msg/DelegateVotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVotingPower(ctx, req.(*MsgDelegateVotingPower))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitSignalPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitSignalPrices)
 	if err := dec(in); err != nil {
@@ -641,6 +1013,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateSignalMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSignalMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSignalMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Msg/UpdateSignalMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSignalMetadata(ctx, req.(*MsgUpdateSignalMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetCircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCircuitBreakers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Msg/ResetCircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCircuitBreakers(ctx, req.(*MsgResetCircuitBreakers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "band.feeds.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -649,6 +1057,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "DelegateVotingPower",
+			Handler:    _Msg_DelegateVotingPower_Handler,
+		},
 		{
 			MethodName: "SubmitSignalPrices",
 			Handler:    _Msg_SubmitSignalPrices_Handler,
@@ -661,6 +1073,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateSignalMetadata",
+			Handler:    _Msg_UpdateSignalMetadata_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreakers",
+			Handler:    _Msg_ResetCircuitBreakers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/feeds/v1beta1/tx.proto",
@@ -733,7 +1153,7 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitSignalPrices) Marshal() (dAtA []byte, err error) {
+func (m *MsgDelegateVotingPower) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -743,29 +1163,94 @@ func (m *MsgSubmitSignalPrices) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitSignalPrices) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDelegateVotingPower) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitSignalPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDelegateVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SignalPrices) > 0 {
-		for iNdEx := len(m.SignalPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignalPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.Power != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignalPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignalPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignalPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalPrices) > 0 {
+		for iNdEx := len(m.SignalPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.Timestamp != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Timestamp))
@@ -931,6 +1416,135 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateSignalMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSignalMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSignalMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalMetadata) > 0 {
+		for iNdEx := len(m.SignalMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSignalMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSignalMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSignalMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreakers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreakers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreakers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalIDs) > 0 {
+		for iNdEx := len(m.SignalIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIDs[iNdEx])
+			copy(dAtA[i:], m.SignalIDs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SignalIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -970,6 +1584,35 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDelegateVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovTx(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *MsgDelegateVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSubmitSignalPrices) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1049,13 +1692,418 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateSignalMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SignalMetadata) > 0 {
+		for _, e := range m.SignalMetadata {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateSignalMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetCircuitBreakers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SignalIDs) > 0 {
+		for _, s := range m.SignalIDs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgResetCircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signals = append(m.Signals, Signal{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateVotingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVotingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1078,15 +2126,15 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSignalPrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSignalPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1114,11 +2162,30 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1145,8 +2212,8 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signals = append(m.Signals, Signal{})
-			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignalPrices = append(m.SignalPrices, SignalPrice{})
+			if err := m.SignalPrices[len(m.SignalPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1171,7 +2238,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSignalPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1194,10 +2261,10 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSignalPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSignalPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1221,7 +2288,7 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1244,15 +2311,15 @@ func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignalPrices: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignalPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1280,13 +2347,13 @@ func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1296,14 +2363,160 @@ func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferenceSourceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1330,8 +2543,7 @@ func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalPrices = append(m.SignalPrices, SignalPrice{})
-			if err := m.SignalPrices[len(m.SignalPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1356,7 +2568,7 @@ func (m *MsgSubmitSignalPrices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSignalPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1379,10 +2591,10 @@ func (m *MsgSubmitSignalPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSignalPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSignalPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1406,7 +2618,7 @@ func (m *MsgSubmitSignalPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSignalMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1429,15 +2641,15 @@ func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSignalMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSignalMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1465,11 +2677,11 @@ func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceSourceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1496,7 +2708,8 @@ func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReferenceSourceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignalMetadata = append(m.SignalMetadata, SignalMetadata{})
+			if err := m.SignalMetadata[len(m.SignalMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1521,7 +2734,7 @@ func (m *MsgUpdateReferenceSourceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSignalMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1544,10 +2757,10 @@ func (m *MsgUpdateReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSignalMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateReferenceSourceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSignalMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1571,7 +2784,7 @@ func (m *MsgUpdateReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgResetCircuitBreakers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1594,15 +2807,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetCircuitBreakers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetCircuitBreakers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1630,13 +2843,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1646,24 +2859,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SignalIDs = append(m.SignalIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1686,7 +2898,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetCircuitBreakersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1709,10 +2921,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetCircuitBreakersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorAccuracy creates a new ValidatorAccuracy instance without any tracked submission.
+func NewValidatorAccuracy(val sdk.ValAddress, window uint64) ValidatorAccuracy {
+	return ValidatorAccuracy{
+		Validator:        val.String(),
+		Window:           window,
+		AccuracyScoreBPS: CalculateAccuracyScore(0, 0),
+	}
+}
+
+// NewAccuracyRecord creates a new AccuracyRecord instance.
+func NewAccuracyRecord(reportCount uint64, outlierCount uint64) AccuracyRecord {
+	return AccuracyRecord{
+		ReportCount:  reportCount,
+		OutlierCount: outlierCount,
+	}
+}
+
+// IsWindowFilled returns true if the validator has submitted at least a full window of submissions.
+func (a ValidatorAccuracy) IsWindowFilled() bool {
+	return a.SubmissionCount >= a.Window
+}
+
+// OutlierBasisPoint returns the ratio (in basis point) of outlier prices to reported prices within the window.
+func (a ValidatorAccuracy) OutlierBasisPoint() uint64 {
+	return BasisPointMax - a.AccuracyScoreBPS
+}
+
+// CalculateAccuracyScore calculates the ratio (in basis point) of non-outlier prices to reported prices.
+// A validator without any reported price has a full score.
+func CalculateAccuracyScore(reportCount uint64, outlierCount uint64) uint64 {
+	if reportCount == 0 {
+		return BasisPointMax
+	}
+
+	return (reportCount - outlierCount) * BasisPointMax / reportCount
+}
+
+// IsOutlierPrice returns true if the validator price deviates from the final price by more than the given
+// deviation (in basis point).
+func IsOutlierPrice(validatorPrice uint64, price uint64, outlierDeviationBasisPoint int64) bool {
+	diff := sdkmath.NewIntFromUint64(validatorPrice).Sub(sdkmath.NewIntFromUint64(price)).Abs()
+
+	return diff.MulRaw(int64(BasisPointMax)).GT(sdkmath.NewIntFromUint64(price).MulRaw(outlierDeviationBasisPoint))
+}
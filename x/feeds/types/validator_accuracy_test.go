@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestCalculateAccuracyScore(t *testing.T) {
+	require.Equal(t, uint64(10000), types.CalculateAccuracyScore(0, 0))
+	require.Equal(t, uint64(10000), types.CalculateAccuracyScore(3, 0))
+	require.Equal(t, uint64(6666), types.CalculateAccuracyScore(3, 1))
+	require.Equal(t, uint64(0), types.CalculateAccuracyScore(3, 3))
+}
+
+func TestIsOutlierPrice(t *testing.T) {
+	tests := []struct {
+		name           string
+		validatorPrice uint64
+		price          uint64
+		deviation      int64
+		expected       bool
+	}{
+		{"same price", 1000, 1000, 500, false},
+		{"deviation at the limit", 1050, 1000, 500, false},
+		{"deviation above the limit", 1051, 1000, 500, true},
+		{"deviation below the price", 949, 1000, 500, true},
+		{"large prices", ^uint64(0), ^uint64(0) - 1, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, types.IsOutlierPrice(tt.validatorPrice, tt.price, tt.deviation))
+		})
+	}
+}
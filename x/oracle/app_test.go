@@ -17,6 +17,7 @@ import (
 	band "github.com/bandprotocol/chain/v3/app"
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 type AppTestSuite struct {
@@ -109,6 +110,10 @@ func (s *AppTestSuite) TestSuccessRequestOracleData() {
 		bandtesting.Validators[0].Address.String(),
 		nil,
 	)
+	expectRequest.OracleScriptVersion = 1
+	for i := range expectRequest.RawRequests {
+		expectRequest.RawRequests[i].DataSourceVersion = 1
+	}
 
 	request, err := s.app.OracleKeeper.GetRequest(ctx, types.RequestID(1))
 	require.NoError(err)
@@ -225,6 +230,7 @@ func (s *AppTestSuite) TestSuccessRequestOracleData() {
 	result, err := s.app.OracleKeeper.GetResult(ctx2, types.RequestID(1))
 	require.NoError(err)
 	require.Equal(expRes, result)
+	require.Equal(uint64(1), s.app.OracleKeeper.GetResultOracleScriptVersion(ctx2, types.RequestID(1)))
 }
 
 func (s *AppTestSuite) TestExpiredRequestOracleData() {
@@ -296,6 +302,10 @@ func (s *AppTestSuite) TestExpiredRequestOracleData() {
 		bandtesting.Validators[0].Address.String(),
 		nil,
 	)
+	expectRequest.OracleScriptVersion = 1
+	for i := range expectRequest.RawRequests {
+		expectRequest.RawRequests[i].DataSourceVersion = 1
+	}
 
 	request, err := s.app.OracleKeeper.GetRequest(ctx, types.RequestID(1))
 	require.NoError(err)
@@ -341,3 +351,51 @@ func (s *AppTestSuite) TestExpiredRequestOracleData() {
 
 	require.Equal(expectEvents, result.Events)
 }
+
+func (s *AppTestSuite) TestRequestWithTunnelCallback() {
+	require := s.Require()
+
+	ctx := s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{}).
+		WithBlockTime(time.Unix(1581589790, 0))
+	requestMsg := types.NewMsgRequestData(
+		types.OracleScriptID(1),
+		[]byte("calldata"),
+		3,
+		2,
+		"app_test",
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 10000000)),
+		bandtesting.TestDefaultPrepareGas,
+		bandtesting.TestDefaultExecuteGas,
+		bandtesting.Validators[0].Address,
+		0,
+	)
+	id, err := s.app.OracleKeeper.PrepareRequestWithCallback(
+		ctx, requestMsg, bandtesting.Validators[0].Address, tunneltypes.ModuleName, 100000,
+	)
+	require.NoError(err)
+
+	req := s.app.OracleKeeper.MustGetRequest(ctx, id)
+	for _, val := range req.RequestedValidators[:2] {
+		valAddr, err := sdk.ValAddressFromBech32(val)
+		require.NoError(err)
+		s.app.OracleKeeper.SetReport(ctx, id, types.NewReport(valAddr, true, []types.RawReport{
+			types.NewRawReport(1, 0, []byte("answer1")),
+			types.NewRawReport(2, 0, []byte("answer2")),
+			types.NewRawReport(3, 0, []byte("answer3")),
+		}))
+	}
+
+	// The result must be delivered to x/tunnel through the registered callback route.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	s.app.OracleKeeper.ResolveRequest(ctx, id)
+	require.Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		tunneltypes.EventTypeReceiveOracleResult,
+		sdk.NewAttribute(tunneltypes.AttributeKeyRequestID, fmt.Sprint(id)),
+		sdk.NewAttribute(tunneltypes.AttributeKeyStatus, types.RESOLVE_STATUS_SUCCESS.String()),
+		sdk.NewAttribute(tunneltypes.AttributeKeyResult, "74657374"),
+		sdk.NewAttribute(tunneltypes.AttributeKeyReason, ""),
+	))
+	for _, ev := range ctx.EventManager().Events() {
+		require.NotEqual(types.EventTypeCallbackFail, ev.Type)
+	}
+}
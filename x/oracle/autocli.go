@@ -29,12 +29,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Get summary information of a data source",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "data_source_id"}},
 				},
+				{
+					RpcMethod:      "DataSourceVersions",
+					Use:            "data-source-versions [id]",
+					Short:          "Get all versions of a data source",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "data_source_id"}},
+				},
 				{
 					RpcMethod:      "OracleScript",
 					Use:            "oracle-script [id]",
 					Short:          "Get summary information of an oracle script",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "oracle_script_id"}},
 				},
+				{
+					RpcMethod:      "OracleScriptVersions",
+					Use:            "oracle-script-versions [id]",
+					Short:          "Get all versions of an oracle script",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "oracle_script_id"}},
+				},
 				{
 					RpcMethod:      "Request",
 					Use:            "request [id]",
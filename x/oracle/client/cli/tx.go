@@ -21,21 +21,23 @@ import (
 )
 
 const (
-	flagName          = "name"
-	flagDescription   = "description"
-	flagScript        = "script"
-	flagOwner         = "owner"
-	flagCalldata      = "calldata"
-	flagClientID      = "client-id"
-	flagSchema        = "schema"
-	flagSourceCodeURL = "url"
-	flagPrepareGas    = "prepare-gas"
-	flagExecuteGas    = "execute-gas"
-	flagTSSEncoder    = "tss-encoder"
-	flagFeeLimit      = "fee-limit"
-	flagFee           = "fee"
-	flagTreasury      = "treasury"
-	flagExpiration    = "expiration"
+	flagName                = "name"
+	flagDescription         = "description"
+	flagScript              = "script"
+	flagOwner               = "owner"
+	flagCalldata            = "calldata"
+	flagClientID            = "client-id"
+	flagSchema              = "schema"
+	flagSourceCodeURL       = "url"
+	flagPrepareGas          = "prepare-gas"
+	flagExecuteGas          = "execute-gas"
+	flagTSSEncoder          = "tss-encoder"
+	flagFeeLimit            = "fee-limit"
+	flagFee                 = "fee"
+	flagTreasury            = "treasury"
+	flagExpiration          = "expiration"
+	flagCacheable           = "cacheable"
+	flagOracleScriptVersion = "oracle-script-version"
 )
 
 // NewTxCmd returns the transaction commands for this module
@@ -64,7 +66,7 @@ func NewTxCmd() *cobra.Command {
 // GetCmdRequest implements the request command handler.
 func GetCmdRequest() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "request [oracle-script-id] [ask-count] [min-count] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit])",
+		Use:   "request [oracle-script-id] [ask-count] [min-count] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit]) (--oracle-script-version=[version])",
 		Short: "Make a new data request via an existing oracle script",
 		Args:  cobra.ExactArgs(3),
 		Long: strings.TrimSpace(
@@ -133,6 +135,11 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 				return err
 			}
 
+			oracleScriptVersion, err := cmd.Flags().GetUint64(flagOracleScriptVersion)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgRequestData(
 				oracleScriptID,
 				calldata,
@@ -145,6 +152,7 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 				clientCtx.GetFromAddress(),
 				types.Encoder(tssEncoder),
 			)
+			msg.OracleScriptVersion = oracleScriptVersion
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -162,6 +170,8 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 		String(flagFeeLimit, "", "The maximum tokens paid to all data source and TSS signature providers, if any")
 	cmd.Flags().
 		Int32(flagTSSEncoder, 0, "The encode type of oracle result that will be sent to TSS (1=proto, 2=ABI, 3=Partial ABI)")
+	cmd.Flags().
+		Uint64(flagOracleScriptVersion, 0, "The version of the oracle script to be used, or 0 to use the latest version")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -171,7 +181,7 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 // GetCmdCreateDataSource implements the create data source command handler.
 func GetCmdCreateDataSource() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-data-source (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner]) (--treasury [treasury]) (--fee [fee])",
+		Use:   "create-data-source (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner]) (--treasury [treasury]) (--fee [fee]) (--cacheable)",
 		Short: "Create a new data source",
 		Args:  cobra.NoArgs,
 		Long: strings.TrimSpace(
@@ -235,6 +245,11 @@ $ %s tx oracle create-data-source --name coingecko-price --description "The scri
 				return err
 			}
 
+			cacheable, err := cmd.Flags().GetBool(flagCacheable)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateDataSource(
 				name,
 				description,
@@ -243,6 +258,7 @@ $ %s tx oracle create-data-source --name coingecko-price --description "The scri
 				treasury,
 				owner,
 				clientCtx.GetFromAddress(),
+				cacheable,
 			)
 
 			err = msg.ValidateBasic()
@@ -259,6 +275,7 @@ $ %s tx oracle create-data-source --name coingecko-price --description "The scri
 	cmd.Flags().String(flagOwner, "", "Owner of this data source")
 	cmd.Flags().String(flagTreasury, "", "Treasury of this data source")
 	cmd.Flags().String(flagFee, "", "Fee of this data source")
+	cmd.Flags().Bool(flagCacheable, false, "Whether validators may reuse recent results of this data source")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -267,7 +284,7 @@ $ %s tx oracle create-data-source --name coingecko-price --description "The scri
 // GetCmdEditDataSource implements the edit data source command handler.
 func GetCmdEditDataSource() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "edit-data-source [id] (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner]) (--treasury [treasury]) (--fee [fee])",
+		Use:   "edit-data-source [id] (--name [name]) (--description [description]) (--script [path-to-script]) (--owner [owner]) (--treasury [treasury]) (--fee [fee]) (--cacheable [true|false])",
 		Short: "Edit data source",
 		Args:  cobra.ExactArgs(1),
 		Long: strings.TrimSpace(
@@ -338,6 +355,22 @@ $ %s tx oracle edit-data-source 1 --name coingecko-price --description The scrip
 				return err
 			}
 
+			cacheableStr, err := cmd.Flags().GetString(flagCacheable)
+			if err != nil {
+				return err
+			}
+			cacheable := types.CACHEABLE_OPTION_DO_NOT_MODIFY
+			if cacheableStr != types.DoNotModify {
+				enabled, err := strconv.ParseBool(cacheableStr)
+				if err != nil {
+					return err
+				}
+				cacheable = types.CACHEABLE_OPTION_DISABLE
+				if enabled {
+					cacheable = types.CACHEABLE_OPTION_ENABLE
+				}
+			}
+
 			msg := types.NewMsgEditDataSource(
 				dataSourceID,
 				name,
@@ -347,6 +380,7 @@ $ %s tx oracle edit-data-source 1 --name coingecko-price --description The scrip
 				treasury,
 				owner,
 				clientCtx.GetFromAddress(),
+				cacheable,
 			)
 
 			err = msg.ValidateBasic()
@@ -363,6 +397,7 @@ $ %s tx oracle edit-data-source 1 --name coingecko-price --description The scrip
 	cmd.Flags().String(flagTreasury, "", "Treasury of this data source")
 	cmd.Flags().String(flagFee, "", "Fee of this data source")
 	cmd.Flags().String(flagOwner, "", "Owner of this data source")
+	cmd.Flags().String(flagCacheable, types.DoNotModify, "Whether validators may reuse recent results of this data source")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -27,6 +27,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
 	for _, oracleScript := range data.OracleScripts {
 		_ = k.AddOracleScript(ctx, oracleScript)
 	}
+	for _, dataSourceVersion := range data.DataSourceVersions {
+		k.SetDataSourceVersion(ctx, dataSourceVersion)
+	}
+	for _, oracleScriptVersion := range data.OracleScriptVersions {
+		k.SetOracleScriptVersion(ctx, oracleScriptVersion)
+	}
 
 	k.SetPort(ctx, types.PortID)
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -44,8 +50,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:        k.GetParams(ctx),
-		DataSources:   k.GetAllDataSources(ctx),
-		OracleScripts: k.GetAllOracleScripts(ctx),
+		Params:               k.GetParams(ctx),
+		DataSources:          k.GetAllDataSources(ctx),
+		OracleScripts:        k.GetAllOracleScripts(ctx),
+		DataSourceVersions:   k.GetAllDataSourceVersions(ctx),
+		OracleScriptVersions: k.GetAllOracleScriptVersions(ctx),
 	}
 }
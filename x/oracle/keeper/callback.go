@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// invokeCallback calls back the module that made the given request, if any, with the result
+// of the request. A failing callback never affects the resolution of the request; its state
+// changes are discarded and an event is emitted instead.
+func (k Keeper) invokeCallback(ctx sdk.Context, id types.RequestID, reason string) {
+	req := k.MustGetRequest(ctx, id)
+	if req.CallbackModule == "" {
+		return
+	}
+
+	gasUsed, err := k.safeInvokeCallback(ctx, req, k.MustGetResult(ctx, id), reason)
+	if err != nil {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCallbackFail,
+			sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyCallbackModule, req.CallbackModule),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+		return
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCallback,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, req.CallbackModule),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+	))
+}
+
+// safeInvokeCallback calls the callback of the request in a cached context limited to the
+// callback gas limit of the request. The state changes are only written if the callback
+// succeeds. Panics, including running out of gas, are recovered and returned as errors.
+func (k Keeper) safeInvokeCallback(
+	ctx sdk.Context,
+	req types.Request,
+	result types.Result,
+	reason string,
+) (gasUsed uint64, err error) {
+	cb, ok := k.cbRouter.GetRoute(req.CallbackModule)
+	if !ok {
+		return 0, types.ErrCallbackNotFound.Wrapf("module: %s", req.CallbackModule)
+	}
+
+	gasMeter := storetypes.NewGasMeter(req.CallbackGasLimit)
+	defer func() {
+		gasUsed = gasMeter.GasConsumedToLimit()
+		if r := recover(); r != nil {
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = sdkerrors.ErrOutOfGas.Wrapf("out of gas in location: %s", oog.Descriptor)
+				return
+			}
+			ctx.Logger().Error(fmt.Sprintf("Panic recovered: %v", r))
+			err = types.ErrCallbackPanic
+		}
+	}()
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	switch result.ResolveStatus {
+	case types.RESOLVE_STATUS_SUCCESS:
+		err = cb.OnRequestSuccess(cacheCtx, result)
+	case types.RESOLVE_STATUS_FAILURE:
+		err = cb.OnRequestFailure(cacheCtx, result, reason)
+	case types.RESOLVE_STATUS_EXPIRED:
+		err = cb.OnRequestExpired(cacheCtx, result)
+	default:
+		err = fmt.Errorf("unexpected resolve status: %s", result.ResolveStatus)
+	}
+	if err != nil {
+		return gasMeter.GasConsumedToLimit(), err
+	}
+
+	writeFn()
+
+	return gasMeter.GasConsumedToLimit(), nil
+}
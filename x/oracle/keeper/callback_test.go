@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"go.uber.org/mock/gomock"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const testCallbackModule = "testmodule"
+
+var testCallbackStoreKey = []byte{0xee}
+
+// testCallback is an OracleCallback that records its calls and writes them to the store.
+type testCallback struct {
+	key storetypes.StoreKey
+
+	results []types.Result
+	reasons []string
+	gas     uint64
+	err     error
+	panic   bool
+}
+
+func (cb *testCallback) call(ctx sdk.Context, result types.Result, reason string) error {
+	ctx.KVStore(cb.key).Set(testCallbackStoreKey, []byte(result.ResolveStatus.String()))
+	ctx.GasMeter().ConsumeGas(cb.gas, "TEST_CALLBACK")
+	if cb.panic {
+		panic("callback panic")
+	}
+	cb.results = append(cb.results, result)
+	cb.reasons = append(cb.reasons, reason)
+	return cb.err
+}
+
+func (cb *testCallback) OnRequestSuccess(ctx sdk.Context, result types.Result) error {
+	return cb.call(ctx, result, "")
+}
+
+func (cb *testCallback) OnRequestFailure(ctx sdk.Context, result types.Result, reason string) error {
+	return cb.call(ctx, result, reason)
+}
+
+func (cb *testCallback) OnRequestExpired(ctx sdk.Context, result types.Result) error {
+	return cb.call(ctx, result, "")
+}
+
+func callbackRequest() types.Request {
+	req := defaultRequest()
+	req.CallbackModule = testCallbackModule
+	req.CallbackGasLimit = 100000
+	return req
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestWithCallbackInvalid() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	msg := types.NewMsgRequestData(
+		1, basicCalldata, 1, 1, basicClientID, bandtesting.Coins100band,
+		testDefaultPrepareGas, testDefaultExecuteGas, alice, 0,
+	)
+	_, err := k.PrepareRequestWithCallback(ctx, msg, alice, "unknown", 100000)
+	require.ErrorIs(err, types.ErrCallbackNotFound)
+	_, err = k.PrepareRequestWithCallback(ctx, msg, alice, testCallbackModule, 0)
+	require.ErrorIs(err, types.ErrInvalidCallbackGasLimit)
+	_, err = k.PrepareRequestWithCallback(ctx, msg, alice, testCallbackModule, types.DefaultMaxCallbackGasLimit+1)
+	require.ErrorIs(err, types.ErrInvalidCallbackGasLimit)
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestWithCallbackSuccess() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	msg := types.NewMsgRequestData(
+		1, basicCalldata, 1, 1, basicClientID, bandtesting.Coins100band,
+		testDefaultPrepareGas, testDefaultExecuteGas, alice, 0,
+	)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, bandtesting.Coins1band).Times(3)
+	suite.authKeeper.EXPECT().
+		GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).
+		Return(feeCollectorAcc)
+	// 100000 gas at 0.0025uband per gas
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), alice, feeCollectorAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("uband", 250)))
+
+	id, err := k.PrepareRequestWithCallback(ctx, msg, alice, testCallbackModule, 100000)
+	require.NoError(err)
+
+	req := k.MustGetRequest(ctx, id)
+	require.Equal(testCallbackModule, req.CallbackModule)
+	require.Equal(uint64(100000), req.CallbackGasLimit)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 96999750)), req.FeeLimit)
+}
+
+func (suite *KeeperTestSuite) TestResolveSuccessWithCallback() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 42, callbackRequest())
+	k.SetReport(ctx, 42, types.NewReport(validators[0].Address, true, nil))
+	k.ResolveSuccess(ctx, 42, defaultRequest().Requester, defaultRequest().FeeLimit, basicResult, 1234, 0)
+
+	require.Equal([]types.Result{k.MustGetResult(ctx, 42)}, suite.callback.results)
+	require.Equal([]byte("RESOLVE_STATUS_SUCCESS"), ctx.KVStore(suite.key).Get(testCallbackStoreKey))
+	require.Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCallback,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, testCallbackModule),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, "2690"),
+	))
+}
+
+func (suite *KeeperTestSuite) TestResolveFailureWithCallbackError() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.callback.err = errors.New("callback error")
+	k.SetRequest(ctx, 42, callbackRequest())
+	k.ResolveFailure(ctx, 42, "REASON")
+
+	// The request is resolved, but the state changes of the callback are discarded.
+	require.Equal(types.RESOLVE_STATUS_FAILURE, k.MustGetResult(ctx, 42).ResolveStatus)
+	require.Equal([]string{"REASON"}, suite.callback.reasons)
+	require.Nil(ctx.KVStore(suite.key).Get(testCallbackStoreKey))
+	require.Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCallbackFail,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, testCallbackModule),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, "2690"),
+		sdk.NewAttribute(types.AttributeKeyReason, "callback error"),
+	))
+}
+
+func (suite *KeeperTestSuite) TestResolveExpiredWithCallbackOutOfGas() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.callback.gas = 200000
+	k.SetRequest(ctx, 42, callbackRequest())
+	k.ResolveExpired(ctx, 42)
+
+	require.Equal(types.RESOLVE_STATUS_EXPIRED, k.MustGetResult(ctx, 42).ResolveStatus)
+	require.Empty(suite.callback.results)
+	require.Nil(ctx.KVStore(suite.key).Get(testCallbackStoreKey))
+	require.Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCallbackFail,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, testCallbackModule),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, "100000"),
+		sdk.NewAttribute(types.AttributeKeyReason, "out of gas in location: TEST_CALLBACK: out of gas"),
+	))
+}
+
+func (suite *KeeperTestSuite) TestResolveExpiredWithCallbackPanic() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.callback.panic = true
+	k.SetRequest(ctx, 42, callbackRequest())
+	require.NotPanics(func() { k.ResolveExpired(ctx, 42) })
+
+	require.Equal(types.RESOLVE_STATUS_EXPIRED, k.MustGetResult(ctx, 42).ResolveStatus)
+	require.Nil(ctx.KVStore(suite.key).Get(testCallbackStoreKey))
+	require.Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCallbackFail,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyCallbackModule, testCallbackModule),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, "2690"),
+		sdk.NewAttribute(types.AttributeKeyReason, types.ErrCallbackPanic.Error()),
+	))
+}
@@ -89,6 +89,7 @@ func addSimpleDataSourceAndOracleScript(ctx sdk.Context, k keeper.Keeper, dir st
 				"filename"+idxStr,
 				bandtesting.Coins1band,
 				treasury,
+				false,
 			),
 		)
 	}
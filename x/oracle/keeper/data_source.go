@@ -41,22 +41,39 @@ func (k Keeper) SetDataSource(ctx sdk.Context, id types.DataSourceID, dataSource
 	store.Set(types.DataSourceStoreKey(id), k.cdc.MustMarshal(&dataSource))
 }
 
-// AddDataSource adds the given data source to the storage.
+// AddDataSource adds the given data source to the storage. A data source without a version is
+// created at version 1, together with the record of that version.
 func (k Keeper) AddDataSource(ctx sdk.Context, dataSource types.DataSource) types.DataSourceID {
 	id := k.GetNextDataSourceID(ctx)
+	if dataSource.Version == 0 {
+		dataSource.Version = 1
+		k.SetDataSourceVersion(ctx, types.NewDataSourceVersion(id, dataSource.Version, dataSource.Filename))
+	}
 	k.SetDataSource(ctx, id, dataSource)
 	return id
 }
 
 // MustEditDataSource edits the given data source by id and flushes it to the storage.
-func (k Keeper) MustEditDataSource(ctx sdk.Context, id types.DataSourceID, new types.DataSource) {
+// Whether the data source is cacheable is updated by the given option instead of new.Cacheable.
+func (k Keeper) MustEditDataSource(
+	ctx sdk.Context,
+	id types.DataSourceID,
+	new types.DataSource,
+	cacheable types.CacheableOption,
+) {
 	dataSource := k.MustGetDataSource(ctx, id)
 	dataSource.Owner = new.Owner
 	dataSource.Name = modify(dataSource.Name, new.Name)
 	dataSource.Description = modify(dataSource.Description, new.Description)
-	dataSource.Filename = modify(dataSource.Filename, new.Filename)
+	filename := modify(dataSource.Filename, new.Filename)
+	if filename != dataSource.Filename {
+		dataSource.Filename = filename
+		dataSource.Version++
+		k.SetDataSourceVersion(ctx, types.NewDataSourceVersion(id, dataSource.Version, dataSource.Filename))
+	}
 	dataSource.Treasury = new.Treasury
 	dataSource.Fee = new.Fee
+	dataSource.Cacheable = modifyCacheable(dataSource.Cacheable, cacheable)
 	k.SetDataSource(ctx, id, dataSource)
 }
 
@@ -73,6 +90,42 @@ func (k Keeper) GetAllDataSources(ctx sdk.Context) (dataSources []types.DataSour
 	return dataSources
 }
 
+// GetDataSourceVersion returns the given version of the data source or error if not exists.
+func (k Keeper) GetDataSourceVersion(
+	ctx sdk.Context,
+	id types.DataSourceID,
+	version uint64,
+) (types.DataSourceVersion, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DataSourceVersionStoreKey(id, version))
+	if bz == nil {
+		return types.DataSourceVersion{}, types.ErrDataSourceVersionNotFound.Wrapf("id: %d, version: %d", id, version)
+	}
+	var dataSourceVersion types.DataSourceVersion
+	k.cdc.MustUnmarshal(bz, &dataSourceVersion)
+	return dataSourceVersion, nil
+}
+
+// SetDataSourceVersion saves the given data source version to the storage.
+func (k Keeper) SetDataSourceVersion(ctx sdk.Context, dataSourceVersion types.DataSourceVersion) {
+	ctx.KVStore(k.storeKey).Set(
+		types.DataSourceVersionStoreKey(dataSourceVersion.DataSourceID, dataSourceVersion.Version),
+		k.cdc.MustMarshal(&dataSourceVersion),
+	)
+}
+
+// GetAllDataSourceVersions returns the list of all versions of all data sources in the store.
+func (k Keeper) GetAllDataSourceVersions(ctx sdk.Context) (dataSourceVersions []types.DataSourceVersion) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DataSourceVersionStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var dataSourceVersion types.DataSourceVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &dataSourceVersion)
+		dataSourceVersions = append(dataSourceVersions, dataSourceVersion)
+	}
+	return dataSourceVersions
+}
+
 // AddExecutableFile saves the given executable file to a file to filecahe storage and returns
 // its sha256sum reference name. Returns do-not-modify symbol if the input is do-not-modify.
 func (k Keeper) AddExecutableFile(file []byte) string {
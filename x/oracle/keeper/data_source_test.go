@@ -22,6 +22,7 @@ func (suite *KeeperTestSuite) TestHasDataSource() {
 		basicFilename,
 		bandtesting.CoinsZero,
 		types.KeyExpirationBlockCount,
+		false,
 	))
 	require.True(k.HasDataSource(ctx, 42))
 }
@@ -43,6 +44,7 @@ func (suite *KeeperTestSuite) TestSetterGetterDataSource() {
 		"filename1",
 		bandtesting.EmptyCoins,
 		treasury,
+		false,
 	)
 	dataSource2 := types.NewDataSource(
 		bob,
@@ -51,6 +53,7 @@ func (suite *KeeperTestSuite) TestSetterGetterDataSource() {
 		"filename2",
 		bandtesting.EmptyCoins,
 		treasury,
+		false,
 	)
 	// Sets id 42 with data soure 1 and id 42 with data source 2.
 	k.SetDataSource(ctx, 42, dataSource1)
@@ -85,6 +88,7 @@ func (suite *KeeperTestSuite) TestAddDataSourceEditDataSourceBasic() {
 		"FILENAME1",
 		bandtesting.EmptyCoins,
 		treasury,
+		false,
 	)
 	dataSource2 := types.NewDataSource(
 		bob,
@@ -93,9 +97,11 @@ func (suite *KeeperTestSuite) TestAddDataSourceEditDataSourceBasic() {
 		"FILENAME2",
 		bandtesting.EmptyCoins,
 		treasury,
+		true,
 	)
-	// Adds a new data source to the store. We should be able to retrieve it back.
+	// Adds a new data source to the store. We should be able to retrieve it back at version 1.
 	id := k.AddDataSource(ctx, dataSource1)
+	dataSource1.Version = 1
 	require.Equal(dataSource1, k.MustGetDataSource(ctx, id))
 	require.NotEqual(dataSource2, k.MustGetDataSource(ctx, id))
 	owner, err := sdk.AccAddressFromBech32(dataSource2.Owner)
@@ -105,7 +111,9 @@ func (suite *KeeperTestSuite) TestAddDataSourceEditDataSourceBasic() {
 	// Edits the data source. We should get the updated data source.
 	k.MustEditDataSource(ctx, id, types.NewDataSource(
 		owner, dataSource2.Name, dataSource2.Description, dataSource2.Filename, bandtesting.EmptyCoins, treasury,
-	))
+		dataSource2.Cacheable,
+	), types.CACHEABLE_OPTION_ENABLE)
+	dataSource2.Version = 2
 	require.NotEqual(dataSource1, k.MustGetDataSource(ctx, id))
 	require.Equal(dataSource2, k.MustGetDataSource(ctx, id))
 }
@@ -123,6 +131,7 @@ func (suite *KeeperTestSuite) TestEditDataSourceDoNotModify() {
 		"FILENAME1",
 		bandtesting.EmptyCoins,
 		treasury,
+		false,
 	)
 	dataSource2 := types.NewDataSource(
 		bob,
@@ -131,13 +140,15 @@ func (suite *KeeperTestSuite) TestEditDataSourceDoNotModify() {
 		"FILENAME2",
 		bandtesting.EmptyCoins,
 		treasury,
+		false,
 	)
 	// Adds a new data source to the store. We should be able to retrieve it back.
 	id := k.AddDataSource(ctx, dataSource1)
+	dataSource1.Version = 1
 	require.Equal(dataSource1, k.MustGetDataSource(ctx, id))
 	require.NotEqual(dataSource2, k.MustGetDataSource(ctx, id))
 	// Edits the data source. We should get the updated data source.
-	k.MustEditDataSource(ctx, id, dataSource2)
+	k.MustEditDataSource(ctx, id, dataSource2, types.CACHEABLE_OPTION_DO_NOT_MODIFY)
 	dataSourceRes := k.MustGetDataSource(ctx, id)
 	require.NotEqual(dataSourceRes, dataSource1)
 	require.NotEqual(dataSourceRes, dataSource2)
@@ -147,6 +158,61 @@ func (suite *KeeperTestSuite) TestEditDataSourceDoNotModify() {
 	require.Equal(dataSourceRes.Filename, dataSource2.Filename)
 	require.Equal(dataSourceRes.Fee, dataSource2.Fee)
 	require.Equal(dataSourceRes.Treasury, dataSource2.Treasury)
+	require.Equal(uint64(2), dataSourceRes.Version)
+}
+
+func (suite *KeeperTestSuite) TestEditDataSourceCacheable() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	id := k.AddDataSource(ctx, types.NewDataSource(
+		owner, basicName, basicDesc, basicFilename, bandtesting.EmptyCoins, treasury, true,
+	))
+	edit := types.NewDataSource(
+		owner, types.DoNotModify, types.DoNotModify, types.DoNotModify, bandtesting.EmptyCoins, treasury, false,
+	)
+	// Editing without a cacheable option must keep the data source cacheable.
+	k.MustEditDataSource(ctx, id, edit, types.CACHEABLE_OPTION_DO_NOT_MODIFY)
+	require.True(k.MustGetDataSource(ctx, id).Cacheable)
+	k.MustEditDataSource(ctx, id, edit, types.CACHEABLE_OPTION_DISABLE)
+	require.False(k.MustGetDataSource(ctx, id).Cacheable)
+	k.MustEditDataSource(ctx, id, edit, types.CACHEABLE_OPTION_DO_NOT_MODIFY)
+	require.False(k.MustGetDataSource(ctx, id).Cacheable)
+	k.MustEditDataSource(ctx, id, edit, types.CACHEABLE_OPTION_ENABLE)
+	require.True(k.MustGetDataSource(ctx, id).Cacheable)
+}
+
+func (suite *KeeperTestSuite) TestEditDataSourceVersion() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	id := k.AddDataSource(ctx, types.NewDataSource(
+		owner, basicName, basicDesc, "FILENAME1", bandtesting.EmptyCoins, treasury, false,
+	))
+	// Editing anything but the executable must not create a new version.
+	k.MustEditDataSource(ctx, id, types.NewDataSource(
+		owner, "NAME2", basicDesc, types.DoNotModify, bandtesting.EmptyCoins, treasury, false,
+	), types.CACHEABLE_OPTION_DO_NOT_MODIFY)
+	k.MustEditDataSource(ctx, id, types.NewDataSource(
+		owner, types.DoNotModify, basicDesc, "FILENAME1", bandtesting.EmptyCoins, treasury, false,
+	), types.CACHEABLE_OPTION_DO_NOT_MODIFY)
+	require.Equal(uint64(1), k.MustGetDataSource(ctx, id).Version)
+	// Changing the executable creates a new version and keeps the previous one.
+	k.MustEditDataSource(ctx, id, types.NewDataSource(
+		owner, types.DoNotModify, basicDesc, "FILENAME2", bandtesting.EmptyCoins, treasury, false,
+	), types.CACHEABLE_OPTION_DO_NOT_MODIFY)
+	require.Equal(uint64(2), k.MustGetDataSource(ctx, id).Version)
+
+	version1, err := k.GetDataSourceVersion(ctx, id, 1)
+	require.NoError(err)
+	require.Equal(types.NewDataSourceVersion(id, 1, "FILENAME1"), version1)
+	version2, err := k.GetDataSourceVersion(ctx, id, 2)
+	require.NoError(err)
+	require.Equal(types.NewDataSourceVersion(id, 2, "FILENAME2"), version2)
+	_, err = k.GetDataSourceVersion(ctx, id, 3)
+	require.ErrorIs(err, types.ErrDataSourceVersionNotFound)
 }
 
 func (suite *KeeperTestSuite) TestAddDataSourceDataSourceMustReturnCorrectID() {
@@ -166,6 +232,7 @@ func (suite *KeeperTestSuite) TestAddDataSourceDataSourceMustReturnCorrectID() {
 			basicFilename,
 			bandtesting.EmptyCoins,
 			treasury,
+			false,
 		),
 	)
 	require.Equal(types.DataSourceID(genesisCount+1), id1)
@@ -179,6 +246,7 @@ func (suite *KeeperTestSuite) TestAddDataSourceDataSourceMustReturnCorrectID() {
 			basicFilename,
 			bandtesting.EmptyCoins,
 			treasury,
+			false,
 		),
 	)
 	require.Equal(types.DataSourceID(genesisCount+2), id2)
@@ -199,7 +267,8 @@ func (suite *KeeperTestSuite) TestEditDataSourceNonExistentDataSource() {
 			basicFilename,
 			bandtesting.EmptyCoins,
 			treasury,
-		))
+			false,
+		), types.CACHEABLE_OPTION_DO_NOT_MODIFY)
 	})
 }
 
@@ -209,8 +278,16 @@ func (suite *KeeperTestSuite) TestGetAllDataSources() {
 	require := suite.Require()
 
 	dataSources := bandtesting.GenerateDataSources(suite.homeDir)
+	var dataSourceVersions []types.DataSourceVersion
+	for i := range dataSources {
+		dataSources[i].Version = 1
+		dataSourceVersions = append(dataSourceVersions, types.NewDataSourceVersion(
+			types.DataSourceID(i+1), 1, dataSources[i].Filename,
+		))
+	}
 
 	require.Equal(dataSources, k.GetAllDataSources(ctx))
+	require.Equal(dataSourceVersions, k.GetAllDataSourceVersions(ctx))
 }
 
 func (suite *KeeperTestSuite) TestAddExecutableFile() {
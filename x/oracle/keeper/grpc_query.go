@@ -8,9 +8,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/store/prefix"
+
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -62,6 +65,39 @@ func (k Querier) DataSource(
 	return &types.QueryDataSourceResponse{DataSource: &ds}, nil
 }
 
+// DataSourceVersions queries all versions of the data source for given data source id.
+func (k Querier) DataSourceVersions(
+	c context.Context,
+	req *types.QueryDataSourceVersionsRequest,
+) (*types.QueryDataSourceVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	id := types.DataSourceID(req.DataSourceId)
+	if !k.HasDataSource(ctx, id) {
+		return nil, types.ErrDataSourceNotFound.Wrapf("id: %d", id)
+	}
+
+	versionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DataSourceVersionsStoreKey(id))
+
+	var versions []types.DataSourceVersion
+	pageRes, err := query.Paginate(versionStore, req.Pagination, func(_ []byte, value []byte) error {
+		var version types.DataSourceVersion
+		if err := k.cdc.Unmarshal(value, &version); err != nil {
+			return err
+		}
+
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDataSourceVersionsResponse{DataSourceVersions: versions, Pagination: pageRes}, nil
+}
+
 // OracleScript queries oracle script info for given oracle script id.
 func (k Querier) OracleScript(
 	c context.Context,
@@ -78,6 +114,39 @@ func (k Querier) OracleScript(
 	return &types.QueryOracleScriptResponse{OracleScript: &os}, nil
 }
 
+// OracleScriptVersions queries all versions of the oracle script for given oracle script id.
+func (k Querier) OracleScriptVersions(
+	c context.Context,
+	req *types.QueryOracleScriptVersionsRequest,
+) (*types.QueryOracleScriptVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	id := types.OracleScriptID(req.OracleScriptId)
+	if !k.HasOracleScript(ctx, id) {
+		return nil, types.ErrOracleScriptNotFound.Wrapf("id: %d", id)
+	}
+
+	versionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleScriptVersionsStoreKey(id))
+
+	var versions []types.OracleScriptVersion
+	pageRes, err := query.Paginate(versionStore, req.Pagination, func(_ []byte, value []byte) error {
+		var version types.OracleScriptVersion
+		if err := k.cdc.Unmarshal(value, &version); err != nil {
+			return err
+		}
+
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOracleScriptVersionsResponse{OracleScriptVersions: versions, Pagination: pageRes}, nil
+}
+
 // Request queries request info for given request id.
 func (k Querier) Request(c context.Context, req *types.QueryRequestRequest) (*types.QueryRequestResponse, error) {
 	if req == nil {
@@ -110,7 +179,13 @@ func (k Querier) Request(c context.Context, req *types.QueryRequestRequest) (*ty
 			)
 		}
 		result := k.MustGetResult(ctx, rid)
-		return &types.QueryRequestResponse{Request: nil, Reports: nil, Result: &result, Signing: signingResult}, nil
+		return &types.QueryRequestResponse{
+			Request:             nil,
+			Reports:             nil,
+			Result:              &result,
+			Signing:             signingResult,
+			OracleScriptVersion: k.GetResultOracleScriptVersion(ctx, rid),
+		}, nil
 	}
 
 	reports := k.GetReports(ctx, rid)
@@ -121,10 +196,11 @@ func (k Querier) Request(c context.Context, req *types.QueryRequestRequest) (*ty
 	result := k.MustGetResult(ctx, rid)
 
 	return &types.QueryRequestResponse{
-		Request: &request,
-		Reports: reports,
-		Result:  &result,
-		Signing: signingResult,
+		Request:             &request,
+		Reports:             reports,
+		Result:              &result,
+		Signing:             signingResult,
+		OracleScriptVersion: k.GetResultOracleScriptVersion(ctx, rid),
 	}, nil
 }
 
@@ -722,3 +722,90 @@ func (suite *KeeperTestSuite) TestPendingRequestsSuccess() {
 	require.Equal(&types.QueryPendingRequestsResponse{RequestIDs: []uint64{3}}, r)
 	require.NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestQueryDataSourceVersions() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	querier := suite.queryClient
+	require := suite.Require()
+
+	id := k.AddDataSource(ctx, types.NewDataSource(
+		owner, basicName, basicDesc, "FILENAME1", bandtesting.EmptyCoins, treasury, false,
+	))
+	k.MustEditDataSource(ctx, id, types.NewDataSource(
+		owner, basicName, basicDesc, "FILENAME2", bandtesting.EmptyCoins, treasury, false,
+	), types.CACHEABLE_OPTION_DO_NOT_MODIFY)
+
+	res, err := querier.DataSourceVersions(
+		context.Background(),
+		&types.QueryDataSourceVersionsRequest{DataSourceId: uint64(id)},
+	)
+	require.NoError(err)
+	require.Equal([]types.DataSourceVersion{
+		types.NewDataSourceVersion(id, 1, "FILENAME1"),
+		types.NewDataSourceVersion(id, 2, "FILENAME2"),
+	}, res.DataSourceVersions)
+
+	_, err = querier.DataSourceVersions(
+		context.Background(),
+		&types.QueryDataSourceVersionsRequest{DataSourceId: 9999},
+	)
+	require.ErrorContains(err, "data source not found")
+}
+
+func (suite *KeeperTestSuite) TestQueryOracleScriptVersions() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	querier := suite.queryClient
+	require := suite.Require()
+
+	id := k.AddOracleScript(ctx, types.NewOracleScript(
+		owner, basicName, basicDesc, "FILENAME1", basicSchema, basicSourceCodeURL,
+	))
+	k.MustEditOracleScript(ctx, id, types.NewOracleScript(
+		owner, basicName, basicDesc, "FILENAME2", basicSchema, basicSourceCodeURL,
+	))
+
+	res, err := querier.OracleScriptVersions(
+		context.Background(),
+		&types.QueryOracleScriptVersionsRequest{OracleScriptId: uint64(id)},
+	)
+	require.NoError(err)
+	require.Equal([]types.OracleScriptVersion{
+		types.NewOracleScriptVersion(id, 1, "FILENAME1", basicSchema),
+		types.NewOracleScriptVersion(id, 2, "FILENAME2", basicSchema),
+	}, res.OracleScriptVersions)
+
+	_, err = querier.OracleScriptVersions(
+		context.Background(),
+		&types.QueryOracleScriptVersionsRequest{OracleScriptId: 9999},
+	)
+	require.ErrorContains(err, "oracle script not found")
+}
+
+func (suite *KeeperTestSuite) TestQueryRequestOracleScriptVersion() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	querier := suite.queryClient
+	require := suite.Require()
+
+	req := defaultRequest()
+	req.OracleScriptVersion = 2
+	k.SetRequest(ctx, 42, req)
+	k.SetResult(ctx, 42, types.NewResult(
+		basicClientID, 1, basicCalldata, 2, 1, 42, 1, 0, 1, types.RESOLVE_STATUS_SUCCESS, basicResult,
+	))
+	k.SetResultOracleScriptVersion(ctx, 42, req.OracleScriptVersion)
+
+	res, err := querier.Request(context.Background(), &types.QueryRequestRequest{RequestId: 42})
+	require.NoError(err)
+	require.Equal(uint64(2), res.OracleScriptVersion)
+
+	// The version must still be available after the request is pruned.
+	k.DeleteRequest(ctx, 42)
+	k.SetRequestLastExpired(ctx, 42)
+	res, err = querier.Request(context.Background(), &types.QueryRequestRequest{RequestId: 42})
+	require.NoError(err)
+	require.Nil(res.Request)
+	require.Equal(uint64(2), res.OracleScriptVersion)
+}
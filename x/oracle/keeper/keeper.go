@@ -40,6 +40,7 @@ type Keeper struct {
 	rollingseedKepper types.RollingseedKeeper
 	bandtssKeeper     types.BandtssKeeper
 	scopedKeeper      capabilitykeeper.ScopedKeeper
+	cbRouter          *types.CallbackRouter
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -63,6 +64,7 @@ func NewKeeper(
 	bandtssKeeper types.BandtssKeeper,
 	scopeKeeper capabilitykeeper.ScopedKeeper,
 	owasmVM *owasm.Vm,
+	cbRouter *types.CallbackRouter,
 	authority string,
 ) Keeper {
 	return Keeper{
@@ -81,6 +83,7 @@ func NewKeeper(
 		rollingseedKepper: rollingseedKepper,
 		bandtssKeeper:     bandtssKeeper,
 		scopedKeeper:      scopeKeeper,
+		cbRouter:          cbRouter,
 		authority:         authority,
 	}
 }
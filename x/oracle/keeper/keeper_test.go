@@ -44,6 +44,7 @@ type KeeperTestSuite struct {
 	authzKeeper       *oracletestutil.MockAuthzKeeper
 	rollingseedKeeper *oracletestutil.MockRollingseedKeeper
 	bandtssKeeper     *oracletestutil.MockBandtssKeeper
+	callback          *testCallback
 
 	key         storetypes.StoreKey
 	queryClient types.QueryClient
@@ -75,6 +76,10 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.rollingseedKeeper = oracletestutil.NewMockRollingseedKeeper(ctrl)
 	suite.bandtssKeeper = oracletestutil.NewMockBandtssKeeper(ctrl)
 
+	suite.callback = &testCallback{key: key}
+	cbRouter := types.NewCallbackRouter().AddRoute(testCallbackModule, suite.callback)
+	cbRouter.Seal()
+
 	suite.key = key
 	suite.homeDir = testutil.GetTempDir(suite.T())
 	suite.fileDir = filepath.Join(suite.homeDir, "files")
@@ -99,6 +104,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		suite.bandtssKeeper,
 		capabilitykeeper.ScopedKeeper{},
 		owasmVM,
+		cbRouter,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -5,6 +5,8 @@ import (
 
 	"github.com/bandprotocol/chain/v3/x/oracle/exported"
 	v2 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v2"
+	v3 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v3"
+	v4 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -27,3 +29,17 @@ func NewMigrator(k Keeper, ss exported.Subspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates the x/oracle module state from the consensus version 2 to
+// version 3. Specifically, it creates the first version of every existing data
+// source and oracle script.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate3to4 migrates the x/oracle module state from the consensus version 3 to
+// version 4. Specifically, it sets the default price and maximum limit of the
+// callback gas.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
@@ -11,3 +11,16 @@ func modify(oldVal string, newVal string) string {
 	}
 	return newVal
 }
+
+// modifyCacheable returns whether a data source is cacheable after applying the given option.
+// Returns old value if the option is `CACHEABLE_OPTION_DO_NOT_MODIFY`
+func modifyCacheable(oldVal bool, option types.CacheableOption) bool {
+	switch option {
+	case types.CACHEABLE_OPTION_ENABLE:
+		return true
+	case types.CACHEABLE_OPTION_DISABLE:
+		return false
+	default:
+		return oldVal
+	}
+}
@@ -41,9 +41,16 @@ func (k Keeper) SetOracleScript(ctx sdk.Context, id types.OracleScriptID, oracle
 	store.Set(types.OracleScriptStoreKey(id), k.cdc.MustMarshal(&oracleScript))
 }
 
-// AddOracleScript adds the given oracle script to the storage.
+// AddOracleScript adds the given oracle script to the storage. An oracle script without a version
+// is created at version 1, together with the record of that version.
 func (k Keeper) AddOracleScript(ctx sdk.Context, oracleScript types.OracleScript) types.OracleScriptID {
 	id := k.GetNextOracleScriptID(ctx)
+	if oracleScript.Version == 0 {
+		oracleScript.Version = 1
+		k.SetOracleScriptVersion(ctx, types.NewOracleScriptVersion(
+			id, oracleScript.Version, oracleScript.Filename, oracleScript.Schema,
+		))
+	}
 	k.SetOracleScript(ctx, id, oracleScript)
 	return id
 }
@@ -54,9 +61,17 @@ func (k Keeper) MustEditOracleScript(ctx sdk.Context, id types.OracleScriptID, n
 	oracleScript.Owner = new.Owner
 	oracleScript.Name = modify(oracleScript.Name, new.Name)
 	oracleScript.Description = modify(oracleScript.Description, new.Description)
-	oracleScript.Filename = modify(oracleScript.Filename, new.Filename)
-	oracleScript.Schema = modify(oracleScript.Schema, new.Schema)
 	oracleScript.SourceCodeURL = modify(oracleScript.SourceCodeURL, new.SourceCodeURL)
+	filename := modify(oracleScript.Filename, new.Filename)
+	schema := modify(oracleScript.Schema, new.Schema)
+	if filename != oracleScript.Filename || schema != oracleScript.Schema {
+		oracleScript.Filename = filename
+		oracleScript.Schema = schema
+		oracleScript.Version++
+		k.SetOracleScriptVersion(ctx, types.NewOracleScriptVersion(
+			id, oracleScript.Version, oracleScript.Filename, oracleScript.Schema,
+		))
+	}
 	k.SetOracleScript(ctx, id, oracleScript)
 }
 
@@ -73,6 +88,44 @@ func (k Keeper) GetAllOracleScripts(ctx sdk.Context) (oracleScripts []types.Orac
 	return oracleScripts
 }
 
+// GetOracleScriptVersion returns the given version of the oracle script or error if not exists.
+func (k Keeper) GetOracleScriptVersion(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	version uint64,
+) (types.OracleScriptVersion, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.OracleScriptVersionStoreKey(id, version))
+	if bz == nil {
+		return types.OracleScriptVersion{}, types.ErrOracleScriptVersionNotFound.Wrapf(
+			"id: %d, version: %d", id, version,
+		)
+	}
+	var oracleScriptVersion types.OracleScriptVersion
+	k.cdc.MustUnmarshal(bz, &oracleScriptVersion)
+	return oracleScriptVersion, nil
+}
+
+// SetOracleScriptVersion saves the given oracle script version to the storage.
+func (k Keeper) SetOracleScriptVersion(ctx sdk.Context, oracleScriptVersion types.OracleScriptVersion) {
+	ctx.KVStore(k.storeKey).Set(
+		types.OracleScriptVersionStoreKey(oracleScriptVersion.OracleScriptID, oracleScriptVersion.Version),
+		k.cdc.MustMarshal(&oracleScriptVersion),
+	)
+}
+
+// GetAllOracleScriptVersions returns the list of all versions of all oracle scripts in the store.
+func (k Keeper) GetAllOracleScriptVersions(ctx sdk.Context) (oracleScriptVersions []types.OracleScriptVersion) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.OracleScriptVersionStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var oracleScriptVersion types.OracleScriptVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &oracleScriptVersion)
+		oracleScriptVersions = append(oracleScriptVersions, oracleScriptVersion)
+	}
+	return oracleScriptVersions
+}
+
 // AddOracleScriptFile compiles Wasm code (see go-owasm), adds the compiled file to filecache,
 // and returns its sha256 reference name. Returns do-not-modify symbol if input is do-not-modify.
 func (k Keeper) AddOracleScriptFile(file []byte) (string, error) {
@@ -70,6 +70,7 @@ func (suite *KeeperTestSuite) TestAddEditOracleScriptBasic() {
 	)
 	// Adds a new oracle script to the store. We should be able to retrieve it back.
 	id := k.AddOracleScript(ctx, oracleScript1)
+	oracleScript1.Version = 1
 	require.Equal(oracleScript1, k.MustGetOracleScript(ctx, id))
 	require.NotEqual(oracleScript2, k.MustGetOracleScript(ctx, id))
 	// Edits the oracle script. We should get the updated oracle script.
@@ -81,6 +82,7 @@ func (suite *KeeperTestSuite) TestAddEditOracleScriptBasic() {
 			oracleScript2.Schema, oracleScript2.SourceCodeURL,
 		))
 	})
+	oracleScript2.Version = 2
 	require.NotEqual(oracleScript1, k.MustGetOracleScript(ctx, id))
 	require.Equal(oracleScript2, k.MustGetOracleScript(ctx, id))
 }
@@ -100,6 +102,7 @@ func (suite *KeeperTestSuite) TestAddEditOracleScriptDoNotModify() {
 	)
 	// Adds a new oracle script to the store. We should be able to retrieve it back.
 	id := k.AddOracleScript(ctx, oracleScript1)
+	oracleScript1.Version = 1
 	require.Equal(oracleScript1, k.MustGetOracleScript(ctx, id))
 	require.NotEqual(oracleScript2, k.MustGetOracleScript(ctx, id))
 	// Edits the oracle script. We should get the updated oracle script.
@@ -113,6 +116,43 @@ func (suite *KeeperTestSuite) TestAddEditOracleScriptDoNotModify() {
 	require.Equal(oracleScriptRes.Filename, oracleScript2.Filename)
 	require.Equal(oracleScriptRes.Schema, oracleScript1.Schema)
 	require.Equal(oracleScriptRes.SourceCodeURL, oracleScript1.SourceCodeURL)
+	require.Equal(uint64(2), oracleScriptRes.Version)
+}
+
+func (suite *KeeperTestSuite) TestEditOracleScriptVersion() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	id := k.AddOracleScript(ctx, types.NewOracleScript(
+		bandtesting.Owner.Address, basicName, basicDesc, "FILENAME1", basicSchema, basicSourceCodeURL,
+	))
+	// Editing anything but the Wasm code and the schema must not create a new version.
+	k.MustEditOracleScript(ctx, id, types.NewOracleScript(
+		bandtesting.Owner.Address, "NAME2", basicDesc, types.DoNotModify, types.DoNotModify, "URL2",
+	))
+	require.Equal(uint64(1), k.MustGetOracleScript(ctx, id).Version)
+	// Changing the schema or the Wasm code creates a new version and keeps the previous ones.
+	k.MustEditOracleScript(ctx, id, types.NewOracleScript(
+		bandtesting.Owner.Address, basicName, basicDesc, types.DoNotModify, "SCHEMA2", basicSourceCodeURL,
+	))
+	k.MustEditOracleScript(ctx, id, types.NewOracleScript(
+		bandtesting.Owner.Address, basicName, basicDesc, "FILENAME2", types.DoNotModify, basicSourceCodeURL,
+	))
+	require.Equal(uint64(3), k.MustGetOracleScript(ctx, id).Version)
+
+	expectVersions := []types.OracleScriptVersion{
+		types.NewOracleScriptVersion(id, 1, "FILENAME1", basicSchema),
+		types.NewOracleScriptVersion(id, 2, "FILENAME1", "SCHEMA2"),
+		types.NewOracleScriptVersion(id, 3, "FILENAME2", "SCHEMA2"),
+	}
+	for _, expect := range expectVersions {
+		version, err := k.GetOracleScriptVersion(ctx, id, expect.Version)
+		require.NoError(err)
+		require.Equal(expect, version)
+	}
+	_, err := k.GetOracleScriptVersion(ctx, id, 4)
+	require.ErrorIs(err, types.ErrOracleScriptVersionNotFound)
 }
 
 func (suite *KeeperTestSuite) TestAddOracleScriptMustReturnCorrectID() {
@@ -154,6 +194,9 @@ func (suite *KeeperTestSuite) TestGetAllOracleScripts() {
 	require := suite.Require()
 
 	oracleScripts := bandtesting.GenerateOracleScripts(suite.homeDir)
+	for i := range oracleScripts {
+		oracleScripts[i].Version = 1
+	}
 
 	// We should be able to get all genesis oracle scripts.
 	require.Equal(
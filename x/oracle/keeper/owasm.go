@@ -73,6 +73,41 @@ func (k Keeper) PrepareRequest(
 	r types.RequestSpec,
 	feePayer sdk.AccAddress,
 	ibcChannel *types.IBCChannel,
+) (types.RequestID, error) {
+	return k.prepareRequest(ctx, r, feePayer, ibcChannel, "", 0)
+}
+
+// PrepareRequestWithCallback prepares a request made by another module, like PrepareRequest.
+// The module is called back through its route in the callback router once the request is
+// resolved or expired. The fee of the callback gas limit is paid from the fee limit.
+func (k Keeper) PrepareRequestWithCallback(
+	ctx sdk.Context,
+	r types.RequestSpec,
+	feePayer sdk.AccAddress,
+	callbackModule string,
+	callbackGasLimit uint64,
+) (types.RequestID, error) {
+	if !k.cbRouter.HasRoute(callbackModule) {
+		return 0, types.ErrCallbackNotFound.Wrapf("module: %s", callbackModule)
+	}
+	if callbackGasLimit == 0 {
+		return 0, types.ErrInvalidCallbackGasLimit.Wrap("callback gas limit must be positive")
+	}
+	if maxGasLimit := k.GetParams(ctx).MaxCallbackGasLimit; callbackGasLimit > maxGasLimit {
+		return 0, types.ErrInvalidCallbackGasLimit.Wrapf("callback gas limit %d exceeds %d", callbackGasLimit, maxGasLimit)
+	}
+	return k.prepareRequest(ctx, r, feePayer, nil, callbackModule, callbackGasLimit)
+}
+
+// prepareRequest performs the prepare call of the request and saves it to store, with the
+// callback module and its gas limit if any.
+func (k Keeper) prepareRequest(
+	ctx sdk.Context,
+	r types.RequestSpec,
+	feePayer sdk.AccAddress,
+	ibcChannel *types.IBCChannel,
+	callbackModule string,
+	callbackGasLimit uint64,
 ) (types.RequestID, error) {
 	calldataSize := len(r.GetCalldata())
 	if calldataSize > int(k.GetSpanSize(ctx)) {
@@ -111,6 +146,20 @@ func (k Keeper) PrepareRequest(
 		r.GetFeeLimit(),
 	)
 
+	// Use the pinned version of the oracle script if any, or its latest version otherwise.
+	script, err := k.GetOracleScript(ctx, req.OracleScriptID)
+	if err != nil {
+		return 0, err
+	}
+	req.OracleScriptVersion = r.GetOracleScriptVersion()
+	if req.OracleScriptVersion == 0 {
+		req.OracleScriptVersion = script.Version
+	}
+	code, err := k.getOracleScriptCode(ctx, req.OracleScriptID, script, req.OracleScriptVersion)
+	if err != nil {
+		return 0, err
+	}
+
 	// Create an execution environment and call Owasm prepare function.
 	env := types.NewPrepareEnv(
 		req,
@@ -118,15 +167,10 @@ func (k Keeper) PrepareRequest(
 		int64(params.MaxRawRequestCount),
 		int64(k.GetSpanSize(ctx)),
 	)
-	script, err := k.GetOracleScript(ctx, req.OracleScriptID)
-	if err != nil {
-		return 0, err
-	}
 
 	// Consume fee and execute owasm code
 	ctx.GasMeter().ConsumeGas(params.BaseOwasmGas, "BASE_OWASM_FEE")
 	ctx.GasMeter().ConsumeGas(r.GetPrepareGas(), "OWASM_PREPARE_FEE")
-	code := k.GetFile(script.Filename)
 	output, err := k.owasmVM.Prepare(code, ConvertToOwasmGas(r.GetPrepareGas()), env)
 	if err != nil {
 		return 0, types.ErrBadWasmExecution.Wrap(err.Error())
@@ -137,11 +181,29 @@ func (k Keeper) PrepareRequest(
 	if len(req.RawRequests) == 0 {
 		return 0, types.ErrEmptyRawRequests
 	}
+	// Pin each raw request to the current version of its data source.
+	for i := range req.RawRequests {
+		ds, err := k.GetDataSource(ctx, req.RawRequests[i].DataSourceID)
+		if err != nil {
+			return 0, err
+		}
+		req.RawRequests[i].DataSourceVersion = ds.Version
+	}
 	// Collect ds fee
 	totalFees, err := k.CollectFee(ctx, feePayer, req.FeeLimit, askCount, req.RawRequests)
 	if err != nil {
 		return 0, err
 	}
+	// Collect callback fee
+	if callbackModule != "" {
+		callbackFee, err := k.CollectCallbackFee(ctx, feePayer, req.FeeLimit.Sub(totalFees...), callbackGasLimit)
+		if err != nil {
+			return 0, err
+		}
+		totalFees = totalFees.Add(callbackFee...)
+		req.CallbackModule = callbackModule
+		req.CallbackGasLimit = callbackGasLimit
+	}
 
 	// We now have everything we need to the request, so let's add it to the store.
 	req.FeeLimit = req.FeeLimit.Sub(totalFees...)
@@ -192,7 +254,11 @@ func (k Keeper) ResolveRequest(ctx sdk.Context, reqID types.RequestID) {
 	req := k.MustGetRequest(ctx, reqID)
 	env := types.NewExecuteEnv(req, k.GetReports(ctx, reqID), ctx.BlockTime(), int64(k.GetSpanSize(ctx)))
 	script := k.MustGetOracleScript(ctx, req.OracleScriptID)
-	code := k.GetFile(script.Filename)
+	code, err := k.getOracleScriptCode(ctx, req.OracleScriptID, script, req.OracleScriptVersion)
+	if err != nil {
+		k.ResolveFailure(ctx, reqID, err.Error())
+		return
+	}
 	output, err := k.owasmVM.Execute(code, ConvertToOwasmGas(req.GetExecuteGas()), env)
 
 	if err != nil {
@@ -204,6 +270,24 @@ func (k Keeper) ResolveRequest(ctx sdk.Context, reqID types.RequestID) {
 	}
 }
 
+// getOracleScriptCode returns the compiled Wasm code of the given version of the oracle script.
+// Version 0 refers to the current code of an oracle script that has never been versioned.
+func (k Keeper) getOracleScriptCode(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	script types.OracleScript,
+	version uint64,
+) ([]byte, error) {
+	if version == 0 {
+		return k.GetFile(script.Filename), nil
+	}
+	scriptVersion, err := k.GetOracleScriptVersion(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+	return k.GetFile(scriptVersion.Filename), nil
+}
+
 // CollectFee subtract fee from fee payer and send them to treasury
 func (k Keeper) CollectFee(
 	ctx sdk.Context,
@@ -242,3 +326,29 @@ func (k Keeper) CollectFee(
 
 	return collector.Collected(), nil
 }
+
+// CollectCallbackFee subtracts the fee of the given callback gas limit from fee payer and sends
+// it to the fee collector.
+func (k Keeper) CollectCallbackFee(
+	ctx sdk.Context,
+	payer sdk.AccAddress,
+	feeLimit sdk.Coins,
+	callbackGasLimit uint64,
+) (sdk.Coins, error) {
+	fee := sdk.NewCoins()
+	for _, price := range k.GetParams(ctx).CallbackGasPrice {
+		amount := price.Amount.MulInt(math.NewIntFromUint64(callbackGasLimit)).Ceil().TruncateInt()
+		fee = fee.Add(sdk.NewCoin(price.Denom, amount))
+	}
+	if fee.IsZero() {
+		return fee, nil
+	}
+
+	collector := newFeeCollector(k.bankKeeper, feeLimit, payer)
+	feeCollector := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+	if err := collector.Collect(ctx, fee, feeCollector.GetAddress()); err != nil {
+		return nil, err
+	}
+
+	return collector.Collected(), nil
+}
@@ -230,6 +230,65 @@ func (suite *KeeperTestSuite) TestPrepareRequestSuccessBasic() {
 	require.Equal(1, wrappedGasMeter.CountRecord(testDefaultExecuteGas, "OWASM_EXECUTE_FEE"))
 }
 
+func (suite *KeeperTestSuite) TestPrepareRequestPinnedVersion() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	// OracleScript#1 is at version 2 with a missing file, so only version 1 can be executed.
+	oracleScript := k.MustGetOracleScript(ctx, 1)
+	k.SetOracleScriptVersion(ctx, types.NewOracleScriptVersion(1, 1, oracleScript.Filename, oracleScript.Schema))
+	oracleScript.Filename = "missing_file"
+	oracleScript.Version = 2
+	k.SetOracleScript(ctx, 1, oracleScript)
+	k.SetOracleScriptVersion(ctx, types.NewOracleScriptVersion(1, 2, oracleScript.Filename, oracleScript.Schema))
+	dataSource := k.MustGetDataSource(ctx, 1)
+	dataSource.Version = 3
+	k.SetDataSource(ctx, 1, dataSource)
+
+	ctx = ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+
+	msg := types.NewMsgRequestData(
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		bandtesting.Coins100band,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		alice,
+		0,
+	)
+	msg.OracleScriptVersion = 1
+
+	// Define expected mock keeper
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY")).
+		Times(2)
+
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, bandtesting.Coins1band).Times(3)
+
+	id, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.NoError(err)
+
+	req := k.MustGetRequest(ctx, id)
+	require.Equal(uint64(1), req.OracleScriptVersion)
+	require.Equal(uint64(3), req.RawRequests[0].DataSourceVersion)
+	require.Equal(uint64(0), req.RawRequests[1].DataSourceVersion)
+
+	// A version that does not exist cannot be requested.
+	msg.OracleScriptVersion = 3
+	_, err = k.PrepareRequest(ctx, msg, alice, nil)
+	require.ErrorIs(err, types.ErrOracleScriptVersionNotFound)
+}
+
 func (suite *KeeperTestSuite) TestPrepareRequestInvalidCalldataSize() {
 	ctx := suite.ctx
 	k := suite.oracleKeeper
@@ -1031,6 +1090,48 @@ func (suite *KeeperTestSuite) TestResolveRequestNoReturnData() {
 	)}, ctx.EventManager().Events())
 }
 
+func (suite *KeeperTestSuite) TestResolveRequestMissingVersion() {
+	require := suite.Require()
+	suite.mockIterateBondedValidatorsByPower()
+	suite.activeAllValidators()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+
+	ctx = ctx.WithBlockTime(bandtesting.ParseTime(1581589890))
+	req := types.NewRequest(
+		1,
+		basicCalldata,
+		[]sdk.ValAddress{bandtesting.Validators[0].ValAddress, bandtesting.Validators[1].ValAddress},
+		1,
+		42,
+		bandtesting.ParseTime(1581589790),
+		basicClientID,
+		[]types.RawRequest{
+			types.NewRawRequest(1, 1, []byte("test")),
+		},
+		nil,
+		1,
+		0,
+		bandtesting.FeePayer.Address.String(),
+		bandtesting.Coins100band,
+	)
+	// The version record of OracleScript#1 does not exist, so the request must fail instead of panicking.
+	req.OracleScriptVersion = 99
+	k.SetRequest(ctx, 42, req)
+	k.SetReport(ctx, 42, types.NewReport(
+		bandtesting.Validators[0].ValAddress, true, []types.RawReport{
+			types.NewRawReport(1, 0, []byte("test")),
+		},
+	))
+	require.NotPanics(func() { k.ResolveRequest(ctx, 42) })
+	result := types.NewResult(
+		basicClientID, 1, basicCalldata, 2, 1, 42, 1, bandtesting.ParseTime(1581589790).Unix(),
+		bandtesting.ParseTime(1581589890).Unix(), types.RESOLVE_STATUS_FAILURE, nil,
+	)
+	require.Equal(result, k.MustGetResult(ctx, 42))
+	require.Equal(uint64(99), k.GetResultOracleScriptVersion(ctx, 42))
+}
+
 func (suite *KeeperTestSuite) TestResolveRequestWasmFailure() {
 	require := suite.Require()
 	suite.mockIterateBondedValidatorsByPower()
@@ -1158,6 +1259,56 @@ func (suite *KeeperTestSuite) TestResolveRequestSuccess() {
 	require.Equal(expectResult, k.MustGetResult(ctx, 42))
 }
 
+func (suite *KeeperTestSuite) TestResolveRequestPinnedVersion() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	// The request uses version 1 of OracleScript#1, which has been edited since.
+	oracleScript := k.MustGetOracleScript(ctx, 1)
+	k.SetOracleScriptVersion(ctx, types.NewOracleScriptVersion(1, 1, oracleScript.Filename, oracleScript.Schema))
+	oracleScript.Filename = "missing_file"
+	oracleScript.Version = 2
+	k.SetOracleScript(ctx, 1, oracleScript)
+
+	ctx = ctx.WithBlockTime(bandtesting.ParseTime(1581589890))
+	req := types.NewRequest(
+		// 1st Wasm - return "test"
+		1,
+		basicCalldata,
+		[]sdk.ValAddress{validators[0].Address, validators[1].Address},
+		1,
+		42,
+		bandtesting.ParseTime(1581589790),
+		basicClientID,
+		[]types.RawRequest{
+			types.NewRawRequest(1, 1, []byte("test")),
+		},
+		nil,
+		testDefaultExecuteGas,
+		0,
+		bandtesting.FeePayer.Address.String(),
+		bandtesting.Coins100band,
+	)
+	req.OracleScriptVersion = 1
+	k.SetRequest(ctx, 42, req)
+	k.SetReport(ctx, 42, types.NewReport(
+		validators[0].Address, true, []types.RawReport{
+			types.NewRawReport(1, 0, []byte("test")),
+		},
+	))
+	k.ResolveRequest(ctx, 42)
+	expectResult := types.NewResult(
+		basicClientID, 1, basicCalldata, 2, 1,
+		42, 1, bandtesting.ParseTime(1581589790).Unix(),
+		bandtesting.ParseTime(1581589890).Unix(), types.RESOLVE_STATUS_SUCCESS, []byte("test"),
+	)
+	require.Equal(expectResult, k.MustGetResult(ctx, 42))
+	require.Equal(uint64(1), k.GetResultOracleScriptVersion(ctx, 42))
+}
+
 func (suite *KeeperTestSuite) TestResolveRequestSuccessComplex() {
 	ctx := suite.ctx
 	k := suite.oracleKeeper
@@ -1220,6 +1371,7 @@ func rawRequestsFromFees(ctx sdk.Context, k keeper.Keeper, fees []sdk.Coins) []t
 			"no file",
 			f,
 			bandtesting.Treasury.Address,
+			false,
 		))
 
 		rawRequests = append(rawRequests, types.NewRawRequest(
@@ -55,6 +55,21 @@ func (k Keeper) MustGetResult(ctx sdk.Context, id types.RequestID) types.Result
 	return result
 }
 
+// SetResultOracleScriptVersion sets the oracle script version used to resolve the request to the store.
+func (k Keeper) SetResultOracleScriptVersion(ctx sdk.Context, reqID types.RequestID, version uint64) {
+	ctx.KVStore(k.storeKey).Set(types.ResultOracleScriptVersionStoreKey(reqID), sdk.Uint64ToBigEndian(version))
+}
+
+// GetResultOracleScriptVersion returns the oracle script version used to resolve the request, or 0 if
+// the request was resolved before oracle scripts are versioned.
+func (k Keeper) GetResultOracleScriptVersion(ctx sdk.Context, reqID types.RequestID) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.ResultOracleScriptVersionStoreKey(reqID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
 // ResolveSuccess resolves the given request as success with the given result.
 func (k Keeper) ResolveSuccess(
 	ctx sdk.Context,
@@ -66,6 +81,7 @@ func (k Keeper) ResolveSuccess(
 	encoder types.Encoder,
 ) {
 	k.SaveResult(ctx, id, types.RESOLVE_STATUS_SUCCESS, result)
+	k.invokeCallback(ctx, id, "")
 
 	event := sdk.NewEvent(
 		types.EventTypeResolve,
@@ -163,6 +179,7 @@ func (k Keeper) handleCreateSigningFailed(
 // ResolveFailure resolves the given request as failure with the given reason.
 func (k Keeper) ResolveFailure(ctx sdk.Context, id types.RequestID, reason string) {
 	k.SaveResult(ctx, id, types.RESOLVE_STATUS_FAILURE, []byte{})
+	k.invokeCallback(ctx, id, reason)
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeResolve,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
@@ -174,6 +191,7 @@ func (k Keeper) ResolveFailure(ctx sdk.Context, id types.RequestID, reason strin
 // ResolveExpired resolves the given request as expired.
 func (k Keeper) ResolveExpired(ctx sdk.Context, id types.RequestID) {
 	k.SaveResult(ctx, id, types.RESOLVE_STATUS_EXPIRED, []byte{})
+	k.invokeCallback(ctx, id, "")
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeResolve,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
@@ -187,7 +205,7 @@ func (k Keeper) SaveResult(
 ) {
 	r := k.MustGetRequest(ctx, id)
 	reportCount := k.GetReportCount(ctx, id)
-	k.SetResult(ctx, id, types.NewResult(
+	res := types.NewResult(
 		r.ClientID,                         // ClientID
 		r.OracleScriptID,                   // OracleScriptID
 		r.Calldata,                         // Calldata
@@ -199,7 +217,12 @@ func (k Keeper) SaveResult(
 		ctx.BlockTime().Unix(),             // ResolveTime
 		status,                             // ResolveStatus
 		result,                             // Result
-	))
+	)
+	k.SetResult(ctx, id, res)
+	// The version is kept out of the result, whose encoding is verified by the bridge contracts.
+	if r.OracleScriptVersion != 0 {
+		k.SetResultOracleScriptVersion(ctx, id, r.OracleScriptVersion)
+	}
 
 	if r.IBCChannel != nil {
 		sourceChannel := r.IBCChannel.ChannelId
@@ -0,0 +1,47 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/bandprotocol/chain/v3/x/oracle"
+	v3 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v3"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v3.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	dataSource := types.DataSource{Name: "ds", Filename: "ds_file"}
+	store.Set(types.DataSourceStoreKey(1), cdc.MustMarshal(&dataSource))
+	oracleScript := types.OracleScript{Name: "os", Filename: "os_file", Schema: "schema"}
+	store.Set(types.OracleScriptStoreKey(2), cdc.MustMarshal(&oracleScript))
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var ds types.DataSource
+	cdc.MustUnmarshal(store.Get(types.DataSourceStoreKey(1)), &ds)
+	require.Equal(t, uint64(1), ds.Version)
+	var dsVersion types.DataSourceVersion
+	cdc.MustUnmarshal(store.Get(types.DataSourceVersionStoreKey(1, 1)), &dsVersion)
+	require.Equal(t, types.NewDataSourceVersion(1, 1, "ds_file"), dsVersion)
+
+	var os types.OracleScript
+	cdc.MustUnmarshal(store.Get(types.OracleScriptStoreKey(2)), &os)
+	require.Equal(t, uint64(1), os.Version)
+	var osVersion types.OracleScriptVersion
+	cdc.MustUnmarshal(store.Get(types.OracleScriptVersionStoreKey(2, 1)), &osVersion)
+	require.Equal(t, types.NewOracleScriptVersion(2, 1, "os_file", "schema"), osVersion)
+}
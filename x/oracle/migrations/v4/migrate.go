@@ -0,0 +1,43 @@
+package v4
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const (
+	ModuleName = "oracle"
+)
+
+// Migrate migrates the x/oracle module state from the consensus version 3 to
+// version 4. Specifically, it sets the default price and maximum limit of the
+// callback gas if they are not set.
+func Migrate(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	bz := store.Get(types.ParamsKeyPrefix)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+	if params.CallbackGasPrice.IsZero() {
+		params.CallbackGasPrice = types.DefaultCallbackGasPrice
+	}
+	if params.MaxCallbackGasLimit == 0 {
+		params.MaxCallbackGasLimit = types.DefaultMaxCallbackGasLimit
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	store.Set(types.ParamsKeyPrefix, cdc.MustMarshal(&params))
+
+	return nil
+}
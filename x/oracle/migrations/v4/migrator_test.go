@@ -0,0 +1,37 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/bandprotocol/chain/v3/x/oracle"
+	v4 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v4"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v4.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	params.CallbackGasPrice = nil
+	params.MaxCallbackGasLimit = 0
+	store.Set(types.ParamsKeyPrefix, cdc.MustMarshal(&params))
+
+	require.NoError(t, v4.Migrate(ctx, store, cdc))
+
+	var migrated types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKeyPrefix), &migrated)
+	require.Equal(t, types.DefaultParams(), migrated)
+}
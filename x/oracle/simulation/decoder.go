@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
@@ -35,6 +36,18 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &osA)
 			cdc.MustUnmarshal(kvB.Value, &osB)
 			return fmt.Sprintf("%v\n%v", osA, osB)
+		case bytes.Equal(kvA.Key[:1], types.DataSourceVersionStoreKeyPrefix):
+			var dsvA, dsvB types.DataSourceVersion
+			cdc.MustUnmarshal(kvA.Value, &dsvA)
+			cdc.MustUnmarshal(kvB.Value, &dsvB)
+			return fmt.Sprintf("%v\n%v", dsvA, dsvB)
+		case bytes.Equal(kvA.Key[:1], types.OracleScriptVersionStoreKeyPrefix):
+			var osvA, osvB types.OracleScriptVersion
+			cdc.MustUnmarshal(kvA.Value, &osvA)
+			cdc.MustUnmarshal(kvB.Value, &osvB)
+			return fmt.Sprintf("%v\n%v", osvA, osvB)
+		case bytes.Equal(kvA.Key[:1], types.ResultOracleScriptVersionStoreKeyPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
 		case bytes.Equal(kvA.Key[:1], types.ValidatorStatusKeyPrefix):
 			var vsA, vsB types.ValidatorStatus
 			cdc.MustUnmarshal(kvA.Value, &vsA)
@@ -56,6 +56,7 @@ func TestDecodeStore(t *testing.T) {
 		"filename",
 		sdk.NewCoins(sdk.NewInt64Coin("band", 1000)),
 		treaAddr,
+		false,
 	)
 
 	oracleScript := types.NewOracleScript(
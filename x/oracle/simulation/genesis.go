@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"math/rand"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 
@@ -66,6 +69,16 @@ func GenIBCRequestEnabled(r *rand.Rand) bool {
 	return r.Int63n(100) < 50
 }
 
+// GenCallbackGasPrice returns randomized CallbackGasPrice
+func GenCallbackGasPrice(r *rand.Rand) sdk.DecCoins {
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", math.LegacyNewDecWithPrec(r.Int63n(100), 4)))
+}
+
+// GenMaxCallbackGasLimit returns randomized MaxCallbackGasLimit
+func GenMaxCallbackGasLimit(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 100000, 5000000))
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var maxRawRequestCount uint64
@@ -134,6 +147,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { ibcRequestEnabled = GenIBCRequestEnabled(r) },
 	)
 
+	var callbackGasPrice sdk.DecCoins
+	simState.AppParams.GetOrGenerate(
+		"CallbackGasPrice", &callbackGasPrice, simState.Rand,
+		func(r *rand.Rand) { callbackGasPrice = GenCallbackGasPrice(r) },
+	)
+
+	var maxCallbackGasLimit uint64
+	simState.AppParams.GetOrGenerate(
+		"MaxCallbackGasLimit", &maxCallbackGasLimit, simState.Rand,
+		func(r *rand.Rand) { maxCallbackGasLimit = GenMaxCallbackGasLimit(r) },
+	)
+
 	oracleGenesis := types.NewGenesisState(
 		types.NewParams(
 			maxRawRequestCount,
@@ -147,9 +172,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 			oracleRewardPercentage,
 			inactivePenaltyDuration,
 			ibcRequestEnabled,
+			callbackGasPrice,
+			maxCallbackGasLimit,
 		),
 		[]types.DataSource{},
 		[]types.OracleScript{},
+		[]types.DataSourceVersion{},
+		[]types.OracleScriptVersion{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis, "", " ")
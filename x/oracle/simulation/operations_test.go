@@ -241,6 +241,7 @@ func (suite *SimTestSuite) TestSimulateMsgEditDataSource() {
 			"filename",
 			sdk.NewCoins(),
 			suite.accs[0].Address,
+			false,
 		),
 	)
 
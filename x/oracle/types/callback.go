@@ -0,0 +1,80 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CallbackRouter is a struct that holds a map of OracleCallback objects for each module.
+type CallbackRouter struct {
+	routes map[string]OracleCallback
+	sealed bool
+}
+
+// NewCallbackRouter creates a new CallbackRouter instance.
+func NewCallbackRouter() *CallbackRouter {
+	return &CallbackRouter{
+		routes: make(map[string]OracleCallback),
+	}
+}
+
+// Seal seals the CallbackRouter which prohibits any subsequent OracleCallback to be added.
+// Seal will panic if called more than once.
+func (cbr *CallbackRouter) Seal() {
+	if cbr.sealed {
+		panic(errors.New("callback router is already sealed"))
+	}
+	cbr.sealed = true
+}
+
+// Sealed returns whether the CallbackRouter can be changed or not.
+func (cbr CallbackRouter) Sealed() bool {
+	return cbr.sealed
+}
+
+// AddRoute adds OracleCallback for a given module name. It returns the CallbackRouter
+// so that the function can be chained. It will panic if the CallbackRouter is sealed.
+func (cbr *CallbackRouter) AddRoute(module string, cbs OracleCallback) *CallbackRouter {
+	if cbr.sealed {
+		panic(fmt.Errorf("callback router sealed; cannot register %s route callbacks", module))
+	}
+	if !sdk.IsAlphaNumeric(module) {
+		panic(errors.New("callback route expressions can only contain alphanumeric characters"))
+	}
+	if cbr.HasRoute(module) {
+		panic(fmt.Errorf("route %s has already been registered", module))
+	}
+
+	cbr.routes[module] = cbs
+	return cbr
+}
+
+// HasRoute returns whether the given module is registered.
+func (cbr *CallbackRouter) HasRoute(module string) bool {
+	_, ok := cbr.routes[module]
+	return ok
+}
+
+// GetRoute returns an OracleCallback for a given module.
+func (cbr *CallbackRouter) GetRoute(module string) (OracleCallback, bool) {
+	if !cbr.HasRoute(module) {
+		return nil, false
+	}
+	return cbr.routes[module], true
+}
+
+// OracleCallback defines the expected interface for a callback object that registered
+// in the callbackRouter. The callbacks run with the callback gas limit of the request and
+// their state changes are discarded if they return an error, panic or run out of gas.
+type OracleCallback interface {
+	// Must be called after a request is resolved successfully.
+	OnRequestSuccess(ctx sdk.Context, result Result) error
+
+	// Must be called after a request fails to resolve.
+	OnRequestFailure(ctx sdk.Context, result Result, reason string) error
+
+	// Must be called after a request is expired due to insufficient reports.
+	OnRequestExpired(ctx sdk.Context, result Result) error
+}
@@ -6,6 +6,7 @@ import (
 
 func NewDataSource(
 	owner sdk.AccAddress, name, description, filename string, fee sdk.Coins, treasury sdk.AccAddress,
+	cacheable bool,
 ) DataSource {
 	return DataSource{
 		Owner:       owner.String(),
@@ -14,5 +15,14 @@ func NewDataSource(
 		Filename:    filename,
 		Treasury:    treasury.String(),
 		Fee:         fee,
+		Cacheable:   cacheable,
+	}
+}
+
+func NewDataSourceVersion(dataSourceID DataSourceID, version uint64, filename string) DataSourceVersion {
+	return DataSourceVersion{
+		DataSourceID: dataSourceID,
+		Version:      version,
+		Filename:     filename,
 	}
 }
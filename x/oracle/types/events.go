@@ -16,6 +16,8 @@ const (
 	EventTypeSendPacketFail        = "send_packet_fail"
 	EventTypeUpdateParams          = "update_params"
 	EventTypeHandleRequestSignFail = "handle_request_sign_fail"
+	EventTypeCallback              = "callback"
+	EventTypeCallbackFail          = "callback_fail"
 
 	AttributeKeyID                  = "id"
 	AttributeKeySigningID           = "signing_id"
@@ -38,4 +40,5 @@ const (
 	AttributeKeyParams              = "params"
 	AttributeKeySigningErrCodespace = "signing_error_codespace"
 	AttributeKeySigningErrCode      = "signing_error_code"
+	AttributeKeyCallbackModule      = "callback_module"
 )
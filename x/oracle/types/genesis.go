@@ -7,20 +7,30 @@ import (
 )
 
 // NewGenesisState creates a new GenesisState instanc e
-func NewGenesisState(params Params, dataSources []DataSource, oracleScripts []OracleScript) *GenesisState {
+func NewGenesisState(
+	params Params,
+	dataSources []DataSource,
+	oracleScripts []OracleScript,
+	dataSourceVersions []DataSourceVersion,
+	oracleScriptVersions []OracleScriptVersion,
+) *GenesisState {
 	return &GenesisState{
-		Params:        params,
-		DataSources:   dataSources,
-		OracleScripts: oracleScripts,
+		Params:               params,
+		DataSources:          dataSources,
+		OracleScripts:        oracleScripts,
+		DataSourceVersions:   dataSourceVersions,
+		OracleScriptVersions: oracleScriptVersions,
 	}
 }
 
 // DefaultGenesisState returns the default oracle genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		DataSources:   []DataSource{},
-		OracleScripts: []OracleScript{},
+		Params:               DefaultParams(),
+		DataSources:          []DataSource{},
+		OracleScripts:        []OracleScript{},
+		DataSourceVersions:   []DataSourceVersion{},
+		OracleScriptVersions: []OracleScriptVersion{},
 	}
 }
 
@@ -54,6 +54,12 @@ var (
 	ParamsKeyPrefix = []byte{0x06}
 	// SigningResultStoreKeyPrefix is the prefix for signing ID store.
 	SigningResultStoreKeyPrefix = []byte{0x07}
+	// DataSourceVersionStoreKeyPrefix is the prefix for data source version store.
+	DataSourceVersionStoreKeyPrefix = []byte{0x08}
+	// OracleScriptVersionStoreKeyPrefix is the prefix for oracle script version store.
+	OracleScriptVersionStoreKeyPrefix = []byte{0x09}
+	// ResultOracleScriptVersionStoreKeyPrefix is the prefix for the oracle script version of request result store.
+	ResultOracleScriptVersionStoreKeyPrefix = []byte{0x0a}
 	// ResultStoreKeyPrefix is the prefix for request result store.
 	ResultStoreKeyPrefix = []byte{0xff}
 
@@ -81,6 +87,32 @@ func OracleScriptStoreKey(oracleScriptID OracleScriptID) []byte {
 	return append(OracleScriptStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(oracleScriptID))...)
 }
 
+// DataSourceVersionsStoreKey returns the prefix key to retrieve all versions of a data source.
+func DataSourceVersionsStoreKey(dataSourceID DataSourceID) []byte {
+	return append(DataSourceVersionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(dataSourceID))...)
+}
+
+// DataSourceVersionStoreKey returns the key to retrieve a specific version of a data source.
+func DataSourceVersionStoreKey(dataSourceID DataSourceID, version uint64) []byte {
+	return append(DataSourceVersionsStoreKey(dataSourceID), sdk.Uint64ToBigEndian(version)...)
+}
+
+// OracleScriptVersionsStoreKey returns the prefix key to retrieve all versions of an oracle script.
+func OracleScriptVersionsStoreKey(oracleScriptID OracleScriptID) []byte {
+	return append(OracleScriptVersionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(oracleScriptID))...)
+}
+
+// OracleScriptVersionStoreKey returns the key to retrieve a specific version of an oracle script.
+func OracleScriptVersionStoreKey(oracleScriptID OracleScriptID, version uint64) []byte {
+	return append(OracleScriptVersionsStoreKey(oracleScriptID), sdk.Uint64ToBigEndian(version)...)
+}
+
+// ResultOracleScriptVersionStoreKey returns the key to retrieve the oracle script version used to resolve
+// a request.
+func ResultOracleScriptVersionStoreKey(requestID RequestID) []byte {
+	return append(ResultOracleScriptVersionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
+}
+
 // ValidatorStatusStoreKey returns the key to a validator's status.
 func ValidatorStatusStoreKey(v sdk.ValAddress) []byte {
 	return append(ValidatorStatusKeyPrefix, v.Bytes()...)
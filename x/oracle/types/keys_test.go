@@ -29,6 +29,21 @@ func TestOracleScriptStoreKey(t *testing.T) {
 	require.Equal(t, expect, OracleScriptStoreKey(123))
 }
 
+func TestDataSourceVersionStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0800000000000003780000000000000002")
+	require.Equal(t, expect, DataSourceVersionStoreKey(888, 2))
+}
+
+func TestOracleScriptVersionStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("09000000000000007b0000000000000003")
+	require.Equal(t, expect, OracleScriptVersionStoreKey(123, 3))
+}
+
+func TestResultOracleScriptVersionStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0a000000000000002a")
+	require.Equal(t, expect, ResultOracleScriptVersionStoreKey(42))
+}
+
 func TestValidatorStatusStoreKey(t *testing.T) {
 	val, _ := sdk.ValAddressFromHex("b80f2a5df7d5710b15622d1a9f1e3830ded5bda8")
 	expect, _ := hex.DecodeString("05b80f2a5df7d5710b15622d1a9f1e3830ded5bda8")
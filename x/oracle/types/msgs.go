@@ -130,6 +130,7 @@ func (m MsgReportData) ValidateBasic() error {
 // NewMsgCreateDataSource creates a new MsgCreateDataSource instance
 func NewMsgCreateDataSource(
 	name, description string, executable []byte, fee sdk.Coins, treasury, owner, sender sdk.AccAddress,
+	cacheable bool,
 ) *MsgCreateDataSource {
 	return &MsgCreateDataSource{
 		Name:        name,
@@ -139,6 +140,7 @@ func NewMsgCreateDataSource(
 		Treasury:    treasury.String(),
 		Owner:       owner.String(),
 		Sender:      sender.String(),
+		Cacheable:   cacheable,
 	}
 }
 
@@ -194,6 +196,7 @@ func NewMsgEditDataSource(
 	executable []byte,
 	fee sdk.Coins,
 	treasury, owner, sender sdk.AccAddress,
+	cacheable CacheableOption,
 ) *MsgEditDataSource {
 	return &MsgEditDataSource{
 		DataSourceID: dataSourceID,
@@ -204,6 +207,7 @@ func NewMsgEditDataSource(
 		Treasury:     treasury.String(),
 		Owner:        owner.String(),
 		Sender:       sender.String(),
+		Cacheable:    cacheable,
 	}
 }
 
@@ -245,6 +249,9 @@ func (m MsgEditDataSource) ValidateBasic() error {
 	if len(m.Executable) > MaxExecutableSize {
 		return WrapMaxError(ErrTooLargeExecutable, len(m.Executable), MaxExecutableSize)
 	}
+	if _, ok := CacheableOption_name[int32(m.Cacheable)]; !ok {
+		return ErrInvalidCacheableOption.Wrapf("cacheable option: %d", m.Cacheable)
+	}
 	return nil
 }
 
@@ -58,23 +58,24 @@ func TestMsgCreateDataSourceValidation(t *testing.T) {
 				GoodTestAddr,
 				GoodTestAddr,
 				GoodTestAddr,
+				false,
 			),
 		},
 		{
 			false,
-			NewMsgCreateDataSource("name", "desc", []byte("exec"), GoodCoins, EmptyAddr, GoodTestAddr, GoodTestAddr),
+			NewMsgCreateDataSource("name", "desc", []byte("exec"), GoodCoins, EmptyAddr, GoodTestAddr, GoodTestAddr, false),
 		},
 		{
 			false,
-			NewMsgCreateDataSource("name", "desc", []byte("exec"), GoodCoins, GoodTestAddr, EmptyAddr, GoodTestAddr),
+			NewMsgCreateDataSource("name", "desc", []byte("exec"), GoodCoins, GoodTestAddr, EmptyAddr, GoodTestAddr, false),
 		},
 		{
 			false,
-			NewMsgCreateDataSource("name", "desc", []byte("exec"), GoodCoins, GoodTestAddr, GoodTestAddr, EmptyAddr),
+			NewMsgCreateDataSource("name", "desc", []byte("exec"), GoodCoins, GoodTestAddr, GoodTestAddr, EmptyAddr, false),
 		},
 		{
 			false,
-			NewMsgCreateDataSource("name", "desc", []byte("exec"), BadCoins, GoodTestAddr, GoodTestAddr, GoodTestAddr),
+			NewMsgCreateDataSource("name", "desc", []byte("exec"), BadCoins, GoodTestAddr, GoodTestAddr, GoodTestAddr, false),
 		},
 		{
 			false,
@@ -86,6 +87,7 @@ func TestMsgCreateDataSourceValidation(t *testing.T) {
 				GoodTestAddr,
 				GoodTestAddr,
 				GoodTestAddr,
+				false,
 			),
 		},
 		{
@@ -98,9 +100,10 @@ func TestMsgCreateDataSourceValidation(t *testing.T) {
 				GoodTestAddr,
 				GoodTestAddr,
 				GoodTestAddr,
+				false,
 			),
 		},
-		{false, NewMsgCreateDataSource("name", "desc", []byte{}, GoodCoins, GoodTestAddr, GoodTestAddr, GoodTestAddr)},
+		{false, NewMsgCreateDataSource("name", "desc", []byte{}, GoodCoins, GoodTestAddr, GoodTestAddr, GoodTestAddr, false)},
 		{
 			false,
 			NewMsgCreateDataSource(
@@ -111,6 +114,7 @@ func TestMsgCreateDataSourceValidation(t *testing.T) {
 				GoodTestAddr,
 				GoodTestAddr,
 				GoodTestAddr,
+				false,
 			),
 		},
 		{
@@ -123,6 +127,7 @@ func TestMsgCreateDataSourceValidation(t *testing.T) {
 				GoodTestAddr,
 				GoodTestAddr,
 				GoodTestAddr,
+				false,
 			),
 		},
 	})
@@ -141,19 +146,20 @@ func TestMsgEditDataSourceValidation(t *testing.T) {
 				GoodTestAddr,
 				GoodTestAddr,
 				GoodTestAddr,
+				CACHEABLE_OPTION_DO_NOT_MODIFY,
 			),
 		},
 		{
 			false,
-			NewMsgEditDataSource(1, "name", "desc", []byte("exec"), GoodCoins, EmptyAddr, GoodTestAddr, GoodTestAddr),
+			NewMsgEditDataSource(1, "name", "desc", []byte("exec"), GoodCoins, EmptyAddr, GoodTestAddr, GoodTestAddr, CACHEABLE_OPTION_DO_NOT_MODIFY),
 		},
 		{
 			false,
-			NewMsgEditDataSource(1, "name", "desc", []byte("exec"), GoodCoins, GoodTestAddr, EmptyAddr, GoodTestAddr),
+			NewMsgEditDataSource(1, "name", "desc", []byte("exec"), GoodCoins, GoodTestAddr, EmptyAddr, GoodTestAddr, CACHEABLE_OPTION_DO_NOT_MODIFY),
 		},
 		{
 			false,
-			NewMsgEditDataSource(1, "name", "desc", []byte("exec"), GoodCoins, GoodTestAddr, GoodTestAddr, EmptyAddr),
+			NewMsgEditDataSource(1, "name", "desc", []byte("exec"), GoodCoins, GoodTestAddr, GoodTestAddr, EmptyAddr, CACHEABLE_OPTION_DO_NOT_MODIFY),
 		},
 		{
 			false,
@@ -166,6 +172,7 @@ func TestMsgEditDataSourceValidation(t *testing.T) {
 				GoodTestAddr,
 				GoodTestAddr,
 				GoodTestAddr,
+				CACHEABLE_OPTION_DO_NOT_MODIFY,
 			),
 		},
 		{
@@ -179,6 +186,7 @@ func TestMsgEditDataSourceValidation(t *testing.T) {
 				GoodTestAddr,
 				GoodTestAddr,
 				GoodTestAddr,
+				CACHEABLE_OPTION_DO_NOT_MODIFY,
 			),
 		},
 		{
@@ -192,11 +200,12 @@ func TestMsgEditDataSourceValidation(t *testing.T) {
 				GoodTestAddr,
 				GoodTestAddr,
 				GoodTestAddr,
+				CACHEABLE_OPTION_DO_NOT_MODIFY,
 			),
 		},
 		{
 			false,
-			NewMsgEditDataSource(1, "name", "desc", []byte{}, GoodCoins, GoodTestAddr, GoodTestAddr, GoodTestAddr),
+			NewMsgEditDataSource(1, "name", "desc", []byte{}, GoodCoins, GoodTestAddr, GoodTestAddr, GoodTestAddr, CACHEABLE_OPTION_DO_NOT_MODIFY),
 		},
 		{
 			false,
@@ -209,6 +218,35 @@ func TestMsgEditDataSourceValidation(t *testing.T) {
 				GoodTestAddr,
 				GoodTestAddr,
 				GoodTestAddr,
+				CACHEABLE_OPTION_DO_NOT_MODIFY,
+			),
+		},
+		{
+			true,
+			NewMsgEditDataSource(
+				1,
+				"name",
+				"desc",
+				[]byte("exec"),
+				GoodCoins,
+				GoodTestAddr,
+				GoodTestAddr,
+				GoodTestAddr,
+				CACHEABLE_OPTION_ENABLE,
+			),
+		},
+		{
+			false,
+			NewMsgEditDataSource(
+				1,
+				"name",
+				"desc",
+				[]byte("exec"),
+				GoodCoins,
+				GoodTestAddr,
+				GoodTestAddr,
+				GoodTestAddr,
+				CacheableOption(3),
 			),
 		},
 	})
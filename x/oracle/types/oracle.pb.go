@@ -68,6 +68,38 @@ func (ResolveStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9714783eaff1514b, []int{0}
 }
 
+// CacheableOption is the option to update whether a data source is cacheable.
+type CacheableOption int32
+
+const (
+	// CACHEABLE_OPTION_DO_NOT_MODIFY keeps whether the data source is cacheable.
+	CACHEABLE_OPTION_DO_NOT_MODIFY CacheableOption = 0
+	// CACHEABLE_OPTION_ENABLE makes the data source cacheable.
+	CACHEABLE_OPTION_ENABLE CacheableOption = 1
+	// CACHEABLE_OPTION_DISABLE makes the data source non-cacheable.
+	CACHEABLE_OPTION_DISABLE CacheableOption = 2
+)
+
+var CacheableOption_name = map[int32]string{
+	0: "CACHEABLE_OPTION_DO_NOT_MODIFY",
+	1: "CACHEABLE_OPTION_ENABLE",
+	2: "CACHEABLE_OPTION_DISABLE",
+}
+
+var CacheableOption_value = map[string]int32{
+	"CACHEABLE_OPTION_DO_NOT_MODIFY": 0,
+	"CACHEABLE_OPTION_ENABLE":        1,
+	"CACHEABLE_OPTION_DISABLE":       2,
+}
+
+func (x CacheableOption) String() string {
+	return proto.EnumName(CacheableOption_name, int32(x))
+}
+
+func (CacheableOption) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{1}
+}
+
 // Encoder is an enumerator that defines the mode of encoding message in tss module.
 type Encoder int32
 
@@ -101,7 +133,7 @@ func (x Encoder) String() string {
 }
 
 func (Encoder) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{1}
+	return fileDescriptor_9714783eaff1514b, []int{2}
 }
 
 // DataSource is the data structure for storing data sources in the storage.
@@ -120,6 +152,12 @@ type DataSource struct {
 	// Fee is the data source fee per ask_count that data provider will receive
 	// from requester.
 	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// Cacheable indicates that validators may reuse a recent result of the data
+	// source for the same calldata instead of executing it for every raw request.
+	Cacheable bool `protobuf:"varint,7,opt,name=cacheable,proto3" json:"cacheable,omitempty"`
+	// Version is the latest version of the data source. It starts at 1 and is
+	// incremented whenever the executable of the data source is changed.
+	Version uint64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *DataSource) Reset()         { *m = DataSource{} }
@@ -197,6 +235,86 @@ func (m *DataSource) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *DataSource) GetCacheable() bool {
+	if m != nil {
+		return m.Cacheable
+	}
+	return false
+}
+
+func (m *DataSource) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// DataSourceVersion is an immutable snapshot of the executable of a data
+// source at a given version.
+type DataSourceVersion struct {
+	// DataSourceID is the ID of the data source
+	DataSourceID DataSourceID `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3,casttype=DataSourceID" json:"data_source_id,omitempty"`
+	// Version is the version of the data source
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is string of file name used as reference for locating
+	// data source file stored in bandchain nodes
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+}
+
+func (m *DataSourceVersion) Reset()         { *m = DataSourceVersion{} }
+func (m *DataSourceVersion) String() string { return proto.CompactTextString(m) }
+func (*DataSourceVersion) ProtoMessage()    {}
+func (*DataSourceVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{1}
+}
+func (m *DataSourceVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSourceVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSourceVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSourceVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSourceVersion.Merge(m, src)
+}
+func (m *DataSourceVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSourceVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSourceVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSourceVersion proto.InternalMessageInfo
+
+func (m *DataSourceVersion) GetDataSourceID() DataSourceID {
+	if m != nil {
+		return m.DataSourceID
+	}
+	return 0
+}
+
+func (m *DataSourceVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *DataSourceVersion) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
 // OracleScript is the data structure for storing oracle scripts in the storage.
 type OracleScript struct {
 	// Owner is an address of the account who own the oracle script
@@ -216,13 +334,17 @@ type OracleScript struct {
 	// It is recommendded to store source code on IPFS and get its URL to preserve
 	// decentralization.
 	SourceCodeURL string `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// Version is the latest version of the oracle script. It starts at 1 and is
+	// incremented whenever the Wasm code or the schema of the oracle script is
+	// changed.
+	Version uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *OracleScript) Reset()         { *m = OracleScript{} }
 func (m *OracleScript) String() string { return proto.CompactTextString(m) }
 func (*OracleScript) ProtoMessage()    {}
 func (*OracleScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{1}
+	return fileDescriptor_9714783eaff1514b, []int{2}
 }
 func (m *OracleScript) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,6 +415,88 @@ func (m *OracleScript) GetSourceCodeURL() string {
 	return ""
 }
 
+func (m *OracleScript) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// OracleScriptVersion is an immutable snapshot of the Wasm code and the schema
+// of an oracle script at a given version.
+type OracleScriptVersion struct {
+	// OracleScriptID is the ID of the oracle script
+	OracleScriptID OracleScriptID `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Version is the version of the oracle script
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is string of file name used as reference for locating
+	// compiled oracle script WASM file stored in bandchain nodes
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Schema is the schema of the oracle script input/output
+	Schema string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *OracleScriptVersion) Reset()         { *m = OracleScriptVersion{} }
+func (m *OracleScriptVersion) String() string { return proto.CompactTextString(m) }
+func (*OracleScriptVersion) ProtoMessage()    {}
+func (*OracleScriptVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{3}
+}
+func (m *OracleScriptVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleScriptVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleScriptVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleScriptVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleScriptVersion.Merge(m, src)
+}
+func (m *OracleScriptVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleScriptVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleScriptVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleScriptVersion proto.InternalMessageInfo
+
+func (m *OracleScriptVersion) GetOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *OracleScriptVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *OracleScriptVersion) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *OracleScriptVersion) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
 // RawRequest is the data structure for storing raw requests in the storage.
 type RawRequest struct {
 	// ExternalID is an ID of the raw request
@@ -302,13 +506,17 @@ type RawRequest struct {
 	// Calldata is the data used as argument params for executing data source
 	// script
 	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// DataSourceVersion is the version of the data source at the time of the
+	// request, which validators must execute. It is 0 for the requests made
+	// before data sources are versioned.
+	DataSourceVersion uint64 `protobuf:"varint,4,opt,name=data_source_version,json=dataSourceVersion,proto3" json:"data_source_version,omitempty"`
 }
 
 func (m *RawRequest) Reset()         { *m = RawRequest{} }
 func (m *RawRequest) String() string { return proto.CompactTextString(m) }
 func (*RawRequest) ProtoMessage()    {}
 func (*RawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{2}
+	return fileDescriptor_9714783eaff1514b, []int{4}
 }
 func (m *RawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,6 +566,13 @@ func (m *RawRequest) GetCalldata() []byte {
 	return nil
 }
 
+func (m *RawRequest) GetDataSourceVersion() uint64 {
+	if m != nil {
+		return m.DataSourceVersion
+	}
+	return 0
+}
+
 // RawRequest is the data structure for storing raw reporter in the storage.
 type RawReport struct {
 	// ExternalID is an ID of the raw request
@@ -376,7 +591,7 @@ func (m *RawReport) Reset()         { *m = RawReport{} }
 func (m *RawReport) String() string { return proto.CompactTextString(m) }
 func (*RawReport) ProtoMessage()    {}
 func (*RawReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{3}
+	return fileDescriptor_9714783eaff1514b, []int{5}
 }
 func (m *RawReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,13 +675,23 @@ type Request struct {
 	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
 	// FeeLimit is the maximum tokens that will be paid for this request.
 	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// OracleScriptVersion is the version of the oracle script used by the
+	// request. It is 0 for the requests made before oracle scripts are
+	// versioned.
+	OracleScriptVersion uint64 `protobuf:"varint,14,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+	// CallbackModule is the name of the module to be called back when the
+	// request is resolved or expired, or empty if there is none.
+	CallbackModule string `protobuf:"bytes,15,opt,name=callback_module,json=callbackModule,proto3" json:"callback_module,omitempty"`
+	// CallbackGasLimit is the maximum amount of gas the callback of the request
+	// can consume.
+	CallbackGasLimit uint64 `protobuf:"varint,16,opt,name=callback_gas_limit,json=callbackGasLimit,proto3" json:"callback_gas_limit,omitempty"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{4}
+	return fileDescriptor_9714783eaff1514b, []int{6}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,6 +811,27 @@ func (m *Request) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Request) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
+}
+
+func (m *Request) GetCallbackModule() string {
+	if m != nil {
+		return m.CallbackModule
+	}
+	return ""
+}
+
+func (m *Request) GetCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.CallbackGasLimit
+	}
+	return 0
+}
+
 // Report is the data structure for storing reports in the storage.
 type Report struct {
 	// Validator is a validator address who submit the report
@@ -602,7 +848,7 @@ func (m *Report) Reset()         { *m = Report{} }
 func (m *Report) String() string { return proto.CompactTextString(m) }
 func (*Report) ProtoMessage()    {}
 func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{5}
+	return fileDescriptor_9714783eaff1514b, []int{7}
 }
 func (m *Report) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +933,7 @@ func (m *OracleRequestPacketData) Reset()         { *m = OracleRequestPacketData
 func (m *OracleRequestPacketData) String() string { return proto.CompactTextString(m) }
 func (*OracleRequestPacketData) ProtoMessage()    {}
 func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{6}
+	return fileDescriptor_9714783eaff1514b, []int{8}
 }
 func (m *OracleRequestPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,7 +1036,7 @@ func (m *OracleRequestPacketAcknowledgement) Reset()         { *m = OracleReques
 func (m *OracleRequestPacketAcknowledgement) String() string { return proto.CompactTextString(m) }
 func (*OracleRequestPacketAcknowledgement) ProtoMessage()    {}
 func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{7}
+	return fileDescriptor_9714783eaff1514b, []int{9}
 }
 func (m *OracleRequestPacketAcknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +1102,7 @@ func (m *OracleResponsePacketData) Reset()         { *m = OracleResponsePacketDa
 func (m *OracleResponsePacketData) String() string { return proto.CompactTextString(m) }
 func (*OracleResponsePacketData) ProtoMessage()    {}
 func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{8}
+	return fileDescriptor_9714783eaff1514b, []int{10}
 }
 func (m *OracleResponsePacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1221,7 @@ func (m *Result) Reset()         { *m = Result{} }
 func (m *Result) String() string { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()    {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{9}
+	return fileDescriptor_9714783eaff1514b, []int{11}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1341,7 @@ func (m *SigningResult) Reset()         { *m = SigningResult{} }
 func (m *SigningResult) String() string { return proto.CompactTextString(m) }
 func (*SigningResult) ProtoMessage()    {}
 func (*SigningResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{10}
+	return fileDescriptor_9714783eaff1514b, []int{12}
 }
 func (m *SigningResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1405,7 @@ func (m *ValidatorStatus) Reset()         { *m = ValidatorStatus{} }
 func (m *ValidatorStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidatorStatus) ProtoMessage()    {}
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{11}
+	return fileDescriptor_9714783eaff1514b, []int{13}
 }
 func (m *ValidatorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1214,7 +1460,7 @@ func (m *ActiveValidator) Reset()         { *m = ActiveValidator{} }
 func (m *ActiveValidator) String() string { return proto.CompactTextString(m) }
 func (*ActiveValidator) ProtoMessage()    {}
 func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{12}
+	return fileDescriptor_9714783eaff1514b, []int{14}
 }
 func (m *ActiveValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1290,13 +1536,20 @@ type Params struct {
 	// IBCRequestEnabled is a flag indicating whether sending oracle request via
 	// IBC is allowed
 	IBCRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// CallbackGasPrice is the price per unit of gas of the callbacks of the
+	// requests made by other modules, which is paid from the fee limit of the
+	// requests.
+	CallbackGasPrice github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,12,rep,name=callback_gas_price,json=callbackGasPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"callback_gas_price"`
+	// MaxCallbackGasLimit is the maximum amount of gas the callback of a request
+	// can be given.
+	MaxCallbackGasLimit uint64 `protobuf:"varint,13,opt,name=max_callback_gas_limit,json=maxCallbackGasLimit,proto3" json:"max_callback_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{13}
+	return fileDescriptor_9714783eaff1514b, []int{15}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,6 +1655,20 @@ func (m *Params) GetIBCRequestEnabled() bool {
 	return false
 }
 
+func (m *Params) GetCallbackGasPrice() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CallbackGasPrice
+	}
+	return nil
+}
+
+func (m *Params) GetMaxCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.MaxCallbackGasLimit
+	}
+	return 0
+}
+
 // PendingResolveList is a list of requests that are waiting to be resolved
 type PendingResolveList struct {
 	// RequestIDs is a list of request IDs that are waiting to be resolved
@@ -1412,7 +1679,7 @@ func (m *PendingResolveList) Reset()         { *m = PendingResolveList{} }
 func (m *PendingResolveList) String() string { return proto.CompactTextString(m) }
 func (*PendingResolveList) ProtoMessage()    {}
 func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{14}
+	return fileDescriptor_9714783eaff1514b, []int{16}
 }
 func (m *PendingResolveList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1463,7 +1730,7 @@ func (m *IBCChannel) Reset()         { *m = IBCChannel{} }
 func (m *IBCChannel) String() string { return proto.CompactTextString(m) }
 func (*IBCChannel) ProtoMessage()    {}
 func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{15}
+	return fileDescriptor_9714783eaff1514b, []int{17}
 }
 func (m *IBCChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1525,7 +1792,7 @@ func (m *RequestVerification) Reset()         { *m = RequestVerification{} }
 func (m *RequestVerification) String() string { return proto.CompactTextString(m) }
 func (*RequestVerification) ProtoMessage()    {}
 func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{16}
+	return fileDescriptor_9714783eaff1514b, []int{18}
 }
 func (m *RequestVerification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1610,7 +1877,7 @@ func (m *PriceResult) Reset()         { *m = PriceResult{} }
 func (m *PriceResult) String() string { return proto.CompactTextString(m) }
 func (*PriceResult) ProtoMessage()    {}
 func (*PriceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{17}
+	return fileDescriptor_9714783eaff1514b, []int{19}
 }
 func (m *PriceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1686,7 +1953,7 @@ func (m *OracleResultSignatureOrder) Reset()         { *m = OracleResultSignatur
 func (m *OracleResultSignatureOrder) String() string { return proto.CompactTextString(m) }
 func (*OracleResultSignatureOrder) ProtoMessage()    {}
 func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{18}
+	return fileDescriptor_9714783eaff1514b, []int{20}
 }
 func (m *OracleResultSignatureOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1717,9 +1984,12 @@ var xxx_messageInfo_OracleResultSignatureOrder proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("band.oracle.v1.ResolveStatus", ResolveStatus_name, ResolveStatus_value)
+	proto.RegisterEnum("band.oracle.v1.CacheableOption", CacheableOption_name, CacheableOption_value)
 	proto.RegisterEnum("band.oracle.v1.Encoder", Encoder_name, Encoder_value)
 	proto.RegisterType((*DataSource)(nil), "band.oracle.v1.DataSource")
+	proto.RegisterType((*DataSourceVersion)(nil), "band.oracle.v1.DataSourceVersion")
 	proto.RegisterType((*OracleScript)(nil), "band.oracle.v1.OracleScript")
+	proto.RegisterType((*OracleScriptVersion)(nil), "band.oracle.v1.OracleScriptVersion")
 	proto.RegisterType((*RawRequest)(nil), "band.oracle.v1.RawRequest")
 	proto.RegisterType((*RawReport)(nil), "band.oracle.v1.RawReport")
 	proto.RegisterType((*Request)(nil), "band.oracle.v1.Request")
@@ -1742,130 +2012,147 @@ func init() {
 func init() { proto.RegisterFile("band/oracle/v1/oracle.proto", fileDescriptor_9714783eaff1514b) }
 
 var fileDescriptor_9714783eaff1514b = []byte{
-	// 1965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xdb, 0x6f, 0x63, 0x47,
-	0x19, 0xcf, 0xb1, 0x9d, 0xc4, 0xfe, 0xec, 0x38, 0xc9, 0x6c, 0xba, 0xf1, 0x7a, 0xb7, 0x71, 0x88,
-	0x0a, 0x2c, 0x2b, 0xb0, 0xc9, 0x16, 0x21, 0x76, 0x01, 0x89, 0xf8, 0xb2, 0xd4, 0x34, 0xda, 0x58,
-	0xe3, 0x64, 0x85, 0x90, 0xd0, 0xd1, 0xf8, 0x9c, 0x89, 0x33, 0xcd, 0xb9, 0x31, 0x73, 0x9c, 0x38,
-	0x7d, 0xe3, 0xad, 0xea, 0xd3, 0x3e, 0x23, 0x55, 0xaa, 0xd4, 0x37, 0x5e, 0x11, 0xff, 0x01, 0x88,
-	0xf2, 0x44, 0x1f, 0x91, 0x90, 0x52, 0xe4, 0x95, 0x10, 0xff, 0x00, 0x2f, 0xf0, 0x82, 0xe6, 0x72,
-	0x7c, 0xab, 0xe9, 0x76, 0x77, 0x0b, 0x0f, 0x3c, 0xf9, 0x7c, 0x97, 0x99, 0xf9, 0x6e, 0xbf, 0x6f,
-	0xbe, 0x31, 0xdc, 0xee, 0x91, 0xc0, 0xad, 0x85, 0x9c, 0x38, 0x1e, 0xad, 0x5d, 0xec, 0x9b, 0xaf,
-	0x6a, 0xc4, 0xc3, 0x38, 0x44, 0x45, 0x29, 0xac, 0x1a, 0xd6, 0xc5, 0x7e, 0x79, 0xab, 0x1f, 0xf6,
-	0x43, 0x25, 0xaa, 0xc9, 0x2f, 0xad, 0x55, 0xae, 0xf4, 0xc3, 0xb0, 0xef, 0xd1, 0x9a, 0xa2, 0x7a,
-	0x83, 0xd3, 0x5a, 0xcc, 0x7c, 0x2a, 0x62, 0xe2, 0x47, 0x46, 0x61, 0xc7, 0x09, 0x85, 0x1f, 0x8a,
-	0x5a, 0x8f, 0x08, 0x79, 0x46, 0x8f, 0xc6, 0x64, 0xbf, 0xe6, 0x84, 0x2c, 0xd0, 0xf2, 0xbd, 0x7f,
-	0x58, 0x00, 0x4d, 0x12, 0x93, 0x6e, 0x38, 0xe0, 0x0e, 0x45, 0x5b, 0xb0, 0x1c, 0x5e, 0x06, 0x94,
-	0x97, 0xac, 0x5d, 0xeb, 0x6e, 0x0e, 0x6b, 0x02, 0x21, 0xc8, 0x04, 0xc4, 0xa7, 0xa5, 0x94, 0x62,
-	0xaa, 0x6f, 0xb4, 0x0b, 0x79, 0x97, 0x0a, 0x87, 0xb3, 0x28, 0x66, 0x61, 0x50, 0x4a, 0x2b, 0xd1,
-	0x34, 0x0b, 0x95, 0x21, 0x7b, 0xca, 0x3c, 0xaa, 0x56, 0x66, 0x94, 0x78, 0x4c, 0x4b, 0x59, 0xcc,
-	0x29, 0x11, 0x03, 0x7e, 0x55, 0x5a, 0xd6, 0xb2, 0x84, 0x46, 0x3f, 0x87, 0xf4, 0x29, 0xa5, 0xa5,
-	0x95, 0xdd, 0xf4, 0xdd, 0xfc, 0xfd, 0x5b, 0x55, 0xed, 0x40, 0x55, 0x3a, 0x50, 0x35, 0x0e, 0x54,
-	0x1b, 0x21, 0x0b, 0xea, 0xdf, 0xfe, 0xf8, 0xba, 0xb2, 0xf4, 0xeb, 0x4f, 0x2b, 0x77, 0xfb, 0x2c,
-	0x3e, 0x1b, 0xf4, 0xaa, 0x4e, 0xe8, 0xd7, 0x8c, 0xb7, 0xfa, 0xe7, 0x5b, 0xc2, 0x3d, 0xaf, 0xc5,
-	0x57, 0x11, 0x15, 0x6a, 0x81, 0xc0, 0x72, 0xdf, 0x87, 0x99, 0xbf, 0x7f, 0x58, 0xb1, 0xf6, 0xfe,
-	0x64, 0x41, 0xe1, 0x48, 0x05, 0xb7, 0xab, 0x0c, 0xfe, 0x9f, 0x79, 0x7e, 0x13, 0x56, 0x84, 0x73,
-	0x46, 0x7d, 0x62, 0xfc, 0x36, 0x14, 0x7a, 0x00, 0xeb, 0x42, 0xe5, 0xc0, 0x76, 0x42, 0x97, 0xda,
-	0x03, 0xee, 0x95, 0x56, 0xa4, 0x42, 0x7d, 0x73, 0x74, 0x5d, 0x59, 0xd3, 0xe9, 0x69, 0x84, 0x2e,
-	0x3d, 0xc1, 0x87, 0x78, 0x4d, 0x4c, 0x48, 0xee, 0x19, 0x8f, 0x7e, 0x6b, 0x01, 0x60, 0x72, 0x89,
-	0xe9, 0x2f, 0x06, 0x54, 0xc4, 0xe8, 0x87, 0x90, 0xa7, 0xc3, 0x98, 0xf2, 0x80, 0x78, 0x36, 0x73,
-	0x95, 0x57, 0x99, 0xfa, 0x9d, 0xd1, 0x75, 0x05, 0x5a, 0x86, 0xdd, 0x6e, 0xfe, 0x73, 0x86, 0xc2,
-	0x90, 0x2c, 0x68, 0xbb, 0xe8, 0x11, 0x14, 0x5d, 0x12, 0x13, 0xdb, 0xd8, 0xc4, 0x5c, 0x15, 0x82,
-	0x4c, 0x7d, 0x77, 0x74, 0x5d, 0x29, 0x4c, 0x0a, 0x46, 0xed, 0x31, 0x43, 0xe3, 0x82, 0x3b, 0xa1,
-	0x5c, 0x19, 0x0a, 0x87, 0x78, 0x9e, 0xe4, 0xa9, 0x48, 0x15, 0xf0, 0x98, 0x36, 0x76, 0xff, 0xd2,
-	0x82, 0x9c, 0xb2, 0x3b, 0x0a, 0xf9, 0x2b, 0x9b, 0x7d, 0x1b, 0x72, 0x74, 0xc8, 0x62, 0x15, 0x43,
-	0x65, 0xf1, 0x1a, 0xce, 0x4a, 0x86, 0x0c, 0x95, 0x4c, 0xe6, 0x94, 0x1d, 0x99, 0x29, 0x1b, 0x7e,
-	0xbf, 0x0c, 0xab, 0x49, 0xe0, 0x1e, 0xc3, 0x86, 0x46, 0x9d, 0xad, 0x13, 0x3a, 0x31, 0xe3, 0x8d,
-	0xd1, 0x75, 0xa5, 0x38, 0x5d, 0x34, 0xca, 0x94, 0x39, 0x0e, 0x2e, 0x86, 0xd3, 0xf4, 0x6c, 0x04,
-	0x52, 0xb3, 0x11, 0x40, 0xfb, 0xb0, 0xc5, 0xf5, 0xb1, 0xd4, 0xb5, 0x2f, 0x88, 0xc7, 0x5c, 0x12,
-	0x87, 0x5c, 0x94, 0xd2, 0xbb, 0xe9, 0xbb, 0x39, 0x7c, 0x63, 0x2c, 0x7b, 0x32, 0x16, 0x49, 0x0f,
-	0x7d, 0x16, 0xd8, 0x4e, 0x38, 0x08, 0x62, 0x55, 0x5c, 0x19, 0x9c, 0xf5, 0x59, 0xd0, 0x90, 0x34,
-	0xfa, 0x2a, 0x14, 0xcd, 0x1a, 0xfb, 0x8c, 0xb2, 0xfe, 0x59, 0xac, 0x8a, 0x2c, 0x8d, 0xd7, 0x0c,
-	0xf7, 0x2d, 0xc5, 0x44, 0x5f, 0x81, 0x42, 0xa2, 0x26, 0xfb, 0x85, 0x2a, 0xb4, 0x34, 0xce, 0x1b,
-	0xde, 0x31, 0xf3, 0x29, 0xfa, 0x06, 0xe4, 0x1c, 0x8f, 0xd1, 0x40, 0xb9, 0xbf, 0xaa, 0x0a, 0xb1,
-	0x30, 0xba, 0xae, 0x64, 0x1b, 0x8a, 0xd9, 0x6e, 0xe2, 0xac, 0x16, 0xb7, 0x5d, 0xd4, 0x80, 0x02,
-	0x27, 0x97, 0xb6, 0x59, 0x2d, 0x4a, 0x59, 0x05, 0xdc, 0x72, 0x75, 0xb6, 0x81, 0x55, 0x27, 0xb5,
-	0x59, 0xcf, 0x48, 0xe4, 0xe2, 0x3c, 0x1f, 0x73, 0x04, 0x7a, 0x1b, 0xf2, 0xac, 0xe7, 0xd8, 0xce,
-	0x19, 0x09, 0x02, 0xea, 0x95, 0x72, 0xbb, 0xd6, 0xa2, 0x3d, 0xda, 0xf5, 0x46, 0x43, 0x6b, 0xd4,
-	0x8b, 0xb2, 0x26, 0x26, 0x34, 0x06, 0xd6, 0x73, 0xcc, 0x37, 0xaa, 0xc8, 0x22, 0xa2, 0xce, 0x20,
-	0xa6, 0x76, 0x9f, 0x88, 0x12, 0xa8, 0x28, 0x81, 0x61, 0xfd, 0x98, 0x08, 0xf4, 0x16, 0xe4, 0x63,
-	0x21, 0x6c, 0x1a, 0xc8, 0x3a, 0xe1, 0xa5, 0xfc, 0xae, 0x75, 0xb7, 0x78, 0x7f, 0x7b, 0xfe, 0xb4,
-	0x96, 0x16, 0xeb, 0xa3, 0x8e, 0xbb, 0x5d, 0x43, 0x63, 0x88, 0x85, 0x30, 0xdf, 0xe8, 0x0e, 0xe4,
-	0x92, 0x2c, 0xf1, 0x52, 0x41, 0x21, 0x7a, 0xc2, 0x40, 0x67, 0x90, 0x3b, 0xa5, 0xd4, 0xf6, 0x98,
-	0xcf, 0xe2, 0xd2, 0xda, 0x97, 0xdf, 0xd0, 0xb2, 0xa7, 0x94, 0x1e, 0xca, 0xcd, 0x4d, 0x1d, 0xff,
-	0xca, 0x82, 0x15, 0x03, 0xa4, 0x3b, 0x90, 0x1b, 0x17, 0x94, 0xe9, 0x69, 0x13, 0x06, 0xba, 0x07,
-	0x9b, 0x2c, 0xb0, 0x7b, 0xf4, 0x34, 0xe4, 0xd4, 0xe6, 0x54, 0x84, 0xde, 0x85, 0xc6, 0x4b, 0x16,
-	0xaf, 0xb3, 0xa0, 0xae, 0xf8, 0x58, 0xb3, 0xd1, 0x8f, 0x20, 0xaf, 0xf3, 0x2b, 0xf7, 0xd5, 0xb5,
-	0x29, 0xdd, 0x58, 0x94, 0x5e, 0xa9, 0x61, 0xb2, 0x0b, 0x3c, 0x61, 0x08, 0x63, 0xdc, 0xdf, 0xd2,
-	0xb0, 0xad, 0xb1, 0x62, 0xb2, 0xde, 0x21, 0xce, 0x39, 0x8d, 0x65, 0xf3, 0x98, 0x2d, 0x37, 0xeb,
-	0x73, 0xcb, 0x6d, 0x11, 0x3e, 0x53, 0x5f, 0x12, 0x3e, 0xe7, 0x3a, 0x94, 0x04, 0x1b, 0x11, 0xe7,
-	0xb3, 0x60, 0x23, 0xe2, 0x5c, 0x83, 0x6d, 0x06, 0x89, 0xcb, 0x73, 0x48, 0x9c, 0xc9, 0xfc, 0xca,
-	0x7f, 0x31, 0xf3, 0xb2, 0xd8, 0x23, 0x4e, 0x23, 0xc2, 0x75, 0xb1, 0xaf, 0xea, 0x62, 0x37, 0x2c,
-	0x59, 0xec, 0x73, 0x68, 0xc8, 0x3e, 0x0f, 0x0d, 0xb9, 0x97, 0x46, 0x83, 0x49, 0x34, 0x85, 0xbd,
-	0x05, 0x79, 0x3e, 0x70, 0xce, 0x83, 0xf0, 0xd2, 0xa3, 0x6e, 0x9f, 0xfa, 0x34, 0x88, 0xd1, 0x03,
-	0x80, 0xa4, 0x09, 0x8d, 0x3b, 0x6c, 0x79, 0x74, 0x5d, 0xc9, 0x99, 0x55, 0x2a, 0x79, 0x13, 0x62,
-	0x0c, 0xab, 0xb6, 0x6b, 0x8e, 0xf9, 0x43, 0x0a, 0x4a, 0xc9, 0x39, 0x22, 0x0a, 0x03, 0x41, 0x5f,
-	0xae, 0xa0, 0x66, 0x0d, 0x49, 0xbd, 0x80, 0x21, 0xaa, 0x3e, 0x02, 0x61, 0x4a, 0x20, 0x6d, 0xea,
-	0x23, 0x10, 0xba, 0x04, 0xe6, 0xbb, 0x6c, 0xe6, 0xb3, 0x5d, 0x56, 0xa9, 0x28, 0x94, 0x69, 0x95,
-	0xe5, 0x44, 0x45, 0xf1, 0x94, 0x4a, 0x53, 0xb6, 0x74, 0xad, 0x22, 0x62, 0x12, 0x0f, 0x84, 0xea,
-	0xd6, 0xc5, 0xfb, 0xaf, 0x7f, 0x06, 0x80, 0x5a, 0xab, 0xab, 0x94, 0x64, 0xc7, 0x9f, 0x22, 0xe5,
-	0xd4, 0xc1, 0xa9, 0x18, 0x78, 0xb1, 0xaa, 0x8f, 0x02, 0x36, 0x94, 0x89, 0xe4, 0x5f, 0xd2, 0xb2,
-	0x6d, 0x48, 0xc6, 0xff, 0x1f, 0x10, 0x67, 0xb3, 0xbb, 0xf2, 0xd2, 0xd9, 0x5d, 0x7d, 0x4e, 0x76,
-	0xb3, 0xcf, 0xcf, 0x6e, 0xee, 0x8b, 0x64, 0x17, 0x5e, 0x29, 0xbb, 0xf9, 0x05, 0xd9, 0xfd, 0xa3,
-	0x05, 0x6b, 0x5d, 0xd6, 0x0f, 0x58, 0xd0, 0x37, 0x49, 0x7e, 0x07, 0x40, 0x68, 0xc6, 0x04, 0x7a,
-	0x6f, 0xcb, 0x98, 0x18, 0x35, 0x15, 0x93, 0x87, 0x53, 0xbd, 0x48, 0x1a, 0xa3, 0xde, 0x0b, 0x4e,
-	0xe8, 0xd5, 0x9c, 0x33, 0xc2, 0x82, 0xda, 0xc5, 0x9b, 0xb5, 0xa1, 0xe2, 0xc7, 0x42, 0x98, 0xce,
-	0x34, 0x5e, 0x8d, 0x73, 0x66, 0xfb, 0xb6, 0x8b, 0xbe, 0x0e, 0xeb, 0x94, 0xf3, 0x90, 0xab, 0x91,
-	0x4c, 0x44, 0xc4, 0x49, 0x86, 0xe9, 0xa2, 0x62, 0x37, 0x12, 0x2e, 0x7a, 0x1d, 0x60, 0xa2, 0x68,
-	0xc0, 0x94, 0x1b, 0xeb, 0x18, 0x5f, 0x22, 0x58, 0x1f, 0xcf, 0x42, 0xc6, 0xf9, 0xdb, 0x90, 0x63,
-	0xc2, 0x26, 0x4e, 0xcc, 0x2e, 0xa8, 0xf2, 0x25, 0x8b, 0xb3, 0x4c, 0x1c, 0x28, 0x1a, 0x3d, 0x84,
-	0x65, 0xc1, 0x02, 0x73, 0xa6, 0x1c, 0x28, 0xf4, 0x7b, 0xa9, 0x9a, 0xbc, 0x97, 0xaa, 0xc7, 0xc9,
-	0x7b, 0xa9, 0x9e, 0x95, 0x3d, 0xf8, 0xe9, 0xa7, 0x15, 0x0b, 0xeb, 0x25, 0xe6, 0xc4, 0x03, 0x58,
-	0xd7, 0x7b, 0x8d, 0xcf, 0x45, 0x25, 0x58, 0x25, 0xae, 0xcb, 0xa9, 0x10, 0xe6, 0x62, 0x4d, 0x48,
-	0xf9, 0x88, 0x88, 0xc2, 0x4b, 0xca, 0x35, 0x0e, 0xb0, 0x26, 0xf6, 0x7e, 0x97, 0x81, 0x95, 0x0e,
-	0xe1, 0xc4, 0x17, 0x68, 0x1f, 0x5e, 0xf3, 0xc9, 0xd0, 0x9e, 0x9a, 0x97, 0x4c, 0x79, 0xa9, 0x24,
-	0x60, 0xe4, 0x93, 0xe1, 0x64, 0x4e, 0xd2, 0x85, 0xb6, 0x07, 0x6b, 0x72, 0xc9, 0xa4, 0xfc, 0xf5,
-	0xde, 0x79, 0x9f, 0x0c, 0x0f, 0x12, 0x04, 0xdc, 0x83, 0x4d, 0xa9, 0x93, 0xc0, 0xc5, 0x16, 0xec,
-	0xdd, 0x24, 0x84, 0xeb, 0x3e, 0x19, 0x36, 0x0c, 0xbf, 0xcb, 0xde, 0xa5, 0xa8, 0x06, 0x5b, 0xca,
-	0x04, 0x75, 0x37, 0xdb, 0x13, 0x75, 0x8d, 0x2a, 0xb9, 0x8f, 0xbe, 0xb6, 0x9b, 0xc9, 0x82, 0xef,
-	0xc0, 0x4d, 0x3a, 0x8c, 0x18, 0x27, 0xf2, 0x6d, 0x63, 0xf7, 0xbc, 0xd0, 0x39, 0x9f, 0xc1, 0xda,
-	0xd6, 0x44, 0x5a, 0x97, 0x42, 0x6d, 0xd2, 0x1b, 0x50, 0x94, 0xf7, 0x9c, 0x1d, 0x5e, 0x12, 0xe1,
-	0xab, 0x8b, 0x47, 0x61, 0x0f, 0x17, 0x24, 0xf7, 0x48, 0x32, 0xe5, 0xd5, 0xf3, 0x00, 0x6e, 0x45,
-	0x94, 0x4f, 0x46, 0xdf, 0x71, 0x54, 0x26, 0x57, 0xd9, 0xcd, 0x88, 0xf2, 0x71, 0xec, 0x4d, 0x64,
-	0xe4, 0xd2, 0x6f, 0x02, 0x12, 0xc4, 0x8f, 0x3c, 0x59, 0xc5, 0x31, 0xbf, 0x32, 0x26, 0xe9, 0xdb,
-	0x6d, 0x23, 0x91, 0x1c, 0xf3, 0x2b, 0x6d, 0xce, 0xf7, 0xa0, 0x64, 0x9a, 0x15, 0xa7, 0x97, 0x84,
-	0xbb, 0x76, 0x44, 0xb9, 0x43, 0x83, 0x98, 0xf4, 0x35, 0x2e, 0x33, 0xf8, 0x66, 0x68, 0xee, 0x12,
-	0x29, 0xee, 0x8c, 0xa5, 0xe8, 0x21, 0xdc, 0x62, 0x81, 0x2e, 0x2f, 0x3b, 0xa2, 0x01, 0xf1, 0xe2,
-	0x2b, 0xdb, 0x1d, 0x68, 0x7f, 0xcd, 0x68, 0xb9, 0x9d, 0x28, 0x74, 0xb4, 0xbc, 0x69, 0xc4, 0xa8,
-	0x05, 0x37, 0xe4, 0x54, 0x9b, 0x38, 0x45, 0x03, 0xd2, 0xf3, 0xa8, 0xab, 0x50, 0x9a, 0xad, 0xbf,
-	0x36, 0xba, 0xae, 0x6c, 0xb6, 0xeb, 0x0d, 0xe3, 0x53, 0x4b, 0x0b, 0xf1, 0x26, 0xeb, 0x39, 0xb3,
-	0x2c, 0x53, 0x89, 0xdf, 0x07, 0xd4, 0xa1, 0x81, 0xab, 0x61, 0x2c, 0xd1, 0x7f, 0xc8, 0x84, 0xba,
-	0xfe, 0x27, 0xfd, 0x4d, 0x16, 0x64, 0x5a, 0xde, 0xee, 0xe3, 0x26, 0x96, 0x0c, 0x5f, 0x3f, 0x81,
-	0xa9, 0x61, 0x19, 0x6d, 0xc3, 0xaa, 0xca, 0x7e, 0xd2, 0xe3, 0xf1, 0x8a, 0x24, 0xdb, 0xae, 0x04,
-	0xa1, 0x19, 0xc1, 0x93, 0x6e, 0x9e, 0xc3, 0x39, 0xc3, 0x19, 0x5f, 0xbc, 0x1f, 0xa5, 0xe0, 0x86,
-	0xb1, 0xf0, 0x09, 0xe5, 0xec, 0x94, 0x39, 0xda, 0xdb, 0xaf, 0x41, 0x56, 0xf5, 0x86, 0xc9, 0xd5,
-	0x91, 0x1f, 0x5d, 0x57, 0x56, 0x1b, 0x92, 0xd7, 0x6e, 0xe2, 0x55, 0x25, 0x6c, 0xbb, 0xb3, 0xa3,
-	0x69, 0x6a, 0x7e, 0x34, 0x9d, 0x6d, 0xd8, 0xe9, 0x17, 0x69, 0xd8, 0x73, 0x8f, 0xc7, 0xcc, 0x2b,
-	0xbf, 0x79, 0x97, 0x5f, 0xe6, 0xcd, 0x6b, 0xa2, 0xf4, 0x1b, 0x0b, 0xf2, 0x1d, 0xce, 0x1c, 0x6a,
-	0x9a, 0xae, 0x7c, 0xf8, 0x5f, 0xf9, 0xbd, 0xd0, 0x4b, 0x42, 0xae, 0x29, 0xb4, 0x03, 0xe0, 0x0f,
-	0xbc, 0x98, 0x45, 0x1e, 0x1b, 0x37, 0x8e, 0x29, 0x0e, 0x2a, 0x42, 0x2a, 0x1a, 0x1a, 0x30, 0xa7,
-	0xa2, 0xe1, 0x5c, 0x7c, 0x32, 0x2f, 0x12, 0x9f, 0xe7, 0x8f, 0x1b, 0x7b, 0x4f, 0x2d, 0x28, 0x8f,
-	0x87, 0xaa, 0x81, 0x17, 0xcb, 0x9e, 0x4e, 0xe2, 0x01, 0xa7, 0x47, 0x5c, 0x3e, 0x77, 0x5e, 0x7e,
-	0x68, 0x43, 0xfb, 0xb0, 0x9a, 0x4c, 0x98, 0xa9, 0xcf, 0x9d, 0x30, 0x71, 0xa2, 0xf7, 0x30, 0xf3,
-	0xde, 0x87, 0x95, 0xa5, 0x7b, 0xff, 0xb2, 0x60, 0x6d, 0xe6, 0xfa, 0x43, 0x3f, 0x80, 0x0a, 0x6e,
-	0x75, 0x8f, 0x0e, 0x9f, 0xb4, 0xec, 0xee, 0xf1, 0xc1, 0xf1, 0x49, 0xd7, 0x3e, 0xea, 0xb4, 0x1e,
-	0xdb, 0x27, 0x8f, 0xbb, 0x9d, 0x56, 0xa3, 0xfd, 0xa8, 0xdd, 0x6a, 0x6e, 0x2c, 0x95, 0xb7, 0xdf,
-	0xff, 0x60, 0xf7, 0xc6, 0x02, 0x35, 0xf4, 0x5d, 0xb8, 0x39, 0xc7, 0xee, 0x9e, 0x34, 0x1a, 0xad,
-	0x6e, 0x77, 0xc3, 0x2a, 0x97, 0xdf, 0xff, 0x60, 0xf7, 0x3f, 0x48, 0x17, 0xac, 0x7b, 0x74, 0xd0,
-	0x3e, 0x3c, 0xc1, 0xad, 0x8d, 0xd4, 0xc2, 0x75, 0x46, 0xba, 0x60, 0x5d, 0xeb, 0xa7, 0x9d, 0x36,
-	0x6e, 0x35, 0x37, 0xd2, 0x0b, 0xd7, 0x19, 0x69, 0x39, 0xf3, 0xde, 0x47, 0x3b, 0x4b, 0xf7, 0xde,
-	0x81, 0xd5, 0xe4, 0xad, 0xb9, 0x0d, 0x37, 0x5a, 0x8f, 0x1b, 0x47, 0xcd, 0x16, 0x9e, 0x75, 0x15,
-	0x6d, 0xc2, 0x5a, 0x22, 0xe8, 0xe0, 0xa3, 0xe3, 0xa3, 0x0d, 0x0b, 0x6d, 0xc1, 0x46, 0xc2, 0x7a,
-	0x74, 0x72, 0x78, 0x68, 0x1f, 0xd4, 0xdb, 0x1b, 0xa9, 0xe9, 0x1d, 0x3a, 0x07, 0xf8, 0xb8, 0x7d,
-	0xa0, 0x05, 0x69, 0x7d, 0x56, 0xbd, 0xfd, 0xf1, 0x68, 0xc7, 0xfa, 0x64, 0xb4, 0x63, 0xfd, 0x75,
-	0xb4, 0x63, 0x3d, 0x7d, 0xb6, 0xb3, 0xf4, 0xc9, 0xb3, 0x9d, 0xa5, 0x3f, 0x3f, 0xdb, 0x59, 0xfa,
-	0x59, 0xed, 0x0b, 0x0c, 0x03, 0xe6, 0x8f, 0x4c, 0x35, 0x0b, 0xf4, 0x56, 0x94, 0xc6, 0x9b, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xb1, 0x6d, 0x99, 0xe4, 0x14, 0x00, 0x00,
+	// 2231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x6f, 0x1b, 0x59,
+	0x15, 0xcf, 0xd8, 0x4e, 0x62, 0x1f, 0x3b, 0x8e, 0x73, 0x93, 0x4d, 0x5c, 0xb7, 0x1b, 0x9b, 0x68,
+	0x81, 0x50, 0x16, 0x9b, 0xb4, 0x08, 0xd1, 0x02, 0x12, 0xfe, 0xea, 0xd6, 0x6c, 0x36, 0xb6, 0xc6,
+	0x49, 0x05, 0x48, 0x68, 0x74, 0x3d, 0x73, 0xe3, 0xcc, 0x66, 0x3c, 0x33, 0xdc, 0x3b, 0xce, 0xc7,
+	0xbe, 0x20, 0xde, 0x56, 0xfb, 0xd4, 0x37, 0x24, 0xa4, 0x95, 0x56, 0xda, 0x37, 0x24, 0x9e, 0x78,
+	0xe2, 0x2f, 0x60, 0x79, 0xeb, 0x23, 0x12, 0x52, 0x16, 0xb9, 0x02, 0xc1, 0xbf, 0xb0, 0xbc, 0xa0,
+	0xfb, 0x31, 0x1e, 0xdb, 0x35, 0xdb, 0x36, 0x2d, 0x3c, 0xf0, 0x14, 0x9f, 0xdf, 0x39, 0xf7, 0xe3,
+	0x7c, 0x9f, 0x3b, 0x81, 0x9b, 0x3d, 0xec, 0x5a, 0x15, 0x8f, 0x62, 0xd3, 0x21, 0x95, 0xb3, 0x3d,
+	0xf5, 0xab, 0xec, 0x53, 0x2f, 0xf0, 0x50, 0x96, 0x33, 0xcb, 0x0a, 0x3a, 0xdb, 0x2b, 0x6c, 0xf4,
+	0xbd, 0xbe, 0x27, 0x58, 0x15, 0xfe, 0x4b, 0x4a, 0x15, 0x8a, 0x7d, 0xcf, 0xeb, 0x3b, 0xa4, 0x22,
+	0xa8, 0xde, 0xf0, 0xb8, 0x12, 0xd8, 0x03, 0xc2, 0x02, 0x3c, 0xf0, 0x95, 0xc0, 0xb6, 0xe9, 0xb1,
+	0x81, 0xc7, 0x2a, 0x3d, 0xcc, 0xf8, 0x19, 0x3d, 0x12, 0xe0, 0xbd, 0x8a, 0xe9, 0xd9, 0xae, 0xe4,
+	0xef, 0xfc, 0x2e, 0x06, 0xd0, 0xc0, 0x01, 0xee, 0x7a, 0x43, 0x6a, 0x12, 0xb4, 0x01, 0x8b, 0xde,
+	0xb9, 0x4b, 0x68, 0x5e, 0x2b, 0x69, 0xbb, 0x29, 0x5d, 0x12, 0x08, 0x41, 0xc2, 0xc5, 0x03, 0x92,
+	0x8f, 0x09, 0x50, 0xfc, 0x46, 0x25, 0x48, 0x5b, 0x84, 0x99, 0xd4, 0xf6, 0x03, 0xdb, 0x73, 0xf3,
+	0x71, 0xc1, 0x9a, 0x84, 0x50, 0x01, 0x92, 0xc7, 0xb6, 0x43, 0xc4, 0xca, 0x84, 0x60, 0x8f, 0x69,
+	0xce, 0x0b, 0x28, 0xc1, 0x6c, 0x48, 0x2f, 0xf3, 0x8b, 0x92, 0x17, 0xd2, 0xe8, 0xe7, 0x10, 0x3f,
+	0x26, 0x24, 0xbf, 0x54, 0x8a, 0xef, 0xa6, 0xef, 0xdc, 0x28, 0x4b, 0x05, 0xca, 0x5c, 0x81, 0xb2,
+	0x52, 0xa0, 0x5c, 0xf7, 0x6c, 0xb7, 0xf6, 0xed, 0xcf, 0xae, 0x8a, 0x0b, 0xbf, 0xfd, 0xbc, 0xb8,
+	0xdb, 0xb7, 0x83, 0x93, 0x61, 0xaf, 0x6c, 0x7a, 0x83, 0x8a, 0xd2, 0x56, 0xfe, 0xf9, 0x16, 0xb3,
+	0x4e, 0x2b, 0xc1, 0xa5, 0x4f, 0x98, 0x58, 0xc0, 0x74, 0xbe, 0x2f, 0xba, 0x05, 0x29, 0x13, 0x9b,
+	0x27, 0x04, 0xf7, 0x1c, 0x92, 0x5f, 0x2e, 0x69, 0xbb, 0x49, 0x3d, 0x02, 0x50, 0x1e, 0x96, 0xcf,
+	0x08, 0x65, 0x5c, 0xa5, 0x64, 0x49, 0xdb, 0x4d, 0xe8, 0x21, 0x79, 0x3f, 0xf1, 0x8f, 0x4f, 0x8a,
+	0xda, 0xce, 0xaf, 0x35, 0x58, 0x8b, 0xec, 0xf5, 0x48, 0xf2, 0xd0, 0x03, 0xc8, 0x5a, 0x38, 0xc0,
+	0x06, 0x13, 0xa8, 0x61, 0x5b, 0xc2, 0x7e, 0x89, 0x5a, 0x69, 0x74, 0x55, 0xcc, 0x44, 0xe2, 0xad,
+	0xc6, 0x17, 0x33, 0xb4, 0x9e, 0xb1, 0x22, 0xca, 0x9a, 0x3c, 0x3d, 0x36, 0x75, 0xfa, 0x94, 0x31,
+	0xe3, 0xd3, 0xc6, 0x54, 0x37, 0xfb, 0xa7, 0x06, 0x99, 0xb6, 0x08, 0x97, 0xae, 0x70, 0xc1, 0xff,
+	0xcc, 0x97, 0x9b, 0xb0, 0xc4, 0xcc, 0x13, 0x32, 0xc0, 0xca, 0x93, 0x8a, 0x42, 0xf7, 0x60, 0x55,
+	0xd9, 0xc3, 0xf4, 0x2c, 0x62, 0x0c, 0xa9, 0x93, 0x5f, 0xe2, 0x02, 0xb5, 0xb5, 0xd1, 0x55, 0x71,
+	0x45, 0xea, 0x5c, 0xf7, 0x2c, 0x72, 0xa4, 0xef, 0xeb, 0x2b, 0x2c, 0x22, 0xa9, 0x33, 0x69, 0x87,
+	0xe5, 0x79, 0x5e, 0xf8, 0x83, 0x06, 0xeb, 0x93, 0xba, 0x86, 0x7e, 0x38, 0x80, 0x9c, 0xcc, 0x18,
+	0x43, 0x5e, 0x3d, 0xf2, 0xc4, 0x5b, 0xa3, 0xab, 0x62, 0x76, 0x72, 0x89, 0xf0, 0xc5, 0x0c, 0xa2,
+	0x67, 0xbd, 0x49, 0xfa, 0x9a, 0xfe, 0x98, 0x30, 0x48, 0x62, 0xd2, 0x20, 0xea, 0xee, 0x7f, 0xd3,
+	0x00, 0x74, 0x7c, 0xae, 0x93, 0x5f, 0x0c, 0x09, 0x0b, 0xd0, 0x0f, 0x21, 0x4d, 0x2e, 0x02, 0x42,
+	0x5d, 0xec, 0x44, 0xb7, 0xbd, 0x35, 0xba, 0x2a, 0x42, 0x53, 0xc1, 0xe2, 0xa6, 0x13, 0x94, 0x0e,
+	0xe1, 0x82, 0x96, 0x35, 0x27, 0xf2, 0x62, 0xd7, 0x8a, 0xbc, 0x02, 0x24, 0x4d, 0xec, 0x38, 0x1c,
+	0x13, 0xfa, 0x64, 0xf4, 0x31, 0x8d, 0xca, 0xb0, 0x3e, 0x79, 0x46, 0x68, 0x91, 0x84, 0xb0, 0xc8,
+	0x9a, 0x35, 0x9b, 0x0d, 0x4a, 0xcf, 0x5f, 0x69, 0x90, 0x12, 0x7a, 0xfa, 0x1e, 0x7d, 0x65, 0x35,
+	0x6f, 0x42, 0x8a, 0x5c, 0xd8, 0x81, 0x88, 0x24, 0xa1, 0xe1, 0x8a, 0x9e, 0xe4, 0x00, 0x0f, 0x18,
+	0x1e, 0xd2, 0x13, 0xf7, 0x16, 0xbf, 0xd5, 0x1d, 0x9e, 0x2c, 0xc1, 0x72, 0x68, 0xe8, 0xd7, 0x1d,
+	0x1b, 0x93, 0x16, 0x8b, 0xcd, 0x58, 0x6c, 0x0f, 0x36, 0xa8, 0x3c, 0x96, 0x58, 0xc6, 0x19, 0x76,
+	0x6c, 0x0b, 0x07, 0x1e, 0x65, 0xf9, 0x78, 0x29, 0xbe, 0x9b, 0xd2, 0xd7, 0xc7, 0xbc, 0x47, 0x63,
+	0x16, 0xd7, 0x70, 0x60, 0xbb, 0x86, 0xe9, 0x0d, 0xdd, 0x40, 0x99, 0x36, 0x39, 0xb0, 0xdd, 0x3a,
+	0xa7, 0xd1, 0x57, 0x21, 0xab, 0xd6, 0x18, 0x27, 0xc4, 0xee, 0x9f, 0x04, 0x22, 0xd5, 0xe2, 0xfa,
+	0x8a, 0x42, 0x1f, 0x0a, 0x10, 0x7d, 0x05, 0x32, 0xa1, 0x18, 0xef, 0x03, 0x22, 0xdd, 0xe2, 0x7a,
+	0x5a, 0x61, 0x87, 0xf6, 0x80, 0xa0, 0x6f, 0x40, 0xca, 0x74, 0x6c, 0xe2, 0x0a, 0xf5, 0x97, 0x45,
+	0x3a, 0x66, 0x46, 0x57, 0xc5, 0x64, 0x5d, 0x80, 0xad, 0x86, 0x9e, 0x94, 0xec, 0x96, 0x85, 0xea,
+	0x90, 0xa1, 0xf8, 0xdc, 0x50, 0xab, 0x59, 0x3e, 0x29, 0x0a, 0x72, 0xa1, 0x3c, 0xdd, 0x98, 0xca,
+	0x51, 0x2c, 0xd7, 0x12, 0xbc, 0x22, 0xeb, 0x69, 0x3a, 0x46, 0x18, 0x7a, 0x17, 0xd2, 0x76, 0xcf,
+	0x34, 0xcc, 0x13, 0xec, 0xba, 0xc4, 0xc9, 0xa7, 0x4a, 0xda, 0xbc, 0x3d, 0x5a, 0xb5, 0x7a, 0x5d,
+	0x4a, 0xd4, 0xb2, 0x3c, 0x26, 0x22, 0x5a, 0x07, 0xbb, 0x67, 0xaa, 0xdf, 0xa8, 0xc8, 0x83, 0x88,
+	0x98, 0xc3, 0x80, 0x18, 0x7d, 0xcc, 0xf2, 0x20, 0xac, 0x04, 0x0a, 0x7a, 0x07, 0x33, 0xf4, 0x10,
+	0xd2, 0x01, 0x63, 0x06, 0x71, 0x79, 0x9c, 0xd0, 0x7c, 0xba, 0xa4, 0xed, 0x66, 0xef, 0x6c, 0xcd,
+	0x9e, 0xd6, 0x94, 0x6c, 0x79, 0xd4, 0x61, 0xb7, 0xab, 0x68, 0x1d, 0x02, 0xc6, 0xd4, 0x6f, 0xde,
+	0x25, 0x42, 0x2f, 0xd1, 0x7c, 0x46, 0xa4, 0x71, 0x04, 0xa0, 0x13, 0x48, 0x1d, 0x13, 0x62, 0x38,
+	0xf6, 0xc0, 0x0e, 0xf2, 0x2b, 0xaf, 0xbf, 0x51, 0x25, 0x8f, 0x09, 0xd9, 0xe7, 0x9b, 0xa3, 0x3b,
+	0xf0, 0xc6, 0x74, 0xd4, 0x86, 0xd9, 0x97, 0x15, 0xca, 0xaf, 0x7b, 0x73, 0xaa, 0xe0, 0xd7, 0x61,
+	0x95, 0x47, 0x62, 0x0f, 0x9b, 0xa7, 0xc6, 0xc0, 0xb3, 0x86, 0x0e, 0xc9, 0xaf, 0x0a, 0x0d, 0xb2,
+	0x21, 0xfc, 0x9e, 0x40, 0xd1, 0xdb, 0x80, 0xc6, 0x82, 0x7d, 0xcc, 0x94, 0x3e, 0x39, 0xb1, 0x73,
+	0x2e, 0xe4, 0xbc, 0x83, 0x99, 0xb8, 0x8a, 0x4a, 0xa9, 0xdf, 0x68, 0xb0, 0xa4, 0x72, 0xfa, 0x16,
+	0xa4, 0xc6, 0xb1, 0xad, 0x9a, 0x4c, 0x04, 0xa0, 0xdb, 0xb0, 0x66, 0xbb, 0x46, 0x8f, 0x1c, 0x7b,
+	0x94, 0x18, 0x94, 0x30, 0xcf, 0x39, 0x93, 0xa9, 0x9b, 0xd4, 0x57, 0x6d, 0xb7, 0x26, 0x70, 0x5d,
+	0xc2, 0xe8, 0x47, 0x90, 0x96, 0xa1, 0xc6, 0xf7, 0x95, 0x69, 0xc2, 0x2d, 0x3a, 0x2f, 0xd2, 0xb8,
+	0x84, 0x0a, 0x34, 0xa0, 0x21, 0xc0, 0xd4, 0xe5, 0xfe, 0x1e, 0x87, 0x2d, 0x99, 0xb6, 0x2a, 0x00,
+	0x3b, 0xd8, 0x3c, 0x25, 0x01, 0xaf, 0x7b, 0xd3, 0x91, 0xaf, 0x7d, 0x69, 0xe4, 0xcf, 0x2b, 0x15,
+	0xb1, 0xd7, 0x54, 0x2a, 0x66, 0x8b, 0xeb, 0x4d, 0x48, 0x61, 0x76, 0x3a, 0x9d, 0xf7, 0x98, 0x9d,
+	0xca, 0xbc, 0x9f, 0x2a, 0x0a, 0x8b, 0x33, 0x45, 0x61, 0x2a, 0x08, 0x97, 0xfe, 0x9b, 0x41, 0x58,
+	0x84, 0xb4, 0x4f, 0x89, 0x8f, 0xa9, 0xcc, 0x3b, 0xd9, 0x92, 0x41, 0x41, 0x3c, 0xef, 0x66, 0x12,
+	0x33, 0xf9, 0xbc, 0xc4, 0x4c, 0x5d, 0x3b, 0x31, 0x95, 0xa3, 0x09, 0xec, 0xcc, 0xf1, 0x73, 0xd5,
+	0x3c, 0x75, 0xbd, 0x73, 0x87, 0x58, 0x7d, 0x32, 0x20, 0x6e, 0x80, 0xee, 0x01, 0x84, 0xf5, 0x70,
+	0x5c, 0xec, 0x0b, 0xa3, 0xab, 0x62, 0x4a, 0xad, 0x12, 0xce, 0x8b, 0x88, 0x71, 0x86, 0xb7, 0x2c,
+	0x75, 0xcc, 0x1f, 0x63, 0x90, 0x0f, 0xcf, 0x61, 0xbe, 0xe7, 0x32, 0x72, 0xbd, 0x80, 0x9a, 0xbe,
+	0x48, 0xec, 0x25, 0x2e, 0x22, 0xe2, 0xc3, 0x65, 0x2a, 0x04, 0xe2, 0x2a, 0x3e, 0x5c, 0x26, 0x43,
+	0x60, 0xb6, 0xe0, 0x27, 0x9e, 0x2d, 0xf8, 0x42, 0x44, 0x64, 0x99, 0x14, 0x59, 0x0c, 0x45, 0x04,
+	0x26, 0x44, 0x1a, 0x90, 0x55, 0xa4, 0xc1, 0x02, 0x1c, 0x0c, 0x99, 0x68, 0x1c, 0xd9, 0x3b, 0x6f,
+	0x3e, 0x93, 0x80, 0x52, 0xaa, 0x2b, 0x84, 0x78, 0xf3, 0x99, 0x20, 0xf9, 0xd4, 0x43, 0x09, 0x1b,
+	0x3a, 0x81, 0x88, 0x8f, 0x8c, 0xae, 0x28, 0x65, 0xc9, 0xbf, 0xc4, 0x79, 0xd9, 0xe0, 0xc0, 0xff,
+	0x5f, 0x22, 0x4e, 0x7b, 0x77, 0xe9, 0xda, 0xde, 0x5d, 0x7e, 0x8e, 0x77, 0x93, 0xcf, 0xf7, 0x6e,
+	0xea, 0x45, 0xbc, 0x0b, 0xaf, 0xe4, 0xdd, 0xf4, 0x1c, 0xef, 0xfe, 0x49, 0x83, 0x95, 0xae, 0xdd,
+	0x77, 0x6d, 0xb7, 0xaf, 0x9c, 0xfc, 0x3e, 0x00, 0x93, 0x40, 0x94, 0x7a, 0xef, 0x72, 0x9b, 0x28,
+	0x31, 0x61, 0x93, 0xfb, 0x13, 0xb5, 0x88, 0x5f, 0x46, 0x3c, 0x49, 0x4d, 0xcf, 0xa9, 0x98, 0x27,
+	0xd8, 0x76, 0x2b, 0x67, 0x77, 0x2b, 0x17, 0x02, 0x0f, 0x18, 0x53, 0x95, 0x69, 0xbc, 0x5a, 0x4f,
+	0xa9, 0xed, 0x5b, 0x16, 0xef, 0x77, 0x84, 0x52, 0x8f, 0x8a, 0xe9, 0x90, 0xf9, 0xd8, 0x0c, 0x5f,
+	0x37, 0x59, 0x01, 0xd7, 0x43, 0x14, 0xbd, 0x09, 0x10, 0x09, 0xaa, 0x64, 0x4a, 0x8d, 0x65, 0x94,
+	0x2e, 0x3e, 0xac, 0x8e, 0xc7, 0x32, 0xa5, 0xfc, 0x4d, 0x48, 0xd9, 0xcc, 0xc0, 0x66, 0x60, 0x9f,
+	0x11, 0xa1, 0x4b, 0x52, 0x4f, 0xda, 0xac, 0x2a, 0x68, 0x74, 0x1f, 0x16, 0x99, 0xed, 0xaa, 0x33,
+	0xf9, 0x6c, 0x23, 0x9f, 0xe4, 0xe5, 0xf0, 0x49, 0x5e, 0x3e, 0x0c, 0x9f, 0xe4, 0xb5, 0x24, 0xaf,
+	0xc1, 0x8f, 0x3f, 0x2f, 0x6a, 0xba, 0x5c, 0xa2, 0x4e, 0xac, 0xc2, 0xaa, 0xdc, 0x6b, 0x7c, 0x2e,
+	0x7f, 0x78, 0x60, 0xcb, 0xa2, 0x84, 0x31, 0xd5, 0x58, 0x43, 0x92, 0xbf, 0xea, 0x7c, 0xef, 0x9c,
+	0x50, 0xf5, 0x20, 0x91, 0xc4, 0xce, 0x17, 0x8b, 0xb0, 0xd4, 0xc1, 0x14, 0x0f, 0x18, 0xda, 0x83,
+	0x37, 0x06, 0xf8, 0xc2, 0x98, 0x18, 0xdd, 0x54, 0x78, 0x09, 0x27, 0xe8, 0x68, 0x80, 0x2f, 0xa2,
+	0x91, 0x4d, 0x06, 0xda, 0x0e, 0xac, 0xf0, 0x25, 0x51, 0xf8, 0xcb, 0xbd, 0xd3, 0x03, 0x7c, 0x51,
+	0x0d, 0x33, 0xe0, 0x36, 0xac, 0x71, 0x99, 0x30, 0x5d, 0x0c, 0x66, 0x7f, 0x10, 0x9a, 0x70, 0x75,
+	0x80, 0x2f, 0xea, 0x0a, 0xef, 0xda, 0x1f, 0x10, 0x54, 0x81, 0x0d, 0x71, 0x05, 0xd1, 0x9b, 0x8d,
+	0x48, 0x5c, 0xbd, 0x18, 0xf8, 0x0d, 0x04, 0xab, 0x11, 0x2e, 0xf8, 0x0e, 0x6c, 0x92, 0x0b, 0xdf,
+	0xa6, 0x98, 0x3f, 0x36, 0x8d, 0x9e, 0xe3, 0x99, 0xa7, 0x53, 0xb9, 0xb6, 0x11, 0x71, 0x6b, 0x9c,
+	0x29, 0xaf, 0xf4, 0x16, 0x64, 0x79, 0x9f, 0x33, 0xbc, 0x73, 0xcc, 0x06, 0xa2, 0xf1, 0x88, 0xdc,
+	0xd3, 0x33, 0x1c, 0x6d, 0x73, 0x90, 0xb7, 0x9e, 0x7b, 0x70, 0xc3, 0x27, 0x34, 0x9a, 0xc2, 0xc7,
+	0x56, 0x89, 0x5a, 0xd9, 0xa6, 0x4f, 0xe8, 0xd8, 0xf6, 0xca, 0x32, 0x7c, 0xe9, 0xdb, 0x80, 0x18,
+	0x1e, 0xf8, 0x0e, 0x8f, 0xe2, 0x80, 0x5e, 0xaa, 0x2b, 0xc9, 0xee, 0x96, 0x0b, 0x39, 0x87, 0xf4,
+	0x52, 0x5e, 0xe7, 0x7b, 0x90, 0x57, 0xc5, 0x8a, 0x92, 0x73, 0x4c, 0x2d, 0xc3, 0x27, 0xd4, 0x24,
+	0x6e, 0x80, 0xfb, 0x32, 0x2f, 0x13, 0xfa, 0xa6, 0xa7, 0x7a, 0x09, 0x67, 0x77, 0xc6, 0x5c, 0x74,
+	0x1f, 0x6e, 0xd8, 0xae, 0x0c, 0x2f, 0xc3, 0x27, 0x2e, 0x76, 0x82, 0x4b, 0xc3, 0x1a, 0x4a, 0x7d,
+	0xd5, 0x94, 0xbb, 0x15, 0x0a, 0x74, 0x24, 0xbf, 0xa1, 0xd8, 0xa8, 0x09, 0xeb, 0x7c, 0xc0, 0x0e,
+	0x95, 0x22, 0x2e, 0xff, 0x8c, 0x61, 0x89, 0x2c, 0x4d, 0xd6, 0xde, 0x18, 0x5d, 0x15, 0xd7, 0x5a,
+	0xb5, 0xba, 0xd2, 0xa9, 0x29, 0x99, 0xfa, 0x9a, 0xdd, 0x33, 0xa7, 0x21, 0xf4, 0xcb, 0x99, 0x51,
+	0xd0, 0xa7, 0xb6, 0x49, 0xf2, 0x19, 0x31, 0x55, 0xdc, 0x9a, 0x3b, 0x55, 0x34, 0x88, 0x29, 0x06,
+	0x8b, 0xbb, 0x6a, 0xb0, 0xf8, 0xe6, 0x0b, 0x0c, 0x16, 0x6a, 0x0d, 0x9b, 0x9a, 0x2e, 0x3b, 0xfc,
+	0x28, 0x74, 0x17, 0x36, 0xc3, 0xf8, 0x9a, 0x99, 0x47, 0x57, 0xe4, 0xa4, 0xab, 0x82, 0x6c, 0xce,
+	0x48, 0xfa, 0x7d, 0x40, 0x1d, 0xe2, 0x5a, 0xb2, 0xf8, 0xf0, 0x9a, 0xb5, 0x6f, 0x33, 0x31, 0xb4,
+	0x44, 0x55, 0x99, 0xa7, 0x51, 0x9c, 0xcf, 0x24, 0xe3, 0xd2, 0x1b, 0x8e, 0x8c, 0x3f, 0x86, 0x89,
+	0xd7, 0x06, 0xda, 0x82, 0x65, 0x11, 0xb3, 0x61, 0x67, 0xd2, 0x97, 0x38, 0xd9, 0xb2, 0x78, 0xe9,
+	0x50, 0x6f, 0x98, 0xb0, 0x07, 0xa5, 0xf4, 0x94, 0x42, 0xc6, 0xe3, 0xc2, 0xa7, 0x31, 0x58, 0x57,
+	0x76, 0x7d, 0x44, 0xa8, 0x7d, 0x6c, 0x9b, 0xd2, 0x47, 0x5f, 0x83, 0xa4, 0xa8, 0x68, 0x51, 0xc3,
+	0x4b, 0x8f, 0xae, 0x8a, 0xcb, 0x75, 0x8e, 0xb5, 0x1a, 0xfa, 0xb2, 0x60, 0xb6, 0xac, 0xe9, 0x81,
+	0x3a, 0x36, 0x3b, 0x50, 0x4f, 0xb7, 0x99, 0xf8, 0xcb, 0xb4, 0x99, 0x99, 0xd7, 0x77, 0xe2, 0x95,
+	0x3f, 0x32, 0x2c, 0x5e, 0xe7, 0x23, 0x83, 0xb2, 0xd2, 0xef, 0x35, 0x48, 0x0b, 0x9f, 0xab, 0x56,
+	0xc1, 0x3f, 0x97, 0x5c, 0x0e, 0x7a, 0x9e, 0x13, 0x9a, 0x5c, 0x52, 0x68, 0x1b, 0x60, 0x30, 0x74,
+	0x02, 0xdb, 0x77, 0xec, 0x71, 0xb9, 0x9b, 0x40, 0x50, 0x16, 0x62, 0xfe, 0x85, 0x2a, 0x41, 0x31,
+	0xff, 0x62, 0xc6, 0x3e, 0x89, 0x97, 0xb1, 0xcf, 0xf3, 0x87, 0xa4, 0x9d, 0xc7, 0x1a, 0x14, 0xc6,
+	0xa3, 0xe0, 0xd0, 0x09, 0x78, 0x27, 0xc2, 0xc1, 0x90, 0x92, 0x36, 0xe5, 0xef, 0xc5, 0xeb, 0x8f,
+	0x9a, 0x68, 0x0f, 0x96, 0xc3, 0xb9, 0x38, 0xf6, 0xa5, 0x73, 0xb1, 0x1e, 0xca, 0xdd, 0x4f, 0x7c,
+	0xf8, 0x49, 0x71, 0xe1, 0xf6, 0xbf, 0x34, 0x58, 0x99, 0x6a, 0xda, 0xe8, 0x07, 0x50, 0xd4, 0x9b,
+	0xdd, 0xf6, 0xfe, 0xa3, 0xa6, 0xd1, 0x3d, 0xac, 0x1e, 0x1e, 0x75, 0x8d, 0x76, 0xa7, 0x79, 0x60,
+	0x1c, 0x1d, 0x74, 0x3b, 0xcd, 0x7a, 0xeb, 0x41, 0xab, 0xd9, 0xc8, 0x2d, 0x14, 0xb6, 0x3e, 0xfa,
+	0xb8, 0xb4, 0x3e, 0x47, 0x0c, 0x7d, 0x17, 0x36, 0x67, 0xe0, 0xee, 0x51, 0xbd, 0xde, 0xec, 0x76,
+	0x73, 0x5a, 0xa1, 0xf0, 0xd1, 0xc7, 0xa5, 0xff, 0xc0, 0x9d, 0xb3, 0xee, 0x41, 0xb5, 0xb5, 0x7f,
+	0xa4, 0x37, 0x73, 0xb1, 0xb9, 0xeb, 0x14, 0x77, 0xce, 0xba, 0xe6, 0x4f, 0x3a, 0x2d, 0xbd, 0xd9,
+	0xc8, 0xc5, 0xe7, 0xae, 0x53, 0xdc, 0x42, 0xe2, 0xc3, 0x4f, 0xb7, 0x17, 0x6e, 0x9f, 0xc1, 0x6a,
+	0x3d, 0xfc, 0x6c, 0xdb, 0x96, 0x5f, 0x29, 0x77, 0x60, 0xbb, 0x5e, 0xad, 0x3f, 0x6c, 0x56, 0x6b,
+	0xfb, 0x4d, 0xa3, 0xdd, 0x39, 0x6c, 0xb5, 0x0f, 0x8c, 0x46, 0xdb, 0x38, 0x68, 0x1f, 0x1a, 0xef,
+	0xb5, 0x1b, 0xad, 0x07, 0x3f, 0xcd, 0x2d, 0xa0, 0x9b, 0xb0, 0xf5, 0x8c, 0x4c, 0xf3, 0x80, 0x53,
+	0x39, 0x0d, 0xdd, 0x82, 0xfc, 0xb3, 0x1b, 0xb4, 0xba, 0x82, 0x1b, 0x53, 0xe7, 0xbe, 0x0f, 0xcb,
+	0xe1, 0x47, 0x82, 0x2d, 0x58, 0x6f, 0x1e, 0xd4, 0xdb, 0x8d, 0xa6, 0x3e, 0x6d, 0x62, 0xb4, 0x06,
+	0x2b, 0x21, 0xa3, 0xa3, 0xb7, 0x0f, 0xdb, 0x39, 0x0d, 0x6d, 0x40, 0x2e, 0x84, 0x1e, 0x1c, 0xed,
+	0xef, 0x1b, 0xd5, 0x5a, 0x2b, 0x17, 0x9b, 0xdc, 0xa1, 0x53, 0xd5, 0x0f, 0x5b, 0x55, 0xc9, 0x88,
+	0xcb, 0xb3, 0x6a, 0xad, 0xcf, 0x46, 0xdb, 0xda, 0x93, 0xd1, 0xb6, 0xf6, 0xd7, 0xd1, 0xb6, 0xf6,
+	0xf8, 0xe9, 0xf6, 0xc2, 0x93, 0xa7, 0xdb, 0x0b, 0x7f, 0x7e, 0xba, 0xbd, 0xf0, 0xb3, 0xca, 0x0b,
+	0x8c, 0x4e, 0xea, 0x3f, 0x0b, 0xa2, 0xf4, 0xf6, 0x96, 0x84, 0xc4, 0xdd, 0x7f, 0x0f, 0x00, 0xeb,
+	0x25, 0x9b, 0x0d, 0x75, 0x18, 0x00, 0x00,
 }
 
 func (this *DataSource) Equal(that interface{}) bool {
@@ -1910,6 +2197,42 @@ func (this *DataSource) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Cacheable != that1.Cacheable {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *DataSourceVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataSourceVersion)
+	if !ok {
+		that2, ok := that.(DataSourceVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Filename != that1.Filename {
+		return false
+	}
 	return true
 }
 func (this *OracleScript) Equal(that interface{}) bool {
@@ -1949,6 +2272,42 @@ func (this *OracleScript) Equal(that interface{}) bool {
 	if this.SourceCodeURL != that1.SourceCodeURL {
 		return false
 	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *OracleScriptVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleScriptVersion)
+	if !ok {
+		that2, ok := that.(OracleScriptVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Filename != that1.Filename {
+		return false
+	}
+	if this.Schema != that1.Schema {
+		return false
+	}
 	return true
 }
 func (this *RawRequest) Equal(that interface{}) bool {
@@ -1979,6 +2338,9 @@ func (this *RawRequest) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Calldata, that1.Calldata) {
 		return false
 	}
+	if this.DataSourceVersion != that1.DataSourceVersion {
+		return false
+	}
 	return true
 }
 func (this *RawReport) Equal(that interface{}) bool {
@@ -2084,6 +2446,15 @@ func (this *Request) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
+	if this.CallbackModule != that1.CallbackModule {
+		return false
+	}
+	if this.CallbackGasLimit != that1.CallbackGasLimit {
+		return false
+	}
 	return true
 }
 func (this *Report) Equal(that interface{}) bool {
@@ -2403,9 +2774,20 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.IBCRequestEnabled != that1.IBCRequestEnabled {
 		return false
 	}
-	return true
-}
-func (this *PendingResolveList) Equal(that interface{}) bool {
+	if len(this.CallbackGasPrice) != len(that1.CallbackGasPrice) {
+		return false
+	}
+	for i := range this.CallbackGasPrice {
+		if !this.CallbackGasPrice[i].Equal(&that1.CallbackGasPrice[i]) {
+			return false
+		}
+	}
+	if this.MaxCallbackGasLimit != that1.MaxCallbackGasLimit {
+		return false
+	}
+	return true
+}
+func (this *PendingResolveList) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
@@ -2517,6 +2899,21 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Cacheable {
+		i--
+		if m.Cacheable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Fee) > 0 {
 		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2569,6 +2966,46 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DataSourceVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSourceVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSourceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OracleScript) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2589,6 +3026,11 @@ func (m *OracleScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.SourceCodeURL) > 0 {
 		i -= len(m.SourceCodeURL)
 		copy(dAtA[i:], m.SourceCodeURL)
@@ -2634,6 +3076,53 @@ func (m *OracleScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OracleScriptVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleScriptVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleScriptVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RawRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2654,6 +3143,11 @@ func (m *RawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DataSourceVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceVersion))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Calldata) > 0 {
 		i -= len(m.Calldata)
 		copy(dAtA[i:], m.Calldata)
@@ -2734,6 +3228,25 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CallbackGasLimit != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.CallbackGasLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.CallbackModule) > 0 {
+		i -= len(m.CallbackModule)
+		copy(dAtA[i:], m.CallbackModule)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.CallbackModule)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.FeeLimit) > 0 {
 		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3282,6 +3795,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxCallbackGasLimit != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxCallbackGasLimit))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.CallbackGasPrice) > 0 {
+		for iNdEx := len(m.CallbackGasPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackGasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.IBCRequestEnabled {
 		i--
 		if m.IBCRequestEnabled {
@@ -3601,6 +4133,31 @@ func (m *DataSource) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
+	if m.Cacheable {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DataSourceVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceID != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceID))
+	}
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	return n
 }
 
@@ -3634,6 +4191,32 @@ func (m *OracleScript) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *OracleScriptVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	}
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	return n
 }
 
@@ -3653,6 +4236,9 @@ func (m *RawRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if m.DataSourceVersion != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceVersion))
+	}
 	return n
 }
 
@@ -3733,6 +4319,16 @@ func (m *Request) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptVersion))
+	}
+	l = len(m.CallbackModule)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.CallbackGasLimit != 0 {
+		n += 2 + sovOracle(uint64(m.CallbackGasLimit))
+	}
 	return n
 }
 
@@ -3976,6 +4572,15 @@ func (m *Params) Size() (n int) {
 	if m.IBCRequestEnabled {
 		n += 2
 	}
+	if len(m.CallbackGasPrice) > 0 {
+		for _, e := range m.CallbackGasPrice {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.MaxCallbackGasLimit != 0 {
+		n += 1 + sovOracle(uint64(m.MaxCallbackGasLimit))
+	}
 	return n
 }
 
@@ -4243,7 +4848,442 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cacheable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cacheable = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSourceVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSourceVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSourceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			}
+			m.DataSourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleScript) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4271,13 +5311,13 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Treasury = string(dAtA[iNdEx:postIndex])
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4287,26 +5327,11 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4328,7 +5353,7 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleScript) Unmarshal(dAtA []byte) error {
+func (m *OracleScriptVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4351,17 +5376,17 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleScriptVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleScriptVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
-			var stringLen uint64
+			m.OracleScriptID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4371,29 +5396,16 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4403,57 +5415,12 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 			}
@@ -4485,7 +5452,7 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 			}
 			m.Filename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
@@ -4517,38 +5484,6 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 			}
 			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4671,6 +5606,25 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 				m.Calldata = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersion", wireType)
+			}
+			m.DataSourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5191,6 +6145,76 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+			}
+			m.OracleScriptVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+			}
+			m.CallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6757,6 +7781,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IBCRequestEnabled = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackGasPrice = append(m.CallbackGasPrice, types.DecCoin{})
+			if err := m.CallbackGasPrice[len(m.CallbackGasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGasLimit", wireType)
+			}
+			m.MaxCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
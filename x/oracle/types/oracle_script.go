@@ -21,3 +21,17 @@ func NewOracleScript(
 		SourceCodeURL: sourceCodeURL,
 	}
 }
+
+func NewOracleScriptVersion(
+	oracleScriptID OracleScriptID,
+	version uint64,
+	filename string,
+	schema string,
+) OracleScriptVersion {
+	return OracleScriptVersion{
+		OracleScriptID: oracleScriptID,
+		Version:        version,
+		Filename:       filename,
+		Schema:         schema,
+	}
+}
@@ -65,6 +65,12 @@ func (p OracleRequestPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
 }
 
+// GetOracleScriptVersion implements RequestSpec. IBC requests always use the latest version of
+// the oracle script, as the packet format is shared with the counterparty chains.
+func (p OracleRequestPacketData) GetOracleScriptVersion() uint64 {
+	return 0
+}
+
 func NewOracleRequestPacketAcknowledgement(requestID RequestID) *OracleRequestPacketAcknowledgement {
 	return &OracleRequestPacketAcknowledgement{
 		RequestID: requestID,
@@ -3,6 +3,10 @@ package types
 import (
 	"fmt"
 	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -19,13 +23,19 @@ const (
 	DefaultOracleRewardPercentage  = uint64(70)
 	DefaultInactivePenaltyDuration = uint64(10 * time.Minute)
 	DefaultIBCRequestEnabled       = true
+	DefaultMaxCallbackGasLimit     = uint64(1000000)
 )
 
+// DefaultCallbackGasPrice is the default price of the gas of the callbacks.
+var DefaultCallbackGasPrice = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", math.LegacyNewDecWithPrec(25, 4)))
+
 // NewParams creates a new parameter configuration for the oracle module
 func NewParams(
 	maxRawRequestCount, maxAskCount, maxCalldataSize, maxReportDataSize, expirationBlockCount, baseRequestGas, perValidatorRequestGas,
 	samplingTryCount, oracleRewardPercentage, inactivePenaltyDuration uint64,
 	ibcRequestEnabled bool,
+	callbackGasPrice sdk.DecCoins,
+	maxCallbackGasLimit uint64,
 ) Params {
 	return Params{
 		MaxRawRequestCount:      maxRawRequestCount,
@@ -39,6 +49,8 @@ func NewParams(
 		OracleRewardPercentage:  oracleRewardPercentage,
 		InactivePenaltyDuration: inactivePenaltyDuration,
 		IBCRequestEnabled:       ibcRequestEnabled,
+		CallbackGasPrice:        callbackGasPrice,
+		MaxCallbackGasLimit:     maxCallbackGasLimit,
 	}
 }
 
@@ -56,6 +68,8 @@ func DefaultParams() Params {
 		DefaultOracleRewardPercentage,
 		DefaultInactivePenaltyDuration,
 		DefaultIBCRequestEnabled,
+		DefaultCallbackGasPrice,
+		DefaultMaxCallbackGasLimit,
 	)
 }
 
@@ -94,6 +108,12 @@ func (p Params) Validate() error {
 	if err := validateBool()(p.IBCRequestEnabled); err != nil {
 		return err
 	}
+	if err := p.CallbackGasPrice.Validate(); err != nil {
+		return fmt.Errorf("invalid callback gas price: %w", err)
+	}
+	if err := validateUint64("max callback gas limit", false)(p.MaxCallbackGasLimit); err != nil {
+		return err
+	}
 
 	return nil
 }
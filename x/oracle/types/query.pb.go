@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -316,6 +317,118 @@ func (m *QueryDataSourceResponse) GetDataSource() *DataSource {
 	return nil
 }
 
+// QueryDataSourceVersionsRequest is request type for the
+// Query/DataSourceVersions RPC method.
+type QueryDataSourceVersionsRequest struct {
+	// DataSourceID is ID of a data source script
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDataSourceVersionsRequest) Reset()         { *m = QueryDataSourceVersionsRequest{} }
+func (m *QueryDataSourceVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataSourceVersionsRequest) ProtoMessage()    {}
+func (*QueryDataSourceVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{6}
+}
+func (m *QueryDataSourceVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataSourceVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataSourceVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataSourceVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataSourceVersionsRequest.Merge(m, src)
+}
+func (m *QueryDataSourceVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataSourceVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataSourceVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataSourceVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryDataSourceVersionsRequest) GetDataSourceId() uint64 {
+	if m != nil {
+		return m.DataSourceId
+	}
+	return 0
+}
+
+func (m *QueryDataSourceVersionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDataSourceVersionsResponse is response type for the
+// Query/DataSourceVersions RPC method.
+type QueryDataSourceVersionsResponse struct {
+	// DataSourceVersions is the list of versions of the data source
+	DataSourceVersions []DataSourceVersion `protobuf:"bytes,1,rep,name=data_source_versions,json=dataSourceVersions,proto3" json:"data_source_versions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDataSourceVersionsResponse) Reset()         { *m = QueryDataSourceVersionsResponse{} }
+func (m *QueryDataSourceVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataSourceVersionsResponse) ProtoMessage()    {}
+func (*QueryDataSourceVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{7}
+}
+func (m *QueryDataSourceVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataSourceVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataSourceVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataSourceVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataSourceVersionsResponse.Merge(m, src)
+}
+func (m *QueryDataSourceVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataSourceVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataSourceVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataSourceVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryDataSourceVersionsResponse) GetDataSourceVersions() []DataSourceVersion {
+	if m != nil {
+		return m.DataSourceVersions
+	}
+	return nil
+}
+
+func (m *QueryDataSourceVersionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryOracleScriptRequest is request type for the Query/OracleScript RPC
 // method.
 type QueryOracleScriptRequest struct {
@@ -327,7 +440,7 @@ func (m *QueryOracleScriptRequest) Reset()         { *m = QueryOracleScriptReque
 func (m *QueryOracleScriptRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleScriptRequest) ProtoMessage()    {}
 func (*QueryOracleScriptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{6}
+	return fileDescriptor_e351f430ef3842d0, []int{8}
 }
 func (m *QueryOracleScriptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +487,7 @@ func (m *QueryOracleScriptResponse) Reset()         { *m = QueryOracleScriptResp
 func (m *QueryOracleScriptResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleScriptResponse) ProtoMessage()    {}
 func (*QueryOracleScriptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{7}
+	return fileDescriptor_e351f430ef3842d0, []int{9}
 }
 func (m *QueryOracleScriptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,6 +523,118 @@ func (m *QueryOracleScriptResponse) GetOracleScript() *OracleScript {
 	return nil
 }
 
+// QueryOracleScriptVersionsRequest is request type for the
+// Query/OracleScriptVersions RPC method.
+type QueryOracleScriptVersionsRequest struct {
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOracleScriptVersionsRequest) Reset()         { *m = QueryOracleScriptVersionsRequest{} }
+func (m *QueryOracleScriptVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleScriptVersionsRequest) ProtoMessage()    {}
+func (*QueryOracleScriptVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{10}
+}
+func (m *QueryOracleScriptVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleScriptVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleScriptVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleScriptVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleScriptVersionsRequest.Merge(m, src)
+}
+func (m *QueryOracleScriptVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleScriptVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleScriptVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleScriptVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryOracleScriptVersionsRequest) GetOracleScriptId() uint64 {
+	if m != nil {
+		return m.OracleScriptId
+	}
+	return 0
+}
+
+func (m *QueryOracleScriptVersionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOracleScriptVersionsResponse is response type for the
+// Query/OracleScriptVersions RPC method.
+type QueryOracleScriptVersionsResponse struct {
+	// OracleScriptVersions is the list of versions of the oracle script
+	OracleScriptVersions []OracleScriptVersion `protobuf:"bytes,1,rep,name=oracle_script_versions,json=oracleScriptVersions,proto3" json:"oracle_script_versions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOracleScriptVersionsResponse) Reset()         { *m = QueryOracleScriptVersionsResponse{} }
+func (m *QueryOracleScriptVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleScriptVersionsResponse) ProtoMessage()    {}
+func (*QueryOracleScriptVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{11}
+}
+func (m *QueryOracleScriptVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleScriptVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleScriptVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleScriptVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleScriptVersionsResponse.Merge(m, src)
+}
+func (m *QueryOracleScriptVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleScriptVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleScriptVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleScriptVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryOracleScriptVersionsResponse) GetOracleScriptVersions() []OracleScriptVersion {
+	if m != nil {
+		return m.OracleScriptVersions
+	}
+	return nil
+}
+
+func (m *QueryOracleScriptVersionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryRequestRequest is request type for the Query/Request RPC method.
 type QueryRequestRequest struct {
 	// RequestID is ID of an oracle request
@@ -420,7 +645,7 @@ func (m *QueryRequestRequest) Reset()         { *m = QueryRequestRequest{} }
 func (m *QueryRequestRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequestRequest) ProtoMessage()    {}
 func (*QueryRequestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{8}
+	return fileDescriptor_e351f430ef3842d0, []int{12}
 }
 func (m *QueryRequestRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,13 +692,17 @@ type QueryRequestResponse struct {
 	Result *Result `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 	// Signing is the signing detail in the bandtss module.
 	Signing *SigningResult `protobuf:"bytes,4,opt,name=signing,proto3" json:"signing,omitempty"`
+	// OracleScriptVersion is the version of the oracle script used to resolve the
+	// request. It is 0 if the request is not resolved yet or was resolved before
+	// oracle scripts are versioned.
+	OracleScriptVersion uint64 `protobuf:"varint,5,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
 }
 
 func (m *QueryRequestResponse) Reset()         { *m = QueryRequestResponse{} }
 func (m *QueryRequestResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRequestResponse) ProtoMessage()    {}
 func (*QueryRequestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{9}
+	return fileDescriptor_e351f430ef3842d0, []int{13}
 }
 func (m *QueryRequestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,6 +759,13 @@ func (m *QueryRequestResponse) GetSigning() *SigningResult {
 	return nil
 }
 
+func (m *QueryRequestResponse) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
+}
+
 // QueryPendingRequestRequest is request type for the Query/PendingRequests RPC
 // method.
 type QueryPendingRequestsRequest struct {
@@ -541,7 +777,7 @@ func (m *QueryPendingRequestsRequest) Reset()         { *m = QueryPendingRequest
 func (m *QueryPendingRequestsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingRequestsRequest) ProtoMessage()    {}
 func (*QueryPendingRequestsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{10}
+	return fileDescriptor_e351f430ef3842d0, []int{14}
 }
 func (m *QueryPendingRequestsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,7 +824,7 @@ func (m *QueryPendingRequestsResponse) Reset()         { *m = QueryPendingReques
 func (m *QueryPendingRequestsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingRequestsResponse) ProtoMessage()    {}
 func (*QueryPendingRequestsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{11}
+	return fileDescriptor_e351f430ef3842d0, []int{15}
 }
 func (m *QueryPendingRequestsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +868,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{12}
+	return fileDescriptor_e351f430ef3842d0, []int{16}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -671,7 +907,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{13}
+	return fileDescriptor_e351f430ef3842d0, []int{17}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,7 +953,7 @@ func (m *QueryValidatorRequest) Reset()         { *m = QueryValidatorRequest{} }
 func (m *QueryValidatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorRequest) ProtoMessage()    {}
 func (*QueryValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{14}
+	return fileDescriptor_e351f430ef3842d0, []int{18}
 }
 func (m *QueryValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,7 +999,7 @@ func (m *QueryValidatorResponse) Reset()         { *m = QueryValidatorResponse{}
 func (m *QueryValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorResponse) ProtoMessage()    {}
 func (*QueryValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{15}
+	return fileDescriptor_e351f430ef3842d0, []int{19}
 }
 func (m *QueryValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +1047,7 @@ func (m *QueryIsReporterRequest) Reset()         { *m = QueryIsReporterRequest{}
 func (m *QueryIsReporterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIsReporterRequest) ProtoMessage()    {}
 func (*QueryIsReporterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{16}
+	return fileDescriptor_e351f430ef3842d0, []int{20}
 }
 func (m *QueryIsReporterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,7 +1100,7 @@ func (m *QueryIsReporterResponse) Reset()         { *m = QueryIsReporterResponse
 func (m *QueryIsReporterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIsReporterResponse) ProtoMessage()    {}
 func (*QueryIsReporterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{17}
+	return fileDescriptor_e351f430ef3842d0, []int{21}
 }
 func (m *QueryIsReporterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -910,7 +1146,7 @@ func (m *QueryReportersRequest) Reset()         { *m = QueryReportersRequest{} }
 func (m *QueryReportersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryReportersRequest) ProtoMessage()    {}
 func (*QueryReportersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{18}
+	return fileDescriptor_e351f430ef3842d0, []int{22}
 }
 func (m *QueryReportersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -956,7 +1192,7 @@ func (m *QueryReportersResponse) Reset()         { *m = QueryReportersResponse{}
 func (m *QueryReportersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryReportersResponse) ProtoMessage()    {}
 func (*QueryReportersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{19}
+	return fileDescriptor_e351f430ef3842d0, []int{23}
 }
 func (m *QueryReportersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1001,7 +1237,7 @@ func (m *QueryActiveValidatorsRequest) Reset()         { *m = QueryActiveValidat
 func (m *QueryActiveValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActiveValidatorsRequest) ProtoMessage()    {}
 func (*QueryActiveValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{20}
+	return fileDescriptor_e351f430ef3842d0, []int{24}
 }
 func (m *QueryActiveValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1041,7 +1277,7 @@ func (m *QueryActiveValidatorsResponse) Reset()         { *m = QueryActiveValida
 func (m *QueryActiveValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActiveValidatorsResponse) ProtoMessage()    {}
 func (*QueryActiveValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{21}
+	return fileDescriptor_e351f430ef3842d0, []int{25}
 }
 func (m *QueryActiveValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1331,7 @@ func (m *QueryRequestSearchRequest) Reset()         { *m = QueryRequestSearchReq
 func (m *QueryRequestSearchRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequestSearchRequest) ProtoMessage()    {}
 func (*QueryRequestSearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{22}
+	return fileDescriptor_e351f430ef3842d0, []int{26}
 }
 func (m *QueryRequestSearchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1163,7 +1399,7 @@ func (m *QueryRequestSearchResponse) Reset()         { *m = QueryRequestSearchRe
 func (m *QueryRequestSearchResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRequestSearchResponse) ProtoMessage()    {}
 func (*QueryRequestSearchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{23}
+	return fileDescriptor_e351f430ef3842d0, []int{27}
 }
 func (m *QueryRequestSearchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1214,7 +1450,7 @@ func (m *QueryRequestPriceRequest) Reset()         { *m = QueryRequestPriceReque
 func (m *QueryRequestPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequestPriceRequest) ProtoMessage()    {}
 func (*QueryRequestPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{24}
+	return fileDescriptor_e351f430ef3842d0, []int{28}
 }
 func (m *QueryRequestPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1275,7 +1511,7 @@ func (m *QueryRequestPriceResponse) Reset()         { *m = QueryRequestPriceResp
 func (m *QueryRequestPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRequestPriceResponse) ProtoMessage()    {}
 func (*QueryRequestPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{25}
+	return fileDescriptor_e351f430ef3842d0, []int{29}
 }
 func (m *QueryRequestPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1340,7 +1576,7 @@ func (m *QueryRequestVerificationRequest) Reset()         { *m = QueryRequestVer
 func (m *QueryRequestVerificationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequestVerificationRequest) ProtoMessage()    {}
 func (*QueryRequestVerificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{26}
+	return fileDescriptor_e351f430ef3842d0, []int{30}
 }
 func (m *QueryRequestVerificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1448,7 +1684,7 @@ func (m *QueryRequestVerificationResponse) Reset()         { *m = QueryRequestVe
 func (m *QueryRequestVerificationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRequestVerificationResponse) ProtoMessage()    {}
 func (*QueryRequestVerificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e351f430ef3842d0, []int{27}
+	return fileDescriptor_e351f430ef3842d0, []int{31}
 }
 func (m *QueryRequestVerificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1526,8 +1762,12 @@ func init() {
 	proto.RegisterType((*QueryDataResponse)(nil), "band.oracle.v1.QueryDataResponse")
 	proto.RegisterType((*QueryDataSourceRequest)(nil), "band.oracle.v1.QueryDataSourceRequest")
 	proto.RegisterType((*QueryDataSourceResponse)(nil), "band.oracle.v1.QueryDataSourceResponse")
+	proto.RegisterType((*QueryDataSourceVersionsRequest)(nil), "band.oracle.v1.QueryDataSourceVersionsRequest")
+	proto.RegisterType((*QueryDataSourceVersionsResponse)(nil), "band.oracle.v1.QueryDataSourceVersionsResponse")
 	proto.RegisterType((*QueryOracleScriptRequest)(nil), "band.oracle.v1.QueryOracleScriptRequest")
 	proto.RegisterType((*QueryOracleScriptResponse)(nil), "band.oracle.v1.QueryOracleScriptResponse")
+	proto.RegisterType((*QueryOracleScriptVersionsRequest)(nil), "band.oracle.v1.QueryOracleScriptVersionsRequest")
+	proto.RegisterType((*QueryOracleScriptVersionsResponse)(nil), "band.oracle.v1.QueryOracleScriptVersionsResponse")
 	proto.RegisterType((*QueryRequestRequest)(nil), "band.oracle.v1.QueryRequestRequest")
 	proto.RegisterType((*QueryRequestResponse)(nil), "band.oracle.v1.QueryRequestResponse")
 	proto.RegisterType((*QueryPendingRequestsRequest)(nil), "band.oracle.v1.QueryPendingRequestsRequest")
@@ -1553,104 +1793,118 @@ func init() {
 func init() { proto.RegisterFile("band/oracle/v1/query.proto", fileDescriptor_e351f430ef3842d0) }
 
 var fileDescriptor_e351f430ef3842d0 = []byte{
-	// 1538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0xae, 0x3f, 0x5e, 0x9c, 0xaf, 0x49, 0x68, 0x9d, 0x75, 0xe2, 0xa4, 0x9b, 0xd2,
-	0x3a, 0xfd, 0xf0, 0x36, 0x6d, 0x45, 0xa5, 0x82, 0x5a, 0x9a, 0xe6, 0x80, 0x85, 0xd4, 0x0f, 0x47,
-	0xea, 0x01, 0x01, 0x66, 0x62, 0x2f, 0xce, 0xaa, 0xf6, 0xae, 0xbb, 0xb3, 0xb6, 0x12, 0x55, 0xe1,
-	0xc0, 0x01, 0x09, 0x09, 0x04, 0x12, 0x02, 0x09, 0xf5, 0xc4, 0x81, 0x23, 0xb7, 0x5e, 0xe0, 0x2f,
-	0xe8, 0xb1, 0x2a, 0x17, 0x4e, 0x15, 0x4a, 0x39, 0xf1, 0x4f, 0x80, 0x76, 0xe6, 0xcd, 0x7a, 0x77,
-	0xbd, 0x76, 0xcd, 0xa1, 0x07, 0x6e, 0xd9, 0x79, 0xbf, 0xf7, 0xde, 0x6f, 0xde, 0x9b, 0x79, 0xf3,
-	0x73, 0x40, 0xdd, 0xa5, 0x56, 0x43, 0xb7, 0x1d, 0x5a, 0x6f, 0x19, 0x7a, 0x6f, 0x53, 0x7f, 0xd8,
-	0x35, 0x9c, 0x83, 0x72, 0xc7, 0xb1, 0x5d, 0x9b, 0xcc, 0x78, 0xb6, 0xb2, 0xb0, 0x95, 0x7b, 0x9b,
-	0xea, 0x62, 0xd3, 0x6e, 0xda, 0xdc, 0xa4, 0x7b, 0x7f, 0x09, 0x94, 0xba, 0xdc, 0xb4, 0xed, 0x66,
-	0xcb, 0xd0, 0x69, 0xc7, 0xd4, 0xa9, 0x65, 0xd9, 0x2e, 0x75, 0x4d, 0xdb, 0x62, 0x68, 0x5d, 0xaa,
-	0xdb, 0xac, 0x6d, 0xb3, 0x9a, 0x70, 0x13, 0x1f, 0x68, 0x2a, 0x44, 0x52, 0x63, 0x22, 0x6e, 0xd4,
-	0x16, 0x81, 0xdc, 0xf3, 0xa8, 0xdc, 0xb2, 0xbb, 0x96, 0xcb, 0xaa, 0xc6, 0xc3, 0xae, 0xc1, 0x5c,
-	0xed, 0x07, 0x05, 0x16, 0x42, 0xcb, 0xac, 0x63, 0x5b, 0xcc, 0x20, 0x67, 0x61, 0xbe, 0x41, 0x5d,
-	0x5a, 0x63, 0x76, 0xd7, 0xa9, 0x1b, 0xb5, 0xba, 0x67, 0xcd, 0x2b, 0x6b, 0x4a, 0x29, 0x59, 0x9d,
-	0xf5, 0x0c, 0x3b, 0x7c, 0x9d, 0x3b, 0x91, 0x32, 0x2c, 0x88, 0x4c, 0x35, 0x56, 0x77, 0xcc, 0x8e,
-	0x8b, 0xe8, 0x04, 0x47, 0xcf, 0x0b, 0xd3, 0x0e, 0xb7, 0x08, 0xfc, 0x3a, 0x4c, 0x3b, 0x22, 0x3d,
-	0x22, 0x27, 0x39, 0x32, 0x87, 0x8b, 0x1c, 0xa4, 0xe9, 0x30, 0xc7, 0x79, 0x6d, 0x53, 0x97, 0x22,
-	0x59, 0x52, 0x80, 0x2c, 0x27, 0xb5, 0x47, 0xd9, 0x1e, 0x27, 0x93, 0xad, 0x66, 0xbc, 0x85, 0xf7,
-	0x28, 0xdb, 0xd3, 0xce, 0xc0, 0x7c, 0xc0, 0x01, 0xb7, 0x41, 0x20, 0xe9, 0x01, 0x38, 0x38, 0x57,
-	0xe5, 0x7f, 0x6b, 0xd7, 0xe1, 0xb8, 0x0f, 0x14, 0xdb, 0x90, 0xf1, 0x4f, 0xc1, 0x4c, 0x70, 0xd3,
-	0x66, 0x03, 0x77, 0x9c, 0xeb, 0xef, 0xb8, 0xd2, 0xd0, 0xee, 0xc3, 0x89, 0x01, 0x7f, 0x4c, 0xf7,
-	0x36, 0x4c, 0x05, 0x02, 0x70, 0xef, 0xa9, 0x4b, 0x6a, 0x39, 0xdc, 0xf5, 0x72, 0xc0, 0x11, 0xfa,
-	0x91, 0xb5, 0x6d, 0xc8, 0xf3, 0xb8, 0x77, 0x02, 0x05, 0x93, 0xcc, 0x4a, 0x30, 0x17, 0x2e, 0xb1,
-	0xcf, 0x6d, 0x26, 0x58, 0xdf, 0x4a, 0x43, 0xfb, 0x18, 0x96, 0x62, 0xa2, 0x20, 0xbf, 0x9b, 0x30,
-	0x1d, 0x0a, 0x83, 0x0c, 0x97, 0xa3, 0x0c, 0x43, 0xce, 0xb9, 0x60, 0x06, 0xed, 0x0a, 0x9e, 0x17,
-	0x64, 0x26, 0x09, 0xae, 0x00, 0xc8, 0x9e, 0xfa, 0xd4, 0xb2, 0xb8, 0x52, 0x69, 0x68, 0x7f, 0x2b,
-	0xb0, 0x18, 0x76, 0x43, 0x46, 0x9b, 0x90, 0x46, 0x14, 0x72, 0x39, 0x11, 0xe5, 0x22, 0x3d, 0x24,
-	0x8e, 0xbc, 0xe5, 0xb9, 0x74, 0x6c, 0xc7, 0x65, 0xf9, 0xc4, 0xda, 0x64, 0x69, 0xea, 0xd2, 0xf1,
-	0x41, 0x17, 0xcf, 0xbc, 0x95, 0x7c, 0xfa, 0x62, 0x75, 0xa2, 0x2a, 0xc1, 0xa4, 0x0c, 0x29, 0xc7,
-	0x60, 0xdd, 0x96, 0x38, 0x6f, 0xb1, 0x6e, 0x9e, 0xb5, 0x8a, 0x28, 0x72, 0x15, 0xd2, 0xcc, 0x6c,
-	0x5a, 0xa6, 0xd5, 0xcc, 0x27, 0xb9, 0xc3, 0x4a, 0xd4, 0x61, 0x47, 0x98, 0xd1, 0x4f, 0xa2, 0xb5,
-	0x36, 0x14, 0xf8, 0x5e, 0xef, 0x1a, 0x56, 0x83, 0x9b, 0x39, 0x6f, 0x79, 0xe5, 0xc8, 0x6d, 0x98,
-	0xef, 0xd1, 0x96, 0xd9, 0xa0, 0xae, 0xed, 0xd4, 0x68, 0xa3, 0xe1, 0x18, 0x8c, 0x89, 0xd3, 0xbc,
-	0x75, 0xf2, 0xf9, 0x93, 0x0b, 0x2b, 0x78, 0xa5, 0xef, 0x4b, 0xcc, 0x4d, 0x01, 0xd9, 0x71, 0x1d,
-	0x2f, 0xd8, 0x5c, 0x2f, 0xb2, 0xae, 0xdd, 0x81, 0xe5, 0xf8, 0x74, 0x58, 0x62, 0x1d, 0xa6, 0xfa,
-	0xad, 0xf1, 0x32, 0x4d, 0x96, 0x92, 0x5b, 0x33, 0x47, 0x2f, 0x56, 0x01, 0xa1, 0x95, 0x6d, 0x56,
-	0x05, 0xbf, 0x57, 0xcc, 0x9f, 0x14, 0x77, 0xa9, 0x43, 0xdb, 0xfe, 0xa4, 0x78, 0x1f, 0x1b, 0x2f,
-	0x57, 0x31, 0xfa, 0x15, 0x48, 0x75, 0xf8, 0x0a, 0xf6, 0x6f, 0xa0, 0xaa, 0x02, 0x8f, 0xcd, 0x40,
-	0xac, 0xd6, 0x84, 0x37, 0x78, 0x30, 0x7f, 0x93, 0xaf, 0xab, 0x38, 0xf7, 0xf0, 0xb2, 0x07, 0x12,
-	0x21, 0xf1, 0xab, 0x90, 0x62, 0x2e, 0x75, 0xbb, 0x92, 0xf8, 0x6a, 0x94, 0xb8, 0xef, 0xb2, 0xc3,
-	0x61, 0x55, 0x84, 0x6b, 0xbf, 0x28, 0x18, 0xb3, 0xc2, 0xc4, 0x41, 0x33, 0x5e, 0x17, 0x7b, 0x72,
-	0x0b, 0xe6, 0x1c, 0x4c, 0xe1, 0x87, 0x4b, 0xf0, 0x70, 0xf9, 0xe7, 0x4f, 0x2e, 0x2c, 0x62, 0xb8,
-	0x70, 0x94, 0x59, 0xe9, 0x21, 0x4b, 0x70, 0x0d, 0xe7, 0x55, 0x90, 0x2e, 0xd6, 0x60, 0x15, 0xa6,
-	0x4c, 0x56, 0x93, 0x0e, 0x9c, 0x69, 0xa6, 0x0a, 0xa6, 0x0f, 0xf4, 0xfb, 0x24, 0x17, 0x5e, 0xdb,
-	0x21, 0xbe, 0x8d, 0x35, 0x0d, 0x24, 0xf2, 0x0f, 0x58, 0x26, 0x40, 0x70, 0x72, 0xe4, 0xde, 0x7d,
-	0xa4, 0x56, 0xc4, 0x4b, 0x71, 0xb3, 0xee, 0x9a, 0x3d, 0xc3, 0xa7, 0xe1, 0x9f, 0xe6, 0x4f, 0x60,
-	0x65, 0x88, 0x1d, 0xd3, 0xde, 0x00, 0xf0, 0x49, 0x8a, 0x4b, 0x13, 0x73, 0x44, 0x22, 0xde, 0xd5,
-	0x80, 0x8b, 0xf6, 0x58, 0xc1, 0x49, 0x8c, 0x29, 0x77, 0x0c, 0xea, 0xd4, 0xf7, 0xfe, 0xf3, 0x40,
-	0x27, 0x2a, 0x64, 0xea, 0xb4, 0xd5, 0xe2, 0xcf, 0x58, 0x42, 0xbc, 0x79, 0xf2, 0xdb, 0x7b, 0x10,
-	0x29, 0x7b, 0x10, 0x7a, 0x45, 0x33, 0x94, 0x3d, 0x10, 0xcf, 0x6c, 0x01, 0xb2, 0x6d, 0xd3, 0x42,
-	0x63, 0x52, 0x18, 0xdb, 0xa6, 0x25, 0x9e, 0xd7, 0x0f, 0x41, 0x8d, 0x23, 0x87, 0x9b, 0xbf, 0x1e,
-	0x9d, 0xca, 0xa7, 0xa2, 0x3b, 0x8f, 0x1b, 0xe6, 0xfe, 0x88, 0xd6, 0x2c, 0x7c, 0xca, 0x10, 0x70,
-	0xd7, 0x31, 0xfb, 0x8f, 0x6c, 0x1e, 0xd2, 0xec, 0xa0, 0xbd, 0x6b, 0xb7, 0x44, 0x55, 0xb3, 0x55,
-	0xf9, 0x19, 0xde, 0x4d, 0x62, 0xd4, 0x6e, 0x26, 0x23, 0xbb, 0xf9, 0x28, 0x5c, 0x6a, 0xcc, 0x87,
-	0x9b, 0x79, 0x17, 0xa6, 0x3b, 0xde, 0x42, 0x4d, 0xcc, 0x75, 0xd9, 0xcc, 0xc2, 0xc0, 0xa0, 0x42,
-	0x2f, 0x6f, 0x96, 0xe7, 0x3a, 0xfd, 0x0f, 0xa6, 0xfd, 0x96, 0x80, 0xd5, 0x60, 0xfc, 0xfb, 0x86,
-	0x63, 0x7e, 0x6a, 0xd6, 0xb9, 0x2c, 0x93, 0xdb, 0x5a, 0x82, 0x4c, 0x7d, 0x8f, 0x9a, 0x96, 0x6c,
-	0x64, 0xb6, 0x9a, 0xe6, 0xdf, 0x95, 0x06, 0xb9, 0x01, 0x59, 0xff, 0x5c, 0xe0, 0xf5, 0x1d, 0xe3,
-	0x8e, 0xf4, 0x7d, 0x22, 0x8f, 0xeb, 0x64, 0xe4, 0x71, 0xf5, 0x6e, 0xb1, 0xb1, 0xef, 0x1a, 0x8e,
-	0x45, 0x5b, 0x9e, 0x5d, 0xb4, 0x1a, 0xe4, 0x52, 0xa5, 0x11, 0xa3, 0x6b, 0x8e, 0x0d, 0xea, 0x1a,
-	0xef, 0xa0, 0xf9, 0x17, 0x2d, 0x25, 0x0e, 0x9a, 0xfc, 0x26, 0xcb, 0x90, 0xf5, 0x5e, 0x37, 0xea,
-	0x76, 0x1d, 0x23, 0x9f, 0xe6, 0x62, 0xaa, 0xbf, 0xc0, 0x7b, 0x43, 0xf7, 0x6b, 0x0d, 0xa3, 0x45,
-	0x0f, 0xf2, 0x19, 0xec, 0x0d, 0xdd, 0xdf, 0xf6, 0xbe, 0xb5, 0x7f, 0x14, 0x58, 0x1b, 0x5e, 0x3c,
-	0xec, 0xd1, 0xff, 0xbf, 0x7a, 0x4b, 0x90, 0x31, 0x19, 0x96, 0x20, 0xc5, 0xe7, 0x68, 0xda, 0x64,
-	0xbc, 0x02, 0x97, 0x7e, 0x9d, 0x85, 0x63, 0xbc, 0x02, 0xa4, 0x05, 0x29, 0xa1, 0xb3, 0x89, 0x16,
-	0x7b, 0xa1, 0x42, 0xda, 0x5c, 0x5d, 0x1f, 0x89, 0x11, 0x95, 0xd3, 0x96, 0x3e, 0xff, 0xfd, 0xaf,
-	0xef, 0x12, 0x0b, 0x64, 0x3e, 0xa0, 0xfb, 0xeb, 0x22, 0x47, 0x07, 0x92, 0x9e, 0xd4, 0x24, 0x6b,
-	0xb1, 0x71, 0x02, 0xc2, 0x5a, 0x3d, 0x39, 0x02, 0x81, 0x79, 0xd6, 0x79, 0x9e, 0x15, 0x52, 0x08,
-	0xe4, 0xf1, 0x0a, 0xa0, 0x3f, 0xf2, 0x25, 0xf9, 0x21, 0xf9, 0x46, 0x01, 0xe8, 0xab, 0x5b, 0x72,
-	0x7a, 0x68, 0xd8, 0x90, 0xee, 0x56, 0xcf, 0xbc, 0x12, 0x87, 0x24, 0x2e, 0x72, 0x12, 0x67, 0x49,
-	0x29, 0x42, 0x02, 0x7b, 0xc3, 0x90, 0x8c, 0xdf, 0xa9, 0x43, 0xf2, 0xa3, 0x02, 0xb9, 0xa0, 0x9a,
-	0x25, 0xa5, 0xd8, 0x5c, 0x31, 0x9a, 0x5b, 0xdd, 0x18, 0x03, 0x89, 0xbc, 0xae, 0x70, 0x5e, 0x65,
-	0x72, 0x7e, 0xe0, 0xc7, 0x17, 0x8e, 0x77, 0xa6, 0x3f, 0x8a, 0x8e, 0xfb, 0x43, 0xf2, 0x19, 0xa4,
-	0xe5, 0xf4, 0x58, 0x1f, 0x3d, 0x5f, 0x05, 0xa1, 0xb1, 0x86, 0xb0, 0x56, 0xe2, 0x5c, 0x34, 0xb2,
-	0x16, 0xe0, 0x82, 0xa7, 0x9d, 0xe9, 0x8f, 0xfa, 0x37, 0xe1, 0x90, 0xfc, 0xac, 0xc0, 0x6c, 0x44,
-	0x34, 0x92, 0x73, 0xb1, 0x39, 0xe2, 0x95, 0xac, 0x7a, 0x7e, 0x3c, 0x30, 0x12, 0xbb, 0xca, 0x89,
-	0x6d, 0x12, 0x3d, 0x40, 0xac, 0x23, 0xb0, 0xb5, 0x3e, 0xc1, 0x01, 0x55, 0x71, 0x48, 0xbe, 0x56,
-	0x20, 0xeb, 0x5f, 0x73, 0xf2, 0x66, 0x6c, 0xd2, 0xa8, 0x90, 0x54, 0x4f, 0xbf, 0x0a, 0x86, 0xac,
-	0x36, 0x39, 0xab, 0x73, 0x64, 0x23, 0xc0, 0xaa, 0xff, 0x8a, 0xc7, 0xf2, 0xf9, 0x49, 0x01, 0xe8,
-	0x8b, 0xa9, 0x21, 0xa7, 0x7c, 0x40, 0x1c, 0x0e, 0x39, 0xe5, 0x83, 0xaa, 0x4c, 0xdb, 0xe2, 0x94,
-	0xde, 0x21, 0xd7, 0x42, 0x1d, 0x14, 0xa0, 0x38, 0x42, 0x5e, 0x57, 0xc3, 0x22, 0xf1, 0x90, 0x7c,
-	0xa5, 0x40, 0xd6, 0xd7, 0x52, 0x43, 0x6a, 0x16, 0x15, 0x75, 0x43, 0x6a, 0x36, 0x20, 0xc9, 0x62,
-	0xaf, 0xa1, 0xa4, 0x10, 0x5f, 0xb2, 0xef, 0x15, 0x98, 0x8b, 0x4a, 0x2d, 0x12, 0x7f, 0x7c, 0x86,
-	0x28, 0x36, 0xf5, 0xc2, 0x98, 0x68, 0xe4, 0x78, 0x8a, 0x73, 0x2c, 0x92, 0xe5, 0x00, 0x47, 0xca,
-	0xc1, 0xb5, 0x7e, 0x7b, 0xbd, 0x81, 0x2c, 0x7e, 0x9f, 0x0c, 0x19, 0xc8, 0xa1, 0x9f, 0x40, 0x43,
-	0x06, 0x72, 0xf8, 0x07, 0x51, 0xec, 0x40, 0x16, 0xbf, 0x7a, 0xc8, 0x97, 0x0a, 0x4c, 0x87, 0x04,
-	0x17, 0xd9, 0x18, 0x75, 0xa5, 0x43, 0x8a, 0x51, 0x3d, 0x3b, 0x0e, 0x14, 0x39, 0x9c, 0xe4, 0x1c,
-	0x0a, 0x64, 0x69, 0x70, 0x06, 0xd4, 0x98, 0xc8, 0xfc, 0x85, 0x02, 0xb9, 0xa0, 0x5c, 0x1a, 0x32,
-	0x18, 0x63, 0x14, 0x9c, 0xba, 0x31, 0x06, 0x72, 0x0c, 0x22, 0x5c, 0x62, 0x31, 0xf2, 0x58, 0x81,
-	0x85, 0x18, 0x69, 0x40, 0xf4, 0x51, 0x59, 0x62, 0x14, 0x98, 0x7a, 0x71, 0x7c, 0x87, 0x11, 0xec,
-	0x7a, 0x1e, 0xf0, 0x40, 0x0e, 0xa4, 0xad, 0xca, 0xd3, 0xa3, 0xa2, 0xf2, 0xec, 0xa8, 0xa8, 0xfc,
-	0x79, 0x54, 0x54, 0xbe, 0x7d, 0x59, 0x9c, 0x78, 0xf6, 0xb2, 0x38, 0xf1, 0xc7, 0xcb, 0xe2, 0xc4,
-	0x07, 0x7a, 0xd3, 0x74, 0xf7, 0xba, 0xbb, 0xe5, 0xba, 0xdd, 0xd6, 0xbd, 0xc4, 0xfc, 0xbf, 0x6c,
-	0x75, 0xbb, 0xa5, 0x73, 0xe1, 0xa2, 0xf7, 0x2e, 0xeb, 0xfb, 0x32, 0xac, 0x7b, 0xd0, 0x31, 0xd8,
-	0x6e, 0x8a, 0x23, 0x2e, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x69, 0x17, 0x2e, 0x0f, 0x21, 0x14,
-	0x00, 0x00,
+	// 1763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x4f, 0x1b, 0xd7,
+	0x16, 0x67, 0x0c, 0x01, 0xfb, 0xf0, 0x11, 0xb8, 0x10, 0x62, 0x06, 0x30, 0x30, 0xe4, 0xf1, 0x95,
+	0xc4, 0x13, 0x93, 0xbc, 0x87, 0x5e, 0xde, 0x7b, 0xc9, 0x0b, 0x41, 0xef, 0x3d, 0xeb, 0x49, 0x09,
+	0x31, 0x12, 0x52, 0xab, 0xb6, 0xee, 0xc5, 0x9e, 0x9a, 0x51, 0x8c, 0xc7, 0x99, 0x3b, 0xb6, 0x40,
+	0x88, 0x2e, 0xba, 0xa8, 0x54, 0xa9, 0x5f, 0x52, 0x3f, 0xa4, 0x2a, 0x52, 0xa5, 0x2e, 0xba, 0xe8,
+	0xa2, 0x52, 0x17, 0x59, 0x65, 0x5f, 0x29, 0x9b, 0x4a, 0x51, 0xba, 0xe9, 0x2a, 0xaa, 0x48, 0xff,
+	0x8f, 0x56, 0x73, 0xef, 0xb9, 0xe3, 0x99, 0xf1, 0xd8, 0x18, 0x29, 0x59, 0x74, 0x05, 0xf7, 0x9e,
+	0xdf, 0x39, 0xe7, 0x77, 0x3e, 0xe6, 0xde, 0x73, 0x0d, 0xea, 0x0e, 0xad, 0x14, 0x75, 0xcb, 0xa6,
+	0x85, 0xb2, 0xa1, 0xd7, 0x33, 0xfa, 0x83, 0x9a, 0x61, 0x1f, 0xa4, 0xab, 0xb6, 0xe5, 0x58, 0x64,
+	0xc8, 0x95, 0xa5, 0x85, 0x2c, 0x5d, 0xcf, 0xa8, 0x63, 0x25, 0xab, 0x64, 0x71, 0x91, 0xee, 0xfe,
+	0x27, 0x50, 0xea, 0x54, 0xc9, 0xb2, 0x4a, 0x65, 0x43, 0xa7, 0x55, 0x53, 0xa7, 0x95, 0x8a, 0xe5,
+	0x50, 0xc7, 0xb4, 0x2a, 0x0c, 0xa5, 0x13, 0x05, 0x8b, 0xed, 0x59, 0x2c, 0x2f, 0xd4, 0xc4, 0x02,
+	0x45, 0x2b, 0x62, 0xa5, 0xef, 0x50, 0x66, 0x08, 0xbf, 0x7a, 0x3d, 0xb3, 0x63, 0x38, 0x34, 0xa3,
+	0x57, 0x69, 0xc9, 0xac, 0x70, 0x3b, 0x88, 0x9d, 0x0c, 0xd1, 0x44, 0x52, 0x5c, 0xa8, 0x8d, 0x01,
+	0xb9, 0xe7, 0xaa, 0xdf, 0xb6, 0x6a, 0x15, 0x87, 0xe5, 0x8c, 0x07, 0x35, 0x83, 0x39, 0xda, 0x97,
+	0x0a, 0x8c, 0x06, 0xb6, 0x59, 0xd5, 0xaa, 0x30, 0x83, 0xac, 0xc0, 0x48, 0x91, 0x3a, 0x34, 0xcf,
+	0xac, 0x9a, 0x5d, 0x30, 0xf2, 0x05, 0x57, 0x9a, 0x54, 0x66, 0x95, 0xa5, 0x9e, 0xdc, 0x59, 0x57,
+	0xb0, 0xc5, 0xf7, 0xb9, 0x12, 0x49, 0xc3, 0xa8, 0xf0, 0x94, 0x67, 0x05, 0xdb, 0xac, 0x3a, 0x88,
+	0x8e, 0x71, 0xf4, 0x88, 0x10, 0x6d, 0x71, 0x89, 0xc0, 0xcf, 0xc3, 0xa0, 0x2d, 0xdc, 0x23, 0xb2,
+	0x9b, 0x23, 0x07, 0x70, 0x93, 0x83, 0x34, 0x1d, 0x86, 0x39, 0xaf, 0x0d, 0xea, 0x50, 0x24, 0x4b,
+	0x26, 0x21, 0xc1, 0x49, 0xed, 0x52, 0xb6, 0xcb, 0xc9, 0x24, 0x72, 0x71, 0x77, 0xe3, 0x7f, 0x94,
+	0xed, 0x6a, 0x8b, 0x30, 0xe2, 0x53, 0xc0, 0x30, 0x08, 0xf4, 0xb8, 0x00, 0x0e, 0x1e, 0xc8, 0xf1,
+	0xff, 0xb5, 0x1b, 0x30, 0xee, 0x01, 0x45, 0x18, 0xd2, 0xfe, 0x05, 0x18, 0xf2, 0x07, 0x6d, 0x16,
+	0x31, 0xe2, 0x81, 0x46, 0xc4, 0xd9, 0xa2, 0xb6, 0x0d, 0xe7, 0x9b, 0xf4, 0xd1, 0xdd, 0x3f, 0xa0,
+	0xdf, 0x67, 0x80, 0x6b, 0xf7, 0xaf, 0xaa, 0xe9, 0x60, 0x87, 0xa4, 0x7d, 0x8a, 0xd0, 0xb0, 0xac,
+	0x7d, 0xac, 0x40, 0x2a, 0x64, 0x78, 0xdb, 0xb0, 0x99, 0xdb, 0x26, 0xa7, 0x22, 0x48, 0xfe, 0x03,
+	0xd0, 0x68, 0x0d, 0x5e, 0x86, 0xfe, 0xd5, 0x85, 0x34, 0x76, 0x95, 0xdb, 0x47, 0x69, 0xd1, 0xbf,
+	0xd8, 0x47, 0xe9, 0x4d, 0x5a, 0x92, 0x29, 0xc8, 0xf9, 0x34, 0xb5, 0x1f, 0x15, 0x98, 0x69, 0x49,
+	0x08, 0x23, 0x7e, 0x0d, 0xc6, 0xfc, 0x8c, 0xea, 0x28, 0x4f, 0x2a, 0xb3, 0xdd, 0x4b, 0xfd, 0xab,
+	0x73, 0xad, 0x43, 0x47, 0x4b, 0xeb, 0x3d, 0x4f, 0x9e, 0xcf, 0x74, 0xe5, 0x48, 0xb1, 0xc9, 0x05,
+	0xf9, 0x6f, 0x44, 0x18, 0x8b, 0x27, 0x86, 0x21, 0x78, 0x05, 0xe2, 0xd8, 0x80, 0x24, 0x0f, 0xe3,
+	0xae, 0xaf, 0x13, 0x65, 0x46, 0x97, 0x60, 0x38, 0xd8, 0xbb, 0x5e, 0x4e, 0x87, 0xfc, 0x8d, 0x9b,
+	0x2d, 0x6a, 0x6f, 0xc1, 0x44, 0x84, 0x15, 0x4c, 0xc3, 0x2d, 0x18, 0x0c, 0x98, 0xc1, 0xd2, 0x4f,
+	0x85, 0xe3, 0x0f, 0x28, 0x0f, 0xf8, 0x3d, 0x68, 0x9f, 0x2b, 0x30, 0xdb, 0xe4, 0x20, 0xdc, 0x00,
+	0x1d, 0xd3, 0x7d, 0x69, 0x4d, 0xf0, 0x93, 0x02, 0x73, 0x6d, 0x68, 0x61, 0xfc, 0x79, 0x18, 0x0f,
+	0xf2, 0x0a, 0x35, 0xc2, 0x7c, 0xbb, 0x44, 0x04, 0x5b, 0x61, 0xcc, 0x8a, 0x70, 0xf4, 0xf2, 0x9a,
+	0xe1, 0x1a, 0x9e, 0x77, 0x32, 0x56, 0xf1, 0x87, 0x4c, 0x03, 0xc8, 0x33, 0xc9, 0x4b, 0x69, 0x02,
+	0x77, 0xb2, 0x45, 0xed, 0xeb, 0x18, 0x8c, 0x05, 0xd5, 0x30, 0xf0, 0x0c, 0xf4, 0x21, 0x0a, 0x4b,
+	0x7e, 0x3e, 0x1c, 0xa9, 0xd4, 0x90, 0x38, 0xf2, 0x37, 0x57, 0xa5, 0x6a, 0xd9, 0x0e, 0x4b, 0xc6,
+	0x78, 0x72, 0xc6, 0x9b, 0x55, 0x5c, 0x31, 0xe6, 0x43, 0x82, 0x49, 0x1a, 0x7a, 0x6d, 0x83, 0xd5,
+	0xca, 0xe2, 0xbc, 0x8c, 0x54, 0x73, 0xa5, 0x39, 0x44, 0x91, 0x35, 0xe8, 0x63, 0x66, 0xa9, 0x62,
+	0x56, 0x4a, 0xc9, 0x1e, 0xae, 0x30, 0x1d, 0x56, 0xd8, 0x12, 0x62, 0xd4, 0x93, 0x68, 0xb2, 0x0a,
+	0xe7, 0x22, 0x8b, 0x99, 0x3c, 0xc3, 0xd3, 0x32, 0x1a, 0x51, 0x20, 0x6d, 0x0f, 0x26, 0x79, 0x7e,
+	0x36, 0x8d, 0x4a, 0x91, 0x9b, 0xe4, 0xb1, 0x7a, 0x7d, 0x7b, 0x07, 0x46, 0xea, 0xb4, 0x6c, 0x16,
+	0xa9, 0x63, 0xd9, 0x79, 0x5a, 0x2c, 0xda, 0x06, 0x63, 0xe2, 0x04, 0x5f, 0x9f, 0x7b, 0xf6, 0xe8,
+	0xf2, 0x34, 0x16, 0x72, 0x5b, 0x62, 0x6e, 0x09, 0xc8, 0x96, 0x63, 0xbb, 0xc6, 0x86, 0xeb, 0xa1,
+	0x7d, 0xed, 0x2e, 0x4c, 0x45, 0xbb, 0xc3, 0xb2, 0xe8, 0xd0, 0xdf, 0x28, 0xa7, 0x68, 0xc2, 0x9e,
+	0xf5, 0xa1, 0xe3, 0xe7, 0x33, 0x80, 0xd0, 0xec, 0x06, 0xcb, 0x81, 0x57, 0x5f, 0xe6, 0xdd, 0x8e,
+	0x9b, 0xd4, 0xa6, 0x7b, 0xde, 0xed, 0xf8, 0x7f, 0x18, 0x0d, 0xec, 0xa2, 0xf5, 0x6b, 0xd0, 0x5b,
+	0xe5, 0x3b, 0x49, 0x25, 0xba, 0x12, 0x02, 0x8f, 0x05, 0x44, 0xac, 0x56, 0x82, 0x73, 0xdc, 0x98,
+	0x17, 0xe4, 0xab, 0x4a, 0xce, 0x3d, 0xbc, 0xe0, 0x7c, 0x8e, 0x90, 0xf8, 0x1a, 0xf4, 0x32, 0x87,
+	0x3a, 0x35, 0x49, 0x7c, 0x26, 0x4c, 0xdc, 0x53, 0xd9, 0xe2, 0xb0, 0x1c, 0xc2, 0xb5, 0xef, 0x15,
+	0xb4, 0x99, 0x65, 0xa2, 0x39, 0x8d, 0x57, 0xc5, 0x9e, 0xdc, 0x86, 0x61, 0x1b, 0x5d, 0x78, 0xe6,
+	0x62, 0xdc, 0x5c, 0xf2, 0xd9, 0xa3, 0xcb, 0x63, 0x68, 0x2e, 0x68, 0xe5, 0xac, 0xd4, 0x90, 0x29,
+	0xb8, 0x0e, 0xe7, 0x9b, 0xe8, 0x62, 0x0e, 0x66, 0xa0, 0xdf, 0x64, 0x79, 0xa9, 0xc0, 0x99, 0xc6,
+	0x73, 0x60, 0x7a, 0x40, 0xaf, 0x4e, 0x72, 0xe3, 0x95, 0x35, 0xf1, 0x1d, 0x18, 0x0f, 0x3b, 0xf2,
+	0x1a, 0x2c, 0xee, 0x23, 0xd8, 0xdd, 0x36, 0x76, 0x0f, 0xa9, 0xa5, 0xf0, 0xa3, 0xb8, 0x55, 0x70,
+	0xcc, 0xba, 0xe1, 0xd1, 0xf0, 0xba, 0xf9, 0x6d, 0x98, 0x6e, 0x21, 0x47, 0xb7, 0x37, 0x01, 0x3c,
+	0x92, 0xf2, 0xe4, 0x6e, 0x6a, 0x91, 0x90, 0x76, 0xce, 0xa7, 0xa2, 0x3d, 0x54, 0xf0, 0x92, 0x44,
+	0x97, 0x5b, 0x06, 0xb5, 0x0b, 0xbb, 0xa7, 0xbf, 0xbc, 0x54, 0x88, 0x17, 0x68, 0xb9, 0xcc, 0x47,
+	0xb7, 0x98, 0x98, 0xf3, 0xe4, 0xda, 0x1d, 0x02, 0x29, 0xbb, 0x1f, 0x98, 0x1c, 0xe3, 0x94, 0xdd,
+	0x17, 0xa3, 0xe5, 0x24, 0x24, 0xf6, 0xcc, 0x0a, 0x0a, 0x7b, 0x84, 0x70, 0xcf, 0xac, 0x70, 0xa1,
+	0xf6, 0x06, 0xa8, 0x51, 0xe4, 0x30, 0xf8, 0x1b, 0xe1, 0x93, 0xfc, 0x42, 0x38, 0xf2, 0xa8, 0x0b,
+	0xc0, 0x3b, 0xd6, 0xb5, 0x0a, 0x24, 0xfd, 0x80, 0x4d, 0xdb, 0x6c, 0x0c, 0x96, 0x49, 0xe8, 0x63,
+	0x07, 0x7b, 0x3b, 0x56, 0x59, 0x64, 0x35, 0x91, 0x93, 0xcb, 0x60, 0x34, 0xb1, 0x76, 0xd1, 0x74,
+	0x87, 0xa2, 0x79, 0x13, 0x26, 0x22, 0xfc, 0x61, 0x30, 0xff, 0x86, 0xc1, 0xaa, 0xbb, 0x91, 0x17,
+	0x77, 0x81, 0x2c, 0xe6, 0x64, 0xd3, 0x41, 0x85, 0x5a, 0xee, 0xf9, 0x3f, 0x50, 0x6d, 0x2c, 0x98,
+	0xf6, 0x38, 0x06, 0x33, 0x7e, 0xfb, 0xdb, 0x86, 0x6d, 0xbe, 0x63, 0x16, 0xf8, 0x25, 0x2a, 0xc3,
+	0x9a, 0x80, 0x78, 0x61, 0x97, 0x9a, 0x15, 0x59, 0xc8, 0x44, 0xae, 0x8f, 0xaf, 0xb3, 0x45, 0x72,
+	0x13, 0x12, 0x5e, 0x5f, 0x24, 0x63, 0x9d, 0x7e, 0x23, 0x0d, 0x9d, 0xd0, 0x85, 0xdc, 0x1d, 0xba,
+	0x90, 0xdd, 0xaf, 0xd8, 0xd8, 0x77, 0x0c, 0xbb, 0x42, 0xcb, 0xae, 0x5c, 0x94, 0x1a, 0xe4, 0x56,
+	0xb6, 0x18, 0x31, 0x2a, 0x9f, 0x89, 0x18, 0x95, 0x55, 0xdf, 0x87, 0xd6, 0x2b, 0x1a, 0x4d, 0xae,
+	0xc9, 0x14, 0x24, 0xdc, 0x1b, 0x91, 0x3a, 0x35, 0xdb, 0x48, 0xf6, 0xf1, 0x07, 0x44, 0x63, 0x83,
+	0xd7, 0x86, 0xee, 0xe7, 0x8b, 0x46, 0x99, 0x1e, 0x24, 0xe3, 0x58, 0x1b, 0xba, 0xbf, 0xe1, 0xae,
+	0xb5, 0xdf, 0xe5, 0x2c, 0x17, 0x99, 0x3c, 0xac, 0xd1, 0x9f, 0x3f, 0x7b, 0x13, 0x10, 0x37, 0x19,
+	0xa6, 0xa0, 0x97, 0x9f, 0xa3, 0x7d, 0x26, 0xe3, 0x19, 0x58, 0xfd, 0x8e, 0xc0, 0x19, 0x9e, 0x01,
+	0x52, 0x86, 0x5e, 0xf1, 0xb6, 0x24, 0x5a, 0xe4, 0x07, 0x15, 0x78, 0x8f, 0xaa, 0xf3, 0x6d, 0x31,
+	0x22, 0x73, 0xda, 0xc4, 0x7b, 0x3f, 0xff, 0xf6, 0x59, 0x6c, 0x94, 0x8c, 0xf8, 0xde, 0xba, 0x05,
+	0xe1, 0xa3, 0x0a, 0x3d, 0xee, 0x1b, 0x83, 0xcc, 0x46, 0xda, 0xf1, 0x3d, 0x26, 0xd5, 0xb9, 0x36,
+	0x08, 0xf4, 0x33, 0xcf, 0xfd, 0x4c, 0x93, 0x49, 0x9f, 0x1f, 0x37, 0x01, 0xfa, 0xa1, 0xf7, 0x0c,
+	0x3d, 0x22, 0x9f, 0x28, 0x00, 0x8d, 0x67, 0x0d, 0x59, 0x68, 0x69, 0x36, 0xf0, 0xd6, 0x54, 0x17,
+	0x4f, 0xc4, 0x21, 0x89, 0x2b, 0x9c, 0xc4, 0x0a, 0x59, 0x0a, 0x91, 0xc0, 0xda, 0x30, 0xfd, 0xd0,
+	0xb7, 0xca, 0x9b, 0xc5, 0x23, 0xf2, 0x83, 0x02, 0xa4, 0xf9, 0xc9, 0x46, 0xd2, 0x27, 0x78, 0x0c,
+	0xbd, 0x35, 0x54, 0xbd, 0x63, 0x3c, 0x32, 0xfd, 0x3b, 0x67, 0x7a, 0x95, 0x64, 0x3a, 0x65, 0xaa,
+	0xcb, 0x57, 0x02, 0xf9, 0x4a, 0x81, 0x01, 0xff, 0x93, 0x80, 0x2c, 0x45, 0x3a, 0x8f, 0x78, 0xc1,
+	0xa9, 0xcb, 0x1d, 0x20, 0x91, 0xe0, 0x35, 0x4e, 0x30, 0x4d, 0x2e, 0x35, 0xfd, 0x46, 0x82, 0x37,
+	0x12, 0xd3, 0x0f, 0xc3, 0x37, 0xd4, 0x11, 0x79, 0xac, 0xc0, 0x58, 0xd4, 0xe3, 0x87, 0x5c, 0x39,
+	0xd1, 0x73, 0x38, 0xa5, 0x99, 0x53, 0x68, 0x20, 0xe7, 0x7f, 0x71, 0xce, 0x6b, 0xe4, 0xaf, 0xa7,
+	0xe1, 0xdc, 0x48, 0xec, 0xbb, 0xd0, 0x27, 0x4f, 0xeb, 0xf9, 0xf6, 0xf7, 0x99, 0x60, 0xd8, 0xd1,
+	0xa5, 0xa7, 0x2d, 0x71, 0x52, 0x1a, 0x99, 0xf5, 0x91, 0xc2, 0xd3, 0x85, 0xe9, 0x87, 0x8d, 0x93,
+	0xe7, 0x88, 0x7c, 0xab, 0xc0, 0xd9, 0xd0, 0x90, 0x4e, 0x2e, 0x46, 0xfa, 0x88, 0x7e, 0x39, 0xa8,
+	0x97, 0x3a, 0x03, 0x23, 0xb1, 0x35, 0x4e, 0x2c, 0x43, 0x74, 0x1f, 0xb1, 0xaa, 0xc0, 0xe6, 0x1b,
+	0x04, 0x9b, 0xa6, 0xb8, 0x23, 0xf2, 0x91, 0x02, 0x09, 0xef, 0x58, 0x25, 0x7f, 0x89, 0x74, 0x1a,
+	0x1e, 0xdc, 0xd5, 0x85, 0x93, 0x60, 0xc8, 0x2a, 0xc3, 0x59, 0x5d, 0x24, 0xcb, 0x3e, 0x56, 0x1e,
+	0x87, 0x68, 0x3e, 0xdf, 0x28, 0x00, 0x8d, 0xe1, 0xb5, 0xc5, 0xa9, 0xd2, 0x34, 0x8c, 0xab, 0x8b,
+	0x27, 0xe2, 0x90, 0xd2, 0x3a, 0xa7, 0xf4, 0x4f, 0x72, 0x3d, 0x50, 0x41, 0x01, 0x8a, 0x22, 0xa4,
+	0x1f, 0x4a, 0x69, 0x83, 0xe3, 0x87, 0x0a, 0x24, 0xa4, 0x61, 0xd6, 0x22, 0x67, 0xe1, 0x21, 0x5a,
+	0x5d, 0x38, 0x09, 0xd6, 0xe6, 0xd8, 0x93, 0x14, 0xa2, 0x53, 0xf6, 0x85, 0x02, 0xc3, 0xe1, 0xd1,
+	0x96, 0x44, 0xb7, 0x4f, 0x8b, 0x09, 0x59, 0xbd, 0xdc, 0x21, 0x1a, 0x39, 0x5e, 0xe0, 0x1c, 0x53,
+	0x64, 0xca, 0xc7, 0x91, 0x72, 0x70, 0xbe, 0x51, 0x5e, 0xf7, 0x02, 0x14, 0xef, 0xc1, 0x16, 0x17,
+	0x60, 0xe0, 0xc9, 0xa9, 0xce, 0xb7, 0xc5, 0xb4, 0xb9, 0x00, 0xc5, 0x2b, 0x93, 0x7c, 0xa0, 0xc0,
+	0x60, 0x60, 0xc0, 0x25, 0xcb, 0xed, 0x3e, 0xe9, 0xc0, 0x84, 0xae, 0xae, 0x74, 0x02, 0x45, 0x0e,
+	0x73, 0x9c, 0xc3, 0x24, 0x99, 0x68, 0x3e, 0x03, 0xf2, 0x4c, 0x78, 0x7e, 0x5f, 0x81, 0x01, 0xff,
+	0x78, 0xda, 0xe2, 0x54, 0x8f, 0x98, 0x98, 0xd5, 0xe5, 0x0e, 0x90, 0x1d, 0x10, 0xe1, 0x23, 0x2d,
+	0x23, 0x0f, 0x15, 0x18, 0x8d, 0x18, 0xc5, 0x88, 0xde, 0xce, 0x4b, 0xc4, 0xc4, 0xab, 0x5e, 0xe9,
+	0x5c, 0xa1, 0x0d, 0xbb, 0xba, 0x0b, 0x3c, 0x90, 0x07, 0xd2, 0x7a, 0xf6, 0xc9, 0x71, 0x4a, 0x79,
+	0x7a, 0x9c, 0x52, 0x7e, 0x3d, 0x4e, 0x29, 0x9f, 0xbe, 0x48, 0x75, 0x3d, 0x7d, 0x91, 0xea, 0xfa,
+	0xe5, 0x45, 0xaa, 0xeb, 0x75, 0xbd, 0x64, 0x3a, 0xbb, 0xb5, 0x9d, 0x74, 0xc1, 0xda, 0xd3, 0x5d,
+	0xc7, 0xfc, 0x97, 0xfc, 0x82, 0x55, 0xd6, 0xf9, 0xa0, 0xa8, 0xd7, 0xaf, 0xea, 0xfb, 0xd2, 0xac,
+	0x73, 0x50, 0x35, 0xd8, 0x4e, 0x2f, 0x47, 0x5c, 0xfd, 0x63, 0x00, 0x41, 0x94, 0xc1, 0xe6, 0xb1,
+	0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1673,8 +1927,14 @@ type QueryClient interface {
 	Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error)
 	// DataSource queries data source summary info for given data source id.
 	DataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error)
+	// DataSourceVersions queries all versions of the data source for given data
+	// source id.
+	DataSourceVersions(ctx context.Context, in *QueryDataSourceVersionsRequest, opts ...grpc.CallOption) (*QueryDataSourceVersionsResponse, error)
 	// OracleScript queries oracle script summary info for given oracle script id.
 	OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error)
+	// OracleScriptVersions queries all versions of the oracle script for given
+	// oracle script id.
+	OracleScriptVersions(ctx context.Context, in *QueryOracleScriptVersionsRequest, opts ...grpc.CallOption) (*QueryOracleScriptVersionsResponse, error)
 	// Request queries request info for given request id.
 	Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error)
 	// PendingRequests queries list of pending request IDs assigned to given
@@ -1735,6 +1995,15 @@ func (c *queryClient) DataSource(ctx context.Context, in *QueryDataSourceRequest
 	return out, nil
 }
 
+func (c *queryClient) DataSourceVersions(ctx context.Context, in *QueryDataSourceVersionsRequest, opts ...grpc.CallOption) (*QueryDataSourceVersionsResponse, error) {
+	out := new(QueryDataSourceVersionsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/DataSourceVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error) {
 	out := new(QueryOracleScriptResponse)
 	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/OracleScript", in, out, opts...)
@@ -1744,6 +2013,15 @@ func (c *queryClient) OracleScript(ctx context.Context, in *QueryOracleScriptReq
 	return out, nil
 }
 
+func (c *queryClient) OracleScriptVersions(ctx context.Context, in *QueryOracleScriptVersionsRequest, opts ...grpc.CallOption) (*QueryOracleScriptVersionsResponse, error) {
+	out := new(QueryOracleScriptVersionsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/OracleScriptVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error) {
 	out := new(QueryRequestResponse)
 	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Request", in, out, opts...)
@@ -1844,8 +2122,14 @@ type QueryServer interface {
 	Data(context.Context, *QueryDataRequest) (*QueryDataResponse, error)
 	// DataSource queries data source summary info for given data source id.
 	DataSource(context.Context, *QueryDataSourceRequest) (*QueryDataSourceResponse, error)
+	// DataSourceVersions queries all versions of the data source for given data
+	// source id.
+	DataSourceVersions(context.Context, *QueryDataSourceVersionsRequest) (*QueryDataSourceVersionsResponse, error)
 	// OracleScript queries oracle script summary info for given oracle script id.
 	OracleScript(context.Context, *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error)
+	// OracleScriptVersions queries all versions of the oracle script for given
+	// oracle script id.
+	OracleScriptVersions(context.Context, *QueryOracleScriptVersionsRequest) (*QueryOracleScriptVersionsResponse, error)
 	// Request queries request info for given request id.
 	Request(context.Context, *QueryRequestRequest) (*QueryRequestResponse, error)
 	// PendingRequests queries list of pending request IDs assigned to given
@@ -1884,9 +2168,15 @@ func (*UnimplementedQueryServer) Data(ctx context.Context, req *QueryDataRequest
 func (*UnimplementedQueryServer) DataSource(ctx context.Context, req *QueryDataSourceRequest) (*QueryDataSourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DataSource not implemented")
 }
+func (*UnimplementedQueryServer) DataSourceVersions(ctx context.Context, req *QueryDataSourceVersionsRequest) (*QueryDataSourceVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataSourceVersions not implemented")
+}
 func (*UnimplementedQueryServer) OracleScript(ctx context.Context, req *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OracleScript not implemented")
 }
+func (*UnimplementedQueryServer) OracleScriptVersions(ctx context.Context, req *QueryOracleScriptVersionsRequest) (*QueryOracleScriptVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleScriptVersions not implemented")
+}
 func (*UnimplementedQueryServer) Request(ctx context.Context, req *QueryRequestRequest) (*QueryRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
 }
@@ -1976,6 +2266,24 @@ func _Query_DataSource_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DataSourceVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataSourceVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataSourceVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/DataSourceVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataSourceVersions(ctx, req.(*QueryDataSourceVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_OracleScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryOracleScriptRequest)
 	if err := dec(in); err != nil {
@@ -1994,6 +2302,24 @@ func _Query_OracleScript_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OracleScriptVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleScriptVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleScriptVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/OracleScriptVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleScriptVersions(ctx, req.(*QueryOracleScriptVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRequestRequest)
 	if err := dec(in); err != nil {
@@ -2190,10 +2516,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DataSource",
 			Handler:    _Query_DataSource_Handler,
 		},
+		{
+			MethodName: "DataSourceVersions",
+			Handler:    _Query_DataSourceVersions_Handler,
+		},
 		{
 			MethodName: "OracleScript",
 			Handler:    _Query_OracleScript_Handler,
 		},
+		{
+			MethodName: "OracleScriptVersions",
+			Handler:    _Query_OracleScriptVersions_Handler,
+		},
 		{
 			MethodName: "Request",
 			Handler:    _Query_Request_Handler,
@@ -2423,7 +2757,7 @@ func (m *QueryDataSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOracleScriptRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDataSourceVersionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2433,25 +2767,37 @@ func (m *QueryOracleScriptRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOracleScriptRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDataSourceVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOracleScriptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDataSourceVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OracleScriptId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DataSourceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOracleScriptResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDataSourceVersionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2461,19 +2807,19 @@ func (m *QueryOracleScriptResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOracleScriptResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDataSourceVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOracleScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDataSourceVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OracleScript != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.OracleScript.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2481,15 +2827,181 @@ func (m *QueryOracleScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if len(m.DataSourceVersions) > 0 {
+		for iNdEx := len(m.DataSourceVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataSourceVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleScriptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleScriptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleScriptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OracleScriptId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleScriptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleScriptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OracleScript != nil {
+		{
+			size, err := m.OracleScript.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleScriptVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleScriptVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleScriptVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleScriptId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleScriptVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleScriptVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleScriptVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OracleScriptVersions) > 0 {
+		for iNdEx := len(m.OracleScriptVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleScriptVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2534,6 +3046,11 @@ func (m *QueryRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Signing != nil {
 		{
 			size, err := m.Signing.MarshalToSizedBuffer(dAtA[:i])
@@ -2638,20 +3155,20 @@ func (m *QueryPendingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	var l int
 	_ = l
 	if len(m.RequestIDs) > 0 {
-		dAtA7 := make([]byte, len(m.RequestIDs)*10)
-		var j6 int
+		dAtA11 := make([]byte, len(m.RequestIDs)*10)
+		var j10 int
 		for _, num := range m.RequestIDs {
 			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j6++
+				j10++
 			}
-			dAtA7[j6] = uint8(num)
-			j6++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3352,6 +3869,41 @@ func (m *QueryDataSourceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDataSourceVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceId != 0 {
+		n += 1 + sovQuery(uint64(m.DataSourceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataSourceVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DataSourceVersions) > 0 {
+		for _, e := range m.DataSourceVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryOracleScriptRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3377,6 +3929,41 @@ func (m *QueryOracleScriptResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOracleScriptVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptId != 0 {
+		n += 1 + sovQuery(uint64(m.OracleScriptId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleScriptVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleScriptVersions) > 0 {
+		for _, e := range m.OracleScriptVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryRequestRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3413,6 +4000,9 @@ func (m *QueryRequestResponse) Size() (n int) {
 		l = m.Signing.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovQuery(uint64(m.OracleScriptVersion))
+	}
 	return n
 }
 
@@ -4193,7 +4783,7 @@ func (m *QueryDataSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDataSourceVersionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4216,17 +4806,17 @@ func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleScriptRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataSourceVersionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleScriptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataSourceVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 			}
-			m.OracleScriptId = 0
+			m.DataSourceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4236,7 +4826,232 @@ func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptId |= uint64(b&0x7F) << shift
+				m.DataSourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataSourceVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataSourceVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataSourceVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataSourceVersions = append(m.DataSourceVersions, DataSourceVersion{})
+			if err := m.DataSourceVersions[len(m.DataSourceVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleScriptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleScriptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+			}
+			m.OracleScriptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4348,6 +5163,231 @@ func (m *QueryOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOracleScriptVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleScriptVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleScriptVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+			}
+			m.OracleScriptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleScriptVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleScriptVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleScriptVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleScriptVersions = append(m.OracleScriptVersions, OracleScriptVersion{})
+			if err := m.OracleScriptVersions[len(m.OracleScriptVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4588,6 +5628,25 @@ func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+			}
+			m.OracleScriptVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -22,6 +22,7 @@ type RequestSpec interface {
 	GetExecuteGas() uint64
 	GetFeeLimit() sdk.Coins
 	GetTSSEncoder() Encoder
+	GetOracleScriptVersion() uint64
 }
 
 func NewRawRequest(
@@ -58,6 +58,9 @@ type MsgRequestData struct {
 	Sender string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
 	// TSSEncoder is the mode of encoding oracle result signature order.
 	TSSEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be used, or 0
+	// to use the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,11,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
 }
 
 func (m *MsgRequestData) Reset()         { *m = MsgRequestData{} }
@@ -163,6 +166,13 @@ func (m *MsgRequestData) GetTSSEncoder() Encoder {
 	return ENCODER_UNSPECIFIED
 }
 
+func (m *MsgRequestData) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
+}
+
 // MsgRequestDataResponse is response data for MsgRequestData message
 type MsgRequestDataResponse struct {
 }
@@ -322,6 +332,9 @@ type MsgCreateDataSource struct {
 	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
 	// Sender is the signer of this message.
 	Sender string `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Cacheable indicates that validators may reuse a recent result of the data
+	// source for the same calldata instead of executing it for every raw request.
+	Cacheable bool `protobuf:"varint,8,opt,name=cacheable,proto3" json:"cacheable,omitempty"`
 }
 
 func (m *MsgCreateDataSource) Reset()         { *m = MsgCreateDataSource{} }
@@ -406,6 +419,13 @@ func (m *MsgCreateDataSource) GetSender() string {
 	return ""
 }
 
+func (m *MsgCreateDataSource) GetCacheable() bool {
+	if m != nil {
+		return m.Cacheable
+	}
+	return false
+}
+
 // MsgCreateDataSourceResponse is response data for MsgCreateDataSource message
 type MsgCreateDataSourceResponse struct {
 }
@@ -466,6 +486,9 @@ type MsgEditDataSource struct {
 	// Sender is the signer of this message. Must be the current data source's
 	// owner.
 	Sender string `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Cacheable is the option to update whether validators may reuse a recent
+	// result of the data source for the same calldata.
+	Cacheable CacheableOption `protobuf:"varint,9,opt,name=cacheable,proto3,enum=band.oracle.v1.CacheableOption" json:"cacheable,omitempty"`
 }
 
 func (m *MsgEditDataSource) Reset()         { *m = MsgEditDataSource{} }
@@ -557,6 +580,13 @@ func (m *MsgEditDataSource) GetSender() string {
 	return ""
 }
 
+func (m *MsgEditDataSource) GetCacheable() CacheableOption {
+	if m != nil {
+		return m.Cacheable
+	}
+	return CACHEABLE_OPTION_DO_NOT_MODIFY
+}
+
 // MsgEditDataSourceResponse is response data for MsgEditDataSource message
 type MsgEditDataSourceResponse struct {
 }
@@ -1090,88 +1120,91 @@ func init() {
 func init() { proto.RegisterFile("band/oracle/v1/tx.proto", fileDescriptor_3ffde65d794f19e2) }
 
 var fileDescriptor_3ffde65d794f19e2 = []byte{
-	// 1284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x36, 0xad, 0x8f, 0x88, 0x23, 0x59, 0x49, 0x98, 0x0f, 0xc9, 0x34, 0x2c, 0x2a, 0xca, 0xc7,
-	0xab, 0x24, 0xb0, 0x98, 0x38, 0xc1, 0x0b, 0xc4, 0x97, 0x36, 0x72, 0xd2, 0xd6, 0x40, 0xd4, 0x16,
-	0x74, 0x53, 0x14, 0x05, 0x5a, 0x61, 0x45, 0x6e, 0x68, 0x36, 0x12, 0xa9, 0x72, 0x57, 0x4a, 0x72,
-	0x2b, 0x7a, 0xec, 0xa5, 0xed, 0x3f, 0xe8, 0xad, 0x40, 0x81, 0x02, 0x39, 0xe4, 0x2f, 0x14, 0xc8,
-	0x31, 0x48, 0x2f, 0x3d, 0xa9, 0x85, 0x72, 0x48, 0xef, 0x3d, 0x14, 0xe8, 0xa9, 0xd8, 0xe5, 0x8a,
-	0x22, 0x65, 0xca, 0x76, 0x8c, 0x34, 0x17, 0x9b, 0xb3, 0xcf, 0x33, 0xb3, 0xc3, 0x79, 0x66, 0x96,
-	0x2b, 0x28, 0x75, 0x90, 0x6b, 0xe9, 0x9e, 0x8f, 0xcc, 0x2e, 0xd6, 0x87, 0x57, 0x75, 0xfa, 0xb0,
-	0xd1, 0xf7, 0x3d, 0xea, 0x29, 0x45, 0x06, 0x34, 0x02, 0xa0, 0x31, 0xbc, 0xaa, 0x9e, 0xb4, 0x3d,
-	0xdb, 0xe3, 0x90, 0xce, 0x9e, 0x02, 0x96, 0xba, 0x32, 0xe3, 0x2e, 0xf8, 0x01, 0x58, 0x31, 0x3d,
-	0xd2, 0xf3, 0x88, 0xde, 0x41, 0x84, 0x81, 0x1d, 0x4c, 0xd1, 0x55, 0xdd, 0xf4, 0x1c, 0x57, 0xe0,
-	0xcb, 0x01, 0xde, 0x0e, 0xa2, 0x06, 0x86, 0x80, 0x4a, 0xc2, 0xb5, 0x47, 0x6c, 0x16, 0xb6, 0x47,
-	0x6c, 0x01, 0x1c, 0x47, 0x3d, 0xc7, 0xf5, 0x74, 0xfe, 0x37, 0x58, 0xaa, 0xfd, 0x9c, 0x86, 0x62,
-	0x8b, 0xd8, 0x06, 0xfe, 0x72, 0x80, 0x09, 0xbd, 0x85, 0x28, 0x52, 0xde, 0x87, 0x63, 0x41, 0x26,
-	0x6d, 0x62, 0xfa, 0x4e, 0x9f, 0xb6, 0x1d, 0xab, 0x2c, 0x55, 0xa5, 0x7a, 0xba, 0x79, 0x6e, 0x3c,
-	0xd2, 0x8a, 0x1f, 0x70, 0x6c, 0x9b, 0x43, 0x5b, 0xb7, 0xfe, 0xd9, 0xb5, 0x62, 0x14, 0xbd, 0xa8,
-	0x6d, 0x29, 0x2a, 0xe4, 0x4c, 0xd4, 0xed, 0x5a, 0x88, 0xa2, 0xf2, 0x62, 0x55, 0xaa, 0x17, 0x8c,
-	0xd0, 0x56, 0x56, 0x40, 0x46, 0xe4, 0x7e, 0xdb, 0xf4, 0x06, 0x2e, 0x2d, 0xa7, 0xd8, 0x26, 0x46,
-	0x0e, 0x91, 0xfb, 0x9b, 0xcc, 0x66, 0x60, 0xcf, 0x71, 0x05, 0x98, 0x0e, 0xc0, 0x9e, 0xe3, 0x06,
-	0xe0, 0x45, 0x90, 0xcd, 0xae, 0x83, 0x5d, 0x9e, 0x5e, 0xa6, 0x2a, 0xd5, 0xe5, 0x66, 0x61, 0x3c,
-	0xd2, 0x72, 0x9b, 0x7c, 0x71, 0xeb, 0x96, 0x91, 0x0b, 0xe0, 0x2d, 0x4b, 0xd9, 0x01, 0xf9, 0x1e,
-	0xc6, 0xed, 0xae, 0xd3, 0x73, 0x68, 0x39, 0x5b, 0x4d, 0xd5, 0xf3, 0xeb, 0xcb, 0x0d, 0x51, 0x31,
-	0x56, 0xde, 0x86, 0x28, 0x6f, 0x63, 0xd3, 0x73, 0xdc, 0xe6, 0x95, 0xa7, 0x23, 0x6d, 0xe1, 0xa7,
-	0xdf, 0xb5, 0xba, 0xed, 0xd0, 0x9d, 0x41, 0xa7, 0x61, 0x7a, 0x3d, 0x51, 0x5e, 0xf1, 0x6f, 0x8d,
-	0x58, 0xf7, 0x75, 0xfa, 0xa8, 0x8f, 0x09, 0x77, 0x20, 0x46, 0xee, 0x1e, 0xc6, 0x77, 0x58, 0x70,
-	0x45, 0x83, 0x7c, 0xdf, 0xc7, 0x7d, 0xe4, 0xe3, 0xb6, 0x8d, 0x48, 0xf9, 0x08, 0xcf, 0x19, 0xc4,
-	0xd2, 0xbb, 0x88, 0x30, 0x02, 0x7e, 0x88, 0xcd, 0x01, 0x0d, 0x08, 0xb9, 0x80, 0x20, 0x96, 0x18,
-	0xe1, 0x0a, 0x64, 0x09, 0x76, 0x2d, 0xec, 0x97, 0x65, 0xfe, 0x4e, 0xe5, 0xe7, 0x4f, 0xd6, 0x4e,
-	0x8a, 0x5c, 0x6f, 0x5a, 0x96, 0x8f, 0x09, 0xd9, 0xa6, 0xbe, 0xe3, 0xda, 0x86, 0xe0, 0x29, 0xef,
-	0x41, 0x9e, 0x12, 0xd2, 0xc6, 0xae, 0xe9, 0x31, 0x37, 0xa8, 0x4a, 0xf5, 0xe2, 0x7a, 0xa9, 0x11,
-	0xef, 0xc0, 0xc6, 0xed, 0x00, 0x6e, 0x16, 0xc7, 0x23, 0x0d, 0x3e, 0xda, 0xde, 0x16, 0xb6, 0x01,
-	0x94, 0x10, 0xf1, 0xbc, 0x51, 0xfb, 0xf3, 0x07, 0x4d, 0xfa, 0xfa, 0xe5, 0xe3, 0x4b, 0x22, 0xf4,
-	0x37, 0x2f, 0x1f, 0x5f, 0x12, 0x62, 0xea, 0xa2, 0x41, 0x6a, 0x65, 0x38, 0x1d, 0x6f, 0x17, 0x03,
-	0x93, 0xbe, 0xe7, 0x12, 0x5c, 0xfb, 0x4b, 0x82, 0x25, 0x0e, 0xf5, 0x3d, 0x3f, 0x68, 0xa4, 0x1b,
-	0x00, 0x7e, 0x40, 0x9c, 0xb6, 0x90, 0x3a, 0x1e, 0x69, 0xb2, 0x70, 0xe7, 0xdd, 0x33, 0x35, 0x0c,
-	0x59, 0xb0, 0xb7, 0x2c, 0xe5, 0x6d, 0xc8, 0xfb, 0xe8, 0x41, 0xdb, 0xe7, 0xc1, 0x48, 0x79, 0x51,
-	0x88, 0x36, 0xf3, 0x52, 0x06, 0x7a, 0x10, 0x6c, 0xd7, 0x4c, 0x33, 0xd1, 0x0c, 0xf0, 0x27, 0x0b,
-	0x44, 0x79, 0x0b, 0xe4, 0x21, 0xea, 0x3a, 0x16, 0xa2, 0x9e, 0xcf, 0x3b, 0x4b, 0x6e, 0x9e, 0x79,
-	0xfe, 0x64, 0x6d, 0x55, 0xd4, 0xf2, 0xe3, 0x09, 0x16, 0x2f, 0xea, 0xd4, 0x67, 0xe3, 0xdc, 0xa4,
-	0x1a, 0xd3, 0x35, 0x56, 0x90, 0xa5, 0xb0, 0x20, 0x6c, 0x9f, 0x5a, 0x09, 0x4e, 0xc5, 0x5e, 0x3a,
-	0x2c, 0xc7, 0xb7, 0x29, 0x38, 0xd1, 0x22, 0xf6, 0xa6, 0x8f, 0x11, 0xc5, 0x0c, 0xd9, 0xf6, 0x06,
-	0xbe, 0x89, 0x15, 0x05, 0xd2, 0x2e, 0xea, 0x61, 0x5e, 0x0e, 0xd9, 0xe0, 0xcf, 0x4a, 0x15, 0xf2,
-	0x16, 0x0e, 0x86, 0xcd, 0xf1, 0x5c, 0x3e, 0x24, 0xb2, 0x11, 0x5d, 0x52, 0x2a, 0x20, 0x9a, 0x04,
-	0x75, 0xba, 0x98, 0xbf, 0x4e, 0xc1, 0x88, 0xac, 0x28, 0x9f, 0x41, 0xea, 0x1e, 0xc6, 0xe5, 0xf4,
-	0xeb, 0x6f, 0x6e, 0x16, 0x57, 0xb9, 0x0e, 0x39, 0xea, 0x63, 0x44, 0x06, 0xfe, 0x23, 0x31, 0x6b,
-	0xf3, 0xfb, 0x32, 0x64, 0x2a, 0x0d, 0xc8, 0x78, 0x0f, 0x5c, 0xec, 0x97, 0xb3, 0xfb, 0xb8, 0x04,
-	0xb4, 0x48, 0xef, 0x1f, 0x39, 0x58, 0xef, 0x6f, 0x5c, 0x4e, 0xe8, 0xd8, 0x92, 0x10, 0x68, 0xb6,
-	0xf2, 0xb5, 0x55, 0x58, 0x49, 0x10, 0x24, 0x14, 0xec, 0xd7, 0x14, 0x1c, 0x6f, 0x11, 0xfb, 0xb6,
-	0xe5, 0xd0, 0x88, 0x5c, 0xef, 0x40, 0x91, 0x1d, 0x54, 0x6d, 0xc2, 0xcd, 0x69, 0x1f, 0x57, 0xc7,
-	0x23, 0xad, 0x30, 0xe5, 0xf1, 0x56, 0x8e, 0xd9, 0x46, 0xc1, 0x9a, 0x5a, 0x56, 0x28, 0xfb, 0xe2,
-	0x7c, 0xd9, 0x53, 0xfb, 0xc9, 0x9e, 0x9e, 0x27, 0x7b, 0xe6, 0x0d, 0xc8, 0x9e, 0x7d, 0x75, 0xd9,
-	0x8f, 0xbc, 0xaa, 0xec, 0xb9, 0x03, 0xca, 0x7e, 0x31, 0x41, 0xf6, 0x53, 0x42, 0xf6, 0xb8, 0x7e,
-	0xb5, 0x15, 0x58, 0xde, 0x25, 0x6a, 0x28, 0xf9, 0x2f, 0x8b, 0x7c, 0x7a, 0x83, 0x96, 0x88, 0x7e,
-	0xc5, 0x0e, 0x39, 0xa5, 0xa7, 0x21, 0x4b, 0xcc, 0x1d, 0xdc, 0x43, 0x42, 0x4b, 0x61, 0x29, 0x37,
-	0xe0, 0xa8, 0xe8, 0x1f, 0x76, 0xd0, 0xb6, 0x07, 0x7e, 0x97, 0x6b, 0x29, 0x37, 0x8f, 0x8f, 0x47,
-	0xda, 0x52, 0x90, 0xd4, 0xa6, 0x67, 0xe1, 0xbb, 0xc6, 0x1d, 0x63, 0x89, 0x4c, 0x4d, 0xbf, 0xcb,
-	0x12, 0x61, 0x3e, 0x7c, 0xea, 0x0a, 0x06, 0x7f, 0x7e, 0x03, 0x73, 0xb5, 0x96, 0x50, 0xe0, 0xe5,
-	0xd8, 0x5c, 0x45, 0xab, 0x55, 0xd3, 0x60, 0x35, 0xb1, 0x8c, 0x61, 0xa1, 0xbf, 0x0f, 0x0e, 0x43,
-	0x26, 0x43, 0xac, 0xcc, 0xaf, 0xfb, 0xaa, 0x71, 0xb8, 0x29, 0x9b, 0xca, 0x96, 0xde, 0x4f, 0xb6,
-	0xcc, 0x2b, 0xca, 0x96, 0x4d, 0x92, 0xed, 0x3f, 0x9b, 0x8b, 0xbd, 0x8e, 0xc3, 0xd9, 0xda, 0x8b,
-	0xe3, 0x70, 0x76, 0x39, 0x94, 0x6c, 0x08, 0xf9, 0x16, 0xb1, 0x6f, 0x9a, 0xd4, 0x19, 0x22, 0x8a,
-	0xe3, 0x9f, 0x53, 0xe9, 0x10, 0x9f, 0xd3, 0x0b, 0xc9, 0x9f, 0xd3, 0xa3, 0x22, 0xbd, 0xc9, 0x46,
-	0xb5, 0x53, 0xbc, 0x53, 0x26, 0x66, 0x98, 0xce, 0x8f, 0x12, 0x1c, 0x6d, 0x11, 0xfb, 0x6e, 0xdf,
-	0x42, 0x14, 0x7f, 0x88, 0x7c, 0xd4, 0x23, 0xca, 0xff, 0x41, 0x46, 0x03, 0xba, 0xe3, 0xf9, 0x0e,
-	0x7d, 0x24, 0x72, 0x9a, 0x5f, 0xa3, 0x29, 0x55, 0xb9, 0x0e, 0xd9, 0x3e, 0x8f, 0xc0, 0xfb, 0x24,
-	0xbf, 0x7e, 0x7a, 0xf6, 0x5e, 0x11, 0xc4, 0x17, 0x97, 0x0a, 0xc1, 0xdd, 0xb8, 0xc0, 0x93, 0x0f,
-	0xa3, 0xb0, 0xe4, 0x4f, 0x88, 0xe4, 0xa3, 0x59, 0xd5, 0x96, 0xa1, 0x34, 0x93, 0xe8, 0xe4, 0x25,
-	0xd6, 0xff, 0xce, 0x40, 0xaa, 0x45, 0x6c, 0xe5, 0x2e, 0xe4, 0xa3, 0x17, 0xee, 0xca, 0xec, 0xfe,
-	0xf1, 0x1b, 0x96, 0x7a, 0x61, 0x6f, 0x7c, 0x12, 0x5e, 0x31, 0x00, 0x22, 0xb7, 0xaf, 0xd5, 0x44,
-	0xaf, 0x09, 0xac, 0x9e, 0xdf, 0x13, 0x0e, 0x63, 0x5a, 0x70, 0x6c, 0xd7, 0x15, 0xe6, 0x6c, 0x82,
-	0xeb, 0x2c, 0x49, 0xbd, 0x7c, 0x00, 0x52, 0xb8, 0xcb, 0xe7, 0x50, 0x9c, 0xf9, 0xee, 0x9e, 0x49,
-	0x70, 0x8f, 0x53, 0xd4, 0x8b, 0xfb, 0x52, 0xc2, 0xf8, 0x5f, 0x80, 0x92, 0x70, 0xc8, 0x9f, 0x9f,
-	0x9b, 0x62, 0x94, 0xa6, 0xae, 0x1d, 0x88, 0x16, 0xad, 0xd8, 0xae, 0x73, 0xee, 0xec, 0x9c, 0x54,
-	0x63, 0xfb, 0x5c, 0x3e, 0x00, 0x29, 0xdc, 0xe5, 0x0e, 0xe4, 0xc2, 0xd9, 0x5c, 0x49, 0x70, 0x9c,
-	0x80, 0xea, 0xd9, 0x3d, 0xc0, 0x30, 0xda, 0x27, 0x50, 0x88, 0x4d, 0x96, 0x96, 0xe0, 0x14, 0x25,
-	0xa8, 0xff, 0xdb, 0x87, 0x30, 0x89, 0xac, 0x66, 0xbe, 0x7a, 0xf9, 0xf8, 0x92, 0xd4, 0xdc, 0x7a,
-	0x3a, 0xae, 0x48, 0xcf, 0xc6, 0x15, 0xe9, 0x8f, 0x71, 0x45, 0xfa, 0xee, 0x45, 0x65, 0xe1, 0xd9,
-	0x8b, 0xca, 0xc2, 0x6f, 0x2f, 0x2a, 0x0b, 0x9f, 0xea, 0x91, 0x2b, 0x09, 0x8b, 0xc9, 0x7f, 0x96,
-	0x9a, 0x5e, 0x57, 0x37, 0x77, 0x90, 0xe3, 0xea, 0xc3, 0x6b, 0xfa, 0xc3, 0xc9, 0x4f, 0x64, 0x7e,
-	0x3f, 0xe9, 0x64, 0x39, 0xe3, 0xda, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xab, 0x06, 0x21,
-	0x7d, 0x0f, 0x00, 0x00,
+	// 1334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0x26, 0xb6, 0xf1, 0x3e, 0x3b, 0x06, 0x16, 0x42, 0x36, 0xce, 0x37, 0x5e, 0x63, 0x7e,
+	0x7c, 0x03, 0x28, 0x5e, 0x08, 0xa8, 0x12, 0x91, 0xaa, 0x16, 0x07, 0xda, 0x46, 0x22, 0xa5, 0xda,
+	0x14, 0x54, 0x55, 0x6a, 0xad, 0xc9, 0xee, 0xb0, 0xd9, 0x62, 0xef, 0xba, 0x33, 0x63, 0x03, 0xb7,
+	0xaa, 0xc7, 0x9e, 0xda, 0xfe, 0x05, 0xbd, 0x55, 0xaa, 0x54, 0x89, 0x03, 0xff, 0x42, 0x25, 0x8e,
+	0x88, 0x53, 0x4f, 0x6e, 0x6b, 0x0e, 0xf4, 0xde, 0x43, 0xa5, 0x9e, 0xaa, 0x99, 0x1d, 0xaf, 0x77,
+	0x9d, 0x4d, 0x1c, 0x10, 0xf4, 0x92, 0xec, 0x9b, 0xcf, 0xe7, 0xbd, 0x79, 0xfb, 0xde, 0x67, 0xde,
+	0x8e, 0x61, 0x7e, 0x1b, 0xf9, 0x8e, 0x19, 0x10, 0x64, 0xb7, 0xb0, 0xd9, 0xbb, 0x64, 0xb2, 0x07,
+	0xf5, 0x0e, 0x09, 0x58, 0xa0, 0x95, 0x38, 0x50, 0x0f, 0x81, 0x7a, 0xef, 0x52, 0xf9, 0xb8, 0x1b,
+	0xb8, 0x81, 0x80, 0x4c, 0xfe, 0x14, 0xb2, 0xca, 0x8b, 0x63, 0xee, 0x92, 0x1f, 0x82, 0x15, 0x3b,
+	0xa0, 0xed, 0x80, 0x9a, 0xdb, 0x88, 0x72, 0x70, 0x1b, 0x33, 0x74, 0xc9, 0xb4, 0x03, 0xcf, 0x97,
+	0xf8, 0x42, 0x88, 0x37, 0xc3, 0xa8, 0xa1, 0x21, 0xa1, 0x79, 0xe9, 0xda, 0xa6, 0x2e, 0x0f, 0xdb,
+	0xa6, 0xae, 0x04, 0x8e, 0xa2, 0xb6, 0xe7, 0x07, 0xa6, 0xf8, 0x1b, 0x2e, 0xd5, 0xfe, 0xc8, 0x40,
+	0x69, 0x93, 0xba, 0x16, 0xfe, 0xb2, 0x8b, 0x29, 0xbb, 0x8e, 0x18, 0xd2, 0x3e, 0x84, 0x23, 0x61,
+	0x26, 0x4d, 0x6a, 0x13, 0xaf, 0xc3, 0x9a, 0x9e, 0xa3, 0x2b, 0x55, 0x65, 0x39, 0xd3, 0x38, 0x3d,
+	0xe8, 0x1b, 0xa5, 0x5b, 0x02, 0xdb, 0x12, 0xd0, 0xc6, 0xf5, 0x7f, 0x76, 0xad, 0x58, 0xa5, 0x20,
+	0x6e, 0x3b, 0x5a, 0x19, 0xf2, 0x36, 0x6a, 0xb5, 0x1c, 0xc4, 0x90, 0x3e, 0x5d, 0x55, 0x96, 0x8b,
+	0x56, 0x64, 0x6b, 0x8b, 0xa0, 0x22, 0x7a, 0xaf, 0x69, 0x07, 0x5d, 0x9f, 0xe9, 0x33, 0x7c, 0x13,
+	0x2b, 0x8f, 0xe8, 0xbd, 0x75, 0x6e, 0x73, 0xb0, 0xed, 0xf9, 0x12, 0xcc, 0x84, 0x60, 0xdb, 0xf3,
+	0x43, 0xf0, 0x1c, 0xa8, 0x76, 0xcb, 0xc3, 0xbe, 0x48, 0x2f, 0x5b, 0x55, 0x96, 0xd5, 0x46, 0x71,
+	0xd0, 0x37, 0xf2, 0xeb, 0x62, 0x71, 0xe3, 0xba, 0x95, 0x0f, 0xe1, 0x0d, 0x47, 0xdb, 0x01, 0xf5,
+	0x2e, 0xc6, 0xcd, 0x96, 0xd7, 0xf6, 0x98, 0x9e, 0xab, 0xce, 0x2c, 0x17, 0x56, 0x17, 0xea, 0xb2,
+	0x62, 0xbc, 0xbc, 0x75, 0x59, 0xde, 0xfa, 0x7a, 0xe0, 0xf9, 0x8d, 0x8b, 0x4f, 0xfa, 0xc6, 0xd4,
+	0x4f, 0xbf, 0x19, 0xcb, 0xae, 0xc7, 0x76, 0xba, 0xdb, 0x75, 0x3b, 0x68, 0xcb, 0xf2, 0xca, 0x7f,
+	0x2b, 0xd4, 0xb9, 0x67, 0xb2, 0x87, 0x1d, 0x4c, 0x85, 0x03, 0xb5, 0xf2, 0x77, 0x31, 0xbe, 0xc9,
+	0x83, 0x6b, 0x06, 0x14, 0x3a, 0x04, 0x77, 0x10, 0xc1, 0x4d, 0x17, 0x51, 0xfd, 0x90, 0xc8, 0x19,
+	0xe4, 0xd2, 0xfb, 0x88, 0x72, 0x02, 0x7e, 0x80, 0xed, 0x2e, 0x0b, 0x09, 0xf9, 0x90, 0x20, 0x97,
+	0x38, 0xe1, 0x22, 0xe4, 0x28, 0xf6, 0x1d, 0x4c, 0x74, 0x55, 0xbc, 0x93, 0xfe, 0xec, 0xf1, 0xca,
+	0x71, 0x99, 0xeb, 0x35, 0xc7, 0x21, 0x98, 0xd2, 0x2d, 0x46, 0x3c, 0xdf, 0xb5, 0x24, 0x4f, 0xfb,
+	0x00, 0x0a, 0x8c, 0xd2, 0x26, 0xf6, 0xed, 0x80, 0xbb, 0x41, 0x55, 0x59, 0x2e, 0xad, 0xce, 0xd7,
+	0x93, 0x0a, 0xac, 0xdf, 0x08, 0xe1, 0x46, 0x69, 0xd0, 0x37, 0xe0, 0xe3, 0xad, 0x2d, 0x69, 0x5b,
+	0xc0, 0x28, 0x95, 0xcf, 0xda, 0x2a, 0xcc, 0x25, 0x1b, 0xdf, 0xc3, 0x84, 0x7a, 0x81, 0xaf, 0x17,
+	0x44, 0x9a, 0xc7, 0xe2, 0x7d, 0xbd, 0x13, 0x42, 0x6b, 0xb5, 0x3f, 0x7f, 0x30, 0x94, 0xaf, 0x5f,
+	0x3c, 0x3a, 0x2f, 0xd3, 0xf9, 0xe6, 0xc5, 0xa3, 0xf3, 0x52, 0x00, 0xa6, 0x14, 0x55, 0x4d, 0x87,
+	0x13, 0x49, 0x89, 0x59, 0x98, 0x76, 0x02, 0x9f, 0xe2, 0xda, 0x5f, 0x0a, 0xcc, 0x0a, 0xa8, 0x13,
+	0x90, 0x50, 0x7c, 0x57, 0x01, 0x48, 0x48, 0x1c, 0xc9, 0xae, 0x3c, 0xe8, 0x1b, 0xaa, 0x74, 0x17,
+	0x8a, 0x1b, 0x19, 0x96, 0x2a, 0xd9, 0x1b, 0x8e, 0xf6, 0x2e, 0x14, 0x08, 0xba, 0xdf, 0x24, 0x22,
+	0x18, 0xd5, 0xa7, 0x65, 0xa3, 0xc7, 0x0a, 0x61, 0xa1, 0xfb, 0xe1, 0x76, 0x8d, 0x0c, 0x6f, 0xb4,
+	0x05, 0x64, 0xb8, 0x40, 0xb5, 0x77, 0x40, 0xed, 0xa1, 0x96, 0xe7, 0x20, 0x16, 0x10, 0xa1, 0x46,
+	0xb5, 0x71, 0xf2, 0xd9, 0xe3, 0x95, 0x25, 0x59, 0xff, 0x3b, 0x43, 0x2c, 0xd9, 0x88, 0x91, 0xcf,
+	0xda, 0xe9, 0x61, 0x35, 0x46, 0x6b, 0xbc, 0x20, 0xb3, 0x51, 0x41, 0xf8, 0x3e, 0xb5, 0x79, 0x98,
+	0x4b, 0xbc, 0x74, 0x54, 0x8e, 0x9f, 0x67, 0xe0, 0xd8, 0x26, 0x75, 0xd7, 0x09, 0x46, 0x0c, 0x73,
+	0x64, 0x2b, 0xe8, 0x12, 0x1b, 0x6b, 0x1a, 0x64, 0x7c, 0xd4, 0xc6, 0xa2, 0x1c, 0xaa, 0x25, 0x9e,
+	0xb5, 0x2a, 0x14, 0x1c, 0x1c, 0xf6, 0x89, 0xb7, 0x68, 0x5a, 0x40, 0xf1, 0x25, 0xad, 0x02, 0x52,
+	0x58, 0x68, 0xbb, 0x85, 0xc5, 0xeb, 0x14, 0xad, 0xd8, 0x8a, 0xf6, 0x19, 0xcc, 0xdc, 0xc5, 0x58,
+	0xcf, 0xbc, 0xfe, 0x03, 0xc1, 0xe3, 0x6a, 0x57, 0x20, 0xcf, 0x08, 0x46, 0xb4, 0x4b, 0x1e, 0xea,
+	0xd9, 0x09, 0x5a, 0x8e, 0x98, 0x5a, 0x1d, 0xb2, 0xc1, 0x7d, 0x1f, 0x13, 0x3d, 0x37, 0xc1, 0x25,
+	0xa4, 0xc5, 0xce, 0xcb, 0xa1, 0x03, 0x9e, 0x97, 0xff, 0x81, 0x6a, 0x23, 0x7b, 0x07, 0x8b, 0xaa,
+	0xf0, 0x03, 0x98, 0xb7, 0x46, 0x0b, 0x6b, 0x17, 0x52, 0xf4, 0x3c, 0x2f, 0xdb, 0x37, 0xde, 0x97,
+	0xda, 0x12, 0x2c, 0xa6, 0xb4, 0x2b, 0x6a, 0xe7, 0xf7, 0x19, 0x38, 0xba, 0x49, 0xdd, 0x1b, 0x8e,
+	0xc7, 0x62, 0xcd, 0x7c, 0x0f, 0x4a, 0x7c, 0xf4, 0x35, 0xa9, 0x30, 0x47, 0x2a, 0xaf, 0x0e, 0xfa,
+	0x46, 0x71, 0xc4, 0x13, 0x42, 0x4f, 0xd8, 0x56, 0xd1, 0x19, 0x59, 0x4e, 0x24, 0x8a, 0xe9, 0xbd,
+	0x45, 0x31, 0x33, 0x49, 0x14, 0x99, 0xbd, 0x44, 0x91, 0xfd, 0x0f, 0x44, 0x91, 0x7b, 0x79, 0x51,
+	0x1c, 0x7a, 0x59, 0x51, 0xe4, 0x0f, 0x28, 0x8a, 0xb7, 0xe3, 0xa2, 0x50, 0xc5, 0x08, 0x35, 0xc6,
+	0x27, 0xc7, 0xfa, 0x90, 0x70, 0x4b, 0x94, 0x32, 0xae, 0x9a, 0x73, 0x29, 0xaa, 0x91, 0xb3, 0xd4,
+	0x4c, 0xb6, 0xbf, 0xb6, 0x08, 0x0b, 0xbb, 0x34, 0x11, 0x29, 0xe6, 0x97, 0x69, 0x98, 0x8b, 0x14,
+	0x15, 0xff, 0xac, 0xbe, 0xe2, 0x08, 0x38, 0x01, 0x39, 0x6a, 0xef, 0xe0, 0x36, 0x92, 0x52, 0x90,
+	0x96, 0x76, 0x15, 0x0e, 0x4b, 0xf9, 0xf1, 0xc9, 0xdf, 0xec, 0x92, 0x96, 0x90, 0x82, 0xda, 0x38,
+	0x3a, 0xe8, 0x1b, 0xb3, 0x61, 0x52, 0xeb, 0x81, 0x83, 0x6f, 0x5b, 0x37, 0xad, 0x59, 0x3a, 0x32,
+	0x49, 0x8b, 0x27, 0xc2, 0x7d, 0xc4, 0x91, 0x2e, 0x5a, 0xe2, 0xf9, 0xcd, 0x1f, 0xda, 0xb5, 0x95,
+	0x94, 0x02, 0x2f, 0x24, 0x8e, 0x65, 0xbc, 0x5a, 0x35, 0x03, 0x96, 0x52, 0xcb, 0x18, 0x15, 0xfa,
+	0xbb, 0x70, 0xd2, 0xf2, 0x36, 0x24, 0xca, 0xfc, 0xba, 0xef, 0x3e, 0xaf, 0x76, 0x48, 0x47, 0x6d,
+	0xcb, 0x4c, 0x6a, 0x5b, 0xf6, 0x25, 0xdb, 0x96, 0x4b, 0x6b, 0xdb, 0x9b, 0x3a, 0x56, 0xfb, 0x4e,
+	0xd3, 0xf1, 0xda, 0xcb, 0x69, 0x3a, 0xbe, 0x1c, 0xb5, 0xac, 0x07, 0x85, 0x4d, 0xea, 0x5e, 0xb3,
+	0x99, 0xd7, 0x43, 0x0c, 0x27, 0xbf, 0xd5, 0xca, 0x2b, 0x7c, 0xab, 0xcf, 0xa6, 0x7f, 0xab, 0x0f,
+	0xcb, 0xf4, 0x86, 0x1b, 0xd5, 0xe6, 0x84, 0x52, 0x86, 0x66, 0x94, 0xce, 0x8f, 0x0a, 0x1c, 0xde,
+	0xa4, 0xee, 0xed, 0x8e, 0x83, 0x18, 0xfe, 0x08, 0x11, 0xd4, 0xa6, 0xda, 0x5b, 0xa0, 0xa2, 0x2e,
+	0xdb, 0x09, 0x88, 0xc7, 0x1e, 0xea, 0xca, 0x84, 0x1a, 0x8d, 0xa8, 0xda, 0x15, 0xc8, 0x75, 0x44,
+	0x04, 0xa1, 0x93, 0xc2, 0xea, 0x89, 0xf1, 0xd1, 0x13, 0xc6, 0x97, 0x37, 0x16, 0xc9, 0x5d, 0x3b,
+	0x2b, 0x92, 0x8f, 0xa2, 0xf0, 0xe4, 0xe5, 0x15, 0xcd, 0x8c, 0x67, 0x55, 0x5b, 0x80, 0xf9, 0xb1,
+	0x44, 0x87, 0x2f, 0xb1, 0xfa, 0x77, 0x16, 0x66, 0x36, 0xa9, 0xab, 0xdd, 0x86, 0x42, 0xfc, 0x17,
+	0x40, 0x65, 0x7c, 0xff, 0xe4, 0xf5, 0xad, 0x7c, 0x76, 0x7f, 0x7c, 0x18, 0x5e, 0xb3, 0x00, 0x62,
+	0x57, 0xbb, 0xa5, 0x54, 0xaf, 0x21, 0x5c, 0x3e, 0xb3, 0x2f, 0x1c, 0xc5, 0x74, 0xe0, 0xc8, 0xae,
+	0xfb, 0xd1, 0xa9, 0x14, 0xd7, 0x71, 0x52, 0xf9, 0xc2, 0x01, 0x48, 0xd1, 0x2e, 0x9f, 0x43, 0x69,
+	0xec, 0xb3, 0x7d, 0x32, 0xc5, 0x3d, 0x49, 0x29, 0x9f, 0x9b, 0x48, 0x89, 0xe2, 0x7f, 0x01, 0x5a,
+	0xca, 0x90, 0x3f, 0xb3, 0x67, 0x8a, 0x71, 0x5a, 0x79, 0xe5, 0x40, 0xb4, 0x78, 0xc5, 0x76, 0xcd,
+	0xb9, 0x53, 0x7b, 0xa4, 0x9a, 0xd8, 0xe7, 0xc2, 0x01, 0x48, 0xd1, 0x2e, 0x37, 0x21, 0x1f, 0x9d,
+	0xcd, 0xc5, 0x14, 0xc7, 0x21, 0x58, 0x3e, 0xb5, 0x0f, 0x18, 0x45, 0xfb, 0x04, 0x8a, 0x89, 0x93,
+	0x65, 0xa4, 0x38, 0xc5, 0x09, 0xe5, 0xff, 0x4f, 0x20, 0x0c, 0x23, 0x97, 0xb3, 0x5f, 0xbd, 0x78,
+	0x74, 0x5e, 0x69, 0x6c, 0x3c, 0x19, 0x54, 0x94, 0xa7, 0x83, 0x8a, 0xf2, 0xfb, 0xa0, 0xa2, 0x7c,
+	0xfb, 0xbc, 0x32, 0xf5, 0xf4, 0x79, 0x65, 0xea, 0xd7, 0xe7, 0x95, 0xa9, 0x4f, 0xcd, 0xd8, 0x8d,
+	0x86, 0xc7, 0x14, 0xbf, 0x93, 0xed, 0xa0, 0x65, 0xda, 0x3b, 0xc8, 0xf3, 0xcd, 0xde, 0x65, 0xf3,
+	0xc1, 0xf0, 0x37, 0xbb, 0xb8, 0xde, 0x6c, 0xe7, 0x04, 0xe3, 0xf2, 0xbf, 0x03, 0x00, 0x9a, 0x93,
+	0x44, 0x26, 0x0e, 0x10, 0x00, 0x00,
 }
 
 func (this *MsgRequestData) Equal(that interface{}) bool {
@@ -1228,6 +1261,9 @@ func (this *MsgRequestData) Equal(that interface{}) bool {
 	if this.TSSEncoder != that1.TSSEncoder {
 		return false
 	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
 	return true
 }
 func (this *MsgReportData) Equal(that interface{}) bool {
@@ -1310,6 +1346,9 @@ func (this *MsgCreateDataSource) Equal(that interface{}) bool {
 	if this.Sender != that1.Sender {
 		return false
 	}
+	if this.Cacheable != that1.Cacheable {
+		return false
+	}
 	return true
 }
 func (this *MsgEditDataSource) Equal(that interface{}) bool {
@@ -1360,6 +1399,9 @@ func (this *MsgEditDataSource) Equal(that interface{}) bool {
 	if this.Sender != that1.Sender {
 		return false
 	}
+	if this.Cacheable != that1.Cacheable {
+		return false
+	}
 	return true
 }
 func (this *MsgCreateOracleScript) Equal(that interface{}) bool {
@@ -1848,6 +1890,11 @@ func (m *MsgRequestData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.TSSEncoder != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TSSEncoder))
 		i--
@@ -2031,6 +2078,16 @@ func (m *MsgCreateDataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Cacheable {
+		i--
+		if m.Cacheable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -2133,6 +2190,11 @@ func (m *MsgEditDataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Cacheable != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cacheable))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -2584,6 +2646,9 @@ func (m *MsgRequestData) Size() (n int) {
 	if m.TSSEncoder != 0 {
 		n += 1 + sovTx(uint64(m.TSSEncoder))
 	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptVersion))
+	}
 	return n
 }
 
@@ -2663,6 +2728,9 @@ func (m *MsgCreateDataSource) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Cacheable {
+		n += 2
+	}
 	return n
 }
 
@@ -2714,6 +2782,9 @@ func (m *MsgEditDataSource) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Cacheable != 0 {
+		n += 1 + sovTx(uint64(m.Cacheable))
+	}
 	return n
 }
 
@@ -3148,6 +3219,25 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+			}
+			m.OracleScriptVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3661,6 +3751,26 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cacheable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cacheable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4008,6 +4118,25 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cacheable", wireType)
+			}
+			m.Cacheable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cacheable |= CacheableOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
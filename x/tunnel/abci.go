@@ -14,5 +14,15 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	// Produce packets for all tunnels that are active and have passed the interval time trigger
 	// or deviated from the last price to destination route.
 	// Error should not happen here since the tunnel is already validated.
-	return k.ProduceActiveTunnelPackets(ctx)
+	if err := k.ProduceActiveTunnelPackets(ctx); err != nil {
+		return err
+	}
+
+	// Resolve the pending TSS packets whose signing requests have succeeded or fallen.
+	k.UpdateTSSPacketStatuses(ctx)
+
+	// Prune the oldest packets that are outside the retention policy with bounded work per block.
+	k.PrunePackets(ctx)
+
+	return nil
 }
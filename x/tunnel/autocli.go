@@ -11,6 +11,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
 			Service: tunnelv1beta1.Query_ServiceDesc.ServiceName,
+			// the custom query command adds the export-packets command
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "Params",
@@ -64,6 +66,49 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "sequence"},
 					},
 				},
+				{
+					RpcMethod: "PacketsByTime",
+					Use:       "packets-by-time [tunnel-id] [start-time] [end-time]",
+					Short:     "Query the packets of a tunnel created within a time range (unix seconds, end-time 0 for no bound)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tunnel_id"},
+						{ProtoField: "start_time"},
+						{ProtoField: "end_time"},
+					},
+				},
+				{
+					RpcMethod: "PacketsByStatus",
+					Use:       "packets-by-status [status]",
+					Short:     "Query the packets of all tunnels with a status and an optional --receipt-type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "status"},
+					},
+				},
+				{
+					RpcMethod: "FailedPackets",
+					Use:       "failed-packets [tunnel-id]",
+					Short:     "Query all failed packets of a tunnel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tunnel_id"},
+					},
+				},
+				{
+					RpcMethod: "FeePayerBalance",
+					Use:       "fee-payer-balance [tunnel-id]",
+					Short:     "Query the fee payer balance and the funding policy of a tunnel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tunnel_id"},
+					},
+				},
+				{
+					RpcMethod: "EstimateTunnelCost",
+					Use:       "estimate-tunnel-cost [route-json] [interval]",
+					Short:     "Estimate the fee per packet and the daily cost of a tunnel with the given route, interval and --signal-deviations",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "route"},
+						{ProtoField: "interval"},
+					},
+				},
 				{
 					RpcMethod: "TotalFees",
 					Use:       "total-fees",
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+const (
+	flagStartTime = "start-time"
+	flagEndTime   = "end-time"
+)
+
+// GetQueryCmd returns a root CLI command handler for the x/tunnel query commands that are not
+// generated by autocli.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the tunnel module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetQueryCmdExportPackets(),
+	)
+
+	return queryCmd
+}
+
+// GetQueryCmdExportPackets returns the command to dump the packets of a tunnel to a file, one JSON
+// packet per line, so that they can be archived before they are pruned.
+func GetQueryCmdExportPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-packets [tunnel-id] [output-file]",
+		Short: "Export the packets of a tunnel created within --start-time and --end-time to a file",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tunnelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := cmd.Flags().GetInt64(flagStartTime)
+			if err != nil {
+				return err
+			}
+
+			endTime, err := cmd.Flags().GetInt64(flagEndTime)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			writer := bufio.NewWriter(file)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var nextKey []byte
+			for {
+				// iterate the primary packet store, which is the source of truth of the packets
+				res, err := queryClient.Packets(cmd.Context(), &types.QueryPacketsRequest{
+					TunnelId:   tunnelID,
+					Pagination: &query.PageRequest{Key: nextKey},
+				})
+				if err != nil {
+					return err
+				}
+
+				for _, packet := range res.Packets {
+					if packet.CreatedAt < startTime || (endTime != 0 && packet.CreatedAt > endTime) {
+						continue
+					}
+
+					bz, err := clientCtx.Codec.MarshalJSON(packet)
+					if err != nil {
+						return err
+					}
+
+					if _, err := writer.Write(append(bz, '\n')); err != nil {
+						return err
+					}
+				}
+
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				nextKey = res.Pagination.NextKey
+			}
+
+			return writer.Flush()
+		},
+	}
+
+	cmd.Flags().Int64(flagStartTime, 0, "Unix time in seconds of the oldest packet to export")
+	cmd.Flags().Int64(flagEndTime, 0, "Unix time in seconds of the newest packet to export, 0 for no bound")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
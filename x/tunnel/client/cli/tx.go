@@ -14,6 +14,11 @@ import (
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
+const (
+	flagSchedule               = "schedule"
+	flagIncludePriceConfidence = "include-price-confidence"
+)
+
 // GetTxCmd returns a root CLI command handler for all x/tunnel transaction commands.
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -32,6 +37,13 @@ func GetTxCmd() *cobra.Command {
 		GetTxCmdActivateTunnel(),
 		GetTxCmdDeactivateTunnel(),
 		GetTxCmdTriggerTunnel(),
+		GetTxCmdRetryPacket(),
+		GetTxCmdTransferTunnelOwnership(),
+		GetTxCmdAcceptTunnelOwnership(),
+		GetTxCmdUpdateTunnelAdmins(),
+		GetTxCmdApproveFundingSource(),
+		GetTxCmdSetFundingPolicy(),
+		GetTxCmdRemoveFundingPolicy(),
 		GetTxCmdDepositToTunnel(),
 		GetTxCmdWithdrawFromTunnel(),
 	)
@@ -53,6 +65,7 @@ func GetTxCmdCreateTunnel() *cobra.Command {
 		GetTxCmdCreateIBCTunnel(),
 		GetTxCmdCreateIBCHookTunnel(),
 		GetTxCmdCreateRouterTunnel(),
+		GetTxCmdCreateIBCABITunnel(),
 	)
 
 	return txCmd
@@ -105,10 +118,18 @@ func GetTxCmdCreateTSSTunnel() *cobra.Command {
 				return err
 			}
 
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+			msg.Schedule = schedule
+			msg.DerivedSignals = signalDeviations.ToDerivedSignals()
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(flagSchedule, "", "Cron-like schedule (UTC) to send all prices, e.g. \"0 * * * *\"")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -150,10 +171,25 @@ func GetTxCmdCreateIBCTunnel() *cobra.Command {
 				return err
 			}
 
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+			msg.Schedule = schedule
+			msg.DerivedSignals = signalDeviations.ToDerivedSignals()
+
+			includePriceConfidence, err := cmd.Flags().GetBool(flagIncludePriceConfidence)
+			if err != nil {
+				return err
+			}
+			msg.IncludePriceConfidence = includePriceConfidence
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(flagSchedule, "", "Cron-like schedule (UTC) to send all prices, e.g. \"0 * * * *\"")
+	cmd.Flags().Bool(flagIncludePriceConfidence, false, "Include the confidence metadata of the feed prices in the packets")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -199,10 +235,25 @@ func GetTxCmdCreateIBCHookTunnel() *cobra.Command {
 				return err
 			}
 
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+			msg.Schedule = schedule
+			msg.DerivedSignals = signalDeviations.ToDerivedSignals()
+
+			includePriceConfidence, err := cmd.Flags().GetBool(flagIncludePriceConfidence)
+			if err != nil {
+				return err
+			}
+			msg.IncludePriceConfidence = includePriceConfidence
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(flagSchedule, "", "Cron-like schedule (UTC) to send all prices, e.g. \"0 * * * *\"")
+	cmd.Flags().Bool(flagIncludePriceConfidence, false, "Include the confidence metadata of the feed prices in the packets")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -254,10 +305,76 @@ func GetTxCmdCreateRouterTunnel() *cobra.Command {
 				return err
 			}
 
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+			msg.Schedule = schedule
+			msg.DerivedSignals = signalDeviations.ToDerivedSignals()
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(flagSchedule, "", "Cron-like schedule (UTC) to send all prices, e.g. \"0 * * * *\"")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetTxCmdCreateIBCABITunnel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-abi [encoder] [initial-deposit] [interval] [signal-deviations-json-file]",
+		Short: "Create a new IBC ABI tunnel",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			encoder, err := strconv.ParseInt(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signalDeviations, err := parseSignalDeviations(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateIBCABITunnel(
+				signalDeviations.ToSignalDeviations(),
+				interval,
+				feedstypes.Encoder(encoder),
+				initialDeposit,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err != nil {
+				return err
+			}
+
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+			msg.Schedule = schedule
+			msg.DerivedSignals = signalDeviations.ToDerivedSignals()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSchedule, "", "Cron-like schedule (UTC) to send all prices, e.g. \"0 * * * *\"")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -275,6 +392,7 @@ func GetTxCmdUpdateRoute() *cobra.Command {
 		GetTxCmdUpdateIBCRoute(),
 		GetTxCmdUpdateIBCHookRoute(),
 		GetTxCmdUpdateRouterRoute(),
+		GetTxCmdUpdateIBCABIRoute(),
 	)
 
 	return txCmd
@@ -396,6 +514,48 @@ func GetTxCmdUpdateRouterRoute() *cobra.Command {
 	return cmd
 }
 
+func GetTxCmdUpdateIBCABIRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-abi [tunnel-id] [channel-id] [encoder]",
+		Short: "Update IBC ABI route of a IBC ABI tunnel",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			channelID := args[1]
+
+			encoder, err := strconv.ParseInt(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgUpdateIBCABIRoute(
+				id,
+				channelID,
+				feedstypes.Encoder(encoder),
+				clientCtx.GetFromAddress().String(),
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetTxCmdUpdateSignalsAndInterval() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-signals-and-interval [tunnel-id] [interval] [signalDeviations-json-file] ",
@@ -422,16 +582,31 @@ func GetTxCmdUpdateSignalsAndInterval() *cobra.Command {
 				return err
 			}
 
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+
+			includePriceConfidence, err := cmd.Flags().GetBool(flagIncludePriceConfidence)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateSignalsAndInterval(
 				id,
 				signalDeviations.ToSignalDeviations(),
+				signalDeviations.ToDerivedSignals(),
 				interval,
+				schedule,
+				includePriceConfidence,
 				clientCtx.GetFromAddress().String(),
 			)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(flagSchedule, "", "Cron-like schedule (UTC) to send all prices, e.g. \"0 * * * *\"")
+	cmd.Flags().Bool(flagIncludePriceConfidence, false, "Include the confidence metadata of the feed prices in the packets")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -546,6 +721,229 @@ func GetTxCmdTriggerTunnel() *cobra.Command {
 	return cmd
 }
 
+func GetTxCmdRetryPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-packet [tunnel-id] [sequence]",
+		Short: "Resend a failed packet of a tunnel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tunnelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetryPacket(tunnelID, sequence, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdTransferTunnelOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-tunnel-ownership [tunnel-id] [new-creator]",
+		Short: "Propose a new creator of a tunnel, who must accept the ownership",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tunnelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferTunnelOwnership(tunnelID, args[1], clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdAcceptTunnelOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-tunnel-ownership [tunnel-id]",
+		Short: "Accept the proposed ownership of a tunnel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tunnelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptTunnelOwnership(tunnelID, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdUpdateTunnelAdmins() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-tunnel-admins [tunnel-id] [admins-json-file]",
+		Short: "Replace the co-administrators of a tunnel and their permissions",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tunnelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tunnelAdmins, err := parseTunnelAdmins(args[1])
+			if err != nil {
+				return err
+			}
+
+			admins, err := tunnelAdmins.ToTunnelAdmins()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateTunnelAdmins(tunnelID, admins, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdApproveFundingSource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-funding-source [tunnel-id] [source]",
+		Short: "Approve the funding account that can set the funding policy of a tunnel; omit the source to revoke the approval",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var source string
+			if len(args) > 1 {
+				source = args[1]
+			}
+
+			msg := types.NewMsgApproveFundingSource(id, source, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdSetFundingPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-funding-policy [tunnel-id] [allowance] [threshold] [top-up-amount]",
+		Short: "Allow a tunnel to top up its fee payer from the sender account when its balance is below the threshold",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			allowance, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			threshold, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			topUpAmount, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFundingPolicy(
+				id,
+				allowance,
+				threshold,
+				topUpAmount,
+				clientCtx.GetFromAddress().String(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdRemoveFundingPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-funding-policy [tunnel-id]",
+		Short: "Remove the funding policy of a tunnel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveFundingPolicy(id, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetTxCmdDepositToTunnel() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deposit-to-tunnel [tunnel-id] [amount]",
@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
@@ -11,6 +12,7 @@ import (
 // SignalDeviations represents the signal deviation in the file
 type SignalDeviations struct {
 	SignalDeviations []SignalDeviation `json:"signal_deviations"`
+	DerivedSignals   []DerivedSignal   `json:"derived_signals"`
 }
 
 // SignalDeviation represents the signal information without soft deviation, which may be utilized in the future for deviation adjustments
@@ -34,6 +36,25 @@ func (ss SignalDeviations) ToSignalDeviations() []types.SignalDeviation {
 	return signalDeviations
 }
 
+// DerivedSignal represents the derived signal information without soft deviation in the file
+type DerivedSignal struct {
+	SignalID     string `json:"signal_id"`
+	Expression   string `json:"expression"`
+	DeviationBPS uint64 `json:"deviation_bps"`
+	TWAPWindow   uint64 `json:"twap_window"`
+}
+
+// ToDerivedSignals converts derived signal information to types.DerivedSignal, excluding soft deviation.
+func (ss SignalDeviations) ToDerivedSignals() []types.DerivedSignal {
+	var derivedSignals []types.DerivedSignal
+	for _, ds := range ss.DerivedSignals {
+		derivedSignal := types.NewDerivedSignal(ds.SignalID, ds.Expression, ds.DeviationBPS, ds.DeviationBPS)
+		derivedSignal.TWAPWindow = ds.TWAPWindow
+		derivedSignals = append(derivedSignals, derivedSignal)
+	}
+	return derivedSignals
+}
+
 // parseSignalDeviations parses the signal infos from the given file
 func parseSignalDeviations(signalDeviationsFile string) (SignalDeviations, error) {
 	var signalDeviations SignalDeviations
@@ -53,3 +74,51 @@ func parseSignalDeviations(signalDeviationsFile string) (SignalDeviations, error
 
 	return signalDeviations, nil
 }
+
+// TunnelAdmins represents the co-administrators of a tunnel in the file
+type TunnelAdmins struct {
+	Admins []TunnelAdmin `json:"admins"`
+}
+
+// TunnelAdmin represents a co-administrator and the names of its permissions in the file
+type TunnelAdmin struct {
+	Address     string   `json:"address"`
+	Permissions []string `json:"permissions"`
+}
+
+// ToTunnelAdmins converts the admins in the file to types.TunnelAdmin.
+func (ta TunnelAdmins) ToTunnelAdmins() ([]types.TunnelAdmin, error) {
+	admins := make([]types.TunnelAdmin, 0, len(ta.Admins))
+	for _, admin := range ta.Admins {
+		var permissions []types.TunnelPermission
+		for _, name := range admin.Permissions {
+			p, ok := types.TunnelPermission_value[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown tunnel permission %s", name)
+			}
+			permissions = append(permissions, types.TunnelPermission(p))
+		}
+		admins = append(admins, types.NewTunnelAdmin(admin.Address, permissions))
+	}
+	return admins, nil
+}
+
+// parseTunnelAdmins parses the tunnel admins from the given file
+func parseTunnelAdmins(tunnelAdminsFile string) (TunnelAdmins, error) {
+	var tunnelAdmins TunnelAdmins
+
+	if tunnelAdminsFile == "" {
+		return TunnelAdmins{}, errors.New("tunnel admins file is required")
+	}
+
+	contents, err := os.ReadFile(tunnelAdminsFile)
+	if err != nil {
+		return TunnelAdmins{}, err
+	}
+
+	if err := json.Unmarshal(contents, &tunnelAdmins); err != nil {
+		return TunnelAdmins{}, err
+	}
+
+	return tunnelAdmins, nil
+}
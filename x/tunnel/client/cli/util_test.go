@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 func TestParseSignalDeviations(t *testing.T) {
@@ -13,23 +15,63 @@ func TestParseSignalDeviations(t *testing.T) {
 		{SignalID: "CS:BTC-USD", DeviationBPS: 2000},
 		{SignalID: "CS:ETH-USD", DeviationBPS: 4000},
 	}
-	file, cleanup := createTempSignalDeviationFile(signalDeviations)
+	derivedSignals := []DerivedSignal{
+		{SignalID: "DS:ETH-BTC", Expression: "CS:ETH-USD / CS:BTC-USD", DeviationBPS: 1000},
+		{SignalID: "DS:BTC-TWAP-1H", Expression: "CS:BTC-USD", DeviationBPS: 1000, TWAPWindow: 3600},
+	}
+	file, cleanup := createTempSignalDeviationFile(SignalDeviations{
+		SignalDeviations: signalDeviations,
+		DerivedSignals:   derivedSignals,
+	})
 	defer cleanup()
 
 	result, err := parseSignalDeviations(file)
 	require.NoError(t, err)
 	require.Equal(t, signalDeviations, result.SignalDeviations)
+	require.Equal(t, derivedSignals, result.DerivedSignals)
+}
+
+func TestParseTunnelAdmins(t *testing.T) {
+	tunnelAdmins := TunnelAdmins{
+		Admins: []TunnelAdmin{
+			{Address: "band1admin", Permissions: []string{"TUNNEL_PERMISSION_ACTIVATE", "TUNNEL_PERMISSION_DEACTIVATE"}},
+		},
+	}
+	file, cleanup := createTempJSONFile(tunnelAdmins)
+	defer cleanup()
+
+	result, err := parseTunnelAdmins(file)
+	require.NoError(t, err)
+	require.Equal(t, tunnelAdmins, result)
+
+	admins, err := result.ToTunnelAdmins()
+	require.NoError(t, err)
+	require.Equal(t, []types.TunnelAdmin{
+		types.NewTunnelAdmin(
+			"band1admin",
+			[]types.TunnelPermission{types.TUNNEL_PERMISSION_ACTIVATE, types.TUNNEL_PERMISSION_DEACTIVATE},
+		),
+	}, admins)
+
+	result.Admins[0].Permissions = []string{"TUNNEL_PERMISSION_UNKNOWN"}
+	_, err = result.ToTunnelAdmins()
+	require.Error(t, err)
 }
 
 // Helper function to create a temporary file with signal info JSON content
-func createTempSignalDeviationFile(signalDeviations []SignalDeviation) (string, func()) {
-	file, err := os.CreateTemp("", "signalDeviations*.json")
+func createTempSignalDeviationFile(signalDeviations SignalDeviations) (string, func()) {
+	return createTempJSONFile(signalDeviations)
+}
+
+// Helper function to create a temporary file with the given JSON content
+func createTempJSONFile(v interface{}) (string, func()) {
+	file, err := os.CreateTemp("", "tunnel*.json")
 	if err != nil {
 		panic(err)
 	}
 	filePath := file.Name()
 
-	content, err := json.Marshal(SignalDeviations{SignalDeviations: signalDeviations})
+	content, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
 	}
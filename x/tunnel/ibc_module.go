@@ -1,6 +1,7 @@
 package tunnel
 
 import (
+	"fmt"
 	"math"
 	"strings"
 
@@ -57,13 +58,18 @@ func (im IBCModule) OnChanOpenInit(
 		return "", types.ErrInvalidPortID
 	}
 
-	// If version is empty, set it to the current version
+	expVersion, err := im.keeper.ChannelVersion(ctx, portID)
+	if err != nil {
+		return "", err
+	}
+
+	// If version is empty, set it to the version of the port
 	if strings.TrimSpace(version) == "" {
-		version = types.Version
+		version = expVersion
 	}
 
-	if version != types.Version {
-		return "", types.ErrInvalidVersion.Wrapf("got %s, expected %s", version, types.Version)
+	if version != expVersion {
+		return "", types.ErrInvalidVersion.Wrapf("got %s, expected %s", version, expVersion)
 	}
 
 	// openInit must claim the channelCapability that IBC passes into the callback
@@ -94,11 +100,16 @@ func (im IBCModule) OnChanOpenTry(
 		return "", types.ErrInvalidPortID
 	}
 
-	if counterpartyVersion != types.Version {
+	expVersion, err := im.keeper.ChannelVersion(ctx, portID)
+	if err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != expVersion {
 		return "", types.ErrInvalidVersion.Wrapf(
 			"invalid counterparty version: got: %s, expected %s",
 			counterpartyVersion,
-			types.Version,
+			expVersion,
 		)
 	}
 
@@ -113,7 +124,7 @@ func (im IBCModule) OnChanOpenTry(
 		}
 	}
 
-	return types.Version, nil
+	return expVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -124,11 +135,16 @@ func (im IBCModule) OnChanOpenAck(
 	counterpartyChannelID string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
+	expVersion, err := im.keeper.ChannelVersion(ctx, portID)
+	if err != nil {
+		return err
+	}
+
+	if counterpartyVersion != expVersion {
 		return types.ErrInvalidVersion.Wrapf(
 			"invalid counterparty version: %s, expected %s",
 			counterpartyVersion,
-			types.Version,
+			expVersion,
 		)
 	}
 	return nil
@@ -182,7 +198,19 @@ func (im IBCModule) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	// do nothing for out-going packet
+	// an acknowledgement that cannot be decoded is treated as a failed delivery
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		ack = channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal tunnel packet acknowledgement: %v", err),
+		)
+	}
+
+	// tunnel channels are ordered, so an error must not be returned as it would block the channel
+	handleTunnelCallback(ctx, im.keeper, packet, func(cacheCtx sdk.Context) error {
+		return im.keeper.OnAcknowledgementPacket(cacheCtx, packet, ack)
+	})
+
 	return nil
 }
 
@@ -192,10 +220,51 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	// do nothing for out-going packet
+	// tunnel channels are ordered, so an error must not be returned as it would block the channel
+	handleTunnelCallback(ctx, im.keeper, packet, func(cacheCtx sdk.Context) error {
+		return im.keeper.OnTimeoutPacket(cacheCtx, packet)
+	})
+
 	return nil
 }
 
+// handleTunnelCallback runs the tunnel keeper callback in a cached context and commits its state
+// changes only if it succeeds. A failure is reported instead of being returned to the caller.
+func handleTunnelCallback(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	packet channeltypes.Packet,
+	callback func(cacheCtx sdk.Context) error,
+) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := callback(cacheCtx); err != nil {
+		reportTunnelCallbackError(ctx, k, packet, err)
+		return
+	}
+
+	writeFn()
+}
+
+// reportTunnelCallbackError logs an error of the tunnel keeper while handling the given IBC packet
+// and emits an event about it.
+func reportTunnelCallbackError(ctx sdk.Context, k keeper.Keeper, packet channeltypes.Packet, err error) {
+	k.Logger(ctx).Error(
+		"failed to update tunnel packet status",
+		"port_id", packet.SourcePort,
+		"channel_id", packet.SourceChannel,
+		"sequence", packet.Sequence,
+		"error", err,
+	)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdatePacketStatusFail,
+		sdk.NewAttribute(types.AttributeKeyPortID, packet.SourcePort),
+		sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeyIBCSequence, fmt.Sprintf("%d", packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+	))
+}
+
 // OnChanUpgradeInit implements the IBCModule interface
 func (im IBCModule) OnChanUpgradeInit(
 	ctx sdk.Context,
@@ -208,8 +277,13 @@ func (im IBCModule) OnChanUpgradeInit(
 		return "", err
 	}
 
-	if proposedVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, proposedVersion)
+	expVersion, err := im.keeper.ChannelVersion(ctx, portID)
+	if err != nil {
+		return "", err
+	}
+
+	if proposedVersion != expVersion {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", expVersion, proposedVersion)
 	}
 
 	return proposedVersion, nil
@@ -227,17 +301,27 @@ func (im IBCModule) OnChanUpgradeTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	expVersion, err := im.keeper.ChannelVersion(ctx, portID)
+	if err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != expVersion {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", expVersion, counterpartyVersion)
 	}
 
 	return counterpartyVersion, nil
 }
 
 // OnChanUpgradeAck implements the IBCModule interface
-func (IBCModule) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+func (im IBCModule) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	expVersion, err := im.keeper.ChannelVersion(ctx, portID)
+	if err != nil {
+		return err
+	}
+
+	if counterpartyVersion != expVersion {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", expVersion, counterpartyVersion)
 	}
 
 	return nil
@@ -253,10 +337,14 @@ func (IBCModule) OnChanUpgradeOpen(
 ) {
 }
 
-// UnmarshalPacketData attempts to unmarshal the provided packet data bytes
-// into a TunnelPricesPacketData. This function implements the optional
-// PacketDataUnmarshaler interface required for ADR 008 support.
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes into a TSSPacket if
+// they are ABI-encoded by the IBC ABI route, or into a TunnelPricesPacketData otherwise. This
+// function implements the optional PacketDataUnmarshaler interface required for ADR 008 support.
 func (IBCModule) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	if tssPacket, _, err := types.DecodeTSS(bz); err == nil {
+		return tssPacket, nil
+	}
+
 	var packetData types.TunnelPricesPacketData
 	if err := types.ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
 		return nil, err
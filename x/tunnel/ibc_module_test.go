@@ -13,7 +13,7 @@ import (
 func TestPacketDataUnmarshalerInterface(t *testing.T) {
 	var (
 		data          []byte
-		expPacketData types.TunnelPricesPacketData
+		expPacketData interface{}
 	)
 
 	testCases := []struct {
@@ -31,7 +31,7 @@ func TestPacketDataUnmarshalerInterface(t *testing.T) {
 		{
 			"all good",
 			func() {
-				expPacketData = types.TunnelPricesPacketData{
+				packetData := types.TunnelPricesPacketData{
 					TunnelID: 1,
 					Sequence: 1,
 					Prices: []feedstypes.Price{
@@ -44,7 +44,28 @@ func TestPacketDataUnmarshalerInterface(t *testing.T) {
 					},
 					CreatedAt: 1633024800,
 				}
-				data = expPacketData.GetBytes()
+				expPacketData = packetData
+				data = packetData.GetBytes()
+			},
+			true,
+		},
+		{
+			"all good - abi encoded",
+			func() {
+				prices := []feedstypes.Price{
+					{
+						Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+						SignalID:  "CS:BAND-USD",
+						Price:     50000,
+						Timestamp: 1733000000,
+					},
+				}
+				relayPrices, err := feedstypes.ToRelayPrices(prices)
+				require.NoError(t, err)
+
+				expPacketData = types.NewTSSPacket(1, relayPrices, 1633024800)
+				data, err = types.EncodeTSS(1, prices, 1633024800, feedstypes.ENCODER_FIXED_POINT_ABI)
+				require.NoError(t, err)
 			},
 			true,
 		},
@@ -37,7 +37,8 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 			panic(fmt.Sprintf("cannot get route for tunnel ID: %d", t.ID))
 		}
 
-		if _, ok := route.(*types.IBCRoute); ok {
+		switch route.(type) {
+		case *types.IBCRoute, *types.IBCABIRoute:
 			_, err = k.ensureIBCPort(ctx, t.ID)
 			if err != nil {
 				panic(fmt.Sprintf("cannot bind port for tunnel ID: %d", t.ID))
@@ -55,6 +56,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 	// set the total fees
 	k.SetTotalFees(ctx, data.TotalFees)
 
+	// set the references of in-flight IBC packets
+	for _, ref := range data.IBCPacketRefs {
+		k.SetIBCPacket(ctx, ref.PortID, ref.ChannelID, ref.IBCSequence, ref.TunnelID, ref.Sequence)
+	}
+
 	balance := k.GetModuleBalance(ctx)
 	// set module account if its balance is zero
 	if balance.IsZero() {
@@ -70,10 +76,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:      k.GetParams(ctx),
-		TunnelCount: k.GetTunnelCount(ctx),
-		Tunnels:     k.GetTunnels(ctx),
-		Deposits:    k.GetAllDeposits(ctx),
-		TotalFees:   k.GetTotalFees(ctx),
+		Params:        k.GetParams(ctx),
+		TunnelCount:   k.GetTunnelCount(ctx),
+		Tunnels:       k.GetTunnels(ctx),
+		Deposits:      k.GetAllDeposits(ctx),
+		TotalFees:     k.GetTotalFees(ctx),
+		IBCPacketRefs: k.GetAllIBCPacketRefs(ctx),
 	}
 }
@@ -32,7 +32,7 @@ func (s *KeeperTestSuite) TestInitExportGenesis() {
 		Return(sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(100)))).
 		AnyTimes()
 
-	t, err := types.NewTunnel(1, 0, types.NewIBCRoute("channel-0"), "", nil, 0, nil, false, 0, "")
+	t, err := types.NewTunnel(1, 0, types.NewIBCRoute("channel-0"), "", nil, nil, 0, "", false, nil, false, 0, "")
 	s.Require().NoError(err)
 	// Create a valid genesis state
 	genesisState := &types.GenesisState{
@@ -44,6 +44,9 @@ func (s *KeeperTestSuite) TestInitExportGenesis() {
 		TotalFees: types.TotalFees{
 			TotalBasePacketFee: sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(100))),
 		},
+		IBCPacketRefs: []types.IBCPacketRef{
+			types.NewIBCPacketRef("tunnel.1", "channel-0", 1, 1, 1),
+		},
 	}
 
 	// Initialize the genesis state
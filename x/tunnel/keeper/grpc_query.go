@@ -2,15 +2,18 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
@@ -32,6 +35,10 @@ func (q queryServer) Tunnels(c context.Context, req *types.QueryTunnelsRequest)
 		store,
 		req.Pagination,
 		func(key []byte, t *types.Tunnel) (*types.Tunnel, error) {
+			if req.Admin != "" && !t.IsAdmin(req.Admin) {
+				return nil, nil
+			}
+
 			switch req.StatusFilter {
 			case types.TUNNEL_STATUS_FILTER_UNSPECIFIED:
 				return t, nil
@@ -133,6 +140,84 @@ func (q queryServer) Packets(c context.Context, req *types.QueryPacketsRequest)
 	return &types.QueryPacketsResponse{Packets: filteredPackets, Pagination: pageRes}, nil
 }
 
+// PacketsByTime queries the packets of the tunnel created within the time range.
+func (q queryServer) PacketsByTime(
+	c context.Context,
+	req *types.QueryPacketsByTimeRequest,
+) (*types.QueryPacketsByTimeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.StartTime < 0 || (req.EndTime != 0 && req.EndTime < req.StartTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time range [%d, %d]", req.StartTime, req.EndTime)
+	}
+
+	// start iterating from the start time if the request does not continue from a previous page
+	pagination := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pagination = *req.Pagination
+	}
+	if len(pagination.Key) == 0 && pagination.Offset == 0 && !pagination.Reverse {
+		pagination.Key = sdk.Uint64ToBigEndian(uint64(req.StartTime))
+	}
+
+	var packets []*types.Packet
+	store := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.PacketsTimeIndexKey(req.TunnelId))
+	pageRes, err := query.FilteredPaginate(
+		store,
+		pagination,
+		func(key []byte, _ []byte, accumulate bool) (bool, error) {
+			createdAt := int64(sdk.BigEndianToUint64(key[:8]))
+			if createdAt < req.StartTime || (req.EndTime != 0 && createdAt > req.EndTime) {
+				return false, nil
+			}
+
+			if accumulate {
+				packet, err := q.k.GetPacket(ctx, req.TunnelId, sdk.BigEndianToUint64(key[8:]))
+				if err != nil {
+					return false, err
+				}
+				packets = append(packets, &packet)
+			}
+
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPacketsByTimeResponse{Packets: packets, Pagination: pageRes}, nil
+}
+
+// PacketsByStatus queries the packets of all tunnels with the status and the optional receipt type.
+func (q queryServer) PacketsByStatus(
+	c context.Context,
+	req *types.QueryPacketsByStatusRequest,
+) (*types.QueryPacketsByStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	indexKey := types.PacketsStatusIndexKey(req.Status)
+	if req.ReceiptType != "" {
+		indexKey = types.PacketsStatusReceiptTypeIndexKey(req.Status, strings.TrimPrefix(req.ReceiptType, "/"))
+	}
+
+	var packets []*types.Packet
+	store := prefix.NewStore(ctx.KVStore(q.k.storeKey), indexKey)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		tunnelID, sequence := types.SplitPacketIndexKey(key)
+		packet, err := q.k.GetPacket(ctx, tunnelID, sequence)
+		if err != nil {
+			return err
+		}
+		packets = append(packets, &packet)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPacketsByStatusResponse{Packets: packets, Pagination: pageRes}, nil
+}
+
 // Packet queries a packet by tunnel ID and sequence.
 func (q queryServer) Packet(c context.Context, req *types.QueryPacketRequest) (*types.QueryPacketResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -144,6 +229,122 @@ func (q queryServer) Packet(c context.Context, req *types.QueryPacketRequest) (*
 	return &types.QueryPacketResponse{Packet: &packet}, nil
 }
 
+// FailedPackets queries all failed packets of the tunnel.
+func (q queryServer) FailedPackets(
+	c context.Context,
+	req *types.QueryFailedPacketsRequest,
+) (*types.QueryFailedPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.FailedPacketsStoreKey(req.TunnelId))
+	failedPackets, pageRes, err := query.GenericFilteredPaginate(
+		q.k.cdc,
+		store,
+		req.Pagination,
+		func(key []byte, p *types.FailedPacket) (*types.FailedPacket, error) {
+			return p, nil
+		}, func() *types.FailedPacket {
+			return &types.FailedPacket{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFailedPacketsResponse{FailedPackets: failedPackets, Pagination: pageRes}, nil
+}
+
+// FeePayerBalance queries the fee payer balance and the funding policy of the tunnel.
+func (q queryServer) FeePayerBalance(
+	c context.Context,
+	req *types.QueryFeePayerBalanceRequest,
+) (*types.QueryFeePayerBalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	tunnel, err := q.k.GetTunnel(ctx, req.TunnelId)
+	if err != nil {
+		return nil, err
+	}
+
+	feePayer, err := sdk.AccAddressFromBech32(tunnel.FeePayer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeePayerBalanceResponse{
+		FeePayer:      tunnel.FeePayer,
+		Balance:       q.k.bankKeeper.GetAllBalances(ctx, feePayer),
+		FundingPolicy: tunnel.FundingPolicy,
+	}, nil
+}
+
+// EstimateTunnelCost estimates the fee per packet and the daily cost of running a tunnel.
+func (q queryServer) EstimateTunnelCost(
+	c context.Context,
+	req *types.QueryEstimateTunnelCostRequest,
+) (*types.QueryEstimateTunnelCostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := q.k.GetParams(ctx)
+
+	route, err := req.GetRouteValue()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := route.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := types.ValidateSignalDeviations(req.SignalDeviations, params.MaxSignals, params.MaxDeviationBPS, params.MinDeviationBPS); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := types.ValidateInterval(req.Interval, params.MaxInterval, params.MinInterval); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !req.RelayerFee.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid relayer fee: %s", req.RelayerFee)
+	}
+
+	routeFee, err := q.k.GetRouteFee(ctx, route)
+	if err != nil {
+		return nil, err
+	}
+
+	// IBC-based routes are relayed off-chain, so their relayer fee is only part of the estimate
+	relayerFee := sdk.NewCoins()
+	switch route.(type) {
+	case *types.IBCRoute, *types.IBCHookRoute, *types.RouterRoute, *types.IBCABIRoute:
+		relayerFee = req.RelayerFee
+	}
+
+	// model the packets triggered by price deviations by replaying the price history of the signals
+	var historicalPrices []feedstypes.Price
+	for _, sd := range req.SignalDeviations {
+		historicalPrices = append(historicalPrices, q.k.feedsKeeper.GetPriceHistory(ctx, sd.SignalID)...)
+	}
+	deviationPackets, duration := CountDeviationPackets(req.SignalDeviations, req.Interval, historicalPrices)
+	deviationPacketsPerDay := types.ScalePacketsPerDay(
+		deviationPackets,
+		duration,
+		types.EstimatePacketsPerDay(max(req.Interval, params.MinInterval)),
+	)
+
+	packetFee := params.BasePacketFee.Add(routeFee...).Add(relayerFee...)
+	packetsPerDay := types.EstimatePacketsPerDay(req.Interval) + deviationPacketsPerDay
+
+	return &types.QueryEstimateTunnelCostResponse{
+		MinDeposit:             params.MinDeposit,
+		BasePacketFee:          params.BasePacketFee,
+		RouteFee:               routeFee,
+		PacketFee:              packetFee,
+		PacketsPerDay:          packetsPerDay,
+		DailyCost:              packetFee.MulInt(sdkmath.NewIntFromUint64(packetsPerDay)),
+		DeviationPacketsPerDay: deviationPacketsPerDay,
+		RelayerFee:             relayerFee,
+	}, nil
+}
+
 func (q queryServer) TotalFees(
 	c context.Context,
 	req *types.QueryTotalFeesRequest,
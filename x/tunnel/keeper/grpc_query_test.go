@@ -1,10 +1,15 @@
 package keeper_test
 
 import (
+	"go.uber.org/mock/gomock"
+
 	sdkmath "cosmossdk.io/math"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
@@ -28,6 +33,37 @@ func (s *KeeperTestSuite) TestGRPCQueryTunnels() {
 	s.Require().Equal(tunnel2, *resp.Tunnels[1])
 }
 
+func (s *KeeperTestSuite) TestGRPCQueryTunnelsByAdmin() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	creator := sdk.AccAddress([]byte("creator")).String()
+	admin := sdk.AccAddress([]byte("admin")).String()
+
+	tunnel1 := types.Tunnel{
+		ID:      1,
+		Creator: creator,
+	}
+	tunnel2 := types.Tunnel{
+		ID:      2,
+		Creator: sdk.AccAddress([]byte("other")).String(),
+		Admins: []types.TunnelAdmin{
+			types.NewTunnelAdmin(admin, []types.TunnelPermission{types.TUNNEL_PERMISSION_TRIGGER}),
+		},
+	}
+	k.SetTunnel(ctx, tunnel1)
+	k.SetTunnel(ctx, tunnel2)
+
+	resp, err := q.Tunnels(ctx, &types.QueryTunnelsRequest{Admin: creator})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Tunnels, 1)
+	s.Require().Equal(tunnel1, *resp.Tunnels[0])
+
+	resp, err = q.Tunnels(ctx, &types.QueryTunnelsRequest{Admin: admin})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Tunnels, 1)
+	s.Require().Equal(tunnel2, *resp.Tunnels[0])
+}
+
 func (s *KeeperTestSuite) TestGRPCQueryTunnel() {
 	ctx, k, q := s.ctx, s.keeper, s.queryServer
 
@@ -44,6 +80,139 @@ func (s *KeeperTestSuite) TestGRPCQueryTunnel() {
 	s.Require().Equal(tunnel, resp.Tunnel)
 }
 
+func (s *KeeperTestSuite) TestGRPCQueryFeePayerBalance() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	feePayer := sdk.AccAddress([]byte("fee_payer"))
+	policy := types.NewFundingPolicy(
+		sdk.AccAddress([]byte("source")).String(),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 300)),
+	)
+	tunnel := types.Tunnel{
+		ID:            1,
+		FeePayer:      feePayer.String(),
+		FundingPolicy: &policy,
+	}
+	k.SetTunnel(ctx, tunnel)
+
+	balance := sdk.NewCoins(sdk.NewInt64Coin("uband", 50))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feePayer).Return(balance)
+
+	resp, err := q.FeePayerBalance(ctx, &types.QueryFeePayerBalanceRequest{TunnelId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(feePayer.String(), resp.FeePayer)
+	s.Require().Equal(balance, resp.Balance)
+	s.Require().Equal(&policy, resp.FundingPolicy)
+
+	_, err = q.FeePayerBalance(ctx, &types.QueryFeePayerBalanceRequest{TunnelId: 2})
+	s.Require().ErrorIs(err, types.ErrTunnelNotFound)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryEstimateTunnelCost() {
+	ctx, q := s.ctx, s.queryServer
+
+	signalDeviations := []types.SignalDeviation{
+		{SignalID: "CS:BAND-USD", SoftDeviationBPS: 100, HardDeviationBPS: 100},
+	}
+
+	tssRoute, err := codectypes.NewAnyWithValue(&types.TSSRoute{
+		DestinationChainID:         "chain-1",
+		DestinationContractAddress: "0x1234567890abcdef",
+		Encoder:                    feedstypes.ENCODER_FIXED_POINT_ABI,
+	})
+	s.Require().NoError(err)
+
+	signingFee := sdk.NewCoins(sdk.NewInt64Coin("uband", 20))
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(signingFee, nil)
+
+	// only the move to 1020 deviates from the latest sent price by at least the hard deviation
+	// without the interval being due, so one deviation packet is produced over two hours.
+	s.feedsKeeper.EXPECT().GetPriceHistory(gomock.Any(), "CS:BAND-USD").Return([]feedstypes.Price{
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 0),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1005, 600),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1020, 1200),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1021, 1800),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1021, 4000),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1100, 7200),
+	})
+
+	resp, err := q.EstimateTunnelCost(ctx, &types.QueryEstimateTunnelCostRequest{
+		Route:            tssRoute,
+		SignalDeviations: signalDeviations,
+		Interval:         3600,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultMinDeposit, resp.MinDeposit)
+	s.Require().Equal(types.DefaultBasePacketFee, resp.BasePacketFee)
+	s.Require().Equal(signingFee, resp.RouteFee)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 520)), resp.PacketFee)
+	s.Require().Equal(uint64(12), resp.DeviationPacketsPerDay)
+	s.Require().Equal(uint64(36), resp.PacketsPerDay)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 18720)), resp.DailyCost)
+
+	// IBC routes include the relayer fee in the estimate without an on-chain route fee
+	ibcRoute, err := codectypes.NewAnyWithValue(&types.IBCRoute{})
+	s.Require().NoError(err)
+
+	relayerFee := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+	s.feedsKeeper.EXPECT().GetPriceHistory(gomock.Any(), "CS:BAND-USD").Return(nil)
+
+	resp, err = q.EstimateTunnelCost(ctx, &types.QueryEstimateTunnelCostRequest{
+		Route:            ibcRoute,
+		SignalDeviations: signalDeviations,
+		Interval:         60,
+		RelayerFee:       relayerFee,
+	})
+	s.Require().NoError(err)
+	s.Require().True(resp.RouteFee.IsZero())
+	s.Require().Equal(relayerFee, resp.RelayerFee)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 600)), resp.PacketFee)
+	s.Require().Equal(uint64(0), resp.DeviationPacketsPerDay)
+	s.Require().Equal(uint64(1440), resp.PacketsPerDay)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 864000)), resp.DailyCost)
+
+	// the deviation packets of a short history are capped at one packet per interval
+	s.feedsKeeper.EXPECT().GetPriceHistory(gomock.Any(), "CS:BAND-USD").Return([]feedstypes.Price{
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 0),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1020, 60),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1041, 120),
+	})
+
+	resp, err = q.EstimateTunnelCost(ctx, &types.QueryEstimateTunnelCostRequest{
+		Route:            ibcRoute,
+		SignalDeviations: signalDeviations,
+		Interval:         3600,
+	})
+	s.Require().NoError(err)
+	s.Require().True(resp.RelayerFee.IsZero())
+	s.Require().Equal(uint64(24), resp.DeviationPacketsPerDay)
+	s.Require().Equal(uint64(48), resp.PacketsPerDay)
+
+	// the relayer fee is ignored for routes that are not relayed through IBC
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(signingFee, nil)
+	s.feedsKeeper.EXPECT().GetPriceHistory(gomock.Any(), "CS:BAND-USD").Return(nil)
+
+	resp, err = q.EstimateTunnelCost(ctx, &types.QueryEstimateTunnelCostRequest{
+		Route:            tssRoute,
+		SignalDeviations: signalDeviations,
+		Interval:         3600,
+		RelayerFee:       relayerFee,
+	})
+	s.Require().NoError(err)
+	s.Require().True(resp.RelayerFee.IsZero())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 520)), resp.PacketFee)
+
+	// interval out of range
+	_, err = q.EstimateTunnelCost(ctx, &types.QueryEstimateTunnelCostRequest{
+		Route:            ibcRoute,
+		SignalDeviations: signalDeviations,
+		Interval:         1,
+	})
+	s.Require().Error(err)
+}
+
 func (s *KeeperTestSuite) TestGRPCQueryDeposits() {
 	ctx, k, q := s.ctx, s.keeper, s.queryServer
 
@@ -175,6 +344,113 @@ func (s *KeeperTestSuite) TestGRPCQueryPackets() {
 	s.Require().Equal(packet2, *resp.Packets[1])
 }
 
+func (s *KeeperTestSuite) TestGRPCQueryPacketsByTime() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	packet1 := types.Packet{TunnelID: 1, Sequence: 1, CreatedAt: 100}
+	packet2 := types.Packet{TunnelID: 1, Sequence: 2, CreatedAt: 200}
+	packet3 := types.Packet{TunnelID: 1, Sequence: 3, CreatedAt: 300}
+	packet4 := types.Packet{TunnelID: 2, Sequence: 1, CreatedAt: 200}
+	k.SetPacket(ctx, packet1)
+	k.SetPacket(ctx, packet2)
+	k.SetPacket(ctx, packet3)
+	k.SetPacket(ctx, packet4)
+
+	resp, err := q.PacketsByTime(ctx, &types.QueryPacketsByTimeRequest{
+		TunnelId:  1,
+		StartTime: 150,
+		EndTime:   300,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.Packet{&packet2, &packet3}, resp.Packets)
+
+	// no upper bound
+	resp, err = q.PacketsByTime(ctx, &types.QueryPacketsByTimeRequest{
+		TunnelId:  1,
+		StartTime: 100,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.Packet{&packet1, &packet2, &packet3}, resp.Packets)
+
+	// pagination continues from the next key
+	resp, err = q.PacketsByTime(ctx, &types.QueryPacketsByTimeRequest{
+		TunnelId:   1,
+		StartTime:  100,
+		EndTime:    300,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.Packet{&packet1, &packet2}, resp.Packets)
+
+	resp, err = q.PacketsByTime(ctx, &types.QueryPacketsByTimeRequest{
+		TunnelId:   1,
+		StartTime:  100,
+		EndTime:    300,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.Packet{&packet3}, resp.Packets)
+
+	// invalid time range
+	_, err = q.PacketsByTime(ctx, &types.QueryPacketsByTimeRequest{
+		TunnelId:  1,
+		StartTime: 300,
+		EndTime:   100,
+	})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryPacketsByStatus() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	tssTunnel := types.Tunnel{ID: 1}
+	err := tssTunnel.SetRoute(&types.TSSRoute{DestinationChainID: "1", DestinationContractAddress: "0x123"})
+	s.Require().NoError(err)
+	k.SetTunnel(ctx, tssTunnel)
+
+	ibcTunnel := types.Tunnel{ID: 2}
+	err = ibcTunnel.SetRoute(&types.IBCRoute{ChannelID: "channel-0"})
+	s.Require().NoError(err)
+	k.SetTunnel(ctx, ibcTunnel)
+
+	packet1 := types.Packet{TunnelID: 1, Sequence: 1, Status: types.PACKET_STATUS_FAILED}
+	packet2 := types.Packet{TunnelID: 2, Sequence: 1, Status: types.PACKET_STATUS_FAILED}
+	packet3 := types.Packet{TunnelID: 2, Sequence: 2, Status: types.PACKET_STATUS_PENDING}
+	k.SetPacket(ctx, packet1)
+	k.SetPacket(ctx, packet2)
+	k.SetPacket(ctx, packet3)
+
+	resp, err := q.PacketsByStatus(ctx, &types.QueryPacketsByStatusRequest{
+		Status: types.PACKET_STATUS_FAILED,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Packets, 2)
+
+	resp, err = q.PacketsByStatus(ctx, &types.QueryPacketsByStatusRequest{
+		Status:      types.PACKET_STATUS_FAILED,
+		ReceiptType: "band.tunnel.v1beta1.TSSPacketReceipt",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.Packet{&packet1}, resp.Packets)
+
+	// the status index is updated when the packet status changes
+	packet3.Status = types.PACKET_STATUS_SUCCESS
+	k.SetPacket(ctx, packet3)
+
+	resp, err = q.PacketsByStatus(ctx, &types.QueryPacketsByStatusRequest{
+		Status: types.PACKET_STATUS_PENDING,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Packets)
+
+	resp, err = q.PacketsByStatus(ctx, &types.QueryPacketsByStatusRequest{
+		Status:      types.PACKET_STATUS_SUCCESS,
+		ReceiptType: "/band.tunnel.v1beta1.IBCPacketReceipt",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.Packet{&packet3}, resp.Packets)
+}
+
 func (s *KeeperTestSuite) TestGRPCQueryPacket() {
 	ctx, k, q := s.ctx, s.keeper, s.queryServer
 
@@ -218,6 +494,27 @@ func (s *KeeperTestSuite) TestGRPCQueryPacket() {
 	s.Require().Equal(packet1, *res.Packet)
 }
 
+func (s *KeeperTestSuite) TestGRPCQueryFailedPackets() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	failedPacket1 := types.NewFailedPacket(1, 1, "route error", 1, 0)
+	failedPacket2 := types.NewFailedPacket(1, 3, "route error", 2, 0)
+	failedPacket3 := types.NewFailedPacket(2, 1, "route error", 1, 0)
+
+	k.SetFailedPacket(ctx, failedPacket1)
+	k.SetFailedPacket(ctx, failedPacket2)
+	k.SetFailedPacket(ctx, failedPacket3)
+
+	resp, err := q.FailedPackets(ctx, &types.QueryFailedPacketsRequest{
+		TunnelId: 1,
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+	s.Require().Len(resp.FailedPackets, 2)
+	s.Require().Equal(failedPacket1, *resp.FailedPackets[0])
+	s.Require().Equal(failedPacket2, *resp.FailedPackets[1])
+}
+
 func (s *KeeperTestSuite) TestGRPCQueryTotalFees() {
 	ctx, k, q := s.ctx, s.keeper, s.queryServer
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"math"
+	"sort"
 
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -19,6 +20,99 @@ func CreatePricesMap(prices []feedstypes.Price) map[string]feedstypes.Price {
 	return pricesMap
 }
 
+// AddDerivedPrices returns a prices map containing the given feed prices and the prices of the given
+// derived signals calculated from them. A derived signal with a TWAP window is calculated from the
+// TWAP prices of that window instead. The given feed prices map is not modified.
+func AddDerivedPrices(
+	derivedSignals []types.DerivedSignal,
+	feedsPricesMap map[string]feedstypes.Price,
+	twapPricesMaps map[uint64]map[string]feedstypes.Price,
+	timestamp int64,
+) map[string]feedstypes.Price {
+	if len(derivedSignals) == 0 {
+		return feedsPricesMap
+	}
+
+	pricesMap := make(map[string]feedstypes.Price, len(feedsPricesMap)+len(derivedSignals))
+	for signalID, p := range feedsPricesMap {
+		pricesMap[signalID] = p
+	}
+	for _, d := range derivedSignals {
+		sourcePricesMap := feedsPricesMap
+		if d.TWAPWindow > 0 {
+			sourcePricesMap = twapPricesMaps[d.TWAPWindow]
+		}
+		pricesMap[d.SignalID] = d.CalculatePrice(sourcePricesMap, timestamp)
+	}
+
+	return pricesMap
+}
+
+// CountDeviationPackets replays the given historical prices through a tunnel with the given signal
+// deviations and interval, and returns the number of packets triggered by price deviations together
+// with the duration in seconds covered by the history. Packets triggered by the interval are not
+// counted, but they reset the prices from which the deviations are measured.
+func CountDeviationPackets(
+	signalDeviations []types.SignalDeviation,
+	interval uint64,
+	historicalPrices []feedstypes.Price,
+) (count uint64, duration int64) {
+	if len(historicalPrices) == 0 || interval == 0 {
+		return 0, 0
+	}
+
+	prices := make([]feedstypes.Price, len(historicalPrices))
+	copy(prices, historicalPrices)
+	sort.SliceStable(prices, func(i, j int) bool { return prices[i].Timestamp < prices[j].Timestamp })
+
+	start := prices[0].Timestamp
+	end := prices[len(prices)-1].Timestamp
+
+	// the tunnel is assumed to send all prices at the start of the history
+	currentPricesMap := make(map[string]feedstypes.Price)
+	for i := 0; i < len(prices) && prices[i].Timestamp == start; i++ {
+		currentPricesMap[prices[i].SignalID] = prices[i]
+	}
+	latestPricesMap := make(map[string]feedstypes.Price, len(currentPricesMap))
+	for signalID, p := range currentPricesMap {
+		latestPricesMap[signalID] = p
+	}
+	lastInterval := start
+
+	for i := 0; i < len(prices); {
+		timestamp := prices[i].Timestamp
+
+		// the prices do not change between the history entries, so the interval triggers before this
+		// timestamp send the current prices.
+		if elapsed := timestamp - lastInterval; elapsed > int64(interval) {
+			lastInterval += (elapsed - 1) / int64(interval) * int64(interval)
+			for signalID, p := range currentPricesMap {
+				latestPricesMap[signalID] = p
+			}
+		}
+
+		for ; i < len(prices) && prices[i].Timestamp == timestamp; i++ {
+			currentPricesMap[prices[i].SignalID] = prices[i]
+		}
+		if timestamp == start {
+			continue
+		}
+
+		intervalDue := timestamp >= lastInterval+int64(interval)
+		newPrices := GenerateNewPrices(signalDeviations, latestPricesMap, currentPricesMap, timestamp, intervalDue)
+		if intervalDue {
+			lastInterval = timestamp
+		} else if len(newPrices) > 0 {
+			count++
+		}
+		for _, p := range newPrices {
+			latestPricesMap[p.SignalID] = p
+		}
+	}
+
+	return count, end - start
+}
+
 // GenerateNewPrices generates new prices based on the current prices and signal deviations.
 func GenerateNewPrices(
 	signalDeviations []types.SignalDeviation,
@@ -41,6 +135,11 @@ func GenerateNewPrices(
 			feedPrice = feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, sd.SignalID, 0, timestamp)
 		}
 
+		// skip the signals whose prices are halted by the circuit breaker of the feeds module
+		if feedPrice.Status == feedstypes.PRICE_STATUS_HALTED {
+			continue
+		}
+
 		// calculate deviation between old price and new price and compare with the threshold.
 		// shouldSend is set to true if sendAll is true or there is a signal whose deviation
 		// is over the hard threshold.
@@ -158,3 +158,76 @@ func (s *KeeperTestSuite) TestGeneratePricesNotMeetHardDeviation() {
 	)
 	s.Require().Len(newPrices, 0)
 }
+
+func (s *KeeperTestSuite) TestGeneratePricesWithDerivedSignals() {
+	pricesMap := map[string]feedstypes.Price{
+		"CS:ETH-USD": {
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:ETH-USD",
+			Price:     3000000000000,
+			Timestamp: 1733000000,
+		},
+		"CS:BTC-USD": {
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:BTC-USD",
+			Price:     60000000000000,
+			Timestamp: 1732999990,
+		},
+	}
+	tunnel := types.Tunnel{
+		ID: 1,
+		SignalDeviations: []types.SignalDeviation{
+			{SignalID: "CS:ETH-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+		},
+		DerivedSignals: []types.DerivedSignal{
+			{SignalID: "DS:ETH-BTC", Expression: "CS:ETH-USD / CS:BTC-USD", SoftDeviationBPS: 100, HardDeviationBPS: 100},
+		},
+	}
+	latestPricesMap := keeper.CreatePricesMap([]feedstypes.Price{
+		{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "CS:ETH-USD", Price: 3000000000000, Timestamp: 1732000000},
+		{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "DS:ETH-BTC", Price: 60000000, Timestamp: 1732000000},
+	})
+
+	allPricesMap := keeper.AddDerivedPrices(tunnel.DerivedSignals, pricesMap, nil, 1733000000)
+	s.Require().Len(pricesMap, 2)
+	s.Require().Equal(
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DS:ETH-BTC", 50000000, 1732999990),
+		allPricesMap["DS:ETH-BTC"],
+	)
+
+	// only the derived signal exceeds its hard deviation
+	newPrices := keeper.GenerateNewPrices(
+		tunnel.GetAllSignalDeviations(),
+		latestPricesMap,
+		allPricesMap,
+		1733000000,
+		false,
+	)
+	s.Require().Equal([]feedstypes.Price{allPricesMap["DS:ETH-BTC"]}, newPrices)
+}
+
+func (s *KeeperTestSuite) TestGeneratePricesSkipHaltedPrices() {
+	pricesMap := map[string]feedstypes.Price{
+		"CS:BAND-USD": {
+			Status:    feedstypes.PRICE_STATUS_HALTED,
+			SignalID:  "CS:BAND-USD",
+			Price:     50000,
+			Timestamp: 1733000000,
+		},
+		"CS:ETH-USD": {
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:ETH-USD",
+			Price:     2000,
+			Timestamp: 1733000000,
+		},
+	}
+	signalDeviations := []types.SignalDeviation{
+		{SignalID: "CS:BAND-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+		{SignalID: "CS:ETH-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+	}
+
+	// the halted price is skipped even if all prices are sent
+	newPrices := keeper.GenerateNewPrices(signalDeviations, map[string]feedstypes.Price{}, pricesMap, 1733000000, true)
+	s.Require().Len(newPrices, 1)
+	s.Require().Equal("CS:ETH-USD", newPrices[0].SignalID)
+}
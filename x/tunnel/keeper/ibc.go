@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 // bindIBCPort will reserve the port.
@@ -38,6 +41,45 @@ func PortIDForTunnel(tunnelID uint64) string {
 	return fmt.Sprintf("%s%d", portIDPrefix, tunnelID)
 }
 
+// TunnelIDFromPortID returns the tunnel ID of the given port ID.
+func TunnelIDFromPortID(portID string) (uint64, error) {
+	if !IsValidPortID(portID) {
+		return 0, types.ErrInvalidPortID.Wrapf("port id %s", portID)
+	}
+
+	tunnelID, err := strconv.ParseUint(strings.TrimPrefix(portID, portIDPrefix), 10, 64)
+	if err != nil {
+		return 0, types.ErrInvalidPortID.Wrapf("port id %s: %s", portID, err)
+	}
+
+	return tunnelID, nil
+}
+
+// ChannelVersion returns the channel version of the port of a tunnel. The channels of the IBC ABI
+// route carry ABI-encoded packets and use VersionABI, while the other tunnel channels use Version.
+func (k Keeper) ChannelVersion(ctx sdk.Context, portID string) (string, error) {
+	tunnelID, err := TunnelIDFromPortID(portID)
+	if err != nil {
+		return "", err
+	}
+
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return "", err
+	}
+
+	route, err := tunnel.GetRouteValue()
+	if err != nil {
+		return "", err
+	}
+
+	if _, ok := route.(*types.IBCABIRoute); ok {
+		return types.VersionABI, nil
+	}
+
+	return types.Version, nil
+}
+
 // IsValidPortID checks if a given port ID is valid.
 // It ensures that the port ID starts with the predefined prefix (portIDPrefix).
 func IsValidPortID(portID string) bool {
@@ -3,7 +3,9 @@ package keeper_test
 import (
 	"fmt"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/tunnel/keeper"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 func (s *KeeperTestSuite) TestPortIDForTunnel() {
@@ -42,3 +44,37 @@ func (s *KeeperTestSuite) TestIsValidPortID() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestTunnelIDFromPortID() {
+	tunnelID, err := keeper.TunnelIDFromPortID("tunnel.1")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), tunnelID)
+
+	_, err = keeper.TunnelIDFromPortID("tun.1")
+	s.Require().ErrorIs(err, types.ErrInvalidPortID)
+
+	_, err = keeper.TunnelIDFromPortID("tunnel.abc")
+	s.Require().ErrorIs(err, types.ErrInvalidPortID)
+}
+
+func (s *KeeperTestSuite) TestChannelVersion() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	portID := keeper.PortIDForTunnel(tunnel.ID)
+
+	version, err := k.ChannelVersion(ctx, portID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Version, version)
+
+	err = tunnel.SetRoute(&types.IBCABIRoute{ChannelID: "channel-0", Encoder: feedstypes.ENCODER_FIXED_POINT_ABI})
+	s.Require().NoError(err)
+	k.SetTunnel(ctx, *tunnel)
+
+	version, err = k.ChannelVersion(ctx, portID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.VersionABI, version)
+
+	_, err = k.ChannelVersion(ctx, keeper.PortIDForTunnel(tunnel.ID+1))
+	s.Require().ErrorIs(err, types.ErrTunnelNotFound)
+}
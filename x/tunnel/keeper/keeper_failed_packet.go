@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// SetFailedPacket sets a failed packet in the store
+func (k Keeper) SetFailedPacket(ctx sdk.Context, failedPacket types.FailedPacket) {
+	ctx.KVStore(k.storeKey).
+		Set(types.FailedPacketStoreKey(failedPacket.TunnelID, failedPacket.Sequence), k.cdc.MustMarshal(&failedPacket))
+}
+
+// GetFailedPacket retrieves a failed packet by its tunnel ID and sequence
+func (k Keeper) GetFailedPacket(ctx sdk.Context, tunnelID uint64, sequence uint64) (types.FailedPacket, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FailedPacketStoreKey(tunnelID, sequence))
+	if bz == nil {
+		return types.FailedPacket{}, types.ErrFailedPacketNotFound.Wrapf(
+			"tunnelID: %d, sequence: %d",
+			tunnelID,
+			sequence,
+		)
+	}
+
+	var failedPacket types.FailedPacket
+	k.cdc.MustUnmarshal(bz, &failedPacket)
+	return failedPacket, nil
+}
+
+// GetFailedPackets retrieves all failed packets of the given tunnel
+func (k Keeper) GetFailedPackets(ctx sdk.Context, tunnelID uint64) []types.FailedPacket {
+	var failedPackets []types.FailedPacket
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FailedPacketsStoreKey(tunnelID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var failedPacket types.FailedPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &failedPacket)
+		failedPackets = append(failedPackets, failedPacket)
+	}
+	return failedPackets
+}
+
+// DeleteFailedPacket deletes a failed packet from the store
+func (k Keeper) DeleteFailedPacket(ctx sdk.Context, tunnelID uint64, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.FailedPacketStoreKey(tunnelID, sequence))
+}
+
+// TrySendPacket sends a packet to the destination route. If sending fails, the state changes
+// made by the route are discarded and the failure is recorded so that the packet can be retried.
+// On success, any failure record of the packet is removed.
+func (k Keeper) TrySendPacket(ctx sdk.Context, packet types.Packet) error {
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	sendErr := k.SendPacket(cacheCtx, packet)
+	if sendErr == nil {
+		writeFn()
+		k.DeleteFailedPacket(ctx, packet.TunnelID, packet.Sequence)
+		return nil
+	}
+
+	failedPacket := k.recordFailedPacket(ctx, packet, sendErr.Error())
+
+	packet.Status = types.PACKET_STATUS_FAILED
+	k.SetPacket(ctx, packet)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSendPacketFail,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", packet.TunnelID)),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyAttempts, fmt.Sprintf("%d", failedPacket.Attempts)),
+		sdk.NewAttribute(types.AttributeKeyReason, failedPacket.Reason),
+	))
+
+	return sendErr
+}
+
+// recordFailedPacket increases the attempt count of the failure record of the packet, or starts
+// a new one, with the given reason.
+func (k Keeper) recordFailedPacket(ctx sdk.Context, packet types.Packet, reason string) types.FailedPacket {
+	failedPacket, err := k.GetFailedPacket(ctx, packet.TunnelID, packet.Sequence)
+	if err != nil {
+		failedPacket = types.NewFailedPacket(packet.TunnelID, packet.Sequence, "", 0, 0)
+	}
+	failedPacket.Reason = reason
+	failedPacket.Attempts++
+	failedPacket.LastAttemptAt = ctx.BlockTime().Unix()
+	k.SetFailedPacket(ctx, failedPacket)
+
+	return failedPacket
+}
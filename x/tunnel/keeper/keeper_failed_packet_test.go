@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func (s *KeeperTestSuite) TestGetSetDeleteFailedPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	failedPacket := types.NewFailedPacket(1, 1, "route error", 1, ctx.BlockTime().Unix())
+	k.SetFailedPacket(ctx, failedPacket)
+
+	storedFailedPacket, err := k.GetFailedPacket(ctx, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(failedPacket, storedFailedPacket)
+
+	s.Require().Equal([]types.FailedPacket{failedPacket}, k.GetFailedPackets(ctx, 1))
+	s.Require().Empty(k.GetFailedPackets(ctx, 2))
+
+	k.DeleteFailedPacket(ctx, 1, 1)
+
+	_, err = k.GetFailedPacket(ctx, 1, 1)
+	s.Require().ErrorIs(err, types.ErrFailedPacketNotFound)
+}
+
+func (s *KeeperTestSuite) TestTrySendPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	signingFee := sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20)))
+
+	packet := types.NewPacket(tunnel.ID, 1, nil, ctx.BlockTime().Unix())
+	k.SetPacket(ctx, packet)
+
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(signingFee, nil).Times(3)
+
+	// first and second attempts fail
+	s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+		gomock.Any(),
+		tunnel.ID,
+		"chain-1",
+		"0x1234567890abcdef",
+		gomock.Any(),
+		feePayer,
+		signingFee,
+	).Return(bandtsstypes.SigningID(0), sdkerrors.ErrInsufficientFunds).Times(2)
+
+	err := k.TrySendPacket(ctx, packet)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	err = k.TrySendPacket(ctx, packet)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	failedPacket, err := k.GetFailedPacket(ctx, tunnel.ID, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), failedPacket.Attempts)
+
+	storedPacket, err := k.GetPacket(ctx, tunnel.ID, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PACKET_STATUS_FAILED, storedPacket.Status)
+
+	// third attempt succeeds and removes the failure record
+	s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+		gomock.Any(),
+		tunnel.ID,
+		"chain-1",
+		"0x1234567890abcdef",
+		gomock.Any(),
+		feePayer,
+		signingFee,
+	).Return(bandtsstypes.SigningID(1), nil)
+
+	err = k.TrySendPacket(ctx, packet)
+	s.Require().NoError(err)
+
+	_, err = k.GetFailedPacket(ctx, tunnel.ID, 1)
+	s.Require().ErrorIs(err, types.ErrFailedPacketNotFound)
+
+	storedPacket, err = k.GetPacket(ctx, tunnel.ID, 1)
+	s.Require().NoError(err)
+	s.Require().NotNil(storedPacket.Receipt)
+	s.Require().Equal(types.PACKET_STATUS_PENDING, storedPacket.Status)
+}
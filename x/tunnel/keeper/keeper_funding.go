@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// ApproveFundingSource sets the funding account that can set the funding policy of the tunnel.
+// An existing funding policy of another funding account is removed.
+func (k Keeper) ApproveFundingSource(ctx sdk.Context, tunnelID uint64, source string) error {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	tunnel.FundingSource = source
+	k.SetTunnel(ctx, tunnel)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeApproveFundingSource,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeySource, source),
+	))
+
+	if tunnel.FundingPolicy != nil && tunnel.FundingPolicy.Source != source {
+		return k.RemoveFundingPolicy(ctx, tunnelID)
+	}
+
+	return nil
+}
+
+// SetFundingPolicy sets the funding policy of the tunnel. A tunnel can only be funded by the
+// funding account approved by its creator.
+func (k Keeper) SetFundingPolicy(ctx sdk.Context, tunnelID uint64, policy types.FundingPolicy) error {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	if tunnel.FundingSource != policy.Source {
+		return types.ErrInvalidFundingSender.Wrapf(
+			"source %s is not approved by the creator of tunnel %d",
+			policy.Source,
+			tunnelID,
+		)
+	}
+
+	tunnel.FundingPolicy = &policy
+	k.SetTunnel(ctx, tunnel)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetFundingPolicy,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeySource, policy.Source),
+		sdk.NewAttribute(types.AttributeKeyAllowance, policy.Allowance.String()),
+		sdk.NewAttribute(types.AttributeKeyThreshold, policy.Threshold.String()),
+		sdk.NewAttribute(types.AttributeKeyTopUpAmount, policy.TopUpAmount.String()),
+	))
+
+	return nil
+}
+
+// RemoveFundingPolicy removes the funding policy of the tunnel.
+func (k Keeper) RemoveFundingPolicy(ctx sdk.Context, tunnelID uint64) error {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	if tunnel.FundingPolicy == nil {
+		return types.ErrFundingPolicyNotFound.Wrapf("tunnelID: %d", tunnelID)
+	}
+
+	source := tunnel.FundingPolicy.Source
+	tunnel.FundingPolicy = nil
+	k.SetTunnel(ctx, tunnel)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveFundingPolicy,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeySource, source),
+	))
+
+	return nil
+}
+
+// TopUpFeePayer sends the top-up amount from the funding account to the fee payer of the tunnel
+// if the fee payer balance is below the threshold of the funding policy. The sent amount is
+// deducted from the allowance. It returns the coins sent to the fee payer.
+func (k Keeper) TopUpFeePayer(ctx sdk.Context, tunnelID uint64) (sdk.Coins, error) {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := tunnel.FundingPolicy
+	if policy == nil {
+		return sdk.NewCoins(), nil
+	}
+
+	feePayer, err := sdk.AccAddressFromBech32(tunnel.FeePayer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !policy.IsBelowThreshold(k.bankKeeper.GetAllBalances(ctx, feePayer)) {
+		return sdk.NewCoins(), nil
+	}
+
+	// the allowance is used up
+	amount := policy.GetTopUpCoins()
+	if amount.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	source, err := sdk.AccAddressFromBech32(policy.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, source, feePayer, amount); err != nil {
+		return nil, err
+	}
+
+	policy.Allowance = policy.Allowance.Sub(amount...)
+	k.SetTunnel(ctx, tunnel)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTopUpFeePayer,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeySource, policy.Source),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, tunnel.FeePayer),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyAllowance, policy.Allowance.String()),
+	))
+
+	return amount, nil
+}
+
+// TryTopUpFeePayer tops up the fee payer of the tunnel. If the top-up fails, e.g. the funding
+// account does not have enough balance, the state changes are discarded and an event is emitted.
+func (k Keeper) TryTopUpFeePayer(ctx sdk.Context, tunnelID uint64) {
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	if _, err := k.TopUpFeePayer(cacheCtx, tunnelID); err != nil {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTopUpFeePayerFail,
+			sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+		return
+	}
+
+	writeFn()
+}
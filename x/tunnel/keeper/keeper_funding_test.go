@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func (s *KeeperTestSuite) TestSetRemoveFundingPolicy() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	source := sdk.AccAddress([]byte("source")).String()
+	policy := types.NewFundingPolicy(
+		source,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 300)),
+	)
+
+	// the source must be approved by the creator
+	err := k.SetFundingPolicy(ctx, tunnel.ID, policy)
+	s.Require().ErrorIs(err, types.ErrInvalidFundingSender)
+
+	err = k.ApproveFundingSource(ctx, tunnel.ID, source)
+	s.Require().NoError(err)
+
+	err = k.SetFundingPolicy(ctx, tunnel.ID, policy)
+	s.Require().NoError(err)
+
+	storedTunnel := k.MustGetTunnel(ctx, tunnel.ID)
+	s.Require().Equal(&policy, storedTunnel.FundingPolicy)
+
+	// another funding account cannot replace the policy
+	otherPolicy := policy
+	otherPolicy.Source = sdk.AccAddress([]byte("other_source")).String()
+	err = k.SetFundingPolicy(ctx, tunnel.ID, otherPolicy)
+	s.Require().ErrorIs(err, types.ErrInvalidFundingSender)
+
+	err = k.RemoveFundingPolicy(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().Nil(k.MustGetTunnel(ctx, tunnel.ID).FundingPolicy)
+
+	err = k.RemoveFundingPolicy(ctx, tunnel.ID)
+	s.Require().ErrorIs(err, types.ErrFundingPolicyNotFound)
+}
+
+func (s *KeeperTestSuite) TestApproveFundingSource() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	source := sdk.AccAddress([]byte("source")).String()
+
+	err := k.ApproveFundingSource(ctx, tunnel.ID, source)
+	s.Require().NoError(err)
+
+	err = k.SetFundingPolicy(ctx, tunnel.ID, types.NewFundingPolicy(
+		source,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 300)),
+	))
+	s.Require().NoError(err)
+
+	// approving another funding account removes the policy of the previous one
+	err = k.ApproveFundingSource(ctx, tunnel.ID, sdk.AccAddress([]byte("other_source")).String())
+	s.Require().NoError(err)
+
+	storedTunnel := k.MustGetTunnel(ctx, tunnel.ID)
+	s.Require().Equal(sdk.AccAddress([]byte("other_source")).String(), storedTunnel.FundingSource)
+	s.Require().Nil(storedTunnel.FundingPolicy)
+}
+
+func (s *KeeperTestSuite) TestTopUpFeePayer() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	source := sdk.AccAddress([]byte("source"))
+
+	err := k.ApproveFundingSource(ctx, tunnel.ID, source.String())
+	s.Require().NoError(err)
+
+	err = k.SetFundingPolicy(ctx, tunnel.ID, types.NewFundingPolicy(
+		source.String(),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 500)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 300)),
+	))
+	s.Require().NoError(err)
+
+	// balance is above the threshold
+	s.bankKeeper.EXPECT().GetAllBalances(ctx, feePayer).Return(sdk.NewCoins(sdk.NewInt64Coin("uband", 100)))
+
+	amount, err := k.TopUpFeePayer(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().True(amount.IsZero())
+
+	// balance is below the threshold
+	s.bankKeeper.EXPECT().GetAllBalances(ctx, feePayer).Return(sdk.NewCoins(sdk.NewInt64Coin("uband", 99)))
+	s.bankKeeper.EXPECT().
+		SendCoins(ctx, source, feePayer, sdk.NewCoins(sdk.NewInt64Coin("uband", 300))).
+		Return(nil)
+
+	amount, err = k.TopUpFeePayer(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 300)), amount)
+	s.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 200)),
+		k.MustGetTunnel(ctx, tunnel.ID).FundingPolicy.Allowance,
+	)
+
+	// top-up amount is capped by the remaining allowance
+	s.bankKeeper.EXPECT().GetAllBalances(ctx, feePayer).Return(sdk.NewCoins())
+	s.bankKeeper.EXPECT().
+		SendCoins(ctx, source, feePayer, sdk.NewCoins(sdk.NewInt64Coin("uband", 200))).
+		Return(nil)
+
+	amount, err = k.TopUpFeePayer(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 200)), amount)
+	s.Require().True(k.MustGetTunnel(ctx, tunnel.ID).FundingPolicy.Allowance.IsZero())
+
+	// allowance is used up
+	s.bankKeeper.EXPECT().GetAllBalances(ctx, feePayer).Return(sdk.NewCoins())
+
+	amount, err = k.TopUpFeePayer(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	s.Require().True(amount.IsZero())
+}
+
+func (s *KeeperTestSuite) TestTryTopUpFeePayerFail() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	policy := types.NewFundingPolicy(
+		sdk.AccAddress([]byte("source")).String(),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 500)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 300)),
+	)
+	err := k.ApproveFundingSource(ctx, tunnel.ID, policy.Source)
+	s.Require().NoError(err)
+
+	err = k.SetFundingPolicy(ctx, tunnel.ID, policy)
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), gomock.Any()).Return(sdk.NewCoins())
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(sdkerrors.ErrInsufficientFunds)
+
+	k.TryTopUpFeePayer(ctx, tunnel.ID)
+
+	// the allowance is not consumed
+	s.Require().Equal(&policy, k.MustGetTunnel(ctx, tunnel.ID).FundingPolicy)
+
+	events := ctx.EventManager().Events()
+	s.Require().Equal(types.EventTypeTopUpFeePayerFail, events[len(events)-1].Type)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -25,16 +26,47 @@ func (k Keeper) DeductBasePacketFee(ctx sdk.Context, feePayer sdk.AccAddress) er
 	return nil
 }
 
-// SetPacket sets a packet in the store
+// SetPacket sets a packet in the store and updates its creation time and status indexes
 func (k Keeper) SetPacket(ctx sdk.Context, packet types.Packet) {
-	ctx.KVStore(k.storeKey).
-		Set(types.TunnelPacketStoreKey(packet.TunnelID, packet.Sequence), k.cdc.MustMarshal(&packet))
+	store := ctx.KVStore(k.storeKey)
+	key := types.TunnelPacketStoreKey(packet.TunnelID, packet.Sequence)
+	receiptType := k.getPacketReceiptType(ctx, packet.TunnelID)
+
+	// remove the status index of the previous version of the packet
+	if bz := store.Get(key); bz != nil {
+		var prevPacket types.Packet
+		k.cdc.MustUnmarshal(bz, &prevPacket)
+		store.Delete(types.PacketStatusIndexKey(prevPacket.Status, receiptType, packet.TunnelID, packet.Sequence))
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&packet))
+	store.Set(types.PacketTimeIndexKey(packet.TunnelID, packet.CreatedAt, packet.Sequence), []byte{})
+	store.Set(types.PacketStatusIndexKey(packet.Status, receiptType, packet.TunnelID, packet.Sequence), []byte{})
+}
+
+// getPacketReceiptType returns the receipt type of the packets of the tunnel. The route type of
+// a tunnel cannot be changed, so all packets of a tunnel have the same receipt type.
+func (k Keeper) getPacketReceiptType(ctx sdk.Context, tunnelID uint64) string {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil || tunnel.Route == nil {
+		return ""
+	}
+
+	route, err := tunnel.GetRouteValue()
+	if err != nil {
+		return ""
+	}
+
+	return types.GetRouteReceiptType(route)
 }
 
 // GetPacket retrieves a packet by its tunnel ID and packet ID
 func (k Keeper) GetPacket(ctx sdk.Context, tunnelID uint64, sequence uint64) (types.Packet, error) {
 	bz := ctx.KVStore(k.storeKey).Get(types.TunnelPacketStoreKey(tunnelID, sequence))
 	if bz == nil {
+		if sequence <= k.GetPrunedSequence(ctx, tunnelID) {
+			return types.Packet{}, types.ErrPacketPruned.Wrapf("tunnelID: %d, sequence: %d", tunnelID, sequence)
+		}
 		return types.Packet{}, types.ErrPacketNotFound.Wrapf("tunnelID: %d, sequence: %d", tunnelID, sequence)
 	}
 
@@ -53,11 +85,20 @@ func (k Keeper) ProduceActiveTunnelPackets(ctx sdk.Context) error {
 
 	// create new packet. If failed to produce packet, emit an event.
 	for _, id := range ids {
+		// top up the fee payer from its funding account before producing the packet
+		// so that the tunnel is not deactivated due to an insufficient fee payer balance.
+		k.TryTopUpFeePayer(ctx, id)
+
 		cacheCtx, writeFn := ctx.CacheContext()
 
 		// Produce a packet. If produce packet successfully, update the context state.
-		// if not, emit an event and deactivate the tunnel.
+		// if not, emit an event and deactivate the tunnel. If the packet is created but
+		// failed to be sent, the packet and its failure record are kept so that it can be retried.
 		if err := k.ProducePacket(cacheCtx, id, pricesMap); err != nil {
+			if errors.Is(err, types.ErrSendPacketFailed) {
+				writeFn()
+			}
+
 			ctx.EventManager().EmitEvent(sdk.NewEvent(
 				types.EventTypeProducePacketFail,
 				sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", id)),
@@ -96,13 +137,29 @@ func (k Keeper) ProducePacket(
 	latestPricesMap := CreatePricesMap(latestPrices.Prices)
 
 	// check if the interval has passed
-	sendAll := unixNow >= int64(tunnel.Interval)+latestPrices.LastInterval
+	intervalDue := unixNow >= int64(tunnel.Interval)+latestPrices.LastInterval
+
+	// check if a scheduled time has passed since the last scheduled trigger
+	lastSchedule := latestPrices.LastSchedule
+	if lastSchedule == 0 {
+		lastSchedule = latestPrices.LastInterval
+	}
+	scheduleDue, err := types.IsScheduleDue(tunnel.Schedule, lastSchedule, unixNow)
+	if err != nil {
+		return err
+	}
+
+	sendAll := intervalDue || scheduleDue
+
+	// calculate the prices of the derived signals from the feed prices
+	twapPricesMaps := k.GetTWAPPricesMaps(ctx, tunnel.DerivedSignals)
+	pricesMap := AddDerivedPrices(tunnel.DerivedSignals, feedsPricesMap, twapPricesMaps, unixNow)
 
 	// generate newPrices; if no newPrices, stop the process.
 	newPrices := GenerateNewPrices(
-		tunnel.SignalDeviations,
+		tunnel.GetAllSignalDeviations(),
 		latestPricesMap,
-		feedsPricesMap,
+		pricesMap,
 		ctx.BlockTime().Unix(),
 		sendAll,
 	)
@@ -116,16 +173,19 @@ func (k Keeper) ProducePacket(
 		return err
 	}
 
-	// send packet
-	if err := k.SendPacket(ctx, packet); err != nil {
-		return sdkerrors.Wrapf(err, "failed to send packet for tunnel %d", tunnel.ID)
+	// send packet; if failed, record the failure so that the packet can be retried later.
+	if err := k.TrySendPacket(ctx, packet); err != nil {
+		return types.ErrSendPacketFailed.Wrapf("tunnel %d, sequence %d: %s", tunnel.ID, packet.Sequence, err)
 	}
 
 	// update latest price info.
 	latestPrices.UpdatePrices(newPrices)
-	if sendAll {
+	if intervalDue {
 		latestPrices.LastInterval = unixNow
 	}
+	if scheduleDue {
+		latestPrices.LastSchedule = unixNow
+	}
 	k.SetLatestPrices(ctx, latestPrices)
 
 	// emit an event
@@ -138,6 +198,72 @@ func (k Keeper) ProducePacket(
 	return nil
 }
 
+// GetDerivedPrices calculates the current prices of the given derived signals from the feed prices
+func (k Keeper) GetDerivedPrices(ctx sdk.Context, derivedSignals []types.DerivedSignal) []feedstypes.Price {
+	if len(derivedSignals) == 0 {
+		return []feedstypes.Price{}
+	}
+
+	var sourceSignalIDs []string
+	for _, d := range derivedSignals {
+		if d.TWAPWindow > 0 {
+			continue
+		}
+		signalIDs, err := d.GetSourceSignalIDs()
+		if err != nil {
+			continue
+		}
+		sourceSignalIDs = append(sourceSignalIDs, signalIDs...)
+	}
+	feedsPricesMap := CreatePricesMap(k.feedsKeeper.GetPrices(ctx, sourceSignalIDs))
+	pricesMap := AddDerivedPrices(
+		derivedSignals,
+		feedsPricesMap,
+		k.GetTWAPPricesMaps(ctx, derivedSignals),
+		ctx.BlockTime().Unix(),
+	)
+
+	prices := make([]feedstypes.Price, 0, len(derivedSignals))
+	for _, d := range derivedSignals {
+		prices = append(prices, pricesMap[d.SignalID])
+	}
+
+	return prices
+}
+
+// GetTWAPPricesMaps returns the TWAP prices of the source signals of the given derived signals,
+// grouped by TWAP window. Derived signals without a TWAP window are skipped.
+func (k Keeper) GetTWAPPricesMaps(
+	ctx sdk.Context,
+	derivedSignals []types.DerivedSignal,
+) map[uint64]map[string]feedstypes.Price {
+	twapPricesMaps := make(map[uint64]map[string]feedstypes.Price)
+	for _, d := range derivedSignals {
+		if d.TWAPWindow == 0 {
+			continue
+		}
+
+		signalIDs, err := d.GetSourceSignalIDs()
+		if err != nil {
+			continue
+		}
+
+		pricesMap, ok := twapPricesMaps[d.TWAPWindow]
+		if !ok {
+			pricesMap = make(map[string]feedstypes.Price)
+			twapPricesMaps[d.TWAPWindow] = pricesMap
+		}
+
+		for _, signalID := range signalIDs {
+			if _, ok := pricesMap[signalID]; !ok {
+				pricesMap[signalID] = k.feedsKeeper.GetTWAPPrice(ctx, signalID, int64(d.TWAPWindow))
+			}
+		}
+	}
+
+	return twapPricesMaps
+}
+
 // CreatePacket creates a new packet of the given tunnel. Creating a packet charges
 // the base packet fee to the tunnel's fee payer.
 func (k Keeper) CreatePacket(
@@ -152,10 +278,16 @@ func (k Keeper) CreatePacket(
 
 	// deduct base packet fee from the fee payer,
 	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	basePacketFee := k.GetParams(ctx).BasePacketFee
 	if err := k.DeductBasePacketFee(ctx, feePayer); err != nil {
 		return types.Packet{}, sdkerrors.Wrapf(err, "failed to deduct base packet fee for tunnel %d", tunnel.ID)
 	}
 
+	// keep the aggregation method and confidence metadata of the prices only if the tunnel opts in
+	if !tunnel.IncludePriceConfidence {
+		prices = types.RemovePriceMetadata(prices)
+	}
+
 	tunnel.Sequence++
 	packet := types.NewPacket(
 		tunnelID,
@@ -163,6 +295,7 @@ func (k Keeper) CreatePacket(
 		prices,
 		ctx.BlockTime().Unix(),
 	)
+	packet.BaseFee = basePacketFee
 
 	// update information in the store
 	k.SetTunnel(ctx, tunnel)
@@ -206,12 +339,19 @@ func (k Keeper) SendPacket(ctx sdk.Context, packet types.Packet) (err error) {
 			packet,
 			sdk.MustAccAddressFromBech32(tunnel.FeePayer),
 		)
+		packet.Status = types.PACKET_STATUS_PENDING
 	case *types.IBCRoute:
 		receipt, err = k.SendIBCPacket(ctx, r, packet, tunnel.Interval)
+		packet.Status = types.PACKET_STATUS_PENDING
 	case *types.IBCHookRoute:
 		receipt, err = k.SendIBCHookPacket(ctx, r, packet, sdk.MustAccAddressFromBech32(tunnel.FeePayer), tunnel.Interval)
+		packet.Status = types.PACKET_STATUS_PENDING
 	case *types.RouterRoute:
 		receipt, err = k.SendRouterPacket(ctx, r, packet, sdk.MustAccAddressFromBech32(tunnel.FeePayer), tunnel.Interval)
+		packet.Status = types.PACKET_STATUS_PENDING
+	case *types.IBCABIRoute:
+		receipt, err = k.SendIBCABIPacket(ctx, r, packet, tunnel.Interval)
+		packet.Status = types.PACKET_STATUS_PENDING
 	default:
 		return types.ErrInvalidRoute.Wrapf("no route found for tunnel ID: %d", tunnel.ID)
 	}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// SetIBCPacket sets the reference from an IBC packet to the tunnel packet that it delivers
+func (k Keeper) SetIBCPacket(
+	ctx sdk.Context,
+	portID string,
+	channelID string,
+	ibcSequence uint64,
+	tunnelID uint64,
+	sequence uint64,
+) {
+	ctx.KVStore(k.storeKey).Set(
+		types.IBCPacketStoreKey(portID, channelID, ibcSequence),
+		append(sdk.Uint64ToBigEndian(tunnelID), sdk.Uint64ToBigEndian(sequence)...),
+	)
+}
+
+// GetIBCPacket retrieves the tunnel ID and sequence of the tunnel packet delivered by the given IBC packet
+func (k Keeper) GetIBCPacket(
+	ctx sdk.Context,
+	portID string,
+	channelID string,
+	ibcSequence uint64,
+) (tunnelID uint64, sequence uint64, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.IBCPacketStoreKey(portID, channelID, ibcSequence))
+	if bz == nil {
+		return 0, 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz[:8]), sdk.BigEndianToUint64(bz[8:]), true
+}
+
+// GetAllIBCPacketRefs retrieves all references from in-flight IBC packets to tunnel packets
+func (k Keeper) GetAllIBCPacketRefs(ctx sdk.Context) []types.IBCPacketRef {
+	var refs []types.IBCPacketRef
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.IBCPacketStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, ibcSequence := types.SplitIBCPacketStoreKey(iterator.Key())
+		bz := iterator.Value()
+		refs = append(refs, types.NewIBCPacketRef(
+			portID,
+			channelID,
+			ibcSequence,
+			sdk.BigEndianToUint64(bz[:8]),
+			sdk.BigEndianToUint64(bz[8:]),
+		))
+	}
+	return refs
+}
+
+// DeleteIBCPacket deletes the reference of the given IBC packet
+func (k Keeper) DeleteIBCPacket(ctx sdk.Context, portID string, channelID string, ibcSequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.IBCPacketStoreKey(portID, channelID, ibcSequence))
+}
+
+// OnAcknowledgementPacket updates the status of the tunnel packet delivered by the given IBC packet
+// according to the acknowledgement. IBC packets that are not sent by tunnels are ignored.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	ibcPacket channeltypes.Packet,
+	ack channeltypes.Acknowledgement,
+) error {
+	packet, found, err := k.popIBCPacket(ctx, ibcPacket)
+	if err != nil || !found {
+		return err
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", packet.TunnelID)),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+	}
+
+	if ack.Success() {
+		packet.Status = types.PACKET_STATUS_SUCCESS
+	} else {
+		packet.Status = types.PACKET_STATUS_ERROR
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyReason, ack.GetError()))
+	}
+	k.SetPacket(ctx, packet)
+
+	attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyStatus, packet.Status.String()))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeAcknowledgePacket, attrs...))
+
+	return nil
+}
+
+// OnTimeoutPacket marks the tunnel packet delivered by the given IBC packet as timed out and refunds
+// a portion of its base packet fee to the fee payer. IBC packets that are not sent by tunnels are ignored.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, ibcPacket channeltypes.Packet) error {
+	packet, found, err := k.popIBCPacket(ctx, ibcPacket)
+	if err != nil || !found {
+		return err
+	}
+
+	packet.Status = types.PACKET_STATUS_TIMEOUT
+	k.SetPacket(ctx, packet)
+
+	refund, err := k.RefundTimeoutPacketFee(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTimeoutPacket,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", packet.TunnelID)),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyStatus, packet.Status.String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	))
+
+	return nil
+}
+
+// RefundTimeoutPacketFee refunds the timeout refund portion of the packet's base fee to the tunnel's fee payer
+func (k Keeper) RefundTimeoutPacketFee(ctx sdk.Context, packet types.Packet) (sdk.Coins, error) {
+	refundBPS := sdkmath.NewIntFromUint64(k.GetParams(ctx).TimeoutRefundBPS)
+
+	refund := sdk.NewCoins()
+	for _, coin := range packet.BaseFee {
+		refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(refundBPS).QuoRaw(10000)))
+	}
+	if refund.IsZero() {
+		return refund, nil
+	}
+
+	tunnel, err := k.GetTunnel(ctx, packet.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, feePayer, refund); err != nil {
+		return nil, err
+	}
+
+	// update total fees
+	totalFees := k.GetTotalFees(ctx)
+	// clamp at zero in case the tracked total is below the refund
+	remaining, isNegative := totalFees.TotalBasePacketFee.SafeSub(refund...)
+	if isNegative {
+		remaining = sdk.NewCoins()
+	}
+	totalFees.TotalBasePacketFee = remaining
+	k.SetTotalFees(ctx, totalFees)
+
+	return refund, nil
+}
+
+// popIBCPacket retrieves the tunnel packet delivered by the given IBC packet and removes the reference
+func (k Keeper) popIBCPacket(ctx sdk.Context, ibcPacket channeltypes.Packet) (types.Packet, bool, error) {
+	tunnelID, sequence, found := k.GetIBCPacket(
+		ctx,
+		ibcPacket.SourcePort,
+		ibcPacket.SourceChannel,
+		ibcPacket.Sequence,
+	)
+	if !found {
+		return types.Packet{}, false, nil
+	}
+	k.DeleteIBCPacket(ctx, ibcPacket.SourcePort, ibcPacket.SourceChannel, ibcPacket.Sequence)
+
+	// the packet may have been pruned as expired before the IBC packet is acknowledged
+	packet, err := k.GetPacket(ctx, tunnelID, sequence)
+	if errors.Is(err, types.ErrPacketPruned) {
+		return types.Packet{}, false, nil
+	} else if err != nil {
+		return types.Packet{}, false, err
+	}
+
+	return packet, true, nil
+}
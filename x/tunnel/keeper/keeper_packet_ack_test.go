@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"go.uber.org/mock/gomock"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tunnelmodule "github.com/bandprotocol/chain/v3/x/tunnel"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// mockTransferApp is a transfer application that records the timeouts passed to it.
+type mockTransferApp struct {
+	porttypes.IBCModule
+
+	timeouts int
+}
+
+func (m *mockTransferApp) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	m.timeouts++
+	return nil
+}
+
+func (s *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	cases := map[string]struct {
+		ack       channeltypes.Acknowledgement
+		expStatus types.PacketStatus
+	}{
+		"success acknowledgement": {
+			ack:       channeltypes.NewResultAcknowledgement([]byte{0x01}),
+			expStatus: types.PACKET_STATUS_SUCCESS,
+		},
+		"error acknowledgement": {
+			ack:       channeltypes.NewErrorAcknowledgement(errors.New("contract error")),
+			expStatus: types.PACKET_STATUS_ERROR,
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			ctx, k := s.ctx, s.keeper
+
+			packet := types.NewPacket(1, 1, nil, ctx.BlockTime().Unix())
+			packet.Status = types.PACKET_STATUS_PENDING
+			k.SetPacket(ctx, packet)
+			k.SetIBCPacket(ctx, "tunnel.1", "channel-0", 5, 1, 1)
+
+			ibcPacket := channeltypes.Packet{SourcePort: "tunnel.1", SourceChannel: "channel-0", Sequence: 5}
+			err := k.OnAcknowledgementPacket(ctx, ibcPacket, tc.ack)
+			s.Require().NoError(err)
+
+			storedPacket, err := k.GetPacket(ctx, 1, 1)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expStatus, storedPacket.Status)
+
+			_, _, found := k.GetIBCPacket(ctx, "tunnel.1", "channel-0", 5)
+			s.Require().False(found)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOnAcknowledgementPacketNotTunnelPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	ibcPacket := channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-0", Sequence: 1}
+	err := k.OnAcknowledgementPacket(ctx, ibcPacket, channeltypes.NewResultAcknowledgement([]byte{0x01}))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestOnAcknowledgementPacketPrunedPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	// the tunnel packet has been pruned as expired before the IBC packet is acknowledged
+	k.SetPrunedSequence(ctx, 1, 1)
+	k.SetIBCPacket(ctx, "tunnel.1", "channel-0", 5, 1, 1)
+
+	ibcPacket := channeltypes.Packet{SourcePort: "tunnel.1", SourceChannel: "channel-0", Sequence: 5}
+	err := k.OnAcknowledgementPacket(ctx, ibcPacket, channeltypes.NewResultAcknowledgement([]byte{0x01}))
+	s.Require().NoError(err)
+
+	_, _, found := k.GetIBCPacket(ctx, "tunnel.1", "channel-0", 5)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestOnTimeoutPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+
+	params := k.GetParams(ctx)
+	params.BasePacketFee = sdk.NewCoins(sdk.NewInt64Coin("uband", 500))
+	params.TimeoutRefundBPS = 5000
+	err := k.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	k.SetTotalFees(ctx, types.TotalFees{TotalBasePacketFee: sdk.NewCoins(sdk.NewInt64Coin("uband", 500))})
+
+	packet := types.NewPacket(tunnel.ID, 1, nil, ctx.BlockTime().Unix())
+	packet.BaseFee = params.BasePacketFee
+	packet.Status = types.PACKET_STATUS_PENDING
+	k.SetPacket(ctx, packet)
+	k.SetIBCPacket(ctx, "transfer", "channel-0", 5, tunnel.ID, 1)
+
+	expRefund := sdk.NewCoins(sdk.NewInt64Coin("uband", 250))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, feePayer, expRefund).Return(nil)
+
+	ibcPacket := channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-0", Sequence: 5}
+	err = k.OnTimeoutPacket(ctx, ibcPacket)
+	s.Require().NoError(err)
+
+	storedPacket, err := k.GetPacket(ctx, tunnel.ID, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PACKET_STATUS_TIMEOUT, storedPacket.Status)
+
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 250)), k.GetTotalFees(ctx).TotalBasePacketFee)
+}
+
+func (s *KeeperTestSuite) TestOnTimeoutPacketNoRefund() {
+	ctx, k := s.ctx, s.keeper
+
+	packet := types.NewPacket(1, 1, nil, ctx.BlockTime().Unix())
+	packet.BaseFee = k.GetParams(ctx).BasePacketFee
+	k.SetPacket(ctx, packet)
+	k.SetIBCPacket(ctx, "tunnel.1", "channel-0", 5, 1, 1)
+
+	ibcPacket := channeltypes.Packet{SourcePort: "tunnel.1", SourceChannel: "channel-0", Sequence: 5}
+	err := k.OnTimeoutPacket(ctx, ibcPacket)
+	s.Require().NoError(err)
+
+	storedPacket, err := k.GetPacket(ctx, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PACKET_STATUS_TIMEOUT, storedPacket.Status)
+}
+
+func (s *KeeperTestSuite) TestIBCModuleOnAcknowledgementPacketInvalidAck() {
+	ctx, k := s.ctx, s.keeper
+
+	packet := types.NewPacket(1, 1, nil, ctx.BlockTime().Unix())
+	packet.Status = types.PACKET_STATUS_PENDING
+	k.SetPacket(ctx, packet)
+	k.SetIBCPacket(ctx, "tunnel.1", "channel-0", 5, 1, 1)
+
+	ibcPacket := channeltypes.Packet{SourcePort: "tunnel.1", SourceChannel: "channel-0", Sequence: 5}
+	err := tunnelmodule.NewIBCModule(k).OnAcknowledgementPacket(ctx, ibcPacket, []byte("not json"), nil)
+	s.Require().NoError(err)
+
+	storedPacket, err := k.GetPacket(ctx, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PACKET_STATUS_ERROR, storedPacket.Status)
+}
+
+func (s *KeeperTestSuite) TestIBCTransferMiddlewareOnTimeoutPacketTunnelError() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+
+	params := k.GetParams(ctx)
+	params.BasePacketFee = sdk.NewCoins(sdk.NewInt64Coin("uband", 500))
+	params.TimeoutRefundBPS = 5000
+	err := k.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	packet := types.NewPacket(tunnel.ID, 1, nil, ctx.BlockTime().Unix())
+	packet.BaseFee = params.BasePacketFee
+	packet.Status = types.PACKET_STATUS_PENDING
+	k.SetPacket(ctx, packet)
+	k.SetIBCPacket(ctx, "transfer", "channel-0", 5, tunnel.ID, 1)
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, feePayer, sdk.NewCoins(sdk.NewInt64Coin("uband", 250))).
+		Return(errors.New("refund failed"))
+
+	app := &mockTransferApp{}
+	ibcPacket := channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-0", Sequence: 5}
+	err = tunnelmodule.NewIBCTransferMiddleware(app, k).OnTimeoutPacket(ctx, ibcPacket, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(1, app.timeouts)
+
+	// the failed tunnel update is discarded
+	storedPacket, err := k.GetPacket(ctx, tunnel.ID, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PACKET_STATUS_PENDING, storedPacket.Status)
+}
+
+func (s *KeeperTestSuite) TestOnTimeoutPacketRefundExceedsTotalFees() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+
+	params := k.GetParams(ctx)
+	params.BasePacketFee = sdk.NewCoins(sdk.NewInt64Coin("uband", 500))
+	params.TimeoutRefundBPS = 5000
+	err := k.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	k.SetTotalFees(ctx, types.TotalFees{TotalBasePacketFee: sdk.NewCoins(sdk.NewInt64Coin("uband", 100))})
+
+	packet := types.NewPacket(tunnel.ID, 1, nil, ctx.BlockTime().Unix())
+	packet.BaseFee = params.BasePacketFee
+	packet.Status = types.PACKET_STATUS_PENDING
+	k.SetPacket(ctx, packet)
+	k.SetIBCPacket(ctx, "transfer", "channel-0", 5, tunnel.ID, 1)
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(ctx, types.ModuleName, feePayer, sdk.NewCoins(sdk.NewInt64Coin("uband", 250))).
+		Return(nil)
+
+	ibcPacket := channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-0", Sequence: 5}
+	err = k.OnTimeoutPacket(ctx, ibcPacket)
+	s.Require().NoError(err)
+
+	s.Require().True(k.GetTotalFees(ctx).TotalBasePacketFee.IsZero())
+}
+
+func (s *KeeperTestSuite) TestIBCModuleOnTimeoutPacketTunnelError() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+
+	params := k.GetParams(ctx)
+	params.BasePacketFee = sdk.NewCoins(sdk.NewInt64Coin("uband", 500))
+	params.TimeoutRefundBPS = 5000
+	err := k.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	packet := types.NewPacket(tunnel.ID, 1, nil, ctx.BlockTime().Unix())
+	packet.BaseFee = params.BasePacketFee
+	packet.Status = types.PACKET_STATUS_PENDING
+	k.SetPacket(ctx, packet)
+	k.SetIBCPacket(ctx, "tunnel.1", "channel-0", 5, tunnel.ID, 1)
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, feePayer, sdk.NewCoins(sdk.NewInt64Coin("uband", 250))).
+		Return(errors.New("refund failed"))
+
+	ibcPacket := channeltypes.Packet{SourcePort: "tunnel.1", SourceChannel: "channel-0", Sequence: 5}
+	err = tunnelmodule.NewIBCModule(k).OnTimeoutPacket(ctx, ibcPacket, nil)
+	s.Require().NoError(err)
+
+	// the failed tunnel update is discarded and reported through an event
+	storedPacket, err := k.GetPacket(ctx, tunnel.ID, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PACKET_STATUS_PENDING, storedPacket.Status)
+
+	events := ctx.EventManager().Events()
+	s.Require().Equal(types.EventTypeUpdatePacketStatusFail, events[len(events)-1].Type)
+}
@@ -49,6 +49,7 @@ func (k Keeper) SendIBCPacket(
 	if err != nil {
 		return nil, err
 	}
+	k.SetIBCPacket(ctx, portID, route.ChannelID, sequence, packet.TunnelID, packet.Sequence)
 
 	return types.NewIBCPacketReceipt(sequence), nil
 }
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// SendIBCABIPacket sends IBC packet with the ABI-encoded payload
+func (k Keeper) SendIBCABIPacket(
+	ctx sdk.Context,
+	route *types.IBCABIRoute,
+	packet types.Packet,
+	interval uint64,
+) (types.PacketReceiptI, error) {
+	portID := PortIDForTunnel(packet.TunnelID)
+	// retrieve the dynamic capability for this channel
+	channelCap, ok := k.scopedKeeper.GetCapability(
+		ctx,
+		host.ChannelCapabilityPath(portID, route.ChannelID),
+	)
+	if !ok {
+		return nil, types.ErrChannelCapabilityNotFound
+	}
+
+	// encode the packet in the same layout as the TSS message
+	packetBytes, err := types.EncodeTSS(
+		packet.Sequence,
+		packet.Prices,
+		packet.CreatedAt,
+		route.Encoder,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// send packet to IBC, authenticating with channelCap
+	sequence, err := k.ics4Wrapper.SendPacket(
+		ctx,
+		channelCap,
+		portID,
+		route.ChannelID,
+		clienttypes.NewHeight(0, 0),
+		uint64(ctx.BlockTime().UnixNano())+interval*uint64(time.Second)*2,
+		packetBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetIBCPacket(ctx, portID, route.ChannelID, sequence, packet.TunnelID, packet.Sequence)
+
+	return types.NewIBCABIPacketReceipt(sequence), nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func (s *KeeperTestSuite) TestSendIBCABIPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	route := &types.IBCABIRoute{
+		ChannelID: "channel-0",
+		Encoder:   feedstypes.ENCODER_FIXED_POINT_ABI,
+	}
+	packet := types.Packet{
+		TunnelID:  1,
+		Sequence:  1,
+		Prices:    []feedstypes.Price{},
+		CreatedAt: 1730358471,
+	}
+	interval := uint64(60)
+
+	expectedBytes, err := types.EncodeTSS(packet.Sequence, packet.Prices, packet.CreatedAt, route.Encoder)
+	s.Require().NoError(err)
+
+	s.scopedKeeper.EXPECT().GetCapability(ctx, gomock.Any()).Return(&capabilitytypes.Capability{}, true)
+	s.icsWrapper.EXPECT().
+		SendPacket(ctx, gomock.Any(), "tunnel.1", route.ChannelID, clienttypes.NewHeight(0, 0), uint64(ctx.BlockTime().UnixNano())+interval*uint64(time.Second)*2, expectedBytes).
+		Return(uint64(1), nil)
+
+	content, err := k.SendIBCABIPacket(ctx, route, packet, interval)
+	s.Require().NoError(err)
+
+	packetReceipt, ok := content.(*types.IBCABIPacketReceipt)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(1), packetReceipt.Sequence)
+}
+
+func (s *KeeperTestSuite) TestSendIBCABIPacketCapabilityNotFound() {
+	ctx, k := s.ctx, s.keeper
+
+	route := &types.IBCABIRoute{
+		ChannelID: "channel-0",
+		Encoder:   feedstypes.ENCODER_TICK_ABI,
+	}
+	packet := types.Packet{
+		TunnelID:  1,
+		Sequence:  1,
+		Prices:    []feedstypes.Price{},
+		CreatedAt: 1730358471,
+	}
+
+	s.scopedKeeper.EXPECT().GetCapability(ctx, gomock.Any()).Return(nil, false)
+
+	_, err := k.SendIBCABIPacket(ctx, route, packet, 60)
+	s.Require().ErrorIs(err, types.ErrChannelCapabilityNotFound)
+}
@@ -46,6 +46,7 @@ func (k Keeper) SendIBCHookPacket(
 	if err != nil {
 		return nil, err
 	}
+	k.SetIBCPacket(ctx, ibctransfertypes.PortID, route.ChannelID, res.Sequence, packet.TunnelID, packet.Sequence)
 
 	return types.NewIBCHookPacketReceipt(res.Sequence), nil
 }
@@ -37,4 +37,9 @@ func (s *KeeperTestSuite) TestSendIBCPacket() {
 	packetReceipt, ok := content.(*types.IBCPacketReceipt)
 	s.Require().True(ok)
 	s.Require().Equal(uint64(1), packetReceipt.Sequence)
+
+	tunnelID, sequence, found := k.GetIBCPacket(ctx, "tunnel.1", route.ChannelID, 1)
+	s.Require().True(found)
+	s.Require().Equal(packet.TunnelID, tunnelID)
+	s.Require().Equal(packet.Sequence, sequence)
 }
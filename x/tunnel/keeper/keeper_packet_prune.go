@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// SetPruneCursor sets the ID of the last tunnel whose packets were completely pruned.
+func (k Keeper) SetPruneCursor(ctx sdk.Context, tunnelID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.PruneCursorStoreKey, sdk.Uint64ToBigEndian(tunnelID))
+}
+
+// GetPruneCursor returns the ID of the last tunnel whose packets were completely pruned.
+func (k Keeper) GetPruneCursor(ctx sdk.Context) uint64 {
+	return sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.PruneCursorStoreKey))
+}
+
+// SetPrunedSequence sets the latest pruned packet sequence of the tunnel.
+func (k Keeper) SetPrunedSequence(ctx sdk.Context, tunnelID uint64, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.PrunedSequenceStoreKey(tunnelID), sdk.Uint64ToBigEndian(sequence))
+}
+
+// GetPrunedSequence returns the latest pruned packet sequence of the tunnel.
+func (k Keeper) GetPrunedSequence(ctx sdk.Context, tunnelID uint64) uint64 {
+	return sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.PrunedSequenceStoreKey(tunnelID)))
+}
+
+// DeletePacket deletes a packet along with its indexes and failure record from the store.
+func (k Keeper) DeletePacket(ctx sdk.Context, packet types.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	receiptType := k.getPacketReceiptType(ctx, packet.TunnelID)
+
+	store.Delete(types.TunnelPacketStoreKey(packet.TunnelID, packet.Sequence))
+	store.Delete(types.PacketTimeIndexKey(packet.TunnelID, packet.CreatedAt, packet.Sequence))
+	store.Delete(types.PacketStatusIndexKey(packet.Status, receiptType, packet.TunnelID, packet.Sequence))
+	k.DeleteFailedPacket(ctx, packet.TunnelID, packet.Sequence)
+}
+
+// PrunePackets deletes the packets that are outside the retention policy. Tunnels are visited
+// in a round-robin order starting after the prune cursor, and each visited tunnel and each pruned
+// packet consumes one unit of the per-block budget so that the work of a block is bounded.
+func (k Keeper) PrunePackets(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.IsPacketPruningEnabled() {
+		return
+	}
+
+	budget := params.MaxPrunedPacketsPerBlock
+	count := k.GetTunnelCount(ctx)
+	cursor := k.GetPruneCursor(ctx)
+
+	for i := uint64(0); i < count && budget > 0; i++ {
+		tunnelID := (cursor+i)%count + 1
+		budget--
+
+		pruned, done := k.PruneTunnelPackets(ctx, tunnelID, params, budget)
+		budget -= pruned
+
+		// resume from this tunnel in the next block if it still has packets to prune
+		if !done {
+			break
+		}
+		k.SetPruneCursor(ctx, tunnelID)
+	}
+}
+
+// PruneTunnelPackets deletes up to limit of the oldest packets of the tunnel that are outside
+// the retention policy. A packet is kept if it is one of the latest packet_retention_count packets
+// or younger than packet_retention_duration. Pruning stops at the first pending packet whose IBC
+// packet has not timed out yet, while older pending packets are pruned as expired. It returns the
+// number of pruned packets and whether no more packets of the tunnel can be pruned in this block.
+func (k Keeper) PruneTunnelPackets(
+	ctx sdk.Context,
+	tunnelID uint64,
+	params types.Params,
+	limit uint64,
+) (pruned uint64, done bool) {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return 0, true
+	}
+
+	prevPrunedSequence := k.GetPrunedSequence(ctx, tunnelID)
+	prunedSequence := prevPrunedSequence
+	done = true
+
+	for sequence := prevPrunedSequence + 1; sequence <= tunnel.Sequence; sequence++ {
+		// keep the latest packets of the tunnel
+		if params.PacketRetentionCount > 0 && tunnel.Sequence-sequence < params.PacketRetentionCount {
+			break
+		}
+
+		packet, err := k.GetPacket(ctx, tunnelID, sequence)
+		if err == nil {
+			// keep the packets that are younger than the retention duration
+			if params.PacketRetentionDuration > 0 &&
+				packet.CreatedAt+int64(params.PacketRetentionDuration) > ctx.BlockTime().Unix() {
+				break
+			}
+
+			// keep a pending packet until its IBC packet times out, so that its acknowledgement or
+			// timeout can still be processed; a packet that is still pending afterward is expired.
+			if packet.Status == types.PACKET_STATUS_PENDING &&
+				packet.CreatedAt+int64(2*tunnel.Interval) > ctx.BlockTime().Unix() {
+				break
+			}
+
+			if pruned >= limit {
+				done = false
+				break
+			}
+
+			k.DeletePacket(ctx, packet)
+			pruned++
+		}
+
+		prunedSequence = sequence
+	}
+
+	if prunedSequence != prevPrunedSequence {
+		k.SetPrunedSequence(ctx, tunnelID, prunedSequence)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePrunePackets,
+			sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+			sdk.NewAttribute(types.AttributeKeyPrunedSequence, fmt.Sprintf("%d", prunedSequence)),
+		))
+	}
+
+	return pruned, done
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// addSamplePackets adds a tunnel with the given number of packets that are created one minute apart.
+func (s *KeeperTestSuite) addSamplePackets(count uint64) *types.Tunnel {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(false)
+	for seq := uint64(1); seq <= count; seq++ {
+		createdAt := ctx.BlockTime().Unix() - int64(count-seq)*60
+		k.SetPacket(ctx, types.NewPacket(tunnel.ID, seq, nil, createdAt))
+	}
+
+	tunnel.Sequence = count
+	k.SetTunnel(ctx, *tunnel)
+
+	return tunnel
+}
+
+func (s *KeeperTestSuite) TestPruneTunnelPacketsByCount() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.addSamplePackets(5)
+	k.SetFailedPacket(ctx, types.NewFailedPacket(tunnel.ID, 1, "route error", 1, ctx.BlockTime().Unix()))
+
+	params := k.GetParams(ctx)
+	params.PacketRetentionCount = 2
+
+	pruned, done := k.PruneTunnelPackets(ctx, tunnel.ID, params, 100)
+	s.Require().Equal(uint64(3), pruned)
+	s.Require().True(done)
+	s.Require().Equal(uint64(3), k.GetPrunedSequence(ctx, tunnel.ID))
+
+	_, err := k.GetPacket(ctx, tunnel.ID, 3)
+	s.Require().ErrorIs(err, types.ErrPacketPruned)
+	_, err = k.GetPacket(ctx, tunnel.ID, 4)
+	s.Require().NoError(err)
+	_, err = k.GetPacket(ctx, tunnel.ID, 6)
+	s.Require().ErrorIs(err, types.ErrPacketNotFound)
+
+	// the failure record and the indexes of the pruned packets are removed
+	s.Require().Empty(k.GetFailedPackets(ctx, tunnel.ID))
+
+	res, err := s.queryServer.PacketsByTime(ctx, &types.QueryPacketsByTimeRequest{TunnelId: tunnel.ID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Packets, 2)
+
+	res2, err := s.queryServer.PacketsByStatus(ctx, &types.QueryPacketsByStatusRequest{
+		Status: types.PACKET_STATUS_UNSPECIFIED,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res2.Packets, 2)
+}
+
+func (s *KeeperTestSuite) TestPruneTunnelPacketsByDuration() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.addSamplePackets(5)
+
+	// packets 4 and 5 are younger than two minutes
+	params := k.GetParams(ctx)
+	params.PacketRetentionDuration = 120
+
+	pruned, done := k.PruneTunnelPackets(ctx, tunnel.ID, params, 100)
+	s.Require().Equal(uint64(3), pruned)
+	s.Require().True(done)
+	s.Require().Equal(uint64(3), k.GetPrunedSequence(ctx, tunnel.ID))
+
+	// a packet is kept if it is in either retention window
+	params.PacketRetentionCount = 2
+	params.PacketRetentionDuration = 60
+
+	pruned, done = k.PruneTunnelPackets(ctx, tunnel.ID, params, 100)
+	s.Require().Equal(uint64(0), pruned)
+	s.Require().True(done)
+}
+
+func (s *KeeperTestSuite) TestPruneTunnelPacketsPendingPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.addSamplePackets(5)
+
+	packet, err := k.GetPacket(ctx, tunnel.ID, 2)
+	s.Require().NoError(err)
+	packet.Status = types.PACKET_STATUS_PENDING
+	k.SetPacket(ctx, packet)
+
+	params := k.GetParams(ctx)
+	params.PacketRetentionCount = 1
+
+	// packet 2 was created three minutes ago, before its IBC packet timed out at twice the interval
+	tunnel.Interval = 100
+	k.SetTunnel(ctx, *tunnel)
+
+	pruned, done := k.PruneTunnelPackets(ctx, tunnel.ID, params, 100)
+	s.Require().Equal(uint64(1), pruned)
+	s.Require().True(done)
+	s.Require().Equal(uint64(1), k.GetPrunedSequence(ctx, tunnel.ID))
+
+	// once its IBC packet has timed out, the pending packet is expired instead of blocking pruning
+	tunnel.Interval = 60
+	k.SetTunnel(ctx, *tunnel)
+
+	pruned, done = k.PruneTunnelPackets(ctx, tunnel.ID, params, 100)
+	s.Require().Equal(uint64(3), pruned)
+	s.Require().True(done)
+	s.Require().Equal(uint64(4), k.GetPrunedSequence(ctx, tunnel.ID))
+
+	_, err = k.GetPacket(ctx, tunnel.ID, 2)
+	s.Require().ErrorIs(err, types.ErrPacketPruned)
+}
+
+func (s *KeeperTestSuite) TestPrunePackets() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel1 := s.addSamplePackets(5)
+	tunnel2 := s.addSamplePackets(5)
+
+	// pruning is disabled by default
+	k.PrunePackets(ctx)
+	s.Require().Equal(uint64(0), k.GetPrunedSequence(ctx, tunnel1.ID))
+
+	params := k.GetParams(ctx)
+	params.PacketRetentionCount = 1
+	params.MaxPrunedPacketsPerBlock = 5
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	// visiting tunnel 1 and pruning its 4 packets consume the whole budget
+	k.PrunePackets(ctx)
+	s.Require().Equal(uint64(4), k.GetPrunedSequence(ctx, tunnel1.ID))
+	s.Require().Equal(uint64(0), k.GetPrunedSequence(ctx, tunnel2.ID))
+	s.Require().Equal(tunnel1.ID, k.GetPruneCursor(ctx))
+
+	// the next block continues from tunnel 2
+	k.PrunePackets(ctx)
+	s.Require().Equal(uint64(4), k.GetPrunedSequence(ctx, tunnel2.ID))
+	s.Require().Equal(tunnel2.ID, k.GetPruneCursor(ctx))
+}
@@ -63,6 +63,7 @@ func (k Keeper) SendRouterPacket(
 	if err != nil {
 		return nil, err
 	}
+	k.SetIBCPacket(ctx, ibctransfertypes.PortID, routerIBCChannel, res.Sequence, packet.TunnelID, packet.Sequence)
 
 	return types.NewRouterPacketReceipt(res.Sequence), nil
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"go.uber.org/mock/gomock"
 
 	sdkmath "cosmossdk.io/math"
@@ -88,6 +90,7 @@ func (s *KeeperTestSuite) TestCreatePacket() {
 		Sequence:  1,
 		Prices:    prices,
 		CreatedAt: ctx.BlockTime().Unix(),
+		BaseFee:   k.GetParams(ctx).BasePacketFee,
 	}
 
 	// create a packet
@@ -101,6 +104,49 @@ func (s *KeeperTestSuite) TestCreatePacket() {
 	s.Require().Equal(uint64(1), updatedTunnel.Sequence)
 }
 
+func (s *KeeperTestSuite) TestCreatePacketPriceConfidence() {
+	ctx, k := s.ctx, s.keeper
+
+	feePayer := sdk.AccAddress([]byte("fee_payer_address"))
+	tunnel := types.Tunnel{
+		ID:       1,
+		FeePayer: feePayer.String(),
+		IsActive: true,
+		SignalDeviations: []types.SignalDeviation{
+			{SignalID: "CS:BAND-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+		},
+		CreatedAt: ctx.BlockTime().Unix(),
+	}
+	err := tunnel.SetRoute(types.NewIBCRoute("channel-0"))
+	s.Require().NoError(err)
+	k.SetTunnel(ctx, tunnel)
+
+	price := feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 5000000, 1733000000)
+	price.AggregationMethod = feedstypes.AGGREGATION_METHOD_WEIGHTED_MEDIAN
+	price.Confidence = feedstypes.NewPriceConfidence(10, 8000, 25)
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(ctx, feePayer, types.ModuleName, k.GetParams(ctx).BasePacketFee).
+		Return(nil).
+		Times(2)
+
+	// the aggregation method and confidence are removed by default
+	packet, err := k.CreatePacket(ctx, tunnel.ID, []feedstypes.Price{price})
+	s.Require().NoError(err)
+	s.Require().Equal(feedstypes.AGGREGATION_METHOD_UNSPECIFIED, packet.Prices[0].AggregationMethod)
+	s.Require().Nil(packet.Prices[0].Confidence)
+
+	// the aggregation method and confidence are kept if the tunnel opts in
+	tunnel, err = k.GetTunnel(ctx, tunnel.ID)
+	s.Require().NoError(err)
+	tunnel.IncludePriceConfidence = true
+	k.SetTunnel(ctx, tunnel)
+
+	packet, err = k.CreatePacket(ctx, tunnel.ID, []feedstypes.Price{price})
+	s.Require().NoError(err)
+	s.Require().Equal([]feedstypes.Price{price}, packet.Prices)
+}
+
 func (s *KeeperTestSuite) TestProducePacket() {
 	ctx, k := s.ctx, s.keeper
 
@@ -163,6 +209,115 @@ func (s *KeeperTestSuite) TestProducePacket() {
 	s.Require().NoError(err)
 }
 
+func (s *KeeperTestSuite) TestGetDerivedPricesWithTWAP() {
+	ctx, k := s.ctx, s.keeper
+
+	derivedSignals := []types.DerivedSignal{
+		types.NewDerivedSignal("DS:ETH-BTC", "CS:ETH-USD / CS:BTC-USD", 100, 100),
+		{
+			SignalID:         "DS:ETH-BTC-TWAP",
+			Expression:       "CS:ETH-USD / CS:BTC-USD",
+			SoftDeviationBPS: 100,
+			HardDeviationBPS: 100,
+			TWAPWindow:       3600,
+		},
+	}
+
+	s.feedsKeeper.EXPECT().GetPrices(gomock.Any(), []string{"CS:ETH-USD", "CS:BTC-USD"}).Return([]feedstypes.Price{
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 3000000000000, 1733000000),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BTC-USD", 60000000000000, 1733000000),
+	})
+	s.feedsKeeper.EXPECT().GetTWAPPrice(gomock.Any(), "CS:ETH-USD", int64(3600)).Return(
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 2400000000000, 1732999990),
+	)
+	s.feedsKeeper.EXPECT().GetTWAPPrice(gomock.Any(), "CS:BTC-USD", int64(3600)).Return(
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BTC-USD", 60000000000000, 1732999980),
+	)
+
+	prices := k.GetDerivedPrices(ctx, derivedSignals)
+	s.Require().Equal([]feedstypes.Price{
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DS:ETH-BTC", 50000000, 1733000000),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DS:ETH-BTC-TWAP", 40000000, 1732999980),
+	}, prices)
+}
+
+func (s *KeeperTestSuite) TestProducePacketBySchedule() {
+	ctx, k := s.ctx, s.keeper
+
+	// 2024-12-01 00:10:00 UTC
+	now := time.Date(2024, 12, 1, 0, 10, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	tunnelID := uint64(1)
+	pricesMap := map[string]feedstypes.Price{
+		"CS:BAND-USD": {
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:BAND-USD",
+			Price:     50000,
+			Timestamp: now.Unix(),
+		},
+	}
+	feePayer := sdk.AccAddress([]byte("fee_payer_address"))
+	tunnel := types.Tunnel{
+		ID:       1,
+		FeePayer: feePayer.String(),
+		IsActive: true,
+		SignalDeviations: []types.SignalDeviation{
+			{SignalID: "CS:BAND-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+		},
+		Interval:  86400,
+		Schedule:  "*/5 * * * *",
+		CreatedAt: now.Unix(),
+	}
+	route := &types.TSSRoute{
+		DestinationChainID:         "chain-1",
+		DestinationContractAddress: "0x1234567890abcdef",
+	}
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(ctx, feePayer, types.ModuleName, k.GetParams(ctx).BasePacketFee).
+		Return(nil).Times(1)
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(
+		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil,
+	).Times(1)
+	s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+		gomock.Any(),
+		uint64(1),
+		"chain-1",
+		"0x1234567890abcdef",
+		gomock.Any(),
+		feePayer,
+		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+	).Return(bandtsstypes.SigningID(1), nil).Times(1)
+
+	err := tunnel.SetRoute(route)
+	s.Require().NoError(err)
+	k.SetTunnel(ctx, tunnel)
+
+	// the interval has not passed and the price has not deviated, but 00:05:00 is scheduled
+	lastInterval := now.Add(-6 * time.Minute).Unix()
+	k.SetLatestPrices(ctx, types.LatestPrices{
+		TunnelID: tunnelID,
+		Prices: []feedstypes.Price{
+			{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "CS:BAND-USD", Price: 50000, Timestamp: lastInterval},
+		},
+		LastInterval: lastInterval,
+	})
+
+	err = k.ProducePacket(ctx, tunnelID, pricesMap)
+	s.Require().NoError(err)
+
+	latestPrices, err := k.GetLatestPrices(ctx, tunnelID)
+	s.Require().NoError(err)
+	s.Require().Equal(lastInterval, latestPrices.LastInterval)
+	s.Require().Equal(now.Unix(), latestPrices.LastSchedule)
+
+	// no scheduled time has passed since the last trigger; no packet is produced
+	err = k.ProducePacket(ctx, tunnelID, pricesMap)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), k.MustGetTunnel(ctx, tunnelID).Sequence)
+}
+
 func (s *KeeperTestSuite) TestProduceActiveTunnelPackets() {
 	ctx, k := s.ctx, s.keeper
 
@@ -287,3 +442,79 @@ func (s *KeeperTestSuite) TestProduceActiveTunnelPacketsNotEnoughMoney() {
 	activeTunnels := k.GetActiveTunnelIDs(ctx)
 	s.Require().Len(activeTunnels, 0)
 }
+
+func (s *KeeperTestSuite) TestProduceActiveTunnelPacketsSendPacketFailed() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnelID := uint64(1)
+	feePayer := sdk.AccAddress([]byte("fee_payer_address"))
+	tunnel := types.Tunnel{
+		ID:       1,
+		FeePayer: feePayer.String(),
+		IsActive: true,
+		SignalDeviations: []types.SignalDeviation{
+			{SignalID: "CS:BAND-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+		},
+		CreatedAt: ctx.BlockTime().Unix(),
+	}
+	route := &types.TSSRoute{
+		DestinationChainID:         "chain-1",
+		DestinationContractAddress: "0x",
+	}
+
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(
+		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil,
+	)
+
+	s.feedsKeeper.EXPECT().GetAllPrices(gomock.Any()).Return([]feedstypes.Price{
+		{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "CS:BAND-USD", Price: 50000, Timestamp: 1733000000},
+	})
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, types.DefaultBasePacketFee).
+		Return(nil)
+
+	s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+		gomock.Any(),
+		uint64(1),
+		"chain-1",
+		"0x",
+		gomock.Any(),
+		feePayer,
+		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+	).Return(bandtsstypes.SigningID(0), sdkerrors.ErrInsufficientFunds)
+
+	err := tunnel.SetRoute(route)
+	s.Require().NoError(err)
+
+	// set deposit to the tunnel to be able to activate
+	tunnel.TotalDeposit = append(tunnel.TotalDeposit, k.GetParams(ctx).MinDeposit...)
+	k.SetTunnel(ctx, tunnel)
+
+	err = k.ActivateTunnel(ctx, tunnelID)
+	s.Require().NoError(err)
+
+	k.SetLatestPrices(ctx, types.NewLatestPrices(tunnelID, []feedstypes.Price{
+		{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "CS:BAND-USD", Price: 6000000, Timestamp: 1732000000},
+	}, 0))
+
+	err = k.ProduceActiveTunnelPackets(ctx)
+	s.Require().NoError(err)
+
+	// the tunnel is deactivated but the packet is kept
+	newTunnelInfo, err := k.GetTunnel(ctx, tunnelID)
+	s.Require().NoError(err)
+	s.Require().False(newTunnelInfo.IsActive)
+	s.Require().Equal(uint64(1), newTunnelInfo.Sequence)
+
+	packet, err := k.GetPacket(ctx, tunnelID, 1)
+	s.Require().NoError(err)
+	s.Require().Nil(packet.Receipt)
+
+	// the failure is recorded
+	failedPacket, err := k.GetFailedPacket(ctx, tunnelID, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), failedPacket.Attempts)
+	s.Require().Contains(failedPacket.Reason, sdkerrors.ErrInsufficientFunds.Error())
+	s.Require().Equal(ctx.BlockTime().Unix(), failedPacket.LastAttemptAt)
+}
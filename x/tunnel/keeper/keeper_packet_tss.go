@@ -1,8 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
@@ -41,3 +46,70 @@ func (k Keeper) SendTSSPacket(
 
 	return types.NewTSSPacketReceipt(signingID), nil
 }
+
+// UpdateTSSPacketStatuses resolves the pending TSS packets whose signing requests have completed.
+// A packet whose signing succeeded is marked as success. A packet whose signing has fallen is marked
+// as failed and recorded as a failed packet so that it can be retried.
+func (k Keeper) UpdateTSSPacketStatuses(ctx sdk.Context) {
+	// collect the pending packets first as updating the status modifies the index being iterated.
+	var packets []types.Packet
+	iterator := storetypes.KVStorePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.PacketsStatusReceiptTypeIndexKey(
+			types.PACKET_STATUS_PENDING,
+			types.GetRouteReceiptType(&types.TSSRoute{}),
+		),
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		tunnelID, sequence := types.SplitPacketIndexKey(iterator.Key())
+		packet, err := k.GetPacket(ctx, tunnelID, sequence)
+		if err != nil {
+			continue
+		}
+		packets = append(packets, packet)
+	}
+	iterator.Close()
+
+	for _, packet := range packets {
+		receipt, err := packet.GetReceiptValue()
+		if err != nil {
+			continue
+		}
+		tssReceipt, ok := receipt.(*types.TSSPacketReceipt)
+		if !ok {
+			continue
+		}
+
+		signingStatus, err := k.bandtssKeeper.GetSigningStatus(ctx, tssReceipt.SigningID)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to get signing status of tss packet",
+				"tunnel_id", packet.TunnelID,
+				"sequence", packet.Sequence,
+				"err", err,
+			)
+			continue
+		}
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", packet.TunnelID)),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+		}
+
+		switch signingStatus {
+		case tsstypes.SIGNING_STATUS_SUCCESS:
+			packet.Status = types.PACKET_STATUS_SUCCESS
+		case tsstypes.SIGNING_STATUS_FALLEN:
+			reason := fmt.Sprintf("tss signing %d has fallen", tssReceipt.SigningID)
+			k.recordFailedPacket(ctx, packet, reason)
+			packet.Status = types.PACKET_STATUS_FAILED
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyReason, reason))
+		default:
+			continue
+		}
+		k.SetPacket(ctx, packet)
+
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyStatus, packet.Status.String()))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUpdateTSSPacketStatus, attrs...))
+	}
+}
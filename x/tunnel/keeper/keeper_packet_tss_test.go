@@ -12,6 +12,7 @@ import (
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
 	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
@@ -56,3 +57,54 @@ func (s *KeeperTestSuite) TestSendTSSPacket() {
 	s.Require().True(ok)
 	s.Require().Equal(bandtsstypes.SigningID(1), packetReceipt.SigningID)
 }
+
+func (s *KeeperTestSuite) TestUpdateTSSPacketStatuses() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := s.AddSampleTunnel(true)
+
+	signingStatuses := map[uint64]tsstypes.SigningStatus{
+		1: tsstypes.SIGNING_STATUS_WAITING,
+		2: tsstypes.SIGNING_STATUS_SUCCESS,
+		3: tsstypes.SIGNING_STATUS_FALLEN,
+	}
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		packet := types.NewPacket(tunnel.ID, sequence, []feedstypes.Price{}, time.Now().Unix())
+		packet.Status = types.PACKET_STATUS_PENDING
+		err := packet.SetReceipt(types.NewTSSPacketReceipt(bandtsstypes.SigningID(sequence)))
+		s.Require().NoError(err)
+		k.SetPacket(ctx, packet)
+
+		s.bandtssKeeper.EXPECT().
+			GetSigningStatus(ctx, bandtsstypes.SigningID(sequence)).
+			Return(signingStatuses[sequence], nil)
+	}
+
+	k.UpdateTSSPacketStatuses(ctx)
+
+	expStatuses := map[uint64]types.PacketStatus{
+		1: types.PACKET_STATUS_PENDING,
+		2: types.PACKET_STATUS_SUCCESS,
+		3: types.PACKET_STATUS_FAILED,
+	}
+	for sequence, expStatus := range expStatuses {
+		packet, err := k.GetPacket(ctx, tunnel.ID, sequence)
+		s.Require().NoError(err)
+		s.Require().Equal(expStatus, packet.Status)
+	}
+
+	// only the packet whose signing has fallen is recorded as failed and can be retried
+	_, err := k.GetFailedPacket(ctx, tunnel.ID, 2)
+	s.Require().ErrorIs(err, types.ErrFailedPacketNotFound)
+	failedPacket, err := k.GetFailedPacket(ctx, tunnel.ID, 3)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), failedPacket.Attempts)
+	s.Require().Equal("tss signing 3 has fallen", failedPacket.Reason)
+
+	// resolved packets are no longer checked
+	s.bandtssKeeper.EXPECT().
+		GetSigningStatus(ctx, bandtsstypes.SigningID(1)).
+		Return(tsstypes.SIGNING_STATUS_WAITING, nil)
+
+	k.UpdateTSSPacketStatuses(ctx)
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// GetRouteFee returns the fee charged by the route for each packet on top of the base packet fee.
+// IBC-based routes are relayed off-chain, so they do not charge an on-chain route fee.
+func (k Keeper) GetRouteFee(ctx sdk.Context, route types.RouteI) (sdk.Coins, error) {
+	switch route.(type) {
+	case *types.TSSRoute:
+		return k.bandtssKeeper.GetSigningFee(ctx)
+	default:
+		return sdk.NewCoins(), nil
+	}
+}
@@ -136,7 +136,10 @@ func (s *KeeperTestSuite) AddSampleTunnel(isActive bool) *types.Tunnel {
 		ctx,
 		route,
 		signalDeviations,
+		nil,
 		10,
+		"",
+		false,
 		sdk.AccAddress([]byte("creator_address")),
 	)
 	s.Require().NoError(err)
@@ -156,6 +159,22 @@ func (s *KeeperTestSuite) AddSampleTunnel(isActive bool) *types.Tunnel {
 	return tunnel
 }
 
+// AddSampleTunnelAdmin adds an active sample tunnel with a co-administrator holding the given permission.
+func (s *KeeperTestSuite) AddSampleTunnelAdmin(permission types.TunnelPermission) *types.Tunnel {
+	tunnel, err := s.keeper.GetTunnel(s.ctx, s.AddSampleTunnel(true).ID)
+	s.Require().NoError(err)
+
+	tunnel.Admins = []types.TunnelAdmin{
+		types.NewTunnelAdmin(
+			sdk.AccAddress([]byte("admin_address")).String(),
+			[]types.TunnelPermission{permission},
+		),
+	}
+	s.keeper.SetTunnel(s.ctx, tunnel)
+
+	return &tunnel
+}
+
 func (s *KeeperTestSuite) AddSampleIBCTunnel(isActive bool) {
 	ctx, k := s.ctx, s.keeper
 
@@ -181,7 +200,57 @@ func (s *KeeperTestSuite) AddSampleIBCTunnel(isActive bool) {
 		ctx,
 		route,
 		signalDeviations,
+		nil,
+		10,
+		"",
+		false,
+		sdk.AccAddress([]byte("creator_address")),
+	)
+	s.Require().NoError(err)
+
+	if isActive {
+		tunnel, err := k.GetTunnel(ctx, tunnel.ID)
+		s.Require().NoError(err)
+
+		// set deposit to the tunnel to be able to activate
+		tunnel.TotalDeposit = append(tunnel.TotalDeposit, k.GetParams(ctx).MinDeposit...)
+		k.SetTunnel(ctx, tunnel)
+
+		err = k.ActivateTunnel(ctx, tunnel.ID)
+		s.Require().NoError(err)
+	}
+}
+
+func (s *KeeperTestSuite) AddSampleIBCABITunnel(isActive bool) {
+	ctx, k := s.ctx, s.keeper
+
+	s.accountKeeper.EXPECT().
+		GetAccount(ctx, gomock.Any()).
+		Return(nil).Times(1)
+	s.accountKeeper.EXPECT().NewAccount(ctx, gomock.Any()).Times(1)
+	s.accountKeeper.EXPECT().SetAccount(ctx, gomock.Any()).Times(1)
+
+	signalDeviations := []types.SignalDeviation{
+		{
+			SignalID:         "CS:BAND-USD",
+			SoftDeviationBPS: 100,
+			HardDeviationBPS: 100,
+		},
+	}
+
+	route := &types.IBCABIRoute{
+		ChannelID: "",
+		Encoder:   feedstypes.ENCODER_FIXED_POINT_ABI,
+	}
+
+	tunnel, err := k.AddTunnel(
+		ctx,
+		route,
+		signalDeviations,
+		nil,
 		10,
+		"",
+		false,
 		sdk.AccAddress([]byte("creator_address")),
 	)
 	s.Require().NoError(err)
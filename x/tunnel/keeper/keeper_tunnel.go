@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -17,7 +18,10 @@ func (k Keeper) AddTunnel(
 	ctx sdk.Context,
 	route types.RouteI,
 	signalDeviations []types.SignalDeviation,
+	derivedSignals []types.DerivedSignal,
 	interval uint64,
+	schedule string,
+	includePriceConfidence bool,
 	creator sdk.AccAddress,
 ) (*types.Tunnel, error) {
 	id := k.GetTunnelCount(ctx)
@@ -39,7 +43,10 @@ func (k Keeper) AddTunnel(
 		route,
 		feePayer.String(),
 		signalDeviations,
+		derivedSignals,
 		interval,
+		schedule,
+		includePriceConfidence,
 		sdk.NewCoins(),
 		false,
 		ctx.BlockTime().Unix(),
@@ -58,19 +65,26 @@ func (k Keeper) AddTunnel(
 		types.EventTypeCreateTunnel,
 		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
 		sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", tunnel.Interval)),
+		sdk.NewAttribute(types.AttributeKeySchedule, tunnel.Schedule),
 		sdk.NewAttribute(types.AttributeKeyRoute, tunnel.Route.String()),
 		sdk.NewAttribute(types.AttributeKeyFeePayer, tunnel.FeePayer),
 		sdk.NewAttribute(types.AttributeKeyIsActive, fmt.Sprintf("%t", tunnel.IsActive)),
 		sdk.NewAttribute(types.AttributeKeyCreatedAt, fmt.Sprintf("%d", tunnel.CreatedAt)),
 		sdk.NewAttribute(types.AttributeKeyCreator, tunnel.Creator),
 	)
-	for _, sd := range tunnel.SignalDeviations {
+	for _, sd := range tunnel.GetAllSignalDeviations() {
 		event = event.AppendAttributes(
 			sdk.NewAttribute(types.AttributeKeySignalID, sd.SignalID),
 			sdk.NewAttribute(types.AttributeKeySoftDeviationBPS, fmt.Sprintf("%d", sd.SoftDeviationBPS)),
 			sdk.NewAttribute(types.AttributeKeyHardDeviationBPS, fmt.Sprintf("%d", sd.HardDeviationBPS)),
 		)
 	}
+	for _, d := range tunnel.DerivedSignals {
+		event = event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyDerivedSignalID, d.SignalID),
+			sdk.NewAttribute(types.AttributeKeyExpression, d.Expression),
+		)
+	}
 	ctx.EventManager().EmitEvent(event)
 
 	return &tunnel, nil
@@ -81,7 +95,10 @@ func (k Keeper) UpdateSignalsAndInterval(
 	ctx sdk.Context,
 	tunnelID uint64,
 	signalDeviations []types.SignalDeviation,
+	derivedSignals []types.DerivedSignal,
 	interval uint64,
+	schedule string,
+	includePriceConfidence bool,
 ) error {
 	tunnel, err := k.GetTunnel(ctx, tunnelID)
 	if err != nil {
@@ -90,7 +107,10 @@ func (k Keeper) UpdateSignalsAndInterval(
 
 	// edit the tunnel
 	tunnel.SignalDeviations = signalDeviations
+	tunnel.DerivedSignals = derivedSignals
 	tunnel.Interval = interval
+	tunnel.Schedule = schedule
+	tunnel.IncludePriceConfidence = includePriceConfidence
 	k.SetTunnel(ctx, tunnel)
 
 	// edit the prices info
@@ -100,14 +120,108 @@ func (k Keeper) UpdateSignalsAndInterval(
 		types.EventTypeUpdateSignalsAndInterval,
 		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
 		sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", tunnel.Interval)),
+		sdk.NewAttribute(types.AttributeKeySchedule, tunnel.Schedule),
 	)
-	for _, sd := range signalDeviations {
+	for _, sd := range tunnel.GetAllSignalDeviations() {
 		event = event.AppendAttributes(
 			sdk.NewAttribute(types.AttributeKeySignalID, sd.SignalID),
 			sdk.NewAttribute(types.AttributeKeySoftDeviationBPS, fmt.Sprintf("%d", sd.SoftDeviationBPS)),
 			sdk.NewAttribute(types.AttributeKeyHardDeviationBPS, fmt.Sprintf("%d", sd.HardDeviationBPS)),
 		)
 	}
+	for _, d := range tunnel.DerivedSignals {
+		event = event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyDerivedSignalID, d.SignalID),
+			sdk.NewAttribute(types.AttributeKeyExpression, d.Expression),
+		)
+	}
+	ctx.EventManager().EmitEvent(event)
+
+	return nil
+}
+
+// ProposeTunnelOwnership sets the pending creator of a tunnel. The ownership is transferred
+// only after the pending creator accepts it.
+func (k Keeper) ProposeTunnelOwnership(ctx sdk.Context, tunnelID uint64, pendingCreator string) error {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	tunnel.PendingCreator = pendingCreator
+	k.SetTunnel(ctx, tunnel)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProposeTunnelOwnership,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+		sdk.NewAttribute(types.AttributeKeyCreator, tunnel.Creator),
+		sdk.NewAttribute(types.AttributeKeyPendingCreator, pendingCreator),
+	))
+
+	return nil
+}
+
+// TransferTunnelOwnership transfers the ownership of a tunnel to a new creator and clears its pending
+// creator. The co-administrators of the tunnel are kept, except the new creator which no longer needs
+// to be an admin.
+func (k Keeper) TransferTunnelOwnership(ctx sdk.Context, tunnelID uint64, newCreator string) error {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	previousCreator := tunnel.Creator
+	tunnel.Creator = newCreator
+	tunnel.PendingCreator = ""
+
+	admins := make([]types.TunnelAdmin, 0, len(tunnel.Admins))
+	for _, admin := range tunnel.Admins {
+		if admin.Address != newCreator {
+			admins = append(admins, admin)
+		}
+	}
+	tunnel.Admins = admins
+	k.SetTunnel(ctx, tunnel)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferTunnelOwnership,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+		sdk.NewAttribute(types.AttributeKeyPreviousCreator, previousCreator),
+		sdk.NewAttribute(types.AttributeKeyCreator, tunnel.Creator),
+	))
+
+	return nil
+}
+
+// UpdateTunnelAdmins replaces the co-administrators of a tunnel.
+func (k Keeper) UpdateTunnelAdmins(ctx sdk.Context, tunnelID uint64, admins []types.TunnelAdmin) error {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	if err := types.ValidateTunnelAdmins(admins, tunnel.Creator); err != nil {
+		return err
+	}
+
+	tunnel.Admins = admins
+	k.SetTunnel(ctx, tunnel)
+
+	event := sdk.NewEvent(
+		types.EventTypeUpdateTunnelAdmins,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+	)
+	for _, admin := range tunnel.Admins {
+		permissions := make([]string, 0, len(admin.Permissions))
+		for _, p := range admin.Permissions {
+			permissions = append(permissions, p.String())
+		}
+
+		event = event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyAdmin, admin.Address),
+			sdk.NewAttribute(types.AttributeKeyPermissions, strings.Join(permissions, ",")),
+		)
+	}
 	ctx.EventManager().EmitEvent(event)
 
 	return nil
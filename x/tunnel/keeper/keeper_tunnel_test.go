@@ -50,7 +50,7 @@ func (s *KeeperTestSuite) TestAddTunnel() {
 	s.accountKeeper.EXPECT().NewAccount(ctx, gomock.Any()).Times(1)
 	s.accountKeeper.EXPECT().SetAccount(ctx, gomock.Any()).Times(1)
 
-	tunnel, err := k.AddTunnel(ctx, route, signalDeviations, interval, creator)
+	tunnel, err := k.AddTunnel(ctx, route, signalDeviations, nil, interval, "", false, creator)
 	s.Require().NoError(err)
 	s.Require().Equal(expectedTunnel, *tunnel)
 
@@ -85,7 +85,10 @@ func (s *KeeperTestSuite) TestUpdateSignalsAndInterval() {
 		ctx,
 		initialRoute,
 		initialSignalDeviations,
+		nil,
 		initialInterval,
+		"",
+		false,
 		creator,
 	)
 	s.Require().NoError(err)
@@ -95,17 +98,32 @@ func (s *KeeperTestSuite) TestUpdateSignalsAndInterval() {
 		{SignalID: "CS:BAND-USD", SoftDeviationBPS: 1100, HardDeviationBPS: 1100},
 		{SignalID: "CS:ETH-USD", SoftDeviationBPS: 1100, HardDeviationBPS: 1100},
 	}
+	newDerivedSignals := []types.DerivedSignal{
+		{SignalID: "DS:ETH-BTC", Expression: "CS:ETH-USD / CS:BTC-USD", SoftDeviationBPS: 1100, HardDeviationBPS: 1100},
+	}
 	newInterval := uint64(20)
+	newSchedule := "0 * * * *"
 
 	// call the UpdateSignalsAndInterval function
-	err = k.UpdateSignalsAndInterval(ctx, initialTunnel.ID, newSignalDeviations, newInterval)
+	err = k.UpdateSignalsAndInterval(
+		ctx,
+		initialTunnel.ID,
+		newSignalDeviations,
+		newDerivedSignals,
+		newInterval,
+		newSchedule,
+		true,
+	)
 	s.Require().NoError(err)
 
 	// validate the edited tunnel
 	editedTunnel, err := k.GetTunnel(ctx, initialTunnel.ID)
 	s.Require().NoError(err)
 	s.Require().Equal(newSignalDeviations, editedTunnel.SignalDeviations)
+	s.Require().Equal(newDerivedSignals, editedTunnel.DerivedSignals)
 	s.Require().Equal(newInterval, editedTunnel.Interval)
+	s.Require().Equal(newSchedule, editedTunnel.Schedule)
+	s.Require().True(editedTunnel.IncludePriceConfidence)
 
 	// check the latest prices
 	latestPrices, err := k.GetLatestPrices(ctx, editedTunnel.ID)
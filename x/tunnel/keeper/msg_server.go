@@ -35,7 +35,8 @@ func (k msgServer) CreateTunnel(
 	params := k.Keeper.GetParams(ctx)
 
 	// validate signal infos and interval
-	if err := types.ValidateSignalDeviations(msg.SignalDeviations, params.MaxSignals, params.MaxDeviationBPS, params.MinDeviationBPS); err != nil {
+	signalDeviations := types.CombineSignalDeviations(msg.SignalDeviations, msg.DerivedSignals)
+	if err := types.ValidateSignalDeviations(signalDeviations, params.MaxSignals, params.MaxDeviationBPS, params.MinDeviationBPS); err != nil {
 		return nil, err
 	}
 
@@ -44,6 +45,11 @@ func (k msgServer) CreateTunnel(
 		return nil, err
 	}
 
+	// validate schedule
+	if err := types.ValidateSchedule(msg.Schedule, params.MinInterval); err != nil {
+		return nil, err
+	}
+
 	creator, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
 		return nil, err
@@ -54,7 +60,7 @@ func (k msgServer) CreateTunnel(
 		return nil, err
 	}
 
-	// isIBCRoute is true if the route is IBCRoute
+	// isIBCRoute is true if the route is IBCRoute or IBCABIRoute
 	var isIBCRoute bool
 
 	// validate the route based on the route type
@@ -64,6 +70,11 @@ func (k msgServer) CreateTunnel(
 			return nil, types.ErrInvalidRoute.Wrap("channel id should be set after create tunnel")
 		}
 		isIBCRoute = true
+	case *types.IBCABIRoute:
+		if r.ChannelID != "" {
+			return nil, types.ErrInvalidRoute.Wrap("channel id should be set after create tunnel")
+		}
+		isIBCRoute = true
 	case *types.IBCHookRoute:
 		_, found := k.channelKeeper.GetChannel(ctx, ibctransfertypes.PortID, r.ChannelID)
 		if !found {
@@ -76,14 +87,17 @@ func (k msgServer) CreateTunnel(
 		ctx,
 		route,
 		msg.SignalDeviations,
+		msg.DerivedSignals,
 		msg.Interval,
+		msg.Schedule,
+		msg.IncludePriceConfidence,
 		creator,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// bind ibc port for the tunnel if the route is IBCRoute
+	// bind ibc port for the tunnel if the route is IBCRoute or IBCABIRoute
 	if isIBCRoute {
 		_, err = k.ensureIBCPort(ctx, tunnel.ID)
 		if err != nil {
@@ -115,7 +129,7 @@ func (k msgServer) UpdateRoute(
 		return nil, err
 	}
 
-	if msg.Creator != tunnel.Creator {
+	if !tunnel.IsAuthorized(msg.Creator, types.TUNNEL_PERMISSION_UPDATE_ROUTE) {
 		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
 	}
 
@@ -135,6 +149,12 @@ func (k msgServer) UpdateRoute(
 			return nil, types.ErrInvalidChannelID
 		}
 		tunnel.Route = msg.Route
+	case *types.IBCABIRoute:
+		_, found := k.channelKeeper.GetChannel(ctx, PortIDForTunnel(msg.TunnelID), r.ChannelID)
+		if !found {
+			return nil, types.ErrInvalidChannelID
+		}
+		tunnel.Route = msg.Route
 	case *types.IBCHookRoute:
 		_, found := k.channelKeeper.GetChannel(ctx, ibctransfertypes.PortID, r.ChannelID)
 		if !found {
@@ -163,7 +183,8 @@ func (k msgServer) UpdateSignalsAndInterval(
 	params := k.Keeper.GetParams(ctx)
 
 	// validate signal infos and interval
-	if err := types.ValidateSignalDeviations(msg.SignalDeviations, params.MaxSignals, params.MaxDeviationBPS, params.MinDeviationBPS); err != nil {
+	signalDeviations := types.CombineSignalDeviations(msg.SignalDeviations, msg.DerivedSignals)
+	if err := types.ValidateSignalDeviations(signalDeviations, params.MaxSignals, params.MaxDeviationBPS, params.MinDeviationBPS); err != nil {
 		return nil, err
 	}
 
@@ -172,16 +193,29 @@ func (k msgServer) UpdateSignalsAndInterval(
 		return nil, err
 	}
 
+	// validate schedule
+	if err := types.ValidateSchedule(msg.Schedule, params.MinInterval); err != nil {
+		return nil, err
+	}
+
 	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Creator != tunnel.Creator {
+	if !tunnel.IsAuthorized(msg.Creator, types.TUNNEL_PERMISSION_UPDATE_SIGNALS_AND_INTERVAL) {
 		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
 	}
 
-	err = k.Keeper.UpdateSignalsAndInterval(ctx, msg.TunnelID, msg.SignalDeviations, msg.Interval)
+	err = k.Keeper.UpdateSignalsAndInterval(
+		ctx,
+		msg.TunnelID,
+		msg.SignalDeviations,
+		msg.DerivedSignals,
+		msg.Interval,
+		msg.Schedule,
+		msg.IncludePriceConfidence,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +235,7 @@ func (k msgServer) WithdrawFeePayerFunds(
 		return nil, err
 	}
 
-	if msg.Creator != tunnel.Creator {
+	if !tunnel.IsAuthorized(msg.Creator, types.TUNNEL_PERMISSION_WITHDRAW_FEE_PAYER_FUNDS) {
 		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
 	}
 
@@ -210,7 +244,8 @@ func (k msgServer) WithdrawFeePayerFunds(
 		return nil, err
 	}
 
-	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	// the funds are always withdrawn to the creator of the tunnel
+	creator, err := sdk.AccAddressFromBech32(tunnel.Creator)
 	if err != nil {
 		return nil, err
 	}
@@ -240,8 +275,8 @@ func (k msgServer) ActivateTunnel(
 		return nil, err
 	}
 
-	// check if the creator is the same
-	if msg.Creator != tunnel.Creator {
+	// check if the sender is the creator or an admin with the permission
+	if !tunnel.IsAuthorized(msg.Creator, types.TUNNEL_PERMISSION_ACTIVATE) {
 		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
 	}
 
@@ -269,7 +304,7 @@ func (k msgServer) DeactivateTunnel(
 		return nil, err
 	}
 
-	if msg.Creator != tunnel.Creator {
+	if !tunnel.IsAuthorized(msg.Creator, types.TUNNEL_PERMISSION_DEACTIVATE) {
 		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
 	}
 
@@ -296,7 +331,7 @@ func (k msgServer) TriggerTunnel(
 		return nil, err
 	}
 
-	if msg.Creator != tunnel.Creator {
+	if !tunnel.IsAuthorized(msg.Creator, types.TUNNEL_PERMISSION_TRIGGER) {
 		return nil, types.ErrInvalidTunnelCreator.Wrapf(
 			"creator %s, tunnelID %d",
 			msg.Creator,
@@ -310,6 +345,7 @@ func (k msgServer) TriggerTunnel(
 
 	signalIDs := tunnel.GetSignalIDs()
 	prices := k.Keeper.feedsKeeper.GetPrices(ctx, signalIDs)
+	prices = append(prices, k.Keeper.GetDerivedPrices(ctx, tunnel.DerivedSignals)...)
 
 	// create a new packet
 	packet, err := k.Keeper.CreatePacket(ctx, tunnel.ID, prices)
@@ -341,6 +377,187 @@ func (k msgServer) TriggerTunnel(
 	return &types.MsgTriggerTunnelResponse{}, nil
 }
 
+// RetryPacket resends a failed packet of a tunnel. A failed retry does not revert the transaction,
+// so that the attempt is recorded in the failed packet record.
+func (k msgServer) RetryPacket(
+	goCtx context.Context,
+	msg *types.MsgRetryPacket,
+) (*types.MsgRetryPacketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the creator, an admin with the permission or the fee payer of the tunnel can retry the packet
+	if !tunnel.IsAuthorized(msg.Sender, types.TUNNEL_PERMISSION_RETRY_PACKET) && msg.Sender != tunnel.FeePayer {
+		return nil, types.ErrInvalidRetrySender.Wrapf("sender %s, tunnelID %d", msg.Sender, msg.TunnelID)
+	}
+
+	if _, err := k.Keeper.GetFailedPacket(ctx, msg.TunnelID, msg.Sequence); err != nil {
+		return nil, err
+	}
+
+	packet, err := k.Keeper.GetPacket(ctx, msg.TunnelID, msg.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	// the failure is recorded and emitted by TrySendPacket
+	if err := k.Keeper.TrySendPacket(ctx, packet); err != nil {
+		return &types.MsgRetryPacketResponse{Success: false, Reason: err.Error()}, nil
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRetryPacketSuccess,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", packet.TunnelID)),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+	))
+
+	return &types.MsgRetryPacketResponse{Success: true}, nil
+}
+
+// TransferTunnelOwnership proposes a new creator of the tunnel. The new creator must accept the ownership.
+func (k msgServer) TransferTunnelOwnership(
+	goCtx context.Context,
+	msg *types.MsgTransferTunnelOwnership,
+) (*types.MsgTransferTunnelOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the creator can transfer the ownership
+	if msg.Creator != tunnel.Creator {
+		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
+	}
+
+	if err := k.Keeper.ProposeTunnelOwnership(ctx, msg.TunnelID, msg.NewCreator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferTunnelOwnershipResponse{}, nil
+}
+
+// AcceptTunnelOwnership transfers the ownership of the tunnel to its pending creator.
+func (k msgServer) AcceptTunnelOwnership(
+	goCtx context.Context,
+	msg *types.MsgAcceptTunnelOwnership,
+) (*types.MsgAcceptTunnelOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the pending creator can accept the ownership
+	if tunnel.PendingCreator == "" || msg.NewCreator != tunnel.PendingCreator {
+		return nil, types.ErrInvalidPendingCreator.Wrapf("new creator %s, tunnelID %d", msg.NewCreator, msg.TunnelID)
+	}
+
+	if err := k.Keeper.TransferTunnelOwnership(ctx, msg.TunnelID, msg.NewCreator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptTunnelOwnershipResponse{}, nil
+}
+
+// UpdateTunnelAdmins updates the co-administrators of the tunnel.
+func (k msgServer) UpdateTunnelAdmins(
+	goCtx context.Context,
+	msg *types.MsgUpdateTunnelAdmins,
+) (*types.MsgUpdateTunnelAdminsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the creator can update the admins
+	if msg.Creator != tunnel.Creator {
+		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
+	}
+
+	if err := k.Keeper.UpdateTunnelAdmins(ctx, msg.TunnelID, msg.Admins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateTunnelAdminsResponse{}, nil
+}
+
+// ApproveFundingSource approves the funding account that can set the funding policy of the tunnel.
+func (k msgServer) ApproveFundingSource(
+	goCtx context.Context,
+	msg *types.MsgApproveFundingSource,
+) (*types.MsgApproveFundingSourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the creator can approve the funding account
+	if msg.Creator != tunnel.Creator {
+		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
+	}
+
+	if err := k.Keeper.ApproveFundingSource(ctx, msg.TunnelID, msg.Source); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveFundingSourceResponse{}, nil
+}
+
+// SetFundingPolicy grants an allowance from the funding account to top up the fee payer of the tunnel.
+func (k msgServer) SetFundingPolicy(
+	goCtx context.Context,
+	msg *types.MsgSetFundingPolicy,
+) (*types.MsgSetFundingPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policy := types.NewFundingPolicy(msg.Source, msg.Allowance, msg.Threshold, msg.TopUpAmount)
+	if err := k.Keeper.SetFundingPolicy(ctx, msg.TunnelID, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFundingPolicyResponse{}, nil
+}
+
+// RemoveFundingPolicy removes the funding policy of the tunnel.
+func (k msgServer) RemoveFundingPolicy(
+	goCtx context.Context,
+	msg *types.MsgRemoveFundingPolicy,
+) (*types.MsgRemoveFundingPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if tunnel.FundingPolicy == nil {
+		return nil, types.ErrFundingPolicyNotFound.Wrapf("tunnelID: %d", msg.TunnelID)
+	}
+
+	// only the funding account or the creator of the tunnel can remove the funding policy
+	if msg.Sender != tunnel.FundingPolicy.Source && msg.Sender != tunnel.Creator {
+		return nil, types.ErrInvalidFundingSender.Wrapf("sender %s, tunnelID %d", msg.Sender, msg.TunnelID)
+	}
+
+	if err := k.Keeper.RemoveFundingPolicy(ctx, msg.TunnelID); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFundingPolicyResponse{}, nil
+}
+
 // DepositToTunnel adds deposit to the tunnel.
 func (k msgServer) DepositToTunnel(
 	goCtx context.Context,
@@ -92,6 +92,29 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 			expErr:    true,
 			expErrMsg: "interval out of range",
 		},
+		"schedule too frequent": {
+			preRun: func() (*types.MsgCreateTunnel, error) {
+				params := types.DefaultParams()
+				params.MinInterval = 3600
+				s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+				msg, err := types.NewMsgCreateTunnel(
+					signalDeviations,
+					3600,
+					route,
+					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(100))),
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+				if err != nil {
+					return nil, err
+				}
+				msg.Schedule = "*/30 * * * *"
+
+				return msg, nil
+			},
+			expErr:    true,
+			expErrMsg: "invalid schedule",
+		},
 		"channel id should be set after create tunnel": {
 			preRun: func() (*types.MsgCreateTunnel, error) {
 				depositor := sdk.AccAddress([]byte("creator_address"))
@@ -130,6 +153,41 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 			expErr:    false,
 			expErrMsg: "",
 		},
+		"all good (ibc abi route)": {
+			preRun: func() (*types.MsgCreateTunnel, error) {
+				s.accountKeeper.EXPECT().
+					GetAccount(s.ctx, gomock.Any()).
+					Return(nil).Times(1)
+				s.accountKeeper.EXPECT().NewAccount(s.ctx, gomock.Any()).Times(1)
+				s.accountKeeper.EXPECT().SetAccount(s.ctx, gomock.Any()).Times(1)
+				s.scopedKeeper.EXPECT().
+					GetCapability(s.ctx, "ports/tunnel.1").
+					Return(&capabilitytypes.Capability{}, true)
+
+				return types.NewMsgCreateIBCABITunnel(
+					signalDeviations,
+					60,
+					feedstypes.ENCODER_TICK_ABI,
+					sdk.NewCoins(),
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
+		"channel id should be set after create tunnel (ibc abi route)": {
+			preRun: func() (*types.MsgCreateTunnel, error) {
+				return types.NewMsgCreateTunnel(
+					signalDeviations,
+					60,
+					types.NewIBCABIRoute("channel-0", feedstypes.ENCODER_FIXED_POINT_ABI),
+					sdk.NewCoins(),
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    true,
+			expErrMsg: "channel id should be set after create tunnel",
+		},
 		"all good without initial deposit": {
 			preRun: func() (*types.MsgCreateTunnel, error) {
 				s.accountKeeper.EXPECT().
@@ -254,6 +312,42 @@ func (s *KeeperTestSuite) TestMsgUpdateRoute() {
 			expErr:    false,
 			expErrMsg: "",
 		},
+		"all good (ibc abi route)": {
+			preRun: func() (*types.MsgUpdateRoute, error) {
+				s.channelKeeper.EXPECT().
+					GetChannel(gomock.Any(), "tunnel.1", "channel-0").
+					Return(channeltypes.Channel{}, true)
+
+				s.AddSampleIBCABITunnel(false)
+
+				return types.NewMsgUpdateIBCABIRoute(
+					1,
+					"channel-0",
+					feedstypes.ENCODER_TICK_ABI,
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
+		"channel not found (ibc abi route)": {
+			preRun: func() (*types.MsgUpdateRoute, error) {
+				s.channelKeeper.EXPECT().
+					GetChannel(gomock.Any(), "tunnel.1", "channel-0").
+					Return(channeltypes.Channel{}, false)
+
+				s.AddSampleIBCABITunnel(false)
+
+				return types.NewMsgUpdateIBCABIRoute(
+					1,
+					"channel-0",
+					feedstypes.ENCODER_TICK_ABI,
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    true,
+			expErrMsg: "invalid channel id",
+		},
 	}
 
 	for name, tc := range cases {
@@ -304,7 +398,10 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 				return types.NewMsgUpdateSignalsAndInterval(
 					1,
 					editedSignalDeviations,
+					nil,
 					60,
+					"",
+					false,
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -332,7 +429,10 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 				return types.NewMsgUpdateSignalsAndInterval(
 					1,
 					editedSignalDeviations,
+					nil,
 					60,
+					"",
+					false,
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -359,7 +459,10 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 				return types.NewMsgUpdateSignalsAndInterval(
 					1,
 					editedSignalDeviations,
+					nil,
 					1,
+					"",
+					false,
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -371,7 +474,10 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 				return types.NewMsgUpdateSignalsAndInterval(
 					1,
 					[]types.SignalDeviation{},
+					nil,
 					60,
+					"",
+					false,
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -385,7 +491,10 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 				return types.NewMsgUpdateSignalsAndInterval(
 					1,
 					[]types.SignalDeviation{},
+					nil,
 					60,
+					"",
+					false,
 					sdk.AccAddress([]byte("wrong_creator_address")).String(),
 				)
 			},
@@ -407,7 +516,10 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 				return types.NewMsgUpdateSignalsAndInterval(
 					1,
 					editedSignalDeviations,
+					nil,
 					60,
+					"",
+					false,
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -625,6 +737,24 @@ func (s *KeeperTestSuite) TestMsgDeactivateTunnel() {
 			expErr:    true,
 			expErrMsg: "already inactive",
 		},
+		"admin without permission": {
+			preRun: func() *types.MsgDeactivateTunnel {
+				s.AddSampleTunnelAdmin(types.TUNNEL_PERMISSION_ACTIVATE)
+
+				return types.NewMsgDeactivateTunnel(1, sdk.AccAddress([]byte("admin_address")).String())
+			},
+			expErr:    true,
+			expErrMsg: "invalid creator of the tunnel",
+		},
+		"admin with permission": {
+			preRun: func() *types.MsgDeactivateTunnel {
+				s.AddSampleTunnelAdmin(types.TUNNEL_PERMISSION_DEACTIVATE)
+
+				return types.NewMsgDeactivateTunnel(1, sdk.AccAddress([]byte("admin_address")).String())
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
 		"all good": {
 			preRun: func() *types.MsgDeactivateTunnel {
 				s.AddSampleTunnel(true)
@@ -743,6 +873,583 @@ func (s *KeeperTestSuite) TestMsgTriggerTunnel() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgRetryPacket() {
+	cases := map[string]struct {
+		preRun      func() *types.MsgRetryPacket
+		expErr      bool
+		expErrMsg   string
+		expAttempts uint64
+		expReason   string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgRetryPacket {
+				return types.NewMsgRetryPacket(1, 1, sdk.AccAddress([]byte("creator_address")).String())
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"invalid sender": {
+			preRun: func() *types.MsgRetryPacket {
+				s.AddSampleTunnel(false)
+
+				return types.NewMsgRetryPacket(1, 1, sdk.AccAddress([]byte("wrong_creator_address")).String())
+			},
+			expErr:    true,
+			expErrMsg: "invalid sender to retry packet",
+		},
+		"all good on inactive tunnel": {
+			preRun: func() *types.MsgRetryPacket {
+				tunnel := s.AddSampleTunnel(false)
+				s.keeper.SetPacket(s.ctx, types.NewPacket(1, 1, nil, s.ctx.BlockTime().Unix()))
+				s.keeper.SetFailedPacket(s.ctx, types.NewFailedPacket(1, 1, "route error", 1, 0))
+
+				s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(
+					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil,
+				)
+				s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+					gomock.Any(),
+					uint64(1),
+					"chain-1",
+					"0x1234567890abcdef",
+					gomock.Any(),
+					sdk.MustAccAddressFromBech32(tunnel.FeePayer),
+					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+				).Return(bandtsstypes.SigningID(1), nil)
+
+				return types.NewMsgRetryPacket(1, 1, sdk.AccAddress([]byte("creator_address")).String())
+			},
+			expErr:      false,
+			expAttempts: 0,
+		},
+		"failed packet not found": {
+			preRun: func() *types.MsgRetryPacket {
+				s.AddSampleTunnel(true)
+				s.keeper.SetPacket(s.ctx, types.NewPacket(1, 1, nil, s.ctx.BlockTime().Unix()))
+
+				return types.NewMsgRetryPacket(1, 1, sdk.AccAddress([]byte("creator_address")).String())
+			},
+			expErr:    true,
+			expErrMsg: "failed packet not found",
+		},
+		"retry failed": {
+			preRun: func() *types.MsgRetryPacket {
+				tunnel := s.AddSampleTunnel(true)
+				s.keeper.SetPacket(s.ctx, types.NewPacket(1, 1, nil, s.ctx.BlockTime().Unix()))
+				s.keeper.SetFailedPacket(s.ctx, types.NewFailedPacket(1, 1, "route error", 1, 0))
+
+				s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(
+					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil,
+				)
+				s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+					gomock.Any(),
+					uint64(1),
+					"chain-1",
+					"0x1234567890abcdef",
+					gomock.Any(),
+					sdk.MustAccAddressFromBech32(tunnel.FeePayer),
+					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+				).Return(bandtsstypes.SigningID(0), sdkerrors.ErrInsufficientFunds)
+
+				return types.NewMsgRetryPacket(1, 1, sdk.AccAddress([]byte("creator_address")).String())
+			},
+			expErr:      false,
+			expAttempts: 2,
+			expReason:   "insufficient funds",
+		},
+		"all good by fee payer": {
+			preRun: func() *types.MsgRetryPacket {
+				tunnel := s.AddSampleTunnel(true)
+				s.keeper.SetPacket(s.ctx, types.NewPacket(1, 1, nil, s.ctx.BlockTime().Unix()))
+				s.keeper.SetFailedPacket(s.ctx, types.NewFailedPacket(1, 1, "route error", 1, 0))
+
+				s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(
+					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil,
+				)
+				s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+					gomock.Any(),
+					uint64(1),
+					"chain-1",
+					"0x1234567890abcdef",
+					gomock.Any(),
+					sdk.MustAccAddressFromBech32(tunnel.FeePayer),
+					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+				).Return(bandtsstypes.SigningID(1), nil)
+
+				return types.NewMsgRetryPacket(1, 1, tunnel.FeePayer)
+			},
+			expErr:      false,
+			expErrMsg:   "",
+			expAttempts: 0,
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			resp, err := s.msgServer.RetryPacket(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				failedPacket, err := s.keeper.GetFailedPacket(s.ctx, msg.TunnelID, msg.Sequence)
+				if tc.expAttempts == 0 {
+					s.Require().True(resp.Success)
+					s.Require().ErrorIs(err, types.ErrFailedPacketNotFound)
+				} else {
+					s.Require().False(resp.Success)
+					s.Require().Contains(resp.Reason, tc.expReason)
+					s.Require().NoError(err)
+					s.Require().Equal(tc.expAttempts, failedPacket.Attempts)
+				}
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgTransferTunnelOwnership() {
+	creator := sdk.AccAddress([]byte("creator_address")).String()
+	newCreator := sdk.AccAddress([]byte("new_creator")).String()
+
+	cases := map[string]struct {
+		preRun    func() *types.MsgTransferTunnelOwnership
+		expErr    bool
+		expErrMsg string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgTransferTunnelOwnership {
+				return types.NewMsgTransferTunnelOwnership(1, newCreator, creator)
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"invalid creator of the tunnel": {
+			preRun: func() *types.MsgTransferTunnelOwnership {
+				s.AddSampleTunnel(true)
+
+				return types.NewMsgTransferTunnelOwnership(
+					1,
+					newCreator,
+					sdk.AccAddress([]byte("wrong_creator_address")).String(),
+				)
+			},
+			expErr:    true,
+			expErrMsg: "invalid creator of the tunnel",
+		},
+		"admin cannot transfer the ownership": {
+			preRun: func() *types.MsgTransferTunnelOwnership {
+				s.AddSampleTunnelAdmin(types.TUNNEL_PERMISSION_UPDATE_ROUTE)
+
+				return types.NewMsgTransferTunnelOwnership(1, newCreator, sdk.AccAddress([]byte("admin_address")).String())
+			},
+			expErr:    true,
+			expErrMsg: "invalid creator of the tunnel",
+		},
+		"all good": {
+			preRun: func() *types.MsgTransferTunnelOwnership {
+				s.AddSampleTunnel(true)
+
+				return types.NewMsgTransferTunnelOwnership(1, newCreator, creator)
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			_, err := s.msgServer.TransferTunnelOwnership(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				// the ownership is not transferred until the new creator accepts it
+				tunnel, err := s.keeper.GetTunnel(s.ctx, msg.TunnelID)
+				s.Require().NoError(err)
+				s.Require().Equal(creator, tunnel.Creator)
+				s.Require().Equal(newCreator, tunnel.PendingCreator)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgAcceptTunnelOwnership() {
+	creator := sdk.AccAddress([]byte("creator_address")).String()
+	newCreator := sdk.AccAddress([]byte("new_creator")).String()
+
+	cases := map[string]struct {
+		preRun    func() *types.MsgAcceptTunnelOwnership
+		expErr    bool
+		expErrMsg string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgAcceptTunnelOwnership {
+				return types.NewMsgAcceptTunnelOwnership(1, newCreator)
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"no pending creator": {
+			preRun: func() *types.MsgAcceptTunnelOwnership {
+				s.AddSampleTunnel(true)
+
+				return types.NewMsgAcceptTunnelOwnership(1, newCreator)
+			},
+			expErr:    true,
+			expErrMsg: "invalid pending creator",
+		},
+		"not the pending creator": {
+			preRun: func() *types.MsgAcceptTunnelOwnership {
+				s.AddSampleTunnel(true)
+				err := s.keeper.ProposeTunnelOwnership(s.ctx, 1, newCreator)
+				s.Require().NoError(err)
+
+				return types.NewMsgAcceptTunnelOwnership(1, sdk.AccAddress([]byte("other_address")).String())
+			},
+			expErr:    true,
+			expErrMsg: "invalid pending creator",
+		},
+		"all good": {
+			preRun: func() *types.MsgAcceptTunnelOwnership {
+				s.AddSampleTunnel(true)
+				err := s.keeper.ProposeTunnelOwnership(s.ctx, 1, newCreator)
+				s.Require().NoError(err)
+
+				return types.NewMsgAcceptTunnelOwnership(1, newCreator)
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			_, err := s.msgServer.AcceptTunnelOwnership(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				tunnel, err := s.keeper.GetTunnel(s.ctx, msg.TunnelID)
+				s.Require().NoError(err)
+				s.Require().Equal(newCreator, tunnel.Creator)
+				s.Require().Empty(tunnel.PendingCreator)
+				s.Require().False(tunnel.IsAuthorized(creator, types.TUNNEL_PERMISSION_DEACTIVATE))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateTunnelAdmins() {
+	creator := sdk.AccAddress([]byte("creator_address")).String()
+	admins := []types.TunnelAdmin{
+		types.NewTunnelAdmin(
+			sdk.AccAddress([]byte("admin_address")).String(),
+			[]types.TunnelPermission{types.TUNNEL_PERMISSION_TRIGGER},
+		),
+	}
+
+	cases := map[string]struct {
+		preRun    func() *types.MsgUpdateTunnelAdmins
+		expErr    bool
+		expErrMsg string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgUpdateTunnelAdmins {
+				return types.NewMsgUpdateTunnelAdmins(1, admins, creator)
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"invalid creator of the tunnel": {
+			preRun: func() *types.MsgUpdateTunnelAdmins {
+				s.AddSampleTunnel(true)
+
+				return types.NewMsgUpdateTunnelAdmins(
+					1,
+					admins,
+					sdk.AccAddress([]byte("wrong_creator_address")).String(),
+				)
+			},
+			expErr:    true,
+			expErrMsg: "invalid creator of the tunnel",
+		},
+		"creator cannot be an admin": {
+			preRun: func() *types.MsgUpdateTunnelAdmins {
+				s.AddSampleTunnel(true)
+
+				return types.NewMsgUpdateTunnelAdmins(
+					1,
+					[]types.TunnelAdmin{
+						types.NewTunnelAdmin(creator, []types.TunnelPermission{types.TUNNEL_PERMISSION_TRIGGER}),
+					},
+					creator,
+				)
+			},
+			expErr:    true,
+			expErrMsg: "invalid tunnel admin",
+		},
+		"all good": {
+			preRun: func() *types.MsgUpdateTunnelAdmins {
+				s.AddSampleTunnel(true)
+
+				return types.NewMsgUpdateTunnelAdmins(1, admins, creator)
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			_, err := s.msgServer.UpdateTunnelAdmins(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				tunnel, err := s.keeper.GetTunnel(s.ctx, msg.TunnelID)
+				s.Require().NoError(err)
+				s.Require().Equal(admins, tunnel.Admins)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgApproveFundingSource() {
+	creator := sdk.AccAddress([]byte("creator_address")).String()
+	source := sdk.AccAddress([]byte("source")).String()
+
+	cases := map[string]struct {
+		preRun    func() *types.MsgApproveFundingSource
+		expErr    bool
+		expErrMsg string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgApproveFundingSource {
+				return types.NewMsgApproveFundingSource(1, source, creator)
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"invalid creator of the tunnel": {
+			preRun: func() *types.MsgApproveFundingSource {
+				s.AddSampleTunnel(true)
+
+				return types.NewMsgApproveFundingSource(1, source, source)
+			},
+			expErr:    true,
+			expErrMsg: "invalid creator of the tunnel",
+		},
+		"all good": {
+			preRun: func() *types.MsgApproveFundingSource {
+				s.AddSampleTunnel(true)
+
+				return types.NewMsgApproveFundingSource(1, source, creator)
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			_, err := s.msgServer.ApproveFundingSource(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				tunnel, err := s.keeper.GetTunnel(s.ctx, msg.TunnelID)
+				s.Require().NoError(err)
+				s.Require().Equal(source, tunnel.FundingSource)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgSetFundingPolicy() {
+	source := sdk.AccAddress([]byte("source")).String()
+	allowance := sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))
+	threshold := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+	topUpAmount := sdk.NewCoins(sdk.NewInt64Coin("uband", 300))
+
+	cases := map[string]struct {
+		preRun    func() *types.MsgSetFundingPolicy
+		expErr    bool
+		expErrMsg string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgSetFundingPolicy {
+				return types.NewMsgSetFundingPolicy(1, allowance, threshold, topUpAmount, source)
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"source not approved": {
+			preRun: func() *types.MsgSetFundingPolicy {
+				s.AddSampleTunnel(true)
+
+				return types.NewMsgSetFundingPolicy(1, allowance, threshold, topUpAmount, source)
+			},
+			expErr:    true,
+			expErrMsg: "invalid sender of funding policy",
+		},
+		"another source approved": {
+			preRun: func() *types.MsgSetFundingPolicy {
+				s.AddSampleTunnel(true)
+
+				err := s.keeper.ApproveFundingSource(s.ctx, 1, sdk.AccAddress([]byte("other_source")).String())
+				s.Require().NoError(err)
+
+				return types.NewMsgSetFundingPolicy(1, allowance, threshold, topUpAmount, source)
+			},
+			expErr:    true,
+			expErrMsg: "invalid sender of funding policy",
+		},
+		"all good": {
+			preRun: func() *types.MsgSetFundingPolicy {
+				s.AddSampleTunnel(true)
+
+				err := s.keeper.ApproveFundingSource(s.ctx, 1, source)
+				s.Require().NoError(err)
+
+				return types.NewMsgSetFundingPolicy(1, allowance, threshold, topUpAmount, source)
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			_, err := s.msgServer.SetFundingPolicy(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				tunnel, err := s.keeper.GetTunnel(s.ctx, msg.TunnelID)
+				s.Require().NoError(err)
+				s.Require().Equal(source, tunnel.FundingPolicy.Source)
+				s.Require().Equal(allowance, tunnel.FundingPolicy.Allowance)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgRemoveFundingPolicy() {
+	source := sdk.AccAddress([]byte("source")).String()
+	setFundingPolicy := func() {
+		err := s.keeper.ApproveFundingSource(s.ctx, 1, source)
+		s.Require().NoError(err)
+
+		err = s.keeper.SetFundingPolicy(s.ctx, 1, types.NewFundingPolicy(
+			source,
+			sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+			sdk.NewCoins(sdk.NewInt64Coin("uband", 100)),
+			sdk.NewCoins(sdk.NewInt64Coin("uband", 300)),
+		))
+		s.Require().NoError(err)
+	}
+
+	cases := map[string]struct {
+		preRun    func() *types.MsgRemoveFundingPolicy
+		expErr    bool
+		expErrMsg string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgRemoveFundingPolicy {
+				return types.NewMsgRemoveFundingPolicy(1, source)
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"funding policy not found": {
+			preRun: func() *types.MsgRemoveFundingPolicy {
+				s.AddSampleTunnel(true)
+
+				return types.NewMsgRemoveFundingPolicy(1, source)
+			},
+			expErr:    true,
+			expErrMsg: "funding policy not found",
+		},
+		"invalid sender": {
+			preRun: func() *types.MsgRemoveFundingPolicy {
+				s.AddSampleTunnel(true)
+				setFundingPolicy()
+
+				return types.NewMsgRemoveFundingPolicy(1, sdk.AccAddress([]byte("other")).String())
+			},
+			expErr:    true,
+			expErrMsg: "invalid sender of funding policy",
+		},
+		"removed by the source": {
+			preRun: func() *types.MsgRemoveFundingPolicy {
+				s.AddSampleTunnel(true)
+				setFundingPolicy()
+
+				return types.NewMsgRemoveFundingPolicy(1, source)
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
+		"removed by the creator": {
+			preRun: func() *types.MsgRemoveFundingPolicy {
+				s.AddSampleTunnel(true)
+				setFundingPolicy()
+
+				return types.NewMsgRemoveFundingPolicy(1, sdk.AccAddress([]byte("creator_address")).String())
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			_, err := s.msgServer.RemoveFundingPolicy(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				tunnel, err := s.keeper.GetTunnel(s.ctx, msg.TunnelID)
+				s.Require().NoError(err)
+				s.Require().Nil(tunnel.FundingPolicy)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgDepositToTunnel() {
 	cases := map[string]struct {
 		preRun    func() *types.MsgDepositToTunnel
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+var _ oracletypes.OracleCallback = &OracleCallback{}
+
+// OracleCallback receives the results of the oracle requests made by the tunnel module.
+type OracleCallback struct {
+	k Keeper
+}
+
+// NewOracleCallback creates a new OracleCallback instance.
+func NewOracleCallback(k Keeper) OracleCallback {
+	return OracleCallback{k}
+}
+
+// OnRequestSuccess implements oracletypes.OracleCallback.
+func (cb OracleCallback) OnRequestSuccess(ctx sdk.Context, result oracletypes.Result) error {
+	cb.emitOracleResult(ctx, result, "")
+	return nil
+}
+
+// OnRequestFailure implements oracletypes.OracleCallback.
+func (cb OracleCallback) OnRequestFailure(ctx sdk.Context, result oracletypes.Result, reason string) error {
+	cb.emitOracleResult(ctx, result, reason)
+	return nil
+}
+
+// OnRequestExpired implements oracletypes.OracleCallback.
+func (cb OracleCallback) OnRequestExpired(ctx sdk.Context, result oracletypes.Result) error {
+	cb.emitOracleResult(ctx, result, "")
+	return nil
+}
+
+// emitOracleResult emits an event with the result of the oracle request.
+func (cb OracleCallback) emitOracleResult(ctx sdk.Context, result oracletypes.Result, reason string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReceiveOracleResult,
+		sdk.NewAttribute(types.AttributeKeyRequestID, fmt.Sprintf("%d", result.RequestID)),
+		sdk.NewAttribute(types.AttributeKeyStatus, result.ResolveStatus.String()),
+		sdk.NewAttribute(types.AttributeKeyResult, hex.EncodeToString(result.Result)),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+}
@@ -0,0 +1,62 @@
+package v2
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+const (
+	ModuleName = "tunnel"
+)
+
+// Migrate migrates the x/tunnel module state from the consensus version 1 to
+// version 2. Specifically, it backfills the creation time and status indexes of
+// every existing packet and marks the TSS packets, which were previously stored
+// without a status, as pending so that they are resolved from their signing status.
+func Migrate(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	receiptTypes := make(map[uint64]string)
+	tunnelIterator := storetypes.KVStorePrefixIterator(store, types.TunnelStoreKeyPrefix)
+	for ; tunnelIterator.Valid(); tunnelIterator.Next() {
+		var tunnel types.Tunnel
+		cdc.MustUnmarshal(tunnelIterator.Value(), &tunnel)
+
+		route, err := tunnel.GetRouteValue()
+		if err != nil {
+			tunnelIterator.Close()
+			return err
+		}
+		receiptTypes[tunnel.ID] = types.GetRouteReceiptType(route)
+	}
+	tunnelIterator.Close()
+
+	var packets []types.Packet
+	packetIterator := storetypes.KVStorePrefixIterator(store, types.PacketStoreKeyPrefix)
+	for ; packetIterator.Valid(); packetIterator.Next() {
+		var packet types.Packet
+		cdc.MustUnmarshal(packetIterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+	packetIterator.Close()
+
+	tssReceiptType := types.GetRouteReceiptType(&types.TSSRoute{})
+	for _, packet := range packets {
+		receiptType := receiptTypes[packet.TunnelID]
+		if receiptType == tssReceiptType && packet.Status == types.PACKET_STATUS_UNSPECIFIED {
+			packet.Status = types.PACKET_STATUS_PENDING
+			store.Set(types.TunnelPacketStoreKey(packet.TunnelID, packet.Sequence), cdc.MustMarshal(&packet))
+		}
+
+		store.Set(types.PacketTimeIndexKey(packet.TunnelID, packet.CreatedAt, packet.Sequence), []byte{})
+		store.Set(types.PacketStatusIndexKey(packet.Status, receiptType, packet.TunnelID, packet.Sequence), []byte{})
+	}
+
+	return nil
+}
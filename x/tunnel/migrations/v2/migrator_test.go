@@ -0,0 +1,64 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel"
+	v2 "github.com/bandprotocol/chain/v3/x/tunnel/migrations/v2"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(tunnel.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v2.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	tssTunnel := types.Tunnel{ID: 1}
+	require.NoError(t, tssTunnel.SetRoute(&types.TSSRoute{DestinationChainID: "chain-1"}))
+	store.Set(types.TunnelStoreKey(1), cdc.MustMarshal(&tssTunnel))
+	ibcTunnel := types.Tunnel{ID: 2}
+	require.NoError(t, ibcTunnel.SetRoute(&types.IBCRoute{ChannelID: "channel-0"}))
+	store.Set(types.TunnelStoreKey(2), cdc.MustMarshal(&ibcTunnel))
+
+	tssPacket := types.NewPacket(1, 1, []feedstypes.Price{}, 100)
+	store.Set(types.TunnelPacketStoreKey(1, 1), cdc.MustMarshal(&tssPacket))
+	ibcPacket := types.NewPacket(2, 1, []feedstypes.Price{}, 200)
+	ibcPacket.Status = types.PACKET_STATUS_SUCCESS
+	store.Set(types.TunnelPacketStoreKey(2, 1), cdc.MustMarshal(&ibcPacket))
+
+	require.NoError(t, v2.Migrate(ctx, store, cdc))
+
+	// TSS packets without a status become pending
+	var packet types.Packet
+	cdc.MustUnmarshal(store.Get(types.TunnelPacketStoreKey(1, 1)), &packet)
+	require.Equal(t, types.PACKET_STATUS_PENDING, packet.Status)
+	require.True(t, store.Has(types.PacketTimeIndexKey(1, 100, 1)))
+	require.True(t, store.Has(types.PacketStatusIndexKey(
+		types.PACKET_STATUS_PENDING,
+		types.GetRouteReceiptType(&types.TSSRoute{}),
+		1,
+		1,
+	)))
+
+	cdc.MustUnmarshal(store.Get(types.TunnelPacketStoreKey(2, 1)), &packet)
+	require.Equal(t, types.PACKET_STATUS_SUCCESS, packet.Status)
+	require.True(t, store.Has(types.PacketTimeIndexKey(2, 200, 1)))
+	require.True(t, store.Has(types.PacketStatusIndexKey(
+		types.PACKET_STATUS_SUCCESS,
+		types.GetRouteReceiptType(&types.IBCRoute{}),
+		2,
+		1,
+	)))
+}
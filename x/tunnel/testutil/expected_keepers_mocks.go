@@ -495,6 +495,34 @@ func (mr *MockFeedsKeeperMockRecorder) GetPrices(ctx, signalIDs any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrices", reflect.TypeOf((*MockFeedsKeeper)(nil).GetPrices), ctx, signalIDs)
 }
 
+// GetPriceHistory mocks base method.
+func (m *MockFeedsKeeper) GetPriceHistory(ctx types2.Context, signalID string) []types0.Price {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceHistory", ctx, signalID)
+	ret0, _ := ret[0].([]types0.Price)
+	return ret0
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockFeedsKeeperMockRecorder) GetPriceHistory(ctx, signalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockFeedsKeeper)(nil).GetPriceHistory), ctx, signalID)
+}
+
+// GetTWAPPrice mocks base method.
+func (m *MockFeedsKeeper) GetTWAPPrice(ctx types2.Context, signalID string, window int64) types0.Price {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTWAPPrice", ctx, signalID, window)
+	ret0, _ := ret[0].(types0.Price)
+	return ret0
+}
+
+// GetTWAPPrice indicates an expected call of GetTWAPPrice.
+func (mr *MockFeedsKeeperMockRecorder) GetTWAPPrice(ctx, signalID, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTWAPPrice", reflect.TypeOf((*MockFeedsKeeper)(nil).GetTWAPPrice), ctx, signalID, window)
+}
+
 // MockBandtssKeeper is a mock of BandtssKeeper interface.
 type MockBandtssKeeper struct {
 	ctrl     *gomock.Controller
@@ -548,3 +576,18 @@ func (mr *MockBandtssKeeperMockRecorder) GetSigningFee(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSigningFee", reflect.TypeOf((*MockBandtssKeeper)(nil).GetSigningFee), ctx)
 }
+
+// GetSigningStatus mocks base method.
+func (m *MockBandtssKeeper) GetSigningStatus(ctx types2.Context, id types.SigningID) (types1.SigningStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSigningStatus", ctx, id)
+	ret0, _ := ret[0].(types1.SigningStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSigningStatus indicates an expected call of GetSigningStatus.
+func (mr *MockBandtssKeeperMockRecorder) GetSigningStatus(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSigningStatus", reflect.TypeOf((*MockBandtssKeeper)(nil).GetSigningStatus), ctx, id)
+}
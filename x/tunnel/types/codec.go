@@ -25,6 +25,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgActivateTunnel{}, "tunnel/MsgActivateTunnel")
 	legacy.RegisterAminoMsg(cdc, &MsgDeactivateTunnel{}, "tunnel/MsgDeactivateTunnel")
 	legacy.RegisterAminoMsg(cdc, &MsgTriggerTunnel{}, "tunnel/MsgTriggerTunnel")
+	legacy.RegisterAminoMsg(cdc, &MsgRetryPacket{}, "tunnel/MsgRetryPacket")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferTunnelOwnership{}, "tunnel/MsgTransferTunnelOwnership")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptTunnelOwnership{}, "tunnel/MsgAcceptTunnelOwnership")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateTunnelAdmins{}, "tunnel/MsgUpdateTunnelAdmins")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveFundingSource{}, "tunnel/MsgApproveFundingSource")
+	legacy.RegisterAminoMsg(cdc, &MsgSetFundingPolicy{}, "tunnel/MsgSetFundingPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveFundingPolicy{}, "tunnel/MsgRemoveFundingPolicy")
 	legacy.RegisterAminoMsg(cdc, &MsgDepositToTunnel{}, "tunnel/MsgDepositToTunnel")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFromTunnel{}, "tunnel/MsgWithdrawFromTunnel")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "tunnel/MsgUpdateParams")
@@ -34,12 +41,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&IBCRoute{}, "tunnel/IBCRoute", nil)
 	cdc.RegisterConcrete(&IBCHookRoute{}, "tunnel/IBCHookRoute", nil)
 	cdc.RegisterConcrete(&RouterRoute{}, "tunnel/RouterRoute", nil)
+	cdc.RegisterConcrete(&IBCABIRoute{}, "tunnel/IBCABIRoute", nil)
 
 	cdc.RegisterInterface((*PacketReceiptI)(nil), nil)
 	cdc.RegisterConcrete(&TSSPacketReceipt{}, "tunnel/TSSPacketReceipt", nil)
 	cdc.RegisterConcrete(&IBCPacketReceipt{}, "tunnel/IBCPacketReceipt", nil)
 	cdc.RegisterConcrete(&IBCHookPacketReceipt{}, "tunnel/IBCHookPacketReceipt", nil)
 	cdc.RegisterConcrete(&RouterPacketReceipt{}, "tunnel/RouterPacketReceipt", nil)
+	cdc.RegisterConcrete(&IBCABIPacketReceipt{}, "tunnel/IBCABIPacketReceipt", nil)
 
 	cdc.RegisterConcrete(Params{}, "tunnel/Params", nil)
 }
@@ -55,6 +64,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgActivateTunnel{},
 		&MsgDeactivateTunnel{},
 		&MsgTriggerTunnel{},
+		&MsgRetryPacket{},
+		&MsgTransferTunnelOwnership{},
+		&MsgAcceptTunnelOwnership{},
+		&MsgUpdateTunnelAdmins{},
+		&MsgApproveFundingSource{},
+		&MsgSetFundingPolicy{},
+		&MsgRemoveFundingPolicy{},
 		&MsgDepositToTunnel{},
 		&MsgWithdrawFromTunnel{},
 		&MsgUpdateParams{},
@@ -67,6 +83,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&IBCRoute{},
 		&IBCHookRoute{},
 		&RouterRoute{},
+		&IBCABIRoute{},
 	)
 
 	registry.RegisterInterface(
@@ -76,6 +93,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&IBCPacketReceipt{},
 		&IBCHookPacketReceipt{},
 		&RouterPacketReceipt{},
+		&IBCABIPacketReceipt{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,353 @@
+package types
+
+import (
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+const (
+	// MaxDerivedSignalExpressionLength is the maximum length of a derived signal expression.
+	MaxDerivedSignalExpressionLength = 256
+
+	// MaxTWAPWindow is the maximum TWAP window of a derived signal in seconds (7 days).
+	MaxTWAPWindow = 7 * 24 * 60 * 60
+
+	// pricePrecision is the precision of the feed prices, i.e. a price of 1 is represented as 10^9.
+	pricePrecision = 1_000_000_000
+)
+
+// maxDerivedValue bounds the intermediate values of a derived signal expression so that
+// the decimal arithmetic can never overflow.
+var maxDerivedValue = sdkmath.LegacyNewDec(10).Power(30)
+
+// NewDerivedSignal creates a new DerivedSignal instance.
+func NewDerivedSignal(
+	signalID string,
+	expression string,
+	softDeviationBPS uint64,
+	hardDeviationBPS uint64,
+) DerivedSignal {
+	return DerivedSignal{
+		SignalID:         signalID,
+		Expression:       expression,
+		SoftDeviationBPS: softDeviationBPS,
+		HardDeviationBPS: hardDeviationBPS,
+	}
+}
+
+// ToSignalDeviation returns the signal deviation of the derived signal.
+func (d DerivedSignal) ToSignalDeviation() SignalDeviation {
+	return NewSignalDeviation(d.SignalID, d.SoftDeviationBPS, d.HardDeviationBPS)
+}
+
+// Validate validates the signal ID, the TWAP window and the expression of the derived signal.
+func (d DerivedSignal) Validate() error {
+	if d.SignalID == "" {
+		return ErrInvalidDerivedSignal.Wrap("signal id cannot be empty")
+	}
+
+	if uint64(len(d.SignalID)) > feedstypes.MaxSignalIDCharacters {
+		return ErrInvalidDerivedSignal.Wrapf(
+			"signal id %s exceeds %d characters",
+			d.SignalID,
+			feedstypes.MaxSignalIDCharacters,
+		)
+	}
+
+	if d.TWAPWindow > MaxTWAPWindow {
+		return ErrInvalidDerivedSignal.Wrapf("twap window %d exceeds %d seconds", d.TWAPWindow, MaxTWAPWindow)
+	}
+
+	_, err := ParseDerivedExpression(d.Expression)
+	return err
+}
+
+// GetSourceSignalIDs returns the feed signal IDs that the derived signal is calculated from.
+func (d DerivedSignal) GetSourceSignalIDs() ([]string, error) {
+	expr, err := ParseDerivedExpression(d.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return expr.SignalIDs(), nil
+}
+
+// CalculatePrice calculates the price of the derived signal from the given feed prices.
+// If any of the source prices is not available or the expression cannot be evaluated,
+// the derived price is not available. The timestamp of the derived price is the oldest
+// timestamp of the source prices.
+func (d DerivedSignal) CalculatePrice(
+	feedsPricesMap map[string]feedstypes.Price,
+	timestamp int64,
+) feedstypes.Price {
+	expr, err := ParseDerivedExpression(d.Expression)
+	if err != nil {
+		return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, d.SignalID, 0, timestamp)
+	}
+
+	priceTimestamp := timestamp
+	for _, signalID := range expr.SignalIDs() {
+		p, ok := feedsPricesMap[signalID]
+		if !ok {
+			return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, d.SignalID, 0, timestamp)
+		}
+
+		if p.Status != feedstypes.PRICE_STATUS_AVAILABLE {
+			return feedstypes.NewPrice(p.Status, d.SignalID, 0, timestamp)
+		}
+
+		if p.Timestamp < priceTimestamp {
+			priceTimestamp = p.Timestamp
+		}
+	}
+
+	value, ok := expr.evaluate(feedsPricesMap)
+	if !ok || value.IsNegative() {
+		return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, d.SignalID, 0, timestamp)
+	}
+
+	price := value.MulInt64(pricePrecision).TruncateInt()
+	if !price.IsUint64() {
+		return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, d.SignalID, 0, timestamp)
+	}
+
+	return feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, d.SignalID, price.Uint64(), priceTimestamp)
+}
+
+// CombineSignalDeviations returns the signal deviations followed by the signal deviations of
+// the derived signals.
+func CombineSignalDeviations(
+	signalDeviations []SignalDeviation,
+	derivedSignals []DerivedSignal,
+) []SignalDeviation {
+	combined := make([]SignalDeviation, 0, len(signalDeviations)+len(derivedSignals))
+	combined = append(combined, signalDeviations...)
+	for _, d := range derivedSignals {
+		combined = append(combined, d.ToSignalDeviation())
+	}
+
+	return combined
+}
+
+// ValidateDerivedSignals validates the given derived signals.
+func ValidateDerivedSignals(derivedSignals []DerivedSignal) error {
+	for _, d := range derivedSignals {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DerivedExpression is a parsed derived signal expression. An expression consists of feed signal IDs,
+// decimal numbers, the operators `+`, `-`, `*`, `/` and parentheses. The `-` operator must be separated
+// from signal IDs by spaces because signal IDs may contain `-`, e.g. "CS:ETH-USD - CS:BTC-USD".
+// Signal IDs are evaluated as the real value of the feed prices.
+type DerivedExpression struct {
+	op       byte
+	value    sdkmath.LegacyDec
+	signalID string
+	left     *DerivedExpression
+	right    *DerivedExpression
+}
+
+// ParseDerivedExpression parses a derived signal expression.
+func ParseDerivedExpression(expr string) (*DerivedExpression, error) {
+	if len(expr) > MaxDerivedSignalExpressionLength {
+		return nil, ErrInvalidDerivedSignal.Wrapf(
+			"expression exceeds %d characters",
+			MaxDerivedSignalExpressionLength,
+		)
+	}
+
+	p := &expressionParser{tokens: tokenizeExpression(expr)}
+	if len(p.tokens) == 0 {
+		return nil, ErrInvalidDerivedSignal.Wrap("expression cannot be empty")
+	}
+
+	e, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos != len(p.tokens) {
+		return nil, ErrInvalidDerivedSignal.Wrapf("unexpected token %s in expression %s", p.tokens[p.pos], expr)
+	}
+
+	return e, nil
+}
+
+// SignalIDs returns the unique signal IDs in the expression in order of appearance.
+func (e *DerivedExpression) SignalIDs() []string {
+	seen := make(map[string]bool)
+	var signalIDs []string
+
+	var walk func(*DerivedExpression)
+	walk = func(n *DerivedExpression) {
+		if n == nil {
+			return
+		}
+		if n.signalID != "" && !seen[n.signalID] {
+			seen[n.signalID] = true
+			signalIDs = append(signalIDs, n.signalID)
+		}
+		walk(n.left)
+		walk(n.right)
+	}
+	walk(e)
+
+	return signalIDs
+}
+
+// evaluate evaluates the expression with the given prices. It returns false if the expression
+// divides by zero or any value exceeds the bound.
+func (e *DerivedExpression) evaluate(pricesMap map[string]feedstypes.Price) (sdkmath.LegacyDec, bool) {
+	switch {
+	case e.signalID != "":
+		p := pricesMap[e.signalID]
+		return sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(p.Price)).QuoInt64(pricePrecision), true
+	case e.op == 0:
+		return e.value, true
+	}
+
+	left, ok := e.left.evaluate(pricesMap)
+	if !ok {
+		return sdkmath.LegacyDec{}, false
+	}
+	right, ok := e.right.evaluate(pricesMap)
+	if !ok {
+		return sdkmath.LegacyDec{}, false
+	}
+
+	var result sdkmath.LegacyDec
+	switch e.op {
+	case '+':
+		result = left.Add(right)
+	case '-':
+		result = left.Sub(right)
+	case '*':
+		result = left.Mul(right)
+	case '/':
+		if right.IsZero() {
+			return sdkmath.LegacyDec{}, false
+		}
+		result = left.Quo(right)
+	}
+
+	if result.Abs().GT(maxDerivedValue) {
+		return sdkmath.LegacyDec{}, false
+	}
+
+	return result, true
+}
+
+// tokenizeExpression splits the expression into operators, parentheses and operands.
+func tokenizeExpression(expr string) []string {
+	var tokens []string
+	for i := 0; i < len(expr); {
+		switch c := expr[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case strings.IndexByte("+-*/()", c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		default:
+			j := i
+			for j < len(expr) && strings.IndexByte(" \t+*/()", expr[j]) < 0 {
+				j++
+			}
+			tokens = append(tokens, expr[i:j])
+			i = j
+		}
+	}
+
+	return tokens
+}
+
+// expressionParser is a recursive descent parser of derived signal expressions.
+type expressionParser struct {
+	tokens []string
+	pos    int
+}
+
+// parseSum parses `product (('+' | '-') product)*`.
+func (p *expressionParser) parseSum() (*DerivedExpression, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.pos < len(p.tokens) && (p.tokens[p.pos] == "+" || p.tokens[p.pos] == "-") {
+		op := p.tokens[p.pos][0]
+		p.pos++
+
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = &DerivedExpression{op: op, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseProduct parses `operand (('*' | '/') operand)*`.
+func (p *expressionParser) parseProduct() (*DerivedExpression, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.pos < len(p.tokens) && (p.tokens[p.pos] == "*" || p.tokens[p.pos] == "/") {
+		op := p.tokens[p.pos][0]
+		p.pos++
+
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		left = &DerivedExpression{op: op, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseOperand parses a signal ID, a decimal number or a parenthesized expression.
+func (p *expressionParser) parseOperand() (*DerivedExpression, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, ErrInvalidDerivedSignal.Wrap("unexpected end of expression")
+	}
+
+	token := p.tokens[p.pos]
+	p.pos++
+
+	switch {
+	case token == "(":
+		e, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos >= len(p.tokens) || p.tokens[p.pos] != ")" {
+			return nil, ErrInvalidDerivedSignal.Wrap("missing closing parenthesis")
+		}
+		p.pos++
+		return e, nil
+	case len(token) == 1 && strings.Contains("+-*/)", token):
+		return nil, ErrInvalidDerivedSignal.Wrapf("unexpected operator %s", token)
+	case token[0] >= '0' && token[0] <= '9':
+		value, err := sdkmath.LegacyNewDecFromStr(token)
+		if err != nil || value.GT(maxDerivedValue) {
+			return nil, ErrInvalidDerivedSignal.Wrapf("invalid number %s", token)
+		}
+		return &DerivedExpression{value: value}, nil
+	default:
+		if uint64(len(token)) > feedstypes.MaxSignalIDCharacters {
+			return nil, ErrInvalidDerivedSignal.Wrapf("invalid signal id %s", token)
+		}
+		return &DerivedExpression{signalID: token}, nil
+	}
+}
@@ -0,0 +1,145 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestParseDerivedExpression(t *testing.T) {
+	testCases := []struct {
+		name         string
+		expr         string
+		expSignalIDs []string
+		expErr       bool
+	}{
+		{
+			name:         "ratio",
+			expr:         "CS:ETH-USD / CS:BTC-USD",
+			expSignalIDs: []string{"CS:ETH-USD", "CS:BTC-USD"},
+		},
+		{
+			name:         "weighted basket",
+			expr:         "0.5 * CS:BTC-USD + 0.3*CS:ETH-USD + 0.2 * (CS:BAND-USD - 1)",
+			expSignalIDs: []string{"CS:BTC-USD", "CS:ETH-USD", "CS:BAND-USD"},
+		},
+		{
+			name:         "duplicate signal ids",
+			expr:         "CS:ETH-USD * CS:ETH-USD",
+			expSignalIDs: []string{"CS:ETH-USD"},
+		},
+		{name: "empty", expr: " ", expErr: true},
+		{name: "dangling operator", expr: "CS:ETH-USD *", expErr: true},
+		{name: "leading operator", expr: "- CS:ETH-USD", expErr: true},
+		{name: "missing closing parenthesis", expr: "(CS:ETH-USD + 1", expErr: true},
+		{name: "extra closing parenthesis", expr: "CS:ETH-USD + 1)", expErr: true},
+		{name: "invalid number", expr: "1.2.3 * CS:ETH-USD", expErr: true},
+		{name: "signal id too long", expr: "CS:ABCDEFGHIJKLMNOPQRSTUVWXYZ-USDT", expErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := types.ParseDerivedExpression(tc.expr)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidDerivedSignal)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expSignalIDs, expr.SignalIDs())
+		})
+	}
+}
+
+func TestDerivedSignal_Validate(t *testing.T) {
+	require.NoError(t, types.NewDerivedSignal("DS:ETH-BTC", "CS:ETH-USD / CS:BTC-USD", 100, 100).Validate())
+	require.ErrorIs(
+		t,
+		types.NewDerivedSignal("", "CS:ETH-USD / CS:BTC-USD", 100, 100).Validate(),
+		types.ErrInvalidDerivedSignal,
+	)
+	require.ErrorIs(
+		t,
+		types.NewDerivedSignal("DS:ABCDEFGHIJKLMNOPQRSTUVWXYZ-USDT", "CS:ETH-USD", 100, 100).Validate(),
+		types.ErrInvalidDerivedSignal,
+	)
+	require.ErrorIs(
+		t,
+		types.NewDerivedSignal("DS:ETH-BTC", "CS:ETH-USD /", 100, 100).Validate(),
+		types.ErrInvalidDerivedSignal,
+	)
+
+	twapSignal := types.NewDerivedSignal("DS:BTC-TWAP", "CS:BTC-USD", 100, 100)
+	twapSignal.TWAPWindow = types.MaxTWAPWindow
+	require.NoError(t, twapSignal.Validate())
+	twapSignal.TWAPWindow = types.MaxTWAPWindow + 1
+	require.ErrorIs(t, twapSignal.Validate(), types.ErrInvalidDerivedSignal)
+	twapSignal.TWAPWindow = 1 << 63
+	require.ErrorIs(t, twapSignal.Validate(), types.ErrInvalidDerivedSignal)
+}
+
+func TestDerivedSignal_CalculatePrice(t *testing.T) {
+	pricesMap := map[string]feedstypes.Price{
+		"CS:ETH-USD":  feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 3000000000000, 100),
+		"CS:BTC-USD":  feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BTC-USD", 60000000000000, 90),
+		"CS:ZERO-USD": feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ZERO-USD", 0, 100),
+		"CS:BAND-USD": feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "CS:BAND-USD", 0, 100),
+	}
+
+	testCases := []struct {
+		name       string
+		expression string
+		expPrice   feedstypes.Price
+	}{
+		{
+			name:       "ratio",
+			expression: "CS:ETH-USD / CS:BTC-USD",
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DS:TEST", 50000000, 90),
+		},
+		{
+			name:       "weighted basket",
+			expression: "0.5 * CS:BTC-USD + 0.5 * CS:ETH-USD",
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DS:TEST", 31500000000000, 90),
+		},
+		{
+			name:       "operator precedence",
+			expression: "CS:ETH-USD - 1000 * 2",
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DS:TEST", 1000000000000, 100),
+		},
+		{
+			name:       "source price not available",
+			expression: "CS:ETH-USD / CS:BAND-USD",
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "DS:TEST", 0, 200),
+		},
+		{
+			name:       "source price not in current feeds",
+			expression: "CS:ETH-USD / CS:DOGE-USD",
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, "DS:TEST", 0, 200),
+		},
+		{
+			name:       "division by zero",
+			expression: "CS:ETH-USD / CS:ZERO-USD",
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "DS:TEST", 0, 200),
+		},
+		{
+			name:       "negative price",
+			expression: "CS:ETH-USD - CS:BTC-USD",
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "DS:TEST", 0, 200),
+		},
+		{
+			name:       "overflow",
+			expression: "CS:BTC-USD * CS:BTC-USD * CS:BTC-USD * CS:BTC-USD",
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "DS:TEST", 0, 200),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := types.NewDerivedSignal("DS:TEST", tc.expression, 100, 100)
+			require.Equal(t, tc.expPrice, d.CalculatePrice(pricesMap, 200))
+		})
+	}
+}
@@ -86,3 +86,32 @@ func EncodeTSS(
 		return nil, ErrInvalidEncoder.Wrapf("invalid encoder mode: %s", encoder.String())
 	}
 }
+
+// DecodeTSS decodes a tss message encoded by EncodeTSS and returns the packet and its encoder.
+func DecodeTSS(bz []byte) (TSSPacket, feedstypes.Encoder, error) {
+	if len(bz) < len(feedstypes.EncoderFixedPointABIPrefix) {
+		return TSSPacket{}, feedstypes.ENCODER_UNSPECIFIED, ErrInvalidEncoder.Wrap("message too short")
+	}
+
+	var encoder feedstypes.Encoder
+	switch string(bz[:len(feedstypes.EncoderFixedPointABIPrefix)]) {
+	case feedstypes.EncoderFixedPointABIPrefix:
+		encoder = feedstypes.ENCODER_FIXED_POINT_ABI
+	case feedstypes.EncoderTickABIPrefix:
+		encoder = feedstypes.ENCODER_TICK_ABI
+	default:
+		return TSSPacket{}, feedstypes.ENCODER_UNSPECIFIED, ErrInvalidEncoder.Wrap("unknown encoder prefix")
+	}
+
+	values, err := tssPacketArgs.Unpack(bz[len(feedstypes.EncoderFixedPointABIPrefix):])
+	if err != nil {
+		return TSSPacket{}, feedstypes.ENCODER_UNSPECIFIED, err
+	}
+
+	tssPacket, ok := abi.ConvertType(values[0], new(TSSPacket)).(*TSSPacket)
+	if !ok {
+		return TSSPacket{}, feedstypes.ENCODER_UNSPECIFIED, ErrInvalidEncoder.Wrap("invalid message layout")
+	}
+
+	return *tssPacket, encoder, nil
+}
@@ -55,3 +55,30 @@ func TestEncodeTSSTick(t *testing.T) {
 
 	require.Equal(t, expectedMsg, hex.EncodeToString(msg))
 }
+
+func TestDecodeTSS(t *testing.T) {
+	prices := []feedstypes.Price{
+		{SignalID: "CS:BAND-USD", Price: 2, Status: feedstypes.PRICE_STATUS_AVAILABLE},
+	}
+	relayPrices, err := feedstypes.ToRelayPrices(prices)
+	require.NoError(t, err)
+
+	for _, encoder := range []feedstypes.Encoder{feedstypes.ENCODER_FIXED_POINT_ABI, feedstypes.ENCODER_TICK_ABI} {
+		msg, err := types.EncodeTSS(3, prices, 123, encoder)
+		require.NoError(t, err)
+
+		packet, decodedEncoder, err := types.DecodeTSS(msg)
+		require.NoError(t, err)
+		require.Equal(t, encoder, decodedEncoder)
+		require.Equal(t, uint64(3), packet.Sequence)
+		require.Equal(t, int64(123), packet.CreatedAt)
+		require.Len(t, packet.RelayPrices, 1)
+		require.Equal(t, relayPrices[0].SignalID, packet.RelayPrices[0].SignalID)
+	}
+
+	_, _, err = types.DecodeTSS([]byte("invalid message"))
+	require.ErrorIs(t, err, types.ErrInvalidEncoder)
+
+	_, _, err = types.DecodeTSS([]byte(feedstypes.EncoderFixedPointABIPrefix + "invalid"))
+	require.Error(t, err)
+}
@@ -31,4 +31,15 @@ var (
 	ErrSendPacketPanic           = errorsmod.Register(ModuleName, 24, "panic in sending packet")
 	ErrInvalidChannelID          = errorsmod.Register(ModuleName, 25, "invalid channel id")
 	ErrInvalidPortID             = errorsmod.Register(ModuleName, 26, "invalid port id")
+	ErrFailedPacketNotFound      = errorsmod.Register(ModuleName, 27, "failed packet not found")
+	ErrSendPacketFailed          = errorsmod.Register(ModuleName, 28, "failed to send packet")
+	ErrInvalidRetrySender        = errorsmod.Register(ModuleName, 29, "invalid sender to retry packet")
+	ErrInvalidSchedule           = errorsmod.Register(ModuleName, 30, "invalid schedule")
+	ErrInvalidDerivedSignal      = errorsmod.Register(ModuleName, 31, "invalid derived signal")
+	ErrInvalidTunnelAdmin        = errorsmod.Register(ModuleName, 32, "invalid tunnel admin")
+	ErrInvalidFundingPolicy      = errorsmod.Register(ModuleName, 33, "invalid funding policy")
+	ErrFundingPolicyNotFound     = errorsmod.Register(ModuleName, 34, "funding policy not found")
+	ErrInvalidFundingSender      = errorsmod.Register(ModuleName, 35, "invalid sender of funding policy")
+	ErrPacketPruned              = errorsmod.Register(ModuleName, 36, "packet pruned")
+	ErrInvalidPendingCreator     = errorsmod.Register(ModuleName, 37, "invalid pending creator")
 )
@@ -10,13 +10,31 @@ const (
 	EventTypeTriggerTunnel            = "trigger_tunnel"
 	EventTypeProducePacketFail        = "produce_packet_fail"
 	EventTypeProducePacketSuccess     = "produce_packet_success"
+	EventTypeSendPacketFail           = "send_packet_fail"
+	EventTypeRetryPacketSuccess       = "retry_packet_success"
+	EventTypeAcknowledgePacket        = "acknowledge_packet"
+	EventTypeTimeoutPacket            = "timeout_packet"
+	EventTypeUpdateTSSPacketStatus    = "update_tss_packet_status"
+	EventTypePrunePackets             = "prune_packets"
+	EventTypeProposeTunnelOwnership   = "propose_tunnel_ownership"
+	EventTypeTransferTunnelOwnership  = "transfer_tunnel_ownership"
+	EventTypeUpdateTunnelAdmins       = "update_tunnel_admins"
+	EventTypeApproveFundingSource     = "approve_funding_source"
+	EventTypeSetFundingPolicy         = "set_funding_policy"
+	EventTypeRemoveFundingPolicy      = "remove_funding_policy"
+	EventTypeTopUpFeePayer            = "top_up_fee_payer"
+	EventTypeTopUpFeePayerFail        = "top_up_fee_payer_fail"
 	EventTypeDepositToTunnel          = "deposit_to_tunnel"
 	EventTypeWithdrawFromTunnel       = "withdraw_from_tunnel"
+	EventTypeReceiveOracleResult      = "receive_oracle_result"
+	EventTypeUpdatePacketStatusFail   = "update_packet_status_fail"
 
 	AttributeKeyParams           = "params"
 	AttributeKeyTunnelID         = "tunnel_id"
 	AttributeKeySequence         = "sequence"
+	AttributeKeyPrunedSequence   = "pruned_sequence"
 	AttributeKeyInterval         = "interval"
+	AttributeKeySchedule         = "schedule"
 	AttributeKeyRoute            = "route"
 	AttributeKeyEncoder          = "encoder"
 	AttributeKeyInitialDeposit   = "initial_deposit"
@@ -24,11 +42,30 @@ const (
 	AttributeKeySignalID         = "signal_id"
 	AttributeKeySoftDeviationBPS = "soft_deviation_bps"
 	AttributeKeyHardDeviationBPS = "hard_deviation_bps"
+	AttributeKeyDerivedSignalID  = "derived_signal_id"
+	AttributeKeyExpression       = "expression"
 	AttributeKeyIsActive         = "is_active"
 	AttributeKeyCreatedAt        = "created_at"
 	AttributeKeyCreator          = "creator"
+	AttributeKeyPreviousCreator  = "previous_creator"
+	AttributeKeyPendingCreator   = "pending_creator"
+	AttributeKeyAdmin            = "admin"
+	AttributeKeyPermissions      = "permissions"
+	AttributeKeySource           = "source"
+	AttributeKeyAllowance        = "allowance"
+	AttributeKeyThreshold        = "threshold"
+	AttributeKeyTopUpAmount      = "top_up_amount"
 	AttributeKeyDepositor        = "depositor"
 	AttributeKeyWithdrawer       = "withdrawer"
 	AttributeKeyAmount           = "amount"
 	AttributeKeyReason           = "reason"
+	AttributeKeyAttempts         = "attempts"
+	AttributeKeySender           = "sender"
+	AttributeKeyStatus           = "status"
+	AttributeKeyRefund           = "refund"
+	AttributeKeyRequestID        = "request_id"
+	AttributeKeyResult           = "result"
+	AttributeKeyPortID           = "port_id"
+	AttributeKeyChannelID        = "channel_id"
+	AttributeKeyIBCSequence      = "ibc_sequence"
 )
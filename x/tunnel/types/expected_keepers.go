@@ -79,6 +79,8 @@ type TransferKeeper interface {
 type FeedsKeeper interface {
 	GetAllPrices(ctx sdk.Context) (prices []feedstypes.Price)
 	GetPrices(ctx sdk.Context, signalIDs []string) (prices []feedstypes.Price)
+	GetTWAPPrice(ctx sdk.Context, signalID string, window int64) feedstypes.Price
+	GetPriceHistory(ctx sdk.Context, signalID string) (prices []feedstypes.Price)
 }
 
 type BandtssKeeper interface {
@@ -92,4 +94,5 @@ type BandtssKeeper interface {
 		feeLimit sdk.Coins,
 	) (bandtsstypes.SigningID, error)
 	GetSigningFee(ctx sdk.Context) (sdk.Coins, error)
+	GetSigningStatus(ctx sdk.Context, id bandtsstypes.SigningID) (tsstypes.SigningStatus, error)
 }
@@ -0,0 +1,18 @@
+package types
+
+// NewFailedPacket creates a new FailedPacket instance.
+func NewFailedPacket(
+	tunnelID uint64,
+	sequence uint64,
+	reason string,
+	attempts uint64,
+	lastAttemptAt int64,
+) FailedPacket {
+	return FailedPacket{
+		TunnelID:      tunnelID,
+		Sequence:      sequence,
+		Reason:        reason,
+		Attempts:      attempts,
+		LastAttemptAt: lastAttemptAt,
+	}
+}
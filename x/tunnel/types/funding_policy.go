@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewFundingPolicy creates a new FundingPolicy instance.
+func NewFundingPolicy(
+	source string,
+	allowance sdk.Coins,
+	threshold sdk.Coins,
+	topUpAmount sdk.Coins,
+) FundingPolicy {
+	return FundingPolicy{
+		Source:      source,
+		Allowance:   allowance,
+		Threshold:   threshold,
+		TopUpAmount: topUpAmount,
+	}
+}
+
+// Validate validates the funding policy.
+func (p FundingPolicy) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Source); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid source address: %s", err)
+	}
+
+	if !p.Allowance.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid allowance: %s", p.Allowance)
+	}
+
+	if p.Threshold.Empty() || !p.Threshold.IsValid() {
+		return ErrInvalidFundingPolicy.Wrapf("invalid threshold: %s", p.Threshold)
+	}
+
+	if p.TopUpAmount.Empty() || !p.TopUpAmount.IsValid() {
+		return ErrInvalidFundingPolicy.Wrapf("invalid top-up amount: %s", p.TopUpAmount)
+	}
+
+	return nil
+}
+
+// IsBelowThreshold returns true if the balance of any denom of the threshold is below the threshold.
+func (p FundingPolicy) IsBelowThreshold(balance sdk.Coins) bool {
+	for _, coin := range p.Threshold {
+		if balance.AmountOf(coin.Denom).LT(coin.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTopUpCoins returns the coins to send on the next top-up, which is the top-up amount
+// capped by the remaining allowance.
+func (p FundingPolicy) GetTopUpCoins() sdk.Coins {
+	return p.TopUpAmount.Min(p.Allowance)
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestFundingPolicy_Validate(t *testing.T) {
+	source := sdk.AccAddress("source").String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+
+	tests := []struct {
+		name   string
+		policy types.FundingPolicy
+		expErr bool
+	}{
+		{
+			name:   "valid policy",
+			policy: types.NewFundingPolicy(source, coins, coins, coins),
+			expErr: false,
+		},
+		{
+			name:   "invalid source",
+			policy: types.NewFundingPolicy("invalid", coins, coins, coins),
+			expErr: true,
+		},
+		{
+			name:   "invalid allowance",
+			policy: types.NewFundingPolicy(source, sdk.Coins{{Denom: "uband", Amount: sdkmath.NewInt(-1)}}, coins, coins),
+			expErr: true,
+		},
+		{
+			name:   "empty threshold",
+			policy: types.NewFundingPolicy(source, coins, sdk.NewCoins(), coins),
+			expErr: true,
+		},
+		{
+			name:   "empty top-up amount",
+			policy: types.NewFundingPolicy(source, coins, coins, sdk.NewCoins()),
+			expErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFundingPolicy_IsBelowThreshold(t *testing.T) {
+	policy := types.NewFundingPolicy(
+		sdk.AccAddress("source").String(),
+		nil,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 100), sdk.NewInt64Coin("uatom", 10)),
+		nil,
+	)
+
+	require.False(t, policy.IsBelowThreshold(sdk.NewCoins(sdk.NewInt64Coin("uband", 100), sdk.NewInt64Coin("uatom", 10))))
+	require.True(t, policy.IsBelowThreshold(sdk.NewCoins(sdk.NewInt64Coin("uband", 99), sdk.NewInt64Coin("uatom", 10))))
+	require.True(t, policy.IsBelowThreshold(sdk.NewCoins(sdk.NewInt64Coin("uband", 100))))
+}
+
+func TestFundingPolicy_GetTopUpCoins(t *testing.T) {
+	policy := types.NewFundingPolicy(
+		sdk.AccAddress("source").String(),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 200)),
+		nil,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 300), sdk.NewInt64Coin("uatom", 10)),
+	)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uband", 200)), policy.GetTopUpCoins())
+
+	policy.Allowance = sdk.NewCoins()
+	require.True(t, policy.GetTopUpCoins().IsZero())
+}
@@ -24,6 +24,23 @@ func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(DefaultParams(), 0, []Tunnel{}, TotalFees{})
 }
 
+// NewIBCPacketRef creates a new IBCPacketRef instance
+func NewIBCPacketRef(
+	portID string,
+	channelID string,
+	ibcSequence uint64,
+	tunnelID uint64,
+	sequence uint64,
+) IBCPacketRef {
+	return IBCPacketRef{
+		PortID:      portID,
+		ChannelID:   channelID,
+		IBCSequence: ibcSequence,
+		TunnelID:    tunnelID,
+		Sequence:    sequence,
+	}
+}
+
 // ValidateGenesis validates the provided genesis state.
 func ValidateGenesis(data GenesisState) error {
 	// validate the tunnel count
@@ -71,6 +88,20 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	// validate the IBC packet references
+	ibcPacketRefs := make(map[string]bool)
+	for _, ref := range data.IBCPacketRefs {
+		if _, ok := tunnelIDs[ref.TunnelID]; !ok {
+			return ErrInvalidGenesis.Wrapf("IBC packet reference has non-existent tunnel id: %d", ref.TunnelID)
+		}
+
+		key := string(IBCPacketStoreKey(ref.PortID, ref.ChannelID, ref.IBCSequence))
+		if _, ok := ibcPacketRefs[key]; ok {
+			return ErrInvalidGenesis.Wrapf("duplicate IBC packet reference: %v", ref)
+		}
+		ibcPacketRefs[key] = true
+	}
+
 	// validate the total fees
 	if err := data.TotalFees.Validate(); err != nil {
 		return ErrInvalidGenesis.Wrapf("invalid total fees: %s", err.Error())
@@ -35,6 +35,8 @@ type GenesisState struct {
 	Deposits []Deposit `protobuf:"bytes,4,rep,name=deposits,proto3" json:"deposits"`
 	// total_fees is the type for the total fees collected by the tunnel
 	TotalFees TotalFees `protobuf:"bytes,5,opt,name=total_fees,json=totalFees,proto3" json:"total_fees"`
+	// ibc_packet_refs is the list of references from in-flight IBC packets to the tunnel packets they deliver.
+	IBCPacketRefs []IBCPacketRef `protobuf:"bytes,6,rep,name=ibc_packet_refs,json=ibcPacketRefs,proto3" json:"ibc_packet_refs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -105,34 +107,135 @@ func (m *GenesisState) GetTotalFees() TotalFees {
 	return TotalFees{}
 }
 
+func (m *GenesisState) GetIBCPacketRefs() []IBCPacketRef {
+	if m != nil {
+		return m.IBCPacketRefs
+	}
+	return nil
+}
+
+// IBCPacketRef is the reference from an IBC packet to the tunnel packet that it delivers.
+type IBCPacketRef struct {
+	// port_id is the source port of the IBC packet.
+	PortID string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the source channel of the IBC packet.
+	ChannelID string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// ibc_sequence is the sequence of the IBC packet.
+	IBCSequence uint64 `protobuf:"varint,3,opt,name=ibc_sequence,json=ibcSequence,proto3" json:"ibc_sequence,omitempty"`
+	// tunnel_id is the tunnel ID of the tunnel packet.
+	TunnelID uint64 `protobuf:"varint,4,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the tunnel packet.
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *IBCPacketRef) Reset()         { *m = IBCPacketRef{} }
+func (m *IBCPacketRef) String() string { return proto.CompactTextString(m) }
+func (*IBCPacketRef) ProtoMessage()    {}
+func (*IBCPacketRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e920a50d5f95d889, []int{1}
+}
+func (m *IBCPacketRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCPacketRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCPacketRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCPacketRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCPacketRef.Merge(m, src)
+}
+func (m *IBCPacketRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCPacketRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCPacketRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCPacketRef proto.InternalMessageInfo
+
+func (m *IBCPacketRef) GetPortID() string {
+	if m != nil {
+		return m.PortID
+	}
+	return ""
+}
+
+func (m *IBCPacketRef) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *IBCPacketRef) GetIBCSequence() uint64 {
+	if m != nil {
+		return m.IBCSequence
+	}
+	return 0
+}
+
+func (m *IBCPacketRef) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *IBCPacketRef) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "band.tunnel.v1beta1.GenesisState")
+	proto.RegisterType((*IBCPacketRef)(nil), "band.tunnel.v1beta1.IBCPacketRef")
 }
 
 func init() { proto.RegisterFile("band/tunnel/v1beta1/genesis.proto", fileDescriptor_e920a50d5f95d889) }
 
 var fileDescriptor_e920a50d5f95d889 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0xb6, 0x14, 0x70, 0x3b, 0x19, 0x86, 0xa8, 0x20, 0x93, 0x32, 0x75, 0xb2, 0x55,
-	0x3a, 0x21, 0x24, 0x06, 0x8a, 0x40, 0x6c, 0xa8, 0x30, 0xb1, 0x54, 0x4e, 0x6a, 0xd2, 0x48, 0xad,
-	0x1d, 0xd5, 0xd7, 0x0a, 0xde, 0x82, 0x27, 0xe1, 0x39, 0x3a, 0x76, 0x64, 0x42, 0xa8, 0x79, 0x11,
-	0x14, 0x3b, 0xe9, 0x14, 0xd8, 0x72, 0x97, 0xef, 0xff, 0x7c, 0x67, 0xe3, 0x6e, 0x28, 0xd4, 0x84,
-	0xc3, 0x52, 0x29, 0x39, 0xe3, 0xab, 0x7e, 0x28, 0x41, 0xf4, 0x79, 0x2c, 0x95, 0x34, 0x89, 0x61,
-	0xe9, 0x42, 0x83, 0x26, 0x47, 0x39, 0xc2, 0x1c, 0xc2, 0x0a, 0xa4, 0x73, 0x1c, 0xeb, 0x58, 0xdb,
-	0xff, 0x3c, 0xff, 0x72, 0x68, 0x27, 0xa8, 0xb2, 0xa5, 0x62, 0x21, 0xe6, 0xe6, 0x3f, 0xa2, 0x70,
-	0x5b, 0xe2, 0xfc, 0xb3, 0x86, 0xdb, 0xf7, 0x6e, 0x80, 0x27, 0x10, 0x20, 0xc9, 0x25, 0x6e, 0x3a,
-	0x85, 0x8f, 0x02, 0xd4, 0x6b, 0x5d, 0x9c, 0xb0, 0x8a, 0x81, 0xd8, 0xa3, 0x45, 0x6e, 0x1a, 0xeb,
-	0xef, 0x33, 0x6f, 0x54, 0x04, 0x48, 0x17, 0xb7, 0x1d, 0x36, 0x8e, 0xf4, 0x52, 0x81, 0x5f, 0x0b,
-	0x50, 0xaf, 0x31, 0x6a, 0xb9, 0xde, 0x30, 0x6f, 0x91, 0x2b, 0xbc, 0xef, 0x4a, 0xe3, 0xd7, 0x83,
-	0xfa, 0x9f, 0xfa, 0x67, 0x5b, 0x16, 0xfa, 0x32, 0x41, 0xae, 0xf1, 0xc1, 0x44, 0xa6, 0xda, 0x24,
-	0x60, 0xfc, 0x86, 0x4d, 0x9f, 0x56, 0xa6, 0x6f, 0x1d, 0x54, 0xc4, 0x77, 0x19, 0x32, 0xc4, 0x18,
-	0x34, 0x88, 0xd9, 0xf8, 0x55, 0x4a, 0xe3, 0xef, 0xd9, 0xf5, 0x68, 0xf5, 0xf9, 0x39, 0x76, 0x27,
-	0x65, 0xb9, 0xe1, 0x21, 0xec, 0x1a, 0x0f, 0xeb, 0x2d, 0x45, 0x9b, 0x2d, 0x45, 0x3f, 0x5b, 0x8a,
-	0x3e, 0x32, 0xea, 0x6d, 0x32, 0xea, 0x7d, 0x65, 0xd4, 0x7b, 0xe1, 0x71, 0x02, 0xd3, 0x65, 0xc8,
-	0x22, 0x3d, 0xe7, 0xb9, 0xd4, 0x5e, 0x70, 0xa4, 0x67, 0x3c, 0x9a, 0x8a, 0x44, 0xf1, 0xd5, 0x80,
-	0xbf, 0x95, 0x4f, 0x01, 0xef, 0xa9, 0x34, 0x61, 0xd3, 0x12, 0x83, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x47, 0x82, 0xdd, 0x45, 0x16, 0x02, 0x00, 0x00,
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xda, 0x65, 0x8d, 0x9b, 0x69, 0x92, 0x01, 0x29, 0x2a, 0x28, 0x69, 0xc7, 0x65,
+	0x48, 0x28, 0xd1, 0xb6, 0x13, 0x42, 0xe2, 0x90, 0x54, 0xa0, 0xdc, 0xaa, 0x8c, 0x13, 0x97, 0xe0,
+	0x38, 0x6e, 0x6b, 0xd1, 0xc5, 0x21, 0x76, 0x27, 0xf8, 0x16, 0xfb, 0x58, 0x3b, 0xee, 0xc8, 0x01,
+	0x45, 0x28, 0xfd, 0x22, 0xc8, 0x76, 0x52, 0x76, 0x08, 0xdc, 0xf2, 0xfe, 0xfe, 0xfd, 0xff, 0xb1,
+	0xdf, 0x7b, 0x60, 0x9e, 0xa1, 0x22, 0x0f, 0xc4, 0xae, 0x28, 0xc8, 0x36, 0xb8, 0xbd, 0xc8, 0x88,
+	0x40, 0x17, 0xc1, 0x9a, 0x14, 0x84, 0x53, 0xee, 0x97, 0x15, 0x13, 0x0c, 0x3e, 0x95, 0x88, 0xaf,
+	0x11, 0xbf, 0x45, 0xa6, 0xcf, 0xd6, 0x6c, 0xcd, 0xd4, 0x79, 0x20, 0xbf, 0x34, 0x3a, 0x9d, 0xf5,
+	0xa5, 0x95, 0xa8, 0x42, 0x37, 0xfc, 0x7f, 0x44, 0x9b, 0xad, 0x88, 0xb3, 0xbb, 0x21, 0xb0, 0x3f,
+	0xea, 0x0b, 0x5c, 0x0b, 0x24, 0x08, 0x7c, 0x0b, 0x4c, 0x1d, 0xe1, 0x18, 0x33, 0xe3, 0x7c, 0x72,
+	0xf9, 0xc2, 0xef, 0xb9, 0x90, 0xbf, 0x54, 0x48, 0x38, 0xba, 0xaf, 0xbd, 0x41, 0xd2, 0x1a, 0xe0,
+	0x1c, 0xd8, 0x1a, 0x4b, 0x31, 0xdb, 0x15, 0xc2, 0x79, 0x32, 0x33, 0xce, 0x47, 0xc9, 0x44, 0x6b,
+	0x91, 0x94, 0xe0, 0x3b, 0x70, 0xac, 0x4b, 0xee, 0x0c, 0x67, 0xc3, 0x7f, 0xc6, 0x7f, 0x52, 0x65,
+	0x1b, 0xdf, 0x39, 0xe0, 0x7b, 0x30, 0xce, 0x49, 0xc9, 0x38, 0x15, 0xdc, 0x19, 0x29, 0xf7, 0xcb,
+	0x5e, 0xf7, 0x42, 0x43, 0xad, 0xfd, 0xe0, 0x81, 0x11, 0x00, 0x82, 0x09, 0xb4, 0x4d, 0x57, 0x84,
+	0x70, 0xe7, 0x48, 0x3d, 0xcf, 0xed, 0xff, 0xbf, 0xc4, 0x3e, 0x10, 0xd2, 0xbd, 0xd0, 0x12, 0x9d,
+	0x00, 0xbf, 0x80, 0x53, 0x9a, 0xe1, 0xb4, 0x44, 0xf8, 0x2b, 0x11, 0x69, 0x45, 0x56, 0xdc, 0x31,
+	0xd5, 0x5d, 0xe6, 0xbd, 0x49, 0x71, 0x18, 0x2d, 0x15, 0x9a, 0x90, 0x55, 0xf8, 0x5c, 0x86, 0x35,
+	0xb5, 0x77, 0xf2, 0x58, 0xe5, 0xc9, 0x09, 0xcd, 0xf0, 0xdf, 0xf2, 0xec, 0x97, 0x01, 0xec, 0xc7,
+	0x00, 0x7c, 0x05, 0x8e, 0x4b, 0x56, 0x89, 0x94, 0xe6, 0x6a, 0x26, 0x56, 0x08, 0x9a, 0xda, 0x33,
+	0x97, 0xac, 0x12, 0xf1, 0x22, 0x31, 0xe5, 0x51, 0x9c, 0xc3, 0x37, 0x00, 0xe0, 0x0d, 0x52, 0xdd,
+	0xa7, 0xb9, 0x6a, 0xbd, 0x15, 0x9e, 0x34, 0xb5, 0x67, 0x45, 0x5a, 0x8d, 0x17, 0x89, 0xd5, 0x02,
+	0x71, 0x0e, 0x2f, 0x81, 0x2d, 0x5f, 0xc1, 0xc9, 0xb7, 0x1d, 0x29, 0x30, 0x71, 0x86, 0x72, 0x54,
+	0xe1, 0x69, 0x53, 0x7b, 0x93, 0x38, 0x8c, 0xae, 0x5b, 0x39, 0x99, 0xd0, 0x0c, 0x77, 0x05, 0x7c,
+	0x0d, 0xac, 0x76, 0xbc, 0x34, 0x77, 0x46, 0xca, 0x60, 0x37, 0xb5, 0x37, 0xd6, 0xc3, 0x8a, 0x17,
+	0xc9, 0x58, 0x1f, 0xc7, 0x39, 0x9c, 0x82, 0xf1, 0x21, 0xfa, 0x48, 0x6d, 0xc1, 0xa1, 0x0e, 0xe3,
+	0xfb, 0xc6, 0x35, 0x1e, 0x1a, 0xd7, 0xf8, 0xdd, 0xb8, 0xc6, 0xdd, 0xde, 0x1d, 0x3c, 0xec, 0xdd,
+	0xc1, 0xcf, 0xbd, 0x3b, 0xf8, 0x1c, 0xac, 0xa9, 0xd8, 0xec, 0x32, 0x1f, 0xb3, 0x9b, 0x40, 0xf6,
+	0x52, 0x6d, 0x28, 0x66, 0xdb, 0x00, 0x6f, 0x10, 0x2d, 0x82, 0xdb, 0xab, 0xe0, 0x7b, 0xb7, 0xcb,
+	0xe2, 0x47, 0x49, 0x78, 0x66, 0x2a, 0xe2, 0xea, 0xcf, 0x00, 0x73, 0x00, 0x71, 0xbf, 0x57, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -155,6 +258,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IBCPacketRefs) > 0 {
+		for iNdEx := len(m.IBCPacketRefs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IBCPacketRefs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size, err := m.TotalFees.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -211,6 +328,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IBCPacketRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCPacketRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCPacketRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IBCSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IBCSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortID) > 0 {
+		i -= len(m.PortID)
+		copy(dAtA[i:], m.PortID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -247,6 +416,38 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.TotalFees.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.IBCPacketRefs) > 0 {
+		for _, e := range m.IBCPacketRefs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IBCPacketRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.IBCSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.IBCSequence))
+	}
+	if m.TunnelID != 0 {
+		n += 1 + sovGenesis(uint64(m.TunnelID))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
 	return n
 }
 
@@ -438,6 +639,211 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPacketRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPacketRefs = append(m.IBCPacketRefs, IBCPacketRef{})
+			if err := m.IBCPacketRefs[len(m.IBCPacketRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCPacketRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCPacketRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCPacketRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCSequence", wireType)
+			}
+			m.IBCSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IBCSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
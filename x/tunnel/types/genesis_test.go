@@ -50,6 +50,35 @@ func TestValidateGenesis(t *testing.T) {
 			expErr:    true,
 			expErrMsg: "duplicate tunnel ID found",
 		},
+		"IBC packet reference has non-existent tunnel": {
+			genesisState: types.GenesisState{
+				Params:      types.DefaultParams(),
+				TunnelCount: 1,
+				Tunnels: []types.Tunnel{
+					{ID: 1},
+				},
+				IBCPacketRefs: []types.IBCPacketRef{
+					types.NewIBCPacketRef("tunnel.2", "channel-0", 1, 2, 1),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "IBC packet reference has non-existent tunnel id",
+		},
+		"duplicate IBC packet reference": {
+			genesisState: types.GenesisState{
+				Params:      types.DefaultParams(),
+				TunnelCount: 1,
+				Tunnels: []types.Tunnel{
+					{ID: 1},
+				},
+				IBCPacketRefs: []types.IBCPacketRef{
+					types.NewIBCPacketRef("tunnel.1", "channel-0", 1, 1, 1),
+					types.NewIBCPacketRef("tunnel.1", "channel-0", 1, 1, 2),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "duplicate IBC packet reference",
+		},
 		"deposit has non-existent": {
 			genesisState: types.GenesisState{
 				Params:      types.DefaultParams(),
@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
 const (
@@ -12,6 +13,9 @@ const (
 	// Version defines the current version the IBC module supports
 	Version = "tunnel-1"
 
+	// VersionABI defines the version of the channels of the IBC ABI route, whose packets are ABI-encoded
+	VersionABI = "tunnel-abi-1"
+
 	// TunnelAccountsKey is used to store the key for the account
 	TunnelAccountsKey = "tunnel-accounts"
 
@@ -29,6 +33,7 @@ var (
 	// global store keys
 	TunnelCountStoreKey = []byte{0x00}
 	TotalFeeStoreKey    = []byte{0x01}
+	PruneCursorStoreKey = []byte{0x02}
 
 	// store prefixes
 	ActiveTunnelIDStoreKeyPrefix = []byte{0x10}
@@ -36,6 +41,13 @@ var (
 	PacketStoreKeyPrefix         = []byte{0x12}
 	LatestPricesStoreKeyPrefix   = []byte{0x13}
 	DepositStoreKeyPrefix        = []byte{0x14}
+	FailedPacketStoreKeyPrefix   = []byte{0x15}
+	IBCPacketStoreKeyPrefix      = []byte{0x16}
+	PrunedSequenceStoreKeyPrefix = []byte{0x17}
+
+	// index prefixes
+	PacketTimeIndexKeyPrefix   = []byte{0x80}
+	PacketStatusIndexKeyPrefix = []byte{0x81}
 
 	// params store keys
 	ParamsKey = []byte{0x90}
@@ -75,3 +87,76 @@ func DepositsStoreKey(tunnelID uint64) []byte {
 func DepositStoreKey(tunnelID uint64, depositor sdk.AccAddress) []byte {
 	return append(DepositsStoreKey(tunnelID), address.MustLengthPrefix(depositor)...)
 }
+
+// FailedPacketsStoreKey returns the key to retrieve all failed packets of a tunnel from the store.
+func FailedPacketsStoreKey(tunnelID uint64) []byte {
+	return append(FailedPacketStoreKeyPrefix, sdk.Uint64ToBigEndian(tunnelID)...)
+}
+
+// FailedPacketStoreKey returns the key to retrieve a failed packet of a tunnel from the store.
+func FailedPacketStoreKey(tunnelID uint64, sequence uint64) []byte {
+	return append(FailedPacketsStoreKey(tunnelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// IBCPacketStoreKey returns the key to retrieve the tunnel packet of an IBC packet that is
+// sent from the given port and channel with the given IBC sequence.
+func IBCPacketStoreKey(portID string, channelID string, ibcSequence uint64) []byte {
+	key := append(IBCPacketStoreKeyPrefix, address.MustLengthPrefix([]byte(portID))...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(ibcSequence)...)
+}
+
+// SplitIBCPacketStoreKey returns the port ID, channel ID and IBC sequence of an IBC packet store key.
+func SplitIBCPacketStoreKey(key []byte) (portID string, channelID string, ibcSequence uint64) {
+	// the format of key is prefix || portLen || portID || channelLen || channelID || ibcSequence
+	kv.AssertKeyAtLeastLength(key, 2)
+	portLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+portLen)
+	portID = string(key[2 : 2+portLen])
+
+	channelLen := int(key[2+portLen])
+	kv.AssertKeyLength(key, 3+portLen+channelLen+8)
+	channelID = string(key[3+portLen : 3+portLen+channelLen])
+	ibcSequence = sdk.BigEndianToUint64(key[3+portLen+channelLen:])
+
+	return
+}
+
+// PrunedSequenceStoreKey returns the key to retrieve the latest pruned packet sequence of a tunnel.
+func PrunedSequenceStoreKey(tunnelID uint64) []byte {
+	return append(PrunedSequenceStoreKeyPrefix, sdk.Uint64ToBigEndian(tunnelID)...)
+}
+
+// PacketsTimeIndexKey returns the key to iterate over the packets of a tunnel ordered by creation time.
+func PacketsTimeIndexKey(tunnelID uint64) []byte {
+	return append(PacketTimeIndexKeyPrefix, sdk.Uint64ToBigEndian(tunnelID)...)
+}
+
+// PacketTimeIndexKey returns the key of the creation time index of a packet.
+func PacketTimeIndexKey(tunnelID uint64, createdAt int64, sequence uint64) []byte {
+	key := append(PacketsTimeIndexKey(tunnelID), sdk.Uint64ToBigEndian(uint64(createdAt))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// PacketsStatusIndexKey returns the key to iterate over the packets of all tunnels with the given status.
+func PacketsStatusIndexKey(status PacketStatus) []byte {
+	return append(PacketStatusIndexKeyPrefix, byte(status))
+}
+
+// PacketsStatusReceiptTypeIndexKey returns the key to iterate over the packets of all tunnels with
+// the given status and receipt type.
+func PacketsStatusReceiptTypeIndexKey(status PacketStatus, receiptType string) []byte {
+	return append(PacketsStatusIndexKey(status), address.MustLengthPrefix([]byte(receiptType))...)
+}
+
+// PacketStatusIndexKey returns the key of the status index of a packet.
+func PacketStatusIndexKey(status PacketStatus, receiptType string, tunnelID uint64, sequence uint64) []byte {
+	key := append(PacketsStatusReceiptTypeIndexKey(status, receiptType), sdk.Uint64ToBigEndian(tunnelID)...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// SplitPacketIndexKey returns the tunnel ID and the sequence at the end of a packet index key.
+func SplitPacketIndexKey(key []byte) (tunnelID uint64, sequence uint64) {
+	n := len(key)
+	return sdk.BigEndianToUint64(key[n-16 : n-8]), sdk.BigEndianToUint64(key[n-8:])
+}
@@ -47,6 +47,40 @@ func TestDepositStoreKey(t *testing.T) {
 	require.Equal(t, expect, types.DepositStoreKey(1, depositor))
 }
 
+func TestFailedPacketsStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("150000000000000001")
+	require.Equal(t, expect, types.FailedPacketsStoreKey(1))
+}
+
+func TestFailedPacketStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("1500000000000000010000000000000002")
+	require.Equal(t, expect, types.FailedPacketStoreKey(1, 2))
+}
+
+func TestIBCPacketStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("160874756e6e656c2e31096368616e6e656c2d310000000000000002")
+	require.Equal(t, expect, types.IBCPacketStoreKey("tunnel.1", "channel-1", 2))
+
+	portID, channelID, ibcSequence := types.SplitIBCPacketStoreKey(expect)
+	require.Equal(t, "tunnel.1", portID)
+	require.Equal(t, "channel-1", channelID)
+	require.Equal(t, uint64(2), ibcSequence)
+}
+
+func TestPacketTimeIndexKey(t *testing.T) {
+	expect, _ := hex.DecodeString("80000000000000000100000000000000640000000000000002")
+	require.Equal(t, expect, types.PacketTimeIndexKey(1, 100, 2))
+}
+
+func TestPacketStatusIndexKey(t *testing.T) {
+	expect, _ := hex.DecodeString("8105035453530000000000000001" + "0000000000000002")
+	require.Equal(t, expect, types.PacketStatusIndexKey(types.PACKET_STATUS_FAILED, "TSS", 1, 2))
+
+	tunnelID, sequence := types.SplitPacketIndexKey(expect)
+	require.Equal(t, uint64(1), tunnelID)
+	require.Equal(t, uint64(2), sequence)
+}
+
 func TestParamsKey(t *testing.T) {
 	expect, _ := hex.DecodeString("90")
 	require.Equal(t, expect, types.ParamsKey)
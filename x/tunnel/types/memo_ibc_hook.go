@@ -1,9 +1,5 @@
 package types
 
-import (
-	sdk "github.com/cosmos/cosmos-sdk/types"
-)
-
 // NewIBCHookMemo creates a new IBCHookMemo instance.
 func NewIBCHookMemo(
 	contract string,
@@ -23,5 +19,5 @@ func NewIBCHookMemo(
 
 // JSONString returns the JSON string representation of the IBCHookMemo
 func (r IBCHookMemo) JSONString() string {
-	return string(sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&r)))
+	return string(MustMarshalPacketJSON(&r))
 }
@@ -11,9 +11,9 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _, _, _, _ sdk.Msg                       = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgWithdrawFeePayerFunds{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgUpdateParams{}
-	_, _, _, _, _, _, _, _, _    sdk.HasValidateBasic          = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgUpdateParams{}
-	_, _                         types.UnpackInterfacesMessage = &MsgCreateTunnel{}, &MsgUpdateRoute{}
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _ sdk.Msg                       = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgWithdrawFeePayerFunds{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgRetryPacket{}, &MsgTransferTunnelOwnership{}, &MsgAcceptTunnelOwnership{}, &MsgUpdateTunnelAdmins{}, &MsgApproveFundingSource{}, &MsgSetFundingPolicy{}, &MsgRemoveFundingPolicy{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgUpdateParams{}
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _    sdk.HasValidateBasic          = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgRetryPacket{}, &MsgTransferTunnelOwnership{}, &MsgAcceptTunnelOwnership{}, &MsgUpdateTunnelAdmins{}, &MsgApproveFundingSource{}, &MsgSetFundingPolicy{}, &MsgRemoveFundingPolicy{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgUpdateParams{}
+	_, _                                              types.UnpackInterfacesMessage = &MsgCreateTunnel{}, &MsgUpdateRoute{}
 )
 
 // NewMsgCreateTunnel creates a new MsgCreateTunnel instance.
@@ -103,6 +103,18 @@ func NewMsgCreateRouterTunnel(
 	return m, nil
 }
 
+// NewMsgCreateIBCABITunnel creates a new MsgCreateTunnel instance with IBC ABI route type.
+func NewMsgCreateIBCABITunnel(
+	signalDeviations []SignalDeviation,
+	interval uint64,
+	encoder feedstypes.Encoder,
+	deposit sdk.Coins,
+	creator string,
+) (*MsgCreateTunnel, error) {
+	r := NewIBCABIRoute("", encoder)
+	return NewMsgCreateTunnel(signalDeviations, interval, r, deposit, creator)
+}
+
 // GetRouteValue returns the route of the tunnel.
 func (m MsgCreateTunnel) GetRouteValue() (RouteI, error) {
 	r, ok := m.Route.GetCachedValue().(RouteI)
@@ -120,13 +132,18 @@ func (m MsgCreateTunnel) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
 	}
 
-	// signal deviations cannot be empty
-	if len(m.SignalDeviations) == 0 {
+	// signal deviations and derived signals cannot both be empty
+	if len(m.SignalDeviations) == 0 && len(m.DerivedSignals) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrapf("signal deviations cannot be empty")
 	}
 
 	// signal deviations cannot duplicate
-	if err := validateUniqueSignalIDs(m.SignalDeviations); err != nil {
+	if err := validateUniqueSignalIDs(CombineSignalDeviations(m.SignalDeviations, m.DerivedSignals)); err != nil {
+		return err
+	}
+
+	// derived signals must be valid
+	if err := ValidateDerivedSignals(m.DerivedSignals); err != nil {
 		return err
 	}
 
@@ -145,6 +162,13 @@ func (m MsgCreateTunnel) ValidateBasic() error {
 		return sdkerrors.ErrInvalidCoins.Wrapf("invalid initial deposit: %s", m.InitialDeposit)
 	}
 
+	// schedule must be valid if set
+	if m.Schedule != "" {
+		if _, err := ParseSchedule(m.Schedule); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -210,6 +234,16 @@ func NewMsgUpdateRouterRoute(
 	)
 }
 
+// NewMsgUpdateIBCABIRoute creates a new MsgUpdateRoute instance.
+func NewMsgUpdateIBCABIRoute(
+	tunnelID uint64,
+	channelID string,
+	encoder feedstypes.Encoder,
+	creator string,
+) (*MsgUpdateRoute, error) {
+	return NewMsgUpdateRoute(tunnelID, NewIBCABIRoute(channelID, encoder), creator)
+}
+
 // GetRouteValue returns the route of the message.
 func (m MsgUpdateRoute) GetRouteValue() (RouteI, error) {
 	r, ok := m.Route.GetCachedValue().(RouteI)
@@ -249,14 +283,20 @@ func (m MsgUpdateRoute) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 func NewMsgUpdateSignalsAndInterval(
 	tunnelID uint64,
 	signalDeviations []SignalDeviation,
+	derivedSignals []DerivedSignal,
 	interval uint64,
+	schedule string,
+	includePriceConfidence bool,
 	creator string,
 ) *MsgUpdateSignalsAndInterval {
 	return &MsgUpdateSignalsAndInterval{
-		TunnelID:         tunnelID,
-		SignalDeviations: signalDeviations,
-		Interval:         interval,
-		Creator:          creator,
+		TunnelID:               tunnelID,
+		SignalDeviations:       signalDeviations,
+		DerivedSignals:         derivedSignals,
+		Interval:               interval,
+		Schedule:               schedule,
+		IncludePriceConfidence: includePriceConfidence,
+		Creator:                creator,
 	}
 }
 
@@ -267,15 +307,27 @@ func (m MsgUpdateSignalsAndInterval) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
 	}
 
-	// signal deviations cannot be empty
-	if len(m.SignalDeviations) == 0 {
+	// signal deviations and derived signals cannot both be empty
+	if len(m.SignalDeviations) == 0 && len(m.DerivedSignals) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrapf("signal deviations cannot be empty")
 	}
 	// signal deviations cannot duplicate
-	if err := validateUniqueSignalIDs(m.SignalDeviations); err != nil {
+	if err := validateUniqueSignalIDs(CombineSignalDeviations(m.SignalDeviations, m.DerivedSignals)); err != nil {
+		return err
+	}
+
+	// derived signals must be valid
+	if err := ValidateDerivedSignals(m.DerivedSignals); err != nil {
 		return err
 	}
 
+	// schedule must be valid if set
+	if m.Schedule != "" {
+		if _, err := ParseSchedule(m.Schedule); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -363,6 +415,174 @@ func (m MsgTriggerTunnel) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgRetryPacket creates a new MsgRetryPacket instance.
+func NewMsgRetryPacket(
+	tunnelID uint64,
+	sequence uint64,
+	sender string,
+) *MsgRetryPacket {
+	return &MsgRetryPacket{
+		TunnelID: tunnelID,
+		Sequence: sequence,
+		Sender:   sender,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgRetryPacket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgTransferTunnelOwnership creates a new MsgTransferTunnelOwnership instance.
+func NewMsgTransferTunnelOwnership(
+	tunnelID uint64,
+	newCreator string,
+	creator string,
+) *MsgTransferTunnelOwnership {
+	return &MsgTransferTunnelOwnership{
+		TunnelID:   tunnelID,
+		NewCreator: newCreator,
+		Creator:    creator,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgTransferTunnelOwnership) ValidateBasic() error {
+	// creator address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	// new creator address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.NewCreator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new creator address: %s", err)
+	}
+
+	if m.NewCreator == m.Creator {
+		return sdkerrors.ErrInvalidRequest.Wrap("new creator must be different from the current creator")
+	}
+
+	return nil
+}
+
+// NewMsgAcceptTunnelOwnership creates a new MsgAcceptTunnelOwnership instance.
+func NewMsgAcceptTunnelOwnership(
+	tunnelID uint64,
+	newCreator string,
+) *MsgAcceptTunnelOwnership {
+	return &MsgAcceptTunnelOwnership{
+		TunnelID:   tunnelID,
+		NewCreator: newCreator,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgAcceptTunnelOwnership) ValidateBasic() error {
+	// new creator address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.NewCreator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new creator address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgUpdateTunnelAdmins creates a new MsgUpdateTunnelAdmins instance.
+func NewMsgUpdateTunnelAdmins(
+	tunnelID uint64,
+	admins []TunnelAdmin,
+	creator string,
+) *MsgUpdateTunnelAdmins {
+	return &MsgUpdateTunnelAdmins{
+		TunnelID: tunnelID,
+		Admins:   admins,
+		Creator:  creator,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUpdateTunnelAdmins) ValidateBasic() error {
+	// creator address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	// admins must be valid
+	return ValidateTunnelAdmins(m.Admins, m.Creator)
+}
+
+// NewMsgApproveFundingSource creates a new MsgApproveFundingSource instance.
+func NewMsgApproveFundingSource(
+	tunnelID uint64,
+	source string,
+	creator string,
+) *MsgApproveFundingSource {
+	return &MsgApproveFundingSource{
+		TunnelID: tunnelID,
+		Source:   source,
+		Creator:  creator,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgApproveFundingSource) ValidateBasic() error {
+	// creator address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	// source address must be valid if it is set
+	if m.Source != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Source); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid source address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgSetFundingPolicy creates a new MsgSetFundingPolicy instance.
+func NewMsgSetFundingPolicy(
+	tunnelID uint64,
+	allowance sdk.Coins,
+	threshold sdk.Coins,
+	topUpAmount sdk.Coins,
+	source string,
+) *MsgSetFundingPolicy {
+	return &MsgSetFundingPolicy{
+		TunnelID:    tunnelID,
+		Allowance:   allowance,
+		Threshold:   threshold,
+		TopUpAmount: topUpAmount,
+		Source:      source,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgSetFundingPolicy) ValidateBasic() error {
+	return NewFundingPolicy(m.Source, m.Allowance, m.Threshold, m.TopUpAmount).Validate()
+}
+
+// NewMsgRemoveFundingPolicy creates a new MsgRemoveFundingPolicy instance.
+func NewMsgRemoveFundingPolicy(tunnelID uint64, sender string) *MsgRemoveFundingPolicy {
+	return &MsgRemoveFundingPolicy{
+		TunnelID: tunnelID,
+		Sender:   sender,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgRemoveFundingPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
+
 // NewMsgDepositToTunnel creates a new MsgDepositToTunnel instance.
 func NewMsgDepositToTunnel(
 	tunnelID uint64,
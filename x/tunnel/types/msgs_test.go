@@ -42,7 +42,18 @@ func TestMsgCreateTunnel_ValidateBasic(t *testing.T) {
 	err = msg.ValidateBasic()
 	require.NoError(t, err)
 
+	// Valid case - with schedule
+	msg.Schedule = "0 * * * *"
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid schedule
+	msg.Schedule = "0 * * *"
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+
 	// Invalid creator
+	msg.Schedule = ""
 	msg.Creator = "invalidCreator"
 	err = msg.ValidateBasic()
 	require.Error(t, err)
@@ -88,7 +99,7 @@ func TestMsgUpdateSignalsAndInterval_ValidateBasic(t *testing.T) {
 	signalDeviations := []types.SignalDeviation{
 		{SignalID: "signal1", SoftDeviationBPS: 5000, HardDeviationBPS: 1000},
 	}
-	msg := types.NewMsgUpdateSignalsAndInterval(1, signalDeviations, 10, validCreator.String())
+	msg := types.NewMsgUpdateSignalsAndInterval(1, signalDeviations, nil, 10, "", false, validCreator.String())
 
 	// Valid case
 	err := msg.ValidateBasic()
@@ -104,6 +115,34 @@ func TestMsgUpdateSignalsAndInterval_ValidateBasic(t *testing.T) {
 	msg.SignalDeviations = []types.SignalDeviation{}
 	err = msg.ValidateBasic()
 	require.Error(t, err)
+
+	// Invalid schedule
+	msg.SignalDeviations = signalDeviations
+	msg.Schedule = "60 * * * *"
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+
+	// Valid case - derived signals only
+	msg.Schedule = ""
+	msg.SignalDeviations = []types.SignalDeviation{}
+	msg.DerivedSignals = []types.DerivedSignal{
+		types.NewDerivedSignal("DS:ETH-BTC", "CS:ETH-USD / CS:BTC-USD", 5000, 1000),
+	}
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Duplicate signal ID between signal deviations and derived signals
+	msg.SignalDeviations = []types.SignalDeviation{
+		{SignalID: "DS:ETH-BTC", SoftDeviationBPS: 5000, HardDeviationBPS: 1000},
+	}
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid derived signal expression
+	msg.SignalDeviations = signalDeviations
+	msg.DerivedSignals[0].Expression = "CS:ETH-USD /"
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidDerivedSignal)
 }
 
 // ====================================
@@ -157,6 +196,163 @@ func TestMsgTriggerTunnel_ValidateBasic(t *testing.T) {
 	require.Error(t, err)
 }
 
+// ====================================
+// MsgRetryPacket
+// ====================================
+
+func TestMsgRetryPacket_ValidateBasic(t *testing.T) {
+	msg := types.NewMsgRetryPacket(1, 1, validCreator.String())
+
+	// Valid case
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid sender
+	msg.Sender = "invalidSender"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgTransferTunnelOwnership
+// ====================================
+
+func TestMsgTransferTunnelOwnership_ValidateBasic(t *testing.T) {
+	msg := types.NewMsgTransferTunnelOwnership(1, validDepositor.String(), validCreator.String())
+
+	// Valid case
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Same creator
+	msg.NewCreator = validCreator.String()
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid new creator
+	msg.NewCreator = "invalidCreator"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgAcceptTunnelOwnership
+// ====================================
+
+func TestMsgAcceptTunnelOwnership_ValidateBasic(t *testing.T) {
+	msg := types.NewMsgAcceptTunnelOwnership(1, validCreator.String())
+
+	// Valid case
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid new creator
+	msg.NewCreator = "invalidCreator"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgUpdateTunnelAdmins
+// ====================================
+
+func TestMsgUpdateTunnelAdmins_ValidateBasic(t *testing.T) {
+	admins := []types.TunnelAdmin{
+		types.NewTunnelAdmin(validDepositor.String(), []types.TunnelPermission{types.TUNNEL_PERMISSION_TRIGGER}),
+	}
+	msg := types.NewMsgUpdateTunnelAdmins(1, admins, validCreator.String())
+
+	// Valid case
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Empty admins
+	msg.Admins = nil
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Creator as an admin
+	msg.Admins = []types.TunnelAdmin{
+		types.NewTunnelAdmin(validCreator.String(), []types.TunnelPermission{types.TUNNEL_PERMISSION_TRIGGER}),
+	}
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid creator
+	msg.Admins = admins
+	msg.Creator = "invalidCreator"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgApproveFundingSource
+// ====================================
+
+func TestMsgApproveFundingSource_ValidateBasic(t *testing.T) {
+	msg := types.NewMsgApproveFundingSource(1, validDepositor.String(), validCreator.String())
+
+	// Valid case
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Empty source revokes the approval
+	msg.Source = ""
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid source
+	msg.Source = "invalidSource"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgSetFundingPolicy
+// ====================================
+
+func TestMsgSetFundingPolicy_ValidateBasic(t *testing.T) {
+	msg := types.NewMsgSetFundingPolicy(
+		1,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 300)),
+		validDepositor.String(),
+	)
+
+	// Valid case
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Empty threshold
+	msg.Threshold = sdk.Coins{}
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid source
+	msg.Threshold = sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+	msg.Source = "invalidSource"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgRemoveFundingPolicy
+// ====================================
+
+func TestMsgRemoveFundingPolicy_ValidateBasic(t *testing.T) {
+	msg := types.NewMsgRemoveFundingPolicy(1, validCreator.String())
+
+	// Valid case
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid sender
+	msg.Sender = "invalidSender"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
 // ====================================
 // MsgWithdrawFromTunnel
 // ====================================
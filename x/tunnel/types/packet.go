@@ -28,6 +28,18 @@ func NewPacket(
 	}
 }
 
+// RemovePriceMetadata returns a copy of the given prices without their aggregation method and
+// confidence metadata.
+func RemovePriceMetadata(prices []feedstypes.Price) []feedstypes.Price {
+	result := make([]feedstypes.Price, 0, len(prices))
+	for _, p := range prices {
+		p.AggregationMethod = feedstypes.AGGREGATION_METHOD_UNSPECIFIED
+		p.Confidence = nil
+		result = append(result, p)
+	}
+	return result
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Packet) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var receipt PacketReceiptI
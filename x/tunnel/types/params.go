@@ -20,6 +20,10 @@ var (
 	DefaultBasePacketFee             = sdk.NewCoins(sdk.NewInt64Coin("uband", 500))
 	DefaultRouterIBCChannel          = ""
 	DefaultRouterIntegrationContract = ""
+	DefaultTimeoutRefundBPS          = uint64(0)
+	DefaultPacketRetentionCount      = uint64(0)
+	DefaultPacketRetentionDuration   = uint64(0)
+	DefaultMaxPrunedPacketsPerBlock  = uint64(100)
 )
 
 // NewParams creates a new Params instance
@@ -33,6 +37,10 @@ func NewParams(
 	basePacketFee sdk.Coins,
 	routerIBCChannel string,
 	routerIntegrationContract string,
+	timeoutRefundBPS uint64,
+	packetRetentionCount uint64,
+	packetRetentionDuration uint64,
+	maxPrunedPacketsPerBlock uint64,
 ) Params {
 	return Params{
 		MinDeposit:                minDeposit,
@@ -44,6 +52,10 @@ func NewParams(
 		BasePacketFee:             basePacketFee,
 		RouterIBCChannel:          routerIBCChannel,
 		RouterIntegrationContract: routerIntegrationContract,
+		TimeoutRefundBPS:          timeoutRefundBPS,
+		PacketRetentionCount:      packetRetentionCount,
+		PacketRetentionDuration:   packetRetentionDuration,
+		MaxPrunedPacketsPerBlock:  maxPrunedPacketsPerBlock,
 	}
 }
 
@@ -59,6 +71,10 @@ func DefaultParams() Params {
 		DefaultBasePacketFee,
 		DefaultRouterIBCChannel,
 		DefaultRouterIntegrationContract,
+		DefaultTimeoutRefundBPS,
+		DefaultPacketRetentionCount,
+		DefaultPacketRetentionDuration,
+		DefaultMaxPrunedPacketsPerBlock,
 	)
 }
 
@@ -122,9 +138,26 @@ func (p Params) Validate() error {
 		return fmt.Errorf("channel identifier is not in the format: `channel-{N}` or be empty string")
 	}
 
+	// validate TimeoutRefundBPS
+	if p.TimeoutRefundBPS > 10000 {
+		return fmt.Errorf("timeout refund bps must be less than or equal to 10000: %d", p.TimeoutRefundBPS)
+	}
+
+	// validate MaxPrunedPacketsPerBlock if packet pruning is enabled
+	if p.IsPacketPruningEnabled() {
+		if err := validateUint64("max pruned packets per block", true)(p.MaxPrunedPacketsPerBlock); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// IsPacketPruningEnabled returns true if any packet retention policy is set.
+func (p Params) IsPacketPruningEnabled() bool {
+	return p.PacketRetentionCount > 0 || p.PacketRetentionDuration > 0
+}
+
 // validateUint64 validates if a given number is a valid uint64.
 func validateUint64(name string, positiveOnly bool) func(interface{}) error {
 	return func(i interface{}) error {
@@ -46,6 +46,17 @@ type Params struct {
 	// router_integration_contract specifies the address of the Router integration contract on the Router chain
 	// that the tunnel module will interact with.
 	RouterIntegrationContract string `protobuf:"bytes,9,opt,name=router_integration_contract,json=routerIntegrationContract,proto3" json:"router_integration_contract,omitempty"`
+	// timeout_refund_bps is the portion of the base packet fee in basis points that is refunded
+	// to the fee payer when an IBC packet of the tunnel is timed out.
+	TimeoutRefundBPS uint64 `protobuf:"varint,10,opt,name=timeout_refund_bps,json=timeoutRefundBps,proto3" json:"timeout_refund_bps,omitempty"`
+	// packet_retention_count is the number of the latest packets of each tunnel that are never pruned.
+	// Zero disables the count-based retention.
+	PacketRetentionCount uint64 `protobuf:"varint,11,opt,name=packet_retention_count,json=packetRetentionCount,proto3" json:"packet_retention_count,omitempty"`
+	// packet_retention_duration is the duration in seconds for which packets are never pruned.
+	// Zero disables the age-based retention.
+	PacketRetentionDuration uint64 `protobuf:"varint,12,opt,name=packet_retention_duration,json=packetRetentionDuration,proto3" json:"packet_retention_duration,omitempty"`
+	// max_pruned_packets_per_block is the maximum number of packets pruned in a block.
+	MaxPrunedPacketsPerBlock uint64 `protobuf:"varint,13,opt,name=max_pruned_packets_per_block,json=maxPrunedPacketsPerBlock,proto3" json:"max_pruned_packets_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -144,6 +155,34 @@ func (m *Params) GetRouterIntegrationContract() string {
 	return ""
 }
 
+func (m *Params) GetTimeoutRefundBPS() uint64 {
+	if m != nil {
+		return m.TimeoutRefundBPS
+	}
+	return 0
+}
+
+func (m *Params) GetPacketRetentionCount() uint64 {
+	if m != nil {
+		return m.PacketRetentionCount
+	}
+	return 0
+}
+
+func (m *Params) GetPacketRetentionDuration() uint64 {
+	if m != nil {
+		return m.PacketRetentionDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunedPacketsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedPacketsPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "band.tunnel.v1beta1.Params")
 }
@@ -151,37 +190,43 @@ func init() {
 func init() { proto.RegisterFile("band/tunnel/v1beta1/params.proto", fileDescriptor_842b3bf03f22bf82) }
 
 var fileDescriptor_842b3bf03f22bf82 = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xc0, 0x63, 0x1a, 0x02, 0xbd, 0x82, 0x52, 0xdc, 0x0e, 0x6e, 0x91, 0xec, 0xc0, 0x94, 0x05,
-	0x1f, 0xa5, 0x1b, 0x03, 0x48, 0x0e, 0x42, 0xca, 0x80, 0x14, 0xb9, 0x1b, 0x8b, 0xf5, 0x7c, 0x39,
-	0x9c, 0x53, 0xed, 0x3b, 0xcb, 0x77, 0x89, 0xcc, 0xb7, 0xe0, 0x23, 0x30, 0xf3, 0x49, 0x3a, 0x76,
-	0x64, 0x0a, 0xc8, 0x59, 0xf8, 0x18, 0xe8, 0xfe, 0xa4, 0x8d, 0x32, 0x77, 0xb2, 0xf5, 0xde, 0xef,
-	0x7e, 0xef, 0xdd, 0xd3, 0x3b, 0x34, 0xca, 0x81, 0xcf, 0xb1, 0x5a, 0x72, 0x4e, 0x4b, 0xbc, 0xba,
-	0xc8, 0xa9, 0x82, 0x0b, 0x5c, 0x43, 0x03, 0x95, 0x8c, 0xeb, 0x46, 0x28, 0xe1, 0x9f, 0x68, 0x22,
-	0xb6, 0x44, 0xec, 0x88, 0xf3, 0xd3, 0x42, 0x14, 0xc2, 0xe4, 0xb1, 0xfe, 0xb3, 0xe8, 0x79, 0x48,
-	0x84, 0xac, 0x84, 0xc4, 0x39, 0x48, 0x7a, 0x27, 0x23, 0x82, 0x71, 0x9b, 0x7f, 0xbd, 0xee, 0xa3,
-	0xc1, 0xcc, 0xb8, 0xfd, 0x12, 0x1d, 0x55, 0x8c, 0x67, 0x73, 0x5a, 0x0b, 0xc9, 0x54, 0xe0, 0x8d,
-	0x0e, 0xc6, 0x47, 0xef, 0xce, 0x62, 0x2b, 0x88, 0xb5, 0x60, 0x5b, 0x2b, 0x9e, 0x08, 0xc6, 0x93,
-	0xb7, 0x37, 0xeb, 0xa8, 0xf7, 0xeb, 0x4f, 0x34, 0x2e, 0x98, 0x5a, 0x2c, 0xf3, 0x98, 0x88, 0x0a,
-	0xbb, 0x6a, 0xf6, 0xf3, 0x46, 0xce, 0xaf, 0xb1, 0xfa, 0x5e, 0x53, 0x69, 0x0e, 0xc8, 0x14, 0x55,
-	0x8c, 0x7f, 0xb2, 0x7a, 0xff, 0x15, 0x7a, 0xa6, 0xab, 0x31, 0xae, 0x68, 0xb3, 0x82, 0x32, 0x78,
-	0x34, 0xf2, 0xc6, 0xfd, 0x54, 0x77, 0x30, 0x75, 0x21, 0x83, 0x40, 0x7b, 0x8f, 0x1c, 0x38, 0x04,
-	0xda, 0x3b, 0xe4, 0x23, 0x7a, 0x61, 0x7b, 0x5e, 0x31, 0x50, 0x4c, 0xf0, 0x2c, 0xaf, 0x65, 0xd0,
-	0xd7, 0x5c, 0x72, 0xd2, 0xad, 0xa3, 0xe1, 0x17, 0x5d, 0xd0, 0xe5, 0x92, 0xd9, 0x55, 0x3a, 0xac,
-	0x76, 0x03, 0xb5, 0x34, 0x02, 0x68, 0xf7, 0x04, 0x8f, 0x77, 0x04, 0xd0, 0xee, 0x09, 0x76, 0x03,
-	0xb5, 0xf4, 0x23, 0xa4, 0x1b, 0xca, 0x24, 0x2b, 0x38, 0x94, 0x32, 0x18, 0x98, 0x1e, 0x51, 0x05,
-	0xed, 0x95, 0x8d, 0xf8, 0x12, 0x0d, 0xf5, 0xec, 0xb2, 0x1a, 0xc8, 0x35, 0x55, 0xd9, 0x37, 0x4a,
-	0x83, 0x27, 0x0f, 0x3f, 0xda, 0xe7, 0x5a, 0x32, 0x33, 0x25, 0x3e, 0x53, 0xea, 0x27, 0xc8, 0x6f,
-	0xc4, 0x52, 0xd1, 0x26, 0x63, 0x39, 0xc9, 0xc8, 0x02, 0xf4, 0xaa, 0x04, 0x4f, 0x47, 0xde, 0xf8,
-	0x30, 0x39, 0xed, 0xd6, 0xd1, 0x71, 0x6a, 0xb2, 0xd3, 0x64, 0x32, 0xb1, 0xb9, 0xf4, 0xd8, 0xf2,
-	0xd3, 0x9c, 0xb8, 0x88, 0xff, 0x01, 0xbd, 0xdc, 0x3a, 0xb8, 0xa2, 0x45, 0x63, 0xe7, 0x43, 0x04,
-	0x57, 0x0d, 0x10, 0x15, 0x1c, 0x6a, 0x59, 0x7a, 0xe6, 0x8e, 0xdd, 0x13, 0x13, 0x07, 0xbc, 0xef,
-	0xff, 0xfb, 0x19, 0x79, 0xc9, 0xf4, 0xa6, 0x0b, 0xbd, 0xdb, 0x2e, 0xf4, 0xfe, 0x76, 0xa1, 0xf7,
-	0x63, 0x13, 0xf6, 0x6e, 0x37, 0x61, 0xef, 0xf7, 0x26, 0xec, 0x7d, 0xc5, 0x3b, 0x97, 0xd3, 0x0b,
-	0x6d, 0x16, 0x92, 0x88, 0x12, 0x93, 0x05, 0x30, 0x8e, 0x57, 0x97, 0xb8, 0xdd, 0xbe, 0x02, 0x73,
-	0xd3, 0x7c, 0x60, 0x88, 0xcb, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe3, 0xfb, 0x5a, 0xe0, 0x21,
-	0x03, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xaf, 0xfd, 0x02, 0x9d, 0xb6, 0x6a, 0x70, 0x23, 0x70, 0x0a, 0xb2, 0x03, 0xab,
+	0x6c, 0xb0, 0x29, 0x65, 0xd5, 0x45, 0x91, 0x9c, 0x0a, 0x29, 0x0b, 0xa4, 0xc8, 0x65, 0xc5, 0xc6,
+	0x1a, 0x8f, 0xa7, 0xc9, 0x28, 0xf6, 0x8c, 0x35, 0x33, 0x8e, 0xcc, 0x5b, 0xf0, 0x08, 0xac, 0x79,
+	0x92, 0x2e, 0xbb, 0x60, 0xc1, 0x2a, 0xa0, 0x64, 0xc3, 0x63, 0xa0, 0xf9, 0x93, 0x36, 0x84, 0x2d,
+	0xab, 0x44, 0xf7, 0xfc, 0xee, 0xb9, 0x57, 0xe7, 0x7a, 0x40, 0x3f, 0x83, 0x34, 0x8f, 0x64, 0x4d,
+	0x29, 0x2e, 0xa2, 0xf9, 0x69, 0x86, 0x25, 0x3c, 0x8d, 0x2a, 0xc8, 0x61, 0x29, 0xc2, 0x8a, 0x33,
+	0xc9, 0xdc, 0x63, 0x45, 0x84, 0x86, 0x08, 0x2d, 0x71, 0xd2, 0x9d, 0xb0, 0x09, 0xd3, 0x7a, 0xa4,
+	0xfe, 0x19, 0xf4, 0xc4, 0x47, 0x4c, 0x94, 0x4c, 0x44, 0x19, 0x14, 0xf8, 0xce, 0x0c, 0x31, 0x42,
+	0x8d, 0xfe, 0xe2, 0x5b, 0x1b, 0xb4, 0xc7, 0xda, 0xdb, 0x2d, 0xc0, 0x7e, 0x49, 0x68, 0x9a, 0xe3,
+	0x8a, 0x09, 0x22, 0x3d, 0xa7, 0xbf, 0x33, 0xd8, 0x7f, 0xdd, 0x0b, 0x8d, 0x41, 0xa8, 0x0c, 0xd6,
+	0xb3, 0xc2, 0x21, 0x23, 0x34, 0x7e, 0x75, 0xb3, 0x08, 0x5a, 0x5f, 0x7f, 0x04, 0x83, 0x09, 0x91,
+	0xd3, 0x3a, 0x0b, 0x11, 0x2b, 0x23, 0x3b, 0xcd, 0xfc, 0xbc, 0x14, 0xf9, 0x2c, 0x92, 0x9f, 0x2a,
+	0x2c, 0x74, 0x83, 0x48, 0x40, 0x49, 0xe8, 0xa5, 0xb1, 0x77, 0x9f, 0x83, 0x03, 0x35, 0x8d, 0x50,
+	0x89, 0xf9, 0x1c, 0x16, 0xde, 0x7f, 0x7d, 0x67, 0xb0, 0x9b, 0xa8, 0x0d, 0x46, 0xb6, 0xa4, 0x11,
+	0xd8, 0xdc, 0x23, 0x3b, 0x16, 0x81, 0xcd, 0x1d, 0xf2, 0x16, 0x3c, 0x32, 0x3b, 0xcf, 0x09, 0x94,
+	0x84, 0xd1, 0x34, 0xab, 0x84, 0xb7, 0xab, 0xb8, 0xf8, 0x78, 0xb9, 0x08, 0x8e, 0xde, 0xab, 0x81,
+	0x56, 0x8b, 0xc7, 0x57, 0xc9, 0x51, 0xb9, 0x59, 0xa8, 0x84, 0x36, 0x80, 0xcd, 0x96, 0xc1, 0xff,
+	0x1b, 0x06, 0xb0, 0xd9, 0x32, 0xd8, 0x2c, 0x54, 0xc2, 0x0d, 0x80, 0x5a, 0x28, 0x15, 0x64, 0x42,
+	0x61, 0x21, 0xbc, 0xb6, 0xde, 0x11, 0x94, 0xb0, 0xb9, 0x32, 0x15, 0x57, 0x80, 0x23, 0x95, 0x5d,
+	0x5a, 0x41, 0x34, 0xc3, 0x32, 0xbd, 0xc6, 0xd8, 0x7b, 0xf0, 0xef, 0xa3, 0x3d, 0x54, 0x26, 0x63,
+	0x3d, 0xe2, 0x1d, 0xc6, 0x6e, 0x0c, 0x5c, 0xce, 0x6a, 0x89, 0x79, 0x4a, 0x32, 0x94, 0xa2, 0x29,
+	0x54, 0x9f, 0x8a, 0xf7, 0xb0, 0xef, 0x0c, 0xf6, 0xe2, 0xee, 0x72, 0x11, 0x74, 0x12, 0xad, 0x8e,
+	0xe2, 0xe1, 0xd0, 0x68, 0x49, 0xc7, 0xf0, 0xa3, 0x0c, 0xd9, 0x8a, 0x7b, 0x01, 0x9e, 0xae, 0x3d,
+	0xa8, 0xc4, 0x13, 0x6e, 0xf2, 0x41, 0x8c, 0x4a, 0x0e, 0x91, 0xf4, 0xf6, 0x94, 0x59, 0xd2, 0xb3,
+	0x6d, 0xf7, 0xc4, 0xd0, 0x02, 0x6a, 0x07, 0x49, 0x4a, 0xcc, 0x6a, 0x99, 0x72, 0x7c, 0x5d, 0xd3,
+	0x5c, 0x67, 0x0b, 0x74, 0xb6, 0x7a, 0x87, 0x0f, 0x46, 0x4d, 0xb4, 0xa8, 0xc2, 0xed, 0xc8, 0x3f,
+	0x2a, 0x95, 0x70, 0xdf, 0x80, 0xc7, 0x36, 0x37, 0x8e, 0x25, 0xa6, 0x76, 0x83, 0x9a, 0x4a, 0x6f,
+	0x5f, 0x07, 0xdd, 0x35, 0x6a, 0xb2, 0x16, 0x87, 0x4a, 0x73, 0xcf, 0x41, 0xef, 0xaf, 0xae, 0xbc,
+	0x36, 0xeb, 0x79, 0x07, 0xba, 0xf1, 0xc9, 0x56, 0xe3, 0xa5, 0x95, 0xdd, 0x0b, 0xf0, 0x4c, 0xdd,
+	0xb3, 0xe2, 0x35, 0xc5, 0xb9, 0x3d, 0x9a, 0x48, 0x2b, 0xcc, 0xd3, 0xac, 0x60, 0x68, 0xe6, 0x1d,
+	0xea, 0x76, 0xaf, 0x84, 0xcd, 0x58, 0x23, 0x26, 0x73, 0x31, 0xc6, 0x3c, 0x56, 0xfa, 0xf9, 0xee,
+	0xaf, 0x2f, 0x81, 0x13, 0x8f, 0x6e, 0x96, 0xbe, 0x73, 0xbb, 0xf4, 0x9d, 0x9f, 0x4b, 0xdf, 0xf9,
+	0xbc, 0xf2, 0x5b, 0xb7, 0x2b, 0xbf, 0xf5, 0x7d, 0xe5, 0xb7, 0x3e, 0x46, 0x1b, 0x27, 0x55, 0xcf,
+	0x58, 0x3f, 0x43, 0xc4, 0x8a, 0x08, 0x4d, 0x21, 0xa1, 0xd1, 0xfc, 0x2c, 0x6a, 0xd6, 0x6f, 0x5f,
+	0xdf, 0x37, 0x6b, 0x6b, 0xe2, 0xec, 0xf7, 0x00, 0xe4, 0x89, 0x9d, 0x96, 0x17, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -240,6 +285,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.RouterIntegrationContract != that1.RouterIntegrationContract {
 		return false
 	}
+	if this.TimeoutRefundBPS != that1.TimeoutRefundBPS {
+		return false
+	}
+	if this.PacketRetentionCount != that1.PacketRetentionCount {
+		return false
+	}
+	if this.PacketRetentionDuration != that1.PacketRetentionDuration {
+		return false
+	}
+	if this.MaxPrunedPacketsPerBlock != that1.MaxPrunedPacketsPerBlock {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -262,6 +319,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPrunedPacketsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrunedPacketsPerBlock))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PacketRetentionDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PacketRetentionDuration))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PacketRetentionCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PacketRetentionCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TimeoutRefundBPS != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimeoutRefundBPS))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.RouterIntegrationContract) > 0 {
 		i -= len(m.RouterIntegrationContract)
 		copy(dAtA[i:], m.RouterIntegrationContract)
@@ -384,6 +461,18 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.TimeoutRefundBPS != 0 {
+		n += 1 + sovParams(uint64(m.TimeoutRefundBPS))
+	}
+	if m.PacketRetentionCount != 0 {
+		n += 1 + sovParams(uint64(m.PacketRetentionCount))
+	}
+	if m.PacketRetentionDuration != 0 {
+		n += 1 + sovParams(uint64(m.PacketRetentionDuration))
+	}
+	if m.MaxPrunedPacketsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrunedPacketsPerBlock))
+	}
 	return n
 }
 
@@ -649,6 +738,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.RouterIntegrationContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRefundBPS", wireType)
+			}
+			m.TimeoutRefundBPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRefundBPS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketRetentionCount", wireType)
+			}
+			m.PacketRetentionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketRetentionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketRetentionDuration", wireType)
+			}
+			m.PacketRetentionDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketRetentionDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPacketsPerBlock", wireType)
+			}
+			m.MaxPrunedPacketsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedPacketsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -14,6 +14,15 @@ func TestParams_Validate(t *testing.T) {
 		expErr       bool
 		expErrMsg    string
 	}{
+		"invalid TimeoutRefundBPS": {
+			genesisState: func() types.Params {
+				p := types.DefaultParams()
+				p.TimeoutRefundBPS = 10001
+				return p
+			}(),
+			expErr:    true,
+			expErrMsg: "timeout refund bps must be less than or equal to 10000",
+		},
 		"invalid MinInterval": {
 			genesisState: func() types.Params {
 				p := types.DefaultParams()
@@ -79,6 +88,16 @@ func TestParams_Validate(t *testing.T) {
 			expErr:    true,
 			expErrMsg: "max signals must be positive",
 		},
+		"invalid MaxPrunedPacketsPerBlock": {
+			genesisState: func() types.Params {
+				p := types.DefaultParams()
+				p.PacketRetentionCount = 100
+				p.MaxPrunedPacketsPerBlock = 0
+				return p
+			}(),
+			expErr:    true,
+			expErrMsg: "max pruned packets per block must be positive",
+		},
 		"valid params": {
 			genesisState: types.DefaultParams(),
 			expErr:       false,
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = &QueryEstimateTunnelCostRequest{}
+
+// GetRouteValue returns the proposed route of the request.
+func (m QueryEstimateTunnelCostRequest) GetRouteValue() (RouteI, error) {
+	r, ok := m.Route.GetCachedValue().(RouteI)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (RouteI)(nil), m.Route.GetCachedValue())
+	}
+
+	return r, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryEstimateTunnelCostRequest) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var route RouteI
+	return unpacker.UnpackAny(m.Route, &route)
+}
@@ -6,6 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -68,6 +72,8 @@ type QueryTunnelsRequest struct {
 	StatusFilter TunnelStatusFilter `protobuf:"varint,1,opt,name=status_filter,json=statusFilter,proto3,enum=band.tunnel.v1beta1.TunnelStatusFilter" json:"status_filter,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// admin is an optional address to filter tunnels that the address is the creator or a co-administrator of.
+	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
 func (m *QueryTunnelsRequest) Reset()         { *m = QueryTunnelsRequest{} }
@@ -117,6 +123,13 @@ func (m *QueryTunnelsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryTunnelsRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
 // QueryTunnelsResponse is the response type for the Query/Tunnels RPC method.
 type QueryTunnelsResponse struct {
 	// Tunnels is a list of tunnels.
@@ -585,6 +598,253 @@ func (m *QueryPacketsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryPacketsByTimeRequest is the request type for the Query/PacketsByTime RPC method.
+type QueryPacketsByTimeRequest struct {
+	// tunnel_id is the ID of the tunnel to query packets.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// start_time is the earliest creation time (unix seconds) of the packets, inclusive.
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the latest creation time (unix seconds) of the packets, inclusive. Zero means no upper bound.
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPacketsByTimeRequest) Reset()         { *m = QueryPacketsByTimeRequest{} }
+func (m *QueryPacketsByTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketsByTimeRequest) ProtoMessage()    {}
+func (*QueryPacketsByTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{10}
+}
+func (m *QueryPacketsByTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketsByTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketsByTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketsByTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketsByTimeRequest.Merge(m, src)
+}
+func (m *QueryPacketsByTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketsByTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketsByTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketsByTimeRequest proto.InternalMessageInfo
+
+func (m *QueryPacketsByTimeRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
+	}
+	return 0
+}
+
+func (m *QueryPacketsByTimeRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryPacketsByTimeRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *QueryPacketsByTimeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPacketsByTimeResponse is the response type for the Query/PacketsByTime RPC method.
+type QueryPacketsByTimeResponse struct {
+	// packets is a list of packets ordered by creation time.
+	Packets []*Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPacketsByTimeResponse) Reset()         { *m = QueryPacketsByTimeResponse{} }
+func (m *QueryPacketsByTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketsByTimeResponse) ProtoMessage()    {}
+func (*QueryPacketsByTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{11}
+}
+func (m *QueryPacketsByTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketsByTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketsByTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketsByTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketsByTimeResponse.Merge(m, src)
+}
+func (m *QueryPacketsByTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketsByTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketsByTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketsByTimeResponse proto.InternalMessageInfo
+
+func (m *QueryPacketsByTimeResponse) GetPackets() []*Packet {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *QueryPacketsByTimeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPacketsByStatusRequest is the request type for the Query/PacketsByStatus RPC method.
+type QueryPacketsByStatusRequest struct {
+	// status is the status of the packets.
+	Status PacketStatus `protobuf:"varint,1,opt,name=status,proto3,enum=band.tunnel.v1beta1.PacketStatus" json:"status,omitempty"`
+	// receipt_type is the optional full name of the packet receipt type, e.g. band.tunnel.v1beta1.TSSPacketReceipt.
+	ReceiptType string `protobuf:"bytes,2,opt,name=receipt_type,json=receiptType,proto3" json:"receipt_type,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPacketsByStatusRequest) Reset()         { *m = QueryPacketsByStatusRequest{} }
+func (m *QueryPacketsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketsByStatusRequest) ProtoMessage()    {}
+func (*QueryPacketsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{12}
+}
+func (m *QueryPacketsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketsByStatusRequest.Merge(m, src)
+}
+func (m *QueryPacketsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketsByStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketsByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryPacketsByStatusRequest) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PACKET_STATUS_UNSPECIFIED
+}
+
+func (m *QueryPacketsByStatusRequest) GetReceiptType() string {
+	if m != nil {
+		return m.ReceiptType
+	}
+	return ""
+}
+
+func (m *QueryPacketsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPacketsByStatusResponse is the response type for the Query/PacketsByStatus RPC method.
+type QueryPacketsByStatusResponse struct {
+	// packets is a list of packets.
+	Packets []*Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPacketsByStatusResponse) Reset()         { *m = QueryPacketsByStatusResponse{} }
+func (m *QueryPacketsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketsByStatusResponse) ProtoMessage()    {}
+func (*QueryPacketsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{13}
+}
+func (m *QueryPacketsByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketsByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketsByStatusResponse.Merge(m, src)
+}
+func (m *QueryPacketsByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketsByStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketsByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryPacketsByStatusResponse) GetPackets() []*Packet {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *QueryPacketsByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryPacketRequest is the request type for the Query/Packet RPC method.
 type QueryPacketRequest struct {
 	// tunnel_id is the ID of the tunnel to query packets.
@@ -597,7 +857,7 @@ func (m *QueryPacketRequest) Reset()         { *m = QueryPacketRequest{} }
 func (m *QueryPacketRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketRequest) ProtoMessage()    {}
 func (*QueryPacketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80b85392d1440ac, []int{10}
+	return fileDescriptor_f80b85392d1440ac, []int{14}
 }
 func (m *QueryPacketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,7 +910,7 @@ func (m *QueryPacketResponse) Reset()         { *m = QueryPacketResponse{} }
 func (m *QueryPacketResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketResponse) ProtoMessage()    {}
 func (*QueryPacketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80b85392d1440ac, []int{11}
+	return fileDescriptor_f80b85392d1440ac, []int{15}
 }
 func (m *QueryPacketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,22 +946,26 @@ func (m *QueryPacketResponse) GetPacket() *Packet {
 	return nil
 }
 
-// QueryTotalFeesRequest is the request type for the Query/TotalFees RPC method.
-type QueryTotalFeesRequest struct {
+// QueryFailedPacketsRequest is the request type for the Query/FailedPackets RPC method.
+type QueryFailedPacketsRequest struct {
+	// tunnel_id is the ID of the tunnel to query failed packets.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalFeesRequest) Reset()         { *m = QueryTotalFeesRequest{} }
-func (m *QueryTotalFeesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalFeesRequest) ProtoMessage()    {}
-func (*QueryTotalFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80b85392d1440ac, []int{12}
+func (m *QueryFailedPacketsRequest) Reset()         { *m = QueryFailedPacketsRequest{} }
+func (m *QueryFailedPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedPacketsRequest) ProtoMessage()    {}
+func (*QueryFailedPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{16}
 }
-func (m *QueryTotalFeesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryFailedPacketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFailedPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalFeesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFailedPacketsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -711,36 +975,52 @@ func (m *QueryTotalFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalFeesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalFeesRequest.Merge(m, src)
+func (m *QueryFailedPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedPacketsRequest.Merge(m, src)
 }
-func (m *QueryTotalFeesRequest) XXX_Size() int {
+func (m *QueryFailedPacketsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalFeesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalFeesRequest.DiscardUnknown(m)
+func (m *QueryFailedPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedPacketsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalFeesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryFailedPacketsRequest proto.InternalMessageInfo
 
-// QueryTotalFeesResponse is the response type for the Query/TotalFees RPC method.
-type QueryTotalFeesResponse struct {
-	// total_fees is the total fees collected by the tunnel.
-	TotalFees TotalFees `protobuf:"bytes,1,opt,name=total_fees,json=totalFees,proto3" json:"total_fees"`
+func (m *QueryFailedPacketsRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
+	}
+	return 0
 }
 
-func (m *QueryTotalFeesResponse) Reset()         { *m = QueryTotalFeesResponse{} }
-func (m *QueryTotalFeesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalFeesResponse) ProtoMessage()    {}
-func (*QueryTotalFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80b85392d1440ac, []int{13}
+func (m *QueryFailedPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryTotalFeesResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryFailedPacketsResponse is the response type for the Query/FailedPackets RPC method.
+type QueryFailedPacketsResponse struct {
+	// failed_packets is a list of failed packets.
+	FailedPackets []*FailedPacket `protobuf:"bytes,1,rep,name=failed_packets,json=failedPackets,proto3" json:"failed_packets,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedPacketsResponse) Reset()         { *m = QueryFailedPacketsResponse{} }
+func (m *QueryFailedPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedPacketsResponse) ProtoMessage()    {}
+func (*QueryFailedPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{17}
+}
+func (m *QueryFailedPacketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFailedPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalFeesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFailedPacketsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -750,41 +1030,50 @@ func (m *QueryTotalFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalFeesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalFeesResponse.Merge(m, src)
+func (m *QueryFailedPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedPacketsResponse.Merge(m, src)
 }
-func (m *QueryTotalFeesResponse) XXX_Size() int {
+func (m *QueryFailedPacketsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalFeesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalFeesResponse.DiscardUnknown(m)
+func (m *QueryFailedPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedPacketsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalFeesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFailedPacketsResponse proto.InternalMessageInfo
 
-func (m *QueryTotalFeesResponse) GetTotalFees() TotalFees {
+func (m *QueryFailedPacketsResponse) GetFailedPackets() []*FailedPacket {
 	if m != nil {
-		return m.TotalFees
+		return m.FailedPackets
 	}
-	return TotalFees{}
+	return nil
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (m *QueryFailedPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80b85392d1440ac, []int{14}
+// QueryFeePayerBalanceRequest is the request type for the Query/FeePayerBalance RPC method.
+type QueryFeePayerBalanceRequest struct {
+	// tunnel_id is the ID of the tunnel to query.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryFeePayerBalanceRequest) Reset()         { *m = QueryFeePayerBalanceRequest{} }
+func (m *QueryFeePayerBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayerBalanceRequest) ProtoMessage()    {}
+func (*QueryFeePayerBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{18}
+}
+func (m *QueryFeePayerBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFeePayerBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFeePayerBalanceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -794,36 +1083,47 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryFeePayerBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayerBalanceRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryFeePayerBalanceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryFeePayerBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayerBalanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryFeePayerBalanceRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params is the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryFeePayerBalanceRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80b85392d1440ac, []int{15}
+// QueryFeePayerBalanceResponse is the response type for the Query/FeePayerBalance RPC method.
+type QueryFeePayerBalanceResponse struct {
+	// fee_payer is the address of the fee payer of the tunnel.
+	FeePayer string `protobuf:"bytes,1,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// balance is the balance of the fee payer.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// funding_policy is the funding policy of the tunnel, if any.
+	FundingPolicy *FundingPolicy `protobuf:"bytes,3,opt,name=funding_policy,json=fundingPolicy,proto3" json:"funding_policy,omitempty"`
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryFeePayerBalanceResponse) Reset()         { *m = QueryFeePayerBalanceResponse{} }
+func (m *QueryFeePayerBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayerBalanceResponse) ProtoMessage()    {}
+func (*QueryFeePayerBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{19}
+}
+func (m *QueryFeePayerBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFeePayerBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFeePayerBalanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -833,785 +1133,1104 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryFeePayerBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayerBalanceResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryFeePayerBalanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryFeePayerBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayerBalanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFeePayerBalanceResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryFeePayerBalanceResponse) GetFeePayer() string {
 	if m != nil {
-		return m.Params
+		return m.FeePayer
 	}
-	return Params{}
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("band.tunnel.v1beta1.TunnelStatusFilter", TunnelStatusFilter_name, TunnelStatusFilter_value)
-	proto.RegisterType((*QueryTunnelsRequest)(nil), "band.tunnel.v1beta1.QueryTunnelsRequest")
-	proto.RegisterType((*QueryTunnelsResponse)(nil), "band.tunnel.v1beta1.QueryTunnelsResponse")
-	proto.RegisterType((*QueryTunnelRequest)(nil), "band.tunnel.v1beta1.QueryTunnelRequest")
-	proto.RegisterType((*QueryTunnelResponse)(nil), "band.tunnel.v1beta1.QueryTunnelResponse")
-	proto.RegisterType((*QueryDepositsRequest)(nil), "band.tunnel.v1beta1.QueryDepositsRequest")
-	proto.RegisterType((*QueryDepositsResponse)(nil), "band.tunnel.v1beta1.QueryDepositsResponse")
-	proto.RegisterType((*QueryDepositRequest)(nil), "band.tunnel.v1beta1.QueryDepositRequest")
-	proto.RegisterType((*QueryDepositResponse)(nil), "band.tunnel.v1beta1.QueryDepositResponse")
-	proto.RegisterType((*QueryPacketsRequest)(nil), "band.tunnel.v1beta1.QueryPacketsRequest")
-	proto.RegisterType((*QueryPacketsResponse)(nil), "band.tunnel.v1beta1.QueryPacketsResponse")
-	proto.RegisterType((*QueryPacketRequest)(nil), "band.tunnel.v1beta1.QueryPacketRequest")
-	proto.RegisterType((*QueryPacketResponse)(nil), "band.tunnel.v1beta1.QueryPacketResponse")
-	proto.RegisterType((*QueryTotalFeesRequest)(nil), "band.tunnel.v1beta1.QueryTotalFeesRequest")
-	proto.RegisterType((*QueryTotalFeesResponse)(nil), "band.tunnel.v1beta1.QueryTotalFeesResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "band.tunnel.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "band.tunnel.v1beta1.QueryParamsResponse")
+func (m *QueryFeePayerBalanceResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("band/tunnel/v1beta1/query.proto", fileDescriptor_f80b85392d1440ac) }
+func (m *QueryFeePayerBalanceResponse) GetFundingPolicy() *FundingPolicy {
+	if m != nil {
+		return m.FundingPolicy
+	}
+	return nil
+}
 
-var fileDescriptor_f80b85392d1440ac = []byte{
-	// 944 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x18, 0xf5, 0x84, 0x60, 0xc7, 0x53, 0x40, 0xd5, 0x34, 0xb4, 0x61, 0x13, 0x36, 0x66, 0xf9, 0x11,
-	0x37, 0x81, 0x1d, 0x25, 0x01, 0x14, 0x10, 0x42, 0xb4, 0xa9, 0x8d, 0x8c, 0x42, 0x64, 0x36, 0x0e,
-	0x07, 0x24, 0x64, 0xad, 0x9d, 0xa9, 0xbb, 0xc2, 0xd9, 0xd9, 0x7a, 0xd6, 0x15, 0xc5, 0x8a, 0x90,
-	0x10, 0x87, 0x1e, 0x38, 0x20, 0x55, 0x02, 0x09, 0x2e, 0x48, 0x1c, 0xf9, 0x47, 0x7a, 0xac, 0xc4,
-	0x85, 0x13, 0x42, 0x09, 0x7f, 0x08, 0xda, 0x99, 0x6f, 0xd6, 0x5e, 0x77, 0x59, 0x6f, 0xa4, 0xa8,
-	0x37, 0x7b, 0xf6, 0x7d, 0xf3, 0xde, 0xf7, 0xbe, 0x99, 0xb7, 0x8b, 0x57, 0x3b, 0xae, 0x7f, 0x44,
-	0xc3, 0xa1, 0xef, 0xb3, 0x3e, 0xbd, 0xb7, 0xd9, 0x61, 0xa1, 0xbb, 0x49, 0xef, 0x0e, 0xd9, 0xe0,
-	0xbe, 0x1d, 0x0c, 0x78, 0xc8, 0xc9, 0x95, 0x08, 0x60, 0x2b, 0x80, 0x0d, 0x00, 0x63, 0xb1, 0xc7,
-	0x7b, 0x5c, 0x3e, 0xa7, 0xd1, 0x2f, 0x05, 0x35, 0xd6, 0xbb, 0x5c, 0x1c, 0x73, 0x41, 0x3b, 0xae,
-	0x60, 0x6a, 0x8f, 0x78, 0xc7, 0xc0, 0xed, 0x79, 0xbe, 0x1b, 0x7a, 0xdc, 0x07, 0xec, 0x4a, 0x8f,
-	0xf3, 0x5e, 0x9f, 0x51, 0x37, 0xf0, 0xa8, 0xeb, 0xfb, 0x3c, 0x94, 0x0f, 0x05, 0x3c, 0xad, 0xa4,
-	0xa9, 0x0a, 0xdc, 0x81, 0x7b, 0x9c, 0x89, 0x00, 0x95, 0x12, 0x61, 0xfd, 0x81, 0xf0, 0x95, 0xcf,
-	0x22, 0x11, 0x2d, 0xb9, 0x2a, 0x1c, 0x76, 0x77, 0xc8, 0x44, 0x48, 0xf6, 0xf0, 0xf3, 0x22, 0x74,
-	0xc3, 0xa1, 0x68, 0xdf, 0xf6, 0xfa, 0x21, 0x1b, 0x2c, 0xa1, 0x0a, 0xaa, 0xbe, 0xb0, 0xb5, 0x66,
-	0xa7, 0x34, 0x6a, 0xab, 0xda, 0x03, 0x89, 0xaf, 0x4b, 0xb8, 0xf3, 0x9c, 0x98, 0xf8, 0x47, 0xea,
-	0x18, 0x8f, 0x7b, 0x5b, 0x9a, 0xab, 0xa0, 0xea, 0xa5, 0xad, 0x37, 0x6c, 0x65, 0x84, 0x1d, 0x19,
-	0x61, 0x2b, 0x33, 0xf5, 0x86, 0x4d, 0xb7, 0xc7, 0x40, 0x89, 0x33, 0x51, 0x69, 0xfd, 0x84, 0xf0,
-	0x62, 0x52, 0xad, 0x08, 0xb8, 0x2f, 0x18, 0x79, 0x07, 0x97, 0x94, 0x26, 0xb1, 0x84, 0x2a, 0xcf,
-	0x54, 0x2f, 0x6d, 0x2d, 0x67, 0x08, 0x75, 0x34, 0x96, 0x7c, 0x9c, 0xa2, 0x6b, 0x6d, 0xa6, 0x2e,
-	0xc5, 0x99, 0x10, 0xb6, 0x89, 0xc9, 0x84, 0x2e, 0x6d, 0xe2, 0x32, 0x2e, 0x2b, 0xa6, 0xb6, 0x77,
-	0x24, 0x0d, 0x9c, 0x77, 0x16, 0xd4, 0x42, 0xe3, 0xc8, 0x6a, 0x26, 0x8c, 0x8f, 0x3b, 0x79, 0x0f,
-	0x17, 0x15, 0x44, 0x16, 0x64, 0x37, 0x72, 0x73, 0xfe, 0xd1, 0xdf, 0xab, 0x05, 0x07, 0x0a, 0xac,
-	0x11, 0x98, 0x73, 0x8b, 0x05, 0x5c, 0x78, 0xa1, 0xc8, 0x23, 0xe3, 0xc2, 0x46, 0xf3, 0x0b, 0xc2,
-	0x2f, 0x4e, 0xb1, 0x43, 0x47, 0x3b, 0x78, 0xe1, 0x08, 0xd6, 0x60, 0x38, 0x2b, 0xa9, 0x3d, 0x41,
-	0xa1, 0x13, 0xa3, 0x2f, 0x6e, 0x3c, 0xda, 0x6b, 0x4d, 0x91, 0xc7, 0x98, 0x15, 0x5c, 0x06, 0x21,
-	0x7c, 0x20, 0xb9, 0xcb, 0xce, 0x78, 0xc1, 0x6a, 0x25, 0xbd, 0x8e, 0x9b, 0xfd, 0x00, 0x97, 0x00,
-	0x04, 0xf3, 0xcb, 0xec, 0x15, 0x06, 0xa8, 0x4b, 0xac, 0x6f, 0x40, 0x67, 0xd3, 0xed, 0x7e, 0xc5,
-	0x9e, 0xf2, 0x00, 0xe3, 0xbb, 0x15, 0x93, 0x8f, 0xef, 0x56, 0xa0, 0x96, 0x32, 0xef, 0x96, 0x2a,
-	0x73, 0x34, 0xf6, 0xe2, 0x86, 0xf7, 0x29, 0xdc, 0x2d, 0x20, 0xc8, 0xe3, 0x89, 0x81, 0x17, 0x44,
-	0x84, 0xf3, 0xbb, 0x4c, 0x32, 0xcf, 0x3b, 0xf1, 0x7f, 0xeb, 0x93, 0x84, 0xc7, 0x71, 0x97, 0xdb,
-	0xb8, 0xa8, 0x94, 0x67, 0xde, 0x3b, 0x28, 0x02, 0xa8, 0x75, 0x0d, 0xce, 0x7c, 0x8b, 0x87, 0x6e,
-	0xbf, 0xce, 0x98, 0x9e, 0x98, 0xf5, 0x25, 0xbe, 0x3a, 0xfd, 0x00, 0x78, 0x76, 0x31, 0x0e, 0xa3,
-	0xc5, 0xf6, 0x6d, 0xc6, 0x04, 0x70, 0x99, 0xe9, 0x77, 0x5c, 0xd7, 0xc2, 0x29, 0x29, 0x87, 0x7a,
-	0xc1, 0x5a, 0x8c, 0x2d, 0x89, 0xc2, 0x5e, 0x93, 0x36, 0xe3, 0xce, 0xd4, 0xea, 0x38, 0x51, 0xd4,
-	0x4b, 0x61, 0x46, 0x67, 0x11, 0x44, 0x27, 0x8a, 0x2a, 0x58, 0xff, 0x1e, 0x61, 0xf2, 0x64, 0xb8,
-	0x93, 0xd7, 0x70, 0xa5, 0x75, 0xb8, 0xbf, 0x5f, 0xdb, 0x6b, 0x1f, 0xb4, 0x6e, 0xb4, 0x0e, 0x0f,
-	0xda, 0xf5, 0xc6, 0x5e, 0xab, 0xe6, 0xb4, 0x0f, 0xf7, 0x0f, 0x9a, 0xb5, 0xdd, 0x46, 0xbd, 0x51,
-	0xbb, 0x75, 0xb9, 0x40, 0x56, 0xf1, 0x72, 0x2a, 0xea, 0xc6, 0x6e, 0xab, 0xf1, 0x79, 0xed, 0x32,
-	0x22, 0xaf, 0xe0, 0x97, 0x53, 0x01, 0x8d, 0x7d, 0x80, 0xcc, 0x19, 0xf3, 0x0f, 0x7e, 0x37, 0x0b,
-	0x5b, 0x3f, 0x94, 0xf1, 0xb3, 0xb2, 0x33, 0xf2, 0x2d, 0x2e, 0x41, 0xf4, 0x93, 0x6a, 0x6a, 0x1b,
-	0x29, 0xef, 0x32, 0xe3, 0x7a, 0x0e, 0xa4, 0xf2, 0xca, 0x5a, 0xfd, 0xee, 0xcf, 0x7f, 0x1f, 0xce,
-	0xbd, 0x44, 0xae, 0xa5, 0xbf, 0x34, 0x05, 0x79, 0x80, 0x70, 0x51, 0x15, 0x91, 0xb5, 0x59, 0xdb,
-	0x6a, 0xfe, 0xea, 0x6c, 0x20, 0xd0, 0x6f, 0x48, 0xfa, 0xd7, 0xc9, 0xab, 0xff, 0x43, 0x4f, 0x47,
-	0xf1, 0x99, 0x3f, 0x21, 0x3f, 0x23, 0xbc, 0xa0, 0xc3, 0x96, 0x64, 0xf4, 0x38, 0xf5, 0x3a, 0x30,
-	0xd6, 0xf3, 0x40, 0x41, 0xd0, 0xdb, 0x52, 0x90, 0x4d, 0xde, 0xcc, 0x21, 0x88, 0xc6, 0xb9, 0xfd,
-	0x1b, 0xc2, 0x25, 0xd8, 0x2a, 0x6b, 0x4c, 0xc9, 0x34, 0x36, 0xae, 0xe7, 0x40, 0x82, 0xac, 0x8f,
-	0xa4, 0xac, 0xf7, 0xc9, 0xce, 0x79, 0x64, 0xd1, 0x51, 0x1c, 0xdf, 0x27, 0xe4, 0x21, 0xc2, 0x25,
-	0x08, 0xba, 0x2c, 0x89, 0xc9, 0x20, 0xce, 0x92, 0x38, 0x95, 0x9a, 0xd6, 0xb6, 0x94, 0xf8, 0x16,
-	0xd9, 0xc8, 0x23, 0x51, 0x67, 0xe6, 0xaf, 0x08, 0x17, 0xd5, 0x46, 0x59, 0xa7, 0x2b, 0x11, 0x84,
-	0x46, 0x75, 0x36, 0x10, 0x24, 0x7d, 0x28, 0x25, 0xed, 0x90, 0x77, 0xcf, 0x21, 0x89, 0x8e, 0x74,
-	0x70, 0x9e, 0x44, 0x67, 0xbf, 0x1c, 0x87, 0x12, 0xc9, 0x38, 0x46, 0xd3, 0x71, 0x68, 0x6c, 0xe4,
-	0xc2, 0x82, 0x4c, 0x4b, 0xca, 0x5c, 0x21, 0xc6, 0x13, 0x32, 0xe3, 0xdc, 0x24, 0xa3, 0xc8, 0xa7,
-	0x28, 0xa2, 0xb2, 0x7d, 0x9a, 0x48, 0xc7, 0x6c, 0x9f, 0x26, 0x03, 0xd3, 0x32, 0xa5, 0x80, 0x25,
-	0x72, 0x35, 0xfd, 0xdb, 0xfa, 0x66, 0xe3, 0xd1, 0xa9, 0x89, 0x1e, 0x9f, 0x9a, 0xe8, 0x9f, 0x53,
-	0x13, 0xfd, 0x78, 0x66, 0x16, 0x1e, 0x9f, 0x99, 0x85, 0xbf, 0xce, 0xcc, 0xc2, 0x17, 0xb4, 0xe7,
-	0x85, 0x77, 0x86, 0x1d, 0xbb, 0xcb, 0x8f, 0x69, 0xc4, 0x26, 0xbf, 0xb1, 0xbb, 0xbc, 0x4f, 0xbb,
-	0x77, 0x5c, 0xcf, 0xa7, 0xf7, 0xb6, 0xe9, 0xd7, 0x7a, 0xcf, 0xf0, 0x7e, 0xc0, 0x44, 0xa7, 0x28,
-	0x11, 0xdb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x75, 0xeb, 0x1c, 0x48, 0x61, 0x0c, 0x00, 0x00,
+// QueryEstimateTunnelCostRequest is the request type for the Query/EstimateTunnelCost RPC method.
+type QueryEstimateTunnelCostRequest struct {
+	// route is the proposed route of the tunnel.
+	Route *types1.Any `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// signal_deviations is the list of proposed signal deviations.
+	SignalDeviations []SignalDeviation `protobuf:"bytes,2,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations"`
+	// interval is the proposed interval for delivering the signal prices in seconds.
+	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// relayer_fee is the expected fee paid off-chain to the relayer for each packet of an IBC-based route.
+	// It is only used for the estimate and is not charged by the tunnel module.
+	RelayerFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=relayer_fee,json=relayerFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"relayer_fee"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryEstimateTunnelCostRequest) Reset()         { *m = QueryEstimateTunnelCostRequest{} }
+func (m *QueryEstimateTunnelCostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateTunnelCostRequest) ProtoMessage()    {}
+func (*QueryEstimateTunnelCostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{20}
+}
+func (m *QueryEstimateTunnelCostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateTunnelCostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateTunnelCostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateTunnelCostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTunnelCostRequest.Merge(m, src)
+}
+func (m *QueryEstimateTunnelCostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateTunnelCostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTunnelCostRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryEstimateTunnelCostRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Tunnels is a RPC method that returns all tunnels.
-	Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error)
-	// Tunnel is a RPC method that returns a tunnel by its ID.
-	Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error)
-	// Deposits queries all deposits of a single tunnel.
-	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
-	// Deposit queries single deposit information based tunnelID, depositAddr.
-	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
-	// Packets is a RPC method that returns all packets of a tunnel.
-	Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error)
-	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
-	Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error)
-	// TotalFees is a RPC method that returns the total fees collected by the tunnel
-	TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error)
-	// Params is a RPC method that returns all parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryEstimateTunnelCostRequest) GetRoute() *types1.Any {
+	if m != nil {
+		return m.Route
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryEstimateTunnelCostRequest) GetSignalDeviations() []SignalDeviation {
+	if m != nil {
+		return m.SignalDeviations
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryEstimateTunnelCostRequest) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
 }
 
-func (c *queryClient) Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error) {
-	out := new(QueryTunnelsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnels", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateTunnelCostRequest) GetRelayerFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RelayerFee
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error) {
-	out := new(QueryTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryEstimateTunnelCostResponse is the response type for the Query/EstimateTunnelCost RPC method.
+type QueryEstimateTunnelCostResponse struct {
+	// min_deposit is the minimum deposit required to activate the tunnel.
+	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit"`
+	// base_packet_fee is the base fee charged by the tunnel module for each packet.
+	BasePacketFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=base_packet_fee,json=basePacketFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"base_packet_fee"`
+	// route_fee is the fee charged by the route for each packet, e.g. the bandtss signing fee.
+	RouteFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=route_fee,json=routeFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"route_fee"`
+	// packet_fee is the total cost of each packet, including the relayer fee.
+	PacketFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=packet_fee,json=packetFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"packet_fee"`
+	// packets_per_day is the estimated number of packets produced per day, including the packets
+	// triggered by price deviations.
+	PacketsPerDay uint64 `protobuf:"varint,5,opt,name=packets_per_day,json=packetsPerDay,proto3" json:"packets_per_day,omitempty"`
+	// daily_cost is the estimated cost of running the tunnel per day.
+	DailyCost github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=daily_cost,json=dailyCost,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_cost"`
+	// deviation_packets_per_day is the estimated number of packets per day triggered by price deviations,
+	// modeled from the price history of the signals.
+	DeviationPacketsPerDay uint64 `protobuf:"varint,7,opt,name=deviation_packets_per_day,json=deviationPacketsPerDay,proto3" json:"deviation_packets_per_day,omitempty"`
+	// relayer_fee is the expected fee paid off-chain to the relayer for each packet, which is zero for
+	// routes that are not relayed through IBC.
+	RelayerFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=relayer_fee,json=relayerFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"relayer_fee"`
+}
+
+func (m *QueryEstimateTunnelCostResponse) Reset()         { *m = QueryEstimateTunnelCostResponse{} }
+func (m *QueryEstimateTunnelCostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateTunnelCostResponse) ProtoMessage()    {}
+func (*QueryEstimateTunnelCostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{21}
+}
+func (m *QueryEstimateTunnelCostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateTunnelCostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateTunnelCostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEstimateTunnelCostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTunnelCostResponse.Merge(m, src)
+}
+func (m *QueryEstimateTunnelCostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateTunnelCostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTunnelCostResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
-	out := new(QueryDepositsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposits", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEstimateTunnelCostResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateTunnelCostResponse) GetMinDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinDeposit
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
-	out := new(QueryDepositResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateTunnelCostResponse) GetBasePacketFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BasePacketFee
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error) {
-	out := new(QueryPacketsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateTunnelCostResponse) GetRouteFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RouteFee
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error) {
-	out := new(QueryPacketResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateTunnelCostResponse) GetPacketFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PacketFee
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error) {
-	out := new(QueryTotalFeesResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/TotalFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateTunnelCostResponse) GetPacketsPerDay() uint64 {
+	if m != nil {
+		return m.PacketsPerDay
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateTunnelCostResponse) GetDailyCost() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailyCost
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Tunnels is a RPC method that returns all tunnels.
-	Tunnels(context.Context, *QueryTunnelsRequest) (*QueryTunnelsResponse, error)
-	// Tunnel is a RPC method that returns a tunnel by its ID.
-	Tunnel(context.Context, *QueryTunnelRequest) (*QueryTunnelResponse, error)
-	// Deposits queries all deposits of a single tunnel.
-	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
-	// Deposit queries single deposit information based tunnelID, depositAddr.
-	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
-	// Packets is a RPC method that returns all packets of a tunnel.
-	Packets(context.Context, *QueryPacketsRequest) (*QueryPacketsResponse, error)
-	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
-	Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error)
-	// TotalFees is a RPC method that returns the total fees collected by the tunnel
-	TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error)
-	// Params is a RPC method that returns all parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryEstimateTunnelCostResponse) GetDeviationPacketsPerDay() uint64 {
+	if m != nil {
+		return m.DeviationPacketsPerDay
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryEstimateTunnelCostResponse) GetRelayerFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RelayerFee
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Tunnels(ctx context.Context, req *QueryTunnelsRequest) (*QueryTunnelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tunnels not implemented")
-}
-func (*UnimplementedQueryServer) Tunnel(ctx context.Context, req *QueryTunnelRequest) (*QueryTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tunnel not implemented")
+// QueryTotalFeesRequest is the request type for the Query/TotalFees RPC method.
+type QueryTotalFeesRequest struct {
 }
-func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+
+func (m *QueryTotalFeesRequest) Reset()         { *m = QueryTotalFeesRequest{} }
+func (m *QueryTotalFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalFeesRequest) ProtoMessage()    {}
+func (*QueryTotalFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{22}
 }
-func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *QueryTotalFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Packets(ctx context.Context, req *QueryPacketsRequest) (*QueryPacketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Packets not implemented")
+func (m *QueryTotalFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Packet(ctx context.Context, req *QueryPacketRequest) (*QueryPacketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Packet not implemented")
+func (m *QueryTotalFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalFeesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalFees(ctx context.Context, req *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalFees not implemented")
+func (m *QueryTotalFeesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryTotalFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalFeesRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryTotalFeesRequest proto.InternalMessageInfo
 
-func _Query_Tunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTunnelsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Tunnels(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Tunnels",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Tunnels(ctx, req.(*QueryTunnelsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryTotalFeesResponse is the response type for the Query/TotalFees RPC method.
+type QueryTotalFeesResponse struct {
+	// total_fees is the total fees collected by the tunnel.
+	TotalFees TotalFees `protobuf:"bytes,1,opt,name=total_fees,json=totalFees,proto3" json:"total_fees"`
 }
 
-func _Query_Tunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTunnelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Tunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Tunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Tunnel(ctx, req.(*QueryTunnelRequest))
+func (m *QueryTotalFeesResponse) Reset()         { *m = QueryTotalFeesResponse{} }
+func (m *QueryTotalFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalFeesResponse) ProtoMessage()    {}
+func (*QueryTotalFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{23}
+}
+func (m *QueryTotalFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTotalFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalFeesResponse.Merge(m, src)
+}
+func (m *QueryTotalFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalFeesResponse.DiscardUnknown(m)
 }
 
-func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Deposits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+var xxx_messageInfo_QueryTotalFeesResponse proto.InternalMessageInfo
+
+func (m *QueryTotalFeesResponse) GetTotalFees() TotalFees {
+	if m != nil {
+		return m.TotalFees
 	}
-	return interceptor(ctx, in, info, handler)
+	return TotalFees{}
 }
 
-func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposit(ctx, req.(*QueryDepositRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func _Query_Packets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPacketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Packets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Packets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Packets(ctx, req.(*QueryPacketsRequest))
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{24}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func _Query_Packet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPacketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Packet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Packet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Packet(ctx, req.(*QueryPacketRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params is the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func _Query_TotalFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/TotalFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalFees(ctx, req.(*QueryTotalFeesRequest))
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{25}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "band.tunnel.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Tunnels",
-			Handler:    _Query_Tunnels_Handler,
-		},
-		{
-			MethodName: "Tunnel",
-			Handler:    _Query_Tunnel_Handler,
-		},
-		{
-			MethodName: "Deposits",
-			Handler:    _Query_Deposits_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _Query_Deposit_Handler,
-		},
-		{
-			MethodName: "Packets",
-			Handler:    _Query_Packets_Handler,
-		},
-		{
-			MethodName: "Packet",
-			Handler:    _Query_Packet_Handler,
-		},
-		{
-			MethodName: "TotalFees",
-			Handler:    _Query_TotalFees_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "band/tunnel/v1beta1/query.proto",
+func init() {
+	proto.RegisterEnum("band.tunnel.v1beta1.TunnelStatusFilter", TunnelStatusFilter_name, TunnelStatusFilter_value)
+	proto.RegisterType((*QueryTunnelsRequest)(nil), "band.tunnel.v1beta1.QueryTunnelsRequest")
+	proto.RegisterType((*QueryTunnelsResponse)(nil), "band.tunnel.v1beta1.QueryTunnelsResponse")
+	proto.RegisterType((*QueryTunnelRequest)(nil), "band.tunnel.v1beta1.QueryTunnelRequest")
+	proto.RegisterType((*QueryTunnelResponse)(nil), "band.tunnel.v1beta1.QueryTunnelResponse")
+	proto.RegisterType((*QueryDepositsRequest)(nil), "band.tunnel.v1beta1.QueryDepositsRequest")
+	proto.RegisterType((*QueryDepositsResponse)(nil), "band.tunnel.v1beta1.QueryDepositsResponse")
+	proto.RegisterType((*QueryDepositRequest)(nil), "band.tunnel.v1beta1.QueryDepositRequest")
+	proto.RegisterType((*QueryDepositResponse)(nil), "band.tunnel.v1beta1.QueryDepositResponse")
+	proto.RegisterType((*QueryPacketsRequest)(nil), "band.tunnel.v1beta1.QueryPacketsRequest")
+	proto.RegisterType((*QueryPacketsResponse)(nil), "band.tunnel.v1beta1.QueryPacketsResponse")
+	proto.RegisterType((*QueryPacketsByTimeRequest)(nil), "band.tunnel.v1beta1.QueryPacketsByTimeRequest")
+	proto.RegisterType((*QueryPacketsByTimeResponse)(nil), "band.tunnel.v1beta1.QueryPacketsByTimeResponse")
+	proto.RegisterType((*QueryPacketsByStatusRequest)(nil), "band.tunnel.v1beta1.QueryPacketsByStatusRequest")
+	proto.RegisterType((*QueryPacketsByStatusResponse)(nil), "band.tunnel.v1beta1.QueryPacketsByStatusResponse")
+	proto.RegisterType((*QueryPacketRequest)(nil), "band.tunnel.v1beta1.QueryPacketRequest")
+	proto.RegisterType((*QueryPacketResponse)(nil), "band.tunnel.v1beta1.QueryPacketResponse")
+	proto.RegisterType((*QueryFailedPacketsRequest)(nil), "band.tunnel.v1beta1.QueryFailedPacketsRequest")
+	proto.RegisterType((*QueryFailedPacketsResponse)(nil), "band.tunnel.v1beta1.QueryFailedPacketsResponse")
+	proto.RegisterType((*QueryFeePayerBalanceRequest)(nil), "band.tunnel.v1beta1.QueryFeePayerBalanceRequest")
+	proto.RegisterType((*QueryFeePayerBalanceResponse)(nil), "band.tunnel.v1beta1.QueryFeePayerBalanceResponse")
+	proto.RegisterType((*QueryEstimateTunnelCostRequest)(nil), "band.tunnel.v1beta1.QueryEstimateTunnelCostRequest")
+	proto.RegisterType((*QueryEstimateTunnelCostResponse)(nil), "band.tunnel.v1beta1.QueryEstimateTunnelCostResponse")
+	proto.RegisterType((*QueryTotalFeesRequest)(nil), "band.tunnel.v1beta1.QueryTotalFeesRequest")
+	proto.RegisterType((*QueryTotalFeesResponse)(nil), "band.tunnel.v1beta1.QueryTotalFeesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "band.tunnel.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "band.tunnel.v1beta1.QueryParamsResponse")
 }
 
-func (m *QueryTunnelsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("band/tunnel/v1beta1/query.proto", fileDescriptor_f80b85392d1440ac) }
+
+var fileDescriptor_f80b85392d1440ac = []byte{
+	// 1719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0xdb, 0x56,
+	0x12, 0x37, 0xfd, 0xa1, 0x8f, 0x71, 0x1c, 0x67, 0x5f, 0xbc, 0x89, 0x4c, 0x3b, 0xb2, 0xcd, 0x64,
+	0x13, 0xd9, 0x59, 0x8b, 0xb1, 0x9d, 0x64, 0x13, 0xef, 0x07, 0xd6, 0x5f, 0xda, 0xd5, 0x22, 0x6b,
+	0x68, 0x69, 0x65, 0x17, 0x58, 0x60, 0x41, 0x50, 0xd2, 0x93, 0xc2, 0x8d, 0x44, 0x2a, 0x22, 0x65,
+	0x54, 0x35, 0x82, 0x16, 0x45, 0x81, 0xe6, 0x58, 0x20, 0x40, 0x03, 0xb4, 0x45, 0xd1, 0xa2, 0xb7,
+	0xa2, 0x45, 0x7b, 0x48, 0x2f, 0xed, 0xa9, 0xe8, 0x25, 0xe8, 0x29, 0x68, 0x2f, 0x3d, 0xb5, 0x45,
+	0xd2, 0xbf, 0xa2, 0xa7, 0x82, 0xef, 0x0d, 0x69, 0x51, 0xa6, 0x25, 0x3a, 0xb0, 0xdb, 0x9c, 0x62,
+	0xbe, 0xf7, 0x9b, 0x99, 0xdf, 0xcc, 0xbc, 0x79, 0x6f, 0x46, 0x81, 0xa9, 0x82, 0x66, 0x94, 0x64,
+	0xbb, 0x69, 0x18, 0xb4, 0x2a, 0x6f, 0x2f, 0x14, 0xa8, 0xad, 0x2d, 0xc8, 0x77, 0x9a, 0xb4, 0xd1,
+	0x4a, 0xd7, 0x1b, 0xa6, 0x6d, 0x92, 0x93, 0x0e, 0x20, 0xcd, 0x01, 0x69, 0x04, 0x88, 0xe3, 0x15,
+	0xd3, 0xac, 0x54, 0xa9, 0xcc, 0x20, 0x85, 0x66, 0x59, 0xd6, 0x0c, 0xc4, 0x8b, 0x63, 0x15, 0xb3,
+	0x62, 0xb2, 0x3f, 0x65, 0xe7, 0x2f, 0x5c, 0x1d, 0x2f, 0x9a, 0x56, 0xcd, 0xb4, 0x54, 0xbe, 0xc1,
+	0x3f, 0x70, 0x6b, 0x8e, 0x7f, 0xc9, 0x05, 0xcd, 0xa2, 0xdc, 0xb2, 0xc7, 0xa3, 0xae, 0x55, 0x74,
+	0x43, 0xb3, 0x75, 0xd3, 0x40, 0x6c, 0xb2, 0x1d, 0xeb, 0xa2, 0x8a, 0xa6, 0xee, 0xee, 0x4f, 0x22,
+	0x2f, 0xad, 0xae, 0xcb, 0x9a, 0x61, 0x98, 0x36, 0x13, 0x76, 0x2d, 0x4d, 0x07, 0xf9, 0x5a, 0xd7,
+	0x1a, 0x5a, 0xad, 0x2b, 0x02, 0x7d, 0x67, 0x08, 0xe9, 0x0b, 0x01, 0x4e, 0xfe, 0xcb, 0x21, 0x99,
+	0x67, 0xab, 0x96, 0x42, 0xef, 0x34, 0xa9, 0x65, 0x93, 0x1b, 0x30, 0x62, 0xd9, 0x9a, 0xdd, 0xb4,
+	0xd4, 0xb2, 0x5e, 0xb5, 0x69, 0x23, 0x21, 0x4c, 0x0b, 0xa9, 0xe3, 0x8b, 0x17, 0xd2, 0x01, 0xe1,
+	0x4b, 0x73, 0xd9, 0x2d, 0x86, 0xcf, 0x30, 0xb8, 0x72, 0xcc, 0x6a, 0xfb, 0x22, 0x19, 0x80, 0x5d,
+	0xdf, 0x13, 0xfd, 0xd3, 0x42, 0x6a, 0x78, 0xf1, 0x7c, 0x1a, 0xc3, 0xe6, 0x38, 0x9f, 0xe6, 0x29,
+	0x72, 0x15, 0xe6, 0xb4, 0x0a, 0x45, 0x26, 0x4a, 0x9b, 0x24, 0x19, 0x83, 0x21, 0xad, 0x54, 0xd3,
+	0x8d, 0xc4, 0xc0, 0xb4, 0x90, 0x8a, 0x2b, 0xfc, 0x43, 0x7a, 0x43, 0x80, 0x31, 0xbf, 0x0f, 0x56,
+	0xdd, 0x34, 0x2c, 0x4a, 0xae, 0x40, 0x94, 0x33, 0xb5, 0x12, 0xc2, 0xf4, 0x40, 0x6a, 0x78, 0x71,
+	0xa2, 0x0b, 0x7d, 0xc5, 0xc5, 0x92, 0xbf, 0x05, 0xb0, 0xbd, 0xd0, 0x93, 0x2d, 0xb7, 0xd9, 0x4e,
+	0x57, 0x5a, 0x00, 0xd2, 0xc6, 0xcb, 0x0d, 0xed, 0x04, 0xc4, 0xb9, 0x25, 0x55, 0x2f, 0xb1, 0xb0,
+	0x0e, 0x2a, 0x31, 0xbe, 0x90, 0x2d, 0x49, 0x39, 0x5f, 0x3a, 0x3c, 0x4f, 0xae, 0x43, 0x84, 0x43,
+	0x98, 0x40, 0x77, 0x47, 0x56, 0x07, 0x1f, 0x7d, 0x37, 0xd5, 0xa7, 0xa0, 0x80, 0xb4, 0x83, 0xc1,
+	0x59, 0xa7, 0x75, 0xd3, 0xd2, 0x6d, 0x2b, 0x0c, 0x8d, 0xc3, 0x4a, 0x98, 0xf4, 0xa6, 0x00, 0xbf,
+	0xed, 0xb0, 0x8e, 0x1e, 0x5d, 0x83, 0x58, 0x09, 0xd7, 0x30, 0x39, 0x93, 0x81, 0x3e, 0xa1, 0xa0,
+	0xe2, 0xa1, 0x0f, 0x2f, 0x3d, 0x6e, 0xac, 0x5d, 0x13, 0x61, 0x02, 0x33, 0x09, 0x71, 0x24, 0x62,
+	0x36, 0x98, 0xed, 0xb8, 0xb2, 0xbb, 0x20, 0xe5, 0xfd, 0xb1, 0xf6, 0x9c, 0xfd, 0x13, 0x44, 0x11,
+	0x84, 0xf9, 0xeb, 0xea, 0x2b, 0x26, 0xd0, 0x15, 0x91, 0x5e, 0x44, 0x9e, 0x39, 0xad, 0x78, 0x9b,
+	0xfe, 0xc2, 0x09, 0xf4, 0x6a, 0xcb, 0x33, 0xbe, 0x5b, 0x5b, 0x75, 0xbe, 0xd4, 0xb5, 0xb6, 0xb8,
+	0x98, 0xe2, 0x62, 0x0f, 0x2f, 0x79, 0x9f, 0x09, 0x30, 0xde, 0x4e, 0x6c, 0xb5, 0x95, 0xd7, 0x6b,
+	0x34, 0x54, 0x6c, 0xce, 0x00, 0x58, 0xb6, 0xd6, 0xb0, 0x55, 0x5b, 0xaf, 0x51, 0xc6, 0x61, 0x40,
+	0x89, 0xb3, 0x15, 0x47, 0x05, 0x19, 0x87, 0x18, 0x35, 0x4a, 0x7c, 0x73, 0x80, 0x6d, 0x46, 0xa9,
+	0x51, 0x62, 0x5b, 0xfe, 0xa8, 0x0e, 0x3e, 0x73, 0x54, 0xdf, 0x16, 0x40, 0x0c, 0x22, 0xff, 0x9c,
+	0xc4, 0xf6, 0x4b, 0x01, 0x26, 0xfc, 0xf4, 0xf8, 0xdd, 0xee, 0x46, 0xf7, 0x3a, 0x44, 0xf8, 0xf5,
+	0x8e, 0xaf, 0xc2, 0x4c, 0x17, 0x7a, 0x28, 0x89, 0x02, 0x64, 0x06, 0x8e, 0x35, 0x68, 0x91, 0xea,
+	0x75, 0x5b, 0xb5, 0x5b, 0x75, 0x8a, 0x25, 0x34, 0x8c, 0x6b, 0xf9, 0x56, 0xbd, 0x33, 0xc8, 0x03,
+	0xcf, 0x1c, 0xe4, 0x77, 0x04, 0x98, 0x0c, 0xf6, 0xe2, 0x39, 0x09, 0xf3, 0x3f, 0xf1, 0x79, 0x40,
+	0x03, 0x61, 0x8e, 0xae, 0x08, 0x31, 0xcb, 0xc1, 0x19, 0x45, 0x1e, 0xba, 0x41, 0xc5, 0xfb, 0x96,
+	0xfe, 0xe1, 0xbb, 0x26, 0x3c, 0x2f, 0x97, 0x20, 0xc2, 0x99, 0x77, 0x7d, 0x3a, 0x50, 0x08, 0xa1,
+	0xd2, 0xcb, 0x6e, 0x75, 0x65, 0x34, 0xbd, 0x4a, 0x4b, 0xbf, 0xc6, 0xcd, 0xf3, 0xb1, 0x5b, 0x23,
+	0x1d, 0x14, 0xd0, 0xad, 0xbf, 0xc3, 0xf1, 0x32, 0xdb, 0x50, 0xfd, 0x39, 0x0c, 0x3e, 0x8b, 0xed,
+	0x3a, 0x94, 0x91, 0x72, 0xbb, 0xc6, 0xc3, 0xcb, 0xe7, 0x32, 0x56, 0x4d, 0x86, 0xd2, 0x9c, 0xd6,
+	0xa2, 0x8d, 0x55, 0xad, 0xaa, 0x19, 0xc5, 0x50, 0x77, 0x92, 0xf4, 0x5a, 0x3f, 0x4c, 0x06, 0x0b,
+	0x7b, 0x87, 0x35, 0x5e, 0xa6, 0x54, 0xad, 0x3b, 0x7b, 0x4c, 0x3a, 0xbe, 0x9a, 0xf8, 0xfa, 0xe1,
+	0xfc, 0x18, 0xf2, 0x5c, 0x29, 0x95, 0x1a, 0xd4, 0xb2, 0xb6, 0xec, 0x86, 0x6e, 0x54, 0x94, 0x58,
+	0x19, 0xb5, 0x10, 0x0a, 0xd1, 0x02, 0xd7, 0x94, 0xe8, 0x67, 0xf1, 0x19, 0xf7, 0x79, 0xe6, 0xfa,
+	0xb4, 0x66, 0xea, 0xc6, 0xea, 0x25, 0xe7, 0xd9, 0xf9, 0xe0, 0xfb, 0xa9, 0x54, 0x45, 0xb7, 0x6f,
+	0x35, 0x0b, 0xe9, 0xa2, 0x59, 0xc3, 0xd6, 0x16, 0xff, 0x99, 0xb7, 0x4a, 0xb7, 0x65, 0xa7, 0x6c,
+	0x2d, 0x26, 0x60, 0x29, 0xae, 0x6e, 0x92, 0x85, 0xe3, 0xe5, 0xa6, 0x51, 0xd2, 0x8d, 0x8a, 0x5a,
+	0x37, 0xab, 0x7a, 0xb1, 0x85, 0x75, 0x2b, 0x05, 0x67, 0x83, 0x43, 0x73, 0x0c, 0xa9, 0x8c, 0x94,
+	0xdb, 0x3f, 0xa5, 0xcf, 0xfb, 0x21, 0xc9, 0x22, 0xb1, 0x61, 0xd9, 0x7a, 0x4d, 0xb3, 0x29, 0xef,
+	0x6a, 0xd6, 0x4c, 0xcb, 0x2b, 0x91, 0xab, 0x30, 0xd4, 0x30, 0x9b, 0x36, 0xc5, 0x13, 0x3d, 0x96,
+	0xe6, 0x6d, 0x72, 0xda, 0x6d, 0xdf, 0xd3, 0x2b, 0x46, 0x6b, 0x15, 0xbe, 0x7a, 0x38, 0x1f, 0x51,
+	0x1c, 0x58, 0x56, 0xe1, 0x70, 0xf2, 0x1f, 0xf8, 0x8d, 0xa5, 0x57, 0x0c, 0xad, 0xaa, 0x96, 0xe8,
+	0xb6, 0xce, 0x7b, 0x69, 0x0c, 0xcb, 0xb9, 0x40, 0xa2, 0x5b, 0x0c, 0xbd, 0xee, 0x82, 0xf1, 0x61,
+	0x3e, 0x61, 0xf9, 0x97, 0x2d, 0xa7, 0x2c, 0x75, 0xc3, 0xa6, 0x8d, 0x6d, 0xad, 0xca, 0x1c, 0x1f,
+	0x54, 0xbc, 0x6f, 0x52, 0x85, 0xe1, 0x06, 0xad, 0x3a, 0xc9, 0x50, 0xcb, 0x94, 0x26, 0x06, 0x0f,
+	0x3f, 0x0b, 0x80, 0xfa, 0x33, 0x94, 0x4a, 0x0f, 0x22, 0x30, 0xb5, 0x6f, 0xf4, 0xf0, 0x28, 0x55,
+	0x61, 0xb8, 0xa6, 0x1b, 0xea, 0x6e, 0x47, 0x72, 0xf8, 0x8c, 0x6a, 0xba, 0x81, 0xcd, 0x0c, 0xb1,
+	0x60, 0xd4, 0x51, 0x89, 0x65, 0xca, 0x62, 0x70, 0x04, 0x27, 0x71, 0xc4, 0x51, 0xc2, 0x2b, 0x3a,
+	0x43, 0x29, 0xb9, 0x05, 0x71, 0x96, 0x72, 0x66, 0x6e, 0xe0, 0xf0, 0xcd, 0xc5, 0x98, 0x76, 0xc7,
+	0xd2, 0xff, 0x9d, 0xdb, 0xc3, 0xf3, 0xec, 0x08, 0xb2, 0x1b, 0xaf, 0x7b, 0x5e, 0x9d, 0x87, 0x51,
+	0xfe, 0x61, 0xa9, 0x75, 0xda, 0x50, 0x4b, 0x5a, 0x2b, 0x31, 0xc4, 0x4e, 0xdb, 0x08, 0x2e, 0xe7,
+	0x68, 0x63, 0x5d, 0x6b, 0x39, 0x9c, 0x4a, 0x9a, 0x5e, 0x6d, 0xa9, 0x45, 0xd3, 0xb2, 0x13, 0x91,
+	0x23, 0xe0, 0xc4, 0xd4, 0x3b, 0x87, 0x8a, 0x5c, 0x87, 0x71, 0xaf, 0x98, 0xd4, 0x4e, 0x76, 0x51,
+	0xc6, 0xee, 0x94, 0x07, 0xc8, 0xf9, 0x68, 0x76, 0x54, 0x46, 0xec, 0x68, 0x2b, 0xe3, 0x34, 0x4e,
+	0x22, 0x79, 0xd3, 0xd6, 0xaa, 0x19, 0x4a, 0xdd, 0xd7, 0x4c, 0xfa, 0x1f, 0x9c, 0xea, 0xdc, 0xc0,
+	0x42, 0x59, 0x03, 0xb0, 0x9d, 0x45, 0x87, 0x9e, 0x85, 0x97, 0x4d, 0x32, 0x78, 0xf2, 0x72, 0x65,
+	0xf1, 0x8a, 0x88, 0xdb, 0xee, 0x82, 0x34, 0xe6, 0xbd, 0xf2, 0xce, 0x60, 0xee, 0x1a, 0xcd, 0xc1,
+	0x49, 0xdf, 0xea, 0xee, 0x9c, 0xc7, 0x07, 0xf8, 0x1e, 0x8f, 0xb5, 0x03, 0x71, 0xe7, 0x3c, 0x2e,
+	0x30, 0xf7, 0xaa, 0x00, 0x64, 0xef, 0x20, 0x4e, 0xce, 0xc1, 0x74, 0xfe, 0xe6, 0xe6, 0xe6, 0xc6,
+	0x0d, 0x75, 0x2b, 0xbf, 0x92, 0xbf, 0xb9, 0xa5, 0x66, 0xb2, 0x37, 0xf2, 0x1b, 0x8a, 0x7a, 0x73,
+	0x73, 0x2b, 0xb7, 0xb1, 0x96, 0xcd, 0x64, 0x37, 0xd6, 0x4f, 0xf4, 0x91, 0x29, 0x98, 0x08, 0x44,
+	0xad, 0xac, 0xe5, 0xb3, 0xff, 0xde, 0x38, 0x21, 0x90, 0x19, 0x38, 0x13, 0x08, 0xc8, 0x6e, 0x22,
+	0xa4, 0x5f, 0x1c, 0xbc, 0xf7, 0x7e, 0xb2, 0x6f, 0xf1, 0xa7, 0x51, 0x18, 0x62, 0x9e, 0x91, 0x97,
+	0x20, 0x9a, 0xc7, 0x89, 0x3a, 0x15, 0xe8, 0x46, 0xc0, 0xef, 0x0e, 0xe2, 0x6c, 0x08, 0x24, 0x8f,
+	0x95, 0x34, 0xf5, 0xca, 0x37, 0x3f, 0xde, 0xef, 0x1f, 0x27, 0xa7, 0x83, 0x7f, 0xe0, 0xb0, 0xc8,
+	0x3d, 0x01, 0x22, 0x5c, 0x88, 0x5c, 0xe8, 0xa5, 0xd6, 0xb5, 0x9f, 0xea, 0x0d, 0x44, 0xf3, 0x17,
+	0x99, 0xf9, 0xdf, 0x91, 0xb3, 0xfb, 0x98, 0x97, 0x77, 0xbc, 0xd7, 0xfe, 0x2e, 0x79, 0x20, 0x40,
+	0xcc, 0x1d, 0x81, 0x49, 0x17, 0x1f, 0x3b, 0x86, 0x74, 0x71, 0x2e, 0x0c, 0x14, 0x09, 0x5d, 0x66,
+	0x84, 0xd2, 0xe4, 0xf7, 0x21, 0x08, 0xc9, 0xde, 0x34, 0xfd, 0xae, 0x00, 0x51, 0xf7, 0xaa, 0x4e,
+	0xf5, 0xb4, 0x16, 0x22, 0x4d, 0x1d, 0xb3, 0xaf, 0xf4, 0x57, 0x46, 0x6b, 0x99, 0x5c, 0x3b, 0x08,
+	0x2d, 0x79, 0xc7, 0x1b, 0xaa, 0xef, 0x92, 0xfb, 0x02, 0x44, 0xdd, 0x66, 0xad, 0x0b, 0x45, 0x7f,
+	0x93, 0x2a, 0xce, 0x86, 0x40, 0x22, 0xc5, 0x25, 0x46, 0x71, 0x9e, 0x5c, 0x0c, 0x43, 0xd1, 0x1d,
+	0x03, 0x3e, 0x12, 0x60, 0xc4, 0x37, 0xbe, 0x91, 0x74, 0x4f, 0x8b, 0xbe, 0x21, 0x55, 0x94, 0x43,
+	0xe3, 0x91, 0xe7, 0x1f, 0x19, 0xcf, 0x2b, 0x64, 0xe9, 0x00, 0x3c, 0xd5, 0x42, 0x8b, 0x8d, 0xb2,
+	0xe4, 0x3d, 0x01, 0x46, 0x3b, 0x26, 0x21, 0x72, 0x29, 0x04, 0x03, 0xdf, 0xe8, 0x27, 0x2e, 0x1c,
+	0x40, 0x02, 0x59, 0xcf, 0x32, 0xd6, 0x67, 0xc9, 0xcc, 0xde, 0x9f, 0x2a, 0x3d, 0x86, 0x38, 0x1d,
+	0xbe, 0x25, 0x40, 0x84, 0xab, 0xe9, 0x56, 0xb1, 0xbe, 0x79, 0x49, 0x4c, 0xf5, 0x06, 0x22, 0x91,
+	0xbf, 0x30, 0x22, 0xd7, 0xc8, 0xd5, 0x03, 0x84, 0x4f, 0xde, 0x71, 0xe7, 0xab, 0xbb, 0xe4, 0x43,
+	0x01, 0x46, 0x7c, 0xc3, 0x48, 0xb7, 0x8c, 0x07, 0x0d, 0x4e, 0xa2, 0x1c, 0x1a, 0x8f, 0x94, 0x97,
+	0x19, 0xe5, 0xcb, 0x64, 0x31, 0x0c, 0x65, 0xff, 0x3c, 0x44, 0x3e, 0x15, 0x60, 0xb4, 0x63, 0x9a,
+	0xe8, 0x96, 0xf0, 0xe0, 0xa9, 0x45, 0x5c, 0x38, 0x80, 0x04, 0x92, 0xfe, 0x33, 0x23, 0xfd, 0x07,
+	0x72, 0x25, 0x14, 0x69, 0x77, 0xa8, 0x51, 0xdd, 0x59, 0xe2, 0x13, 0x01, 0xc8, 0xde, 0xee, 0x95,
+	0x2c, 0xed, 0x4f, 0x64, 0xdf, 0x49, 0x41, 0xbc, 0x7c, 0x30, 0x21, 0x74, 0x40, 0x66, 0x0e, 0xcc,
+	0x4a, 0xe7, 0x3a, 0x1d, 0xa0, 0x28, 0xa3, 0xa2, 0x03, 0x4e, 0x7f, 0xb5, 0x2c, 0xcc, 0x39, 0x2f,
+	0x4d, 0xdc, 0x6b, 0x01, 0x48, 0x97, 0x4b, 0xbb, 0xb3, 0xf9, 0x10, 0x2f, 0x86, 0xc2, 0x22, 0x2f,
+	0x89, 0xf1, 0x9a, 0x24, 0xe2, 0x9e, 0xc0, 0x7a, 0x5d, 0x0a, 0xd9, 0x71, 0x2a, 0xc8, 0x69, 0x08,
+	0xba, 0x57, 0x50, 0x5b, 0x2f, 0x22, 0xa6, 0x7a, 0x03, 0x91, 0x40, 0x92, 0x11, 0x48, 0x90, 0x53,
+	0xc1, 0xff, 0xeb, 0xb0, 0x9a, 0x7d, 0xf4, 0x24, 0x29, 0x3c, 0x7e, 0x92, 0x14, 0x7e, 0x78, 0x92,
+	0x14, 0x5e, 0x7f, 0x9a, 0xec, 0x7b, 0xfc, 0x34, 0xd9, 0xf7, 0xed, 0xd3, 0x64, 0xdf, 0x7f, 0xe5,
+	0xb6, 0x9e, 0xcd, 0xb1, 0xc6, 0x46, 0xb5, 0xa2, 0x59, 0x95, 0x8b, 0xb7, 0x34, 0xdd, 0x90, 0xb7,
+	0x97, 0xe4, 0x17, 0x5c, 0x9d, 0xac, 0x81, 0x2b, 0x44, 0x18, 0x62, 0xe9, 0xe7, 0x01, 0x00, 0x4f,
+	0xd5, 0x2d, 0xa3, 0xd1, 0x19, 0x00, 0x00,
 }
 
-func (m *QueryTunnelsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Tunnels is a RPC method that returns all tunnels.
+	Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error)
+	// Tunnel is a RPC method that returns a tunnel by its ID.
+	Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error)
+	// Deposits queries all deposits of a single tunnel.
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// Deposit queries single deposit information based tunnelID, depositAddr.
+	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
+	// Packets is a RPC method that returns all packets of a tunnel.
+	Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error)
+	// PacketsByTime is a RPC method that returns the packets of a tunnel created within a time range.
+	PacketsByTime(ctx context.Context, in *QueryPacketsByTimeRequest, opts ...grpc.CallOption) (*QueryPacketsByTimeResponse, error)
+	// PacketsByStatus is a RPC method that returns the packets of all tunnels with the given status
+	// and receipt type.
+	PacketsByStatus(ctx context.Context, in *QueryPacketsByStatusRequest, opts ...grpc.CallOption) (*QueryPacketsByStatusResponse, error)
+	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
+	Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error)
+	// FailedPackets is a RPC method that returns all failed packets of a tunnel.
+	FailedPackets(ctx context.Context, in *QueryFailedPacketsRequest, opts ...grpc.CallOption) (*QueryFailedPacketsResponse, error)
+	// FeePayerBalance is a RPC method that returns the fee payer balance and the funding policy of a tunnel.
+	FeePayerBalance(ctx context.Context, in *QueryFeePayerBalanceRequest, opts ...grpc.CallOption) (*QueryFeePayerBalanceResponse, error)
+	// EstimateTunnelCost is a RPC method that estimates the cost of creating and running a tunnel
+	// with the given route, signal deviations and interval.
+	EstimateTunnelCost(ctx context.Context, in *QueryEstimateTunnelCostRequest, opts ...grpc.CallOption) (*QueryEstimateTunnelCostResponse, error)
+	// TotalFees is a RPC method that returns the total fees collected by the tunnel
+	TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error)
+	// Params is a RPC method that returns all parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
-func (m *QueryTunnelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StatusFilter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StatusFilter))
-		i--
-		dAtA[i] = 0x8
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error) {
+	out := new(QueryTunnelsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnels", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTunnelsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error) {
+	out := new(QueryTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTunnelsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTunnelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
+	out := new(QueryDepositResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Tunnels) > 0 {
-		for iNdEx := len(m.Tunnels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tunnels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error) {
+	out := new(QueryPacketsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTunnelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PacketsByTime(ctx context.Context, in *QueryPacketsByTimeRequest, opts ...grpc.CallOption) (*QueryPacketsByTimeResponse, error) {
+	out := new(QueryPacketsByTimeResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/PacketsByTime", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTunnelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PacketsByStatus(ctx context.Context, in *QueryPacketsByStatusRequest, opts ...grpc.CallOption) (*QueryPacketsByStatusResponse, error) {
+	out := new(QueryPacketsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/PacketsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTunnelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error) {
+	out := new(QueryPacketResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FailedPackets(ctx context.Context, in *QueryFailedPacketsRequest, opts ...grpc.CallOption) (*QueryFailedPacketsResponse, error) {
+	out := new(QueryFailedPacketsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/FailedPackets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FeePayerBalance(ctx context.Context, in *QueryFeePayerBalanceRequest, opts ...grpc.CallOption) (*QueryFeePayerBalanceResponse, error) {
+	out := new(QueryFeePayerBalanceResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/FeePayerBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) EstimateTunnelCost(ctx context.Context, in *QueryEstimateTunnelCostRequest, opts ...grpc.CallOption) (*QueryEstimateTunnelCostResponse, error) {
+	out := new(QueryEstimateTunnelCostResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/EstimateTunnelCost", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error) {
+	out := new(QueryTotalFeesResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/TotalFees", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Tunnels is a RPC method that returns all tunnels.
+	Tunnels(context.Context, *QueryTunnelsRequest) (*QueryTunnelsResponse, error)
+	// Tunnel is a RPC method that returns a tunnel by its ID.
+	Tunnel(context.Context, *QueryTunnelRequest) (*QueryTunnelResponse, error)
+	// Deposits queries all deposits of a single tunnel.
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// Deposit queries single deposit information based tunnelID, depositAddr.
+	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
+	// Packets is a RPC method that returns all packets of a tunnel.
+	Packets(context.Context, *QueryPacketsRequest) (*QueryPacketsResponse, error)
+	// PacketsByTime is a RPC method that returns the packets of a tunnel created within a time range.
+	PacketsByTime(context.Context, *QueryPacketsByTimeRequest) (*QueryPacketsByTimeResponse, error)
+	// PacketsByStatus is a RPC method that returns the packets of all tunnels with the given status
+	// and receipt type.
+	PacketsByStatus(context.Context, *QueryPacketsByStatusRequest) (*QueryPacketsByStatusResponse, error)
+	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
+	Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error)
+	// FailedPackets is a RPC method that returns all failed packets of a tunnel.
+	FailedPackets(context.Context, *QueryFailedPacketsRequest) (*QueryFailedPacketsResponse, error)
+	// FeePayerBalance is a RPC method that returns the fee payer balance and the funding policy of a tunnel.
+	FeePayerBalance(context.Context, *QueryFeePayerBalanceRequest) (*QueryFeePayerBalanceResponse, error)
+	// EstimateTunnelCost is a RPC method that estimates the cost of creating and running a tunnel
+	// with the given route, signal deviations and interval.
+	EstimateTunnelCost(context.Context, *QueryEstimateTunnelCostRequest) (*QueryEstimateTunnelCostResponse, error)
+	// TotalFees is a RPC method that returns the total fees collected by the tunnel
+	TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error)
+	// Params is a RPC method that returns all parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Tunnels(ctx context.Context, req *QueryTunnelsRequest) (*QueryTunnelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tunnels not implemented")
+}
+func (*UnimplementedQueryServer) Tunnel(ctx context.Context, req *QueryTunnelRequest) (*QueryTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tunnel not implemented")
+}
+func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+}
+func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedQueryServer) Packets(ctx context.Context, req *QueryPacketsRequest) (*QueryPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Packets not implemented")
+}
+func (*UnimplementedQueryServer) PacketsByTime(ctx context.Context, req *QueryPacketsByTimeRequest) (*QueryPacketsByTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketsByTime not implemented")
+}
+func (*UnimplementedQueryServer) PacketsByStatus(ctx context.Context, req *QueryPacketsByStatusRequest) (*QueryPacketsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketsByStatus not implemented")
+}
+func (*UnimplementedQueryServer) Packet(ctx context.Context, req *QueryPacketRequest) (*QueryPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Packet not implemented")
+}
+func (*UnimplementedQueryServer) FailedPackets(ctx context.Context, req *QueryFailedPacketsRequest) (*QueryFailedPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedPackets not implemented")
+}
+func (*UnimplementedQueryServer) FeePayerBalance(ctx context.Context, req *QueryFeePayerBalanceRequest) (*QueryFeePayerBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayerBalance not implemented")
+}
+func (*UnimplementedQueryServer) EstimateTunnelCost(ctx context.Context, req *QueryEstimateTunnelCostRequest) (*QueryEstimateTunnelCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTunnelCost not implemented")
+}
+func (*UnimplementedQueryServer) TotalFees(ctx context.Context, req *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalFees not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Tunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTunnelsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Tunnels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Tunnels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tunnels(ctx, req.(*QueryTunnelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Tunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Tunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tunnel(ctx, req.(*QueryTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
+func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).Deposits(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Deposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposit(ctx, req.(*QueryDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Packets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Packets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Packets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Packets(ctx, req.(*QueryPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_PacketsByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketsByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PacketsByTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/PacketsByTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketsByTime(ctx, req.(*QueryPacketsByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPacketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PacketsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketsByStatusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PacketsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/PacketsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketsByStatus(ctx, req.(*QueryPacketsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+func _Query_Packet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Packet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Packet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Packet(ctx, req.(*QueryPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/FailedPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedPackets(ctx, req.(*QueryFailedPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayerBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayerBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayerBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/FeePayerBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayerBalance(ctx, req.(*QueryFeePayerBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateTunnelCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateTunnelCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateTunnelCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/EstimateTunnelCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateTunnelCost(ctx, req.(*QueryEstimateTunnelCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/TotalFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalFees(ctx, req.(*QueryTotalFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.tunnel.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Tunnels",
+			Handler:    _Query_Tunnels_Handler,
+		},
+		{
+			MethodName: "Tunnel",
+			Handler:    _Query_Tunnel_Handler,
+		},
+		{
+			MethodName: "Deposits",
+			Handler:    _Query_Deposits_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Query_Deposit_Handler,
+		},
+		{
+			MethodName: "Packets",
+			Handler:    _Query_Packets_Handler,
+		},
+		{
+			MethodName: "PacketsByTime",
+			Handler:    _Query_PacketsByTime_Handler,
+		},
+		{
+			MethodName: "PacketsByStatus",
+			Handler:    _Query_PacketsByStatus_Handler,
+		},
+		{
+			MethodName: "Packet",
+			Handler:    _Query_Packet_Handler,
+		},
+		{
+			MethodName: "FailedPackets",
+			Handler:    _Query_FailedPackets_Handler,
+		},
+		{
+			MethodName: "FeePayerBalance",
+			Handler:    _Query_FeePayerBalance_Handler,
+		},
+		{
+			MethodName: "EstimateTunnelCost",
+			Handler:    _Query_EstimateTunnelCost_Handler,
+		},
+		{
+			MethodName: "TotalFees",
+			Handler:    _Query_TotalFees_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/tunnel/v1beta1/query.proto",
+}
+
+func (m *QueryTunnelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTunnelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTunnelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1624,15 +2243,15 @@ func (m *QueryPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+	if m.StatusFilter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StatusFilter))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTunnelsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1642,12 +2261,12 @@ func (m *QueryPacketsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTunnelsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTunnelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1664,10 +2283,10 @@ func (m *QueryPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Packets) > 0 {
-		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Tunnels) > 0 {
+		for iNdEx := len(m.Tunnels) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Tunnels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1681,7 +2300,7 @@ func (m *QueryPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTunnelRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1691,21 +2310,16 @@ func (m *QueryPacketRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTunnelRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTunnelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
-	}
 	if m.TunnelId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
 		i--
@@ -1714,7 +2328,7 @@ func (m *QueryPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTunnelResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1724,32 +2338,30 @@ func (m *QueryPacketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Packet != nil {
-		{
-			size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalFeesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1759,20 +2371,37 @@ func (m *QueryTotalFeesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalFeesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1782,30 +2411,46 @@ func (m *QueryTotalFeesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.TotalFees.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1815,20 +2460,32 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDepositResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1838,18 +2495,18 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDepositResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1861,247 +2518,2608 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTunnelsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StatusFilter != 0 {
-		n += 1 + sovQuery(uint64(m.StatusFilter))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTunnelsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Tunnels) > 0 {
-		for _, e := range m.Tunnels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTunnelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
+func (m *QueryPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Tunnel.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketsByTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketsByTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketsByTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func (m *QueryDepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
 	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
-	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketsByTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Deposit.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPacketsByTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketsByTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Packets) > 0 {
-		for _, e := range m.Packets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ReceiptType) > 0 {
+		i -= len(m.ReceiptType)
+		copy(dAtA[i:], m.ReceiptType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReceiptType)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Packet != nil {
-		l = m.Packet.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Packet != nil {
+		{
+			size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFailedPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TotalFees.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFailedPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFailedPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedPackets) > 0 {
+		for iNdEx := len(m.FailedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayerBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayerBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayerBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayerBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayerBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayerBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FundingPolicy != nil {
+		{
+			size, err := m.FundingPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTunnelCostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTunnelCostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTunnelCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RelayerFee) > 0 {
+		for iNdEx := len(m.RelayerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Interval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignalDeviations) > 0 {
+		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTunnelCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTunnelCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTunnelCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RelayerFee) > 0 {
+		for iNdEx := len(m.RelayerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.DeviationPacketsPerDay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DeviationPacketsPerDay))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DailyCost) > 0 {
+		for iNdEx := len(m.DailyCost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyCost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PacketsPerDay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PacketsPerDay))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PacketFee) > 0 {
+		for iNdEx := len(m.PacketFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RouteFee) > 0 {
+		for iNdEx := len(m.RouteFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RouteFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BasePacketFee) > 0 {
+		for iNdEx := len(m.BasePacketFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BasePacketFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTunnelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StatusFilter != 0 {
+		n += 1 + sovQuery(uint64(m.StatusFilter))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTunnelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tunnels) > 0 {
+		for _, e := range m.Tunnels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTunnelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	return n
+}
+
+func (m *QueryTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tunnel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketsByTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketsByTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.ReceiptType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		l = m.Packet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedPackets) > 0 {
+		for _, e := range m.FailedPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayerBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	return n
+}
+
+func (m *QueryFeePayerBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FundingPolicy != nil {
+		l = m.FundingPolicy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateTunnelCostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SignalDeviations) > 0 {
+		for _, e := range m.SignalDeviations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovQuery(uint64(m.Interval))
+	}
+	if len(m.RelayerFee) > 0 {
+		for _, e := range m.RelayerFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateTunnelCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BasePacketFee) > 0 {
+		for _, e := range m.BasePacketFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RouteFee) > 0 {
+		for _, e := range m.RouteFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PacketFee) > 0 {
+		for _, e := range m.PacketFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.PacketsPerDay != 0 {
+		n += 1 + sovQuery(uint64(m.PacketsPerDay))
+	}
+	if len(m.DailyCost) > 0 {
+		for _, e := range m.DailyCost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.DeviationPacketsPerDay != 0 {
+		n += 1 + sovQuery(uint64(m.DeviationPacketsPerDay))
+	}
+	if len(m.RelayerFee) > 0 {
+		for _, e := range m.RelayerFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+			}
+			m.StatusFilter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusFilter |= TunnelStatusFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tunnels = append(m.Tunnels, &Tunnel{})
+			if err := m.Tunnels[len(m.Tunnels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, &Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, &Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketsByTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketsByTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketsByTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketsByTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketsByTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketsByTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, &Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketsByStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2124,17 +5142,17 @@ func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketsByStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.StatusFilter = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2144,12 +5162,44 @@ func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StatusFilter |= TunnelStatusFilter(b&0x7F) << shift
+				m.Status |= PacketStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2206,7 +5256,7 @@ func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketsByStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2229,15 +5279,15 @@ func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketsByStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tunnels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2264,8 +5314,8 @@ func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tunnels = append(m.Tunnels, &Tunnel{})
-			if err := m.Tunnels[len(m.Tunnels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Packets = append(m.Packets, &Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2326,7 +5376,7 @@ func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2349,10 +5399,10 @@ func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2374,6 +5424,25 @@ func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2395,7 +5464,7 @@ func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2418,15 +5487,15 @@ func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2453,7 +5522,10 @@ func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Packet == nil {
+				m.Packet = &Packet{}
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2478,7 +5550,7 @@ func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFailedPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2501,10 +5573,10 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFailedPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFailedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2583,7 +5655,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFailedPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2606,15 +5678,15 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFailedPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFailedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedPackets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2641,8 +5713,8 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, &Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedPackets = append(m.FailedPackets, &FailedPacket{})
+			if err := m.FailedPackets[len(m.FailedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2703,7 +5775,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePayerBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2726,10 +5798,10 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePayerBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePayerBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2751,9 +5823,59 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayerBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayerBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayerBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2781,7 +5903,77 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FundingPolicy == nil {
+				m.FundingPolicy = &FundingPolicy{}
+			}
+			if err := m.FundingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2804,7 +5996,7 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateTunnelCostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2827,15 +6019,51 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateTunnelCostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateTunnelCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &types1.Any{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2862,65 +6090,16 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
+			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.TunnelId = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2930,14 +6109,14 @@ func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TunnelId |= uint64(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2964,10 +6143,8 @@ func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RelayerFee = append(m.RelayerFee, types.Coin{})
+			if err := m.RelayerFee[len(m.RelayerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2992,7 +6169,7 @@ func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateTunnelCostResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3015,15 +6192,15 @@ func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateTunnelCostResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateTunnelCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3050,14 +6227,14 @@ func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Packets = append(m.Packets, &Packet{})
-			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePacketFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3084,68 +6261,84 @@ func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BasePacketFee = append(m.BasePacketFee, types.Coin{})
+			if err := m.BasePacketFee[len(m.BasePacketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteFee", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.RouteFee = append(m.RouteFee, types.Coin{})
+			if err := m.RouteFee[len(m.RouteFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PacketFee = append(m.PacketFee, types.Coin{})
+			if err := m.PacketFee[len(m.PacketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsPerDay", wireType)
 			}
-			m.TunnelId = 0
+			m.PacketsPerDay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3155,16 +6348,16 @@ func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TunnelId |= uint64(b&0x7F) << shift
+				m.PacketsPerDay |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyCost", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3174,64 +6367,48 @@ func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.DailyCost = append(m.DailyCost, types.Coin{})
+			if err := m.DailyCost[len(m.DailyCost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationPacketsPerDay", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DeviationPacketsPerDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationPacketsPerDay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3258,10 +6435,8 @@ func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Packet == nil {
-				m.Packet = &Packet{}
-			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RelayerFee = append(m.RelayerFee, types.Coin{})
+			if err := m.RelayerFee[len(m.RelayerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10,3 +10,21 @@ type RouteI interface {
 
 	ValidateBasic() error
 }
+
+// GetRouteReceiptType returns the full name of the packet receipt type that the route produces.
+func GetRouteReceiptType(route RouteI) string {
+	switch route.(type) {
+	case *TSSRoute:
+		return proto.MessageName(&TSSPacketReceipt{})
+	case *IBCRoute:
+		return proto.MessageName(&IBCPacketReceipt{})
+	case *IBCHookRoute:
+		return proto.MessageName(&IBCHookPacketReceipt{})
+	case *RouterRoute:
+		return proto.MessageName(&RouterPacketReceipt{})
+	case *IBCABIRoute:
+		return proto.MessageName(&IBCABIPacketReceipt{})
+	default:
+		return ""
+	}
+}
@@ -929,6 +929,107 @@ func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) GetPayload() string {
 	return ""
 }
 
+// IBCABIRoute represents a route for IBC packets whose payload is ABI-encoded and implements the RouteI interface.
+type IBCABIRoute struct {
+	// channel_id is the IBC channel ID
+	ChannelID string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// encoder is the mode of encoding packet data.
+	Encoder types.Encoder `protobuf:"varint,2,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+}
+
+func (m *IBCABIRoute) Reset()         { *m = IBCABIRoute{} }
+func (m *IBCABIRoute) String() string { return proto.CompactTextString(m) }
+func (*IBCABIRoute) ProtoMessage()    {}
+func (*IBCABIRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{11}
+}
+func (m *IBCABIRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCABIRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCABIRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCABIRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCABIRoute.Merge(m, src)
+}
+func (m *IBCABIRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCABIRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCABIRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCABIRoute proto.InternalMessageInfo
+
+func (m *IBCABIRoute) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *IBCABIRoute) GetEncoder() types.Encoder {
+	if m != nil {
+		return m.Encoder
+	}
+	return types.ENCODER_UNSPECIFIED
+}
+
+// IBCABIPacketReceipt represents a receipt for a IBC ABI packet and implements the PacketReceiptI interface.
+type IBCABIPacketReceipt struct {
+	// sequence is representing the sequence of the IBC packet.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *IBCABIPacketReceipt) Reset()         { *m = IBCABIPacketReceipt{} }
+func (m *IBCABIPacketReceipt) String() string { return proto.CompactTextString(m) }
+func (*IBCABIPacketReceipt) ProtoMessage()    {}
+func (*IBCABIPacketReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{12}
+}
+func (m *IBCABIPacketReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCABIPacketReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCABIPacketReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCABIPacketReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCABIPacketReceipt.Merge(m, src)
+}
+func (m *IBCABIPacketReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCABIPacketReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCABIPacketReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCABIPacketReceipt proto.InternalMessageInfo
+
+func (m *IBCABIPacketReceipt) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TSSRoute)(nil), "band.tunnel.v1beta1.TSSRoute")
 	proto.RegisterType((*TSSPacketReceipt)(nil), "band.tunnel.v1beta1.TSSPacketReceipt")
@@ -947,68 +1048,71 @@ func init() {
 	proto.RegisterType((*RouterMemo_Payload)(nil), "band.tunnel.v1beta1.RouterMemo.Payload")
 	proto.RegisterType((*RouterMemo_Payload_Msg)(nil), "band.tunnel.v1beta1.RouterMemo.Payload.Msg")
 	proto.RegisterType((*RouterMemo_Payload_Msg_ReceiveBandDataArgs)(nil), "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs")
+	proto.RegisterType((*IBCABIRoute)(nil), "band.tunnel.v1beta1.IBCABIRoute")
+	proto.RegisterType((*IBCABIPacketReceipt)(nil), "band.tunnel.v1beta1.IBCABIPacketReceipt")
 }
 
 func init() { proto.RegisterFile("band/tunnel/v1beta1/route.proto", fileDescriptor_543238289d94b7a6) }
 
 var fileDescriptor_543238289d94b7a6 = []byte{
-	// 881 bytes of a gzipped FileDescriptorProto
+	// 904 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x18, 0xcd, 0x66, 0xad, 0xc4, 0xfe, 0xdc, 0xa4, 0x65, 0x12, 0x2a, 0xd7, 0x05, 0xdb, 0xf2, 0x05,
-	0xa3, 0xb6, 0xbb, 0xaa, 0x23, 0x84, 0x94, 0x0b, 0x64, 0xed, 0x42, 0x57, 0x10, 0x29, 0x5a, 0xe7,
-	0xd4, 0x8b, 0x35, 0xd9, 0x19, 0x36, 0x4b, 0xe3, 0x1d, 0x77, 0x67, 0x12, 0xe8, 0xbf, 0x80, 0x38,
-	0x20, 0xee, 0xdc, 0x39, 0x72, 0x28, 0x82, 0x3f, 0xa1, 0xca, 0xa9, 0x47, 0xb8, 0x58, 0x68, 0xf3,
-	0x07, 0x70, 0xe7, 0x84, 0xe6, 0xc7, 0x3a, 0x5e, 0x6b, 0x91, 0xdc, 0xf6, 0xc2, 0xcd, 0xf3, 0x7d,
-	0x6f, 0xde, 0x9b, 0xf7, 0xcd, 0xd3, 0xac, 0xa1, 0x7d, 0x82, 0x13, 0xe2, 0x8a, 0xf3, 0x24, 0xa1,
-	0x67, 0xee, 0xc5, 0xc3, 0x13, 0x2a, 0xf0, 0x43, 0x37, 0x65, 0xe7, 0x82, 0x3a, 0xd3, 0x94, 0x09,
-	0x86, 0x76, 0x24, 0xc0, 0xd1, 0x00, 0xc7, 0x00, 0x9a, 0x77, 0x42, 0xc6, 0x27, 0x8c, 0x8f, 0x15,
-	0xc4, 0xd5, 0x0b, 0x8d, 0x6f, 0xee, 0x46, 0x2c, 0x62, 0xba, 0x2e, 0x7f, 0x99, 0x6a, 0x47, 0xc9,
-	0x7c, 0x45, 0x29, 0xe1, 0x73, 0x15, 0x9a, 0x84, 0x8c, 0xd0, 0xd4, 0x20, 0x5a, 0x25, 0x08, 0xb5,
-	0xd2, 0xfd, 0xee, 0x9f, 0x16, 0x54, 0x8f, 0x47, 0xa3, 0x40, 0x1e, 0x0d, 0x3d, 0x86, 0x5d, 0x42,
-	0xb9, 0x88, 0x13, 0x2c, 0x62, 0x96, 0x8c, 0xc3, 0x53, 0x1c, 0x27, 0xe3, 0x98, 0x34, 0xac, 0x8e,
-	0xd5, 0xab, 0x79, 0xb7, 0xb3, 0x59, 0x1b, 0x0d, 0xaf, 0xfb, 0x03, 0xd9, 0xf6, 0x87, 0x01, 0x22,
-	0xcb, 0x35, 0x82, 0x3e, 0x85, 0xf7, 0x0a, 0x4c, 0x2c, 0x11, 0x29, 0x0e, 0xc5, 0x18, 0x13, 0x92,
-	0x52, 0xce, 0x1b, 0xeb, 0x92, 0x31, 0x68, 0x2e, 0xee, 0x34, 0x90, 0x03, 0x8d, 0x40, 0x1f, 0xc1,
-	0xa6, 0x71, 0xd2, 0xb0, 0x3b, 0x56, 0x6f, 0xbb, 0x7f, 0xd7, 0x51, 0x23, 0xd3, 0x87, 0x37, 0x56,
-	0x9c, 0x47, 0x1a, 0x12, 0xe4, 0xd8, 0x7d, 0xb8, 0x7c, 0xf1, 0x60, 0x43, 0xb9, 0xf1, 0xbb, 0x3f,
-	0x5a, 0x70, 0xeb, 0x78, 0x34, 0x3a, 0xc2, 0xe1, 0x53, 0x2a, 0x02, 0x1a, 0xd2, 0x78, 0x2a, 0xd0,
-	0xd7, 0x00, 0x3c, 0x8e, 0x92, 0x38, 0x89, 0x72, 0x67, 0x15, 0xef, 0x8b, 0x6c, 0xd6, 0xae, 0x8d,
-	0x74, 0xd5, 0x1f, 0xfe, 0x33, 0x6b, 0xef, 0x47, 0xb1, 0x38, 0x3d, 0x3f, 0x71, 0x42, 0x36, 0x71,
-	0xa5, 0xaa, 0x9a, 0x55, 0xc8, 0xce, 0x5c, 0x35, 0x12, 0xf7, 0x62, 0xcf, 0xfd, 0x56, 0xd5, 0x05,
-	0xe7, 0xae, 0x78, 0x3e, 0xa5, 0xdc, 0x99, 0xef, 0x0e, 0x6a, 0x86, 0xde, 0x27, 0xfb, 0xe8, 0xf2,
-	0xc5, 0x83, 0xed, 0x82, 0xbc, 0xdf, 0x1d, 0x42, 0xd5, 0xf7, 0x06, 0x7a, 0xde, 0xf7, 0x01, 0xc2,
-	0x53, 0x2c, 0x23, 0x70, 0x3d, 0xe5, 0x2d, 0x79, 0x96, 0x81, 0xae, 0x4a, 0x36, 0x03, 0xf0, 0x49,
-	0xc1, 0x9a, 0x07, 0xb7, 0x7c, 0x6f, 0x50, 0x74, 0xd6, 0x84, 0x2a, 0xa7, 0xcf, 0xce, 0x69, 0x12,
-	0x52, 0xed, 0x2b, 0x98, 0xaf, 0x4b, 0x4f, 0xf2, 0x9b, 0x05, 0xb7, 0x8f, 0x55, 0x00, 0x8f, 0xd2,
-	0x38, 0xa4, 0x5c, 0xb7, 0x87, 0x58, 0x60, 0xf4, 0x21, 0xd4, 0x74, 0x34, 0xaf, 0x67, 0x74, 0x23,
-	0x9b, 0xb5, 0xab, 0x1a, 0xee, 0x0f, 0x83, 0xaa, 0x6e, 0xfb, 0xa4, 0xa0, 0xba, 0x5e, 0x54, 0x45,
-	0x1f, 0xc3, 0xc6, 0x54, 0x51, 0x37, 0xec, 0x8e, 0xdd, 0xab, 0xf7, 0xef, 0x94, 0x5d, 0xa1, 0x12,
-	0xf7, 0x2a, 0x2f, 0x67, 0xed, 0xb5, 0xc0, 0xc0, 0xd1, 0xfb, 0x00, 0x61, 0x4a, 0xb1, 0xa0, 0x64,
-	0x8c, 0x45, 0xa3, 0xd2, 0xb1, 0x7a, 0x76, 0x50, 0x33, 0x95, 0x03, 0xd1, 0xfd, 0xce, 0x82, 0x1b,
-	0xbe, 0x37, 0x78, 0xcc, 0xd8, 0xd3, 0x37, 0x18, 0xe4, 0xdb, 0x87, 0xb3, 0x70, 0x15, 0x9f, 0xc1,
-	0xae, 0x39, 0xcb, 0x1b, 0x5d, 0x87, 0xa4, 0xa6, 0x89, 0xf0, 0xbb, 0xdf, 0xdb, 0x50, 0x37, 0x44,
-	0x87, 0x74, 0xc2, 0x90, 0x07, 0x95, 0x6f, 0x30, 0x9f, 0xa8, 0xbd, 0xf5, 0x7e, 0xcf, 0x29, 0x79,
-	0x30, 0x9c, 0x05, 0xbc, 0x73, 0x84, 0x9f, 0x9f, 0x31, 0x4c, 0xcc, 0x24, 0xd5, 0xde, 0xe6, 0xef,
-	0xeb, 0xb0, 0x69, 0xea, 0xf2, 0x3c, 0xb9, 0x53, 0x3d, 0xa1, 0x60, 0xbe, 0x46, 0x43, 0xb0, 0x27,
-	0x3c, 0x52, 0xc6, 0xeb, 0xfd, 0xfb, 0xab, 0x4a, 0x39, 0x87, 0x3c, 0x32, 0x72, 0x72, 0x7b, 0xf3,
-	0xd2, 0x02, 0xfb, 0x90, 0x47, 0x28, 0x82, 0xed, 0x54, 0x0e, 0xe1, 0x82, 0x8e, 0xa7, 0xca, 0xa3,
-	0xf1, 0xb0, 0xff, 0x3a, 0xc4, 0x4e, 0xa0, 0x29, 0xf4, 0x94, 0x8c, 0xcc, 0x56, 0xba, 0x58, 0x6c,
-	0x3e, 0x81, 0xad, 0x02, 0x0a, 0xf9, 0xb0, 0x51, 0x50, 0xbc, 0x57, 0xaa, 0x58, 0x1e, 0xfa, 0x79,
-	0x04, 0x55, 0x45, 0x3e, 0x8c, 0x75, 0x75, 0xc3, 0xe9, 0xff, 0xef, 0x6d, 0xec, 0xc3, 0xbb, 0x8b,
-	0x0c, 0x11, 0xe6, 0xe3, 0xb3, 0x78, 0x12, 0x0b, 0xf5, 0x52, 0x56, 0x82, 0x9d, 0x85, 0xe6, 0xe7,
-	0x98, 0x7f, 0x29, 0x5b, 0x85, 0xc8, 0x3e, 0x82, 0x1d, 0x6d, 0xed, 0xed, 0x1e, 0x90, 0xbf, 0x6d,
-	0x00, 0xcd, 0xa3, 0x02, 0x7b, 0x50, 0x08, 0xec, 0x07, 0xa5, 0xa3, 0xbf, 0x86, 0x97, 0xe6, 0xf5,
-	0x27, 0x7b, 0xb5, 0xbc, 0x0e, 0x16, 0xf3, 0x7a, 0x6f, 0x45, 0xa5, 0xe5, 0xb8, 0xfe, 0xb2, 0xae,
-	0xe3, 0xfa, 0x0c, 0xde, 0xc9, 0xe3, 0x2a, 0x89, 0xc6, 0x04, 0x0b, 0x6c, 0x4c, 0x7c, 0xf2, 0x1a,
-	0xd4, 0x79, 0x60, 0x3d, 0x9c, 0x10, 0x99, 0xa7, 0x83, 0x34, 0xe2, 0x46, 0xee, 0x66, 0x5a, 0x6c,
-	0x35, 0x7f, 0xb5, 0x60, 0xa7, 0x04, 0x8e, 0xf6, 0x60, 0x4b, 0xde, 0xdd, 0x72, 0xba, 0x6e, 0x66,
-	0xb3, 0x76, 0x5d, 0xa6, 0x2b, 0x8f, 0x55, 0x9d, 0xcc, 0x17, 0x24, 0x4f, 0xc3, 0x7f, 0x05, 0x49,
-	0xa5, 0x61, 0x39, 0x41, 0x77, 0xa1, 0xb6, 0x9c, 0x9a, 0x6a, 0x64, 0xa2, 0x82, 0x1a, 0xb0, 0x39,
-	0xd5, 0xbe, 0xd4, 0xd3, 0x5b, 0x0b, 0xf2, 0xa5, 0x77, 0xf8, 0x73, 0xd6, 0xb2, 0x5e, 0x66, 0x2d,
-	0xeb, 0x55, 0xd6, 0xb2, 0xfe, 0xca, 0x5a, 0xd6, 0x0f, 0x57, 0xad, 0xb5, 0x57, 0x57, 0xad, 0xb5,
-	0x3f, 0xae, 0x5a, 0x6b, 0x4f, 0xdc, 0x15, 0xbe, 0x9a, 0xe6, 0x2f, 0x91, 0xfa, 0x68, 0x9e, 0x6c,
-	0x28, 0xc4, 0xde, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xfb, 0xd4, 0x72, 0x2e, 0x09, 0x00,
-	0x00,
+	0x14, 0xce, 0x7a, 0xad, 0xc4, 0x7e, 0x6e, 0xd2, 0x32, 0x0e, 0x95, 0xeb, 0x82, 0x6d, 0xf9, 0x82,
+	0x51, 0xdb, 0x5d, 0xd5, 0x11, 0x42, 0xca, 0x05, 0xbc, 0x76, 0xa0, 0x2b, 0x88, 0x14, 0xad, 0x73,
+	0xea, 0xc5, 0x9a, 0xec, 0x0c, 0x9b, 0xa5, 0xf1, 0xae, 0xbb, 0x33, 0x0e, 0xf4, 0xc2, 0x1f, 0x80,
+	0x38, 0x20, 0xee, 0xdc, 0x39, 0x72, 0x28, 0x82, 0x3f, 0xa1, 0xca, 0xa9, 0x47, 0xb8, 0x58, 0xc8,
+	0xf9, 0x03, 0xb8, 0x73, 0x42, 0xf3, 0xc3, 0xce, 0xae, 0xb5, 0x48, 0xa9, 0x73, 0xe9, 0xcd, 0xf3,
+	0xde, 0x37, 0xef, 0x7b, 0xdf, 0x9b, 0x4f, 0x6f, 0x0d, 0xcd, 0x13, 0x1c, 0x11, 0x9b, 0x4f, 0xa3,
+	0x88, 0x9e, 0xd9, 0xe7, 0x8f, 0x4f, 0x28, 0xc7, 0x8f, 0xed, 0x24, 0x9e, 0x72, 0x6a, 0x4d, 0x92,
+	0x98, 0xc7, 0xa8, 0x2a, 0x00, 0x96, 0x02, 0x58, 0x1a, 0x50, 0xbf, 0xe7, 0xc7, 0x6c, 0x1c, 0xb3,
+	0x91, 0x84, 0xd8, 0xea, 0xa0, 0xf0, 0xf5, 0xdd, 0x20, 0x0e, 0x62, 0x15, 0x17, 0xbf, 0x74, 0xb4,
+	0x25, 0x69, 0xbe, 0xa2, 0x94, 0xb0, 0x25, 0x0b, 0x8d, 0xfc, 0x98, 0xd0, 0x44, 0x23, 0x1a, 0x39,
+	0x08, 0x79, 0x52, 0xf9, 0xf6, 0x5f, 0x06, 0x94, 0x8e, 0x87, 0x43, 0x4f, 0xb4, 0x86, 0x9e, 0xc0,
+	0x2e, 0xa1, 0x8c, 0x87, 0x11, 0xe6, 0x61, 0x1c, 0x8d, 0xfc, 0x53, 0x1c, 0x46, 0xa3, 0x90, 0xd4,
+	0x8c, 0x96, 0xd1, 0x29, 0x3b, 0x77, 0xe7, 0xb3, 0x26, 0x1a, 0x5c, 0xe5, 0xfb, 0x22, 0xed, 0x0e,
+	0x3c, 0x44, 0x56, 0x63, 0x04, 0x7d, 0x0a, 0xef, 0x65, 0x2a, 0xc5, 0x11, 0x4f, 0xb0, 0xcf, 0x47,
+	0x98, 0x90, 0x84, 0x32, 0x56, 0x2b, 0x88, 0x8a, 0x5e, 0x3d, 0x7d, 0x53, 0x43, 0x7a, 0x0a, 0x81,
+	0x3e, 0x82, 0x2d, 0xad, 0xa4, 0x66, 0xb6, 0x8c, 0xce, 0x4e, 0xf7, 0xbe, 0x25, 0x47, 0xa6, 0x9a,
+	0xd7, 0x52, 0xac, 0x03, 0x05, 0xf1, 0x16, 0xd8, 0x7d, 0xb8, 0x78, 0xf9, 0x68, 0x53, 0xaa, 0x71,
+	0xdb, 0x3f, 0x19, 0x70, 0xe7, 0x78, 0x38, 0x3c, 0xc2, 0xfe, 0x33, 0xca, 0x3d, 0xea, 0xd3, 0x70,
+	0xc2, 0xd1, 0xd7, 0x00, 0x2c, 0x0c, 0xa2, 0x30, 0x0a, 0x16, 0xca, 0x8a, 0xce, 0x17, 0xf3, 0x59,
+	0xb3, 0x3c, 0x54, 0x51, 0x77, 0xf0, 0xef, 0xac, 0xb9, 0x1f, 0x84, 0xfc, 0x74, 0x7a, 0x62, 0xf9,
+	0xf1, 0xd8, 0x16, 0xac, 0x72, 0x56, 0x7e, 0x7c, 0x66, 0xcb, 0x91, 0xd8, 0xe7, 0x7b, 0xf6, 0xb7,
+	0x32, 0xce, 0x19, 0xb3, 0xf9, 0x8b, 0x09, 0x65, 0xd6, 0xf2, 0xb6, 0x57, 0xd6, 0xe5, 0x5d, 0xb2,
+	0x8f, 0x2e, 0x5e, 0x3e, 0xda, 0xc9, 0xd0, 0xbb, 0xed, 0x01, 0x94, 0x5c, 0xa7, 0xaf, 0xe6, 0xfd,
+	0x10, 0xc0, 0x3f, 0xc5, 0xc2, 0x02, 0x57, 0x53, 0xde, 0x16, 0xbd, 0xf4, 0x55, 0x54, 0x54, 0xd3,
+	0x00, 0x97, 0x64, 0xa4, 0x39, 0x70, 0xc7, 0x75, 0xfa, 0x59, 0x65, 0x75, 0x28, 0x31, 0xfa, 0x7c,
+	0x4a, 0x23, 0x9f, 0x2a, 0x5d, 0xde, 0xf2, 0x9c, 0xdb, 0xc9, 0xef, 0x06, 0xdc, 0x3d, 0x96, 0x06,
+	0x3c, 0x4a, 0x42, 0x9f, 0x32, 0x95, 0x1e, 0x60, 0x8e, 0xd1, 0x87, 0x50, 0xe6, 0xd3, 0x74, 0x5f,
+	0x45, 0xe7, 0xd6, 0x7c, 0xd6, 0x2c, 0x29, 0xb8, 0x3b, 0xf0, 0x4a, 0x2a, 0xed, 0x92, 0x0c, 0x6b,
+	0x21, 0xcb, 0x8a, 0x3e, 0x86, 0xcd, 0x89, 0x2c, 0x5d, 0x33, 0x5b, 0x66, 0xa7, 0xd2, 0xbd, 0x97,
+	0xf7, 0x84, 0x92, 0xdc, 0x29, 0xbe, 0x9a, 0x35, 0x37, 0x3c, 0x0d, 0x47, 0xef, 0x03, 0xf8, 0x09,
+	0xc5, 0x9c, 0x92, 0x11, 0xe6, 0xb5, 0x62, 0xcb, 0xe8, 0x98, 0x5e, 0x59, 0x47, 0x7a, 0xbc, 0xfd,
+	0xbd, 0x01, 0xb7, 0x5c, 0xa7, 0xff, 0x24, 0x8e, 0x9f, 0xad, 0x31, 0xc8, 0x9b, 0x9b, 0x33, 0xf3,
+	0x14, 0x9f, 0xc1, 0xae, 0xee, 0x65, 0xad, 0xe7, 0x10, 0xa5, 0x69, 0xc4, 0xdd, 0xf6, 0x0f, 0x26,
+	0x54, 0x74, 0xa1, 0x43, 0x3a, 0x8e, 0x91, 0x03, 0xc5, 0x6f, 0x30, 0x1b, 0xcb, 0xbb, 0x95, 0x6e,
+	0xc7, 0xca, 0x59, 0x18, 0x56, 0x0a, 0x6f, 0x1d, 0xe1, 0x17, 0x67, 0x31, 0x26, 0x7a, 0x92, 0xf2,
+	0x6e, 0xfd, 0x8f, 0x02, 0x6c, 0xe9, 0xb8, 0xe8, 0x67, 0xa1, 0x54, 0x4d, 0xc8, 0x5b, 0x9e, 0xd1,
+	0x00, 0xcc, 0x31, 0x0b, 0xa4, 0xf0, 0x4a, 0xf7, 0xe1, 0x75, 0xa9, 0xac, 0x43, 0x16, 0x68, 0x3a,
+	0x71, 0xbd, 0x7e, 0x61, 0x80, 0x79, 0xc8, 0x02, 0x14, 0xc0, 0x4e, 0x22, 0x86, 0x70, 0x4e, 0x47,
+	0x13, 0xa9, 0x51, 0x6b, 0xd8, 0x7f, 0x93, 0xc2, 0x96, 0xa7, 0x4a, 0xa8, 0x29, 0x69, 0x9a, 0xed,
+	0x24, 0x1d, 0xac, 0x3f, 0x85, 0xed, 0x0c, 0x0a, 0xb9, 0xb0, 0x99, 0x61, 0x7c, 0x90, 0xcb, 0x98,
+	0x6f, 0xfa, 0xa5, 0x05, 0x65, 0x44, 0x2c, 0xc6, 0x8a, 0x7c, 0xe1, 0xe4, 0xed, 0xdb, 0x8d, 0x5d,
+	0x78, 0x37, 0x5d, 0x21, 0xc0, 0x6c, 0x74, 0x16, 0x8e, 0x43, 0x2e, 0x37, 0x65, 0xd1, 0xab, 0xa6,
+	0x92, 0x9f, 0x63, 0xf6, 0xa5, 0x48, 0x65, 0x2c, 0x7b, 0x00, 0x55, 0x25, 0xed, 0x66, 0x0b, 0xe4,
+	0x1f, 0x13, 0x40, 0xd5, 0x91, 0x86, 0xed, 0x65, 0x0c, 0xfb, 0x41, 0xee, 0xe8, 0xaf, 0xe0, 0xb9,
+	0x7e, 0xfd, 0xd9, 0xbc, 0x9e, 0x5f, 0xfb, 0x69, 0xbf, 0x3e, 0xb8, 0x26, 0xd3, 0xaa, 0x5d, 0x7f,
+	0x2d, 0x28, 0xbb, 0x3e, 0x87, 0x77, 0x16, 0x76, 0x15, 0x85, 0x46, 0x04, 0x73, 0xac, 0x45, 0x7c,
+	0xf2, 0x06, 0xa5, 0x17, 0x86, 0x75, 0x70, 0x44, 0x84, 0x9f, 0x7a, 0x49, 0xc0, 0x34, 0xdd, 0xed,
+	0x24, 0x9b, 0xaa, 0xff, 0x66, 0x40, 0x35, 0x07, 0x8e, 0xf6, 0x60, 0x5b, 0xbc, 0xdd, 0xaa, 0xbb,
+	0x6e, 0xcf, 0x67, 0xcd, 0x8a, 0x70, 0xd7, 0xc2, 0x56, 0x15, 0xb2, 0x3c, 0x90, 0x85, 0x1b, 0xfe,
+	0xcf, 0x48, 0xd2, 0x0d, 0xab, 0x0e, 0xba, 0x0f, 0xe5, 0x55, 0xd7, 0x94, 0x02, 0x6d, 0x15, 0x54,
+	0x83, 0xad, 0x89, 0xd2, 0x25, 0x57, 0x6f, 0xd9, 0x5b, 0x1c, 0xdb, 0xdf, 0xc9, 0x15, 0xd5, 0x73,
+	0xdc, 0x75, 0xd6, 0x6e, 0xea, 0x8b, 0x5e, 0x58, 0xf3, 0x8b, 0x7e, 0x00, 0x55, 0xc5, 0x7f, 0x23,
+	0xe3, 0x3a, 0x87, 0xbf, 0xcc, 0x1b, 0xc6, 0xab, 0x79, 0xc3, 0x78, 0x3d, 0x6f, 0x18, 0x7f, 0xcf,
+	0x1b, 0xc6, 0x8f, 0x97, 0x8d, 0x8d, 0xd7, 0x97, 0x8d, 0x8d, 0x3f, 0x2f, 0x1b, 0x1b, 0x4f, 0xed,
+	0x6b, 0x7c, 0xfc, 0xf5, 0x3f, 0x3b, 0xf9, 0xed, 0x3f, 0xd9, 0x94, 0x88, 0xbd, 0xff, 0x06, 0x00,
+	0x59, 0x37, 0x3f, 0xd5, 0xf5, 0x09, 0x00, 0x00,
 }
 
 func (this *TSSRoute) Equal(that interface{}) bool {
@@ -1463,6 +1567,57 @@ func (this *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Equal(that interface{})
 	}
 	return true
 }
+func (this *IBCABIRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCABIRoute)
+	if !ok {
+		that2, ok := that.(IBCABIRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelID != that1.ChannelID {
+		return false
+	}
+	if this.Encoder != that1.Encoder {
+		return false
+	}
+	return true
+}
+func (this *IBCABIPacketReceipt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCABIPacketReceipt)
+	if !ok {
+		that2, ok := that.(IBCABIPacketReceipt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	return true
+}
 func (m *TSSRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2072,6 +2227,69 @@ func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
+func (m *IBCABIRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCABIRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCABIRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Encoder != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.Encoder))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCABIPacketReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCABIPacketReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCABIPacketReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRoute(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRoute(v)
 	base := offset
@@ -2334,6 +2552,34 @@ func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Size() (n int) {
 	return n
 }
 
+func (m *IBCABIRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	if m.Encoder != 0 {
+		n += 1 + sovRoute(uint64(m.Encoder))
+	}
+	return n
+}
+
+func (m *IBCABIPacketReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovRoute(uint64(m.Sequence))
+	}
+	return n
+}
+
 func sovRoute(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4029,6 +4275,176 @@ func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
+func (m *IBCABIRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCABIRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCABIRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			m.Encoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Encoder |= types.Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCABIPacketReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCABIPacketReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCABIPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRoute(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
+	proto "github.com/cosmos/gogoproto/proto"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -53,5 +55,43 @@ func NewTunnelPricesPacketData(
 
 // GetBytes is a helper for serialising
 func (p TunnelPricesPacketData) GetBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
+	return MustMarshalPacketJSON(&p)
+}
+
+// MustMarshalPacketJSON returns the sorted JSON encoding of the given message without the price
+// metadata that is not set, so that the packets of the tunnels that do not include the price metadata
+// keep the format of the prices without the metadata.
+func MustMarshalPacketJSON(msg proto.Message) []byte {
+	var v any
+	if err := json.Unmarshal(ModuleCdc.MustMarshalJSON(msg), &v); err != nil {
+		panic(err)
+	}
+	removeUnsetPriceMetadata(v)
+
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// removeUnsetPriceMetadata removes the unset aggregation methods and confidences from the decoded JSON value.
+func removeUnsetPriceMetadata(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		if v["aggregation_method"] == feedstypes.AGGREGATION_METHOD_UNSPECIFIED.String() {
+			delete(v, "aggregation_method")
+		}
+		if confidence, ok := v["confidence"]; ok && confidence == nil {
+			delete(v, "confidence")
+		}
+		for _, child := range v {
+			removeUnsetPriceMetadata(child)
+		}
+	case []any:
+		for _, child := range v {
+			removeUnsetPriceMetadata(child)
+		}
+	}
 }
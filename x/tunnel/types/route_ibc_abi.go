@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// IBCABIRoute defines the IBC ABI route for the tunnel module
+var _ RouteI = &IBCABIRoute{}
+
+// NewIBCABIRoute creates a new IBCABIRoute instance.
+func NewIBCABIRoute(channelID string, encoder feedstypes.Encoder) *IBCABIRoute {
+	return &IBCABIRoute{
+		ChannelID: channelID,
+		Encoder:   encoder,
+	}
+}
+
+// ValidateBasic validates the IBCABIRoute
+func (r *IBCABIRoute) ValidateBasic() error {
+	// Validate the ChannelID format
+	if r.ChannelID != "" && !channeltypes.IsChannelIDFormat(r.ChannelID) {
+		return fmt.Errorf("channel identifier is not in the format: `channel-{N}` or be empty string")
+	}
+
+	if err := feedstypes.ValidateEncoder(r.Encoder); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewIBCABIPacketReceipt creates a new IBCABIPacketReceipt instance.
+func NewIBCABIPacketReceipt(sequence uint64) *IBCABIPacketReceipt {
+	return &IBCABIPacketReceipt{
+		Sequence: sequence,
+	}
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestIBCABIRoute_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		route  types.IBCABIRoute
+		expErr bool
+		errMsg string
+	}{
+		{
+			name:   "all good with empty channel",
+			route:  *types.NewIBCABIRoute("", feedstypes.ENCODER_FIXED_POINT_ABI),
+			expErr: false,
+		},
+		{
+			name:   "all good",
+			route:  *types.NewIBCABIRoute("channel-1", feedstypes.ENCODER_TICK_ABI),
+			expErr: false,
+		},
+		{
+			name:   "invalid channel id",
+			route:  *types.NewIBCABIRoute("invalid-channel", feedstypes.ENCODER_FIXED_POINT_ABI),
+			expErr: true,
+			errMsg: "channel identifier is not in the format",
+		},
+		{
+			name:   "invalid encoder",
+			route:  *types.NewIBCABIRoute("channel-1", feedstypes.ENCODER_UNSPECIFIED),
+			expErr: true,
+			errMsg: "invalid encoder",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.route.ValidateBasic()
+			if tt.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
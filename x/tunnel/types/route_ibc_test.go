@@ -27,3 +27,19 @@ func TestGetByteTunnelPricesPacketData(t *testing.T) {
 		packet.GetBytes(),
 	)
 }
+
+func TestGetByteTunnelPricesPacketDataWithConfidence(t *testing.T) {
+	price := feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 50000, 1733000000)
+	price.AggregationMethod = feedstypes.AGGREGATION_METHOD_WEIGHTED_MEDIAN
+	price.Confidence = feedstypes.NewPriceConfidence(10, 8000, 25)
+
+	packet := types.NewTunnelPricesPacketData(1, 2, []feedstypes.Price{price}, 1633024800)
+
+	require.Equal(
+		t,
+		[]byte(
+			`{"created_at":"1633024800","prices":[{"aggregation_method":"AGGREGATION_METHOD_WEIGHTED_MEDIAN","confidence":{"dispersion":"25","power_share_bps":"8000","reporter_count":"10"},"price":"50000","signal_id":"CS:BAND-USD","status":"PRICE_STATUS_AVAILABLE","timestamp":"1733000000"}],"sequence":"2","tunnel_id":"1"}`,
+		),
+		packet.GetBytes(),
+	)
+}
@@ -0,0 +1,260 @@
+package types
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxScheduleSearchYears is the number of years to search for the next scheduled time
+// before considering that the schedule never matches.
+const maxScheduleSearchYears = 5
+
+// scheduleField describes the allowed range of a field in a schedule expression.
+type scheduleField struct {
+	name string
+	min  uint64
+	max  uint64
+}
+
+var scheduleFields = []scheduleField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 6},
+}
+
+// Schedule is a parsed cron-like schedule expression. The expression has five fields separated
+// by spaces: minute, hour, day of month, month and day of week. Each field accepts `*`, a number,
+// a range `a-b`, a step `*/n` or `a-b/n`, and a comma-separated list of them. The schedule is
+// evaluated against the block time in UTC.
+type Schedule struct {
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	months   uint64
+	weekdays uint64
+
+	// dayStar and weekdayStar indicate whether the day of month and day of week fields are `*`.
+	// If both fields are restricted, a day matches when either field matches.
+	dayStar     bool
+	weekdayStar bool
+}
+
+// ParseSchedule parses a cron-like schedule expression.
+func ParseSchedule(expr string) (Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(scheduleFields) {
+		return Schedule{}, ErrInvalidSchedule.Wrapf(
+			"expected %d fields, got %d: %s",
+			len(scheduleFields),
+			len(fields),
+			expr,
+		)
+	}
+
+	sets := make([]uint64, len(fields))
+	for i, f := range fields {
+		set, err := parseScheduleField(f, scheduleFields[i])
+		if err != nil {
+			return Schedule{}, err
+		}
+		sets[i] = set
+	}
+
+	return Schedule{
+		minutes:     sets[0],
+		hours:       sets[1],
+		days:        sets[2],
+		months:      sets[3],
+		weekdays:    sets[4],
+		dayStar:     fields[2] == "*",
+		weekdayStar: fields[4] == "*",
+	}, nil
+}
+
+// parseScheduleField parses a field of a schedule expression into a bit set of the allowed values.
+func parseScheduleField(expr string, field scheduleField) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(part, "/")
+
+		step := uint64(1)
+		if hasStep {
+			s, err := strconv.ParseUint(stepExpr, 10, 64)
+			if err != nil || s == 0 {
+				return 0, ErrInvalidSchedule.Wrapf("invalid step of %s field: %s", field.name, part)
+			}
+			step = s
+		}
+
+		var start, end uint64
+		switch {
+		case rangeExpr == "*":
+			start, end = field.min, field.max
+		case strings.Contains(rangeExpr, "-"):
+			startExpr, endExpr, _ := strings.Cut(rangeExpr, "-")
+			s, err1 := strconv.ParseUint(startExpr, 10, 64)
+			e, err2 := strconv.ParseUint(endExpr, 10, 64)
+			if err1 != nil || err2 != nil {
+				return 0, ErrInvalidSchedule.Wrapf("invalid range of %s field: %s", field.name, part)
+			}
+			start, end = s, e
+		default:
+			v, err := strconv.ParseUint(rangeExpr, 10, 64)
+			if err != nil || hasStep {
+				return 0, ErrInvalidSchedule.Wrapf("invalid value of %s field: %s", field.name, part)
+			}
+			start, end = v, v
+		}
+
+		if start < field.min || end > field.max || start > end {
+			return 0, ErrInvalidSchedule.Wrapf(
+				"%s field must be within [%d, %d]: %s",
+				field.name,
+				field.min,
+				field.max,
+				part,
+			)
+		}
+
+		for v := start; v <= end; v += step {
+			set |= 1 << v
+		}
+	}
+
+	return set, nil
+}
+
+// Next returns the earliest scheduled time strictly after the given time. It returns the zero time
+// if the schedule does not match within the next few years.
+func (s Schedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxScheduleSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if s.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if s.hours&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+
+		if s.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// matchDay returns true if the day of the given time matches the day of month and day of week fields.
+func (s Schedule) matchDay(t time.Time) bool {
+	dayMatch := s.days&(1<<uint(t.Day())) != 0
+	weekdayMatch := s.weekdays&(1<<uint(t.Weekday())) != 0
+
+	if s.dayStar || s.weekdayStar {
+		return dayMatch && weekdayMatch
+	}
+	return dayMatch || weekdayMatch
+}
+
+// MinInterval returns the shortest possible gap in seconds between two consecutive scheduled times
+// within a day, including the gap across midnight.
+func (s Schedule) MinInterval() uint64 {
+	if bits.OnesCount64(s.minutes)*bits.OnesCount64(s.hours) <= 1 {
+		return 24 * 60 * 60
+	}
+
+	var prev, first int
+	minGap := 24 * 60
+	count := 0
+	for h := 0; h < 24; h++ {
+		if s.hours&(1<<uint(h)) == 0 {
+			continue
+		}
+		for m := 0; m < 60; m++ {
+			if s.minutes&(1<<uint(m)) == 0 {
+				continue
+			}
+
+			minuteOfDay := h*60 + m
+			if count == 0 {
+				first = minuteOfDay
+			} else if minuteOfDay-prev < minGap {
+				minGap = minuteOfDay - prev
+			}
+			prev = minuteOfDay
+			count++
+		}
+	}
+
+	// gap between the last scheduled time of a day and the first scheduled time of the next day
+	if gap := 24*60 - prev + first; gap < minGap {
+		minGap = gap
+	}
+
+	return uint64(minGap) * 60
+}
+
+// ValidateSchedule validates the schedule expression of a tunnel. An empty schedule is valid
+// and disables the schedule trigger.
+func ValidateSchedule(schedule string, minInterval uint64) error {
+	if schedule == "" {
+		return nil
+	}
+
+	s, err := ParseSchedule(schedule)
+	if err != nil {
+		return err
+	}
+
+	// whether a schedule matches does not depend on the year except for February 29, which
+	// occurs within the search horizon from any reference time, so a fixed reference time is used.
+	if s.Next(time.Unix(0, 0)).IsZero() {
+		return ErrInvalidSchedule.Wrapf("schedule never matches: %s", schedule)
+	}
+
+	if s.MinInterval() < minInterval {
+		return ErrInvalidSchedule.Wrapf(
+			"scheduled times must be at least %d seconds apart: %s",
+			minInterval,
+			schedule,
+		)
+	}
+
+	return nil
+}
+
+// IsScheduleDue returns true if a scheduled time of the schedule expression has passed
+// since the last time it was triggered.
+func IsScheduleDue(schedule string, lastTriggered int64, now int64) (bool, error) {
+	if schedule == "" {
+		return false, nil
+	}
+
+	s, err := ParseSchedule(schedule)
+	if err != nil {
+		return false, err
+	}
+
+	next := s.Next(time.Unix(lastTriggered, 0))
+	if next.IsZero() {
+		return false, nil
+	}
+
+	return next.Unix() <= now, nil
+}
@@ -0,0 +1,163 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestParseSchedule(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expr   string
+		expErr bool
+	}{
+		{name: "every minute", expr: "* * * * *", expErr: false},
+		{name: "list, range and step", expr: "0,30 9-17 */2 1-6/2 1-5", expErr: false},
+		{name: "too few fields", expr: "* * * *", expErr: true},
+		{name: "too many fields", expr: "* * * * * *", expErr: true},
+		{name: "minute out of range", expr: "60 * * * *", expErr: true},
+		{name: "day of month out of range", expr: "* * 0 * *", expErr: true},
+		{name: "day of week out of range", expr: "* * * * 7", expErr: true},
+		{name: "reversed range", expr: "* 10-5 * * *", expErr: true},
+		{name: "zero step", expr: "*/0 * * * *", expErr: true},
+		{name: "step without range", expr: "5/10 * * * *", expErr: true},
+		{name: "not a number", expr: "a * * * *", expErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.ParseSchedule(tc.expr)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidSchedule)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	// 2024-12-01 is a Sunday
+	base := time.Date(2024, 12, 1, 10, 7, 30, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+		expr string
+		from time.Time
+		exp  time.Time
+	}{
+		{
+			name: "every minute",
+			expr: "* * * * *",
+			from: base,
+			exp:  time.Date(2024, 12, 1, 10, 8, 0, 0, time.UTC),
+		},
+		{
+			name: "every 15 minutes",
+			expr: "*/15 * * * *",
+			from: base,
+			exp:  time.Date(2024, 12, 1, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name: "strictly after the given time",
+			expr: "0 * * * *",
+			from: time.Date(2024, 12, 1, 10, 0, 0, 0, time.UTC),
+			exp:  time.Date(2024, 12, 1, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "daily at midnight",
+			expr: "0 0 * * *",
+			from: base,
+			exp:  time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "next weekday",
+			expr: "30 9 * * 1-5",
+			from: base,
+			exp:  time.Date(2024, 12, 2, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "next year",
+			expr: "0 0 1 1 *",
+			from: base,
+			exp:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week",
+			expr: "0 0 15 * 3",
+			from: base,
+			exp:  time.Date(2024, 12, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "never matches",
+			expr: "0 0 31 2 *",
+			from: base,
+			exp:  time.Time{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := types.ParseSchedule(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, s.Next(tc.from))
+		})
+	}
+}
+
+func TestScheduleMinInterval(t *testing.T) {
+	testCases := []struct {
+		expr string
+		exp  uint64
+	}{
+		{expr: "* * * * *", exp: 60},
+		{expr: "*/15 * * * *", exp: 900},
+		{expr: "0 * * * *", exp: 3600},
+		{expr: "0 0 * * *", exp: 86400},
+		{expr: "0,50 0,12 * * *", exp: 3000},
+		{expr: "0 1,23 * * *", exp: 7200},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			s, err := types.ParseSchedule(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, s.MinInterval())
+		})
+	}
+}
+
+func TestValidateSchedule(t *testing.T) {
+	require.NoError(t, types.ValidateSchedule("", 3600))
+	require.NoError(t, types.ValidateSchedule("0 * * * *", 3600))
+	require.ErrorIs(t, types.ValidateSchedule("*/30 * * * *", 3600), types.ErrInvalidSchedule)
+	require.ErrorIs(t, types.ValidateSchedule("invalid", 0), types.ErrInvalidSchedule)
+
+	// schedules that can never match
+	require.NoError(t, types.ValidateSchedule("0 0 29 2 *", 3600))
+	require.ErrorIs(t, types.ValidateSchedule("0 0 30 2 *", 3600), types.ErrInvalidSchedule)
+	require.ErrorIs(t, types.ValidateSchedule("0 0 31 4,6,9,11 *", 3600), types.ErrInvalidSchedule)
+}
+
+func TestIsScheduleDue(t *testing.T) {
+	last := time.Date(2024, 12, 1, 10, 0, 0, 0, time.UTC).Unix()
+
+	due, err := types.IsScheduleDue("", last, last+7200)
+	require.NoError(t, err)
+	require.False(t, due)
+
+	due, err = types.IsScheduleDue("0 * * * *", last, last+3599)
+	require.NoError(t, err)
+	require.False(t, due)
+
+	due, err = types.IsScheduleDue("0 * * * *", last, last+3600)
+	require.NoError(t, err)
+	require.True(t, due)
+
+	_, err = types.IsScheduleDue("invalid", last, last)
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+}
@@ -19,7 +19,10 @@ func NewTunnel(
 	route RouteI,
 	feePayer string,
 	signalDeviations []SignalDeviation,
+	derivedSignals []DerivedSignal,
 	interval uint64,
+	schedule string,
+	includePriceConfidence bool,
 	totalDeposit []sdk.Coin,
 	isActive bool,
 	createdAt int64,
@@ -35,16 +38,19 @@ func NewTunnel(
 	}
 
 	return Tunnel{
-		ID:               id,
-		Sequence:         sequence,
-		Route:            any,
-		FeePayer:         feePayer,
-		SignalDeviations: signalDeviations,
-		Interval:         interval,
-		TotalDeposit:     totalDeposit,
-		IsActive:         isActive,
-		CreatedAt:        createdAt,
-		Creator:          creator,
+		ID:                     id,
+		Sequence:               sequence,
+		Route:                  any,
+		FeePayer:               feePayer,
+		SignalDeviations:       signalDeviations,
+		DerivedSignals:         derivedSignals,
+		Interval:               interval,
+		Schedule:               schedule,
+		IncludePriceConfidence: includePriceConfidence,
+		TotalDeposit:           totalDeposit,
+		IsActive:               isActive,
+		CreatedAt:              createdAt,
+		Creator:                creator,
 	}, nil
 }
 
@@ -102,6 +108,41 @@ func (t Tunnel) GetSignalIDs() []string {
 	return signalIDs
 }
 
+// IsAuthorized returns true if the address is the creator of the tunnel or a co-administrator
+// of the tunnel with the given permission.
+func (t Tunnel) IsAuthorized(address string, permission TunnelPermission) bool {
+	if address == t.Creator {
+		return true
+	}
+
+	for _, admin := range t.Admins {
+		if admin.Address == address {
+			return admin.HasPermission(permission)
+		}
+	}
+	return false
+}
+
+// IsAdmin returns true if the address is the creator or a co-administrator of the tunnel.
+func (t Tunnel) IsAdmin(address string) bool {
+	if address == t.Creator {
+		return true
+	}
+
+	for _, admin := range t.Admins {
+		if admin.Address == address {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAllSignalDeviations returns the signal deviations of the tunnel followed by the signal
+// deviations of its derived signals.
+func (t Tunnel) GetAllSignalDeviations() []SignalDeviation {
+	return CombineSignalDeviations(t.SignalDeviations, t.DerivedSignals)
+}
+
 // ValidateInterval validates the interval of the tunnel.
 func ValidateInterval(interval, maxInterval, minInterval uint64) error {
 	if interval < minInterval || interval > maxInterval {
@@ -114,3 +155,27 @@ func ValidateInterval(interval, maxInterval, minInterval uint64) error {
 	}
 	return nil
 }
+
+// SecondsPerDay is the number of seconds in a day.
+const SecondsPerDay uint64 = 24 * 60 * 60
+
+// EstimatePacketsPerDay estimates the number of packets produced per day by a tunnel with the
+// given interval, as a packet with all signal prices is produced every time the interval passes.
+func EstimatePacketsPerDay(interval uint64) uint64 {
+	if interval == 0 {
+		return 0
+	}
+
+	return (SecondsPerDay + interval - 1) / interval
+}
+
+// ScalePacketsPerDay scales the number of packets produced over the given duration in seconds
+// to the number of packets per day, rounded up and capped at the given maximum, since a short
+// duration extrapolates a few packets to an unrealistic daily count.
+func ScalePacketsPerDay(count uint64, duration int64, maxPacketsPerDay uint64) uint64 {
+	if count == 0 || duration <= 0 {
+		return 0
+	}
+
+	return min((count*SecondsPerDay+uint64(duration)-1)/uint64(duration), maxPacketsPerDay)
+}
@@ -29,6 +29,103 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TunnelPermission defines an action on the tunnel that the creator can grant to a co-administrator.
+type TunnelPermission int32
+
+const (
+	// TUNNEL_PERMISSION_UNSPECIFIED defines an unspecified permission.
+	TUNNEL_PERMISSION_UNSPECIFIED TunnelPermission = 0
+	// TUNNEL_PERMISSION_UPDATE_ROUTE allows updating the route of the tunnel.
+	TUNNEL_PERMISSION_UPDATE_ROUTE TunnelPermission = 1
+	// TUNNEL_PERMISSION_UPDATE_SIGNALS_AND_INTERVAL allows updating the signals and interval of the tunnel.
+	TUNNEL_PERMISSION_UPDATE_SIGNALS_AND_INTERVAL TunnelPermission = 2
+	// TUNNEL_PERMISSION_ACTIVATE allows activating the tunnel.
+	TUNNEL_PERMISSION_ACTIVATE TunnelPermission = 3
+	// TUNNEL_PERMISSION_DEACTIVATE allows deactivating the tunnel.
+	TUNNEL_PERMISSION_DEACTIVATE TunnelPermission = 4
+	// TUNNEL_PERMISSION_TRIGGER allows manually triggering the tunnel.
+	TUNNEL_PERMISSION_TRIGGER TunnelPermission = 5
+	// TUNNEL_PERMISSION_WITHDRAW_FEE_PAYER_FUNDS allows withdrawing the fee payer funds to the creator.
+	TUNNEL_PERMISSION_WITHDRAW_FEE_PAYER_FUNDS TunnelPermission = 6
+	// TUNNEL_PERMISSION_RETRY_PACKET allows resending failed packets of the tunnel.
+	TUNNEL_PERMISSION_RETRY_PACKET TunnelPermission = 7
+)
+
+var TunnelPermission_name = map[int32]string{
+	0: "TUNNEL_PERMISSION_UNSPECIFIED",
+	1: "TUNNEL_PERMISSION_UPDATE_ROUTE",
+	2: "TUNNEL_PERMISSION_UPDATE_SIGNALS_AND_INTERVAL",
+	3: "TUNNEL_PERMISSION_ACTIVATE",
+	4: "TUNNEL_PERMISSION_DEACTIVATE",
+	5: "TUNNEL_PERMISSION_TRIGGER",
+	6: "TUNNEL_PERMISSION_WITHDRAW_FEE_PAYER_FUNDS",
+	7: "TUNNEL_PERMISSION_RETRY_PACKET",
+}
+
+var TunnelPermission_value = map[string]int32{
+	"TUNNEL_PERMISSION_UNSPECIFIED":                 0,
+	"TUNNEL_PERMISSION_UPDATE_ROUTE":                1,
+	"TUNNEL_PERMISSION_UPDATE_SIGNALS_AND_INTERVAL": 2,
+	"TUNNEL_PERMISSION_ACTIVATE":                    3,
+	"TUNNEL_PERMISSION_DEACTIVATE":                  4,
+	"TUNNEL_PERMISSION_TRIGGER":                     5,
+	"TUNNEL_PERMISSION_WITHDRAW_FEE_PAYER_FUNDS":    6,
+	"TUNNEL_PERMISSION_RETRY_PACKET":                7,
+}
+
+func (x TunnelPermission) String() string {
+	return proto.EnumName(TunnelPermission_name, int32(x))
+}
+
+func (TunnelPermission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{0}
+}
+
+// PacketStatus defines the delivery status of a packet.
+type PacketStatus int32
+
+const (
+	// PACKET_STATUS_UNSPECIFIED defines a packet whose delivery is not tracked by the route.
+	PACKET_STATUS_UNSPECIFIED PacketStatus = 0
+	// PACKET_STATUS_PENDING defines a packet that is waiting for an acknowledgement or a timeout.
+	PACKET_STATUS_PENDING PacketStatus = 1
+	// PACKET_STATUS_SUCCESS defines a packet that is acknowledged successfully by the counterparty chain.
+	PACKET_STATUS_SUCCESS PacketStatus = 2
+	// PACKET_STATUS_ERROR defines a packet that is acknowledged with an error by the counterparty chain.
+	PACKET_STATUS_ERROR PacketStatus = 3
+	// PACKET_STATUS_TIMEOUT defines a packet that is timed out before being received by the counterparty chain.
+	PACKET_STATUS_TIMEOUT PacketStatus = 4
+	// PACKET_STATUS_FAILED defines a packet that the route failed to send, e.g. the signing request of
+	// a TSS packet cannot be created. The packet can be resent with MsgRetryPacket.
+	PACKET_STATUS_FAILED PacketStatus = 5
+)
+
+var PacketStatus_name = map[int32]string{
+	0: "PACKET_STATUS_UNSPECIFIED",
+	1: "PACKET_STATUS_PENDING",
+	2: "PACKET_STATUS_SUCCESS",
+	3: "PACKET_STATUS_ERROR",
+	4: "PACKET_STATUS_TIMEOUT",
+	5: "PACKET_STATUS_FAILED",
+}
+
+var PacketStatus_value = map[string]int32{
+	"PACKET_STATUS_UNSPECIFIED": 0,
+	"PACKET_STATUS_PENDING":     1,
+	"PACKET_STATUS_SUCCESS":     2,
+	"PACKET_STATUS_ERROR":       3,
+	"PACKET_STATUS_TIMEOUT":     4,
+	"PACKET_STATUS_FAILED":      5,
+}
+
+func (x PacketStatus) String() string {
+	return proto.EnumName(PacketStatus_name, int32(x))
+}
+
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{1}
+}
+
 // Tunnel contains the information of the tunnel that is created by the user
 type Tunnel struct {
 	// id is the tunnel ID
@@ -51,6 +148,21 @@ type Tunnel struct {
 	CreatedAt int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// creator is the address of the creator
 	Creator string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schedule is the cron-like expression (minute hour day-of-month month day-of-week) evaluated
+	// against the block time in UTC for delivering all signal prices. It is disabled if empty.
+	Schedule string `protobuf:"bytes,11,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// derived_signals is the list of signals whose prices are derived from the feed prices
+	DerivedSignals []DerivedSignal `protobuf:"bytes,12,rep,name=derived_signals,json=derivedSignals,proto3" json:"derived_signals"`
+	// admins is the list of co-administrators of the tunnel and their permissions
+	Admins []TunnelAdmin `protobuf:"bytes,13,rep,name=admins,proto3" json:"admins"`
+	// funding_policy is the optional policy to automatically top up the fee payer from a funding account
+	FundingPolicy *FundingPolicy `protobuf:"bytes,14,opt,name=funding_policy,json=fundingPolicy,proto3" json:"funding_policy,omitempty"`
+	// include_price_confidence is the flag to include the confidence metadata of the feed prices in the packets
+	IncludePriceConfidence bool `protobuf:"varint,15,opt,name=include_price_confidence,json=includePriceConfidence,proto3" json:"include_price_confidence,omitempty"`
+	// pending_creator is the address proposed as the new creator; it becomes the creator once it accepts the ownership
+	PendingCreator string `protobuf:"bytes,16,opt,name=pending_creator,json=pendingCreator,proto3" json:"pending_creator,omitempty"`
+	// funding_source is the funding account approved by the creator to set the funding policy of the tunnel
+	FundingSource string `protobuf:"bytes,17,opt,name=funding_source,json=fundingSource,proto3" json:"funding_source,omitempty"`
 }
 
 func (m *Tunnel) Reset()         { *m = Tunnel{} }
@@ -156,6 +268,184 @@ func (m *Tunnel) GetCreator() string {
 	return ""
 }
 
+func (m *Tunnel) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *Tunnel) GetDerivedSignals() []DerivedSignal {
+	if m != nil {
+		return m.DerivedSignals
+	}
+	return nil
+}
+
+func (m *Tunnel) GetAdmins() []TunnelAdmin {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+func (m *Tunnel) GetFundingPolicy() *FundingPolicy {
+	if m != nil {
+		return m.FundingPolicy
+	}
+	return nil
+}
+
+func (m *Tunnel) GetIncludePriceConfidence() bool {
+	if m != nil {
+		return m.IncludePriceConfidence
+	}
+	return false
+}
+
+func (m *Tunnel) GetPendingCreator() string {
+	if m != nil {
+		return m.PendingCreator
+	}
+	return ""
+}
+
+func (m *Tunnel) GetFundingSource() string {
+	if m != nil {
+		return m.FundingSource
+	}
+	return ""
+}
+
+// TunnelAdmin is a co-administrator of the tunnel with the permissions granted by the creator
+type TunnelAdmin struct {
+	// address is the address of the co-administrator
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// permissions is the list of actions that the co-administrator can perform
+	Permissions []TunnelPermission `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=band.tunnel.v1beta1.TunnelPermission" json:"permissions,omitempty"`
+}
+
+func (m *TunnelAdmin) Reset()         { *m = TunnelAdmin{} }
+func (m *TunnelAdmin) String() string { return proto.CompactTextString(m) }
+func (*TunnelAdmin) ProtoMessage()    {}
+func (*TunnelAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{1}
+}
+func (m *TunnelAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelAdmin.Merge(m, src)
+}
+func (m *TunnelAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelAdmin proto.InternalMessageInfo
+
+func (m *TunnelAdmin) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TunnelAdmin) GetPermissions() []TunnelPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+// FundingPolicy is the allowance granted by a funding account to top up the fee payer of the tunnel
+// whenever the fee payer balance falls below the threshold
+type FundingPolicy struct {
+	// source is the address of the funding account
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// allowance is the remaining amount of coins that can be pulled from the funding account
+	Allowance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=allowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"allowance"`
+	// threshold is the fee payer balance below which the fee payer is topped up
+	Threshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=threshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"threshold"`
+	// top_up_amount is the amount of coins sent to the fee payer on each top-up
+	TopUpAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=top_up_amount,json=topUpAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"top_up_amount"`
+}
+
+func (m *FundingPolicy) Reset()         { *m = FundingPolicy{} }
+func (m *FundingPolicy) String() string { return proto.CompactTextString(m) }
+func (*FundingPolicy) ProtoMessage()    {}
+func (*FundingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{2}
+}
+func (m *FundingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingPolicy.Merge(m, src)
+}
+func (m *FundingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingPolicy proto.InternalMessageInfo
+
+func (m *FundingPolicy) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *FundingPolicy) GetAllowance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+func (m *FundingPolicy) GetThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Threshold
+	}
+	return nil
+}
+
+func (m *FundingPolicy) GetTopUpAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TopUpAmount
+	}
+	return nil
+}
+
 // LatestPrices is the type for prices that tunnel produces
 type LatestPrices struct {
 	// tunnel_id is the tunnel ID
@@ -164,13 +454,15 @@ type LatestPrices struct {
 	Prices []types2.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
 	// last_interval is the last interval when the signal prices are produced by interval trigger
 	LastInterval int64 `protobuf:"varint,3,opt,name=last_interval,json=lastInterval,proto3" json:"last_interval,omitempty"`
+	// last_schedule is the last time when the signal prices are produced by schedule trigger
+	LastSchedule int64 `protobuf:"varint,4,opt,name=last_schedule,json=lastSchedule,proto3" json:"last_schedule,omitempty"`
 }
 
 func (m *LatestPrices) Reset()         { *m = LatestPrices{} }
 func (m *LatestPrices) String() string { return proto.CompactTextString(m) }
 func (*LatestPrices) ProtoMessage()    {}
 func (*LatestPrices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{1}
+	return fileDescriptor_6bb6151451ba2f25, []int{3}
 }
 func (m *LatestPrices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,6 +512,13 @@ func (m *LatestPrices) GetLastInterval() int64 {
 	return 0
 }
 
+func (m *LatestPrices) GetLastSchedule() int64 {
+	if m != nil {
+		return m.LastSchedule
+	}
+	return 0
+}
+
 // TotalFees is the type for the total fees collected by the tunnel
 type TotalFees struct {
 	// total_base_packet_fee is the total base packet fee collected by the tunnel
@@ -230,7 +529,7 @@ func (m *TotalFees) Reset()         { *m = TotalFees{} }
 func (m *TotalFees) String() string { return proto.CompactTextString(m) }
 func (*TotalFees) ProtoMessage()    {}
 func (*TotalFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{2}
+	return fileDescriptor_6bb6151451ba2f25, []int{4}
 }
 func (m *TotalFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,13 +577,17 @@ type Packet struct {
 	Receipt *types.Any `protobuf:"bytes,4,opt,name=receipt,proto3" json:"receipt,omitempty"`
 	// created_at is the timestamp when the packet is created
 	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// base_fee is the base packet fee charged for the packet.
+	BaseFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=base_fee,json=baseFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"base_fee"`
+	// status is the delivery status of the packet on the destination route.
+	Status PacketStatus `protobuf:"varint,7,opt,name=status,proto3,enum=band.tunnel.v1beta1.PacketStatus" json:"status,omitempty"`
 }
 
 func (m *Packet) Reset()         { *m = Packet{} }
 func (m *Packet) String() string { return proto.CompactTextString(m) }
 func (*Packet) ProtoMessage()    {}
 func (*Packet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{3}
+	return fileDescriptor_6bb6151451ba2f25, []int{5}
 }
 func (m *Packet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,6 +651,102 @@ func (m *Packet) GetCreatedAt() int64 {
 	return 0
 }
 
+func (m *Packet) GetBaseFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BaseFee
+	}
+	return nil
+}
+
+func (m *Packet) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PACKET_STATUS_UNSPECIFIED
+}
+
+// FailedPacket is the record of a packet that failed to be sent to the destination route.
+type FailedPacket struct {
+	// tunnel_id is the tunnel ID
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the failed packet.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// reason is the error returned by the route on the latest attempt.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// attempts is the number of times the packet has been tried to be sent.
+	Attempts uint64 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// last_attempt_at is the timestamp of the latest attempt.
+	LastAttemptAt int64 `protobuf:"varint,5,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+}
+
+func (m *FailedPacket) Reset()         { *m = FailedPacket{} }
+func (m *FailedPacket) String() string { return proto.CompactTextString(m) }
+func (*FailedPacket) ProtoMessage()    {}
+func (*FailedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{6}
+}
+func (m *FailedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedPacket.Merge(m, src)
+}
+func (m *FailedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedPacket proto.InternalMessageInfo
+
+func (m *FailedPacket) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *FailedPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *FailedPacket) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FailedPacket) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *FailedPacket) GetLastAttemptAt() int64 {
+	if m != nil {
+		return m.LastAttemptAt
+	}
+	return 0
+}
+
 // Deposit defines an amount deposited by an account address to the tunnel.
 type Deposit struct {
 	// tunnel_id defines the unique id of the tunnel.
@@ -362,7 +761,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{4}
+	return fileDescriptor_6bb6151451ba2f25, []int{7}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +825,7 @@ func (m *SignalDeviation) Reset()         { *m = SignalDeviation{} }
 func (m *SignalDeviation) String() string { return proto.CompactTextString(m) }
 func (*SignalDeviation) ProtoMessage()    {}
 func (*SignalDeviation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{5}
+	return fileDescriptor_6bb6151451ba2f25, []int{8}
 }
 func (m *SignalDeviation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,30 +875,33 @@ func (m *SignalDeviation) GetHardDeviationBPS() uint64 {
 	return 0
 }
 
-// TunnelSignatureOrder defines a general signature order for sending signature to tss group.
-type TunnelSignatureOrder struct {
-	// sequence is the sequence of the packet
-	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	// prices is the list of prices information from feeds module.
-	Prices []types2.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
-	// created_at is the timestamp when the packet is created
-	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// encoder is the mode of encoding data.
-	Encoder types2.Encoder `protobuf:"varint,4,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+// DerivedSignal is the type for a signal whose price is calculated from the prices of feed signals
+type DerivedSignal struct {
+	// signal_id is the signal ID of the derived signal
+	SignalID string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// expression is the arithmetic expression over feed signal IDs, e.g. "CS:ETH-USD / CS:BTC-USD"
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// soft_deviation_bps is the soft deviation in basis points
+	SoftDeviationBPS uint64 `protobuf:"varint,3,opt,name=soft_deviation_bps,json=softDeviationBps,proto3" json:"soft_deviation_bps,omitempty"`
+	// hard_deviation_bps is the hard deviation in basis points
+	HardDeviationBPS uint64 `protobuf:"varint,4,opt,name=hard_deviation_bps,json=hardDeviationBps,proto3" json:"hard_deviation_bps,omitempty"`
+	// twap_window is the duration in seconds of the time-weighted average of the source feed prices;
+	// zero uses the latest feed prices
+	TWAPWindow uint64 `protobuf:"varint,5,opt,name=twap_window,json=twapWindow,proto3" json:"twap_window,omitempty"`
 }
 
-func (m *TunnelSignatureOrder) Reset()         { *m = TunnelSignatureOrder{} }
-func (m *TunnelSignatureOrder) String() string { return proto.CompactTextString(m) }
-func (*TunnelSignatureOrder) ProtoMessage()    {}
-func (*TunnelSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{6}
+func (m *DerivedSignal) Reset()         { *m = DerivedSignal{} }
+func (m *DerivedSignal) String() string { return proto.CompactTextString(m) }
+func (*DerivedSignal) ProtoMessage()    {}
+func (*DerivedSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{9}
 }
-func (m *TunnelSignatureOrder) XXX_Unmarshal(b []byte) error {
+func (m *DerivedSignal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TunnelSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DerivedSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TunnelSignatureOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DerivedSignal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -509,89 +911,218 @@ func (m *TunnelSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *TunnelSignatureOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TunnelSignatureOrder.Merge(m, src)
+func (m *DerivedSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedSignal.Merge(m, src)
 }
-func (m *TunnelSignatureOrder) XXX_Size() int {
+func (m *DerivedSignal) XXX_Size() int {
 	return m.Size()
 }
-func (m *TunnelSignatureOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_TunnelSignatureOrder.DiscardUnknown(m)
+func (m *DerivedSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedSignal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TunnelSignatureOrder proto.InternalMessageInfo
+var xxx_messageInfo_DerivedSignal proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Tunnel)(nil), "band.tunnel.v1beta1.Tunnel")
-	proto.RegisterType((*LatestPrices)(nil), "band.tunnel.v1beta1.LatestPrices")
-	proto.RegisterType((*TotalFees)(nil), "band.tunnel.v1beta1.TotalFees")
-	proto.RegisterType((*Packet)(nil), "band.tunnel.v1beta1.Packet")
-	proto.RegisterType((*Deposit)(nil), "band.tunnel.v1beta1.Deposit")
-	proto.RegisterType((*SignalDeviation)(nil), "band.tunnel.v1beta1.SignalDeviation")
-	proto.RegisterType((*TunnelSignatureOrder)(nil), "band.tunnel.v1beta1.TunnelSignatureOrder")
+func (m *DerivedSignal) GetSignalID() string {
+	if m != nil {
+		return m.SignalID
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("band/tunnel/v1beta1/tunnel.proto", fileDescriptor_6bb6151451ba2f25) }
+func (m *DerivedSignal) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
 
-var fileDescriptor_6bb6151451ba2f25 = []byte{
-	// 898 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xd8, 0x8e, 0xed, 0x9d, 0xa6, 0x25, 0x1d, 0x0c, 0xda, 0xa6, 0xc2, 0xb6, 0x02, 0x07,
-	0x83, 0x94, 0x5d, 0x9a, 0x2a, 0x45, 0xea, 0x2d, 0x4b, 0xa8, 0xb0, 0x04, 0x22, 0xda, 0x54, 0x42,
-	0xe2, 0xb2, 0x1a, 0xef, 0x3e, 0xdb, 0xa3, 0x3a, 0x3b, 0xcb, 0xcc, 0xd8, 0x22, 0x17, 0xce, 0x5c,
-	0x90, 0xe0, 0x03, 0x20, 0xf5, 0x58, 0x71, 0xe2, 0x90, 0xef, 0x40, 0x95, 0x53, 0xc5, 0x89, 0x03,
-	0x0a, 0xc8, 0x39, 0x80, 0xc4, 0x97, 0x40, 0x3b, 0x33, 0x6b, 0x63, 0x2b, 0x6d, 0x64, 0xa9, 0x97,
-	0xc4, 0xef, 0xbd, 0xdf, 0xbc, 0x7f, 0xbf, 0xdf, 0xcc, 0xe2, 0x4e, 0x9f, 0xa6, 0x89, 0xaf, 0x26,
-	0x69, 0x0a, 0x63, 0x7f, 0x7a, 0xaf, 0x0f, 0x8a, 0xde, 0xb3, 0xa6, 0x97, 0x09, 0xae, 0x38, 0x79,
-	0x33, 0x47, 0x78, 0xd6, 0x65, 0x11, 0xdb, 0xb7, 0xe9, 0x09, 0x4b, 0xb9, 0xaf, 0xff, 0x1a, 0xdc,
-	0x76, 0x2b, 0xe6, 0xf2, 0x84, 0x4b, 0xbf, 0x4f, 0x25, 0xcc, 0x33, 0xc5, 0x9c, 0xa5, 0x36, 0x7e,
-	0xc7, 0xc4, 0x23, 0x6d, 0xf9, 0xc6, 0xb0, 0xa1, 0xe6, 0x90, 0x0f, 0xb9, 0xf1, 0xe7, 0xbf, 0x8a,
-	0x03, 0x43, 0xce, 0x87, 0x63, 0xf0, 0xb5, 0xd5, 0x9f, 0x0c, 0x7c, 0x9a, 0x9e, 0xda, 0x90, 0xe9,
-	0x7a, 0x00, 0x90, 0xc8, 0x79, 0x29, 0x48, 0x63, 0x9e, 0x80, 0x28, 0xba, 0xb9, 0x02, 0xa1, 0x2d,
-	0x13, 0xdf, 0xf9, 0xbe, 0x8a, 0x6b, 0x8f, 0xf5, 0x4c, 0xe4, 0x6d, 0x5c, 0x66, 0x89, 0x8b, 0x3a,
-	0xa8, 0x5b, 0x0d, 0x6a, 0xb3, 0x8b, 0x76, 0xb9, 0x77, 0x18, 0x96, 0x59, 0x42, 0xb6, 0x71, 0x43,
-	0xc2, 0xd7, 0x13, 0x48, 0x63, 0x70, 0xcb, 0x79, 0x34, 0x9c, 0xdb, 0xe4, 0x01, 0xde, 0x10, 0x7c,
-	0xa2, 0xc0, 0xad, 0x74, 0x50, 0xf7, 0xc6, 0x5e, 0xd3, 0x33, 0xbd, 0x7a, 0x45, 0xaf, 0xde, 0x41,
-	0x7a, 0x1a, 0xe0, 0xf3, 0xb3, 0xdd, 0x5a, 0x98, 0xc3, 0x7a, 0xa1, 0x81, 0x93, 0x7d, 0xec, 0x0c,
-	0x00, 0xa2, 0x8c, 0x9e, 0x82, 0x70, 0xab, 0x1d, 0xd4, 0x75, 0x02, 0xf7, 0xb7, 0xb3, 0xdd, 0xa6,
-	0x5d, 0xc7, 0x41, 0x92, 0x08, 0x90, 0xf2, 0x58, 0x09, 0x96, 0x0e, 0xc3, 0xc6, 0x00, 0xe0, 0x28,
-	0x47, 0x92, 0x2f, 0xf1, 0x6d, 0xc9, 0x86, 0x29, 0x1d, 0x47, 0x09, 0x4c, 0x19, 0x55, 0x8c, 0xa7,
-	0xd2, 0xdd, 0xe8, 0x54, 0xba, 0x37, 0xf6, 0xde, 0xf3, 0xae, 0xe0, 0xc7, 0x3b, 0xd6, 0xe8, 0xc3,
-	0x02, 0x1c, 0x54, 0x9f, 0x5f, 0xb4, 0x4b, 0xe1, 0x96, 0x5c, 0x76, 0xcb, 0x7c, 0x46, 0x96, 0x2a,
-	0x10, 0x53, 0x3a, 0x76, 0x6b, 0x66, 0xc6, 0xc2, 0x26, 0x13, 0x7c, 0x53, 0x71, 0xa5, 0x6b, 0x66,
-	0x5c, 0x32, 0xe5, 0xd6, 0x75, 0xc1, 0x3b, 0x9e, 0x6d, 0x36, 0x27, 0x7a, 0x5e, 0xf0, 0x63, 0xce,
-	0xd2, 0x60, 0x3f, 0xaf, 0xf2, 0xf3, 0x9f, 0xed, 0xee, 0x90, 0xa9, 0xd1, 0xa4, 0xef, 0xc5, 0xfc,
-	0xc4, 0x12, 0x6d, 0xff, 0xed, 0xca, 0xe4, 0x89, 0xaf, 0x4e, 0x33, 0x90, 0xfa, 0x80, 0x7c, 0xf6,
-	0xf7, 0x2f, 0x1f, 0xa0, 0x70, 0x53, 0x97, 0x39, 0x34, 0x55, 0xc8, 0x5d, 0xec, 0x30, 0x19, 0xd1,
-	0x58, 0xb1, 0x29, 0xb8, 0x8d, 0x0e, 0xea, 0x36, 0xc2, 0x06, 0x93, 0x07, 0xda, 0x26, 0xef, 0x60,
-	0x1c, 0x0b, 0xa0, 0x0a, 0x92, 0x88, 0x2a, 0xd7, 0xe9, 0xa0, 0x6e, 0x25, 0x74, 0xac, 0xe7, 0x40,
-	0x91, 0x3d, 0x5c, 0xd7, 0x06, 0x17, 0x2e, 0xbe, 0x66, 0xb9, 0x05, 0xf0, 0x61, 0xf5, 0x9f, 0xa7,
-	0x6d, 0xb4, 0xf3, 0x13, 0xc2, 0x9b, 0x9f, 0x51, 0x05, 0x52, 0x1d, 0x09, 0x16, 0x83, 0x24, 0xef,
-	0x63, 0xc7, 0xec, 0x34, 0x9a, 0x8b, 0x63, 0x73, 0x76, 0xd1, 0x6e, 0x18, 0xd1, 0xf4, 0x0e, 0xc3,
-	0x86, 0x09, 0xf7, 0x12, 0xf2, 0x11, 0xae, 0x65, 0xfa, 0x90, 0x5b, 0xb6, 0x1b, 0xd2, 0x94, 0x18,
-	0xb9, 0x15, 0x0b, 0xd2, 0x69, 0x2d, 0x0f, 0x16, 0x4e, 0xde, 0xc5, 0x37, 0xc7, 0x54, 0xaa, 0x68,
-	0x4e, 0x41, 0x45, 0x0f, 0xb4, 0x99, 0x3b, 0x7b, 0xd6, 0x67, 0xfb, 0xfb, 0x11, 0x61, 0xe7, 0x71,
-	0xbe, 0xa6, 0x47, 0x00, 0x92, 0x7c, 0x8b, 0xdf, 0x32, 0xd4, 0xe4, 0x1c, 0x44, 0x19, 0x8d, 0x9f,
-	0x80, 0x8a, 0x06, 0x00, 0x2e, 0xba, 0x8e, 0xa2, 0x0f, 0xd7, 0xa5, 0x28, 0x24, 0xba, 0x52, 0x40,
-	0x25, 0x1c, 0xe9, 0x3a, 0x8f, 0x00, 0x6c, 0x4f, 0xff, 0x22, 0x5c, 0x33, 0xbe, 0x75, 0xb6, 0xf5,
-	0xaa, 0x6b, 0xb5, 0xd8, 0x64, 0x65, 0xbd, 0x4d, 0x06, 0xb8, 0x2e, 0x20, 0x06, 0x96, 0x29, 0x7d,
-	0xab, 0x5e, 0x76, 0x23, 0xc9, 0xf9, 0xd9, 0xee, 0x2d, 0xd3, 0x72, 0x68, 0xe0, 0xbd, 0xb0, 0x38,
-	0xb8, 0xa2, 0xad, 0x8d, 0x15, 0x6d, 0xed, 0xfc, 0x81, 0x70, 0xbd, 0xd0, 0xe8, 0x1a, 0xe3, 0x3e,
-	0xc0, 0x8e, 0xbd, 0x3f, 0x5c, 0xe8, 0x79, 0x5f, 0x25, 0xca, 0x05, 0x94, 0x8c, 0x70, 0x8d, 0x9e,
-	0xf0, 0x49, 0xaa, 0xe6, 0xab, 0x78, 0xdd, 0xd7, 0xce, 0xe6, 0xb7, 0x64, 0x9e, 0x23, 0xfc, 0xc6,
-	0xca, 0xab, 0x91, 0x8f, 0x69, 0x9f, 0x1d, 0x3b, 0xa6, 0x63, 0xc6, 0x34, 0xb8, 0x7c, 0x4c, 0x13,
-	0xee, 0x25, 0x24, 0xc0, 0x44, 0xf2, 0x81, 0x5a, 0xbc, 0x4f, 0x51, 0x3f, 0x93, 0x86, 0xdf, 0xa0,
-	0x39, 0xbb, 0x68, 0x6f, 0x1d, 0xf3, 0x81, 0x5a, 0xbc, 0x47, 0x47, 0xc7, 0xe1, 0x96, 0x5c, 0xf2,
-	0x64, 0x39, 0x89, 0x64, 0x44, 0x45, 0xb2, 0x92, 0xa3, 0xb2, 0xc8, 0xf1, 0x29, 0x15, 0xc9, 0x72,
-	0x8e, 0xd1, 0x92, 0x27, 0x93, 0x76, 0x98, 0x5f, 0x11, 0x6e, 0x1a, 0x2e, 0x74, 0xab, 0x6a, 0x22,
-	0xe0, 0x0b, 0x91, 0x80, 0x58, 0x12, 0x1f, 0x7a, 0xa9, 0xf8, 0xd6, 0xbc, 0xc6, 0xcb, 0xc2, 0xa9,
-	0xac, 0x3e, 0x4a, 0xfb, 0xb8, 0x6e, 0xbf, 0x4d, 0x5a, 0x9b, 0xb7, 0xf6, 0xee, 0x5e, 0x95, 0xf8,
-	0x13, 0x03, 0x09, 0x0b, 0xec, 0xc3, 0xea, 0x77, 0x4f, 0xdb, 0xa5, 0xe0, 0xf3, 0x67, 0xb3, 0x16,
-	0x7a, 0x3e, 0x6b, 0xa1, 0x17, 0xb3, 0x16, 0xfa, 0x6b, 0xd6, 0x42, 0x3f, 0x5c, 0xb6, 0x4a, 0x2f,
-	0x2e, 0x5b, 0xa5, 0xdf, 0x2f, 0x5b, 0xa5, 0xaf, 0xfc, 0xff, 0x31, 0x9e, 0xe7, 0xd4, 0x62, 0x8f,
-	0xf9, 0xd8, 0x8f, 0x47, 0x94, 0xa5, 0xfe, 0xf4, 0xbe, 0xff, 0x4d, 0xf1, 0x6d, 0xd7, 0xf4, 0xf7,
-	0x6b, 0x1a, 0x71, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x38, 0x1b, 0xc5, 0xfa, 0xf7, 0x07,
-	0x00, 0x00,
+func (m *DerivedSignal) GetSoftDeviationBPS() uint64 {
+	if m != nil {
+		return m.SoftDeviationBPS
+	}
+	return 0
+}
+
+func (m *DerivedSignal) GetHardDeviationBPS() uint64 {
+	if m != nil {
+		return m.HardDeviationBPS
+	}
+	return 0
+}
+
+func (m *DerivedSignal) GetTWAPWindow() uint64 {
+	if m != nil {
+		return m.TWAPWindow
+	}
+	return 0
+}
+
+// TunnelSignatureOrder defines a general signature order for sending signature to tss group.
+type TunnelSignatureOrder struct {
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// prices is the list of prices information from feeds module.
+	Prices []types2.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
+	// created_at is the timestamp when the packet is created
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// encoder is the mode of encoding data.
+	Encoder types2.Encoder `protobuf:"varint,4,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+}
+
+func (m *TunnelSignatureOrder) Reset()         { *m = TunnelSignatureOrder{} }
+func (m *TunnelSignatureOrder) String() string { return proto.CompactTextString(m) }
+func (*TunnelSignatureOrder) ProtoMessage()    {}
+func (*TunnelSignatureOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{10}
+}
+func (m *TunnelSignatureOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelSignatureOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelSignatureOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelSignatureOrder.Merge(m, src)
+}
+func (m *TunnelSignatureOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelSignatureOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelSignatureOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelSignatureOrder proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("band.tunnel.v1beta1.TunnelPermission", TunnelPermission_name, TunnelPermission_value)
+	proto.RegisterEnum("band.tunnel.v1beta1.PacketStatus", PacketStatus_name, PacketStatus_value)
+	proto.RegisterType((*Tunnel)(nil), "band.tunnel.v1beta1.Tunnel")
+	proto.RegisterType((*TunnelAdmin)(nil), "band.tunnel.v1beta1.TunnelAdmin")
+	proto.RegisterType((*FundingPolicy)(nil), "band.tunnel.v1beta1.FundingPolicy")
+	proto.RegisterType((*LatestPrices)(nil), "band.tunnel.v1beta1.LatestPrices")
+	proto.RegisterType((*TotalFees)(nil), "band.tunnel.v1beta1.TotalFees")
+	proto.RegisterType((*Packet)(nil), "band.tunnel.v1beta1.Packet")
+	proto.RegisterType((*FailedPacket)(nil), "band.tunnel.v1beta1.FailedPacket")
+	proto.RegisterType((*Deposit)(nil), "band.tunnel.v1beta1.Deposit")
+	proto.RegisterType((*SignalDeviation)(nil), "band.tunnel.v1beta1.SignalDeviation")
+	proto.RegisterType((*DerivedSignal)(nil), "band.tunnel.v1beta1.DerivedSignal")
+	proto.RegisterType((*TunnelSignatureOrder)(nil), "band.tunnel.v1beta1.TunnelSignatureOrder")
+}
+
+func init() { proto.RegisterFile("band/tunnel/v1beta1/tunnel.proto", fileDescriptor_6bb6151451ba2f25) }
+
+var fileDescriptor_6bb6151451ba2f25 = []byte{
+	// 1598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xe3, 0xc6,
+	0x15, 0x37, 0x25, 0x59, 0xb2, 0xc6, 0xb6, 0xcc, 0x9d, 0x38, 0x5b, 0xae, 0xd3, 0x95, 0x14, 0xf7,
+	0x03, 0xea, 0x02, 0x96, 0xb2, 0x0e, 0x36, 0x6d, 0x73, 0x68, 0x41, 0x49, 0x94, 0x43, 0xd4, 0x2b,
+	0xab, 0x43, 0x2a, 0x46, 0x7a, 0x21, 0x68, 0x72, 0x24, 0x11, 0x91, 0x39, 0x2c, 0x67, 0xe4, 0x8d,
+	0x2f, 0x3d, 0xe7, 0x54, 0xa4, 0xc7, 0x1e, 0x0a, 0x04, 0xe8, 0x25, 0xe8, 0xa9, 0x87, 0x05, 0x7a,
+	0x2f, 0x50, 0x24, 0xd8, 0x53, 0xd0, 0x53, 0x0f, 0x85, 0x5b, 0x68, 0x0f, 0xed, 0x9f, 0x11, 0x70,
+	0x66, 0x28, 0x59, 0xb6, 0x76, 0x0d, 0x03, 0xeb, 0x8b, 0xcd, 0xf7, 0xde, 0x6f, 0xde, 0xc7, 0xef,
+	0xcd, 0xbc, 0x19, 0x1b, 0x54, 0x4f, 0xdc, 0xd0, 0x6f, 0xb0, 0x49, 0x18, 0xe2, 0x71, 0xe3, 0xec,
+	0xf1, 0x09, 0x66, 0xee, 0x63, 0x29, 0xd6, 0xa3, 0x98, 0x30, 0x02, 0xdf, 0x4a, 0x10, 0x75, 0xa9,
+	0x92, 0x88, 0x9d, 0x7b, 0xee, 0x69, 0x10, 0x92, 0x06, 0xff, 0x29, 0x70, 0x3b, 0x65, 0x8f, 0xd0,
+	0x53, 0x42, 0x1b, 0x27, 0x2e, 0xc5, 0x33, 0x4f, 0x1e, 0x09, 0x42, 0x69, 0x7f, 0x20, 0xec, 0x0e,
+	0x97, 0x1a, 0x42, 0x90, 0xa6, 0xed, 0x21, 0x19, 0x12, 0xa1, 0x4f, 0xbe, 0xd2, 0x05, 0x43, 0x42,
+	0x86, 0x63, 0xdc, 0xe0, 0xd2, 0xc9, 0x64, 0xd0, 0x70, 0xc3, 0x73, 0x69, 0x12, 0x59, 0x0f, 0x30,
+	0xf6, 0xe9, 0x2c, 0x14, 0x0e, 0x3d, 0xe2, 0xe3, 0x38, 0xcd, 0x66, 0x09, 0x82, 0x4b, 0xc2, 0xbe,
+	0xfb, 0x8f, 0x02, 0xc8, 0xdb, 0xbc, 0x26, 0x78, 0x1f, 0x64, 0x02, 0x5f, 0x53, 0xaa, 0x4a, 0x2d,
+	0xd7, 0xcc, 0x4f, 0x2f, 0x2a, 0x19, 0xb3, 0x8d, 0x32, 0x81, 0x0f, 0x77, 0xc0, 0x1a, 0xc5, 0xbf,
+	0x9d, 0xe0, 0xd0, 0xc3, 0x5a, 0x26, 0xb1, 0xa2, 0x99, 0x0c, 0x3f, 0x00, 0xab, 0x31, 0x99, 0x30,
+	0xac, 0x65, 0xab, 0x4a, 0x6d, 0x7d, 0x7f, 0xbb, 0x2e, 0x72, 0xad, 0xa7, 0xb9, 0xd6, 0xf5, 0xf0,
+	0xbc, 0x09, 0x5e, 0x3c, 0xdf, 0xcb, 0xa3, 0x04, 0x66, 0x22, 0x01, 0x87, 0x4f, 0x40, 0x71, 0x80,
+	0xb1, 0x13, 0xb9, 0xe7, 0x38, 0xd6, 0x72, 0x55, 0xa5, 0x56, 0x6c, 0x6a, 0xff, 0x7c, 0xbe, 0xb7,
+	0x2d, 0xe9, 0xd0, 0x7d, 0x3f, 0xc6, 0x94, 0x5a, 0x2c, 0x0e, 0xc2, 0x21, 0x5a, 0x1b, 0x60, 0xdc,
+	0x4b, 0x90, 0xf0, 0x18, 0xdc, 0xa3, 0xc1, 0x30, 0x74, 0xc7, 0x8e, 0x8f, 0xcf, 0x02, 0x97, 0x05,
+	0x24, 0xa4, 0xda, 0x6a, 0x35, 0x5b, 0x5b, 0xdf, 0xff, 0x61, 0x7d, 0x49, 0x7f, 0xea, 0x16, 0x47,
+	0xb7, 0x53, 0x70, 0x33, 0xf7, 0xcd, 0x45, 0x65, 0x05, 0xa9, 0x74, 0x51, 0x4d, 0x93, 0x1a, 0x83,
+	0x90, 0xe1, 0xf8, 0xcc, 0x1d, 0x6b, 0x79, 0x51, 0x63, 0x2a, 0xc3, 0x09, 0xd8, 0x64, 0x84, 0xf1,
+	0x98, 0x11, 0xa1, 0x01, 0xd3, 0x0a, 0x3c, 0xe0, 0x83, 0xba, 0x4c, 0x36, 0x69, 0xf4, 0x2c, 0x60,
+	0x8b, 0x04, 0x61, 0xf3, 0x49, 0x12, 0xe5, 0x2f, 0xff, 0xa9, 0xd4, 0x86, 0x01, 0x1b, 0x4d, 0x4e,
+	0xea, 0x1e, 0x39, 0x95, 0x8d, 0x96, 0xbf, 0xf6, 0xa8, 0xff, 0x69, 0x83, 0x9d, 0x47, 0x98, 0xf2,
+	0x05, 0xf4, 0xab, 0xff, 0xfd, 0xf5, 0x91, 0x82, 0x36, 0x78, 0x98, 0xb6, 0x88, 0x02, 0xdf, 0x01,
+	0xc5, 0x80, 0x3a, 0xae, 0xc7, 0x82, 0x33, 0xac, 0xad, 0x55, 0x95, 0xda, 0x1a, 0x5a, 0x0b, 0xa8,
+	0xce, 0x65, 0xf8, 0x10, 0x00, 0x2f, 0xc6, 0x2e, 0xc3, 0xbe, 0xe3, 0x32, 0xad, 0x58, 0x55, 0x6a,
+	0x59, 0x54, 0x94, 0x1a, 0x9d, 0xc1, 0x7d, 0x50, 0xe0, 0x02, 0x89, 0x35, 0x70, 0x03, 0xb9, 0x29,
+	0x90, 0xb7, 0xd9, 0x1b, 0x61, 0x7f, 0x32, 0xc6, 0xda, 0x7a, 0xb2, 0x08, 0xcd, 0x64, 0xf8, 0x6b,
+	0xb0, 0xe5, 0xe3, 0x38, 0x38, 0xc3, 0xbe, 0x23, 0xa8, 0xa3, 0xda, 0x06, 0x27, 0x61, 0x77, 0x29,
+	0xeb, 0x6d, 0x81, 0x15, 0xe4, 0x4b, 0xce, 0x4b, 0xfe, 0x65, 0x25, 0x85, 0xbf, 0x00, 0x79, 0xd7,
+	0x3f, 0x0d, 0x42, 0xaa, 0x6d, 0x72, 0x4f, 0xd5, 0xa5, 0x9e, 0xc4, 0xd6, 0xd4, 0x13, 0xa0, 0xf4,
+	0x23, 0x57, 0x41, 0x13, 0x94, 0x06, 0x93, 0xd0, 0x0f, 0xc2, 0xa1, 0x13, 0x91, 0x71, 0xe0, 0x9d,
+	0x6b, 0xa5, 0xaa, 0xf2, 0xca, 0x8c, 0x3a, 0x02, 0xda, 0xe3, 0x48, 0xb4, 0x39, 0xb8, 0x2c, 0xc2,
+	0x9f, 0x01, 0x2d, 0x08, 0xbd, 0xf1, 0xc4, 0xc7, 0x4e, 0x14, 0x07, 0x1e, 0x76, 0x3c, 0x12, 0x0e,
+	0x02, 0x9f, 0x6f, 0xf8, 0x2d, 0x4e, 0xfc, 0x7d, 0x69, 0xef, 0x25, 0xe6, 0xd6, 0xcc, 0x0a, 0x75,
+	0xb0, 0x15, 0x61, 0x91, 0x44, 0xca, 0xb7, 0x7a, 0x03, 0xdf, 0x25, 0xb9, 0xa0, 0x25, 0x69, 0xff,
+	0xe5, 0xbc, 0x0e, 0x4a, 0x26, 0xb1, 0x87, 0xb5, 0x7b, 0x37, 0x78, 0x48, 0xb3, 0xb7, 0x38, 0xfc,
+	0xc3, 0xdc, 0xff, 0xbf, 0xac, 0x28, 0xbb, 0x5f, 0x28, 0x60, 0xfd, 0x12, 0x59, 0xc9, 0x0e, 0x70,
+	0xc5, 0x2a, 0x4d, 0xb9, 0xc1, 0x5f, 0x0a, 0x84, 0x07, 0x60, 0x3d, 0xc2, 0xf1, 0x69, 0x40, 0x29,
+	0x3f, 0x57, 0x99, 0x6a, 0xb6, 0x56, 0xda, 0xff, 0xd1, 0x6b, 0xfa, 0xd2, 0x9b, 0xa1, 0xd1, 0xe5,
+	0x95, 0x32, 0xa5, 0x3f, 0x65, 0xc1, 0xe6, 0x02, 0xef, 0xf0, 0x3d, 0x90, 0x97, 0x35, 0xde, 0x94,
+	0x93, 0xc4, 0xc1, 0x10, 0x14, 0xdd, 0xf1, 0x98, 0x3c, 0x73, 0xc5, 0xf0, 0xb9, 0x9b, 0x73, 0x37,
+	0x0f, 0x91, 0xc4, 0x63, 0xa3, 0x18, 0xd3, 0x11, 0x19, 0xfb, 0x5a, 0xf6, 0xae, 0xe2, 0xcd, 0x42,
+	0x40, 0x96, 0xcc, 0x96, 0xc8, 0x99, 0x44, 0x8e, 0x7b, 0x4a, 0x26, 0x21, 0xd3, 0x72, 0x77, 0x14,
+	0x73, 0x9d, 0x91, 0xa8, 0x1f, 0xe9, 0x3c, 0x88, 0xec, 0xcf, 0xdf, 0x15, 0xb0, 0x71, 0xe8, 0x32,
+	0x4c, 0x19, 0xdf, 0xd6, 0x14, 0xfe, 0x04, 0x14, 0x45, 0x9b, 0x9d, 0xd9, 0x3d, 0xb0, 0x31, 0xbd,
+	0xa8, 0xac, 0x89, 0x66, 0x9b, 0x6d, 0xb4, 0x26, 0xcc, 0xa6, 0x0f, 0x7f, 0x0a, 0xf2, 0xfc, 0xa8,
+	0xd0, 0x59, 0x53, 0xf8, 0x2e, 0x11, 0x37, 0x4b, 0x9a, 0x2f, 0x77, 0x9b, 0x1e, 0x5b, 0x01, 0x87,
+	0x3f, 0x00, 0x9b, 0x63, 0x97, 0x32, 0x67, 0x36, 0x6d, 0xb3, 0x7c, 0x76, 0x6d, 0x24, 0x4a, 0x53,
+	0xea, 0x66, 0xa0, 0xd9, 0x3c, 0xca, 0xcd, 0x41, 0x96, 0xd4, 0xc9, 0x22, 0xfe, 0xa0, 0x80, 0xa2,
+	0x9d, 0x8c, 0xcd, 0x0e, 0xc6, 0x14, 0xfe, 0x0e, 0xbc, 0x2d, 0x46, 0x75, 0xc2, 0x9b, 0x13, 0xb9,
+	0xde, 0xa7, 0x98, 0x39, 0x03, 0x9c, 0xec, 0xb7, 0x1b, 0x68, 0x7d, 0xef, 0xb6, 0xb4, 0x22, 0xc8,
+	0x23, 0x35, 0x5d, 0x8a, 0x7b, 0x3c, 0x4e, 0x07, 0xa7, 0x39, 0xfd, 0x3e, 0x0b, 0xf2, 0x42, 0x77,
+	0x1b, 0x4a, 0x5f, 0x77, 0xcd, 0xce, 0xe9, 0xce, 0xde, 0x8e, 0xee, 0x26, 0x28, 0xc4, 0xd8, 0xc3,
+	0x41, 0xc4, 0xb4, 0xdc, 0x6b, 0x6e, 0x68, 0xf8, 0xe2, 0xf9, 0x5e, 0x49, 0xa4, 0x8c, 0x04, 0xdc,
+	0x44, 0xe9, 0xc2, 0x2b, 0x77, 0xcd, 0xea, 0xd5, 0xbb, 0x66, 0x00, 0xd6, 0x38, 0xdb, 0x09, 0xcd,
+	0xf9, 0x37, 0x4f, 0x73, 0x21, 0x71, 0xd2, 0xc1, 0x18, 0xfe, 0x1c, 0xe4, 0x29, 0x73, 0xd9, 0x84,
+	0x6a, 0x85, 0xaa, 0x52, 0x2b, 0xed, 0xbf, 0xbb, 0x74, 0x30, 0x89, 0x22, 0x2c, 0x0e, 0x44, 0x72,
+	0xc1, 0xee, 0xdf, 0x14, 0xb0, 0xd1, 0x71, 0x83, 0x31, 0xf6, 0xdf, 0x6c, 0x5b, 0xee, 0x83, 0x7c,
+	0x8c, 0x5d, 0x4a, 0x42, 0xbe, 0x8b, 0x8b, 0x48, 0x4a, 0xc9, 0x1a, 0x97, 0x31, 0x7c, 0x1a, 0x31,
+	0xca, 0x69, 0xcf, 0xa1, 0x99, 0x0c, 0x7f, 0x0c, 0xb6, 0xf8, 0xde, 0x96, 0x8a, 0x39, 0xa5, 0x7c,
+	0xcb, 0xeb, 0x42, 0xab, 0xa7, 0x67, 0xf4, 0xdf, 0x0a, 0x28, 0xa4, 0x0f, 0x82, 0x5b, 0x24, 0xfd,
+	0x01, 0x28, 0xca, 0xc7, 0x0a, 0x89, 0xb5, 0xcc, 0x0d, 0xb3, 0x76, 0x0e, 0x85, 0x23, 0x90, 0x97,
+	0x73, 0xe8, 0xae, 0x66, 0x9f, 0xf4, 0x2f, 0xcb, 0x7b, 0xa1, 0x80, 0xad, 0x2b, 0x4f, 0xb4, 0xa4,
+	0x4c, 0xf9, 0xc6, 0x93, 0x65, 0x16, 0x45, 0x99, 0x02, 0x97, 0x94, 0x29, 0xcc, 0xa6, 0x0f, 0x9b,
+	0x00, 0x52, 0x32, 0x60, 0xf3, 0xc7, 0xa0, 0x73, 0x12, 0x51, 0xd1, 0xa5, 0xe6, 0xf6, 0xf4, 0xa2,
+	0xa2, 0x5a, 0x64, 0xc0, 0xe6, 0x8f, 0xbf, 0x9e, 0x85, 0x54, 0xba, 0xa0, 0x89, 0x92, 0x13, 0x02,
+	0x47, 0x6e, 0xec, 0x5f, 0xf1, 0x91, 0x9d, 0xfb, 0xf8, 0xc8, 0x8d, 0xfd, 0x45, 0x1f, 0xa3, 0x05,
+	0x4d, 0x94, 0xde, 0x77, 0x7f, 0xcc, 0x80, 0xcd, 0x85, 0x97, 0xcf, 0x6d, 0x4a, 0x29, 0x03, 0x80,
+	0x3f, 0x8b, 0x92, 0xae, 0x04, 0x24, 0x14, 0x2d, 0x43, 0x97, 0x34, 0xaf, 0x28, 0x35, 0xfb, 0x06,
+	0x4a, 0xcd, 0xdd, 0xa6, 0x54, 0xd8, 0x00, 0xeb, 0xec, 0x99, 0x1b, 0x39, 0xcf, 0x82, 0xd0, 0x27,
+	0xcf, 0xf8, 0xd6, 0xcd, 0x35, 0x4b, 0xd3, 0x8b, 0x0a, 0xb0, 0x8f, 0xf5, 0xde, 0x31, 0xd7, 0x22,
+	0x90, 0x40, 0xc4, 0xb7, 0xe4, 0xe6, 0x6b, 0x05, 0x6c, 0x8b, 0x7d, 0xca, 0x6b, 0x67, 0x93, 0x18,
+	0x1f, 0xc5, 0x3e, 0x8e, 0x17, 0x8e, 0x97, 0xf2, 0xca, 0xa9, 0x77, 0xcb, 0x4b, 0x66, 0x71, 0x62,
+	0x65, 0xaf, 0x4e, 0xac, 0x27, 0xa0, 0x20, 0xff, 0x48, 0xe2, 0xc5, 0x97, 0xf6, 0xdf, 0x59, 0xe6,
+	0xd8, 0x10, 0x10, 0x94, 0x62, 0x3f, 0xcc, 0x7d, 0xfe, 0x65, 0x65, 0xe5, 0xd1, 0xd7, 0x19, 0xa0,
+	0x5e, 0x7d, 0xfd, 0xc0, 0x77, 0xc1, 0x43, 0xbb, 0xdf, 0xed, 0x1a, 0x87, 0x4e, 0xcf, 0x40, 0x4f,
+	0x4d, 0xcb, 0x32, 0x8f, 0xba, 0x4e, 0xbf, 0x6b, 0xf5, 0x8c, 0x96, 0xd9, 0x31, 0x8d, 0xb6, 0xba,
+	0x02, 0x77, 0x41, 0x79, 0x09, 0xa4, 0xd7, 0xd6, 0x6d, 0xc3, 0x41, 0x47, 0x7d, 0xdb, 0x50, 0x15,
+	0xf8, 0x18, 0xec, 0xbd, 0x12, 0x63, 0x99, 0x07, 0x5d, 0xfd, 0xd0, 0x72, 0xf4, 0x6e, 0xdb, 0x31,
+	0xbb, 0xb6, 0x81, 0x3e, 0xd6, 0x0f, 0xd5, 0x0c, 0x2c, 0x83, 0x9d, 0xeb, 0x4b, 0xf4, 0x96, 0x6d,
+	0x7e, 0xac, 0xdb, 0x86, 0x9a, 0x85, 0x55, 0xf0, 0xfd, 0xeb, 0xf6, 0xb6, 0x31, 0x43, 0xe4, 0xe0,
+	0x43, 0xf0, 0xe0, 0x3a, 0xc2, 0x46, 0xe6, 0xc1, 0x81, 0x81, 0xd4, 0x55, 0x58, 0x07, 0x8f, 0xae,
+	0x9b, 0x8f, 0x4d, 0xfb, 0xa3, 0x36, 0xd2, 0x8f, 0x9d, 0x8e, 0x61, 0x38, 0x3d, 0xfd, 0x13, 0x03,
+	0x39, 0x9d, 0x7e, 0xb7, 0x6d, 0xa9, 0xf9, 0xe5, 0x75, 0x22, 0xc3, 0x46, 0x9f, 0x38, 0x3d, 0xbd,
+	0xf5, 0x2b, 0xc3, 0x56, 0x0b, 0x3b, 0xb9, 0xcf, 0xff, 0x5c, 0x5e, 0x79, 0x94, 0x4c, 0xe5, 0xcb,
+	0xe3, 0x3a, 0xc9, 0x44, 0x40, 0x1c, 0xcb, 0xd6, 0xed, 0xbe, 0x75, 0x85, 0xc1, 0x07, 0xe0, 0xed,
+	0x45, 0x73, 0xcf, 0xe8, 0xb6, 0xcd, 0xee, 0x81, 0xaa, 0x5c, 0x37, 0x59, 0xfd, 0x56, 0xcb, 0xb0,
+	0x2c, 0x35, 0x03, 0xbf, 0x07, 0xde, 0x5a, 0x34, 0x19, 0x08, 0x1d, 0x21, 0x35, 0x7b, 0x7d, 0x8d,
+	0x6d, 0x3e, 0x35, 0x8e, 0xfa, 0xb6, 0x9a, 0x83, 0x1a, 0xd8, 0x5e, 0x34, 0x75, 0x74, 0xf3, 0xd0,
+	0x68, 0xab, 0xab, 0x22, 0xf3, 0xe6, 0xd3, 0xaf, 0xa6, 0x65, 0xe5, 0x9b, 0x69, 0x59, 0xf9, 0x76,
+	0x5a, 0x56, 0xfe, 0x3b, 0x2d, 0x2b, 0x5f, 0xbc, 0x2c, 0xaf, 0x7c, 0xfb, 0xb2, 0xbc, 0xf2, 0xaf,
+	0x97, 0xe5, 0x95, 0xdf, 0x34, 0x2e, 0x4d, 0xc4, 0x64, 0x5f, 0xf1, 0x9b, 0xd6, 0x23, 0xe3, 0x86,
+	0x37, 0x72, 0x83, 0xb0, 0x71, 0xf6, 0x7e, 0xe3, 0xb3, 0xf4, 0x1f, 0x0d, 0x7c, 0x3c, 0x9e, 0xe4,
+	0x39, 0xe2, 0xfd, 0xef, 0x06, 0x00, 0x88, 0x85, 0x84, 0x20, 0x84, 0x10, 0x00, 0x00,
 }
 
 func (this *Tunnel) Equal(that interface{}) bool {
@@ -653,6 +1184,117 @@ func (this *Tunnel) Equal(that interface{}) bool {
 	if this.Creator != that1.Creator {
 		return false
 	}
+	if this.Schedule != that1.Schedule {
+		return false
+	}
+	if len(this.DerivedSignals) != len(that1.DerivedSignals) {
+		return false
+	}
+	for i := range this.DerivedSignals {
+		if !this.DerivedSignals[i].Equal(&that1.DerivedSignals[i]) {
+			return false
+		}
+	}
+	if len(this.Admins) != len(that1.Admins) {
+		return false
+	}
+	for i := range this.Admins {
+		if !this.Admins[i].Equal(&that1.Admins[i]) {
+			return false
+		}
+	}
+	if !this.FundingPolicy.Equal(that1.FundingPolicy) {
+		return false
+	}
+	if this.IncludePriceConfidence != that1.IncludePriceConfidence {
+		return false
+	}
+	if this.PendingCreator != that1.PendingCreator {
+		return false
+	}
+	if this.FundingSource != that1.FundingSource {
+		return false
+	}
+	return true
+}
+func (this *TunnelAdmin) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TunnelAdmin)
+	if !ok {
+		that2, ok := that.(TunnelAdmin)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Permissions) != len(that1.Permissions) {
+		return false
+	}
+	for i := range this.Permissions {
+		if this.Permissions[i] != that1.Permissions[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *FundingPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FundingPolicy)
+	if !ok {
+		that2, ok := that.(FundingPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if len(this.Allowance) != len(that1.Allowance) {
+		return false
+	}
+	for i := range this.Allowance {
+		if !this.Allowance[i].Equal(&that1.Allowance[i]) {
+			return false
+		}
+	}
+	if len(this.Threshold) != len(that1.Threshold) {
+		return false
+	}
+	for i := range this.Threshold {
+		if !this.Threshold[i].Equal(&that1.Threshold[i]) {
+			return false
+		}
+	}
+	if len(this.TopUpAmount) != len(that1.TopUpAmount) {
+		return false
+	}
+	for i := range this.TopUpAmount {
+		if !this.TopUpAmount[i].Equal(&that1.TopUpAmount[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *LatestPrices) Equal(that interface{}) bool {
@@ -688,6 +1330,9 @@ func (this *LatestPrices) Equal(that interface{}) bool {
 	if this.LastInterval != that1.LastInterval {
 		return false
 	}
+	if this.LastSchedule != that1.LastSchedule {
+		return false
+	}
 	return true
 }
 func (this *TotalFees) Equal(that interface{}) bool {
@@ -758,6 +1403,53 @@ func (this *Packet) Equal(that interface{}) bool {
 	if this.CreatedAt != that1.CreatedAt {
 		return false
 	}
+	if len(this.BaseFee) != len(that1.BaseFee) {
+		return false
+	}
+	for i := range this.BaseFee {
+		if !this.BaseFee[i].Equal(&that1.BaseFee[i]) {
+			return false
+		}
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	return true
+}
+func (this *FailedPacket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FailedPacket)
+	if !ok {
+		that2, ok := that.(FailedPacket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TunnelID != that1.TunnelID {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if this.LastAttemptAt != that1.LastAttemptAt {
+		return false
+	}
 	return true
 }
 func (this *Deposit) Equal(that interface{}) bool {
@@ -825,6 +1517,42 @@ func (this *SignalDeviation) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DerivedSignal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DerivedSignal)
+	if !ok {
+		that2, ok := that.(DerivedSignal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignalID != that1.SignalID {
+		return false
+	}
+	if this.Expression != that1.Expression {
+		return false
+	}
+	if this.SoftDeviationBPS != that1.SoftDeviationBPS {
+		return false
+	}
+	if this.HardDeviationBPS != that1.HardDeviationBPS {
+		return false
+	}
+	if this.TWAPWindow != that1.TWAPWindow {
+		return false
+	}
+	return true
+}
 func (this *TunnelSignatureOrder) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -883,32 +1611,50 @@ func (m *Tunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Creator)))
+	if len(m.FundingSource) > 0 {
+		i -= len(m.FundingSource)
+		copy(dAtA[i:], m.FundingSource)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.FundingSource)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintTunnel(dAtA, i, uint64(m.CreatedAt))
+	if len(m.PendingCreator) > 0 {
+		i -= len(m.PendingCreator)
+		copy(dAtA[i:], m.PendingCreator)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.PendingCreator)))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.IsActive {
+	if m.IncludePriceConfidence {
 		i--
-		if m.IsActive {
+		if m.IncludePriceConfidence {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x78
 	}
-	if len(m.TotalDeposit) > 0 {
-		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+	if m.FundingPolicy != nil {
+		{
+			size, err := m.FundingPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTunnel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Admins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -916,18 +1662,13 @@ func (m *Tunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTunnel(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x6a
 		}
 	}
-	if m.Interval != 0 {
-		i = encodeVarintTunnel(dAtA, i, uint64(m.Interval))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.SignalDeviations) > 0 {
-		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.DerivedSignals) > 0 {
+		for iNdEx := len(m.DerivedSignals) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.DerivedSignals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -935,10 +1676,72 @@ func (m *Tunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTunnel(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x62
 		}
 	}
-	if len(m.FeePayer) > 0 {
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TotalDeposit) > 0 {
+		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SignalDeviations) > 0 {
+		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FeePayer) > 0 {
 		i -= len(m.FeePayer)
 		copy(dAtA[i:], m.FeePayer)
 		i = encodeVarintTunnel(dAtA, i, uint64(len(m.FeePayer)))
@@ -970,6 +1773,126 @@ func (m *Tunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TunnelAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TunnelAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TunnelAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA4 := make([]byte, len(m.Permissions)*10)
+		var j3 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTunnel(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopUpAmount) > 0 {
+		for iNdEx := len(m.TopUpAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopUpAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Threshold) > 0 {
+		for iNdEx := len(m.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Allowance) > 0 {
+		for iNdEx := len(m.Allowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LatestPrices) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -990,6 +1913,11 @@ func (m *LatestPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastSchedule != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.LastSchedule))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.LastInterval != 0 {
 		i = encodeVarintTunnel(dAtA, i, uint64(m.LastInterval))
 		i--
@@ -1074,6 +2002,25 @@ func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BaseFee) > 0 {
+		for iNdEx := len(m.BaseFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.CreatedAt != 0 {
 		i = encodeVarintTunnel(dAtA, i, uint64(m.CreatedAt))
 		i--
@@ -1118,6 +2065,56 @@ func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FailedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastAttemptAt != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.LastAttemptAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1207,6 +2204,58 @@ func (m *SignalDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DerivedSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedSignal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedSignal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TWAPWindow != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.TWAPWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HardDeviationBPS != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.HardDeviationBPS))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SoftDeviationBPS != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.SoftDeviationBPS))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Expression) > 0 {
+		i -= len(m.Expression)
+		copy(dAtA[i:], m.Expression)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Expression)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TunnelSignatureOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1315,26 +2364,111 @@ func (m *Tunnel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTunnel(uint64(l))
 	}
-	return n
-}
-
-func (m *LatestPrices) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTunnel(uint64(m.TunnelID))
+	if len(m.DerivedSignals) > 0 {
+		for _, e := range m.DerivedSignals {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
 	}
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
+	if len(m.Admins) > 0 {
+		for _, e := range m.Admins {
 			l = e.Size()
 			n += 1 + l + sovTunnel(uint64(l))
 		}
 	}
-	if m.LastInterval != 0 {
-		n += 1 + sovTunnel(uint64(m.LastInterval))
+	if m.FundingPolicy != nil {
+		l = m.FundingPolicy.Size()
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if m.IncludePriceConfidence {
+		n += 2
+	}
+	l = len(m.PendingCreator)
+	if l > 0 {
+		n += 2 + l + sovTunnel(uint64(l))
+	}
+	l = len(m.FundingSource)
+	if l > 0 {
+		n += 2 + l + sovTunnel(uint64(l))
+	}
+	return n
+}
+
+func (m *TunnelAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovTunnel(uint64(e))
+		}
+		n += 1 + sovTunnel(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *FundingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if len(m.Allowance) > 0 {
+		for _, e := range m.Allowance {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	if len(m.Threshold) > 0 {
+		for _, e := range m.Threshold {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	if len(m.TopUpAmount) > 0 {
+		for _, e := range m.TopUpAmount {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LatestPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTunnel(uint64(m.TunnelID))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	if m.LastInterval != 0 {
+		n += 1 + sovTunnel(uint64(m.LastInterval))
+	}
+	if m.LastSchedule != 0 {
+		n += 1 + sovTunnel(uint64(m.LastSchedule))
 	}
 	return n
 }
@@ -1379,6 +2513,40 @@ func (m *Packet) Size() (n int) {
 	if m.CreatedAt != 0 {
 		n += 1 + sovTunnel(uint64(m.CreatedAt))
 	}
+	if len(m.BaseFee) > 0 {
+		for _, e := range m.BaseFee {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovTunnel(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *FailedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTunnel(uint64(m.TunnelID))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTunnel(uint64(m.Sequence))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTunnel(uint64(m.Attempts))
+	}
+	if m.LastAttemptAt != 0 {
+		n += 1 + sovTunnel(uint64(m.LastAttemptAt))
+	}
 	return n
 }
 
@@ -1423,6 +2591,32 @@ func (m *SignalDeviation) Size() (n int) {
 	return n
 }
 
+func (m *DerivedSignal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	l = len(m.Expression)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if m.SoftDeviationBPS != 0 {
+		n += 1 + sovTunnel(uint64(m.SoftDeviationBPS))
+	}
+	if m.HardDeviationBPS != 0 {
+		n += 1 + sovTunnel(uint64(m.HardDeviationBPS))
+	}
+	if m.TWAPWindow != 0 {
+		n += 1 + sovTunnel(uint64(m.TWAPWindow))
+	}
+	return n
+}
+
 func (m *TunnelSignatureOrder) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1746,61 +2940,43 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTunnel(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTunnel
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LatestPrices) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTunnel
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LatestPrices: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestPrices: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedSignals", wireType)
 			}
-			m.TunnelID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1810,14 +2986,29 @@ func (m *LatestPrices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TunnelID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedSignals = append(m.DerivedSignals, DerivedSignal{})
+			if err := m.DerivedSignals[len(m.DerivedSignals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1844,16 +3035,16 @@ func (m *LatestPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, types2.Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Admins = append(m.Admins, TunnelAdmin{})
+			if err := m.Admins[len(m.Admins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInterval", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPolicy", wireType)
 			}
-			m.LastInterval = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1863,64 +3054,852 @@ func (m *LatestPrices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastInterval |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTunnel(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalFees) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTunnel
+			if m.FundingPolicy == nil {
+				m.FundingPolicy = &FundingPolicy{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.FundingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePriceConfidence", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePriceConfidence = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCreator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCreator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TunnelAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TunnelAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TunnelAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v TunnelPermission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTunnel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TunnelPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTunnel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTunnel
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTunnel
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]TunnelPermission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TunnelPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTunnel
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TunnelPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowance = append(m.Allowance, types1.Coin{})
+			if err := m.Allowance[len(m.Allowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = append(m.Threshold, types1.Coin{})
+			if err := m.Threshold[len(m.Threshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpAmount = append(m.TopUpAmount, types1.Coin{})
+			if err := m.TopUpAmount[len(m.TopUpAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types2.Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInterval", wireType)
+			}
+			m.LastInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSchedule", wireType)
+			}
+			m.LastSchedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSchedule |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBasePacketFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBasePacketFee = append(m.TotalBasePacketFee, types1.Coin{})
+			if err := m.TotalBasePacketFee[len(m.TotalBasePacketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Packet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Packet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types2.Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipt == nil {
+				m.Receipt = &types.Any{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalFees: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalFees: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBasePacketFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1947,11 +3926,30 @@ func (m *TotalFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalBasePacketFee = append(m.TotalBasePacketFee, types1.Coin{})
-			if err := m.TotalBasePacketFee[len(m.TotalBasePacketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BaseFee = append(m.BaseFee, types1.Coin{})
+			if err := m.BaseFee[len(m.BaseFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTunnel(dAtA[iNdEx:])
@@ -1973,7 +3971,7 @@ func (m *TotalFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Packet) Unmarshal(dAtA []byte) error {
+func (m *FailedPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1996,10 +3994,10 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Packet: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailedPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FailedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2042,9 +4040,9 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2054,31 +4052,29 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTunnel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, types2.Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var msglen int
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2088,33 +4084,16 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Attempts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Receipt == nil {
-				m.Receipt = &types.Any{}
-			}
-			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptAt", wireType)
 			}
-			m.CreatedAt = 0
+			m.LastAttemptAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2124,7 +4103,7 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				m.LastAttemptAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2405,6 +4384,177 @@ func (m *SignalDeviation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DerivedSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftDeviationBPS", wireType)
+			}
+			m.SoftDeviationBPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SoftDeviationBPS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardDeviationBPS", wireType)
+			}
+			m.HardDeviationBPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HardDeviationBPS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TWAPWindow", wireType)
+			}
+			m.TWAPWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TWAPWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TunnelSignatureOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
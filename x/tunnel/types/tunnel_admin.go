@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxTunnelAdmins is the maximum number of co-administrators of a tunnel.
+const MaxTunnelAdmins = 10
+
+// NewTunnelAdmin creates a new TunnelAdmin instance.
+func NewTunnelAdmin(address string, permissions []TunnelPermission) TunnelAdmin {
+	return TunnelAdmin{
+		Address:     address,
+		Permissions: permissions,
+	}
+}
+
+// HasPermission returns true if the co-administrator has the given permission.
+func (a TunnelAdmin) HasPermission(permission TunnelPermission) bool {
+	for _, p := range a.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate validates the address and the permissions of the co-administrator.
+func (a TunnelAdmin) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if len(a.Permissions) == 0 {
+		return ErrInvalidTunnelAdmin.Wrapf("admin %s must have at least one permission", a.Address)
+	}
+
+	seen := make(map[TunnelPermission]bool)
+	for _, p := range a.Permissions {
+		if _, ok := TunnelPermission_name[int32(p)]; !ok || p == TUNNEL_PERMISSION_UNSPECIFIED {
+			return ErrInvalidTunnelAdmin.Wrapf("invalid permission %d of admin %s", p, a.Address)
+		}
+		if seen[p] {
+			return ErrInvalidTunnelAdmin.Wrapf("duplicate permission %s of admin %s", p, a.Address)
+		}
+		seen[p] = true
+	}
+
+	return nil
+}
+
+// ValidateTunnelAdmins validates the co-administrators of a tunnel with the given creator.
+func ValidateTunnelAdmins(admins []TunnelAdmin, creator string) error {
+	if len(admins) > MaxTunnelAdmins {
+		return ErrInvalidTunnelAdmin.Wrapf("max admins %d, got %d", MaxTunnelAdmins, len(admins))
+	}
+
+	seen := make(map[string]bool)
+	for _, admin := range admins {
+		if err := admin.Validate(); err != nil {
+			return err
+		}
+		if admin.Address == creator {
+			return ErrInvalidTunnelAdmin.Wrapf("creator %s cannot be an admin", creator)
+		}
+		if seen[admin.Address] {
+			return ErrInvalidTunnelAdmin.Wrapf("duplicate admin %s", admin.Address)
+		}
+		seen[admin.Address] = true
+	}
+
+	return nil
+}
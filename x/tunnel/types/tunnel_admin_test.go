@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestTunnelAdmin_Validate(t *testing.T) {
+	address := sdk.AccAddress("admin").String()
+
+	tests := []struct {
+		name   string
+		admin  types.TunnelAdmin
+		expErr bool
+	}{
+		{
+			name:   "valid admin",
+			admin:  types.NewTunnelAdmin(address, []types.TunnelPermission{types.TUNNEL_PERMISSION_TRIGGER}),
+			expErr: false,
+		},
+		{
+			name:   "invalid address",
+			admin:  types.NewTunnelAdmin("invalid", []types.TunnelPermission{types.TUNNEL_PERMISSION_TRIGGER}),
+			expErr: true,
+		},
+		{
+			name:   "no permission",
+			admin:  types.NewTunnelAdmin(address, nil),
+			expErr: true,
+		},
+		{
+			name:   "unspecified permission",
+			admin:  types.NewTunnelAdmin(address, []types.TunnelPermission{types.TUNNEL_PERMISSION_UNSPECIFIED}),
+			expErr: true,
+		},
+		{
+			name:   "unknown permission",
+			admin:  types.NewTunnelAdmin(address, []types.TunnelPermission{types.TunnelPermission(100)}),
+			expErr: true,
+		},
+		{
+			name: "duplicate permission",
+			admin: types.NewTunnelAdmin(
+				address,
+				[]types.TunnelPermission{types.TUNNEL_PERMISSION_TRIGGER, types.TUNNEL_PERMISSION_TRIGGER},
+			),
+			expErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.admin.Validate()
+			if tt.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTunnelAdmins(t *testing.T) {
+	creator := sdk.AccAddress("creator").String()
+	admin := types.NewTunnelAdmin(
+		sdk.AccAddress("admin").String(),
+		[]types.TunnelPermission{types.TUNNEL_PERMISSION_TRIGGER},
+	)
+
+	require.NoError(t, types.ValidateTunnelAdmins([]types.TunnelAdmin{admin}, creator))
+
+	// duplicate admins
+	require.Error(t, types.ValidateTunnelAdmins([]types.TunnelAdmin{admin, admin}, creator))
+
+	// creator as an admin
+	require.Error(t, types.ValidateTunnelAdmins([]types.TunnelAdmin{admin}, admin.Address))
+
+	// too many admins
+	var admins []types.TunnelAdmin
+	for i := 0; i <= types.MaxTunnelAdmins; i++ {
+		admins = append(admins, types.NewTunnelAdmin(
+			sdk.AccAddress([]byte{byte(i)}).String(),
+			[]types.TunnelPermission{types.TUNNEL_PERMISSION_TRIGGER},
+		))
+	}
+	require.Error(t, types.ValidateTunnelAdmins(admins, creator))
+}
+
+func TestTunnel_IsAuthorized(t *testing.T) {
+	creator := sdk.AccAddress("creator").String()
+	admin := sdk.AccAddress("admin").String()
+	tunnel := types.Tunnel{
+		Creator: creator,
+		Admins: []types.TunnelAdmin{
+			types.NewTunnelAdmin(admin, []types.TunnelPermission{types.TUNNEL_PERMISSION_TRIGGER}),
+		},
+	}
+
+	require.True(t, tunnel.IsAuthorized(creator, types.TUNNEL_PERMISSION_DEACTIVATE))
+	require.True(t, tunnel.IsAuthorized(admin, types.TUNNEL_PERMISSION_TRIGGER))
+	require.False(t, tunnel.IsAuthorized(admin, types.TUNNEL_PERMISSION_DEACTIVATE))
+	require.False(t, tunnel.IsAuthorized(sdk.AccAddress("other").String(), types.TUNNEL_PERMISSION_TRIGGER))
+
+	require.True(t, tunnel.IsAdmin(creator))
+	require.True(t, tunnel.IsAdmin(admin))
+	require.False(t, tunnel.IsAdmin(sdk.AccAddress("other").String()))
+}
@@ -84,3 +84,20 @@ func TestValidateInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestEstimatePacketsPerDay(t *testing.T) {
+	require.Equal(t, uint64(1440), types.EstimatePacketsPerDay(60))
+	require.Equal(t, uint64(24), types.EstimatePacketsPerDay(3600))
+	require.Equal(t, uint64(3), types.EstimatePacketsPerDay(40000))
+	require.Equal(t, uint64(0), types.EstimatePacketsPerDay(0))
+}
+
+func TestScalePacketsPerDay(t *testing.T) {
+	require.Equal(t, uint64(12), types.ScalePacketsPerDay(1, 7200, 24))
+	require.Equal(t, uint64(2), types.ScalePacketsPerDay(1, 50000, 24))
+	require.Equal(t, uint64(0), types.ScalePacketsPerDay(0, 7200, 24))
+	require.Equal(t, uint64(0), types.ScalePacketsPerDay(1, 0, 24))
+
+	// a short history is capped
+	require.Equal(t, uint64(24), types.ScalePacketsPerDay(2, 60, 24))
+}
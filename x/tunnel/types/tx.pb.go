@@ -46,6 +46,12 @@ type MsgCreateTunnel struct {
 	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit"`
 	// creator is the address of the creator.
 	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schedule is the cron-like expression for delivering the signal prices. It is disabled if empty.
+	Schedule string `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// derived_signals is the list of signals whose prices are derived from the feed prices.
+	DerivedSignals []DerivedSignal `protobuf:"bytes,7,rep,name=derived_signals,json=derivedSignals,proto3" json:"derived_signals"`
+	// include_price_confidence is the flag to include the confidence metadata of the feed prices in the packets.
+	IncludePriceConfidence bool `protobuf:"varint,8,opt,name=include_price_confidence,json=includePriceConfidence,proto3" json:"include_price_confidence,omitempty"`
 }
 
 func (m *MsgCreateTunnel) Reset()         { *m = MsgCreateTunnel{} }
@@ -116,6 +122,27 @@ func (m *MsgCreateTunnel) GetCreator() string {
 	return ""
 }
 
+func (m *MsgCreateTunnel) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *MsgCreateTunnel) GetDerivedSignals() []DerivedSignal {
+	if m != nil {
+		return m.DerivedSignals
+	}
+	return nil
+}
+
+func (m *MsgCreateTunnel) GetIncludePriceConfidence() bool {
+	if m != nil {
+		return m.IncludePriceConfidence
+	}
+	return false
+}
+
 // MsgCreateTunnelResponse is the response type for the Msg/CreateTunnel RPC method.
 type MsgCreateTunnelResponse struct {
 	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
@@ -272,6 +299,12 @@ type MsgUpdateSignalsAndInterval struct {
 	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
 	// creator is the address of the creator.
 	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schedule is the cron-like expression for delivering the signal prices. It is disabled if empty.
+	Schedule string `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// derived_signals is the list of signals whose prices are derived from the feed prices.
+	DerivedSignals []DerivedSignal `protobuf:"bytes,6,rep,name=derived_signals,json=derivedSignals,proto3" json:"derived_signals"`
+	// include_price_confidence is the flag to include the confidence metadata of the feed prices in the packets.
+	IncludePriceConfidence bool `protobuf:"varint,7,opt,name=include_price_confidence,json=includePriceConfidence,proto3" json:"include_price_confidence,omitempty"`
 }
 
 func (m *MsgUpdateSignalsAndInterval) Reset()         { *m = MsgUpdateSignalsAndInterval{} }
@@ -335,6 +368,27 @@ func (m *MsgUpdateSignalsAndInterval) GetCreator() string {
 	return ""
 }
 
+func (m *MsgUpdateSignalsAndInterval) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *MsgUpdateSignalsAndInterval) GetDerivedSignals() []DerivedSignal {
+	if m != nil {
+		return m.DerivedSignals
+	}
+	return nil
+}
+
+func (m *MsgUpdateSignalsAndInterval) GetIncludePriceConfidence() bool {
+	if m != nil {
+		return m.IncludePriceConfidence
+	}
+	return false
+}
+
 // MsgUpdateSignalsAndIntervalResponse is the response type for the Msg/UpdateSignalsAndInterval RPC method.
 type MsgUpdateSignalsAndIntervalResponse struct {
 }
@@ -749,28 +803,28 @@ func (m *MsgTriggerTunnelResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTriggerTunnelResponse proto.InternalMessageInfo
 
-// MsgDepositToTunnel defines a message to deposit to an existing tunnel.
-type MsgDepositToTunnel struct {
-	// tunnel_id defines the unique id of the tunnel.
+// MsgRetryPacket is the transaction message to resend a failed packet of the tunnel.
+type MsgRetryPacket struct {
+	// tunnel_id is the ID of the tunnel of the failed packet.
 	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// amount to be deposited by depositor.
-	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	// depositor defines the deposit addresses from the tunnel.
-	Depositor string `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// sequence is the sequence of the failed packet.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// sender is the address of the tunnel creator or the tunnel fee payer.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgDepositToTunnel) Reset()         { *m = MsgDepositToTunnel{} }
-func (m *MsgDepositToTunnel) String() string { return proto.CompactTextString(m) }
-func (*MsgDepositToTunnel) ProtoMessage()    {}
-func (*MsgDepositToTunnel) Descriptor() ([]byte, []int) {
+func (m *MsgRetryPacket) Reset()         { *m = MsgRetryPacket{} }
+func (m *MsgRetryPacket) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryPacket) ProtoMessage()    {}
+func (*MsgRetryPacket) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d18351d83b4705d0, []int{14}
 }
-func (m *MsgDepositToTunnel) XXX_Unmarshal(b []byte) error {
+func (m *MsgRetryPacket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDepositToTunnel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRetryPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDepositToTunnel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRetryPacket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -780,55 +834,59 @@ func (m *MsgDepositToTunnel) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgDepositToTunnel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDepositToTunnel.Merge(m, src)
+func (m *MsgRetryPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryPacket.Merge(m, src)
 }
-func (m *MsgDepositToTunnel) XXX_Size() int {
+func (m *MsgRetryPacket) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDepositToTunnel) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDepositToTunnel.DiscardUnknown(m)
+func (m *MsgRetryPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryPacket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDepositToTunnel proto.InternalMessageInfo
+var xxx_messageInfo_MsgRetryPacket proto.InternalMessageInfo
 
-func (m *MsgDepositToTunnel) GetTunnelID() uint64 {
+func (m *MsgRetryPacket) GetTunnelID() uint64 {
 	if m != nil {
 		return m.TunnelID
 	}
 	return 0
 }
 
-func (m *MsgDepositToTunnel) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *MsgRetryPacket) GetSequence() uint64 {
 	if m != nil {
-		return m.Amount
+		return m.Sequence
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgDepositToTunnel) GetDepositor() string {
+func (m *MsgRetryPacket) GetSender() string {
 	if m != nil {
-		return m.Depositor
+		return m.Sender
 	}
 	return ""
 }
 
-// MsgDepositToTunnelResponse defines the Msg/DepositToTunnel response type.
-type MsgDepositToTunnelResponse struct {
+// MsgRetryPacketResponse is the response type for the Msg/RetryPacket RPC method.
+type MsgRetryPacketResponse struct {
+	// success is true if the packet is sent by the retry.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// reason is the route error if the packet fails to be sent again.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgDepositToTunnelResponse) Reset()         { *m = MsgDepositToTunnelResponse{} }
-func (m *MsgDepositToTunnelResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDepositToTunnelResponse) ProtoMessage()    {}
-func (*MsgDepositToTunnelResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRetryPacketResponse) Reset()         { *m = MsgRetryPacketResponse{} }
+func (m *MsgRetryPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryPacketResponse) ProtoMessage()    {}
+func (*MsgRetryPacketResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d18351d83b4705d0, []int{15}
 }
-func (m *MsgDepositToTunnelResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRetryPacketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDepositToTunnelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRetryPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDepositToTunnelResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRetryPacketResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -838,40 +896,55 @@ func (m *MsgDepositToTunnelResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgDepositToTunnelResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDepositToTunnelResponse.Merge(m, src)
+func (m *MsgRetryPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryPacketResponse.Merge(m, src)
 }
-func (m *MsgDepositToTunnelResponse) XXX_Size() int {
+func (m *MsgRetryPacketResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDepositToTunnelResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDepositToTunnelResponse.DiscardUnknown(m)
+func (m *MsgRetryPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryPacketResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDepositToTunnelResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRetryPacketResponse proto.InternalMessageInfo
 
-// MsgWithdrawFromTunnel is the transaction message to withdraw a deposit from an existing tunnel.
-type MsgWithdrawFromTunnel struct {
-	// tunnel_id defines the unique id of the tunnel.
+func (m *MsgRetryPacketResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *MsgRetryPacketResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgTransferTunnelOwnership is the transaction message to propose a new creator of the tunnel.
+// The ownership is transferred only after the new creator accepts it with MsgAcceptTunnelOwnership.
+type MsgTransferTunnelOwnership struct {
+	// tunnel_id is the ID of the tunnel to transfer.
 	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// amount to be withdrawn by withdrawer.
-	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	// withdrawer defines the withdraw addresses from the tunnel.
-	Withdrawer string `protobuf:"bytes,3,opt,name=withdrawer,proto3" json:"withdrawer,omitempty"`
+	// new_creator is the address of the proposed new creator of the tunnel.
+	NewCreator string `protobuf:"bytes,2,opt,name=new_creator,json=newCreator,proto3" json:"new_creator,omitempty"`
+	// creator is the address of the current creator.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (m *MsgWithdrawFromTunnel) Reset()         { *m = MsgWithdrawFromTunnel{} }
-func (m *MsgWithdrawFromTunnel) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawFromTunnel) ProtoMessage()    {}
-func (*MsgWithdrawFromTunnel) Descriptor() ([]byte, []int) {
+func (m *MsgTransferTunnelOwnership) Reset()         { *m = MsgTransferTunnelOwnership{} }
+func (m *MsgTransferTunnelOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferTunnelOwnership) ProtoMessage()    {}
+func (*MsgTransferTunnelOwnership) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d18351d83b4705d0, []int{16}
 }
-func (m *MsgWithdrawFromTunnel) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferTunnelOwnership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawFromTunnel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferTunnelOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawFromTunnel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferTunnelOwnership.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -881,55 +954,55 @@ func (m *MsgWithdrawFromTunnel) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawFromTunnel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawFromTunnel.Merge(m, src)
+func (m *MsgTransferTunnelOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferTunnelOwnership.Merge(m, src)
 }
-func (m *MsgWithdrawFromTunnel) XXX_Size() int {
+func (m *MsgTransferTunnelOwnership) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawFromTunnel) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawFromTunnel.DiscardUnknown(m)
+func (m *MsgTransferTunnelOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferTunnelOwnership.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawFromTunnel proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferTunnelOwnership proto.InternalMessageInfo
 
-func (m *MsgWithdrawFromTunnel) GetTunnelID() uint64 {
+func (m *MsgTransferTunnelOwnership) GetTunnelID() uint64 {
 	if m != nil {
 		return m.TunnelID
 	}
 	return 0
 }
 
-func (m *MsgWithdrawFromTunnel) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *MsgTransferTunnelOwnership) GetNewCreator() string {
 	if m != nil {
-		return m.Amount
+		return m.NewCreator
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgWithdrawFromTunnel) GetWithdrawer() string {
+func (m *MsgTransferTunnelOwnership) GetCreator() string {
 	if m != nil {
-		return m.Withdrawer
+		return m.Creator
 	}
 	return ""
 }
 
-// MsgWithdrawFromTunnelResponse defines the Msg/WithdrawFromTunnel response type.
-type MsgWithdrawFromTunnelResponse struct {
+// MsgTransferTunnelOwnershipResponse is the response type for the Msg/TransferTunnelOwnership RPC method.
+type MsgTransferTunnelOwnershipResponse struct {
 }
 
-func (m *MsgWithdrawFromTunnelResponse) Reset()         { *m = MsgWithdrawFromTunnelResponse{} }
-func (m *MsgWithdrawFromTunnelResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawFromTunnelResponse) ProtoMessage()    {}
-func (*MsgWithdrawFromTunnelResponse) Descriptor() ([]byte, []int) {
+func (m *MsgTransferTunnelOwnershipResponse) Reset()         { *m = MsgTransferTunnelOwnershipResponse{} }
+func (m *MsgTransferTunnelOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferTunnelOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferTunnelOwnershipResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d18351d83b4705d0, []int{17}
 }
-func (m *MsgWithdrawFromTunnelResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferTunnelOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawFromTunnelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferTunnelOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawFromTunnelResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferTunnelOwnershipResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -939,38 +1012,38 @@ func (m *MsgWithdrawFromTunnelResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawFromTunnelResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawFromTunnelResponse.Merge(m, src)
+func (m *MsgTransferTunnelOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferTunnelOwnershipResponse.Merge(m, src)
 }
-func (m *MsgWithdrawFromTunnelResponse) XXX_Size() int {
+func (m *MsgTransferTunnelOwnershipResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawFromTunnelResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawFromTunnelResponse.DiscardUnknown(m)
+func (m *MsgTransferTunnelOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferTunnelOwnershipResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawFromTunnelResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferTunnelOwnershipResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the transaction message to update parameters.
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params is the x/tunnel parameters to update.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgAcceptTunnelOwnership is the transaction message for the proposed creator to accept the ownership of the tunnel.
+type MsgAcceptTunnelOwnership struct {
+	// tunnel_id is the ID of the tunnel to accept.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// new_creator is the address of the proposed new creator of the tunnel.
+	NewCreator string `protobuf:"bytes,2,opt,name=new_creator,json=newCreator,proto3" json:"new_creator,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptTunnelOwnership) Reset()         { *m = MsgAcceptTunnelOwnership{} }
+func (m *MsgAcceptTunnelOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptTunnelOwnership) ProtoMessage()    {}
+func (*MsgAcceptTunnelOwnership) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d18351d83b4705d0, []int{18}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptTunnelOwnership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptTunnelOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptTunnelOwnership.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -980,48 +1053,48 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgAcceptTunnelOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptTunnelOwnership.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgAcceptTunnelOwnership) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgAcceptTunnelOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptTunnelOwnership.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptTunnelOwnership proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgAcceptTunnelOwnership) GetTunnelID() uint64 {
 	if m != nil {
-		return m.Authority
+		return m.TunnelID
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgAcceptTunnelOwnership) GetNewCreator() string {
 	if m != nil {
-		return m.Params
+		return m.NewCreator
 	}
-	return Params{}
+	return ""
 }
 
-// MsgUpdateParamsResponse is the response type for the Msg/UpdateParams RPC method.
-type MsgUpdateParamsResponse struct {
+// MsgAcceptTunnelOwnershipResponse is the response type for the Msg/AcceptTunnelOwnership RPC method.
+type MsgAcceptTunnelOwnershipResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptTunnelOwnershipResponse) Reset()         { *m = MsgAcceptTunnelOwnershipResponse{} }
+func (m *MsgAcceptTunnelOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptTunnelOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptTunnelOwnershipResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d18351d83b4705d0, []int{19}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptTunnelOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptTunnelOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptTunnelOwnershipResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1031,1561 +1104,4665 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgAcceptTunnelOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptTunnelOwnershipResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgAcceptTunnelOwnershipResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgAcceptTunnelOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptTunnelOwnershipResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptTunnelOwnershipResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateTunnel)(nil), "band.tunnel.v1beta1.MsgCreateTunnel")
-	proto.RegisterType((*MsgCreateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgCreateTunnelResponse")
-	proto.RegisterType((*MsgUpdateRoute)(nil), "band.tunnel.v1beta1.MsgUpdateRoute")
-	proto.RegisterType((*MsgUpdateRouteResponse)(nil), "band.tunnel.v1beta1.MsgUpdateRouteResponse")
-	proto.RegisterType((*MsgUpdateSignalsAndInterval)(nil), "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval")
-	proto.RegisterType((*MsgUpdateSignalsAndIntervalResponse)(nil), "band.tunnel.v1beta1.MsgUpdateSignalsAndIntervalResponse")
-	proto.RegisterType((*MsgWithdrawFeePayerFunds)(nil), "band.tunnel.v1beta1.MsgWithdrawFeePayerFunds")
-	proto.RegisterType((*MsgWithdrawFeePayerFundsResponse)(nil), "band.tunnel.v1beta1.MsgWithdrawFeePayerFundsResponse")
-	proto.RegisterType((*MsgActivateTunnel)(nil), "band.tunnel.v1beta1.MsgActivateTunnel")
-	proto.RegisterType((*MsgActivateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgActivateTunnelResponse")
-	proto.RegisterType((*MsgDeactivateTunnel)(nil), "band.tunnel.v1beta1.MsgDeactivateTunnel")
-	proto.RegisterType((*MsgDeactivateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgDeactivateTunnelResponse")
-	proto.RegisterType((*MsgTriggerTunnel)(nil), "band.tunnel.v1beta1.MsgTriggerTunnel")
-	proto.RegisterType((*MsgTriggerTunnelResponse)(nil), "band.tunnel.v1beta1.MsgTriggerTunnelResponse")
-	proto.RegisterType((*MsgDepositToTunnel)(nil), "band.tunnel.v1beta1.MsgDepositToTunnel")
-	proto.RegisterType((*MsgDepositToTunnelResponse)(nil), "band.tunnel.v1beta1.MsgDepositToTunnelResponse")
-	proto.RegisterType((*MsgWithdrawFromTunnel)(nil), "band.tunnel.v1beta1.MsgWithdrawFromTunnel")
-	proto.RegisterType((*MsgWithdrawFromTunnelResponse)(nil), "band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "band.tunnel.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.tunnel.v1beta1.MsgUpdateParamsResponse")
+// MsgUpdateTunnelAdmins is the transaction message to update the co-administrators of the tunnel.
+type MsgUpdateTunnelAdmins struct {
+	// tunnel_id is the ID of the tunnel to edit.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// admins is the new list of co-administrators of the tunnel; it replaces the existing list.
+	Admins []TunnelAdmin `protobuf:"bytes,2,rep,name=admins,proto3" json:"admins"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func init() { proto.RegisterFile("band/tunnel/v1beta1/tx.proto", fileDescriptor_d18351d83b4705d0) }
-
-var fileDescriptor_d18351d83b4705d0 = []byte{
-	// 1117 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xbd, 0x6f, 0xdb, 0x46,
-	0x14, 0x37, 0x25, 0xdb, 0xb5, 0x9f, 0x5d, 0x7f, 0x30, 0x76, 0x4c, 0xd1, 0x8e, 0x2c, 0x30, 0x5f,
-	0x8a, 0x13, 0x93, 0xfe, 0x40, 0x82, 0x34, 0x9b, 0x15, 0x23, 0x80, 0x07, 0x01, 0x81, 0xe2, 0x22,
-	0x40, 0x17, 0x83, 0x12, 0xaf, 0x14, 0x11, 0x8b, 0x27, 0xf0, 0x4e, 0x4a, 0x34, 0x14, 0x28, 0x3a,
-	0x74, 0xe8, 0xd2, 0x2e, 0x2d, 0x0a, 0x14, 0x1d, 0xba, 0x14, 0x45, 0x97, 0x7a, 0xc8, 0x1f, 0x11,
-	0x74, 0x69, 0xd0, 0xa9, 0x53, 0xda, 0xda, 0x83, 0xff, 0x8d, 0x82, 0xc7, 0xd3, 0xf1, 0xc3, 0x94,
-	0x43, 0x15, 0x81, 0x91, 0x45, 0x12, 0xdf, 0xfb, 0xdd, 0xbd, 0xdf, 0xfb, 0x1d, 0xdf, 0xbd, 0x27,
-	0x58, 0xa9, 0x9b, 0xae, 0x65, 0xd0, 0x8e, 0xeb, 0xa2, 0x43, 0xa3, 0xbb, 0x59, 0x47, 0xd4, 0xdc,
-	0x34, 0xe8, 0x0b, 0xbd, 0xed, 0x61, 0x8a, 0xe5, 0x4b, 0xbe, 0x57, 0x0f, 0xbc, 0x3a, 0xf7, 0xaa,
-	0x05, 0x1b, 0x63, 0xfb, 0x10, 0x19, 0x0c, 0x52, 0xef, 0x7c, 0x6a, 0x98, 0x6e, 0x2f, 0xc0, 0xab,
-	0x0b, 0x36, 0xb6, 0x31, 0xfb, 0x69, 0xf8, 0xbf, 0xb8, 0xb5, 0xd0, 0xc0, 0xa4, 0x85, 0xc9, 0x41,
-	0xe0, 0x08, 0x1e, 0xb8, 0xab, 0x18, 0x3c, 0x19, 0x75, 0x93, 0x20, 0x11, 0xbe, 0x81, 0x1d, 0x97,
-	0xfb, 0x97, 0xb8, 0xbf, 0x45, 0x6c, 0xa3, 0xbb, 0xe9, 0x7f, 0x71, 0xc7, 0xbc, 0xd9, 0x72, 0x5c,
-	0x6c, 0xb0, 0x4f, 0x6e, 0x2a, 0xa5, 0xa5, 0xd2, 0x36, 0x3d, 0xb3, 0x45, 0xce, 0x43, 0xf0, 0xec,
-	0x18, 0x42, 0xfb, 0x2e, 0x0f, 0xb3, 0x55, 0x62, 0x3f, 0xf4, 0x90, 0x49, 0xd1, 0x3e, 0xf3, 0xc8,
-	0x4f, 0x61, 0x9e, 0x38, 0xb6, 0x6b, 0x1e, 0x1e, 0x58, 0xa8, 0xeb, 0x98, 0xd4, 0xc1, 0x2e, 0x51,
-	0xa4, 0x52, 0xbe, 0x3c, 0xb5, 0x75, 0x4d, 0x4f, 0x11, 0x48, 0x7f, 0xc2, 0xd0, 0xbb, 0x7d, 0x70,
-	0x65, 0xf4, 0xd5, 0x9b, 0xd5, 0x91, 0xda, 0x1c, 0x89, 0x9b, 0x89, 0xac, 0xc2, 0x84, 0xe3, 0x52,
-	0xe4, 0x75, 0xcd, 0x43, 0x25, 0x57, 0x92, 0xca, 0xa3, 0x35, 0xf1, 0x2c, 0xdf, 0x83, 0x31, 0x0f,
-	0x77, 0x28, 0x52, 0xf2, 0x25, 0xa9, 0x3c, 0xb5, 0xb5, 0xa0, 0x07, 0xa2, 0xeb, 0x7d, 0xd1, 0xf5,
-	0x1d, 0xb7, 0x57, 0x81, 0xdf, 0x5f, 0xae, 0x8f, 0xd7, 0x7c, 0xd8, 0x5e, 0x2d, 0x80, 0xcb, 0x3d,
-	0x98, 0x75, 0x5c, 0x87, 0x3a, 0x8c, 0x6d, 0x1b, 0x13, 0x87, 0x2a, 0xa3, 0x8c, 0x6a, 0x41, 0xe7,
-	0xc2, 0xfb, 0x52, 0x0b, 0xaa, 0x0f, 0xb1, 0xe3, 0x56, 0xee, 0xfa, 0xfc, 0x7e, 0xfd, 0x7b, 0xb5,
-	0x6c, 0x3b, 0xb4, 0xd9, 0xa9, 0xeb, 0x0d, 0xdc, 0xe2, 0xa7, 0xc4, 0xbf, 0xd6, 0x89, 0xf5, 0xcc,
-	0xa0, 0xbd, 0x36, 0x22, 0x6c, 0x01, 0xf9, 0xe5, 0xf4, 0x68, 0x4d, 0xaa, 0xcd, 0xf0, 0x40, 0xbb,
-	0x41, 0x1c, 0x79, 0x0b, 0x3e, 0x68, 0xf8, 0xba, 0x61, 0x4f, 0x19, 0x2b, 0x49, 0xe5, 0xc9, 0x8a,
-	0xf2, 0xe7, 0xcb, 0xf5, 0x05, 0x1e, 0x75, 0xc7, 0xb2, 0x3c, 0x44, 0xc8, 0x13, 0xea, 0x39, 0xae,
-	0x5d, 0xeb, 0x03, 0x1f, 0xdc, 0xfc, 0xe2, 0xf4, 0x68, 0xad, 0xff, 0xf4, 0xd5, 0xe9, 0xd1, 0xda,
-	0x65, 0x7e, 0x3a, 0x89, 0x43, 0xd0, 0x76, 0x61, 0x29, 0x61, 0xaa, 0x21, 0xd2, 0xc6, 0x2e, 0x41,
-	0xf2, 0x2d, 0x98, 0x0c, 0x16, 0x1d, 0x38, 0x96, 0x22, 0xf9, 0x3a, 0x56, 0xa6, 0x8f, 0xdf, 0xac,
-	0x4e, 0x04, 0xb0, 0xbd, 0xdd, 0xda, 0x44, 0xe0, 0xde, 0xb3, 0xb4, 0x3f, 0x24, 0x98, 0xa9, 0x12,
-	0xfb, 0xe3, 0xb6, 0x65, 0x52, 0xc4, 0x84, 0x1b, 0x62, 0x75, 0x78, 0x26, 0xb9, 0xe1, 0xce, 0x24,
-	0x22, 0x4c, 0x3e, 0xab, 0x30, 0x37, 0x92, 0xc2, 0x2c, 0x86, 0xc2, 0x44, 0xe8, 0x6b, 0x0a, 0x5c,
-	0x8e, 0x5b, 0xfa, 0xb2, 0x68, 0x3f, 0xe7, 0x60, 0x59, 0xb8, 0x82, 0x57, 0x92, 0xec, 0xb8, 0xd6,
-	0x5e, 0xff, 0x0d, 0x1b, 0x22, 0xf1, 0xd4, 0x0a, 0xc8, 0xbd, 0xe3, 0x0a, 0xc8, 0x27, 0x2a, 0x20,
-	0xa2, 0xda, 0x68, 0x56, 0xd5, 0xb6, 0x93, 0xaa, 0x69, 0x49, 0xd5, 0xce, 0x0a, 0xa1, 0x5d, 0x87,
-	0xab, 0xe7, 0xb8, 0x85, 0x9e, 0xdf, 0xe6, 0x40, 0xa9, 0x12, 0xfb, 0xa9, 0x43, 0x9b, 0x96, 0x67,
-	0x3e, 0x7f, 0x84, 0xd0, 0x63, 0xb3, 0x87, 0xbc, 0x47, 0x1d, 0xd7, 0x22, 0xc3, 0x88, 0xd9, 0x84,
-	0x71, 0xb3, 0x85, 0x3b, 0x2e, 0xe5, 0x0a, 0xbe, 0xfb, 0xc2, 0xe4, 0xfb, 0xff, 0xaf, 0xf7, 0x6e,
-	0x23, 0xa9, 0xe0, 0x6a, 0xa8, 0x60, 0x6a, 0xea, 0x9a, 0x06, 0xa5, 0x41, 0x3e, 0xa1, 0xdd, 0x8f,
-	0x12, 0xcc, 0x57, 0x89, 0xbd, 0xd3, 0xa0, 0x4e, 0x37, 0xbc, 0x58, 0x87, 0x10, 0x2d, 0x92, 0x4a,
-	0x2e, 0x6b, 0x2a, 0xb7, 0x92, 0xa9, 0x28, 0x61, 0x2a, 0x71, 0x26, 0xda, 0x32, 0x14, 0xce, 0x18,
-	0x05, 0xf9, 0x9f, 0x24, 0xb8, 0x54, 0x25, 0xf6, 0x2e, 0x32, 0x2f, 0x94, 0xfe, 0xed, 0x24, 0x7d,
-	0x35, 0xa4, 0x9f, 0xe4, 0xa2, 0x5d, 0x61, 0xb5, 0x9e, 0x34, 0x8b, 0x14, 0x7e, 0x90, 0x60, 0xae,
-	0x4a, 0xec, 0x7d, 0xcf, 0xb1, 0x6d, 0xe4, 0x5d, 0x0c, 0xff, 0x72, 0x92, 0xff, 0x52, 0xc8, 0x3f,
-	0x46, 0x44, 0x53, 0x59, 0x61, 0xc5, 0x6c, 0x82, 0xf9, 0xd7, 0x39, 0x90, 0x59, 0x66, 0xac, 0xc7,
-	0xec, 0xe3, 0xe1, 0xb9, 0x5f, 0x5c, 0xbd, 0xdd, 0x83, 0x49, 0xde, 0x73, 0x33, 0x54, 0x5c, 0x08,
-	0x7d, 0x70, 0xc7, 0x57, 0x2a, 0x7c, 0xf6, 0xb5, 0x2a, 0x44, 0xcf, 0x3a, 0x96, 0xba, 0xb6, 0x02,
-	0xea, 0x59, 0xab, 0xd0, 0xeb, 0xfb, 0x1c, 0x2c, 0x46, 0xcb, 0xd1, 0xc3, 0xad, 0xf7, 0x59, 0xb2,
-	0xfb, 0x00, 0xcf, 0x39, 0x55, 0xf4, 0x76, 0xcd, 0x22, 0xd8, 0xe0, 0xa2, 0x8a, 0x18, 0x7c, 0xd5,
-	0x56, 0x52, 0xee, 0x2a, 0x21, 0x80, 0xb6, 0x0a, 0x57, 0x52, 0x1d, 0x42, 0xbb, 0xdf, 0x24, 0x36,
-	0xfc, 0x05, 0x9d, 0xe0, 0x31, 0x1b, 0x1c, 0xfd, 0x33, 0x35, 0x3b, 0xb4, 0x89, 0x3d, 0x87, 0xf6,
-	0x98, 0x6a, 0xe7, 0x9e, 0xa9, 0x80, 0xca, 0x1f, 0xc1, 0x78, 0x30, 0x7a, 0xf2, 0x61, 0x61, 0x39,
-	0xb5, 0x4f, 0x06, 0x41, 0x78, 0x7b, 0xe4, 0x0b, 0x82, 0x7b, 0x2b, 0xdc, 0x2a, 0x31, 0x15, 0x45,
-	0xd9, 0x69, 0x05, 0x36, 0x15, 0x45, 0x4d, 0xfd, 0x64, 0xb6, 0xfe, 0x9d, 0x80, 0x7c, 0x95, 0xd8,
-	0x72, 0x1d, 0xa6, 0x63, 0xd3, 0x6c, 0x7a, 0xc3, 0x4e, 0xcc, 0x56, 0xea, 0x9d, 0x2c, 0x28, 0x31,
-	0x81, 0x1d, 0xc0, 0x54, 0x74, 0xa4, 0xba, 0x3a, 0x68, 0x71, 0x04, 0xa4, 0xde, 0xce, 0x00, 0x12,
-	0x01, 0xbe, 0x94, 0x40, 0x19, 0x38, 0xc8, 0x6c, 0x9c, 0xbf, 0xd3, 0xd9, 0x15, 0xea, 0xfd, 0x61,
-	0x57, 0x08, 0x22, 0x9f, 0xc1, 0x62, 0xfa, 0x00, 0xb0, 0x3e, 0x68, 0xcb, 0x54, 0xb8, 0x7a, 0x77,
-	0x28, 0xb8, 0x08, 0xdf, 0x84, 0x99, 0x44, 0x0f, 0xbd, 0x31, 0x68, 0xa3, 0x38, 0x4e, 0xd5, 0xb3,
-	0xe1, 0x44, 0x24, 0x17, 0xe6, 0xce, 0x34, 0xbc, 0xf2, 0xa0, 0x3d, 0x92, 0x48, 0x75, 0x23, 0x2b,
-	0x52, 0xc4, 0x43, 0xf0, 0x61, 0xbc, 0x3b, 0x5d, 0x1f, 0xb4, 0x45, 0x0c, 0xa6, 0xae, 0x67, 0x82,
-	0x89, 0x30, 0xcf, 0x60, 0x36, 0xd9, 0x4a, 0x6e, 0x0e, 0xe6, 0x1a, 0x03, 0xaa, 0x46, 0x46, 0xa0,
-	0x08, 0x46, 0x41, 0x4e, 0xb9, 0x87, 0xd7, 0xde, 0x7a, 0xf4, 0x02, 0xab, 0x6e, 0x65, 0xc7, 0x8a,
-	0xa8, 0x75, 0x98, 0x8e, 0xdd, 0x60, 0xd7, 0xce, 0x7f, 0xd9, 0x03, 0xd4, 0xe0, 0x82, 0x4f, 0xbb,
-	0x5c, 0xd4, 0xb1, 0xcf, 0xfd, 0x5b, 0xbc, 0xb2, 0xf7, 0xea, 0xb8, 0x28, 0xbd, 0x3e, 0x2e, 0x4a,
-	0xff, 0x1c, 0x17, 0xa5, 0x6f, 0x4e, 0x8a, 0x23, 0xaf, 0x4f, 0x8a, 0x23, 0x7f, 0x9d, 0x14, 0x47,
-	0x3e, 0x31, 0x22, 0xed, 0xc0, 0xdf, 0x98, 0xfd, 0x45, 0x6a, 0xe0, 0x43, 0xa3, 0xd1, 0x34, 0x1d,
-	0xd7, 0xe8, 0x6e, 0x1b, 0x2f, 0xfa, 0xff, 0xc3, 0x59, 0x6f, 0xa8, 0x8f, 0x33, 0xc4, 0xf6, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xdd, 0x4e, 0xda, 0x90, 0x10, 0x00, 0x00,
+func (m *MsgUpdateTunnelAdmins) Reset()         { *m = MsgUpdateTunnelAdmins{} }
+func (m *MsgUpdateTunnelAdmins) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTunnelAdmins) ProtoMessage()    {}
+func (*MsgUpdateTunnelAdmins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{20}
+}
+func (m *MsgUpdateTunnelAdmins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTunnelAdmins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTunnelAdmins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTunnelAdmins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTunnelAdmins.Merge(m, src)
+}
+func (m *MsgUpdateTunnelAdmins) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTunnelAdmins) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTunnelAdmins.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgUpdateTunnelAdmins proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgUpdateTunnelAdmins) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateTunnel is a RPC method to create a new tunnel.
-	CreateTunnel(ctx context.Context, in *MsgCreateTunnel, opts ...grpc.CallOption) (*MsgCreateTunnelResponse, error)
-	// UpdateRoute is a RPC method to update a route information of the tunnel.
-	UpdateRoute(ctx context.Context, in *MsgUpdateRoute, opts ...grpc.CallOption) (*MsgUpdateRouteResponse, error)
-	// UpdateSignalsAndInterval is a RPC method to update a signals and interval of the tunnel.
-	UpdateSignalsAndInterval(ctx context.Context, in *MsgUpdateSignalsAndInterval, opts ...grpc.CallOption) (*MsgUpdateSignalsAndIntervalResponse, error)
-	// WithdrawFeePayerFunds is a RPC method to withdraw fee payer funds to creator.
-	WithdrawFeePayerFunds(ctx context.Context, in *MsgWithdrawFeePayerFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayerFundsResponse, error)
-	// ActivateTunnel is a RPC method to activate a tunnel.
-	ActivateTunnel(ctx context.Context, in *MsgActivateTunnel, opts ...grpc.CallOption) (*MsgActivateTunnelResponse, error)
-	// DeactivateTunnel is a RPC method to deactivate a tunnel.
-	DeactivateTunnel(ctx context.Context, in *MsgDeactivateTunnel, opts ...grpc.CallOption) (*MsgDeactivateTunnelResponse, error)
-	// TriggerTunnel is a RPC method to manually trigger a tunnel.
-	TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opts ...grpc.CallOption) (*MsgTriggerTunnelResponse, error)
-	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
-	DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error)
-	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
-	WithdrawFromTunnel(ctx context.Context, in *MsgWithdrawFromTunnel, opts ...grpc.CallOption) (*MsgWithdrawFromTunnelResponse, error)
-	// UpdateParams is a RPC method to update parameters
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgUpdateTunnelAdmins) GetAdmins() []TunnelAdmin {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateTunnelAdmins) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgUpdateTunnelAdminsResponse is the response type for the Msg/UpdateTunnelAdmins RPC method.
+type MsgUpdateTunnelAdminsResponse struct {
 }
 
-func (c *msgClient) CreateTunnel(ctx context.Context, in *MsgCreateTunnel, opts ...grpc.CallOption) (*MsgCreateTunnelResponse, error) {
-	out := new(MsgCreateTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/CreateTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTunnelAdminsResponse) Reset()         { *m = MsgUpdateTunnelAdminsResponse{} }
+func (m *MsgUpdateTunnelAdminsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTunnelAdminsResponse) ProtoMessage()    {}
+func (*MsgUpdateTunnelAdminsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{21}
+}
+func (m *MsgUpdateTunnelAdminsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTunnelAdminsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTunnelAdminsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateTunnelAdminsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTunnelAdminsResponse.Merge(m, src)
+}
+func (m *MsgUpdateTunnelAdminsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTunnelAdminsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTunnelAdminsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateRoute(ctx context.Context, in *MsgUpdateRoute, opts ...grpc.CallOption) (*MsgUpdateRouteResponse, error) {
-	out := new(MsgUpdateRouteResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateRoute", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateTunnelAdminsResponse proto.InternalMessageInfo
+
+// MsgApproveFundingSource is the transaction message for the creator to approve the funding account
+// that can set the funding policy of the tunnel.
+type MsgApproveFundingSource struct {
+	// tunnel_id is the ID of the tunnel.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// source is the address of the approved funding account; an empty source revokes the approval.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgApproveFundingSource) Reset()         { *m = MsgApproveFundingSource{} }
+func (m *MsgApproveFundingSource) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveFundingSource) ProtoMessage()    {}
+func (*MsgApproveFundingSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{22}
+}
+func (m *MsgApproveFundingSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveFundingSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveFundingSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgApproveFundingSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveFundingSource.Merge(m, src)
+}
+func (m *MsgApproveFundingSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveFundingSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveFundingSource.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateSignalsAndInterval(ctx context.Context, in *MsgUpdateSignalsAndInterval, opts ...grpc.CallOption) (*MsgUpdateSignalsAndIntervalResponse, error) {
-	out := new(MsgUpdateSignalsAndIntervalResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateSignalsAndInterval", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApproveFundingSource proto.InternalMessageInfo
+
+func (m *MsgApproveFundingSource) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) WithdrawFeePayerFunds(ctx context.Context, in *MsgWithdrawFeePayerFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayerFundsResponse, error) {
-	out := new(MsgWithdrawFeePayerFundsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/WithdrawFeePayerFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgApproveFundingSource) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ActivateTunnel(ctx context.Context, in *MsgActivateTunnel, opts ...grpc.CallOption) (*MsgActivateTunnelResponse, error) {
-	out := new(MsgActivateTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/ActivateTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgApproveFundingSource) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeactivateTunnel(ctx context.Context, in *MsgDeactivateTunnel, opts ...grpc.CallOption) (*MsgDeactivateTunnelResponse, error) {
-	out := new(MsgDeactivateTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/DeactivateTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgApproveFundingSourceResponse is the response type for the Msg/ApproveFundingSource RPC method.
+type MsgApproveFundingSourceResponse struct {
+}
+
+func (m *MsgApproveFundingSourceResponse) Reset()         { *m = MsgApproveFundingSourceResponse{} }
+func (m *MsgApproveFundingSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveFundingSourceResponse) ProtoMessage()    {}
+func (*MsgApproveFundingSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{23}
+}
+func (m *MsgApproveFundingSourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveFundingSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveFundingSourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgApproveFundingSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveFundingSourceResponse.Merge(m, src)
+}
+func (m *MsgApproveFundingSourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveFundingSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveFundingSourceResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opts ...grpc.CallOption) (*MsgTriggerTunnelResponse, error) {
-	out := new(MsgTriggerTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/TriggerTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApproveFundingSourceResponse proto.InternalMessageInfo
+
+// MsgSetFundingPolicy is the transaction message to grant an allowance from a funding account
+// to top up the fee payer of the tunnel. The funding account must be approved by the tunnel creator.
+type MsgSetFundingPolicy struct {
+	// tunnel_id is the ID of the tunnel to fund.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// allowance is the maximum amount of coins that can be pulled from the funding account.
+	Allowance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=allowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"allowance"`
+	// threshold is the fee payer balance below which the fee payer is topped up.
+	Threshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=threshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"threshold"`
+	// top_up_amount is the amount of coins sent to the fee payer on each top-up.
+	TopUpAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=top_up_amount,json=topUpAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"top_up_amount"`
+	// source is the address of the funding account.
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (m *MsgSetFundingPolicy) Reset()         { *m = MsgSetFundingPolicy{} }
+func (m *MsgSetFundingPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFundingPolicy) ProtoMessage()    {}
+func (*MsgSetFundingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{24}
+}
+func (m *MsgSetFundingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFundingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFundingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetFundingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFundingPolicy.Merge(m, src)
+}
+func (m *MsgSetFundingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFundingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFundingPolicy.DiscardUnknown(m)
 }
 
-func (c *msgClient) DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error) {
-	out := new(MsgDepositToTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/DepositToTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetFundingPolicy proto.InternalMessageInfo
+
+func (m *MsgSetFundingPolicy) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) WithdrawFromTunnel(ctx context.Context, in *MsgWithdrawFromTunnel, opts ...grpc.CallOption) (*MsgWithdrawFromTunnelResponse, error) {
-	out := new(MsgWithdrawFromTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFundingPolicy) GetAllowance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Allowance
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFundingPolicy) GetThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Threshold
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateTunnel is a RPC method to create a new tunnel.
-	CreateTunnel(context.Context, *MsgCreateTunnel) (*MsgCreateTunnelResponse, error)
-	// UpdateRoute is a RPC method to update a route information of the tunnel.
-	UpdateRoute(context.Context, *MsgUpdateRoute) (*MsgUpdateRouteResponse, error)
-	// UpdateSignalsAndInterval is a RPC method to update a signals and interval of the tunnel.
-	UpdateSignalsAndInterval(context.Context, *MsgUpdateSignalsAndInterval) (*MsgUpdateSignalsAndIntervalResponse, error)
-	// WithdrawFeePayerFunds is a RPC method to withdraw fee payer funds to creator.
-	WithdrawFeePayerFunds(context.Context, *MsgWithdrawFeePayerFunds) (*MsgWithdrawFeePayerFundsResponse, error)
-	// ActivateTunnel is a RPC method to activate a tunnel.
-	ActivateTunnel(context.Context, *MsgActivateTunnel) (*MsgActivateTunnelResponse, error)
-	// DeactivateTunnel is a RPC method to deactivate a tunnel.
-	DeactivateTunnel(context.Context, *MsgDeactivateTunnel) (*MsgDeactivateTunnelResponse, error)
-	// TriggerTunnel is a RPC method to manually trigger a tunnel.
-	TriggerTunnel(context.Context, *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error)
-	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
-	DepositToTunnel(context.Context, *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error)
-	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
-	WithdrawFromTunnel(context.Context, *MsgWithdrawFromTunnel) (*MsgWithdrawFromTunnelResponse, error)
-	// UpdateParams is a RPC method to update parameters
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgSetFundingPolicy) GetTopUpAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TopUpAmount
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetFundingPolicy) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) CreateTunnel(ctx context.Context, req *MsgCreateTunnel) (*MsgCreateTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTunnel not implemented")
+// MsgSetFundingPolicyResponse is the response type for the Msg/SetFundingPolicy RPC method.
+type MsgSetFundingPolicyResponse struct {
 }
-func (*UnimplementedMsgServer) UpdateRoute(ctx context.Context, req *MsgUpdateRoute) (*MsgUpdateRouteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoute not implemented")
+
+func (m *MsgSetFundingPolicyResponse) Reset()         { *m = MsgSetFundingPolicyResponse{} }
+func (m *MsgSetFundingPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFundingPolicyResponse) ProtoMessage()    {}
+func (*MsgSetFundingPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{25}
 }
-func (*UnimplementedMsgServer) UpdateSignalsAndInterval(ctx context.Context, req *MsgUpdateSignalsAndInterval) (*MsgUpdateSignalsAndIntervalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSignalsAndInterval not implemented")
+func (m *MsgSetFundingPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WithdrawFeePayerFunds(ctx context.Context, req *MsgWithdrawFeePayerFunds) (*MsgWithdrawFeePayerFundsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeePayerFunds not implemented")
+func (m *MsgSetFundingPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFundingPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ActivateTunnel(ctx context.Context, req *MsgActivateTunnel) (*MsgActivateTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivateTunnel not implemented")
+func (m *MsgSetFundingPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFundingPolicyResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeactivateTunnel(ctx context.Context, req *MsgDeactivateTunnel) (*MsgDeactivateTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeactivateTunnel not implemented")
+func (m *MsgSetFundingPolicyResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) TriggerTunnel(ctx context.Context, req *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TriggerTunnel not implemented")
+func (m *MsgSetFundingPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFundingPolicyResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DepositToTunnel(ctx context.Context, req *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositToTunnel not implemented")
+
+var xxx_messageInfo_MsgSetFundingPolicyResponse proto.InternalMessageInfo
+
+// MsgRemoveFundingPolicy is the transaction message to remove the funding policy of the tunnel.
+type MsgRemoveFundingPolicy struct {
+	// tunnel_id is the ID of the tunnel.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sender is the address of the funding account or the tunnel creator.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
-func (*UnimplementedMsgServer) WithdrawFromTunnel(ctx context.Context, req *MsgWithdrawFromTunnel) (*MsgWithdrawFromTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromTunnel not implemented")
+
+func (m *MsgRemoveFundingPolicy) Reset()         { *m = MsgRemoveFundingPolicy{} }
+func (m *MsgRemoveFundingPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFundingPolicy) ProtoMessage()    {}
+func (*MsgRemoveFundingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{26}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRemoveFundingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRemoveFundingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFundingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFundingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFundingPolicy.Merge(m, src)
+}
+func (m *MsgRemoveFundingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFundingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFundingPolicy.DiscardUnknown(m)
 }
 
-func _Msg_CreateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/CreateTunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTunnel(ctx, req.(*MsgCreateTunnel))
+var xxx_messageInfo_MsgRemoveFundingPolicy proto.InternalMessageInfo
+
+func (m *MsgRemoveFundingPolicy) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRoute)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRemoveFundingPolicy) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRoute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateRoute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRoute(ctx, req.(*MsgUpdateRoute))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateSignalsAndInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSignalsAndInterval)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSignalsAndInterval(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateSignalsAndInterval",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSignalsAndInterval(ctx, req.(*MsgUpdateSignalsAndInterval))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgRemoveFundingPolicyResponse is the response type for the Msg/RemoveFundingPolicy RPC method.
+type MsgRemoveFundingPolicyResponse struct {
 }
 
-func _Msg_WithdrawFeePayerFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawFeePayerFunds)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawFeePayerFunds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/WithdrawFeePayerFunds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawFeePayerFunds(ctx, req.(*MsgWithdrawFeePayerFunds))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRemoveFundingPolicyResponse) Reset()         { *m = MsgRemoveFundingPolicyResponse{} }
+func (m *MsgRemoveFundingPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFundingPolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveFundingPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{27}
 }
-
-func _Msg_ActivateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgActivateTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ActivateTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/ActivateTunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ActivateTunnel(ctx, req.(*MsgActivateTunnel))
+func (m *MsgRemoveFundingPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFundingPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFundingPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveFundingPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFundingPolicyResponse.Merge(m, src)
+}
+func (m *MsgRemoveFundingPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFundingPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFundingPolicyResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeactivateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeactivateTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeactivateTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/DeactivateTunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeactivateTunnel(ctx, req.(*MsgDeactivateTunnel))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRemoveFundingPolicyResponse proto.InternalMessageInfo
+
+// MsgDepositToTunnel defines a message to deposit to an existing tunnel.
+type MsgDepositToTunnel struct {
+	// tunnel_id defines the unique id of the tunnel.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// amount to be deposited by depositor.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// depositor defines the deposit addresses from the tunnel.
+	Depositor string `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
 }
 
-func _Msg_TriggerTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTriggerTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TriggerTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/TriggerTunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TriggerTunnel(ctx, req.(*MsgTriggerTunnel))
+func (m *MsgDepositToTunnel) Reset()         { *m = MsgDepositToTunnel{} }
+func (m *MsgDepositToTunnel) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToTunnel) ProtoMessage()    {}
+func (*MsgDepositToTunnel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{28}
+}
+func (m *MsgDepositToTunnel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositToTunnel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToTunnel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDepositToTunnel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToTunnel.Merge(m, src)
+}
+func (m *MsgDepositToTunnel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositToTunnel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToTunnel.DiscardUnknown(m)
 }
 
-func _Msg_DepositToTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositToTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositToTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/DepositToTunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositToTunnel(ctx, req.(*MsgDepositToTunnel))
+var xxx_messageInfo_MsgDepositToTunnel proto.InternalMessageInfo
+
+func (m *MsgDepositToTunnel) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_WithdrawFromTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawFromTunnel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawFromTunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawFromTunnel(ctx, req.(*MsgWithdrawFromTunnel))
+func (m *MsgDepositToTunnel) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgDepositToTunnel) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "band.tunnel.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTunnel",
-			Handler:    _Msg_CreateTunnel_Handler,
-		},
-		{
-			MethodName: "UpdateRoute",
-			Handler:    _Msg_UpdateRoute_Handler,
-		},
-		{
-			MethodName: "UpdateSignalsAndInterval",
-			Handler:    _Msg_UpdateSignalsAndInterval_Handler,
-		},
-		{
-			MethodName: "WithdrawFeePayerFunds",
-			Handler:    _Msg_WithdrawFeePayerFunds_Handler,
-		},
-		{
-			MethodName: "ActivateTunnel",
-			Handler:    _Msg_ActivateTunnel_Handler,
-		},
-		{
-			MethodName: "DeactivateTunnel",
-			Handler:    _Msg_DeactivateTunnel_Handler,
-		},
-		{
-			MethodName: "TriggerTunnel",
-			Handler:    _Msg_TriggerTunnel_Handler,
-		},
-		{
-			MethodName: "DepositToTunnel",
-			Handler:    _Msg_DepositToTunnel_Handler,
-		},
-		{
-			MethodName: "WithdrawFromTunnel",
-			Handler:    _Msg_WithdrawFromTunnel_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "band/tunnel/v1beta1/tx.proto",
+// MsgDepositToTunnelResponse defines the Msg/DepositToTunnel response type.
+type MsgDepositToTunnelResponse struct {
 }
 
-func (m *MsgCreateTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDepositToTunnelResponse) Reset()         { *m = MsgDepositToTunnelResponse{} }
+func (m *MsgDepositToTunnelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToTunnelResponse) ProtoMessage()    {}
+func (*MsgDepositToTunnelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{29}
 }
-
-func (m *MsgCreateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.InitialDeposit) > 0 {
-		for iNdEx := len(m.InitialDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InitialDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.Route != nil {
-		{
-			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Interval != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.SignalDeviations) > 0 {
-		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgDepositToTunnelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositToTunnelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToTunnelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgDepositToTunnelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToTunnelResponse.Merge(m, src)
 }
-
-func (m *MsgCreateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDepositToTunnelResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgDepositToTunnelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToTunnelResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgDepositToTunnelResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateRoute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgWithdrawFromTunnel is the transaction message to withdraw a deposit from an existing tunnel.
+type MsgWithdrawFromTunnel struct {
+	// tunnel_id defines the unique id of the tunnel.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// amount to be withdrawn by withdrawer.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// withdrawer defines the withdraw addresses from the tunnel.
+	Withdrawer string `protobuf:"bytes,3,opt,name=withdrawer,proto3" json:"withdrawer,omitempty"`
 }
 
-func (m *MsgUpdateRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Route != nil {
-		{
-			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgWithdrawFromTunnel) Reset()         { *m = MsgWithdrawFromTunnel{} }
+func (m *MsgWithdrawFromTunnel) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromTunnel) ProtoMessage()    {}
+func (*MsgWithdrawFromTunnel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{30}
+}
+func (m *MsgWithdrawFromTunnel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromTunnel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromTunnel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgWithdrawFromTunnel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromTunnel.Merge(m, src)
+}
+func (m *MsgWithdrawFromTunnel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromTunnel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromTunnel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFromTunnel proto.InternalMessageInfo
+
+func (m *MsgWithdrawFromTunnel) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateRouteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawFromTunnel) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateRouteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawFromTunnel) GetWithdrawer() string {
+	if m != nil {
+		return m.Withdrawer
+	}
+	return ""
 }
 
-func (m *MsgUpdateRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgWithdrawFromTunnelResponse defines the Msg/WithdrawFromTunnel response type.
+type MsgWithdrawFromTunnelResponse struct {
 }
 
-func (m *MsgUpdateSignalsAndInterval) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawFromTunnelResponse) Reset()         { *m = MsgWithdrawFromTunnelResponse{} }
+func (m *MsgWithdrawFromTunnelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromTunnelResponse) ProtoMessage()    {}
+func (*MsgWithdrawFromTunnelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{31}
+}
+func (m *MsgWithdrawFromTunnelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromTunnelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromTunnelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateSignalsAndInterval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawFromTunnelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromTunnelResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFromTunnelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromTunnelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromTunnelResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateSignalsAndInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Interval != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.SignalDeviations) > 0 {
-		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+var xxx_messageInfo_MsgWithdrawFromTunnelResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the transaction message to update parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params is the x/tunnel parameters to update.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{32}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateSignalsAndIntervalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateSignalsAndIntervalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *MsgUpdateSignalsAndIntervalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateParamsResponse is the response type for the Msg/UpdateParams RPC method.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgWithdrawFeePayerFunds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{33}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawFeePayerFunds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateTunnel)(nil), "band.tunnel.v1beta1.MsgCreateTunnel")
+	proto.RegisterType((*MsgCreateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgCreateTunnelResponse")
+	proto.RegisterType((*MsgUpdateRoute)(nil), "band.tunnel.v1beta1.MsgUpdateRoute")
+	proto.RegisterType((*MsgUpdateRouteResponse)(nil), "band.tunnel.v1beta1.MsgUpdateRouteResponse")
+	proto.RegisterType((*MsgUpdateSignalsAndInterval)(nil), "band.tunnel.v1beta1.MsgUpdateSignalsAndInterval")
+	proto.RegisterType((*MsgUpdateSignalsAndIntervalResponse)(nil), "band.tunnel.v1beta1.MsgUpdateSignalsAndIntervalResponse")
+	proto.RegisterType((*MsgWithdrawFeePayerFunds)(nil), "band.tunnel.v1beta1.MsgWithdrawFeePayerFunds")
+	proto.RegisterType((*MsgWithdrawFeePayerFundsResponse)(nil), "band.tunnel.v1beta1.MsgWithdrawFeePayerFundsResponse")
+	proto.RegisterType((*MsgActivateTunnel)(nil), "band.tunnel.v1beta1.MsgActivateTunnel")
+	proto.RegisterType((*MsgActivateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgActivateTunnelResponse")
+	proto.RegisterType((*MsgDeactivateTunnel)(nil), "band.tunnel.v1beta1.MsgDeactivateTunnel")
+	proto.RegisterType((*MsgDeactivateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgDeactivateTunnelResponse")
+	proto.RegisterType((*MsgTriggerTunnel)(nil), "band.tunnel.v1beta1.MsgTriggerTunnel")
+	proto.RegisterType((*MsgTriggerTunnelResponse)(nil), "band.tunnel.v1beta1.MsgTriggerTunnelResponse")
+	proto.RegisterType((*MsgRetryPacket)(nil), "band.tunnel.v1beta1.MsgRetryPacket")
+	proto.RegisterType((*MsgRetryPacketResponse)(nil), "band.tunnel.v1beta1.MsgRetryPacketResponse")
+	proto.RegisterType((*MsgTransferTunnelOwnership)(nil), "band.tunnel.v1beta1.MsgTransferTunnelOwnership")
+	proto.RegisterType((*MsgTransferTunnelOwnershipResponse)(nil), "band.tunnel.v1beta1.MsgTransferTunnelOwnershipResponse")
+	proto.RegisterType((*MsgAcceptTunnelOwnership)(nil), "band.tunnel.v1beta1.MsgAcceptTunnelOwnership")
+	proto.RegisterType((*MsgAcceptTunnelOwnershipResponse)(nil), "band.tunnel.v1beta1.MsgAcceptTunnelOwnershipResponse")
+	proto.RegisterType((*MsgUpdateTunnelAdmins)(nil), "band.tunnel.v1beta1.MsgUpdateTunnelAdmins")
+	proto.RegisterType((*MsgUpdateTunnelAdminsResponse)(nil), "band.tunnel.v1beta1.MsgUpdateTunnelAdminsResponse")
+	proto.RegisterType((*MsgApproveFundingSource)(nil), "band.tunnel.v1beta1.MsgApproveFundingSource")
+	proto.RegisterType((*MsgApproveFundingSourceResponse)(nil), "band.tunnel.v1beta1.MsgApproveFundingSourceResponse")
+	proto.RegisterType((*MsgSetFundingPolicy)(nil), "band.tunnel.v1beta1.MsgSetFundingPolicy")
+	proto.RegisterType((*MsgSetFundingPolicyResponse)(nil), "band.tunnel.v1beta1.MsgSetFundingPolicyResponse")
+	proto.RegisterType((*MsgRemoveFundingPolicy)(nil), "band.tunnel.v1beta1.MsgRemoveFundingPolicy")
+	proto.RegisterType((*MsgRemoveFundingPolicyResponse)(nil), "band.tunnel.v1beta1.MsgRemoveFundingPolicyResponse")
+	proto.RegisterType((*MsgDepositToTunnel)(nil), "band.tunnel.v1beta1.MsgDepositToTunnel")
+	proto.RegisterType((*MsgDepositToTunnelResponse)(nil), "band.tunnel.v1beta1.MsgDepositToTunnelResponse")
+	proto.RegisterType((*MsgWithdrawFromTunnel)(nil), "band.tunnel.v1beta1.MsgWithdrawFromTunnel")
+	proto.RegisterType((*MsgWithdrawFromTunnelResponse)(nil), "band.tunnel.v1beta1.MsgWithdrawFromTunnelResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "band.tunnel.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.tunnel.v1beta1.MsgUpdateParamsResponse")
 }
 
-func (m *MsgWithdrawFeePayerFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("band/tunnel/v1beta1/tx.proto", fileDescriptor_d18351d83b4705d0) }
+
+var fileDescriptor_d18351d83b4705d0 = []byte{
+	// 1719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0xdb, 0x46,
+	0x16, 0x37, 0x25, 0x5b, 0xb6, 0x9f, 0x13, 0x3b, 0x61, 0xfc, 0x41, 0xd3, 0x89, 0xac, 0x30, 0x5f,
+	0x8a, 0x13, 0x89, 0x8e, 0x9d, 0x64, 0x13, 0x1f, 0x16, 0x90, 0x6d, 0x04, 0xf0, 0x02, 0xc6, 0x7a,
+	0xe5, 0x04, 0x01, 0xf6, 0x22, 0x50, 0xe4, 0x84, 0x22, 0x22, 0x71, 0xb4, 0x1c, 0x4a, 0x8e, 0x16,
+	0x58, 0x60, 0xb1, 0x0b, 0xec, 0x61, 0x2f, 0xed, 0xa5, 0x40, 0x81, 0xa2, 0x87, 0x16, 0x3d, 0x14,
+	0xbd, 0xd4, 0x87, 0xa0, 0xd7, 0x5e, 0x83, 0x5e, 0x12, 0xf4, 0x54, 0xa0, 0x40, 0x5a, 0x38, 0x28,
+	0x02, 0xf4, 0xdc, 0x3f, 0xa0, 0x20, 0x39, 0x1a, 0x51, 0x14, 0x69, 0x93, 0x46, 0x9d, 0xf6, 0x62,
+	0x7b, 0xe6, 0xfd, 0x86, 0xef, 0xbd, 0xdf, 0x7b, 0x33, 0xef, 0xcd, 0x18, 0xce, 0x57, 0x15, 0x53,
+	0x93, 0xed, 0x96, 0x69, 0xa2, 0xba, 0xdc, 0xbe, 0x55, 0x45, 0xb6, 0x72, 0x4b, 0xb6, 0x9f, 0x15,
+	0x9b, 0x16, 0xb6, 0x31, 0x7f, 0xce, 0x91, 0x16, 0x3d, 0x69, 0x91, 0x4a, 0xc5, 0x79, 0x1d, 0x63,
+	0xbd, 0x8e, 0x64, 0x17, 0x52, 0x6d, 0x3d, 0x91, 0x15, 0xb3, 0xe3, 0xe1, 0xc5, 0x69, 0x1d, 0xeb,
+	0xd8, 0xfd, 0x53, 0x76, 0xfe, 0xa2, 0xb3, 0xf3, 0x2a, 0x26, 0x0d, 0x4c, 0x2a, 0x9e, 0xc0, 0x1b,
+	0x50, 0x51, 0xd6, 0x1b, 0xc9, 0x55, 0x85, 0x20, 0xa6, 0x5e, 0xc5, 0x86, 0x49, 0xe5, 0x73, 0x54,
+	0xde, 0x20, 0xba, 0xdc, 0xbe, 0xe5, 0xfc, 0xa2, 0x82, 0xb3, 0x4a, 0xc3, 0x30, 0xb1, 0xec, 0xfe,
+	0xa4, 0x53, 0xb9, 0x30, 0x57, 0x9a, 0x8a, 0xa5, 0x34, 0xc8, 0x61, 0x08, 0xea, 0x9d, 0x8b, 0x90,
+	0xbe, 0x1e, 0x86, 0xa9, 0x6d, 0xa2, 0x6f, 0x58, 0x48, 0xb1, 0xd1, 0x43, 0x57, 0xc2, 0x3f, 0x86,
+	0xb3, 0xc4, 0xd0, 0x4d, 0xa5, 0x5e, 0xd1, 0x50, 0xdb, 0x50, 0x6c, 0x03, 0x9b, 0x44, 0xe0, 0x72,
+	0xe9, 0xfc, 0xc4, 0xca, 0xe5, 0x62, 0x08, 0x41, 0xc5, 0x5d, 0x17, 0xbd, 0xd9, 0x05, 0xaf, 0x0f,
+	0xbf, 0x78, 0xbd, 0x38, 0x54, 0x3e, 0x43, 0xfa, 0xa7, 0x09, 0x2f, 0xc2, 0x98, 0x61, 0xda, 0xc8,
+	0x6a, 0x2b, 0x75, 0x21, 0x95, 0xe3, 0xf2, 0xc3, 0x65, 0x36, 0xe6, 0xef, 0xc2, 0x88, 0x85, 0x5b,
+	0x36, 0x12, 0xd2, 0x39, 0x2e, 0x3f, 0xb1, 0x32, 0x5d, 0xf4, 0x48, 0x2f, 0x76, 0x49, 0x2f, 0x96,
+	0xcc, 0xce, 0x3a, 0x7c, 0xf3, 0xbc, 0x90, 0x29, 0x3b, 0xb0, 0xad, 0xb2, 0x07, 0xe7, 0x3b, 0x30,
+	0x65, 0x98, 0x86, 0x6d, 0xb8, 0xd6, 0x36, 0x31, 0x31, 0x6c, 0x61, 0xd8, 0x35, 0x75, 0xbe, 0x48,
+	0x89, 0x77, 0xa8, 0x66, 0xa6, 0x6e, 0x60, 0xc3, 0x5c, 0xbf, 0xe3, 0xd8, 0xf7, 0xc5, 0x0f, 0x8b,
+	0x79, 0xdd, 0xb0, 0x6b, 0xad, 0x6a, 0x51, 0xc5, 0x0d, 0x1a, 0x25, 0xfa, 0xab, 0x40, 0xb4, 0xa7,
+	0xb2, 0xdd, 0x69, 0x22, 0xe2, 0x2e, 0x20, 0x9f, 0xbf, 0xdd, 0x5f, 0xe2, 0xca, 0x93, 0x54, 0xd1,
+	0xa6, 0xa7, 0x87, 0x5f, 0x81, 0x51, 0xd5, 0xe1, 0x0d, 0x5b, 0xc2, 0x48, 0x8e, 0xcb, 0x8f, 0xaf,
+	0x0b, 0xdf, 0x3e, 0x2f, 0x4c, 0x53, 0xad, 0x25, 0x4d, 0xb3, 0x10, 0x21, 0xbb, 0xb6, 0x65, 0x98,
+	0x7a, 0xb9, 0x0b, 0x74, 0x28, 0x20, 0x6a, 0x0d, 0x69, 0xad, 0x3a, 0x12, 0x32, 0xce, 0xa2, 0x32,
+	0x1b, 0xf3, 0x7f, 0x83, 0x29, 0x0d, 0x59, 0x46, 0x1b, 0x69, 0x15, 0x8f, 0x3a, 0x22, 0x8c, 0xba,
+	0xae, 0x48, 0xa1, 0xac, 0x6f, 0x7a, 0x58, 0x8f, 0x7c, 0xca, 0xf9, 0xa4, 0xe6, 0x9f, 0x24, 0xfc,
+	0x3d, 0x10, 0x0c, 0x53, 0xad, 0xb7, 0x34, 0x54, 0x69, 0x5a, 0x86, 0x8a, 0x2a, 0x2a, 0x36, 0x9f,
+	0x18, 0x1a, 0x32, 0x55, 0x24, 0x8c, 0xe5, 0xb8, 0xfc, 0x58, 0x79, 0x96, 0xca, 0x77, 0x1c, 0xf1,
+	0x06, 0x93, 0xae, 0x5d, 0xfb, 0xcf, 0xdb, 0xfd, 0xa5, 0xae, 0xd9, 0xff, 0x7f, 0xbb, 0xbf, 0x34,
+	0x4b, 0xd3, 0x28, 0x90, 0x2d, 0xd2, 0x26, 0xcc, 0x05, 0xa6, 0xca, 0x88, 0x34, 0xb1, 0x49, 0x10,
+	0x7f, 0x1d, 0xc6, 0xbd, 0x45, 0x15, 0x43, 0x13, 0x38, 0x27, 0xe0, 0xeb, 0xa7, 0x0e, 0x5e, 0x2f,
+	0x8e, 0x79, 0xb0, 0xad, 0xcd, 0xf2, 0x98, 0x27, 0xde, 0xd2, 0xa4, 0x97, 0x1c, 0x4c, 0x6e, 0x13,
+	0xfd, 0x51, 0x53, 0x53, 0x6c, 0xe4, 0x46, 0x38, 0xc1, 0xea, 0x5e, 0xf2, 0xa4, 0x92, 0x25, 0x8f,
+	0x2f, 0x82, 0xe9, 0x98, 0x11, 0x5c, 0xbb, 0x1a, 0x24, 0x66, 0xa6, 0x47, 0x8c, 0xcf, 0x7c, 0x49,
+	0x80, 0xd9, 0xfe, 0x99, 0x2e, 0x2d, 0xd2, 0xcb, 0x34, 0x2c, 0x30, 0x11, 0x8d, 0x54, 0xc9, 0xd4,
+	0xb6, 0xba, 0x5b, 0x21, 0x81, 0xe3, 0xa1, 0x5b, 0x35, 0xf5, 0x1b, 0x6f, 0xd5, 0x74, 0x60, 0xab,
+	0xfa, 0x58, 0x1b, 0x3e, 0x4e, 0xde, 0x8f, 0x1c, 0x9d, 0xf7, 0x99, 0x13, 0xcc, 0xfb, 0xd1, 0x43,
+	0xf3, 0x7e, 0x35, 0x18, 0x5e, 0x29, 0x18, 0xde, 0xc1, 0x88, 0x49, 0x57, 0xe0, 0xd2, 0x21, 0x62,
+	0x16, 0xf8, 0x0f, 0x52, 0x20, 0x6c, 0x13, 0xfd, 0xb1, 0x61, 0xd7, 0x34, 0x4b, 0xd9, 0x7b, 0x80,
+	0xd0, 0x8e, 0xd2, 0x41, 0xd6, 0x83, 0x96, 0xa9, 0x91, 0x24, 0x51, 0xaf, 0x41, 0x46, 0x69, 0xe0,
+	0x96, 0x69, 0x0b, 0xa9, 0x13, 0x3a, 0xea, 0xe8, 0xf7, 0x8f, 0xb5, 0x41, 0x96, 0x83, 0x0c, 0x2e,
+	0xf6, 0x18, 0x0c, 0x75, 0x5d, 0x92, 0x20, 0x17, 0x25, 0x63, 0xdc, 0x7d, 0xcc, 0xc1, 0xd9, 0x6d,
+	0xa2, 0x97, 0x54, 0xdb, 0x68, 0xf7, 0x4a, 0x55, 0x02, 0xd2, 0x7c, 0xae, 0xa4, 0xe2, 0xba, 0x72,
+	0x3d, 0xe8, 0x8a, 0xd0, 0x73, 0xa5, 0xdf, 0x12, 0x69, 0x01, 0xe6, 0x07, 0x26, 0x99, 0xf1, 0x9f,
+	0x70, 0x70, 0x6e, 0x9b, 0xe8, 0x9b, 0x48, 0x79, 0xa7, 0xe6, 0xdf, 0x08, 0x9a, 0x2f, 0xf6, 0xcc,
+	0x0f, 0xda, 0x22, 0x5d, 0x80, 0x85, 0x90, 0x69, 0xe6, 0xc2, 0x47, 0x1c, 0x9c, 0xd9, 0x26, 0xfa,
+	0x43, 0xcb, 0xd0, 0x75, 0x64, 0xbd, 0x1b, 0xfb, 0xf3, 0x41, 0xfb, 0xe7, 0x7a, 0xf6, 0xf7, 0x19,
+	0x22, 0x89, 0x20, 0x04, 0xe7, 0x98, 0xe5, 0xfb, 0x5e, 0x69, 0x29, 0x23, 0xdb, 0xea, 0xec, 0x28,
+	0xea, 0x53, 0x64, 0x27, 0xb1, 0xdb, 0x39, 0xb8, 0xd0, 0x3f, 0x5a, 0xee, 0xc9, 0x41, 0x7b, 0x96,
+	0xee, 0x98, 0x5f, 0x86, 0x0c, 0x41, 0xa6, 0x86, 0x8e, 0xde, 0x1c, 0x14, 0xb7, 0x76, 0xc5, 0xf1,
+	0x88, 0x0e, 0x02, 0xb5, 0xc3, 0x67, 0x9f, 0xf4, 0x17, 0x98, 0xed, 0x9f, 0x61, 0x25, 0x55, 0x80,
+	0x51, 0xd2, 0x52, 0x55, 0x44, 0x88, 0x6b, 0xf7, 0x58, 0xb9, 0x3b, 0xe4, 0x67, 0x21, 0x63, 0x21,
+	0x85, 0x60, 0xd3, 0xe3, 0xb7, 0x4c, 0x47, 0xd2, 0xcf, 0x1c, 0x88, 0x2e, 0x37, 0x8a, 0x49, 0x9e,
+	0x74, 0xc9, 0xf9, 0xeb, 0x9e, 0x89, 0x2c, 0x52, 0x33, 0x9a, 0x49, 0xa8, 0xb8, 0x0f, 0x13, 0x26,
+	0xda, 0xab, 0xc4, 0x0d, 0x23, 0x98, 0x68, 0x6f, 0x83, 0x1e, 0xff, 0xc7, 0x39, 0x47, 0x56, 0x82,
+	0xd1, 0xbf, 0xe8, 0x8f, 0x7e, 0xa8, 0x37, 0xd2, 0x65, 0x90, 0xa2, 0xa5, 0x2c, 0x23, 0xbe, 0xe2,
+	0xdc, 0x74, 0x29, 0xa9, 0x2a, 0x6a, 0xda, 0xbf, 0x0b, 0x21, 0x6b, 0xb7, 0x1d, 0xe7, 0xfc, 0xab,
+	0x03, 0x07, 0x65, 0xa8, 0x6d, 0xf4, 0xa0, 0x0c, 0x95, 0x31, 0xe7, 0x7e, 0xe2, 0x60, 0x86, 0x15,
+	0x23, 0x0f, 0x54, 0xd2, 0x1a, 0x86, 0x99, 0xa8, 0xc2, 0xfc, 0x19, 0x32, 0x8a, 0xbb, 0x88, 0x56,
+	0x98, 0x5c, 0x68, 0x25, 0xf6, 0x7d, 0x9d, 0xd6, 0x61, 0xba, 0xea, 0x58, 0xf1, 0x2e, 0x04, 0xe3,
+	0x7d, 0x3e, 0x58, 0x79, 0xfd, 0xde, 0x48, 0x8b, 0x70, 0x21, 0x54, 0xc0, 0x88, 0xf8, 0x9e, 0x73,
+	0x3b, 0xd3, 0x52, 0xb3, 0x69, 0xe1, 0x36, 0x72, 0xaa, 0x89, 0x61, 0xea, 0xbb, 0xb8, 0x65, 0xa9,
+	0x89, 0x7a, 0x4b, 0x67, 0x93, 0xbb, 0x8b, 0x8e, 0x8c, 0x2f, 0xc5, 0x1d, 0xcb, 0x79, 0x39, 0xe8,
+	0x7c, 0xd6, 0x97, 0x0b, 0x21, 0x1e, 0x48, 0x17, 0x61, 0x31, 0x42, 0xc4, 0x08, 0xf8, 0x25, 0xed,
+	0x56, 0x9d, 0x5d, 0x64, 0x53, 0xf9, 0x0e, 0xae, 0x1b, 0x6a, 0x27, 0x89, 0xf3, 0x26, 0x8c, 0x2b,
+	0xf5, 0x3a, 0xde, 0x53, 0xbc, 0xe3, 0xef, 0x64, 0x9a, 0x8d, 0x9e, 0x0a, 0x47, 0x9f, 0x5d, 0xb3,
+	0x10, 0xa9, 0xe1, 0xba, 0x26, 0xa4, 0x4f, 0x4a, 0x1f, 0x53, 0xc1, 0xdb, 0x70, 0xda, 0xc6, 0xcd,
+	0x4a, 0xab, 0x59, 0xa1, 0x0d, 0xd5, 0x49, 0xdd, 0x1d, 0x27, 0x6c, 0xdc, 0x7c, 0xd4, 0x2c, 0x79,
+	0x5d, 0x55, 0x2f, 0xa5, 0x46, 0xe2, 0xa5, 0xd4, 0xda, 0x92, 0x57, 0x37, 0xdc, 0x41, 0xa0, 0x90,
+	0x07, 0xc3, 0x4b, 0x0b, 0x79, 0x70, 0x9a, 0x65, 0xc5, 0x67, 0x1c, 0x2d, 0x2e, 0x8d, 0x5e, 0xe2,
+	0x24, 0x4f, 0x8c, 0x5e, 0xe9, 0x4b, 0xc5, 0x2c, 0x7d, 0x85, 0x40, 0xe9, 0xbb, 0xe0, 0x2f, 0x7d,
+	0x03, 0xb6, 0x48, 0x39, 0xc8, 0x86, 0x4b, 0x98, 0x23, 0xef, 0xa5, 0x80, 0x77, 0x3b, 0x16, 0xf7,
+	0x36, 0xfe, 0x10, 0x27, 0xef, 0x49, 0xde, 0x5d, 0x1f, 0x7d, 0x17, 0xc6, 0xe9, 0xeb, 0x44, 0x8c,
+	0x43, 0xa1, 0x07, 0x5d, 0xbb, 0xe9, 0x90, 0xd6, 0x1b, 0x3b, 0xbc, 0xcd, 0xfb, 0x7b, 0xb8, 0x3e,
+	0xd7, 0xa5, 0xf3, 0x20, 0x0e, 0xce, 0x32, 0xbe, 0x3e, 0x4c, 0xc1, 0x8c, 0xbf, 0xcd, 0xb6, 0x70,
+	0xe3, 0x8f, 0x4c, 0xd9, 0x3d, 0x80, 0x3d, 0x6a, 0x6a, 0x8c, 0x06, 0xcb, 0x87, 0xf5, 0x2e, 0x20,
+	0xbe, 0x89, 0x40, 0x2d, 0x19, 0x24, 0x80, 0xd6, 0x92, 0x41, 0x01, 0xe3, 0xee, 0x4b, 0x0e, 0xa6,
+	0x58, 0xb5, 0xd9, 0x71, 0x9f, 0xd8, 0x9c, 0x98, 0x2a, 0x2d, 0xbb, 0x86, 0x2d, 0xc3, 0xee, 0x08,
+	0xdc, 0x11, 0xf6, 0xf5, 0xa0, 0xfc, 0x7d, 0xc8, 0x78, 0x8f, 0x74, 0xf4, 0xb5, 0x62, 0x21, 0xb4,
+	0xb6, 0x7a, 0x4a, 0xba, 0x65, 0xd5, 0x5b, 0xe0, 0xdd, 0x47, 0x7a, 0x9f, 0x0a, 0x3c, 0xcb, 0xf8,
+	0xad, 0x93, 0xe6, 0x61, 0x2e, 0x30, 0xd5, 0x75, 0x66, 0xe5, 0xd3, 0x29, 0x48, 0x6f, 0x13, 0x9d,
+	0xaf, 0xc2, 0xa9, 0xbe, 0x77, 0xbf, 0xf0, 0x17, 0x83, 0xc0, 0xe3, 0x8e, 0x78, 0x33, 0x0e, 0x8a,
+	0xf5, 0xab, 0x15, 0x98, 0xf0, 0xbf, 0xe9, 0x5c, 0x8a, 0x5a, 0xec, 0x03, 0x89, 0x37, 0x62, 0x80,
+	0x98, 0x82, 0xff, 0x71, 0x20, 0x44, 0xbe, 0xa4, 0x2c, 0x1f, 0xfe, 0xa5, 0xc1, 0x15, 0xe2, 0xbd,
+	0xa4, 0x2b, 0x98, 0x21, 0xff, 0x82, 0x99, 0xf0, 0x8b, 0x7d, 0x21, 0xea, 0x93, 0xa1, 0x70, 0xf1,
+	0x4e, 0x22, 0x38, 0x53, 0x5f, 0x83, 0xc9, 0xc0, 0xdd, 0xf8, 0x6a, 0xd4, 0x87, 0xfa, 0x71, 0x62,
+	0x31, 0x1e, 0x8e, 0x69, 0x32, 0xe1, 0xcc, 0xc0, 0x45, 0x36, 0x1f, 0xf5, 0x8d, 0x20, 0x52, 0x5c,
+	0x8e, 0x8b, 0x64, 0xfa, 0x10, 0x9c, 0xee, 0xbf, 0x75, 0x5e, 0x89, 0xfa, 0x44, 0x1f, 0x4c, 0x2c,
+	0xc4, 0x82, 0xf9, 0x33, 0xd5, 0x7f, 0x45, 0x8c, 0xcc, 0x54, 0x1f, 0x48, 0xbc, 0x11, 0x03, 0xc4,
+	0x14, 0xfc, 0x97, 0x83, 0xb9, 0xa8, 0x5b, 0x98, 0x1c, 0x6d, 0x6b, 0xe8, 0x02, 0xf1, 0x4f, 0x09,
+	0x17, 0xf8, 0xd3, 0x34, 0xfc, 0xde, 0x53, 0x88, 0x4e, 0x83, 0x10, 0xb8, 0x78, 0x27, 0x11, 0x9c,
+	0xa9, 0xb7, 0x81, 0x0f, 0xb9, 0x99, 0x2c, 0x1d, 0xbe, 0xeb, 0xfc, 0x58, 0x71, 0x25, 0x3e, 0x96,
+	0x69, 0xfd, 0x27, 0x4c, 0x87, 0x5e, 0x03, 0x22, 0xcf, 0xb2, 0x30, 0xb4, 0x78, 0x3b, 0x09, 0xda,
+	0xbf, 0x5d, 0x06, 0x3a, 0xf0, 0xc8, 0xed, 0x12, 0x44, 0x8a, 0xcb, 0x71, 0x91, 0x4c, 0xdf, 0x1e,
+	0x9c, 0x0b, 0xeb, 0xed, 0x0e, 0x49, 0xd5, 0x01, 0xb0, 0xb8, 0x9a, 0x00, 0xcc, 0x14, 0x3f, 0x85,
+	0xa9, 0x60, 0x2f, 0x76, 0x2d, 0x7a, 0xb3, 0xf7, 0x01, 0x45, 0x39, 0x26, 0xd0, 0x9f, 0x47, 0x21,
+	0x8d, 0xcc, 0xd2, 0x91, 0x67, 0x27, 0xc3, 0x8a, 0x2b, 0xf1, 0xb1, 0x4c, 0x6b, 0x15, 0x4e, 0xf5,
+	0xb5, 0x00, 0x97, 0x0f, 0xcf, 0x45, 0x0f, 0x25, 0xde, 0x8c, 0x83, 0xea, 0xea, 0x10, 0x47, 0xfe,
+	0xed, 0xb4, 0x41, 0xeb, 0x5b, 0x2f, 0x0e, 0xb2, 0xdc, 0xab, 0x83, 0x2c, 0xf7, 0xe3, 0x41, 0x96,
+	0x7b, 0xff, 0x4d, 0x76, 0xe8, 0xd5, 0x9b, 0xec, 0xd0, 0x77, 0x6f, 0xb2, 0x43, 0x7f, 0x97, 0x7d,
+	0xfd, 0x94, 0xf3, 0x61, 0xf7, 0x9f, 0x1c, 0x2a, 0xae, 0xcb, 0x6a, 0x4d, 0x31, 0x4c, 0xb9, 0xbd,
+	0x2a, 0x3f, 0xeb, 0xfe, 0xcb, 0xcf, 0x6d, 0xae, 0xaa, 0x19, 0x17, 0xb1, 0xfa, 0xeb, 0x00, 0x83,
+	0xd3, 0xe7, 0xed, 0xfb, 0x1c, 0x00, 0x00,
 }
 
-func (m *MsgWithdrawFeePayerFundsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateTunnel is a RPC method to create a new tunnel.
+	CreateTunnel(ctx context.Context, in *MsgCreateTunnel, opts ...grpc.CallOption) (*MsgCreateTunnelResponse, error)
+	// UpdateRoute is a RPC method to update a route information of the tunnel.
+	UpdateRoute(ctx context.Context, in *MsgUpdateRoute, opts ...grpc.CallOption) (*MsgUpdateRouteResponse, error)
+	// UpdateSignalsAndInterval is a RPC method to update a signals and interval of the tunnel.
+	UpdateSignalsAndInterval(ctx context.Context, in *MsgUpdateSignalsAndInterval, opts ...grpc.CallOption) (*MsgUpdateSignalsAndIntervalResponse, error)
+	// WithdrawFeePayerFunds is a RPC method to withdraw fee payer funds to creator.
+	WithdrawFeePayerFunds(ctx context.Context, in *MsgWithdrawFeePayerFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayerFundsResponse, error)
+	// ActivateTunnel is a RPC method to activate a tunnel.
+	ActivateTunnel(ctx context.Context, in *MsgActivateTunnel, opts ...grpc.CallOption) (*MsgActivateTunnelResponse, error)
+	// DeactivateTunnel is a RPC method to deactivate a tunnel.
+	DeactivateTunnel(ctx context.Context, in *MsgDeactivateTunnel, opts ...grpc.CallOption) (*MsgDeactivateTunnelResponse, error)
+	// TriggerTunnel is a RPC method to manually trigger a tunnel.
+	TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opts ...grpc.CallOption) (*MsgTriggerTunnelResponse, error)
+	// RetryPacket is a RPC method to resend a failed packet of the tunnel.
+	RetryPacket(ctx context.Context, in *MsgRetryPacket, opts ...grpc.CallOption) (*MsgRetryPacketResponse, error)
+	// TransferTunnelOwnership is a RPC method to propose a new creator of the tunnel.
+	TransferTunnelOwnership(ctx context.Context, in *MsgTransferTunnelOwnership, opts ...grpc.CallOption) (*MsgTransferTunnelOwnershipResponse, error)
+	// AcceptTunnelOwnership is a RPC method for the proposed creator to accept the ownership of the tunnel.
+	AcceptTunnelOwnership(ctx context.Context, in *MsgAcceptTunnelOwnership, opts ...grpc.CallOption) (*MsgAcceptTunnelOwnershipResponse, error)
+	// UpdateTunnelAdmins is a RPC method to update the co-administrators of the tunnel.
+	UpdateTunnelAdmins(ctx context.Context, in *MsgUpdateTunnelAdmins, opts ...grpc.CallOption) (*MsgUpdateTunnelAdminsResponse, error)
+	// ApproveFundingSource is a RPC method for the creator to approve the funding account of the tunnel.
+	ApproveFundingSource(ctx context.Context, in *MsgApproveFundingSource, opts ...grpc.CallOption) (*MsgApproveFundingSourceResponse, error)
+	// SetFundingPolicy is a RPC method to grant an allowance from a funding account to top up the fee payer.
+	SetFundingPolicy(ctx context.Context, in *MsgSetFundingPolicy, opts ...grpc.CallOption) (*MsgSetFundingPolicyResponse, error)
+	// RemoveFundingPolicy is a RPC method to remove the funding policy of a tunnel.
+	RemoveFundingPolicy(ctx context.Context, in *MsgRemoveFundingPolicy, opts ...grpc.CallOption) (*MsgRemoveFundingPolicyResponse, error)
+	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
+	DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error)
+	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
+	WithdrawFromTunnel(ctx context.Context, in *MsgWithdrawFromTunnel, opts ...grpc.CallOption) (*MsgWithdrawFromTunnelResponse, error)
+	// UpdateParams is a RPC method to update parameters
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgWithdrawFeePayerFundsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgWithdrawFeePayerFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgActivateTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateTunnel(ctx context.Context, in *MsgCreateTunnel, opts ...grpc.CallOption) (*MsgCreateTunnelResponse, error) {
+	out := new(MsgCreateTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/CreateTunnel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgActivateTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgActivateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) UpdateRoute(ctx context.Context, in *MsgUpdateRoute, opts ...grpc.CallOption) (*MsgUpdateRouteResponse, error) {
+	out := new(MsgUpdateRouteResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgActivateTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateSignalsAndInterval(ctx context.Context, in *MsgUpdateSignalsAndInterval, opts ...grpc.CallOption) (*MsgUpdateSignalsAndIntervalResponse, error) {
+	out := new(MsgUpdateSignalsAndIntervalResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateSignalsAndInterval", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgActivateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgActivateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeactivateTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawFeePayerFunds(ctx context.Context, in *MsgWithdrawFeePayerFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayerFundsResponse, error) {
+	out := new(MsgWithdrawFeePayerFundsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/WithdrawFeePayerFunds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeactivateTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgDeactivateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) ActivateTunnel(ctx context.Context, in *MsgActivateTunnel, opts ...grpc.CallOption) (*MsgActivateTunnelResponse, error) {
+	out := new(MsgActivateTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/ActivateTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeactivateTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeactivateTunnel(ctx context.Context, in *MsgDeactivateTunnel, opts ...grpc.CallOption) (*MsgDeactivateTunnelResponse, error) {
+	out := new(MsgDeactivateTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/DeactivateTunnel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeactivateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeactivateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgTriggerTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opts ...grpc.CallOption) (*MsgTriggerTunnelResponse, error) {
+	out := new(MsgTriggerTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/TriggerTunnel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTriggerTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgTriggerTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) RetryPacket(ctx context.Context, in *MsgRetryPacket, opts ...grpc.CallOption) (*MsgRetryPacketResponse, error) {
+	out := new(MsgRetryPacketResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/RetryPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgTriggerTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferTunnelOwnership(ctx context.Context, in *MsgTransferTunnelOwnership, opts ...grpc.CallOption) (*MsgTransferTunnelOwnershipResponse, error) {
+	out := new(MsgTransferTunnelOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/TransferTunnelOwnership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTriggerTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgTriggerTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDepositToTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptTunnelOwnership(ctx context.Context, in *MsgAcceptTunnelOwnership, opts ...grpc.CallOption) (*MsgAcceptTunnelOwnershipResponse, error) {
+	out := new(MsgAcceptTunnelOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/AcceptTunnelOwnership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDepositToTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateTunnelAdmins(ctx context.Context, in *MsgUpdateTunnelAdmins, opts ...grpc.CallOption) (*MsgUpdateTunnelAdminsResponse, error) {
+	out := new(MsgUpdateTunnelAdminsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateTunnelAdmins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDepositToTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) ApproveFundingSource(ctx context.Context, in *MsgApproveFundingSource, opts ...grpc.CallOption) (*MsgApproveFundingSourceResponse, error) {
+	out := new(MsgApproveFundingSourceResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/ApproveFundingSource", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDepositToTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetFundingPolicy(ctx context.Context, in *MsgSetFundingPolicy, opts ...grpc.CallOption) (*MsgSetFundingPolicyResponse, error) {
+	out := new(MsgSetFundingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/SetFundingPolicy", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDepositToTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveFundingPolicy(ctx context.Context, in *MsgRemoveFundingPolicy, opts ...grpc.CallOption) (*MsgRemoveFundingPolicyResponse, error) {
+	out := new(MsgRemoveFundingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/RemoveFundingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDepositToTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error) {
+	out := new(MsgDepositToTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/DepositToTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdrawFromTunnel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawFromTunnel(ctx context.Context, in *MsgWithdrawFromTunnel, opts ...grpc.CallOption) (*MsgWithdrawFromTunnelResponse, error) {
+	out := new(MsgWithdrawFromTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawFromTunnel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdrawFromTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Withdrawer) > 0 {
-		i -= len(m.Withdrawer)
-		copy(dAtA[i:], m.Withdrawer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Withdrawer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateTunnel is a RPC method to create a new tunnel.
+	CreateTunnel(context.Context, *MsgCreateTunnel) (*MsgCreateTunnelResponse, error)
+	// UpdateRoute is a RPC method to update a route information of the tunnel.
+	UpdateRoute(context.Context, *MsgUpdateRoute) (*MsgUpdateRouteResponse, error)
+	// UpdateSignalsAndInterval is a RPC method to update a signals and interval of the tunnel.
+	UpdateSignalsAndInterval(context.Context, *MsgUpdateSignalsAndInterval) (*MsgUpdateSignalsAndIntervalResponse, error)
+	// WithdrawFeePayerFunds is a RPC method to withdraw fee payer funds to creator.
+	WithdrawFeePayerFunds(context.Context, *MsgWithdrawFeePayerFunds) (*MsgWithdrawFeePayerFundsResponse, error)
+	// ActivateTunnel is a RPC method to activate a tunnel.
+	ActivateTunnel(context.Context, *MsgActivateTunnel) (*MsgActivateTunnelResponse, error)
+	// DeactivateTunnel is a RPC method to deactivate a tunnel.
+	DeactivateTunnel(context.Context, *MsgDeactivateTunnel) (*MsgDeactivateTunnelResponse, error)
+	// TriggerTunnel is a RPC method to manually trigger a tunnel.
+	TriggerTunnel(context.Context, *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error)
+	// RetryPacket is a RPC method to resend a failed packet of the tunnel.
+	RetryPacket(context.Context, *MsgRetryPacket) (*MsgRetryPacketResponse, error)
+	// TransferTunnelOwnership is a RPC method to propose a new creator of the tunnel.
+	TransferTunnelOwnership(context.Context, *MsgTransferTunnelOwnership) (*MsgTransferTunnelOwnershipResponse, error)
+	// AcceptTunnelOwnership is a RPC method for the proposed creator to accept the ownership of the tunnel.
+	AcceptTunnelOwnership(context.Context, *MsgAcceptTunnelOwnership) (*MsgAcceptTunnelOwnershipResponse, error)
+	// UpdateTunnelAdmins is a RPC method to update the co-administrators of the tunnel.
+	UpdateTunnelAdmins(context.Context, *MsgUpdateTunnelAdmins) (*MsgUpdateTunnelAdminsResponse, error)
+	// ApproveFundingSource is a RPC method for the creator to approve the funding account of the tunnel.
+	ApproveFundingSource(context.Context, *MsgApproveFundingSource) (*MsgApproveFundingSourceResponse, error)
+	// SetFundingPolicy is a RPC method to grant an allowance from a funding account to top up the fee payer.
+	SetFundingPolicy(context.Context, *MsgSetFundingPolicy) (*MsgSetFundingPolicyResponse, error)
+	// RemoveFundingPolicy is a RPC method to remove the funding policy of a tunnel.
+	RemoveFundingPolicy(context.Context, *MsgRemoveFundingPolicy) (*MsgRemoveFundingPolicyResponse, error)
+	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
+	DepositToTunnel(context.Context, *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error)
+	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
+	WithdrawFromTunnel(context.Context, *MsgWithdrawFromTunnel) (*MsgWithdrawFromTunnelResponse, error)
+	// UpdateParams is a RPC method to update parameters
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgWithdrawFromTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgWithdrawFromTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) CreateTunnel(ctx context.Context, req *MsgCreateTunnel) (*MsgCreateTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTunnel not implemented")
 }
-
-func (m *MsgWithdrawFromTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) UpdateRoute(ctx context.Context, req *MsgUpdateRoute) (*MsgUpdateRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoute not implemented")
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) UpdateSignalsAndInterval(ctx context.Context, req *MsgUpdateSignalsAndInterval) (*MsgUpdateSignalsAndIntervalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSignalsAndInterval not implemented")
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) WithdrawFeePayerFunds(ctx context.Context, req *MsgWithdrawFeePayerFunds) (*MsgWithdrawFeePayerFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeePayerFunds not implemented")
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) ActivateTunnel(ctx context.Context, req *MsgActivateTunnel) (*MsgActivateTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateTunnel not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) DeactivateTunnel(ctx context.Context, req *MsgDeactivateTunnel) (*MsgDeactivateTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateTunnel not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) TriggerTunnel(ctx context.Context, req *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerTunnel not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) RetryPacket(ctx context.Context, req *MsgRetryPacket) (*MsgRetryPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPacket not implemented")
+}
+func (*UnimplementedMsgServer) TransferTunnelOwnership(ctx context.Context, req *MsgTransferTunnelOwnership) (*MsgTransferTunnelOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTunnelOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptTunnelOwnership(ctx context.Context, req *MsgAcceptTunnelOwnership) (*MsgAcceptTunnelOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTunnelOwnership not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTunnelAdmins(ctx context.Context, req *MsgUpdateTunnelAdmins) (*MsgUpdateTunnelAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTunnelAdmins not implemented")
+}
+func (*UnimplementedMsgServer) ApproveFundingSource(ctx context.Context, req *MsgApproveFundingSource) (*MsgApproveFundingSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFundingSource not implemented")
+}
+func (*UnimplementedMsgServer) SetFundingPolicy(ctx context.Context, req *MsgSetFundingPolicy) (*MsgSetFundingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFundingPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFundingPolicy(ctx context.Context, req *MsgRemoveFundingPolicy) (*MsgRemoveFundingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFundingPolicy not implemented")
+}
+func (*UnimplementedMsgServer) DepositToTunnel(ctx context.Context, req *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositToTunnel not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFromTunnel(ctx context.Context, req *MsgWithdrawFromTunnel) (*MsgWithdrawFromTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromTunnel not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
-func (m *MsgCreateTunnel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SignalDeviations) > 0 {
-		for _, e := range m.SignalDeviations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.Interval != 0 {
-		n += 1 + sovTx(uint64(m.Interval))
+
+func _Msg_CreateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTunnel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Route != nil {
-		l = m.Route.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTunnel(ctx, in)
 	}
-	if len(m.InitialDeposit) > 0 {
-		for _, e := range m.InitialDeposit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/CreateTunnel",
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTunnel(ctx, req.(*MsgCreateTunnel))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRoute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRoute(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRoute(ctx, req.(*MsgUpdateRoute))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRoute) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateSignalsAndInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSignalsAndInterval)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSignalsAndInterval(ctx, in)
 	}
-	if m.Route != nil {
-		l = m.Route.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateSignalsAndInterval",
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSignalsAndInterval(ctx, req.(*MsgUpdateSignalsAndInterval))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRouteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateSignalsAndInterval) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+func _Msg_WithdrawFeePayerFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFeePayerFunds)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SignalDeviations) > 0 {
-		for _, e := range m.SignalDeviations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFeePayerFunds(ctx, in)
 	}
-	if m.Interval != 0 {
-		n += 1 + sovTx(uint64(m.Interval))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/WithdrawFeePayerFunds",
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFeePayerFunds(ctx, req.(*MsgWithdrawFeePayerFunds))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSignalsAndIntervalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ActivateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateTunnel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ActivateTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/ActivateTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ActivateTunnel(ctx, req.(*MsgActivateTunnel))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFeePayerFunds) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeactivateTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateTunnel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+	if interceptor == nil {
+		return srv.(MsgServer).DeactivateTunnel(ctx, in)
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/DeactivateTunnel",
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeactivateTunnel(ctx, req.(*MsgDeactivateTunnel))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFeePayerFundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TriggerTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTriggerTunnel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgActivateTunnel) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).TriggerTunnel(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/TriggerTunnel",
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TriggerTunnel(ctx, req.(*MsgTriggerTunnel))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgActivateTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RetryPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryPacket)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeactivateTunnel) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).RetryPacket(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/RetryPacket",
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryPacket(ctx, req.(*MsgRetryPacket))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeactivateTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferTunnelOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTunnelOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgTriggerTunnel) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTunnelOwnership(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/TransferTunnelOwnership",
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTunnelOwnership(ctx, req.(*MsgTransferTunnelOwnership))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTriggerTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AcceptTunnelOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptTunnelOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptTunnelOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/AcceptTunnelOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptTunnelOwnership(ctx, req.(*MsgAcceptTunnelOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositToTunnel) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateTunnelAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTunnelAdmins)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTunnelAdmins(ctx, in)
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateTunnelAdmins",
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTunnelAdmins(ctx, req.(*MsgUpdateTunnelAdmins))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositToTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ApproveFundingSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveFundingSource)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveFundingSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/ApproveFundingSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveFundingSource(ctx, req.(*MsgApproveFundingSource))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFromTunnel) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetFundingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFundingPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TunnelID != 0 {
-		n += 1 + sovTx(uint64(m.TunnelID))
+	if interceptor == nil {
+		return srv.(MsgServer).SetFundingPolicy(ctx, in)
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/SetFundingPolicy",
 	}
-	l = len(m.Withdrawer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFundingPolicy(ctx, req.(*MsgSetFundingPolicy))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFromTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveFundingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFundingPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFundingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/RemoveFundingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFundingPolicy(ctx, req.(*MsgRemoveFundingPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DepositToTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositToTunnel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositToTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/DepositToTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositToTunnel(ctx, req.(*MsgDepositToTunnel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFromTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromTunnel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromTunnel(ctx, req.(*MsgWithdrawFromTunnel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.tunnel.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTunnel",
+			Handler:    _Msg_CreateTunnel_Handler,
+		},
+		{
+			MethodName: "UpdateRoute",
+			Handler:    _Msg_UpdateRoute_Handler,
+		},
+		{
+			MethodName: "UpdateSignalsAndInterval",
+			Handler:    _Msg_UpdateSignalsAndInterval_Handler,
+		},
+		{
+			MethodName: "WithdrawFeePayerFunds",
+			Handler:    _Msg_WithdrawFeePayerFunds_Handler,
+		},
+		{
+			MethodName: "ActivateTunnel",
+			Handler:    _Msg_ActivateTunnel_Handler,
+		},
+		{
+			MethodName: "DeactivateTunnel",
+			Handler:    _Msg_DeactivateTunnel_Handler,
+		},
+		{
+			MethodName: "TriggerTunnel",
+			Handler:    _Msg_TriggerTunnel_Handler,
+		},
+		{
+			MethodName: "RetryPacket",
+			Handler:    _Msg_RetryPacket_Handler,
+		},
+		{
+			MethodName: "TransferTunnelOwnership",
+			Handler:    _Msg_TransferTunnelOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptTunnelOwnership",
+			Handler:    _Msg_AcceptTunnelOwnership_Handler,
+		},
+		{
+			MethodName: "UpdateTunnelAdmins",
+			Handler:    _Msg_UpdateTunnelAdmins_Handler,
+		},
+		{
+			MethodName: "ApproveFundingSource",
+			Handler:    _Msg_ApproveFundingSource_Handler,
+		},
+		{
+			MethodName: "SetFundingPolicy",
+			Handler:    _Msg_SetFundingPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveFundingPolicy",
+			Handler:    _Msg_RemoveFundingPolicy_Handler,
+		},
+		{
+			MethodName: "DepositToTunnel",
+			Handler:    _Msg_DepositToTunnel_Handler,
+		},
+		{
+			MethodName: "WithdrawFromTunnel",
+			Handler:    _Msg_WithdrawFromTunnel_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/tunnel/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.IncludePriceConfidence {
+		i--
+		if m.IncludePriceConfidence {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.DerivedSignals) > 0 {
+		for iNdEx := len(m.DerivedSignals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedSignals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.InitialDeposit) > 0 {
+		for iNdEx := len(m.InitialDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalDeviations) > 0 {
+		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgUpdateRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSignalsAndInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSignalsAndInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSignalsAndInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludePriceConfidence {
+		i--
+		if m.IncludePriceConfidence {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DerivedSignals) > 0 {
+		for iNdEx := len(m.DerivedSignals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedSignals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignalDeviations) > 0 {
+		for iNdEx := len(m.SignalDeviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSignalsAndIntervalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSignalsAndIntervalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSignalsAndIntervalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFeePayerFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeePayerFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeePayerFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFeePayerFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeePayerFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeePayerFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTriggerTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTriggerTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTriggerTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTriggerTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTriggerTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTriggerTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTunnelOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTunnelOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTunnelOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewCreator) > 0 {
+		i -= len(m.NewCreator)
+		copy(dAtA[i:], m.NewCreator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewCreator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTunnelOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTunnelOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTunnelOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptTunnelOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptTunnelOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptTunnelOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewCreator) > 0 {
+		i -= len(m.NewCreator)
+		copy(dAtA[i:], m.NewCreator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewCreator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptTunnelOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptTunnelOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptTunnelOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTunnelAdmins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTunnelAdmins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTunnelAdmins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Admins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTunnelAdminsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTunnelAdminsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTunnelAdminsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveFundingSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveFundingSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveFundingSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveFundingSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveFundingSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveFundingSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFundingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFundingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFundingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TopUpAmount) > 0 {
+		for iNdEx := len(m.TopUpAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopUpAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Threshold) > 0 {
+		for iNdEx := len(m.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Allowance) > 0 {
+		for iNdEx := len(m.Allowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFundingPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFundingPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFundingPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFundingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFundingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFundingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFundingPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFundingPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFundingPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Withdrawer) > 0 {
+		i -= len(m.Withdrawer)
+		copy(dAtA[i:], m.Withdrawer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Withdrawer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalDeviations) > 0 {
+		for _, e := range m.SignalDeviations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DerivedSignals) > 0 {
+		for _, e := range m.DerivedSignals {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.IncludePriceConfidence {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	return n
+}
+
+func (m *MsgUpdateRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSignalsAndInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.SignalDeviations) > 0 {
+		for _, e := range m.SignalDeviations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DerivedSignals) > 0 {
+		for _, e := range m.DerivedSignals {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.IncludePriceConfidence {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateSignalsAndIntervalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFeePayerFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFeePayerFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivateTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgActivateTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeactivateTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeactivateTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTriggerTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTriggerTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRetryPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferTunnelOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.NewCreator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferTunnelOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptTunnelOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.NewCreator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptTunnelOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTunnelAdmins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.Admins) > 0 {
+		for _, e := range m.Admins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTunnelAdminsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveFundingSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveFundingSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFundingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.Allowance) > 0 {
+		for _, e := range m.Allowance {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Threshold) > 0 {
+		for _, e := range m.Threshold {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopUpAmount) > 0 {
+		for _, e := range m.TopUpAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFundingPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFundingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFundingPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositToTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositToTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFromTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Withdrawer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFromTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTunnel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
+			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &types.Any{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedSignals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedSignals = append(m.DerivedSignals, DerivedSignal{})
+			if err := m.DerivedSignals[len(m.DerivedSignals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePriceConfidence", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePriceConfidence = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTunnelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTunnelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &types.Any{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSignalsAndInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSignalsAndInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
+			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedSignals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedSignals = append(m.DerivedSignals, DerivedSignal{})
+			if err := m.DerivedSignals[len(m.DerivedSignals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePriceConfidence", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePriceConfidence = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSignalsAndIntervalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSignalsAndIntervalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSignalsAndIntervalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFeePayerFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFeePayerFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFeePayerFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFeePayerFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFeePayerFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateTunnel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateTunnel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateTunnelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateTunnelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateTunnel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
+func (m *MsgDeactivateTunnelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2608,51 +5785,67 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTunnel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeactivateTunnelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeactivateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTriggerTunnel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
-			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTriggerTunnel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTriggerTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
 			}
-			m.Interval = 0
+			m.TunnelID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2662,16 +5855,16 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= uint64(b&0x7F) << shift
+				m.TunnelID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2681,33 +5874,129 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Route == nil {
-				m.Route = &types.Any{}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTriggerTunnelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTriggerTunnelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTriggerTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2717,29 +6006,33 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TunnelID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
-			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2767,7 +6060,7 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2790,7 +6083,7 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRetryPacketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2813,17 +6106,37 @@ func (m *MsgCreateTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetryPacketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.TunnelID = 0
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2833,11 +6146,24 @@ func (m *MsgCreateTunnelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TunnelID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2859,7 +6185,7 @@ func (m *MsgCreateTunnelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferTunnelOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2882,10 +6208,10 @@ func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferTunnelOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferTunnelOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2909,9 +6235,9 @@ func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCreator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2921,27 +6247,23 @@ func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Route == nil {
-				m.Route = &types.Any{}
-			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewCreator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -2996,7 +6318,7 @@ func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRouteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferTunnelOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3019,10 +6341,10 @@ func (m *MsgUpdateRouteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRouteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferTunnelOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferTunnelOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3046,7 +6368,7 @@ func (m *MsgUpdateRouteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptTunnelOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3069,10 +6391,10 @@ func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSignalsAndInterval: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptTunnelOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSignalsAndInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptTunnelOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3096,60 +6418,7 @@ func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
-			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
-			}
-			m.Interval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Interval |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCreator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3177,7 +6446,7 @@ func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.NewCreator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3200,7 +6469,7 @@ func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSignalsAndIntervalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptTunnelOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3223,10 +6492,10 @@ func (m *MsgUpdateSignalsAndIntervalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSignalsAndIntervalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptTunnelOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSignalsAndIntervalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptTunnelOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3250,7 +6519,7 @@ func (m *MsgUpdateSignalsAndIntervalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTunnelAdmins) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3273,10 +6542,10 @@ func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFeePayerFunds: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTunnelAdmins: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFeePayerFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTunnelAdmins: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3300,7 +6569,7 @@ func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3327,8 +6596,8 @@ func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Admins = append(m.Admins, TunnelAdmin{})
+			if err := m.Admins[len(m.Admins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3385,7 +6654,7 @@ func (m *MsgWithdrawFeePayerFunds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFeePayerFundsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTunnelAdminsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3408,10 +6677,10 @@ func (m *MsgWithdrawFeePayerFundsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFeePayerFundsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTunnelAdminsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFeePayerFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTunnelAdminsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3435,7 +6704,7 @@ func (m *MsgWithdrawFeePayerFundsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateTunnel) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveFundingSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3458,10 +6727,10 @@ func (m *MsgActivateTunnel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateTunnel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveFundingSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveFundingSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3484,6 +6753,38 @@ func (m *MsgActivateTunnel) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
@@ -3536,7 +6837,7 @@ func (m *MsgActivateTunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveFundingSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3559,10 +6860,10 @@ func (m *MsgActivateTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveFundingSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveFundingSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3586,7 +6887,7 @@ func (m *MsgActivateTunnelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFundingPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3609,10 +6910,10 @@ func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateTunnel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFundingPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFundingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3636,7 +6937,109 @@ func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowance = append(m.Allowance, types1.Coin{})
+			if err := m.Allowance[len(m.Allowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = append(m.Threshold, types1.Coin{})
+			if err := m.Threshold[len(m.Threshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpAmount = append(m.TopUpAmount, types1.Coin{})
+			if err := m.TopUpAmount[len(m.TopUpAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3664,7 +7067,7 @@ func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3687,7 +7090,7 @@ func (m *MsgDeactivateTunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFundingPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3710,10 +7113,10 @@ func (m *MsgDeactivateTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFundingPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFundingPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3737,7 +7140,7 @@ func (m *MsgDeactivateTunnelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTriggerTunnel) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFundingPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3760,10 +7163,10 @@ func (m *MsgTriggerTunnel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTriggerTunnel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFundingPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTriggerTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFundingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3787,7 +7190,7 @@ func (m *MsgTriggerTunnel) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3815,7 +7218,7 @@ func (m *MsgTriggerTunnel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3838,7 +7241,7 @@ func (m *MsgTriggerTunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTriggerTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFundingPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3861,10 +7264,10 @@ func (m *MsgTriggerTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTriggerTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFundingPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTriggerTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFundingPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
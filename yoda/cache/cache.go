@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/bandprotocol/chain/v3/yoda/executor"
+)
+
+// Key identifies the execution of a data source with a calldata.
+type Key struct {
+	DataSourceHash string
+	Calldata       string
+}
+
+// String returns the string representation of the key, used to deduplicate in-flight executions.
+func (k Key) String() string {
+	return k.DataSourceHash + "/" + k.Calldata
+}
+
+// requestEnvPrefix is the prefix of the environment variables that are specific to each request.
+var requestEnvPrefix = []byte("BAND_")
+
+// ReadsRequestEnv returns whether the given script may read the request-specific BAND_* environment
+// variables, in which case its results must not be reused across requests.
+func ReadsRequestEnv(script []byte) bool {
+	return bytes.Contains(script, requestEnvPrefix)
+}
+
+// entry is a cached execution result with the time it expires.
+type entry struct {
+	result    executor.ExecResult
+	expiresAt time.Time
+}
+
+// ResultCache keeps the successful execution results of data sources for a short TTL and deduplicates
+// concurrent executions of the same data source with the same calldata.
+type ResultCache struct {
+	ttl   time.Duration
+	group singleflight.Group
+
+	mu        sync.Mutex
+	entries   map[Key]entry
+	lastSweep time.Time
+}
+
+// NewResultCache creates a new ResultCache instance with the given TTL.
+func NewResultCache(ttl time.Duration) *ResultCache {
+	return &ResultCache{
+		ttl:       ttl,
+		entries:   make(map[Key]entry),
+		lastSweep: time.Now(),
+	}
+}
+
+// Do returns the cached result of the key if it has not expired. Otherwise, it calls exec, or waits
+// for the in-flight call of the same key, and caches its result if the execution succeeds with a
+// zero exit code. It also returns whether the result is reused from the cache or another call.
+func (c *ResultCache) Do(key Key, exec func() (executor.ExecResult, error)) (executor.ExecResult, bool, error) {
+	if result, ok := c.get(key); ok {
+		return result, true, nil
+	}
+
+	executed := false
+	v, err, _ := c.group.Do(key.String(), func() (interface{}, error) {
+		executed = true
+		result, err := exec()
+		if err == nil && result.Code == 0 {
+			c.set(key, result)
+		}
+		return result, err
+	})
+
+	return v.(executor.ExecResult), !executed, err
+}
+
+// get returns the cached result of the key if it has not expired.
+func (c *ResultCache) get(key Key) (executor.ExecResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return executor.ExecResult{}, false
+	}
+	if !time.Now().Before(e.expiresAt) {
+		delete(c.entries, key)
+		return executor.ExecResult{}, false
+	}
+
+	return e.result, true
+}
+
+// set caches the result of the key, and removes the expired entries at most once per TTL.
+func (c *ResultCache) set(key Key, result executor.ExecResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastSweep) >= c.ttl {
+		for k, e := range c.entries {
+			if !now.Before(e.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	c.entries[key] = entry{result: result, expiresAt: now.Add(c.ttl)}
+}
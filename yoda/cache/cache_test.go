@@ -0,0 +1,123 @@
+package cache_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/yoda/cache"
+	"github.com/bandprotocol/chain/v3/yoda/executor"
+)
+
+func TestResultCacheHit(t *testing.T) {
+	c := cache.NewResultCache(time.Minute)
+	key := cache.Key{DataSourceHash: "hash", Calldata: "BTC"}
+	called := 0
+	exec := func() (executor.ExecResult, error) {
+		called++
+		return executor.ExecResult{Output: []byte("1"), Code: 0, Version: "v1"}, nil
+	}
+
+	res, hit, err := c.Do(key, exec)
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Equal(t, executor.ExecResult{Output: []byte("1"), Code: 0, Version: "v1"}, res)
+
+	res, hit, err = c.Do(key, exec)
+	require.NoError(t, err)
+	require.True(t, hit)
+	require.Equal(t, executor.ExecResult{Output: []byte("1"), Code: 0, Version: "v1"}, res)
+	require.Equal(t, 1, called)
+
+	// Different calldata must be executed separately.
+	_, hit, err = c.Do(cache.Key{DataSourceHash: "hash", Calldata: "ETH"}, exec)
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Equal(t, 2, called)
+}
+
+func TestResultCacheExpired(t *testing.T) {
+	c := cache.NewResultCache(10 * time.Millisecond)
+	key := cache.Key{DataSourceHash: "hash", Calldata: "BTC"}
+	called := 0
+	exec := func() (executor.ExecResult, error) {
+		called++
+		return executor.ExecResult{Output: []byte("1")}, nil
+	}
+
+	_, _, err := c.Do(key, exec)
+	require.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+
+	_, hit, err := c.Do(key, exec)
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Equal(t, 2, called)
+}
+
+func TestResultCacheSkipFailure(t *testing.T) {
+	c := cache.NewResultCache(time.Minute)
+	key := cache.Key{DataSourceHash: "hash", Calldata: "BTC"}
+
+	_, _, err := c.Do(key, func() (executor.ExecResult, error) {
+		return executor.ExecResult{}, errors.New("failed")
+	})
+	require.EqualError(t, err, "failed")
+
+	res, hit, err := c.Do(key, func() (executor.ExecResult, error) {
+		return executor.ExecResult{Output: []byte("error"), Code: 1}, nil
+	})
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Equal(t, uint32(1), res.Code)
+
+	res, hit, err = c.Do(key, func() (executor.ExecResult, error) {
+		return executor.ExecResult{Output: []byte("1")}, nil
+	})
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Equal(t, []byte("1"), res.Output)
+}
+
+func TestResultCacheDeduplicate(t *testing.T) {
+	c := cache.NewResultCache(time.Minute)
+	key := cache.Key{DataSourceHash: "hash", Calldata: "BTC"}
+	release := make(chan struct{})
+	var called int64
+	exec := func() (executor.ExecResult, error) {
+		atomic.AddInt64(&called, 1)
+		<-release
+		return executor.ExecResult{Output: []byte("1")}, nil
+	}
+
+	var wg sync.WaitGroup
+	var hits int64
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, hit, err := c.Do(key, exec)
+			require.NoError(t, err)
+			require.Equal(t, []byte("1"), res.Output)
+			if hit {
+				atomic.AddInt64(&hits, 1)
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int64(1), atomic.LoadInt64(&called))
+	require.Equal(t, int64(4), atomic.LoadInt64(&hits))
+}
+
+func TestReadsRequestEnv(t *testing.T) {
+	require.False(t, cache.ReadsRequestEnv([]byte("#!/usr/bin/env python3\nprint(1)\n")))
+	require.True(t, cache.ReadsRequestEnv([]byte("#!/bin/sh\necho $BAND_REQUEST_ID\n")))
+	require.True(t, cache.ReadsRequestEnv([]byte("import os\nprint(os.environ['BAND_VALIDATOR'])\n")))
+}
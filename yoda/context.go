@@ -12,7 +12,9 @@ import (
 	"github.com/bandprotocol/chain/v3/app/params"
 	"github.com/bandprotocol/chain/v3/pkg/filecache"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/cache"
 	"github.com/bandprotocol/chain/v3/yoda/executor"
+	"github.com/bandprotocol/chain/v3/yoda/journal"
 )
 
 type FeeEstimationData struct {
@@ -38,6 +40,8 @@ type Context struct {
 	keys             []*keyring.Record
 	executor         executor.Executor
 	fileCache        filecache.Cache
+	journal          *journal.Journal
+	resultCache      *cache.ResultCache // nil if result caching is disabled
 	broadcastTimeout time.Duration
 	maxTry           uint64
 	rpcPollInterval  time.Duration
@@ -54,6 +58,8 @@ type Context struct {
 	pendingGauge   int64
 	errorCount     int64
 	submittedCount int64
+	cacheHitCount  int64
+	cacheMissCount int64
 	home           string
 }
 
@@ -85,3 +91,15 @@ func (c *Context) updateSubmittedCount(amount int64) {
 		atomic.AddInt64(&c.submittedCount, amount)
 	}
 }
+
+func (c *Context) updateCacheHitCount(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.cacheHitCount, amount)
+	}
+}
+
+func (c *Context) updateCacheMissCount(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.cacheMissCount, amount)
+	}
+}
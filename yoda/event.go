@@ -13,6 +13,7 @@ type rawRequest struct {
 	dataSourceHash string
 	externalID     types.ExternalID
 	calldata       string
+	cacheable      bool
 }
 
 // GetEventValues returns the list of all values in the given log with the given type and key.
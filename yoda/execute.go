@@ -153,6 +153,11 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 			}
 			// Transaction passed CheckTx process and wait to include in block.
 			txHash = hash
+			for _, id := range ids {
+				if err := c.journal.AddTxHash(id, txHash); err != nil {
+					l.Error(":exploding_head: Failed to save tx hash to journal with error: %s", c, err.Error())
+				}
+			}
 			break
 		}
 		if txHash == "" {
@@ -172,6 +177,11 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 			if txRes.Code == 0 {
 				l.Info(":smiling_face_with_sunglasses: Successfully broadcast tx with hash: %s", txHash)
 				c.updateSubmittedCount(int64(len(reports)))
+				for _, id := range ids {
+					if err := c.journal.DeleteEntry(id); err != nil {
+						l.Error(":exploding_head: Failed to delete journal entry with error: %s", c, err.Error())
+					}
+				}
 				return
 			}
 			if txRes.Codespace == sdkerrors.RootCodespace &&
@@ -227,18 +237,44 @@ func GetExecutable(c *Context, l *Logger, hash string) ([]byte, error) {
 	return resValue, nil
 }
 
-// GetDataSourceHash fetches data source hash by id
-func GetDataSourceHash(c *Context, l *Logger, id types.DataSourceID) (string, error) {
+// GetDataSource fetches data source by id
+func GetDataSource(c *Context, l *Logger, id types.DataSourceID) (types.DataSource, error) {
 	res, err := abciQuery(c, l, fmt.Sprintf("/store/%s/key", types.StoreKey), types.DataSourceStoreKey(id))
 	if err != nil {
 		l.Error(":skull: Failed to get data source with error: %s", c, err.Error())
-		return "", err
+		return types.DataSource{}, err
 	}
 
 	var d types.DataSource
 	c.encodingConfig.Codec.MustUnmarshal(res.Response.Value, &d)
 
-	return d.Filename, nil
+	return d, nil
+}
+
+// GetDataSourceVersion fetches the given version of data source by id
+func GetDataSourceVersion(
+	c *Context,
+	l *Logger,
+	id types.DataSourceID,
+	version uint64,
+) (types.DataSourceVersion, error) {
+	res, err := abciQuery(
+		c, l, fmt.Sprintf("/store/%s/key", types.StoreKey), types.DataSourceVersionStoreKey(id, version),
+	)
+	if err != nil {
+		l.Error(":skull: Failed to get data source version with error: %s", c, err.Error())
+		return types.DataSourceVersion{}, err
+	}
+	if len(res.Response.Value) == 0 {
+		err := types.ErrDataSourceVersionNotFound.Wrapf("id: %d, version: %d", id, version)
+		l.Error(":skull: Failed to get data source version with error: %s", c, err.Error())
+		return types.DataSourceVersion{}, err
+	}
+
+	var d types.DataSourceVersion
+	c.encodingConfig.Codec.MustUnmarshal(res.Response.Value, &d)
+
+	return d, nil
 }
 
 // GetRequest fetches request by id
@@ -255,6 +291,19 @@ func GetRequest(c *Context, l *Logger, id types.RequestID) (types.Request, error
 	return r, nil
 }
 
+// HasReport returns whether the validator has reported the request
+func HasReport(c *Context, l *Logger, id types.RequestID) (bool, error) {
+	res, err := abciQuery(
+		c, l, fmt.Sprintf("/store/%s/key", types.StoreKey), types.ReportsOfValidatorPrefixKey(id, c.validator),
+	)
+	if err != nil {
+		l.Error(":skull: Failed to get report with error: %s", c, err.Error())
+		return false, err
+	}
+
+	return len(res.Response.Value) != 0, nil
+}
+
 // abciQuery will try to query data from BandChain node maxTry time before give up and return error
 func abciQuery(c *Context, l *Logger, path string, data []byte) (*ctypes.ResultABCIQuery, error) {
 	var lastErr error
@@ -40,6 +40,8 @@ func NewExecutor(executor string) (exec Executor, err error) {
 	switch name {
 	case "rest":
 		exec = NewRestExec(base, timeout)
+	case "local":
+		exec = NewLocalExec(base, timeout)
 	case "docker":
 		return nil, fmt.Errorf("docker executor is currently not supported")
 	default:
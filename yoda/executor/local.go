@@ -0,0 +1,282 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/shlex"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const (
+	// LocalExecVersion is the version reported by LocalExec for every execution.
+	LocalExecVersion = "local:1.0.0"
+
+	// sandboxInitArg is the first argument given to the current executable to make it run as
+	// the sandbox init process (see InitSandbox) instead of its normal entrypoint.
+	sandboxInitArg = "__yoda_sandbox_init"
+	// sandboxMappedArg replaces sandboxInitArg when the sandbox init process executes itself again
+	// once its user namespace is mapped.
+	sandboxMappedArg = "__yoda_sandbox_mapped"
+	// sandboxSetupFailedCode is the exit code of the sandbox init process when it fails to set up
+	// the sandbox before running the script.
+	sandboxSetupFailedCode = 125
+	// sandboxErrorFd is the file descriptor on which the sandbox init process reports setup errors.
+	sandboxErrorFd = 3
+	// sandboxSyncFd is the file descriptor from which the sandbox init process reads a byte once
+	// its user namespace is mapped.
+	sandboxSyncFd = 4
+	// sandboxScriptPath is the path of the data source script in the sandbox.
+	sandboxScriptPath = "/exec"
+	// sandboxHome is the home directory of the data source script in the sandbox.
+	sandboxHome = "/tmp"
+	// sandboxPath is the PATH of the data source script, in which the interpreters of the scripts
+	// run with env are looked up.
+	sandboxPath = "/usr/local/bin:/usr/bin:/bin"
+	// envPrefix is the prefix of the environment variables passed to the data source script.
+	envPrefix = "BAND_"
+)
+
+// LocalExec is an executor that runs data source scripts as child processes of yoda, isolated
+// with Linux namespaces, a minimal root filesystem, resource limits and a seccomp filter.
+type LocalExec struct {
+	dir     string
+	timeout time.Duration
+}
+
+// NewLocalExec creates a new LocalExec instance. The scripts are written to temporary directories
+// under dir, or under the default temporary directory if dir is empty.
+func NewLocalExec(dir string, timeout time.Duration) *LocalExec {
+	return &LocalExec{dir: dir, timeout: timeout}
+}
+
+// Exec implements Executor interface for LocalExec.
+func (e *LocalExec) Exec(code []byte, arg string, env interface{}) (ExecResult, error) {
+	args, err := shlex.Split(arg)
+	if err != nil {
+		return ExecResult{}, err
+	}
+	self, err := os.Executable()
+	if err != nil {
+		return ExecResult{}, err
+	}
+	sysProcAttr, err := sandboxSysProcAttr()
+	if err != nil {
+		return ExecResult{}, err
+	}
+
+	dir, err := os.MkdirTemp(e.dir, "executor")
+	if err != nil {
+		return ExecResult{}, err
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "root"), 0o755); err != nil {
+		return ExecResult{}, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "exec"), code, 0o755); err != nil {
+		return ExecResult{}, err
+	}
+
+	interpreters, err := scriptInterpreters(code)
+	if err != nil {
+		return ExecResult{}, err
+	}
+	envs, err := localEnvs(env)
+	if err != nil {
+		return ExecResult{}, err
+	}
+
+	errReader, errWriter, err := os.Pipe()
+	if err != nil {
+		return ExecResult{}, err
+	}
+	defer errReader.Close()
+	syncReader, syncWriter, err := os.Pipe()
+	if err != nil {
+		errWriter.Close()
+		return ExecResult{}, err
+	}
+	defer syncWriter.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+	cmdArgs := append([]string{
+		sandboxInitArg,
+		strconv.FormatInt(cpuLimitSeconds(e.timeout), 10),
+		dir,
+		strings.Join(interpreters, string(filepath.ListSeparator)),
+	}, args...)
+	cmd := exec.CommandContext(ctx, self, cmdArgs...)
+	cmd.Dir = dir
+	cmd.Env = envs
+	cmd.SysProcAttr = sysProcAttr
+	cmd.ExtraFiles = []*os.File{errWriter, syncReader}
+	// The script is the init process of its PID namespace, so killing it kills all of its
+	// descendants. WaitDelay only guards against the output pipes being held open.
+	cmd.WaitDelay = time.Second
+	stdout := &limitedBuffer{limit: int(types.DefaultMaxReportDataSize)}
+	stderr := &limitedBuffer{limit: int(types.DefaultMaxReportDataSize)}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Start()
+	errWriter.Close()
+	syncReader.Close()
+	if err != nil {
+		return ExecResult{}, fmt.Errorf("failed to start sandbox: %w", err)
+	}
+	if err := setupSandboxIDMap(cmd.Process.Pid); err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return ExecResult{}, fmt.Errorf("failed to map sandbox user: %w", err)
+	}
+	if _, err := syncWriter.Write([]byte{0}); err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return ExecResult{}, fmt.Errorf("failed to start sandbox: %w", err)
+	}
+	err = cmd.Wait()
+	if ctx.Err() == context.DeadlineExceeded {
+		return ExecResult{}, ErrExecutionimeout
+	}
+
+	exitCode := uint32(0)
+	if err != nil {
+		var exitError *exec.ExitError
+		if !errors.As(err, &exitError) {
+			return ExecResult{}, err
+		}
+		exitCode = exitStatusCode(exitError)
+	}
+
+	if exitCode == sandboxSetupFailedCode {
+		setupErr, err := io.ReadAll(io.LimitReader(errReader, 4096))
+		if err != nil {
+			return ExecResult{}, err
+		}
+		if len(setupErr) != 0 {
+			return ExecResult{}, fmt.Errorf("failed to set up sandbox: %s", setupErr)
+		}
+	}
+
+	if exitCode == 0 {
+		return ExecResult{Output: stdout.Bytes(), Code: 0, Version: LocalExecVersion}, nil
+	}
+	return ExecResult{Output: stderr.Bytes(), Code: exitCode, Version: LocalExecVersion}, nil
+}
+
+// localEnvs returns the environment of the data source script. Only the variables with the
+// BAND_ prefix are taken from env, along with the PATH and HOME of the sandbox.
+func localEnvs(env interface{}) ([]string, error) {
+	envs := []string{"PATH=" + sandboxPath, "HOME=" + sandboxHome}
+
+	if env == nil {
+		return envs, nil
+	}
+	envMap, ok := env.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unsupported env type: %T", env)
+	}
+
+	keys := make([]string, 0, len(envMap))
+	for key := range envMap {
+		if strings.HasPrefix(key, envPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		envs = append(envs, fmt.Sprintf("%s=%v", key, envMap[key]))
+	}
+
+	return envs, nil
+}
+
+// scriptInterpreters returns the host paths of the interpreters of the script given by its
+// interpreter directive (#!). If the interpreter is env, the program it runs is looked up in the
+// PATH of the sandbox and returned as well. Only these interpreters are mounted in the sandbox.
+func scriptInterpreters(code []byte) ([]string, error) {
+	line, _, _ := bytes.Cut(code, []byte("\n"))
+	if !bytes.HasPrefix(line, []byte("#!")) {
+		return nil, fmt.Errorf("script has no interpreter directive")
+	}
+	fields := strings.Fields(string(line[2:]))
+	if len(fields) == 0 || !filepath.IsAbs(fields[0]) {
+		return nil, fmt.Errorf("invalid interpreter directive: %s", line)
+	}
+
+	interpreters := []string{filepath.Clean(fields[0])}
+	if filepath.Base(fields[0]) != "env" {
+		return interpreters, nil
+	}
+
+	for _, program := range fields[1:] {
+		if strings.HasPrefix(program, "-") || strings.Contains(program, "=") {
+			continue
+		}
+		for _, dir := range filepath.SplitList(sandboxPath) {
+			path := filepath.Join(dir, program)
+			if info, err := os.Stat(path); err == nil && !info.IsDir() && info.Mode()&0o111 != 0 {
+				return append(interpreters, path), nil
+			}
+		}
+		return nil, fmt.Errorf("interpreter %s is not found in %s", program, sandboxPath)
+	}
+
+	return interpreters, nil
+}
+
+// cpuLimitSeconds returns the CPU time limit of the script, which is the timeout rounded up to
+// the next second.
+func cpuLimitSeconds(timeout time.Duration) int64 {
+	seconds := int64((timeout + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
+
+// exitStatusCode returns the exit code of the process, or 128 plus the signal number if the
+// process is killed by a signal (e.g. when it exceeds its CPU time limit).
+func exitStatusCode(exitError *exec.ExitError) uint32 {
+	if status, ok := exitError.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return uint32(128 + status.Signal())
+	}
+	return uint32(exitError.ExitCode())
+}
+
+// limitedBuffer is an io.Writer that keeps only the first limit bytes written to it. The rest
+// are discarded so that the process writing to it is never blocked.
+type limitedBuffer struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+// Write implements io.Writer interface for limitedBuffer.
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.buf.Len(); remaining > 0 {
+		if len(p) > remaining {
+			b.buf.Write(p[:remaining])
+		} else {
+			b.buf.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+// Bytes returns the bytes kept in the buffer.
+func (b *limitedBuffer) Bytes() []byte {
+	return b.buf.Bytes()
+}
@@ -0,0 +1,202 @@
+package executor
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func TestMain(m *testing.M) {
+	// The local executor runs the test binary as the sandbox init process.
+	InitSandbox()
+	os.Exit(m.Run())
+}
+
+// newTestLocalExec returns a LocalExec, or skips the test if the sandbox cannot run here.
+func newTestLocalExec(t *testing.T, timeout time.Duration) *LocalExec {
+	useTestSubordinateIDs(t)
+
+	e := NewLocalExec("", timeout)
+	res, err := e.Exec([]byte("#!/usr/bin/env python3\nprint('ok')"), "", nil)
+	if err != nil || res.Code != 0 {
+		t.Skipf("local executor is not available: %v %s", err, res.Output)
+	}
+	return e
+}
+
+// useTestSubordinateIDs gives the current user a subordinate ID range if the tests run as root,
+// which can map any ID without an entry in the subordinate ID files of the host.
+func useTestSubordinateIDs(t *testing.T) {
+	if os.Geteuid() != 0 {
+		return
+	}
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	file := filepath.Join(t.TempDir(), "subid")
+	require.NoError(t, os.WriteFile(file, []byte(fmt.Sprintf("%s:100000:65536\n", u.Username)), 0o644))
+
+	uidFile, gidFile := subUIDFile, subGIDFile
+	subUIDFile, subGIDFile = file, file
+	t.Cleanup(func() {
+		subUIDFile, subGIDFile = uidFile, gidFile
+	})
+}
+
+func TestLocalExecSuccess(t *testing.T) {
+	newTestLocalExec(t, 10*time.Second)
+
+	exec, err := NewExecutor("local:?timeout=10s")
+	require.NoError(t, err)
+
+	res, err := exec.Exec([]byte(`#!/usr/bin/env python3
+import os
+import sys
+print(sys.argv[1], sys.argv[2], os.getenv("BAND_REQUEST_ID"), os.getenv("SECRET"), os.getpid())`),
+		"ETH 'BTC USD'",
+		map[string]interface{}{"BAND_REQUEST_ID": 1, "SECRET": "secret"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("ETH BTC USD 1 None 1\n"), Code: 0, Version: LocalExecVersion}, res)
+}
+
+func TestLocalExecNonZeroExit(t *testing.T) {
+	e := newTestLocalExec(t, 10*time.Second)
+
+	res, err := e.Exec([]byte(`#!/usr/bin/env python3
+import sys
+print("output")
+print("error", file=sys.stderr)
+sys.exit(3)`), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("error\n"), Code: 3, Version: LocalExecVersion}, res)
+}
+
+func TestLocalExecTimeout(t *testing.T) {
+	e := newTestLocalExec(t, 2*time.Second)
+	e.timeout = 500 * time.Millisecond
+
+	_, err := e.Exec([]byte(`#!/usr/bin/env python3
+import time
+time.sleep(10)`), "", nil)
+	require.ErrorIs(t, err, ErrExecutionimeout)
+}
+
+func TestLocalExecLongStdout(t *testing.T) {
+	e := newTestLocalExec(t, 10*time.Second)
+
+	res, err := e.Exec([]byte(`#!/usr/bin/env python3
+print("A" * 1000000)`), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, strings.Repeat("A", int(types.DefaultMaxReportDataSize)), string(res.Output))
+}
+
+func TestLocalExecDeniedSyscall(t *testing.T) {
+	e := newTestLocalExec(t, 10*time.Second)
+
+	res, err := e.Exec([]byte(`#!/usr/bin/env python3
+import ctypes
+import os
+import platform
+SYS_UNSHARE = {"x86_64": 272, "aarch64": 97}[platform.machine()]
+libc = ctypes.CDLL(None, use_errno=True)
+print(libc.syscall(SYS_UNSHARE, 0), os.strerror(ctypes.get_errno()))`), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, "-1 Operation not permitted\n", string(res.Output))
+}
+
+func TestLocalExecIsolatedFilesystem(t *testing.T) {
+	e := newTestLocalExec(t, 10*time.Second)
+
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+	secret := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secret, []byte("secret"), 0o644))
+
+	res, err := e.Exec([]byte(`#!/usr/bin/env python3
+import os
+import sys
+for path in sys.argv[1:]:
+    print(os.path.exists(path))
+print(os.getenv("HOME"), os.getuid(), os.getgid())
+try:
+    open("/exec", "w")
+except OSError as e:
+    print(e.strerror)`), fmt.Sprintf("%q %q", home, secret), nil)
+	require.NoError(t, err)
+	require.Equal(t, "False\nFalse\n/tmp 65534 65534\nRead-only file system\n", string(res.Output))
+}
+
+func TestLocalExecInvalidScript(t *testing.T) {
+	e := newTestLocalExec(t, 10*time.Second)
+
+	_, err := e.Exec([]byte("not a script"), "", nil)
+	require.EqualError(t, err, "script has no interpreter directive")
+
+	_, err = e.Exec([]byte("#!/usr/bin/env not-an-interpreter"), "", nil)
+	require.EqualError(t, err, "interpreter not-an-interpreter is not found in /usr/local/bin:/usr/bin:/bin")
+
+	_, err = e.Exec([]byte("#!/nonexistent\n"), "", nil)
+	require.ErrorContains(t, err, "failed to set up sandbox: lstat /nonexistent: no such file or directory")
+}
+
+func TestLocalExecInMultiExec(t *testing.T) {
+	local := NewLocalExec("/nonexistent", 10*time.Second)
+	fallback := newMockExec([]byte("output"), 0, nil)
+	exec, err := NewMultiExec([]Executor{local, fallback}, "order")
+	require.NoError(t, err)
+
+	res, err := exec.Exec([]byte("#!/bin/sh\necho local"), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output"), Code: 0}, res)
+	require.Equal(t, 1, fallback.called)
+
+	_, err = local.Exec(nil, "", nil)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLocalEnvs(t *testing.T) {
+	t.Setenv("PATH", "/bin")
+
+	envs, err := localEnvs(map[string]interface{}{
+		"BAND_VALIDATOR":  "validator",
+		"BAND_CHAIN_ID":   "chain-id",
+		"LD_PRELOAD":      "evil.so",
+		"BAND_REQUEST_ID": 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"PATH=/usr/local/bin:/usr/bin:/bin",
+		"HOME=/tmp",
+		"BAND_CHAIN_ID=chain-id",
+		"BAND_REQUEST_ID=10",
+		"BAND_VALIDATOR=validator",
+	}, envs)
+
+	_, err = localEnvs("BAND_CHAIN_ID=chain-id")
+	require.EqualError(t, err, "unsupported env type: string")
+}
+
+func TestScriptInterpreters(t *testing.T) {
+	interpreters, err := scriptInterpreters([]byte("#!/bin/sh\necho ok"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"/bin/sh"}, interpreters)
+
+	interpreters, err = scriptInterpreters([]byte("#!/usr/bin/env -S sh -e\necho ok"))
+	require.NoError(t, err)
+	require.Len(t, interpreters, 2)
+	require.Equal(t, "/usr/bin/env", interpreters[0])
+	require.Equal(t, "sh", filepath.Base(interpreters[1]))
+
+	_, err = scriptInterpreters([]byte("#! sh\necho ok"))
+	require.EqualError(t, err, "invalid interpreter directive: #! sh")
+}
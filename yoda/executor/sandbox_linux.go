@@ -0,0 +1,527 @@
+//go:build linux
+
+package executor
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// sandboxID is the user and group ID of the script inside its user namespace (nobody).
+	sandboxID = 65534
+	// x32SyscallBit marks the syscalls of the x32 ABI, which are all denied.
+	x32SyscallBit = 0x40000000
+	// maxSymlinkDepth is the maximum number of symbolic links followed to mount a path.
+	maxSymlinkDepth = 40
+)
+
+// The files listing the subordinate user and group IDs of the users (see subuid(5) and subgid(5)).
+var (
+	subUIDFile = "/etc/subuid"
+	subGIDFile = "/etc/subgid"
+)
+
+// sandboxLibraryPaths are the host paths mounted read-only in the sandbox besides the interpreter
+// of the script: the shared libraries and the modules of the interpreters, and the files needed to
+// resolve hosts and verify TLS certificates. The paths that do not exist on the host are skipped.
+var sandboxLibraryPaths = []string{
+	"/lib",
+	"/lib32",
+	"/lib64",
+	"/usr/lib",
+	"/usr/lib32",
+	"/usr/lib64",
+	"/usr/local/lib",
+	"/etc/ld.so.cache",
+	"/etc/ssl",
+	"/etc/ca-certificates",
+	"/etc/hosts",
+	"/etc/resolv.conf",
+	"/etc/nsswitch.conf",
+}
+
+// sandboxDevices are the devices mounted in the sandbox.
+var sandboxDevices = []string{"/dev/null", "/dev/zero", "/dev/random", "/dev/urandom"}
+
+// sandboxRlimits are the resource limits of the script, apart from its CPU time limit which
+// depends on the executor timeout.
+var sandboxRlimits = map[int]uint64{
+	unix.RLIMIT_AS:     1 << 30, // 1 GiB of address space
+	unix.RLIMIT_FSIZE:  16 << 20,
+	unix.RLIMIT_NOFILE: 256,
+	unix.RLIMIT_CORE:   0,
+}
+
+// sandboxDeniedSyscalls are the syscalls the script is not allowed to make. They return EPERM.
+var sandboxDeniedSyscalls = []uint32{
+	unix.SYS_ACCT,
+	unix.SYS_ADD_KEY,
+	unix.SYS_BPF,
+	unix.SYS_CHROOT,
+	unix.SYS_CLOCK_SETTIME,
+	unix.SYS_DELETE_MODULE,
+	unix.SYS_FINIT_MODULE,
+	unix.SYS_INIT_MODULE,
+	unix.SYS_KEXEC_LOAD,
+	unix.SYS_KEYCTL,
+	unix.SYS_MOUNT,
+	unix.SYS_OPEN_BY_HANDLE_AT,
+	unix.SYS_PERF_EVENT_OPEN,
+	unix.SYS_PIVOT_ROOT,
+	unix.SYS_PROCESS_VM_READV,
+	unix.SYS_PROCESS_VM_WRITEV,
+	unix.SYS_PTRACE,
+	unix.SYS_QUOTACTL,
+	unix.SYS_REBOOT,
+	unix.SYS_REQUEST_KEY,
+	unix.SYS_SETNS,
+	unix.SYS_SETTIMEOFDAY,
+	unix.SYS_SWAPOFF,
+	unix.SYS_SWAPON,
+	unix.SYS_SYSLOG,
+	unix.SYS_UMOUNT2,
+	unix.SYS_UNSHARE,
+	unix.SYS_USERFAULTFD,
+}
+
+// sandboxSysProcAttr returns the attributes of the sandbox init process. It runs in new user, PID,
+// mount, IPC and UTS namespaces. The user and group of the user namespace are mapped by
+// setupSandboxIDMap once the process is started. The network namespace is shared, as data source
+// scripts need to fetch data from the Internet.
+func sandboxSysProcAttr() (*syscall.SysProcAttr, error) {
+	return &syscall.SysProcAttr{
+		Cloneflags: syscall.CLONE_NEWUSER | syscall.CLONE_NEWPID | syscall.CLONE_NEWNS |
+			syscall.CLONE_NEWIPC | syscall.CLONE_NEWUTS,
+		Setpgid:   true,
+		Pdeathsig: syscall.SIGKILL,
+	}, nil
+}
+
+// setupSandboxIDMap maps the user and group IDs of the user namespace of the given process. The
+// root of the namespace, which only sets up the sandbox, is the user of yoda, while the sandbox
+// user that runs the script is the first subordinate user and group IDs of the user of yoda, so
+// that the script has none of its privileges on the host. The maps are written with newuidmap and
+// newgidmap, or directly if yoda runs as root.
+func setupSandboxIDMap(pid int) error {
+	u, err := user.Current()
+	if err != nil {
+		return err
+	}
+	uid, err := subordinateID(subUIDFile, u)
+	if err != nil {
+		return err
+	}
+	gid, err := subordinateID(subGIDFile, u)
+	if err != nil {
+		return err
+	}
+
+	maps := []struct {
+		helper string
+		file   string
+		ids    []int
+	}{
+		{"newuidmap", "uid_map", []int{0, os.Getuid(), 1, sandboxID, uid, 1}},
+		{"newgidmap", "gid_map", []int{0, os.Getgid(), 1, sandboxID, gid, 1}},
+	}
+	for _, m := range maps {
+		args := []string{strconv.Itoa(pid)}
+		for _, id := range m.ids {
+			args = append(args, strconv.Itoa(id))
+		}
+
+		if os.Geteuid() == 0 {
+			content := fmt.Sprintf("%s %s %s\n%s %s %s\n", args[1], args[2], args[3], args[4], args[5], args[6])
+			if err := os.WriteFile(fmt.Sprintf("/proc/%d/%s", pid, m.file), []byte(content), 0); err != nil {
+				return fmt.Errorf("failed to write %s: %w", m.file, err)
+			}
+			continue
+		}
+
+		if out, err := exec.Command(m.helper, args...).CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to run %s: %w: %s", m.helper, err, bytes.TrimSpace(out))
+		}
+	}
+
+	return nil
+}
+
+// subordinateID returns the first subordinate ID of the given user in the given subordinate ID
+// file (see subuid(5)).
+func subordinateID(file string, u *user.User) (int, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Split(strings.TrimSpace(line), ":")
+		if len(fields) != 3 || (fields[0] != u.Username && fields[0] != u.Uid) {
+			continue
+		}
+		start, err := strconv.ParseUint(fields[1], 10, 32)
+		if err != nil {
+			continue
+		}
+		count, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil || count == 0 {
+			continue
+		}
+		return int(start), nil
+	}
+
+	return 0, fmt.Errorf("no subordinate id range for user %s in %s", u.Username, file)
+}
+
+// InitSandbox runs the current process as the sandbox init process if it is started by LocalExec.
+// It waits for its user namespace to be mapped and executes itself again to gain the capabilities
+// of the root of the namespace. It then sets up the root filesystem, drops to the sandbox user,
+// applies the resource limits and the seccomp filter, and replaces the process with the data
+// source script, so it never returns in that case. Otherwise, it returns immediately. It must be
+// called at the very beginning of the main function of any binary that uses LocalExec.
+func InitSandbox() {
+	if len(os.Args) < 5 || (os.Args[1] != sandboxInitArg && os.Args[1] != sandboxMappedArg) {
+		return
+	}
+
+	// The seccomp filter and the no_new_privs flag apply to the calling thread, which must be the
+	// one that executes the script.
+	runtime.LockOSThread()
+
+	var err error
+	if os.Args[1] == sandboxInitArg {
+		err = reexecMapped()
+	} else {
+		syscall.CloseOnExec(sandboxErrorFd)
+		err = enterSandbox(os.Args[2], os.Args[3], filepath.SplitList(os.Args[4]), os.Args[5:])
+	}
+	if err != nil {
+		errPipe := os.NewFile(sandboxErrorFd, "sandbox-error")
+		_, _ = errPipe.WriteString(err.Error())
+		os.Exit(sandboxSetupFailedCode)
+	}
+}
+
+// reexecMapped waits for the user namespace to be mapped and executes the current executable
+// again as the root of the namespace, as the capabilities in the namespace are only granted on
+// execution. It only returns on errors.
+func reexecMapped() error {
+	syncPipe := os.NewFile(sandboxSyncFd, "sandbox-sync")
+	if _, err := syncPipe.Read(make([]byte, 1)); err != nil {
+		return fmt.Errorf("user namespace is not mapped: %w", err)
+	}
+	syncPipe.Close()
+
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	args := append([]string{os.Args[0], sandboxMappedArg}, os.Args[2:]...)
+	err = syscall.Exec(self, args, os.Environ())
+	return fmt.Errorf("failed to execute sandbox: %w", err)
+}
+
+// enterSandbox sets up the root filesystem from the given directory, drops to the sandbox user,
+// applies the limits of the sandbox to the current thread and executes the script with the given
+// arguments. It only returns on errors.
+func enterSandbox(cpuLimit string, dir string, interpreters []string, args []string) error {
+	cpuSeconds, err := strconv.ParseUint(cpuLimit, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid cpu limit: %s", cpuLimit)
+	}
+
+	if err := setupRootfs(dir, interpreters); err != nil {
+		return err
+	}
+	// Changing all user IDs from root drops all capabilities.
+	if err := unix.Setgroups(nil); err != nil {
+		return fmt.Errorf("failed to clear groups: %w", err)
+	}
+	if err := unix.Setresgid(sandboxID, sandboxID, sandboxID); err != nil {
+		return fmt.Errorf("failed to set gid: %w", err)
+	}
+	if err := unix.Setresuid(sandboxID, sandboxID, sandboxID); err != nil {
+		return fmt.Errorf("failed to set uid: %w", err)
+	}
+
+	if err := unix.Setrlimit(unix.RLIMIT_CPU, &unix.Rlimit{Cur: cpuSeconds, Max: cpuSeconds}); err != nil {
+		return fmt.Errorf("failed to set rlimit %d: %w", unix.RLIMIT_CPU, err)
+	}
+	for resource, limit := range sandboxRlimits {
+		if err := unix.Setrlimit(resource, &unix.Rlimit{Cur: limit, Max: limit}); err != nil {
+			return fmt.Errorf("failed to set rlimit %d: %w", resource, err)
+		}
+	}
+
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("failed to set no_new_privs: %w", err)
+	}
+	if err := installSeccompFilter(); err != nil {
+		return err
+	}
+
+	err = syscall.Exec(sandboxScriptPath, append([]string{sandboxScriptPath}, args...), os.Environ())
+	return fmt.Errorf("failed to execute script: %w", err)
+}
+
+// setupRootfs builds the root filesystem of the sandbox on a tmpfs mounted on the root directory
+// under the given directory, and makes it the root of the process. The root filesystem only
+// contains read-only bind mounts of the interpreters, sandboxLibraryPaths and the script, the
+// devices in sandboxDevices and a writable tmpfs at /tmp.
+func setupRootfs(dir string, interpreters []string) error {
+	root := filepath.Join(dir, "root")
+
+	// Keep the mounts of the sandbox from propagating to the host.
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("failed to make mounts private: %w", err)
+	}
+	if err := unix.Mount("tmpfs", root, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "mode=0755,size=1m"); err != nil {
+		return fmt.Errorf("failed to mount root: %w", err)
+	}
+
+	rootfs := &sandboxRootfs{root: root}
+	for _, path := range sandboxLibraryPaths {
+		if err := rootfs.bind(path, path, true); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	for _, path := range interpreters {
+		if err := rootfs.bind(path, path, true); err != nil {
+			return err
+		}
+	}
+	for _, path := range sandboxDevices {
+		if err := rootfs.bind(path, path, false); err != nil {
+			return err
+		}
+	}
+	if err := rootfs.bind(filepath.Join(dir, "exec"), sandboxScriptPath, true); err != nil {
+		return err
+	}
+
+	for _, path := range []string{"/tmp", "/.oldroot"} {
+		if err := os.Mkdir(filepath.Join(root, path), 0o755); err != nil {
+			return err
+		}
+	}
+	if err := unix.PivotRoot(root, filepath.Join(root, ".oldroot")); err != nil {
+		return fmt.Errorf("failed to pivot root: %w", err)
+	}
+	if err := unix.Chdir("/"); err != nil {
+		return err
+	}
+	if err := unix.Unmount("/.oldroot", unix.MNT_DETACH); err != nil {
+		return fmt.Errorf("failed to unmount old root: %w", err)
+	}
+	if err := os.Remove("/.oldroot"); err != nil {
+		return err
+	}
+
+	if err := unix.Mount("", "/", "", unix.MS_REMOUNT|unix.MS_RDONLY|unix.MS_NOSUID|unix.MS_NODEV, ""); err != nil {
+		return fmt.Errorf("failed to remount root as read-only: %w", err)
+	}
+	if err := unix.Mount("tmpfs", "/tmp", "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "mode=1777,size=64m"); err != nil {
+		return fmt.Errorf("failed to mount /tmp: %w", err)
+	}
+	return unix.Chdir("/tmp")
+}
+
+// sandboxRootfs is a root filesystem being built by bind mounting host paths into it.
+type sandboxRootfs struct {
+	root    string
+	mounted []string
+}
+
+// bind bind mounts the host path src to the path dst of the root filesystem. If src and dst are
+// the same path, the symbolic links in it are recreated in the root filesystem and their targets
+// are mounted instead, so that the path resolves the same way as on the host. Paths under an
+// already mounted directory are skipped.
+func (r *sandboxRootfs) bind(src string, dst string, readOnly bool) error {
+	if src != dst {
+		resolved, err := filepath.EvalSymlinks(src)
+		if err != nil {
+			return err
+		}
+		return r.mount(resolved, filepath.Clean(dst), readOnly)
+	}
+
+	return r.bindWithDepth(filepath.Clean(src), readOnly, 0)
+}
+
+// bindWithDepth bind mounts the host path to the same path of the root filesystem after following
+// depth symbolic links.
+func (r *sandboxRootfs) bindWithDepth(path string, readOnly bool, depth int) error {
+	if depth > maxSymlinkDepth {
+		return fmt.Errorf("too many levels of symbolic links: %s", path)
+	}
+	if r.isMounted(path) {
+		return nil
+	}
+
+	// Resolve the symbolic links in the path one component at a time.
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i := range parts {
+		current := "/" + filepath.Join(parts[:i+1]...)
+		info, err := os.Lstat(current)
+		if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink == 0 {
+			continue
+		}
+
+		target, err := os.Readlink(current)
+		if err != nil {
+			return err
+		}
+		link := filepath.Join(r.root, current)
+		if err := os.MkdirAll(filepath.Dir(link), 0o755); err != nil {
+			return err
+		}
+		if _, err := os.Lstat(link); os.IsNotExist(err) {
+			if err := os.Symlink(target, link); err != nil {
+				return err
+			}
+		}
+
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(filepath.Dir(current), target)
+		}
+		return r.bindWithDepth(filepath.Join(append([]string{target}, parts[i+1:]...)...), readOnly, depth+1)
+	}
+
+	return r.mount(path, path, readOnly)
+}
+
+// mount bind mounts the host path src, which has no symbolic links, to the path dst of the root
+// filesystem.
+func (r *sandboxRootfs) mount(src string, dst string, readOnly bool) error {
+	if r.isMounted(dst) {
+		return nil
+	}
+
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	target := filepath.Join(r.root, dst)
+	if info.IsDir() {
+		if err := os.MkdirAll(target, 0o755); err != nil {
+			return err
+		}
+	} else {
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return err
+		}
+		f.Close()
+	}
+
+	if err := unix.Mount(src, target, "", unix.MS_BIND, ""); err != nil {
+		return fmt.Errorf("failed to bind mount %s: %w", src, err)
+	}
+	if readOnly {
+		if err := remountReadOnly(target); err != nil {
+			return fmt.Errorf("failed to remount %s as read-only: %w", src, err)
+		}
+	}
+
+	r.mounted = append(r.mounted, dst)
+	return nil
+}
+
+// isMounted returns whether the path or one of its ancestors is already mounted.
+func (r *sandboxRootfs) isMounted(path string) bool {
+	for _, mounted := range r.mounted {
+		if path == mounted || strings.HasPrefix(path, mounted+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// remountReadOnly remounts the given bind mount as read-only. The flags of the mount locked by
+// the user namespace must be kept for the remount to be allowed.
+func remountReadOnly(target string) error {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(target, &stat); err != nil {
+		return err
+	}
+
+	flags := uintptr(unix.MS_REMOUNT | unix.MS_BIND | unix.MS_RDONLY | unix.MS_NOSUID | unix.MS_NODEV)
+	for statFlag, mountFlag := range map[int64]uintptr{
+		unix.ST_NOEXEC:     unix.MS_NOEXEC,
+		unix.ST_NOATIME:    unix.MS_NOATIME,
+		unix.ST_NODIRATIME: unix.MS_NODIRATIME,
+		unix.ST_RELATIME:   unix.MS_RELATIME,
+	} {
+		if stat.Flags&statFlag != 0 {
+			flags |= mountFlag
+		}
+	}
+
+	return unix.Mount("", target, "", flags, "")
+}
+
+// installSeccompFilter installs a seccomp filter that denies sandboxDeniedSyscalls on the current
+// thread and kills the process on any syscall from a foreign architecture.
+func installSeccompFilter() error {
+	var arch uint32
+	switch runtime.GOARCH {
+	case "amd64":
+		arch = unix.AUDIT_ARCH_X86_64
+	case "arm64":
+		arch = unix.AUDIT_ARCH_AARCH64
+	default:
+		return fmt.Errorf("seccomp filter is not supported on %s", runtime.GOARCH)
+	}
+
+	n := len(sandboxDeniedSyscalls)
+	filter := []unix.SockFilter{
+		// Load the architecture (offset 4 of seccomp_data) and kill the process on a mismatch.
+		bpfStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, 4),
+		bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, arch, 1, 0),
+		bpfStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_KILL_PROCESS),
+		// Load the syscall number (offset 0 of seccomp_data).
+		bpfStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, 0),
+		bpfJump(unix.BPF_JMP|unix.BPF_JGE|unix.BPF_K, x32SyscallBit, uint8(n+1), 0),
+	}
+	for i, nr := range sandboxDeniedSyscalls {
+		filter = append(filter, bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, nr, uint8(n-i), 0))
+	}
+	filter = append(filter,
+		bpfStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ALLOW),
+		bpfStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ERRNO|uint32(unix.EPERM)),
+	)
+
+	prog := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+	if err := unix.Prctl(unix.PR_SET_SECCOMP, unix.SECCOMP_MODE_FILTER, uintptr(unsafe.Pointer(&prog)), 0, 0); err != nil {
+		return fmt.Errorf("failed to install seccomp filter: %w", err)
+	}
+	return nil
+}
+
+// bpfStmt returns a BPF statement.
+func bpfStmt(code uint16, k uint32) unix.SockFilter {
+	return unix.SockFilter{Code: code, K: k}
+}
+
+// bpfJump returns a BPF jump instruction.
+func bpfJump(code uint16, k uint32, jt uint8, jf uint8) unix.SockFilter {
+	return unix.SockFilter{Code: code, Jt: jt, Jf: jf, K: k}
+}
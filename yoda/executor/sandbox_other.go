@@ -0,0 +1,22 @@
+//go:build !linux
+
+package executor
+
+import (
+	"fmt"
+	"runtime"
+	"syscall"
+)
+
+// sandboxSysProcAttr returns an error as the sandbox of LocalExec requires Linux.
+func sandboxSysProcAttr() (*syscall.SysProcAttr, error) {
+	return nil, fmt.Errorf("local executor is not supported on %s", runtime.GOOS)
+}
+
+// setupSandboxIDMap returns an error as the sandbox of LocalExec requires Linux.
+func setupSandboxIDMap(int) error {
+	return fmt.Errorf("local executor is not supported on %s", runtime.GOOS)
+}
+
+// InitSandbox does nothing as the sandbox of LocalExec requires Linux.
+func InitSandbox() {}
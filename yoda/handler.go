@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/cache"
+	"github.com/bandprotocol/chain/v3/yoda/executor"
 )
 
 type processingResult struct {
@@ -76,6 +78,16 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 
 	l.Info(":delivery_truck: Processing request")
 
+	// Resume from the journal if the request was handled before yoda restarted.
+	entry, hasEntry := getJournalEntry(c, l, id)
+	if hasEntry && len(entry.TxHashes) != 0 && waitForJournaledTxs(c, l, id, entry.TxHashes) {
+		l.Info(":floppy_disk: Reports have already been submitted in a previous run")
+		if err := c.journal.DeleteEntry(id); err != nil {
+			l.Error(":exploding_head: Failed to delete journal entry with error: %s", c, err.Error())
+		}
+		return
+	}
+
 	keyIndex := c.nextKeyIndex()
 	key := c.keys[keyIndex]
 
@@ -83,22 +95,44 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 
 	// prepare raw requests
 	for _, raw := range req.RawRequests {
-		hash, err := GetDataSourceHash(c, l, raw.DataSourceID)
+		dataSource, err := GetDataSource(c, l, raw.DataSourceID)
 		if err != nil {
-			l.Error(":skull: Failed to get data source hash with error: %s", c, err.Error())
+			l.Error(":skull: Failed to get data source with error: %s", c, err.Error())
 			return
 		}
 
+		// Execute the version of the data source pinned by the request, if any.
+		dataSourceHash := dataSource.Filename
+		if raw.DataSourceVersion != 0 {
+			dataSourceVersion, err := GetDataSourceVersion(c, l, raw.DataSourceID, raw.DataSourceVersion)
+			if err != nil {
+				l.Error(":skull: Failed to get data source version with error: %s", c, err.Error())
+				return
+			}
+			dataSourceHash = dataSourceVersion.Filename
+		}
+
 		rawRequests = append(rawRequests, rawRequest{
 			dataSourceID:   raw.DataSourceID,
-			dataSourceHash: hash,
+			dataSourceHash: dataSourceHash,
 			externalID:     raw.ExternalID,
 			calldata:       string(raw.Calldata),
+			cacheable:      dataSource.Cacheable,
 		})
 	}
 
-	// process raw requests
-	reports, execVersions := handleRawRequests(c, l, id, rawRequests, key)
+	// process raw requests, unless their reports are found in the journal
+	var reports []types.RawReport
+	var execVersions []string
+	if hasEntry {
+		l.Info(":floppy_disk: Found %d reports of the request in the journal", len(entry.RawReports))
+		reports, execVersions = entry.RawReports, entry.ExecVersions
+	} else {
+		reports, execVersions = handleRawRequests(c, l, id, rawRequests, key)
+		if err := c.journal.SetReports(id, reports, execVersions); err != nil {
+			l.Error(":exploding_head: Failed to save reports to journal with error: %s", c, err.Error())
+		}
+	}
 
 	c.pendingMsgs <- ReportMsgWithKey{
 		msg:         types.NewMsgReportData(id, reports, c.validator),
@@ -184,7 +218,7 @@ func handleRawRequest(
 		return
 	}
 
-	result, err := c.executor.Exec(exec, req.calldata, map[string]interface{}{
+	env := map[string]interface{}{
 		"BAND_CHAIN_ID":       vmsg.ChainID,
 		"BAND_DATA_SOURCE_ID": strconv.Itoa(int(vmsg.DataSourceID)),
 		"BAND_VALIDATOR":      vmsg.Validator,
@@ -192,7 +226,24 @@ func handleRawRequest(
 		"BAND_EXTERNAL_ID":    strconv.Itoa(int(vmsg.ExternalID)),
 		"BAND_REPORTER":       hex.EncodeToString(pubkey.Bytes()),
 		"BAND_SIGNATURE":      sig,
-	})
+	}
+
+	var result executor.ExecResult
+	if c.resultCache != nil && req.cacheable && !cache.ReadsRequestEnv(exec) {
+		var hit bool
+		cacheKey := cache.Key{DataSourceHash: req.dataSourceHash, Calldata: req.calldata}
+		result, hit, err = c.resultCache.Do(cacheKey, func() (executor.ExecResult, error) {
+			return c.executor.Exec(exec, req.calldata, env)
+		})
+		if hit {
+			l.Debug(":recycle: Reused result of data source with the same calldata")
+			c.updateCacheHitCount(1)
+		} else {
+			c.updateCacheMissCount(1)
+		}
+	} else {
+		result, err = c.executor.Exec(exec, req.calldata, env)
+	}
 
 	if err != nil {
 		l.Error(":skull: Failed to execute data source script: %s", c, err.Error())
@@ -0,0 +1,13 @@
+package journal
+
+import (
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// Entry represents the progress of yoda on a request, which is kept across restarts.
+type Entry struct {
+	RequestID    types.RequestID   `json:"request_id"`    // ID of the request
+	RawReports   []types.RawReport `json:"raw_reports"`   // Raw reports from executing the data sources
+	ExecVersions []string          `json:"exec_versions"` // Versions of the executors that produced the raw reports
+	TxHashes     []string          `json:"tx_hashes"`     // Hashes of the broadcast transactions that carry the reports
+}
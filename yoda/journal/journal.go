@@ -0,0 +1,114 @@
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// Journal represents an on-disk record of the requests handled by yoda, so that yoda can resume
+// its work after a restart without executing the data sources or broadcasting the reports again.
+type Journal struct {
+	DB dbm.DB
+}
+
+// NewJournal creates a new instance of Journal with the provided database.
+func NewJournal(db dbm.DB) *Journal {
+	return &Journal{
+		DB: db,
+	}
+}
+
+// Close closes the database of the journal.
+func (j *Journal) Close() error {
+	return j.DB.Close()
+}
+
+// SetReports stores the raw reports of the request along with the versions of the executors.
+func (j *Journal) SetReports(requestID types.RequestID, rawReports []types.RawReport, execVersions []string) error {
+	return j.setEntry(Entry{
+		RequestID:    requestID,
+		RawReports:   rawReports,
+		ExecVersions: execVersions,
+		TxHashes:     []string{},
+	})
+}
+
+// AddTxHash appends the hash of a broadcast transaction that carries the reports of the request.
+func (j *Journal) AddTxHash(requestID types.RequestID, txHash string) error {
+	entry, err := j.GetEntry(requestID)
+	if err != nil {
+		return err
+	}
+
+	entry.TxHashes = append(entry.TxHashes, txHash)
+	return j.setEntry(entry)
+}
+
+// GetAllEntries retrieves all journal entries.
+func (j *Journal) GetAllEntries() ([]Entry, error) {
+	iterator, err := j.DB.Iterator(EntryStoreKeyPrefix, storetypes.PrefixEndBytes(EntryStoreKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	entries := make([]Entry, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var entry Entry
+		err = json.Unmarshal(iterator.Value(), &entry)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, err
+}
+
+// GetEntry retrieves the journal entry of the request.
+func (j *Journal) GetEntry(requestID types.RequestID) (Entry, error) {
+	bytes, err := j.DB.Get(EntryStoreKey(requestID))
+	if err != nil {
+		return Entry{}, err
+	}
+
+	if bytes == nil {
+		return Entry{}, fmt.Errorf("journal entry of request ID (%d) doesn't exist", requestID)
+	}
+
+	var entry Entry
+	err = json.Unmarshal(bytes, &entry)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return entry, err
+}
+
+// HasEntry checks if the journal entry of the request exists.
+func (j *Journal) HasEntry(requestID types.RequestID) bool {
+	bytes, err := j.DB.Get(EntryStoreKey(requestID))
+	return err == nil && bytes != nil
+}
+
+// DeleteEntry deletes the journal entry of the request.
+func (j *Journal) DeleteEntry(requestID types.RequestID) error {
+	return j.DB.DeleteSync(EntryStoreKey(requestID))
+}
+
+// setEntry stores the journal entry and syncs it to the disk, as it must survive a crash.
+func (j *Journal) setEntry(entry Entry) error {
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return j.DB.SetSync(EntryStoreKey(entry.RequestID), bytes)
+}
@@ -0,0 +1,64 @@
+package journal_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/journal"
+)
+
+func TestJournal(t *testing.T) {
+	j := journal.NewJournal(dbm.NewMemDB())
+
+	require.False(t, j.HasEntry(1))
+	_, err := j.GetEntry(1)
+	require.EqualError(t, err, "journal entry of request ID (1) doesn't exist")
+	require.Error(t, j.AddTxHash(1, "hash"))
+
+	reports := []types.RawReport{types.NewRawReport(1, 0, []byte("data")), types.NewRawReport(2, 255, nil)}
+	require.NoError(t, j.SetReports(1, reports, []string{"local:1.0.0"}))
+	require.NoError(t, j.SetReports(2, reports[:1], []string{}))
+	require.NoError(t, j.AddTxHash(1, "hash1"))
+	require.NoError(t, j.AddTxHash(1, "hash2"))
+
+	require.True(t, j.HasEntry(1))
+	entry, err := j.GetEntry(1)
+	require.NoError(t, err)
+	require.Equal(t, journal.Entry{
+		RequestID:    1,
+		RawReports:   reports,
+		ExecVersions: []string{"local:1.0.0"},
+		TxHashes:     []string{"hash1", "hash2"},
+	}, entry)
+
+	entries, err := j.GetAllEntries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, types.RequestID(1), entries[0].RequestID)
+	require.Equal(t, types.RequestID(2), entries[1].RequestID)
+
+	require.NoError(t, j.DeleteEntry(1))
+	require.False(t, j.HasEntry(1))
+	entries, err = j.GetAllEntries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestJournalReopen(t *testing.T) {
+	dir := t.TempDir()
+	db, err := dbm.NewDB("journal", dbm.GoLevelDBBackend, dir)
+	require.NoError(t, err)
+	j := journal.NewJournal(db)
+	require.NoError(t, j.SetReports(1, []types.RawReport{types.NewRawReport(1, 0, []byte("data"))}, []string{}))
+	require.NoError(t, j.Close())
+
+	// the database can be opened again only after the journal is closed
+	db, err = dbm.NewDB("journal", dbm.GoLevelDBBackend, dir)
+	require.NoError(t, err)
+	j = journal.NewJournal(db)
+	require.True(t, j.HasEntry(1))
+	require.NoError(t, j.Close())
+}
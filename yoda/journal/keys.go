@@ -0,0 +1,17 @@
+package journal
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+var (
+	// EntryStoreKeyPrefix is the prefix for journal entry store.
+	EntryStoreKeyPrefix = []byte{0x01}
+)
+
+// EntryStoreKey returns the key to retrieve the journal entry of a request.
+func EntryStoreKey(requestID types.RequestID) []byte {
+	return append(EntryStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
+}
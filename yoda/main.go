@@ -20,6 +20,7 @@ import (
 
 	band "github.com/bandprotocol/chain/v3/app"
 	"github.com/bandprotocol/chain/v3/app/params"
+	"github.com/bandprotocol/chain/v3/yoda/executor"
 )
 
 const (
@@ -30,6 +31,7 @@ const (
 	flagRPCPollInterval  = "rpc-poll-interval"
 	flagMaxTry           = "max-try"
 	flagMaxReport        = "max-report"
+	flagResultCacheTTL   = "result-cache-ttl"
 )
 
 // Config data structure for yoda daemon.
@@ -45,6 +47,7 @@ type Config struct {
 	MaxTry            uint64 `mapstructure:"max-try"`             // The maximum number of tries to submit a report transaction
 	MaxReport         uint64 `mapstructure:"max-report"`          // The maximum number of reports in one transaction
 	MetricsListenAddr string `mapstructure:"metrics-listen-addr"` // Address to listen on for prometheus metrics
+	ResultCacheTTL    string `mapstructure:"result-cache-ttl"`    // The duration for which data source results are reused (0 to disable)
 }
 
 // Global instances.
@@ -73,6 +76,9 @@ func init() {
 }
 
 func Main() {
+	// Run as the sandbox of the local executor instead if yoda is started by it.
+	executor.InitSandbox()
+
 	appConfig := sdk.GetConfig()
 	band.SetBech32AddressPrefixesAndBip44CoinTypeAndSeal(appConfig)
 
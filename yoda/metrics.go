@@ -15,6 +15,8 @@ type yodaCollector struct {
 	reportsPendingGaugeDesc   *prometheus.Desc
 	reportsErrorCountDesc     *prometheus.Desc
 	reportsSubmittedCountDesc *prometheus.Desc
+	resultCacheHitCountDesc   *prometheus.Desc
+	resultCacheMissCountDesc  *prometheus.Desc
 }
 
 func NewYodaCollector(c *Context) prometheus.Collector {
@@ -36,6 +38,14 @@ func NewYodaCollector(c *Context) prometheus.Collector {
 			"yoda_reports_submitted_total",
 			"Number of reports submitted since last yoda restart",
 			nil, nil),
+		resultCacheHitCountDesc: prometheus.NewDesc(
+			"yoda_result_cache_hit_total",
+			"Number of data source executions served from the result cache since last yoda restart",
+			nil, nil),
+		resultCacheMissCountDesc: prometheus.NewDesc(
+			"yoda_result_cache_miss_total",
+			"Number of data source executions not served from the result cache since last yoda restart",
+			nil, nil),
 	}
 }
 
@@ -44,6 +54,8 @@ func (collector yodaCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- collector.reportsPendingGaugeDesc
 	ch <- collector.reportsErrorCountDesc
 	ch <- collector.reportsSubmittedCountDesc
+	ch <- collector.resultCacheHitCountDesc
+	ch <- collector.resultCacheMissCountDesc
 }
 
 func (collector yodaCollector) Collect(ch chan<- prometheus.Metric) {
@@ -55,6 +67,10 @@ func (collector yodaCollector) Collect(ch chan<- prometheus.Metric) {
 		float64(atomic.LoadInt64(&collector.context.errorCount)))
 	ch <- prometheus.MustNewConstMetric(collector.reportsSubmittedCountDesc, prometheus.CounterValue,
 		float64(atomic.LoadInt64(&collector.context.submittedCount)))
+	ch <- prometheus.MustNewConstMetric(collector.resultCacheHitCountDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.cacheHitCount)))
+	ch <- prometheus.MustNewConstMetric(collector.resultCacheMissCountDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.cacheMissCount)))
 }
 
 func metricsListen(listenAddr string, c *Context) {
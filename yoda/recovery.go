@@ -0,0 +1,95 @@
+package yoda
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/journal"
+)
+
+// pruneJournal deletes the journal entries of the requests that are no longer pending for the validator,
+// i.e. the requests that have been reported or expired while yoda was not running.
+func pruneJournal(c *Context, l *Logger, pendingIDs []uint64) {
+	pending := make(map[types.RequestID]bool, len(pendingIDs))
+	for _, id := range pendingIDs {
+		pending[types.RequestID(id)] = true
+	}
+
+	entries, err := c.journal.GetAllEntries()
+	if err != nil {
+		l.Error(":exploding_head: Failed to get journal entries with error: %s", c, err.Error())
+		return
+	}
+
+	for _, entry := range entries {
+		if pending[entry.RequestID] {
+			continue
+		}
+		if err := c.journal.DeleteEntry(entry.RequestID); err != nil {
+			l.Error(":exploding_head: Failed to delete journal entry with error: %s", c, err.Error())
+		}
+	}
+}
+
+// getJournalEntry returns the journal entry of the request from a previous run of yoda, if any.
+func getJournalEntry(c *Context, l *Logger, id types.RequestID) (journal.Entry, bool) {
+	if !c.journal.HasEntry(id) {
+		return journal.Entry{}, false
+	}
+
+	entry, err := c.journal.GetEntry(id)
+	if err != nil {
+		l.Error(":exploding_head: Failed to get journal entry with error: %s", c, err.Error())
+		return journal.Entry{}, false
+	}
+
+	return entry, true
+}
+
+// waitForJournaledTxs waits for the transactions broadcast in a previous run of yoda until the broadcast
+// timeout. It returns true if any of them is successfully included in a block or the report of the
+// validator for the request is found on-chain, e.g. from a transaction that was still in the mempool.
+// The transactions and the report are queried once more after the timeout, before the reports are
+// broadcast again.
+func waitForJournaledTxs(c *Context, l *Logger, id types.RequestID, txHashes []string) bool {
+	clientCtx := client.Context{
+		Client:            c.client,
+		TxConfig:          c.encodingConfig.TxConfig,
+		InterfaceRegistry: c.encodingConfig.InterfaceRegistry,
+	}
+
+	pending := make(map[string]bool, len(txHashes))
+	for _, txHash := range txHashes {
+		pending[txHash] = true
+	}
+
+	deadline := time.Now().Add(c.broadcastTimeout)
+	for {
+		for txHash := range pending {
+			txRes, err := authtx.QueryTx(clientCtx, txHash)
+			if err != nil {
+				l.Debug(":warning: Failed to query tx with error: %s", err.Error())
+				continue
+			}
+
+			if txRes.Code == 0 {
+				return true
+			}
+			// The transaction is included in a block but failed, so it does not need to be waited for.
+			delete(pending, txHash)
+		}
+
+		reported, err := HasReport(c, l, id)
+		if err == nil && reported {
+			return true
+		}
+
+		if len(pending) == 0 || time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(c.rpcPollInterval)
+	}
+}
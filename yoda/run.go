@@ -3,7 +3,11 @@ package yoda
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -11,6 +15,7 @@ import (
 
 	httpclient "github.com/cometbft/cometbft/rpc/client/http"
 	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/log"
 
@@ -19,7 +24,9 @@ import (
 
 	"github.com/bandprotocol/chain/v3/pkg/filecache"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/cache"
 	"github.com/bandprotocol/chain/v3/yoda/executor"
+	"github.com/bandprotocol/chain/v3/yoda/journal"
 )
 
 const (
@@ -68,13 +75,21 @@ func runImpl(c *Context, l *Logger) error {
 	c.encodingConfig.Codec.MustUnmarshal(resBz.Response.Value, &pendingRequests)
 
 	l.Info(":mag: Found %d pending requests", len(pendingRequests.RequestIDs))
+	pruneJournal(c, l, pendingRequests.RequestIDs)
 	for _, id := range pendingRequests.RequestIDs {
 		c.pendingRequests[types.RequestID(id)] = true
 		go handleRequest(c, l, types.RequestID(id))
 	}
 
+	// Listen for termination signals for graceful shutdown
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+
 	for {
 		select {
+		case <-sigChan:
+			l.Info(":wave: Received stop signal, shutting down")
+			return nil
 		case ev := <-eventChan:
 			go handleTransaction(c, l, ev.Data.(cmttypes.EventDataTx).TxResult)
 		case keyIndex := <-c.freeKeys:
@@ -145,10 +160,27 @@ func runCmd(c *Context) *cobra.Command {
 				return err
 			}
 			c.fileCache = filecache.New(filepath.Join(c.home, "files"))
+			db, err := dbm.NewDB("journal", dbm.GoLevelDBBackend, c.home)
+			if err != nil {
+				return fmt.Errorf("%w; possibly due to being run in another process", err)
+			}
+			c.journal = journal.NewJournal(db)
+			defer func() {
+				if err := c.journal.Close(); err != nil {
+					l.Error(":exploding_head: Failed to close journal with error: %s", c, err.Error())
+				}
+			}()
 			c.broadcastTimeout, err = time.ParseDuration(cfg.BroadcastTimeout)
 			if err != nil {
 				return err
 			}
+			resultCacheTTL, err := time.ParseDuration(cfg.ResultCacheTTL)
+			if err != nil {
+				return err
+			}
+			if resultCacheTTL > 0 {
+				c.resultCache = cache.NewResultCache(resultCacheTTL)
+			}
 			c.maxTry = cfg.MaxTry
 			c.maxReport = cfg.MaxReport
 			c.rpcPollInterval, err = time.ParseDuration(cfg.RPCPollInterval)
@@ -173,6 +205,7 @@ func runCmd(c *Context) *cobra.Command {
 	cmd.Flags().String(flagRPCPollInterval, "1s", "The duration of rpc poll interval")
 	cmd.Flags().Uint64(flagMaxTry, 5, "The maximum number of tries to submit a report transaction")
 	cmd.Flags().Uint64(flagMaxReport, 10, "The maximum number of reports in one transaction")
+	cmd.Flags().String(flagResultCacheTTL, "0s", "The duration for which results of cacheable data sources are reused for the same calldata (0 to disable)")
 	_ = viper.BindPFlag(flags.FlagChainID, cmd.Flags().Lookup(flags.FlagChainID))
 	_ = viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
 	_ = viper.BindPFlag(flagValidator, cmd.Flags().Lookup(flagValidator))
@@ -183,6 +216,7 @@ func runCmd(c *Context) *cobra.Command {
 	_ = viper.BindPFlag(flagRPCPollInterval, cmd.Flags().Lookup(flagRPCPollInterval))
 	_ = viper.BindPFlag(flagMaxTry, cmd.Flags().Lookup(flagMaxTry))
 	_ = viper.BindPFlag(flagMaxReport, cmd.Flags().Lookup(flagMaxReport))
+	_ = viper.BindPFlag(flagResultCacheTTL, cmd.Flags().Lookup(flagResultCacheTTL))
 
 	return cmd
 }